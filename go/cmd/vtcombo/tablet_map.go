@@ -32,10 +32,12 @@ import (
 	"github.com/youtube/vitess/go/vt/vtgate/vindexes"
 	"github.com/youtube/vitess/go/vt/wrangler"
 
+	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vschemapb "github.com/youtube/vitess/go/vt/proto/vschema"
 	vttestpb "github.com/youtube/vitess/go/vt/proto/vttest"
 )
 
@@ -72,7 +74,7 @@ func createTablet(ctx context.Context, ts topo.Server, cell string, uid uint32,
 	}
 	agent := tabletmanager.NewComboActionAgent(ctx, ts, alias, int32(8000+uid), int32(9000+uid), controller, dbcfgs, mysqld, keyspace, shard, dbname, strings.ToLower(initTabletType.String()))
 	if tabletType == topodatapb.TabletType_MASTER {
-		if err := agent.TabletExternallyReparented(ctx, ""); err != nil {
+		if err := agent.TabletExternallyReparented(ctx, "", false /* validate */); err != nil {
 			return fmt.Errorf("TabletExternallyReparented failed on master %v: %v", topoproto.TabletAliasString(alias), err)
 		}
 	}
@@ -602,6 +604,30 @@ func (itmc *internalTabletManagerClient) GetSchema(ctx context.Context, tablet *
 	return t.agent.GetSchema(ctx, tables, excludeTables, includeViews)
 }
 
+func (itmc *internalTabletManagerClient) GetSchemaBestEffort(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool, timeout time.Duration) (*tabletmanagerdatapb.SchemaDefinition, bool, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, false, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetSchemaBestEffort(ctx, tables, excludeTables, includeViews, timeout)
+}
+
+func (itmc *internalTabletManagerClient) GetCreateStatements(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (map[string]string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetCreateStatements(ctx, tables, excludeTables, includeViews)
+}
+
+func (itmc *internalTabletManagerClient) GetSchemaTimestamps(ctx context.Context, tablet *topodatapb.Tablet, tables []string) (map[string]time.Time, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetSchemaTimestamps(ctx, tables)
+}
+
 func (itmc *internalTabletManagerClient) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -610,6 +636,94 @@ func (itmc *internalTabletManagerClient) GetPermissions(ctx context.Context, tab
 	return t.agent.GetPermissions(ctx)
 }
 
+func (itmc *internalTabletManagerClient) GetConnectionStats(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ConnectionStats, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetConnectionStats(ctx)
+}
+
+func (itmc *internalTabletManagerClient) GetConnectionRejectionStats(ctx context.Context, tablet *topodatapb.Tablet, reset bool) (map[string]int64, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetConnectionRejectionStats(ctx, reset)
+}
+
+func (itmc *internalTabletManagerClient) GetConfig(ctx context.Context, tablet *topodatapb.Tablet) (map[string]string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetConfig(ctx)
+}
+
+func (itmc *internalTabletManagerClient) GetInFlightRPCs(ctx context.Context, tablet *topodatapb.Tablet) (map[string]int64, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetInFlightRPCs(ctx)
+}
+
+func (itmc *internalTabletManagerClient) GetProcessStats(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ProcessStats, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetProcessStats(ctx)
+}
+
+func (itmc *internalTabletManagerClient) GetHealthScore(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.HealthScore, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetHealthScore(ctx)
+}
+
+func (itmc *internalTabletManagerClient) GetErrorLogTail(ctx context.Context, tablet *topodatapb.Tablet, lines int) ([]string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetErrorLogTail(ctx, lines)
+}
+
+func (itmc *internalTabletManagerClient) CaptureDiagnostics(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.DiagnosticBundle, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.CaptureDiagnostics(ctx)
+}
+
+func (itmc *internalTabletManagerClient) CheckDataDir(ctx context.Context, tablet *topodatapb.Tablet) ([]string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.CheckDataDir(ctx)
+}
+
+func (itmc *internalTabletManagerClient) GetThrottleState(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ThrottleState, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetThrottleState(ctx)
+}
+
+func (itmc *internalTabletManagerClient) GetTabletTags(ctx context.Context, tablet *topodatapb.Tablet) (map[string]string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetTabletTags(ctx)
+}
+
 func (itmc *internalTabletManagerClient) SetReadOnly(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -618,15 +732,55 @@ func (itmc *internalTabletManagerClient) SetReadWrite(ctx context.Context, table
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType) error {
+func (itmc *internalTabletManagerClient) SetReadOnlyWithTTL(ctx context.Context, tablet *topodatapb.Tablet, ttl time.Duration) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) SetSuperReadOnly(ctx context.Context, tablet *topodatapb.Tablet, on bool) (bool, error) {
+	return false, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) SetQueryServerConfig(ctx context.Context, tablet *topodatapb.Tablet, config *tabletmanagerdatapb.QueryServerConfig) (*tabletmanagerdatapb.QueryServerConfig, []string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.SetQueryServerConfig(ctx, config)
+}
+
+func (itmc *internalTabletManagerClient) GetQueryBlacklist(ctx context.Context, tablet *topodatapb.Tablet) ([]string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetQueryBlacklist(ctx)
+}
+
+func (itmc *internalTabletManagerClient) SetQueryBlacklist(ctx context.Context, tablet *topodatapb.Tablet, patterns []string) error {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
 		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
 	}
-	t.agent.ChangeType(ctx, dbType)
+	return t.agent.SetQueryBlacklist(ctx, patterns)
+}
+
+func (itmc *internalTabletManagerClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType, idempotent bool) error {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	t.agent.ChangeType(ctx, dbType, idempotent)
 	return nil
 }
 
+func (itmc *internalTabletManagerClient) SetTabletTags(ctx context.Context, tablet *topodatapb.Tablet, tags map[string]string) error {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.SetTabletTags(ctx, tags)
+}
+
 func (itmc *internalTabletManagerClient) Sleep(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) error {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -640,6 +794,10 @@ func (itmc *internalTabletManagerClient) ExecuteHook(ctx context.Context, tablet
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) ExecuteHookToStream(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (tmclient.HookStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -648,6 +806,14 @@ func (itmc *internalTabletManagerClient) RefreshState(ctx context.Context, table
 	return t.agent.RefreshState(ctx)
 }
 
+func (itmc *internalTabletManagerClient) RepublishTopoRecord(ctx context.Context, tablet *topodatapb.Tablet) (int64, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return 0, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.RepublishTopoRecord(ctx)
+}
+
 func (itmc *internalTabletManagerClient) RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -666,6 +832,71 @@ func (itmc *internalTabletManagerClient) IgnoreHealthError(ctx context.Context,
 	return nil
 }
 
+func (itmc *internalTabletManagerClient) SetMaintenanceMode(ctx context.Context, tablet *topodatapb.Tablet, on bool, reason string) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) PauseHealthReporting(ctx context.Context, tablet *topodatapb.Tablet, on bool) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) SetThrottle(ctx context.Context, tablet *topodatapb.Tablet, mode string, duration time.Duration) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) PrepareCutover(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return "", fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.PrepareCutover(ctx)
+}
+
+func (itmc *internalTabletManagerClient) CommitCutover(ctx context.Context, tablet *topodatapb.Tablet, token string) error {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.CommitCutover(ctx, token)
+}
+
+func (itmc *internalTabletManagerClient) AbortCutover(ctx context.Context, tablet *topodatapb.Tablet, token string) error {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.AbortCutover(ctx, token)
+}
+
+func (itmc *internalTabletManagerClient) SetServingKeyRange(ctx context.Context, tablet *topodatapb.Tablet, keyRange *topodatapb.KeyRange, servedTypes []topodatapb.TabletType) error {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.SetServingKeyRange(ctx, keyRange, servedTypes)
+}
+
+func (itmc *internalTabletManagerClient) RestartMysql(ctx context.Context, tablet *topodatapb.Tablet, opts tmclient.RestartMysqlOptions) (logutil.EventStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) Decommission(ctx context.Context, tablet *topodatapb.Tablet, opts tmclient.DecommissionOptions) (logutil.EventStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) CheckTopoConnectivity(ctx context.Context, tablet *topodatapb.Tablet) (bool, time.Duration, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return false, 0, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	reachable, latency := t.agent.CheckTopoConnectivity(ctx)
+	return reachable, latency, nil
+}
+
+func (itmc *internalTabletManagerClient) WarmUp(ctx context.Context, tablet *topodatapb.Tablet, queries []string, loadBufferPool bool) (tmclient.WarmUpStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -690,7 +921,47 @@ func (itmc *internalTabletManagerClient) ApplySchema(ctx context.Context, tablet
 	return t.agent.ApplySchema(ctx, change)
 }
 
-func (itmc *internalTabletManagerClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+func (itmc *internalTabletManagerClient) SchemaDiff(ctx context.Context, tablet *topodatapb.Tablet, desired *tabletmanagerdatapb.SchemaDefinition) ([]string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.SchemaDiff(ctx, desired)
+}
+
+func (itmc *internalTabletManagerClient) AssessSchemaChange(ctx context.Context, tablet *topodatapb.Tablet, change string) (*tabletmanagerdatapb.SchemaChangeAssessment, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.AssessSchemaChange(ctx, change)
+}
+
+func (itmc *internalTabletManagerClient) WatchSchema(ctx context.Context, tablet *topodatapb.Tablet) (tmclient.SchemaChangeStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetVSchema(ctx context.Context, tablet *topodatapb.Tablet) (*vschemapb.Keyspace, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetVSchema(ctx)
+}
+
+func (itmc *internalTabletManagerClient) ApplyVSchema(ctx context.Context, tablet *topodatapb.Tablet, vschema string) error {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.ApplyVSchema(ctx, vschema)
+}
+
+func (itmc *internalTabletManagerClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool, maxExecTime time.Duration) (*querypb.QueryResult, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) ExecuteFetchAsDbaCSV(ctx context.Context, tablet *topodatapb.Tablet, query []byte) (tmclient.CSVStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
@@ -698,18 +969,126 @@ func (itmc *internalTabletManagerClient) ExecuteFetchAsAllPrivs(ctx context.Cont
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) ExecuteFetchColumnar(ctx context.Context, tablet *topodatapb.Tablet, query []byte, maxRows int) (*tabletmanagerdatapb.ColumnarResult, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int) (*querypb.QueryResult, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) ExplainQuery(ctx context.Context, tablet *topodatapb.Tablet, query, format string) (*querypb.QueryResult, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.ExplainQuery(ctx, query, format)
+}
+
+func (itmc *internalTabletManagerClient) ApplyGrants(ctx context.Context, tablet *topodatapb.Tablet, statements []string, atomic bool) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) ChecksumTable(ctx context.Context, tablet *topodatapb.Tablet, table string, keyRange *topodatapb.KeyRange) (uint64, int64, error) {
+	return 0, 0, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) TruncateTable(ctx context.Context, tablet *topodatapb.Tablet, table, confirmKeyspace string) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) RenameTable(ctx context.Context, tablet *topodatapb.Tablet, from, to string, opts tmclient.RenameTableOptions) (*tabletmanagerdatapb.RenameTableResult, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.RenameTable(ctx, from, to, opts.Cascade)
+}
+
+func (itmc *internalTabletManagerClient) StreamRowsInKeyRange(ctx context.Context, tablet *topodatapb.Tablet, table string, keyRange *topodatapb.KeyRange) (tmclient.RowStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) StreamKeyRangeBinlog(ctx context.Context, tablet *topodatapb.Tablet, keyRange *topodatapb.KeyRange, startPos string, charset *binlogdatapb.Charset) (tmclient.BinlogTransactionStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) CloneStream(ctx context.Context, tablet *topodatapb.Tablet, tables []string, opts tmclient.CloneOptions) (tmclient.CloneStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetCharsetConfig(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.CharsetConfig, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetCharsetConfig(ctx)
+}
+
+func (itmc *internalTabletManagerClient) GetProcessList(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.Process, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) KillProcess(ctx context.Context, tablet *topodatapb.Tablet, id int64) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) TailGeneralLog(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) (tmclient.GeneralLogStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) StreamQPS(ctx context.Context, tablet *topodatapb.Tablet, interval time.Duration) (<-chan *tabletmanagerdatapb.QPSSample, tmclient.ErrFunc, error) {
+	return nil, nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) SlaveStatusAllChannels(ctx context.Context, tablet *topodatapb.Tablet) (map[string]*replicationdatapb.Status, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetReplicationSource(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ReplicationSource, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetReplicationSource(ctx)
+}
+
+func (itmc *internalTabletManagerClient) ValidateReplicationCredentials(ctx context.Context, tablet *topodatapb.Tablet) (bool, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return false, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.ValidateReplicationCredentials(ctx)
+}
+
+func (itmc *internalTabletManagerClient) GetApplierQueueStats(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ApplierQueueStats, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetApplierQueueStats(ctx)
+}
+
 func (itmc *internalTabletManagerClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
 	return "", fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) GetGtidPurged(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	return "", fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetBinlogStats(ctx context.Context, tablet *topodatapb.Tablet) (float64, time.Duration, error) {
+	return 0, 0, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetReplicationErrorStats(ctx context.Context, tablet *topodatapb.Tablet, reset bool) (*tabletmanagerdatapb.ReplicationErrorStats, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) StopSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -718,11 +1097,39 @@ func (itmc *internalTabletManagerClient) StopSlaveMinimum(ctx context.Context, t
 	return "", fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) StopSlaveMinimumStream(ctx context.Context, tablet *topodatapb.Tablet, stopPos string, waitTime time.Duration) (tmclient.StopSlaveMinimumStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) BoostReplicationCatchup(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) (tmclient.BoostReplicationCatchupStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) StartSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) TabletExternallyReparented(ctx context.Context, tablet *topodatapb.Tablet, externalID string) error {
+func (itmc *internalTabletManagerClient) EnableParallelReplication(ctx context.Context, tablet *topodatapb.Tablet, workers int, parallelType string) error {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.EnableParallelReplication(ctx, workers, parallelType)
+}
+
+func (itmc *internalTabletManagerClient) RotateReplicationCredentials(ctx context.Context, tablet *topodatapb.Tablet, user, password string) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) ConfigureReplicationSSL(ctx context.Context, tablet *topodatapb.Tablet, opts *tabletmanagerdatapb.ReplicationSSLOptions) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) RepairRelayLog(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) TabletExternallyReparented(ctx context.Context, tablet *topodatapb.Tablet, externalID string, validate bool) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
@@ -730,6 +1137,10 @@ func (itmc *internalTabletManagerClient) GetSlaves(ctx context.Context, tablet *
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) GetReplicationGraph(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ReplicationGraph, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) WaitBlpPosition(ctx context.Context, tablet *topodatapb.Tablet, blpPosition *tabletmanagerdatapb.BlpPosition, waitTime time.Duration) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -746,18 +1157,30 @@ func (itmc *internalTabletManagerClient) RunBlpUntil(ctx context.Context, tablet
 	return "", fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) GetBinlogFilters(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.BinlogFilter, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) ResetReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) InitMaster(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+func (itmc *internalTabletManagerClient) InitMaster(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string) (string, error) {
 	return "", fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) PopulateReparentJournal(ctx context.Context, tablet *topodatapb.Tablet, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, pos string) error {
+func (itmc *internalTabletManagerClient) PopulateReparentJournal(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, pos string) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) GetLastReparentJournalEntry(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ReparentJournalEntry, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetLastReparentJournalEntry(ctx)
+}
+
 func (itmc *internalTabletManagerClient) InitSlave(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, replicationPosition string, timeCreatedNS int64) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -774,7 +1197,11 @@ func (itmc *internalTabletManagerClient) SlaveWasPromoted(ctx context.Context, t
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error {
+func (itmc *internalTabletManagerClient) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) ConfigureReplication(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, parent *topodatapb.TabletAlias, opts tmclient.ReplicationOptions) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
@@ -790,13 +1217,85 @@ func (itmc *internalTabletManagerClient) PromoteSlave(ctx context.Context, table
 	return "", fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) SetReparentEligibility(ctx context.Context, tablet *topodatapb.Tablet, eligible bool, reason string) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) CheckReparentCandidate(ctx context.Context, tablet *topodatapb.Tablet) (bool, string, error) {
+	return false, "", fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) SetSemiSyncAckCount(ctx context.Context, tablet *topodatapb.Tablet, count int) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetDurabilityPolicy(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.DurabilityPolicy, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetDurabilityPolicy(ctx)
+}
+
+func (itmc *internalTabletManagerClient) GetBackupLimits(ctx context.Context, tablet *topodatapb.Tablet) (int, int, error) {
+	return 0, 0, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetBackupPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return "", fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetBackupPosition(ctx)
+}
+
 func (itmc *internalTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) IncrementalBackup(ctx context.Context, tablet *topodatapb.Tablet, baseBackupName string, opts tmclient.IncrementalBackupOptions) (logutil.EventStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) RestoreToTimestamp(ctx context.Context, tablet *topodatapb.Tablet, backupName string, targetTime time.Time) (logutil.EventStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) SetPreferredBackup(ctx context.Context, tablet *topodatapb.Tablet, backupName string) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetPreferredBackup(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	return "", fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) CheckRestoreCompatibility(ctx context.Context, tablet *topodatapb.Tablet, backupName string) (*tabletmanagerdatapb.CompatReport, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) TestRestore(ctx context.Context, tablet *topodatapb.Tablet, backupName string) (logutil.EventStream, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetLastBackupInfo(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.BackupInfo, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) GetBackupFreshness(ctx context.Context, tablet *topodatapb.Tablet) (string, time.Duration, error) {
+	return "", 0, fmt.Errorf("not implemented in vtcombo")
+}
+
+func (itmc *internalTabletManagerClient) CheckBackupReadiness(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ReadinessReport, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.CheckBackupReadiness(ctx)
+}
+
 func (itmc *internalTabletManagerClient) Close() {
 }
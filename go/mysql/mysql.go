@@ -120,6 +120,12 @@ const (
 )
 
 const (
+	// ErrConCount is C.ER_CON_COUNT_ERROR
+	ErrConCount = C.ER_CON_COUNT_ERROR
+
+	// ErrTooManyUserConnections is C.ER_TOO_MANY_USER_CONNECTIONS
+	ErrTooManyUserConnections = C.ER_TOO_MANY_USER_CONNECTIONS
+
 	// ErrDupEntry is C.ER_DUP_ENTRY
 	ErrDupEntry = C.ER_DUP_ENTRY
 
@@ -175,9 +175,18 @@ const (
 	// ERUnknownError is ER_UNKNOWN_ERROR
 	ERUnknownError = 1105
 
+	// ERNonExistingGrant is ER_NONEXISTING_GRANT
+	ERNonExistingGrant = 1141
+
+	// ERNoSuchTable is ER_NO_SUCH_TABLE
+	ERNoSuchTable = 1146
+
 	// ERCantDoThisDuringAnTransaction is
 	// ER_CANT_DO_THIS_DURING_AN_TRANSACTION
 	ERCantDoThisDuringAnTransaction = 1179
+
+	// ERCannotUser is ER_CANNOT_USER
+	ERCannotUser = 1396
 )
 
 // Sql states for errors.
@@ -316,6 +316,41 @@ func (set Mysql56GTIDSet) AddGTID(gtid GTID) GTIDSet {
 	return newSet
 }
 
+// Difference returns the GTIDs of the set that are not in other.
+func (set Mysql56GTIDSet) Difference(other Mysql56GTIDSet) Mysql56GTIDSet {
+	diff := make(Mysql56GTIDSet)
+	for sid, intervals := range set {
+		otherIntervals := other[sid]
+		var diffIntervals []interval
+		for _, iv := range intervals {
+			// Cut the other intervals out of iv. Both lists are
+			// sorted, and the other intervals do not overlap.
+			for _, otherIv := range otherIntervals {
+				if otherIv.end < iv.start {
+					continue
+				}
+				if otherIv.start > iv.end {
+					break
+				}
+				if otherIv.start > iv.start {
+					diffIntervals = append(diffIntervals, interval{start: iv.start, end: otherIv.start - 1})
+				}
+				iv.start = otherIv.end + 1
+				if iv.start > iv.end {
+					break
+				}
+			}
+			if iv.start <= iv.end {
+				diffIntervals = append(diffIntervals, iv)
+			}
+		}
+		if len(diffIntervals) > 0 {
+			diff[sid] = diffIntervals
+		}
+	}
+	return diff
+}
+
 // SIDBlock returns the binary encoding of a MySQL 5.6 GTID set as expected
 // by internal commands that refer to an "SID block".
 //
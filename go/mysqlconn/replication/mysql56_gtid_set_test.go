@@ -247,6 +247,46 @@ func TestMysql56GTIDSetContains(t *testing.T) {
 	}
 }
 
+func TestMysql56GTIDSetDifference(t *testing.T) {
+	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
+
+	set := Mysql56GTIDSet{
+		sid1: []interval{{20, 30}, {35, 40}},
+		sid2: []interval{{1, 5}, {50, 50}},
+	}
+
+	table := []struct {
+		other Mysql56GTIDSet
+		want  Mysql56GTIDSet
+	}{
+		// Nothing removed.
+		{Mysql56GTIDSet{}, set},
+		// Everything removed.
+		{set, Mysql56GTIDSet{}},
+		// Cut the start, the middle and the end of intervals.
+		{
+			Mysql56GTIDSet{sid1: []interval{{1, 22}, {25, 26}, {39, 45}}},
+			Mysql56GTIDSet{
+				sid1: []interval{{23, 24}, {27, 30}, {35, 38}},
+				sid2: []interval{{1, 5}, {50, 50}},
+			},
+		},
+		// One interval covering several.
+		{
+			Mysql56GTIDSet{sid1: []interval{{1, 100}}, sid2: []interval{{3, 3}}},
+			Mysql56GTIDSet{sid2: []interval{{1, 2}, {4, 5}, {50, 50}}},
+		},
+	}
+
+	for _, tcase := range table {
+		got := set.Difference(tcase.other)
+		if !got.Equal(tcase.want) {
+			t.Errorf("Difference(%#v) = %#v, want %#v", tcase.other, got, tcase.want)
+		}
+	}
+}
+
 func TestMysql56GTIDSetEqual(t *testing.T) {
 	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
 	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
@@ -69,6 +69,27 @@ func (rp Position) IsZero() bool {
 	return rp.GTIDSet == nil
 }
 
+// TransactionCount returns the number of transactions in the position.
+// For MySQL 5.6, it is the number of GTIDs in the set. A MariaDB
+// position only has the last GTID, so it is its sequence number.
+// Comparing the counts of two positions of the same server tells how
+// many transactions happened in between.
+func (rp Position) TransactionCount() uint64 {
+	switch gtidSet := rp.GTIDSet.(type) {
+	case Mysql56GTIDSet:
+		var count uint64
+		for _, intervals := range gtidSet {
+			for _, iv := range intervals {
+				count += uint64(iv.end - iv.start + 1)
+			}
+		}
+		return count
+	case MariadbGTID:
+		return gtidSet.Sequence
+	}
+	return 0
+}
+
 // AppendGTID returns a new Position that represents the position
 // after the given GTID is replicated.
 func AppendGTID(rp Position, gtid GTID) Position {
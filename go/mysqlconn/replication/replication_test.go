@@ -16,6 +16,23 @@ func TestPositionEqual(t *testing.T) {
 	}
 }
 
+func TestPositionTransactionCount(t *testing.T) {
+	table := []struct {
+		input Position
+		want  uint64
+	}{
+		{Position{}, 0},
+		{MustParsePosition("MariaDB", "3-5555-1234"), 1234},
+		{MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-5:7"), 6},
+		{MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-5,00010203-0405-0607-0809-0a0b0c0d0eff:10-19"), 15},
+	}
+	for _, tcase := range table {
+		if got := tcase.input.TransactionCount(); got != tcase.want {
+			t.Errorf("%v.TransactionCount() = %v, want %v", tcase.input, got, tcase.want)
+		}
+	}
+}
+
 func TestPositionNotEqual(t *testing.T) {
 	input1 := Position{GTIDSet: MariadbGTID{Domain: 3, Server: 5555, Sequence: 1234}}
 	input2 := Position{GTIDSet: MariadbGTID{Domain: 3, Server: 5555, Sequence: 12345}}
@@ -15,6 +15,8 @@ import (
 	"syscall"
 
 	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
 	vtenv "github.com/youtube/vitess/go/vt/env"
 )
 
@@ -112,6 +114,17 @@ func (hook *Hook) findHook() (*exec.Cmd, int, error) {
 
 // Execute tries to execute the Hook and returns a HookResult.
 func (hook *Hook) Execute() (result *HookResult) {
+	var stdout bytes.Buffer
+	result = hook.ExecuteToWriter(context.Background(), &stdout)
+	result.Stdout = stdout.String()
+	return result
+}
+
+// ExecuteToWriter tries to execute the Hook, writing its stdout to
+// out as it is produced instead of buffering it, and returns a
+// HookResult with an empty Stdout. If ctx is canceled before the hook
+// exits, the hook and the processes it started are killed.
+func (hook *Hook) ExecuteToWriter(ctx context.Context, out io.Writer) (result *HookResult) {
 	result = &HookResult{}
 
 	// Find the hook.
@@ -122,16 +135,36 @@ func (hook *Hook) Execute() (result *HookResult) {
 		return result
 	}
 
-	// Run it.
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
+	// Run it, in its own process group so it can be killed with
+	// its children.
+	var stderr bytes.Buffer
+	cmd.Stdout = out
 	cmd.Stderr = &stderr
-	err = cmd.Run()
-	result.Stdout = stdout.String()
-	result.Stderr = stderr.String()
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	err = cmd.Start()
 	if err == nil {
+		done := make(chan struct{})
+		go func() {
+			select {
+			case <-ctx.Done():
+				log.Warningf("hook: killing %v: %v", hook.Name, ctx.Err())
+				syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+			case <-done:
+			}
+		}()
+		err = cmd.Wait()
+		close(done)
+	}
+	result.Stderr = stderr.String()
+	switch {
+	case err == nil:
 		result.ExitStatus = HOOK_SUCCESS
-	} else {
+	case ctx.Err() != nil:
+		// The exit status of a killed process is -1, which
+		// would read as HOOK_DOES_NOT_EXIST.
+		result.ExitStatus = HOOK_GENERIC_ERROR
+		result.Stderr += "ERROR: " + err.Error() + ": " + ctx.Err().Error() + "\n"
+	default:
 		if cmd.ProcessState != nil && cmd.ProcessState.Sys() != nil {
 			result.ExitStatus = cmd.ProcessState.Sys().(syscall.WaitStatus).ExitStatus()
 		} else {
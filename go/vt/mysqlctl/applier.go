@@ -0,0 +1,80 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+const (
+	applierSlaveStatusQuery = "SHOW SLAVE STATUS"
+	applierCoordinatorQuery = "SELECT t.PROCESSLIST_STATE FROM performance_schema.replication_applier_status_by_coordinator c JOIN performance_schema.threads t ON t.THREAD_ID = c.THREAD_ID"
+	applierWorkersQuery     = "SELECT w.WORKER_ID, w.SERVICE_STATE, t.PROCESSLIST_STATE, w.LAST_SEEN_TRANSACTION FROM performance_schema.replication_applier_status_by_worker w LEFT JOIN performance_schema.threads t ON t.THREAD_ID = w.THREAD_ID ORDER BY w.WORKER_ID"
+)
+
+// GetApplierQueueStats returns the state of the replication applier:
+// how much relay log is waiting to be applied, and what the
+// coordinator and each worker are doing. MySQL doesn't expose the
+// length of the worker queues, but a coordinator waiting for the
+// workers means their queues are full (an apply bottleneck), and
+// workers waiting for events mean they are empty. It returns
+// ErrNotSlave if the server is not a slave.
+func GetApplierQueueStats(ctx context.Context, mysqld MysqlDaemon) (*tabletmanagerdatapb.ApplierQueueStats, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, applierSlaveStatusQuery)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, ErrNotSlave
+	}
+	stats := &tabletmanagerdatapb.ApplierQueueStats{}
+	found := false
+	for i, field := range qr.Fields {
+		if field.Name != "Relay_Log_Space" {
+			continue
+		}
+		if stats.RelayLogSpace, err = qr.Rows[0][i].ParseInt64(); err != nil {
+			return nil, fmt.Errorf("GetApplierQueueStats: invalid Relay_Log_Space %v: %v", qr.Rows[0][i], err)
+		}
+		found = true
+	}
+	if !found {
+		return nil, fmt.Errorf("GetApplierQueueStats: no Relay_Log_Space in %v", applierSlaveStatusQuery)
+	}
+
+	// The coordinator only exists with parallel replication.
+	qr, err = mysqld.FetchSuperQuery(ctx, applierCoordinatorQuery)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) > 0 && len(qr.Rows[0]) > 0 {
+		stats.CoordinatorState = qr.Rows[0][0].String()
+	}
+
+	qr, err = mysqld.FetchSuperQuery(ctx, applierWorkersQuery)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range qr.Rows {
+		if len(row) != 4 {
+			return nil, fmt.Errorf("GetApplierQueueStats: unexpected row %v", row)
+		}
+		id, err := row[0].ParseInt64()
+		if err != nil {
+			return nil, fmt.Errorf("GetApplierQueueStats: invalid WORKER_ID %v: %v", row[0], err)
+		}
+		stats.Workers = append(stats.Workers, &tabletmanagerdatapb.ApplierWorkerStats{
+			WorkerId:            id,
+			ServiceState:        row[1].String(),
+			ThreadState:         row[2].String(),
+			LastSeenTransaction: row[3].String(),
+		})
+	}
+	return stats, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestGetApplierQueueStats(t *testing.T) {
+	fmd := NewFakeMysqlDaemon(nil)
+	fmd.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		applierSlaveStatusQuery: {
+			Fields: []*querypb.Field{
+				{Name: "Master_Host"},
+				{Name: "Relay_Log_Space"},
+			},
+			Rows: [][]sqltypes.Value{
+				processListRow("master1", "104857600"),
+			},
+		},
+		applierCoordinatorQuery: {
+			Rows: [][]sqltypes.Value{
+				processListRow("Waiting for Slave Workers to free pending events"),
+			},
+		},
+		applierWorkersQuery: {
+			Rows: [][]sqltypes.Value{
+				processListRow("1", "ON", "Executing event", "00000000-0000-0000-0000-000000000001:42"),
+				processListRow("2", "ON", "Waiting for an event from Coordinator", "NULL"),
+			},
+		},
+	}
+
+	stats, err := GetApplierQueueStats(context.Background(), fmd)
+	if err != nil {
+		t.Fatalf("GetApplierQueueStats failed: %v", err)
+	}
+	want := &tabletmanagerdatapb.ApplierQueueStats{
+		RelayLogSpace:    104857600,
+		CoordinatorState: "Waiting for Slave Workers to free pending events",
+		Workers: []*tabletmanagerdatapb.ApplierWorkerStats{
+			{
+				WorkerId:            1,
+				ServiceState:        "ON",
+				ThreadState:         "Executing event",
+				LastSeenTransaction: "00000000-0000-0000-0000-000000000001:42",
+			},
+			{
+				WorkerId:     2,
+				ServiceState: "ON",
+				ThreadState:  "Waiting for an event from Coordinator",
+			},
+		},
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("GetApplierQueueStats() = %v, want %v", stats, want)
+	}
+
+	// Not a slave.
+	fmd.FetchSuperQueryMap[applierSlaveStatusQuery] = &sqltypes.Result{}
+	if _, err := GetApplierQueueStats(context.Background(), fmd); err != ErrNotSlave {
+		t.Errorf("GetApplierQueueStats() on a master = %v, want %v", err, ErrNotSlave)
+	}
+}
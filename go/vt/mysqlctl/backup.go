@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	log "github.com/golang/glog"
 	"golang.org/x/net/context"
@@ -37,11 +38,16 @@ const (
 	backupInnodbDataHomeDir     = "InnoDBData"
 	backupInnodbLogGroupHomeDir = "InnoDBLog"
 	backupData                  = "Data"
+	backupBinlogDir             = "BinLog"
 
 	// the manifest file name
 	backupManifest = "MANIFEST"
 )
 
+// BackupTimestampFormat is the layout of the UTC time at the start
+// of backup names.
+const BackupTimestampFormat = "2006-01-02.150405"
+
 const (
 	// slaveStartDeadline is the deadline for starting a slave
 	slaveStartDeadline = 30
@@ -54,6 +60,11 @@ var (
 	// ErrExistingDB is returned when there's already an active DB.
 	ErrExistingDB = errors.New("skipping restore due to existing database")
 
+	// ErrRestoreAborted is returned when the restore context was
+	// canceled while the files were being copied. The partially
+	// restored files have been removed.
+	ErrRestoreAborted = errors.New("restore aborted")
+
 	// backupStorageHook contains the hook name to use to process
 	// backup files. If not set, we will not process the files. It is
 	// only used at backup time. Then it is put in the manifest,
@@ -73,6 +84,8 @@ type FileEntry struct {
 	// - backupInnodbDataHomeDir for files that go into Mycnf.InnodbDataHomeDir
 	// - backupInnodbLogGroupHomeDir for files that go into Mycnf.InnodbLogGroupHomeDir
 	// - backupData for files that go into Mycnf.DataDir
+	// - backupBinlogDir for files that go into the directory of
+	//   Mycnf.BinLogPath (incremental backups only)
 	Base string
 
 	// Name is the file name, relative to Base
@@ -81,6 +94,10 @@ type FileEntry struct {
 	// Hash is the hash of the final data (transformed and
 	// compressed if specified) stored in the BackupStorage.
 	Hash string
+
+	// Size is the size of the file on disk, before it was
+	// transformed and compressed. It is 0 for older backups.
+	Size int64
 }
 
 func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
@@ -93,6 +110,8 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 		root = cnf.InnodbLogGroupHomeDir
 	case backupData:
 		root = cnf.DataDir
+	case backupBinlogDir:
+		root = path.Dir(cnf.BinLogPath)
 	default:
 		return nil, fmt.Errorf("unknown base: %v", fe.Base)
 	}
@@ -134,6 +153,28 @@ type BackupManifest struct {
 	// backups that don't have this flag are assumed to be
 	// compressed.
 	SkipCompress bool
+
+	// MySQLVersion, CharacterSet and StorageEngine are the
+	// settings of the mysqld the backup was taken from. They are
+	// empty for older backups, or if they could not be read.
+	MySQLVersion  string
+	CharacterSet  string
+	StorageEngine string
+
+	// BaseBackup is the name of the backup an incremental backup
+	// applies on top of. It is empty for full backups.
+	BaseBackup string
+}
+
+// DataSize returns the disk space needed to restore the backup: the
+// total size of its files. It is 0 for older backups, that don't
+// record the size of their files.
+func (bm *BackupManifest) DataSize() int64 {
+	var size int64
+	for _, fe := range bm.FileEntries {
+		size += fe.Size
+	}
+	return size
 }
 
 // isDbDir returns true if the given directory contains a DB
@@ -209,20 +250,23 @@ func findFilesToBackup(cnf *Mycnf) ([]FileEntry, error) {
 // - uses the BackupStorage service to store a new backup
 // - shuts down Mysqld during the backup
 // - remember if we were replicating, restore the exact same state
-func Backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, dir, name string, backupConcurrency int, hookExtraEnv map[string]string) error {
+// It returns the number of bytes written to the BackupStorage, even
+// if the backup failed.
+func Backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, dir, name string, backupConcurrency int, hookExtraEnv map[string]string) (int64, error) {
 	// Start the backup with the BackupStorage.
 	bs, err := backupstorage.GetBackupStorage()
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer bs.Close()
 	bh, err := bs.StartBackup(ctx, dir, name)
 	if err != nil {
-		return fmt.Errorf("StartBackup failed: %v", err)
+		return 0, fmt.Errorf("StartBackup failed: %v", err)
 	}
+	cbh := &countingBackupHandle{BackupHandle: bh}
 
 	// Take the backup, and either AbortBackup or EndBackup.
-	usable, err := backup(ctx, mysqld, logger, bh, backupConcurrency, hookExtraEnv)
+	usable, err := backup(ctx, mysqld, logger, cbh, backupConcurrency, hookExtraEnv)
 	var finishErr error
 	if usable {
 		finishErr = bh.EndBackup(ctx)
@@ -237,11 +281,58 @@ func Backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, dir,
 			// finish error, return the backup error.
 			logger.Errorf("failed to finish backup: %v", finishErr)
 		}
-		return err
+		return cbh.bytes.Get(), err
 	}
 
 	// The backup worked, so just return the finish error, if any.
-	return finishErr
+	return cbh.bytes.Get(), finishErr
+}
+
+// countingBackupHandle counts the bytes written to the files of a
+// backup.
+type countingBackupHandle struct {
+	backupstorage.BackupHandle
+	bytes sync2.AtomicInt64
+}
+
+// AddFile is part of the backupstorage.BackupHandle interface.
+func (cbh *countingBackupHandle) AddFile(ctx context.Context, filename string) (io.WriteCloser, error) {
+	wc, err := cbh.BackupHandle.AddFile(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	return &countingWriteCloser{
+		WriteCloser: wc,
+		bytes:       &cbh.bytes,
+	}, nil
+}
+
+type countingWriteCloser struct {
+	io.WriteCloser
+	bytes *sync2.AtomicInt64
+}
+
+func (cwc *countingWriteCloser) Write(p []byte) (int, error) {
+	n, err := cwc.WriteCloser.Write(p)
+	cwc.bytes.Add(int64(n))
+	return n, err
+}
+
+// BackupPosition returns the replication position a backup taken now
+// would capture: the master position if mysqld is not a slave, the
+// position of the replicated transactions otherwise. Unlike backup,
+// it does not stop replication, so the position of a replicating
+// slave may have moved on by the time a backup is taken.
+func BackupPosition(mysqld MysqlDaemon) (replication.Position, error) {
+	slaveStatus, err := mysqld.SlaveStatus()
+	switch err {
+	case nil:
+		return slaveStatus.Position, nil
+	case ErrNotSlave:
+		return mysqld.MasterPosition()
+	default:
+		return replication.Position{}, fmt.Errorf("can't get slave status: %v", err)
+	}
 }
 
 // backup returns a boolean that indicates if the backup is usable,
@@ -298,6 +389,12 @@ func backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh b
 	}
 	logger.Infof("using replication position: %v", replicationPosition)
 
+	// get the settings to record in the MANIFEST, while mysqld is up
+	settings, err := readServerSettings(ctx, mysqld)
+	if err != nil {
+		logger.Warningf("can't get mysqld settings, the backup won't record them: %v", err)
+	}
+
 	// shutdown mysqld
 	err = mysqld.Shutdown(ctx, true)
 	if err != nil {
@@ -305,7 +402,7 @@ func backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh b
 	}
 
 	// Backup everything, capture the error.
-	backupErr := backupFiles(ctx, mysqld, logger, bh, replicationPosition, backupConcurrency, hookExtraEnv)
+	backupErr := backupFiles(ctx, mysqld, logger, bh, replicationPosition, settings, backupConcurrency, hookExtraEnv)
 	usable := backupErr == nil
 
 	// Try to restart mysqld
@@ -347,7 +444,7 @@ func backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh b
 }
 
 // backupFiles finds the list of files to backup, and creates the backup.
-func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, replicationPosition replication.Position, backupConcurrency int, hookExtraEnv map[string]string) (err error) {
+func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, replicationPosition replication.Position, settings serverSettings, backupConcurrency int, hookExtraEnv map[string]string) error {
 	// Get the files to backup.
 	fes, err := findFilesToBackup(mysqld.Cnf())
 	if err != nil {
@@ -355,7 +452,23 @@ func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger,
 	}
 	logger.Infof("found %v files to backup", len(fes))
 
-	// Backup with the provided concurrency.
+	if err := backupFileEntries(ctx, mysqld, logger, bh, fes, backupConcurrency, hookExtraEnv); err != nil {
+		return err
+	}
+	return writeManifest(ctx, bh, &BackupManifest{
+		FileEntries:   fes,
+		Position:      replicationPosition,
+		TransformHook: *backupStorageHook,
+		SkipCompress:  !*backupStorageCompress,
+		MySQLVersion:  settings.version,
+		CharacterSet:  settings.characterSet,
+		StorageEngine: settings.storageEngine,
+	})
+}
+
+// backupFileEntries copies the files to the backup, with the
+// provided concurrency.
+func backupFileEntries(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, fes []FileEntry, backupConcurrency int, hookExtraEnv map[string]string) error {
 	sema := sync2.NewSemaphore(backupConcurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
 	wg := sync.WaitGroup{}
@@ -379,10 +492,12 @@ func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger,
 	}
 
 	wg.Wait()
-	if rec.HasErrors() {
-		return rec.Error()
-	}
+	return rec.Error()
+}
 
+// writeManifest JSON-encodes the MANIFEST of a backup, and adds it
+// to the backup. It is the last file of a complete backup.
+func writeManifest(ctx context.Context, bh backupstorage.BackupHandle, bm *BackupManifest) (err error) {
 	// open the MANIFEST
 	wc, err := bh.AddFile(ctx, backupManifest)
 	if err != nil {
@@ -395,12 +510,6 @@ func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger,
 	}()
 
 	// JSON-encode and write the MANIFEST
-	bm := &BackupManifest{
-		FileEntries:   fes,
-		Position:      replicationPosition,
-		TransformHook: *backupStorageHook,
-		SkipCompress:  !*backupStorageCompress,
-	}
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
 		return fmt.Errorf("cannot JSON encode %v: %v", backupManifest, err)
@@ -421,6 +530,11 @@ func backupFile(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger,
 		return err
 	}
 	defer source.Close()
+	fi, err := source.Stat()
+	if err != nil {
+		return fmt.Errorf("cannot stat source file %v: %v", fe.Name, err)
+	}
+	fe.Size = fi.Size()
 
 	// Open the destination file for writing, and a buffer.
 	wc, err := bh.AddFile(ctx, name)
@@ -559,6 +673,10 @@ func restoreFiles(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle
 			if rec.HasErrors() {
 				return
 			}
+			if err := ctx.Err(); err != nil {
+				rec.RecordError(err)
+				return
+			}
 
 			// And restore the file.
 			name := fmt.Sprintf("%v", i)
@@ -713,10 +831,13 @@ func removeExistingFiles(cnf *Mycnf) error {
 // Restore is the main entry point for backup restore.  If there is no
 // appropriate backup on the BackupStorage, Restore logs an error
 // and returns ErrNoBackup. Any other error is returned.
+// If preferredBackup is set, that backup is restored instead of the
+// most recent one, and it is an error if it cannot be read.
 func Restore(
 	ctx context.Context,
 	mysqld MysqlDaemon,
 	dir string,
+	preferredBackup string,
 	restoreConcurrency int,
 	hookExtraEnv map[string]string,
 	localMetadata map[string]string,
@@ -737,7 +858,7 @@ func Restore(
 		return replication.Position{}, fmt.Errorf("ListBackups failed: %v", err)
 	}
 
-	if len(bhs) == 0 {
+	if len(bhs) == 0 && preferredBackup == "" {
 		// There are no backups (not even broken/incomplete ones).
 		logger.Errorf("No backup to restore on BackupStorage for directory %v. Starting up empty.", dir)
 		if err = populateMetadataTables(mysqld, localMetadata); err == nil {
@@ -751,6 +872,10 @@ func Restore(
 	var toRestore int
 	for toRestore = len(bhs) - 1; toRestore >= 0; toRestore-- {
 		bh = bhs[toRestore]
+		if preferredBackup != "" && bh.Name() != preferredBackup {
+			continue
+		}
+		bm = BackupManifest{}
 		rc, err := bh.ReadFile(ctx, backupManifest)
 		if err != nil {
 			log.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage: can't read MANIFEST: %v)", bh.Name(), dir, err)
@@ -763,10 +888,21 @@ func Restore(
 			log.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage (cannot JSON decode MANIFEST: %v)", bh.Name(), dir, err)
 			continue
 		}
+		if bm.BaseBackup != "" {
+			// Incremental backups only contain binlogs, and
+			// cannot be restored on their own.
+			logger.Infof("Restore: skipping incremental backup %v", bh.Name())
+			continue
+		}
 
 		logger.Infof("Restore: found backup %v %v to restore with %v files", bh.Directory(), bh.Name(), len(bm.FileEntries))
 		break
 	}
+	if toRestore < 0 && preferredBackup != "" {
+		// Do not fall back to another backup, the preferred one
+		// was most likely chosen because the others are not good.
+		return replication.Position{}, fmt.Errorf("preferred backup %v not found or not readable in directory %v on BackupStorage, restart to retry restore", preferredBackup, dir)
+	}
 	if toRestore < 0 {
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
@@ -789,11 +925,167 @@ func Restore(
 		}
 	}
 
+	return restoreFromHandle(ctx, mysqld, bh, &bm, restoreConcurrency, hookExtraEnv, localMetadata, logger)
+}
+
+// RestoreBackup restores the backup with the given name, ignoring any
+// data mysqld may already contain. It returns the replication position
+// of the restored backup.
+func RestoreBackup(
+	ctx context.Context,
+	mysqld MysqlDaemon,
+	dir, name string,
+	restoreConcurrency int,
+	hookExtraEnv map[string]string,
+	localMetadata map[string]string,
+	logger logutil.Logger) (replication.Position, error) {
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return replication.Position{}, err
+	}
+	defer bs.Close()
+
+	bh, bm, err := findBackup(ctx, bs, dir, name)
+	if err != nil {
+		return replication.Position{}, err
+	}
+	if bm.BaseBackup != "" {
+		return replication.Position{}, fmt.Errorf("backup %v is incremental, and cannot be restored on its own", name)
+	}
+	logger.Infof("Restore: found backup %v %v to restore with %v files", bh.Directory(), bh.Name(), len(bm.FileEntries))
+
+	return restoreFromHandle(ctx, mysqld, bh, bm, restoreConcurrency, hookExtraEnv, localMetadata, logger)
+}
+
+// CheckBackup returns an error if the backup with the given name does
+// not exist, or if its MANIFEST cannot be read, in which case it is
+// incomplete and cannot be restored.
+func CheckBackup(ctx context.Context, dir, name string) error {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+
+	_, _, err = findBackup(ctx, bs, dir, name)
+	return err
+}
+
+// ManifestPosition returns the replication position recorded in the
+// MANIFEST of the backup with the given name.
+func ManifestPosition(ctx context.Context, dir, name string) (replication.Position, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return replication.Position{}, err
+	}
+	defer bs.Close()
+
+	_, bm, err := findBackup(ctx, bs, dir, name)
+	if err != nil {
+		return replication.Position{}, err
+	}
+	return bm.Position, nil
+}
+
+// LastBackup returns the name of the most recent complete full backup
+// in dir, the one Restore would pick without a preferred backup, or ""
+// if there is none.
+func LastBackup(ctx context.Context, dir string) (string, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return "", err
+	}
+	defer bs.Close()
+
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return "", fmt.Errorf("ListBackups failed: %v", err)
+	}
+	for i := len(bhs) - 1; i >= 0; i-- {
+		bm, err := readManifest(ctx, bhs[i])
+		if err != nil {
+			log.Warningf("Skipping possibly incomplete backup %v in directory %v on BackupStorage: %v", bhs[i].Name(), dir, err)
+			continue
+		}
+		if bm.BaseBackup != "" {
+			continue
+		}
+		return bhs[i].Name(), nil
+	}
+	return "", nil
+}
+
+// findBackup returns the handle and the MANIFEST of the backup with
+// the given name.
+func findBackup(ctx context.Context, bs backupstorage.BackupStorage, dir, name string) (backupstorage.BackupHandle, *BackupManifest, error) {
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ListBackups failed: %v", err)
+	}
+	var bh backupstorage.BackupHandle
+	for _, b := range bhs {
+		if b.Name() == name {
+			bh = b
+			break
+		}
+	}
+	if bh == nil {
+		return nil, nil, fmt.Errorf("no backup %v in directory %v on BackupStorage", name, dir)
+	}
+
+	bm, err := readManifest(ctx, bh)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bh, bm, nil
+}
+
+// readManifest reads and decodes the MANIFEST of a backup.
+func readManifest(ctx context.Context, bh backupstorage.BackupHandle) (*BackupManifest, error) {
+	rc, err := bh.ReadFile(ctx, backupManifest)
+	if err != nil {
+		return nil, fmt.Errorf("can't read MANIFEST of backup %v, it may be incomplete: %v", bh.Name(), err)
+	}
+	bm := &BackupManifest{}
+	err = json.NewDecoder(rc).Decode(bm)
+	rc.Close()
+	if err != nil {
+		return nil, fmt.Errorf("cannot JSON decode MANIFEST of backup %v: %v", bh.Name(), err)
+	}
+	return bm, nil
+}
+
+// BackupTime returns the time a backup was taken at, from its name.
+func BackupTime(name string) (time.Time, error) {
+	if len(name) < len(BackupTimestampFormat) {
+		return time.Time{}, fmt.Errorf("invalid backup name %v", name)
+	}
+	t, err := time.Parse(BackupTimestampFormat, name[:len(BackupTimestampFormat)])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid backup name %v: %v", name, err)
+	}
+	return t, nil
+}
+
+// restoreFromHandle replaces the data of mysqld with the files of a
+// backup, and restarts mysqld.
+func restoreFromHandle(
+	ctx context.Context,
+	mysqld MysqlDaemon,
+	bh backupstorage.BackupHandle,
+	bm *BackupManifest,
+	restoreConcurrency int,
+	hookExtraEnv map[string]string,
+	localMetadata map[string]string,
+	logger logutil.Logger) (replication.Position, error) {
+
 	// Starting from here we won't be able to recover if we get stopped by a cancelled
-	// context. Thus we use the background context to get through to the finish.
+	// context (except while copying files, see below). Thus we use the
+	// background context to get through to the finish.
 
 	logger.Infof("Restore: shutdown mysqld")
-	err = mysqld.Shutdown(context.Background(), true)
+	err := mysqld.Shutdown(context.Background(), true)
 	if err != nil {
 		return replication.Position{}, err
 	}
@@ -809,9 +1101,19 @@ func Restore(
 		return replication.Position{}, err
 	}
 
+	// Copying the files can be aborted by canceling the context.
+	// In that case we remove what was copied, so the next restore
+	// starts from a clean data directory.
 	logger.Infof("Restore: copying all files")
-	if err := restoreFiles(context.Background(), mysqld.Cnf(), bh, bm.FileEntries, bm.TransformHook, !bm.SkipCompress, restoreConcurrency, hookExtraEnv); err != nil {
-		return replication.Position{}, err
+	if err := restoreFiles(ctx, mysqld.Cnf(), bh, bm.FileEntries, bm.TransformHook, !bm.SkipCompress, restoreConcurrency, hookExtraEnv); err != nil {
+		if ctx.Err() == nil {
+			return replication.Position{}, err
+		}
+		logger.Warningf("Restore: aborted while copying files (%v), deleting partially restored files", err)
+		if err := removeExistingFiles(mysqld.Cnf()); err != nil {
+			return replication.Position{}, fmt.Errorf("restore aborted, but can't remove partially restored files: %v", err)
+		}
+		return replication.Position{}, ErrRestoreAborted
 	}
 
 	// mysqld needs to be running in order for mysql_upgrade to work.
@@ -0,0 +1,125 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+// BackupType is the kind of a backup.
+type BackupType string
+
+const (
+	// BackupTypeFull is a backup that can be restored on its own.
+	BackupTypeFull BackupType = "full"
+
+	// BackupTypeIncremental is a backup that is restored on top of
+	// its base backup.
+	BackupTypeIncremental BackupType = "incremental"
+)
+
+// BackupInfo describes a backup, as returned by ListBackups.
+type BackupInfo struct {
+	// Name is the name of the backup.
+	Name string
+
+	// Type says if the backup is full or incremental.
+	Type BackupType
+
+	// BaseBackup is the name of the backup an incremental backup
+	// applies on top of. It is empty for full backups.
+	BaseBackup string
+
+	// Position is the replication position the backup was taken at.
+	Position replication.Position
+}
+
+// ListBackups returns the backups in a directory, oldest first.
+// Backups whose MANIFEST cannot be read are incomplete, and are
+// skipped.
+func ListBackups(ctx context.Context, dir string) ([]BackupInfo, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, fmt.Errorf("ListBackups failed: %v", err)
+	}
+	result := make([]BackupInfo, 0, len(bhs))
+	for _, bh := range bhs {
+		bm, err := readManifest(ctx, bh)
+		if err != nil {
+			log.Warningf("skipping backup: %v", err)
+			continue
+		}
+		result = append(result, newBackupInfo(bh.Name(), bm))
+	}
+	return result, nil
+}
+
+// newBackupInfo returns the BackupInfo of a backup from its MANIFEST.
+func newBackupInfo(name string, bm *BackupManifest) BackupInfo {
+	bi := BackupInfo{
+		Name:       name,
+		Type:       BackupTypeFull,
+		BaseBackup: bm.BaseBackup,
+		Position:   bm.Position,
+	}
+	if bm.BaseBackup != "" {
+		bi.Type = BackupTypeIncremental
+	}
+	return bi
+}
+
+// ResolveRestoreChain returns the backups to restore, in order, to
+// get to the target backup: a full backup first, followed by each
+// incremental backup up to and including the target.
+func ResolveRestoreChain(backups []BackupInfo, target string) ([]BackupInfo, error) {
+	byName := make(map[string]BackupInfo, len(backups))
+	for _, bi := range backups {
+		byName[bi.Name] = bi
+	}
+
+	// Walk back from the target to its full backup.
+	var chain []BackupInfo
+	seen := make(map[string]bool)
+	name := target
+	for {
+		bi, ok := byName[name]
+		if !ok {
+			if name == target {
+				return nil, fmt.Errorf("no backup %v", target)
+			}
+			return nil, fmt.Errorf("base backup %v of %v is missing", name, chain[len(chain)-1].Name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("backup %v is its own base", name)
+		}
+		seen[name] = true
+		chain = append(chain, bi)
+		if bi.Type == BackupTypeFull {
+			break
+		}
+		if bi.BaseBackup == "" {
+			return nil, fmt.Errorf("incremental backup %v has no base backup", name)
+		}
+		name = bi.BaseBackup
+	}
+
+	// And reverse it, so the full backup comes first.
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain, nil
+}
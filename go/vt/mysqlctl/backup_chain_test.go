@@ -0,0 +1,68 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewBackupInfo(t *testing.T) {
+	bi := newBackupInfo("full", &BackupManifest{})
+	if bi.Type != BackupTypeFull || bi.BaseBackup != "" {
+		t.Errorf("newBackupInfo(full) = %v, want a full backup", bi)
+	}
+	bi = newBackupInfo("incr", &BackupManifest{BaseBackup: "full"})
+	if bi.Type != BackupTypeIncremental || bi.BaseBackup != "full" {
+		t.Errorf("newBackupInfo(incr) = %v, want an incremental backup of full", bi)
+	}
+}
+
+func TestResolveRestoreChain(t *testing.T) {
+	full := BackupInfo{
+		Name: "2017-06-01.000000.cell1-0000000101",
+		Type: BackupTypeFull,
+	}
+	incr1 := BackupInfo{
+		Name:       "2017-06-02.000000.cell1-0000000101",
+		Type:       BackupTypeIncremental,
+		BaseBackup: full.Name,
+	}
+	incr2 := BackupInfo{
+		Name:       "2017-06-03.000000.cell1-0000000101",
+		Type:       BackupTypeIncremental,
+		BaseBackup: incr1.Name,
+	}
+	other := BackupInfo{
+		Name: "2017-05-01.000000.cell1-0000000102",
+		Type: BackupTypeFull,
+	}
+	backups := []BackupInfo{other, full, incr1, incr2}
+
+	chain, err := ResolveRestoreChain(backups, incr2.Name)
+	if err != nil {
+		t.Fatalf("ResolveRestoreChain(incr2) failed: %v", err)
+	}
+	if want := []BackupInfo{full, incr1, incr2}; !reflect.DeepEqual(chain, want) {
+		t.Errorf("ResolveRestoreChain(incr2) = %v, want %v", chain, want)
+	}
+
+	// A full backup is restored on its own.
+	chain, err = ResolveRestoreChain(backups, full.Name)
+	if err != nil {
+		t.Fatalf("ResolveRestoreChain(full) failed: %v", err)
+	}
+	if want := []BackupInfo{full}; !reflect.DeepEqual(chain, want) {
+		t.Errorf("ResolveRestoreChain(full) = %v, want %v", chain, want)
+	}
+
+	// A missing link breaks the chain.
+	if _, err := ResolveRestoreChain([]BackupInfo{full, incr2}, incr2.Name); err == nil {
+		t.Errorf("ResolveRestoreChain without incr1 worked")
+	}
+	if _, err := ResolveRestoreChain(backups, "unknown"); err == nil {
+		t.Errorf("ResolveRestoreChain(unknown) worked")
+	}
+}
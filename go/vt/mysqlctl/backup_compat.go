@@ -0,0 +1,116 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// serverSettingsQuery reads the settings a backup records in its
+// MANIFEST.
+const serverSettingsQuery = "SELECT @@global.version, @@global.character_set_server, @@global.default_storage_engine"
+
+// serverSettings are the mysqld settings a restore depends on.
+type serverSettings struct {
+	version       string
+	characterSet  string
+	storageEngine string
+}
+
+// readServerSettings returns the settings of a running mysqld.
+func readServerSettings(ctx context.Context, mysqld MysqlDaemon) (serverSettings, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, serverSettingsQuery)
+	if err != nil {
+		return serverSettings{}, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 3 {
+		return serverSettings{}, fmt.Errorf("unexpected result for the mysqld settings: %v", qr.Rows)
+	}
+	return serverSettings{
+		version:       qr.Rows[0][0].String(),
+		characterSet:  qr.Rows[0][1].String(),
+		storageEngine: qr.Rows[0][2].String(),
+	}, nil
+}
+
+// CheckRestoreCompatibility compares the mysqld settings recorded in
+// the MANIFEST of the named backup with the ones of mysqld, which must
+// be running. It does not touch any data.
+func CheckRestoreCompatibility(ctx context.Context, mysqld MysqlDaemon, dir, name string) (*tabletmanagerdatapb.CompatReport, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+
+	_, bm, err := findBackup(ctx, bs, dir, name)
+	if err != nil {
+		return nil, err
+	}
+	return checkRestoreCompatibility(ctx, mysqld, bm)
+}
+
+// checkRestoreCompatibility compares the settings of a MANIFEST with
+// the ones of mysqld. A setting the backup did not record is reported
+// as compatible, since it cannot be checked.
+func checkRestoreCompatibility(ctx context.Context, mysqld MysqlDaemon, bm *BackupManifest) (*tabletmanagerdatapb.CompatReport, error) {
+	settings, err := readServerSettings(ctx, mysqld)
+	if err != nil {
+		return nil, fmt.Errorf("can't get mysqld settings: %v", err)
+	}
+
+	report := &tabletmanagerdatapb.CompatReport{
+		Compatible: true,
+	}
+	add := func(name, backupValue, tabletValue string, same func(a, b string) bool) {
+		check := &tabletmanagerdatapb.CompatCheck{
+			Name:        name,
+			BackupValue: backupValue,
+			TabletValue: tabletValue,
+			Compatible:  true,
+		}
+		switch {
+		case backupValue == "":
+			check.Reason = "not recorded in the backup"
+		case !same(backupValue, tabletValue):
+			check.Compatible = false
+			check.Reason = fmt.Sprintf("backup has %v, tablet has %v", backupValue, tabletValue)
+			report.Compatible = false
+		}
+		report.Checks = append(report.Checks, check)
+	}
+	add("mysql_version", bm.MySQLVersion, settings.version, func(a, b string) bool {
+		return majorVersion(a) == majorVersion(b)
+	})
+	add("character_set", bm.CharacterSet, settings.characterSet, strings.EqualFold)
+	add("storage_engine", bm.StorageEngine, settings.storageEngine, strings.EqualFold)
+	return report, nil
+}
+
+// majorVersion returns the flavor and major version of a mysqld
+// version string, e.g. "5.7" for "5.7.17-log", or "MariaDB 10.1" for
+// "10.1.21-MariaDB". Data files are only compatible within a major
+// version.
+func majorVersion(version string) string {
+	flavor := ""
+	if strings.Contains(version, "MariaDB") {
+		flavor = "MariaDB "
+	}
+	if i := strings.IndexByte(version, '-'); i != -1 {
+		version = version[:i]
+	}
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+	return flavor + strings.Join(parts, ".")
+}
@@ -0,0 +1,91 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+)
+
+func TestCheckRestoreCompatibility(t *testing.T) {
+	ctx := context.Background()
+	mysqld := NewFakeMysqlDaemon(nil)
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		serverSettingsQuery: {
+			Rows: [][]sqltypes.Value{
+				{
+					sqltypes.MakeString([]byte("5.7.17-log")),
+					sqltypes.MakeString([]byte("utf8")),
+					sqltypes.MakeString([]byte("InnoDB")),
+				},
+			},
+		},
+	}
+
+	// A backup of another 5.7 release is compatible.
+	report, err := checkRestoreCompatibility(ctx, mysqld, &BackupManifest{
+		MySQLVersion:  "5.7.12-log",
+		CharacterSet:  "utf8",
+		StorageEngine: "innodb",
+	})
+	if err != nil {
+		t.Fatalf("checkRestoreCompatibility failed: %v", err)
+	}
+	if !report.Compatible || len(report.Checks) != 3 {
+		t.Fatalf("checkRestoreCompatibility(5.7.12) = %v, want 3 compatible checks", report)
+	}
+	for _, check := range report.Checks {
+		if !check.Compatible || check.Reason != "" {
+			t.Errorf("check %v is not compatible: %v", check.Name, check)
+		}
+	}
+
+	// A backup of 5.6 is not, and only the version check says so.
+	report, err = checkRestoreCompatibility(ctx, mysqld, &BackupManifest{
+		MySQLVersion:  "5.6.35-log",
+		CharacterSet:  "utf8",
+		StorageEngine: "InnoDB",
+	})
+	if err != nil {
+		t.Fatalf("checkRestoreCompatibility failed: %v", err)
+	}
+	if report.Compatible {
+		t.Errorf("checkRestoreCompatibility(5.6.35) = %v, want incompatible", report)
+	}
+	for _, check := range report.Checks {
+		want := check.Name != "mysql_version"
+		if check.Compatible != want {
+			t.Errorf("check %v: compatible = %v, want %v", check.Name, check.Compatible, want)
+		}
+	}
+	if got, want := report.Checks[0].Reason, "backup has 5.6.35-log, tablet has 5.7.17-log"; got != want {
+		t.Errorf("version check reason = %q, want %q", got, want)
+	}
+
+	// An older backup without recorded settings cannot be checked.
+	report, err = checkRestoreCompatibility(ctx, mysqld, &BackupManifest{})
+	if err != nil {
+		t.Fatalf("checkRestoreCompatibility failed: %v", err)
+	}
+	if !report.Compatible {
+		t.Errorf("checkRestoreCompatibility(no settings) = %v, want compatible", report)
+	}
+}
+
+func TestMajorVersion(t *testing.T) {
+	for version, want := range map[string]string{
+		"5.6.35-log":               "5.6",
+		"5.7.17":                   "5.7",
+		"10.1.21-MariaDB":          "MariaDB 10.1",
+		"10.1.21-MariaDB-1~jessie": "MariaDB 10.1",
+	} {
+		if got := majorVersion(version); got != want {
+			t.Errorf("majorVersion(%v) = %v, want %v", version, got, want)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn"
+	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/sqldb"
+	"github.com/youtube/vitess/go/vt/dbconfigs"
+	vtenv "github.com/youtube/vitess/go/vt/env"
+)
+
+// binlogStopTimeFormat is the layout of the --stop-datetime argument
+// of mysqlbinlog, interpreted in the TZ of the mysqlbinlog process.
+const binlogStopTimeFormat = "2006-01-02 15:04:05"
+
+// ApplyBinlogs reads the binary logs of the given master with
+// mysqlbinlog, and applies all the transactions that are not in
+// startPos and were committed before stopTime.
+// It only works with MySQL 5.6+ GTIDs.
+func (mysqld *Mysqld) ApplyBinlogs(ctx context.Context, masterHost string, masterPort int, startPos replication.Position, stopTime time.Time) error {
+	gtidSet, ok := startPos.GTIDSet.(replication.Mysql56GTIDSet)
+	if !ok {
+		return fmt.Errorf("ApplyBinlogs needs a MySQL 5.6+ GTID position, got %v", startPos)
+	}
+
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	mysqlbinlogPath, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	mysqlPath, err := binaryPath(dir, "mysql")
+	if err != nil {
+		return err
+	}
+
+	replParams, err := mysqld.replParams()
+	if err != nil {
+		return err
+	}
+	replParams.Host = masterHost
+	replParams.Port = masterPort
+	replParams.UnixSocket = ""
+	firstLog, err := firstBinlog(ctx, &replParams)
+	if err != nil {
+		return fmt.Errorf("cannot list binary logs of %v:%v: %v", masterHost, masterPort, err)
+	}
+	replCnf, err := mysqld.defaultsExtraFile(&replParams)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(replCnf)
+
+	dbaParams, err := dbconfigs.WithCredentials(&mysqld.dbcfgs.Dba)
+	if err != nil {
+		return err
+	}
+	dbaCnf, err := mysqld.defaultsExtraFile(&dbaParams)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(dbaCnf)
+
+	env := []string{os.ExpandEnv("LD_LIBRARY_PATH=$VT_MYSQL_ROOT/lib/mysql")}
+	reader := exec.Command(mysqlbinlogPath,
+		// --defaults-extra-file=* must be the first arg.
+		"--defaults-extra-file="+replCnf,
+		"--read-from-remote-server",
+		"--host="+masterHost,
+		fmt.Sprintf("--port=%v", masterPort),
+		"--exclude-gtids="+gtidSet.String(),
+		"--stop-datetime="+stopTime.UTC().Format(binlogStopTimeFormat),
+		"--to-last-log",
+		firstLog)
+	reader.Env = append(env, "TZ=UTC")
+	writer := exec.Command(mysqlPath, "--defaults-extra-file="+dbaCnf)
+	writer.Env = env
+
+	writer.Stdin, err = reader.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	readerErr := &bytes.Buffer{}
+	reader.Stderr = readerErr
+	writerOut := &bytes.Buffer{}
+	writer.Stdout = writerOut
+	writer.Stderr = writerOut
+
+	log.Infof("applying binary logs of %v:%v from %v until %v", masterHost, masterPort, startPos, stopTime)
+	if err := writer.Start(); err != nil {
+		return err
+	}
+	if err := reader.Start(); err != nil {
+		writer.Process.Kill()
+		writer.Wait()
+		return err
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		// Stop both programs if the context is canceled.
+		select {
+		case <-ctx.Done():
+			reader.Process.Kill()
+			writer.Process.Kill()
+		case <-done:
+		}
+	}()
+	if err := reader.Wait(); err != nil {
+		writer.Wait()
+		return fmt.Errorf("mysqlbinlog failed: %v, output: %s", err, readerErr.Bytes())
+	}
+	if err := writer.Wait(); err != nil {
+		return fmt.Errorf("mysql failed: %v, output: %s", err, writerOut.Bytes())
+	}
+	return nil
+}
+
+// firstBinlog returns the name of the oldest binary log of a server.
+func firstBinlog(ctx context.Context, params *sqldb.ConnParams) (string, error) {
+	conn, err := mysqlconn.Connect(ctx, params)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	qr, err := conn.ExecuteFetch("SHOW BINARY LOGS", 1000, false)
+	if err != nil {
+		return "", err
+	}
+	if len(qr.Rows) == 0 || len(qr.Rows[0]) == 0 {
+		return "", fmt.Errorf("no binary log")
+	}
+	return qr.Rows[0][0].String(), nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+const (
+	serverCharsetQuery    = "SELECT @@GLOBAL.character_set_server, @@GLOBAL.collation_server"
+	databaseCharsetsQuery = "SELECT SCHEMA_NAME, DEFAULT_CHARACTER_SET_NAME, DEFAULT_COLLATION_NAME FROM information_schema.SCHEMATA WHERE SCHEMA_NAME NOT IN ('information_schema', 'mysql', 'performance_schema', 'sys')"
+)
+
+// GetCharsetConfig returns the default character set and collation of
+// the server, and of each database that is not a system database.
+func GetCharsetConfig(ctx context.Context, mysqld MysqlDaemon) (*tabletmanagerdatapb.CharsetConfig, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, serverCharsetQuery)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		return nil, fmt.Errorf("GetCharsetConfig: unexpected result for %v: %v", serverCharsetQuery, qr.Rows)
+	}
+	config := &tabletmanagerdatapb.CharsetConfig{
+		CharacterSetServer: qr.Rows[0][0].String(),
+		CollationServer:    qr.Rows[0][1].String(),
+		DatabaseCharsets:   make(map[string]string),
+		DatabaseCollations: make(map[string]string),
+	}
+
+	qr, err = mysqld.FetchSuperQuery(ctx, databaseCharsetsQuery)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range qr.Rows {
+		if len(row) != 3 {
+			return nil, fmt.Errorf("GetCharsetConfig: unexpected row %v", row)
+		}
+		db := row[0].String()
+		config.DatabaseCharsets[db] = row[1].String()
+		config.DatabaseCollations[db] = row[2].String()
+	}
+	return config, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestGetCharsetConfig(t *testing.T) {
+	fmd := NewFakeMysqlDaemon(nil)
+	fmd.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		serverCharsetQuery: {
+			Rows: [][]sqltypes.Value{
+				processListRow("utf8mb4", "utf8mb4_general_ci"),
+			},
+		},
+		databaseCharsetsQuery: {
+			Rows: [][]sqltypes.Value{
+				processListRow("vt_ks", "utf8mb4", "utf8mb4_general_ci"),
+				processListRow("_vt", "latin1", "latin1_swedish_ci"),
+			},
+		},
+	}
+
+	config, err := GetCharsetConfig(context.Background(), fmd)
+	if err != nil {
+		t.Fatalf("GetCharsetConfig failed: %v", err)
+	}
+	want := &tabletmanagerdatapb.CharsetConfig{
+		CharacterSetServer: "utf8mb4",
+		CollationServer:    "utf8mb4_general_ci",
+		DatabaseCharsets: map[string]string{
+			"vt_ks": "utf8mb4",
+			"_vt":   "latin1",
+		},
+		DatabaseCollations: map[string]string{
+			"vt_ks": "utf8mb4_general_ci",
+			"_vt":   "latin1_swedish_ci",
+		},
+	}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("GetCharsetConfig() = %v, want %v", config, want)
+	}
+
+	fmd.FetchSuperQueryMap[serverCharsetQuery] = &sqltypes.Result{}
+	if _, err := GetCharsetConfig(context.Background(), fmd); err == nil {
+		t.Errorf("GetCharsetConfig() with no server settings worked")
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"syscall"
+)
+
+// CheckDataDir returns the problems with the directories mysqld needs,
+// as described in the my.cnf: the data directory, the binlog directory
+// and the directory of the socket must exist, be owned by the user
+// mysqld runs as, which is the user of this process, and be readable,
+// writable and searchable by it. An existing socket file must be a
+// socket. It returns an empty list if there is no problem.
+func CheckDataDir(cnf *Mycnf) ([]string, error) {
+	if cnf == nil {
+		return nil, fmt.Errorf("no my.cnf to find the data directory in")
+	}
+	return checkDataDir(cnf, uint32(os.Getuid())), nil
+}
+
+// checkDataDir is CheckDataDir, with the uid the directories must be
+// owned by.
+func checkDataDir(cnf *Mycnf, uid uint32) []string {
+	var problems []string
+	checkDir := func(what, dir string) {
+		if dir == "" {
+			problems = append(problems, fmt.Sprintf("%v is not set in my.cnf", what))
+			return
+		}
+		fi, err := os.Stat(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				problems = append(problems, fmt.Sprintf("%v %v does not exist", what, dir))
+			} else {
+				problems = append(problems, fmt.Sprintf("cannot stat %v %v: %v", what, dir, err))
+			}
+			return
+		}
+		if !fi.IsDir() {
+			problems = append(problems, fmt.Sprintf("%v %v is not a directory", what, dir))
+			return
+		}
+		if st, ok := fi.Sys().(*syscall.Stat_t); ok && st.Uid != uid {
+			problems = append(problems, fmt.Sprintf("%v %v is owned by uid %v, not by uid %v that mysqld runs as", what, dir, st.Uid, uid))
+		}
+		if perm := fi.Mode().Perm(); perm&0700 != 0700 {
+			problems = append(problems, fmt.Sprintf("%v %v has permissions %v, its owner needs rwx", what, dir, perm))
+		}
+	}
+
+	checkDir("data directory", cnf.DataDir)
+	binlogDir := ""
+	if cnf.BinLogPath != "" {
+		binlogDir = path.Dir(cnf.BinLogPath)
+	}
+	checkDir("binlog directory", binlogDir)
+	socketDir := ""
+	if cnf.SocketFile != "" {
+		socketDir = path.Dir(cnf.SocketFile)
+	}
+	checkDir("socket directory", socketDir)
+
+	// The socket only exists while mysqld runs.
+	if cnf.SocketFile != "" {
+		if fi, err := os.Lstat(cnf.SocketFile); err == nil && fi.Mode()&os.ModeSocket == 0 {
+			problems = append(problems, fmt.Sprintf("socket file %v exists, and is not a socket", cnf.SocketFile))
+		}
+	}
+	return problems
+}
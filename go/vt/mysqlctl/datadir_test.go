@@ -0,0 +1,73 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+)
+
+func TestCheckDataDir(t *testing.T) {
+	root, err := ioutil.TempDir("", "checkdatadir")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	cnf := &Mycnf{
+		DataDir:    path.Join(root, "data"),
+		BinLogPath: path.Join(root, "bin-logs", "vt-bin"),
+		SocketFile: path.Join(root, "mysql.sock"),
+	}
+	for _, dir := range []string{cnf.DataDir, path.Dir(cnf.BinLogPath)} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			t.Fatalf("failed to create directory %v: %v", dir, err)
+		}
+	}
+	uid := uint32(os.Getuid())
+
+	if got := checkDataDir(cnf, uid); len(got) != 0 {
+		t.Errorf("checkDataDir() = %v, want no problem", got)
+	}
+
+	// All the directories are owned by the wrong user.
+	got := checkDataDir(cnf, uid+1)
+	want := []string{
+		fmt.Sprintf("data directory %v is owned by uid %v, not by uid %v that mysqld runs as", cnf.DataDir, uid, uid+1),
+		fmt.Sprintf("binlog directory %v is owned by uid %v, not by uid %v that mysqld runs as", path.Dir(cnf.BinLogPath), uid, uid+1),
+		fmt.Sprintf("socket directory %v is owned by uid %v, not by uid %v that mysqld runs as", root, uid, uid+1),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("checkDataDir() with the wrong owner =\n%v\nwant:\n%v", got, want)
+	}
+
+	// A missing data directory, a read-only binlog directory, and
+	// a stale file in place of the socket.
+	if err := os.Remove(cnf.DataDir); err != nil {
+		t.Fatalf("os.Remove failed: %v", err)
+	}
+	if err := os.Chmod(path.Dir(cnf.BinLogPath), 0500); err != nil {
+		t.Fatalf("os.Chmod failed: %v", err)
+	}
+	if err := ioutil.WriteFile(cnf.SocketFile, nil, 0600); err != nil {
+		t.Fatalf("ioutil.WriteFile failed: %v", err)
+	}
+	got = checkDataDir(cnf, uid)
+	want = []string{
+		fmt.Sprintf("data directory %v does not exist", cnf.DataDir),
+		fmt.Sprintf("binlog directory %v has permissions -r-x------, its owner needs rwx", path.Dir(cnf.BinLogPath)),
+		fmt.Sprintf("socket file %v exists, and is not a socket", cnf.SocketFile),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("checkDataDir() =\n%v\nwant:\n%v", got, want)
+	}
+
+	if _, err := CheckDataDir(nil); err == nil {
+		t.Errorf("CheckDataDir(nil) worked, want an error")
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// errorLogTailChunkSize is how much of the error log is read at once,
+// from the end, when looking for its last lines.
+var errorLogTailChunkSize int64 = 64 * 1024
+
+// ErrorLogTail returns the last lines of the MySQL error log, oldest
+// first. If the log has fewer lines, all of them are returned. Only
+// the end of the file is read.
+func ErrorLogTail(cnf *Mycnf, lines int) ([]string, error) {
+	if cnf == nil || cnf.ErrorLogPath == "" {
+		return nil, fmt.Errorf("no MySQL error log configured")
+	}
+	f, err := os.Open(cnf.ErrorLogPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	// Read chunks from the end until we have a newline before the
+	// first line we want, or the whole file.
+	var data []byte
+	offset := fi.Size()
+	for offset > 0 && bytes.Count(data, []byte{'\n'}) <= lines {
+		size := errorLogTailChunkSize
+		if size > offset {
+			size = offset
+		}
+		offset -= size
+		chunk := make([]byte, size)
+		if _, err := f.ReadAt(chunk, offset); err != nil {
+			return nil, err
+		}
+		data = append(chunk, data...)
+	}
+
+	text := strings.TrimSuffix(string(data), "\n")
+	if text == "" {
+		return nil, nil
+	}
+	result := strings.Split(text, "\n")
+	if len(result) > lines {
+		// This also drops the partial first line of the chunks.
+		result = result[len(result)-lines:]
+	}
+	return result, nil
+}
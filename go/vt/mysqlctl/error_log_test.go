@@ -0,0 +1,58 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestErrorLogTail(t *testing.T) {
+	f, err := ioutil.TempFile("", "errorlogtail")
+	if err != nil {
+		t.Fatalf("ioutil.TempFile failed: %v", err)
+	}
+	defer os.Remove(f.Name())
+	var all []string
+	for i := 1; i <= 100; i++ {
+		all = append(all, fmt.Sprintf("2017-06-01T10:00:%02d [Note] line %v", i%60, i))
+	}
+	if _, err := f.WriteString(strings.Join(all, "\n") + "\n"); err != nil {
+		t.Fatalf("WriteString failed: %v", err)
+	}
+	f.Close()
+	cnf := &Mycnf{ErrorLogPath: f.Name()}
+
+	// Use small chunks, so the lines span several of them.
+	defer func(size int64) { errorLogTailChunkSize = size }(errorLogTailChunkSize)
+	errorLogTailChunkSize = 100
+
+	for _, lines := range []int{1, 10, 99, 100} {
+		got, err := ErrorLogTail(cnf, lines)
+		if err != nil {
+			t.Fatalf("ErrorLogTail(%v) failed: %v", lines, err)
+		}
+		if want := all[len(all)-lines:]; !reflect.DeepEqual(got, want) {
+			t.Errorf("ErrorLogTail(%v) = %v, want %v", lines, got, want)
+		}
+	}
+
+	// A log with fewer lines than requested is returned whole.
+	got, err := ErrorLogTail(cnf, 1000)
+	if err != nil {
+		t.Fatalf("ErrorLogTail(1000) failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, all) {
+		t.Errorf("ErrorLogTail(1000) = %v, want all the lines", got)
+	}
+
+	if _, err := ErrorLogTail(&Mycnf{}, 10); err == nil {
+		t.Errorf("ErrorLogTail without an error log worked")
+	}
+}
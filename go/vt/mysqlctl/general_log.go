@@ -0,0 +1,120 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+)
+
+// generalLogPollInterval is how often TailGeneralLog reads the new
+// entries of the general log. It is a variable for tests.
+var generalLogPollInterval = 100 * time.Millisecond
+
+// generalLogMarker tags the queries TailGeneralLog runs, so they are
+// left out of the entries it returns.
+const generalLogMarker = "vt_tail_general_log"
+
+// TailGeneralLog enables the MySQL general query log for the given
+// duration, and calls send with each entry logged in that time. The
+// entries are read from the mysql.general_log table, so TABLE is added
+// to log_output while it runs. The previous general_log and log_output
+// settings are always restored before returning, even if ctx is
+// canceled or send fails, so the log does not keep growing.
+func TailGeneralLog(ctx context.Context, mysqld MysqlDaemon, duration time.Duration, send func(string) error) (err error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SELECT @@global.general_log, @@global.log_output")
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		return fmt.Errorf("unexpected result for the general log settings: %v", qr.Rows)
+	}
+	oldGeneralLog := qr.Rows[0][0].String()
+	oldLogOutput := qr.Rows[0][1].String()
+	logOutput := oldLogOutput
+	switch strings.ToUpper(logOutput) {
+	case "", "NONE":
+		logOutput = "TABLE"
+	default:
+		if !strings.Contains(strings.ToUpper(logOutput), "TABLE") {
+			logOutput += ",TABLE"
+		}
+	}
+
+	defer func() {
+		// Use a new context, as ctx may be canceled already if the
+		// client went away.
+		restoreCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		restoreErr := mysqld.ExecuteSuperQueryList(restoreCtx, []string{
+			fmt.Sprintf("SET GLOBAL general_log = %v", oldGeneralLog),
+			fmt.Sprintf("SET GLOBAL log_output = '%v'", oldLogOutput),
+		})
+		if restoreErr != nil {
+			log.Errorf("TailGeneralLog cannot restore the general log settings, it may still be enabled: %v", restoreErr)
+			if err == nil {
+				err = restoreErr
+			}
+		}
+	}()
+	if err := mysqld.ExecuteSuperQueryList(ctx, []string{
+		fmt.Sprintf("SET GLOBAL log_output = '%v'", logOutput),
+		"SET GLOBAL general_log = ON",
+	}); err != nil {
+		return err
+	}
+
+	// Only return what is logged from now on.
+	qr, err = mysqld.FetchSuperQuery(ctx, fmt.Sprintf("SELECT /* %v */ NOW(6)", generalLogMarker))
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return fmt.Errorf("unexpected result for the current time: %v", qr.Rows)
+	}
+	last := qr.Rows[0][0].String()
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	ticker := time.NewTicker(generalLogPollInterval)
+	defer ticker.Stop()
+	for done := false; !done; {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			// Read one last time below.
+			done = true
+		case <-ticker.C:
+		}
+		if last, err = sendGeneralLogEntries(ctx, mysqld, last, send); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendGeneralLogEntries sends the entries of the general log newer than
+// last, and returns the time of the newest one.
+func sendGeneralLogEntries(ctx context.Context, mysqld MysqlDaemon, last string, send func(string) error) (string, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, fmt.Sprintf("SELECT /* %v */ event_time, thread_id, command_type, argument FROM mysql.general_log WHERE event_time > '%v' AND argument NOT LIKE '%%%v%%' ORDER BY event_time", generalLogMarker, last, generalLogMarker))
+	if err != nil {
+		return last, err
+	}
+	for _, row := range qr.Rows {
+		if len(row) != 4 {
+			return last, fmt.Errorf("unexpected general log row: %v", row)
+		}
+		if err := send(fmt.Sprintf("%v\t%v\t%v\t%v", row[0].String(), row[1].String(), row[2].String(), row[3].String())); err != nil {
+			return last, err
+		}
+		last = row[0].String()
+	}
+	return last, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+)
+
+func generalLogQuery(last string) string {
+	return fmt.Sprintf("SELECT /* vt_tail_general_log */ event_time, thread_id, command_type, argument FROM mysql.general_log WHERE event_time > '%v' AND argument NOT LIKE '%%vt_tail_general_log%%' ORDER BY event_time", last)
+}
+
+func newGeneralLogMysqlDaemon() *FakeMysqlDaemon {
+	fmd := NewFakeMysqlDaemon(nil)
+	fmd.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SELECT @@global.general_log, @@global.log_output": {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte("0")), sqltypes.MakeString([]byte("FILE"))},
+			},
+		},
+		"SELECT /* vt_tail_general_log */ NOW(6)": {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte("2017-03-01 10:00:00.000000"))},
+			},
+		},
+		generalLogQuery("2017-03-01 10:00:00.000000"): {
+			Rows: [][]sqltypes.Value{
+				{
+					sqltypes.MakeString([]byte("2017-03-01 10:00:00.000001")),
+					sqltypes.MakeString([]byte("12")),
+					sqltypes.MakeString([]byte("Query")),
+					sqltypes.MakeString([]byte("select * from t1")),
+				},
+				{
+					sqltypes.MakeString([]byte("2017-03-01 10:00:00.000002")),
+					sqltypes.MakeString([]byte("12")),
+					sqltypes.MakeString([]byte("Quit")),
+					sqltypes.MakeString([]byte("")),
+				},
+			},
+		},
+		generalLogQuery("2017-03-01 10:00:00.000002"): {},
+	}
+	fmd.ExpectedExecuteSuperQueryList = []string{
+		"SET GLOBAL log_output = 'FILE,TABLE'",
+		"SET GLOBAL general_log = ON",
+		"SET GLOBAL general_log = 0",
+		"SET GLOBAL log_output = 'FILE'",
+	}
+	return fmd
+}
+
+func TestTailGeneralLog(t *testing.T) {
+	defer func(interval time.Duration) {
+		generalLogPollInterval = interval
+	}(generalLogPollInterval)
+	generalLogPollInterval = 10 * time.Millisecond
+
+	fmd := newGeneralLogMysqlDaemon()
+	var entries []string
+	if err := TailGeneralLog(context.Background(), fmd, 100*time.Millisecond, func(entry string) error {
+		entries = append(entries, entry)
+		return nil
+	}); err != nil {
+		t.Fatalf("TailGeneralLog failed: %v", err)
+	}
+	want := []string{
+		"2017-03-01 10:00:00.000001\t12\tQuery\tselect * from t1",
+		"2017-03-01 10:00:00.000002\t12\tQuit\t",
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("TailGeneralLog sent %q, want %q", entries, want)
+	}
+
+	// The general log is disabled again at the end.
+	if err := fmd.CheckSuperQueryList(); err != nil {
+		t.Errorf("TailGeneralLog did not restore the general log: %v", err)
+	}
+}
+
+func TestTailGeneralLogCanceled(t *testing.T) {
+	defer func(interval time.Duration) {
+		generalLogPollInterval = interval
+	}(generalLogPollInterval)
+	generalLogPollInterval = 10 * time.Millisecond
+
+	// The client goes away long before the duration is over.
+	fmd := newGeneralLogMysqlDaemon()
+	ctx, cancel := context.WithCancel(context.Background())
+	err := TailGeneralLog(ctx, fmd, time.Hour, func(entry string) error {
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("TailGeneralLog returned %v, want %v", err, context.Canceled)
+	}
+
+	// The general log is still disabled.
+	if err := fmd.CheckSuperQueryList(); err != nil {
+		t.Errorf("TailGeneralLog did not restore the general log after cancellation: %v", err)
+	}
+}
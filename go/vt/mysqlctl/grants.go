@@ -0,0 +1,148 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn"
+	"github.com/youtube/vitess/go/sqldb"
+	"github.com/youtube/vitess/go/vt/concurrency"
+)
+
+var (
+	// grantAccountsRE matches the accounts part of a GRANT or
+	// REVOKE statement.
+	grantAccountsRE = regexp.MustCompile(`(?is)^.*?\s(?:TO|FROM)\s+(.*)$`)
+
+	// grantOptionsRE matches the options after the accounts of a
+	// GRANT statement.
+	grantOptionsRE = regexp.MustCompile(`(?is)\s(?:REQUIRE|WITH)\s.*$`)
+)
+
+// accountGrants are the grants of an account, as returned by SHOW GRANTS.
+type accountGrants struct {
+	account string
+	grants  []string
+
+	// missing is true if the account didn't exist.
+	missing bool
+}
+
+// ApplyGrants runs a batch of GRANT and REVOKE statements, in order.
+// They cause an implicit commit, so they cannot be run in a
+// transaction. Instead, in atomic mode, the grants of the accounts the
+// statements apply to are saved first, and restored if one of the
+// statements fails: the accounts that didn't exist are dropped. The
+// error names the failing statement.
+func ApplyGrants(ctx context.Context, mysqld MysqlDaemon, statements []string, atomic bool) error {
+	for i, statement := range statements {
+		if !isGrantStatement(statement) {
+			return fmt.Errorf("statement %v is not a GRANT or REVOKE: %v", i, statement)
+		}
+	}
+
+	var saved []accountGrants
+	if atomic {
+		accounts, err := grantAccounts(statements)
+		if err != nil {
+			return err
+		}
+		for _, account := range accounts {
+			qr, err := mysqld.FetchSuperQuery(ctx, "SHOW GRANTS FOR "+account)
+			if sqlErr, ok := err.(*sqldb.SQLError); ok && sqlErr.Number() == mysqlconn.ERNonExistingGrant {
+				// A GRANT statement may create it.
+				saved = append(saved, accountGrants{account: account, missing: true})
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("cannot save the grants of %v: %v", account, err)
+			}
+			ag := accountGrants{account: account}
+			for _, row := range qr.Rows {
+				ag.grants = append(ag.grants, row[0].String())
+			}
+			saved = append(saved, ag)
+		}
+	}
+
+	for i, statement := range statements {
+		err := mysqld.ExecuteSuperQueryList(ctx, []string{statement})
+		if err == nil {
+			continue
+		}
+		err = fmt.Errorf("statement %v (%v) failed: %v", i, statement, err)
+		if !atomic {
+			return fmt.Errorf("%v, the previous statements were applied", err)
+		}
+		if restoreErr := restoreGrants(ctx, mysqld, saved); restoreErr != nil {
+			return fmt.Errorf("%v, and restoring the previous grants failed: %v", err, restoreErr)
+		}
+		return fmt.Errorf("%v, the previous grants were restored", err)
+	}
+	return nil
+}
+
+// restoreGrants revokes all the privileges of the accounts, and
+// grants them their saved grants again. The accounts that didn't
+// exist are dropped, if the statements created them.
+func restoreGrants(ctx context.Context, mysqld MysqlDaemon, saved []accountGrants) error {
+	rec := concurrency.AllErrorRecorder{}
+	for _, ag := range saved {
+		if ag.missing {
+			// FetchSuperQuery keeps the mysql error number.
+			_, err := mysqld.FetchSuperQuery(ctx, "DROP USER "+ag.account)
+			if sqlErr, ok := err.(*sqldb.SQLError); ok && sqlErr.Number() == mysqlconn.ERCannotUser {
+				// It was not created.
+				err = nil
+			}
+			rec.RecordError(err)
+			continue
+		}
+		cmds := []string{"REVOKE ALL PRIVILEGES, GRANT OPTION FROM " + ag.account}
+		cmds = append(cmds, ag.grants...)
+		rec.RecordError(mysqld.ExecuteSuperQueryList(ctx, cmds))
+	}
+	return rec.Error()
+}
+
+// isGrantStatement returns true for GRANT and REVOKE statements.
+func isGrantStatement(statement string) bool {
+	fields := strings.Fields(statement)
+	if len(fields) == 0 {
+		return false
+	}
+	verb := strings.ToUpper(fields[0])
+	return verb == "GRANT" || verb == "REVOKE"
+}
+
+// grantAccounts returns the accounts the statements apply to, in
+// order of first appearance, as they are written in the statements,
+// e.g. 'vt_app'@'localhost'.
+func grantAccounts(statements []string) ([]string, error) {
+	var accounts []string
+	seen := make(map[string]bool)
+	for i, statement := range statements {
+		match := grantAccountsRE.FindStringSubmatch(strings.TrimRight(strings.TrimSpace(statement), ";"))
+		if match == nil {
+			return nil, fmt.Errorf("cannot find the accounts of statement %v: %v", i, statement)
+		}
+		for _, part := range strings.Split(grantOptionsRE.ReplaceAllString(match[1], ""), ",") {
+			fields := strings.Fields(part)
+			if len(fields) == 0 {
+				return nil, fmt.Errorf("cannot find the accounts of statement %v: %v", i, statement)
+			}
+			if account := fields[0]; !seen[account] {
+				seen[account] = true
+				accounts = append(accounts, account)
+			}
+		}
+	}
+	return accounts, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn"
+	"github.com/youtube/vitess/go/sqldb"
+	"github.com/youtube/vitess/go/sqltypes"
+)
+
+func TestApplyGrants(t *testing.T) {
+	ctx := context.Background()
+	mysqld := NewFakeMysqlDaemon(nil)
+	statements := []string{
+		"GRANT SELECT ON vt_ks.* TO 'vt_app'@'localhost'",
+		"REVOKE DELETE ON vt_ks.* FROM 'vt_app'@'localhost'",
+	}
+	mysqld.ExpectedExecuteSuperQueryList = statements
+
+	if err := ApplyGrants(ctx, mysqld, statements, true); err != nil {
+		t.Fatalf("ApplyGrants failed: %v", err)
+	}
+	if err := mysqld.CheckSuperQueryList(); err != nil {
+		t.Errorf("ApplyGrants: %v", err)
+	}
+}
+
+func TestApplyGrantsAtomicFailure(t *testing.T) {
+	ctx := context.Background()
+	mysqld := NewFakeMysqlDaemon(nil)
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW GRANTS FOR 'vt_app'@'localhost'": {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte("GRANT USAGE ON *.* TO 'vt_app'@'localhost'"))},
+				{sqltypes.MakeString([]byte("GRANT SELECT, DELETE ON `vt_ks`.* TO 'vt_app'@'localhost'"))},
+			},
+		},
+		"SHOW GRANTS FOR 'vt_dba'@'localhost'": {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte("GRANT ALL PRIVILEGES ON *.* TO 'vt_dba'@'localhost' WITH GRANT OPTION"))},
+			},
+		},
+	}
+	statements := []string{
+		"GRANT INSERT ON vt_ks.* TO 'vt_app'@'localhost'",
+		"REVOKE DELETE ON vt_ks.* FROM 'vt_app'@'localhost', 'vt_dba'@'localhost'",
+		"GRANT UPDATE ON vt_ks.* TO 'vt_app'@'localhost'",
+	}
+	mysqld.ExpectedExecuteSuperQueryList = []string{
+		statements[0],
+		// The second statement fails, as it does not match.
+		"second statement fails",
+		"REVOKE ALL PRIVILEGES, GRANT OPTION FROM 'vt_app'@'localhost'",
+		"GRANT USAGE ON *.* TO 'vt_app'@'localhost'",
+		"GRANT SELECT, DELETE ON `vt_ks`.* TO 'vt_app'@'localhost'",
+		"REVOKE ALL PRIVILEGES, GRANT OPTION FROM 'vt_dba'@'localhost'",
+		"GRANT ALL PRIVILEGES ON *.* TO 'vt_dba'@'localhost' WITH GRANT OPTION",
+	}
+
+	err := ApplyGrants(ctx, mysqld, statements, true)
+	if err == nil {
+		t.Fatalf("ApplyGrants worked with a failing statement")
+	}
+	for _, want := range []string{"statement 1 (" + statements[1] + ") failed", "the previous grants were restored"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("ApplyGrants returned %v, want it to contain %q", err, want)
+		}
+	}
+	// The third statement was not run, and the grants were restored.
+	if err := mysqld.CheckSuperQueryList(); err != nil {
+		t.Errorf("ApplyGrants: %v", err)
+	}
+}
+
+func TestApplyGrantsNotAtomicFailure(t *testing.T) {
+	ctx := context.Background()
+	mysqld := NewFakeMysqlDaemon(nil)
+	statements := []string{
+		"GRANT INSERT ON vt_ks.* TO 'vt_app'@'localhost'",
+		"GRANT UPDATE ON vt_ks.* TO 'vt_app'@'localhost'",
+	}
+	mysqld.ExpectedExecuteSuperQueryList = []string{
+		statements[0],
+		"second statement fails",
+	}
+
+	err := ApplyGrants(ctx, mysqld, statements, false)
+	if err == nil || !strings.Contains(err.Error(), "the previous statements were applied") {
+		t.Errorf("ApplyGrants returned %v, want an error saying the previous statements were applied", err)
+	}
+}
+
+func TestApplyGrantsRejectsOtherStatements(t *testing.T) {
+	mysqld := NewFakeMysqlDaemon(nil)
+	err := ApplyGrants(context.Background(), mysqld, []string{"DROP USER 'vt_app'@'localhost'"}, false)
+	if err == nil || !strings.Contains(err.Error(), "is not a GRANT or REVOKE") {
+		t.Errorf("ApplyGrants(DROP USER) returned %v, want a not a GRANT or REVOKE error", err)
+	}
+	if mysqld.ExpectedExecuteSuperQueryCurrent != 0 {
+		t.Errorf("ApplyGrants(DROP USER) ran %v statements", mysqld.ExpectedExecuteSuperQueryCurrent)
+	}
+}
+
+func TestGrantAccounts(t *testing.T) {
+	accounts, err := grantAccounts([]string{
+		"GRANT SELECT ON *.* TO 'a'@'%' WITH GRANT OPTION;",
+		"grant select on db.* to 'b'@'localhost', `c`@`%` require ssl",
+		"REVOKE SELECT ON *.* FROM 'a'@'%'",
+	})
+	if err != nil {
+		t.Fatalf("grantAccounts failed: %v", err)
+	}
+	if want := []string{"'a'@'%'", "'b'@'localhost'", "`c`@`%`"}; !reflect.DeepEqual(accounts, want) {
+		t.Errorf("grantAccounts() = %v, want %v", accounts, want)
+	}
+}
+
+func TestApplyGrantsAtomicNewAccount(t *testing.T) {
+	ctx := context.Background()
+	mysqld := NewFakeMysqlDaemon(nil)
+	mysqld.FetchSuperQueryErrors = map[string]error{
+		"SHOW GRANTS FOR 'vt_new'@'%'": sqldb.NewSQLError(mysqlconn.ERNonExistingGrant, "", "There is no such grant defined for user 'vt_new' on host '%%'"),
+	}
+	// The GRANT creates the account, so it is dropped when the
+	// next statement fails.
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"DROP USER 'vt_new'@'%'": {},
+	}
+	statements := []string{
+		"GRANT SELECT ON vt_ks.* TO 'vt_new'@'%'",
+		"GRANT INSERT ON vt_ks.* TO 'vt_new'@'%'",
+	}
+	mysqld.ExpectedExecuteSuperQueryList = []string{
+		statements[0],
+		"second statement fails",
+	}
+
+	err := ApplyGrants(ctx, mysqld, statements, true)
+	if err == nil || !strings.Contains(err.Error(), "the previous grants were restored") {
+		t.Errorf("ApplyGrants returned %v, want an error saying the previous grants were restored", err)
+	}
+
+	// A failure that is not about a missing account still fails
+	// before running anything.
+	mysqld.FetchSuperQueryErrors["SHOW GRANTS FOR 'vt_new'@'%'"] = sqldb.NewSQLError(mysqlconn.ERAccessDeniedError, "", "access denied")
+	mysqld.ExpectedExecuteSuperQueryCurrent = 0
+	err = ApplyGrants(ctx, mysqld, statements, true)
+	if err == nil || !strings.Contains(err.Error(), "cannot save the grants of 'vt_new'@'%'") {
+		t.Errorf("ApplyGrants returned %v, want an error about saving the grants", err)
+	}
+	if mysqld.ExpectedExecuteSuperQueryCurrent != 0 {
+		t.Errorf("ApplyGrants ran %v statements after failing to save the grants", mysqld.ExpectedExecuteSuperQueryCurrent)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+const (
+	// sqlFlushBinaryLogs closes the current binlog, so all the
+	// transactions up to now are in binlogs that won't change.
+	sqlFlushBinaryLogs = "FLUSH BINARY LOGS"
+
+	// sqlShowBinaryLogs lists the binlogs, oldest first.
+	sqlShowBinaryLogs = "SHOW BINARY LOGS"
+)
+
+// IncrementalBackup takes a backup of the binlogs of mysqld, from the
+// position of the base backup to now. The base backup can be full, or
+// incremental itself. mysqld keeps running and replicating.
+//
+// It fails before starting the backup if some of the transactions
+// since the base backup were purged from the binlogs: a full backup
+// has to be taken instead.
+func IncrementalBackup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, dir, name, baseBackupName string, backupConcurrency int, hookExtraEnv map[string]string) error {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+
+	_, base, err := findBackup(ctx, bs, dir, baseBackupName)
+	if err != nil {
+		return err
+	}
+	purged, err := mysqld.PurgedPosition()
+	if err != nil {
+		return fmt.Errorf("can't get purged position: %v", err)
+	}
+	if !base.Position.AtLeast(purged) {
+		return fmt.Errorf("binlogs since position %v of base backup %v have been purged (purged: %v), take a full backup instead", base.Position, baseBackupName, purged)
+	}
+	logger.Infof("taking incremental backup from position %v of base backup %v", base.Position, baseBackupName)
+
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		return fmt.Errorf("StartBackup failed: %v", err)
+	}
+	if err := incrementalBackup(ctx, mysqld, logger, bh, baseBackupName, base, backupConcurrency, hookExtraEnv); err != nil {
+		logger.Errorf("incremental backup is not usable, aborting it: %v", err)
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			logger.Errorf("failed to abort backup: %v", abortErr)
+		}
+		return err
+	}
+	return bh.EndBackup(ctx)
+}
+
+// incrementalBackup copies the binlogs that are not in the base
+// backup, and writes the MANIFEST.
+func incrementalBackup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, baseBackupName string, base *BackupManifest, backupConcurrency int, hookExtraEnv map[string]string) error {
+	// Close the current binlog, so the ones we copy are complete.
+	if err := mysqld.ExecuteSuperQueryList(ctx, []string{sqlFlushBinaryLogs}); err != nil {
+		return fmt.Errorf("can't flush binary logs: %v", err)
+	}
+	position, err := mysqld.MasterPosition()
+	if err != nil {
+		return fmt.Errorf("can't get master position: %v", err)
+	}
+	settings, err := readServerSettings(ctx, mysqld)
+	if err != nil {
+		logger.Warningf("can't get mysqld settings, the backup won't record them: %v", err)
+	}
+
+	fes, err := findBinlogsToBackup(ctx, mysqld, base)
+	if err != nil {
+		return err
+	}
+	logger.Infof("found %v binlogs to backup, up to position %v", len(fes), position)
+
+	if err := backupFileEntries(ctx, mysqld, logger, bh, fes, backupConcurrency, hookExtraEnv); err != nil {
+		return err
+	}
+	return writeManifest(ctx, bh, &BackupManifest{
+		FileEntries:   fes,
+		Position:      position,
+		TransformHook: *backupStorageHook,
+		SkipCompress:  !*backupStorageCompress,
+		MySQLVersion:  settings.version,
+		CharacterSet:  settings.characterSet,
+		StorageEngine: settings.storageEngine,
+		BaseBackup:    baseBackupName,
+	})
+}
+
+// findBinlogsToBackup returns the binlogs closed by the last FLUSH
+// BINARY LOGS. The binlogs a base incremental backup already
+// contains are skipped. With a full base backup, all of them are
+// copied: the binlogs are not parsed to find which transactions they
+// contain, and the ones already in the base backup are skipped when
+// the binlogs are applied.
+func findBinlogsToBackup(ctx context.Context, mysqld MysqlDaemon, base *BackupManifest) ([]FileEntry, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, sqlShowBinaryLogs)
+	if err != nil {
+		return nil, fmt.Errorf("can't list binary logs: %v", err)
+	}
+	if len(qr.Rows) == 0 {
+		return nil, fmt.Errorf("no binary log")
+	}
+
+	inBase := make(map[string]bool)
+	for _, fe := range base.FileEntries {
+		if fe.Base == backupBinlogDir {
+			inBase[fe.Name] = true
+		}
+	}
+
+	// The last binlog is the one opened by the flush.
+	var fes []FileEntry
+	for _, row := range qr.Rows[:len(qr.Rows)-1] {
+		name := row[0].String()
+		if inBase[name] {
+			continue
+		}
+		fes = append(fes, FileEntry{
+			Base: backupBinlogDir,
+			Name: name,
+		})
+	}
+	return fes, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+	"github.com/youtube/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+// setupIncrementalBackup returns a fake mysqld with three binlogs, the
+// last one being opened by FLUSH BINARY LOGS, and a full backup at
+// sequence 10 named "base".
+func setupIncrementalBackup(t *testing.T, root string) *FakeMysqlDaemon {
+	ctx := context.Background()
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "fbs")
+	*backupstorage.BackupStorageImplementation = "file"
+
+	binlogDir := path.Join(root, "bin-logs")
+	if err := os.MkdirAll(binlogDir, os.ModePerm); err != nil {
+		t.Fatalf("failed to create directory %v: %v", binlogDir, err)
+	}
+	for _, name := range []string{"vt-bin.000001", "vt-bin.000002", "vt-bin.000003"} {
+		if err := ioutil.WriteFile(path.Join(binlogDir, name), []byte(name+" contents"), os.ModePerm); err != nil {
+			t.Fatalf("failed to write file %v: %v", name, err)
+		}
+	}
+
+	mysqld := NewFakeMysqlDaemon(nil)
+	mysqld.Mycnf = &Mycnf{
+		BinLogPath: path.Join(binlogDir, "vt-bin"),
+	}
+	mysqld.CurrentMasterPosition = replication.Position{
+		GTIDSet: replication.MariadbGTID{Domain: 0, Server: 1, Sequence: 20},
+	}
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		sqlShowBinaryLogs: {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte("vt-bin.000001")), sqltypes.MakeString([]byte("1024"))},
+				{sqltypes.MakeString([]byte("vt-bin.000002")), sqltypes.MakeString([]byte("1024"))},
+				{sqltypes.MakeString([]byte("vt-bin.000003")), sqltypes.MakeString([]byte("120"))},
+			},
+		},
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatalf("GetBackupStorage failed: %v", err)
+	}
+	defer bs.Close()
+	bh, err := bs.StartBackup(ctx, "ks/0", "base")
+	if err != nil {
+		t.Fatalf("StartBackup failed: %v", err)
+	}
+	if err := writeManifest(ctx, bh, &BackupManifest{
+		Position: replication.Position{
+			GTIDSet: replication.MariadbGTID{Domain: 0, Server: 1, Sequence: 10},
+		},
+	}); err != nil {
+		t.Fatalf("writeManifest failed: %v", err)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatalf("EndBackup failed: %v", err)
+	}
+	return mysqld
+}
+
+func TestIncrementalBackup(t *testing.T) {
+	ctx := context.Background()
+	root, err := ioutil.TempDir("", "incrementalbackuptest")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	mysqld := setupIncrementalBackup(t, root)
+	mysqld.CurrentPurgedPosition = replication.Position{
+		GTIDSet: replication.MariadbGTID{Domain: 0, Server: 1, Sequence: 5},
+	}
+	mysqld.ExpectedExecuteSuperQueryList = []string{
+		sqlFlushBinaryLogs,
+	}
+
+	if err := IncrementalBackup(ctx, mysqld, logutil.NewMemoryLogger(), "ks/0", "incr", "base", 2, nil); err != nil {
+		t.Fatalf("IncrementalBackup failed: %v", err)
+	}
+	if err := mysqld.CheckSuperQueryList(); err != nil {
+		t.Errorf("IncrementalBackup: %v", err)
+	}
+
+	// The backup has the closed binlogs, and is the next link of
+	// the restore chain.
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatalf("GetBackupStorage failed: %v", err)
+	}
+	defer bs.Close()
+	_, bm, err := findBackup(ctx, bs, "ks/0", "incr")
+	if err != nil {
+		t.Fatalf("findBackup failed: %v", err)
+	}
+	var names []string
+	for _, fe := range bm.FileEntries {
+		if fe.Base != backupBinlogDir {
+			t.Errorf("unexpected base %v for %v", fe.Base, fe.Name)
+		}
+		names = append(names, fe.Name)
+	}
+	if want := []string{"vt-bin.000001", "vt-bin.000002"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("incremental backup files = %v, want %v", names, want)
+	}
+	if bm.BaseBackup != "base" || !bm.Position.Equal(mysqld.CurrentMasterPosition) {
+		t.Errorf("incremental backup MANIFEST = %+v, want base backup 'base' at %v", bm, mysqld.CurrentMasterPosition)
+	}
+	backups, err := ListBackups(ctx, "ks/0")
+	if err != nil {
+		t.Fatalf("ListBackups failed: %v", err)
+	}
+	chain, err := ResolveRestoreChain(backups, "incr")
+	if err != nil {
+		t.Fatalf("ResolveRestoreChain failed: %v", err)
+	}
+	if len(chain) != 2 || chain[0].Name != "base" || chain[1].Type != BackupTypeIncremental {
+		t.Errorf("ResolveRestoreChain(incr) = %v, want [base incr]", chain)
+	}
+}
+
+func TestIncrementalBackupPurgedBase(t *testing.T) {
+	ctx := context.Background()
+	root, err := ioutil.TempDir("", "incrementalbackuptest")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	mysqld := setupIncrementalBackup(t, root)
+	mysqld.CurrentPurgedPosition = replication.Position{
+		GTIDSet: replication.MariadbGTID{Domain: 0, Server: 1, Sequence: 12},
+	}
+
+	err = IncrementalBackup(ctx, mysqld, logutil.NewMemoryLogger(), "ks/0", "incr", "base", 2, nil)
+	if err == nil || !strings.Contains(err.Error(), "have been purged") {
+		t.Fatalf("IncrementalBackup with a purged base returned %v, want a purged error", err)
+	}
+
+	// Nothing was run on mysqld, and no backup was started.
+	if mysqld.ExpectedExecuteSuperQueryCurrent != 0 {
+		t.Errorf("%v queries were run on mysqld", mysqld.ExpectedExecuteSuperQueryCurrent)
+	}
+	backups, err := ListBackups(ctx, "ks/0")
+	if err != nil {
+		t.Fatalf("ListBackups failed: %v", err)
+	}
+	if len(backups) != 1 || backups[0].Name != "base" {
+		t.Errorf("ListBackups() = %v, want only the base backup", backups)
+	}
+}
@@ -70,6 +70,29 @@ func NewMycnf(tabletUID uint32, mysqlPort int32) *Mycnf {
 	return cnf
 }
 
+// scratchMycnf returns a copy of cnf with all its files and
+// directories moved under dir, for a scratch instance.
+func scratchMycnf(cnf *Mycnf, dir string) *Mycnf {
+	scratch := *cnf
+	scratch.mycnfMap = nil
+	scratch.path = path.Join(dir, "my.cnf")
+	scratch.DataDir = path.Join(dir, dataDir)
+	scratch.InnodbDataHomeDir = path.Join(dir, innodbDataSubdir)
+	scratch.InnodbLogGroupHomeDir = path.Join(dir, innodbLogSubdir)
+	scratch.SocketFile = path.Join(dir, "mysql.sock")
+	scratch.ErrorLogPath = path.Join(dir, "error.log")
+	scratch.SlowLogPath = path.Join(dir, "slow-query.log")
+	scratch.RelayLogPath = path.Join(dir, relayLogDir, path.Base(cnf.RelayLogPath))
+	scratch.RelayLogIndexPath = scratch.RelayLogPath + ".index"
+	scratch.RelayLogInfoPath = path.Join(dir, relayLogDir, "relay-log.info")
+	scratch.BinLogPath = path.Join(dir, binLogDir, path.Base(cnf.BinLogPath))
+	scratch.MasterInfoFile = path.Join(dir, "master.info")
+	scratch.PidFile = path.Join(dir, "mysql.pid")
+	scratch.TmpDir = path.Join(dir, "tmp")
+	scratch.SlaveLoadTmpDir = scratch.TmpDir
+	return &scratch
+}
+
 // TabletDir returns the default directory for a tablet
 func TabletDir(uid uint32) string {
 	return fmt.Sprintf("%s/vt_%010d", env.VtDataRoot(), uid)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/youtube/vitess/go/mysqlconn/replication"
 	"github.com/youtube/vitess/go/sqldb"
@@ -35,11 +36,17 @@ type MysqlDaemon interface {
 	ReinitConfig(ctx context.Context) error
 	Wait(ctx context.Context) error
 
+	// NewScratch returns a new, stopped, mysqld that keeps all its
+	// files under dir. It is used to check a backup without
+	// touching the live instance.
+	NewScratch(dir string) (MysqlDaemon, error)
+
 	// GetMysqlPort returns the current port mysql is listening on.
 	GetMysqlPort() (int32, error)
 
 	// replication related methods
 	SlaveStatus() (Status, error)
+	SlaveStatusAllChannels() (map[string]Status, error)
 	SetSemiSyncEnabled(master, slave bool) error
 	SemiSyncEnabled() (master, slave bool)
 	SemiSyncSlaveStatus() (bool, error)
@@ -47,12 +54,36 @@ type MysqlDaemon interface {
 	// reparenting related methods
 	ResetReplicationCommands() ([]string, error)
 	MasterPosition() (replication.Position, error)
+	PurgedPosition() (replication.Position, error)
 	IsReadOnly() (bool, error)
 	SetReadOnly(on bool) error
 	SetSlavePositionCommands(pos replication.Position) ([]string, error)
 	SetMasterCommands(masterHost string, masterPort int) ([]string, error)
+	SetMasterFilePositionCommands(masterHost string, masterPort int, file string, position uint64) ([]string, error)
 	WaitForReparentJournal(ctx context.Context, timeCreatedNS int64) error
 
+	// ReplicationCredentials returns the user and password used
+	// to replicate from the master.
+	ReplicationCredentials() (string, string, error)
+
+	// CheckReplicationCredentials connects to the given master
+	// with the replication credentials, without affecting the
+	// running replication.
+	CheckReplicationCredentials(ctx context.Context, masterHost string, masterPort int) error
+
+	// SetReplicationCredentials changes the user and password
+	// SetMasterCommands uses from now on.
+	SetReplicationCredentials(user, password string)
+
+	// SetReplicationSSL makes SetMasterCommands use SSL with the
+	// given files from now on.
+	SetReplicationSSL(ca, cert, key string)
+
+	// ApplyBinlogs applies the transactions of the master binary
+	// logs that are not in startPos and were committed before
+	// stopTime. It is used for point in time recovery.
+	ApplyBinlogs(ctx context.Context, masterHost string, masterPort int, startPos replication.Position, stopTime time.Time) error
+
 	// DemoteMaster waits for all current transactions to finish,
 	// and returns the current replication position. It will not
 	// change the read_only state of the server.
@@ -66,6 +97,12 @@ type MysqlDaemon interface {
 
 	// Schema related methods
 	GetSchema(dbName string, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error)
+	// GetSchemaBestEffort is like GetSchema, but returns the tables
+	// gathered so far and true if ctx is done before it completes.
+	GetSchemaBestEffort(ctx context.Context, dbName string, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, bool, error)
+	// GetCreateStatements returns the raw SHOW CREATE TABLE output
+	// of each table, by name.
+	GetCreateStatements(ctx context.Context, dbName string, tables, excludeTables []string, includeViews bool) (map[string]string, error)
 	PreflightSchemaChange(dbName string, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error)
 	ApplySchemaChange(dbName string, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error)
 
@@ -126,6 +163,9 @@ type FakeMysqlDaemon struct {
 	// and SlaveStatus
 	CurrentMasterPosition replication.Position
 
+	// CurrentPurgedPosition is returned by PurgedPosition
+	CurrentPurgedPosition replication.Position
+
 	// SlaveStatusError is used by SlaveStatus
 	SlaveStatusError error
 
@@ -138,6 +178,14 @@ type FakeMysqlDaemon struct {
 	// SecondsBehindMaster is returned by SlaveStatus
 	SecondsBehindMaster uint
 
+	// LastIOErrno and LastSQLErrno are returned by SlaveStatus
+	LastIOErrno  int
+	LastSQLErrno int
+
+	// SlaveStatusChannels is returned by SlaveStatusAllChannels.
+	// If nil, it returns the SlaveStatus result as the default channel.
+	SlaveStatusChannels map[string]Status
+
 	// ReadOnly is the current value of the flag
 	ReadOnly bool
 
@@ -159,6 +207,53 @@ type FakeMysqlDaemon struct {
 	// SetMasterCommands will return
 	SetMasterCommandsResult []string
 
+	// SetMasterFilePositionCommandsInput is matched against the
+	// input of SetMasterFilePositionCommands (as "%v:%v %v:%v"). If
+	// it doesn't match, SetMasterFilePositionCommands will return
+	// an error.
+	SetMasterFilePositionCommandsInput string
+
+	// SetMasterFilePositionCommandsResult is what
+	// SetMasterFilePositionCommands will return
+	SetMasterFilePositionCommandsResult []string
+
+	// ReplicationUser and ReplicationPassword are returned by
+	// ReplicationCredentials, and set by SetReplicationCredentials.
+	ReplicationUser     string
+	ReplicationPassword string
+
+	// CheckReplicationCredentialsError is returned by
+	// CheckReplicationCredentials.
+	CheckReplicationCredentialsError error
+
+	// ReplicationSSLAllowed, ReplicationSSLCA, ReplicationSSLCert
+	// and ReplicationSSLKey are returned by SlaveStatus. The files
+	// are set by SetReplicationSSL.
+	ReplicationSSLAllowed bool
+	ReplicationSSLCA      string
+	ReplicationSSLCert    string
+	ReplicationSSLKey     string
+
+	// StartSlaveIOThreadFailures is the number of times
+	// START SLAVE IO_THREAD will leave the IO thread stopped,
+	// as if it could not connect to the master.
+	StartSlaveIOThreadFailures int
+
+	// StartSlaveIOErrno, if set, makes START SLAVE leave the
+	// replication stopped with that IO thread error, e.g. 1236 when
+	// the master purged the binlogs the slave needs.
+	StartSlaveIOErrno int
+
+	// ApplyBinlogsMaster, ApplyBinlogsStartPosition and
+	// ApplyBinlogsStopTime record the input of the last
+	// ApplyBinlogs call (the master as "%v:%v").
+	ApplyBinlogsMaster        string
+	ApplyBinlogsStartPosition replication.Position
+	ApplyBinlogsStopTime      time.Time
+
+	// ApplyBinlogsError is returned by ApplyBinlogs
+	ApplyBinlogsError error
+
 	// DemoteMasterPosition is returned by DemoteMaster
 	DemoteMasterPosition replication.Position
 
@@ -177,6 +272,14 @@ type FakeMysqlDaemon struct {
 	// return an error.
 	Schema *tabletmanagerdatapb.SchemaDefinition
 
+	// SchemaTableDelay is how long GetSchemaBestEffort takes to
+	// gather each table, to simulate a slow server.
+	SchemaTableDelay time.Duration
+
+	// CreateStatements has the raw CREATE statements returned by
+	// GetCreateStatements, for the tables of Schema it selects.
+	CreateStatements map[string]string
+
 	// PreflightSchemaChangeResult will be returned by PreflightSchemaChange.
 	// If nil we'll return an error.
 	PreflightSchemaChangeResult []*tabletmanagerdatapb.SchemaChangeResult
@@ -203,6 +306,10 @@ type FakeMysqlDaemon struct {
 	// FetchSuperQueryResults is used by FetchSuperQuery
 	FetchSuperQueryMap map[string]*sqltypes.Result
 
+	// FetchSuperQueryErrors are returned by FetchSuperQuery for
+	// their query, before FetchSuperQueryMap is looked at.
+	FetchSuperQueryErrors map[string]error
+
 	// BinlogPlayerEnabled is used by {Enable,Disable}BinlogPlayer
 	BinlogPlayerEnabled bool
 
@@ -210,6 +317,11 @@ type FakeMysqlDaemon struct {
 	SemiSyncMasterEnabled bool
 	// SemiSyncSlaveEnabled represents the state of rpl_semi_sync_slave_enabled.
 	SemiSyncSlaveEnabled bool
+
+	// Scratch is returned by NewScratch, with its Mycnf set to
+	// use the scratch directory. If nil, NewScratch returns an
+	// error.
+	Scratch *FakeMysqlDaemon
 }
 
 // NewFakeMysqlDaemon returns a FakeMysqlDaemon where mysqld appears
@@ -265,6 +377,15 @@ func (fmd *FakeMysqlDaemon) Wait(ctx context.Context) error {
 	return nil
 }
 
+// NewScratch is part of the MysqlDaemon interface.
+func (fmd *FakeMysqlDaemon) NewScratch(dir string) (MysqlDaemon, error) {
+	if fmd.Scratch == nil {
+		return nil, fmt.Errorf("FakeMysqlDaemon has no scratch instance")
+	}
+	fmd.Scratch.Mycnf = scratchMycnf(fmd.Mycnf, dir)
+	return fmd.Scratch, nil
+}
+
 // GetMysqlPort is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) GetMysqlPort() (int32, error) {
 	if fmd.MysqlPort == -1 {
@@ -285,9 +406,28 @@ func (fmd *FakeMysqlDaemon) SlaveStatus() (Status, error) {
 		SlaveSQLRunning:     fmd.Replicating,
 		MasterHost:          fmd.CurrentMasterHost,
 		MasterPort:          fmd.CurrentMasterPort,
+		MasterUser:          fmd.ReplicationUser,
+		LastIOErrno:         fmd.LastIOErrno,
+		LastSQLErrno:        fmd.LastSQLErrno,
+		MasterSSLAllowed:    fmd.ReplicationSSLAllowed,
+		MasterSSLCA:         fmd.ReplicationSSLCA,
+		MasterSSLCert:       fmd.ReplicationSSLCert,
+		MasterSSLKey:        fmd.ReplicationSSLKey,
 	}, nil
 }
 
+// SlaveStatusAllChannels is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) SlaveStatusAllChannels() (map[string]Status, error) {
+	if fmd.SlaveStatusChannels != nil {
+		return fmd.SlaveStatusChannels, nil
+	}
+	status, err := fmd.SlaveStatus()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]Status{"": status}, nil
+}
+
 // ResetReplicationCommands is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) ResetReplicationCommands() ([]string, error) {
 	return fmd.ResetReplicationResult, fmd.ResetReplicationError
@@ -298,6 +438,11 @@ func (fmd *FakeMysqlDaemon) MasterPosition() (replication.Position, error) {
 	return fmd.CurrentMasterPosition, nil
 }
 
+// PurgedPosition is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) PurgedPosition() (replication.Position, error) {
+	return fmd.CurrentPurgedPosition, nil
+}
+
 // IsReadOnly is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) IsReadOnly() (bool, error) {
 	return fmd.ReadOnly, nil
@@ -326,11 +471,51 @@ func (fmd *FakeMysqlDaemon) SetMasterCommands(masterHost string, masterPort int)
 	return fmd.SetMasterCommandsResult, nil
 }
 
+// SetMasterFilePositionCommands is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) SetMasterFilePositionCommands(masterHost string, masterPort int, file string, position uint64) ([]string, error) {
+	input := fmt.Sprintf("%v:%v %v:%v", masterHost, masterPort, file, position)
+	if fmd.SetMasterFilePositionCommandsInput != input {
+		return nil, fmt.Errorf("wrong input for SetMasterFilePositionCommands: expected %v got %v", fmd.SetMasterFilePositionCommandsInput, input)
+	}
+	return fmd.SetMasterFilePositionCommandsResult, nil
+}
+
 // WaitForReparentJournal is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) WaitForReparentJournal(ctx context.Context, timeCreatedNS int64) error {
 	return nil
 }
 
+// ReplicationCredentials is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) ReplicationCredentials() (string, string, error) {
+	return fmd.ReplicationUser, fmd.ReplicationPassword, nil
+}
+
+// CheckReplicationCredentials is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) CheckReplicationCredentials(ctx context.Context, masterHost string, masterPort int) error {
+	return fmd.CheckReplicationCredentialsError
+}
+
+// SetReplicationCredentials is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) SetReplicationCredentials(user, password string) {
+	fmd.ReplicationUser = user
+	fmd.ReplicationPassword = password
+}
+
+// SetReplicationSSL is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) SetReplicationSSL(ca, cert, key string) {
+	fmd.ReplicationSSLCA = ca
+	fmd.ReplicationSSLCert = cert
+	fmd.ReplicationSSLKey = key
+}
+
+// ApplyBinlogs is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) ApplyBinlogs(ctx context.Context, masterHost string, masterPort int, startPos replication.Position, stopTime time.Time) error {
+	fmd.ApplyBinlogsMaster = fmt.Sprintf("%v:%v", masterHost, masterPort)
+	fmd.ApplyBinlogsStartPosition = startPos
+	fmd.ApplyBinlogsStopTime = stopTime
+	return fmd.ApplyBinlogsError
+}
+
 // DemoteMaster is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) DemoteMaster() (replication.Position, error) {
 	return fmd.DemoteMasterPosition, nil
@@ -375,9 +560,21 @@ func (fmd *FakeMysqlDaemon) ExecuteSuperQueryList(ctx context.Context, queryList
 		// intercept some queries to update our status
 		switch query {
 		case SQLStartSlave:
+			if fmd.StartSlaveIOErrno != 0 {
+				fmd.LastIOErrno = fmd.StartSlaveIOErrno
+				break
+			}
 			fmd.Replicating = true
 		case SQLStopSlave:
 			fmd.Replicating = false
+		case SQLStartSlaveIOThread:
+			if fmd.StartSlaveIOThreadFailures > 0 {
+				fmd.StartSlaveIOThreadFailures--
+				break
+			}
+			fmd.Replicating = true
+		case SQLStopSlaveIOThread:
+			fmd.Replicating = false
 		}
 	}
 	return nil
@@ -385,6 +582,9 @@ func (fmd *FakeMysqlDaemon) ExecuteSuperQueryList(ctx context.Context, queryList
 
 // FetchSuperQuery returns the results from the map, if any
 func (fmd *FakeMysqlDaemon) FetchSuperQuery(ctx context.Context, query string) (*sqltypes.Result, error) {
+	if err, ok := fmd.FetchSuperQueryErrors[query]; ok {
+		return nil, err
+	}
 	if fmd.FetchSuperQueryMap == nil {
 		return nil, fmt.Errorf("unexpected query: %v", query)
 	}
@@ -443,6 +643,44 @@ func (fmd *FakeMysqlDaemon) GetSchema(dbName string, tables, excludeTables []str
 	return tmutils.FilterTables(fmd.Schema, tables, excludeTables, includeViews)
 }
 
+// GetSchemaBestEffort is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) GetSchemaBestEffort(ctx context.Context, dbName string, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, bool, error) {
+	sd, err := fmd.GetSchema(dbName, tables, excludeTables, includeViews)
+	if err != nil {
+		return nil, false, err
+	}
+	partial := &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: sd.DatabaseSchema,
+		Version:        sd.Version,
+	}
+	for _, td := range sd.TableDefinitions {
+		select {
+		case <-ctx.Done():
+			return partial, true, nil
+		case <-time.After(fmd.SchemaTableDelay):
+		}
+		partial.TableDefinitions = append(partial.TableDefinitions, td)
+	}
+	return partial, false, nil
+}
+
+// GetCreateStatements is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) GetCreateStatements(ctx context.Context, dbName string, tables, excludeTables []string, includeViews bool) (map[string]string, error) {
+	sd, err := fmd.GetSchema(dbName, tables, excludeTables, includeViews)
+	if err != nil {
+		return nil, err
+	}
+	statements := make(map[string]string, len(sd.TableDefinitions))
+	for _, td := range sd.TableDefinitions {
+		statement, ok := fmd.CreateStatements[td.Name]
+		if !ok {
+			return nil, fmt.Errorf("no create statement defined for %v", td.Name)
+		}
+		statements[td.Name] = statement
+	}
+	return statements, nil
+}
+
 // PreflightSchemaChange is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) PreflightSchemaChange(dbName string, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error) {
 	if fmd.PreflightSchemaChangeResult == nil {
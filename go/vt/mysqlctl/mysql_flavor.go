@@ -29,9 +29,18 @@ type MysqlFlavor interface {
 	// MasterPosition returns the ReplicationPosition of a master.
 	MasterPosition(mysqld *Mysqld) (replication.Position, error)
 
+	// PurgedPosition returns the set of transactions that have been
+	// purged from the binary logs, and can't be served to slaves.
+	PurgedPosition(mysqld *Mysqld) (replication.Position, error)
+
 	// SlaveStatus returns the ReplicationStatus of a slave.
 	SlaveStatus(mysqld *Mysqld) (Status, error)
 
+	// SlaveStatusAllChannels returns the ReplicationStatus of each
+	// replication channel of a slave, by channel name. The default
+	// channel is named "".
+	SlaveStatusAllChannels(mysqld *Mysqld) (map[string]Status, error)
+
 	// ResetReplicationCommands returns the commands to completely reset
 	// replication on the host.
 	ResetReplicationCommands() []string
@@ -51,6 +60,11 @@ type MysqlFlavor interface {
 	// It should not start or stop replication.
 	SetMasterCommands(params *sqldb.ConnParams, masterHost string, masterPort int, masterConnectRetry int) ([]string, error)
 
+	// SetMasterFilePositionCommands is like SetMasterCommands, but
+	// replicates from the given binlog file and position of the
+	// master instead of using the GTID position.
+	SetMasterFilePositionCommands(params *sqldb.ConnParams, masterHost string, masterPort int, masterConnectRetry int, file string, position uint64) ([]string, error)
+
 	// ParseGTID parses a GTID in the canonical format of this
 	// MySQL flavor into a replication.GTID interface value.
 	ParseGTID(string) (replication.GTID, error)
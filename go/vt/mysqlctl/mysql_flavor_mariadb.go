@@ -39,6 +39,12 @@ func (flavor *mariaDB10) MasterPosition(mysqld *Mysqld) (rp replication.Position
 	return flavor.ParseReplicationPosition(qr.Rows[0][0].String())
 }
 
+// PurgedPosition implements MysqlFlavor.PurgedPosition().
+// MariaDB doesn't keep track of the GTIDs of purged binary logs.
+func (*mariaDB10) PurgedPosition(mysqld *Mysqld) (replication.Position, error) {
+	return replication.Position{}, fmt.Errorf("PurgedPosition is not supported by MariaDB")
+}
+
 // SlaveStatus implements MysqlFlavor.SlaveStatus().
 func (flavor *mariaDB10) SlaveStatus(mysqld *Mysqld) (Status, error) {
 	fields, err := mysqld.fetchSuperQueryMap(context.TODO(), "SHOW ALL SLAVES STATUS")
@@ -50,8 +56,24 @@ func (flavor *mariaDB10) SlaveStatus(mysqld *Mysqld) (Status, error) {
 		// is not configured as a slave.
 		return Status{}, ErrNotSlave
 	}
+	return flavor.parseSlaveStatus(fields)
+}
+
+// SlaveStatusAllChannels implements MysqlFlavor.SlaveStatusAllChannels().
+// MariaDB calls channels connections.
+func (flavor *mariaDB10) SlaveStatusAllChannels(mysqld *Mysqld) (map[string]Status, error) {
+	rows, err := mysqld.fetchSuperQueryMaps(context.TODO(), "SHOW ALL SLAVES STATUS")
+	if err != nil {
+		return nil, err
+	}
+	return parseSlaveStatusChannels(rows, "Connection_name", flavor.parseSlaveStatus)
+}
+
+// parseSlaveStatus parses one row of SHOW ALL SLAVES STATUS.
+func (flavor *mariaDB10) parseSlaveStatus(fields map[string]string) (Status, error) {
 	status := parseSlaveStatus(fields)
 
+	var err error
 	status.Position, err = flavor.ParseReplicationPosition(fields["Gtid_Slave_Pos"])
 	if err != nil {
 		return Status{}, fmt.Errorf("SlaveStatus can't parse MariaDB GTID (Gtid_Slave_Pos: %#v): %v", fields["Gtid_Slave_Pos"], err)
@@ -143,6 +165,17 @@ func (*mariaDB10) SetMasterCommands(params *sqldb.ConnParams, masterHost string,
 	return []string{changeMasterTo}, nil
 }
 
+// SetMasterFilePositionCommands implements MysqlFlavor.SetMasterFilePositionCommands().
+func (*mariaDB10) SetMasterFilePositionCommands(params *sqldb.ConnParams, masterHost string, masterPort int, masterConnectRetry int, file string, position uint64) ([]string, error) {
+	// Make CHANGE MASTER TO command.
+	args := changeMasterArgs(params, masterHost, masterPort, masterConnectRetry)
+	args = append(args, "MASTER_USE_GTID = no")
+	args = append(args, changeMasterFilePositionArgs(file, position)...)
+	changeMasterTo := "CHANGE MASTER TO\n  " + strings.Join(args, ",\n  ")
+
+	return []string{changeMasterTo}, nil
+}
+
 // ParseGTID implements MysqlFlavor.ParseGTID().
 func (*mariaDB10) ParseGTID(s string) (replication.GTID, error) {
 	return replication.ParseGTID(mariadbFlavorID, s)
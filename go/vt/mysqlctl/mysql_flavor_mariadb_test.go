@@ -67,6 +67,38 @@ func TestMariadbSetMasterCommands(t *testing.T) {
 	}
 }
 
+func TestMariadbSetMasterFilePositionCommands(t *testing.T) {
+	params := &sqldb.ConnParams{
+		Uname: "username",
+		Pass:  "password",
+	}
+	masterHost := "localhost"
+	masterPort := 123
+	masterConnectRetry := 1234
+	file := "vt-0000000101-bin.000012"
+	position := uint64(4567)
+	want := []string{
+		`CHANGE MASTER TO
+  MASTER_HOST = 'localhost',
+  MASTER_PORT = 123,
+  MASTER_USER = 'username',
+  MASTER_PASSWORD = 'password',
+  MASTER_CONNECT_RETRY = 1234,
+  MASTER_USE_GTID = no,
+  MASTER_LOG_FILE = 'vt-0000000101-bin.000012',
+  MASTER_LOG_POS = 4567`,
+	}
+
+	got, err := (&mariaDB10{}).SetMasterFilePositionCommands(params, masterHost, masterPort, masterConnectRetry, file, position)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("(&mariaDB10{}).SetMasterFilePositionCommands(%#v, %#v, %#v, %#v, %#v, %#v) = %#v, want %#v", params, masterHost, masterPort, masterConnectRetry, file, position, got, want)
+	}
+}
+
 func TestMariadbSetMasterCommandsSSL(t *testing.T) {
 	params := &sqldb.ConnParams{
 		Uname:     "username",
@@ -39,6 +39,18 @@ func (flavor *mysql56) MasterPosition(mysqld *Mysqld) (rp replication.Position,
 	return flavor.ParseReplicationPosition(qr.Rows[0][0].String())
 }
 
+// PurgedPosition implements MysqlFlavor.PurgedPosition().
+func (flavor *mysql56) PurgedPosition(mysqld *Mysqld) (rp replication.Position, err error) {
+	qr, err := mysqld.FetchSuperQuery(context.TODO(), "SELECT @@GLOBAL.gtid_purged")
+	if err != nil {
+		return rp, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return rp, fmt.Errorf("unexpected result format for gtid_purged: %#v", qr)
+	}
+	return flavor.ParseReplicationPosition(qr.Rows[0][0].String())
+}
+
 // SlaveStatus implements MysqlFlavor.SlaveStatus().
 func (flavor *mysql56) SlaveStatus(mysqld *Mysqld) (Status, error) {
 	fields, err := mysqld.fetchSuperQueryMap(context.TODO(), "SHOW SLAVE STATUS")
@@ -50,8 +62,25 @@ func (flavor *mysql56) SlaveStatus(mysqld *Mysqld) (Status, error) {
 		// is not configured as a slave.
 		return Status{}, ErrNotSlave
 	}
+	return flavor.parseSlaveStatus(fields)
+}
+
+// SlaveStatusAllChannels implements MysqlFlavor.SlaveStatusAllChannels().
+// MySQL 5.6 has no Channel_Name column, so it only has the default
+// channel.
+func (flavor *mysql56) SlaveStatusAllChannels(mysqld *Mysqld) (map[string]Status, error) {
+	rows, err := mysqld.fetchSuperQueryMaps(context.TODO(), "SHOW SLAVE STATUS")
+	if err != nil {
+		return nil, err
+	}
+	return parseSlaveStatusChannels(rows, "Channel_Name", flavor.parseSlaveStatus)
+}
+
+// parseSlaveStatus parses one row of SHOW SLAVE STATUS.
+func (flavor *mysql56) parseSlaveStatus(fields map[string]string) (Status, error) {
 	status := parseSlaveStatus(fields)
 
+	var err error
 	status.Position, err = flavor.ParseReplicationPosition(fields["Executed_Gtid_Set"])
 	if err != nil {
 		return Status{}, fmt.Errorf("SlaveStatus can't parse MySQL 5.6 GTID (Executed_Gtid_Set: %#v): %v", fields["Executed_Gtid_Set"], err)
@@ -132,6 +161,17 @@ func (*mysql56) SetMasterCommands(params *sqldb.ConnParams, masterHost string, m
 	return []string{changeMasterTo}, nil
 }
 
+// SetMasterFilePositionCommands implements MysqlFlavor.SetMasterFilePositionCommands().
+func (*mysql56) SetMasterFilePositionCommands(params *sqldb.ConnParams, masterHost string, masterPort int, masterConnectRetry int, file string, position uint64) ([]string, error) {
+	// Make CHANGE MASTER TO command.
+	args := changeMasterArgs(params, masterHost, masterPort, masterConnectRetry)
+	args = append(args, "MASTER_AUTO_POSITION = 0")
+	args = append(args, changeMasterFilePositionArgs(file, position)...)
+	changeMasterTo := "CHANGE MASTER TO\n  " + strings.Join(args, ",\n  ")
+
+	return []string{changeMasterTo}, nil
+}
+
 // ParseGTID implements MysqlFlavor.ParseGTID().
 func (*mysql56) ParseGTID(s string) (replication.GTID, error) {
 	return replication.ParseGTID(mysql56FlavorID, s)
@@ -89,6 +89,38 @@ func TestMysql56SetMasterCommands(t *testing.T) {
 	}
 }
 
+func TestMysql56SetMasterFilePositionCommands(t *testing.T) {
+	params := &sqldb.ConnParams{
+		Uname: "username",
+		Pass:  "password",
+	}
+	masterHost := "localhost"
+	masterPort := 123
+	masterConnectRetry := 1234
+	file := "vt-0000000101-bin.000012"
+	position := uint64(4567)
+	want := []string{
+		`CHANGE MASTER TO
+  MASTER_HOST = 'localhost',
+  MASTER_PORT = 123,
+  MASTER_USER = 'username',
+  MASTER_PASSWORD = 'password',
+  MASTER_CONNECT_RETRY = 1234,
+  MASTER_AUTO_POSITION = 0,
+  MASTER_LOG_FILE = 'vt-0000000101-bin.000012',
+  MASTER_LOG_POS = 4567`,
+	}
+
+	got, err := (&mysql56{}).SetMasterFilePositionCommands(params, masterHost, masterPort, masterConnectRetry, file, position)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("(&mysql56{}).SetMasterFilePositionCommands(%#v, %#v, %#v, %#v, %#v, %#v) = %#v, want %#v", params, masterHost, masterPort, masterConnectRetry, file, position, got, want)
+	}
+}
+
 func TestMysql56SetMasterCommandsSSL(t *testing.T) {
 	params := &sqldb.ConnParams{
 		Uname:     "username",
@@ -134,3 +166,74 @@ func TestMysql56MakeBinlogEvent(t *testing.T) {
 		t.Errorf("(&mysql56{}).MakeBinlogEvent(%#v) = %#v, want %#v", input, got, want)
 	}
 }
+
+func TestMysql56SlaveStatusChannels(t *testing.T) {
+	flavor := &mysql56{}
+	pos1, _ := flavor.ParseReplicationPosition("00010203-0405-0607-0809-0a0b0c0d0e0f:1-20")
+	pos2, _ := flavor.ParseReplicationPosition("00010203-0405-0607-0809-0a0b0c0d0e0f:1-20,10111213-1415-1617-1819-1a1b1c1d1e1f:1-7")
+
+	// Two channels report distinct sources and positions.
+	rows := []map[string]string{
+		{
+			"Channel_Name":          "",
+			"Master_Host":           "source1",
+			"Master_Port":           "3306",
+			"Slave_IO_Running":      "Yes",
+			"Slave_SQL_Running":     "Yes",
+			"Seconds_Behind_Master": "1",
+			"Executed_Gtid_Set":     "00010203-0405-0607-0809-0a0b0c0d0e0f:1-20",
+		},
+		{
+			"Channel_Name":          "source2",
+			"Master_Host":           "source2",
+			"Master_Port":           "3307",
+			"Slave_IO_Running":      "Yes",
+			"Slave_SQL_Running":     "No",
+			"Seconds_Behind_Master": "5",
+			"Last_SQL_Errno":        "1062",
+			"Executed_Gtid_Set":     "00010203-0405-0607-0809-0a0b0c0d0e0f:1-20,10111213-1415-1617-1819-1a1b1c1d1e1f:1-7",
+		},
+	}
+	want := map[string]Status{
+		"": {
+			Position:            pos1,
+			SlaveIORunning:      true,
+			SlaveSQLRunning:     true,
+			SecondsBehindMaster: 1,
+			MasterHost:          "source1",
+			MasterPort:          3306,
+		},
+		"source2": {
+			Position:            pos2,
+			SlaveIORunning:      true,
+			SlaveSQLRunning:     false,
+			SecondsBehindMaster: 5,
+			MasterHost:          "source2",
+			MasterPort:          3307,
+			LastSQLErrno:        1062,
+		},
+	}
+	got, err := parseSlaveStatusChannels(rows, "Channel_Name", flavor.parseSlaveStatus)
+	if err != nil {
+		t.Fatalf("parseSlaveStatusChannels failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseSlaveStatusChannels() = %#v, want %#v", got, want)
+	}
+
+	// MySQL 5.6 has no Channel_Name column: the only row is the
+	// default channel.
+	delete(rows[0], "Channel_Name")
+	got, err = parseSlaveStatusChannels(rows[:1], "Channel_Name", flavor.parseSlaveStatus)
+	if err != nil {
+		t.Fatalf("parseSlaveStatusChannels failed: %v", err)
+	}
+	if want := map[string]Status{"": want[""]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("parseSlaveStatusChannels() with the default channel = %#v, want %#v", got, want)
+	}
+
+	// No row means the server is not a slave.
+	if _, err := parseSlaveStatusChannels(nil, "Channel_Name", flavor.parseSlaveStatus); err != ErrNotSlave {
+		t.Errorf("parseSlaveStatusChannels() with no row returned %v, want %v", err, ErrNotSlave)
+	}
+}
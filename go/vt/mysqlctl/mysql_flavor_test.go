@@ -33,15 +33,24 @@ func (fakeMysqlFlavor) WaitMasterPos(ctx context.Context, mysqld *Mysqld, target
 func (fakeMysqlFlavor) MasterPosition(mysqld *Mysqld) (replication.Position, error) {
 	return replication.Position{}, nil
 }
+func (fakeMysqlFlavor) PurgedPosition(mysqld *Mysqld) (replication.Position, error) {
+	return replication.Position{}, nil
+}
 func (fakeMysqlFlavor) SlaveStatus(mysqld *Mysqld) (Status, error) {
 	return Status{}, nil
 }
+func (fakeMysqlFlavor) SlaveStatusAllChannels(mysqld *Mysqld) (map[string]Status, error) {
+	return nil, nil
+}
 func (fakeMysqlFlavor) SetSlavePositionCommands(pos replication.Position) ([]string, error) {
 	return nil, nil
 }
 func (fakeMysqlFlavor) SetMasterCommands(params *sqldb.ConnParams, masterHost string, masterPort int, masterConnectRetry int) ([]string, error) {
 	return nil, nil
 }
+func (fakeMysqlFlavor) SetMasterFilePositionCommands(params *sqldb.ConnParams, masterHost string, masterPort int, masterConnectRetry int, file string, position uint64) ([]string, error) {
+	return nil, nil
+}
 func (fakeMysqlFlavor) EnableBinlogPlayback(mysqld *Mysqld) error  { return nil }
 func (fakeMysqlFlavor) DisableBinlogPlayback(mysqld *Mysqld) error { return nil }
 
@@ -59,12 +59,27 @@ type Mysqld struct {
 	dbaMysqlStats      *stats.Timings
 	allprivsMysqlStats *stats.Timings
 	tabletDir          string
+	// scratch is set for a temporary instance created by
+	// NewScratch. It is never managed by mysqlctld or the
+	// mysqld_start and mysqld_shutdown hooks, which are for the
+	// live instance.
+	scratch bool
 
 	// mutex protects the fields below.
 	mutex         sync.Mutex
 	mysqlFlavor   MysqlFlavor
 	onTermFuncs   []func()
 	cancelWaitCmd chan struct{}
+	// replUser and replPassword override the replication credentials
+	// of dbcfgs, if replUser is set.
+	replUser     string
+	replPassword string
+	// replSSLCa, replSSLCert and replSSLKey override the replication
+	// SSL options of dbcfgs, if replSSL is set.
+	replSSL     bool
+	replSSLCa   string
+	replSSLCert string
+	replSSLKey  string
 }
 
 // NewMysqld creates a Mysqld object based on the provided configuration
@@ -125,6 +140,36 @@ func (mysqld *Mysqld) TabletDir() string {
 	return mysqld.tabletDir
 }
 
+// NewScratch returns a new, stopped, mysqld that keeps all its files
+// under dir, and connects through its own socket. It is used to check
+// a backup without touching this instance. The caller has to Close it.
+func (mysqld *Mysqld) NewScratch(dir string) (MysqlDaemon, error) {
+	cnf := scratchMycnf(mysqld.config, dir)
+	dbcfgs := *mysqld.dbcfgs
+	dbcfgs.Dba.Host = ""
+	dbcfgs.Dba.Port = 0
+	dbcfgs.Dba.UnixSocket = cnf.SocketFile
+
+	scratch := NewMysqld(cnf, &dbcfgs, dbconfigs.DbaConfig, false)
+	scratch.scratch = true
+	for _, d := range cnf.directoryList() {
+		if err := os.MkdirAll(d, os.ModePerm); err != nil {
+			scratch.Close()
+			return nil, err
+		}
+	}
+	root, err := vtenv.VtRoot()
+	if err != nil {
+		scratch.Close()
+		return nil, err
+	}
+	if err := scratch.initConfig(root); err != nil {
+		scratch.Close()
+		return nil, err
+	}
+	return scratch, nil
+}
+
 // RunMysqlUpgrade will run the mysql_upgrade program on the current
 // install.  Will be called only when mysqld is running with no
 // network and no grant tables.
@@ -190,7 +235,7 @@ func (mysqld *Mysqld) RunMysqlUpgrade() error {
 // the dba user.
 func (mysqld *Mysqld) Start(ctx context.Context, mysqldArgs ...string) error {
 	// Execute as remote action on mysqlctld if requested.
-	if *socketFile != "" {
+	if *socketFile != "" && !mysqld.scratch {
 		log.Infof("executing Mysqld.Start() remotely via mysqlctld server: %v", *socketFile)
 		client, err := mysqlctlclient.New("unix", *socketFile)
 		if err != nil {
@@ -213,7 +258,11 @@ func (mysqld *Mysqld) startNoWait(ctx context.Context, mysqldArgs ...string) err
 	ts := fmt.Sprintf("Mysqld.Start(%v)", time.Now().Unix())
 
 	// try the mysqld start hook, if any
-	switch hr := hook.NewHook("mysqld_start", mysqldArgs).Execute(); hr.ExitStatus {
+	hr := &hook.HookResult{ExitStatus: hook.HOOK_DOES_NOT_EXIST}
+	if !mysqld.scratch {
+		hr = hook.NewHook("mysqld_start", mysqldArgs).Execute()
+	}
+	switch hr.ExitStatus {
 	case hook.HOOK_SUCCESS:
 		// hook exists and worked, we can keep going
 		name = "mysqld_start hook"
@@ -339,7 +388,7 @@ func (mysqld *Mysqld) Shutdown(ctx context.Context, waitForMysqld bool) error {
 	log.Infof("Mysqld.Shutdown")
 
 	// Execute as remote action on mysqlctld if requested.
-	if *socketFile != "" {
+	if *socketFile != "" && !mysqld.scratch {
 		log.Infof("executing Mysqld.Shutdown() remotely via mysqlctld server: %v", *socketFile)
 		client, err := mysqlctlclient.New("unix", *socketFile)
 		if err != nil {
@@ -367,8 +416,10 @@ func (mysqld *Mysqld) Shutdown(ctx context.Context, waitForMysqld bool) error {
 	}
 
 	// try the mysqld shutdown hook, if any
-	h := hook.NewSimpleHook("mysqld_shutdown")
-	hr := h.Execute()
+	hr := &hook.HookResult{ExitStatus: hook.HOOK_DOES_NOT_EXIST}
+	if !mysqld.scratch {
+		hr = hook.NewSimpleHook("mysqld_shutdown").Execute()
+	}
 	switch hr.ExitStatus {
 	case hook.HOOK_SUCCESS:
 		// hook exists and worked, we can keep going
@@ -616,7 +667,7 @@ func (mysqld *Mysqld) ReinitConfig(ctx context.Context) error {
 	log.Infof("Mysqld.ReinitConfig")
 
 	// Execute as remote action on mysqlctld if requested.
-	if *socketFile != "" {
+	if *socketFile != "" && !mysqld.scratch {
 		log.Infof("executing Mysqld.ReinitConfig() remotely via mysqlctld server: %v", *socketFile)
 		client, err := mysqlctlclient.New("unix", *socketFile)
 		if err != nil {
@@ -0,0 +1,90 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+const (
+	// systemUser is the user MySQL reports for its own threads,
+	// like the slave IO and SQL threads.
+	systemUser = "system user"
+
+	// daemonCommand is the command of MySQL background threads,
+	// like the event scheduler.
+	daemonCommand = "Daemon"
+)
+
+// GetProcessList returns the threads currently running in MySQL.
+func GetProcessList(ctx context.Context, mysqld MysqlDaemon) ([]*tabletmanagerdatapb.Process, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SHOW FULL PROCESSLIST")
+	if err != nil {
+		return nil, err
+	}
+	processes := make([]*tabletmanagerdatapb.Process, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		if len(row) <= colInfo {
+			return nil, fmt.Errorf("GetProcessList: unexpected row %v", row)
+		}
+		id, err := row[colConnectionID].ParseInt64()
+		if err != nil {
+			return nil, fmt.Errorf("GetProcessList: malformed Id: %v", err)
+		}
+		p := &tabletmanagerdatapb.Process{
+			Id:      id,
+			User:    row[colUsername].String(),
+			Host:    row[colClientAddr].String(),
+			Db:      row[colDbName].String(),
+			Command: row[colCommand].String(),
+			State:   row[colState].String(),
+			Info:    row[colInfo].String(),
+		}
+		if !row[colTime].IsNull() {
+			if p.Time, err = row[colTime].ParseInt64(); err != nil {
+				return nil, fmt.Errorf("GetProcessList: malformed Time: %v", err)
+			}
+		}
+		processes = append(processes, p)
+	}
+	return processes, nil
+}
+
+// IsSystemProcess returns true if the thread belongs to MySQL itself,
+// or serves replication to a slave. Killing those would break
+// replication, so KillProcess refuses to.
+func IsSystemProcess(p *tabletmanagerdatapb.Process) bool {
+	// Check for prefix, since it could be "Binlog Dump GTID".
+	return p.User == systemUser ||
+		p.Command == daemonCommand ||
+		strings.HasPrefix(p.Command, binlogDumpCommand)
+}
+
+// KillProcess kills the MySQL connection with the given ID.
+// It returns an error if there is no such connection, or if it is
+// a system or replication thread.
+func KillProcess(ctx context.Context, mysqld MysqlDaemon, id int64) error {
+	processes, err := GetProcessList(ctx, mysqld)
+	if err != nil {
+		return err
+	}
+	for _, p := range processes {
+		if p.Id != id {
+			continue
+		}
+		if IsSystemProcess(p) {
+			return fmt.Errorf("refusing to kill system or replication thread %v (user %q, command %q)", id, p.User, p.Command)
+		}
+		log.Infof("KillProcess: killing connection %v (user %q, info %q)", id, p.User, p.Info)
+		return mysqld.ExecuteSuperQueryList(ctx, []string{fmt.Sprintf("KILL %d", id)})
+	}
+	return fmt.Errorf("no MySQL process with id %v", id)
+}
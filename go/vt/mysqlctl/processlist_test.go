@@ -0,0 +1,108 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func processListRow(values ...string) []sqltypes.Value {
+	row := make([]sqltypes.Value, len(values))
+	for i, v := range values {
+		if v == "NULL" {
+			row[i] = sqltypes.NULL
+			continue
+		}
+		row[i] = sqltypes.MakeString([]byte(v))
+	}
+	return row
+}
+
+func newProcessListMysqlDaemon() *FakeMysqlDaemon {
+	fmd := NewFakeMysqlDaemon(nil)
+	fmd.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW FULL PROCESSLIST": {
+			Rows: [][]sqltypes.Value{
+				processListRow("1", "system user", "", "NULL", "Connect", "3600", "Waiting for master to send event", "NULL"),
+				processListRow("2", "system user", "", "NULL", "Connect", "0", "Slave has read all relay log", "NULL"),
+				processListRow("7", "vt_repl", "10.0.0.2:51234", "NULL", "Binlog Dump GTID", "54", "Master has sent all binlog to slave", "NULL"),
+				processListRow("12", "vt_app", "localhost", "vt_ks", "Query", "300", "Sending data", "select * from t1"),
+			},
+		},
+	}
+	return fmd
+}
+
+func TestGetProcessList(t *testing.T) {
+	fmd := newProcessListMysqlDaemon()
+	processes, err := GetProcessList(context.Background(), fmd)
+	if err != nil {
+		t.Fatalf("GetProcessList failed: %v", err)
+	}
+	if len(processes) != 4 {
+		t.Fatalf("GetProcessList returned %v processes, want 4", len(processes))
+	}
+	want := &tabletmanagerdatapb.Process{
+		Id:      12,
+		User:    "vt_app",
+		Host:    "localhost",
+		Db:      "vt_ks",
+		Command: "Query",
+		Time:    300,
+		State:   "Sending data",
+		Info:    "select * from t1",
+	}
+	if !reflect.DeepEqual(processes[3], want) {
+		t.Errorf("GetProcessList()[3] = %v, want %v", processes[3], want)
+	}
+	if processes[0].Db != "" || processes[0].Info != "" {
+		t.Errorf("GetProcessList()[0] = %v, want empty db and info for NULL", processes[0])
+	}
+	for i, want := range []bool{true, true, true, false} {
+		if got := IsSystemProcess(processes[i]); got != want {
+			t.Errorf("IsSystemProcess(%v) = %v, want %v", processes[i], got, want)
+		}
+	}
+}
+
+func TestKillProcess(t *testing.T) {
+	ctx := context.Background()
+	fmd := newProcessListMysqlDaemon()
+	fmd.ExpectedExecuteSuperQueryList = []string{"KILL 12"}
+
+	// An application query can be killed.
+	if err := KillProcess(ctx, fmd, 12); err != nil {
+		t.Fatalf("KillProcess(12) failed: %v", err)
+	}
+	if err := fmd.CheckSuperQueryList(); err != nil {
+		t.Errorf("KillProcess(12) did not kill the query: %v", err)
+	}
+
+	// Replication threads, on either side, are not.
+	for _, id := range []int64{1, 2, 7} {
+		err := KillProcess(ctx, fmd, id)
+		if err == nil || !strings.Contains(err.Error(), "refusing to kill") {
+			t.Errorf("KillProcess(%v) returned %v, want a refusal", id, err)
+		}
+	}
+
+	// Neither are unknown connections.
+	if err := KillProcess(ctx, fmd, 99); err == nil || !strings.Contains(err.Error(), "no MySQL process") {
+		t.Errorf("KillProcess(99) returned %v, want an unknown process error", err)
+	}
+
+	// Only the one query was sent.
+	if fmd.ExpectedExecuteSuperQueryCurrent != 1 {
+		t.Errorf("KillProcess sent %v queries, want 1", fmd.ExpectedExecuteSuperQueryCurrent)
+	}
+}
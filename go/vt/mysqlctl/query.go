@@ -187,6 +187,27 @@ func (mysqld *Mysqld) fetchSuperQueryMap(ctx context.Context, query string) (map
 	return rowMap, nil
 }
 
+// fetchSuperQueryMaps returns a map from column names to cell data for
+// each row of a query result.
+func (mysqld *Mysqld) fetchSuperQueryMaps(ctx context.Context, query string) ([]map[string]string, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	rowMaps := make([]map[string]string, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		if len(qr.Fields) != len(row) {
+			return nil, fmt.Errorf("query %#v returned %d column names, expected %d", query, len(qr.Fields), len(row))
+		}
+		rowMap := make(map[string]string, len(row))
+		for i, value := range row {
+			rowMap[qr.Fields[i].Name] = value.String()
+		}
+		rowMaps = append(rowMaps, rowMap)
+	}
+	return rowMaps, nil
+}
+
 // fetchVariables returns a map from MySQL variable names to variable value
 // for variables that match the given pattern.
 func (mysqld *Mysqld) fetchVariables(ctx context.Context, pattern string) (map[string]string, error) {
@@ -14,9 +14,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/youtube/vitess/go/mysqlconn"
 	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/sqldb"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
 
 	"golang.org/x/net/context"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
 )
 
 // CreateReparentJournal returns the commands to execute to create
@@ -58,6 +63,44 @@ func queryReparentJournal(timeCreatedNS int64) string {
 	return fmt.Sprintf("SELECT action_name, master_alias, replication_position FROM _vt.reparent_journal WHERE time_created_ns=%v", timeCreatedNS)
 }
 
+// lastReparentJournalEntryQuery is the SQL query to use to read the
+// most recent reparent_journal row.
+const lastReparentJournalEntryQuery = "SELECT time_created_ns, action_name, master_alias, replication_position FROM _vt.reparent_journal ORDER BY time_created_ns DESC LIMIT 1"
+
+// ReadLastReparentJournalEntry returns the most recent row of the
+// reparent_journal table. It returns nil if the table is empty, or
+// does not exist yet because the tablet was never part of a reparent.
+func ReadLastReparentJournalEntry(ctx context.Context, mysqld MysqlDaemon) (*tabletmanagerdatapb.ReparentJournalEntry, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, lastReparentJournalEntryQuery)
+	if err != nil {
+		if sqlErr, ok := err.(*sqldb.SQLError); ok && sqlErr.Number() == mysqlconn.ERNoSuchTable {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	row := qr.Rows[0]
+	if len(row) != 4 {
+		return nil, fmt.Errorf("ReadLastReparentJournalEntry: unexpected row %v", row)
+	}
+	timeCreatedNS, err := row[0].ParseInt64()
+	if err != nil {
+		return nil, fmt.Errorf("ReadLastReparentJournalEntry: invalid time_created_ns %v: %v", row[0], err)
+	}
+	masterAlias, err := topoproto.ParseTabletAlias(row[2].String())
+	if err != nil {
+		return nil, fmt.Errorf("ReadLastReparentJournalEntry: invalid master_alias %v: %v", row[2], err)
+	}
+	return &tabletmanagerdatapb.ReparentJournalEntry{
+		TimeCreatedNs:       timeCreatedNS,
+		ActionName:          row[1].String(),
+		MasterAlias:         masterAlias,
+		ReplicationPosition: row[3].String(),
+	}, nil
+}
+
 // WaitForReparentJournal will wait until the context is done for
 // the row in the reparent_journal table.
 func (mysqld *Mysqld) WaitForReparentJournal(ctx context.Context, timeCreatedNS int64) error {
@@ -0,0 +1,61 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestReadLastReparentJournalEntry(t *testing.T) {
+	fmd := NewFakeMysqlDaemon(nil)
+	fmd.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		lastReparentJournalEntryQuery: {
+			Rows: [][]sqltypes.Value{
+				processListRow("1490000000000000000", "PlannedReparentShard", "cell1-0000000002", "MariaDB/0-1-1234"),
+			},
+		},
+	}
+
+	entry, err := ReadLastReparentJournalEntry(context.Background(), fmd)
+	if err != nil {
+		t.Fatalf("ReadLastReparentJournalEntry failed: %v", err)
+	}
+	want := &tabletmanagerdatapb.ReparentJournalEntry{
+		TimeCreatedNs: 1490000000000000000,
+		ActionName:    "PlannedReparentShard",
+		MasterAlias: &topodatapb.TabletAlias{
+			Cell: "cell1",
+			Uid:  2,
+		},
+		ReplicationPosition: "MariaDB/0-1-1234",
+	}
+	if !reflect.DeepEqual(entry, want) {
+		t.Errorf("ReadLastReparentJournalEntry() = %v, want %v", entry, want)
+	}
+
+	// An empty journal has no last entry.
+	fmd.FetchSuperQueryMap[lastReparentJournalEntryQuery] = &sqltypes.Result{}
+	entry, err = ReadLastReparentJournalEntry(context.Background(), fmd)
+	if err != nil || entry != nil {
+		t.Errorf("ReadLastReparentJournalEntry() on an empty journal = (%v, %v), want (nil, nil)", entry, err)
+	}
+
+	fmd.FetchSuperQueryMap[lastReparentJournalEntryQuery] = &sqltypes.Result{
+		Rows: [][]sqltypes.Value{
+			processListRow("1490000000000000000", "PlannedReparentShard", "bad alias", "MariaDB/0-1-1234"),
+		},
+	}
+	if _, err := ReadLastReparentJournalEntry(context.Background(), fmd); err == nil {
+		t.Errorf("ReadLastReparentJournalEntry() with an invalid master alias worked")
+	}
+}
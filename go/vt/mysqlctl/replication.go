@@ -9,6 +9,7 @@ Handle creating replicas and setting up the replication streams.
 package mysqlctl
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strconv"
@@ -19,9 +20,11 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/youtube/vitess/go/mysql"
+	"github.com/youtube/vitess/go/mysqlconn"
 	"github.com/youtube/vitess/go/mysqlconn/replication"
 	"github.com/youtube/vitess/go/netutil"
 	"github.com/youtube/vitess/go/sqldb"
+	"github.com/youtube/vitess/go/sqltypes"
 	"github.com/youtube/vitess/go/vt/dbconfigs"
 	"github.com/youtube/vitess/go/vt/hook"
 )
@@ -32,6 +35,18 @@ const (
 
 	// SQLStopSlave is the SQl command issued to stop MySQL replication
 	SQLStopSlave = "STOP SLAVE"
+
+	// SQLStartSlaveIOThread is the SQL command issued to start only the
+	// MySQL replication IO thread
+	SQLStartSlaveIOThread = "START SLAVE IO_THREAD"
+
+	// SQLStopSlaveIOThread is the SQL command issued to stop only the
+	// MySQL replication IO thread
+	SQLStopSlaveIOThread = "STOP SLAVE IO_THREAD"
+
+	// SQLResetSlave is the SQL command issued to discard the relay
+	// logs. Unlike RESET SLAVE ALL, it keeps the master host and port.
+	SQLResetSlave = "RESET SLAVE"
 )
 
 func changeMasterArgs(params *sqldb.ConnParams, masterHost string, masterPort int, masterConnectRetry int) []string {
@@ -60,12 +75,27 @@ func changeMasterArgs(params *sqldb.ConnParams, masterHost string, masterPort in
 	return args
 }
 
+// changeMasterFilePositionArgs returns the CHANGE MASTER TO arguments
+// to replicate from a binlog file and position.
+func changeMasterFilePositionArgs(file string, position uint64) []string {
+	return []string{
+		fmt.Sprintf("MASTER_LOG_FILE = %s", encodeString(file)),
+		fmt.Sprintf("MASTER_LOG_POS = %d", position),
+	}
+}
+
 // parseSlaveStatus parses the common fields of SHOW SLAVE STATUS.
 func parseSlaveStatus(fields map[string]string) Status {
 	status := Status{
 		MasterHost:      fields["Master_Host"],
+		MasterUser:      fields["Master_User"],
 		SlaveIORunning:  fields["Slave_IO_Running"] == "Yes",
 		SlaveSQLRunning: fields["Slave_SQL_Running"] == "Yes",
+
+		MasterSSLAllowed: fields["Master_SSL_Allowed"] == "Yes",
+		MasterSSLCA:      fields["Master_SSL_CA_File"],
+		MasterSSLCert:    fields["Master_SSL_Cert"],
+		MasterSSLKey:     fields["Master_SSL_Key"],
 	}
 	parseInt, _ := strconv.ParseInt(fields["Master_Port"], 10, 0)
 	status.MasterPort = int(parseInt)
@@ -73,9 +103,33 @@ func parseSlaveStatus(fields map[string]string) Status {
 	status.MasterConnectRetry = int(parseInt)
 	parseUint, _ := strconv.ParseUint(fields["Seconds_Behind_Master"], 10, 0)
 	status.SecondsBehindMaster = uint(parseUint)
+	parseInt, _ = strconv.ParseInt(fields["Last_IO_Errno"], 10, 0)
+	status.LastIOErrno = int(parseInt)
+	parseInt, _ = strconv.ParseInt(fields["Last_SQL_Errno"], 10, 0)
+	status.LastSQLErrno = int(parseInt)
 	return status
 }
 
+// parseSlaveStatusChannels parses the rows of a slave status query,
+// one per replication channel, into statuses by channel name. The
+// channel name is read from channelField, and is "" if it is missing.
+func parseSlaveStatusChannels(rows []map[string]string, channelField string, parse func(map[string]string) (Status, error)) (map[string]Status, error) {
+	if len(rows) == 0 {
+		// The query returned no data, meaning the server
+		// is not configured as a slave.
+		return nil, ErrNotSlave
+	}
+	statuses := make(map[string]Status, len(rows))
+	for _, fields := range rows {
+		status, err := parse(fields)
+		if err != nil {
+			return nil, err
+		}
+		statuses[fields[channelField]] = status
+	}
+	return statuses, nil
+}
+
 // WaitForSlaveStart waits until the deadline for replication to start.
 // This validates the current master is correct and can be connected to.
 func WaitForSlaveStart(mysqld MysqlDaemon, slaveStartDeadline int) error {
@@ -194,6 +248,16 @@ func (mysqld *Mysqld) SlaveStatus() (Status, error) {
 	return flavor.SlaveStatus(mysqld)
 }
 
+// SlaveStatusAllChannels returns the slave replication statuses of
+// all replication channels, by channel name.
+func (mysqld *Mysqld) SlaveStatusAllChannels() (map[string]Status, error) {
+	flavor, err := mysqld.flavor()
+	if err != nil {
+		return nil, fmt.Errorf("SlaveStatusAllChannels needs flavor: %v", err)
+	}
+	return flavor.SlaveStatusAllChannels(mysqld)
+}
+
 // MasterPosition returns master replication position
 func (mysqld *Mysqld) MasterPosition() (rp replication.Position, err error) {
 	flavor, err := mysqld.flavor()
@@ -203,6 +267,16 @@ func (mysqld *Mysqld) MasterPosition() (rp replication.Position, err error) {
 	return flavor.MasterPosition(mysqld)
 }
 
+// PurgedPosition returns the set of transactions purged from the
+// binary logs, i.e. gtid_purged.
+func (mysqld *Mysqld) PurgedPosition() (rp replication.Position, err error) {
+	flavor, err := mysqld.flavor()
+	if err != nil {
+		return rp, fmt.Errorf("PurgedPosition needs flavor: %v", err)
+	}
+	return flavor.PurgedPosition(mysqld)
+}
+
 // SetSlavePositionCommands returns the commands to set the
 // replication position at which the slave will resume
 // when it is later reparented with SetMasterCommands.
@@ -221,13 +295,136 @@ func (mysqld *Mysqld) SetMasterCommands(masterHost string, masterPort int) ([]st
 	if err != nil {
 		return nil, fmt.Errorf("SetMasterCommands needs flavor: %v", err)
 	}
-	params, err := dbconfigs.WithCredentials(&mysqld.dbcfgs.Repl)
+	params, err := mysqld.replParams()
 	if err != nil {
 		return nil, err
 	}
 	return flavor.SetMasterCommands(&params, masterHost, masterPort, int(masterConnectRetry.Seconds()))
 }
 
+// SetMasterFilePositionCommands returns the commands to run to make
+// the provided host / port the master, replicating from the given
+// binlog file and position instead of the GTID position.
+func (mysqld *Mysqld) SetMasterFilePositionCommands(masterHost string, masterPort int, file string, position uint64) ([]string, error) {
+	flavor, err := mysqld.flavor()
+	if err != nil {
+		return nil, fmt.Errorf("SetMasterFilePositionCommands needs flavor: %v", err)
+	}
+	params, err := mysqld.replParams()
+	if err != nil {
+		return nil, err
+	}
+	return flavor.SetMasterFilePositionCommands(&params, masterHost, masterPort, int(masterConnectRetry.Seconds()), file, position)
+}
+
+// replParams returns the connection parameters used to replicate from
+// the master, with the credentials set by SetReplicationCredentials
+// and the SSL options set by SetReplicationSSL, if any.
+func (mysqld *Mysqld) replParams() (sqldb.ConnParams, error) {
+	params, err := dbconfigs.WithCredentials(&mysqld.dbcfgs.Repl)
+	if err != nil {
+		return params, err
+	}
+	mysqld.mutex.Lock()
+	defer mysqld.mutex.Unlock()
+	if mysqld.replUser != "" {
+		params.Uname = mysqld.replUser
+		params.Pass = mysqld.replPassword
+	}
+	if mysqld.replSSL {
+		mysql.EnableSSL(&params)
+		params.SslCa = mysqld.replSSLCa
+		params.SslCaPath = ""
+		params.SslCert = mysqld.replSSLCert
+		params.SslKey = mysqld.replSSLKey
+	}
+	return params, nil
+}
+
+// ReplicationCredentials returns the user and password used to
+// replicate from the master.
+func (mysqld *Mysqld) ReplicationCredentials() (string, string, error) {
+	params, err := mysqld.replParams()
+	if err != nil {
+		return "", "", err
+	}
+	return params.Uname, params.Pass, nil
+}
+
+// CheckReplicationCredentials connects to the given master with the
+// credentials and SSL options used to replicate from it, then
+// disconnects. The running replication is not affected.
+func (mysqld *Mysqld) CheckReplicationCredentials(ctx context.Context, masterHost string, masterPort int) error {
+	params, err := mysqld.replParams()
+	if err != nil {
+		return err
+	}
+	params.Host = masterHost
+	params.Port = masterPort
+	params.UnixSocket = ""
+	conn, err := mysqlconn.Connect(ctx, &params)
+	if err != nil {
+		return err
+	}
+	conn.Close()
+	return nil
+}
+
+// SetReplicationCredentials changes the user and password used by
+// SetMasterCommands from now on. It does not change the current
+// replication settings of MySQL, see ChangeReplicationCredentialsCommands.
+// They are only kept in memory: after a restart of vttablet,
+// SetMasterCommands uses the credentials of dbcfgs again, while MySQL
+// keeps the ones of its last CHANGE MASTER TO.
+func (mysqld *Mysqld) SetReplicationCredentials(user, password string) {
+	mysqld.mutex.Lock()
+	defer mysqld.mutex.Unlock()
+	mysqld.replUser = user
+	mysqld.replPassword = password
+}
+
+// ChangeReplicationCredentialsCommands returns the commands to change
+// the credentials a slave connects to its master with, keeping all its
+// other replication settings. The IO thread must be stopped while they
+// run, and restarted after.
+func ChangeReplicationCredentialsCommands(user, password string) []string {
+	// MASTER_PASSWORD comes first, so redactMasterPassword finds it.
+	return []string{fmt.Sprintf("CHANGE MASTER TO\n  MASTER_PASSWORD = %s,\n  MASTER_USER = %s", encodeString(password), encodeString(user))}
+}
+
+// encodeString returns s as an SQL string literal.
+func encodeString(s string) string {
+	buf := &bytes.Buffer{}
+	sqltypes.MakeString([]byte(s)).EncodeSQL(buf)
+	return buf.String()
+}
+
+// SetReplicationSSL makes SetMasterCommands use SSL with the given
+// files from now on. It does not change the current replication
+// settings of MySQL, see ChangeReplicationSSLCommands. The files are
+// only kept in memory: after a restart, the -db-config-repl-ssl-*
+// flags are used again.
+func (mysqld *Mysqld) SetReplicationSSL(ca, cert, key string) {
+	mysqld.mutex.Lock()
+	defer mysqld.mutex.Unlock()
+	mysqld.replSSL = true
+	mysqld.replSSLCa = ca
+	mysqld.replSSLCert = cert
+	mysqld.replSSLKey = key
+}
+
+// ChangeReplicationSSLCommands returns the commands to change the SSL
+// options a slave connects to its master with, keeping all its other
+// replication settings. The IO thread must be stopped while they run,
+// and restarted after.
+func ChangeReplicationSSLCommands(enabled bool, ca, cert, key string) []string {
+	ssl := 0
+	if enabled {
+		ssl = 1
+	}
+	return []string{fmt.Sprintf("CHANGE MASTER TO\n  MASTER_SSL = %d,\n  MASTER_SSL_CA = %s,\n  MASTER_SSL_CERT = %s,\n  MASTER_SSL_KEY = %s", ssl, encodeString(ca), encodeString(cert), encodeString(key))}
+}
+
 // ResetReplicationCommands returns the commands to run to reset all
 // replication for this host.
 func (mysqld *Mysqld) ResetReplicationCommands() ([]string, error) {
@@ -288,6 +485,23 @@ func FindSlaves(mysqld MysqlDaemon) ([]string, error) {
 	return addrs, nil
 }
 
+// SemiSyncMasterClients returns the number of semi-sync slaves
+// currently connected to the master.
+func SemiSyncMasterClients(mysqld MysqlDaemon) (int, error) {
+	qr, err := mysqld.FetchSuperQuery(context.TODO(), "SHOW STATUS LIKE 'Rpl_semi_sync_master_clients'")
+	if err != nil {
+		return 0, err
+	}
+	if len(qr.Rows) != 1 {
+		return 0, errors.New("no Rpl_semi_sync_master_clients variable in mysql")
+	}
+	clients, err := strconv.Atoi(qr.Rows[0][1].String())
+	if err != nil {
+		return 0, fmt.Errorf("invalid Rpl_semi_sync_master_clients value: %v", err)
+	}
+	return clients, nil
+}
+
 // WaitBlpPosition will wait for the filtered replication to reach at least
 // the provided position.
 func WaitBlpPosition(ctx context.Context, mysqld MysqlDaemon, sql string, replicationPosition string) error {
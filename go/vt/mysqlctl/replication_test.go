@@ -5,6 +5,7 @@
 package mysqlctl
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -44,3 +45,41 @@ func TestRedactMasterPassword(t *testing.T) {
   MASTER_PASSWORD = 'AAA`, `CHANGE MASTER TO
   MASTER_PASSWORD = 'AAA`)
 }
+
+func TestChangeReplicationCredentialsCommands(t *testing.T) {
+	got := ChangeReplicationCredentialsCommands("vt_'repl", "pa'ss\\")
+	want := []string{`CHANGE MASTER TO
+  MASTER_PASSWORD = 'pa\'ss\\',
+  MASTER_USER = 'vt_\'repl'`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangeReplicationCredentialsCommands() = %q, want %q", got, want)
+	}
+	if r := redactMasterPassword(got[0]); r != `CHANGE MASTER TO
+  MASTER_PASSWORD = '********',
+  MASTER_USER = 'vt_\'repl'` {
+		t.Errorf("redactMasterPassword() = %q", r)
+	}
+}
+
+func TestChangeMasterFilePositionArgs(t *testing.T) {
+	got := changeMasterFilePositionArgs("vt-bin.000012', MASTER_HOST = 'evil", 4567)
+	want := []string{
+		`MASTER_LOG_FILE = 'vt-bin.000012\', MASTER_HOST = \'evil'`,
+		"MASTER_LOG_POS = 4567",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("changeMasterFilePositionArgs() = %q, want %q", got, want)
+	}
+}
+
+func TestChangeReplicationSSLCommands(t *testing.T) {
+	got := ChangeReplicationSSLCommands(true, "/certs/ca.pem", "/certs/o'cert.pem", "/certs/key\\.pem")
+	want := []string{`CHANGE MASTER TO
+  MASTER_SSL = 1,
+  MASTER_SSL_CA = '/certs/ca.pem',
+  MASTER_SSL_CERT = '/certs/o\'cert.pem',
+  MASTER_SSL_KEY = '/certs/key\\.pem'`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangeReplicationSSLCommands() = %q, want %q", got, want)
+	}
+}
@@ -35,17 +35,30 @@ func (mysqld *Mysqld) executeSchemaCommands(sql string) error {
 // GetSchema returns the schema for database for tables listed in
 // tables. If tables is empty, return the schema for all tables.
 func (mysqld *Mysqld) GetSchema(dbName string, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
-	ctx := context.TODO()
+	sd, _, err := mysqld.getSchema(context.TODO(), dbName, tables, excludeTables, includeViews, false /*bestEffort*/)
+	return sd, err
+}
+
+// GetSchemaBestEffort is like GetSchema, but when ctx is done before
+// all tables are gathered, it returns the tables gathered so far and
+// true, instead of an error.
+func (mysqld *Mysqld) GetSchemaBestEffort(ctx context.Context, dbName string, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, bool, error) {
+	return mysqld.getSchema(ctx, dbName, tables, excludeTables, includeViews, true /*bestEffort*/)
+}
+
+// getSchema is the implementation of GetSchema and GetSchemaBestEffort.
+// The returned bool is true if the schema is incomplete.
+func (mysqld *Mysqld) getSchema(ctx context.Context, dbName string, tables, excludeTables []string, includeViews, bestEffort bool) (*tabletmanagerdatapb.SchemaDefinition, bool, error) {
 	sd := &tabletmanagerdatapb.SchemaDefinition{}
 	backtickDBName := sqlparser.Backtick(dbName)
 
 	// get the database creation command
 	qr, fetchErr := mysqld.FetchSuperQuery(ctx, fmt.Sprintf("SHOW CREATE DATABASE IF NOT EXISTS %s", backtickDBName))
 	if fetchErr != nil {
-		return nil, fetchErr
+		return nil, false, fetchErr
 	}
 	if len(qr.Rows) == 0 {
-		return nil, fmt.Errorf("empty create database statement for %v", dbName)
+		return nil, false, fmt.Errorf("empty create database statement for %v", dbName)
 	}
 	sd.DatabaseSchema = strings.Replace(qr.Rows[0][1].String(), backtickDBName, "{{.DatabaseName}}", 1)
 
@@ -56,14 +69,19 @@ func (mysqld *Mysqld) GetSchema(dbName string, tables, excludeTables []string, i
 	}
 	qr, err := mysqld.FetchSuperQuery(ctx, sql)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	if len(qr.Rows) == 0 {
-		return sd, nil
+		return sd, false, nil
 	}
 
 	sd.TableDefinitions = make([]*tabletmanagerdatapb.TableDefinition, 0, len(qr.Rows))
+	incomplete := false
 	for _, row := range qr.Rows {
+		if bestEffort && ctx.Err() != nil {
+			incomplete = true
+			break
+		}
 		tableName := row[0].String()
 		tableType := row[1].String()
 
@@ -73,7 +91,7 @@ func (mysqld *Mysqld) GetSchema(dbName string, tables, excludeTables []string, i
 			// dataLength is NULL for views, then we use 0
 			dataLength, err = row[2].ParseUint64()
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 		}
 
@@ -82,16 +100,20 @@ func (mysqld *Mysqld) GetSchema(dbName string, tables, excludeTables []string, i
 		if !row[3].IsNull() {
 			rowCount, err = row[3].ParseUint64()
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 		}
 
 		qr, fetchErr := mysqld.FetchSuperQuery(ctx, fmt.Sprintf("SHOW CREATE TABLE %s.%s", backtickDBName, sqlparser.Backtick(tableName)))
 		if fetchErr != nil {
-			return nil, fetchErr
+			if bestEffort && ctx.Err() != nil {
+				incomplete = true
+				break
+			}
+			return nil, false, fetchErr
 		}
 		if len(qr.Rows) == 0 {
-			return nil, fmt.Errorf("empty create table statement for %v", tableName)
+			return nil, false, fmt.Errorf("empty create table statement for %v", tableName)
 		}
 
 		// Normalize & remove auto_increment because it changes on every insert
@@ -111,11 +133,11 @@ func (mysqld *Mysqld) GetSchema(dbName string, tables, excludeTables []string, i
 
 		td.Columns, err = mysqld.GetColumns(dbName, tableName)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 		td.PrimaryKeyColumns, err = mysqld.GetPrimaryKeyColumns(dbName, tableName)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 		td.Type = tableType
 		td.DataLength = dataLength
@@ -123,12 +145,59 @@ func (mysqld *Mysqld) GetSchema(dbName string, tables, excludeTables []string, i
 		sd.TableDefinitions = append(sd.TableDefinitions, td)
 	}
 
+	if incomplete {
+		log.Warningf("GetSchemaBestEffort: %v, returning %v of %v tables", ctx.Err(), len(sd.TableDefinitions), len(qr.Rows))
+	}
+
 	sd, err = tmutils.FilterTables(sd, tables, excludeTables, includeViews)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	tmutils.GenerateSchemaVersion(sd)
-	return sd, nil
+	return sd, incomplete, nil
+}
+
+// GetCreateStatements returns the output of SHOW CREATE TABLE for
+// the tables listed in tables, by name, exactly as the server returns
+// it. Unlike GetSchema, it keeps AUTO_INCREMENT and the database name
+// in views. If tables is empty, it returns all tables.
+func (mysqld *Mysqld) GetCreateStatements(ctx context.Context, dbName string, tables, excludeTables []string, includeViews bool) (map[string]string, error) {
+	sql := "SELECT table_name, table_type FROM information_schema.tables WHERE table_schema = " + encodeString(dbName)
+	if !includeViews {
+		sql += " AND table_type = '" + tmutils.TableBaseTable + "'"
+	}
+	qr, err := mysqld.FetchSuperQuery(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use FilterTables on the bare list, to match tables the same
+	// way GetSchema does.
+	sd := &tabletmanagerdatapb.SchemaDefinition{}
+	for _, row := range qr.Rows {
+		sd.TableDefinitions = append(sd.TableDefinitions, &tabletmanagerdatapb.TableDefinition{
+			Name: row[0].String(),
+			Type: row[1].String(),
+		})
+	}
+	sd, err = tmutils.FilterTables(sd, tables, excludeTables, includeViews)
+	if err != nil {
+		return nil, err
+	}
+
+	backtickDBName := sqlparser.Backtick(dbName)
+	statements := make(map[string]string, len(sd.TableDefinitions))
+	for _, td := range sd.TableDefinitions {
+		qr, err := mysqld.FetchSuperQuery(ctx, fmt.Sprintf("SHOW CREATE TABLE %s.%s", backtickDBName, sqlparser.Backtick(td.Name)))
+		if err != nil {
+			return nil, err
+		}
+		if len(qr.Rows) == 0 {
+			return nil, fmt.Errorf("empty create table statement for %v", td.Name)
+		}
+		statements[td.Name] = qr.Rows[0][1].String()
+	}
+	return statements, nil
 }
 
 // ResolveTables returns a list of actual tables+views matching a list
@@ -0,0 +1,78 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqldb"
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/dbconfigs"
+	"github.com/youtube/vitess/go/vt/vttest/fakesqldb"
+)
+
+// showCreateResult returns a SHOW CREATE TABLE result for name.
+func showCreateResult(name, statement string) *sqltypes.Result {
+	return &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{
+			sqltypes.MakeString([]byte(name)),
+			sqltypes.MakeString([]byte(statement)),
+		}},
+	}
+}
+
+func TestGetCreateStatements(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	dbcfgs := dbconfigs.DBConfigs{
+		Dba: sqldb.ConnParams{Engine: db.Name},
+	}
+	mysqld := NewMysqld(NewMycnf(11111, 6802), &dbcfgs, dbconfigs.DbaConfig, false)
+	defer mysqld.Close()
+
+	t1 := "CREATE TABLE `t1` (\n  `id` bigint(20) NOT NULL AUTO_INCREMENT,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB AUTO_INCREMENT=1234 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"
+	v1 := "CREATE ALGORITHM=UNDEFINED DEFINER=`vt_dba`@`localhost` SQL SECURITY DEFINER VIEW `vt_ks`.`v1` AS select `vt_ks`.`t1`.`id` AS `id` from `vt_ks`.`t1`"
+	db.AddQuery("SELECT table_name, table_type FROM information_schema.tables WHERE table_schema = 'vt_ks'", &sqltypes.Result{
+		Rows: [][]sqltypes.Value{
+			{sqltypes.MakeString([]byte("t1")), sqltypes.MakeString([]byte("BASE TABLE"))},
+			{sqltypes.MakeString([]byte("v1")), sqltypes.MakeString([]byte("VIEW"))},
+		},
+	})
+	db.AddQuery("SELECT table_name, table_type FROM information_schema.tables WHERE table_schema = 'vt_ks' AND table_type = 'BASE TABLE'", &sqltypes.Result{
+		Rows: [][]sqltypes.Value{
+			{sqltypes.MakeString([]byte("t1")), sqltypes.MakeString([]byte("BASE TABLE"))},
+		},
+	})
+	db.AddQuery("SHOW CREATE TABLE `vt_ks`.`t1`", showCreateResult("t1", t1))
+	db.AddQuery("SHOW CREATE TABLE `vt_ks`.`v1`", showCreateResult("v1", v1))
+
+	// The statements are returned as the server prints them,
+	// AUTO_INCREMENT and charset included.
+	got, err := mysqld.GetCreateStatements(ctx, "vt_ks", nil, nil, true /*includeViews*/)
+	if err != nil {
+		t.Fatalf("GetCreateStatements failed: %v", err)
+	}
+	want := map[string]string{
+		"t1": t1,
+		"v1": v1,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetCreateStatements() = %v, want %v", got, want)
+	}
+
+	got, err = mysqld.GetCreateStatements(ctx, "vt_ks", nil, nil, false /*includeViews*/)
+	if err != nil {
+		t.Fatalf("GetCreateStatements failed: %v", err)
+	}
+	want = map[string]string{
+		"t1": t1,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetCreateStatements() without views = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"syscall"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+	"github.com/youtube/vitess/go/vt/sqlparser"
+)
+
+// testRestoreDir is the directory, in the tablet directory, where
+// TestRestoreBackup restores a backup.
+const testRestoreDir = "test_restore"
+
+// systemDatabases are not checked by TestRestoreBackup: they are
+// re-created by mysql_upgrade on a real restore anyway.
+var systemDatabases = map[string]bool{
+	"information_schema": true,
+	"mysql":              true,
+	"performance_schema": true,
+	"sys":                true,
+}
+
+// TestRestoreBackup restores the backup with the given name into a
+// scratch mysqld, next to the data of mysqld, and checks all its
+// tables. The scratch mysqld and its files are removed in any case,
+// and mysqld is not touched. It fails before restoring anything if
+// there is not enough free disk space for the backup files, or if the
+// backup is too old to record their size.
+func TestRestoreBackup(
+	ctx context.Context,
+	mysqld MysqlDaemon,
+	dir, name string,
+	restoreConcurrency int,
+	hookExtraEnv map[string]string,
+	logger logutil.Logger) error {
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+
+	bh, bm, err := findBackup(ctx, bs, dir, name)
+	if err != nil {
+		return err
+	}
+	if bm.BaseBackup != "" {
+		return fmt.Errorf("backup %v is incremental, and cannot be restored on its own", name)
+	}
+	logger.Infof("TestRestore: found backup %v %v to restore with %v files", bh.Directory(), bh.Name(), len(bm.FileEntries))
+
+	// Older backups don't record the size of their files, and the
+	// size of the stored files is the compressed one.
+	size := bm.DataSize()
+	if size == 0 && len(bm.FileEntries) > 0 {
+		return fmt.Errorf("backup %v doesn't record the size of its files, cannot check there is enough disk space to restore it", name)
+	}
+	tabletDir := path.Dir(mysqld.Cnf().DataDir)
+	_, free, err := DiskSpace(tabletDir)
+	if err != nil {
+		return fmt.Errorf("can't get free disk space for %v: %v", tabletDir, err)
+	}
+	if size > free {
+		return fmt.Errorf("not enough disk space in %v to restore backup %v: need %v bytes, have %v", tabletDir, name, size, free)
+	}
+
+	scratchDir := path.Join(tabletDir, testRestoreDir)
+	if err := os.RemoveAll(scratchDir); err != nil {
+		return fmt.Errorf("can't remove old scratch directory %v: %v", scratchDir, err)
+	}
+	defer func() {
+		if err := os.RemoveAll(scratchDir); err != nil {
+			logger.Warningf("TestRestore: can't remove scratch directory %v: %v", scratchDir, err)
+		}
+	}()
+
+	logger.Infof("TestRestore: creating scratch mysqld in %v", scratchDir)
+	scratch, err := mysqld.NewScratch(scratchDir)
+	if err != nil {
+		return fmt.Errorf("can't create scratch mysqld: %v", err)
+	}
+	defer scratch.Close()
+
+	logger.Infof("TestRestore: copying all files")
+	if err := restoreFiles(ctx, scratch.Cnf(), bh, bm.FileEntries, bm.TransformHook, !bm.SkipCompress, restoreConcurrency, hookExtraEnv); err != nil {
+		return err
+	}
+
+	// Same as a real restore, the grant tables may be from an older
+	// version. Nobody else should connect to the scratch mysqld.
+	logger.Infof("TestRestore: starting scratch mysqld")
+	if err := scratch.Start(ctx, "--skip-grant-tables", "--skip-networking"); err != nil {
+		return fmt.Errorf("can't start scratch mysqld: %v", err)
+	}
+	defer func() {
+		if err := scratch.Shutdown(context.Background(), true); err != nil {
+			logger.Warningf("TestRestore: can't shut down scratch mysqld: %v", err)
+		}
+	}()
+
+	logger.Infof("TestRestore: checking tables")
+	tables, err := checkAllTables(ctx, scratch)
+	if err != nil {
+		return err
+	}
+	logger.Infof("TestRestore: backup %v restored and checked %v tables", name, tables)
+	return nil
+}
+
+// checkAllTables runs CHECK TABLE on all the tables of the
+// non-system databases of mysqld, and returns how many it checked.
+func checkAllTables(ctx context.Context, mysqld MysqlDaemon) (int, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SHOW DATABASES")
+	if err != nil {
+		return 0, fmt.Errorf("can't list databases: %v", err)
+	}
+	count := 0
+	for _, row := range qr.Rows {
+		dbName := row[0].String()
+		if systemDatabases[dbName] {
+			continue
+		}
+		tableQr, err := mysqld.FetchSuperQuery(ctx, "SHOW TABLES FROM "+sqlparser.Backtick(dbName))
+		if err != nil {
+			return count, fmt.Errorf("can't list tables of %v: %v", dbName, err)
+		}
+		for _, tableRow := range tableQr.Rows {
+			tableName := tableRow[0].String()
+			checkQr, err := mysqld.FetchSuperQuery(ctx, fmt.Sprintf("CHECK TABLE %v.%v", sqlparser.Backtick(dbName), sqlparser.Backtick(tableName)))
+			if err != nil {
+				return count, fmt.Errorf("can't check table %v.%v: %v", dbName, tableName, err)
+			}
+			// The result has Table, Op, Msg_type and Msg_text
+			// columns, and ends with a status row.
+			for _, r := range checkQr.Rows {
+				if len(r) != 4 {
+					return count, fmt.Errorf("unexpected CHECK TABLE result for %v.%v: %v", dbName, tableName, r)
+				}
+				msgType, msgText := r[2].String(), r[3].String()
+				if msgType == "error" || (msgType == "status" && msgText != "OK") {
+					return count, fmt.Errorf("table %v.%v is corrupt: %v", dbName, tableName, msgText)
+				}
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+// DiskSpace returns the size of the file system of dir, and the disk
+// space available on it to an unprivileged user.
+func DiskSpace(dir string) (total, free int64, err error) {
+	var fs syscall.Statfs_t
+	if err := syscall.Statfs(dir, &fs); err != nil {
+		return 0, 0, err
+	}
+	return int64(fs.Blocks) * int64(fs.Bsize), int64(fs.Bavail) * int64(fs.Bsize), nil
+}
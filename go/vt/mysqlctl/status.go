@@ -20,6 +20,22 @@ type Status struct {
 	MasterHost          string
 	MasterPort          int
 	MasterConnectRetry  int
+
+	// MasterUser is the user the slave connects to the master as.
+	MasterUser string
+
+	// LastIOErrno and LastSQLErrno are the codes of the last errors
+	// of the IO and SQL threads, 0 if there were none.
+	LastIOErrno  int
+	LastSQLErrno int
+
+	// MasterSSLAllowed is set if the slave connects to its master
+	// with SSL. MasterSSLCA, MasterSSLCert and MasterSSLKey are the
+	// files it uses for it.
+	MasterSSLAllowed bool
+	MasterSSLCA      string
+	MasterSSLCert    string
+	MasterSSLKey     string
 }
 
 // SlaveRunning returns true iff both the Slave IO and Slave SQL threads are
@@ -0,0 +1,53 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmutils
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/youtube/vitess/go/vt/concurrency"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// DiffCharsetConfigs records the differences between two charset
+// configurations. Only the databases both sides have are compared:
+// a missing database is a schema difference, not a charset one.
+func DiffCharsetConfigs(leftName string, left *tabletmanagerdatapb.CharsetConfig, rightName string, right *tabletmanagerdatapb.CharsetConfig, er concurrency.ErrorRecorder) {
+	if left.CharacterSetServer != right.CharacterSetServer {
+		er.RecordError(fmt.Errorf("%v and %v disagree on character_set_server: %v differs from %v", leftName, rightName, left.CharacterSetServer, right.CharacterSetServer))
+	}
+	if left.CollationServer != right.CollationServer {
+		er.RecordError(fmt.Errorf("%v and %v disagree on collation_server: %v differs from %v", leftName, rightName, left.CollationServer, right.CollationServer))
+	}
+
+	var dbs []string
+	for db := range left.DatabaseCharsets {
+		if _, ok := right.DatabaseCharsets[db]; ok {
+			dbs = append(dbs, db)
+		}
+	}
+	sort.Strings(dbs)
+	for _, db := range dbs {
+		if l, r := left.DatabaseCharsets[db], right.DatabaseCharsets[db]; l != r {
+			er.RecordError(fmt.Errorf("%v and %v disagree on the character set of database %v: %v differs from %v", leftName, rightName, db, l, r))
+		}
+		if l, r := left.DatabaseCollations[db], right.DatabaseCollations[db]; l != r {
+			er.RecordError(fmt.Errorf("%v and %v disagree on the collation of database %v: %v differs from %v", leftName, rightName, db, l, r))
+		}
+	}
+}
+
+// DiffCharsetConfigsToArray diffs two charset configurations, and
+// returns the differences.
+func DiffCharsetConfigsToArray(leftName string, left *tabletmanagerdatapb.CharsetConfig, rightName string, right *tabletmanagerdatapb.CharsetConfig) (result []string) {
+	er := concurrency.AllErrorRecorder{}
+	DiffCharsetConfigs(leftName, left, rightName, right, &er)
+	if er.HasErrors() {
+		return er.ErrorStrings()
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmutils
+
+import (
+	"reflect"
+	"testing"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestCharsetConfigsDiff(t *testing.T) {
+	config := func(server, vtKs string) *tabletmanagerdatapb.CharsetConfig {
+		return &tabletmanagerdatapb.CharsetConfig{
+			CharacterSetServer: server,
+			CollationServer:    server + "_general_ci",
+			DatabaseCharsets: map[string]string{
+				"vt_ks": vtKs,
+				"_vt":   "utf8",
+			},
+			DatabaseCollations: map[string]string{
+				"vt_ks": vtKs + "_general_ci",
+				"_vt":   "utf8_general_ci",
+			},
+		}
+	}
+
+	if got := DiffCharsetConfigsToArray("master", config("utf8mb4", "utf8mb4"), "replica", config("utf8mb4", "utf8mb4")); got != nil {
+		t.Errorf("DiffCharsetConfigsToArray() on identical configs = %v, want nil", got)
+	}
+
+	got := DiffCharsetConfigsToArray("master", config("utf8mb4", "utf8mb4"), "replica", config("latin1", "utf8"))
+	want := []string{
+		"master and replica disagree on character_set_server: utf8mb4 differs from latin1",
+		"master and replica disagree on collation_server: utf8mb4_general_ci differs from latin1_general_ci",
+		"master and replica disagree on the character set of database vt_ks: utf8mb4 differs from utf8",
+		"master and replica disagree on the collation of database vt_ks: utf8mb4_general_ci differs from utf8_general_ci",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffCharsetConfigsToArray() = %v, want %v", got, want)
+	}
+
+	// A database only one side has is not a charset difference.
+	extra := config("utf8mb4", "utf8mb4")
+	extra.DatabaseCharsets["other"] = "latin1"
+	extra.DatabaseCollations["other"] = "latin1_swedish_ci"
+	if got := DiffCharsetConfigsToArray("master", config("utf8mb4", "utf8mb4"), "replica", extra); got != nil {
+		t.Errorf("DiffCharsetConfigsToArray() with an extra database = %v, want nil", got)
+	}
+}
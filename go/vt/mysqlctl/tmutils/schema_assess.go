@@ -0,0 +1,203 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmutils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// This file contains the code to guess how MySQL executes an ALTER
+// TABLE statement, following the InnoDB online DDL rules.
+
+// The algorithms an ALTER TABLE can use, from least to most disruptive.
+const (
+	// AlterAlgorithmInstant only changes the table metadata.
+	AlterAlgorithmInstant = "INSTANT"
+	// AlterAlgorithmInplace changes the table in place, possibly
+	// rebuilding it, while allowing concurrent writes.
+	AlterAlgorithmInplace = "INPLACE"
+	// AlterAlgorithmCopy copies the table, blocking writes meanwhile.
+	AlterAlgorithmCopy = "COPY"
+)
+
+var alterAlgorithmRank = map[string]int{
+	AlterAlgorithmInstant: 0,
+	AlterAlgorithmInplace: 1,
+	AlterAlgorithmCopy:    2,
+}
+
+var alterTableStatement = regexp.MustCompile("(?is)^\\s*ALTER\\s+TABLE\\s+(?:(?:`[^`]+`|[\\w$]+)\\.)?(`[^`]+`|[\\w$]+)\\s+(.*?)[\\s;]*$")
+
+var integerDisplayWidth = regexp.MustCompile(`^(tinyint|smallint|mediumint|int|bigint)\(\d+\)`)
+
+// ParseAlterTable splits an ALTER TABLE statement into the name of the
+// table, without database or quotes, and its clauses.
+func ParseAlterTable(change string) (string, []string, error) {
+	match := alterTableStatement.FindStringSubmatch(change)
+	if match == nil {
+		return "", nil, fmt.Errorf("not an ALTER TABLE statement: %q", change)
+	}
+	table := strings.Trim(match[1], "`")
+
+	// Split the clauses on the commas that are not in parentheses
+	// or quotes.
+	var clauses []string
+	var quote rune
+	depth := 0
+	start := 0
+	spec := match[2]
+	for i, c := range spec {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			clauses = append(clauses, strings.TrimSpace(spec[start:i]))
+			start = i + 1
+		}
+	}
+	clauses = append(clauses, strings.TrimSpace(spec[start:]))
+	return table, clauses, nil
+}
+
+// AlterAlgorithm returns the least disruptive algorithm MySQL can use
+// to apply the ALTER TABLE clauses to the table, whose current schema
+// is the output of SHOW CREATE TABLE. Clauses it doesn't know are
+// assumed to need a copy.
+func AlterAlgorithm(clauses []string, createTable string) (string, error) {
+	ts, err := parseTableSchema(createTable)
+	if err != nil {
+		return "", err
+	}
+
+	normalized := make([]string, len(clauses))
+	addsPrimaryKey := false
+	for i, clause := range clauses {
+		normalized[i] = strings.ToLower(strings.Join(strings.Fields(clause), " "))
+		if strings.HasPrefix(normalized[i], "add primary key") {
+			addsPrimaryKey = true
+		}
+	}
+
+	algorithm := AlterAlgorithmInstant
+	for _, clause := range normalized {
+		a := clauseAlgorithm(clause, ts, addsPrimaryKey)
+		if alterAlgorithmRank[a] > alterAlgorithmRank[algorithm] {
+			algorithm = a
+		}
+	}
+	return algorithm, nil
+}
+
+// clauseAlgorithm returns the algorithm needed by one lower case
+// ALTER TABLE clause.
+func clauseAlgorithm(clause string, ts *tableSchema, addsPrimaryKey bool) string {
+	hasPrefix := func(prefixes ...string) bool {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(clause, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case hasPrefix("algorithm"):
+		if strings.HasSuffix(clause, "copy") {
+			return AlterAlgorithmCopy
+		}
+		return AlterAlgorithmInstant
+	case hasPrefix("lock"):
+		return AlterAlgorithmInstant
+	case hasPrefix("alter "):
+		// Setting or dropping a column default.
+		return AlterAlgorithmInstant
+	case hasPrefix("rename "):
+		// Renaming an index or the table.
+		return AlterAlgorithmInstant
+	case hasPrefix("drop index ", "drop key ", "drop foreign key "):
+		return AlterAlgorithmInstant
+	case hasPrefix("comment"):
+		return AlterAlgorithmInstant
+	case hasPrefix("drop primary key"):
+		// Without a new primary key, InnoDB copies the table to
+		// its hidden clustered index.
+		if addsPrimaryKey {
+			return AlterAlgorithmInplace
+		}
+		return AlterAlgorithmCopy
+	case hasPrefix("add "), hasPrefix("drop "):
+		// Adding or dropping a column or an index.
+		return AlterAlgorithmInplace
+	case hasPrefix("modify ", "change "):
+		if columnTypeChanged(clause, ts) {
+			return AlterAlgorithmCopy
+		}
+		return AlterAlgorithmInplace
+	case hasPrefix("engine", "row_format", "key_block_size", "force", "auto_increment", "default charset", "default character set", "character set", "charset"):
+		return AlterAlgorithmInplace
+	}
+	return AlterAlgorithmCopy
+}
+
+// columnTypeChanged returns whether a MODIFY or CHANGE clause changes
+// the type of the column. Renames and nullability changes don't.
+func columnTypeChanged(clause string, ts *tableSchema) bool {
+	fields := strings.Fields(clause)
+	isChange := fields[0] == "change"
+	fields = fields[1:]
+	if len(fields) > 0 && fields[0] == "column" {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return true
+	}
+	name := strings.Trim(fields[0], "`")
+	fields = fields[1:]
+	if isChange {
+		// Skip the new name.
+		if len(fields) == 0 {
+			return true
+		}
+		fields = fields[1:]
+	}
+
+	var currentDef string
+	for column, def := range ts.columnDefs {
+		if strings.ToLower(column) == name {
+			currentDef = strings.ToLower(strings.TrimPrefix(def, "`"+column+"` "))
+			break
+		}
+	}
+	if currentDef == "" {
+		return true
+	}
+	return columnType(fields) != columnType(strings.Fields(currentDef))
+}
+
+// columnType returns the type of a column from the lower case fields
+// of its definition. Integer display widths don't matter.
+func columnType(fields []string) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	t := integerDisplayWidth.ReplaceAllString(fields[0], "$1")
+	if t == "integer" {
+		t = "int"
+	}
+	if len(fields) > 1 && fields[1] == "unsigned" {
+		t += " unsigned"
+	}
+	return t
+}
@@ -0,0 +1,72 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAlterTable(t *testing.T) {
+	table, clauses, err := ParseAlterTable("ALTER TABLE `vt_ks`.`t1` ADD COLUMN c varchar(10) DEFAULT 'a,b', ADD INDEX c_idx (c, id);")
+	if err != nil {
+		t.Fatalf("ParseAlterTable failed: %v", err)
+	}
+	if table != "t1" {
+		t.Errorf("ParseAlterTable returned table %v, want t1", table)
+	}
+	want := []string{
+		"ADD COLUMN c varchar(10) DEFAULT 'a,b'",
+		"ADD INDEX c_idx (c, id)",
+	}
+	if !reflect.DeepEqual(clauses, want) {
+		t.Errorf("ParseAlterTable returned clauses %q, want %q", clauses, want)
+	}
+
+	if _, _, err := ParseAlterTable("CREATE TABLE t1 (id bigint)"); err == nil {
+		t.Errorf("ParseAlterTable of a CREATE TABLE should have failed")
+	}
+}
+
+func TestAlterAlgorithm(t *testing.T) {
+	createTable := "CREATE TABLE `t1` (\n" +
+		"  `id` bigint(20) unsigned NOT NULL,\n" +
+		"  `name` varchar(64) DEFAULT NULL,\n" +
+		"  `count` int(11) NOT NULL DEFAULT '0',\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  KEY `name_idx` (`name`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8"
+
+	table := []struct {
+		change string
+		want   string
+	}{
+		{"ALTER TABLE t1 ALTER COLUMN count SET DEFAULT 1", AlterAlgorithmInstant},
+		{"ALTER TABLE t1 RENAME INDEX name_idx TO name_index, DROP INDEX name_idx", AlterAlgorithmInstant},
+		{"ALTER TABLE t1 ADD COLUMN msg varchar(10)", AlterAlgorithmInplace},
+		{"ALTER TABLE t1 ADD INDEX count_idx (count), ALGORITHM=INPLACE, LOCK=NONE", AlterAlgorithmInplace},
+		{"ALTER TABLE t1 MODIFY count INT NULL", AlterAlgorithmInplace},
+		{"ALTER TABLE t1 CHANGE `name` `label` varchar(64)", AlterAlgorithmInplace},
+		{"ALTER TABLE t1 DROP PRIMARY KEY, ADD PRIMARY KEY (id, name)", AlterAlgorithmInplace},
+		{"ALTER TABLE t1 MODIFY count bigint NOT NULL", AlterAlgorithmCopy},
+		{"ALTER TABLE t1 ADD COLUMN msg varchar(10), CHANGE name name text", AlterAlgorithmCopy},
+		{"ALTER TABLE t1 DROP PRIMARY KEY", AlterAlgorithmCopy},
+		{"ALTER TABLE t1 CONVERT TO CHARACTER SET utf8mb4", AlterAlgorithmCopy},
+		{"ALTER TABLE t1 ADD COLUMN msg varchar(10), ALGORITHM=COPY", AlterAlgorithmCopy},
+	}
+	for _, tcase := range table {
+		_, clauses, err := ParseAlterTable(tcase.change)
+		if err != nil {
+			t.Fatalf("ParseAlterTable(%v) failed: %v", tcase.change, err)
+		}
+		got, err := AlterAlgorithm(clauses, createTable)
+		if err != nil {
+			t.Fatalf("AlterAlgorithm(%v) failed: %v", tcase.change, err)
+		}
+		if got != tcase.want {
+			t.Errorf("AlterAlgorithm(%v) = %v, want %v", tcase.change, got, tcase.want)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmutils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/youtube/vitess/go/vt/sqlparser"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// This file contains the code to compute the DDL statements that turn
+// one SchemaDefinition into another.
+
+var autoIncrementOption = regexp.MustCompile(`\s*AUTO_INCREMENT=\d+`)
+
+// tableSchema is the parsed form of a CREATE TABLE statement, as
+// returned by SHOW CREATE TABLE.
+type tableSchema struct {
+	// columns has the column names, in order, and columnDefs their
+	// definitions, by name.
+	columns    []string
+	columnDefs map[string]string
+	// keys has the index and constraint names, in order, and keyDefs
+	// their definitions, by name. The primary key is named PRIMARY.
+	keys    []string
+	keyDefs map[string]string
+	// options has the table options, without AUTO_INCREMENT.
+	options string
+}
+
+// backquotedName returns the first `name` in s, with its doubled
+// backquotes unescaped.
+func backquotedName(s string) (string, error) {
+	start := strings.Index(s, "`")
+	if start == -1 {
+		return "", fmt.Errorf("no name in %q", s)
+	}
+	var name []byte
+	for i := start + 1; i < len(s); i++ {
+		if s[i] != '`' {
+			name = append(name, s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == '`' {
+			name = append(name, '`')
+			i++
+			continue
+		}
+		return string(name), nil
+	}
+	return "", fmt.Errorf("no name in %q", s)
+}
+
+// parseTableSchema parses the output of SHOW CREATE TABLE. It relies
+// on its format: one column or key definition per line, and the table
+// options on the last line.
+func parseTableSchema(schema string) (*tableSchema, error) {
+	lines := strings.Split(strings.TrimSpace(schema), "\n")
+	if len(lines) < 3 || !strings.HasPrefix(lines[0], "CREATE TABLE ") || !strings.HasPrefix(lines[len(lines)-1], ")") {
+		return nil, fmt.Errorf("cannot parse table schema: %q", schema)
+	}
+	ts := &tableSchema{
+		columnDefs: make(map[string]string),
+		keyDefs:    make(map[string]string),
+		options:    strings.TrimSpace(autoIncrementOption.ReplaceAllString(strings.TrimPrefix(lines[len(lines)-1], ")"), "")),
+	}
+	for _, line := range lines[1 : len(lines)-1] {
+		def := strings.TrimSuffix(strings.TrimSpace(line), ",")
+		switch {
+		case strings.HasPrefix(def, "`"):
+			name, err := backquotedName(def)
+			if err != nil {
+				return nil, err
+			}
+			ts.columns = append(ts.columns, name)
+			ts.columnDefs[name] = def
+		case strings.HasPrefix(def, "PRIMARY KEY "):
+			ts.keys = append(ts.keys, "PRIMARY")
+			ts.keyDefs["PRIMARY"] = def
+		case strings.HasPrefix(def, "KEY "), strings.HasPrefix(def, "UNIQUE KEY "), strings.HasPrefix(def, "FULLTEXT KEY "), strings.HasPrefix(def, "SPATIAL KEY "), strings.HasPrefix(def, "CONSTRAINT "):
+			name, err := backquotedName(def)
+			if err != nil {
+				return nil, err
+			}
+			ts.keys = append(ts.keys, name)
+			ts.keyDefs[name] = def
+		default:
+			return nil, fmt.Errorf("cannot parse table schema line: %q", line)
+		}
+	}
+	return ts, nil
+}
+
+// dropKeySQL returns the ALTER TABLE clause that drops a key.
+func dropKeySQL(name, def string) string {
+	switch {
+	case name == "PRIMARY" && strings.HasPrefix(def, "PRIMARY KEY "):
+		return "DROP PRIMARY KEY"
+	case strings.HasPrefix(def, "CONSTRAINT "):
+		return "DROP FOREIGN KEY " + sqlparser.Backtick(name)
+	}
+	return "DROP INDEX " + sqlparser.Backtick(name)
+}
+
+// alterTableSQL returns the ALTER TABLE statement that turns the
+// table from its current schema into the desired one, or "" if they
+// are equivalent. A column that is dropped while another one with the
+// same definition is added may have been renamed, so that is an error
+// rather than a guess.
+func alterTableSQL(name, current, desired string) (string, error) {
+	from, err := parseTableSchema(current)
+	if err != nil {
+		return "", fmt.Errorf("table %v: %v", name, err)
+	}
+	to, err := parseTableSchema(desired)
+	if err != nil {
+		return "", fmt.Errorf("table %v: %v", name, err)
+	}
+
+	var specs []string
+
+	// Drop the keys first, so their columns can be dropped too.
+	for _, key := range from.keys {
+		if def, ok := to.keyDefs[key]; !ok || def != from.keyDefs[key] {
+			specs = append(specs, dropKeySQL(key, from.keyDefs[key]))
+		}
+	}
+
+	// Columns with the same definition but another name may be renames.
+	dropped := make(map[string]string)
+	for _, column := range from.columns {
+		if _, ok := to.columnDefs[column]; !ok {
+			dropped[strings.TrimPrefix(from.columnDefs[column], sqlparser.Backtick(column)+" ")] = column
+			specs = append(specs, "DROP COLUMN "+sqlparser.Backtick(column))
+		}
+	}
+	var ambiguous []string
+	for i, column := range to.columns {
+		def := to.columnDefs[column]
+		currentDef, ok := from.columnDefs[column]
+		if ok {
+			if def != currentDef {
+				specs = append(specs, "MODIFY COLUMN "+def)
+			}
+			continue
+		}
+		if old, ok := dropped[strings.TrimPrefix(def, sqlparser.Backtick(column)+" ")]; ok {
+			ambiguous = append(ambiguous, fmt.Sprintf("%v to %v", old, column))
+		}
+		if i == 0 {
+			specs = append(specs, "ADD COLUMN "+def+" FIRST")
+		} else {
+			specs = append(specs, fmt.Sprintf("ADD COLUMN %v AFTER %v", def, sqlparser.Backtick(to.columns[i-1])))
+		}
+	}
+	if len(ambiguous) > 0 {
+		return "", fmt.Errorf("table %v: columns may have been renamed (%v), the change must be made by hand", name, strings.Join(ambiguous, ", "))
+	}
+
+	for _, key := range to.keys {
+		if def, ok := from.keyDefs[key]; !ok || def != to.keyDefs[key] {
+			specs = append(specs, "ADD "+to.keyDefs[key])
+		}
+	}
+	if from.options != to.options {
+		specs = append(specs, to.options)
+	}
+
+	if len(specs) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("ALTER TABLE %v %v", sqlparser.Backtick(name), strings.Join(specs, ", ")), nil
+}
+
+// DiffSchemaToSQL returns the DDL statements that turn the current
+// schema into the desired one, in the order they should be run: views
+// that change are dropped first, then tables are created, altered and
+// dropped, and views are created last, as they depend on the tables.
+// The database creation command is not compared.
+func DiffSchemaToSQL(current, desired *tabletmanagerdatapb.SchemaDefinition) ([]string, error) {
+	currentTables := make(map[string]*tabletmanagerdatapb.TableDefinition)
+	for _, td := range current.TableDefinitions {
+		currentTables[td.Name] = td
+	}
+	desiredTables := make(map[string]*tabletmanagerdatapb.TableDefinition)
+	for _, td := range desired.TableDefinitions {
+		desiredTables[td.Name] = td
+	}
+
+	var dropViews, createTables, alterTables, dropTables, createViews []string
+	for _, td := range sortedTableDefinitions(current) {
+		want, ok := desiredTables[td.Name]
+		if ok && want.Type == td.Type && want.Schema == td.Schema {
+			continue
+		}
+		if ok && want.Type == TableBaseTable && td.Type == TableBaseTable {
+			// Altered below, in the desired order.
+			continue
+		}
+		if td.Type == TableView {
+			dropViews = append(dropViews, "DROP VIEW "+sqlparser.Backtick(td.Name))
+		} else {
+			dropTables = append(dropTables, "DROP TABLE "+sqlparser.Backtick(td.Name))
+		}
+	}
+	for _, td := range sortedTableDefinitions(desired) {
+		have, ok := currentTables[td.Name]
+		if ok && have.Type == td.Type && have.Schema == td.Schema {
+			continue
+		}
+		if td.Type == TableView {
+			createViews = append(createViews, td.Schema)
+			continue
+		}
+		if ok && have.Type == TableBaseTable {
+			sql, err := alterTableSQL(td.Name, have.Schema, td.Schema)
+			if err != nil {
+				return nil, err
+			}
+			if sql != "" {
+				alterTables = append(alterTables, sql)
+			}
+			continue
+		}
+		createTables = append(createTables, td.Schema)
+	}
+
+	var result []string
+	for _, statements := range [][]string{dropViews, createTables, alterTables, dropTables, createViews} {
+		result = append(result, statements...)
+	}
+	return result, nil
+}
+
+// tableDefinitionsByName sorts TableDefinitions by name.
+type tableDefinitionsByName struct {
+	TableDefinitions
+}
+
+// Less is part of the sort.Interface interface.
+func (tds tableDefinitionsByName) Less(i, j int) bool {
+	return tds.TableDefinitions[i].Name < tds.TableDefinitions[j].Name
+}
+
+// sortedTableDefinitions returns the table definitions of sd, sorted by
+// name.
+func sortedTableDefinitions(sd *tabletmanagerdatapb.SchemaDefinition) []*tabletmanagerdatapb.TableDefinition {
+	tds := make(TableDefinitions, len(sd.TableDefinitions))
+	copy(tds, sd.TableDefinitions)
+	sort.Sort(tableDefinitionsByName{tds})
+	return tds
+}
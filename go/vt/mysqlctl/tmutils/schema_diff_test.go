@@ -0,0 +1,181 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmutils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func baseTable(name, schema string) *tabletmanagerdatapb.TableDefinition {
+	return &tabletmanagerdatapb.TableDefinition{
+		Name:   name,
+		Schema: schema,
+		Type:   TableBaseTable,
+	}
+}
+
+func viewTable(name, schema string) *tabletmanagerdatapb.TableDefinition {
+	return &tabletmanagerdatapb.TableDefinition{
+		Name:   name,
+		Schema: schema,
+		Type:   TableView,
+	}
+}
+
+const schemaDiffT1 = "CREATE TABLE `t1` (\n" +
+	"  `id` bigint(20) NOT NULL,\n" +
+	"  `msg` varchar(64) DEFAULT NULL,\n" +
+	"  `ts` timestamp NULL DEFAULT NULL,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  KEY `idx_ts` (`ts`)\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8"
+
+func TestDiffSchemaToSQL(t *testing.T) {
+	current := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			baseTable("t1", schemaDiffT1),
+			baseTable("t2", "CREATE TABLE `t2` (\n  `id` bigint(20) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB"),
+			baseTable("t3", "CREATE TABLE `t3` (\n  `id` bigint(20) NOT NULL\n) ENGINE=InnoDB"),
+			viewTable("v1", "CREATE VIEW `v1` AS select `id` from `t1`"),
+		},
+	}
+
+	testcases := []struct {
+		desc    string
+		desired []*tabletmanagerdatapb.TableDefinition
+		want    []string
+	}{{
+		desc:    "same schema",
+		desired: current.TableDefinitions,
+	}, {
+		desc: "added table",
+		desired: append([]*tabletmanagerdatapb.TableDefinition{
+			baseTable("t0", "CREATE TABLE `t0` (\n  `id` bigint(20) NOT NULL\n) ENGINE=InnoDB"),
+		}, current.TableDefinitions...),
+		want: []string{
+			"CREATE TABLE `t0` (\n  `id` bigint(20) NOT NULL\n) ENGINE=InnoDB",
+		},
+	}, {
+		desc: "dropped table",
+		desired: []*tabletmanagerdatapb.TableDefinition{
+			current.TableDefinitions[0],
+			current.TableDefinitions[1],
+			current.TableDefinitions[3],
+		},
+		want: []string{
+			"DROP TABLE `t3`",
+		},
+	}, {
+		desc: "modified table",
+		desired: []*tabletmanagerdatapb.TableDefinition{
+			baseTable("t1", "CREATE TABLE `t1` (\n"+
+				"  `id` bigint(20) NOT NULL,\n"+
+				"  `msg` varchar(128) DEFAULT NULL,\n"+
+				"  `user_id` bigint(20) NOT NULL,\n"+
+				"  PRIMARY KEY (`id`),\n"+
+				"  KEY `idx_user_id` (`user_id`)\n"+
+				") ENGINE=InnoDB AUTO_INCREMENT=42 DEFAULT CHARSET=utf8"),
+			current.TableDefinitions[1],
+			current.TableDefinitions[2],
+			current.TableDefinitions[3],
+		},
+		want: []string{
+			"ALTER TABLE `t1` DROP INDEX `idx_ts`, DROP COLUMN `ts`, MODIFY COLUMN `msg` varchar(128) DEFAULT NULL, ADD COLUMN `user_id` bigint(20) NOT NULL AFTER `msg`, ADD KEY `idx_user_id` (`user_id`)",
+		},
+	}, {
+		desc: "modified table options and primary key",
+		desired: []*tabletmanagerdatapb.TableDefinition{
+			current.TableDefinitions[0],
+			baseTable("t2", "CREATE TABLE `t2` (\n  `id` bigint(20) NOT NULL,\n  PRIMARY KEY (`id`) USING HASH\n) ENGINE=MyISAM"),
+			current.TableDefinitions[2],
+			current.TableDefinitions[3],
+		},
+		want: []string{
+			"ALTER TABLE `t2` DROP PRIMARY KEY, ADD PRIMARY KEY (`id`) USING HASH, ENGINE=MyISAM",
+		},
+	}, {
+		desc: "modified view and table replaced by a view",
+		desired: []*tabletmanagerdatapb.TableDefinition{
+			current.TableDefinitions[0],
+			current.TableDefinitions[1],
+			viewTable("t3", "CREATE VIEW `t3` AS select `id` from `t2`"),
+			viewTable("v1", "CREATE VIEW `v1` AS select `id`, `msg` from `t1`"),
+		},
+		want: []string{
+			"DROP VIEW `v1`",
+			"DROP TABLE `t3`",
+			"CREATE VIEW `t3` AS select `id` from `t2`",
+			"CREATE VIEW `v1` AS select `id`, `msg` from `t1`",
+		},
+	}}
+	for _, tc := range testcases {
+		got, err := DiffSchemaToSQL(current, &tabletmanagerdatapb.SchemaDefinition{
+			TableDefinitions: tc.desired,
+		})
+		if err != nil {
+			t.Errorf("%v: DiffSchemaToSQL failed: %v", tc.desc, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%v: DiffSchemaToSQL() =\n%q\nwant:\n%q", tc.desc, got, tc.want)
+		}
+	}
+}
+
+func TestDiffSchemaToSQLAmbiguousRename(t *testing.T) {
+	current := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			baseTable("t1", schemaDiffT1),
+		},
+	}
+	desired := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			baseTable("t1", strings.Replace(schemaDiffT1, "`msg`", "`message`", 1)),
+		},
+	}
+	_, err := DiffSchemaToSQL(current, desired)
+	if err == nil || !strings.Contains(err.Error(), "msg to message") {
+		t.Errorf("DiffSchemaToSQL with a renamed column returned %v, want an ambiguous rename error", err)
+	}
+}
+
+func TestDiffSchemaToSQLQuotedNames(t *testing.T) {
+	// SHOW CREATE TABLE doubles the backquotes in names, and so must
+	// the statements that use them.
+	current := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			baseTable("t`1", "CREATE TABLE `t``1` (\n"+
+				"  `id` bigint(20) NOT NULL,\n"+
+				"  `a``b` int(11) DEFAULT NULL,\n"+
+				"  KEY `k``1` (`a``b`)\n"+
+				") ENGINE=InnoDB"),
+			baseTable("t`2", "CREATE TABLE `t``2` (\n  `id` bigint(20) NOT NULL\n) ENGINE=InnoDB"),
+		},
+	}
+	desired := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			baseTable("t`1", "CREATE TABLE `t``1` (\n"+
+				"  `id` bigint(20) NOT NULL,\n"+
+				"  `x``y` bigint(20) NOT NULL,\n"+
+				"  `z` int(11) DEFAULT NULL\n"+
+				") ENGINE=InnoDB"),
+		},
+	}
+	got, err := DiffSchemaToSQL(current, desired)
+	if err != nil {
+		t.Fatalf("DiffSchemaToSQL failed: %v", err)
+	}
+	want := []string{
+		"ALTER TABLE `t``1` DROP INDEX `k``1`, DROP COLUMN `a``b`, ADD COLUMN `x``y` bigint(20) NOT NULL AFTER `id`, ADD COLUMN `z` int(11) DEFAULT NULL AFTER `x``y`",
+		"DROP TABLE `t``2`",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffSchemaToSQL() =\n%q\nwant:\n%q", got, want)
+	}
+}
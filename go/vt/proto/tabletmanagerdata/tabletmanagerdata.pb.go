@@ -22,50 +22,202 @@ It has these top-level messages:
 	SleepResponse
 	ExecuteHookRequest
 	ExecuteHookResponse
+	ExecuteHookToStreamRequest
+	ExecuteHookToStreamResponse
 	GetSchemaRequest
 	GetSchemaResponse
+	GetCreateStatementsRequest
+	GetCreateStatementsResponse
+	GetSchemaTimestampsRequest
+	GetSchemaTimestampsResponse
 	GetPermissionsRequest
 	GetPermissionsResponse
+	ConnectionStats
+	GetConnectionStatsRequest
+	GetConnectionStatsResponse
+	GetConnectionRejectionStatsRequest
+	GetConnectionRejectionStatsResponse
+	GetConfigRequest
+	GetConfigResponse
+	GetInFlightRPCsRequest
+	GetInFlightRPCsResponse
+	ProcessStats
+	GetProcessStatsRequest
+	GetProcessStatsResponse
+	HealthScore
+	GetHealthScoreRequest
+	GetHealthScoreResponse
+	GetErrorLogTailRequest
+	GetErrorLogTailResponse
+	CheckDataDirRequest
+	CheckDataDirResponse
+	DiagnosticBundle
+	CaptureDiagnosticsRequest
+	CaptureDiagnosticsResponse
+	ThrottleState
+	GetThrottleStateRequest
+	GetThrottleStateResponse
+	GetTabletTagsRequest
+	GetTabletTagsResponse
+	SetThrottleRequest
+	SetThrottleResponse
 	SetReadOnlyRequest
 	SetReadOnlyResponse
 	SetReadWriteRequest
 	SetReadWriteResponse
+	SetReadOnlyWithTTLRequest
+	SetReadOnlyWithTTLResponse
+	SetSuperReadOnlyRequest
+	SetSuperReadOnlyResponse
+	QueryServerConfig
+	SetQueryServerConfigRequest
+	SetQueryServerConfigResponse
+	GetQueryBlacklistRequest
+	GetQueryBlacklistResponse
+	SetQueryBlacklistRequest
+	SetQueryBlacklistResponse
 	ChangeTypeRequest
 	ChangeTypeResponse
+	SetTabletTagsRequest
+	SetTabletTagsResponse
 	RefreshStateRequest
 	RefreshStateResponse
+	RepublishTopoRecordRequest
+	RepublishTopoRecordResponse
 	RunHealthCheckRequest
 	RunHealthCheckResponse
 	IgnoreHealthErrorRequest
 	IgnoreHealthErrorResponse
+	SetMaintenanceModeRequest
+	SetMaintenanceModeResponse
+	PauseHealthReportingRequest
+	PauseHealthReportingResponse
+	PrepareCutoverRequest
+	PrepareCutoverResponse
+	CommitCutoverRequest
+	CommitCutoverResponse
+	AbortCutoverRequest
+	AbortCutoverResponse
+	SetServingKeyRangeRequest
+	SetServingKeyRangeResponse
+	RestartMysqlRequest
+	RestartMysqlResponse
+	DecommissionRequest
+	DecommissionResponse
+	CheckTopoConnectivityRequest
+	CheckTopoConnectivityResponse
+	WarmUpRequest
+	WarmUpResponse
 	ReloadSchemaRequest
 	ReloadSchemaResponse
 	PreflightSchemaRequest
 	PreflightSchemaResponse
 	ApplySchemaRequest
 	ApplySchemaResponse
+	SchemaDiffRequest
+	SchemaDiffResponse
+	SchemaChangeAssessment
+	AssessSchemaChangeRequest
+	AssessSchemaChangeResponse
+	SchemaChangeEvent
+	WatchSchemaRequest
+	WatchSchemaResponse
+	GetVSchemaRequest
+	GetVSchemaResponse
+	ApplyVSchemaRequest
+	ApplyVSchemaResponse
 	ExecuteFetchAsDbaRequest
 	ExecuteFetchAsDbaResponse
+	ExecuteFetchAsDbaCSVRequest
+	ExecuteFetchAsDbaCSVResponse
+	ColumnarResult
+	ExecuteFetchColumnarRequest
+	ExecuteFetchColumnarResponse
 	ExecuteFetchAsAllPrivsRequest
 	ExecuteFetchAsAllPrivsResponse
 	ExecuteFetchAsAppRequest
 	ExecuteFetchAsAppResponse
+	ExplainQueryRequest
+	ExplainQueryResponse
+	ApplyGrantsRequest
+	ApplyGrantsResponse
+	ChecksumTableRequest
+	ChecksumTableResponse
+	TruncateTableRequest
+	TruncateTableResponse
+	RenameTableRequest
+	RenameTableResult
+	RenameTableResponse
+	StreamRowsInKeyRangeRequest
+	StreamRowsInKeyRangeResponse
+	StreamKeyRangeBinlogRequest
+	StreamKeyRangeBinlogResponse
+	CloneStreamRequest
+	CloneStreamResponse
+	Process
+	GetProcessListRequest
+	GetProcessListResponse
+	CharsetConfig
+	GetCharsetConfigRequest
+	GetCharsetConfigResponse
+	KillProcessRequest
+	KillProcessResponse
+	TailGeneralLogRequest
+	TailGeneralLogResponse
+	QPSSample
+	StreamQPSRequest
+	StreamQPSResponse
 	SlaveStatusRequest
 	SlaveStatusResponse
+	SlaveStatusAllChannelsRequest
+	SlaveStatusAllChannelsResponse
+	ReplicationSource
+	GetReplicationSourceRequest
+	GetReplicationSourceResponse
+	ValidateReplicationCredentialsRequest
+	ValidateReplicationCredentialsResponse
+	ApplierWorkerStats
+	ApplierQueueStats
+	GetApplierQueueStatsRequest
+	GetApplierQueueStatsResponse
 	MasterPositionRequest
 	MasterPositionResponse
+	GetGtidPurgedRequest
+	GetGtidPurgedResponse
+	GetBinlogStatsRequest
+	GetBinlogStatsResponse
+	ReplicationErrorStats
+	GetReplicationErrorStatsRequest
+	GetReplicationErrorStatsResponse
 	StopSlaveRequest
 	StopSlaveResponse
 	StopSlaveMinimumRequest
 	StopSlaveMinimumResponse
+	StopSlaveMinimumStreamRequest
+	StopSlaveMinimumStreamResponse
+	BoostReplicationCatchupRequest
+	BoostReplicationCatchupResponse
 	StartSlaveRequest
 	StartSlaveResponse
+	EnableParallelReplicationRequest
+	EnableParallelReplicationResponse
+	RotateReplicationCredentialsRequest
+	RotateReplicationCredentialsResponse
+	ReplicationSSLOptions
+	ConfigureReplicationSSLRequest
+	ConfigureReplicationSSLResponse
+	RepairRelayLogRequest
+	RepairRelayLogResponse
 	TabletExternallyReparentedRequest
 	TabletExternallyReparentedResponse
 	TabletExternallyElectedRequest
 	TabletExternallyElectedResponse
 	GetSlavesRequest
 	GetSlavesResponse
+	ReplicationNeighbor
+	ReplicationGraph
+	GetReplicationGraphRequest
+	GetReplicationGraphResponse
 	WaitBlpPositionRequest
 	WaitBlpPositionResponse
 	StopBlpRequest
@@ -74,12 +226,18 @@ It has these top-level messages:
 	StartBlpResponse
 	RunBlpUntilRequest
 	RunBlpUntilResponse
+	BinlogFilter
+	GetBinlogFiltersRequest
+	GetBinlogFiltersResponse
 	ResetReplicationRequest
 	ResetReplicationResponse
 	InitMasterRequest
 	InitMasterResponse
 	PopulateReparentJournalRequest
 	PopulateReparentJournalResponse
+	ReparentJournalEntry
+	GetLastReparentJournalEntryRequest
+	GetLastReparentJournalEntryResponse
 	InitSlaveRequest
 	InitSlaveResponse
 	DemoteMasterRequest
@@ -90,26 +248,65 @@ It has these top-level messages:
 	SlaveWasPromotedResponse
 	SetMasterRequest
 	SetMasterResponse
+	ConfigureReplicationRequest
+	ConfigureReplicationResponse
 	SlaveWasRestartedRequest
 	SlaveWasRestartedResponse
 	StopReplicationAndGetStatusRequest
 	StopReplicationAndGetStatusResponse
 	PromoteSlaveRequest
 	PromoteSlaveResponse
+	SetReparentEligibilityRequest
+	SetReparentEligibilityResponse
+	CheckReparentCandidateRequest
+	CheckReparentCandidateResponse
+	SetSemiSyncAckCountRequest
+	SetSemiSyncAckCountResponse
+	DurabilityPolicy
+	GetDurabilityPolicyRequest
+	GetDurabilityPolicyResponse
+	GetBackupLimitsRequest
+	GetBackupLimitsResponse
+	GetBackupPositionRequest
+	GetBackupPositionResponse
 	BackupRequest
 	BackupResponse
+	IncrementalBackupRequest
+	IncrementalBackupResponse
 	RestoreFromBackupRequest
 	RestoreFromBackupResponse
+	RestoreToTimestampRequest
+	RestoreToTimestampResponse
+	SetPreferredBackupRequest
+	SetPreferredBackupResponse
+	GetPreferredBackupRequest
+	GetPreferredBackupResponse
+	CompatCheck
+	CompatReport
+	CheckRestoreCompatibilityRequest
+	CheckRestoreCompatibilityResponse
+	BackupInfo
+	GetLastBackupInfoRequest
+	GetLastBackupInfoResponse
+	GetBackupFreshnessRequest
+	GetBackupFreshnessResponse
+	ReadinessReport
+	CheckBackupReadinessRequest
+	CheckBackupReadinessResponse
+	TestRestoreRequest
+	TestRestoreResponse
 */
 package tabletmanagerdata
 
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import binlogdata "github.com/youtube/vitess/go/vt/proto/binlogdata"
 import query "github.com/youtube/vitess/go/vt/proto/query"
 import topodata "github.com/youtube/vitess/go/vt/proto/topodata"
 import replicationdata "github.com/youtube/vitess/go/vt/proto/replicationdata"
 import logutil "github.com/youtube/vitess/go/vt/proto/logutil"
+import vschema "github.com/youtube/vitess/go/vt/proto/vschema"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -333,25 +530,65 @@ func (m *ExecuteHookResponse) String() string            { return proto.CompactT
 func (*ExecuteHookResponse) ProtoMessage()               {}
 func (*ExecuteHookResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
+type ExecuteHookToStreamRequest struct {
+	Name       string            `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Parameters []string          `protobuf:"bytes,2,rep,name=parameters" json:"parameters,omitempty"`
+	ExtraEnv   map[string]string `protobuf:"bytes,3,rep,name=extra_env,json=extraEnv" json:"extra_env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *ExecuteHookToStreamRequest) Reset()                    { *m = ExecuteHookToStreamRequest{} }
+func (m *ExecuteHookToStreamRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExecuteHookToStreamRequest) ProtoMessage()               {}
+func (*ExecuteHookToStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *ExecuteHookToStreamRequest) GetExtraEnv() map[string]string {
+	if m != nil {
+		return m.ExtraEnv
+	}
+	return nil
+}
+
+type ExecuteHookToStreamResponse struct {
+	// stdout is the next chunk of the hook's stdout.
+	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	// done is only set on the last response, which carries the exit
+	// status and the stderr of the hook instead of stdout.
+	Done       bool   `protobuf:"varint,2,opt,name=done" json:"done,omitempty"`
+	ExitStatus int64  `protobuf:"varint,3,opt,name=exit_status,json=exitStatus" json:"exit_status,omitempty"`
+	Stderr     string `protobuf:"bytes,4,opt,name=stderr" json:"stderr,omitempty"`
+}
+
+func (m *ExecuteHookToStreamResponse) Reset()                    { *m = ExecuteHookToStreamResponse{} }
+func (m *ExecuteHookToStreamResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExecuteHookToStreamResponse) ProtoMessage()               {}
+func (*ExecuteHookToStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
 type GetSchemaRequest struct {
 	Tables        []string `protobuf:"bytes,1,rep,name=tables" json:"tables,omitempty"`
 	IncludeViews  bool     `protobuf:"varint,2,opt,name=include_views,json=includeViews" json:"include_views,omitempty"`
 	ExcludeTables []string `protobuf:"bytes,3,rep,name=exclude_tables,json=excludeTables" json:"exclude_tables,omitempty"`
+	// best_effort_timeout_ns, if set, bounds the time spent gathering
+	// the schema. When it expires, the tables gathered so far are
+	// returned with incomplete set, instead of an error.
+	BestEffortTimeoutNs int64 `protobuf:"varint,4,opt,name=best_effort_timeout_ns,json=bestEffortTimeoutNs" json:"best_effort_timeout_ns,omitempty"`
 }
 
 func (m *GetSchemaRequest) Reset()                    { *m = GetSchemaRequest{} }
 func (m *GetSchemaRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetSchemaRequest) ProtoMessage()               {}
-func (*GetSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 type GetSchemaResponse struct {
 	SchemaDefinition *SchemaDefinition `protobuf:"bytes,1,opt,name=schema_definition,json=schemaDefinition" json:"schema_definition,omitempty"`
+	// incomplete is set if best_effort_timeout_ns expired before all
+	// tables were gathered.
+	Incomplete bool `protobuf:"varint,2,opt,name=incomplete" json:"incomplete,omitempty"`
 }
 
 func (m *GetSchemaResponse) Reset()                    { *m = GetSchemaResponse{} }
 func (m *GetSchemaResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetSchemaResponse) ProtoMessage()               {}
-func (*GetSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *GetSchemaResponse) GetSchemaDefinition() *SchemaDefinition {
 	if m != nil {
@@ -360,13 +597,69 @@ func (m *GetSchemaResponse) GetSchemaDefinition() *SchemaDefinition {
 	return nil
 }
 
+type GetCreateStatementsRequest struct {
+	Tables        []string `protobuf:"bytes,1,rep,name=tables" json:"tables,omitempty"`
+	IncludeViews  bool     `protobuf:"varint,2,opt,name=include_views,json=includeViews" json:"include_views,omitempty"`
+	ExcludeTables []string `protobuf:"bytes,3,rep,name=exclude_tables,json=excludeTables" json:"exclude_tables,omitempty"`
+}
+
+func (m *GetCreateStatementsRequest) Reset()                    { *m = GetCreateStatementsRequest{} }
+func (m *GetCreateStatementsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetCreateStatementsRequest) ProtoMessage()               {}
+func (*GetCreateStatementsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+type GetCreateStatementsResponse struct {
+	// create_statements maps each table or view name to the output of
+	// SHOW CREATE TABLE, unmodified.
+	CreateStatements map[string]string `protobuf:"bytes,1,rep,name=create_statements,json=createStatements" json:"create_statements,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *GetCreateStatementsResponse) Reset()                    { *m = GetCreateStatementsResponse{} }
+func (m *GetCreateStatementsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetCreateStatementsResponse) ProtoMessage()               {}
+func (*GetCreateStatementsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *GetCreateStatementsResponse) GetCreateStatements() map[string]string {
+	if m != nil {
+		return m.CreateStatements
+	}
+	return nil
+}
+
+type GetSchemaTimestampsRequest struct {
+	Tables []string `protobuf:"bytes,1,rep,name=tables" json:"tables,omitempty"`
+}
+
+func (m *GetSchemaTimestampsRequest) Reset()                    { *m = GetSchemaTimestampsRequest{} }
+func (m *GetSchemaTimestampsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetSchemaTimestampsRequest) ProtoMessage()               {}
+func (*GetSchemaTimestampsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+type GetSchemaTimestampsResponse struct {
+	// timestamps maps each table name to the time it was created or last
+	// rebuilt by an ALTER TABLE, in seconds since the epoch.
+	Timestamps map[string]int64 `protobuf:"bytes,1,rep,name=timestamps" json:"timestamps,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+}
+
+func (m *GetSchemaTimestampsResponse) Reset()                    { *m = GetSchemaTimestampsResponse{} }
+func (m *GetSchemaTimestampsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetSchemaTimestampsResponse) ProtoMessage()               {}
+func (*GetSchemaTimestampsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *GetSchemaTimestampsResponse) GetTimestamps() map[string]int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
 type GetPermissionsRequest struct {
 }
 
 func (m *GetPermissionsRequest) Reset()                    { *m = GetPermissionsRequest{} }
 func (m *GetPermissionsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetPermissionsRequest) ProtoMessage()               {}
-func (*GetPermissionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*GetPermissionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 type GetPermissionsResponse struct {
 	Permissions *Permissions `protobuf:"bytes,1,opt,name=permissions" json:"permissions,omitempty"`
@@ -375,7 +668,7 @@ type GetPermissionsResponse struct {
 func (m *GetPermissionsResponse) Reset()                    { *m = GetPermissionsResponse{} }
 func (m *GetPermissionsResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetPermissionsResponse) ProtoMessage()               {}
-func (*GetPermissionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*GetPermissionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *GetPermissionsResponse) GetPermissions() *Permissions {
 	if m != nil {
@@ -384,341 +677,2081 @@ func (m *GetPermissionsResponse) GetPermissions() *Permissions {
 	return nil
 }
 
-type SetReadOnlyRequest struct {
+// ConnectionStats describes how busy the query service of a tablet is.
+type ConnectionStats struct {
+	// open_connections is the number of connections currently in use
+	// across the query service pools.
+	OpenConnections int64 `protobuf:"varint,1,opt,name=open_connections,json=openConnections" json:"open_connections,omitempty"`
+	// active_transactions is the number of open transactions.
+	ActiveTransactions int64 `protobuf:"varint,2,opt,name=active_transactions,json=activeTransactions" json:"active_transactions,omitempty"`
+	// pool_capacity is the total capacity of the query service pools.
+	PoolCapacity int64 `protobuf:"varint,3,opt,name=pool_capacity,json=poolCapacity" json:"pool_capacity,omitempty"`
 }
 
-func (m *SetReadOnlyRequest) Reset()                    { *m = SetReadOnlyRequest{} }
-func (m *SetReadOnlyRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetReadOnlyRequest) ProtoMessage()               {}
-func (*SetReadOnlyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (m *ConnectionStats) Reset()                    { *m = ConnectionStats{} }
+func (m *ConnectionStats) String() string            { return proto.CompactTextString(m) }
+func (*ConnectionStats) ProtoMessage()               {}
+func (*ConnectionStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
-type SetReadOnlyResponse struct {
+type GetConnectionStatsRequest struct {
 }
 
-func (m *SetReadOnlyResponse) Reset()                    { *m = SetReadOnlyResponse{} }
-func (m *SetReadOnlyResponse) String() string            { return proto.CompactTextString(m) }
-func (*SetReadOnlyResponse) ProtoMessage()               {}
-func (*SetReadOnlyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (m *GetConnectionStatsRequest) Reset()                    { *m = GetConnectionStatsRequest{} }
+func (m *GetConnectionStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetConnectionStatsRequest) ProtoMessage()               {}
+func (*GetConnectionStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
-type SetReadWriteRequest struct {
+type GetConnectionStatsResponse struct {
+	ConnectionStats *ConnectionStats `protobuf:"bytes,1,opt,name=connection_stats,json=connectionStats" json:"connection_stats,omitempty"`
 }
 
-func (m *SetReadWriteRequest) Reset()                    { *m = SetReadWriteRequest{} }
-func (m *SetReadWriteRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetReadWriteRequest) ProtoMessage()               {}
-func (*SetReadWriteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (m *GetConnectionStatsResponse) Reset()                    { *m = GetConnectionStatsResponse{} }
+func (m *GetConnectionStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetConnectionStatsResponse) ProtoMessage()               {}
+func (*GetConnectionStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
-type SetReadWriteResponse struct {
+func (m *GetConnectionStatsResponse) GetConnectionStats() *ConnectionStats {
+	if m != nil {
+		return m.ConnectionStats
+	}
+	return nil
 }
 
-func (m *SetReadWriteResponse) Reset()                    { *m = SetReadWriteResponse{} }
-func (m *SetReadWriteResponse) String() string            { return proto.CompactTextString(m) }
-func (*SetReadWriteResponse) ProtoMessage()               {}
-func (*SetReadWriteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
-
-type ChangeTypeRequest struct {
-	TabletType topodata.TabletType `protobuf:"varint,1,opt,name=tablet_type,json=tabletType,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+type GetConnectionRejectionStatsRequest struct {
+	// reset_counts clears the counts once they are read.
+	ResetCounts bool `protobuf:"varint,1,opt,name=reset_counts,json=resetCounts" json:"reset_counts,omitempty"`
 }
 
-func (m *ChangeTypeRequest) Reset()                    { *m = ChangeTypeRequest{} }
-func (m *ChangeTypeRequest) String() string            { return proto.CompactTextString(m) }
-func (*ChangeTypeRequest) ProtoMessage()               {}
-func (*ChangeTypeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (m *GetConnectionRejectionStatsRequest) Reset()                    { *m = GetConnectionRejectionStatsRequest{} }
+func (m *GetConnectionRejectionStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetConnectionRejectionStatsRequest) ProtoMessage()               {}
+func (*GetConnectionRejectionStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
-type ChangeTypeResponse struct {
+type GetConnectionRejectionStatsResponse struct {
+	// rejections maps each reason a connection request was rejected for,
+	// like PoolExhausted or MaxConnections, to its count since the last
+	// reset.
+	Rejections map[string]int64 `protobuf:"bytes,1,rep,name=rejections" json:"rejections,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 }
 
-func (m *ChangeTypeResponse) Reset()                    { *m = ChangeTypeResponse{} }
-func (m *ChangeTypeResponse) String() string            { return proto.CompactTextString(m) }
-func (*ChangeTypeResponse) ProtoMessage()               {}
-func (*ChangeTypeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (m *GetConnectionRejectionStatsResponse) Reset()                    { *m = GetConnectionRejectionStatsResponse{} }
+func (m *GetConnectionRejectionStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetConnectionRejectionStatsResponse) ProtoMessage()               {}
+func (*GetConnectionRejectionStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
-type RefreshStateRequest struct {
+func (m *GetConnectionRejectionStatsResponse) GetRejections() map[string]int64 {
+	if m != nil {
+		return m.Rejections
+	}
+	return nil
 }
 
-func (m *RefreshStateRequest) Reset()                    { *m = RefreshStateRequest{} }
-func (m *RefreshStateRequest) String() string            { return proto.CompactTextString(m) }
-func (*RefreshStateRequest) ProtoMessage()               {}
-func (*RefreshStateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
-
-type RefreshStateResponse struct {
+type GetConfigRequest struct {
 }
 
-func (m *RefreshStateResponse) Reset()                    { *m = RefreshStateResponse{} }
-func (m *RefreshStateResponse) String() string            { return proto.CompactTextString(m) }
-func (*RefreshStateResponse) ProtoMessage()               {}
-func (*RefreshStateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (m *GetConfigRequest) Reset()                    { *m = GetConfigRequest{} }
+func (m *GetConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetConfigRequest) ProtoMessage()               {}
+func (*GetConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
-type RunHealthCheckRequest struct {
+type GetConfigResponse struct {
+	// flags maps each command line flag name to its current value.
+	Flags map[string]string `protobuf:"bytes,1,rep,name=flags" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
-func (m *RunHealthCheckRequest) Reset()                    { *m = RunHealthCheckRequest{} }
-func (m *RunHealthCheckRequest) String() string            { return proto.CompactTextString(m) }
-func (*RunHealthCheckRequest) ProtoMessage()               {}
-func (*RunHealthCheckRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (m *GetConfigResponse) Reset()                    { *m = GetConfigResponse{} }
+func (m *GetConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetConfigResponse) ProtoMessage()               {}
+func (*GetConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
-type RunHealthCheckResponse struct {
+func (m *GetConfigResponse) GetFlags() map[string]string {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
 }
 
-func (m *RunHealthCheckResponse) Reset()                    { *m = RunHealthCheckResponse{} }
-func (m *RunHealthCheckResponse) String() string            { return proto.CompactTextString(m) }
-func (*RunHealthCheckResponse) ProtoMessage()               {}
-func (*RunHealthCheckResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
-
-type IgnoreHealthErrorRequest struct {
-	Pattern string `protobuf:"bytes,1,opt,name=pattern" json:"pattern,omitempty"`
+type GetInFlightRPCsRequest struct {
 }
 
-func (m *IgnoreHealthErrorRequest) Reset()                    { *m = IgnoreHealthErrorRequest{} }
-func (m *IgnoreHealthErrorRequest) String() string            { return proto.CompactTextString(m) }
-func (*IgnoreHealthErrorRequest) ProtoMessage()               {}
-func (*IgnoreHealthErrorRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (m *GetInFlightRPCsRequest) Reset()                    { *m = GetInFlightRPCsRequest{} }
+func (m *GetInFlightRPCsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetInFlightRPCsRequest) ProtoMessage()               {}
+func (*GetInFlightRPCsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
-type IgnoreHealthErrorResponse struct {
+type GetInFlightRPCsResponse struct {
+	// in_flight maps each method name to the number of its RPCs being
+	// processed. Methods with no RPC in flight are omitted.
+	InFlight map[string]int64 `protobuf:"bytes,1,rep,name=in_flight,json=inFlight" json:"in_flight,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 }
 
-func (m *IgnoreHealthErrorResponse) Reset()                    { *m = IgnoreHealthErrorResponse{} }
-func (m *IgnoreHealthErrorResponse) String() string            { return proto.CompactTextString(m) }
-func (*IgnoreHealthErrorResponse) ProtoMessage()               {}
-func (*IgnoreHealthErrorResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (m *GetInFlightRPCsResponse) Reset()                    { *m = GetInFlightRPCsResponse{} }
+func (m *GetInFlightRPCsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetInFlightRPCsResponse) ProtoMessage()               {}
+func (*GetInFlightRPCsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
-type ReloadSchemaRequest struct {
-	// wait_position allows scheduling a schema reload to occur after a
-	// given DDL has replicated to this slave, by specifying a replication
-	// position to wait for. Leave empty to trigger the reload immediately.
-	WaitPosition string `protobuf:"bytes,1,opt,name=wait_position,json=waitPosition" json:"wait_position,omitempty"`
+func (m *GetInFlightRPCsResponse) GetInFlight() map[string]int64 {
+	if m != nil {
+		return m.InFlight
+	}
+	return nil
 }
 
-func (m *ReloadSchemaRequest) Reset()                    { *m = ReloadSchemaRequest{} }
-func (m *ReloadSchemaRequest) String() string            { return proto.CompactTextString(m) }
-func (*ReloadSchemaRequest) ProtoMessage()               {}
-func (*ReloadSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
-
-type ReloadSchemaResponse struct {
+// ProcessStats are the resource usage metrics of the tablet manager
+// process.
+type ProcessStats struct {
+	Goroutines int64 `protobuf:"varint,1,opt,name=goroutines" json:"goroutines,omitempty"`
+	// threads is the number of OS threads the process created.
+	Threads int64 `protobuf:"varint,2,opt,name=threads" json:"threads,omitempty"`
+	// open_files is the number of open file descriptors, or -1 if the
+	// OS does not expose them.
+	OpenFiles int64 `protobuf:"varint,3,opt,name=open_files,json=openFiles" json:"open_files,omitempty"`
+	// heap_alloc_bytes is the heap memory in use.
+	HeapAllocBytes uint64 `protobuf:"varint,4,opt,name=heap_alloc_bytes,json=heapAllocBytes" json:"heap_alloc_bytes,omitempty"`
+	// sys_bytes is the memory obtained from the OS.
+	SysBytes uint64 `protobuf:"varint,5,opt,name=sys_bytes,json=sysBytes" json:"sys_bytes,omitempty"`
 }
 
-func (m *ReloadSchemaResponse) Reset()                    { *m = ReloadSchemaResponse{} }
-func (m *ReloadSchemaResponse) String() string            { return proto.CompactTextString(m) }
-func (*ReloadSchemaResponse) ProtoMessage()               {}
-func (*ReloadSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (m *ProcessStats) Reset()                    { *m = ProcessStats{} }
+func (m *ProcessStats) String() string            { return proto.CompactTextString(m) }
+func (*ProcessStats) ProtoMessage()               {}
+func (*ProcessStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
-type PreflightSchemaRequest struct {
-	Changes []string `protobuf:"bytes,1,rep,name=changes" json:"changes,omitempty"`
+type GetProcessStatsRequest struct {
 }
 
-func (m *PreflightSchemaRequest) Reset()                    { *m = PreflightSchemaRequest{} }
-func (m *PreflightSchemaRequest) String() string            { return proto.CompactTextString(m) }
-func (*PreflightSchemaRequest) ProtoMessage()               {}
-func (*PreflightSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (m *GetProcessStatsRequest) Reset()                    { *m = GetProcessStatsRequest{} }
+func (m *GetProcessStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetProcessStatsRequest) ProtoMessage()               {}
+func (*GetProcessStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
-type PreflightSchemaResponse struct {
-	// change_results has for each change the schema before and after it.
-	// The number of elements is identical to the length of "changes" in the request.
-	ChangeResults []*SchemaChangeResult `protobuf:"bytes,1,rep,name=change_results,json=changeResults" json:"change_results,omitempty"`
+type GetProcessStatsResponse struct {
+	Stats *ProcessStats `protobuf:"bytes,1,opt,name=stats" json:"stats,omitempty"`
 }
 
-func (m *PreflightSchemaResponse) Reset()                    { *m = PreflightSchemaResponse{} }
-func (m *PreflightSchemaResponse) String() string            { return proto.CompactTextString(m) }
-func (*PreflightSchemaResponse) ProtoMessage()               {}
-func (*PreflightSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (m *GetProcessStatsResponse) Reset()                    { *m = GetProcessStatsResponse{} }
+func (m *GetProcessStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetProcessStatsResponse) ProtoMessage()               {}
+func (*GetProcessStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
-func (m *PreflightSchemaResponse) GetChangeResults() []*SchemaChangeResult {
+func (m *GetProcessStatsResponse) GetStats() *ProcessStats {
 	if m != nil {
-		return m.ChangeResults
+		return m.Stats
 	}
 	return nil
 }
 
-type ApplySchemaRequest struct {
-	Sql              string            `protobuf:"bytes,1,opt,name=sql" json:"sql,omitempty"`
-	Force            bool              `protobuf:"varint,2,opt,name=force" json:"force,omitempty"`
-	AllowReplication bool              `protobuf:"varint,3,opt,name=allow_replication,json=allowReplication" json:"allow_replication,omitempty"`
-	BeforeSchema     *SchemaDefinition `protobuf:"bytes,4,opt,name=before_schema,json=beforeSchema" json:"before_schema,omitempty"`
-	AfterSchema      *SchemaDefinition `protobuf:"bytes,5,opt,name=after_schema,json=afterSchema" json:"after_schema,omitempty"`
+// HealthScore is a composite health score of a tablet, with the
+// factors it is computed from. Each factor is between 0 (worst) and
+// 1 (best).
+type HealthScore struct {
+	// score is between 0 (unusable) and 100 (perfect health).
+	Score int32 `protobuf:"varint,1,opt,name=score" json:"score,omitempty"`
+	// replication_lag_factor is 1 - replication lag / unhealthy
+	// threshold.
+	ReplicationLagFactor float64 `protobuf:"fixed64,2,opt,name=replication_lag_factor,json=replicationLagFactor" json:"replication_lag_factor,omitempty"`
+	// error_rate_factor is 1 - the ratio of queries that failed since
+	// the tablet started.
+	ErrorRateFactor float64 `protobuf:"fixed64,3,opt,name=error_rate_factor,json=errorRateFactor" json:"error_rate_factor,omitempty"`
+	// load_factor is 1 - the ratio of the connection pools in use.
+	LoadFactor float64 `protobuf:"fixed64,4,opt,name=load_factor,json=loadFactor" json:"load_factor,omitempty"`
+	// health_error is the error of the last health check. If set, the
+	// score is 0.
+	HealthError string `protobuf:"bytes,5,opt,name=health_error,json=healthError" json:"health_error,omitempty"`
 }
 
-func (m *ApplySchemaRequest) Reset()                    { *m = ApplySchemaRequest{} }
-func (m *ApplySchemaRequest) String() string            { return proto.CompactTextString(m) }
-func (*ApplySchemaRequest) ProtoMessage()               {}
-func (*ApplySchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (m *HealthScore) Reset()                    { *m = HealthScore{} }
+func (m *HealthScore) String() string            { return proto.CompactTextString(m) }
+func (*HealthScore) ProtoMessage()               {}
+func (*HealthScore) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
-func (m *ApplySchemaRequest) GetBeforeSchema() *SchemaDefinition {
-	if m != nil {
-		return m.BeforeSchema
-	}
-	return nil
+type GetHealthScoreRequest struct {
 }
 
-func (m *ApplySchemaRequest) GetAfterSchema() *SchemaDefinition {
+func (m *GetHealthScoreRequest) Reset()                    { *m = GetHealthScoreRequest{} }
+func (m *GetHealthScoreRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetHealthScoreRequest) ProtoMessage()               {}
+func (*GetHealthScoreRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+type GetHealthScoreResponse struct {
+	Score *HealthScore `protobuf:"bytes,1,opt,name=score" json:"score,omitempty"`
+}
+
+func (m *GetHealthScoreResponse) Reset()                    { *m = GetHealthScoreResponse{} }
+func (m *GetHealthScoreResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetHealthScoreResponse) ProtoMessage()               {}
+func (*GetHealthScoreResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *GetHealthScoreResponse) GetScore() *HealthScore {
 	if m != nil {
-		return m.AfterSchema
+		return m.Score
 	}
 	return nil
 }
 
-type ApplySchemaResponse struct {
-	BeforeSchema *SchemaDefinition `protobuf:"bytes,1,opt,name=before_schema,json=beforeSchema" json:"before_schema,omitempty"`
-	AfterSchema  *SchemaDefinition `protobuf:"bytes,2,opt,name=after_schema,json=afterSchema" json:"after_schema,omitempty"`
+type GetErrorLogTailRequest struct {
+	// lines is the number of lines to return, from the end of the log.
+	Lines int64 `protobuf:"varint,1,opt,name=lines" json:"lines,omitempty"`
 }
 
-func (m *ApplySchemaResponse) Reset()                    { *m = ApplySchemaResponse{} }
-func (m *ApplySchemaResponse) String() string            { return proto.CompactTextString(m) }
-func (*ApplySchemaResponse) ProtoMessage()               {}
-func (*ApplySchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (m *GetErrorLogTailRequest) Reset()                    { *m = GetErrorLogTailRequest{} }
+func (m *GetErrorLogTailRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetErrorLogTailRequest) ProtoMessage()               {}
+func (*GetErrorLogTailRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
-func (m *ApplySchemaResponse) GetBeforeSchema() *SchemaDefinition {
-	if m != nil {
-		return m.BeforeSchema
-	}
-	return nil
+type GetErrorLogTailResponse struct {
+	// lines are the last lines of the log, oldest first.
+	Lines []string `protobuf:"bytes,1,rep,name=lines" json:"lines,omitempty"`
 }
 
-func (m *ApplySchemaResponse) GetAfterSchema() *SchemaDefinition {
-	if m != nil {
-		return m.AfterSchema
-	}
-	return nil
+func (m *GetErrorLogTailResponse) Reset()                    { *m = GetErrorLogTailResponse{} }
+func (m *GetErrorLogTailResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetErrorLogTailResponse) ProtoMessage()               {}
+func (*GetErrorLogTailResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+type CheckDataDirRequest struct {
 }
 
-type ExecuteFetchAsDbaRequest struct {
-	Query          []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	DbName         string `protobuf:"bytes,2,opt,name=db_name,json=dbName" json:"db_name,omitempty"`
-	MaxRows        uint64 `protobuf:"varint,3,opt,name=max_rows,json=maxRows" json:"max_rows,omitempty"`
-	DisableBinlogs bool   `protobuf:"varint,4,opt,name=disable_binlogs,json=disableBinlogs" json:"disable_binlogs,omitempty"`
-	ReloadSchema   bool   `protobuf:"varint,5,opt,name=reload_schema,json=reloadSchema" json:"reload_schema,omitempty"`
+func (m *CheckDataDirRequest) Reset()                    { *m = CheckDataDirRequest{} }
+func (m *CheckDataDirRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckDataDirRequest) ProtoMessage()               {}
+func (*CheckDataDirRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+type CheckDataDirResponse struct {
+	// problems describe what is wrong with the directories mysqld
+	// needs. It is empty if they are fine.
+	Problems []string `protobuf:"bytes,1,rep,name=problems" json:"problems,omitempty"`
 }
 
-func (m *ExecuteFetchAsDbaRequest) Reset()                    { *m = ExecuteFetchAsDbaRequest{} }
-func (m *ExecuteFetchAsDbaRequest) String() string            { return proto.CompactTextString(m) }
-func (*ExecuteFetchAsDbaRequest) ProtoMessage()               {}
-func (*ExecuteFetchAsDbaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (m *CheckDataDirResponse) Reset()                    { *m = CheckDataDirResponse{} }
+func (m *CheckDataDirResponse) String() string            { return proto.CompactTextString(m) }
+func (*CheckDataDirResponse) ProtoMessage()               {}
+func (*CheckDataDirResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
-type ExecuteFetchAsDbaResponse struct {
-	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+// DiagnosticBundle is what an operator usually looks at first when
+// investigating an issue with a tablet, captured at once.
+type DiagnosticBundle struct {
+	// processes are the threads running in MySQL.
+	Processes []*Process `protobuf:"bytes,1,rep,name=processes" json:"processes,omitempty"`
+	// slave_status is unset if the tablet is not a slave.
+	SlaveStatus *replicationdata.Status `protobuf:"bytes,2,opt,name=slave_status,json=slaveStatus" json:"slave_status,omitempty"`
+	// error_log_tail are the last lines of the MySQL error log, oldest
+	// first.
+	ErrorLogTail []string `protobuf:"bytes,3,rep,name=error_log_tail,json=errorLogTail" json:"error_log_tail,omitempty"`
+	// variables are the values of the MySQL variables that matter most
+	// for diagnostics, by name.
+	Variables map[string]string `protobuf:"bytes,4,rep,name=variables" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// data_dir_total_bytes and data_dir_free_bytes are the size and the
+	// available space of the file system of the MySQL data directory.
+	DataDirTotalBytes int64 `protobuf:"varint,5,opt,name=data_dir_total_bytes,json=dataDirTotalBytes" json:"data_dir_total_bytes,omitempty"`
+	DataDirFreeBytes  int64 `protobuf:"varint,6,opt,name=data_dir_free_bytes,json=dataDirFreeBytes" json:"data_dir_free_bytes,omitempty"`
+	// errors describe the sections that could not be captured. The
+	// other sections are still set.
+	Errors []string `protobuf:"bytes,7,rep,name=errors" json:"errors,omitempty"`
 }
 
-func (m *ExecuteFetchAsDbaResponse) Reset()                    { *m = ExecuteFetchAsDbaResponse{} }
-func (m *ExecuteFetchAsDbaResponse) String() string            { return proto.CompactTextString(m) }
-func (*ExecuteFetchAsDbaResponse) ProtoMessage()               {}
-func (*ExecuteFetchAsDbaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (m *DiagnosticBundle) Reset()                    { *m = DiagnosticBundle{} }
+func (m *DiagnosticBundle) String() string            { return proto.CompactTextString(m) }
+func (*DiagnosticBundle) ProtoMessage()               {}
+func (*DiagnosticBundle) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
-func (m *ExecuteFetchAsDbaResponse) GetResult() *query.QueryResult {
+func (m *DiagnosticBundle) GetProcesses() []*Process {
 	if m != nil {
-		return m.Result
+		return m.Processes
 	}
 	return nil
 }
 
-type ExecuteFetchAsAllPrivsRequest struct {
-	Query        []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	DbName       string `protobuf:"bytes,2,opt,name=db_name,json=dbName" json:"db_name,omitempty"`
-	MaxRows      uint64 `protobuf:"varint,3,opt,name=max_rows,json=maxRows" json:"max_rows,omitempty"`
-	ReloadSchema bool   `protobuf:"varint,4,opt,name=reload_schema,json=reloadSchema" json:"reload_schema,omitempty"`
+func (m *DiagnosticBundle) GetSlaveStatus() *replicationdata.Status {
+	if m != nil {
+		return m.SlaveStatus
+	}
+	return nil
 }
 
-func (m *ExecuteFetchAsAllPrivsRequest) Reset()                    { *m = ExecuteFetchAsAllPrivsRequest{} }
-func (m *ExecuteFetchAsAllPrivsRequest) String() string            { return proto.CompactTextString(m) }
-func (*ExecuteFetchAsAllPrivsRequest) ProtoMessage()               {}
-func (*ExecuteFetchAsAllPrivsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (m *DiagnosticBundle) GetVariables() map[string]string {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
 
-type ExecuteFetchAsAllPrivsResponse struct {
-	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+type CaptureDiagnosticsRequest struct {
 }
 
-func (m *ExecuteFetchAsAllPrivsResponse) Reset()                    { *m = ExecuteFetchAsAllPrivsResponse{} }
-func (m *ExecuteFetchAsAllPrivsResponse) String() string            { return proto.CompactTextString(m) }
-func (*ExecuteFetchAsAllPrivsResponse) ProtoMessage()               {}
-func (*ExecuteFetchAsAllPrivsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (m *CaptureDiagnosticsRequest) Reset()                    { *m = CaptureDiagnosticsRequest{} }
+func (m *CaptureDiagnosticsRequest) String() string            { return proto.CompactTextString(m) }
+func (*CaptureDiagnosticsRequest) ProtoMessage()               {}
+func (*CaptureDiagnosticsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
-func (m *ExecuteFetchAsAllPrivsResponse) GetResult() *query.QueryResult {
+type CaptureDiagnosticsResponse struct {
+	Bundle *DiagnosticBundle `protobuf:"bytes,1,opt,name=bundle" json:"bundle,omitempty"`
+}
+
+func (m *CaptureDiagnosticsResponse) Reset()                    { *m = CaptureDiagnosticsResponse{} }
+func (m *CaptureDiagnosticsResponse) String() string            { return proto.CompactTextString(m) }
+func (*CaptureDiagnosticsResponse) ProtoMessage()               {}
+func (*CaptureDiagnosticsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *CaptureDiagnosticsResponse) GetBundle() *DiagnosticBundle {
 	if m != nil {
-		return m.Result
+		return m.Bundle
 	}
 	return nil
 }
 
-type ExecuteFetchAsAppRequest struct {
-	Query   []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	MaxRows uint64 `protobuf:"varint,2,opt,name=max_rows,json=maxRows" json:"max_rows,omitempty"`
+// ThrottleState is whether the heavy operations of a tablet,
+// IncrementalBackup and TestRestore, are throttled, and why.
+type ThrottleState struct {
+	Throttled bool `protobuf:"varint,1,opt,name=throttled" json:"throttled,omitempty"`
+	// metric is the name of the metric throttling is based on.
+	Metric string `protobuf:"bytes,2,opt,name=metric" json:"metric,omitempty"`
+	// value is the current value of the metric, and threshold the
+	// value above which operations are throttled.
+	Value     int64 `protobuf:"varint,3,opt,name=value" json:"value,omitempty"`
+	Threshold int64 `protobuf:"varint,4,opt,name=threshold" json:"threshold,omitempty"`
+	// reason explains why operations are throttled. It is empty if
+	// they are not.
+	Reason string `protobuf:"bytes,5,opt,name=reason" json:"reason,omitempty"`
+	// mode is "auto", or the mode forced by SetThrottle.
+	Mode string `protobuf:"bytes,6,opt,name=mode" json:"mode,omitempty"`
+	// mode_expire_time_ns is when a forced mode goes back to auto.
+	ModeExpireTimeNs int64 `protobuf:"varint,7,opt,name=mode_expire_time_ns,json=modeExpireTimeNs" json:"mode_expire_time_ns,omitempty"`
 }
 
-func (m *ExecuteFetchAsAppRequest) Reset()                    { *m = ExecuteFetchAsAppRequest{} }
-func (m *ExecuteFetchAsAppRequest) String() string            { return proto.CompactTextString(m) }
-func (*ExecuteFetchAsAppRequest) ProtoMessage()               {}
-func (*ExecuteFetchAsAppRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (m *ThrottleState) Reset()                    { *m = ThrottleState{} }
+func (m *ThrottleState) String() string            { return proto.CompactTextString(m) }
+func (*ThrottleState) ProtoMessage()               {}
+func (*ThrottleState) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
-type ExecuteFetchAsAppResponse struct {
-	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+type GetThrottleStateRequest struct {
 }
 
-func (m *ExecuteFetchAsAppResponse) Reset()                    { *m = ExecuteFetchAsAppResponse{} }
-func (m *ExecuteFetchAsAppResponse) String() string            { return proto.CompactTextString(m) }
-func (*ExecuteFetchAsAppResponse) ProtoMessage()               {}
-func (*ExecuteFetchAsAppResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (m *GetThrottleStateRequest) Reset()                    { *m = GetThrottleStateRequest{} }
+func (m *GetThrottleStateRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetThrottleStateRequest) ProtoMessage()               {}
+func (*GetThrottleStateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
-func (m *ExecuteFetchAsAppResponse) GetResult() *query.QueryResult {
+type GetThrottleStateResponse struct {
+	State *ThrottleState `protobuf:"bytes,1,opt,name=state" json:"state,omitempty"`
+}
+
+func (m *GetThrottleStateResponse) Reset()                    { *m = GetThrottleStateResponse{} }
+func (m *GetThrottleStateResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetThrottleStateResponse) ProtoMessage()               {}
+func (*GetThrottleStateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *GetThrottleStateResponse) GetState() *ThrottleState {
 	if m != nil {
-		return m.Result
+		return m.State
 	}
 	return nil
 }
 
-type SlaveStatusRequest struct {
+type GetTabletTagsRequest struct {
 }
 
-func (m *SlaveStatusRequest) Reset()                    { *m = SlaveStatusRequest{} }
-func (m *SlaveStatusRequest) String() string            { return proto.CompactTextString(m) }
-func (*SlaveStatusRequest) ProtoMessage()               {}
-func (*SlaveStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (m *GetTabletTagsRequest) Reset()                    { *m = GetTabletTagsRequest{} }
+func (m *GetTabletTagsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetTabletTagsRequest) ProtoMessage()               {}
+func (*GetTabletTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
-type SlaveStatusResponse struct {
-	Status *replicationdata.Status `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
+type GetTabletTagsResponse struct {
+	Tags map[string]string `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
-func (m *SlaveStatusResponse) Reset()                    { *m = SlaveStatusResponse{} }
-func (m *SlaveStatusResponse) String() string            { return proto.CompactTextString(m) }
-func (*SlaveStatusResponse) ProtoMessage()               {}
-func (*SlaveStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (m *GetTabletTagsResponse) Reset()                    { *m = GetTabletTagsResponse{} }
+func (m *GetTabletTagsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetTabletTagsResponse) ProtoMessage()               {}
+func (*GetTabletTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
-func (m *SlaveStatusResponse) GetStatus() *replicationdata.Status {
+func (m *GetTabletTagsResponse) GetTags() map[string]string {
 	if m != nil {
-		return m.Status
+		return m.Tags
 	}
 	return nil
 }
 
-type MasterPositionRequest struct {
+type SetThrottleRequest struct {
+	// mode is "force_on", "force_off" or "auto".
+	Mode string `protobuf:"bytes,1,opt,name=mode" json:"mode,omitempty"`
+	// duration_ns is how long a forced mode lasts, before going back
+	// to auto. It is required for a forced mode.
+	DurationNs int64 `protobuf:"varint,2,opt,name=duration_ns,json=durationNs" json:"duration_ns,omitempty"`
 }
 
-func (m *MasterPositionRequest) Reset()                    { *m = MasterPositionRequest{} }
-func (m *MasterPositionRequest) String() string            { return proto.CompactTextString(m) }
-func (*MasterPositionRequest) ProtoMessage()               {}
-func (*MasterPositionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (m *SetThrottleRequest) Reset()                    { *m = SetThrottleRequest{} }
+func (m *SetThrottleRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetThrottleRequest) ProtoMessage()               {}
+func (*SetThrottleRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
-type MasterPositionResponse struct {
-	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
+type SetThrottleResponse struct {
 }
 
-func (m *MasterPositionResponse) Reset()                    { *m = MasterPositionResponse{} }
-func (m *MasterPositionResponse) String() string            { return proto.CompactTextString(m) }
-func (*MasterPositionResponse) ProtoMessage()               {}
-func (*MasterPositionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (m *SetThrottleResponse) Reset()                    { *m = SetThrottleResponse{} }
+func (m *SetThrottleResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetThrottleResponse) ProtoMessage()               {}
+func (*SetThrottleResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
-type StopSlaveRequest struct {
+type SetReadOnlyRequest struct {
 }
 
-func (m *StopSlaveRequest) Reset()                    { *m = StopSlaveRequest{} }
-func (m *StopSlaveRequest) String() string            { return proto.CompactTextString(m) }
-func (*StopSlaveRequest) ProtoMessage()               {}
-func (*StopSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (m *SetReadOnlyRequest) Reset()                    { *m = SetReadOnlyRequest{} }
+func (m *SetReadOnlyRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetReadOnlyRequest) ProtoMessage()               {}
+func (*SetReadOnlyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
-type StopSlaveResponse struct {
+type SetReadOnlyResponse struct {
 }
 
-func (m *StopSlaveResponse) Reset()                    { *m = StopSlaveResponse{} }
-func (m *StopSlaveResponse) String() string            { return proto.CompactTextString(m) }
+func (m *SetReadOnlyResponse) Reset()                    { *m = SetReadOnlyResponse{} }
+func (m *SetReadOnlyResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetReadOnlyResponse) ProtoMessage()               {}
+func (*SetReadOnlyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+type SetReadWriteRequest struct {
+}
+
+func (m *SetReadWriteRequest) Reset()                    { *m = SetReadWriteRequest{} }
+func (m *SetReadWriteRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetReadWriteRequest) ProtoMessage()               {}
+func (*SetReadWriteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+type SetReadWriteResponse struct {
+}
+
+func (m *SetReadWriteResponse) Reset()                    { *m = SetReadWriteResponse{} }
+func (m *SetReadWriteResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetReadWriteResponse) ProtoMessage()               {}
+func (*SetReadWriteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+type SetReadOnlyWithTTLRequest struct {
+	// ttl_ns is how long the tablet stays read-only. 0 makes it
+	// read-write right away.
+	TtlNs int64 `protobuf:"varint,1,opt,name=ttl_ns,json=ttlNs" json:"ttl_ns,omitempty"`
+}
+
+func (m *SetReadOnlyWithTTLRequest) Reset()                    { *m = SetReadOnlyWithTTLRequest{} }
+func (m *SetReadOnlyWithTTLRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetReadOnlyWithTTLRequest) ProtoMessage()               {}
+func (*SetReadOnlyWithTTLRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+type SetReadOnlyWithTTLResponse struct {
+}
+
+func (m *SetReadOnlyWithTTLResponse) Reset()                    { *m = SetReadOnlyWithTTLResponse{} }
+func (m *SetReadOnlyWithTTLResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetReadOnlyWithTTLResponse) ProtoMessage()               {}
+func (*SetReadOnlyWithTTLResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+type SetSuperReadOnlyRequest struct {
+	On bool `protobuf:"varint,1,opt,name=on" json:"on,omitempty"`
+}
+
+func (m *SetSuperReadOnlyRequest) Reset()                    { *m = SetSuperReadOnlyRequest{} }
+func (m *SetSuperReadOnlyRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetSuperReadOnlyRequest) ProtoMessage()               {}
+func (*SetSuperReadOnlyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+type SetSuperReadOnlyResponse struct {
+	// super_read_only is the resulting value of super_read_only.
+	SuperReadOnly bool `protobuf:"varint,1,opt,name=super_read_only,json=superReadOnly" json:"super_read_only,omitempty"`
+}
+
+func (m *SetSuperReadOnlyResponse) Reset()                    { *m = SetSuperReadOnlyResponse{} }
+func (m *SetSuperReadOnlyResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetSuperReadOnlyResponse) ProtoMessage()               {}
+func (*SetSuperReadOnlyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+// QueryServerConfig are the query server settings changed by
+// SetQueryServerConfig. Zero values are left unchanged.
+type QueryServerConfig struct {
+	// pool_size, stream_pool_size and transaction_pool_size are the
+	// capacities of the connection pools.
+	PoolSize            int64 `protobuf:"varint,1,opt,name=pool_size,json=poolSize" json:"pool_size,omitempty"`
+	StreamPoolSize      int64 `protobuf:"varint,2,opt,name=stream_pool_size,json=streamPoolSize" json:"stream_pool_size,omitempty"`
+	TransactionPoolSize int64 `protobuf:"varint,3,opt,name=transaction_pool_size,json=transactionPoolSize" json:"transaction_pool_size,omitempty"`
+	// query_timeout_ns is the query timeout, in nanoseconds.
+	QueryTimeoutNs int64 `protobuf:"varint,4,opt,name=query_timeout_ns,json=queryTimeoutNs" json:"query_timeout_ns,omitempty"`
+	// disable_consolidation turns off the consolidation of identical
+	// concurrent queries.
+	DisableConsolidation bool `protobuf:"varint,5,opt,name=disable_consolidation,json=disableConsolidation" json:"disable_consolidation,omitempty"`
+}
+
+func (m *QueryServerConfig) Reset()                    { *m = QueryServerConfig{} }
+func (m *QueryServerConfig) String() string            { return proto.CompactTextString(m) }
+func (*QueryServerConfig) ProtoMessage()               {}
+func (*QueryServerConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+type SetQueryServerConfigRequest struct {
+	Config *QueryServerConfig `protobuf:"bytes,1,opt,name=config" json:"config,omitempty"`
+}
+
+func (m *SetQueryServerConfigRequest) Reset()                    { *m = SetQueryServerConfigRequest{} }
+func (m *SetQueryServerConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetQueryServerConfigRequest) ProtoMessage()               {}
+func (*SetQueryServerConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *SetQueryServerConfigRequest) GetConfig() *QueryServerConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type SetQueryServerConfigResponse struct {
+	// applied has the settings after the change.
+	Applied *QueryServerConfig `protobuf:"bytes,1,opt,name=applied" json:"applied,omitempty"`
+	// skipped has the names of the settings that cannot change at
+	// runtime, and were left unchanged.
+	Skipped []string `protobuf:"bytes,2,rep,name=skipped" json:"skipped,omitempty"`
+}
+
+func (m *SetQueryServerConfigResponse) Reset()                    { *m = SetQueryServerConfigResponse{} }
+func (m *SetQueryServerConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetQueryServerConfigResponse) ProtoMessage()               {}
+func (*SetQueryServerConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *SetQueryServerConfigResponse) GetApplied() *QueryServerConfig {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+type GetQueryBlacklistRequest struct {
+}
+
+func (m *GetQueryBlacklistRequest) Reset()                    { *m = GetQueryBlacklistRequest{} }
+func (m *GetQueryBlacklistRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetQueryBlacklistRequest) ProtoMessage()               {}
+func (*GetQueryBlacklistRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+type GetQueryBlacklistResponse struct {
+	Patterns []string `protobuf:"bytes,1,rep,name=patterns" json:"patterns,omitempty"`
+}
+
+func (m *GetQueryBlacklistResponse) Reset()                    { *m = GetQueryBlacklistResponse{} }
+func (m *GetQueryBlacklistResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetQueryBlacklistResponse) ProtoMessage()               {}
+func (*GetQueryBlacklistResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+type SetQueryBlacklistRequest struct {
+	// patterns are regular expressions, matched against the full query.
+	// An empty list clears the blacklist.
+	Patterns []string `protobuf:"bytes,1,rep,name=patterns" json:"patterns,omitempty"`
+}
+
+func (m *SetQueryBlacklistRequest) Reset()                    { *m = SetQueryBlacklistRequest{} }
+func (m *SetQueryBlacklistRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetQueryBlacklistRequest) ProtoMessage()               {}
+func (*SetQueryBlacklistRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+type SetQueryBlacklistResponse struct {
+}
+
+func (m *SetQueryBlacklistResponse) Reset()                    { *m = SetQueryBlacklistResponse{} }
+func (m *SetQueryBlacklistResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetQueryBlacklistResponse) ProtoMessage()               {}
+func (*SetQueryBlacklistResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+type ChangeTypeRequest struct {
+	TabletType topodata.TabletType `protobuf:"varint,1,opt,name=tablet_type,json=tabletType,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	// idempotent makes the call a no-op if the tablet already has
+	// tablet_type.
+	Idempotent bool `protobuf:"varint,2,opt,name=idempotent" json:"idempotent,omitempty"`
+}
+
+func (m *ChangeTypeRequest) Reset()                    { *m = ChangeTypeRequest{} }
+func (m *ChangeTypeRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChangeTypeRequest) ProtoMessage()               {}
+func (*ChangeTypeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+type ChangeTypeResponse struct {
+}
+
+func (m *ChangeTypeResponse) Reset()                    { *m = ChangeTypeResponse{} }
+func (m *ChangeTypeResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChangeTypeResponse) ProtoMessage()               {}
+func (*ChangeTypeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+type SetTabletTagsRequest struct {
+	// tags are merged into the tablet tags. A tag with an empty value
+	// is removed.
+	Tags map[string]string `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *SetTabletTagsRequest) Reset()                    { *m = SetTabletTagsRequest{} }
+func (m *SetTabletTagsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetTabletTagsRequest) ProtoMessage()               {}
+func (*SetTabletTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *SetTabletTagsRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type SetTabletTagsResponse struct {
+}
+
+func (m *SetTabletTagsResponse) Reset()                    { *m = SetTabletTagsResponse{} }
+func (m *SetTabletTagsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetTabletTagsResponse) ProtoMessage()               {}
+func (*SetTabletTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+type RefreshStateRequest struct {
+}
+
+func (m *RefreshStateRequest) Reset()                    { *m = RefreshStateRequest{} }
+func (m *RefreshStateRequest) String() string            { return proto.CompactTextString(m) }
+func (*RefreshStateRequest) ProtoMessage()               {}
+func (*RefreshStateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+type RefreshStateResponse struct {
+}
+
+func (m *RefreshStateResponse) Reset()                    { *m = RefreshStateResponse{} }
+func (m *RefreshStateResponse) String() string            { return proto.CompactTextString(m) }
+func (*RefreshStateResponse) ProtoMessage()               {}
+func (*RefreshStateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+type RepublishTopoRecordRequest struct {
+}
+
+func (m *RepublishTopoRecordRequest) Reset()                    { *m = RepublishTopoRecordRequest{} }
+func (m *RepublishTopoRecordRequest) String() string            { return proto.CompactTextString(m) }
+func (*RepublishTopoRecordRequest) ProtoMessage()               {}
+func (*RepublishTopoRecordRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+type RepublishTopoRecordResponse struct {
+	// version is the version of the tablet record that was written.
+	Version int64 `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+}
+
+func (m *RepublishTopoRecordResponse) Reset()                    { *m = RepublishTopoRecordResponse{} }
+func (m *RepublishTopoRecordResponse) String() string            { return proto.CompactTextString(m) }
+func (*RepublishTopoRecordResponse) ProtoMessage()               {}
+func (*RepublishTopoRecordResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+type RunHealthCheckRequest struct {
+}
+
+func (m *RunHealthCheckRequest) Reset()                    { *m = RunHealthCheckRequest{} }
+func (m *RunHealthCheckRequest) String() string            { return proto.CompactTextString(m) }
+func (*RunHealthCheckRequest) ProtoMessage()               {}
+func (*RunHealthCheckRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+type RunHealthCheckResponse struct {
+}
+
+func (m *RunHealthCheckResponse) Reset()                    { *m = RunHealthCheckResponse{} }
+func (m *RunHealthCheckResponse) String() string            { return proto.CompactTextString(m) }
+func (*RunHealthCheckResponse) ProtoMessage()               {}
+func (*RunHealthCheckResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+type IgnoreHealthErrorRequest struct {
+	Pattern string `protobuf:"bytes,1,opt,name=pattern" json:"pattern,omitempty"`
+}
+
+func (m *IgnoreHealthErrorRequest) Reset()                    { *m = IgnoreHealthErrorRequest{} }
+func (m *IgnoreHealthErrorRequest) String() string            { return proto.CompactTextString(m) }
+func (*IgnoreHealthErrorRequest) ProtoMessage()               {}
+func (*IgnoreHealthErrorRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+type IgnoreHealthErrorResponse struct {
+}
+
+func (m *IgnoreHealthErrorResponse) Reset()                    { *m = IgnoreHealthErrorResponse{} }
+func (m *IgnoreHealthErrorResponse) String() string            { return proto.CompactTextString(m) }
+func (*IgnoreHealthErrorResponse) ProtoMessage()               {}
+func (*IgnoreHealthErrorResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+type SetMaintenanceModeRequest struct {
+	On     bool   `protobuf:"varint,1,opt,name=on" json:"on,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *SetMaintenanceModeRequest) Reset()                    { *m = SetMaintenanceModeRequest{} }
+func (m *SetMaintenanceModeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetMaintenanceModeRequest) ProtoMessage()               {}
+func (*SetMaintenanceModeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+type SetMaintenanceModeResponse struct {
+}
+
+func (m *SetMaintenanceModeResponse) Reset()                    { *m = SetMaintenanceModeResponse{} }
+func (m *SetMaintenanceModeResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetMaintenanceModeResponse) ProtoMessage()               {}
+func (*SetMaintenanceModeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+type PauseHealthReportingRequest struct {
+	On bool `protobuf:"varint,1,opt,name=on" json:"on,omitempty"`
+}
+
+func (m *PauseHealthReportingRequest) Reset()                    { *m = PauseHealthReportingRequest{} }
+func (m *PauseHealthReportingRequest) String() string            { return proto.CompactTextString(m) }
+func (*PauseHealthReportingRequest) ProtoMessage()               {}
+func (*PauseHealthReportingRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+type PauseHealthReportingResponse struct {
+}
+
+func (m *PauseHealthReportingResponse) Reset()                    { *m = PauseHealthReportingResponse{} }
+func (m *PauseHealthReportingResponse) String() string            { return proto.CompactTextString(m) }
+func (*PauseHealthReportingResponse) ProtoMessage()               {}
+func (*PauseHealthReportingResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+type PrepareCutoverRequest struct {
+}
+
+func (m *PrepareCutoverRequest) Reset()                    { *m = PrepareCutoverRequest{} }
+func (m *PrepareCutoverRequest) String() string            { return proto.CompactTextString(m) }
+func (*PrepareCutoverRequest) ProtoMessage()               {}
+func (*PrepareCutoverRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+
+type PrepareCutoverResponse struct {
+	// token identifies the prepared cutover, for CommitCutover and
+	// AbortCutover.
+	Token string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+}
+
+func (m *PrepareCutoverResponse) Reset()                    { *m = PrepareCutoverResponse{} }
+func (m *PrepareCutoverResponse) String() string            { return proto.CompactTextString(m) }
+func (*PrepareCutoverResponse) ProtoMessage()               {}
+func (*PrepareCutoverResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+type CommitCutoverRequest struct {
+	Token string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+}
+
+func (m *CommitCutoverRequest) Reset()                    { *m = CommitCutoverRequest{} }
+func (m *CommitCutoverRequest) String() string            { return proto.CompactTextString(m) }
+func (*CommitCutoverRequest) ProtoMessage()               {}
+func (*CommitCutoverRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+type CommitCutoverResponse struct {
+}
+
+func (m *CommitCutoverResponse) Reset()                    { *m = CommitCutoverResponse{} }
+func (m *CommitCutoverResponse) String() string            { return proto.CompactTextString(m) }
+func (*CommitCutoverResponse) ProtoMessage()               {}
+func (*CommitCutoverResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+
+type AbortCutoverRequest struct {
+	Token string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+}
+
+func (m *AbortCutoverRequest) Reset()                    { *m = AbortCutoverRequest{} }
+func (m *AbortCutoverRequest) String() string            { return proto.CompactTextString(m) }
+func (*AbortCutoverRequest) ProtoMessage()               {}
+func (*AbortCutoverRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+
+type AbortCutoverResponse struct {
+}
+
+func (m *AbortCutoverResponse) Reset()                    { *m = AbortCutoverResponse{} }
+func (m *AbortCutoverResponse) String() string            { return proto.CompactTextString(m) }
+func (*AbortCutoverResponse) ProtoMessage()               {}
+func (*AbortCutoverResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+
+type SetServingKeyRangeRequest struct {
+	// key_range is the keyrange the tablet serves. It must be within
+	// the keyrange of the tablet's shard.
+	KeyRange *topodata.KeyRange `protobuf:"bytes,1,opt,name=key_range,json=keyRange" json:"key_range,omitempty"`
+	// served_types are the tablet types the keyrange is served for,
+	// all of them if empty.
+	ServedTypes []topodata.TabletType `protobuf:"varint,2,rep,packed,name=served_types,json=servedTypes,enum=topodata.TabletType" json:"served_types,omitempty"`
+}
+
+func (m *SetServingKeyRangeRequest) Reset()                    { *m = SetServingKeyRangeRequest{} }
+func (m *SetServingKeyRangeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetServingKeyRangeRequest) ProtoMessage()               {}
+func (*SetServingKeyRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+
+func (m *SetServingKeyRangeRequest) GetKeyRange() *topodata.KeyRange {
+	if m != nil {
+		return m.KeyRange
+	}
+	return nil
+}
+
+type SetServingKeyRangeResponse struct {
+}
+
+func (m *SetServingKeyRangeResponse) Reset()                    { *m = SetServingKeyRangeResponse{} }
+func (m *SetServingKeyRangeResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetServingKeyRangeResponse) ProtoMessage()               {}
+func (*SetServingKeyRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+
+type RestartMysqlRequest struct {
+	// healthy_timeout_ns is how long to wait for the restarted mysqld
+	// to be healthy. 0 uses the tablet default.
+	HealthyTimeoutNs int64 `protobuf:"varint,1,opt,name=healthy_timeout_ns,json=healthyTimeoutNs" json:"healthy_timeout_ns,omitempty"`
+}
+
+func (m *RestartMysqlRequest) Reset()                    { *m = RestartMysqlRequest{} }
+func (m *RestartMysqlRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestartMysqlRequest) ProtoMessage()               {}
+func (*RestartMysqlRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+
+type RestartMysqlResponse struct {
+	Event *logutil.Event `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
+}
+
+func (m *RestartMysqlResponse) Reset()                    { *m = RestartMysqlResponse{} }
+func (m *RestartMysqlResponse) String() string            { return proto.CompactTextString(m) }
+func (*RestartMysqlResponse) ProtoMessage()               {}
+func (*RestartMysqlResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+
+func (m *RestartMysqlResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type DecommissionRequest struct {
+	// stop_replication stops replication once the tablet is drained.
+	StopReplication bool `protobuf:"varint,1,opt,name=stop_replication,json=stopReplication" json:"stop_replication,omitempty"`
+	// stop_mysqld stops mysqld once the tablet is drained. It implies
+	// stop_replication.
+	StopMysqld bool `protobuf:"varint,2,opt,name=stop_mysqld,json=stopMysqld" json:"stop_mysqld,omitempty"`
+}
+
+func (m *DecommissionRequest) Reset()                    { *m = DecommissionRequest{} }
+func (m *DecommissionRequest) String() string            { return proto.CompactTextString(m) }
+func (*DecommissionRequest) ProtoMessage()               {}
+func (*DecommissionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+
+type DecommissionResponse struct {
+	Event *logutil.Event `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
+}
+
+func (m *DecommissionResponse) Reset()                    { *m = DecommissionResponse{} }
+func (m *DecommissionResponse) String() string            { return proto.CompactTextString(m) }
+func (*DecommissionResponse) ProtoMessage()               {}
+func (*DecommissionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+
+func (m *DecommissionResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type CheckTopoConnectivityRequest struct {
+}
+
+func (m *CheckTopoConnectivityRequest) Reset()                    { *m = CheckTopoConnectivityRequest{} }
+func (m *CheckTopoConnectivityRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckTopoConnectivityRequest) ProtoMessage()               {}
+func (*CheckTopoConnectivityRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+
+type CheckTopoConnectivityResponse struct {
+	// reachable is false if the tablet could not read from the topo server.
+	Reachable bool `protobuf:"varint,1,opt,name=reachable" json:"reachable,omitempty"`
+	// latency_ns is how long the topo reads took, in nanoseconds.
+	LatencyNs int64 `protobuf:"varint,2,opt,name=latency_ns,json=latencyNs" json:"latency_ns,omitempty"`
+}
+
+func (m *CheckTopoConnectivityResponse) Reset()                    { *m = CheckTopoConnectivityResponse{} }
+func (m *CheckTopoConnectivityResponse) String() string            { return proto.CompactTextString(m) }
+func (*CheckTopoConnectivityResponse) ProtoMessage()               {}
+func (*CheckTopoConnectivityResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+
+type WarmUpRequest struct {
+	// queries are run once each, their results are discarded.
+	Queries []string `protobuf:"bytes,1,rep,name=queries" json:"queries,omitempty"`
+	// load_buffer_pool loads the InnoDB buffer pool from its last dump.
+	LoadBufferPool bool `protobuf:"varint,2,opt,name=load_buffer_pool,json=loadBufferPool" json:"load_buffer_pool,omitempty"`
+}
+
+func (m *WarmUpRequest) Reset()                    { *m = WarmUpRequest{} }
+func (m *WarmUpRequest) String() string            { return proto.CompactTextString(m) }
+func (*WarmUpRequest) ProtoMessage()               {}
+func (*WarmUpRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+
+type WarmUpResponse struct {
+	// queries_run is the number of queries run so far,
+	// out of queries_total.
+	QueriesRun   int64 `protobuf:"varint,1,opt,name=queries_run,json=queriesRun" json:"queries_run,omitempty"`
+	QueriesTotal int64 `protobuf:"varint,2,opt,name=queries_total,json=queriesTotal" json:"queries_total,omitempty"`
+	// buffer_pool_fill is the fraction of the InnoDB buffer pool pages
+	// that hold data, between 0 and 1.
+	BufferPoolFill float64 `protobuf:"fixed64,3,opt,name=buffer_pool_fill,json=bufferPoolFill" json:"buffer_pool_fill,omitempty"`
+	// done is set on the last response, once the warm up is complete.
+	Done bool `protobuf:"varint,4,opt,name=done" json:"done,omitempty"`
+}
+
+func (m *WarmUpResponse) Reset()                    { *m = WarmUpResponse{} }
+func (m *WarmUpResponse) String() string            { return proto.CompactTextString(m) }
+func (*WarmUpResponse) ProtoMessage()               {}
+func (*WarmUpResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+
+type ReloadSchemaRequest struct {
+	// wait_position allows scheduling a schema reload to occur after a
+	// given DDL has replicated to this slave, by specifying a replication
+	// position to wait for. Leave empty to trigger the reload immediately.
+	WaitPosition string `protobuf:"bytes,1,opt,name=wait_position,json=waitPosition" json:"wait_position,omitempty"`
+}
+
+func (m *ReloadSchemaRequest) Reset()                    { *m = ReloadSchemaRequest{} }
+func (m *ReloadSchemaRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReloadSchemaRequest) ProtoMessage()               {}
+func (*ReloadSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+
+type ReloadSchemaResponse struct {
+}
+
+func (m *ReloadSchemaResponse) Reset()                    { *m = ReloadSchemaResponse{} }
+func (m *ReloadSchemaResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReloadSchemaResponse) ProtoMessage()               {}
+func (*ReloadSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+
+type PreflightSchemaRequest struct {
+	Changes []string `protobuf:"bytes,1,rep,name=changes" json:"changes,omitempty"`
+}
+
+func (m *PreflightSchemaRequest) Reset()                    { *m = PreflightSchemaRequest{} }
+func (m *PreflightSchemaRequest) String() string            { return proto.CompactTextString(m) }
+func (*PreflightSchemaRequest) ProtoMessage()               {}
+func (*PreflightSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+
+type PreflightSchemaResponse struct {
+	// change_results has for each change the schema before and after it.
+	// The number of elements is identical to the length of "changes" in the request.
+	ChangeResults []*SchemaChangeResult `protobuf:"bytes,1,rep,name=change_results,json=changeResults" json:"change_results,omitempty"`
+}
+
+func (m *PreflightSchemaResponse) Reset()                    { *m = PreflightSchemaResponse{} }
+func (m *PreflightSchemaResponse) String() string            { return proto.CompactTextString(m) }
+func (*PreflightSchemaResponse) ProtoMessage()               {}
+func (*PreflightSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+
+func (m *PreflightSchemaResponse) GetChangeResults() []*SchemaChangeResult {
+	if m != nil {
+		return m.ChangeResults
+	}
+	return nil
+}
+
+type ApplySchemaRequest struct {
+	Sql              string            `protobuf:"bytes,1,opt,name=sql" json:"sql,omitempty"`
+	Force            bool              `protobuf:"varint,2,opt,name=force" json:"force,omitempty"`
+	AllowReplication bool              `protobuf:"varint,3,opt,name=allow_replication,json=allowReplication" json:"allow_replication,omitempty"`
+	BeforeSchema     *SchemaDefinition `protobuf:"bytes,4,opt,name=before_schema,json=beforeSchema" json:"before_schema,omitempty"`
+	AfterSchema      *SchemaDefinition `protobuf:"bytes,5,opt,name=after_schema,json=afterSchema" json:"after_schema,omitempty"`
+}
+
+func (m *ApplySchemaRequest) Reset()                    { *m = ApplySchemaRequest{} }
+func (m *ApplySchemaRequest) String() string            { return proto.CompactTextString(m) }
+func (*ApplySchemaRequest) ProtoMessage()               {}
+func (*ApplySchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+
+func (m *ApplySchemaRequest) GetBeforeSchema() *SchemaDefinition {
+	if m != nil {
+		return m.BeforeSchema
+	}
+	return nil
+}
+
+func (m *ApplySchemaRequest) GetAfterSchema() *SchemaDefinition {
+	if m != nil {
+		return m.AfterSchema
+	}
+	return nil
+}
+
+type ApplySchemaResponse struct {
+	BeforeSchema *SchemaDefinition `protobuf:"bytes,1,opt,name=before_schema,json=beforeSchema" json:"before_schema,omitempty"`
+	AfterSchema  *SchemaDefinition `protobuf:"bytes,2,opt,name=after_schema,json=afterSchema" json:"after_schema,omitempty"`
+}
+
+func (m *ApplySchemaResponse) Reset()                    { *m = ApplySchemaResponse{} }
+func (m *ApplySchemaResponse) String() string            { return proto.CompactTextString(m) }
+func (*ApplySchemaResponse) ProtoMessage()               {}
+func (*ApplySchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+
+func (m *ApplySchemaResponse) GetBeforeSchema() *SchemaDefinition {
+	if m != nil {
+		return m.BeforeSchema
+	}
+	return nil
+}
+
+func (m *ApplySchemaResponse) GetAfterSchema() *SchemaDefinition {
+	if m != nil {
+		return m.AfterSchema
+	}
+	return nil
+}
+
+type SchemaDiffRequest struct {
+	Desired *SchemaDefinition `protobuf:"bytes,1,opt,name=desired" json:"desired,omitempty"`
+}
+
+func (m *SchemaDiffRequest) Reset()                    { *m = SchemaDiffRequest{} }
+func (m *SchemaDiffRequest) String() string            { return proto.CompactTextString(m) }
+func (*SchemaDiffRequest) ProtoMessage()               {}
+func (*SchemaDiffRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
+
+func (m *SchemaDiffRequest) GetDesired() *SchemaDefinition {
+	if m != nil {
+		return m.Desired
+	}
+	return nil
+}
+
+type SchemaDiffResponse struct {
+	// statements are the DDL statements to run, in order, to turn the
+	// schema of the tablet into the desired one.
+	Statements []string `protobuf:"bytes,1,rep,name=statements" json:"statements,omitempty"`
+}
+
+func (m *SchemaDiffResponse) Reset()                    { *m = SchemaDiffResponse{} }
+func (m *SchemaDiffResponse) String() string            { return proto.CompactTextString(m) }
+func (*SchemaDiffResponse) ProtoMessage()               {}
+func (*SchemaDiffResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+
+// SchemaChangeAssessment describes how MySQL would run an ALTER TABLE.
+type SchemaChangeAssessment struct {
+	Table string `protobuf:"bytes,1,opt,name=table" json:"table,omitempty"`
+	// algorithm is INSTANT, INPLACE or COPY, in MySQL online DDL terms.
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm" json:"algorithm,omitempty"`
+	// table_size_bytes is the size of the table data and indexes.
+	TableSizeBytes int64 `protobuf:"varint,3,opt,name=table_size_bytes,json=tableSizeBytes" json:"table_size_bytes,omitempty"`
+	// estimated_lock_ns is how long writes to the table are expected
+	// to be blocked by the change.
+	EstimatedLockNs int64 `protobuf:"varint,4,opt,name=estimated_lock_ns,json=estimatedLockNs" json:"estimated_lock_ns,omitempty"`
+}
+
+func (m *SchemaChangeAssessment) Reset()                    { *m = SchemaChangeAssessment{} }
+func (m *SchemaChangeAssessment) String() string            { return proto.CompactTextString(m) }
+func (*SchemaChangeAssessment) ProtoMessage()               {}
+func (*SchemaChangeAssessment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
+
+type AssessSchemaChangeRequest struct {
+	Change string `protobuf:"bytes,1,opt,name=change" json:"change,omitempty"`
+}
+
+func (m *AssessSchemaChangeRequest) Reset()                    { *m = AssessSchemaChangeRequest{} }
+func (m *AssessSchemaChangeRequest) String() string            { return proto.CompactTextString(m) }
+func (*AssessSchemaChangeRequest) ProtoMessage()               {}
+func (*AssessSchemaChangeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
+
+type AssessSchemaChangeResponse struct {
+	Assessment *SchemaChangeAssessment `protobuf:"bytes,1,opt,name=assessment" json:"assessment,omitempty"`
+}
+
+func (m *AssessSchemaChangeResponse) Reset()                    { *m = AssessSchemaChangeResponse{} }
+func (m *AssessSchemaChangeResponse) String() string            { return proto.CompactTextString(m) }
+func (*AssessSchemaChangeResponse) ProtoMessage()               {}
+func (*AssessSchemaChangeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109} }
+
+func (m *AssessSchemaChangeResponse) GetAssessment() *SchemaChangeAssessment {
+	if m != nil {
+		return m.Assessment
+	}
+	return nil
+}
+
+// SchemaChangeEvent describes a table or view of a tablet whose
+// definition changed.
+type SchemaChangeEvent struct {
+	// name is the name of the table or view.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// definition is its new definition, or unset if it was dropped.
+	Definition *TableDefinition `protobuf:"bytes,2,opt,name=definition" json:"definition,omitempty"`
+}
+
+func (m *SchemaChangeEvent) Reset()                    { *m = SchemaChangeEvent{} }
+func (m *SchemaChangeEvent) String() string            { return proto.CompactTextString(m) }
+func (*SchemaChangeEvent) ProtoMessage()               {}
+func (*SchemaChangeEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{110} }
+
+func (m *SchemaChangeEvent) GetDefinition() *TableDefinition {
+	if m != nil {
+		return m.Definition
+	}
+	return nil
+}
+
+type WatchSchemaRequest struct {
+}
+
+func (m *WatchSchemaRequest) Reset()                    { *m = WatchSchemaRequest{} }
+func (m *WatchSchemaRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchSchemaRequest) ProtoMessage()               {}
+func (*WatchSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{111} }
+
+type WatchSchemaResponse struct {
+	Event *SchemaChangeEvent `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
+}
+
+func (m *WatchSchemaResponse) Reset()                    { *m = WatchSchemaResponse{} }
+func (m *WatchSchemaResponse) String() string            { return proto.CompactTextString(m) }
+func (*WatchSchemaResponse) ProtoMessage()               {}
+func (*WatchSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{112} }
+
+func (m *WatchSchemaResponse) GetEvent() *SchemaChangeEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type GetVSchemaRequest struct {
+}
+
+func (m *GetVSchemaRequest) Reset()                    { *m = GetVSchemaRequest{} }
+func (m *GetVSchemaRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetVSchemaRequest) ProtoMessage()               {}
+func (*GetVSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{113} }
+
+type GetVSchemaResponse struct {
+	// vschema is the VSchema of the tablet keyspace.
+	Vschema *vschema.Keyspace `protobuf:"bytes,1,opt,name=vschema" json:"vschema,omitempty"`
+}
+
+func (m *GetVSchemaResponse) Reset()                    { *m = GetVSchemaResponse{} }
+func (m *GetVSchemaResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetVSchemaResponse) ProtoMessage()               {}
+func (*GetVSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{114} }
+
+func (m *GetVSchemaResponse) GetVschema() *vschema.Keyspace {
+	if m != nil {
+		return m.Vschema
+	}
+	return nil
+}
+
+type ApplyVSchemaRequest struct {
+	// vschema is the JSON encoded VSchema of the tablet keyspace.
+	Vschema string `protobuf:"bytes,1,opt,name=vschema" json:"vschema,omitempty"`
+}
+
+func (m *ApplyVSchemaRequest) Reset()                    { *m = ApplyVSchemaRequest{} }
+func (m *ApplyVSchemaRequest) String() string            { return proto.CompactTextString(m) }
+func (*ApplyVSchemaRequest) ProtoMessage()               {}
+func (*ApplyVSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{115} }
+
+type ApplyVSchemaResponse struct {
+}
+
+func (m *ApplyVSchemaResponse) Reset()                    { *m = ApplyVSchemaResponse{} }
+func (m *ApplyVSchemaResponse) String() string            { return proto.CompactTextString(m) }
+func (*ApplyVSchemaResponse) ProtoMessage()               {}
+func (*ApplyVSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{116} }
+
+type ExecuteFetchAsDbaRequest struct {
+	Query          []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	DbName         string `protobuf:"bytes,2,opt,name=db_name,json=dbName" json:"db_name,omitempty"`
+	MaxRows        uint64 `protobuf:"varint,3,opt,name=max_rows,json=maxRows" json:"max_rows,omitempty"`
+	DisableBinlogs bool   `protobuf:"varint,4,opt,name=disable_binlogs,json=disableBinlogs" json:"disable_binlogs,omitempty"`
+	ReloadSchema   bool   `protobuf:"varint,5,opt,name=reload_schema,json=reloadSchema" json:"reload_schema,omitempty"`
+	// max_exec_time_ns, if set, caps the time mysql spends running the
+	// query. Past it, mysql aborts the query.
+	MaxExecTimeNs int64 `protobuf:"varint,6,opt,name=max_exec_time_ns,json=maxExecTimeNs" json:"max_exec_time_ns,omitempty"`
+}
+
+func (m *ExecuteFetchAsDbaRequest) Reset()                    { *m = ExecuteFetchAsDbaRequest{} }
+func (m *ExecuteFetchAsDbaRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExecuteFetchAsDbaRequest) ProtoMessage()               {}
+func (*ExecuteFetchAsDbaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{117} }
+
+type ExecuteFetchAsDbaResponse struct {
+	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *ExecuteFetchAsDbaResponse) Reset()                    { *m = ExecuteFetchAsDbaResponse{} }
+func (m *ExecuteFetchAsDbaResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExecuteFetchAsDbaResponse) ProtoMessage()               {}
+func (*ExecuteFetchAsDbaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{118} }
+
+func (m *ExecuteFetchAsDbaResponse) GetResult() *query.QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ExecuteFetchAsDbaCSVRequest struct {
+	Query  []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName" json:"db_name,omitempty"`
+}
+
+func (m *ExecuteFetchAsDbaCSVRequest) Reset()                    { *m = ExecuteFetchAsDbaCSVRequest{} }
+func (m *ExecuteFetchAsDbaCSVRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExecuteFetchAsDbaCSVRequest) ProtoMessage()               {}
+func (*ExecuteFetchAsDbaCSVRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{119} }
+
+type ExecuteFetchAsDbaCSVResponse struct {
+	// data is the next chunk of CSV encoded rows. The first chunk
+	// starts with a header row of field names.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ExecuteFetchAsDbaCSVResponse) Reset()                    { *m = ExecuteFetchAsDbaCSVResponse{} }
+func (m *ExecuteFetchAsDbaCSVResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExecuteFetchAsDbaCSVResponse) ProtoMessage()               {}
+func (*ExecuteFetchAsDbaCSVResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{120} }
+
+// ColumnarResult is a query result stored by column: the values of
+// each column for all rows are encoded together. It is more compact
+// than a query.QueryResult for results with many rows.
+type ColumnarResult struct {
+	Fields       []*query.Field `protobuf:"bytes,1,rep,name=fields" json:"fields,omitempty"`
+	RowsAffected uint64         `protobuf:"varint,2,opt,name=rows_affected,json=rowsAffected" json:"rows_affected,omitempty"`
+	InsertId     uint64         `protobuf:"varint,3,opt,name=insert_id,json=insertId" json:"insert_id,omitempty"`
+	RowCount     uint64         `protobuf:"varint,4,opt,name=row_count,json=rowCount" json:"row_count,omitempty"`
+	// columns has one entry per field. Each column is encoded like a
+	// query.Row: the lengths of its values for all rows, -1 for NULL,
+	// and the concatenation of the values.
+	Columns []*query.Row `protobuf:"bytes,5,rep,name=columns" json:"columns,omitempty"`
+}
+
+func (m *ColumnarResult) Reset()                    { *m = ColumnarResult{} }
+func (m *ColumnarResult) String() string            { return proto.CompactTextString(m) }
+func (*ColumnarResult) ProtoMessage()               {}
+func (*ColumnarResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{121} }
+
+func (m *ColumnarResult) GetFields() []*query.Field {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ColumnarResult) GetColumns() []*query.Row {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+type ExecuteFetchColumnarRequest struct {
+	Query   []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	DbName  string `protobuf:"bytes,2,opt,name=db_name,json=dbName" json:"db_name,omitempty"`
+	MaxRows uint64 `protobuf:"varint,3,opt,name=max_rows,json=maxRows" json:"max_rows,omitempty"`
+}
+
+func (m *ExecuteFetchColumnarRequest) Reset()                    { *m = ExecuteFetchColumnarRequest{} }
+func (m *ExecuteFetchColumnarRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExecuteFetchColumnarRequest) ProtoMessage()               {}
+func (*ExecuteFetchColumnarRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{122} }
+
+type ExecuteFetchColumnarResponse struct {
+	Result *ColumnarResult `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *ExecuteFetchColumnarResponse) Reset()                    { *m = ExecuteFetchColumnarResponse{} }
+func (m *ExecuteFetchColumnarResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExecuteFetchColumnarResponse) ProtoMessage()               {}
+func (*ExecuteFetchColumnarResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{123} }
+
+func (m *ExecuteFetchColumnarResponse) GetResult() *ColumnarResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ExecuteFetchAsAllPrivsRequest struct {
+	Query        []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	DbName       string `protobuf:"bytes,2,opt,name=db_name,json=dbName" json:"db_name,omitempty"`
+	MaxRows      uint64 `protobuf:"varint,3,opt,name=max_rows,json=maxRows" json:"max_rows,omitempty"`
+	ReloadSchema bool   `protobuf:"varint,4,opt,name=reload_schema,json=reloadSchema" json:"reload_schema,omitempty"`
+}
+
+func (m *ExecuteFetchAsAllPrivsRequest) Reset()                    { *m = ExecuteFetchAsAllPrivsRequest{} }
+func (m *ExecuteFetchAsAllPrivsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExecuteFetchAsAllPrivsRequest) ProtoMessage()               {}
+func (*ExecuteFetchAsAllPrivsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{124} }
+
+type ExecuteFetchAsAllPrivsResponse struct {
+	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *ExecuteFetchAsAllPrivsResponse) Reset()         { *m = ExecuteFetchAsAllPrivsResponse{} }
+func (m *ExecuteFetchAsAllPrivsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecuteFetchAsAllPrivsResponse) ProtoMessage()    {}
+func (*ExecuteFetchAsAllPrivsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{125}
+}
+
+func (m *ExecuteFetchAsAllPrivsResponse) GetResult() *query.QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ExecuteFetchAsAppRequest struct {
+	Query   []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	MaxRows uint64 `protobuf:"varint,2,opt,name=max_rows,json=maxRows" json:"max_rows,omitempty"`
+}
+
+func (m *ExecuteFetchAsAppRequest) Reset()                    { *m = ExecuteFetchAsAppRequest{} }
+func (m *ExecuteFetchAsAppRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExecuteFetchAsAppRequest) ProtoMessage()               {}
+func (*ExecuteFetchAsAppRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{126} }
+
+type ExecuteFetchAsAppResponse struct {
+	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *ExecuteFetchAsAppResponse) Reset()                    { *m = ExecuteFetchAsAppResponse{} }
+func (m *ExecuteFetchAsAppResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExecuteFetchAsAppResponse) ProtoMessage()               {}
+func (*ExecuteFetchAsAppResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{127} }
+
+func (m *ExecuteFetchAsAppResponse) GetResult() *query.QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ExplainQueryRequest struct {
+	Query string `protobuf:"bytes,1,opt,name=query" json:"query,omitempty"`
+	// format is TRADITIONAL (the default if empty) or JSON.
+	Format string `protobuf:"bytes,2,opt,name=format" json:"format,omitempty"`
+}
+
+func (m *ExplainQueryRequest) Reset()                    { *m = ExplainQueryRequest{} }
+func (m *ExplainQueryRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExplainQueryRequest) ProtoMessage()               {}
+func (*ExplainQueryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{128} }
+
+type ExplainQueryResponse struct {
+	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *ExplainQueryResponse) Reset()                    { *m = ExplainQueryResponse{} }
+func (m *ExplainQueryResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExplainQueryResponse) ProtoMessage()               {}
+func (*ExplainQueryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{129} }
+
+func (m *ExplainQueryResponse) GetResult() *query.QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ApplyGrantsRequest struct {
+	// statements are GRANT or REVOKE statements, run in order.
+	Statements []string `protobuf:"bytes,1,rep,name=statements" json:"statements,omitempty"`
+	// atomic restores the previous grants if a statement fails.
+	Atomic bool `protobuf:"varint,2,opt,name=atomic" json:"atomic,omitempty"`
+}
+
+func (m *ApplyGrantsRequest) Reset()                    { *m = ApplyGrantsRequest{} }
+func (m *ApplyGrantsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ApplyGrantsRequest) ProtoMessage()               {}
+func (*ApplyGrantsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{130} }
+
+type ApplyGrantsResponse struct {
+}
+
+func (m *ApplyGrantsResponse) Reset()                    { *m = ApplyGrantsResponse{} }
+func (m *ApplyGrantsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ApplyGrantsResponse) ProtoMessage()               {}
+func (*ApplyGrantsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{131} }
+
+type ChecksumTableRequest struct {
+	Table string `protobuf:"bytes,1,opt,name=table" json:"table,omitempty"`
+	// key_range restricts the checksum to the rows in that range.
+	// If unset, all the rows are included.
+	KeyRange *topodata.KeyRange `protobuf:"bytes,2,opt,name=key_range,json=keyRange" json:"key_range,omitempty"`
+}
+
+func (m *ChecksumTableRequest) Reset()                    { *m = ChecksumTableRequest{} }
+func (m *ChecksumTableRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChecksumTableRequest) ProtoMessage()               {}
+func (*ChecksumTableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{132} }
+
+func (m *ChecksumTableRequest) GetKeyRange() *topodata.KeyRange {
+	if m != nil {
+		return m.KeyRange
+	}
+	return nil
+}
+
+type ChecksumTableResponse struct {
+	// checksum is the sum of the hashes of the rows, so it does not
+	// depend on the order the rows are read in.
+	Checksum uint64 `protobuf:"varint,1,opt,name=checksum" json:"checksum,omitempty"`
+	RowCount int64  `protobuf:"varint,2,opt,name=row_count,json=rowCount" json:"row_count,omitempty"`
+}
+
+func (m *ChecksumTableResponse) Reset()                    { *m = ChecksumTableResponse{} }
+func (m *ChecksumTableResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChecksumTableResponse) ProtoMessage()               {}
+func (*ChecksumTableResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{133} }
+
+type TruncateTableRequest struct {
+	Table string `protobuf:"bytes,1,opt,name=table" json:"table,omitempty"`
+	// confirm_keyspace must be the keyspace of the tablet, or the
+	// table is not truncated.
+	ConfirmKeyspace string `protobuf:"bytes,2,opt,name=confirm_keyspace,json=confirmKeyspace" json:"confirm_keyspace,omitempty"`
+}
+
+func (m *TruncateTableRequest) Reset()                    { *m = TruncateTableRequest{} }
+func (m *TruncateTableRequest) String() string            { return proto.CompactTextString(m) }
+func (*TruncateTableRequest) ProtoMessage()               {}
+func (*TruncateTableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{134} }
+
+type TruncateTableResponse struct {
+}
+
+func (m *TruncateTableResponse) Reset()                    { *m = TruncateTableResponse{} }
+func (m *TruncateTableResponse) String() string            { return proto.CompactTextString(m) }
+func (*TruncateTableResponse) ProtoMessage()               {}
+func (*TruncateTableResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{135} }
+
+type RenameTableRequest struct {
+	FromTable string `protobuf:"bytes,1,opt,name=from_table,json=fromTable" json:"from_table,omitempty"`
+	ToTable   string `protobuf:"bytes,2,opt,name=to_table,json=toTable" json:"to_table,omitempty"`
+	// cascade renames the table even if views or foreign keys depend
+	// on it.
+	Cascade bool `protobuf:"varint,3,opt,name=cascade" json:"cascade,omitempty"`
+}
+
+func (m *RenameTableRequest) Reset()                    { *m = RenameTableRequest{} }
+func (m *RenameTableRequest) String() string            { return proto.CompactTextString(m) }
+func (*RenameTableRequest) ProtoMessage()               {}
+func (*RenameTableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{136} }
+
+// RenameTableResult is what RenameTable found and did.
+type RenameTableResult struct {
+	// dependent_views are the views that use the table.
+	DependentViews []string `protobuf:"bytes,1,rep,name=dependent_views,json=dependentViews" json:"dependent_views,omitempty"`
+	// dependent_foreign_keys are the foreign keys of other tables that
+	// reference the table, as table.constraint.
+	DependentForeignKeys []string `protobuf:"bytes,2,rep,name=dependent_foreign_keys,json=dependentForeignKeys" json:"dependent_foreign_keys,omitempty"`
+	// renamed is false if the rename was refused because of the
+	// dependents.
+	Renamed bool `protobuf:"varint,3,opt,name=renamed" json:"renamed,omitempty"`
+}
+
+func (m *RenameTableResult) Reset()                    { *m = RenameTableResult{} }
+func (m *RenameTableResult) String() string            { return proto.CompactTextString(m) }
+func (*RenameTableResult) ProtoMessage()               {}
+func (*RenameTableResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{137} }
+
+type RenameTableResponse struct {
+	Result *RenameTableResult `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *RenameTableResponse) Reset()                    { *m = RenameTableResponse{} }
+func (m *RenameTableResponse) String() string            { return proto.CompactTextString(m) }
+func (*RenameTableResponse) ProtoMessage()               {}
+func (*RenameTableResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{138} }
+
+func (m *RenameTableResponse) GetResult() *RenameTableResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type StreamRowsInKeyRangeRequest struct {
+	Table string `protobuf:"bytes,1,opt,name=table" json:"table,omitempty"`
+	// key_range restricts the stream to the rows in that range.
+	// If unset, all the rows are sent.
+	KeyRange *topodata.KeyRange `protobuf:"bytes,2,opt,name=key_range,json=keyRange" json:"key_range,omitempty"`
+}
+
+func (m *StreamRowsInKeyRangeRequest) Reset()                    { *m = StreamRowsInKeyRangeRequest{} }
+func (m *StreamRowsInKeyRangeRequest) String() string            { return proto.CompactTextString(m) }
+func (*StreamRowsInKeyRangeRequest) ProtoMessage()               {}
+func (*StreamRowsInKeyRangeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{139} }
+
+func (m *StreamRowsInKeyRangeRequest) GetKeyRange() *topodata.KeyRange {
+	if m != nil {
+		return m.KeyRange
+	}
+	return nil
+}
+
+type StreamRowsInKeyRangeResponse struct {
+	// result has the fields in the first response, and rows after.
+	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *StreamRowsInKeyRangeResponse) Reset()                    { *m = StreamRowsInKeyRangeResponse{} }
+func (m *StreamRowsInKeyRangeResponse) String() string            { return proto.CompactTextString(m) }
+func (*StreamRowsInKeyRangeResponse) ProtoMessage()               {}
+func (*StreamRowsInKeyRangeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{140} }
+
+func (m *StreamRowsInKeyRangeResponse) GetResult() *query.QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type StreamKeyRangeBinlogRequest struct {
+	// key_range restricts the stream to the rows in that range.
+	KeyRange *topodata.KeyRange `protobuf:"bytes,1,opt,name=key_range,json=keyRange" json:"key_range,omitempty"`
+	// position is the replication position to start streaming from.
+	Position string `protobuf:"bytes,2,opt,name=position" json:"position,omitempty"`
+	// charset is the charset the statements are sent in.
+	Charset *binlogdata.Charset `protobuf:"bytes,3,opt,name=charset" json:"charset,omitempty"`
+}
+
+func (m *StreamKeyRangeBinlogRequest) Reset()                    { *m = StreamKeyRangeBinlogRequest{} }
+func (m *StreamKeyRangeBinlogRequest) String() string            { return proto.CompactTextString(m) }
+func (*StreamKeyRangeBinlogRequest) ProtoMessage()               {}
+func (*StreamKeyRangeBinlogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{141} }
+
+func (m *StreamKeyRangeBinlogRequest) GetKeyRange() *topodata.KeyRange {
+	if m != nil {
+		return m.KeyRange
+	}
+	return nil
+}
+
+func (m *StreamKeyRangeBinlogRequest) GetCharset() *binlogdata.Charset {
+	if m != nil {
+		return m.Charset
+	}
+	return nil
+}
+
+type StreamKeyRangeBinlogResponse struct {
+	// binlog_transaction only has the statements touching rows
+	// in the key range.
+	BinlogTransaction *binlogdata.BinlogTransaction `protobuf:"bytes,1,opt,name=binlog_transaction,json=binlogTransaction" json:"binlog_transaction,omitempty"`
+}
+
+func (m *StreamKeyRangeBinlogResponse) Reset()                    { *m = StreamKeyRangeBinlogResponse{} }
+func (m *StreamKeyRangeBinlogResponse) String() string            { return proto.CompactTextString(m) }
+func (*StreamKeyRangeBinlogResponse) ProtoMessage()               {}
+func (*StreamKeyRangeBinlogResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{142} }
+
+func (m *StreamKeyRangeBinlogResponse) GetBinlogTransaction() *binlogdata.BinlogTransaction {
+	if m != nil {
+		return m.BinlogTransaction
+	}
+	return nil
+}
+
+type CloneStreamRequest struct {
+	Tables []string `protobuf:"bytes,1,rep,name=tables" json:"tables,omitempty"`
+	// buffer_size is the approximate size in bytes of the row batches.
+	// If unset, a default size is used.
+	BufferSize int64 `protobuf:"varint,2,opt,name=buffer_size,json=bufferSize" json:"buffer_size,omitempty"`
+}
+
+func (m *CloneStreamRequest) Reset()                    { *m = CloneStreamRequest{} }
+func (m *CloneStreamRequest) String() string            { return proto.CompactTextString(m) }
+func (*CloneStreamRequest) ProtoMessage()               {}
+func (*CloneStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{143} }
+
+// CloneStreamResponse is one message of a CloneStream. The first one
+// only has the position, and the others the rows of a table.
+type CloneStreamResponse struct {
+	// position is the replication position of the snapshot.
+	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
+	Table    string `protobuf:"bytes,2,opt,name=table" json:"table,omitempty"`
+	// result has the fields in the first response of each table, and
+	// rows after.
+	Result *query.QueryResult `protobuf:"bytes,3,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *CloneStreamResponse) Reset()                    { *m = CloneStreamResponse{} }
+func (m *CloneStreamResponse) String() string            { return proto.CompactTextString(m) }
+func (*CloneStreamResponse) ProtoMessage()               {}
+func (*CloneStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{144} }
+
+func (m *CloneStreamResponse) GetResult() *query.QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+// Process is one MySQL thread, as listed by SHOW FULL PROCESSLIST.
+type Process struct {
+	Id      int64  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	User    string `protobuf:"bytes,2,opt,name=user" json:"user,omitempty"`
+	Host    string `protobuf:"bytes,3,opt,name=host" json:"host,omitempty"`
+	Db      string `protobuf:"bytes,4,opt,name=db" json:"db,omitempty"`
+	Command string `protobuf:"bytes,5,opt,name=command" json:"command,omitempty"`
+	// time is the number of seconds the thread has been in its state.
+	Time  int64  `protobuf:"varint,6,opt,name=time" json:"time,omitempty"`
+	State string `protobuf:"bytes,7,opt,name=state" json:"state,omitempty"`
+	// info is the statement the thread is executing, if any.
+	Info string `protobuf:"bytes,8,opt,name=info" json:"info,omitempty"`
+}
+
+func (m *Process) Reset()                    { *m = Process{} }
+func (m *Process) String() string            { return proto.CompactTextString(m) }
+func (*Process) ProtoMessage()               {}
+func (*Process) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{145} }
+
+type GetProcessListRequest struct {
+}
+
+func (m *GetProcessListRequest) Reset()                    { *m = GetProcessListRequest{} }
+func (m *GetProcessListRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetProcessListRequest) ProtoMessage()               {}
+func (*GetProcessListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{146} }
+
+type GetProcessListResponse struct {
+	Processes []*Process `protobuf:"bytes,1,rep,name=processes" json:"processes,omitempty"`
+}
+
+func (m *GetProcessListResponse) Reset()                    { *m = GetProcessListResponse{} }
+func (m *GetProcessListResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetProcessListResponse) ProtoMessage()               {}
+func (*GetProcessListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{147} }
+
+func (m *GetProcessListResponse) GetProcesses() []*Process {
+	if m != nil {
+		return m.Processes
+	}
+	return nil
+}
+
+// CharsetConfig is the character set and collation configuration of
+// a MySQL server.
+type CharsetConfig struct {
+	CharacterSetServer string `protobuf:"bytes,1,opt,name=character_set_server,json=characterSetServer" json:"character_set_server,omitempty"`
+	CollationServer    string `protobuf:"bytes,2,opt,name=collation_server,json=collationServer" json:"collation_server,omitempty"`
+	// database_charsets and database_collations are the default
+	// character set and collation of each database, by name. System
+	// databases are omitted.
+	DatabaseCharsets   map[string]string `protobuf:"bytes,3,rep,name=database_charsets,json=databaseCharsets" json:"database_charsets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DatabaseCollations map[string]string `protobuf:"bytes,4,rep,name=database_collations,json=databaseCollations" json:"database_collations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *CharsetConfig) Reset()                    { *m = CharsetConfig{} }
+func (m *CharsetConfig) String() string            { return proto.CompactTextString(m) }
+func (*CharsetConfig) ProtoMessage()               {}
+func (*CharsetConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{148} }
+
+func (m *CharsetConfig) GetDatabaseCharsets() map[string]string {
+	if m != nil {
+		return m.DatabaseCharsets
+	}
+	return nil
+}
+
+func (m *CharsetConfig) GetDatabaseCollations() map[string]string {
+	if m != nil {
+		return m.DatabaseCollations
+	}
+	return nil
+}
+
+type GetCharsetConfigRequest struct {
+}
+
+func (m *GetCharsetConfigRequest) Reset()                    { *m = GetCharsetConfigRequest{} }
+func (m *GetCharsetConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetCharsetConfigRequest) ProtoMessage()               {}
+func (*GetCharsetConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{149} }
+
+type GetCharsetConfigResponse struct {
+	Config *CharsetConfig `protobuf:"bytes,1,opt,name=config" json:"config,omitempty"`
+}
+
+func (m *GetCharsetConfigResponse) Reset()                    { *m = GetCharsetConfigResponse{} }
+func (m *GetCharsetConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetCharsetConfigResponse) ProtoMessage()               {}
+func (*GetCharsetConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{150} }
+
+func (m *GetCharsetConfigResponse) GetConfig() *CharsetConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type KillProcessRequest struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *KillProcessRequest) Reset()                    { *m = KillProcessRequest{} }
+func (m *KillProcessRequest) String() string            { return proto.CompactTextString(m) }
+func (*KillProcessRequest) ProtoMessage()               {}
+func (*KillProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{151} }
+
+type KillProcessResponse struct {
+}
+
+func (m *KillProcessResponse) Reset()                    { *m = KillProcessResponse{} }
+func (m *KillProcessResponse) String() string            { return proto.CompactTextString(m) }
+func (*KillProcessResponse) ProtoMessage()               {}
+func (*KillProcessResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{152} }
+
+type TailGeneralLogRequest struct {
+	// duration_ns is how long the general log is enabled for.
+	DurationNs int64 `protobuf:"varint,1,opt,name=duration_ns,json=durationNs" json:"duration_ns,omitempty"`
+}
+
+func (m *TailGeneralLogRequest) Reset()                    { *m = TailGeneralLogRequest{} }
+func (m *TailGeneralLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*TailGeneralLogRequest) ProtoMessage()               {}
+func (*TailGeneralLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{153} }
+
+type TailGeneralLogResponse struct {
+	// entry is one line of the general log, as tab separated
+	// event time, thread id, command type and argument.
+	Entry string `protobuf:"bytes,1,opt,name=entry" json:"entry,omitempty"`
+}
+
+func (m *TailGeneralLogResponse) Reset()                    { *m = TailGeneralLogResponse{} }
+func (m *TailGeneralLogResponse) String() string            { return proto.CompactTextString(m) }
+func (*TailGeneralLogResponse) ProtoMessage()               {}
+func (*TailGeneralLogResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{154} }
+
+// QPSSample is the query rate of a tablet over one interval.
+type QPSSample struct {
+	// timestamp_ns is when the sample was taken.
+	TimestampNs int64 `protobuf:"varint,1,opt,name=timestamp_ns,json=timestampNs" json:"timestamp_ns,omitempty"`
+	// interval_ns is the time since the previous sample.
+	IntervalNs int64 `protobuf:"varint,2,opt,name=interval_ns,json=intervalNs" json:"interval_ns,omitempty"`
+	// read_qps is the rate of select queries.
+	ReadQps float64 `protobuf:"fixed64,3,opt,name=read_qps,json=readQps" json:"read_qps,omitempty"`
+	// write_qps is the rate of insert, update and delete queries.
+	WriteQps float64 `protobuf:"fixed64,4,opt,name=write_qps,json=writeQps" json:"write_qps,omitempty"`
+	// total_qps is the rate of all queries, including the ones that
+	// are neither reads nor writes, like transaction statements.
+	TotalQps float64 `protobuf:"fixed64,5,opt,name=total_qps,json=totalQps" json:"total_qps,omitempty"`
+	// dropped is how many samples were dropped so far because the
+	// client did not read them fast enough.
+	Dropped int64 `protobuf:"varint,6,opt,name=dropped" json:"dropped,omitempty"`
+}
+
+func (m *QPSSample) Reset()                    { *m = QPSSample{} }
+func (m *QPSSample) String() string            { return proto.CompactTextString(m) }
+func (*QPSSample) ProtoMessage()               {}
+func (*QPSSample) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{155} }
+
+type StreamQPSRequest struct {
+	// interval_ns is the time between samples.
+	IntervalNs int64 `protobuf:"varint,1,opt,name=interval_ns,json=intervalNs" json:"interval_ns,omitempty"`
+}
+
+func (m *StreamQPSRequest) Reset()                    { *m = StreamQPSRequest{} }
+func (m *StreamQPSRequest) String() string            { return proto.CompactTextString(m) }
+func (*StreamQPSRequest) ProtoMessage()               {}
+func (*StreamQPSRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{156} }
+
+type StreamQPSResponse struct {
+	Sample *QPSSample `protobuf:"bytes,1,opt,name=sample" json:"sample,omitempty"`
+}
+
+func (m *StreamQPSResponse) Reset()                    { *m = StreamQPSResponse{} }
+func (m *StreamQPSResponse) String() string            { return proto.CompactTextString(m) }
+func (*StreamQPSResponse) ProtoMessage()               {}
+func (*StreamQPSResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{157} }
+
+func (m *StreamQPSResponse) GetSample() *QPSSample {
+	if m != nil {
+		return m.Sample
+	}
+	return nil
+}
+
+type SlaveStatusRequest struct {
+}
+
+func (m *SlaveStatusRequest) Reset()                    { *m = SlaveStatusRequest{} }
+func (m *SlaveStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*SlaveStatusRequest) ProtoMessage()               {}
+func (*SlaveStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{158} }
+
+type SlaveStatusResponse struct {
+	Status *replicationdata.Status `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *SlaveStatusResponse) Reset()                    { *m = SlaveStatusResponse{} }
+func (m *SlaveStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*SlaveStatusResponse) ProtoMessage()               {}
+func (*SlaveStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{159} }
+
+func (m *SlaveStatusResponse) GetStatus() *replicationdata.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type SlaveStatusAllChannelsRequest struct {
+}
+
+func (m *SlaveStatusAllChannelsRequest) Reset()                    { *m = SlaveStatusAllChannelsRequest{} }
+func (m *SlaveStatusAllChannelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SlaveStatusAllChannelsRequest) ProtoMessage()               {}
+func (*SlaveStatusAllChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{160} }
+
+type SlaveStatusAllChannelsResponse struct {
+	// statuses has the status of each replication channel, by channel
+	// name. The default channel is named "".
+	Statuses map[string]*replicationdata.Status `protobuf:"bytes,1,rep,name=statuses" json:"statuses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *SlaveStatusAllChannelsResponse) Reset()         { *m = SlaveStatusAllChannelsResponse{} }
+func (m *SlaveStatusAllChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*SlaveStatusAllChannelsResponse) ProtoMessage()    {}
+func (*SlaveStatusAllChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{161}
+}
+
+func (m *SlaveStatusAllChannelsResponse) GetStatuses() map[string]*replicationdata.Status {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// ReplicationSource is who a slave replicates from, and as which
+// user. It never has the replication password.
+type ReplicationSource struct {
+	MasterHost string `protobuf:"bytes,1,opt,name=master_host,json=masterHost" json:"master_host,omitempty"`
+	MasterPort int32  `protobuf:"varint,2,opt,name=master_port,json=masterPort" json:"master_port,omitempty"`
+	User       string `protobuf:"bytes,3,opt,name=user" json:"user,omitempty"`
+}
+
+func (m *ReplicationSource) Reset()                    { *m = ReplicationSource{} }
+func (m *ReplicationSource) String() string            { return proto.CompactTextString(m) }
+func (*ReplicationSource) ProtoMessage()               {}
+func (*ReplicationSource) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{162} }
+
+type GetReplicationSourceRequest struct {
+}
+
+func (m *GetReplicationSourceRequest) Reset()                    { *m = GetReplicationSourceRequest{} }
+func (m *GetReplicationSourceRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetReplicationSourceRequest) ProtoMessage()               {}
+func (*GetReplicationSourceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{163} }
+
+type GetReplicationSourceResponse struct {
+	Source *ReplicationSource `protobuf:"bytes,1,opt,name=source" json:"source,omitempty"`
+}
+
+func (m *GetReplicationSourceResponse) Reset()                    { *m = GetReplicationSourceResponse{} }
+func (m *GetReplicationSourceResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetReplicationSourceResponse) ProtoMessage()               {}
+func (*GetReplicationSourceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{164} }
+
+func (m *GetReplicationSourceResponse) GetSource() *ReplicationSource {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+type ValidateReplicationCredentialsRequest struct {
+}
+
+func (m *ValidateReplicationCredentialsRequest) Reset()                    { *m = ValidateReplicationCredentialsRequest{} }
+func (m *ValidateReplicationCredentialsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ValidateReplicationCredentialsRequest) ProtoMessage()               {}
+func (*ValidateReplicationCredentialsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{165} }
+
+type ValidateReplicationCredentialsResponse struct {
+	// valid is false if the master denied access with the replication
+	// credentials.
+	Valid bool `protobuf:"varint,1,opt,name=valid" json:"valid,omitempty"`
+}
+
+func (m *ValidateReplicationCredentialsResponse) Reset() {
+	*m = ValidateReplicationCredentialsResponse{}
+}
+func (m *ValidateReplicationCredentialsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateReplicationCredentialsResponse) ProtoMessage()    {}
+func (*ValidateReplicationCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{166}
+}
+
+// ApplierWorkerStats is the state of one replication applier worker.
+type ApplierWorkerStats struct {
+	WorkerId int64 `protobuf:"varint,1,opt,name=worker_id,json=workerId" json:"worker_id,omitempty"`
+	// service_state is ON or OFF.
+	ServiceState string `protobuf:"bytes,2,opt,name=service_state,json=serviceState" json:"service_state,omitempty"`
+	// thread_state is the processlist state of the worker thread, e.g.
+	// "Waiting for an event from Coordinator" if its queue is empty.
+	ThreadState         string `protobuf:"bytes,3,opt,name=thread_state,json=threadState" json:"thread_state,omitempty"`
+	LastSeenTransaction string `protobuf:"bytes,4,opt,name=last_seen_transaction,json=lastSeenTransaction" json:"last_seen_transaction,omitempty"`
+}
+
+func (m *ApplierWorkerStats) Reset()                    { *m = ApplierWorkerStats{} }
+func (m *ApplierWorkerStats) String() string            { return proto.CompactTextString(m) }
+func (*ApplierWorkerStats) ProtoMessage()               {}
+func (*ApplierWorkerStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{167} }
+
+// ApplierQueueStats describes the backlog of the replication applier.
+type ApplierQueueStats struct {
+	// relay_log_space is the size in bytes of the relay logs.
+	RelayLogSpace int64 `protobuf:"varint,1,opt,name=relay_log_space,json=relayLogSpace" json:"relay_log_space,omitempty"`
+	// coordinator_state is the processlist state of the parallel
+	// replication coordinator, e.g. "Waiting for Slave Workers to free
+	// pending events" if the worker queues are full. It is empty
+	// without parallel replication.
+	CoordinatorState string                `protobuf:"bytes,2,opt,name=coordinator_state,json=coordinatorState" json:"coordinator_state,omitempty"`
+	Workers          []*ApplierWorkerStats `protobuf:"bytes,3,rep,name=workers" json:"workers,omitempty"`
+}
+
+func (m *ApplierQueueStats) Reset()                    { *m = ApplierQueueStats{} }
+func (m *ApplierQueueStats) String() string            { return proto.CompactTextString(m) }
+func (*ApplierQueueStats) ProtoMessage()               {}
+func (*ApplierQueueStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{168} }
+
+func (m *ApplierQueueStats) GetWorkers() []*ApplierWorkerStats {
+	if m != nil {
+		return m.Workers
+	}
+	return nil
+}
+
+type GetApplierQueueStatsRequest struct {
+}
+
+func (m *GetApplierQueueStatsRequest) Reset()                    { *m = GetApplierQueueStatsRequest{} }
+func (m *GetApplierQueueStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetApplierQueueStatsRequest) ProtoMessage()               {}
+func (*GetApplierQueueStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{169} }
+
+type GetApplierQueueStatsResponse struct {
+	Stats *ApplierQueueStats `protobuf:"bytes,1,opt,name=stats" json:"stats,omitempty"`
+}
+
+func (m *GetApplierQueueStatsResponse) Reset()                    { *m = GetApplierQueueStatsResponse{} }
+func (m *GetApplierQueueStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetApplierQueueStatsResponse) ProtoMessage()               {}
+func (*GetApplierQueueStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{170} }
+
+func (m *GetApplierQueueStatsResponse) GetStats() *ApplierQueueStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type MasterPositionRequest struct {
+}
+
+func (m *MasterPositionRequest) Reset()                    { *m = MasterPositionRequest{} }
+func (m *MasterPositionRequest) String() string            { return proto.CompactTextString(m) }
+func (*MasterPositionRequest) ProtoMessage()               {}
+func (*MasterPositionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{171} }
+
+type MasterPositionResponse struct {
+	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
+}
+
+func (m *MasterPositionResponse) Reset()                    { *m = MasterPositionResponse{} }
+func (m *MasterPositionResponse) String() string            { return proto.CompactTextString(m) }
+func (*MasterPositionResponse) ProtoMessage()               {}
+func (*MasterPositionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{172} }
+
+type GetGtidPurgedRequest struct {
+}
+
+func (m *GetGtidPurgedRequest) Reset()                    { *m = GetGtidPurgedRequest{} }
+func (m *GetGtidPurgedRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetGtidPurgedRequest) ProtoMessage()               {}
+func (*GetGtidPurgedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{173} }
+
+type GetGtidPurgedResponse struct {
+	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
+}
+
+func (m *GetGtidPurgedResponse) Reset()                    { *m = GetGtidPurgedResponse{} }
+func (m *GetGtidPurgedResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetGtidPurgedResponse) ProtoMessage()               {}
+func (*GetGtidPurgedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{174} }
+
+type GetBinlogStatsRequest struct {
+}
+
+func (m *GetBinlogStatsRequest) Reset()                    { *m = GetBinlogStatsRequest{} }
+func (m *GetBinlogStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBinlogStatsRequest) ProtoMessage()               {}
+func (*GetBinlogStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{175} }
+
+type GetBinlogStatsResponse struct {
+	// transactions_per_second is the rate at which transactions were
+	// added to the binlogs: written on a master, applied on a slave.
+	TransactionsPerSecond float64 `protobuf:"fixed64,1,opt,name=transactions_per_second,json=transactionsPerSecond" json:"transactions_per_second,omitempty"`
+	// window_ns is the duration the rate was measured over.
+	WindowNs int64 `protobuf:"varint,2,opt,name=window_ns,json=windowNs" json:"window_ns,omitempty"`
+}
+
+func (m *GetBinlogStatsResponse) Reset()                    { *m = GetBinlogStatsResponse{} }
+func (m *GetBinlogStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetBinlogStatsResponse) ProtoMessage()               {}
+func (*GetBinlogStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{176} }
+
+// ReplicationErrorStats are the replication errors and reconnects of
+// a tablet, counted by its health check. An error is counted once
+// while it persists.
+type ReplicationErrorStats struct {
+	IoErrors  int64 `protobuf:"varint,1,opt,name=io_errors,json=ioErrors" json:"io_errors,omitempty"`
+	SqlErrors int64 `protobuf:"varint,2,opt,name=sql_errors,json=sqlErrors" json:"sql_errors,omitempty"`
+	// reconnects is the number of times the tablet tried to reconnect
+	// its stopped replication to the master.
+	Reconnects int64 `protobuf:"varint,3,opt,name=reconnects" json:"reconnects,omitempty"`
+}
+
+func (m *ReplicationErrorStats) Reset()                    { *m = ReplicationErrorStats{} }
+func (m *ReplicationErrorStats) String() string            { return proto.CompactTextString(m) }
+func (*ReplicationErrorStats) ProtoMessage()               {}
+func (*ReplicationErrorStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{177} }
+
+type GetReplicationErrorStatsRequest struct {
+	// reset_counts clears the counts as they are read.
+	ResetCounts bool `protobuf:"varint,1,opt,name=reset_counts,json=resetCounts" json:"reset_counts,omitempty"`
+}
+
+func (m *GetReplicationErrorStatsRequest) Reset()         { *m = GetReplicationErrorStatsRequest{} }
+func (m *GetReplicationErrorStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationErrorStatsRequest) ProtoMessage()    {}
+func (*GetReplicationErrorStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{178}
+}
+
+type GetReplicationErrorStatsResponse struct {
+	Stats *ReplicationErrorStats `protobuf:"bytes,1,opt,name=stats" json:"stats,omitempty"`
+}
+
+func (m *GetReplicationErrorStatsResponse) Reset()         { *m = GetReplicationErrorStatsResponse{} }
+func (m *GetReplicationErrorStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationErrorStatsResponse) ProtoMessage()    {}
+func (*GetReplicationErrorStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{179}
+}
+
+func (m *GetReplicationErrorStatsResponse) GetStats() *ReplicationErrorStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type StopSlaveRequest struct {
+}
+
+func (m *StopSlaveRequest) Reset()                    { *m = StopSlaveRequest{} }
+func (m *StopSlaveRequest) String() string            { return proto.CompactTextString(m) }
+func (*StopSlaveRequest) ProtoMessage()               {}
+func (*StopSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{180} }
+
+type StopSlaveResponse struct {
+}
+
+func (m *StopSlaveResponse) Reset()                    { *m = StopSlaveResponse{} }
+func (m *StopSlaveResponse) String() string            { return proto.CompactTextString(m) }
 func (*StopSlaveResponse) ProtoMessage()               {}
-func (*StopSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*StopSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{181} }
 
 type StopSlaveMinimumRequest struct {
 	Position    string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
@@ -728,7 +2761,7 @@ type StopSlaveMinimumRequest struct {
 func (m *StopSlaveMinimumRequest) Reset()                    { *m = StopSlaveMinimumRequest{} }
 func (m *StopSlaveMinimumRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopSlaveMinimumRequest) ProtoMessage()               {}
-func (*StopSlaveMinimumRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*StopSlaveMinimumRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{182} }
 
 type StopSlaveMinimumResponse struct {
 	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
@@ -737,36 +2770,205 @@ type StopSlaveMinimumResponse struct {
 func (m *StopSlaveMinimumResponse) Reset()                    { *m = StopSlaveMinimumResponse{} }
 func (m *StopSlaveMinimumResponse) String() string            { return proto.CompactTextString(m) }
 func (*StopSlaveMinimumResponse) ProtoMessage()               {}
-func (*StopSlaveMinimumResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*StopSlaveMinimumResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{183} }
+
+type StopSlaveMinimumStreamRequest struct {
+	Position    string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
+	WaitTimeout int64  `protobuf:"varint,2,opt,name=wait_timeout,json=waitTimeout" json:"wait_timeout,omitempty"`
+}
+
+func (m *StopSlaveMinimumStreamRequest) Reset()                    { *m = StopSlaveMinimumStreamRequest{} }
+func (m *StopSlaveMinimumStreamRequest) String() string            { return proto.CompactTextString(m) }
+func (*StopSlaveMinimumStreamRequest) ProtoMessage()               {}
+func (*StopSlaveMinimumStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{184} }
+
+type StopSlaveMinimumStreamResponse struct {
+	// position is the current slave position while waiting, and the
+	// position replication was stopped at in the last message.
+	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
+	// stopped is set in the last message, once replication was stopped.
+	Stopped bool `protobuf:"varint,2,opt,name=stopped" json:"stopped,omitempty"`
+}
+
+func (m *StopSlaveMinimumStreamResponse) Reset()         { *m = StopSlaveMinimumStreamResponse{} }
+func (m *StopSlaveMinimumStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*StopSlaveMinimumStreamResponse) ProtoMessage()    {}
+func (*StopSlaveMinimumStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{185}
+}
+
+type BoostReplicationCatchupRequest struct {
+	Duration int64 `protobuf:"varint,1,opt,name=duration" json:"duration,omitempty"`
+}
+
+func (m *BoostReplicationCatchupRequest) Reset()                    { *m = BoostReplicationCatchupRequest{} }
+func (m *BoostReplicationCatchupRequest) String() string            { return proto.CompactTextString(m) }
+func (*BoostReplicationCatchupRequest) ProtoMessage()               {}
+func (*BoostReplicationCatchupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{186} }
+
+type BoostReplicationCatchupResponse struct {
+	SecondsBehindMaster int64 `protobuf:"varint,1,opt,name=seconds_behind_master,json=secondsBehindMaster" json:"seconds_behind_master,omitempty"`
+	// settings are the boosted MySQL settings in the first message,
+	// and the restored ones in the last message.
+	Settings map[string]int64 `protobuf:"bytes,2,rep,name=settings" json:"settings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// reverted is set in the last message, once the settings were
+	// restored.
+	Reverted bool `protobuf:"varint,3,opt,name=reverted" json:"reverted,omitempty"`
+}
+
+func (m *BoostReplicationCatchupResponse) Reset()                    { *m = BoostReplicationCatchupResponse{} }
+func (m *BoostReplicationCatchupResponse) String() string            { return proto.CompactTextString(m) }
+func (*BoostReplicationCatchupResponse) ProtoMessage()               {}
+func (*BoostReplicationCatchupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{187} }
+
+func (m *BoostReplicationCatchupResponse) GetSettings() map[string]int64 {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
 
 type StartSlaveRequest struct {
 }
 
-func (m *StartSlaveRequest) Reset()                    { *m = StartSlaveRequest{} }
-func (m *StartSlaveRequest) String() string            { return proto.CompactTextString(m) }
-func (*StartSlaveRequest) ProtoMessage()               {}
-func (*StartSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (m *StartSlaveRequest) Reset()                    { *m = StartSlaveRequest{} }
+func (m *StartSlaveRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartSlaveRequest) ProtoMessage()               {}
+func (*StartSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{188} }
+
+type StartSlaveResponse struct {
+}
+
+func (m *StartSlaveResponse) Reset()                    { *m = StartSlaveResponse{} }
+func (m *StartSlaveResponse) String() string            { return proto.CompactTextString(m) }
+func (*StartSlaveResponse) ProtoMessage()               {}
+func (*StartSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{189} }
+
+type EnableParallelReplicationRequest struct {
+	// workers is the new slave_parallel_workers.
+	Workers int64 `protobuf:"varint,1,opt,name=workers" json:"workers,omitempty"`
+	// parallel_type is the new slave_parallel_type, DATABASE or
+	// LOGICAL_CLOCK.
+	ParallelType string `protobuf:"bytes,2,opt,name=parallel_type,json=parallelType" json:"parallel_type,omitempty"`
+}
+
+func (m *EnableParallelReplicationRequest) Reset()                    { *m = EnableParallelReplicationRequest{} }
+func (m *EnableParallelReplicationRequest) String() string            { return proto.CompactTextString(m) }
+func (*EnableParallelReplicationRequest) ProtoMessage()               {}
+func (*EnableParallelReplicationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{190} }
+
+type EnableParallelReplicationResponse struct {
+}
+
+func (m *EnableParallelReplicationResponse) Reset()                    { *m = EnableParallelReplicationResponse{} }
+func (m *EnableParallelReplicationResponse) String() string            { return proto.CompactTextString(m) }
+func (*EnableParallelReplicationResponse) ProtoMessage()               {}
+func (*EnableParallelReplicationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{191} }
+
+type RotateReplicationCredentialsRequest struct {
+	User     string `protobuf:"bytes,1,opt,name=user" json:"user,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+}
+
+func (m *RotateReplicationCredentialsRequest) Reset()         { *m = RotateReplicationCredentialsRequest{} }
+func (m *RotateReplicationCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateReplicationCredentialsRequest) ProtoMessage()    {}
+func (*RotateReplicationCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{192}
+}
+
+type RotateReplicationCredentialsResponse struct {
+}
+
+func (m *RotateReplicationCredentialsResponse) Reset()         { *m = RotateReplicationCredentialsResponse{} }
+func (m *RotateReplicationCredentialsResponse) String() string { return proto.CompactTextString(m) }
+func (*RotateReplicationCredentialsResponse) ProtoMessage()    {}
+func (*RotateReplicationCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{193}
+}
+
+// ReplicationSSLOptions are the SSL files a slave connects to its
+// master with.
+type ReplicationSSLOptions struct {
+	// ca is the certificate authority file to check the master with.
+	Ca string `protobuf:"bytes,1,opt,name=ca" json:"ca,omitempty"`
+	// cert and key are the client certificate and key files.
+	Cert string `protobuf:"bytes,2,opt,name=cert" json:"cert,omitempty"`
+	Key  string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty"`
+}
+
+func (m *ReplicationSSLOptions) Reset()                    { *m = ReplicationSSLOptions{} }
+func (m *ReplicationSSLOptions) String() string            { return proto.CompactTextString(m) }
+func (*ReplicationSSLOptions) ProtoMessage()               {}
+func (*ReplicationSSLOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{194} }
+
+type ConfigureReplicationSSLRequest struct {
+	Options *ReplicationSSLOptions `protobuf:"bytes,1,opt,name=options" json:"options,omitempty"`
+}
+
+func (m *ConfigureReplicationSSLRequest) Reset()         { *m = ConfigureReplicationSSLRequest{} }
+func (m *ConfigureReplicationSSLRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfigureReplicationSSLRequest) ProtoMessage()    {}
+func (*ConfigureReplicationSSLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{195}
+}
+
+func (m *ConfigureReplicationSSLRequest) GetOptions() *ReplicationSSLOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type ConfigureReplicationSSLResponse struct {
+}
+
+func (m *ConfigureReplicationSSLResponse) Reset()         { *m = ConfigureReplicationSSLResponse{} }
+func (m *ConfigureReplicationSSLResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfigureReplicationSSLResponse) ProtoMessage()    {}
+func (*ConfigureReplicationSSLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{196}
+}
+
+type RepairRelayLogRequest struct {
+}
+
+func (m *RepairRelayLogRequest) Reset()                    { *m = RepairRelayLogRequest{} }
+func (m *RepairRelayLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*RepairRelayLogRequest) ProtoMessage()               {}
+func (*RepairRelayLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{197} }
 
-type StartSlaveResponse struct {
+type RepairRelayLogResponse struct {
+	Event *logutil.Event `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
 }
 
-func (m *StartSlaveResponse) Reset()                    { *m = StartSlaveResponse{} }
-func (m *StartSlaveResponse) String() string            { return proto.CompactTextString(m) }
-func (*StartSlaveResponse) ProtoMessage()               {}
-func (*StartSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (m *RepairRelayLogResponse) Reset()                    { *m = RepairRelayLogResponse{} }
+func (m *RepairRelayLogResponse) String() string            { return proto.CompactTextString(m) }
+func (*RepairRelayLogResponse) ProtoMessage()               {}
+func (*RepairRelayLogResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{198} }
+
+func (m *RepairRelayLogResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
 
 type TabletExternallyReparentedRequest struct {
 	// external_id is an string value that may be provided by an external
 	// agent for tracking purposes. The tablet will emit this string in
 	// events triggered by TabletExternallyReparented, such as VitessReparent.
 	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId" json:"external_id,omitempty"`
+	// validate makes the tablet reject the reparent if it is still
+	// replicating from another master.
+	Validate bool `protobuf:"varint,2,opt,name=validate" json:"validate,omitempty"`
 }
 
 func (m *TabletExternallyReparentedRequest) Reset()         { *m = TabletExternallyReparentedRequest{} }
 func (m *TabletExternallyReparentedRequest) String() string { return proto.CompactTextString(m) }
 func (*TabletExternallyReparentedRequest) ProtoMessage()    {}
 func (*TabletExternallyReparentedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{51}
+	return fileDescriptor0, []int{199}
 }
 
 type TabletExternallyReparentedResponse struct {
@@ -776,16 +2978,18 @@ func (m *TabletExternallyReparentedResponse) Reset()         { *m = TabletExtern
 func (m *TabletExternallyReparentedResponse) String() string { return proto.CompactTextString(m) }
 func (*TabletExternallyReparentedResponse) ProtoMessage()    {}
 func (*TabletExternallyReparentedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{52}
+	return fileDescriptor0, []int{200}
 }
 
 type TabletExternallyElectedRequest struct {
 }
 
-func (m *TabletExternallyElectedRequest) Reset()                    { *m = TabletExternallyElectedRequest{} }
-func (m *TabletExternallyElectedRequest) String() string            { return proto.CompactTextString(m) }
-func (*TabletExternallyElectedRequest) ProtoMessage()               {}
-func (*TabletExternallyElectedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (m *TabletExternallyElectedRequest) Reset()         { *m = TabletExternallyElectedRequest{} }
+func (m *TabletExternallyElectedRequest) String() string { return proto.CompactTextString(m) }
+func (*TabletExternallyElectedRequest) ProtoMessage()    {}
+func (*TabletExternallyElectedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{201}
+}
 
 type TabletExternallyElectedResponse struct {
 }
@@ -794,7 +2998,7 @@ func (m *TabletExternallyElectedResponse) Reset()         { *m = TabletExternall
 func (m *TabletExternallyElectedResponse) String() string { return proto.CompactTextString(m) }
 func (*TabletExternallyElectedResponse) ProtoMessage()    {}
 func (*TabletExternallyElectedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{54}
+	return fileDescriptor0, []int{202}
 }
 
 type GetSlavesRequest struct {
@@ -803,7 +3007,7 @@ type GetSlavesRequest struct {
 func (m *GetSlavesRequest) Reset()                    { *m = GetSlavesRequest{} }
 func (m *GetSlavesRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetSlavesRequest) ProtoMessage()               {}
-func (*GetSlavesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*GetSlavesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{203} }
 
 type GetSlavesResponse struct {
 	Addrs []string `protobuf:"bytes,1,rep,name=addrs" json:"addrs,omitempty"`
@@ -812,7 +3016,79 @@ type GetSlavesResponse struct {
 func (m *GetSlavesResponse) Reset()                    { *m = GetSlavesResponse{} }
 func (m *GetSlavesResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetSlavesResponse) ProtoMessage()               {}
-func (*GetSlavesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*GetSlavesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{204} }
+
+// ReplicationNeighbor is a mysql instance a tablet replicates from or
+// to.
+type ReplicationNeighbor struct {
+	// host is the host name or address mysql reports.
+	Host string `protobuf:"bytes,1,opt,name=host" json:"host,omitempty"`
+	// alias is the tablet of the shard running on host, if it could be
+	// found.
+	Alias *topodata.TabletAlias `protobuf:"bytes,2,opt,name=alias" json:"alias,omitempty"`
+}
+
+func (m *ReplicationNeighbor) Reset()                    { *m = ReplicationNeighbor{} }
+func (m *ReplicationNeighbor) String() string            { return proto.CompactTextString(m) }
+func (*ReplicationNeighbor) ProtoMessage()               {}
+func (*ReplicationNeighbor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{205} }
+
+func (m *ReplicationNeighbor) GetAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.Alias
+	}
+	return nil
+}
+
+// ReplicationGraph has the immediate replication neighbors of a tablet.
+type ReplicationGraph struct {
+	// master is unset if the tablet does not replicate.
+	Master *ReplicationNeighbor   `protobuf:"bytes,1,opt,name=master" json:"master,omitempty"`
+	Slaves []*ReplicationNeighbor `protobuf:"bytes,2,rep,name=slaves" json:"slaves,omitempty"`
+}
+
+func (m *ReplicationGraph) Reset()                    { *m = ReplicationGraph{} }
+func (m *ReplicationGraph) String() string            { return proto.CompactTextString(m) }
+func (*ReplicationGraph) ProtoMessage()               {}
+func (*ReplicationGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{206} }
+
+func (m *ReplicationGraph) GetMaster() *ReplicationNeighbor {
+	if m != nil {
+		return m.Master
+	}
+	return nil
+}
+
+func (m *ReplicationGraph) GetSlaves() []*ReplicationNeighbor {
+	if m != nil {
+		return m.Slaves
+	}
+	return nil
+}
+
+type GetReplicationGraphRequest struct {
+}
+
+func (m *GetReplicationGraphRequest) Reset()                    { *m = GetReplicationGraphRequest{} }
+func (m *GetReplicationGraphRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetReplicationGraphRequest) ProtoMessage()               {}
+func (*GetReplicationGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{207} }
+
+type GetReplicationGraphResponse struct {
+	Graph *ReplicationGraph `protobuf:"bytes,1,opt,name=graph" json:"graph,omitempty"`
+}
+
+func (m *GetReplicationGraphResponse) Reset()                    { *m = GetReplicationGraphResponse{} }
+func (m *GetReplicationGraphResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetReplicationGraphResponse) ProtoMessage()               {}
+func (*GetReplicationGraphResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{208} }
+
+func (m *GetReplicationGraphResponse) GetGraph() *ReplicationGraph {
+	if m != nil {
+		return m.Graph
+	}
+	return nil
+}
 
 type WaitBlpPositionRequest struct {
 	BlpPosition *BlpPosition `protobuf:"bytes,1,opt,name=blp_position,json=blpPosition" json:"blp_position,omitempty"`
@@ -822,7 +3098,7 @@ type WaitBlpPositionRequest struct {
 func (m *WaitBlpPositionRequest) Reset()                    { *m = WaitBlpPositionRequest{} }
 func (m *WaitBlpPositionRequest) String() string            { return proto.CompactTextString(m) }
 func (*WaitBlpPositionRequest) ProtoMessage()               {}
-func (*WaitBlpPositionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (*WaitBlpPositionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{209} }
 
 func (m *WaitBlpPositionRequest) GetBlpPosition() *BlpPosition {
 	if m != nil {
@@ -837,7 +3113,7 @@ type WaitBlpPositionResponse struct {
 func (m *WaitBlpPositionResponse) Reset()                    { *m = WaitBlpPositionResponse{} }
 func (m *WaitBlpPositionResponse) String() string            { return proto.CompactTextString(m) }
 func (*WaitBlpPositionResponse) ProtoMessage()               {}
-func (*WaitBlpPositionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+func (*WaitBlpPositionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{210} }
 
 type StopBlpRequest struct {
 }
@@ -845,7 +3121,7 @@ type StopBlpRequest struct {
 func (m *StopBlpRequest) Reset()                    { *m = StopBlpRequest{} }
 func (m *StopBlpRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopBlpRequest) ProtoMessage()               {}
-func (*StopBlpRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+func (*StopBlpRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{211} }
 
 type StopBlpResponse struct {
 	BlpPositions []*BlpPosition `protobuf:"bytes,1,rep,name=blp_positions,json=blpPositions" json:"blp_positions,omitempty"`
@@ -854,7 +3130,7 @@ type StopBlpResponse struct {
 func (m *StopBlpResponse) Reset()                    { *m = StopBlpResponse{} }
 func (m *StopBlpResponse) String() string            { return proto.CompactTextString(m) }
 func (*StopBlpResponse) ProtoMessage()               {}
-func (*StopBlpResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+func (*StopBlpResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{212} }
 
 func (m *StopBlpResponse) GetBlpPositions() []*BlpPosition {
 	if m != nil {
@@ -869,7 +3145,7 @@ type StartBlpRequest struct {
 func (m *StartBlpRequest) Reset()                    { *m = StartBlpRequest{} }
 func (m *StartBlpRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartBlpRequest) ProtoMessage()               {}
-func (*StartBlpRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+func (*StartBlpRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{213} }
 
 type StartBlpResponse struct {
 }
@@ -877,7 +3153,7 @@ type StartBlpResponse struct {
 func (m *StartBlpResponse) Reset()                    { *m = StartBlpResponse{} }
 func (m *StartBlpResponse) String() string            { return proto.CompactTextString(m) }
 func (*StartBlpResponse) ProtoMessage()               {}
-func (*StartBlpResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+func (*StartBlpResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{214} }
 
 type RunBlpUntilRequest struct {
 	BlpPositions []*BlpPosition `protobuf:"bytes,1,rep,name=blp_positions,json=blpPositions" json:"blp_positions,omitempty"`
@@ -887,7 +3163,7 @@ type RunBlpUntilRequest struct {
 func (m *RunBlpUntilRequest) Reset()                    { *m = RunBlpUntilRequest{} }
 func (m *RunBlpUntilRequest) String() string            { return proto.CompactTextString(m) }
 func (*RunBlpUntilRequest) ProtoMessage()               {}
-func (*RunBlpUntilRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+func (*RunBlpUntilRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{215} }
 
 func (m *RunBlpUntilRequest) GetBlpPositions() []*BlpPosition {
 	if m != nil {
@@ -903,7 +3179,60 @@ type RunBlpUntilResponse struct {
 func (m *RunBlpUntilResponse) Reset()                    { *m = RunBlpUntilResponse{} }
 func (m *RunBlpUntilResponse) String() string            { return proto.CompactTextString(m) }
 func (*RunBlpUntilResponse) ProtoMessage()               {}
-func (*RunBlpUntilResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+func (*RunBlpUntilResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{216} }
+
+// BinlogFilter is the filter a binlog player applies to the binlogs
+// of its source shard.
+type BinlogFilter struct {
+	// uid identifies the binlog player, as in BlpPosition.
+	Uid uint32 `protobuf:"varint,1,opt,name=uid" json:"uid,omitempty"`
+	// keyspace and shard are the source shard.
+	Keyspace string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,3,opt,name=shard" json:"shard,omitempty"`
+	// key_range is the range of the rows replicated, if the player
+	// replicates by keyrange: the intersection of the source and
+	// destination keyranges.
+	KeyRange *topodata.KeyRange `protobuf:"bytes,4,opt,name=key_range,json=keyRange" json:"key_range,omitempty"`
+	// tables are the tables replicated, if the player replicates
+	// by table.
+	Tables []string `protobuf:"bytes,5,rep,name=tables" json:"tables,omitempty"`
+}
+
+func (m *BinlogFilter) Reset()                    { *m = BinlogFilter{} }
+func (m *BinlogFilter) String() string            { return proto.CompactTextString(m) }
+func (*BinlogFilter) ProtoMessage()               {}
+func (*BinlogFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{217} }
+
+func (m *BinlogFilter) GetKeyRange() *topodata.KeyRange {
+	if m != nil {
+		return m.KeyRange
+	}
+	return nil
+}
+
+type GetBinlogFiltersRequest struct {
+}
+
+func (m *GetBinlogFiltersRequest) Reset()                    { *m = GetBinlogFiltersRequest{} }
+func (m *GetBinlogFiltersRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBinlogFiltersRequest) ProtoMessage()               {}
+func (*GetBinlogFiltersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{218} }
+
+type GetBinlogFiltersResponse struct {
+	Filters []*BinlogFilter `protobuf:"bytes,1,rep,name=filters" json:"filters,omitempty"`
+}
+
+func (m *GetBinlogFiltersResponse) Reset()                    { *m = GetBinlogFiltersResponse{} }
+func (m *GetBinlogFiltersResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetBinlogFiltersResponse) ProtoMessage()               {}
+func (*GetBinlogFiltersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{219} }
+
+func (m *GetBinlogFiltersResponse) GetFilters() []*BinlogFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
 
 type ResetReplicationRequest struct {
 }
@@ -911,7 +3240,7 @@ type ResetReplicationRequest struct {
 func (m *ResetReplicationRequest) Reset()                    { *m = ResetReplicationRequest{} }
 func (m *ResetReplicationRequest) String() string            { return proto.CompactTextString(m) }
 func (*ResetReplicationRequest) ProtoMessage()               {}
-func (*ResetReplicationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+func (*ResetReplicationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{220} }
 
 type ResetReplicationResponse struct {
 }
@@ -919,15 +3248,20 @@ type ResetReplicationResponse struct {
 func (m *ResetReplicationResponse) Reset()                    { *m = ResetReplicationResponse{} }
 func (m *ResetReplicationResponse) String() string            { return proto.CompactTextString(m) }
 func (*ResetReplicationResponse) ProtoMessage()               {}
-func (*ResetReplicationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+func (*ResetReplicationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{221} }
 
 type InitMasterRequest struct {
+	// expected_keyspace and expected_shard, if set, are checked against
+	// the tablet keyspace and shard, and the call is rejected if they
+	// do not match.
+	ExpectedKeyspace string `protobuf:"bytes,1,opt,name=expected_keyspace,json=expectedKeyspace" json:"expected_keyspace,omitempty"`
+	ExpectedShard    string `protobuf:"bytes,2,opt,name=expected_shard,json=expectedShard" json:"expected_shard,omitempty"`
 }
 
 func (m *InitMasterRequest) Reset()                    { *m = InitMasterRequest{} }
 func (m *InitMasterRequest) String() string            { return proto.CompactTextString(m) }
 func (*InitMasterRequest) ProtoMessage()               {}
-func (*InitMasterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+func (*InitMasterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{222} }
 
 type InitMasterResponse struct {
 	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
@@ -936,19 +3270,24 @@ type InitMasterResponse struct {
 func (m *InitMasterResponse) Reset()                    { *m = InitMasterResponse{} }
 func (m *InitMasterResponse) String() string            { return proto.CompactTextString(m) }
 func (*InitMasterResponse) ProtoMessage()               {}
-func (*InitMasterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+func (*InitMasterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{223} }
 
 type PopulateReparentJournalRequest struct {
 	TimeCreatedNs       int64                 `protobuf:"varint,1,opt,name=time_created_ns,json=timeCreatedNs" json:"time_created_ns,omitempty"`
 	ActionName          string                `protobuf:"bytes,2,opt,name=action_name,json=actionName" json:"action_name,omitempty"`
 	MasterAlias         *topodata.TabletAlias `protobuf:"bytes,3,opt,name=master_alias,json=masterAlias" json:"master_alias,omitempty"`
 	ReplicationPosition string                `protobuf:"bytes,4,opt,name=replication_position,json=replicationPosition" json:"replication_position,omitempty"`
+	// expected_keyspace and expected_shard: see InitMasterRequest.
+	ExpectedKeyspace string `protobuf:"bytes,5,opt,name=expected_keyspace,json=expectedKeyspace" json:"expected_keyspace,omitempty"`
+	ExpectedShard    string `protobuf:"bytes,6,opt,name=expected_shard,json=expectedShard" json:"expected_shard,omitempty"`
 }
 
-func (m *PopulateReparentJournalRequest) Reset()                    { *m = PopulateReparentJournalRequest{} }
-func (m *PopulateReparentJournalRequest) String() string            { return proto.CompactTextString(m) }
-func (*PopulateReparentJournalRequest) ProtoMessage()               {}
-func (*PopulateReparentJournalRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+func (m *PopulateReparentJournalRequest) Reset()         { *m = PopulateReparentJournalRequest{} }
+func (m *PopulateReparentJournalRequest) String() string { return proto.CompactTextString(m) }
+func (*PopulateReparentJournalRequest) ProtoMessage()    {}
+func (*PopulateReparentJournalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{224}
+}
 
 func (m *PopulateReparentJournalRequest) GetMasterAlias() *topodata.TabletAlias {
 	if m != nil {
@@ -964,7 +3303,52 @@ func (m *PopulateReparentJournalResponse) Reset()         { *m = PopulateReparen
 func (m *PopulateReparentJournalResponse) String() string { return proto.CompactTextString(m) }
 func (*PopulateReparentJournalResponse) ProtoMessage()    {}
 func (*PopulateReparentJournalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{70}
+	return fileDescriptor0, []int{225}
+}
+
+// ReparentJournalEntry is a row of the reparent_journal table.
+type ReparentJournalEntry struct {
+	TimeCreatedNs       int64                 `protobuf:"varint,1,opt,name=time_created_ns,json=timeCreatedNs" json:"time_created_ns,omitempty"`
+	ActionName          string                `protobuf:"bytes,2,opt,name=action_name,json=actionName" json:"action_name,omitempty"`
+	MasterAlias         *topodata.TabletAlias `protobuf:"bytes,3,opt,name=master_alias,json=masterAlias" json:"master_alias,omitempty"`
+	ReplicationPosition string                `protobuf:"bytes,4,opt,name=replication_position,json=replicationPosition" json:"replication_position,omitempty"`
+}
+
+func (m *ReparentJournalEntry) Reset()                    { *m = ReparentJournalEntry{} }
+func (m *ReparentJournalEntry) String() string            { return proto.CompactTextString(m) }
+func (*ReparentJournalEntry) ProtoMessage()               {}
+func (*ReparentJournalEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{226} }
+
+func (m *ReparentJournalEntry) GetMasterAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.MasterAlias
+	}
+	return nil
+}
+
+type GetLastReparentJournalEntryRequest struct {
+}
+
+func (m *GetLastReparentJournalEntryRequest) Reset()                    { *m = GetLastReparentJournalEntryRequest{} }
+func (m *GetLastReparentJournalEntryRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetLastReparentJournalEntryRequest) ProtoMessage()               {}
+func (*GetLastReparentJournalEntryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{227} }
+
+type GetLastReparentJournalEntryResponse struct {
+	// entry is not set if the reparent journal is empty.
+	Entry *ReparentJournalEntry `protobuf:"bytes,1,opt,name=entry" json:"entry,omitempty"`
+}
+
+func (m *GetLastReparentJournalEntryResponse) Reset()                    { *m = GetLastReparentJournalEntryResponse{} }
+func (m *GetLastReparentJournalEntryResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetLastReparentJournalEntryResponse) ProtoMessage()               {}
+func (*GetLastReparentJournalEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{228} }
+
+func (m *GetLastReparentJournalEntryResponse) GetEntry() *ReparentJournalEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
 }
 
 type InitSlaveRequest struct {
@@ -976,7 +3360,7 @@ type InitSlaveRequest struct {
 func (m *InitSlaveRequest) Reset()                    { *m = InitSlaveRequest{} }
 func (m *InitSlaveRequest) String() string            { return proto.CompactTextString(m) }
 func (*InitSlaveRequest) ProtoMessage()               {}
-func (*InitSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+func (*InitSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{229} }
 
 func (m *InitSlaveRequest) GetParent() *topodata.TabletAlias {
 	if m != nil {
@@ -991,7 +3375,7 @@ type InitSlaveResponse struct {
 func (m *InitSlaveResponse) Reset()                    { *m = InitSlaveResponse{} }
 func (m *InitSlaveResponse) String() string            { return proto.CompactTextString(m) }
 func (*InitSlaveResponse) ProtoMessage()               {}
-func (*InitSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+func (*InitSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{230} }
 
 type DemoteMasterRequest struct {
 }
@@ -999,7 +3383,7 @@ type DemoteMasterRequest struct {
 func (m *DemoteMasterRequest) Reset()                    { *m = DemoteMasterRequest{} }
 func (m *DemoteMasterRequest) String() string            { return proto.CompactTextString(m) }
 func (*DemoteMasterRequest) ProtoMessage()               {}
-func (*DemoteMasterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+func (*DemoteMasterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{231} }
 
 type DemoteMasterResponse struct {
 	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
@@ -1008,7 +3392,7 @@ type DemoteMasterResponse struct {
 func (m *DemoteMasterResponse) Reset()                    { *m = DemoteMasterResponse{} }
 func (m *DemoteMasterResponse) String() string            { return proto.CompactTextString(m) }
 func (*DemoteMasterResponse) ProtoMessage()               {}
-func (*DemoteMasterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+func (*DemoteMasterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{232} }
 
 type PromoteSlaveWhenCaughtUpRequest struct {
 	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
@@ -1018,7 +3402,7 @@ func (m *PromoteSlaveWhenCaughtUpRequest) Reset()         { *m = PromoteSlaveWhe
 func (m *PromoteSlaveWhenCaughtUpRequest) String() string { return proto.CompactTextString(m) }
 func (*PromoteSlaveWhenCaughtUpRequest) ProtoMessage()    {}
 func (*PromoteSlaveWhenCaughtUpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{75}
+	return fileDescriptor0, []int{233}
 }
 
 type PromoteSlaveWhenCaughtUpResponse struct {
@@ -1029,7 +3413,7 @@ func (m *PromoteSlaveWhenCaughtUpResponse) Reset()         { *m = PromoteSlaveWh
 func (m *PromoteSlaveWhenCaughtUpResponse) String() string { return proto.CompactTextString(m) }
 func (*PromoteSlaveWhenCaughtUpResponse) ProtoMessage()    {}
 func (*PromoteSlaveWhenCaughtUpResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{76}
+	return fileDescriptor0, []int{234}
 }
 
 type SlaveWasPromotedRequest struct {
@@ -1038,7 +3422,7 @@ type SlaveWasPromotedRequest struct {
 func (m *SlaveWasPromotedRequest) Reset()                    { *m = SlaveWasPromotedRequest{} }
 func (m *SlaveWasPromotedRequest) String() string            { return proto.CompactTextString(m) }
 func (*SlaveWasPromotedRequest) ProtoMessage()               {}
-func (*SlaveWasPromotedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+func (*SlaveWasPromotedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{235} }
 
 type SlaveWasPromotedResponse struct {
 }
@@ -1046,18 +3430,21 @@ type SlaveWasPromotedResponse struct {
 func (m *SlaveWasPromotedResponse) Reset()                    { *m = SlaveWasPromotedResponse{} }
 func (m *SlaveWasPromotedResponse) String() string            { return proto.CompactTextString(m) }
 func (*SlaveWasPromotedResponse) ProtoMessage()               {}
-func (*SlaveWasPromotedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+func (*SlaveWasPromotedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{236} }
 
 type SetMasterRequest struct {
 	Parent          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=parent" json:"parent,omitempty"`
 	TimeCreatedNs   int64                 `protobuf:"varint,2,opt,name=time_created_ns,json=timeCreatedNs" json:"time_created_ns,omitempty"`
 	ForceStartSlave bool                  `protobuf:"varint,3,opt,name=force_start_slave,json=forceStartSlave" json:"force_start_slave,omitempty"`
+	// expected_keyspace and expected_shard: see InitMasterRequest.
+	ExpectedKeyspace string `protobuf:"bytes,4,opt,name=expected_keyspace,json=expectedKeyspace" json:"expected_keyspace,omitempty"`
+	ExpectedShard    string `protobuf:"bytes,5,opt,name=expected_shard,json=expectedShard" json:"expected_shard,omitempty"`
 }
 
 func (m *SetMasterRequest) Reset()                    { *m = SetMasterRequest{} }
 func (m *SetMasterRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetMasterRequest) ProtoMessage()               {}
-func (*SetMasterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+func (*SetMasterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{237} }
 
 func (m *SetMasterRequest) GetParent() *topodata.TabletAlias {
 	if m != nil {
@@ -1072,7 +3459,40 @@ type SetMasterResponse struct {
 func (m *SetMasterResponse) Reset()                    { *m = SetMasterResponse{} }
 func (m *SetMasterResponse) String() string            { return proto.CompactTextString(m) }
 func (*SetMasterResponse) ProtoMessage()               {}
-func (*SetMasterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+func (*SetMasterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{238} }
+
+type ConfigureReplicationRequest struct {
+	Parent *topodata.TabletAlias `protobuf:"bytes,1,opt,name=parent" json:"parent,omitempty"`
+	// auto_position replicates from the GTID position. Otherwise,
+	// replication starts at file / position of the parent's binlogs.
+	AutoPosition    bool   `protobuf:"varint,2,opt,name=auto_position,json=autoPosition" json:"auto_position,omitempty"`
+	File            string `protobuf:"bytes,3,opt,name=file" json:"file,omitempty"`
+	Position        uint64 `protobuf:"varint,4,opt,name=position" json:"position,omitempty"`
+	ForceStartSlave bool   `protobuf:"varint,5,opt,name=force_start_slave,json=forceStartSlave" json:"force_start_slave,omitempty"`
+	// expected_keyspace and expected_shard: see InitMasterRequest.
+	ExpectedKeyspace string `protobuf:"bytes,6,opt,name=expected_keyspace,json=expectedKeyspace" json:"expected_keyspace,omitempty"`
+	ExpectedShard    string `protobuf:"bytes,7,opt,name=expected_shard,json=expectedShard" json:"expected_shard,omitempty"`
+}
+
+func (m *ConfigureReplicationRequest) Reset()                    { *m = ConfigureReplicationRequest{} }
+func (m *ConfigureReplicationRequest) String() string            { return proto.CompactTextString(m) }
+func (*ConfigureReplicationRequest) ProtoMessage()               {}
+func (*ConfigureReplicationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{239} }
+
+func (m *ConfigureReplicationRequest) GetParent() *topodata.TabletAlias {
+	if m != nil {
+		return m.Parent
+	}
+	return nil
+}
+
+type ConfigureReplicationResponse struct {
+}
+
+func (m *ConfigureReplicationResponse) Reset()                    { *m = ConfigureReplicationResponse{} }
+func (m *ConfigureReplicationResponse) String() string            { return proto.CompactTextString(m) }
+func (*ConfigureReplicationResponse) ProtoMessage()               {}
+func (*ConfigureReplicationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{240} }
 
 type SlaveWasRestartedRequest struct {
 	// the parent alias the tablet should have
@@ -1082,7 +3502,7 @@ type SlaveWasRestartedRequest struct {
 func (m *SlaveWasRestartedRequest) Reset()                    { *m = SlaveWasRestartedRequest{} }
 func (m *SlaveWasRestartedRequest) String() string            { return proto.CompactTextString(m) }
 func (*SlaveWasRestartedRequest) ProtoMessage()               {}
-func (*SlaveWasRestartedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+func (*SlaveWasRestartedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{241} }
 
 func (m *SlaveWasRestartedRequest) GetParent() *topodata.TabletAlias {
 	if m != nil {
@@ -1097,7 +3517,7 @@ type SlaveWasRestartedResponse struct {
 func (m *SlaveWasRestartedResponse) Reset()                    { *m = SlaveWasRestartedResponse{} }
 func (m *SlaveWasRestartedResponse) String() string            { return proto.CompactTextString(m) }
 func (*SlaveWasRestartedResponse) ProtoMessage()               {}
-func (*SlaveWasRestartedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+func (*SlaveWasRestartedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{242} }
 
 type StopReplicationAndGetStatusRequest struct {
 }
@@ -1106,7 +3526,7 @@ func (m *StopReplicationAndGetStatusRequest) Reset()         { *m = StopReplicat
 func (m *StopReplicationAndGetStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationAndGetStatusRequest) ProtoMessage()    {}
 func (*StopReplicationAndGetStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{83}
+	return fileDescriptor0, []int{243}
 }
 
 type StopReplicationAndGetStatusResponse struct {
@@ -1117,7 +3537,7 @@ func (m *StopReplicationAndGetStatusResponse) Reset()         { *m = StopReplica
 func (m *StopReplicationAndGetStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationAndGetStatusResponse) ProtoMessage()    {}
 func (*StopReplicationAndGetStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{84}
+	return fileDescriptor0, []int{244}
 }
 
 func (m *StopReplicationAndGetStatusResponse) GetStatus() *replicationdata.Status {
@@ -1133,7 +3553,7 @@ type PromoteSlaveRequest struct {
 func (m *PromoteSlaveRequest) Reset()                    { *m = PromoteSlaveRequest{} }
 func (m *PromoteSlaveRequest) String() string            { return proto.CompactTextString(m) }
 func (*PromoteSlaveRequest) ProtoMessage()               {}
-func (*PromoteSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+func (*PromoteSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{245} }
 
 type PromoteSlaveResponse struct {
 	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
@@ -1142,7 +3562,151 @@ type PromoteSlaveResponse struct {
 func (m *PromoteSlaveResponse) Reset()                    { *m = PromoteSlaveResponse{} }
 func (m *PromoteSlaveResponse) String() string            { return proto.CompactTextString(m) }
 func (*PromoteSlaveResponse) ProtoMessage()               {}
-func (*PromoteSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+func (*PromoteSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{246} }
+
+type SetReparentEligibilityRequest struct {
+	Eligible bool `protobuf:"varint,1,opt,name=eligible" json:"eligible,omitempty"`
+	// reason is reported by CheckReparentCandidate while the tablet
+	// is not eligible.
+	Reason string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *SetReparentEligibilityRequest) Reset()                    { *m = SetReparentEligibilityRequest{} }
+func (m *SetReparentEligibilityRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetReparentEligibilityRequest) ProtoMessage()               {}
+func (*SetReparentEligibilityRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{247} }
+
+type SetReparentEligibilityResponse struct {
+}
+
+func (m *SetReparentEligibilityResponse) Reset()         { *m = SetReparentEligibilityResponse{} }
+func (m *SetReparentEligibilityResponse) String() string { return proto.CompactTextString(m) }
+func (*SetReparentEligibilityResponse) ProtoMessage()    {}
+func (*SetReparentEligibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{248}
+}
+
+type CheckReparentCandidateRequest struct {
+}
+
+func (m *CheckReparentCandidateRequest) Reset()                    { *m = CheckReparentCandidateRequest{} }
+func (m *CheckReparentCandidateRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckReparentCandidateRequest) ProtoMessage()               {}
+func (*CheckReparentCandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{249} }
+
+type CheckReparentCandidateResponse struct {
+	Eligible bool   `protobuf:"varint,1,opt,name=eligible" json:"eligible,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *CheckReparentCandidateResponse) Reset()         { *m = CheckReparentCandidateResponse{} }
+func (m *CheckReparentCandidateResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckReparentCandidateResponse) ProtoMessage()    {}
+func (*CheckReparentCandidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{250}
+}
+
+type SetSemiSyncAckCountRequest struct {
+	// count is the number of semi-sync slaves the master waits for
+	// before acknowledging a commit.
+	Count int32 `protobuf:"varint,1,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *SetSemiSyncAckCountRequest) Reset()                    { *m = SetSemiSyncAckCountRequest{} }
+func (m *SetSemiSyncAckCountRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetSemiSyncAckCountRequest) ProtoMessage()               {}
+func (*SetSemiSyncAckCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{251} }
+
+type SetSemiSyncAckCountResponse struct {
+}
+
+func (m *SetSemiSyncAckCountResponse) Reset()                    { *m = SetSemiSyncAckCountResponse{} }
+func (m *SetSemiSyncAckCountResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetSemiSyncAckCountResponse) ProtoMessage()               {}
+func (*SetSemiSyncAckCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{252} }
+
+// DurabilityPolicy describes how a tablet configures semi-sync.
+type DurabilityPolicy struct {
+	// name is "semi_sync" if semi-sync is enabled on the master and
+	// its master eligible slaves, "none" otherwise.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// semi_sync_master and semi_sync_slave are the semi-sync settings
+	// the policy requires for the current tablet type.
+	SemiSyncMaster bool `protobuf:"varint,2,opt,name=semi_sync_master,json=semiSyncMaster" json:"semi_sync_master,omitempty"`
+	SemiSyncSlave  bool `protobuf:"varint,3,opt,name=semi_sync_slave,json=semiSyncSlave" json:"semi_sync_slave,omitempty"`
+	// mysql_semi_sync_master and mysql_semi_sync_slave are the
+	// settings currently in effect in MySQL.
+	MysqlSemiSyncMaster bool `protobuf:"varint,4,opt,name=mysql_semi_sync_master,json=mysqlSemiSyncMaster" json:"mysql_semi_sync_master,omitempty"`
+	MysqlSemiSyncSlave  bool `protobuf:"varint,5,opt,name=mysql_semi_sync_slave,json=mysqlSemiSyncSlave" json:"mysql_semi_sync_slave,omitempty"`
+}
+
+func (m *DurabilityPolicy) Reset()                    { *m = DurabilityPolicy{} }
+func (m *DurabilityPolicy) String() string            { return proto.CompactTextString(m) }
+func (*DurabilityPolicy) ProtoMessage()               {}
+func (*DurabilityPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{253} }
+
+type GetDurabilityPolicyRequest struct {
+}
+
+func (m *GetDurabilityPolicyRequest) Reset()                    { *m = GetDurabilityPolicyRequest{} }
+func (m *GetDurabilityPolicyRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDurabilityPolicyRequest) ProtoMessage()               {}
+func (*GetDurabilityPolicyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{254} }
+
+type GetDurabilityPolicyResponse struct {
+	Policy *DurabilityPolicy `protobuf:"bytes,1,opt,name=policy" json:"policy,omitempty"`
+}
+
+func (m *GetDurabilityPolicyResponse) Reset()                    { *m = GetDurabilityPolicyResponse{} }
+func (m *GetDurabilityPolicyResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDurabilityPolicyResponse) ProtoMessage()               {}
+func (*GetDurabilityPolicyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{255} }
+
+func (m *GetDurabilityPolicyResponse) GetPolicy() *DurabilityPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type GetBackupLimitsRequest struct {
+}
+
+func (m *GetBackupLimitsRequest) Reset()                    { *m = GetBackupLimitsRequest{} }
+func (m *GetBackupLimitsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBackupLimitsRequest) ProtoMessage()               {}
+func (*GetBackupLimitsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{256} }
+
+type GetBackupLimitsResponse struct {
+	// default_concurrency is the concurrency to use when none is given.
+	DefaultConcurrency int64 `protobuf:"varint,1,opt,name=default_concurrency,json=defaultConcurrency" json:"default_concurrency,omitempty"`
+	// max_concurrency is the highest concurrency the tablet accepts.
+	MaxConcurrency int64 `protobuf:"varint,2,opt,name=max_concurrency,json=maxConcurrency" json:"max_concurrency,omitempty"`
+}
+
+func (m *GetBackupLimitsResponse) Reset()                    { *m = GetBackupLimitsResponse{} }
+func (m *GetBackupLimitsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetBackupLimitsResponse) ProtoMessage()               {}
+func (*GetBackupLimitsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{257} }
+
+type GetBackupPositionRequest struct {
+}
+
+func (m *GetBackupPositionRequest) Reset()                    { *m = GetBackupPositionRequest{} }
+func (m *GetBackupPositionRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBackupPositionRequest) ProtoMessage()               {}
+func (*GetBackupPositionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{258} }
+
+type GetBackupPositionResponse struct {
+	// position is the replication position a backup taken now would
+	// capture.
+	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
+}
+
+func (m *GetBackupPositionResponse) Reset()                    { *m = GetBackupPositionResponse{} }
+func (m *GetBackupPositionResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetBackupPositionResponse) ProtoMessage()               {}
+func (*GetBackupPositionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{259} }
 
 type BackupRequest struct {
 	Concurrency int64 `protobuf:"varint,1,opt,name=concurrency" json:"concurrency,omitempty"`
@@ -1151,7 +3715,7 @@ type BackupRequest struct {
 func (m *BackupRequest) Reset()                    { *m = BackupRequest{} }
 func (m *BackupRequest) String() string            { return proto.CompactTextString(m) }
 func (*BackupRequest) ProtoMessage()               {}
-func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{260} }
 
 type BackupResponse struct {
 	Event *logutil.Event `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
@@ -1160,7 +3724,7 @@ type BackupResponse struct {
 func (m *BackupResponse) Reset()                    { *m = BackupResponse{} }
 func (m *BackupResponse) String() string            { return proto.CompactTextString(m) }
 func (*BackupResponse) ProtoMessage()               {}
-func (*BackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+func (*BackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{261} }
 
 func (m *BackupResponse) GetEvent() *logutil.Event {
 	if m != nil {
@@ -1169,13 +3733,39 @@ func (m *BackupResponse) GetEvent() *logutil.Event {
 	return nil
 }
 
+type IncrementalBackupRequest struct {
+	BaseBackupName string `protobuf:"bytes,1,opt,name=base_backup_name,json=baseBackupName" json:"base_backup_name,omitempty"`
+	Concurrency    int64  `protobuf:"varint,2,opt,name=concurrency" json:"concurrency,omitempty"`
+}
+
+func (m *IncrementalBackupRequest) Reset()                    { *m = IncrementalBackupRequest{} }
+func (m *IncrementalBackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*IncrementalBackupRequest) ProtoMessage()               {}
+func (*IncrementalBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{262} }
+
+type IncrementalBackupResponse struct {
+	Event *logutil.Event `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
+}
+
+func (m *IncrementalBackupResponse) Reset()                    { *m = IncrementalBackupResponse{} }
+func (m *IncrementalBackupResponse) String() string            { return proto.CompactTextString(m) }
+func (*IncrementalBackupResponse) ProtoMessage()               {}
+func (*IncrementalBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{263} }
+
+func (m *IncrementalBackupResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
 type RestoreFromBackupRequest struct {
 }
 
 func (m *RestoreFromBackupRequest) Reset()                    { *m = RestoreFromBackupRequest{} }
 func (m *RestoreFromBackupRequest) String() string            { return proto.CompactTextString(m) }
 func (*RestoreFromBackupRequest) ProtoMessage()               {}
-func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{264} }
 
 type RestoreFromBackupResponse struct {
 	Event *logutil.Event `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
@@ -1184,7 +3774,7 @@ type RestoreFromBackupResponse struct {
 func (m *RestoreFromBackupResponse) Reset()                    { *m = RestoreFromBackupResponse{} }
 func (m *RestoreFromBackupResponse) String() string            { return proto.CompactTextString(m) }
 func (*RestoreFromBackupResponse) ProtoMessage()               {}
-func (*RestoreFromBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+func (*RestoreFromBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{265} }
 
 func (m *RestoreFromBackupResponse) GetEvent() *logutil.Event {
 	if m != nil {
@@ -1193,6 +3783,262 @@ func (m *RestoreFromBackupResponse) GetEvent() *logutil.Event {
 	return nil
 }
 
+type RestoreToTimestampRequest struct {
+	BackupName string `protobuf:"bytes,1,opt,name=backup_name,json=backupName" json:"backup_name,omitempty"`
+	// target_time_ns is the time to recover to, in nanoseconds since epoch.
+	TargetTimeNs int64 `protobuf:"varint,2,opt,name=target_time_ns,json=targetTimeNs" json:"target_time_ns,omitempty"`
+}
+
+func (m *RestoreToTimestampRequest) Reset()                    { *m = RestoreToTimestampRequest{} }
+func (m *RestoreToTimestampRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestoreToTimestampRequest) ProtoMessage()               {}
+func (*RestoreToTimestampRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{266} }
+
+type RestoreToTimestampResponse struct {
+	Event *logutil.Event `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
+}
+
+func (m *RestoreToTimestampResponse) Reset()                    { *m = RestoreToTimestampResponse{} }
+func (m *RestoreToTimestampResponse) String() string            { return proto.CompactTextString(m) }
+func (*RestoreToTimestampResponse) ProtoMessage()               {}
+func (*RestoreToTimestampResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{267} }
+
+func (m *RestoreToTimestampResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type SetPreferredBackupRequest struct {
+	// backup_name is the backup new tablets restore from. Empty clears it.
+	BackupName string `protobuf:"bytes,1,opt,name=backup_name,json=backupName" json:"backup_name,omitempty"`
+}
+
+func (m *SetPreferredBackupRequest) Reset()                    { *m = SetPreferredBackupRequest{} }
+func (m *SetPreferredBackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetPreferredBackupRequest) ProtoMessage()               {}
+func (*SetPreferredBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{268} }
+
+type SetPreferredBackupResponse struct {
+}
+
+func (m *SetPreferredBackupResponse) Reset()                    { *m = SetPreferredBackupResponse{} }
+func (m *SetPreferredBackupResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetPreferredBackupResponse) ProtoMessage()               {}
+func (*SetPreferredBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{269} }
+
+type GetPreferredBackupRequest struct {
+}
+
+func (m *GetPreferredBackupRequest) Reset()                    { *m = GetPreferredBackupRequest{} }
+func (m *GetPreferredBackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetPreferredBackupRequest) ProtoMessage()               {}
+func (*GetPreferredBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{270} }
+
+type GetPreferredBackupResponse struct {
+	BackupName string `protobuf:"bytes,1,opt,name=backup_name,json=backupName" json:"backup_name,omitempty"`
+}
+
+func (m *GetPreferredBackupResponse) Reset()                    { *m = GetPreferredBackupResponse{} }
+func (m *GetPreferredBackupResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetPreferredBackupResponse) ProtoMessage()               {}
+func (*GetPreferredBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{271} }
+
+// CompatCheck compares one mysqld setting of a backup with the
+// tablet's.
+type CompatCheck struct {
+	// name is the setting: mysql_version, character_set or
+	// storage_engine.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// backup_value is empty if the backup did not record the setting.
+	BackupValue string `protobuf:"bytes,2,opt,name=backup_value,json=backupValue" json:"backup_value,omitempty"`
+	TabletValue string `protobuf:"bytes,3,opt,name=tablet_value,json=tabletValue" json:"tablet_value,omitempty"`
+	Compatible  bool   `protobuf:"varint,4,opt,name=compatible" json:"compatible,omitempty"`
+	// reason explains an incompatible or unknown setting.
+	Reason string `protobuf:"bytes,5,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *CompatCheck) Reset()                    { *m = CompatCheck{} }
+func (m *CompatCheck) String() string            { return proto.CompactTextString(m) }
+func (*CompatCheck) ProtoMessage()               {}
+func (*CompatCheck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{272} }
+
+// CompatReport is the result of CheckRestoreCompatibility.
+type CompatReport struct {
+	// compatible is true if all the checks are.
+	Compatible bool           `protobuf:"varint,1,opt,name=compatible" json:"compatible,omitempty"`
+	Checks     []*CompatCheck `protobuf:"bytes,2,rep,name=checks" json:"checks,omitempty"`
+}
+
+func (m *CompatReport) Reset()                    { *m = CompatReport{} }
+func (m *CompatReport) String() string            { return proto.CompactTextString(m) }
+func (*CompatReport) ProtoMessage()               {}
+func (*CompatReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{273} }
+
+func (m *CompatReport) GetChecks() []*CompatCheck {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+type CheckRestoreCompatibilityRequest struct {
+	BackupName string `protobuf:"bytes,1,opt,name=backup_name,json=backupName" json:"backup_name,omitempty"`
+}
+
+func (m *CheckRestoreCompatibilityRequest) Reset()         { *m = CheckRestoreCompatibilityRequest{} }
+func (m *CheckRestoreCompatibilityRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckRestoreCompatibilityRequest) ProtoMessage()    {}
+func (*CheckRestoreCompatibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{274}
+}
+
+type CheckRestoreCompatibilityResponse struct {
+	Report *CompatReport `protobuf:"bytes,1,opt,name=report" json:"report,omitempty"`
+}
+
+func (m *CheckRestoreCompatibilityResponse) Reset()         { *m = CheckRestoreCompatibilityResponse{} }
+func (m *CheckRestoreCompatibilityResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckRestoreCompatibilityResponse) ProtoMessage()    {}
+func (*CheckRestoreCompatibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{275}
+}
+
+func (m *CheckRestoreCompatibilityResponse) GetReport() *CompatReport {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
+// BackupInfo describes a backup taken by a tablet.
+type BackupInfo struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// size_bytes is the number of bytes written to the backup storage.
+	SizeBytes   int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes" json:"size_bytes,omitempty"`
+	StartTimeNs int64 `protobuf:"varint,3,opt,name=start_time_ns,json=startTimeNs" json:"start_time_ns,omitempty"`
+	EndTimeNs   int64 `protobuf:"varint,4,opt,name=end_time_ns,json=endTimeNs" json:"end_time_ns,omitempty"`
+	DurationNs  int64 `protobuf:"varint,5,opt,name=duration_ns,json=durationNs" json:"duration_ns,omitempty"`
+	Success     bool  `protobuf:"varint,6,opt,name=success" json:"success,omitempty"`
+	// error is set if the backup failed.
+	Error string `protobuf:"bytes,7,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *BackupInfo) Reset()                    { *m = BackupInfo{} }
+func (m *BackupInfo) String() string            { return proto.CompactTextString(m) }
+func (*BackupInfo) ProtoMessage()               {}
+func (*BackupInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{276} }
+
+type GetLastBackupInfoRequest struct {
+}
+
+func (m *GetLastBackupInfoRequest) Reset()                    { *m = GetLastBackupInfoRequest{} }
+func (m *GetLastBackupInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetLastBackupInfoRequest) ProtoMessage()               {}
+func (*GetLastBackupInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{277} }
+
+type GetLastBackupInfoResponse struct {
+	// info is not set if the tablet did not take a backup since it
+	// started.
+	Info *BackupInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+}
+
+func (m *GetLastBackupInfoResponse) Reset()                    { *m = GetLastBackupInfoResponse{} }
+func (m *GetLastBackupInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetLastBackupInfoResponse) ProtoMessage()               {}
+func (*GetLastBackupInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{278} }
+
+func (m *GetLastBackupInfoResponse) GetInfo() *BackupInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type GetBackupFreshnessRequest struct {
+}
+
+func (m *GetBackupFreshnessRequest) Reset()                    { *m = GetBackupFreshnessRequest{} }
+func (m *GetBackupFreshnessRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBackupFreshnessRequest) ProtoMessage()               {}
+func (*GetBackupFreshnessRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{279} }
+
+type GetBackupFreshnessResponse struct {
+	// backup_name is the most recent complete full backup of the
+	// shard, or empty if there is none.
+	BackupName string `protobuf:"bytes,1,opt,name=backup_name,json=backupName" json:"backup_name,omitempty"`
+	// age_ns is how long ago that backup was taken, or -1 if the
+	// shard never had a complete full backup.
+	AgeNs int64 `protobuf:"varint,2,opt,name=age_ns,json=ageNs" json:"age_ns,omitempty"`
+}
+
+func (m *GetBackupFreshnessResponse) Reset()                    { *m = GetBackupFreshnessResponse{} }
+func (m *GetBackupFreshnessResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetBackupFreshnessResponse) ProtoMessage()               {}
+func (*GetBackupFreshnessResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{280} }
+
+// ReadinessReport says if a tablet can take a consistent backup.
+type ReadinessReport struct {
+	Ready bool `protobuf:"varint,1,opt,name=ready" json:"ready,omitempty"`
+	// reasons are why the tablet is not ready, e.g. a running DDL.
+	Reasons []string `protobuf:"bytes,2,rep,name=reasons" json:"reasons,omitempty"`
+}
+
+func (m *ReadinessReport) Reset()                    { *m = ReadinessReport{} }
+func (m *ReadinessReport) String() string            { return proto.CompactTextString(m) }
+func (*ReadinessReport) ProtoMessage()               {}
+func (*ReadinessReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{281} }
+
+type CheckBackupReadinessRequest struct {
+}
+
+func (m *CheckBackupReadinessRequest) Reset()                    { *m = CheckBackupReadinessRequest{} }
+func (m *CheckBackupReadinessRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckBackupReadinessRequest) ProtoMessage()               {}
+func (*CheckBackupReadinessRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{282} }
+
+type CheckBackupReadinessResponse struct {
+	Report *ReadinessReport `protobuf:"bytes,1,opt,name=report" json:"report,omitempty"`
+}
+
+func (m *CheckBackupReadinessResponse) Reset()                    { *m = CheckBackupReadinessResponse{} }
+func (m *CheckBackupReadinessResponse) String() string            { return proto.CompactTextString(m) }
+func (*CheckBackupReadinessResponse) ProtoMessage()               {}
+func (*CheckBackupReadinessResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{283} }
+
+func (m *CheckBackupReadinessResponse) GetReport() *ReadinessReport {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
+type TestRestoreRequest struct {
+	BackupName string `protobuf:"bytes,1,opt,name=backup_name,json=backupName" json:"backup_name,omitempty"`
+}
+
+func (m *TestRestoreRequest) Reset()                    { *m = TestRestoreRequest{} }
+func (m *TestRestoreRequest) String() string            { return proto.CompactTextString(m) }
+func (*TestRestoreRequest) ProtoMessage()               {}
+func (*TestRestoreRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{284} }
+
+type TestRestoreResponse struct {
+	Event *logutil.Event `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
+}
+
+func (m *TestRestoreResponse) Reset()                    { *m = TestRestoreResponse{} }
+func (m *TestRestoreResponse) String() string            { return proto.CompactTextString(m) }
+func (*TestRestoreResponse) ProtoMessage()               {}
+func (*TestRestoreResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{285} }
+
+func (m *TestRestoreResponse) GetEvent() *logutil.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TableDefinition)(nil), "tabletmanagerdata.TableDefinition")
 	proto.RegisterType((*SchemaDefinition)(nil), "tabletmanagerdata.SchemaDefinition")
@@ -1207,50 +4053,202 @@ func init() {
 	proto.RegisterType((*SleepResponse)(nil), "tabletmanagerdata.SleepResponse")
 	proto.RegisterType((*ExecuteHookRequest)(nil), "tabletmanagerdata.ExecuteHookRequest")
 	proto.RegisterType((*ExecuteHookResponse)(nil), "tabletmanagerdata.ExecuteHookResponse")
+	proto.RegisterType((*ExecuteHookToStreamRequest)(nil), "tabletmanagerdata.ExecuteHookToStreamRequest")
+	proto.RegisterType((*ExecuteHookToStreamResponse)(nil), "tabletmanagerdata.ExecuteHookToStreamResponse")
 	proto.RegisterType((*GetSchemaRequest)(nil), "tabletmanagerdata.GetSchemaRequest")
 	proto.RegisterType((*GetSchemaResponse)(nil), "tabletmanagerdata.GetSchemaResponse")
+	proto.RegisterType((*GetCreateStatementsRequest)(nil), "tabletmanagerdata.GetCreateStatementsRequest")
+	proto.RegisterType((*GetCreateStatementsResponse)(nil), "tabletmanagerdata.GetCreateStatementsResponse")
+	proto.RegisterType((*GetSchemaTimestampsRequest)(nil), "tabletmanagerdata.GetSchemaTimestampsRequest")
+	proto.RegisterType((*GetSchemaTimestampsResponse)(nil), "tabletmanagerdata.GetSchemaTimestampsResponse")
 	proto.RegisterType((*GetPermissionsRequest)(nil), "tabletmanagerdata.GetPermissionsRequest")
 	proto.RegisterType((*GetPermissionsResponse)(nil), "tabletmanagerdata.GetPermissionsResponse")
+	proto.RegisterType((*ConnectionStats)(nil), "tabletmanagerdata.ConnectionStats")
+	proto.RegisterType((*GetConnectionStatsRequest)(nil), "tabletmanagerdata.GetConnectionStatsRequest")
+	proto.RegisterType((*GetConnectionStatsResponse)(nil), "tabletmanagerdata.GetConnectionStatsResponse")
+	proto.RegisterType((*GetConnectionRejectionStatsRequest)(nil), "tabletmanagerdata.GetConnectionRejectionStatsRequest")
+	proto.RegisterType((*GetConnectionRejectionStatsResponse)(nil), "tabletmanagerdata.GetConnectionRejectionStatsResponse")
+	proto.RegisterType((*GetConfigRequest)(nil), "tabletmanagerdata.GetConfigRequest")
+	proto.RegisterType((*GetConfigResponse)(nil), "tabletmanagerdata.GetConfigResponse")
+	proto.RegisterType((*GetInFlightRPCsRequest)(nil), "tabletmanagerdata.GetInFlightRPCsRequest")
+	proto.RegisterType((*GetInFlightRPCsResponse)(nil), "tabletmanagerdata.GetInFlightRPCsResponse")
+	proto.RegisterType((*ProcessStats)(nil), "tabletmanagerdata.ProcessStats")
+	proto.RegisterType((*GetProcessStatsRequest)(nil), "tabletmanagerdata.GetProcessStatsRequest")
+	proto.RegisterType((*GetProcessStatsResponse)(nil), "tabletmanagerdata.GetProcessStatsResponse")
+	proto.RegisterType((*HealthScore)(nil), "tabletmanagerdata.HealthScore")
+	proto.RegisterType((*GetHealthScoreRequest)(nil), "tabletmanagerdata.GetHealthScoreRequest")
+	proto.RegisterType((*GetHealthScoreResponse)(nil), "tabletmanagerdata.GetHealthScoreResponse")
+	proto.RegisterType((*GetErrorLogTailRequest)(nil), "tabletmanagerdata.GetErrorLogTailRequest")
+	proto.RegisterType((*GetErrorLogTailResponse)(nil), "tabletmanagerdata.GetErrorLogTailResponse")
+	proto.RegisterType((*CheckDataDirRequest)(nil), "tabletmanagerdata.CheckDataDirRequest")
+	proto.RegisterType((*CheckDataDirResponse)(nil), "tabletmanagerdata.CheckDataDirResponse")
+	proto.RegisterType((*DiagnosticBundle)(nil), "tabletmanagerdata.DiagnosticBundle")
+	proto.RegisterType((*CaptureDiagnosticsRequest)(nil), "tabletmanagerdata.CaptureDiagnosticsRequest")
+	proto.RegisterType((*CaptureDiagnosticsResponse)(nil), "tabletmanagerdata.CaptureDiagnosticsResponse")
+	proto.RegisterType((*ThrottleState)(nil), "tabletmanagerdata.ThrottleState")
+	proto.RegisterType((*GetThrottleStateRequest)(nil), "tabletmanagerdata.GetThrottleStateRequest")
+	proto.RegisterType((*GetThrottleStateResponse)(nil), "tabletmanagerdata.GetThrottleStateResponse")
+	proto.RegisterType((*GetTabletTagsRequest)(nil), "tabletmanagerdata.GetTabletTagsRequest")
+	proto.RegisterType((*GetTabletTagsResponse)(nil), "tabletmanagerdata.GetTabletTagsResponse")
+	proto.RegisterType((*SetThrottleRequest)(nil), "tabletmanagerdata.SetThrottleRequest")
+	proto.RegisterType((*SetThrottleResponse)(nil), "tabletmanagerdata.SetThrottleResponse")
 	proto.RegisterType((*SetReadOnlyRequest)(nil), "tabletmanagerdata.SetReadOnlyRequest")
 	proto.RegisterType((*SetReadOnlyResponse)(nil), "tabletmanagerdata.SetReadOnlyResponse")
 	proto.RegisterType((*SetReadWriteRequest)(nil), "tabletmanagerdata.SetReadWriteRequest")
 	proto.RegisterType((*SetReadWriteResponse)(nil), "tabletmanagerdata.SetReadWriteResponse")
+	proto.RegisterType((*SetReadOnlyWithTTLRequest)(nil), "tabletmanagerdata.SetReadOnlyWithTTLRequest")
+	proto.RegisterType((*SetReadOnlyWithTTLResponse)(nil), "tabletmanagerdata.SetReadOnlyWithTTLResponse")
+	proto.RegisterType((*SetSuperReadOnlyRequest)(nil), "tabletmanagerdata.SetSuperReadOnlyRequest")
+	proto.RegisterType((*SetSuperReadOnlyResponse)(nil), "tabletmanagerdata.SetSuperReadOnlyResponse")
+	proto.RegisterType((*QueryServerConfig)(nil), "tabletmanagerdata.QueryServerConfig")
+	proto.RegisterType((*SetQueryServerConfigRequest)(nil), "tabletmanagerdata.SetQueryServerConfigRequest")
+	proto.RegisterType((*SetQueryServerConfigResponse)(nil), "tabletmanagerdata.SetQueryServerConfigResponse")
+	proto.RegisterType((*GetQueryBlacklistRequest)(nil), "tabletmanagerdata.GetQueryBlacklistRequest")
+	proto.RegisterType((*GetQueryBlacklistResponse)(nil), "tabletmanagerdata.GetQueryBlacklistResponse")
+	proto.RegisterType((*SetQueryBlacklistRequest)(nil), "tabletmanagerdata.SetQueryBlacklistRequest")
+	proto.RegisterType((*SetQueryBlacklistResponse)(nil), "tabletmanagerdata.SetQueryBlacklistResponse")
 	proto.RegisterType((*ChangeTypeRequest)(nil), "tabletmanagerdata.ChangeTypeRequest")
 	proto.RegisterType((*ChangeTypeResponse)(nil), "tabletmanagerdata.ChangeTypeResponse")
+	proto.RegisterType((*SetTabletTagsRequest)(nil), "tabletmanagerdata.SetTabletTagsRequest")
+	proto.RegisterType((*SetTabletTagsResponse)(nil), "tabletmanagerdata.SetTabletTagsResponse")
 	proto.RegisterType((*RefreshStateRequest)(nil), "tabletmanagerdata.RefreshStateRequest")
 	proto.RegisterType((*RefreshStateResponse)(nil), "tabletmanagerdata.RefreshStateResponse")
+	proto.RegisterType((*RepublishTopoRecordRequest)(nil), "tabletmanagerdata.RepublishTopoRecordRequest")
+	proto.RegisterType((*RepublishTopoRecordResponse)(nil), "tabletmanagerdata.RepublishTopoRecordResponse")
 	proto.RegisterType((*RunHealthCheckRequest)(nil), "tabletmanagerdata.RunHealthCheckRequest")
 	proto.RegisterType((*RunHealthCheckResponse)(nil), "tabletmanagerdata.RunHealthCheckResponse")
 	proto.RegisterType((*IgnoreHealthErrorRequest)(nil), "tabletmanagerdata.IgnoreHealthErrorRequest")
 	proto.RegisterType((*IgnoreHealthErrorResponse)(nil), "tabletmanagerdata.IgnoreHealthErrorResponse")
+	proto.RegisterType((*SetMaintenanceModeRequest)(nil), "tabletmanagerdata.SetMaintenanceModeRequest")
+	proto.RegisterType((*SetMaintenanceModeResponse)(nil), "tabletmanagerdata.SetMaintenanceModeResponse")
+	proto.RegisterType((*PauseHealthReportingRequest)(nil), "tabletmanagerdata.PauseHealthReportingRequest")
+	proto.RegisterType((*PauseHealthReportingResponse)(nil), "tabletmanagerdata.PauseHealthReportingResponse")
+	proto.RegisterType((*PrepareCutoverRequest)(nil), "tabletmanagerdata.PrepareCutoverRequest")
+	proto.RegisterType((*PrepareCutoverResponse)(nil), "tabletmanagerdata.PrepareCutoverResponse")
+	proto.RegisterType((*CommitCutoverRequest)(nil), "tabletmanagerdata.CommitCutoverRequest")
+	proto.RegisterType((*CommitCutoverResponse)(nil), "tabletmanagerdata.CommitCutoverResponse")
+	proto.RegisterType((*AbortCutoverRequest)(nil), "tabletmanagerdata.AbortCutoverRequest")
+	proto.RegisterType((*AbortCutoverResponse)(nil), "tabletmanagerdata.AbortCutoverResponse")
+	proto.RegisterType((*SetServingKeyRangeRequest)(nil), "tabletmanagerdata.SetServingKeyRangeRequest")
+	proto.RegisterType((*SetServingKeyRangeResponse)(nil), "tabletmanagerdata.SetServingKeyRangeResponse")
+	proto.RegisterType((*RestartMysqlRequest)(nil), "tabletmanagerdata.RestartMysqlRequest")
+	proto.RegisterType((*RestartMysqlResponse)(nil), "tabletmanagerdata.RestartMysqlResponse")
+	proto.RegisterType((*DecommissionRequest)(nil), "tabletmanagerdata.DecommissionRequest")
+	proto.RegisterType((*DecommissionResponse)(nil), "tabletmanagerdata.DecommissionResponse")
+	proto.RegisterType((*CheckTopoConnectivityRequest)(nil), "tabletmanagerdata.CheckTopoConnectivityRequest")
+	proto.RegisterType((*CheckTopoConnectivityResponse)(nil), "tabletmanagerdata.CheckTopoConnectivityResponse")
+	proto.RegisterType((*WarmUpRequest)(nil), "tabletmanagerdata.WarmUpRequest")
+	proto.RegisterType((*WarmUpResponse)(nil), "tabletmanagerdata.WarmUpResponse")
 	proto.RegisterType((*ReloadSchemaRequest)(nil), "tabletmanagerdata.ReloadSchemaRequest")
 	proto.RegisterType((*ReloadSchemaResponse)(nil), "tabletmanagerdata.ReloadSchemaResponse")
 	proto.RegisterType((*PreflightSchemaRequest)(nil), "tabletmanagerdata.PreflightSchemaRequest")
 	proto.RegisterType((*PreflightSchemaResponse)(nil), "tabletmanagerdata.PreflightSchemaResponse")
 	proto.RegisterType((*ApplySchemaRequest)(nil), "tabletmanagerdata.ApplySchemaRequest")
 	proto.RegisterType((*ApplySchemaResponse)(nil), "tabletmanagerdata.ApplySchemaResponse")
+	proto.RegisterType((*SchemaDiffRequest)(nil), "tabletmanagerdata.SchemaDiffRequest")
+	proto.RegisterType((*SchemaDiffResponse)(nil), "tabletmanagerdata.SchemaDiffResponse")
+	proto.RegisterType((*SchemaChangeAssessment)(nil), "tabletmanagerdata.SchemaChangeAssessment")
+	proto.RegisterType((*AssessSchemaChangeRequest)(nil), "tabletmanagerdata.AssessSchemaChangeRequest")
+	proto.RegisterType((*AssessSchemaChangeResponse)(nil), "tabletmanagerdata.AssessSchemaChangeResponse")
+	proto.RegisterType((*SchemaChangeEvent)(nil), "tabletmanagerdata.SchemaChangeEvent")
+	proto.RegisterType((*WatchSchemaRequest)(nil), "tabletmanagerdata.WatchSchemaRequest")
+	proto.RegisterType((*WatchSchemaResponse)(nil), "tabletmanagerdata.WatchSchemaResponse")
+	proto.RegisterType((*GetVSchemaRequest)(nil), "tabletmanagerdata.GetVSchemaRequest")
+	proto.RegisterType((*GetVSchemaResponse)(nil), "tabletmanagerdata.GetVSchemaResponse")
+	proto.RegisterType((*ApplyVSchemaRequest)(nil), "tabletmanagerdata.ApplyVSchemaRequest")
+	proto.RegisterType((*ApplyVSchemaResponse)(nil), "tabletmanagerdata.ApplyVSchemaResponse")
 	proto.RegisterType((*ExecuteFetchAsDbaRequest)(nil), "tabletmanagerdata.ExecuteFetchAsDbaRequest")
 	proto.RegisterType((*ExecuteFetchAsDbaResponse)(nil), "tabletmanagerdata.ExecuteFetchAsDbaResponse")
+	proto.RegisterType((*ExecuteFetchAsDbaCSVRequest)(nil), "tabletmanagerdata.ExecuteFetchAsDbaCSVRequest")
+	proto.RegisterType((*ExecuteFetchAsDbaCSVResponse)(nil), "tabletmanagerdata.ExecuteFetchAsDbaCSVResponse")
+	proto.RegisterType((*ColumnarResult)(nil), "tabletmanagerdata.ColumnarResult")
+	proto.RegisterType((*ExecuteFetchColumnarRequest)(nil), "tabletmanagerdata.ExecuteFetchColumnarRequest")
+	proto.RegisterType((*ExecuteFetchColumnarResponse)(nil), "tabletmanagerdata.ExecuteFetchColumnarResponse")
 	proto.RegisterType((*ExecuteFetchAsAllPrivsRequest)(nil), "tabletmanagerdata.ExecuteFetchAsAllPrivsRequest")
 	proto.RegisterType((*ExecuteFetchAsAllPrivsResponse)(nil), "tabletmanagerdata.ExecuteFetchAsAllPrivsResponse")
 	proto.RegisterType((*ExecuteFetchAsAppRequest)(nil), "tabletmanagerdata.ExecuteFetchAsAppRequest")
 	proto.RegisterType((*ExecuteFetchAsAppResponse)(nil), "tabletmanagerdata.ExecuteFetchAsAppResponse")
+	proto.RegisterType((*ExplainQueryRequest)(nil), "tabletmanagerdata.ExplainQueryRequest")
+	proto.RegisterType((*ExplainQueryResponse)(nil), "tabletmanagerdata.ExplainQueryResponse")
+	proto.RegisterType((*ApplyGrantsRequest)(nil), "tabletmanagerdata.ApplyGrantsRequest")
+	proto.RegisterType((*ApplyGrantsResponse)(nil), "tabletmanagerdata.ApplyGrantsResponse")
+	proto.RegisterType((*ChecksumTableRequest)(nil), "tabletmanagerdata.ChecksumTableRequest")
+	proto.RegisterType((*ChecksumTableResponse)(nil), "tabletmanagerdata.ChecksumTableResponse")
+	proto.RegisterType((*TruncateTableRequest)(nil), "tabletmanagerdata.TruncateTableRequest")
+	proto.RegisterType((*TruncateTableResponse)(nil), "tabletmanagerdata.TruncateTableResponse")
+	proto.RegisterType((*RenameTableRequest)(nil), "tabletmanagerdata.RenameTableRequest")
+	proto.RegisterType((*RenameTableResult)(nil), "tabletmanagerdata.RenameTableResult")
+	proto.RegisterType((*RenameTableResponse)(nil), "tabletmanagerdata.RenameTableResponse")
+	proto.RegisterType((*StreamRowsInKeyRangeRequest)(nil), "tabletmanagerdata.StreamRowsInKeyRangeRequest")
+	proto.RegisterType((*StreamRowsInKeyRangeResponse)(nil), "tabletmanagerdata.StreamRowsInKeyRangeResponse")
+	proto.RegisterType((*StreamKeyRangeBinlogRequest)(nil), "tabletmanagerdata.StreamKeyRangeBinlogRequest")
+	proto.RegisterType((*StreamKeyRangeBinlogResponse)(nil), "tabletmanagerdata.StreamKeyRangeBinlogResponse")
+	proto.RegisterType((*CloneStreamRequest)(nil), "tabletmanagerdata.CloneStreamRequest")
+	proto.RegisterType((*CloneStreamResponse)(nil), "tabletmanagerdata.CloneStreamResponse")
+	proto.RegisterType((*Process)(nil), "tabletmanagerdata.Process")
+	proto.RegisterType((*GetProcessListRequest)(nil), "tabletmanagerdata.GetProcessListRequest")
+	proto.RegisterType((*GetProcessListResponse)(nil), "tabletmanagerdata.GetProcessListResponse")
+	proto.RegisterType((*CharsetConfig)(nil), "tabletmanagerdata.CharsetConfig")
+	proto.RegisterType((*GetCharsetConfigRequest)(nil), "tabletmanagerdata.GetCharsetConfigRequest")
+	proto.RegisterType((*GetCharsetConfigResponse)(nil), "tabletmanagerdata.GetCharsetConfigResponse")
+	proto.RegisterType((*KillProcessRequest)(nil), "tabletmanagerdata.KillProcessRequest")
+	proto.RegisterType((*KillProcessResponse)(nil), "tabletmanagerdata.KillProcessResponse")
+	proto.RegisterType((*TailGeneralLogRequest)(nil), "tabletmanagerdata.TailGeneralLogRequest")
+	proto.RegisterType((*TailGeneralLogResponse)(nil), "tabletmanagerdata.TailGeneralLogResponse")
+	proto.RegisterType((*QPSSample)(nil), "tabletmanagerdata.QPSSample")
+	proto.RegisterType((*StreamQPSRequest)(nil), "tabletmanagerdata.StreamQPSRequest")
+	proto.RegisterType((*StreamQPSResponse)(nil), "tabletmanagerdata.StreamQPSResponse")
 	proto.RegisterType((*SlaveStatusRequest)(nil), "tabletmanagerdata.SlaveStatusRequest")
 	proto.RegisterType((*SlaveStatusResponse)(nil), "tabletmanagerdata.SlaveStatusResponse")
+	proto.RegisterType((*SlaveStatusAllChannelsRequest)(nil), "tabletmanagerdata.SlaveStatusAllChannelsRequest")
+	proto.RegisterType((*SlaveStatusAllChannelsResponse)(nil), "tabletmanagerdata.SlaveStatusAllChannelsResponse")
+	proto.RegisterType((*ReplicationSource)(nil), "tabletmanagerdata.ReplicationSource")
+	proto.RegisterType((*GetReplicationSourceRequest)(nil), "tabletmanagerdata.GetReplicationSourceRequest")
+	proto.RegisterType((*GetReplicationSourceResponse)(nil), "tabletmanagerdata.GetReplicationSourceResponse")
+	proto.RegisterType((*ValidateReplicationCredentialsRequest)(nil), "tabletmanagerdata.ValidateReplicationCredentialsRequest")
+	proto.RegisterType((*ValidateReplicationCredentialsResponse)(nil), "tabletmanagerdata.ValidateReplicationCredentialsResponse")
+	proto.RegisterType((*ApplierWorkerStats)(nil), "tabletmanagerdata.ApplierWorkerStats")
+	proto.RegisterType((*ApplierQueueStats)(nil), "tabletmanagerdata.ApplierQueueStats")
+	proto.RegisterType((*GetApplierQueueStatsRequest)(nil), "tabletmanagerdata.GetApplierQueueStatsRequest")
+	proto.RegisterType((*GetApplierQueueStatsResponse)(nil), "tabletmanagerdata.GetApplierQueueStatsResponse")
 	proto.RegisterType((*MasterPositionRequest)(nil), "tabletmanagerdata.MasterPositionRequest")
 	proto.RegisterType((*MasterPositionResponse)(nil), "tabletmanagerdata.MasterPositionResponse")
+	proto.RegisterType((*GetGtidPurgedRequest)(nil), "tabletmanagerdata.GetGtidPurgedRequest")
+	proto.RegisterType((*GetGtidPurgedResponse)(nil), "tabletmanagerdata.GetGtidPurgedResponse")
+	proto.RegisterType((*GetBinlogStatsRequest)(nil), "tabletmanagerdata.GetBinlogStatsRequest")
+	proto.RegisterType((*GetBinlogStatsResponse)(nil), "tabletmanagerdata.GetBinlogStatsResponse")
+	proto.RegisterType((*ReplicationErrorStats)(nil), "tabletmanagerdata.ReplicationErrorStats")
+	proto.RegisterType((*GetReplicationErrorStatsRequest)(nil), "tabletmanagerdata.GetReplicationErrorStatsRequest")
+	proto.RegisterType((*GetReplicationErrorStatsResponse)(nil), "tabletmanagerdata.GetReplicationErrorStatsResponse")
 	proto.RegisterType((*StopSlaveRequest)(nil), "tabletmanagerdata.StopSlaveRequest")
 	proto.RegisterType((*StopSlaveResponse)(nil), "tabletmanagerdata.StopSlaveResponse")
 	proto.RegisterType((*StopSlaveMinimumRequest)(nil), "tabletmanagerdata.StopSlaveMinimumRequest")
 	proto.RegisterType((*StopSlaveMinimumResponse)(nil), "tabletmanagerdata.StopSlaveMinimumResponse")
+	proto.RegisterType((*StopSlaveMinimumStreamRequest)(nil), "tabletmanagerdata.StopSlaveMinimumStreamRequest")
+	proto.RegisterType((*StopSlaveMinimumStreamResponse)(nil), "tabletmanagerdata.StopSlaveMinimumStreamResponse")
+	proto.RegisterType((*BoostReplicationCatchupRequest)(nil), "tabletmanagerdata.BoostReplicationCatchupRequest")
+	proto.RegisterType((*BoostReplicationCatchupResponse)(nil), "tabletmanagerdata.BoostReplicationCatchupResponse")
 	proto.RegisterType((*StartSlaveRequest)(nil), "tabletmanagerdata.StartSlaveRequest")
 	proto.RegisterType((*StartSlaveResponse)(nil), "tabletmanagerdata.StartSlaveResponse")
+	proto.RegisterType((*EnableParallelReplicationRequest)(nil), "tabletmanagerdata.EnableParallelReplicationRequest")
+	proto.RegisterType((*EnableParallelReplicationResponse)(nil), "tabletmanagerdata.EnableParallelReplicationResponse")
+	proto.RegisterType((*RotateReplicationCredentialsRequest)(nil), "tabletmanagerdata.RotateReplicationCredentialsRequest")
+	proto.RegisterType((*RotateReplicationCredentialsResponse)(nil), "tabletmanagerdata.RotateReplicationCredentialsResponse")
+	proto.RegisterType((*ReplicationSSLOptions)(nil), "tabletmanagerdata.ReplicationSSLOptions")
+	proto.RegisterType((*ConfigureReplicationSSLRequest)(nil), "tabletmanagerdata.ConfigureReplicationSSLRequest")
+	proto.RegisterType((*ConfigureReplicationSSLResponse)(nil), "tabletmanagerdata.ConfigureReplicationSSLResponse")
+	proto.RegisterType((*RepairRelayLogRequest)(nil), "tabletmanagerdata.RepairRelayLogRequest")
+	proto.RegisterType((*RepairRelayLogResponse)(nil), "tabletmanagerdata.RepairRelayLogResponse")
 	proto.RegisterType((*TabletExternallyReparentedRequest)(nil), "tabletmanagerdata.TabletExternallyReparentedRequest")
 	proto.RegisterType((*TabletExternallyReparentedResponse)(nil), "tabletmanagerdata.TabletExternallyReparentedResponse")
 	proto.RegisterType((*TabletExternallyElectedRequest)(nil), "tabletmanagerdata.TabletExternallyElectedRequest")
 	proto.RegisterType((*TabletExternallyElectedResponse)(nil), "tabletmanagerdata.TabletExternallyElectedResponse")
 	proto.RegisterType((*GetSlavesRequest)(nil), "tabletmanagerdata.GetSlavesRequest")
 	proto.RegisterType((*GetSlavesResponse)(nil), "tabletmanagerdata.GetSlavesResponse")
+	proto.RegisterType((*ReplicationNeighbor)(nil), "tabletmanagerdata.ReplicationNeighbor")
+	proto.RegisterType((*ReplicationGraph)(nil), "tabletmanagerdata.ReplicationGraph")
+	proto.RegisterType((*GetReplicationGraphRequest)(nil), "tabletmanagerdata.GetReplicationGraphRequest")
+	proto.RegisterType((*GetReplicationGraphResponse)(nil), "tabletmanagerdata.GetReplicationGraphResponse")
 	proto.RegisterType((*WaitBlpPositionRequest)(nil), "tabletmanagerdata.WaitBlpPositionRequest")
 	proto.RegisterType((*WaitBlpPositionResponse)(nil), "tabletmanagerdata.WaitBlpPositionResponse")
 	proto.RegisterType((*StopBlpRequest)(nil), "tabletmanagerdata.StopBlpRequest")
@@ -1259,12 +4257,18 @@ func init() {
 	proto.RegisterType((*StartBlpResponse)(nil), "tabletmanagerdata.StartBlpResponse")
 	proto.RegisterType((*RunBlpUntilRequest)(nil), "tabletmanagerdata.RunBlpUntilRequest")
 	proto.RegisterType((*RunBlpUntilResponse)(nil), "tabletmanagerdata.RunBlpUntilResponse")
+	proto.RegisterType((*BinlogFilter)(nil), "tabletmanagerdata.BinlogFilter")
+	proto.RegisterType((*GetBinlogFiltersRequest)(nil), "tabletmanagerdata.GetBinlogFiltersRequest")
+	proto.RegisterType((*GetBinlogFiltersResponse)(nil), "tabletmanagerdata.GetBinlogFiltersResponse")
 	proto.RegisterType((*ResetReplicationRequest)(nil), "tabletmanagerdata.ResetReplicationRequest")
 	proto.RegisterType((*ResetReplicationResponse)(nil), "tabletmanagerdata.ResetReplicationResponse")
 	proto.RegisterType((*InitMasterRequest)(nil), "tabletmanagerdata.InitMasterRequest")
 	proto.RegisterType((*InitMasterResponse)(nil), "tabletmanagerdata.InitMasterResponse")
 	proto.RegisterType((*PopulateReparentJournalRequest)(nil), "tabletmanagerdata.PopulateReparentJournalRequest")
 	proto.RegisterType((*PopulateReparentJournalResponse)(nil), "tabletmanagerdata.PopulateReparentJournalResponse")
+	proto.RegisterType((*ReparentJournalEntry)(nil), "tabletmanagerdata.ReparentJournalEntry")
+	proto.RegisterType((*GetLastReparentJournalEntryRequest)(nil), "tabletmanagerdata.GetLastReparentJournalEntryRequest")
+	proto.RegisterType((*GetLastReparentJournalEntryResponse)(nil), "tabletmanagerdata.GetLastReparentJournalEntryResponse")
 	proto.RegisterType((*InitSlaveRequest)(nil), "tabletmanagerdata.InitSlaveRequest")
 	proto.RegisterType((*InitSlaveResponse)(nil), "tabletmanagerdata.InitSlaveResponse")
 	proto.RegisterType((*DemoteMasterRequest)(nil), "tabletmanagerdata.DemoteMasterRequest")
@@ -1275,149 +4279,492 @@ func init() {
 	proto.RegisterType((*SlaveWasPromotedResponse)(nil), "tabletmanagerdata.SlaveWasPromotedResponse")
 	proto.RegisterType((*SetMasterRequest)(nil), "tabletmanagerdata.SetMasterRequest")
 	proto.RegisterType((*SetMasterResponse)(nil), "tabletmanagerdata.SetMasterResponse")
+	proto.RegisterType((*ConfigureReplicationRequest)(nil), "tabletmanagerdata.ConfigureReplicationRequest")
+	proto.RegisterType((*ConfigureReplicationResponse)(nil), "tabletmanagerdata.ConfigureReplicationResponse")
 	proto.RegisterType((*SlaveWasRestartedRequest)(nil), "tabletmanagerdata.SlaveWasRestartedRequest")
 	proto.RegisterType((*SlaveWasRestartedResponse)(nil), "tabletmanagerdata.SlaveWasRestartedResponse")
 	proto.RegisterType((*StopReplicationAndGetStatusRequest)(nil), "tabletmanagerdata.StopReplicationAndGetStatusRequest")
 	proto.RegisterType((*StopReplicationAndGetStatusResponse)(nil), "tabletmanagerdata.StopReplicationAndGetStatusResponse")
 	proto.RegisterType((*PromoteSlaveRequest)(nil), "tabletmanagerdata.PromoteSlaveRequest")
 	proto.RegisterType((*PromoteSlaveResponse)(nil), "tabletmanagerdata.PromoteSlaveResponse")
+	proto.RegisterType((*SetReparentEligibilityRequest)(nil), "tabletmanagerdata.SetReparentEligibilityRequest")
+	proto.RegisterType((*SetReparentEligibilityResponse)(nil), "tabletmanagerdata.SetReparentEligibilityResponse")
+	proto.RegisterType((*CheckReparentCandidateRequest)(nil), "tabletmanagerdata.CheckReparentCandidateRequest")
+	proto.RegisterType((*CheckReparentCandidateResponse)(nil), "tabletmanagerdata.CheckReparentCandidateResponse")
+	proto.RegisterType((*SetSemiSyncAckCountRequest)(nil), "tabletmanagerdata.SetSemiSyncAckCountRequest")
+	proto.RegisterType((*SetSemiSyncAckCountResponse)(nil), "tabletmanagerdata.SetSemiSyncAckCountResponse")
+	proto.RegisterType((*DurabilityPolicy)(nil), "tabletmanagerdata.DurabilityPolicy")
+	proto.RegisterType((*GetDurabilityPolicyRequest)(nil), "tabletmanagerdata.GetDurabilityPolicyRequest")
+	proto.RegisterType((*GetDurabilityPolicyResponse)(nil), "tabletmanagerdata.GetDurabilityPolicyResponse")
+	proto.RegisterType((*GetBackupLimitsRequest)(nil), "tabletmanagerdata.GetBackupLimitsRequest")
+	proto.RegisterType((*GetBackupLimitsResponse)(nil), "tabletmanagerdata.GetBackupLimitsResponse")
+	proto.RegisterType((*GetBackupPositionRequest)(nil), "tabletmanagerdata.GetBackupPositionRequest")
+	proto.RegisterType((*GetBackupPositionResponse)(nil), "tabletmanagerdata.GetBackupPositionResponse")
 	proto.RegisterType((*BackupRequest)(nil), "tabletmanagerdata.BackupRequest")
 	proto.RegisterType((*BackupResponse)(nil), "tabletmanagerdata.BackupResponse")
+	proto.RegisterType((*IncrementalBackupRequest)(nil), "tabletmanagerdata.IncrementalBackupRequest")
+	proto.RegisterType((*IncrementalBackupResponse)(nil), "tabletmanagerdata.IncrementalBackupResponse")
 	proto.RegisterType((*RestoreFromBackupRequest)(nil), "tabletmanagerdata.RestoreFromBackupRequest")
 	proto.RegisterType((*RestoreFromBackupResponse)(nil), "tabletmanagerdata.RestoreFromBackupResponse")
+	proto.RegisterType((*RestoreToTimestampRequest)(nil), "tabletmanagerdata.RestoreToTimestampRequest")
+	proto.RegisterType((*RestoreToTimestampResponse)(nil), "tabletmanagerdata.RestoreToTimestampResponse")
+	proto.RegisterType((*SetPreferredBackupRequest)(nil), "tabletmanagerdata.SetPreferredBackupRequest")
+	proto.RegisterType((*SetPreferredBackupResponse)(nil), "tabletmanagerdata.SetPreferredBackupResponse")
+	proto.RegisterType((*GetPreferredBackupRequest)(nil), "tabletmanagerdata.GetPreferredBackupRequest")
+	proto.RegisterType((*GetPreferredBackupResponse)(nil), "tabletmanagerdata.GetPreferredBackupResponse")
+	proto.RegisterType((*CompatCheck)(nil), "tabletmanagerdata.CompatCheck")
+	proto.RegisterType((*CompatReport)(nil), "tabletmanagerdata.CompatReport")
+	proto.RegisterType((*CheckRestoreCompatibilityRequest)(nil), "tabletmanagerdata.CheckRestoreCompatibilityRequest")
+	proto.RegisterType((*CheckRestoreCompatibilityResponse)(nil), "tabletmanagerdata.CheckRestoreCompatibilityResponse")
+	proto.RegisterType((*BackupInfo)(nil), "tabletmanagerdata.BackupInfo")
+	proto.RegisterType((*GetLastBackupInfoRequest)(nil), "tabletmanagerdata.GetLastBackupInfoRequest")
+	proto.RegisterType((*GetLastBackupInfoResponse)(nil), "tabletmanagerdata.GetLastBackupInfoResponse")
+	proto.RegisterType((*GetBackupFreshnessRequest)(nil), "tabletmanagerdata.GetBackupFreshnessRequest")
+	proto.RegisterType((*GetBackupFreshnessResponse)(nil), "tabletmanagerdata.GetBackupFreshnessResponse")
+	proto.RegisterType((*ReadinessReport)(nil), "tabletmanagerdata.ReadinessReport")
+	proto.RegisterType((*CheckBackupReadinessRequest)(nil), "tabletmanagerdata.CheckBackupReadinessRequest")
+	proto.RegisterType((*CheckBackupReadinessResponse)(nil), "tabletmanagerdata.CheckBackupReadinessResponse")
+	proto.RegisterType((*TestRestoreRequest)(nil), "tabletmanagerdata.TestRestoreRequest")
+	proto.RegisterType((*TestRestoreResponse)(nil), "tabletmanagerdata.TestRestoreResponse")
 }
 
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2049 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xcc, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
-	0xf5, 0x07, 0x45, 0x49, 0x96, 0x0e, 0x2f, 0x22, 0x97, 0xba, 0x50, 0x0a, 0xfe, 0xba, 0xac, 0x9d,
-	0x7f, 0x54, 0x17, 0x55, 0x6a, 0x25, 0x0d, 0x82, 0x04, 0x29, 0xaa, 0xab, 0xed, 0xc4, 0x89, 0x95,
-	0x95, 0x2f, 0x45, 0x5f, 0x16, 0x43, 0xee, 0x11, 0xb9, 0xd0, 0x72, 0x77, 0x3d, 0x33, 0x2b, 0x89,
-	0x40, 0xd1, 0x8f, 0xd0, 0xb7, 0xbe, 0xf5, 0xad, 0x40, 0xfb, 0xde, 0x0f, 0x93, 0xa2, 0x9f, 0xa4,
-	0x0f, 0x7d, 0x29, 0xe6, 0x46, 0xce, 0x92, 0x94, 0x4d, 0x19, 0x2e, 0xd0, 0x17, 0x81, 0xe7, 0x37,
-	0xe7, 0x3e, 0x67, 0xce, 0x39, 0x0b, 0xc1, 0x1a, 0x27, 0xad, 0x08, 0x79, 0x8f, 0xc4, 0xa4, 0x83,
-	0x34, 0x20, 0x9c, 0xec, 0xa5, 0x34, 0xe1, 0x89, 0x53, 0x1f, 0x3b, 0xd8, 0x28, 0xbd, 0xc9, 0x90,
-	0xf6, 0xd5, 0xf9, 0x46, 0x95, 0x27, 0x69, 0x32, 0xe4, 0xdf, 0x58, 0xa1, 0x98, 0x46, 0x61, 0x9b,
-	0xf0, 0x30, 0x89, 0x2d, 0xb8, 0x12, 0x25, 0x9d, 0x8c, 0x87, 0x91, 0x22, 0xdd, 0x7f, 0x16, 0x60,
-	0xe9, 0x85, 0x50, 0x7c, 0x8c, 0x17, 0x61, 0x1c, 0x0a, 0x66, 0xc7, 0x81, 0xd9, 0x98, 0xf4, 0xb0,
-	0x59, 0xd8, 0x2e, 0xec, 0x2e, 0x7a, 0xf2, 0xb7, 0xb3, 0x0a, 0xf3, 0xac, 0xdd, 0xc5, 0x1e, 0x69,
-	0xce, 0x48, 0x54, 0x53, 0x4e, 0x13, 0xee, 0xb5, 0x93, 0x28, 0xeb, 0xc5, 0xac, 0x59, 0xdc, 0x2e,
-	0xee, 0x2e, 0x7a, 0x86, 0x74, 0xf6, 0xa0, 0x91, 0xd2, 0xb0, 0x47, 0x68, 0xdf, 0xbf, 0xc4, 0xbe,
-	0x6f, 0xb8, 0x66, 0x25, 0x57, 0x5d, 0x1f, 0x7d, 0x87, 0xfd, 0x23, 0xcd, 0xef, 0xc0, 0x2c, 0xef,
-	0xa7, 0xd8, 0x9c, 0x53, 0x56, 0xc5, 0x6f, 0x67, 0x0b, 0x4a, 0xc2, 0x75, 0x3f, 0xc2, 0xb8, 0xc3,
-	0xbb, 0xcd, 0xf9, 0xed, 0xc2, 0xee, 0xac, 0x07, 0x02, 0x7a, 0x26, 0x11, 0xe7, 0x23, 0x58, 0xa4,
-	0xc9, 0xb5, 0xdf, 0x4e, 0xb2, 0x98, 0x37, 0xef, 0xc9, 0xe3, 0x05, 0x9a, 0x5c, 0x1f, 0x09, 0xda,
-	0xfd, 0x6b, 0x01, 0x6a, 0xe7, 0xd2, 0x4d, 0x2b, 0xb8, 0x4f, 0x60, 0x49, 0xc8, 0xb7, 0x08, 0x43,
-	0x5f, 0x47, 0xa4, 0xe2, 0xac, 0x1a, 0x58, 0x89, 0x38, 0xcf, 0x41, 0x65, 0xdc, 0x0f, 0x06, 0xc2,
-	0xac, 0x39, 0xb3, 0x5d, 0xdc, 0x2d, 0xed, 0xbb, 0x7b, 0xe3, 0x97, 0x34, 0x92, 0x44, 0xaf, 0xc6,
-	0xf3, 0x00, 0x13, 0xa9, 0xba, 0x42, 0xca, 0xc2, 0x24, 0x6e, 0x16, 0xa5, 0x45, 0x43, 0x0a, 0x47,
-	0x1d, 0x65, 0xf5, 0xa8, 0x4b, 0xe2, 0x0e, 0x7a, 0xc8, 0xb2, 0x88, 0x3b, 0x4f, 0xa0, 0xd2, 0xc2,
-	0x8b, 0x84, 0xe6, 0x1c, 0x2d, 0xed, 0xdf, 0x9f, 0x60, 0x7d, 0x34, 0x4c, 0xaf, 0xac, 0x24, 0x75,
-	0x2c, 0xa7, 0x50, 0x26, 0x17, 0x1c, 0xa9, 0x6f, 0xdd, 0xe1, 0x94, 0x8a, 0x4a, 0x52, 0x50, 0xc1,
-	0xee, 0xbf, 0x0a, 0x50, 0x7d, 0xc9, 0x90, 0x9e, 0x21, 0xed, 0x85, 0x8c, 0xe9, 0x62, 0xe9, 0x26,
-	0x8c, 0x9b, 0x62, 0x11, 0xbf, 0x05, 0x96, 0x31, 0xa4, 0xba, 0x54, 0xe4, 0x6f, 0xe7, 0xe7, 0x50,
-	0x4f, 0x09, 0x63, 0xd7, 0x09, 0x0d, 0xfc, 0x76, 0x17, 0xdb, 0x97, 0x2c, 0xeb, 0xc9, 0x3c, 0xcc,
-	0x7a, 0x35, 0x73, 0x70, 0xa4, 0x71, 0xe7, 0x47, 0x80, 0x94, 0x86, 0x57, 0x61, 0x84, 0x1d, 0x54,
-	0x25, 0x53, 0xda, 0x7f, 0x34, 0xc1, 0xdb, 0xbc, 0x2f, 0x7b, 0x67, 0x03, 0x99, 0x93, 0x98, 0xd3,
-	0xbe, 0x67, 0x29, 0xd9, 0xf8, 0x06, 0x96, 0x46, 0x8e, 0x9d, 0x1a, 0x14, 0x2f, 0xb1, 0xaf, 0x3d,
-	0x17, 0x3f, 0x9d, 0x65, 0x98, 0xbb, 0x22, 0x51, 0x86, 0xda, 0x73, 0x45, 0x7c, 0x35, 0xf3, 0x65,
-	0xc1, 0xfd, 0xa9, 0x00, 0xe5, 0xe3, 0xd6, 0x3b, 0xe2, 0xae, 0xc2, 0x4c, 0xd0, 0xd2, 0xb2, 0x33,
-	0x41, 0x6b, 0x90, 0x87, 0xa2, 0x95, 0x87, 0xe7, 0x13, 0x42, 0xfb, 0x74, 0x42, 0x68, 0xb6, 0xb1,
-	0xff, 0x66, 0x60, 0x7f, 0x29, 0x40, 0x69, 0x68, 0x89, 0x39, 0xcf, 0xa0, 0x26, 0xfc, 0xf4, 0xd3,
-	0x21, 0xd6, 0x2c, 0x48, 0x2f, 0x77, 0xde, 0x79, 0x01, 0xde, 0x52, 0x96, 0xa3, 0x99, 0x73, 0x0a,
-	0xd5, 0xa0, 0x95, 0xd3, 0xa5, 0x5e, 0xd0, 0xd6, 0x3b, 0x22, 0xf6, 0x2a, 0x81, 0x45, 0x31, 0xf7,
-	0x6b, 0x28, 0x1d, 0x46, 0xe9, 0x59, 0xc2, 0xd4, 0x23, 0xae, 0x41, 0x31, 0x0b, 0x03, 0x19, 0x60,
-	0xc5, 0x13, 0x3f, 0x9d, 0x0d, 0x58, 0x48, 0xf5, 0xa9, 0x8e, 0x71, 0x40, 0xbb, 0x9f, 0x40, 0xe9,
-	0x2c, 0x8c, 0x3b, 0x1e, 0xbe, 0xc9, 0x90, 0x71, 0xf1, 0x0e, 0x53, 0xd2, 0x8f, 0x12, 0x12, 0xe8,
-	0x0c, 0x19, 0xd2, 0xdd, 0x85, 0xb2, 0x62, 0x64, 0x69, 0x12, 0x33, 0x7c, 0x0b, 0xe7, 0x43, 0x28,
-	0x9f, 0x47, 0x88, 0xa9, 0xd1, 0xb9, 0x01, 0x0b, 0x41, 0x46, 0x65, 0xaf, 0x95, 0xac, 0x45, 0x6f,
-	0x40, 0xbb, 0x4b, 0x50, 0xd1, 0xbc, 0x4a, 0xad, 0xfb, 0x8f, 0x02, 0x38, 0x27, 0x37, 0xd8, 0xce,
-	0x38, 0x3e, 0x49, 0x92, 0x4b, 0xa3, 0x63, 0x52, 0xdb, 0xdd, 0x04, 0x48, 0x09, 0x25, 0x3d, 0xe4,
-	0x48, 0x55, 0xee, 0x16, 0x3d, 0x0b, 0x71, 0xce, 0x60, 0x11, 0x6f, 0x38, 0x25, 0x3e, 0xc6, 0x57,
-	0xb2, 0x01, 0x97, 0xf6, 0x3f, 0x9b, 0x90, 0xda, 0x71, 0x6b, 0x7b, 0x27, 0x42, 0xec, 0x24, 0xbe,
-	0x52, 0x05, 0xb5, 0x80, 0x9a, 0xdc, 0xf8, 0x1a, 0x2a, 0xb9, 0xa3, 0x3b, 0x15, 0xd3, 0x05, 0x34,
-	0x72, 0xa6, 0x74, 0x1e, 0xb7, 0xa0, 0x84, 0x37, 0x21, 0xf7, 0x19, 0x27, 0x3c, 0x63, 0x3a, 0x41,
-	0x20, 0xa0, 0x73, 0x89, 0xc8, 0xe9, 0xc2, 0x83, 0x24, 0xe3, 0x83, 0xe9, 0x22, 0x29, 0x8d, 0x23,
-	0x35, 0x4f, 0x48, 0x53, 0xee, 0x15, 0xd4, 0x1e, 0x23, 0x57, 0x4d, 0xc9, 0xa4, 0x6f, 0x15, 0xe6,
-	0x65, 0xe0, 0xaa, 0x5c, 0x17, 0x3d, 0x4d, 0x39, 0xf7, 0xa1, 0x12, 0xc6, 0xed, 0x28, 0x0b, 0xd0,
-	0xbf, 0x0a, 0xf1, 0x9a, 0x49, 0x13, 0x0b, 0x5e, 0x59, 0x83, 0xaf, 0x04, 0xe6, 0x7c, 0x0c, 0x55,
-	0xbc, 0x51, 0x4c, 0x5a, 0x89, 0x9a, 0x66, 0x15, 0x8d, 0xca, 0xee, 0xce, 0x5c, 0x84, 0xba, 0x65,
-	0x57, 0x47, 0x77, 0x06, 0x75, 0xd5, 0x56, 0xad, 0x49, 0x71, 0x97, 0x56, 0x5d, 0x63, 0x23, 0x88,
-	0xbb, 0x06, 0x2b, 0x8f, 0x91, 0x5b, 0xf5, 0xaf, 0x63, 0x74, 0x7f, 0x07, 0xab, 0xa3, 0x07, 0xda,
-	0x89, 0xdf, 0x40, 0x29, 0xff, 0x62, 0x85, 0xf9, 0xcd, 0x09, 0xe6, 0x6d, 0x61, 0x5b, 0xc4, 0x5d,
-	0x06, 0xe7, 0x1c, 0xb9, 0x87, 0x24, 0x78, 0x1e, 0x47, 0x7d, 0x63, 0x71, 0x05, 0x1a, 0x39, 0x54,
-	0x97, 0xf0, 0x10, 0x7e, 0x4d, 0x43, 0x8e, 0x86, 0x7b, 0x15, 0x96, 0xf3, 0xb0, 0x66, 0xff, 0x16,
-	0xea, 0x6a, 0xb2, 0xbd, 0xe8, 0xa7, 0x86, 0xd9, 0xf9, 0x15, 0x94, 0x94, 0x7b, 0xbe, 0x9c, 0xfb,
-	0xc2, 0xe5, 0xea, 0xfe, 0xf2, 0xde, 0x60, 0x8d, 0x91, 0x39, 0xe7, 0x52, 0x02, 0xf8, 0xe0, 0xb7,
-	0xf0, 0xd3, 0xd6, 0x35, 0x74, 0xc8, 0xc3, 0x0b, 0x8a, 0xac, 0x2b, 0x4a, 0xca, 0x76, 0x28, 0x0f,
-	0x6b, 0xf6, 0x35, 0x58, 0xf1, 0xb2, 0xf8, 0x09, 0x92, 0x88, 0x77, 0xe5, 0xd4, 0x31, 0x02, 0x4d,
-	0x58, 0x1d, 0x3d, 0xd0, 0x22, 0x9f, 0x43, 0xf3, 0x69, 0x27, 0x4e, 0x28, 0xaa, 0xc3, 0x13, 0x4a,
-	0x13, 0x9a, 0x6b, 0x29, 0x9c, 0x23, 0x8d, 0x87, 0x8d, 0x42, 0x92, 0xee, 0x47, 0xb0, 0x3e, 0x41,
-	0x4a, 0xab, 0xfc, 0x4a, 0x38, 0x2d, 0xfa, 0x49, 0xbe, 0x92, 0xef, 0x43, 0xe5, 0x9a, 0x84, 0xdc,
-	0x1f, 0x34, 0x34, 0xa5, 0xb3, 0x2c, 0x40, 0xd3, 0x02, 0x55, 0x64, 0xb6, 0xac, 0xd6, 0xb9, 0x0f,
-	0xab, 0x67, 0x14, 0x2f, 0xa2, 0xb0, 0xd3, 0x1d, 0x79, 0x20, 0x62, 0x55, 0x93, 0x89, 0x33, 0x2f,
-	0xc4, 0x90, 0x6e, 0x07, 0xd6, 0xc6, 0x64, 0x74, 0x5d, 0x3d, 0x83, 0xaa, 0xe2, 0xf2, 0xa9, 0x5c,
-	0x4a, 0xcc, 0x30, 0xf8, 0xf8, 0xd6, 0xca, 0xb6, 0x57, 0x18, 0xaf, 0xd2, 0xb6, 0x28, 0xe6, 0xfe,
-	0xbb, 0x00, 0xce, 0x41, 0x9a, 0x46, 0xfd, 0xbc, 0x67, 0x35, 0x28, 0xb2, 0x37, 0x91, 0x69, 0x31,
-	0xec, 0x4d, 0x24, 0x5a, 0xcc, 0x45, 0x42, 0xdb, 0xa8, 0x1f, 0xab, 0x22, 0xc4, 0x0e, 0x41, 0xa2,
-	0x28, 0xb9, 0xf6, 0xad, 0xd5, 0x56, 0x76, 0x86, 0x05, 0xaf, 0x26, 0x0f, 0xbc, 0x21, 0x3e, 0xbe,
-	0x3d, 0xcd, 0x7e, 0xa8, 0xed, 0x69, 0xee, 0x3d, 0xb7, 0xa7, 0xbf, 0x15, 0xa0, 0x91, 0x8b, 0x5e,
-	0xe7, 0xf8, 0x7f, 0x6f, 0xcf, 0xfb, 0x7b, 0x01, 0x9a, 0xba, 0x91, 0x9f, 0x22, 0x6f, 0x77, 0x0f,
-	0xd8, 0x71, 0x6b, 0x70, 0x5b, 0xcb, 0x30, 0x27, 0xbf, 0x3b, 0xa4, 0x9b, 0x65, 0x4f, 0x11, 0xce,
-	0x1a, 0xdc, 0x0b, 0x5a, 0xbe, 0x1c, 0x60, 0xba, 0x87, 0x07, 0xad, 0x1f, 0xc4, 0x08, 0x5b, 0x87,
-	0x85, 0x1e, 0xb9, 0xf1, 0x69, 0x72, 0xcd, 0xf4, 0xbe, 0x77, 0xaf, 0x47, 0x6e, 0xbc, 0xe4, 0x9a,
-	0xc9, 0x5d, 0x3c, 0x64, 0x72, 0xc9, 0x6e, 0x85, 0x71, 0x94, 0x74, 0x98, 0xbc, 0xa4, 0x05, 0xaf,
-	0xaa, 0xe1, 0x43, 0x85, 0x8a, 0x17, 0x41, 0x65, 0xb1, 0xdb, 0x57, 0xb0, 0xe0, 0x95, 0xa9, 0xf5,
-	0x02, 0xdc, 0xc7, 0xb0, 0x3e, 0xc1, 0x67, 0x9d, 0xe3, 0x87, 0x30, 0xaf, 0x0a, 0x58, 0x27, 0xd7,
-	0xd9, 0x53, 0xdf, 0x4e, 0x3f, 0x8a, 0xbf, 0xba, 0x58, 0x35, 0x87, 0xfb, 0xc7, 0x02, 0xfc, 0x5f,
-	0x5e, 0xd3, 0x41, 0x14, 0x89, 0x1d, 0x8b, 0x7d, 0xf8, 0x14, 0x8c, 0x45, 0x36, 0x3b, 0x21, 0xb2,
-	0x67, 0xb0, 0x79, 0x9b, 0x3f, 0xef, 0x11, 0xde, 0x77, 0xa3, 0x77, 0x7b, 0x90, 0xa6, 0x6f, 0x0f,
-	0xcc, 0xf6, 0x7f, 0x26, 0xe7, 0xff, 0x78, 0xd2, 0xa5, 0xb2, 0xf7, 0xf0, 0x4a, 0x8c, 0x9f, 0x88,
-	0x5c, 0xa1, 0xda, 0x08, 0x4c, 0x3b, 0x3e, 0x85, 0x46, 0x0e, 0xd5, 0x8a, 0x3f, 0x15, 0x7b, 0xc1,
-	0x60, 0x97, 0x28, 0xed, 0xaf, 0xed, 0x8d, 0x7e, 0xec, 0x6a, 0x01, 0xcd, 0x26, 0xfa, 0xfd, 0xf7,
-	0x84, 0x71, 0xa4, 0xa6, 0x7f, 0x1a, 0x03, 0x9f, 0xc3, 0xea, 0xe8, 0x81, 0xb6, 0x61, 0x6f, 0x94,
-	0x85, 0x91, 0x8d, 0xd2, 0x81, 0xda, 0x39, 0x4f, 0x52, 0xe9, 0x9a, 0xd1, 0xd4, 0x80, 0xba, 0x85,
-	0xe9, 0x6e, 0xfc, 0x5b, 0x58, 0x1b, 0x80, 0xdf, 0x87, 0x71, 0xd8, 0xcb, 0x7a, 0xd6, 0xca, 0x78,
-	0x9b, 0x7e, 0x67, 0x07, 0x64, 0xb3, 0xf7, 0x79, 0xd8, 0x43, 0xb3, 0x15, 0x15, 0xbd, 0x92, 0xc0,
-	0x5e, 0x28, 0xc8, 0xfd, 0x02, 0x9a, 0xe3, 0x9a, 0xa7, 0x70, 0x5d, 0xba, 0x49, 0x28, 0xcf, 0xf9,
-	0x2e, 0x92, 0x6f, 0x81, 0xda, 0xf9, 0x63, 0xd8, 0x51, 0x33, 0xf8, 0xe4, 0x46, 0xcc, 0x32, 0x12,
-	0x89, 0x05, 0x20, 0x25, 0x14, 0x63, 0x8e, 0x81, 0x09, 0x43, 0xee, 0x76, 0xea, 0xd8, 0x0f, 0xcd,
-	0x9e, 0x0c, 0x06, 0x7a, 0x1a, 0xb8, 0x0f, 0xc0, 0x7d, 0x9b, 0x16, 0x6d, 0x6b, 0x1b, 0x36, 0x47,
-	0xb9, 0x4e, 0x22, 0x6c, 0x0f, 0x0d, 0xb9, 0x3b, 0xb0, 0x75, 0x2b, 0x87, 0x56, 0xe2, 0xa8, 0xb5,
-	0x50, 0x04, 0x31, 0xa8, 0xa0, 0x9f, 0xa9, 0x95, 0x4d, 0x63, 0x3a, 0x41, 0xcb, 0x30, 0x47, 0x82,
-	0x80, 0x9a, 0x41, 0xa8, 0x08, 0xf7, 0x0f, 0xb0, 0xfa, 0x9a, 0x84, 0xdc, 0xfa, 0xd0, 0x30, 0x41,
-	0x1e, 0x40, 0xb9, 0x15, 0xa5, 0xf9, 0x81, 0x3c, 0x79, 0xbd, 0xb2, 0x85, 0x4b, 0x2d, 0xeb, 0x93,
-	0x65, 0x8a, 0x2b, 0x5d, 0x87, 0xb5, 0x31, 0xfb, 0x3a, 0xb2, 0x1a, 0x54, 0xc5, 0x6d, 0x1f, 0x46,
-	0xe6, 0xa5, 0xba, 0xaf, 0x60, 0x69, 0x80, 0xe8, 0xa8, 0x8e, 0xa0, 0x62, 0x7b, 0x69, 0x46, 0xf5,
-	0xbb, 0xdc, 0x2c, 0x5b, 0x6e, 0x32, 0xb7, 0x2e, 0xf4, 0x12, 0xca, 0x2d, 0x53, 0xb2, 0xda, 0x0d,
-	0xa4, 0x1d, 0xfa, 0x3d, 0x38, 0x5e, 0x16, 0x1f, 0x46, 0xe9, 0xcb, 0x98, 0x87, 0x91, 0xc9, 0xd3,
-	0x87, 0xf0, 0x60, 0x9a, 0x4c, 0x3d, 0x82, 0x46, 0xce, 0xfa, 0x14, 0x75, 0xbf, 0x0e, 0x6b, 0x1e,
-	0x32, 0xb1, 0x9c, 0x0e, 0x1a, 0x85, 0x89, 0x6f, 0x03, 0x9a, 0xe3, 0x47, 0x3a, 0xce, 0x06, 0xd4,
-	0x9f, 0xc6, 0x21, 0x57, 0x3d, 0xc2, 0x08, 0xfc, 0x12, 0x1c, 0x1b, 0x9c, 0xc2, 0xfa, 0x4f, 0x05,
-	0xd8, 0x3c, 0x4b, 0xd2, 0x2c, 0x92, 0x4b, 0xa8, 0xaa, 0xfe, 0x6f, 0x93, 0x4c, 0x94, 0xb1, 0xc9,
-	0xdd, 0xff, 0xc3, 0x92, 0x88, 0xd8, 0x6f, 0x53, 0x24, 0x1c, 0x03, 0x3f, 0x36, 0x1f, 0x4a, 0x15,
-	0x01, 0x1f, 0x29, 0xf4, 0x07, 0x26, 0x1e, 0x1c, 0x69, 0x0b, 0xa5, 0xf6, 0xa4, 0x01, 0x05, 0xc9,
-	0x69, 0xf3, 0x25, 0x94, 0x7b, 0xd2, 0x33, 0x9f, 0x44, 0x21, 0x51, 0x13, 0xa7, 0xb4, 0xbf, 0x32,
-	0xba, 0x58, 0x1f, 0x88, 0x43, 0xaf, 0xa4, 0x58, 0x25, 0xe1, 0x3c, 0x82, 0x65, 0xab, 0x8f, 0x0e,
-	0xcb, 0x7d, 0x56, 0xda, 0x68, 0x58, 0x67, 0x83, 0x35, 0x74, 0x07, 0xb6, 0x6e, 0x8d, 0x4b, 0xa7,
-	0xf0, 0xcf, 0x05, 0xa8, 0x89, 0x74, 0xd9, 0x1d, 0xc7, 0xf9, 0x05, 0xcc, 0x2b, 0x6e, 0xfd, 0x96,
-	0x6e, 0x71, 0x4f, 0x33, 0xdd, 0xea, 0xd9, 0xcc, 0xad, 0x9e, 0x4d, 0xca, 0x67, 0x71, 0x42, 0x3e,
-	0xcd, 0x0d, 0xe7, 0x5b, 0xdf, 0x0a, 0x34, 0x8e, 0xb1, 0x97, 0x70, 0xcc, 0x5f, 0xfc, 0x3e, 0x2c,
-	0xe7, 0xe1, 0x29, 0xae, 0xfe, 0x1b, 0xd8, 0x3a, 0xa3, 0x89, 0x10, 0x92, 0x26, 0x5e, 0x77, 0x31,
-	0x3e, 0x22, 0x59, 0xa7, 0xcb, 0x5f, 0xa6, 0x53, 0x8c, 0x02, 0xf7, 0xd7, 0xb0, 0x7d, 0xbb, 0xf8,
-	0x74, 0x75, 0xaf, 0x04, 0x09, 0xd3, 0x7a, 0x02, 0xab, 0xee, 0xc7, 0x8f, 0x74, 0x02, 0xfe, 0x54,
-	0x80, 0xda, 0x39, 0xe6, 0xeb, 0xfe, 0xae, 0x97, 0x36, 0xe1, 0x06, 0x66, 0x26, 0x55, 0xf4, 0x43,
-	0xa8, 0xcb, 0xfd, 0xde, 0x67, 0xa2, 0xcb, 0xf8, 0x4c, 0xf8, 0xa4, 0xd7, 0xfa, 0x25, 0x79, 0x30,
-	0x9c, 0x4d, 0x72, 0x7c, 0xe1, 0xc8, 0xcb, 0x73, 0x9f, 0x0e, 0x03, 0xf1, 0x50, 0x2a, 0x19, 0xce,
-	0xa7, 0xbb, 0xf9, 0x2c, 0xbe, 0xd7, 0x26, 0xa8, 0xd2, 0x76, 0x1e, 0x80, 0x2b, 0x7a, 0xae, 0xd5,
-	0x27, 0x0e, 0xe2, 0x40, 0x4c, 0x97, 0xdc, 0xce, 0xf2, 0x0a, 0xee, 0xbf, 0x95, 0xeb, 0x7d, 0x77,
-	0x98, 0x15, 0x68, 0xd8, 0x95, 0x60, 0xd5, 0x64, 0x1e, 0x9e, 0xa2, 0x28, 0x1e, 0x41, 0xe5, 0x90,
-	0xb4, 0x2f, 0xb3, 0x41, 0x05, 0x6e, 0x43, 0xa9, 0x9d, 0xc4, 0xed, 0x8c, 0x52, 0x8c, 0xdb, 0x7d,
-	0xdd, 0x78, 0x6c, 0xc8, 0xfd, 0x02, 0xaa, 0x46, 0x44, 0x1b, 0x78, 0x00, 0x73, 0x78, 0x35, 0x4c,
-	0x6c, 0x75, 0xcf, 0xfc, 0x67, 0xe1, 0x44, 0xa0, 0x9e, 0x3a, 0xd4, 0xcd, 0x95, 0x27, 0x14, 0x4f,
-	0x69, 0xd2, 0xcb, 0x59, 0x75, 0x0f, 0x60, 0x7d, 0xc2, 0xd9, 0x5d, 0xd4, 0xb7, 0xe6, 0xe5, 0xbf,
-	0x31, 0x3e, 0xfb, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x67, 0xfd, 0x4a, 0x37, 0x19, 0x00,
-	0x00,
+	// 6960 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0x4b, 0x8c, 0x1c, 0xc9,
+	0x75, 0x20, 0xaa, 0xff, 0xfd, 0xaa, 0xbf, 0xd9, 0x5f, 0x36, 0xc9, 0x26, 0x99, 0xa4, 0xe6, 0xab,
+	0x69, 0x6a, 0x9a, 0xa3, 0x99, 0xd9, 0xf9, 0x49, 0xdd, 0x4d, 0x36, 0x87, 0x1a, 0x92, 0xd3, 0x93,
+	0xdd, 0x43, 0x4a, 0x2b, 0xad, 0x6a, 0xa3, 0x32, 0xa3, 0xaa, 0x53, 0x9d, 0x95, 0x99, 0x8c, 0x8c,
+	0x6a, 0xb2, 0x85, 0xc5, 0x62, 0xb1, 0x80, 0x6e, 0xc2, 0x1e, 0x16, 0x86, 0x61, 0xc3, 0x06, 0x0c,
+	0xdb, 0x80, 0x0d, 0xd9, 0xb0, 0x8f, 0xbe, 0xd8, 0xf0, 0xd9, 0x06, 0x74, 0xf3, 0x0f, 0x86, 0x2f,
+	0xbe, 0x19, 0x3e, 0xf8, 0xec, 0x83, 0x2f, 0xc6, 0x8b, 0x78, 0x91, 0x19, 0x59, 0x95, 0xd5, 0x1f,
+	0x6a, 0x2c, 0xd8, 0xa7, 0xaa, 0x78, 0xbf, 0x78, 0xf1, 0x22, 0xe2, 0xc5, 0x8b, 0x88, 0x17, 0x09,
+	0x2b, 0x92, 0x35, 0x23, 0x2e, 0x3b, 0x2c, 0x66, 0x6d, 0x2e, 0x02, 0x26, 0xd9, 0x46, 0x2a, 0x12,
+	0x99, 0x38, 0xf3, 0x7d, 0x88, 0xb5, 0xb9, 0x66, 0x18, 0x47, 0x49, 0xbb, 0x20, 0x5a, 0xab, 0x3f,
+	0xeb, 0x72, 0x71, 0x42, 0x85, 0x19, 0x99, 0xa4, 0x89, 0x85, 0x5c, 0x12, 0x3c, 0x8d, 0x42, 0x9f,
+	0xc9, 0x30, 0x89, 0x2d, 0xf0, 0x74, 0x94, 0xb4, 0xbb, 0x32, 0x8c, 0x4c, 0xf1, 0x38, 0xf3, 0x0f,
+	0x79, 0x87, 0xb0, 0xee, 0xdf, 0xd7, 0x60, 0xf6, 0x00, 0x6b, 0xbe, 0xcb, 0x5b, 0x61, 0x1c, 0x22,
+	0xaf, 0xe3, 0xc0, 0x48, 0xcc, 0x3a, 0x7c, 0xb5, 0x76, 0xbd, 0xf6, 0xda, 0xa4, 0xa7, 0xfe, 0x3b,
+	0xcb, 0x30, 0xa6, 0xf9, 0x56, 0x87, 0x14, 0x94, 0x4a, 0xce, 0x2a, 0x8c, 0xfb, 0x49, 0xd4, 0xed,
+	0xc4, 0xd9, 0xea, 0xf0, 0xf5, 0xe1, 0xd7, 0x26, 0x3d, 0x53, 0x74, 0x36, 0x60, 0x21, 0x15, 0x61,
+	0x87, 0x89, 0x93, 0xc6, 0x11, 0x3f, 0x69, 0x18, 0xaa, 0x11, 0x45, 0x35, 0x4f, 0xa8, 0xcf, 0xf8,
+	0xc9, 0x0e, 0xd1, 0x3b, 0x30, 0x22, 0x4f, 0x52, 0xbe, 0x3a, 0xaa, 0x6b, 0xc5, 0xff, 0xce, 0x35,
+	0xa8, 0x63, 0x4b, 0x1a, 0x11, 0x8f, 0xdb, 0xf2, 0x70, 0x75, 0xec, 0x7a, 0xed, 0xb5, 0x11, 0x0f,
+	0x10, 0xf4, 0x50, 0x41, 0x9c, 0xcb, 0x30, 0x29, 0x92, 0xe7, 0x0d, 0x3f, 0xe9, 0xc6, 0x72, 0x75,
+	0x5c, 0xa1, 0x27, 0x44, 0xf2, 0x7c, 0x07, 0xcb, 0xee, 0xef, 0xd5, 0x60, 0x6e, 0x5f, 0xa9, 0x69,
+	0x35, 0xee, 0x55, 0x98, 0x45, 0xfe, 0x26, 0xcb, 0x78, 0x83, 0x5a, 0xa4, 0xdb, 0x39, 0x63, 0xc0,
+	0x9a, 0xc5, 0xf9, 0x1c, 0x74, 0x97, 0x34, 0x82, 0x9c, 0x39, 0x5b, 0x1d, 0xba, 0x3e, 0xfc, 0x5a,
+	0x7d, 0xd3, 0xdd, 0xe8, 0xef, 0xc5, 0x1e, 0x23, 0x7a, 0x73, 0xb2, 0x0c, 0xc8, 0xd0, 0x54, 0xc7,
+	0x5c, 0x64, 0x61, 0x12, 0xaf, 0x0e, 0xab, 0x1a, 0x4d, 0x11, 0x15, 0x75, 0x74, 0xad, 0x3b, 0x87,
+	0x2c, 0x6e, 0x73, 0x8f, 0x67, 0xdd, 0x48, 0x3a, 0x9f, 0xc2, 0x74, 0x93, 0xb7, 0x12, 0x51, 0x52,
+	0xb4, 0xbe, 0x79, 0xb3, 0xa2, 0xf6, 0xde, 0x66, 0x7a, 0x53, 0x9a, 0x93, 0xda, 0xb2, 0x0b, 0x53,
+	0xac, 0x25, 0xb9, 0x68, 0x58, 0x7d, 0x78, 0x4e, 0x41, 0x75, 0xc5, 0xa8, 0xc1, 0xee, 0xbf, 0xd6,
+	0x60, 0xe6, 0xcb, 0x8c, 0x8b, 0x3d, 0x2e, 0x3a, 0x61, 0x96, 0xd1, 0x60, 0x39, 0x4c, 0x32, 0x69,
+	0x06, 0x0b, 0xfe, 0x47, 0x58, 0x37, 0xe3, 0x82, 0x86, 0x8a, 0xfa, 0xef, 0xbc, 0x09, 0xf3, 0x29,
+	0xcb, 0xb2, 0xe7, 0x89, 0x08, 0x1a, 0xfe, 0x21, 0xf7, 0x8f, 0xb2, 0x6e, 0x47, 0xd9, 0x61, 0xc4,
+	0x9b, 0x33, 0x88, 0x1d, 0x82, 0x3b, 0x5f, 0x00, 0xa4, 0x22, 0x3c, 0x0e, 0x23, 0xde, 0xe6, 0x7a,
+	0xc8, 0xd4, 0x37, 0xdf, 0xae, 0xd0, 0xb6, 0xac, 0xcb, 0xc6, 0x5e, 0xce, 0x73, 0x2f, 0x96, 0xe2,
+	0xc4, 0xb3, 0x84, 0xac, 0x7d, 0x0c, 0xb3, 0x3d, 0x68, 0x67, 0x0e, 0x86, 0x8f, 0xf8, 0x09, 0x69,
+	0x8e, 0x7f, 0x9d, 0x45, 0x18, 0x3d, 0x66, 0x51, 0x97, 0x93, 0xe6, 0xba, 0xf0, 0xc1, 0xd0, 0xfb,
+	0x35, 0xf7, 0x6f, 0x6b, 0x30, 0x75, 0xb7, 0x79, 0x46, 0xbb, 0x67, 0x60, 0x28, 0x68, 0x12, 0xef,
+	0x50, 0xd0, 0xcc, 0xed, 0x30, 0x6c, 0xd9, 0xe1, 0xf3, 0x8a, 0xa6, 0xdd, 0xae, 0x68, 0xda, 0xdd,
+	0xe6, 0x2f, 0xa7, 0x61, 0xbf, 0x5b, 0x83, 0x7a, 0x51, 0x53, 0xe6, 0x3c, 0x84, 0x39, 0xd4, 0xb3,
+	0x91, 0x16, 0xb0, 0xd5, 0x9a, 0xd2, 0xf2, 0xc6, 0x99, 0x1d, 0xe0, 0xcd, 0x76, 0x4b, 0xe5, 0xcc,
+	0xd9, 0x85, 0x99, 0xa0, 0x59, 0x92, 0xa5, 0x67, 0xd0, 0xb5, 0x33, 0x5a, 0xec, 0x4d, 0x07, 0x56,
+	0x29, 0x73, 0x3f, 0x84, 0xfa, 0x76, 0x94, 0xee, 0x25, 0x99, 0x9e, 0xc4, 0x73, 0x30, 0xdc, 0x0d,
+	0x03, 0xd5, 0xc0, 0x69, 0x0f, 0xff, 0x3a, 0x6b, 0x30, 0x91, 0x12, 0x96, 0xda, 0x98, 0x97, 0xdd,
+	0x57, 0xa1, 0xbe, 0x17, 0xc6, 0x6d, 0x8f, 0x3f, 0xeb, 0xf2, 0x4c, 0xe2, 0x3c, 0x4c, 0xd9, 0x49,
+	0x94, 0xb0, 0x80, 0x2c, 0x64, 0x8a, 0xee, 0x6b, 0x30, 0xa5, 0x09, 0xb3, 0x34, 0x89, 0x33, 0x7e,
+	0x0a, 0xe5, 0x1b, 0x30, 0xb5, 0x1f, 0x71, 0x9e, 0x1a, 0x99, 0x6b, 0x30, 0x11, 0x74, 0x85, 0x72,
+	0xbd, 0x8a, 0x74, 0xd8, 0xcb, 0xcb, 0xee, 0x2c, 0x4c, 0x13, 0xad, 0x16, 0xeb, 0xfe, 0x5d, 0x0d,
+	0x9c, 0x7b, 0x2f, 0xb8, 0xdf, 0x95, 0xfc, 0xd3, 0x24, 0x39, 0x32, 0x32, 0xaa, 0xdc, 0xee, 0x3a,
+	0x40, 0xca, 0x04, 0xeb, 0x70, 0xc9, 0x85, 0xb6, 0xdd, 0xa4, 0x67, 0x41, 0x9c, 0x3d, 0x98, 0xe4,
+	0x2f, 0xa4, 0x60, 0x0d, 0x1e, 0x1f, 0x2b, 0x07, 0x5c, 0xdf, 0xbc, 0x53, 0x61, 0xda, 0xfe, 0xda,
+	0x36, 0xee, 0x21, 0xdb, 0xbd, 0xf8, 0x58, 0x0f, 0xa8, 0x09, 0x4e, 0xc5, 0xb5, 0x0f, 0x61, 0xba,
+	0x84, 0xba, 0xd0, 0x60, 0x6a, 0xc1, 0x42, 0xa9, 0x2a, 0xb2, 0xe3, 0x35, 0xa8, 0xf3, 0x17, 0xa1,
+	0x6c, 0x64, 0x92, 0xc9, 0x6e, 0x46, 0x06, 0x02, 0x04, 0xed, 0x2b, 0x88, 0x5a, 0x5d, 0x64, 0x90,
+	0x74, 0x65, 0xbe, 0xba, 0xa8, 0x12, 0xc1, 0xb9, 0x30, 0x53, 0x88, 0x4a, 0xee, 0x3f, 0xd5, 0x60,
+	0xcd, 0xaa, 0xe8, 0x20, 0xd9, 0x97, 0x82, 0xb3, 0xce, 0x2f, 0x62, 0xc9, 0xef, 0xf6, 0x5b, 0xf2,
+	0xc3, 0xd3, 0x2d, 0xd9, 0x53, 0xeb, 0x7f, 0x8c, 0x45, 0xff, 0x6f, 0x0d, 0x2e, 0x57, 0xd6, 0x49,
+	0xa6, 0x2d, 0x2c, 0x87, 0xe2, 0xa6, 0x72, 0xcb, 0x39, 0x30, 0x12, 0x24, 0xb1, 0x16, 0x38, 0xe1,
+	0xa9, 0xff, 0xbd, 0xdd, 0x30, 0x3c, 0xa0, 0x1b, 0xd0, 0xdc, 0x23, 0x25, 0x73, 0xff, 0x61, 0x0d,
+	0xe6, 0xee, 0x73, 0xa9, 0x17, 0x01, 0x63, 0xe4, 0x65, 0x18, 0x53, 0xe6, 0xd1, 0xee, 0x61, 0xd2,
+	0xa3, 0x92, 0x73, 0x13, 0xa6, 0xc3, 0xd8, 0x8f, 0xba, 0x01, 0x6f, 0x1c, 0x87, 0xfc, 0x79, 0x46,
+	0x2a, 0x4c, 0x11, 0xf0, 0x09, 0xc2, 0x9c, 0xaf, 0xc1, 0x0c, 0x7f, 0xa1, 0x89, 0x48, 0x88, 0x8e,
+	0x1e, 0xa6, 0x09, 0x7a, 0xa0, 0x65, 0xdd, 0x81, 0xe5, 0x26, 0xcf, 0x64, 0x83, 0xb7, 0x5a, 0x89,
+	0x90, 0x0d, 0x19, 0x76, 0x78, 0xd2, 0x95, 0x0d, 0x15, 0x46, 0xa0, 0xf2, 0x0b, 0x88, 0xbd, 0xa7,
+	0x90, 0x07, 0x1a, 0xf7, 0x38, 0x73, 0x7f, 0x52, 0x83, 0x79, 0x4b, 0x5b, 0x32, 0xd4, 0x1e, 0xcc,
+	0xeb, 0xc5, 0xcf, 0x5a, 0xcf, 0x2f, 0xb2, 0xa0, 0xce, 0x65, 0x3d, 0x10, 0x1c, 0x51, 0x61, 0xec,
+	0x27, 0x9d, 0x34, 0xe2, 0xd2, 0x18, 0xda, 0x82, 0xb8, 0xff, 0xa7, 0x06, 0x6b, 0xf7, 0xb9, 0xdc,
+	0x11, 0x9c, 0x49, 0x8e, 0x16, 0xe6, 0x1d, 0x1e, 0xcb, 0xec, 0x97, 0x68, 0x3f, 0xf7, 0x6f, 0x6a,
+	0x70, 0xb9, 0x52, 0x05, 0x32, 0xca, 0x33, 0x98, 0xf7, 0x15, 0xae, 0x91, 0xe5, 0x48, 0xf2, 0xf6,
+	0x77, 0x2b, 0x8c, 0x72, 0x8a, 0xa8, 0x8d, 0x5e, 0x84, 0x9e, 0x05, 0x73, 0x7e, 0x0f, 0x78, 0x6d,
+	0x07, 0x96, 0x2a, 0x49, 0x2f, 0x34, 0x2b, 0xde, 0x51, 0x96, 0xd5, 0x7d, 0x84, 0x1d, 0x9f, 0x49,
+	0xd6, 0x49, 0xcf, 0xb2, 0xac, 0xfb, 0x67, 0xda, 0x1a, 0xfd, 0x6c, 0x64, 0x8d, 0x1f, 0x02, 0xc8,
+	0x1c, 0x4a, 0x66, 0xf8, 0xa4, 0xda, 0x0c, 0x83, 0x64, 0x6c, 0x14, 0x20, 0x5a, 0xa9, 0x0b, 0x89,
+	0xb8, 0x52, 0xf7, 0xa0, 0xcf, 0x6a, 0xf4, 0xb0, 0xdd, 0xe8, 0x15, 0x58, 0xba, 0xcf, 0xa5, 0xb5,
+	0x2a, 0x52, 0x7b, 0xdd, 0xff, 0x0e, 0xcb, 0xbd, 0x08, 0x6a, 0xd1, 0xb7, 0xa1, 0x5e, 0x5e, 0xc7,
+	0x71, 0xb8, 0xaf, 0x57, 0x34, 0xc9, 0x66, 0xb6, 0x59, 0xdc, 0xff, 0x5f, 0x83, 0xd9, 0x9d, 0x24,
+	0x8e, 0xb9, 0x8f, 0x63, 0x1e, 0xfb, 0x2c, 0x73, 0x5e, 0x87, 0xb9, 0x24, 0xe5, 0x71, 0xc3, 0xcf,
+	0xe1, 0xc6, 0xa7, 0xcf, 0x22, 0xbc, 0x20, 0xcf, 0x9c, 0xdb, 0xb0, 0xc0, 0x7c, 0x19, 0x1e, 0xf3,
+	0x86, 0x14, 0x2c, 0xce, 0x98, 0x6f, 0xc2, 0x68, 0xa4, 0x76, 0x34, 0xea, 0xc0, 0xc2, 0xe0, 0xe8,
+	0x4f, 0x93, 0x24, 0x6a, 0xf8, 0x2c, 0x65, 0x7e, 0x28, 0x4f, 0xc8, 0x4b, 0x4d, 0x21, 0x70, 0x87,
+	0x60, 0xee, 0x65, 0xb8, 0x84, 0x43, 0xb1, 0xac, 0x96, 0xb1, 0xc6, 0x11, 0xac, 0x55, 0x21, 0xc9,
+	0x22, 0x8f, 0x60, 0xae, 0x50, 0x5b, 0x8d, 0x7a, 0x63, 0x96, 0xaa, 0xa0, 0xbe, 0x57, 0xca, 0xac,
+	0x5f, 0x06, 0xb8, 0xf7, 0xc1, 0x2d, 0x55, 0xe6, 0xf1, 0x1f, 0xf5, 0xab, 0xe4, 0xdc, 0x80, 0x29,
+	0xc1, 0x33, 0x2e, 0xf5, 0x3e, 0x45, 0x57, 0x38, 0xe1, 0xd5, 0x15, 0x4c, 0x6d, 0x55, 0x32, 0xf7,
+	0xe7, 0x35, 0xb8, 0x79, 0xaa, 0x24, 0xd2, 0xbf, 0x05, 0x20, 0x0c, 0xc6, 0x8c, 0xd1, 0xdd, 0x01,
+	0x53, 0xf5, 0x0c, 0x59, 0x1b, 0x39, 0xd8, 0x8c, 0xd5, 0x42, 0x32, 0x8e, 0xd5, 0x1e, 0xf4, 0x85,
+	0xc6, 0xaa, 0xa3, 0x16, 0x8c, 0x9d, 0x24, 0x6e, 0x85, 0x26, 0xee, 0x72, 0x7f, 0x45, 0xfb, 0x65,
+	0x03, 0xa4, 0x06, 0xdd, 0x83, 0xd1, 0x56, 0xc4, 0xda, 0xa6, 0x2d, 0xb7, 0x07, 0xb6, 0xc5, 0x62,
+	0xda, 0xd8, 0x45, 0x0e, 0xad, 0xb4, 0xe6, 0x5e, 0x7b, 0x1f, 0xa0, 0x00, 0x5e, 0xc8, 0x97, 0xac,
+	0xaa, 0xd9, 0xf3, 0x20, 0xde, 0x8d, 0xc2, 0xf6, 0xa1, 0xf4, 0xf6, 0x76, 0xf2, 0x91, 0xf4, 0x47,
+	0x35, 0x58, 0xe9, 0x43, 0x91, 0xda, 0x5f, 0xc2, 0x64, 0x18, 0x37, 0x5a, 0x0a, 0x41, 0xaa, 0xbf,
+	0x5f, 0xad, 0x7a, 0x15, 0xfb, 0x86, 0x01, 0x52, 0xac, 0x10, 0x52, 0x11, 0x63, 0x85, 0x12, 0xea,
+	0x42, 0x46, 0xff, 0xe3, 0x1a, 0x4c, 0xed, 0x89, 0xc4, 0xe7, 0x59, 0xa6, 0x27, 0xea, 0x3a, 0x40,
+	0x3b, 0x11, 0x49, 0x57, 0x86, 0x31, 0xcf, 0xc3, 0xae, 0x02, 0x82, 0xf1, 0xad, 0x3c, 0x14, 0x9c,
+	0x05, 0x66, 0x46, 0x9a, 0xa2, 0x73, 0x15, 0x40, 0x4d, 0xf1, 0x56, 0xa8, 0xd7, 0x16, 0x44, 0x4e,
+	0x22, 0x64, 0x17, 0x01, 0xce, 0x6b, 0x30, 0x77, 0xc8, 0x59, 0xda, 0x60, 0x51, 0x94, 0xf8, 0x8d,
+	0xe6, 0x89, 0xe4, 0x7a, 0x45, 0x1e, 0xf1, 0x66, 0x10, 0xbe, 0x85, 0xe0, 0x6d, 0x84, 0xe2, 0x06,
+	0x3d, 0x3b, 0xc9, 0x88, 0x64, 0x54, 0x6f, 0xd0, 0xb3, 0x93, 0x4c, 0x21, 0xc9, 0xf4, 0xb6, 0xca,
+	0xc6, 0xf4, 0x7b, 0xb0, 0xd2, 0x87, 0x21, 0xcb, 0x7f, 0x13, 0x46, 0xed, 0x69, 0x5b, 0xb5, 0x93,
+	0x28, 0xf1, 0x69, 0x6a, 0xf7, 0x2f, 0x6b, 0x50, 0xff, 0x94, 0xb3, 0x48, 0x1e, 0xee, 0xfb, 0x89,
+	0xe0, 0x68, 0xc6, 0x0c, 0xff, 0x28, 0x31, 0xa3, 0x9e, 0x2e, 0x38, 0xef, 0xc0, 0xb2, 0x75, 0x8a,
+	0xd2, 0x88, 0x58, 0xbb, 0xd1, 0x62, 0xbe, 0x4c, 0xf4, 0x5e, 0xb6, 0xe6, 0x2d, 0x5a, 0xd8, 0x87,
+	0xac, 0xbd, 0xab, 0x70, 0xce, 0x1b, 0x30, 0xcf, 0x85, 0x48, 0x44, 0x43, 0xe0, 0x52, 0x4a, 0x0c,
+	0xc3, 0x8a, 0x61, 0x56, 0x21, 0x3c, 0x26, 0x39, 0xd1, 0x5e, 0x83, 0x3a, 0xee, 0x20, 0x0c, 0xd5,
+	0x88, 0xa2, 0x02, 0x04, 0x11, 0xc1, 0x0d, 0x98, 0x3a, 0x54, 0x7a, 0x36, 0x14, 0x2b, 0x9d, 0x87,
+	0xd4, 0x35, 0xec, 0x1e, 0x82, 0x68, 0x25, 0xb0, 0x5a, 0x63, 0xcc, 0xf6, 0x18, 0x96, 0x7b, 0x11,
+	0x64, 0xb5, 0x77, 0xec, 0xe6, 0x56, 0xaf, 0x01, 0x36, 0x9b, 0x26, 0x76, 0x37, 0x94, 0x3c, 0x55,
+	0xe9, 0xc3, 0xa4, 0x7d, 0xc0, 0xc2, 0xc8, 0xb8, 0xb4, 0x45, 0x18, 0x8d, 0xac, 0x51, 0xa5, 0x0b,
+	0xee, 0x6d, 0x58, 0xe9, 0xa3, 0x27, 0x05, 0x2c, 0x06, 0x5c, 0x93, 0x89, 0x61, 0x09, 0x16, 0xd4,
+	0xa6, 0xff, 0x2e, 0x93, 0xec, 0x6e, 0x28, 0x4c, 0x3b, 0x36, 0x61, 0xb1, 0x0c, 0x26, 0x21, 0xb8,
+	0xcb, 0x13, 0x49, 0x33, 0xe2, 0x1d, 0x23, 0x27, 0x2f, 0xbb, 0x7f, 0x32, 0x0c, 0x73, 0x77, 0x43,
+	0xd6, 0x8e, 0x93, 0x4c, 0x86, 0xfe, 0x76, 0x37, 0x0e, 0x22, 0xee, 0xbc, 0x0f, 0x93, 0xa9, 0x1e,
+	0x0c, 0xdc, 0x78, 0x98, 0xb5, 0xc1, 0x03, 0xc6, 0x2b, 0x88, 0x9d, 0x0f, 0x60, 0x2a, 0x8b, 0xd8,
+	0x31, 0x37, 0xd1, 0xb2, 0x3e, 0x32, 0x59, 0xd9, 0xe8, 0x3d, 0x64, 0xd3, 0xa1, 0xb3, 0x57, 0x57,
+	0xc4, 0xba, 0xe0, 0xdc, 0x82, 0x19, 0x3d, 0x1e, 0xa2, 0xa4, 0xdd, 0x90, 0x2c, 0x8c, 0x28, 0x3a,
+	0x9b, 0xe2, 0x96, 0x65, 0x70, 0xef, 0x76, 0xcc, 0x44, 0xa8, 0x23, 0x15, 0x7d, 0x10, 0xb0, 0x59,
+	0xb5, 0x2d, 0xee, 0x69, 0xd3, 0xc6, 0x13, 0xc3, 0xa4, 0x9d, 0x47, 0x21, 0xc4, 0xb9, 0x0d, 0x8b,
+	0xc8, 0xd2, 0x08, 0x42, 0xd1, 0x90, 0x89, 0x64, 0x91, 0x35, 0xef, 0x86, 0xbd, 0xf9, 0x40, 0x5b,
+	0xf3, 0x00, 0x31, 0x7a, 0x76, 0xbe, 0x05, 0x0b, 0x39, 0x43, 0x4b, 0x70, 0x4e, 0xf4, 0x63, 0x8a,
+	0x7e, 0x8e, 0xe8, 0x77, 0x05, 0xe7, 0x9a, 0x7c, 0x19, 0xc6, 0x54, 0x0b, 0xb2, 0xd5, 0x71, 0x1d,
+	0x58, 0xe9, 0xd2, 0xda, 0x47, 0x30, 0x53, 0x56, 0xea, 0x42, 0x0e, 0xf8, 0x32, 0x5c, 0xda, 0x61,
+	0xa9, 0xec, 0x0a, 0x5e, 0x34, 0x35, 0x77, 0x04, 0xdf, 0x83, 0xb5, 0x2a, 0x24, 0x8d, 0x87, 0x0f,
+	0x61, 0xac, 0xa9, 0x8c, 0x72, 0x4a, 0x24, 0xdf, 0x6b, 0x3f, 0x8f, 0x58, 0xdc, 0xbf, 0xae, 0xc1,
+	0xf4, 0xc1, 0xa1, 0x48, 0xa4, 0x8c, 0x54, 0xc7, 0x71, 0xe7, 0x0a, 0x4c, 0x4a, 0x02, 0x04, 0xb4,
+	0x48, 0x17, 0x00, 0x6c, 0x7d, 0x87, 0x4b, 0x11, 0xfa, 0x66, 0x93, 0xaa, 0x4b, 0x45, 0xcb, 0x86,
+	0x2d, 0x87, 0x4c, 0xb2, 0x78, 0x76, 0x98, 0x44, 0x01, 0xed, 0x56, 0x0a, 0x00, 0xca, 0x12, 0x9c,
+	0x65, 0x49, 0x4c, 0xd3, 0x9b, 0x4a, 0xb8, 0x6d, 0xeb, 0x24, 0x01, 0x57, 0x3d, 0x30, 0xe9, 0xa9,
+	0xff, 0xd8, 0x49, 0xf8, 0xdb, 0xe0, 0x2f, 0xd2, 0x50, 0x70, 0xb5, 0x09, 0xc2, 0x1d, 0xd0, 0xb8,
+	0xee, 0x24, 0x44, 0xdd, 0x53, 0x18, 0x8c, 0x2d, 0x1f, 0x67, 0xee, 0x25, 0x35, 0x07, 0x4b, 0x0d,
+	0x33, 0xc6, 0xf4, 0x60, 0xb5, 0x1f, 0x45, 0xa6, 0x7c, 0x57, 0xbb, 0x55, 0x63, 0xc9, 0xeb, 0x55,
+	0x47, 0x9c, 0x25, 0x46, 0x4d, 0xee, 0x2e, 0xc3, 0x22, 0xca, 0x54, 0xc4, 0x07, 0xac, 0x9d, 0x77,
+	0xdc, 0xaf, 0xd5, 0x60, 0xa9, 0x07, 0x41, 0x35, 0xed, 0xc2, 0x88, 0x2c, 0x16, 0xfc, 0xcd, 0xea,
+	0x55, 0xb3, 0x9f, 0x6f, 0xe3, 0x20, 0x5f, 0xf3, 0x15, 0xff, 0xda, 0x7b, 0x30, 0x79, 0xf0, 0x52,
+	0x2b, 0xfe, 0x03, 0x70, 0xf6, 0x0b, 0x33, 0x58, 0x87, 0x06, 0xca, 0xf4, 0x35, 0xcb, 0xf4, 0x78,
+	0xfe, 0x4c, 0xc7, 0x38, 0x8d, 0x3c, 0x6c, 0x05, 0x03, 0x7a, 0xac, 0xfc, 0x57, 0x49, 0x14, 0x9d,
+	0xf0, 0x2c, 0xaa, 0x1a, 0x3c, 0xce, 0x82, 0xcf, 0xe3, 0xe8, 0xc4, 0x98, 0x44, 0x13, 0x17, 0x50,
+	0x22, 0x2e, 0xc0, 0x4f, 0x45, 0x58, 0x74, 0xd6, 0x32, 0x2c, 0x96, 0xc1, 0x44, 0xbe, 0x09, 0x97,
+	0x2c, 0x29, 0x4f, 0x43, 0x79, 0x78, 0x70, 0xf0, 0xd0, 0x34, 0x62, 0x09, 0xc6, 0xa4, 0x8c, 0x1a,
+	0x79, 0x40, 0x3e, 0x2a, 0x65, 0xf4, 0x38, 0x73, 0xaf, 0xc0, 0x5a, 0x15, 0x0f, 0x49, 0x7c, 0x1d,
+	0x56, 0xf6, 0xb9, 0xdc, 0xef, 0xa6, 0x5c, 0xf4, 0xa8, 0x8c, 0x27, 0x9a, 0xb4, 0x4d, 0x9e, 0xf0,
+	0x86, 0x92, 0xd8, 0xdd, 0x86, 0xd5, 0x7e, 0x52, 0xea, 0xd7, 0x57, 0x60, 0x36, 0x43, 0x44, 0x03,
+	0x43, 0x88, 0x46, 0x12, 0x47, 0x27, 0xc4, 0x38, 0x9d, 0xd9, 0xf4, 0xee, 0xbf, 0xd4, 0x60, 0xfe,
+	0x0b, 0xbc, 0xc7, 0xd8, 0xe7, 0xe2, 0x98, 0x0b, 0x1d, 0xda, 0x61, 0xa0, 0xa0, 0x02, 0xff, 0x2c,
+	0xfc, 0x31, 0x37, 0x47, 0x68, 0x08, 0xd8, 0x0f, 0x7f, 0xcc, 0x31, 0xde, 0xc8, 0xd4, 0xb9, 0x47,
+	0xa3, 0xa0, 0xd1, 0x9d, 0x31, 0xa3, 0xe1, 0x7b, 0x86, 0x72, 0x13, 0x96, 0xac, 0x9d, 0x86, 0x45,
+	0xae, 0x27, 0xe7, 0x82, 0x85, 0xdc, 0xb3, 0xa4, 0xab, 0x7b, 0x95, 0xfe, 0xf3, 0x85, 0x19, 0x05,
+	0xcf, 0x8f, 0x16, 0x9c, 0x3b, 0xb0, 0x14, 0x84, 0x99, 0xba, 0x15, 0xf0, 0x93, 0x38, 0x4b, 0xa2,
+	0x30, 0xd0, 0x67, 0x7e, 0xa3, 0xaa, 0xa1, 0x8b, 0x84, 0xdc, 0xb1, 0x71, 0xee, 0xf7, 0xe1, 0xf2,
+	0x3e, 0x97, 0x7d, 0x2d, 0x36, 0x26, 0xfe, 0x08, 0xc6, 0x7c, 0x05, 0xa0, 0x99, 0x77, 0xab, 0x62,
+	0x42, 0xf4, 0x33, 0x13, 0x8f, 0xfb, 0x02, 0xae, 0x54, 0x0b, 0xa7, 0x4e, 0xf9, 0x04, 0xc6, 0x59,
+	0x9a, 0x46, 0x21, 0x0f, 0x2e, 0x24, 0xde, 0x30, 0x61, 0x88, 0x98, 0x1d, 0x85, 0x69, 0xca, 0x03,
+	0x3a, 0x33, 0x33, 0x45, 0x77, 0x4d, 0x39, 0x13, 0xc5, 0xba, 0x1d, 0x31, 0xff, 0x28, 0x0a, 0x33,
+	0x69, 0xc6, 0xee, 0x7b, 0x70, 0xa9, 0x02, 0x67, 0x2d, 0xe2, 0x4c, 0x4a, 0x2e, 0xe2, 0x62, 0x11,
+	0xa7, 0xb2, 0xfb, 0xae, 0x1a, 0x5f, 0x95, 0x42, 0x4f, 0xe5, 0xbb, 0x0c, 0x97, 0x2a, 0xf8, 0x68,
+	0x7c, 0xff, 0x08, 0xe6, 0xf5, 0xbd, 0xca, 0xc1, 0x49, 0x9a, 0x4f, 0xf7, 0x6f, 0x42, 0x5d, 0x1b,
+	0xa2, 0xa1, 0x6e, 0x9d, 0xd0, 0x38, 0x33, 0x9b, 0x8b, 0x1b, 0xf9, 0x9d, 0x1a, 0x39, 0x20, 0xe4,
+	0x00, 0x99, 0xff, 0x57, 0x87, 0x3e, 0x01, 0xef, 0xa4, 0x89, 0xe4, 0xb1, 0xcc, 0x0f, 0x7d, 0x72,
+	0x08, 0xce, 0x7c, 0xbb, 0x2e, 0xd2, 0xe0, 0x57, 0x6b, 0x6a, 0x32, 0xf7, 0x79, 0x49, 0xe7, 0x5e,
+	0xc9, 0x17, 0x56, 0x5d, 0x71, 0x54, 0xb1, 0x7d, 0x75, 0xae, 0x70, 0x05, 0x96, 0xf6, 0xab, 0x9c,
+	0x2d, 0x3a, 0x25, 0x8f, 0xb7, 0x70, 0xb9, 0x2a, 0xad, 0x20, 0xcb, 0xb0, 0x58, 0x06, 0x13, 0xf9,
+	0x15, 0x58, 0xf3, 0x78, 0xda, 0x6d, 0x46, 0x61, 0x76, 0x78, 0x90, 0xa4, 0x89, 0xc7, 0xfd, 0x44,
+	0x04, 0xc5, 0x70, 0xb8, 0x5c, 0x89, 0x2d, 0x8e, 0xd9, 0xcd, 0xc5, 0x98, 0x9e, 0xf8, 0xa6, 0x88,
+	0xea, 0x79, 0xdd, 0x58, 0x07, 0xa6, 0x2a, 0x20, 0x34, 0x12, 0x57, 0x61, 0xb9, 0x17, 0x41, 0x9a,
+	0xbc, 0x03, 0xab, 0x0f, 0xda, 0x71, 0x22, 0xf8, 0xa7, 0x45, 0xc0, 0x5c, 0x3a, 0xf9, 0x57, 0x23,
+	0xa6, 0x38, 0xcf, 0x57, 0x45, 0x1c, 0x3f, 0x15, 0x5c, 0x24, 0x72, 0x47, 0x0d, 0xae, 0x47, 0x2c,
+	0x8c, 0x25, 0x8f, 0x59, 0xec, 0xf3, 0x47, 0x49, 0xc0, 0x07, 0x78, 0x48, 0x6b, 0x65, 0x1f, 0xb2,
+	0x57, 0x76, 0x72, 0xc1, 0x7d, 0x42, 0xa8, 0x8a, 0xb7, 0xe0, 0xf2, 0x1e, 0xeb, 0x66, 0x54, 0xbd,
+	0xc7, 0xd3, 0x44, 0x48, 0xeb, 0xca, 0xa2, 0xd7, 0x0d, 0xaf, 0xc3, 0x95, 0x6a, 0x72, 0x12, 0xb7,
+	0x02, 0x4b, 0x7b, 0x82, 0xa7, 0x4c, 0xf0, 0x9d, 0xae, 0x4c, 0x8e, 0x79, 0x1e, 0x58, 0x6f, 0xc0,
+	0x72, 0x2f, 0xa2, 0x88, 0xcf, 0x65, 0x72, 0xc4, 0x8d, 0x65, 0x74, 0xc1, 0xfd, 0x3a, 0x2c, 0xee,
+	0x24, 0x9d, 0x4e, 0x28, 0xcb, 0x72, 0x06, 0x50, 0xaf, 0xc0, 0x52, 0x0f, 0x35, 0xe9, 0xf3, 0x26,
+	0x2c, 0x6c, 0x35, 0x13, 0x71, 0x3e, 0x29, 0xcb, 0xb0, 0x58, 0x26, 0x26, 0x21, 0x3f, 0xa9, 0xa9,
+	0x7e, 0x40, 0x3f, 0x15, 0xc6, 0xed, 0xcf, 0xf8, 0x89, 0xa7, 0xef, 0x4a, 0xb5, 0xac, 0xdb, 0x30,
+	0x89, 0xd7, 0xcc, 0x02, 0x61, 0xe4, 0xea, 0x9c, 0x62, 0x36, 0xe7, 0xd4, 0x13, 0x47, 0xf4, 0xcf,
+	0x79, 0x0f, 0xa6, 0x32, 0x74, 0x79, 0x81, 0x72, 0x00, 0xfa, 0x4a, 0x60, 0x90, 0x07, 0xa8, 0x6b,
+	0x4a, 0xfc, 0x6f, 0x16, 0xd3, 0x3e, 0x35, 0xf2, 0xc1, 0xb2, 0xe0, 0xf1, 0x4c, 0x32, 0x21, 0x1f,
+	0x9d, 0x64, 0xcf, 0xf2, 0xfd, 0xd2, 0xd7, 0xc1, 0xd1, 0x3b, 0xb8, 0xd2, 0x2a, 0xa3, 0x87, 0xfb,
+	0x1c, 0x61, 0x8a, 0x23, 0xec, 0x8f, 0x60, 0xb1, 0x2c, 0x84, 0x3a, 0xe9, 0x16, 0x8c, 0xf2, 0x63,
+	0x74, 0x3c, 0xba, 0x81, 0x33, 0x1b, 0xe6, 0x6e, 0xff, 0x1e, 0x42, 0x3d, 0x8d, 0x74, 0x19, 0x2c,
+	0xdc, 0xe5, 0x3e, 0x76, 0x84, 0xbe, 0x4b, 0x23, 0x15, 0x5e, 0xc7, 0x45, 0x34, 0x49, 0x1b, 0xd6,
+	0x0e, 0x86, 0x86, 0xd4, 0x2c, 0xc2, 0xbd, 0x02, 0x8c, 0x71, 0x8f, 0x22, 0xed, 0x60, 0xed, 0x81,
+	0x71, 0x73, 0x08, 0x52, 0xfa, 0x04, 0xa8, 0x60, 0xb9, 0x8a, 0x0b, 0x29, 0xb8, 0x0e, 0x57, 0xd4,
+	0xa4, 0x45, 0x5f, 0x60, 0x4e, 0xa9, 0x8e, 0x43, 0x99, 0x07, 0x4a, 0x3f, 0x80, 0xab, 0x03, 0xf0,
+	0x54, 0xcd, 0x15, 0x98, 0x14, 0x9c, 0xf9, 0x87, 0xd8, 0x43, 0x26, 0x50, 0xcf, 0x01, 0x78, 0x78,
+	0x11, 0x31, 0xc9, 0x63, 0xff, 0xa4, 0x08, 0xda, 0x26, 0x09, 0xf2, 0x38, 0x73, 0xf7, 0x61, 0xfa,
+	0x29, 0x13, 0x9d, 0x2f, 0x53, 0xcb, 0x2d, 0xe0, 0x3a, 0x1f, 0xe6, 0x9b, 0x53, 0x53, 0xc4, 0xc8,
+	0x40, 0x6d, 0xd6, 0x9b, 0xdd, 0x56, 0x0b, 0xef, 0x44, 0x93, 0x24, 0x22, 0x63, 0xcc, 0x20, 0x7c,
+	0x5b, 0x81, 0x31, 0x8e, 0xc0, 0xc3, 0xad, 0x19, 0x23, 0xb5, 0xb8, 0xf5, 0x22, 0x39, 0x0d, 0xd1,
+	0x35, 0xae, 0x0d, 0x08, 0xe4, 0x75, 0x63, 0x3c, 0xeb, 0x34, 0x04, 0x6a, 0xb7, 0x46, 0xaa, 0x4e,
+	0x11, 0x50, 0xed, 0xd3, 0x50, 0x05, 0xab, 0x76, 0x3c, 0x90, 0x89, 0xe8, 0x68, 0x61, 0xa6, 0x99,
+	0x57, 0xbf, 0x1b, 0x46, 0x51, 0x7e, 0xe5, 0x33, 0x52, 0x5c, 0xf9, 0xb8, 0x1f, 0xe0, 0x68, 0x44,
+	0x55, 0xcb, 0x77, 0x37, 0x37, 0x61, 0xfa, 0x39, 0x0b, 0x65, 0x23, 0xbf, 0x32, 0xd5, 0x13, 0x70,
+	0x0a, 0x81, 0xe6, 0x92, 0x55, 0xfb, 0x7a, 0x9b, 0x37, 0x0f, 0x40, 0xd1, 0x87, 0xe8, 0xa3, 0xaf,
+	0xb2, 0x58, 0x4c, 0x06, 0x51, 0x8b, 0x5f, 0x6e, 0x48, 0x2a, 0xba, 0x6d, 0x58, 0xe9, 0xe3, 0x21,
+	0x33, 0x3d, 0x84, 0x19, 0x4d, 0xd5, 0x10, 0x2a, 0xed, 0xc1, 0x2c, 0x86, 0x5f, 0x1b, 0x78, 0x2b,
+	0x63, 0x27, 0x49, 0x78, 0xd3, 0xbe, 0x55, 0xca, 0xdc, 0x7f, 0xab, 0x81, 0xb3, 0x95, 0xa6, 0xd1,
+	0x49, 0x59, 0xb3, 0x39, 0x18, 0xce, 0x9e, 0x45, 0x66, 0x4d, 0xcc, 0x9e, 0x45, 0xe8, 0x7b, 0x5a,
+	0x89, 0xf0, 0xcd, 0xc5, 0x8d, 0x2e, 0x60, 0x96, 0x02, 0x9e, 0x69, 0x3d, 0x2f, 0x4d, 0x92, 0x61,
+	0x45, 0x31, 0xa7, 0x10, 0xf6, 0x2c, 0xe9, 0xcb, 0xcf, 0x18, 0xf9, 0xaa, 0xf2, 0x33, 0x46, 0x5f,
+	0x32, 0x3f, 0xe3, 0xf7, 0x6b, 0xb0, 0x50, 0x6a, 0x3d, 0xd9, 0xf8, 0x3f, 0x5f, 0x26, 0x89, 0x07,
+	0xf3, 0x44, 0x10, 0xb6, 0x5a, 0xa6, 0x97, 0x3e, 0x86, 0xf1, 0x80, 0x67, 0xa1, 0xc8, 0x83, 0xd5,
+	0x73, 0xc9, 0x35, 0x3c, 0xee, 0x3b, 0xe0, 0xd8, 0x32, 0xa9, 0xed, 0xeb, 0x00, 0x3d, 0x97, 0x5b,
+	0x93, 0x9e, 0x05, 0x71, 0x7f, 0xa7, 0x06, 0xcb, 0xf6, 0xb8, 0xda, 0xca, 0x32, 0x9e, 0x65, 0x88,
+	0x53, 0xeb, 0x53, 0xee, 0x62, 0x26, 0x3d, 0x5d, 0x40, 0xe7, 0xc3, 0xa2, 0x76, 0x22, 0x42, 0x79,
+	0xd8, 0xa1, 0x45, 0xbe, 0x00, 0xe0, 0x7c, 0x55, 0x64, 0x6a, 0xd7, 0x41, 0xe7, 0x29, 0x7a, 0xef,
+	0x31, 0xa3, 0xe0, 0xb8, 0xe3, 0xd0, 0xa7, 0x29, 0x78, 0x6a, 0x98, 0xc9, 0xb0, 0xc3, 0x24, 0x0f,
+	0x1a, 0x51, 0xe2, 0x1f, 0x15, 0xfb, 0x8e, 0xd9, 0x1c, 0xf1, 0x30, 0xf1, 0x8f, 0x1e, 0x67, 0xee,
+	0x1d, 0xb8, 0xa4, 0xf5, 0x2a, 0xcf, 0x80, 0xfc, 0xbe, 0x4b, 0x4f, 0x02, 0xd2, 0x93, 0x4a, 0x6e,
+	0x1b, 0xd6, 0xaa, 0x98, 0xc8, 0x2e, 0x0f, 0x00, 0x58, 0xde, 0x54, 0xb2, 0xf7, 0xeb, 0x67, 0xcc,
+	0xb9, 0xc2, 0x36, 0x9e, 0xc5, 0xec, 0x1e, 0xc1, 0xbc, 0x4d, 0xa5, 0x7c, 0x7d, 0xe5, 0x25, 0xfc,
+	0x36, 0x80, 0x75, 0xfb, 0x3a, 0x34, 0xf0, 0xde, 0xa5, 0x37, 0x99, 0xca, 0xe2, 0xc2, 0x08, 0xfb,
+	0x29, 0x93, 0xfe, 0x61, 0x69, 0x82, 0xbb, 0x5f, 0xc0, 0x42, 0x09, 0x4a, 0x8d, 0xfc, 0xa0, 0xbc,
+	0x1e, 0xdd, 0x3a, 0xa3, 0x7d, 0xa5, 0x55, 0x6a, 0x41, 0x5d, 0x57, 0x3c, 0x29, 0xd7, 0xb3, 0x05,
+	0x8e, 0x0d, 0xa4, 0x6a, 0xde, 0x84, 0xf1, 0xe3, 0xd2, 0xcc, 0x9a, 0xdf, 0xa0, 0x32, 0x46, 0x1e,
+	0x59, 0xca, 0x7c, 0xee, 0x19, 0x0a, 0xf7, 0x36, 0xcd, 0xd1, 0x27, 0x7d, 0xce, 0xf3, 0xb8, 0x94,
+	0x90, 0x96, 0x33, 0x60, 0x40, 0x54, 0x62, 0x20, 0x47, 0xfc, 0x0f, 0x35, 0x58, 0xa5, 0xdc, 0x80,
+	0x5d, 0x2e, 0xfd, 0xc3, 0xad, 0xec, 0x6e, 0x93, 0x59, 0xb1, 0x95, 0xda, 0xbc, 0x52, 0x5e, 0x80,
+	0x2e, 0x38, 0x2b, 0x30, 0x1e, 0x34, 0x1b, 0xaa, 0x5f, 0x28, 0x3c, 0x0d, 0x9a, 0x8f, 0xb1, 0x67,
+	0x2e, 0xc1, 0x44, 0x87, 0xbd, 0x68, 0x88, 0xe4, 0x79, 0x46, 0x59, 0x59, 0xe3, 0x1d, 0xf6, 0xc2,
+	0x4b, 0x9e, 0x67, 0x2a, 0x63, 0x8e, 0x36, 0xbd, 0x3a, 0x21, 0x31, 0xa3, 0x25, 0x66, 0x86, 0xc0,
+	0xdb, 0x1a, 0x8a, 0xab, 0x8a, 0x50, 0x0b, 0x86, 0xed, 0xc6, 0x26, 0xbc, 0x29, 0x61, 0xad, 0x22,
+	0xce, 0xab, 0x30, 0x87, 0x15, 0xf1, 0x17, 0xdc, 0xcf, 0x8f, 0xb2, 0xf4, 0x79, 0xe3, 0x74, 0x87,
+	0xbd, 0xc0, 0xe6, 0xd0, 0x39, 0xd6, 0x7d, 0xb8, 0x54, 0xd1, 0x38, 0x32, 0xf8, 0x1b, 0x18, 0x65,
+	0xa3, 0xc7, 0xcf, 0x43, 0x3d, 0x9d, 0x19, 0xa9, 0x76, 0x80, 0xb4, 0x32, 0x10, 0x85, 0xfb, 0x10,
+	0x2e, 0xf7, 0x09, 0xda, 0xd9, 0x7f, 0xf2, 0x72, 0x86, 0x72, 0x37, 0xe1, 0x4a, 0xb5, 0x34, 0xd2,
+	0x0c, 0x57, 0x61, 0x26, 0x19, 0x49, 0x53, 0xff, 0xdd, 0x3f, 0xad, 0xc1, 0x8c, 0x4e, 0x73, 0x64,
+	0x42, 0x2b, 0xe7, 0xdc, 0x82, 0xb1, 0x56, 0xc8, 0xa3, 0xc0, 0xac, 0x76, 0x53, 0xd4, 0x80, 0x5d,
+	0x04, 0x7a, 0x84, 0x53, 0x16, 0x4d, 0x9e, 0x67, 0x0d, 0xd6, 0x6a, 0x71, 0x5f, 0x72, 0x1d, 0x89,
+	0x8d, 0x78, 0x53, 0x08, 0xdc, 0x22, 0x18, 0x9e, 0x9c, 0x84, 0x71, 0xc6, 0x85, 0x6c, 0x84, 0x01,
+	0xf5, 0xdd, 0x84, 0x06, 0x3c, 0x08, 0xca, 0x09, 0x92, 0x23, 0xe5, 0x04, 0x49, 0xe7, 0x56, 0x91,
+	0xbc, 0x39, 0xaa, 0xb4, 0x00, 0xd2, 0xc2, 0x4b, 0x9e, 0xe7, 0x89, 0x9c, 0x6e, 0xbb, 0x6c, 0xbf,
+	0xa2, 0x21, 0x5f, 0xf1, 0x40, 0x73, 0xbf, 0x07, 0x57, 0xaa, 0x2b, 0x22, 0xd3, 0xfe, 0xb7, 0x9e,
+	0x4e, 0xbf, 0x51, 0x79, 0x63, 0x6b, 0x9b, 0x39, 0x1f, 0x03, 0xff, 0xaf, 0x06, 0x57, 0xcb, 0xdd,
+	0xb6, 0x15, 0x45, 0x98, 0x36, 0x97, 0x7d, 0xf5, 0xf3, 0xa5, 0x6f, 0x1a, 0x8c, 0xf4, 0x4f, 0x03,
+	0xf7, 0x21, 0xac, 0x0f, 0xd2, 0xe7, 0x25, 0x86, 0xf8, 0x67, 0xbd, 0x8e, 0x60, 0x2b, 0x4d, 0x4f,
+	0x6f, 0x98, 0xad, 0xff, 0x50, 0xb9, 0x1b, 0xfa, 0x26, 0x9e, 0x12, 0xf6, 0x12, 0x5a, 0xed, 0x60,
+	0x36, 0x58, 0x1a, 0xb1, 0x30, 0x26, 0x6c, 0x85, 0x42, 0x93, 0x46, 0xa1, 0x65, 0x18, 0x6b, 0x25,
+	0xa2, 0xc3, 0xf2, 0x14, 0x30, 0x5d, 0x72, 0xb7, 0x61, 0xb1, 0x2c, 0xe4, 0xa5, 0x3c, 0x80, 0x8e,
+	0x09, 0xef, 0x0b, 0x66, 0x25, 0xe0, 0x9c, 0x11, 0x18, 0xa0, 0x46, 0x4c, 0x26, 0x1d, 0x3a, 0xef,
+	0x9f, 0xf0, 0xa8, 0x84, 0x47, 0x23, 0x25, 0x69, 0xe4, 0x8d, 0xff, 0x07, 0xdd, 0x59, 0x65, 0xdd,
+	0x8e, 0x5a, 0xbe, 0xac, 0xe6, 0x56, 0x04, 0x11, 0xa5, 0xed, 0xea, 0xd0, 0xd9, 0xdb, 0x55, 0x77,
+	0x0f, 0x96, 0x7a, 0xc4, 0x17, 0xc7, 0x69, 0x79, 0x3e, 0x6d, 0x4d, 0x4f, 0x70, 0x53, 0x2e, 0xcf,
+	0x7e, 0xbd, 0xbb, 0x28, 0xd2, 0xa3, 0x9f, 0xc2, 0xe2, 0x81, 0xe8, 0xc6, 0x3e, 0x93, 0xfc, 0x1c,
+	0x0a, 0xbf, 0xae, 0x12, 0x27, 0x5a, 0xa1, 0xe8, 0x60, 0x3a, 0xb7, 0x5a, 0xd2, 0xa8, 0xa7, 0x66,
+	0x09, 0x6e, 0x56, 0x3a, 0x3c, 0x06, 0xe8, 0x11, 0x4c, 0x26, 0x3a, 0x04, 0xc7, 0xe3, 0x38, 0x99,
+	0x4a, 0xf5, 0x5d, 0x05, 0x68, 0x89, 0xa4, 0xd3, 0xb0, 0x2b, 0x9d, 0x44, 0x88, 0xa2, 0xc2, 0x91,
+	0x2a, 0x13, 0x42, 0xea, 0x0a, 0xc7, 0x65, 0xa2, 0x51, 0xb8, 0xdf, 0x60, 0x99, 0xcf, 0x02, 0x4e,
+	0x31, 0xba, 0x29, 0xba, 0x3f, 0xad, 0xc1, 0x7c, 0xa9, 0x2a, 0xe5, 0x74, 0x71, 0x25, 0xe3, 0x29,
+	0x8f, 0x03, 0x1e, 0x4b, 0x4a, 0xae, 0xd2, 0xdd, 0x3e, 0x93, 0x83, 0x75, 0x7a, 0xd5, 0x3b, 0xb0,
+	0x5c, 0x10, 0x62, 0xf4, 0x1b, 0xb6, 0x63, 0xd5, 0x6c, 0x3a, 0x04, 0x5d, 0xcc, 0xb1, 0xbb, 0x1a,
+	0x89, 0x6d, 0x47, 0x75, 0x84, 0xaa, 0x33, 0x30, 0xea, 0x50, 0xd1, 0xdd, 0xc7, 0x6d, 0x98, 0xad,
+	0x8d, 0xee, 0xba, 0x8f, 0x7a, 0xc6, 0x70, 0x55, 0x78, 0xd2, 0xd7, 0x8a, 0x7c, 0x54, 0x07, 0x70,
+	0x99, 0x92, 0x01, 0x93, 0xe7, 0xd9, 0x83, 0xb8, 0xf7, 0x40, 0xe4, 0x2b, 0x1a, 0x77, 0xdf, 0x81,
+	0x2b, 0xd5, 0xb5, 0xbc, 0xc4, 0x3c, 0xfc, 0xf5, 0x9a, 0x51, 0xd9, 0x88, 0xd1, 0xa1, 0xc3, 0x4b,
+	0x9f, 0xe1, 0x9c, 0x92, 0xf5, 0xeb, 0xbc, 0xa5, 0x36, 0xa3, 0x22, 0xe3, 0x52, 0xf5, 0x46, 0x7d,
+	0x73, 0x61, 0xc3, 0x7a, 0x4f, 0xb1, 0xa3, 0x51, 0x9e, 0xa1, 0x71, 0x23, 0xd3, 0xce, 0x5e, 0xd5,
+	0xf2, 0x6d, 0xaa, 0xa3, 0xd9, 0xed, 0x4c, 0x26, 0x52, 0xf2, 0xaa, 0x2d, 0x59, 0xf3, 0x59, 0x49,
+	0x4d, 0xde, 0x7c, 0xb3, 0x17, 0xe4, 0x3e, 0x02, 0x67, 0x27, 0x4a, 0x62, 0x5e, 0xce, 0x5b, 0x1d,
+	0x94, 0x12, 0x78, 0x0d, 0xea, 0x74, 0x06, 0x60, 0xdd, 0x7c, 0x80, 0x06, 0xe1, 0x7e, 0xc2, 0xcd,
+	0x60, 0xa1, 0x24, 0xce, 0x3a, 0x69, 0x2f, 0xef, 0xf0, 0x0b, 0xf3, 0xe4, 0xc3, 0x63, 0xc8, 0x1e,
+	0x1e, 0x45, 0x6f, 0x0e, 0x9f, 0xd9, 0x9b, 0x3f, 0xab, 0xc1, 0x38, 0x5d, 0x9c, 0xe3, 0x01, 0x25,
+	0xe5, 0x63, 0x0f, 0x7b, 0x43, 0x61, 0x50, 0xf9, 0x02, 0xc0, 0x64, 0xcc, 0x0f, 0xf7, 0x65, 0xcc,
+	0x8f, 0xe4, 0x19, 0xf3, 0xea, 0x39, 0x49, 0xa7, 0xc3, 0xe2, 0x80, 0x2e, 0x46, 0x4d, 0x11, 0xb9,
+	0x31, 0x5c, 0xa4, 0x58, 0x51, 0xfd, 0xc7, 0x36, 0xe8, 0x3b, 0xcb, 0x71, 0xdd, 0x06, 0x55, 0x40,
+	0xca, 0x30, 0x6e, 0x25, 0xab, 0x13, 0xba, 0x1e, 0xfc, 0x6f, 0x72, 0xe7, 0xb4, 0xb6, 0x0f, 0xad,
+	0x9b, 0x0a, 0x0f, 0x96, 0x7b, 0x11, 0x64, 0xbc, 0x97, 0x4e, 0x1d, 0x70, 0xff, 0x62, 0x18, 0xa6,
+	0x69, 0x7c, 0xd1, 0xe5, 0xd6, 0x37, 0x60, 0x11, 0xc7, 0x19, 0xf3, 0xd5, 0xce, 0x99, 0xcb, 0x86,
+	0x3a, 0x4f, 0x14, 0xd4, 0x29, 0x4e, 0x8e, 0xa3, 0x73, 0x45, 0x2e, 0xb4, 0xbb, 0x8d, 0x22, 0x7d,
+	0xf5, 0x48, 0xd4, 0xb9, 0xbb, 0x25, 0x38, 0x91, 0xfa, 0x30, 0x9f, 0xbf, 0x68, 0xa1, 0xd1, 0x9c,
+	0x51, 0x06, 0xf3, 0xbb, 0x55, 0x11, 0x92, 0xad, 0xd9, 0xc6, 0x5d, 0xe2, 0x24, 0xa8, 0x49, 0xdb,
+	0x0c, 0x7a, 0xc0, 0x4e, 0x08, 0x0b, 0x06, 0xd6, 0xc8, 0x15, 0x30, 0x69, 0x0b, 0xef, 0x9f, 0xbf,
+	0x9a, 0x9c, 0x55, 0x57, 0xe4, 0x04, 0x7d, 0x08, 0xcc, 0x10, 0xad, 0xd4, 0xea, 0x22, 0x17, 0x1b,
+	0x6b, 0xf7, 0x60, 0x65, 0x40, 0x9d, 0x17, 0x11, 0x43, 0x97, 0xe9, 0xa5, 0xb6, 0x98, 0x91, 0x73,
+	0x00, 0xab, 0xfd, 0xa8, 0x7c, 0xec, 0x94, 0xef, 0xf4, 0xae, 0x9f, 0x65, 0xa0, 0xfc, 0x3e, 0xef,
+	0x16, 0x38, 0x9f, 0x85, 0x18, 0x0a, 0xea, 0x51, 0x55, 0x9c, 0xff, 0xdb, 0xd3, 0x0b, 0x43, 0x90,
+	0x12, 0x15, 0xad, 0xaf, 0xef, 0xc3, 0x12, 0x66, 0x96, 0xdc, 0xe7, 0x31, 0x17, 0x2c, 0x7a, 0x58,
+	0x38, 0xd6, 0x9e, 0x7b, 0xec, 0x5a, 0xdf, 0x3d, 0xf6, 0x06, 0x2c, 0xf7, 0x72, 0x16, 0xf7, 0x02,
+	0x1c, 0xcd, 0x66, 0x96, 0x11, 0x55, 0x70, 0xff, 0xbc, 0x06, 0x93, 0x5f, 0xec, 0xed, 0xef, 0xb3,
+	0x4e, 0x1a, 0x71, 0x4c, 0x59, 0xca, 0xd3, 0x5c, 0x0b, 0xf9, 0xf5, 0x1c, 0xf6, 0x58, 0xb9, 0xb0,
+	0x30, 0x96, 0x5c, 0x1c, 0xb3, 0xc8, 0xba, 0x49, 0x37, 0xa0, 0xc7, 0x19, 0x2e, 0xf3, 0xea, 0xde,
+	0xf8, 0x59, 0x9a, 0xd1, 0xf9, 0xe6, 0x38, 0x96, 0xbf, 0x48, 0x55, 0x0e, 0xd9, 0x73, 0x11, 0x4a,
+	0xae, 0x70, 0x3a, 0x61, 0x6a, 0x42, 0x01, 0x08, 0xa9, 0x53, 0x5d, 0x10, 0x39, 0xaa, 0x91, 0x0a,
+	0x80, 0xc8, 0x55, 0x18, 0x0f, 0x44, 0xa2, 0x6e, 0x2f, 0xb5, 0xdf, 0x30, 0x45, 0xf7, 0x0e, 0xcc,
+	0x69, 0x67, 0xf9, 0xc5, 0xde, 0xbe, 0x65, 0x25, 0x5b, 0xc7, 0x5a, 0xaf, 0x8e, 0xee, 0x03, 0x98,
+	0xb7, 0x98, 0xf2, 0xcc, 0xaa, 0xb1, 0x4c, 0x99, 0x81, 0xfa, 0xfa, 0x4a, 0xd5, 0x05, 0xab, 0x31,
+	0x95, 0x47, 0xb4, 0x2a, 0x43, 0xa0, 0xc8, 0x18, 0x32, 0x63, 0x6a, 0x17, 0x16, 0x4a, 0x50, 0xaa,
+	0xe2, 0x36, 0xe6, 0xe5, 0xe7, 0x4f, 0x27, 0x4e, 0xc9, 0x42, 0x22, 0x32, 0xf7, 0x1a, 0x5c, 0xb5,
+	0xe4, 0x6c, 0x45, 0x11, 0x1e, 0x6f, 0xc4, 0x3c, 0xca, 0x2b, 0xfa, 0xab, 0x1a, 0xac, 0x0f, 0xa2,
+	0xa0, 0x4a, 0xbf, 0x0f, 0x13, 0x5a, 0x5a, 0xee, 0xfe, 0xbe, 0x55, 0x75, 0x7a, 0x72, 0xaa, 0x10,
+	0xd2, 0xcb, 0xa4, 0x2a, 0xe5, 0x02, 0xd7, 0x0e, 0x60, 0xba, 0x84, 0xaa, 0x98, 0x94, 0x6f, 0xd9,
+	0x93, 0xf2, 0x94, 0x36, 0x5b, 0xb3, 0x35, 0xc4, 0xa0, 0x2f, 0x27, 0xda, 0x4f, 0xba, 0x78, 0xa4,
+	0x7b, 0x0d, 0xea, 0x1d, 0x96, 0xa1, 0xe3, 0xb5, 0xde, 0x6b, 0x81, 0x06, 0x7d, 0x9a, 0xe8, 0x6e,
+	0x27, 0x02, 0xbc, 0x46, 0x53, 0xd5, 0x8d, 0x1a, 0x82, 0xbd, 0x44, 0xc8, 0xaa, 0x67, 0x5c, 0xee,
+	0x55, 0x95, 0x4a, 0xde, 0x57, 0x5b, 0x71, 0x83, 0x71, 0xa5, 0x1a, 0x5d, 0x44, 0x7e, 0x99, 0x82,
+	0x9c, 0x1a, 0xf9, 0xf5, 0x72, 0x13, 0x8f, 0xfb, 0x2a, 0x7c, 0xed, 0x09, 0x53, 0xf9, 0x05, 0xdc,
+	0x22, 0xda, 0x11, 0x1c, 0x23, 0xd2, 0x90, 0x15, 0xdd, 0xfc, 0x09, 0xbc, 0x72, 0x16, 0x61, 0x31,
+	0xcd, 0x8f, 0x91, 0x92, 0x6e, 0x53, 0x74, 0x01, 0x33, 0x60, 0xd5, 0xce, 0x29, 0xe4, 0xe2, 0x69,
+	0x22, 0x8e, 0xb8, 0xd0, 0x79, 0xa5, 0x38, 0x21, 0x55, 0xb1, 0x91, 0x7b, 0xa5, 0x09, 0x0d, 0x78,
+	0x10, 0xe0, 0xf6, 0x17, 0xd7, 0xab, 0xd0, 0xa7, 0x47, 0x05, 0xe4, 0x54, 0xa7, 0x08, 0xa8, 0x33,
+	0xad, 0xd0, 0x63, 0xa8, 0x54, 0x53, 0xa2, 0xd1, 0xa6, 0xad, 0x6b, 0x98, 0x26, 0xd9, 0x84, 0xa5,
+	0x88, 0x65, 0xb8, 0x54, 0xf2, 0xb8, 0x14, 0x73, 0xe9, 0x68, 0x61, 0x01, 0x91, 0xfb, 0x9c, 0xc7,
+	0x76, 0x58, 0xf5, 0xb3, 0x1a, 0xcc, 0x93, 0xbe, 0x5f, 0x74, 0x79, 0x97, 0x6b, 0x75, 0x5f, 0x81,
+	0x59, 0xc1, 0x23, 0x76, 0xa2, 0xd2, 0xf1, 0xf4, 0xce, 0x45, 0x2b, 0x3d, 0xad, 0xc0, 0x0f, 0x93,
+	0xf6, 0x7e, 0xca, 0xf4, 0xe1, 0xbf, 0x9f, 0x24, 0x22, 0x08, 0x63, 0x26, 0x13, 0x51, 0xd2, 0x7e,
+	0xce, 0x42, 0x68, 0xf5, 0xbe, 0x05, 0xe3, 0xba, 0xc9, 0x66, 0xad, 0xad, 0xba, 0xaf, 0xe8, 0xb7,
+	0x9d, 0x67, 0xb8, 0x68, 0x04, 0xf5, 0x69, 0x5b, 0x24, 0xf5, 0x5f, 0xa9, 0x46, 0x17, 0x27, 0x9b,
+	0x76, 0x1a, 0xec, 0xad, 0xc1, 0xb5, 0x5b, 0xcc, 0x9a, 0x05, 0xa3, 0xa1, 0x47, 0x34, 0xbc, 0x75,
+	0x34, 0x68, 0x2a, 0x7d, 0x07, 0x96, 0x7b, 0x11, 0x67, 0x87, 0x92, 0x94, 0x02, 0x76, 0x5f, 0x86,
+	0xc1, 0x5e, 0x57, 0xb4, 0x79, 0x7e, 0xed, 0x7f, 0x07, 0x96, 0x7a, 0xe0, 0xe7, 0x10, 0xa6, 0x23,
+	0x35, 0x1d, 0x44, 0x97, 0x0c, 0xd2, 0x81, 0xe5, 0x5e, 0x44, 0x9e, 0xba, 0xb6, 0x62, 0x8d, 0x8f,
+	0x0c, 0x9f, 0x1b, 0x36, 0x32, 0xee, 0x27, 0xb1, 0x1e, 0x9c, 0x35, 0xcf, 0x4e, 0x09, 0xca, 0xf6,
+	0x30, 0xcc, 0x42, 0xa4, 0x1a, 0xc6, 0x61, 0x1c, 0x24, 0xcf, 0x8b, 0x15, 0x69, 0x42, 0x03, 0x1e,
+	0x67, 0x6e, 0x06, 0x4b, 0xd6, 0x94, 0x51, 0x09, 0x01, 0xf9, 0xe0, 0x0f, 0x93, 0x06, 0xe5, 0x41,
+	0xd2, 0xe0, 0x0f, 0x13, 0x45, 0xa0, 0xf2, 0xa6, 0xb3, 0x67, 0x91, 0xc1, 0xd2, 0xd5, 0x63, 0xf6,
+	0x2c, 0x22, 0xf4, 0x3a, 0x80, 0xe0, 0xf4, 0x86, 0x20, 0x7f, 0x80, 0x55, 0x40, 0xdc, 0xbb, 0x70,
+	0xad, 0xec, 0x36, 0x8a, 0x7a, 0x2f, 0xf0, 0x96, 0xa0, 0x09, 0xd7, 0x07, 0x4b, 0xc9, 0xf3, 0x82,
+	0x4a, 0xc3, 0xe7, 0xb5, 0xd3, 0xfd, 0x8f, 0x25, 0x80, 0x86, 0x90, 0x83, 0xeb, 0x67, 0x92, 0x2a,
+	0xf7, 0x6f, 0x7a, 0x68, 0x01, 0xe6, 0x2d, 0x18, 0xc5, 0x24, 0xdf, 0x85, 0x95, 0x1c, 0xf8, 0x28,
+	0x8c, 0xc3, 0x4e, 0xb7, 0x63, 0x27, 0xf4, 0x0c, 0xda, 0x9e, 0xdc, 0x00, 0x75, 0x19, 0x69, 0x2e,
+	0xcb, 0xc9, 0x94, 0x75, 0x84, 0xd1, 0x35, 0xb9, 0xca, 0x15, 0xea, 0x93, 0x7c, 0x8e, 0x11, 0xf6,
+	0x43, 0xb8, 0xda, 0xcb, 0x57, 0xde, 0x86, 0xfd, 0x82, 0x7a, 0x3d, 0x81, 0xf5, 0x41, 0xf2, 0xcf,
+	0xb1, 0x2f, 0xc3, 0x84, 0x2b, 0x99, 0x50, 0xc2, 0x95, 0x3a, 0x44, 0xa0, 0xa2, 0xfb, 0x11, 0xac,
+	0x6f, 0x27, 0x49, 0x66, 0x77, 0xec, 0x0e, 0x5e, 0x79, 0x74, 0xcf, 0xf5, 0x0a, 0xf5, 0xa7, 0x43,
+	0x70, 0x6d, 0x20, 0x3b, 0xe9, 0xb5, 0x09, 0x4b, 0x7a, 0xde, 0x64, 0x8d, 0x26, 0x3f, 0x0c, 0xe3,
+	0xa0, 0xa1, 0x57, 0x41, 0x12, 0xb6, 0x40, 0xc8, 0x6d, 0x85, 0xd3, 0x8e, 0xc2, 0xf9, 0x01, 0x4c,
+	0x64, 0x5c, 0x62, 0xf6, 0x89, 0x79, 0xdb, 0xfb, 0xed, 0x8a, 0xb1, 0x74, 0x46, 0xcd, 0x1b, 0xfb,
+	0x24, 0xc2, 0xc4, 0x09, 0x54, 0xc4, 0x16, 0x09, 0x7e, 0xcc, 0x85, 0xcc, 0xcf, 0x54, 0xf2, 0x32,
+	0xbe, 0x95, 0x28, 0xb1, 0x5d, 0xe8, 0xad, 0x84, 0x1a, 0xab, 0x4c, 0xc8, 0xd2, 0x00, 0xc6, 0xa0,
+	0xcc, 0x02, 0xd2, 0x08, 0x66, 0x70, 0xfd, 0x5e, 0x8c, 0x2d, 0xda, 0x63, 0x82, 0x45, 0x11, 0x8f,
+	0xac, 0x76, 0x58, 0x97, 0x37, 0x66, 0x35, 0xa0, 0x14, 0x26, 0x2a, 0xaa, 0x07, 0x4d, 0xc4, 0xa7,
+	0x33, 0xcd, 0x68, 0x39, 0x34, 0x40, 0xcc, 0x29, 0x71, 0x6f, 0xc2, 0x8d, 0x53, 0xaa, 0x20, 0x3d,
+	0xbe, 0x84, 0x9b, 0x5e, 0x22, 0xcf, 0x5a, 0xf3, 0xf3, 0x68, 0xa5, 0x66, 0x6d, 0xbd, 0x71, 0xc0,
+	0xd1, 0x1b, 0xfb, 0xfc, 0x9c, 0x84, 0xca, 0xee, 0x2b, 0x70, 0xeb, 0x74, 0xb1, 0x54, 0xfd, 0xa3,
+	0x92, 0x43, 0xdc, 0xdf, 0x7f, 0xf8, 0x79, 0x2a, 0xd5, 0x93, 0xad, 0x19, 0x18, 0xf2, 0xcd, 0x9d,
+	0xd5, 0x90, 0xcf, 0x50, 0x01, 0x9f, 0x0b, 0x73, 0x8e, 0xab, 0xfe, 0x9b, 0xae, 0x19, 0xce, 0xbb,
+	0xc6, 0x0d, 0x60, 0x5d, 0x6f, 0x7d, 0xba, 0x82, 0x97, 0xe5, 0x9a, 0x86, 0x6c, 0xc3, 0x78, 0x92,
+	0x4a, 0xeb, 0xe1, 0xda, 0x19, 0x4e, 0xaa, 0x50, 0xc9, 0x33, 0x8c, 0xee, 0x0d, 0xb8, 0x36, 0xb0,
+	0x96, 0x22, 0x57, 0xca, 0xe3, 0x29, 0x0b, 0x85, 0x47, 0xc1, 0x40, 0x11, 0x3c, 0x2d, 0xf7, 0x22,
+	0x2e, 0x94, 0xe5, 0xf2, 0x3f, 0xe1, 0x86, 0x4e, 0x21, 0xba, 0xf7, 0x42, 0x72, 0x11, 0xb3, 0x28,
+	0x3a, 0xf1, 0x54, 0xea, 0x55, 0x2c, 0xf3, 0x35, 0x52, 0xbf, 0xc9, 0xd5, 0x68, 0x13, 0x4c, 0x4d,
+	0x7a, 0x60, 0x40, 0x0f, 0xd4, 0xc3, 0xf6, 0x63, 0x0a, 0xe1, 0xc8, 0x21, 0xe4, 0x65, 0xf7, 0x16,
+	0xb8, 0xa7, 0xd5, 0x40, 0x0d, 0xbc, 0x0e, 0xeb, 0xbd, 0x54, 0xf7, 0x22, 0xee, 0x17, 0x4a, 0xa0,
+	0x95, 0x06, 0x52, 0x90, 0x10, 0xfd, 0xa0, 0x4b, 0x4d, 0x8c, 0x7c, 0x45, 0x7e, 0x1d, 0xe6, 0x2d,
+	0x58, 0x11, 0x48, 0xb2, 0x20, 0x10, 0xf9, 0x3b, 0x0f, 0x55, 0x70, 0x9f, 0xc0, 0x82, 0x65, 0xfe,
+	0xc7, 0x3c, 0x6c, 0x1f, 0x36, 0x13, 0x51, 0xf9, 0x11, 0x85, 0x37, 0x61, 0x94, 0x45, 0x21, 0x33,
+	0x2f, 0x2e, 0x96, 0x7a, 0x13, 0xb2, 0xb6, 0x10, 0xe9, 0x69, 0x1a, 0x7c, 0x9e, 0x38, 0x67, 0x09,
+	0xbe, 0x2f, 0x58, 0x7a, 0xe8, 0x7c, 0x02, 0x63, 0x96, 0xdf, 0xaa, 0x6f, 0xbe, 0x72, 0xfa, 0xb8,
+	0x31, 0xda, 0x78, 0xc4, 0x85, 0xfc, 0xea, 0x35, 0x87, 0x71, 0x68, 0xe7, 0xe6, 0xd7, 0x5c, 0x98,
+	0x20, 0x56, 0x5e, 0x7f, 0x95, 0x5a, 0xc6, 0x6a, 0xdf, 0x85, 0xcb, 0x95, 0xd8, 0xfc, 0x92, 0x6b,
+	0xb4, 0x8d, 0x80, 0x53, 0x32, 0x20, 0xfa, 0x78, 0x35, 0x87, 0xfb, 0xbf, 0x61, 0xf9, 0x29, 0x0b,
+	0xa5, 0xf5, 0xa1, 0x04, 0x33, 0xca, 0xb6, 0x60, 0xaa, 0x19, 0xa5, 0xe5, 0x74, 0x9f, 0xea, 0x47,
+	0x40, 0x36, 0x73, 0xbd, 0x59, 0x14, 0xce, 0xb3, 0xf0, 0x5d, 0x82, 0x95, 0xbe, 0xfa, 0x69, 0xf8,
+	0xcc, 0xc1, 0x0c, 0xae, 0x89, 0xdb, 0x91, 0x59, 0xab, 0xdc, 0x27, 0x30, 0x9b, 0x43, 0xa8, 0xe9,
+	0x3b, 0x30, 0x6d, 0x6b, 0x69, 0xb6, 0x9d, 0x67, 0xa9, 0x39, 0x65, 0xa9, 0x99, 0xb9, 0xf3, 0x28,
+	0x97, 0x09, 0x69, 0x55, 0xa5, 0x62, 0x15, 0x03, 0x22, 0x85, 0xfe, 0x17, 0x38, 0x5e, 0x37, 0xde,
+	0x8e, 0xd2, 0x2f, 0x63, 0x59, 0xbc, 0x6a, 0xfa, 0x2a, 0x34, 0x38, 0x8f, 0xa5, 0xde, 0x86, 0x85,
+	0x52, 0xed, 0xe7, 0x88, 0x5a, 0x7e, 0xa3, 0x06, 0x53, 0x3a, 0xf8, 0xdd, 0x0d, 0x23, 0x1c, 0xa5,
+	0x95, 0xdf, 0xc0, 0xe8, 0xb9, 0x9a, 0xc9, 0xcb, 0xea, 0xa8, 0xf4, 0x90, 0x89, 0x80, 0x5c, 0xb0,
+	0x2e, 0x94, 0x0f, 0xdc, 0x47, 0xce, 0x71, 0xe0, 0x5e, 0x9c, 0x50, 0x8f, 0x96, 0x9e, 0x56, 0xeb,
+	0x73, 0x32, 0x5b, 0xbf, 0xdc, 0x4b, 0x7c, 0x09, 0xab, 0xfd, 0xa8, 0x7c, 0xb0, 0x8f, 0xb7, 0x34,
+	0x88, 0x2c, 0x5d, 0xf5, 0x9a, 0xcf, 0x66, 0xf5, 0x0c, 0x3d, 0xd6, 0xe8, 0xf1, 0xac, 0x34, 0x91,
+	0x4c, 0x8d, 0x6b, 0xb0, 0xda, 0x8f, 0xa2, 0x7e, 0x6f, 0xc3, 0xfc, 0x83, 0x38, 0x94, 0x3a, 0x78,
+	0x31, 0xdd, 0xfe, 0x26, 0xcc, 0xf3, 0x17, 0xa9, 0x72, 0x78, 0xc5, 0xe5, 0x96, 0xee, 0x80, 0x39,
+	0x83, 0x30, 0xb7, 0x5b, 0xfa, 0xe9, 0x3d, 0x11, 0x6b, 0x93, 0x6a, 0x5b, 0x4f, 0x1b, 0xe8, 0x3e,
+	0x02, 0xdd, 0x6f, 0x80, 0x63, 0x57, 0x74, 0x8e, 0x1e, 0xfe, 0x83, 0x21, 0x58, 0xdf, 0x4b, 0xd2,
+	0x6e, 0xa4, 0xd7, 0x62, 0xe5, 0xc6, 0xbf, 0x93, 0x74, 0xd1, 0x1f, 0x1b, 0x45, 0x5f, 0x81, 0x59,
+	0x95, 0x33, 0xa1, 0x5f, 0xd5, 0x07, 0xc5, 0x29, 0xd5, 0x34, 0x82, 0xf5, 0xbb, 0xfa, 0x40, 0x9f,
+	0xb6, 0xd1, 0x03, 0x08, 0xeb, 0xea, 0x1a, 0x34, 0x48, 0x5d, 0x5f, 0xbf, 0x0f, 0x53, 0x74, 0xe6,
+	0xa1, 0x7d, 0xed, 0xf0, 0x69, 0xbe, 0x96, 0x8e, 0x47, 0x54, 0xc1, 0x79, 0x1b, 0xec, 0x37, 0x90,
+	0x85, 0x4b, 0xa1, 0x5d, 0xb9, 0x85, 0xcb, 0x5d, 0x47, 0xa5, 0x79, 0x47, 0xcf, 0x6d, 0xde, 0xb1,
+	0x2a, 0xf3, 0xde, 0x80, 0x6b, 0x03, 0x6d, 0x45, 0x5d, 0xfd, 0xf3, 0x1a, 0x2c, 0xf6, 0xe0, 0x74,
+	0x9c, 0xf8, 0x5f, 0xd2, 0x8a, 0xb8, 0xd8, 0xdf, 0xe7, 0xf2, 0x21, 0xcb, 0x64, 0x55, 0xa3, 0xcc,
+	0xd8, 0x0f, 0xe0, 0xe6, 0xa9, 0x54, 0x34, 0x0e, 0x3f, 0xb6, 0x4f, 0x75, 0xeb, 0x9b, 0xaf, 0x56,
+	0xaf, 0x32, 0xfd, 0xfc, 0x9a, 0xcb, 0xfd, 0xcd, 0x1a, 0xcc, 0xe1, 0xe8, 0xb6, 0xa3, 0x67, 0xe7,
+	0x2d, 0x18, 0xd3, 0x1c, 0xab, 0xb5, 0xd3, 0xec, 0x40, 0x44, 0x03, 0x4d, 0x30, 0x34, 0x78, 0x20,
+	0x55, 0x74, 0xdc, 0x70, 0x45, 0xc7, 0x61, 0x70, 0x6f, 0x69, 0x57, 0xbc, 0x68, 0xb8, 0xcb, 0x3b,
+	0x89, 0xe4, 0xa5, 0xb9, 0x8f, 0x4f, 0x4d, 0xcb, 0xe0, 0x73, 0xcc, 0xd4, 0x8f, 0xe1, 0xda, 0x9e,
+	0x48, 0x90, 0x49, 0x55, 0xf1, 0xf4, 0x90, 0xc7, 0x3b, 0xac, 0xdb, 0x3e, 0x94, 0x5f, 0xa6, 0xe7,
+	0xd8, 0x43, 0xba, 0x9f, 0xc0, 0xf5, 0xc1, 0xec, 0xe7, 0xa8, 0xfe, 0x12, 0xac, 0x68, 0x46, 0x96,
+	0x91, 0x9c, 0xc0, 0x72, 0x7d, 0xfd, 0x28, 0x32, 0xc0, 0x3f, 0xe3, 0xe7, 0xd0, 0x78, 0x8f, 0xeb,
+	0xbb, 0x60, 0xa7, 0x55, 0xf4, 0xc0, 0x50, 0xd5, 0xd4, 0x79, 0x03, 0xe6, 0x55, 0x42, 0x6d, 0x43,
+	0x25, 0xb1, 0x37, 0x54, 0x60, 0x44, 0x1b, 0xb8, 0x59, 0x85, 0x28, 0xf6, 0x59, 0xd5, 0xee, 0x61,
+	0xe4, 0xdc, 0xee, 0x61, 0xb4, 0xca, 0x3d, 0xe0, 0xf6, 0x8e, 0xf7, 0x38, 0x5f, 0xf7, 0xb7, 0x87,
+	0xe0, 0x72, 0xd5, 0x6e, 0xe0, 0x25, 0x6d, 0x71, 0x13, 0xa6, 0x59, 0x57, 0x26, 0xe5, 0x91, 0x3b,
+	0xe1, 0x4d, 0x21, 0x30, 0x1f, 0xb2, 0x0e, 0x8c, 0xe0, 0x1b, 0x7a, 0x73, 0x76, 0x8c, 0xff, 0x4b,
+	0x7d, 0x4b, 0x29, 0x59, 0xa6, 0x5c, 0x6d, 0xb8, 0xd1, 0x0b, 0x18, 0x6e, 0xec, 0xdc, 0x86, 0x1b,
+	0xaf, 0x32, 0x1c, 0xa6, 0xe6, 0x57, 0x9a, 0x88, 0x6c, 0xf8, 0xa0, 0x18, 0x60, 0xf4, 0x42, 0x81,
+	0x07, 0x2f, 0x67, 0x3f, 0xf5, 0x66, 0xab, 0x5f, 0x14, 0xd5, 0x73, 0x0b, 0xdc, 0xfd, 0xf2, 0xa3,
+	0x84, 0xad, 0x38, 0xc0, 0xdd, 0x46, 0xe9, 0xbe, 0xe4, 0x09, 0xdc, 0x3c, 0x95, 0xea, 0x65, 0xef,
+	0x4f, 0x96, 0x60, 0xc1, 0x9e, 0xa1, 0x96, 0xaf, 0x28, 0x83, 0xcf, 0x31, 0x59, 0xf7, 0xe1, 0xaa,
+	0x7a, 0x7a, 0xa9, 0x1b, 0x7d, 0x2f, 0x0a, 0xdb, 0x61, 0x33, 0x8c, 0x8a, 0xc7, 0x0e, 0xc8, 0xcc,
+	0x15, 0x34, 0x7f, 0xca, 0x90, 0x97, 0x07, 0x3e, 0x26, 0xba, 0x0e, 0xeb, 0x83, 0x84, 0x92, 0xfd,
+	0xae, 0xd1, 0x13, 0x0a, 0x43, 0xb3, 0xc3, 0xe2, 0x80, 0xee, 0x01, 0xcc, 0xf5, 0xe5, 0xfa, 0x20,
+	0x82, 0xa2, 0x55, 0x17, 0x56, 0x6c, 0x93, 0xde, 0xc6, 0x74, 0xc2, 0xfd, 0x93, 0xd8, 0xdf, 0xf2,
+	0x8f, 0xd4, 0x91, 0xa4, 0x95, 0x92, 0xa2, 0x53, 0x91, 0xe8, 0x9b, 0x0b, 0xaa, 0x80, 0x07, 0xe1,
+	0x95, 0x3c, 0xd4, 0x92, 0x7f, 0xac, 0xc1, 0xdc, 0xdd, 0xae, 0x60, 0xba, 0x81, 0x7b, 0x49, 0x14,
+	0xfa, 0x27, 0x95, 0xc9, 0xc5, 0xf8, 0x48, 0x94, 0x77, 0xc2, 0x46, 0x76, 0x12, 0xfb, 0xe6, 0xe0,
+	0x8a, 0x1e, 0x6b, 0x64, 0x24, 0x9c, 0xce, 0xac, 0xf0, 0xa5, 0x6a, 0x4e, 0x69, 0xfb, 0xa6, 0x69,
+	0x43, 0xa8, 0x27, 0xd8, 0x1d, 0x58, 0x56, 0x2f, 0x60, 0x1a, 0x7d, 0x72, 0x75, 0x4a, 0xdf, 0x82,
+	0xc2, 0xee, 0x97, 0x85, 0xbf, 0x0d, 0x4b, 0xbd, 0x4c, 0xf6, 0x2c, 0x76, 0x4a, 0x3c, 0xaa, 0x1e,
+	0xda, 0x30, 0xf6, 0x36, 0xb2, 0xb8, 0x09, 0xb8, 0x5c, 0x89, 0x2d, 0xde, 0xc0, 0xa7, 0x0a, 0x72,
+	0xda, 0x1b, 0xf8, 0x5e, 0x66, 0x62, 0xa1, 0x2f, 0x70, 0x6c, 0x33, 0xff, 0xa8, 0x9b, 0x3e, 0x0c,
+	0x3b, 0x61, 0x71, 0xdc, 0x9e, 0xc1, 0x4a, 0x1f, 0x26, 0x9f, 0x4e, 0x0b, 0x01, 0x6f, 0xb1, 0x6e,
+	0x84, 0x87, 0xd0, 0xb1, 0xdf, 0x15, 0x82, 0xc7, 0x54, 0xfd, 0xb0, 0xe7, 0x10, 0x6a, 0xa7, 0xc0,
+	0x60, 0xde, 0x15, 0x26, 0x1b, 0xda, 0xc4, 0xf4, 0x7a, 0xb7, 0xc3, 0x5e, 0x58, 0x84, 0xf4, 0xa6,
+	0x54, 0x57, 0xda, 0x7b, 0x37, 0xa1, 0xdf, 0x94, 0xf6, 0xe2, 0xce, 0x31, 0x03, 0xdf, 0x86, 0x69,
+	0xcd, 0x65, 0x86, 0xe1, 0x75, 0xa8, 0xf7, 0xeb, 0x6d, 0x83, 0xdc, 0x77, 0x61, 0xc6, 0xb0, 0x5c,
+	0xe8, 0xc8, 0xa7, 0x05, 0xab, 0x0f, 0x62, 0x5f, 0xa8, 0x04, 0x42, 0x16, 0x95, 0x6b, 0xc5, 0x87,
+	0x3c, 0x2c, 0xe3, 0x8d, 0xa6, 0x82, 0x36, 0xac, 0xe1, 0x3b, 0x83, 0x70, 0x4d, 0xac, 0xc2, 0xca,
+	0x1e, 0xfd, 0x86, 0xfa, 0xf5, 0xdb, 0x82, 0x4b, 0x15, 0xf5, 0x5c, 0x48, 0x55, 0xbd, 0x49, 0x92,
+	0x89, 0xe0, 0xbb, 0x22, 0xe9, 0x94, 0x54, 0x45, 0xf1, 0x15, 0xb8, 0x0b, 0x89, 0x6f, 0xe6, 0x22,
+	0x0e, 0x92, 0xfc, 0xa3, 0x57, 0xd6, 0xa1, 0x57, 0xbf, 0x15, 0xa0, 0x59, 0x58, 0xe0, 0x16, 0xcc,
+	0x48, 0x26, 0xda, 0x5c, 0xe6, 0x29, 0xe2, 0xf4, 0x34, 0x4a, 0x43, 0x29, 0x43, 0x7c, 0x1b, 0xd6,
+	0xaa, 0xea, 0xb8, 0x90, 0x9e, 0x1f, 0xa9, 0x37, 0x85, 0xf8, 0x36, 0x89, 0x0b, 0xc1, 0x83, 0x72,
+	0x97, 0x9d, 0xa5, 0x27, 0x3d, 0x05, 0xec, 0xe3, 0x26, 0xcf, 0xa5, 0x3f, 0x53, 0x55, 0x2d, 0xdb,
+	0xfd, 0x18, 0xd6, 0xaa, 0x90, 0xc5, 0xdb, 0xb1, 0xd3, 0x6b, 0xfe, 0xad, 0x1a, 0xd4, 0x77, 0x92,
+	0x4e, 0xca, 0xa4, 0xf2, 0xe2, 0x95, 0x0e, 0xf1, 0x06, 0x4c, 0x91, 0x10, 0x3b, 0xbb, 0x85, 0x04,
+	0x3f, 0x41, 0x10, 0x92, 0xd0, 0x2b, 0xe8, 0xe2, 0x13, 0x16, 0x78, 0x0f, 0xab, 0x60, 0x9a, 0x64,
+	0x1d, 0xc0, 0x57, 0x15, 0xa9, 0x85, 0x40, 0x3b, 0x3e, 0x0b, 0x32, 0xe8, 0x53, 0x16, 0x6e, 0x0b,
+	0xa6, 0xb4, 0x82, 0xfa, 0x79, 0x6a, 0x8f, 0x9c, 0x5a, 0x9f, 0x9c, 0x77, 0x61, 0x4c, 0xe7, 0xad,
+	0xae, 0x0e, 0x0d, 0x3c, 0x74, 0xb1, 0x5a, 0xec, 0x11, 0xb5, 0xbb, 0x03, 0xd7, 0x35, 0x40, 0x0f,
+	0x85, 0x1d, 0x92, 0x58, 0x5a, 0x63, 0xcf, 0x34, 0xe7, 0x0f, 0xe0, 0xc6, 0x29, 0x42, 0xa8, 0x53,
+	0xde, 0xc3, 0x96, 0x62, 0x5b, 0x4e, 0xf9, 0xf4, 0x90, 0xdd, 0x64, 0x8f, 0xc8, 0xf1, 0x4b, 0x23,
+	0xa0, 0x3b, 0xf8, 0x41, 0xdc, 0x4a, 0x2a, 0xfb, 0x0a, 0x2f, 0x0e, 0x8b, 0x07, 0x43, 0xe6, 0xe2,
+	0x30, 0x7f, 0x2b, 0xe4, 0xc2, 0xb4, 0x0e, 0x08, 0xcd, 0x7c, 0xd0, 0xfb, 0x9e, 0xba, 0x02, 0xea,
+	0xe9, 0xe0, 0xac, 0x43, 0x9d, 0xc7, 0x41, 0x4e, 0x41, 0xdf, 0x1c, 0xe1, 0x71, 0x40, 0xf8, 0x9e,
+	0x24, 0xa0, 0xd1, 0xde, 0x24, 0x20, 0x75, 0xf5, 0xd4, 0xf5, 0x7d, 0x9e, 0xe9, 0x17, 0x19, 0x13,
+	0x9e, 0x29, 0xe2, 0xc2, 0xad, 0x3f, 0x46, 0x44, 0x89, 0x76, 0xaa, 0x40, 0xde, 0x1a, 0xf7, 0x9a,
+	0x45, 0xe3, 0x8a, 0x2f, 0x11, 0x5d, 0xaa, 0xc0, 0x91, 0x21, 0xdf, 0xa6, 0x0c, 0x3d, 0x93, 0x3d,
+	0x59, 0x71, 0xe6, 0x53, 0x30, 0x29, 0x52, 0x9a, 0x4b, 0x1a, 0xbc, 0x2b, 0x78, 0x76, 0x18, 0x17,
+	0xe9, 0x51, 0xee, 0x01, 0xac, 0x55, 0x21, 0xcf, 0x39, 0x97, 0xf0, 0xa3, 0x19, 0xac, 0x6d, 0x79,
+	0x99, 0x51, 0xd6, 0x46, 0xf7, 0xb2, 0x85, 0x9f, 0x40, 0x63, 0x41, 0xa8, 0x85, 0xa9, 0x31, 0xbc,
+	0x08, 0xa3, 0x82, 0xb3, 0xc0, 0x7c, 0xd8, 0x42, 0x17, 0x74, 0xde, 0x2f, 0x8e, 0x79, 0x93, 0x1e,
+	0x6c, 0x8a, 0x18, 0xda, 0xa8, 0x61, 0x65, 0x66, 0x77, 0x2e, 0x2d, 0xbf, 0xe3, 0xaf, 0x46, 0xe7,
+	0x77, 0xfc, 0xe5, 0x01, 0xe7, 0x56, 0x6e, 0xd3, 0x4b, 0x2a, 0xe6, 0x63, 0xee, 0x9b, 0xe0, 0x1c,
+	0xf0, 0x4c, 0xd2, 0x80, 0x3e, 0xf7, 0x44, 0xf8, 0x10, 0x16, 0x4a, 0x6c, 0x17, 0x71, 0xa6, 0xcd,
+	0x31, 0xf5, 0x4d, 0xf1, 0x3b, 0xff, 0x3e, 0x00, 0x80, 0x25, 0x3d, 0x66, 0xe5, 0x5c, 0x00, 0x00,
 }
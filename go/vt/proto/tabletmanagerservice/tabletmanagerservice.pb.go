@@ -50,34 +50,226 @@ type TabletManagerClient interface {
 	Sleep(ctx context.Context, in *tabletmanagerdata.SleepRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SleepResponse, error)
 	// ExecuteHook executes the hook remotely
 	ExecuteHook(ctx context.Context, in *tabletmanagerdata.ExecuteHookRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteHookResponse, error)
+	// ExecuteHookToStream executes the hook remotely, and streams its
+	// stdout as it is produced, then its exit status.
+	ExecuteHookToStream(ctx context.Context, in *tabletmanagerdata.ExecuteHookToStreamRequest, opts ...grpc.CallOption) (TabletManager_ExecuteHookToStreamClient, error)
 	// GetSchema asks the tablet for its schema
 	GetSchema(ctx context.Context, in *tabletmanagerdata.GetSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaResponse, error)
+	// GetCreateStatements returns the raw CREATE statements of the
+	// tablet's tables and views
+	GetCreateStatements(ctx context.Context, in *tabletmanagerdata.GetCreateStatementsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetCreateStatementsResponse, error)
+	// GetSchemaTimestamps returns when the tablet's tables were last
+	// altered
+	GetSchemaTimestamps(ctx context.Context, in *tabletmanagerdata.GetSchemaTimestampsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaTimestampsResponse, error)
 	// GetPermissions asks the tablet for its permissions
 	GetPermissions(ctx context.Context, in *tabletmanagerdata.GetPermissionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetPermissionsResponse, error)
+	// GetConnectionStats returns the current connection and transaction
+	// counts of the tablet's query service
+	GetConnectionStats(ctx context.Context, in *tabletmanagerdata.GetConnectionStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetConnectionStatsResponse, error)
+	// GetConnectionRejectionStats returns the connection requests the
+	// query service rejected since the last reset, by reason
+	GetConnectionRejectionStats(ctx context.Context, in *tabletmanagerdata.GetConnectionRejectionStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetConnectionRejectionStatsResponse, error)
+	// GetConfig returns the effective values of the tablet command line flags
+	GetConfig(ctx context.Context, in *tabletmanagerdata.GetConfigRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetConfigResponse, error)
+	// GetInFlightRPCs returns the number of RPCs the tablet manager is
+	// processing, by method
+	GetInFlightRPCs(ctx context.Context, in *tabletmanagerdata.GetInFlightRPCsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetInFlightRPCsResponse, error)
+	// GetProcessStats returns the goroutine, thread, open file and
+	// memory usage of the tablet manager process
+	GetProcessStats(ctx context.Context, in *tabletmanagerdata.GetProcessStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetProcessStatsResponse, error)
+	// GetHealthScore returns a composite health score of the tablet,
+	// combining replication lag, error rate and load
+	GetHealthScore(ctx context.Context, in *tabletmanagerdata.GetHealthScoreRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetHealthScoreResponse, error)
+	// GetErrorLogTail returns the last lines of the MySQL error log
+	GetErrorLogTail(ctx context.Context, in *tabletmanagerdata.GetErrorLogTailRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetErrorLogTailResponse, error)
+	// CaptureDiagnostics returns the processlist, slave status, error
+	// log tail, key variables and disk stats of the tablet at once.
+	CaptureDiagnostics(ctx context.Context, in *tabletmanagerdata.CaptureDiagnosticsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CaptureDiagnosticsResponse, error)
+	// CheckDataDir returns the problems with the ownership and the
+	// permissions of the directories mysqld needs
+	CheckDataDir(ctx context.Context, in *tabletmanagerdata.CheckDataDirRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckDataDirResponse, error)
+	// GetThrottleState returns whether the heavy operations of the
+	// tablet are throttled, and why
+	GetThrottleState(ctx context.Context, in *tabletmanagerdata.GetThrottleStateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetThrottleStateResponse, error)
+	// GetTabletTags returns the tags of the tablet record
+	GetTabletTags(ctx context.Context, in *tabletmanagerdata.GetTabletTagsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetTabletTagsResponse, error)
 	SetReadOnly(ctx context.Context, in *tabletmanagerdata.SetReadOnlyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadOnlyResponse, error)
 	SetReadWrite(ctx context.Context, in *tabletmanagerdata.SetReadWriteRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadWriteResponse, error)
+	// SetReadOnlyWithTTL makes the tablet read-only for a while, after
+	// which it goes back to read-write on its own
+	SetReadOnlyWithTTL(ctx context.Context, in *tabletmanagerdata.SetReadOnlyWithTTLRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadOnlyWithTTLResponse, error)
+	// SetSuperReadOnly sets or clears super_read_only, which also
+	// prevents SUPER users from writing
+	SetSuperReadOnly(ctx context.Context, in *tabletmanagerdata.SetSuperReadOnlyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetSuperReadOnlyResponse, error)
+	// SetQueryServerConfig changes the pool sizes, query timeout and
+	// consolidation of the query server without a restart.
+	SetQueryServerConfig(ctx context.Context, in *tabletmanagerdata.SetQueryServerConfigRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetQueryServerConfigResponse, error)
+	// GetQueryBlacklist returns the query patterns the tablet rejects
+	GetQueryBlacklist(ctx context.Context, in *tabletmanagerdata.GetQueryBlacklistRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetQueryBlacklistResponse, error)
+	// SetQueryBlacklist replaces the query patterns the tablet rejects
+	SetQueryBlacklist(ctx context.Context, in *tabletmanagerdata.SetQueryBlacklistRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetQueryBlacklistResponse, error)
 	// ChangeType asks the remote tablet to change its type
 	ChangeType(ctx context.Context, in *tabletmanagerdata.ChangeTypeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ChangeTypeResponse, error)
+	// SetTabletTags merges tags into the tags of the tablet record
+	SetTabletTags(ctx context.Context, in *tabletmanagerdata.SetTabletTagsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetTabletTagsResponse, error)
 	RefreshState(ctx context.Context, in *tabletmanagerdata.RefreshStateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RefreshStateResponse, error)
+	// RepublishTopoRecord rewrites the tablet record in the topology
+	// from the tablet's in-memory copy.
+	RepublishTopoRecord(ctx context.Context, in *tabletmanagerdata.RepublishTopoRecordRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RepublishTopoRecordResponse, error)
 	RunHealthCheck(ctx context.Context, in *tabletmanagerdata.RunHealthCheckRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RunHealthCheckResponse, error)
 	IgnoreHealthError(ctx context.Context, in *tabletmanagerdata.IgnoreHealthErrorRequest, opts ...grpc.CallOption) (*tabletmanagerdata.IgnoreHealthErrorResponse, error)
+	// SetMaintenanceMode sets or clears a maintenance marker, that
+	// survives tablet restarts
+	SetMaintenanceMode(ctx context.Context, in *tabletmanagerdata.SetMaintenanceModeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetMaintenanceModeResponse, error)
+	// PauseHealthReporting pauses or resumes the publication of the
+	// tablet health, which resumes on its own after a maximum duration
+	PauseHealthReporting(ctx context.Context, in *tabletmanagerdata.PauseHealthReportingRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PauseHealthReportingResponse, error)
+	// SetThrottle forces the heavy operations of the tablet to be
+	// throttled or not, for a while, or goes back to throttling them
+	// on replication lag
+	SetThrottle(ctx context.Context, in *tabletmanagerdata.SetThrottleRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetThrottleResponse, error)
+	// PrepareCutover makes the tablet read-only, as the first phase of
+	// a cutover. The cutover aborts on its own if neither committed nor
+	// aborted in time.
+	PrepareCutover(ctx context.Context, in *tabletmanagerdata.PrepareCutoverRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PrepareCutoverResponse, error)
+	// CommitCutover completes a prepared cutover, applying the serving
+	// state saved in the topology
+	CommitCutover(ctx context.Context, in *tabletmanagerdata.CommitCutoverRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CommitCutoverResponse, error)
+	// AbortCutover cancels a prepared cutover, restoring the read-only
+	// state the tablet had before
+	AbortCutover(ctx context.Context, in *tabletmanagerdata.AbortCutoverRequest, opts ...grpc.CallOption) (*tabletmanagerdata.AbortCutoverResponse, error)
+	// SetServingKeyRange atomically replaces the keyrange the tablet
+	// serves, and the tablet types it serves it for
+	SetServingKeyRange(ctx context.Context, in *tabletmanagerdata.SetServingKeyRangeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetServingKeyRangeResponse, error)
+	// RestartMysql drains the tablet, restarts mysqld, and waits for it
+	// to be healthy before serving again. It streams its progress.
+	RestartMysql(ctx context.Context, in *tabletmanagerdata.RestartMysqlRequest, opts ...grpc.CallOption) (TabletManager_RestartMysqlClient, error)
+	// Decommission drains a non-master tablet, changes its type to
+	// SPARE, and optionally stops replication and mysqld. It streams
+	// its progress.
+	Decommission(ctx context.Context, in *tabletmanagerdata.DecommissionRequest, opts ...grpc.CallOption) (TabletManager_DecommissionClient, error)
+	// CheckTopoConnectivity returns whether the tablet can read from
+	// the topo server, and how long it took.
+	CheckTopoConnectivity(ctx context.Context, in *tabletmanagerdata.CheckTopoConnectivityRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckTopoConnectivityResponse, error)
+	// WarmUp fills the caches of the tablet before it serves, and
+	// streams its progress.
+	WarmUp(ctx context.Context, in *tabletmanagerdata.WarmUpRequest, opts ...grpc.CallOption) (TabletManager_WarmUpClient, error)
 	ReloadSchema(ctx context.Context, in *tabletmanagerdata.ReloadSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReloadSchemaResponse, error)
 	PreflightSchema(ctx context.Context, in *tabletmanagerdata.PreflightSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PreflightSchemaResponse, error)
 	ApplySchema(ctx context.Context, in *tabletmanagerdata.ApplySchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplySchemaResponse, error)
+	// SchemaDiff returns the DDL statements that would turn the schema
+	// of the tablet into the provided one.
+	SchemaDiff(ctx context.Context, in *tabletmanagerdata.SchemaDiffRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SchemaDiffResponse, error)
+	// AssessSchemaChange estimates how disruptive an ALTER TABLE would
+	// be on the live table, without running it.
+	AssessSchemaChange(ctx context.Context, in *tabletmanagerdata.AssessSchemaChangeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.AssessSchemaChangeResponse, error)
+	// WatchSchema streams the changes to the schema of the tablet,
+	// as its query service sees them.
+	WatchSchema(ctx context.Context, in *tabletmanagerdata.WatchSchemaRequest, opts ...grpc.CallOption) (TabletManager_WatchSchemaClient, error)
+	// GetVSchema returns the VSchema of the tablet keyspace.
+	GetVSchema(ctx context.Context, in *tabletmanagerdata.GetVSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetVSchemaResponse, error)
+	// ApplyVSchema saves the VSchema of the tablet keyspace and
+	// rebuilds the SrvVSchema of the tablet cell, for local testing.
+	ApplyVSchema(ctx context.Context, in *tabletmanagerdata.ApplyVSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplyVSchemaResponse, error)
 	ExecuteFetchAsDba(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsDbaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsDbaResponse, error)
+	// ExecuteFetchAsDbaCSV streams the result of a query run with the
+	// DBA user, encoded as CSV.
+	ExecuteFetchAsDbaCSV(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsDbaCSVRequest, opts ...grpc.CallOption) (TabletManager_ExecuteFetchAsDbaCSVClient, error)
+	// ExecuteFetchColumnar runs a query with the DBA user, and returns
+	// the result in columnar form
+	ExecuteFetchColumnar(ctx context.Context, in *tabletmanagerdata.ExecuteFetchColumnarRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchColumnarResponse, error)
 	ExecuteFetchAsAllPrivs(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsAllPrivsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsAllPrivsResponse, error)
 	ExecuteFetchAsApp(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsAppRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsAppResponse, error)
+	// ExplainQuery returns the MySQL execution plan of a query,
+	// without running it
+	ExplainQuery(ctx context.Context, in *tabletmanagerdata.ExplainQueryRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExplainQueryResponse, error)
+	// ApplyGrants runs a batch of GRANT and REVOKE statements,
+	// optionally restoring the previous grants if one fails
+	ApplyGrants(ctx context.Context, in *tabletmanagerdata.ApplyGrantsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplyGrantsResponse, error)
+	// ChecksumTable returns an order independent checksum of the rows
+	// of a table, optionally restricted to a key range
+	ChecksumTable(ctx context.Context, in *tabletmanagerdata.ChecksumTableRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ChecksumTableResponse, error)
+	// TruncateTable truncates a table, if the caller confirmed the
+	// keyspace of the tablet
+	TruncateTable(ctx context.Context, in *tabletmanagerdata.TruncateTableRequest, opts ...grpc.CallOption) (*tabletmanagerdata.TruncateTableResponse, error)
+	// RenameTable renames a table, unless views or foreign keys depend
+	// on it and the caller did not ask to cascade
+	RenameTable(ctx context.Context, in *tabletmanagerdata.RenameTableRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RenameTableResponse, error)
+	// StreamRowsInKeyRange streams the rows of a table in primary key
+	// order, optionally restricted to a key range
+	StreamRowsInKeyRange(ctx context.Context, in *tabletmanagerdata.StreamRowsInKeyRangeRequest, opts ...grpc.CallOption) (TabletManager_StreamRowsInKeyRangeClient, error)
+	// StreamKeyRangeBinlog streams the binlog transactions touching
+	// rows in a key range, from a replication position
+	StreamKeyRangeBinlog(ctx context.Context, in *tabletmanagerdata.StreamKeyRangeBinlogRequest, opts ...grpc.CallOption) (TabletManager_StreamKeyRangeBinlogClient, error)
+	// CloneStream streams the replication position of a consistent
+	// snapshot, then the rows of the tables in that snapshot
+	CloneStream(ctx context.Context, in *tabletmanagerdata.CloneStreamRequest, opts ...grpc.CallOption) (TabletManager_CloneStreamClient, error)
+	// GetCharsetConfig returns the server and database default
+	// character sets and collations.
+	GetCharsetConfig(ctx context.Context, in *tabletmanagerdata.GetCharsetConfigRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetCharsetConfigResponse, error)
+	// GetProcessList returns the threads currently running in MySQL.
+	GetProcessList(ctx context.Context, in *tabletmanagerdata.GetProcessListRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetProcessListResponse, error)
+	// KillProcess kills a MySQL connection by ID. It refuses to kill
+	// system and replication threads.
+	KillProcess(ctx context.Context, in *tabletmanagerdata.KillProcessRequest, opts ...grpc.CallOption) (*tabletmanagerdata.KillProcessResponse, error)
+	// TailGeneralLog enables the MySQL general log for a while, and
+	// streams its entries. The log is disabled again when the stream ends.
+	TailGeneralLog(ctx context.Context, in *tabletmanagerdata.TailGeneralLogRequest, opts ...grpc.CallOption) (TabletManager_TailGeneralLogClient, error)
+	// StreamQPS streams the read, write and total query rates of the
+	// tablet. Samples are dropped, not queued, if the client is slow.
+	StreamQPS(ctx context.Context, in *tabletmanagerdata.StreamQPSRequest, opts ...grpc.CallOption) (TabletManager_StreamQPSClient, error)
 	// SlaveStatus returns the current slave status.
 	SlaveStatus(ctx context.Context, in *tabletmanagerdata.SlaveStatusRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SlaveStatusResponse, error)
+	// SlaveStatusAllChannels returns the slave status of each
+	// replication channel, for multi-source replication.
+	SlaveStatusAllChannels(ctx context.Context, in *tabletmanagerdata.SlaveStatusAllChannelsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SlaveStatusAllChannelsResponse, error)
+	// GetReplicationSource returns the master host, port and
+	// replication user of the slave, without the password.
+	GetReplicationSource(ctx context.Context, in *tabletmanagerdata.GetReplicationSourceRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetReplicationSourceResponse, error)
+	// ValidateReplicationCredentials checks the slave can still log in
+	// to its master with its replication credentials.
+	ValidateReplicationCredentials(ctx context.Context, in *tabletmanagerdata.ValidateReplicationCredentialsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ValidateReplicationCredentialsResponse, error)
+	// GetApplierQueueStats returns the relay log backlog and the state
+	// of the replication applier threads
+	GetApplierQueueStats(ctx context.Context, in *tabletmanagerdata.GetApplierQueueStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetApplierQueueStatsResponse, error)
 	// MasterPosition returns the current master position
 	MasterPosition(ctx context.Context, in *tabletmanagerdata.MasterPositionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.MasterPositionResponse, error)
+	// GetGtidPurged returns the set of transactions purged from the
+	// binary logs, that can't be replicated from this tablet anymore
+	GetGtidPurged(ctx context.Context, in *tabletmanagerdata.GetGtidPurgedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetGtidPurgedResponse, error)
+	// GetBinlogStats returns the recent rate of transactions written
+	// to (master) or applied from (slave) the binary logs
+	GetBinlogStats(ctx context.Context, in *tabletmanagerdata.GetBinlogStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetBinlogStatsResponse, error)
+	// GetReplicationErrorStats returns the number of replication errors
+	// and reconnects since the counts were last reset
+	GetReplicationErrorStats(ctx context.Context, in *tabletmanagerdata.GetReplicationErrorStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetReplicationErrorStatsResponse, error)
 	// StopSlave makes mysql stop its replication
 	StopSlave(ctx context.Context, in *tabletmanagerdata.StopSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopSlaveResponse, error)
 	// StopSlaveMinimum stops the mysql replication after it reaches
 	// the provided minimum point
 	StopSlaveMinimum(ctx context.Context, in *tabletmanagerdata.StopSlaveMinimumRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopSlaveMinimumResponse, error)
+	// StopSlaveMinimumStream is like StopSlaveMinimum, but it streams the
+	// slave position while waiting
+	StopSlaveMinimumStream(ctx context.Context, in *tabletmanagerdata.StopSlaveMinimumStreamRequest, opts ...grpc.CallOption) (TabletManager_StopSlaveMinimumStreamClient, error)
+	// BoostReplicationCatchup raises the MySQL settings limiting how fast
+	// the slave applies its relay logs for a while, and streams the
+	// replication lag
+	BoostReplicationCatchup(ctx context.Context, in *tabletmanagerdata.BoostReplicationCatchupRequest, opts ...grpc.CallOption) (TabletManager_BoostReplicationCatchupClient, error)
 	// StartSlave starts the mysql replication
 	StartSlave(ctx context.Context, in *tabletmanagerdata.StartSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StartSlaveResponse, error)
+	// EnableParallelReplication restarts the SQL thread with the given
+	// parallel replication settings, and rolls back if it does not resume.
+	EnableParallelReplication(ctx context.Context, in *tabletmanagerdata.EnableParallelReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.EnableParallelReplicationResponse, error)
+	// RotateReplicationCredentials changes the credentials the slave
+	// connects to its master with, and rolls back if the IO thread
+	// does not resume with them.
+	RotateReplicationCredentials(ctx context.Context, in *tabletmanagerdata.RotateReplicationCredentialsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RotateReplicationCredentialsResponse, error)
+	// ConfigureReplicationSSL makes the slave connect to its master
+	// with SSL, and rolls back if the IO thread does not resume with it.
+	ConfigureReplicationSSL(ctx context.Context, in *tabletmanagerdata.ConfigureReplicationSSLRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ConfigureReplicationSSLResponse, error)
+	// RepairRelayLog discards the relay logs of the slave, and fetches
+	// them again from the master, from the last applied position
+	RepairRelayLog(ctx context.Context, in *tabletmanagerdata.RepairRelayLogRequest, opts ...grpc.CallOption) (TabletManager_RepairRelayLogClient, error)
 	// TabletExternallyReparented tells a tablet that its underlying MySQL is
 	// currently the master. It is only used in environments (tabletmanagerdata.such as Vitess+MoB)
 	// in which MySQL is reparented by some agent external to Vitess, and then
@@ -106,6 +298,9 @@ type TabletManagerClient interface {
 	TabletExternallyElected(ctx context.Context, in *tabletmanagerdata.TabletExternallyElectedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.TabletExternallyElectedResponse, error)
 	// GetSlaves asks for the list of mysql slaves
 	GetSlaves(ctx context.Context, in *tabletmanagerdata.GetSlavesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSlavesResponse, error)
+	// GetReplicationGraph returns the master and the slaves of the
+	// tablet, as its mysql sees them
+	GetReplicationGraph(ctx context.Context, in *tabletmanagerdata.GetReplicationGraphRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetReplicationGraphResponse, error)
 	// WaitBlpPosition tells the remote tablet to wait until it reaches
 	// the specified binolg player position
 	WaitBlpPosition(ctx context.Context, in *tabletmanagerdata.WaitBlpPositionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.WaitBlpPositionResponse, error)
@@ -116,6 +311,9 @@ type TabletManagerClient interface {
 	StartBlp(ctx context.Context, in *tabletmanagerdata.StartBlpRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StartBlpResponse, error)
 	// RunBlpUntil asks the tablet to restart its binlog players
 	RunBlpUntil(ctx context.Context, in *tabletmanagerdata.RunBlpUntilRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RunBlpUntilResponse, error)
+	// GetBinlogFilters returns the filters the binlog players of the
+	// tablet apply.
+	GetBinlogFilters(ctx context.Context, in *tabletmanagerdata.GetBinlogFiltersRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetBinlogFiltersResponse, error)
 	// ResetReplication makes the target not replicating
 	ResetReplication(ctx context.Context, in *tabletmanagerdata.ResetReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResetReplicationResponse, error)
 	// InitMaster initializes the tablet as a master
@@ -123,6 +321,9 @@ type TabletManagerClient interface {
 	// PopulateReparentJournal tells the tablet to add an entry to its
 	// reparent journal
 	PopulateReparentJournal(ctx context.Context, in *tabletmanagerdata.PopulateReparentJournalRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PopulateReparentJournalResponse, error)
+	// GetLastReparentJournalEntry returns the most recent entry of the
+	// tablet reparent journal
+	GetLastReparentJournalEntry(ctx context.Context, in *tabletmanagerdata.GetLastReparentJournalEntryRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetLastReparentJournalEntryResponse, error)
 	// InitSlave tells the tablet to reparent to the master unconditionnally
 	InitSlave(ctx context.Context, in *tabletmanagerdata.InitSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.InitSlaveResponse, error)
 	// DemoteMaster tells the soon-to-be-former master it's gonna change
@@ -134,6 +335,9 @@ type TabletManagerClient interface {
 	SlaveWasPromoted(ctx context.Context, in *tabletmanagerdata.SlaveWasPromotedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SlaveWasPromotedResponse, error)
 	// SetMaster tells the slave to reparent
 	SetMaster(ctx context.Context, in *tabletmanagerdata.SetMasterRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetMasterResponse, error)
+	// ConfigureReplication tells the slave to reparent, either with
+	// GTID auto-position or from an explicit binlog file and position
+	ConfigureReplication(ctx context.Context, in *tabletmanagerdata.ConfigureReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ConfigureReplicationResponse, error)
 	// SlaveWasRestarted tells the remote tablet its master has changed
 	SlaveWasRestarted(ctx context.Context, in *tabletmanagerdata.SlaveWasRestartedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SlaveWasRestartedResponse, error)
 	// StopReplicationAndGetStatus stops MySQL replication, and returns the
@@ -141,9 +345,53 @@ type TabletManagerClient interface {
 	StopReplicationAndGetStatus(ctx context.Context, in *tabletmanagerdata.StopReplicationAndGetStatusRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopReplicationAndGetStatusResponse, error)
 	// PromoteSlave makes the slave the new master
 	PromoteSlave(ctx context.Context, in *tabletmanagerdata.PromoteSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PromoteSlaveResponse, error)
+	// SetReparentEligibility marks the tablet as eligible or not to be
+	// chosen as the new master by reparent tools
+	SetReparentEligibility(ctx context.Context, in *tabletmanagerdata.SetReparentEligibilityRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReparentEligibilityResponse, error)
+	// CheckReparentCandidate returns whether the tablet can be chosen
+	// as the new master by reparent tools
+	CheckReparentCandidate(ctx context.Context, in *tabletmanagerdata.CheckReparentCandidateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckReparentCandidateResponse, error)
+	// SetSemiSyncAckCount sets the number of semi-sync slaves the master
+	// waits for, if that many are connected
+	SetSemiSyncAckCount(ctx context.Context, in *tabletmanagerdata.SetSemiSyncAckCountRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetSemiSyncAckCountResponse, error)
+	// GetDurabilityPolicy returns the semi-sync policy the tablet
+	// enforces
+	GetDurabilityPolicy(ctx context.Context, in *tabletmanagerdata.GetDurabilityPolicyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetDurabilityPolicyResponse, error)
+	// GetBackupLimits returns the default and maximum backup concurrency
+	GetBackupLimits(ctx context.Context, in *tabletmanagerdata.GetBackupLimitsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetBackupLimitsResponse, error)
+	// GetBackupPosition returns the replication position a backup
+	// taken now would capture, without side effects
+	GetBackupPosition(ctx context.Context, in *tabletmanagerdata.GetBackupPositionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetBackupPositionResponse, error)
 	Backup(ctx context.Context, in *tabletmanagerdata.BackupRequest, opts ...grpc.CallOption) (TabletManager_BackupClient, error)
+	// IncrementalBackup backs up the binlogs since the position of a
+	// prior backup
+	IncrementalBackup(ctx context.Context, in *tabletmanagerdata.IncrementalBackupRequest, opts ...grpc.CallOption) (TabletManager_IncrementalBackupClient, error)
 	// RestoreFromBackup deletes all local data and restores it from the latest backup.
 	RestoreFromBackup(ctx context.Context, in *tabletmanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (TabletManager_RestoreFromBackupClient, error)
+	// RestoreToTimestamp deletes all local data, restores it from the
+	// named backup, and applies the master binlogs up to a target time.
+	RestoreToTimestamp(ctx context.Context, in *tabletmanagerdata.RestoreToTimestampRequest, opts ...grpc.CallOption) (TabletManager_RestoreToTimestampClient, error)
+	// SetPreferredBackup records the backup new tablets of the shard
+	// restore from, instead of the latest one.
+	SetPreferredBackup(ctx context.Context, in *tabletmanagerdata.SetPreferredBackupRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetPreferredBackupResponse, error)
+	// GetPreferredBackup returns the preferred backup of the shard.
+	GetPreferredBackup(ctx context.Context, in *tabletmanagerdata.GetPreferredBackupRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetPreferredBackupResponse, error)
+	// CheckRestoreCompatibility compares the mysqld settings recorded
+	// in a backup of the shard with the tablet's, without restoring it.
+	CheckRestoreCompatibility(ctx context.Context, in *tabletmanagerdata.CheckRestoreCompatibilityRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckRestoreCompatibilityResponse, error)
+	// GetLastBackupInfo returns the size, duration and outcome of the
+	// last backup taken by the tablet
+	GetLastBackupInfo(ctx context.Context, in *tabletmanagerdata.GetLastBackupInfoRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetLastBackupInfoResponse, error)
+	// GetBackupFreshness returns the most recent complete backup of the
+	// shard of the tablet, and how long ago it was taken
+	GetBackupFreshness(ctx context.Context, in *tabletmanagerdata.GetBackupFreshnessRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetBackupFreshnessResponse, error)
+	// CheckBackupReadiness checks the tablet is not running DDL nor
+	// restoring, and replicates and serves fine, so it can take a
+	// consistent backup
+	CheckBackupReadiness(ctx context.Context, in *tabletmanagerdata.CheckBackupReadinessRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckBackupReadinessResponse, error)
+	// TestRestore restores a backup of the shard into a scratch mysqld
+	// on the tablet host and checks it, without touching the tablet.
+	TestRestore(ctx context.Context, in *tabletmanagerdata.TestRestoreRequest, opts ...grpc.CallOption) (TabletManager_TestRestoreClient, error)
 }
 
 type tabletManagerClient struct {
@@ -181,6 +429,38 @@ func (c *tabletManagerClient) ExecuteHook(ctx context.Context, in *tabletmanager
 	return out, nil
 }
 
+func (c *tabletManagerClient) ExecuteHookToStream(ctx context.Context, in *tabletmanagerdata.ExecuteHookToStreamRequest, opts ...grpc.CallOption) (TabletManager_ExecuteHookToStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[0], c.cc, "/tabletmanagerservice.TabletManager/ExecuteHookToStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerExecuteHookToStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_ExecuteHookToStreamClient interface {
+	Recv() (*tabletmanagerdata.ExecuteHookToStreamResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerExecuteHookToStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerExecuteHookToStreamClient) Recv() (*tabletmanagerdata.ExecuteHookToStreamResponse, error) {
+	m := new(tabletmanagerdata.ExecuteHookToStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *tabletManagerClient) GetSchema(ctx context.Context, in *tabletmanagerdata.GetSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaResponse, error) {
 	out := new(tabletmanagerdata.GetSchemaResponse)
 	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetSchema", in, out, c.cc, opts...)
@@ -190,336 +470,391 @@ func (c *tabletManagerClient) GetSchema(ctx context.Context, in *tabletmanagerda
 	return out, nil
 }
 
-func (c *tabletManagerClient) GetPermissions(ctx context.Context, in *tabletmanagerdata.GetPermissionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetPermissionsResponse, error) {
-	out := new(tabletmanagerdata.GetPermissionsResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetPermissions", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetCreateStatements(ctx context.Context, in *tabletmanagerdata.GetCreateStatementsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetCreateStatementsResponse, error) {
+	out := new(tabletmanagerdata.GetCreateStatementsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetCreateStatements", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) SetReadOnly(ctx context.Context, in *tabletmanagerdata.SetReadOnlyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadOnlyResponse, error) {
-	out := new(tabletmanagerdata.SetReadOnlyResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetReadOnly", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetSchemaTimestamps(ctx context.Context, in *tabletmanagerdata.GetSchemaTimestampsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaTimestampsResponse, error) {
+	out := new(tabletmanagerdata.GetSchemaTimestampsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetSchemaTimestamps", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) SetReadWrite(ctx context.Context, in *tabletmanagerdata.SetReadWriteRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadWriteResponse, error) {
-	out := new(tabletmanagerdata.SetReadWriteResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetReadWrite", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetPermissions(ctx context.Context, in *tabletmanagerdata.GetPermissionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetPermissionsResponse, error) {
+	out := new(tabletmanagerdata.GetPermissionsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetPermissions", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) ChangeType(ctx context.Context, in *tabletmanagerdata.ChangeTypeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ChangeTypeResponse, error) {
-	out := new(tabletmanagerdata.ChangeTypeResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ChangeType", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetConnectionStats(ctx context.Context, in *tabletmanagerdata.GetConnectionStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetConnectionStatsResponse, error) {
+	out := new(tabletmanagerdata.GetConnectionStatsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetConnectionStats", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) RefreshState(ctx context.Context, in *tabletmanagerdata.RefreshStateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RefreshStateResponse, error) {
-	out := new(tabletmanagerdata.RefreshStateResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RefreshState", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetConnectionRejectionStats(ctx context.Context, in *tabletmanagerdata.GetConnectionRejectionStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetConnectionRejectionStatsResponse, error) {
+	out := new(tabletmanagerdata.GetConnectionRejectionStatsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetConnectionRejectionStats", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) RunHealthCheck(ctx context.Context, in *tabletmanagerdata.RunHealthCheckRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RunHealthCheckResponse, error) {
-	out := new(tabletmanagerdata.RunHealthCheckResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RunHealthCheck", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetConfig(ctx context.Context, in *tabletmanagerdata.GetConfigRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetConfigResponse, error) {
+	out := new(tabletmanagerdata.GetConfigResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetConfig", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) IgnoreHealthError(ctx context.Context, in *tabletmanagerdata.IgnoreHealthErrorRequest, opts ...grpc.CallOption) (*tabletmanagerdata.IgnoreHealthErrorResponse, error) {
-	out := new(tabletmanagerdata.IgnoreHealthErrorResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/IgnoreHealthError", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetInFlightRPCs(ctx context.Context, in *tabletmanagerdata.GetInFlightRPCsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetInFlightRPCsResponse, error) {
+	out := new(tabletmanagerdata.GetInFlightRPCsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetInFlightRPCs", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) ReloadSchema(ctx context.Context, in *tabletmanagerdata.ReloadSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReloadSchemaResponse, error) {
-	out := new(tabletmanagerdata.ReloadSchemaResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ReloadSchema", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetProcessStats(ctx context.Context, in *tabletmanagerdata.GetProcessStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetProcessStatsResponse, error) {
+	out := new(tabletmanagerdata.GetProcessStatsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetProcessStats", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) PreflightSchema(ctx context.Context, in *tabletmanagerdata.PreflightSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PreflightSchemaResponse, error) {
-	out := new(tabletmanagerdata.PreflightSchemaResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/PreflightSchema", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetHealthScore(ctx context.Context, in *tabletmanagerdata.GetHealthScoreRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetHealthScoreResponse, error) {
+	out := new(tabletmanagerdata.GetHealthScoreResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetHealthScore", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) ApplySchema(ctx context.Context, in *tabletmanagerdata.ApplySchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplySchemaResponse, error) {
-	out := new(tabletmanagerdata.ApplySchemaResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ApplySchema", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetErrorLogTail(ctx context.Context, in *tabletmanagerdata.GetErrorLogTailRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetErrorLogTailResponse, error) {
+	out := new(tabletmanagerdata.GetErrorLogTailResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetErrorLogTail", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) ExecuteFetchAsDba(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsDbaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsDbaResponse, error) {
-	out := new(tabletmanagerdata.ExecuteFetchAsDbaResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ExecuteFetchAsDba", in, out, c.cc, opts...)
+func (c *tabletManagerClient) CaptureDiagnostics(ctx context.Context, in *tabletmanagerdata.CaptureDiagnosticsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CaptureDiagnosticsResponse, error) {
+	out := new(tabletmanagerdata.CaptureDiagnosticsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/CaptureDiagnostics", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) ExecuteFetchAsAllPrivs(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsAllPrivsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsAllPrivsResponse, error) {
-	out := new(tabletmanagerdata.ExecuteFetchAsAllPrivsResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ExecuteFetchAsAllPrivs", in, out, c.cc, opts...)
+func (c *tabletManagerClient) CheckDataDir(ctx context.Context, in *tabletmanagerdata.CheckDataDirRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckDataDirResponse, error) {
+	out := new(tabletmanagerdata.CheckDataDirResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/CheckDataDir", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) ExecuteFetchAsApp(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsAppRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsAppResponse, error) {
-	out := new(tabletmanagerdata.ExecuteFetchAsAppResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ExecuteFetchAsApp", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetThrottleState(ctx context.Context, in *tabletmanagerdata.GetThrottleStateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetThrottleStateResponse, error) {
+	out := new(tabletmanagerdata.GetThrottleStateResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetThrottleState", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) SlaveStatus(ctx context.Context, in *tabletmanagerdata.SlaveStatusRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SlaveStatusResponse, error) {
-	out := new(tabletmanagerdata.SlaveStatusResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SlaveStatus", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetTabletTags(ctx context.Context, in *tabletmanagerdata.GetTabletTagsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetTabletTagsResponse, error) {
+	out := new(tabletmanagerdata.GetTabletTagsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetTabletTags", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) MasterPosition(ctx context.Context, in *tabletmanagerdata.MasterPositionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.MasterPositionResponse, error) {
-	out := new(tabletmanagerdata.MasterPositionResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/MasterPosition", in, out, c.cc, opts...)
+func (c *tabletManagerClient) SetReadOnly(ctx context.Context, in *tabletmanagerdata.SetReadOnlyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadOnlyResponse, error) {
+	out := new(tabletmanagerdata.SetReadOnlyResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetReadOnly", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) StopSlave(ctx context.Context, in *tabletmanagerdata.StopSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopSlaveResponse, error) {
-	out := new(tabletmanagerdata.StopSlaveResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StopSlave", in, out, c.cc, opts...)
+func (c *tabletManagerClient) SetReadWrite(ctx context.Context, in *tabletmanagerdata.SetReadWriteRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadWriteResponse, error) {
+	out := new(tabletmanagerdata.SetReadWriteResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetReadWrite", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) StopSlaveMinimum(ctx context.Context, in *tabletmanagerdata.StopSlaveMinimumRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopSlaveMinimumResponse, error) {
-	out := new(tabletmanagerdata.StopSlaveMinimumResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StopSlaveMinimum", in, out, c.cc, opts...)
+func (c *tabletManagerClient) SetReadOnlyWithTTL(ctx context.Context, in *tabletmanagerdata.SetReadOnlyWithTTLRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadOnlyWithTTLResponse, error) {
+	out := new(tabletmanagerdata.SetReadOnlyWithTTLResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetReadOnlyWithTTL", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) StartSlave(ctx context.Context, in *tabletmanagerdata.StartSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StartSlaveResponse, error) {
-	out := new(tabletmanagerdata.StartSlaveResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StartSlave", in, out, c.cc, opts...)
+func (c *tabletManagerClient) SetSuperReadOnly(ctx context.Context, in *tabletmanagerdata.SetSuperReadOnlyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetSuperReadOnlyResponse, error) {
+	out := new(tabletmanagerdata.SetSuperReadOnlyResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetSuperReadOnly", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) TabletExternallyReparented(ctx context.Context, in *tabletmanagerdata.TabletExternallyReparentedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.TabletExternallyReparentedResponse, error) {
-	out := new(tabletmanagerdata.TabletExternallyReparentedResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/TabletExternallyReparented", in, out, c.cc, opts...)
+func (c *tabletManagerClient) SetQueryServerConfig(ctx context.Context, in *tabletmanagerdata.SetQueryServerConfigRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetQueryServerConfigResponse, error) {
+	out := new(tabletmanagerdata.SetQueryServerConfigResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetQueryServerConfig", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) TabletExternallyElected(ctx context.Context, in *tabletmanagerdata.TabletExternallyElectedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.TabletExternallyElectedResponse, error) {
-	out := new(tabletmanagerdata.TabletExternallyElectedResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/TabletExternallyElected", in, out, c.cc, opts...)
+func (c *tabletManagerClient) GetQueryBlacklist(ctx context.Context, in *tabletmanagerdata.GetQueryBlacklistRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetQueryBlacklistResponse, error) {
+	out := new(tabletmanagerdata.GetQueryBlacklistResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetQueryBlacklist", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) GetSlaves(ctx context.Context, in *tabletmanagerdata.GetSlavesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSlavesResponse, error) {
-	out := new(tabletmanagerdata.GetSlavesResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetSlaves", in, out, c.cc, opts...)
+func (c *tabletManagerClient) SetQueryBlacklist(ctx context.Context, in *tabletmanagerdata.SetQueryBlacklistRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetQueryBlacklistResponse, error) {
+	out := new(tabletmanagerdata.SetQueryBlacklistResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetQueryBlacklist", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) WaitBlpPosition(ctx context.Context, in *tabletmanagerdata.WaitBlpPositionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.WaitBlpPositionResponse, error) {
-	out := new(tabletmanagerdata.WaitBlpPositionResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/WaitBlpPosition", in, out, c.cc, opts...)
+func (c *tabletManagerClient) ChangeType(ctx context.Context, in *tabletmanagerdata.ChangeTypeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ChangeTypeResponse, error) {
+	out := new(tabletmanagerdata.ChangeTypeResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ChangeType", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) StopBlp(ctx context.Context, in *tabletmanagerdata.StopBlpRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopBlpResponse, error) {
-	out := new(tabletmanagerdata.StopBlpResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StopBlp", in, out, c.cc, opts...)
+func (c *tabletManagerClient) SetTabletTags(ctx context.Context, in *tabletmanagerdata.SetTabletTagsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetTabletTagsResponse, error) {
+	out := new(tabletmanagerdata.SetTabletTagsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetTabletTags", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) StartBlp(ctx context.Context, in *tabletmanagerdata.StartBlpRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StartBlpResponse, error) {
-	out := new(tabletmanagerdata.StartBlpResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StartBlp", in, out, c.cc, opts...)
+func (c *tabletManagerClient) RefreshState(ctx context.Context, in *tabletmanagerdata.RefreshStateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RefreshStateResponse, error) {
+	out := new(tabletmanagerdata.RefreshStateResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RefreshState", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) RunBlpUntil(ctx context.Context, in *tabletmanagerdata.RunBlpUntilRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RunBlpUntilResponse, error) {
-	out := new(tabletmanagerdata.RunBlpUntilResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RunBlpUntil", in, out, c.cc, opts...)
+func (c *tabletManagerClient) RepublishTopoRecord(ctx context.Context, in *tabletmanagerdata.RepublishTopoRecordRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RepublishTopoRecordResponse, error) {
+	out := new(tabletmanagerdata.RepublishTopoRecordResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RepublishTopoRecord", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) ResetReplication(ctx context.Context, in *tabletmanagerdata.ResetReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResetReplicationResponse, error) {
-	out := new(tabletmanagerdata.ResetReplicationResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ResetReplication", in, out, c.cc, opts...)
+func (c *tabletManagerClient) RunHealthCheck(ctx context.Context, in *tabletmanagerdata.RunHealthCheckRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RunHealthCheckResponse, error) {
+	out := new(tabletmanagerdata.RunHealthCheckResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RunHealthCheck", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) InitMaster(ctx context.Context, in *tabletmanagerdata.InitMasterRequest, opts ...grpc.CallOption) (*tabletmanagerdata.InitMasterResponse, error) {
-	out := new(tabletmanagerdata.InitMasterResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/InitMaster", in, out, c.cc, opts...)
+func (c *tabletManagerClient) IgnoreHealthError(ctx context.Context, in *tabletmanagerdata.IgnoreHealthErrorRequest, opts ...grpc.CallOption) (*tabletmanagerdata.IgnoreHealthErrorResponse, error) {
+	out := new(tabletmanagerdata.IgnoreHealthErrorResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/IgnoreHealthError", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) PopulateReparentJournal(ctx context.Context, in *tabletmanagerdata.PopulateReparentJournalRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PopulateReparentJournalResponse, error) {
-	out := new(tabletmanagerdata.PopulateReparentJournalResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/PopulateReparentJournal", in, out, c.cc, opts...)
+func (c *tabletManagerClient) SetMaintenanceMode(ctx context.Context, in *tabletmanagerdata.SetMaintenanceModeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetMaintenanceModeResponse, error) {
+	out := new(tabletmanagerdata.SetMaintenanceModeResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetMaintenanceMode", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) InitSlave(ctx context.Context, in *tabletmanagerdata.InitSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.InitSlaveResponse, error) {
-	out := new(tabletmanagerdata.InitSlaveResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/InitSlave", in, out, c.cc, opts...)
+func (c *tabletManagerClient) PauseHealthReporting(ctx context.Context, in *tabletmanagerdata.PauseHealthReportingRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PauseHealthReportingResponse, error) {
+	out := new(tabletmanagerdata.PauseHealthReportingResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/PauseHealthReporting", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) DemoteMaster(ctx context.Context, in *tabletmanagerdata.DemoteMasterRequest, opts ...grpc.CallOption) (*tabletmanagerdata.DemoteMasterResponse, error) {
-	out := new(tabletmanagerdata.DemoteMasterResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/DemoteMaster", in, out, c.cc, opts...)
+func (c *tabletManagerClient) SetThrottle(ctx context.Context, in *tabletmanagerdata.SetThrottleRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetThrottleResponse, error) {
+	out := new(tabletmanagerdata.SetThrottleResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetThrottle", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) PromoteSlaveWhenCaughtUp(ctx context.Context, in *tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PromoteSlaveWhenCaughtUpResponse, error) {
-	out := new(tabletmanagerdata.PromoteSlaveWhenCaughtUpResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/PromoteSlaveWhenCaughtUp", in, out, c.cc, opts...)
+func (c *tabletManagerClient) PrepareCutover(ctx context.Context, in *tabletmanagerdata.PrepareCutoverRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PrepareCutoverResponse, error) {
+	out := new(tabletmanagerdata.PrepareCutoverResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/PrepareCutover", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) SlaveWasPromoted(ctx context.Context, in *tabletmanagerdata.SlaveWasPromotedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SlaveWasPromotedResponse, error) {
-	out := new(tabletmanagerdata.SlaveWasPromotedResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SlaveWasPromoted", in, out, c.cc, opts...)
+func (c *tabletManagerClient) CommitCutover(ctx context.Context, in *tabletmanagerdata.CommitCutoverRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CommitCutoverResponse, error) {
+	out := new(tabletmanagerdata.CommitCutoverResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/CommitCutover", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) SetMaster(ctx context.Context, in *tabletmanagerdata.SetMasterRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetMasterResponse, error) {
-	out := new(tabletmanagerdata.SetMasterResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetMaster", in, out, c.cc, opts...)
+func (c *tabletManagerClient) AbortCutover(ctx context.Context, in *tabletmanagerdata.AbortCutoverRequest, opts ...grpc.CallOption) (*tabletmanagerdata.AbortCutoverResponse, error) {
+	out := new(tabletmanagerdata.AbortCutoverResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/AbortCutover", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) SlaveWasRestarted(ctx context.Context, in *tabletmanagerdata.SlaveWasRestartedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SlaveWasRestartedResponse, error) {
-	out := new(tabletmanagerdata.SlaveWasRestartedResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SlaveWasRestarted", in, out, c.cc, opts...)
+func (c *tabletManagerClient) SetServingKeyRange(ctx context.Context, in *tabletmanagerdata.SetServingKeyRangeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetServingKeyRangeResponse, error) {
+	out := new(tabletmanagerdata.SetServingKeyRangeResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetServingKeyRange", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) StopReplicationAndGetStatus(ctx context.Context, in *tabletmanagerdata.StopReplicationAndGetStatusRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopReplicationAndGetStatusResponse, error) {
-	out := new(tabletmanagerdata.StopReplicationAndGetStatusResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StopReplicationAndGetStatus", in, out, c.cc, opts...)
+func (c *tabletManagerClient) RestartMysql(ctx context.Context, in *tabletmanagerdata.RestartMysqlRequest, opts ...grpc.CallOption) (TabletManager_RestartMysqlClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[1], c.cc, "/tabletmanagerservice.TabletManager/RestartMysql", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &tabletManagerRestartMysqlClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *tabletManagerClient) PromoteSlave(ctx context.Context, in *tabletmanagerdata.PromoteSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PromoteSlaveResponse, error) {
-	out := new(tabletmanagerdata.PromoteSlaveResponse)
-	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/PromoteSlave", in, out, c.cc, opts...)
+type TabletManager_RestartMysqlClient interface {
+	Recv() (*tabletmanagerdata.RestartMysqlResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerRestartMysqlClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerRestartMysqlClient) Recv() (*tabletmanagerdata.RestartMysqlResponse, error) {
+	m := new(tabletmanagerdata.RestartMysqlResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) Decommission(ctx context.Context, in *tabletmanagerdata.DecommissionRequest, opts ...grpc.CallOption) (TabletManager_DecommissionClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[2], c.cc, "/tabletmanagerservice.TabletManager/Decommission", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerDecommissionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_DecommissionClient interface {
+	Recv() (*tabletmanagerdata.DecommissionResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerDecommissionClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerDecommissionClient) Recv() (*tabletmanagerdata.DecommissionResponse, error) {
+	m := new(tabletmanagerdata.DecommissionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) CheckTopoConnectivity(ctx context.Context, in *tabletmanagerdata.CheckTopoConnectivityRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckTopoConnectivityResponse, error) {
+	out := new(tabletmanagerdata.CheckTopoConnectivityResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/CheckTopoConnectivity", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tabletManagerClient) Backup(ctx context.Context, in *tabletmanagerdata.BackupRequest, opts ...grpc.CallOption) (TabletManager_BackupClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[0], c.cc, "/tabletmanagerservice.TabletManager/Backup", opts...)
+func (c *tabletManagerClient) WarmUp(ctx context.Context, in *tabletmanagerdata.WarmUpRequest, opts ...grpc.CallOption) (TabletManager_WarmUpClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[3], c.cc, "/tabletmanagerservice.TabletManager/WarmUp", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &tabletManagerBackupClient{stream}
+	x := &tabletManagerWarmUpClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -529,29 +864,74 @@ func (c *tabletManagerClient) Backup(ctx context.Context, in *tabletmanagerdata.
 	return x, nil
 }
 
-type TabletManager_BackupClient interface {
-	Recv() (*tabletmanagerdata.BackupResponse, error)
+type TabletManager_WarmUpClient interface {
+	Recv() (*tabletmanagerdata.WarmUpResponse, error)
 	grpc.ClientStream
 }
 
-type tabletManagerBackupClient struct {
+type tabletManagerWarmUpClient struct {
 	grpc.ClientStream
 }
 
-func (x *tabletManagerBackupClient) Recv() (*tabletmanagerdata.BackupResponse, error) {
-	m := new(tabletmanagerdata.BackupResponse)
+func (x *tabletManagerWarmUpClient) Recv() (*tabletmanagerdata.WarmUpResponse, error) {
+	m := new(tabletmanagerdata.WarmUpResponse)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *tabletManagerClient) RestoreFromBackup(ctx context.Context, in *tabletmanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (TabletManager_RestoreFromBackupClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[1], c.cc, "/tabletmanagerservice.TabletManager/RestoreFromBackup", opts...)
+func (c *tabletManagerClient) ReloadSchema(ctx context.Context, in *tabletmanagerdata.ReloadSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReloadSchemaResponse, error) {
+	out := new(tabletmanagerdata.ReloadSchemaResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ReloadSchema", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &tabletManagerRestoreFromBackupClient{stream}
+	return out, nil
+}
+
+func (c *tabletManagerClient) PreflightSchema(ctx context.Context, in *tabletmanagerdata.PreflightSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PreflightSchemaResponse, error) {
+	out := new(tabletmanagerdata.PreflightSchemaResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/PreflightSchema", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ApplySchema(ctx context.Context, in *tabletmanagerdata.ApplySchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplySchemaResponse, error) {
+	out := new(tabletmanagerdata.ApplySchemaResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ApplySchema", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) SchemaDiff(ctx context.Context, in *tabletmanagerdata.SchemaDiffRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SchemaDiffResponse, error) {
+	out := new(tabletmanagerdata.SchemaDiffResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SchemaDiff", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) AssessSchemaChange(ctx context.Context, in *tabletmanagerdata.AssessSchemaChangeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.AssessSchemaChangeResponse, error) {
+	out := new(tabletmanagerdata.AssessSchemaChangeResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/AssessSchemaChange", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) WatchSchema(ctx context.Context, in *tabletmanagerdata.WatchSchemaRequest, opts ...grpc.CallOption) (TabletManager_WatchSchemaClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[4], c.cc, "/tabletmanagerservice.TabletManager/WatchSchema", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerWatchSchemaClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -561,24 +941,1039 @@ func (c *tabletManagerClient) RestoreFromBackup(ctx context.Context, in *tabletm
 	return x, nil
 }
 
-type TabletManager_RestoreFromBackupClient interface {
-	Recv() (*tabletmanagerdata.RestoreFromBackupResponse, error)
+type TabletManager_WatchSchemaClient interface {
+	Recv() (*tabletmanagerdata.WatchSchemaResponse, error)
 	grpc.ClientStream
 }
 
-type tabletManagerRestoreFromBackupClient struct {
+type tabletManagerWatchSchemaClient struct {
 	grpc.ClientStream
 }
 
-func (x *tabletManagerRestoreFromBackupClient) Recv() (*tabletmanagerdata.RestoreFromBackupResponse, error) {
-	m := new(tabletmanagerdata.RestoreFromBackupResponse)
+func (x *tabletManagerWatchSchemaClient) Recv() (*tabletmanagerdata.WatchSchemaResponse, error) {
+	m := new(tabletmanagerdata.WatchSchemaResponse)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-// Server API for TabletManager service
+func (c *tabletManagerClient) GetVSchema(ctx context.Context, in *tabletmanagerdata.GetVSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetVSchemaResponse, error) {
+	out := new(tabletmanagerdata.GetVSchemaResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetVSchema", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ApplyVSchema(ctx context.Context, in *tabletmanagerdata.ApplyVSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplyVSchemaResponse, error) {
+	out := new(tabletmanagerdata.ApplyVSchemaResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ApplyVSchema", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ExecuteFetchAsDba(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsDbaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsDbaResponse, error) {
+	out := new(tabletmanagerdata.ExecuteFetchAsDbaResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ExecuteFetchAsDba", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ExecuteFetchAsDbaCSV(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsDbaCSVRequest, opts ...grpc.CallOption) (TabletManager_ExecuteFetchAsDbaCSVClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[5], c.cc, "/tabletmanagerservice.TabletManager/ExecuteFetchAsDbaCSV", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerExecuteFetchAsDbaCSVClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_ExecuteFetchAsDbaCSVClient interface {
+	Recv() (*tabletmanagerdata.ExecuteFetchAsDbaCSVResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerExecuteFetchAsDbaCSVClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerExecuteFetchAsDbaCSVClient) Recv() (*tabletmanagerdata.ExecuteFetchAsDbaCSVResponse, error) {
+	m := new(tabletmanagerdata.ExecuteFetchAsDbaCSVResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) ExecuteFetchColumnar(ctx context.Context, in *tabletmanagerdata.ExecuteFetchColumnarRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchColumnarResponse, error) {
+	out := new(tabletmanagerdata.ExecuteFetchColumnarResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ExecuteFetchColumnar", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ExecuteFetchAsAllPrivs(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsAllPrivsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsAllPrivsResponse, error) {
+	out := new(tabletmanagerdata.ExecuteFetchAsAllPrivsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ExecuteFetchAsAllPrivs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ExecuteFetchAsApp(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsAppRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsAppResponse, error) {
+	out := new(tabletmanagerdata.ExecuteFetchAsAppResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ExecuteFetchAsApp", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ExplainQuery(ctx context.Context, in *tabletmanagerdata.ExplainQueryRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExplainQueryResponse, error) {
+	out := new(tabletmanagerdata.ExplainQueryResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ExplainQuery", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ApplyGrants(ctx context.Context, in *tabletmanagerdata.ApplyGrantsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplyGrantsResponse, error) {
+	out := new(tabletmanagerdata.ApplyGrantsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ApplyGrants", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ChecksumTable(ctx context.Context, in *tabletmanagerdata.ChecksumTableRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ChecksumTableResponse, error) {
+	out := new(tabletmanagerdata.ChecksumTableResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ChecksumTable", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) TruncateTable(ctx context.Context, in *tabletmanagerdata.TruncateTableRequest, opts ...grpc.CallOption) (*tabletmanagerdata.TruncateTableResponse, error) {
+	out := new(tabletmanagerdata.TruncateTableResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/TruncateTable", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) RenameTable(ctx context.Context, in *tabletmanagerdata.RenameTableRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RenameTableResponse, error) {
+	out := new(tabletmanagerdata.RenameTableResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RenameTable", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) StreamRowsInKeyRange(ctx context.Context, in *tabletmanagerdata.StreamRowsInKeyRangeRequest, opts ...grpc.CallOption) (TabletManager_StreamRowsInKeyRangeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[6], c.cc, "/tabletmanagerservice.TabletManager/StreamRowsInKeyRange", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerStreamRowsInKeyRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_StreamRowsInKeyRangeClient interface {
+	Recv() (*tabletmanagerdata.StreamRowsInKeyRangeResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerStreamRowsInKeyRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerStreamRowsInKeyRangeClient) Recv() (*tabletmanagerdata.StreamRowsInKeyRangeResponse, error) {
+	m := new(tabletmanagerdata.StreamRowsInKeyRangeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) StreamKeyRangeBinlog(ctx context.Context, in *tabletmanagerdata.StreamKeyRangeBinlogRequest, opts ...grpc.CallOption) (TabletManager_StreamKeyRangeBinlogClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[7], c.cc, "/tabletmanagerservice.TabletManager/StreamKeyRangeBinlog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerStreamKeyRangeBinlogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_StreamKeyRangeBinlogClient interface {
+	Recv() (*tabletmanagerdata.StreamKeyRangeBinlogResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerStreamKeyRangeBinlogClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerStreamKeyRangeBinlogClient) Recv() (*tabletmanagerdata.StreamKeyRangeBinlogResponse, error) {
+	m := new(tabletmanagerdata.StreamKeyRangeBinlogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) CloneStream(ctx context.Context, in *tabletmanagerdata.CloneStreamRequest, opts ...grpc.CallOption) (TabletManager_CloneStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[8], c.cc, "/tabletmanagerservice.TabletManager/CloneStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerCloneStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_CloneStreamClient interface {
+	Recv() (*tabletmanagerdata.CloneStreamResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerCloneStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerCloneStreamClient) Recv() (*tabletmanagerdata.CloneStreamResponse, error) {
+	m := new(tabletmanagerdata.CloneStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) GetCharsetConfig(ctx context.Context, in *tabletmanagerdata.GetCharsetConfigRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetCharsetConfigResponse, error) {
+	out := new(tabletmanagerdata.GetCharsetConfigResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetCharsetConfig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetProcessList(ctx context.Context, in *tabletmanagerdata.GetProcessListRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetProcessListResponse, error) {
+	out := new(tabletmanagerdata.GetProcessListResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetProcessList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) KillProcess(ctx context.Context, in *tabletmanagerdata.KillProcessRequest, opts ...grpc.CallOption) (*tabletmanagerdata.KillProcessResponse, error) {
+	out := new(tabletmanagerdata.KillProcessResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/KillProcess", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) TailGeneralLog(ctx context.Context, in *tabletmanagerdata.TailGeneralLogRequest, opts ...grpc.CallOption) (TabletManager_TailGeneralLogClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[9], c.cc, "/tabletmanagerservice.TabletManager/TailGeneralLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerTailGeneralLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_TailGeneralLogClient interface {
+	Recv() (*tabletmanagerdata.TailGeneralLogResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerTailGeneralLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerTailGeneralLogClient) Recv() (*tabletmanagerdata.TailGeneralLogResponse, error) {
+	m := new(tabletmanagerdata.TailGeneralLogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) StreamQPS(ctx context.Context, in *tabletmanagerdata.StreamQPSRequest, opts ...grpc.CallOption) (TabletManager_StreamQPSClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[10], c.cc, "/tabletmanagerservice.TabletManager/StreamQPS", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerStreamQPSClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_StreamQPSClient interface {
+	Recv() (*tabletmanagerdata.StreamQPSResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerStreamQPSClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerStreamQPSClient) Recv() (*tabletmanagerdata.StreamQPSResponse, error) {
+	m := new(tabletmanagerdata.StreamQPSResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) SlaveStatus(ctx context.Context, in *tabletmanagerdata.SlaveStatusRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SlaveStatusResponse, error) {
+	out := new(tabletmanagerdata.SlaveStatusResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SlaveStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) SlaveStatusAllChannels(ctx context.Context, in *tabletmanagerdata.SlaveStatusAllChannelsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SlaveStatusAllChannelsResponse, error) {
+	out := new(tabletmanagerdata.SlaveStatusAllChannelsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SlaveStatusAllChannels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetReplicationSource(ctx context.Context, in *tabletmanagerdata.GetReplicationSourceRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetReplicationSourceResponse, error) {
+	out := new(tabletmanagerdata.GetReplicationSourceResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetReplicationSource", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ValidateReplicationCredentials(ctx context.Context, in *tabletmanagerdata.ValidateReplicationCredentialsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ValidateReplicationCredentialsResponse, error) {
+	out := new(tabletmanagerdata.ValidateReplicationCredentialsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ValidateReplicationCredentials", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetApplierQueueStats(ctx context.Context, in *tabletmanagerdata.GetApplierQueueStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetApplierQueueStatsResponse, error) {
+	out := new(tabletmanagerdata.GetApplierQueueStatsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetApplierQueueStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) MasterPosition(ctx context.Context, in *tabletmanagerdata.MasterPositionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.MasterPositionResponse, error) {
+	out := new(tabletmanagerdata.MasterPositionResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/MasterPosition", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetGtidPurged(ctx context.Context, in *tabletmanagerdata.GetGtidPurgedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetGtidPurgedResponse, error) {
+	out := new(tabletmanagerdata.GetGtidPurgedResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetGtidPurged", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetBinlogStats(ctx context.Context, in *tabletmanagerdata.GetBinlogStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetBinlogStatsResponse, error) {
+	out := new(tabletmanagerdata.GetBinlogStatsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetBinlogStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetReplicationErrorStats(ctx context.Context, in *tabletmanagerdata.GetReplicationErrorStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetReplicationErrorStatsResponse, error) {
+	out := new(tabletmanagerdata.GetReplicationErrorStatsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetReplicationErrorStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) StopSlave(ctx context.Context, in *tabletmanagerdata.StopSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopSlaveResponse, error) {
+	out := new(tabletmanagerdata.StopSlaveResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StopSlave", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) StopSlaveMinimum(ctx context.Context, in *tabletmanagerdata.StopSlaveMinimumRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopSlaveMinimumResponse, error) {
+	out := new(tabletmanagerdata.StopSlaveMinimumResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StopSlaveMinimum", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) StopSlaveMinimumStream(ctx context.Context, in *tabletmanagerdata.StopSlaveMinimumStreamRequest, opts ...grpc.CallOption) (TabletManager_StopSlaveMinimumStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[11], c.cc, "/tabletmanagerservice.TabletManager/StopSlaveMinimumStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerStopSlaveMinimumStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_StopSlaveMinimumStreamClient interface {
+	Recv() (*tabletmanagerdata.StopSlaveMinimumStreamResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerStopSlaveMinimumStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerStopSlaveMinimumStreamClient) Recv() (*tabletmanagerdata.StopSlaveMinimumStreamResponse, error) {
+	m := new(tabletmanagerdata.StopSlaveMinimumStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) BoostReplicationCatchup(ctx context.Context, in *tabletmanagerdata.BoostReplicationCatchupRequest, opts ...grpc.CallOption) (TabletManager_BoostReplicationCatchupClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[12], c.cc, "/tabletmanagerservice.TabletManager/BoostReplicationCatchup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerBoostReplicationCatchupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_BoostReplicationCatchupClient interface {
+	Recv() (*tabletmanagerdata.BoostReplicationCatchupResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerBoostReplicationCatchupClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerBoostReplicationCatchupClient) Recv() (*tabletmanagerdata.BoostReplicationCatchupResponse, error) {
+	m := new(tabletmanagerdata.BoostReplicationCatchupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) StartSlave(ctx context.Context, in *tabletmanagerdata.StartSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StartSlaveResponse, error) {
+	out := new(tabletmanagerdata.StartSlaveResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StartSlave", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) EnableParallelReplication(ctx context.Context, in *tabletmanagerdata.EnableParallelReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.EnableParallelReplicationResponse, error) {
+	out := new(tabletmanagerdata.EnableParallelReplicationResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/EnableParallelReplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) RotateReplicationCredentials(ctx context.Context, in *tabletmanagerdata.RotateReplicationCredentialsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RotateReplicationCredentialsResponse, error) {
+	out := new(tabletmanagerdata.RotateReplicationCredentialsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RotateReplicationCredentials", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ConfigureReplicationSSL(ctx context.Context, in *tabletmanagerdata.ConfigureReplicationSSLRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ConfigureReplicationSSLResponse, error) {
+	out := new(tabletmanagerdata.ConfigureReplicationSSLResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ConfigureReplicationSSL", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) RepairRelayLog(ctx context.Context, in *tabletmanagerdata.RepairRelayLogRequest, opts ...grpc.CallOption) (TabletManager_RepairRelayLogClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[13], c.cc, "/tabletmanagerservice.TabletManager/RepairRelayLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerRepairRelayLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_RepairRelayLogClient interface {
+	Recv() (*tabletmanagerdata.RepairRelayLogResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerRepairRelayLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerRepairRelayLogClient) Recv() (*tabletmanagerdata.RepairRelayLogResponse, error) {
+	m := new(tabletmanagerdata.RepairRelayLogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) TabletExternallyReparented(ctx context.Context, in *tabletmanagerdata.TabletExternallyReparentedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.TabletExternallyReparentedResponse, error) {
+	out := new(tabletmanagerdata.TabletExternallyReparentedResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/TabletExternallyReparented", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) TabletExternallyElected(ctx context.Context, in *tabletmanagerdata.TabletExternallyElectedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.TabletExternallyElectedResponse, error) {
+	out := new(tabletmanagerdata.TabletExternallyElectedResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/TabletExternallyElected", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetSlaves(ctx context.Context, in *tabletmanagerdata.GetSlavesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSlavesResponse, error) {
+	out := new(tabletmanagerdata.GetSlavesResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetSlaves", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetReplicationGraph(ctx context.Context, in *tabletmanagerdata.GetReplicationGraphRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetReplicationGraphResponse, error) {
+	out := new(tabletmanagerdata.GetReplicationGraphResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetReplicationGraph", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) WaitBlpPosition(ctx context.Context, in *tabletmanagerdata.WaitBlpPositionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.WaitBlpPositionResponse, error) {
+	out := new(tabletmanagerdata.WaitBlpPositionResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/WaitBlpPosition", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) StopBlp(ctx context.Context, in *tabletmanagerdata.StopBlpRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopBlpResponse, error) {
+	out := new(tabletmanagerdata.StopBlpResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StopBlp", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) StartBlp(ctx context.Context, in *tabletmanagerdata.StartBlpRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StartBlpResponse, error) {
+	out := new(tabletmanagerdata.StartBlpResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StartBlp", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) RunBlpUntil(ctx context.Context, in *tabletmanagerdata.RunBlpUntilRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RunBlpUntilResponse, error) {
+	out := new(tabletmanagerdata.RunBlpUntilResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RunBlpUntil", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetBinlogFilters(ctx context.Context, in *tabletmanagerdata.GetBinlogFiltersRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetBinlogFiltersResponse, error) {
+	out := new(tabletmanagerdata.GetBinlogFiltersResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetBinlogFilters", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ResetReplication(ctx context.Context, in *tabletmanagerdata.ResetReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResetReplicationResponse, error) {
+	out := new(tabletmanagerdata.ResetReplicationResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ResetReplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) InitMaster(ctx context.Context, in *tabletmanagerdata.InitMasterRequest, opts ...grpc.CallOption) (*tabletmanagerdata.InitMasterResponse, error) {
+	out := new(tabletmanagerdata.InitMasterResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/InitMaster", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) PopulateReparentJournal(ctx context.Context, in *tabletmanagerdata.PopulateReparentJournalRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PopulateReparentJournalResponse, error) {
+	out := new(tabletmanagerdata.PopulateReparentJournalResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/PopulateReparentJournal", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetLastReparentJournalEntry(ctx context.Context, in *tabletmanagerdata.GetLastReparentJournalEntryRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetLastReparentJournalEntryResponse, error) {
+	out := new(tabletmanagerdata.GetLastReparentJournalEntryResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetLastReparentJournalEntry", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) InitSlave(ctx context.Context, in *tabletmanagerdata.InitSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.InitSlaveResponse, error) {
+	out := new(tabletmanagerdata.InitSlaveResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/InitSlave", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) DemoteMaster(ctx context.Context, in *tabletmanagerdata.DemoteMasterRequest, opts ...grpc.CallOption) (*tabletmanagerdata.DemoteMasterResponse, error) {
+	out := new(tabletmanagerdata.DemoteMasterResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/DemoteMaster", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) PromoteSlaveWhenCaughtUp(ctx context.Context, in *tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PromoteSlaveWhenCaughtUpResponse, error) {
+	out := new(tabletmanagerdata.PromoteSlaveWhenCaughtUpResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/PromoteSlaveWhenCaughtUp", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) SlaveWasPromoted(ctx context.Context, in *tabletmanagerdata.SlaveWasPromotedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SlaveWasPromotedResponse, error) {
+	out := new(tabletmanagerdata.SlaveWasPromotedResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SlaveWasPromoted", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) SetMaster(ctx context.Context, in *tabletmanagerdata.SetMasterRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetMasterResponse, error) {
+	out := new(tabletmanagerdata.SetMasterResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetMaster", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ConfigureReplication(ctx context.Context, in *tabletmanagerdata.ConfigureReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ConfigureReplicationResponse, error) {
+	out := new(tabletmanagerdata.ConfigureReplicationResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ConfigureReplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) SlaveWasRestarted(ctx context.Context, in *tabletmanagerdata.SlaveWasRestartedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SlaveWasRestartedResponse, error) {
+	out := new(tabletmanagerdata.SlaveWasRestartedResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SlaveWasRestarted", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) StopReplicationAndGetStatus(ctx context.Context, in *tabletmanagerdata.StopReplicationAndGetStatusRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopReplicationAndGetStatusResponse, error) {
+	out := new(tabletmanagerdata.StopReplicationAndGetStatusResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StopReplicationAndGetStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) PromoteSlave(ctx context.Context, in *tabletmanagerdata.PromoteSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PromoteSlaveResponse, error) {
+	out := new(tabletmanagerdata.PromoteSlaveResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/PromoteSlave", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) SetReparentEligibility(ctx context.Context, in *tabletmanagerdata.SetReparentEligibilityRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReparentEligibilityResponse, error) {
+	out := new(tabletmanagerdata.SetReparentEligibilityResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetReparentEligibility", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) CheckReparentCandidate(ctx context.Context, in *tabletmanagerdata.CheckReparentCandidateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckReparentCandidateResponse, error) {
+	out := new(tabletmanagerdata.CheckReparentCandidateResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/CheckReparentCandidate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) SetSemiSyncAckCount(ctx context.Context, in *tabletmanagerdata.SetSemiSyncAckCountRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetSemiSyncAckCountResponse, error) {
+	out := new(tabletmanagerdata.SetSemiSyncAckCountResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetSemiSyncAckCount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetDurabilityPolicy(ctx context.Context, in *tabletmanagerdata.GetDurabilityPolicyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetDurabilityPolicyResponse, error) {
+	out := new(tabletmanagerdata.GetDurabilityPolicyResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetDurabilityPolicy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetBackupLimits(ctx context.Context, in *tabletmanagerdata.GetBackupLimitsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetBackupLimitsResponse, error) {
+	out := new(tabletmanagerdata.GetBackupLimitsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetBackupLimits", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetBackupPosition(ctx context.Context, in *tabletmanagerdata.GetBackupPositionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetBackupPositionResponse, error) {
+	out := new(tabletmanagerdata.GetBackupPositionResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetBackupPosition", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) Backup(ctx context.Context, in *tabletmanagerdata.BackupRequest, opts ...grpc.CallOption) (TabletManager_BackupClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[14], c.cc, "/tabletmanagerservice.TabletManager/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_BackupClient interface {
+	Recv() (*tabletmanagerdata.BackupResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerBackupClient) Recv() (*tabletmanagerdata.BackupResponse, error) {
+	m := new(tabletmanagerdata.BackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) IncrementalBackup(ctx context.Context, in *tabletmanagerdata.IncrementalBackupRequest, opts ...grpc.CallOption) (TabletManager_IncrementalBackupClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[15], c.cc, "/tabletmanagerservice.TabletManager/IncrementalBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerIncrementalBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_IncrementalBackupClient interface {
+	Recv() (*tabletmanagerdata.IncrementalBackupResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerIncrementalBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerIncrementalBackupClient) Recv() (*tabletmanagerdata.IncrementalBackupResponse, error) {
+	m := new(tabletmanagerdata.IncrementalBackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) RestoreFromBackup(ctx context.Context, in *tabletmanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (TabletManager_RestoreFromBackupClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[16], c.cc, "/tabletmanagerservice.TabletManager/RestoreFromBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerRestoreFromBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_RestoreFromBackupClient interface {
+	Recv() (*tabletmanagerdata.RestoreFromBackupResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerRestoreFromBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerRestoreFromBackupClient) Recv() (*tabletmanagerdata.RestoreFromBackupResponse, error) {
+	m := new(tabletmanagerdata.RestoreFromBackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) RestoreToTimestamp(ctx context.Context, in *tabletmanagerdata.RestoreToTimestampRequest, opts ...grpc.CallOption) (TabletManager_RestoreToTimestampClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[17], c.cc, "/tabletmanagerservice.TabletManager/RestoreToTimestamp", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerRestoreToTimestampClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_RestoreToTimestampClient interface {
+	Recv() (*tabletmanagerdata.RestoreToTimestampResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerRestoreToTimestampClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerRestoreToTimestampClient) Recv() (*tabletmanagerdata.RestoreToTimestampResponse, error) {
+	m := new(tabletmanagerdata.RestoreToTimestampResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tabletManagerClient) SetPreferredBackup(ctx context.Context, in *tabletmanagerdata.SetPreferredBackupRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetPreferredBackupResponse, error) {
+	out := new(tabletmanagerdata.SetPreferredBackupResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetPreferredBackup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetPreferredBackup(ctx context.Context, in *tabletmanagerdata.GetPreferredBackupRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetPreferredBackupResponse, error) {
+	out := new(tabletmanagerdata.GetPreferredBackupResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetPreferredBackup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) CheckRestoreCompatibility(ctx context.Context, in *tabletmanagerdata.CheckRestoreCompatibilityRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckRestoreCompatibilityResponse, error) {
+	out := new(tabletmanagerdata.CheckRestoreCompatibilityResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/CheckRestoreCompatibility", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetLastBackupInfo(ctx context.Context, in *tabletmanagerdata.GetLastBackupInfoRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetLastBackupInfoResponse, error) {
+	out := new(tabletmanagerdata.GetLastBackupInfoResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetLastBackupInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetBackupFreshness(ctx context.Context, in *tabletmanagerdata.GetBackupFreshnessRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetBackupFreshnessResponse, error) {
+	out := new(tabletmanagerdata.GetBackupFreshnessResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetBackupFreshness", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) CheckBackupReadiness(ctx context.Context, in *tabletmanagerdata.CheckBackupReadinessRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckBackupReadinessResponse, error) {
+	out := new(tabletmanagerdata.CheckBackupReadinessResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/CheckBackupReadiness", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) TestRestore(ctx context.Context, in *tabletmanagerdata.TestRestoreRequest, opts ...grpc.CallOption) (TabletManager_TestRestoreClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TabletManager_serviceDesc.Streams[18], c.cc, "/tabletmanagerservice.TabletManager/TestRestore", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerTestRestoreClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_TestRestoreClient interface {
+	Recv() (*tabletmanagerdata.TestRestoreResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerTestRestoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerTestRestoreClient) Recv() (*tabletmanagerdata.TestRestoreResponse, error) {
+	m := new(tabletmanagerdata.TestRestoreResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for TabletManager service
 
 type TabletManagerServer interface {
 	// Ping returns the input payload
@@ -587,34 +1982,226 @@ type TabletManagerServer interface {
 	Sleep(context.Context, *tabletmanagerdata.SleepRequest) (*tabletmanagerdata.SleepResponse, error)
 	// ExecuteHook executes the hook remotely
 	ExecuteHook(context.Context, *tabletmanagerdata.ExecuteHookRequest) (*tabletmanagerdata.ExecuteHookResponse, error)
+	// ExecuteHookToStream executes the hook remotely, and streams its
+	// stdout as it is produced, then its exit status.
+	ExecuteHookToStream(*tabletmanagerdata.ExecuteHookToStreamRequest, TabletManager_ExecuteHookToStreamServer) error
 	// GetSchema asks the tablet for its schema
 	GetSchema(context.Context, *tabletmanagerdata.GetSchemaRequest) (*tabletmanagerdata.GetSchemaResponse, error)
+	// GetCreateStatements returns the raw CREATE statements of the
+	// tablet's tables and views
+	GetCreateStatements(context.Context, *tabletmanagerdata.GetCreateStatementsRequest) (*tabletmanagerdata.GetCreateStatementsResponse, error)
+	// GetSchemaTimestamps returns when the tablet's tables were last
+	// altered
+	GetSchemaTimestamps(context.Context, *tabletmanagerdata.GetSchemaTimestampsRequest) (*tabletmanagerdata.GetSchemaTimestampsResponse, error)
 	// GetPermissions asks the tablet for its permissions
 	GetPermissions(context.Context, *tabletmanagerdata.GetPermissionsRequest) (*tabletmanagerdata.GetPermissionsResponse, error)
+	// GetConnectionStats returns the current connection and transaction
+	// counts of the tablet's query service
+	GetConnectionStats(context.Context, *tabletmanagerdata.GetConnectionStatsRequest) (*tabletmanagerdata.GetConnectionStatsResponse, error)
+	// GetConnectionRejectionStats returns the connection requests the
+	// query service rejected since the last reset, by reason
+	GetConnectionRejectionStats(context.Context, *tabletmanagerdata.GetConnectionRejectionStatsRequest) (*tabletmanagerdata.GetConnectionRejectionStatsResponse, error)
+	// GetConfig returns the effective values of the tablet command line flags
+	GetConfig(context.Context, *tabletmanagerdata.GetConfigRequest) (*tabletmanagerdata.GetConfigResponse, error)
+	// GetInFlightRPCs returns the number of RPCs the tablet manager is
+	// processing, by method
+	GetInFlightRPCs(context.Context, *tabletmanagerdata.GetInFlightRPCsRequest) (*tabletmanagerdata.GetInFlightRPCsResponse, error)
+	// GetProcessStats returns the goroutine, thread, open file and
+	// memory usage of the tablet manager process
+	GetProcessStats(context.Context, *tabletmanagerdata.GetProcessStatsRequest) (*tabletmanagerdata.GetProcessStatsResponse, error)
+	// GetHealthScore returns a composite health score of the tablet,
+	// combining replication lag, error rate and load
+	GetHealthScore(context.Context, *tabletmanagerdata.GetHealthScoreRequest) (*tabletmanagerdata.GetHealthScoreResponse, error)
+	// GetErrorLogTail returns the last lines of the MySQL error log
+	GetErrorLogTail(context.Context, *tabletmanagerdata.GetErrorLogTailRequest) (*tabletmanagerdata.GetErrorLogTailResponse, error)
+	// CaptureDiagnostics returns the processlist, slave status, error
+	// log tail, key variables and disk stats of the tablet at once.
+	CaptureDiagnostics(context.Context, *tabletmanagerdata.CaptureDiagnosticsRequest) (*tabletmanagerdata.CaptureDiagnosticsResponse, error)
+	// CheckDataDir returns the problems with the ownership and the
+	// permissions of the directories mysqld needs
+	CheckDataDir(context.Context, *tabletmanagerdata.CheckDataDirRequest) (*tabletmanagerdata.CheckDataDirResponse, error)
+	// GetThrottleState returns whether the heavy operations of the
+	// tablet are throttled, and why
+	GetThrottleState(context.Context, *tabletmanagerdata.GetThrottleStateRequest) (*tabletmanagerdata.GetThrottleStateResponse, error)
+	// GetTabletTags returns the tags of the tablet record
+	GetTabletTags(context.Context, *tabletmanagerdata.GetTabletTagsRequest) (*tabletmanagerdata.GetTabletTagsResponse, error)
 	SetReadOnly(context.Context, *tabletmanagerdata.SetReadOnlyRequest) (*tabletmanagerdata.SetReadOnlyResponse, error)
 	SetReadWrite(context.Context, *tabletmanagerdata.SetReadWriteRequest) (*tabletmanagerdata.SetReadWriteResponse, error)
+	// SetReadOnlyWithTTL makes the tablet read-only for a while, after
+	// which it goes back to read-write on its own
+	SetReadOnlyWithTTL(context.Context, *tabletmanagerdata.SetReadOnlyWithTTLRequest) (*tabletmanagerdata.SetReadOnlyWithTTLResponse, error)
+	// SetSuperReadOnly sets or clears super_read_only, which also
+	// prevents SUPER users from writing
+	SetSuperReadOnly(context.Context, *tabletmanagerdata.SetSuperReadOnlyRequest) (*tabletmanagerdata.SetSuperReadOnlyResponse, error)
+	// SetQueryServerConfig changes the pool sizes, query timeout and
+	// consolidation of the query server without a restart.
+	SetQueryServerConfig(context.Context, *tabletmanagerdata.SetQueryServerConfigRequest) (*tabletmanagerdata.SetQueryServerConfigResponse, error)
+	// GetQueryBlacklist returns the query patterns the tablet rejects
+	GetQueryBlacklist(context.Context, *tabletmanagerdata.GetQueryBlacklistRequest) (*tabletmanagerdata.GetQueryBlacklistResponse, error)
+	// SetQueryBlacklist replaces the query patterns the tablet rejects
+	SetQueryBlacklist(context.Context, *tabletmanagerdata.SetQueryBlacklistRequest) (*tabletmanagerdata.SetQueryBlacklistResponse, error)
 	// ChangeType asks the remote tablet to change its type
 	ChangeType(context.Context, *tabletmanagerdata.ChangeTypeRequest) (*tabletmanagerdata.ChangeTypeResponse, error)
+	// SetTabletTags merges tags into the tags of the tablet record
+	SetTabletTags(context.Context, *tabletmanagerdata.SetTabletTagsRequest) (*tabletmanagerdata.SetTabletTagsResponse, error)
 	RefreshState(context.Context, *tabletmanagerdata.RefreshStateRequest) (*tabletmanagerdata.RefreshStateResponse, error)
+	// RepublishTopoRecord rewrites the tablet record in the topology
+	// from the tablet's in-memory copy.
+	RepublishTopoRecord(context.Context, *tabletmanagerdata.RepublishTopoRecordRequest) (*tabletmanagerdata.RepublishTopoRecordResponse, error)
 	RunHealthCheck(context.Context, *tabletmanagerdata.RunHealthCheckRequest) (*tabletmanagerdata.RunHealthCheckResponse, error)
 	IgnoreHealthError(context.Context, *tabletmanagerdata.IgnoreHealthErrorRequest) (*tabletmanagerdata.IgnoreHealthErrorResponse, error)
+	// SetMaintenanceMode sets or clears a maintenance marker, that
+	// survives tablet restarts
+	SetMaintenanceMode(context.Context, *tabletmanagerdata.SetMaintenanceModeRequest) (*tabletmanagerdata.SetMaintenanceModeResponse, error)
+	// PauseHealthReporting pauses or resumes the publication of the
+	// tablet health, which resumes on its own after a maximum duration
+	PauseHealthReporting(context.Context, *tabletmanagerdata.PauseHealthReportingRequest) (*tabletmanagerdata.PauseHealthReportingResponse, error)
+	// SetThrottle forces the heavy operations of the tablet to be
+	// throttled or not, for a while, or goes back to throttling them
+	// on replication lag
+	SetThrottle(context.Context, *tabletmanagerdata.SetThrottleRequest) (*tabletmanagerdata.SetThrottleResponse, error)
+	// PrepareCutover makes the tablet read-only, as the first phase of
+	// a cutover. The cutover aborts on its own if neither committed nor
+	// aborted in time.
+	PrepareCutover(context.Context, *tabletmanagerdata.PrepareCutoverRequest) (*tabletmanagerdata.PrepareCutoverResponse, error)
+	// CommitCutover completes a prepared cutover, applying the serving
+	// state saved in the topology
+	CommitCutover(context.Context, *tabletmanagerdata.CommitCutoverRequest) (*tabletmanagerdata.CommitCutoverResponse, error)
+	// AbortCutover cancels a prepared cutover, restoring the read-only
+	// state the tablet had before
+	AbortCutover(context.Context, *tabletmanagerdata.AbortCutoverRequest) (*tabletmanagerdata.AbortCutoverResponse, error)
+	// SetServingKeyRange atomically replaces the keyrange the tablet
+	// serves, and the tablet types it serves it for
+	SetServingKeyRange(context.Context, *tabletmanagerdata.SetServingKeyRangeRequest) (*tabletmanagerdata.SetServingKeyRangeResponse, error)
+	// RestartMysql drains the tablet, restarts mysqld, and waits for it
+	// to be healthy before serving again. It streams its progress.
+	RestartMysql(*tabletmanagerdata.RestartMysqlRequest, TabletManager_RestartMysqlServer) error
+	// Decommission drains a non-master tablet, changes its type to
+	// SPARE, and optionally stops replication and mysqld. It streams
+	// its progress.
+	Decommission(*tabletmanagerdata.DecommissionRequest, TabletManager_DecommissionServer) error
+	// CheckTopoConnectivity returns whether the tablet can read from
+	// the topo server, and how long it took.
+	CheckTopoConnectivity(context.Context, *tabletmanagerdata.CheckTopoConnectivityRequest) (*tabletmanagerdata.CheckTopoConnectivityResponse, error)
+	// WarmUp fills the caches of the tablet before it serves, and
+	// streams its progress.
+	WarmUp(*tabletmanagerdata.WarmUpRequest, TabletManager_WarmUpServer) error
 	ReloadSchema(context.Context, *tabletmanagerdata.ReloadSchemaRequest) (*tabletmanagerdata.ReloadSchemaResponse, error)
 	PreflightSchema(context.Context, *tabletmanagerdata.PreflightSchemaRequest) (*tabletmanagerdata.PreflightSchemaResponse, error)
 	ApplySchema(context.Context, *tabletmanagerdata.ApplySchemaRequest) (*tabletmanagerdata.ApplySchemaResponse, error)
+	// SchemaDiff returns the DDL statements that would turn the schema
+	// of the tablet into the provided one.
+	SchemaDiff(context.Context, *tabletmanagerdata.SchemaDiffRequest) (*tabletmanagerdata.SchemaDiffResponse, error)
+	// AssessSchemaChange estimates how disruptive an ALTER TABLE would
+	// be on the live table, without running it.
+	AssessSchemaChange(context.Context, *tabletmanagerdata.AssessSchemaChangeRequest) (*tabletmanagerdata.AssessSchemaChangeResponse, error)
+	// WatchSchema streams the changes to the schema of the tablet,
+	// as its query service sees them.
+	WatchSchema(*tabletmanagerdata.WatchSchemaRequest, TabletManager_WatchSchemaServer) error
+	// GetVSchema returns the VSchema of the tablet keyspace.
+	GetVSchema(context.Context, *tabletmanagerdata.GetVSchemaRequest) (*tabletmanagerdata.GetVSchemaResponse, error)
+	// ApplyVSchema saves the VSchema of the tablet keyspace and
+	// rebuilds the SrvVSchema of the tablet cell, for local testing.
+	ApplyVSchema(context.Context, *tabletmanagerdata.ApplyVSchemaRequest) (*tabletmanagerdata.ApplyVSchemaResponse, error)
 	ExecuteFetchAsDba(context.Context, *tabletmanagerdata.ExecuteFetchAsDbaRequest) (*tabletmanagerdata.ExecuteFetchAsDbaResponse, error)
+	// ExecuteFetchAsDbaCSV streams the result of a query run with the
+	// DBA user, encoded as CSV.
+	ExecuteFetchAsDbaCSV(*tabletmanagerdata.ExecuteFetchAsDbaCSVRequest, TabletManager_ExecuteFetchAsDbaCSVServer) error
+	// ExecuteFetchColumnar runs a query with the DBA user, and returns
+	// the result in columnar form
+	ExecuteFetchColumnar(context.Context, *tabletmanagerdata.ExecuteFetchColumnarRequest) (*tabletmanagerdata.ExecuteFetchColumnarResponse, error)
 	ExecuteFetchAsAllPrivs(context.Context, *tabletmanagerdata.ExecuteFetchAsAllPrivsRequest) (*tabletmanagerdata.ExecuteFetchAsAllPrivsResponse, error)
 	ExecuteFetchAsApp(context.Context, *tabletmanagerdata.ExecuteFetchAsAppRequest) (*tabletmanagerdata.ExecuteFetchAsAppResponse, error)
+	// ExplainQuery returns the MySQL execution plan of a query,
+	// without running it
+	ExplainQuery(context.Context, *tabletmanagerdata.ExplainQueryRequest) (*tabletmanagerdata.ExplainQueryResponse, error)
+	// ApplyGrants runs a batch of GRANT and REVOKE statements,
+	// optionally restoring the previous grants if one fails
+	ApplyGrants(context.Context, *tabletmanagerdata.ApplyGrantsRequest) (*tabletmanagerdata.ApplyGrantsResponse, error)
+	// ChecksumTable returns an order independent checksum of the rows
+	// of a table, optionally restricted to a key range
+	ChecksumTable(context.Context, *tabletmanagerdata.ChecksumTableRequest) (*tabletmanagerdata.ChecksumTableResponse, error)
+	// TruncateTable truncates a table, if the caller confirmed the
+	// keyspace of the tablet
+	TruncateTable(context.Context, *tabletmanagerdata.TruncateTableRequest) (*tabletmanagerdata.TruncateTableResponse, error)
+	// RenameTable renames a table, unless views or foreign keys depend
+	// on it and the caller did not ask to cascade
+	RenameTable(context.Context, *tabletmanagerdata.RenameTableRequest) (*tabletmanagerdata.RenameTableResponse, error)
+	// StreamRowsInKeyRange streams the rows of a table in primary key
+	// order, optionally restricted to a key range
+	StreamRowsInKeyRange(*tabletmanagerdata.StreamRowsInKeyRangeRequest, TabletManager_StreamRowsInKeyRangeServer) error
+	// StreamKeyRangeBinlog streams the binlog transactions touching
+	// rows in a key range, from a replication position
+	StreamKeyRangeBinlog(*tabletmanagerdata.StreamKeyRangeBinlogRequest, TabletManager_StreamKeyRangeBinlogServer) error
+	// CloneStream streams the replication position of a consistent
+	// snapshot, then the rows of the tables in that snapshot
+	CloneStream(*tabletmanagerdata.CloneStreamRequest, TabletManager_CloneStreamServer) error
+	// GetCharsetConfig returns the server and database default
+	// character sets and collations.
+	GetCharsetConfig(context.Context, *tabletmanagerdata.GetCharsetConfigRequest) (*tabletmanagerdata.GetCharsetConfigResponse, error)
+	// GetProcessList returns the threads currently running in MySQL.
+	GetProcessList(context.Context, *tabletmanagerdata.GetProcessListRequest) (*tabletmanagerdata.GetProcessListResponse, error)
+	// KillProcess kills a MySQL connection by ID. It refuses to kill
+	// system and replication threads.
+	KillProcess(context.Context, *tabletmanagerdata.KillProcessRequest) (*tabletmanagerdata.KillProcessResponse, error)
+	// TailGeneralLog enables the MySQL general log for a while, and
+	// streams its entries. The log is disabled again when the stream ends.
+	TailGeneralLog(*tabletmanagerdata.TailGeneralLogRequest, TabletManager_TailGeneralLogServer) error
+	// StreamQPS streams the read, write and total query rates of the
+	// tablet. Samples are dropped, not queued, if the client is slow.
+	StreamQPS(*tabletmanagerdata.StreamQPSRequest, TabletManager_StreamQPSServer) error
 	// SlaveStatus returns the current slave status.
 	SlaveStatus(context.Context, *tabletmanagerdata.SlaveStatusRequest) (*tabletmanagerdata.SlaveStatusResponse, error)
+	// SlaveStatusAllChannels returns the slave status of each
+	// replication channel, for multi-source replication.
+	SlaveStatusAllChannels(context.Context, *tabletmanagerdata.SlaveStatusAllChannelsRequest) (*tabletmanagerdata.SlaveStatusAllChannelsResponse, error)
+	// GetReplicationSource returns the master host, port and
+	// replication user of the slave, without the password.
+	GetReplicationSource(context.Context, *tabletmanagerdata.GetReplicationSourceRequest) (*tabletmanagerdata.GetReplicationSourceResponse, error)
+	// ValidateReplicationCredentials checks the slave can still log in
+	// to its master with its replication credentials.
+	ValidateReplicationCredentials(context.Context, *tabletmanagerdata.ValidateReplicationCredentialsRequest) (*tabletmanagerdata.ValidateReplicationCredentialsResponse, error)
+	// GetApplierQueueStats returns the relay log backlog and the state
+	// of the replication applier threads
+	GetApplierQueueStats(context.Context, *tabletmanagerdata.GetApplierQueueStatsRequest) (*tabletmanagerdata.GetApplierQueueStatsResponse, error)
 	// MasterPosition returns the current master position
 	MasterPosition(context.Context, *tabletmanagerdata.MasterPositionRequest) (*tabletmanagerdata.MasterPositionResponse, error)
+	// GetGtidPurged returns the set of transactions purged from the
+	// binary logs, that can't be replicated from this tablet anymore
+	GetGtidPurged(context.Context, *tabletmanagerdata.GetGtidPurgedRequest) (*tabletmanagerdata.GetGtidPurgedResponse, error)
+	// GetBinlogStats returns the recent rate of transactions written
+	// to (master) or applied from (slave) the binary logs
+	GetBinlogStats(context.Context, *tabletmanagerdata.GetBinlogStatsRequest) (*tabletmanagerdata.GetBinlogStatsResponse, error)
+	// GetReplicationErrorStats returns the number of replication errors
+	// and reconnects since the counts were last reset
+	GetReplicationErrorStats(context.Context, *tabletmanagerdata.GetReplicationErrorStatsRequest) (*tabletmanagerdata.GetReplicationErrorStatsResponse, error)
 	// StopSlave makes mysql stop its replication
 	StopSlave(context.Context, *tabletmanagerdata.StopSlaveRequest) (*tabletmanagerdata.StopSlaveResponse, error)
 	// StopSlaveMinimum stops the mysql replication after it reaches
 	// the provided minimum point
 	StopSlaveMinimum(context.Context, *tabletmanagerdata.StopSlaveMinimumRequest) (*tabletmanagerdata.StopSlaveMinimumResponse, error)
+	// StopSlaveMinimumStream is like StopSlaveMinimum, but it streams the
+	// slave position while waiting
+	StopSlaveMinimumStream(*tabletmanagerdata.StopSlaveMinimumStreamRequest, TabletManager_StopSlaveMinimumStreamServer) error
+	// BoostReplicationCatchup raises the MySQL settings limiting how fast
+	// the slave applies its relay logs for a while, and streams the
+	// replication lag
+	BoostReplicationCatchup(*tabletmanagerdata.BoostReplicationCatchupRequest, TabletManager_BoostReplicationCatchupServer) error
 	// StartSlave starts the mysql replication
 	StartSlave(context.Context, *tabletmanagerdata.StartSlaveRequest) (*tabletmanagerdata.StartSlaveResponse, error)
+	// EnableParallelReplication restarts the SQL thread with the given
+	// parallel replication settings, and rolls back if it does not resume.
+	EnableParallelReplication(context.Context, *tabletmanagerdata.EnableParallelReplicationRequest) (*tabletmanagerdata.EnableParallelReplicationResponse, error)
+	// RotateReplicationCredentials changes the credentials the slave
+	// connects to its master with, and rolls back if the IO thread
+	// does not resume with them.
+	RotateReplicationCredentials(context.Context, *tabletmanagerdata.RotateReplicationCredentialsRequest) (*tabletmanagerdata.RotateReplicationCredentialsResponse, error)
+	// ConfigureReplicationSSL makes the slave connect to its master
+	// with SSL, and rolls back if the IO thread does not resume with it.
+	ConfigureReplicationSSL(context.Context, *tabletmanagerdata.ConfigureReplicationSSLRequest) (*tabletmanagerdata.ConfigureReplicationSSLResponse, error)
+	// RepairRelayLog discards the relay logs of the slave, and fetches
+	// them again from the master, from the last applied position
+	RepairRelayLog(*tabletmanagerdata.RepairRelayLogRequest, TabletManager_RepairRelayLogServer) error
 	// TabletExternallyReparented tells a tablet that its underlying MySQL is
 	// currently the master. It is only used in environments (tabletmanagerdata.such as Vitess+MoB)
 	// in which MySQL is reparented by some agent external to Vitess, and then
@@ -643,6 +2230,9 @@ type TabletManagerServer interface {
 	TabletExternallyElected(context.Context, *tabletmanagerdata.TabletExternallyElectedRequest) (*tabletmanagerdata.TabletExternallyElectedResponse, error)
 	// GetSlaves asks for the list of mysql slaves
 	GetSlaves(context.Context, *tabletmanagerdata.GetSlavesRequest) (*tabletmanagerdata.GetSlavesResponse, error)
+	// GetReplicationGraph returns the master and the slaves of the
+	// tablet, as its mysql sees them
+	GetReplicationGraph(context.Context, *tabletmanagerdata.GetReplicationGraphRequest) (*tabletmanagerdata.GetReplicationGraphResponse, error)
 	// WaitBlpPosition tells the remote tablet to wait until it reaches
 	// the specified binolg player position
 	WaitBlpPosition(context.Context, *tabletmanagerdata.WaitBlpPositionRequest) (*tabletmanagerdata.WaitBlpPositionResponse, error)
@@ -653,6 +2243,9 @@ type TabletManagerServer interface {
 	StartBlp(context.Context, *tabletmanagerdata.StartBlpRequest) (*tabletmanagerdata.StartBlpResponse, error)
 	// RunBlpUntil asks the tablet to restart its binlog players
 	RunBlpUntil(context.Context, *tabletmanagerdata.RunBlpUntilRequest) (*tabletmanagerdata.RunBlpUntilResponse, error)
+	// GetBinlogFilters returns the filters the binlog players of the
+	// tablet apply.
+	GetBinlogFilters(context.Context, *tabletmanagerdata.GetBinlogFiltersRequest) (*tabletmanagerdata.GetBinlogFiltersResponse, error)
 	// ResetReplication makes the target not replicating
 	ResetReplication(context.Context, *tabletmanagerdata.ResetReplicationRequest) (*tabletmanagerdata.ResetReplicationResponse, error)
 	// InitMaster initializes the tablet as a master
@@ -660,6 +2253,9 @@ type TabletManagerServer interface {
 	// PopulateReparentJournal tells the tablet to add an entry to its
 	// reparent journal
 	PopulateReparentJournal(context.Context, *tabletmanagerdata.PopulateReparentJournalRequest) (*tabletmanagerdata.PopulateReparentJournalResponse, error)
+	// GetLastReparentJournalEntry returns the most recent entry of the
+	// tablet reparent journal
+	GetLastReparentJournalEntry(context.Context, *tabletmanagerdata.GetLastReparentJournalEntryRequest) (*tabletmanagerdata.GetLastReparentJournalEntryResponse, error)
 	// InitSlave tells the tablet to reparent to the master unconditionnally
 	InitSlave(context.Context, *tabletmanagerdata.InitSlaveRequest) (*tabletmanagerdata.InitSlaveResponse, error)
 	// DemoteMaster tells the soon-to-be-former master it's gonna change
@@ -671,6 +2267,9 @@ type TabletManagerServer interface {
 	SlaveWasPromoted(context.Context, *tabletmanagerdata.SlaveWasPromotedRequest) (*tabletmanagerdata.SlaveWasPromotedResponse, error)
 	// SetMaster tells the slave to reparent
 	SetMaster(context.Context, *tabletmanagerdata.SetMasterRequest) (*tabletmanagerdata.SetMasterResponse, error)
+	// ConfigureReplication tells the slave to reparent, either with
+	// GTID auto-position or from an explicit binlog file and position
+	ConfigureReplication(context.Context, *tabletmanagerdata.ConfigureReplicationRequest) (*tabletmanagerdata.ConfigureReplicationResponse, error)
 	// SlaveWasRestarted tells the remote tablet its master has changed
 	SlaveWasRestarted(context.Context, *tabletmanagerdata.SlaveWasRestartedRequest) (*tabletmanagerdata.SlaveWasRestartedResponse, error)
 	// StopReplicationAndGetStatus stops MySQL replication, and returns the
@@ -678,774 +2277,2381 @@ type TabletManagerServer interface {
 	StopReplicationAndGetStatus(context.Context, *tabletmanagerdata.StopReplicationAndGetStatusRequest) (*tabletmanagerdata.StopReplicationAndGetStatusResponse, error)
 	// PromoteSlave makes the slave the new master
 	PromoteSlave(context.Context, *tabletmanagerdata.PromoteSlaveRequest) (*tabletmanagerdata.PromoteSlaveResponse, error)
+	// SetReparentEligibility marks the tablet as eligible or not to be
+	// chosen as the new master by reparent tools
+	SetReparentEligibility(context.Context, *tabletmanagerdata.SetReparentEligibilityRequest) (*tabletmanagerdata.SetReparentEligibilityResponse, error)
+	// CheckReparentCandidate returns whether the tablet can be chosen
+	// as the new master by reparent tools
+	CheckReparentCandidate(context.Context, *tabletmanagerdata.CheckReparentCandidateRequest) (*tabletmanagerdata.CheckReparentCandidateResponse, error)
+	// SetSemiSyncAckCount sets the number of semi-sync slaves the master
+	// waits for, if that many are connected
+	SetSemiSyncAckCount(context.Context, *tabletmanagerdata.SetSemiSyncAckCountRequest) (*tabletmanagerdata.SetSemiSyncAckCountResponse, error)
+	// GetDurabilityPolicy returns the semi-sync policy the tablet
+	// enforces
+	GetDurabilityPolicy(context.Context, *tabletmanagerdata.GetDurabilityPolicyRequest) (*tabletmanagerdata.GetDurabilityPolicyResponse, error)
+	// GetBackupLimits returns the default and maximum backup concurrency
+	GetBackupLimits(context.Context, *tabletmanagerdata.GetBackupLimitsRequest) (*tabletmanagerdata.GetBackupLimitsResponse, error)
+	// GetBackupPosition returns the replication position a backup
+	// taken now would capture, without side effects
+	GetBackupPosition(context.Context, *tabletmanagerdata.GetBackupPositionRequest) (*tabletmanagerdata.GetBackupPositionResponse, error)
 	Backup(*tabletmanagerdata.BackupRequest, TabletManager_BackupServer) error
+	// IncrementalBackup backs up the binlogs since the position of a
+	// prior backup
+	IncrementalBackup(*tabletmanagerdata.IncrementalBackupRequest, TabletManager_IncrementalBackupServer) error
 	// RestoreFromBackup deletes all local data and restores it from the latest backup.
 	RestoreFromBackup(*tabletmanagerdata.RestoreFromBackupRequest, TabletManager_RestoreFromBackupServer) error
+	// RestoreToTimestamp deletes all local data, restores it from the
+	// named backup, and applies the master binlogs up to a target time.
+	RestoreToTimestamp(*tabletmanagerdata.RestoreToTimestampRequest, TabletManager_RestoreToTimestampServer) error
+	// SetPreferredBackup records the backup new tablets of the shard
+	// restore from, instead of the latest one.
+	SetPreferredBackup(context.Context, *tabletmanagerdata.SetPreferredBackupRequest) (*tabletmanagerdata.SetPreferredBackupResponse, error)
+	// GetPreferredBackup returns the preferred backup of the shard.
+	GetPreferredBackup(context.Context, *tabletmanagerdata.GetPreferredBackupRequest) (*tabletmanagerdata.GetPreferredBackupResponse, error)
+	// CheckRestoreCompatibility compares the mysqld settings recorded
+	// in a backup of the shard with the tablet's, without restoring it.
+	CheckRestoreCompatibility(context.Context, *tabletmanagerdata.CheckRestoreCompatibilityRequest) (*tabletmanagerdata.CheckRestoreCompatibilityResponse, error)
+	// GetLastBackupInfo returns the size, duration and outcome of the
+	// last backup taken by the tablet
+	GetLastBackupInfo(context.Context, *tabletmanagerdata.GetLastBackupInfoRequest) (*tabletmanagerdata.GetLastBackupInfoResponse, error)
+	// GetBackupFreshness returns the most recent complete backup of the
+	// shard of the tablet, and how long ago it was taken
+	GetBackupFreshness(context.Context, *tabletmanagerdata.GetBackupFreshnessRequest) (*tabletmanagerdata.GetBackupFreshnessResponse, error)
+	// CheckBackupReadiness checks the tablet is not running DDL nor
+	// restoring, and replicates and serves fine, so it can take a
+	// consistent backup
+	CheckBackupReadiness(context.Context, *tabletmanagerdata.CheckBackupReadinessRequest) (*tabletmanagerdata.CheckBackupReadinessResponse, error)
+	// TestRestore restores a backup of the shard into a scratch mysqld
+	// on the tablet host and checks it, without touching the tablet.
+	TestRestore(*tabletmanagerdata.TestRestoreRequest, TabletManager_TestRestoreServer) error
+}
+
+func RegisterTabletManagerServer(s *grpc.Server, srv TabletManagerServer) {
+	s.RegisterService(&_TabletManager_serviceDesc, srv)
+}
+
+func _TabletManager_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).Ping(ctx, req.(*tabletmanagerdata.PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_Sleep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SleepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).Sleep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/Sleep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).Sleep(ctx, req.(*tabletmanagerdata.SleepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ExecuteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ExecuteHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ExecuteHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ExecuteHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ExecuteHook(ctx, req.(*tabletmanagerdata.ExecuteHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ExecuteHookToStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.ExecuteHookToStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).ExecuteHookToStream(m, &tabletManagerExecuteHookToStreamServer{stream})
+}
+
+type TabletManager_ExecuteHookToStreamServer interface {
+	Send(*tabletmanagerdata.ExecuteHookToStreamResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerExecuteHookToStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerExecuteHookToStreamServer) Send(m *tabletmanagerdata.ExecuteHookToStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetSchema(ctx, req.(*tabletmanagerdata.GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetCreateStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetCreateStatementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetCreateStatements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetCreateStatements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetCreateStatements(ctx, req.(*tabletmanagerdata.GetCreateStatementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetSchemaTimestamps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetSchemaTimestampsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetSchemaTimestamps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetSchemaTimestamps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetSchemaTimestamps(ctx, req.(*tabletmanagerdata.GetSchemaTimestampsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetPermissions(ctx, req.(*tabletmanagerdata.GetPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetConnectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetConnectionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetConnectionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetConnectionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetConnectionStats(ctx, req.(*tabletmanagerdata.GetConnectionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetConnectionRejectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetConnectionRejectionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetConnectionRejectionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetConnectionRejectionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetConnectionRejectionStats(ctx, req.(*tabletmanagerdata.GetConnectionRejectionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetConfig(ctx, req.(*tabletmanagerdata.GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetInFlightRPCs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetInFlightRPCsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetInFlightRPCs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetInFlightRPCs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetInFlightRPCs(ctx, req.(*tabletmanagerdata.GetInFlightRPCsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetProcessStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetProcessStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetProcessStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetProcessStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetProcessStats(ctx, req.(*tabletmanagerdata.GetProcessStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetHealthScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetHealthScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetHealthScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetHealthScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetHealthScore(ctx, req.(*tabletmanagerdata.GetHealthScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetErrorLogTail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetErrorLogTailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetErrorLogTail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetErrorLogTail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetErrorLogTail(ctx, req.(*tabletmanagerdata.GetErrorLogTailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_CaptureDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.CaptureDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).CaptureDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/CaptureDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).CaptureDiagnostics(ctx, req.(*tabletmanagerdata.CaptureDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_CheckDataDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.CheckDataDirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).CheckDataDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/CheckDataDir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).CheckDataDir(ctx, req.(*tabletmanagerdata.CheckDataDirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetThrottleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetThrottleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetThrottleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetThrottleState(ctx, req.(*tabletmanagerdata.GetThrottleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetTabletTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetTabletTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetTabletTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetTabletTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetTabletTags(ctx, req.(*tabletmanagerdata.GetTabletTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SetReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetReadOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetReadOnly(ctx, req.(*tabletmanagerdata.SetReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SetReadWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetReadWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetReadWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetReadWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetReadWrite(ctx, req.(*tabletmanagerdata.SetReadWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SetReadOnlyWithTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetReadOnlyWithTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetReadOnlyWithTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetReadOnlyWithTTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetReadOnlyWithTTL(ctx, req.(*tabletmanagerdata.SetReadOnlyWithTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SetSuperReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetSuperReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetSuperReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetSuperReadOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetSuperReadOnly(ctx, req.(*tabletmanagerdata.SetSuperReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SetQueryServerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetQueryServerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetQueryServerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetQueryServerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetQueryServerConfig(ctx, req.(*tabletmanagerdata.SetQueryServerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetQueryBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetQueryBlacklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetQueryBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetQueryBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetQueryBlacklist(ctx, req.(*tabletmanagerdata.GetQueryBlacklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SetQueryBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetQueryBlacklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetQueryBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetQueryBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetQueryBlacklist(ctx, req.(*tabletmanagerdata.SetQueryBlacklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ChangeType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ChangeTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ChangeType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ChangeType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ChangeType(ctx, req.(*tabletmanagerdata.ChangeTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SetTabletTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetTabletTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetTabletTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetTabletTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetTabletTags(ctx, req.(*tabletmanagerdata.SetTabletTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_RefreshState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.RefreshStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).RefreshState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/RefreshState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).RefreshState(ctx, req.(*tabletmanagerdata.RefreshStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_RepublishTopoRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.RepublishTopoRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).RepublishTopoRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/RepublishTopoRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).RepublishTopoRecord(ctx, req.(*tabletmanagerdata.RepublishTopoRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_RunHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.RunHealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).RunHealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/RunHealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).RunHealthCheck(ctx, req.(*tabletmanagerdata.RunHealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_IgnoreHealthError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.IgnoreHealthErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).IgnoreHealthError(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/IgnoreHealthError",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).IgnoreHealthError(ctx, req.(*tabletmanagerdata.IgnoreHealthErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetMaintenanceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetMaintenanceMode(ctx, req.(*tabletmanagerdata.SetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_PauseHealthReporting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.PauseHealthReportingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).PauseHealthReporting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/PauseHealthReporting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).PauseHealthReporting(ctx, req.(*tabletmanagerdata.PauseHealthReportingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SetThrottle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetThrottleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetThrottle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetThrottle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetThrottle(ctx, req.(*tabletmanagerdata.SetThrottleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_PrepareCutover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.PrepareCutoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).PrepareCutover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/PrepareCutover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).PrepareCutover(ctx, req.(*tabletmanagerdata.PrepareCutoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_CommitCutover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.CommitCutoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).CommitCutover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/CommitCutover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).CommitCutover(ctx, req.(*tabletmanagerdata.CommitCutoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_AbortCutover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.AbortCutoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).AbortCutover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/AbortCutover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).AbortCutover(ctx, req.(*tabletmanagerdata.AbortCutoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SetServingKeyRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetServingKeyRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetServingKeyRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetServingKeyRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetServingKeyRange(ctx, req.(*tabletmanagerdata.SetServingKeyRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_RestartMysql_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.RestartMysqlRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).RestartMysql(m, &tabletManagerRestartMysqlServer{stream})
+}
+
+type TabletManager_RestartMysqlServer interface {
+	Send(*tabletmanagerdata.RestartMysqlResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerRestartMysqlServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerRestartMysqlServer) Send(m *tabletmanagerdata.RestartMysqlResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_Decommission_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.DecommissionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).Decommission(m, &tabletManagerDecommissionServer{stream})
+}
+
+type TabletManager_DecommissionServer interface {
+	Send(*tabletmanagerdata.DecommissionResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerDecommissionServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerDecommissionServer) Send(m *tabletmanagerdata.DecommissionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_CheckTopoConnectivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.CheckTopoConnectivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).CheckTopoConnectivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/CheckTopoConnectivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).CheckTopoConnectivity(ctx, req.(*tabletmanagerdata.CheckTopoConnectivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_WarmUp_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.WarmUpRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).WarmUp(m, &tabletManagerWarmUpServer{stream})
+}
+
+type TabletManager_WarmUpServer interface {
+	Send(*tabletmanagerdata.WarmUpResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerWarmUpServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerWarmUpServer) Send(m *tabletmanagerdata.WarmUpResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_ReloadSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ReloadSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ReloadSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ReloadSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ReloadSchema(ctx, req.(*tabletmanagerdata.ReloadSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_PreflightSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.PreflightSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).PreflightSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/PreflightSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).PreflightSchema(ctx, req.(*tabletmanagerdata.PreflightSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ApplySchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ApplySchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ApplySchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ApplySchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ApplySchema(ctx, req.(*tabletmanagerdata.ApplySchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SchemaDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SchemaDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SchemaDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SchemaDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SchemaDiff(ctx, req.(*tabletmanagerdata.SchemaDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_AssessSchemaChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.AssessSchemaChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).AssessSchemaChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/AssessSchemaChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).AssessSchemaChange(ctx, req.(*tabletmanagerdata.AssessSchemaChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_WatchSchema_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.WatchSchemaRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).WatchSchema(m, &tabletManagerWatchSchemaServer{stream})
+}
+
+type TabletManager_WatchSchemaServer interface {
+	Send(*tabletmanagerdata.WatchSchemaResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerWatchSchemaServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerWatchSchemaServer) Send(m *tabletmanagerdata.WatchSchemaResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_GetVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetVSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetVSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetVSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetVSchema(ctx, req.(*tabletmanagerdata.GetVSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ApplyVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ApplyVSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ApplyVSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ApplyVSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ApplyVSchema(ctx, req.(*tabletmanagerdata.ApplyVSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ExecuteFetchAsDba_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ExecuteFetchAsDbaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ExecuteFetchAsDba(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ExecuteFetchAsDba",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ExecuteFetchAsDba(ctx, req.(*tabletmanagerdata.ExecuteFetchAsDbaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ExecuteFetchAsDbaCSV_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.ExecuteFetchAsDbaCSVRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).ExecuteFetchAsDbaCSV(m, &tabletManagerExecuteFetchAsDbaCSVServer{stream})
+}
+
+type TabletManager_ExecuteFetchAsDbaCSVServer interface {
+	Send(*tabletmanagerdata.ExecuteFetchAsDbaCSVResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerExecuteFetchAsDbaCSVServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerExecuteFetchAsDbaCSVServer) Send(m *tabletmanagerdata.ExecuteFetchAsDbaCSVResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_ExecuteFetchColumnar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ExecuteFetchColumnarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ExecuteFetchColumnar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ExecuteFetchColumnar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ExecuteFetchColumnar(ctx, req.(*tabletmanagerdata.ExecuteFetchColumnarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ExecuteFetchAsAllPrivs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ExecuteFetchAsAllPrivsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ExecuteFetchAsAllPrivs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ExecuteFetchAsAllPrivs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ExecuteFetchAsAllPrivs(ctx, req.(*tabletmanagerdata.ExecuteFetchAsAllPrivsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ExecuteFetchAsApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ExecuteFetchAsAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ExecuteFetchAsApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ExecuteFetchAsApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ExecuteFetchAsApp(ctx, req.(*tabletmanagerdata.ExecuteFetchAsAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ExplainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ExplainQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ExplainQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ExplainQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ExplainQuery(ctx, req.(*tabletmanagerdata.ExplainQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ApplyGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ApplyGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ApplyGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ApplyGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ApplyGrants(ctx, req.(*tabletmanagerdata.ApplyGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ChecksumTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ChecksumTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ChecksumTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ChecksumTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ChecksumTable(ctx, req.(*tabletmanagerdata.ChecksumTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_TruncateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.TruncateTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).TruncateTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/TruncateTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).TruncateTable(ctx, req.(*tabletmanagerdata.TruncateTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_RenameTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.RenameTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).RenameTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/RenameTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).RenameTable(ctx, req.(*tabletmanagerdata.RenameTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_StreamRowsInKeyRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.StreamRowsInKeyRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).StreamRowsInKeyRange(m, &tabletManagerStreamRowsInKeyRangeServer{stream})
+}
+
+type TabletManager_StreamRowsInKeyRangeServer interface {
+	Send(*tabletmanagerdata.StreamRowsInKeyRangeResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerStreamRowsInKeyRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerStreamRowsInKeyRangeServer) Send(m *tabletmanagerdata.StreamRowsInKeyRangeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_StreamKeyRangeBinlog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.StreamKeyRangeBinlogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).StreamKeyRangeBinlog(m, &tabletManagerStreamKeyRangeBinlogServer{stream})
+}
+
+type TabletManager_StreamKeyRangeBinlogServer interface {
+	Send(*tabletmanagerdata.StreamKeyRangeBinlogResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerStreamKeyRangeBinlogServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerStreamKeyRangeBinlogServer) Send(m *tabletmanagerdata.StreamKeyRangeBinlogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_CloneStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.CloneStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).CloneStream(m, &tabletManagerCloneStreamServer{stream})
+}
+
+type TabletManager_CloneStreamServer interface {
+	Send(*tabletmanagerdata.CloneStreamResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerCloneStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerCloneStreamServer) Send(m *tabletmanagerdata.CloneStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_GetCharsetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetCharsetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetCharsetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetCharsetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetCharsetConfig(ctx, req.(*tabletmanagerdata.GetCharsetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetProcessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetProcessListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetProcessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetProcessList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetProcessList(ctx, req.(*tabletmanagerdata.GetProcessListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_KillProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.KillProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).KillProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/KillProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).KillProcess(ctx, req.(*tabletmanagerdata.KillProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_TailGeneralLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.TailGeneralLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).TailGeneralLog(m, &tabletManagerTailGeneralLogServer{stream})
+}
+
+type TabletManager_TailGeneralLogServer interface {
+	Send(*tabletmanagerdata.TailGeneralLogResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerTailGeneralLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerTailGeneralLogServer) Send(m *tabletmanagerdata.TailGeneralLogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_StreamQPS_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.StreamQPSRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).StreamQPS(m, &tabletManagerStreamQPSServer{stream})
 }
 
-func RegisterTabletManagerServer(s *grpc.Server, srv TabletManagerServer) {
-	s.RegisterService(&_TabletManager_serviceDesc, srv)
+type TabletManager_StreamQPSServer interface {
+	Send(*tabletmanagerdata.StreamQPSResponse) error
+	grpc.ServerStream
 }
 
-func _TabletManager_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.PingRequest)
+type tabletManagerStreamQPSServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerStreamQPSServer) Send(m *tabletmanagerdata.StreamQPSResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_SlaveStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SlaveStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).Ping(ctx, in)
+		return srv.(TabletManagerServer).SlaveStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/Ping",
+		FullMethod: "/tabletmanagerservice.TabletManager/SlaveStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).Ping(ctx, req.(*tabletmanagerdata.PingRequest))
+		return srv.(TabletManagerServer).SlaveStatus(ctx, req.(*tabletmanagerdata.SlaveStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_Sleep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.SleepRequest)
+func _TabletManager_SlaveStatusAllChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SlaveStatusAllChannelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).Sleep(ctx, in)
+		return srv.(TabletManagerServer).SlaveStatusAllChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/Sleep",
+		FullMethod: "/tabletmanagerservice.TabletManager/SlaveStatusAllChannels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).Sleep(ctx, req.(*tabletmanagerdata.SleepRequest))
+		return srv.(TabletManagerServer).SlaveStatusAllChannels(ctx, req.(*tabletmanagerdata.SlaveStatusAllChannelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_ExecuteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.ExecuteHookRequest)
+func _TabletManager_GetReplicationSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetReplicationSourceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).ExecuteHook(ctx, in)
+		return srv.(TabletManagerServer).GetReplicationSource(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/ExecuteHook",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetReplicationSource",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).ExecuteHook(ctx, req.(*tabletmanagerdata.ExecuteHookRequest))
+		return srv.(TabletManagerServer).GetReplicationSource(ctx, req.(*tabletmanagerdata.GetReplicationSourceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.GetSchemaRequest)
+func _TabletManager_ValidateReplicationCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ValidateReplicationCredentialsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).GetSchema(ctx, in)
+		return srv.(TabletManagerServer).ValidateReplicationCredentials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/GetSchema",
+		FullMethod: "/tabletmanagerservice.TabletManager/ValidateReplicationCredentials",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).GetSchema(ctx, req.(*tabletmanagerdata.GetSchemaRequest))
+		return srv.(TabletManagerServer).ValidateReplicationCredentials(ctx, req.(*tabletmanagerdata.ValidateReplicationCredentialsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.GetPermissionsRequest)
+func _TabletManager_GetApplierQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetApplierQueueStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).GetPermissions(ctx, in)
+		return srv.(TabletManagerServer).GetApplierQueueStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/GetPermissions",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetApplierQueueStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).GetPermissions(ctx, req.(*tabletmanagerdata.GetPermissionsRequest))
+		return srv.(TabletManagerServer).GetApplierQueueStats(ctx, req.(*tabletmanagerdata.GetApplierQueueStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_SetReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.SetReadOnlyRequest)
+func _TabletManager_MasterPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.MasterPositionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).SetReadOnly(ctx, in)
+		return srv.(TabletManagerServer).MasterPosition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/SetReadOnly",
+		FullMethod: "/tabletmanagerservice.TabletManager/MasterPosition",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).SetReadOnly(ctx, req.(*tabletmanagerdata.SetReadOnlyRequest))
+		return srv.(TabletManagerServer).MasterPosition(ctx, req.(*tabletmanagerdata.MasterPositionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_SetReadWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.SetReadWriteRequest)
+func _TabletManager_GetGtidPurged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetGtidPurgedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).SetReadWrite(ctx, in)
+		return srv.(TabletManagerServer).GetGtidPurged(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/SetReadWrite",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetGtidPurged",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).SetReadWrite(ctx, req.(*tabletmanagerdata.SetReadWriteRequest))
+		return srv.(TabletManagerServer).GetGtidPurged(ctx, req.(*tabletmanagerdata.GetGtidPurgedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_ChangeType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.ChangeTypeRequest)
+func _TabletManager_GetBinlogStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetBinlogStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).ChangeType(ctx, in)
+		return srv.(TabletManagerServer).GetBinlogStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/ChangeType",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetBinlogStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).ChangeType(ctx, req.(*tabletmanagerdata.ChangeTypeRequest))
+		return srv.(TabletManagerServer).GetBinlogStats(ctx, req.(*tabletmanagerdata.GetBinlogStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_RefreshState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.RefreshStateRequest)
+func _TabletManager_GetReplicationErrorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetReplicationErrorStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).RefreshState(ctx, in)
+		return srv.(TabletManagerServer).GetReplicationErrorStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/RefreshState",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetReplicationErrorStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).RefreshState(ctx, req.(*tabletmanagerdata.RefreshStateRequest))
+		return srv.(TabletManagerServer).GetReplicationErrorStats(ctx, req.(*tabletmanagerdata.GetReplicationErrorStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_RunHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.RunHealthCheckRequest)
+func _TabletManager_StopSlave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.StopSlaveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).RunHealthCheck(ctx, in)
+		return srv.(TabletManagerServer).StopSlave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/StopSlave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).StopSlave(ctx, req.(*tabletmanagerdata.StopSlaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_StopSlaveMinimum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.StopSlaveMinimumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).StopSlaveMinimum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/StopSlaveMinimum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).StopSlaveMinimum(ctx, req.(*tabletmanagerdata.StopSlaveMinimumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_StopSlaveMinimumStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.StopSlaveMinimumStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).StopSlaveMinimumStream(m, &tabletManagerStopSlaveMinimumStreamServer{stream})
+}
+
+type TabletManager_StopSlaveMinimumStreamServer interface {
+	Send(*tabletmanagerdata.StopSlaveMinimumStreamResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerStopSlaveMinimumStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerStopSlaveMinimumStreamServer) Send(m *tabletmanagerdata.StopSlaveMinimumStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_BoostReplicationCatchup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.BoostReplicationCatchupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).BoostReplicationCatchup(m, &tabletManagerBoostReplicationCatchupServer{stream})
+}
+
+type TabletManager_BoostReplicationCatchupServer interface {
+	Send(*tabletmanagerdata.BoostReplicationCatchupResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerBoostReplicationCatchupServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerBoostReplicationCatchupServer) Send(m *tabletmanagerdata.BoostReplicationCatchupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_StartSlave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.StartSlaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).StartSlave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/StartSlave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).StartSlave(ctx, req.(*tabletmanagerdata.StartSlaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_EnableParallelReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.EnableParallelReplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).EnableParallelReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/EnableParallelReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).EnableParallelReplication(ctx, req.(*tabletmanagerdata.EnableParallelReplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_RotateReplicationCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.RotateReplicationCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).RotateReplicationCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/RotateReplicationCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).RotateReplicationCredentials(ctx, req.(*tabletmanagerdata.RotateReplicationCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ConfigureReplicationSSL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ConfigureReplicationSSLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ConfigureReplicationSSL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ConfigureReplicationSSL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ConfigureReplicationSSL(ctx, req.(*tabletmanagerdata.ConfigureReplicationSSLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_RepairRelayLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.RepairRelayLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).RepairRelayLog(m, &tabletManagerRepairRelayLogServer{stream})
+}
+
+type TabletManager_RepairRelayLogServer interface {
+	Send(*tabletmanagerdata.RepairRelayLogResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerRepairRelayLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerRepairRelayLogServer) Send(m *tabletmanagerdata.RepairRelayLogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_TabletExternallyReparented_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.TabletExternallyReparentedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).TabletExternallyReparented(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/TabletExternallyReparented",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).TabletExternallyReparented(ctx, req.(*tabletmanagerdata.TabletExternallyReparentedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_TabletExternallyElected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.TabletExternallyElectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).TabletExternallyElected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/TabletExternallyElected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).TabletExternallyElected(ctx, req.(*tabletmanagerdata.TabletExternallyElectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetSlaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetSlavesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetSlaves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetSlaves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetSlaves(ctx, req.(*tabletmanagerdata.GetSlavesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetReplicationGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetReplicationGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetReplicationGraph(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/RunHealthCheck",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetReplicationGraph",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).RunHealthCheck(ctx, req.(*tabletmanagerdata.RunHealthCheckRequest))
+		return srv.(TabletManagerServer).GetReplicationGraph(ctx, req.(*tabletmanagerdata.GetReplicationGraphRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_IgnoreHealthError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.IgnoreHealthErrorRequest)
+func _TabletManager_WaitBlpPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.WaitBlpPositionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).IgnoreHealthError(ctx, in)
+		return srv.(TabletManagerServer).WaitBlpPosition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/IgnoreHealthError",
+		FullMethod: "/tabletmanagerservice.TabletManager/WaitBlpPosition",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).IgnoreHealthError(ctx, req.(*tabletmanagerdata.IgnoreHealthErrorRequest))
+		return srv.(TabletManagerServer).WaitBlpPosition(ctx, req.(*tabletmanagerdata.WaitBlpPositionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_ReloadSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.ReloadSchemaRequest)
+func _TabletManager_StopBlp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.StopBlpRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).ReloadSchema(ctx, in)
+		return srv.(TabletManagerServer).StopBlp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/ReloadSchema",
+		FullMethod: "/tabletmanagerservice.TabletManager/StopBlp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).ReloadSchema(ctx, req.(*tabletmanagerdata.ReloadSchemaRequest))
+		return srv.(TabletManagerServer).StopBlp(ctx, req.(*tabletmanagerdata.StopBlpRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_PreflightSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.PreflightSchemaRequest)
+func _TabletManager_StartBlp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.StartBlpRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).PreflightSchema(ctx, in)
+		return srv.(TabletManagerServer).StartBlp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/PreflightSchema",
+		FullMethod: "/tabletmanagerservice.TabletManager/StartBlp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).PreflightSchema(ctx, req.(*tabletmanagerdata.PreflightSchemaRequest))
+		return srv.(TabletManagerServer).StartBlp(ctx, req.(*tabletmanagerdata.StartBlpRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_ApplySchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.ApplySchemaRequest)
+func _TabletManager_RunBlpUntil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.RunBlpUntilRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).ApplySchema(ctx, in)
+		return srv.(TabletManagerServer).RunBlpUntil(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/ApplySchema",
+		FullMethod: "/tabletmanagerservice.TabletManager/RunBlpUntil",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).ApplySchema(ctx, req.(*tabletmanagerdata.ApplySchemaRequest))
+		return srv.(TabletManagerServer).RunBlpUntil(ctx, req.(*tabletmanagerdata.RunBlpUntilRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_ExecuteFetchAsDba_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.ExecuteFetchAsDbaRequest)
+func _TabletManager_GetBinlogFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetBinlogFiltersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).ExecuteFetchAsDba(ctx, in)
+		return srv.(TabletManagerServer).GetBinlogFilters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/ExecuteFetchAsDba",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetBinlogFilters",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).ExecuteFetchAsDba(ctx, req.(*tabletmanagerdata.ExecuteFetchAsDbaRequest))
+		return srv.(TabletManagerServer).GetBinlogFilters(ctx, req.(*tabletmanagerdata.GetBinlogFiltersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_ExecuteFetchAsAllPrivs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.ExecuteFetchAsAllPrivsRequest)
+func _TabletManager_ResetReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ResetReplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).ExecuteFetchAsAllPrivs(ctx, in)
+		return srv.(TabletManagerServer).ResetReplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/ExecuteFetchAsAllPrivs",
+		FullMethod: "/tabletmanagerservice.TabletManager/ResetReplication",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).ExecuteFetchAsAllPrivs(ctx, req.(*tabletmanagerdata.ExecuteFetchAsAllPrivsRequest))
+		return srv.(TabletManagerServer).ResetReplication(ctx, req.(*tabletmanagerdata.ResetReplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_ExecuteFetchAsApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.ExecuteFetchAsAppRequest)
+func _TabletManager_InitMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.InitMasterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).ExecuteFetchAsApp(ctx, in)
+		return srv.(TabletManagerServer).InitMaster(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/ExecuteFetchAsApp",
+		FullMethod: "/tabletmanagerservice.TabletManager/InitMaster",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).ExecuteFetchAsApp(ctx, req.(*tabletmanagerdata.ExecuteFetchAsAppRequest))
+		return srv.(TabletManagerServer).InitMaster(ctx, req.(*tabletmanagerdata.InitMasterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_SlaveStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.SlaveStatusRequest)
+func _TabletManager_PopulateReparentJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.PopulateReparentJournalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).SlaveStatus(ctx, in)
+		return srv.(TabletManagerServer).PopulateReparentJournal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/SlaveStatus",
+		FullMethod: "/tabletmanagerservice.TabletManager/PopulateReparentJournal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).SlaveStatus(ctx, req.(*tabletmanagerdata.SlaveStatusRequest))
+		return srv.(TabletManagerServer).PopulateReparentJournal(ctx, req.(*tabletmanagerdata.PopulateReparentJournalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_MasterPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.MasterPositionRequest)
+func _TabletManager_GetLastReparentJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetLastReparentJournalEntryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).MasterPosition(ctx, in)
+		return srv.(TabletManagerServer).GetLastReparentJournalEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/MasterPosition",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetLastReparentJournalEntry",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).MasterPosition(ctx, req.(*tabletmanagerdata.MasterPositionRequest))
+		return srv.(TabletManagerServer).GetLastReparentJournalEntry(ctx, req.(*tabletmanagerdata.GetLastReparentJournalEntryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_StopSlave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.StopSlaveRequest)
+func _TabletManager_InitSlave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.InitSlaveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).StopSlave(ctx, in)
+		return srv.(TabletManagerServer).InitSlave(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/StopSlave",
+		FullMethod: "/tabletmanagerservice.TabletManager/InitSlave",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).StopSlave(ctx, req.(*tabletmanagerdata.StopSlaveRequest))
+		return srv.(TabletManagerServer).InitSlave(ctx, req.(*tabletmanagerdata.InitSlaveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_StopSlaveMinimum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.StopSlaveMinimumRequest)
+func _TabletManager_DemoteMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.DemoteMasterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).StopSlaveMinimum(ctx, in)
+		return srv.(TabletManagerServer).DemoteMaster(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/StopSlaveMinimum",
+		FullMethod: "/tabletmanagerservice.TabletManager/DemoteMaster",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).StopSlaveMinimum(ctx, req.(*tabletmanagerdata.StopSlaveMinimumRequest))
+		return srv.(TabletManagerServer).DemoteMaster(ctx, req.(*tabletmanagerdata.DemoteMasterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_StartSlave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.StartSlaveRequest)
+func _TabletManager_PromoteSlaveWhenCaughtUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).StartSlave(ctx, in)
+		return srv.(TabletManagerServer).PromoteSlaveWhenCaughtUp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/StartSlave",
+		FullMethod: "/tabletmanagerservice.TabletManager/PromoteSlaveWhenCaughtUp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).StartSlave(ctx, req.(*tabletmanagerdata.StartSlaveRequest))
+		return srv.(TabletManagerServer).PromoteSlaveWhenCaughtUp(ctx, req.(*tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_TabletExternallyReparented_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.TabletExternallyReparentedRequest)
+func _TabletManager_SlaveWasPromoted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SlaveWasPromotedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).TabletExternallyReparented(ctx, in)
+		return srv.(TabletManagerServer).SlaveWasPromoted(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/TabletExternallyReparented",
+		FullMethod: "/tabletmanagerservice.TabletManager/SlaveWasPromoted",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).TabletExternallyReparented(ctx, req.(*tabletmanagerdata.TabletExternallyReparentedRequest))
+		return srv.(TabletManagerServer).SlaveWasPromoted(ctx, req.(*tabletmanagerdata.SlaveWasPromotedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_TabletExternallyElected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.TabletExternallyElectedRequest)
+func _TabletManager_SetMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetMasterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).TabletExternallyElected(ctx, in)
+		return srv.(TabletManagerServer).SetMaster(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/TabletExternallyElected",
+		FullMethod: "/tabletmanagerservice.TabletManager/SetMaster",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).TabletExternallyElected(ctx, req.(*tabletmanagerdata.TabletExternallyElectedRequest))
+		return srv.(TabletManagerServer).SetMaster(ctx, req.(*tabletmanagerdata.SetMasterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_GetSlaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.GetSlavesRequest)
+func _TabletManager_ConfigureReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ConfigureReplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).GetSlaves(ctx, in)
+		return srv.(TabletManagerServer).ConfigureReplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/GetSlaves",
+		FullMethod: "/tabletmanagerservice.TabletManager/ConfigureReplication",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).GetSlaves(ctx, req.(*tabletmanagerdata.GetSlavesRequest))
+		return srv.(TabletManagerServer).ConfigureReplication(ctx, req.(*tabletmanagerdata.ConfigureReplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_WaitBlpPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.WaitBlpPositionRequest)
+func _TabletManager_SlaveWasRestarted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SlaveWasRestartedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).WaitBlpPosition(ctx, in)
+		return srv.(TabletManagerServer).SlaveWasRestarted(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/WaitBlpPosition",
+		FullMethod: "/tabletmanagerservice.TabletManager/SlaveWasRestarted",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).WaitBlpPosition(ctx, req.(*tabletmanagerdata.WaitBlpPositionRequest))
+		return srv.(TabletManagerServer).SlaveWasRestarted(ctx, req.(*tabletmanagerdata.SlaveWasRestartedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_StopBlp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.StopBlpRequest)
+func _TabletManager_StopReplicationAndGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.StopReplicationAndGetStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).StopBlp(ctx, in)
+		return srv.(TabletManagerServer).StopReplicationAndGetStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/StopBlp",
+		FullMethod: "/tabletmanagerservice.TabletManager/StopReplicationAndGetStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).StopBlp(ctx, req.(*tabletmanagerdata.StopBlpRequest))
+		return srv.(TabletManagerServer).StopReplicationAndGetStatus(ctx, req.(*tabletmanagerdata.StopReplicationAndGetStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_StartBlp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.StartBlpRequest)
+func _TabletManager_PromoteSlave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.PromoteSlaveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).StartBlp(ctx, in)
+		return srv.(TabletManagerServer).PromoteSlave(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/StartBlp",
+		FullMethod: "/tabletmanagerservice.TabletManager/PromoteSlave",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).StartBlp(ctx, req.(*tabletmanagerdata.StartBlpRequest))
+		return srv.(TabletManagerServer).PromoteSlave(ctx, req.(*tabletmanagerdata.PromoteSlaveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_RunBlpUntil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.RunBlpUntilRequest)
+func _TabletManager_SetReparentEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetReparentEligibilityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).RunBlpUntil(ctx, in)
+		return srv.(TabletManagerServer).SetReparentEligibility(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/RunBlpUntil",
+		FullMethod: "/tabletmanagerservice.TabletManager/SetReparentEligibility",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).RunBlpUntil(ctx, req.(*tabletmanagerdata.RunBlpUntilRequest))
+		return srv.(TabletManagerServer).SetReparentEligibility(ctx, req.(*tabletmanagerdata.SetReparentEligibilityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_ResetReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.ResetReplicationRequest)
+func _TabletManager_CheckReparentCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.CheckReparentCandidateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).ResetReplication(ctx, in)
+		return srv.(TabletManagerServer).CheckReparentCandidate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/ResetReplication",
+		FullMethod: "/tabletmanagerservice.TabletManager/CheckReparentCandidate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).ResetReplication(ctx, req.(*tabletmanagerdata.ResetReplicationRequest))
+		return srv.(TabletManagerServer).CheckReparentCandidate(ctx, req.(*tabletmanagerdata.CheckReparentCandidateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_InitMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.InitMasterRequest)
+func _TabletManager_SetSemiSyncAckCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetSemiSyncAckCountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).InitMaster(ctx, in)
+		return srv.(TabletManagerServer).SetSemiSyncAckCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/InitMaster",
+		FullMethod: "/tabletmanagerservice.TabletManager/SetSemiSyncAckCount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).InitMaster(ctx, req.(*tabletmanagerdata.InitMasterRequest))
+		return srv.(TabletManagerServer).SetSemiSyncAckCount(ctx, req.(*tabletmanagerdata.SetSemiSyncAckCountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_PopulateReparentJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.PopulateReparentJournalRequest)
+func _TabletManager_GetDurabilityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetDurabilityPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).PopulateReparentJournal(ctx, in)
+		return srv.(TabletManagerServer).GetDurabilityPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/PopulateReparentJournal",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetDurabilityPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).PopulateReparentJournal(ctx, req.(*tabletmanagerdata.PopulateReparentJournalRequest))
+		return srv.(TabletManagerServer).GetDurabilityPolicy(ctx, req.(*tabletmanagerdata.GetDurabilityPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_InitSlave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.InitSlaveRequest)
+func _TabletManager_GetBackupLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetBackupLimitsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).InitSlave(ctx, in)
+		return srv.(TabletManagerServer).GetBackupLimits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/InitSlave",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetBackupLimits",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).InitSlave(ctx, req.(*tabletmanagerdata.InitSlaveRequest))
+		return srv.(TabletManagerServer).GetBackupLimits(ctx, req.(*tabletmanagerdata.GetBackupLimitsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_DemoteMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.DemoteMasterRequest)
+func _TabletManager_GetBackupPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetBackupPositionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).DemoteMaster(ctx, in)
+		return srv.(TabletManagerServer).GetBackupPosition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/DemoteMaster",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetBackupPosition",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).DemoteMaster(ctx, req.(*tabletmanagerdata.DemoteMasterRequest))
+		return srv.(TabletManagerServer).GetBackupPosition(ctx, req.(*tabletmanagerdata.GetBackupPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).Backup(m, &tabletManagerBackupServer{stream})
+}
+
+type TabletManager_BackupServer interface {
+	Send(*tabletmanagerdata.BackupResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerBackupServer) Send(m *tabletmanagerdata.BackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_IncrementalBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.IncrementalBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).IncrementalBackup(m, &tabletManagerIncrementalBackupServer{stream})
+}
+
+type TabletManager_IncrementalBackupServer interface {
+	Send(*tabletmanagerdata.IncrementalBackupResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerIncrementalBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerIncrementalBackupServer) Send(m *tabletmanagerdata.IncrementalBackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_RestoreFromBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.RestoreFromBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).RestoreFromBackup(m, &tabletManagerRestoreFromBackupServer{stream})
+}
+
+type TabletManager_RestoreFromBackupServer interface {
+	Send(*tabletmanagerdata.RestoreFromBackupResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerRestoreFromBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerRestoreFromBackupServer) Send(m *tabletmanagerdata.RestoreFromBackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_RestoreToTimestamp_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.RestoreToTimestampRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(TabletManagerServer).RestoreToTimestamp(m, &tabletManagerRestoreToTimestampServer{stream})
 }
 
-func _TabletManager_PromoteSlaveWhenCaughtUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest)
+type TabletManager_RestoreToTimestampServer interface {
+	Send(*tabletmanagerdata.RestoreToTimestampResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerRestoreToTimestampServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerRestoreToTimestampServer) Send(m *tabletmanagerdata.RestoreToTimestampResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TabletManager_SetPreferredBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetPreferredBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).PromoteSlaveWhenCaughtUp(ctx, in)
+		return srv.(TabletManagerServer).SetPreferredBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/PromoteSlaveWhenCaughtUp",
+		FullMethod: "/tabletmanagerservice.TabletManager/SetPreferredBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).PromoteSlaveWhenCaughtUp(ctx, req.(*tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest))
+		return srv.(TabletManagerServer).SetPreferredBackup(ctx, req.(*tabletmanagerdata.SetPreferredBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_SlaveWasPromoted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.SlaveWasPromotedRequest)
+func _TabletManager_GetPreferredBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetPreferredBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).SlaveWasPromoted(ctx, in)
+		return srv.(TabletManagerServer).GetPreferredBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/SlaveWasPromoted",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetPreferredBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).SlaveWasPromoted(ctx, req.(*tabletmanagerdata.SlaveWasPromotedRequest))
+		return srv.(TabletManagerServer).GetPreferredBackup(ctx, req.(*tabletmanagerdata.GetPreferredBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_SetMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.SetMasterRequest)
+func _TabletManager_CheckRestoreCompatibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.CheckRestoreCompatibilityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).SetMaster(ctx, in)
+		return srv.(TabletManagerServer).CheckRestoreCompatibility(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/SetMaster",
+		FullMethod: "/tabletmanagerservice.TabletManager/CheckRestoreCompatibility",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).SetMaster(ctx, req.(*tabletmanagerdata.SetMasterRequest))
+		return srv.(TabletManagerServer).CheckRestoreCompatibility(ctx, req.(*tabletmanagerdata.CheckRestoreCompatibilityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_SlaveWasRestarted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.SlaveWasRestartedRequest)
+func _TabletManager_GetLastBackupInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetLastBackupInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).SlaveWasRestarted(ctx, in)
+		return srv.(TabletManagerServer).GetLastBackupInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/SlaveWasRestarted",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetLastBackupInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).SlaveWasRestarted(ctx, req.(*tabletmanagerdata.SlaveWasRestartedRequest))
+		return srv.(TabletManagerServer).GetLastBackupInfo(ctx, req.(*tabletmanagerdata.GetLastBackupInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_StopReplicationAndGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.StopReplicationAndGetStatusRequest)
+func _TabletManager_GetBackupFreshness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetBackupFreshnessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).StopReplicationAndGetStatus(ctx, in)
+		return srv.(TabletManagerServer).GetBackupFreshness(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/StopReplicationAndGetStatus",
+		FullMethod: "/tabletmanagerservice.TabletManager/GetBackupFreshness",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).StopReplicationAndGetStatus(ctx, req.(*tabletmanagerdata.StopReplicationAndGetStatusRequest))
+		return srv.(TabletManagerServer).GetBackupFreshness(ctx, req.(*tabletmanagerdata.GetBackupFreshnessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_PromoteSlave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(tabletmanagerdata.PromoteSlaveRequest)
+func _TabletManager_CheckBackupReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.CheckBackupReadinessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TabletManagerServer).PromoteSlave(ctx, in)
+		return srv.(TabletManagerServer).CheckBackupReadiness(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tabletmanagerservice.TabletManager/PromoteSlave",
+		FullMethod: "/tabletmanagerservice.TabletManager/CheckBackupReadiness",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TabletManagerServer).PromoteSlave(ctx, req.(*tabletmanagerdata.PromoteSlaveRequest))
+		return srv.(TabletManagerServer).CheckBackupReadiness(ctx, req.(*tabletmanagerdata.CheckBackupReadinessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TabletManager_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(tabletmanagerdata.BackupRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(TabletManagerServer).Backup(m, &tabletManagerBackupServer{stream})
-}
-
-type TabletManager_BackupServer interface {
-	Send(*tabletmanagerdata.BackupResponse) error
-	grpc.ServerStream
-}
-
-type tabletManagerBackupServer struct {
-	grpc.ServerStream
-}
-
-func (x *tabletManagerBackupServer) Send(m *tabletmanagerdata.BackupResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _TabletManager_RestoreFromBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(tabletmanagerdata.RestoreFromBackupRequest)
+func _TabletManager_TestRestore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.TestRestoreRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(TabletManagerServer).RestoreFromBackup(m, &tabletManagerRestoreFromBackupServer{stream})
+	return srv.(TabletManagerServer).TestRestore(m, &tabletManagerTestRestoreServer{stream})
 }
 
-type TabletManager_RestoreFromBackupServer interface {
-	Send(*tabletmanagerdata.RestoreFromBackupResponse) error
+type TabletManager_TestRestoreServer interface {
+	Send(*tabletmanagerdata.TestRestoreResponse) error
 	grpc.ServerStream
 }
 
-type tabletManagerRestoreFromBackupServer struct {
+type tabletManagerTestRestoreServer struct {
 	grpc.ServerStream
 }
 
-func (x *tabletManagerRestoreFromBackupServer) Send(m *tabletmanagerdata.RestoreFromBackupResponse) error {
+func (x *tabletManagerTestRestoreServer) Send(m *tabletmanagerdata.TestRestoreResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -1469,10 +4675,62 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSchema",
 			Handler:    _TabletManager_GetSchema_Handler,
 		},
+		{
+			MethodName: "GetCreateStatements",
+			Handler:    _TabletManager_GetCreateStatements_Handler,
+		},
+		{
+			MethodName: "GetSchemaTimestamps",
+			Handler:    _TabletManager_GetSchemaTimestamps_Handler,
+		},
 		{
 			MethodName: "GetPermissions",
 			Handler:    _TabletManager_GetPermissions_Handler,
 		},
+		{
+			MethodName: "GetConnectionStats",
+			Handler:    _TabletManager_GetConnectionStats_Handler,
+		},
+		{
+			MethodName: "GetConnectionRejectionStats",
+			Handler:    _TabletManager_GetConnectionRejectionStats_Handler,
+		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _TabletManager_GetConfig_Handler,
+		},
+		{
+			MethodName: "GetInFlightRPCs",
+			Handler:    _TabletManager_GetInFlightRPCs_Handler,
+		},
+		{
+			MethodName: "GetProcessStats",
+			Handler:    _TabletManager_GetProcessStats_Handler,
+		},
+		{
+			MethodName: "GetHealthScore",
+			Handler:    _TabletManager_GetHealthScore_Handler,
+		},
+		{
+			MethodName: "GetErrorLogTail",
+			Handler:    _TabletManager_GetErrorLogTail_Handler,
+		},
+		{
+			MethodName: "CaptureDiagnostics",
+			Handler:    _TabletManager_CaptureDiagnostics_Handler,
+		},
+		{
+			MethodName: "CheckDataDir",
+			Handler:    _TabletManager_CheckDataDir_Handler,
+		},
+		{
+			MethodName: "GetThrottleState",
+			Handler:    _TabletManager_GetThrottleState_Handler,
+		},
+		{
+			MethodName: "GetTabletTags",
+			Handler:    _TabletManager_GetTabletTags_Handler,
+		},
 		{
 			MethodName: "SetReadOnly",
 			Handler:    _TabletManager_SetReadOnly_Handler,
@@ -1481,14 +4739,42 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetReadWrite",
 			Handler:    _TabletManager_SetReadWrite_Handler,
 		},
+		{
+			MethodName: "SetReadOnlyWithTTL",
+			Handler:    _TabletManager_SetReadOnlyWithTTL_Handler,
+		},
+		{
+			MethodName: "SetSuperReadOnly",
+			Handler:    _TabletManager_SetSuperReadOnly_Handler,
+		},
+		{
+			MethodName: "SetQueryServerConfig",
+			Handler:    _TabletManager_SetQueryServerConfig_Handler,
+		},
+		{
+			MethodName: "GetQueryBlacklist",
+			Handler:    _TabletManager_GetQueryBlacklist_Handler,
+		},
+		{
+			MethodName: "SetQueryBlacklist",
+			Handler:    _TabletManager_SetQueryBlacklist_Handler,
+		},
 		{
 			MethodName: "ChangeType",
 			Handler:    _TabletManager_ChangeType_Handler,
 		},
+		{
+			MethodName: "SetTabletTags",
+			Handler:    _TabletManager_SetTabletTags_Handler,
+		},
 		{
 			MethodName: "RefreshState",
 			Handler:    _TabletManager_RefreshState_Handler,
 		},
+		{
+			MethodName: "RepublishTopoRecord",
+			Handler:    _TabletManager_RepublishTopoRecord_Handler,
+		},
 		{
 			MethodName: "RunHealthCheck",
 			Handler:    _TabletManager_RunHealthCheck_Handler,
@@ -1497,6 +4783,38 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IgnoreHealthError",
 			Handler:    _TabletManager_IgnoreHealthError_Handler,
 		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _TabletManager_SetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "PauseHealthReporting",
+			Handler:    _TabletManager_PauseHealthReporting_Handler,
+		},
+		{
+			MethodName: "SetThrottle",
+			Handler:    _TabletManager_SetThrottle_Handler,
+		},
+		{
+			MethodName: "PrepareCutover",
+			Handler:    _TabletManager_PrepareCutover_Handler,
+		},
+		{
+			MethodName: "CommitCutover",
+			Handler:    _TabletManager_CommitCutover_Handler,
+		},
+		{
+			MethodName: "AbortCutover",
+			Handler:    _TabletManager_AbortCutover_Handler,
+		},
+		{
+			MethodName: "SetServingKeyRange",
+			Handler:    _TabletManager_SetServingKeyRange_Handler,
+		},
+		{
+			MethodName: "CheckTopoConnectivity",
+			Handler:    _TabletManager_CheckTopoConnectivity_Handler,
+		},
 		{
 			MethodName: "ReloadSchema",
 			Handler:    _TabletManager_ReloadSchema_Handler,
@@ -1509,10 +4827,30 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ApplySchema",
 			Handler:    _TabletManager_ApplySchema_Handler,
 		},
+		{
+			MethodName: "SchemaDiff",
+			Handler:    _TabletManager_SchemaDiff_Handler,
+		},
+		{
+			MethodName: "AssessSchemaChange",
+			Handler:    _TabletManager_AssessSchemaChange_Handler,
+		},
+		{
+			MethodName: "GetVSchema",
+			Handler:    _TabletManager_GetVSchema_Handler,
+		},
+		{
+			MethodName: "ApplyVSchema",
+			Handler:    _TabletManager_ApplyVSchema_Handler,
+		},
 		{
 			MethodName: "ExecuteFetchAsDba",
 			Handler:    _TabletManager_ExecuteFetchAsDba_Handler,
 		},
+		{
+			MethodName: "ExecuteFetchColumnar",
+			Handler:    _TabletManager_ExecuteFetchColumnar_Handler,
+		},
 		{
 			MethodName: "ExecuteFetchAsAllPrivs",
 			Handler:    _TabletManager_ExecuteFetchAsAllPrivs_Handler,
@@ -1521,14 +4859,74 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteFetchAsApp",
 			Handler:    _TabletManager_ExecuteFetchAsApp_Handler,
 		},
+		{
+			MethodName: "ExplainQuery",
+			Handler:    _TabletManager_ExplainQuery_Handler,
+		},
+		{
+			MethodName: "ApplyGrants",
+			Handler:    _TabletManager_ApplyGrants_Handler,
+		},
+		{
+			MethodName: "ChecksumTable",
+			Handler:    _TabletManager_ChecksumTable_Handler,
+		},
+		{
+			MethodName: "TruncateTable",
+			Handler:    _TabletManager_TruncateTable_Handler,
+		},
+		{
+			MethodName: "RenameTable",
+			Handler:    _TabletManager_RenameTable_Handler,
+		},
+		{
+			MethodName: "GetCharsetConfig",
+			Handler:    _TabletManager_GetCharsetConfig_Handler,
+		},
+		{
+			MethodName: "GetProcessList",
+			Handler:    _TabletManager_GetProcessList_Handler,
+		},
+		{
+			MethodName: "KillProcess",
+			Handler:    _TabletManager_KillProcess_Handler,
+		},
 		{
 			MethodName: "SlaveStatus",
 			Handler:    _TabletManager_SlaveStatus_Handler,
 		},
+		{
+			MethodName: "SlaveStatusAllChannels",
+			Handler:    _TabletManager_SlaveStatusAllChannels_Handler,
+		},
+		{
+			MethodName: "GetReplicationSource",
+			Handler:    _TabletManager_GetReplicationSource_Handler,
+		},
+		{
+			MethodName: "ValidateReplicationCredentials",
+			Handler:    _TabletManager_ValidateReplicationCredentials_Handler,
+		},
+		{
+			MethodName: "GetApplierQueueStats",
+			Handler:    _TabletManager_GetApplierQueueStats_Handler,
+		},
 		{
 			MethodName: "MasterPosition",
 			Handler:    _TabletManager_MasterPosition_Handler,
 		},
+		{
+			MethodName: "GetGtidPurged",
+			Handler:    _TabletManager_GetGtidPurged_Handler,
+		},
+		{
+			MethodName: "GetBinlogStats",
+			Handler:    _TabletManager_GetBinlogStats_Handler,
+		},
+		{
+			MethodName: "GetReplicationErrorStats",
+			Handler:    _TabletManager_GetReplicationErrorStats_Handler,
+		},
 		{
 			MethodName: "StopSlave",
 			Handler:    _TabletManager_StopSlave_Handler,
@@ -1541,6 +4939,18 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StartSlave",
 			Handler:    _TabletManager_StartSlave_Handler,
 		},
+		{
+			MethodName: "EnableParallelReplication",
+			Handler:    _TabletManager_EnableParallelReplication_Handler,
+		},
+		{
+			MethodName: "RotateReplicationCredentials",
+			Handler:    _TabletManager_RotateReplicationCredentials_Handler,
+		},
+		{
+			MethodName: "ConfigureReplicationSSL",
+			Handler:    _TabletManager_ConfigureReplicationSSL_Handler,
+		},
 		{
 			MethodName: "TabletExternallyReparented",
 			Handler:    _TabletManager_TabletExternallyReparented_Handler,
@@ -1553,6 +4963,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSlaves",
 			Handler:    _TabletManager_GetSlaves_Handler,
 		},
+		{
+			MethodName: "GetReplicationGraph",
+			Handler:    _TabletManager_GetReplicationGraph_Handler,
+		},
 		{
 			MethodName: "WaitBlpPosition",
 			Handler:    _TabletManager_WaitBlpPosition_Handler,
@@ -1569,6 +4983,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RunBlpUntil",
 			Handler:    _TabletManager_RunBlpUntil_Handler,
 		},
+		{
+			MethodName: "GetBinlogFilters",
+			Handler:    _TabletManager_GetBinlogFilters_Handler,
+		},
 		{
 			MethodName: "ResetReplication",
 			Handler:    _TabletManager_ResetReplication_Handler,
@@ -1581,6 +4999,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PopulateReparentJournal",
 			Handler:    _TabletManager_PopulateReparentJournal_Handler,
 		},
+		{
+			MethodName: "GetLastReparentJournalEntry",
+			Handler:    _TabletManager_GetLastReparentJournalEntry_Handler,
+		},
 		{
 			MethodName: "InitSlave",
 			Handler:    _TabletManager_InitSlave_Handler,
@@ -1601,6 +5023,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetMaster",
 			Handler:    _TabletManager_SetMaster_Handler,
 		},
+		{
+			MethodName: "ConfigureReplication",
+			Handler:    _TabletManager_ConfigureReplication_Handler,
+		},
 		{
 			MethodName: "SlaveWasRestarted",
 			Handler:    _TabletManager_SlaveWasRestarted_Handler,
@@ -1613,18 +5039,151 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PromoteSlave",
 			Handler:    _TabletManager_PromoteSlave_Handler,
 		},
+		{
+			MethodName: "SetReparentEligibility",
+			Handler:    _TabletManager_SetReparentEligibility_Handler,
+		},
+		{
+			MethodName: "CheckReparentCandidate",
+			Handler:    _TabletManager_CheckReparentCandidate_Handler,
+		},
+		{
+			MethodName: "SetSemiSyncAckCount",
+			Handler:    _TabletManager_SetSemiSyncAckCount_Handler,
+		},
+		{
+			MethodName: "GetDurabilityPolicy",
+			Handler:    _TabletManager_GetDurabilityPolicy_Handler,
+		},
+		{
+			MethodName: "GetBackupLimits",
+			Handler:    _TabletManager_GetBackupLimits_Handler,
+		},
+		{
+			MethodName: "GetBackupPosition",
+			Handler:    _TabletManager_GetBackupPosition_Handler,
+		},
+		{
+			MethodName: "SetPreferredBackup",
+			Handler:    _TabletManager_SetPreferredBackup_Handler,
+		},
+		{
+			MethodName: "GetPreferredBackup",
+			Handler:    _TabletManager_GetPreferredBackup_Handler,
+		},
+		{
+			MethodName: "CheckRestoreCompatibility",
+			Handler:    _TabletManager_CheckRestoreCompatibility_Handler,
+		},
+		{
+			MethodName: "GetLastBackupInfo",
+			Handler:    _TabletManager_GetLastBackupInfo_Handler,
+		},
+		{
+			MethodName: "GetBackupFreshness",
+			Handler:    _TabletManager_GetBackupFreshness_Handler,
+		},
+		{
+			MethodName: "CheckBackupReadiness",
+			Handler:    _TabletManager_CheckBackupReadiness_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExecuteHookToStream",
+			Handler:       _TabletManager_ExecuteHookToStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RestartMysql",
+			Handler:       _TabletManager_RestartMysql_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Decommission",
+			Handler:       _TabletManager_Decommission_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WarmUp",
+			Handler:       _TabletManager_WarmUp_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchSchema",
+			Handler:       _TabletManager_WatchSchema_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExecuteFetchAsDbaCSV",
+			Handler:       _TabletManager_ExecuteFetchAsDbaCSV_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamRowsInKeyRange",
+			Handler:       _TabletManager_StreamRowsInKeyRange_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamKeyRangeBinlog",
+			Handler:       _TabletManager_StreamKeyRangeBinlog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CloneStream",
+			Handler:       _TabletManager_CloneStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TailGeneralLog",
+			Handler:       _TabletManager_TailGeneralLog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamQPS",
+			Handler:       _TabletManager_StreamQPS_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StopSlaveMinimumStream",
+			Handler:       _TabletManager_StopSlaveMinimumStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BoostReplicationCatchup",
+			Handler:       _TabletManager_BoostReplicationCatchup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RepairRelayLog",
+			Handler:       _TabletManager_RepairRelayLog_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Backup",
 			Handler:       _TabletManager_Backup_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "IncrementalBackup",
+			Handler:       _TabletManager_IncrementalBackup_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "RestoreFromBackup",
 			Handler:       _TabletManager_RestoreFromBackup_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RestoreToTimestamp",
+			Handler:       _TabletManager_RestoreToTimestamp_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TestRestore",
+			Handler:       _TabletManager_TestRestore_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "tabletmanagerservice.proto",
 }
@@ -1632,66 +5191,169 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("tabletmanagerservice.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 969 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x98, 0x6d, 0x6f, 0x1c, 0x35,
-	0x10, 0xc7, 0x39, 0x09, 0x0a, 0x98, 0xc7, 0x5a, 0x88, 0xa2, 0x20, 0x01, 0x4d, 0x5a, 0x1e, 0x52,
-	0x54, 0xf5, 0x81, 0xf2, 0xfe, 0x2e, 0xbd, 0xb6, 0x41, 0x44, 0x1c, 0x77, 0x8d, 0x82, 0x84, 0x84,
-	0xe4, 0xec, 0x4d, 0x6f, 0x97, 0x78, 0x6d, 0x63, 0x7b, 0xa3, 0xe4, 0x15, 0x12, 0x12, 0xaf, 0x90,
-	0xf8, 0x04, 0x7c, 0x58, 0xb4, 0x0f, 0xf6, 0x8d, 0xef, 0xbc, 0xbe, 0xbb, 0xb7, 0xf9, 0xff, 0x66,
-	0xc6, 0x3b, 0x9e, 0x19, 0xcf, 0x85, 0xec, 0x59, 0x76, 0xce, 0xc1, 0x96, 0x4c, 0xb0, 0x05, 0x68,
-	0x03, 0xfa, 0xb2, 0xc8, 0xe0, 0xbe, 0xd2, 0xd2, 0x4a, 0xfa, 0x51, 0x4c, 0xdb, 0xbb, 0x15, 0xfc,
-	0x75, 0xce, 0x2c, 0x6b, 0xf1, 0x47, 0xff, 0xed, 0x93, 0xf7, 0x5e, 0x36, 0xda, 0x49, 0xab, 0xd1,
-	0x63, 0xf2, 0xfa, 0xa4, 0x10, 0x0b, 0xfa, 0xd9, 0xfd, 0x75, 0x9b, 0x5a, 0x98, 0xc2, 0x1f, 0x15,
-	0x18, 0xbb, 0xf7, 0x79, 0xaf, 0x6e, 0x94, 0x14, 0x06, 0xf6, 0x5f, 0xa3, 0x3f, 0x92, 0x37, 0x66,
-	0x1c, 0x40, 0xd1, 0x18, 0xdb, 0x28, 0xce, 0xd9, 0x17, 0xfd, 0x80, 0xf7, 0xf6, 0x1b, 0x79, 0x67,
-	0x7c, 0x05, 0x59, 0x65, 0xe1, 0x85, 0x94, 0x17, 0xf4, 0x6e, 0xc4, 0x04, 0xe9, 0xce, 0xf3, 0x97,
-	0x9b, 0x30, 0xef, 0xff, 0x17, 0xf2, 0xf6, 0x73, 0xb0, 0xb3, 0x2c, 0x87, 0x92, 0xd1, 0x83, 0x88,
-	0x99, 0x57, 0x9d, 0xef, 0x3b, 0x69, 0xc8, 0x7b, 0x5e, 0x90, 0xf7, 0x9f, 0x83, 0x9d, 0x80, 0x2e,
-	0x0b, 0x63, 0x0a, 0x29, 0x0c, 0xfd, 0x3a, 0x6e, 0x89, 0x10, 0x17, 0xe3, 0x9b, 0x2d, 0x48, 0x9c,
-	0xa2, 0x19, 0xd8, 0x29, 0xb0, 0xf9, 0x4f, 0x82, 0x5f, 0x47, 0x53, 0x84, 0xf4, 0x54, 0x8a, 0x02,
-	0xcc, 0xfb, 0x67, 0xe4, 0xdd, 0x4e, 0x38, 0xd3, 0x85, 0x05, 0x9a, 0xb0, 0x6c, 0x00, 0x17, 0xe1,
-	0xab, 0x8d, 0x9c, 0x0f, 0xf1, 0x2b, 0x21, 0x47, 0x39, 0x13, 0x0b, 0x78, 0x79, 0xad, 0x80, 0xc6,
-	0x32, 0xbc, 0x94, 0x9d, 0xfb, 0xbb, 0x1b, 0x28, 0x7c, 0xfe, 0x29, 0xbc, 0xd2, 0x60, 0xf2, 0x99,
-	0x65, 0x3d, 0xe7, 0xc7, 0x40, 0xea, 0xfc, 0x21, 0x87, 0xef, 0x7a, 0x5a, 0x89, 0x17, 0xc0, 0xb8,
-	0xcd, 0x8f, 0x72, 0xc8, 0x2e, 0xa2, 0x77, 0x1d, 0x22, 0xa9, 0xbb, 0x5e, 0x25, 0x7d, 0x20, 0x45,
-	0x6e, 0x1e, 0x2f, 0x84, 0xd4, 0xd0, 0xca, 0x63, 0xad, 0xa5, 0xa6, 0xf7, 0x22, 0x1e, 0xd6, 0x28,
-	0x17, 0xee, 0xdb, 0xed, 0xe0, 0x30, 0x7b, 0x5c, 0xb2, 0x79, 0xd7, 0x23, 0xf1, 0xec, 0x2d, 0x81,
-	0x74, 0xf6, 0x30, 0xe7, 0x43, 0xfc, 0x4e, 0x3e, 0x98, 0x68, 0x78, 0xc5, 0x8b, 0x45, 0xee, 0x3a,
-	0x31, 0x96, 0x94, 0x15, 0xc6, 0x05, 0x3a, 0xdc, 0x06, 0xc5, 0xcd, 0x32, 0x54, 0x8a, 0x5f, 0x77,
-	0x71, 0x62, 0x45, 0x84, 0xf4, 0x54, 0xb3, 0x04, 0x18, 0xbe, 0xa0, 0x6e, 0xd0, 0x3c, 0x03, 0x9b,
-	0xe5, 0x43, 0xf3, 0xf4, 0x9c, 0x45, 0x2f, 0x68, 0x8d, 0x4a, 0x5d, 0x50, 0x04, 0xf6, 0x11, 0xff,
-	0x24, 0x1f, 0x87, 0xf2, 0x90, 0xf3, 0x89, 0x2e, 0x2e, 0x0d, 0x7d, 0xb0, 0xd1, 0x93, 0x43, 0x5d,
-	0xec, 0x87, 0x3b, 0x58, 0xf4, 0x7f, 0xf2, 0x50, 0xa9, 0x2d, 0x3e, 0x79, 0xa8, 0xd4, 0xf6, 0x9f,
-	0xdc, 0xc0, 0xc1, 0xc4, 0xe3, 0xec, 0x12, 0xea, 0x36, 0xac, 0x4c, 0x7c, 0xe2, 0x2d, 0xf5, 0xe4,
-	0xc4, 0xc3, 0x18, 0x6e, 0xe7, 0x13, 0x66, 0x2c, 0xe8, 0x89, 0x34, 0x85, 0x2d, 0xa4, 0x88, 0xb6,
-	0x73, 0x88, 0xa4, 0xda, 0x79, 0x95, 0xc4, 0xaf, 0xcf, 0xcc, 0x4a, 0xd5, 0x9c, 0x22, 0xfa, 0xfa,
-	0x78, 0x35, 0xf5, 0xfa, 0x20, 0xc8, 0x7b, 0x2e, 0xc9, 0x87, 0xfe, 0xcf, 0x27, 0x85, 0x28, 0xca,
-	0xaa, 0xa4, 0x87, 0x29, 0xdb, 0x0e, 0x72, 0x71, 0xee, 0x6d, 0xc5, 0xe2, 0x01, 0x3e, 0xb3, 0x4c,
-	0xdb, 0xf6, 0x4b, 0xe2, 0x87, 0x74, 0x72, 0x6a, 0x80, 0x63, 0xca, 0x3b, 0xff, 0x67, 0x40, 0xf6,
-	0xda, 0x75, 0x65, 0x7c, 0x65, 0x41, 0x0b, 0xc6, 0xeb, 0xf7, 0x49, 0x31, 0x0d, 0xc2, 0xc2, 0x9c,
-	0x7e, 0x17, 0xf1, 0xd3, 0x8f, 0xbb, 0xe8, 0x4f, 0x76, 0xb4, 0xf2, 0xa7, 0xf9, 0x6b, 0x40, 0x6e,
-	0xad, 0x82, 0x63, 0x0e, 0x59, 0x7d, 0x94, 0x87, 0x5b, 0x38, 0xed, 0x58, 0x77, 0x8e, 0x47, 0xbb,
-	0x98, 0xac, 0xae, 0x2d, 0x75, 0xa2, 0x4c, 0xef, 0xda, 0xd2, 0xa8, 0x9b, 0xd6, 0x96, 0x0e, 0xc2,
-	0xc3, 0xf8, 0x8c, 0x15, 0x76, 0xc4, 0x95, 0x2f, 0xfe, 0x58, 0x49, 0xaf, 0x30, 0xa9, 0x61, 0xbc,
-	0x86, 0xfa, 0x58, 0x53, 0xf2, 0x66, 0x5d, 0x53, 0x23, 0xae, 0xe8, 0xed, 0x9e, 0x7a, 0x1b, 0x71,
-	0x3f, 0x25, 0xf6, 0x53, 0x88, 0xf7, 0x79, 0x4a, 0xde, 0x6a, 0x8a, 0xa8, 0x76, 0xba, 0xdf, 0x57,
-	0x61, 0xc8, 0xeb, 0x41, 0x92, 0xc1, 0x23, 0x67, 0x5a, 0x89, 0x11, 0x57, 0xa7, 0xc2, 0x16, 0x3c,
-	0x3a, 0x72, 0x90, 0x9e, 0x1a, 0x39, 0x01, 0x86, 0xfb, 0x75, 0x0a, 0xa6, 0xde, 0x8e, 0x14, 0x2f,
-	0x32, 0xd6, 0xe4, 0xfd, 0x30, 0xfa, 0x84, 0x86, 0x50, 0xaa, 0x5f, 0xd7, 0x59, 0xdc, 0xaf, 0xc7,
-	0xa2, 0xb0, 0xed, 0x60, 0x8a, 0xf6, 0xeb, 0x52, 0x4e, 0xf5, 0x2b, 0xa6, 0x82, 0x0e, 0x99, 0x48,
-	0x55, 0xf1, 0x66, 0x49, 0x6a, 0x5b, 0xe8, 0x07, 0x59, 0xd5, 0xb5, 0x1c, 0xed, 0x90, 0x1e, 0x36,
-	0xd5, 0x21, 0xbd, 0x26, 0xb8, 0x43, 0xea, 0xc3, 0xf5, 0x8f, 0x56, 0xaf, 0xa6, 0x3a, 0x04, 0x41,
-	0x78, 0x23, 0x7a, 0x0a, 0xa5, 0xb4, 0xd0, 0x65, 0x2f, 0x76, 0xc9, 0x18, 0x48, 0x6d, 0x44, 0x21,
-	0xe7, 0x43, 0xfc, 0x3d, 0x20, 0x9f, 0x4c, 0xb4, 0xac, 0xb5, 0x26, 0xfa, 0x59, 0x0e, 0xe2, 0x88,
-	0x55, 0x8b, 0xdc, 0x9e, 0x2a, 0x1a, 0xcd, 0x47, 0x0f, 0xec, 0x62, 0x3f, 0xde, 0xc9, 0x26, 0x78,
-	0x45, 0x1a, 0x99, 0x99, 0x8e, 0x9e, 0xc7, 0x5f, 0x91, 0x15, 0x28, 0xf9, 0x8a, 0xac, 0xb1, 0xc1,
-	0x73, 0x08, 0xae, 0x28, 0x0f, 0xe2, 0x3f, 0x1f, 0xc2, 0x9c, 0xde, 0x49, 0x43, 0x78, 0x47, 0x71,
-	0x71, 0xa7, 0x60, 0xea, 0xf6, 0x86, 0x39, 0x4d, 0x9d, 0xce, 0x53, 0xa9, 0x1d, 0x25, 0x02, 0xfb,
-	0x88, 0xff, 0x0e, 0xc8, 0xa7, 0xf5, 0x74, 0x42, 0xfd, 0x37, 0x14, 0xf3, 0x7a, 0xe2, 0xb6, 0x4b,
-	0xcb, 0x93, 0x9e, 0x69, 0xd6, 0xc3, 0xbb, 0x63, 0x7c, 0xbf, 0xab, 0x19, 0x2e, 0x5b, 0x7c, 0xe3,
-	0xd1, 0xb2, 0xc5, 0x40, 0xaa, 0x6c, 0x43, 0xce, 0x87, 0xf8, 0x99, 0xdc, 0x18, 0xb1, 0xec, 0xa2,
-	0x52, 0x34, 0xf6, 0xd3, 0xbe, 0x95, 0x9c, 0xdb, 0xdb, 0x09, 0xc2, 0x39, 0x7c, 0x30, 0xa0, 0x9a,
-	0xdc, 0xac, 0xb3, 0x2b, 0x35, 0x3c, 0xd3, 0xb2, 0xec, 0xbc, 0xf7, 0x0c, 0xbb, 0x90, 0x4a, 0x5d,
-	0x5c, 0x04, 0x5e, 0xc6, 0x3c, 0xbf, 0xd1, 0xfc, 0x97, 0xe4, 0xf1, 0xff, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xa7, 0xa6, 0x78, 0xaf, 0x72, 0x11, 0x00, 0x00,
+	// 2615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x9b, 0xf9, 0x8f, 0x24, 0x37,
+	0x15, 0xc7, 0x19, 0x09, 0x02, 0xa9, 0x1c, 0x90, 0x4a, 0x48, 0x60, 0x41, 0x81, 0x1c, 0x4b, 0xae,
+	0xcd, 0x64, 0x8f, 0x2c, 0xc7, 0x8f, 0x33, 0x3d, 0xb3, 0x9d, 0x21, 0x33, 0xa2, 0xb7, 0xab, 0x77,
+	0x06, 0x11, 0x09, 0xc5, 0x53, 0xfd, 0xa6, 0xdb, 0x59, 0x97, 0x5d, 0x71, 0xb9, 0x86, 0x6d, 0x81,
+	0x84, 0x40, 0x20, 0x21, 0x21, 0x21, 0xc1, 0x2f, 0xfc, 0xbb, 0xa8, 0xce, 0x7e, 0x76, 0xd9, 0xaf,
+	0x7a, 0xf8, 0xb5, 0xdf, 0xc7, 0xfe, 0xba, 0x6c, 0xbf, 0xe7, 0xe7, 0xa3, 0xa3, 0x5b, 0x86, 0x5d,
+	0x0a, 0x30, 0x19, 0x93, 0x6c, 0x05, 0xba, 0x00, 0x7d, 0xcd, 0x53, 0xd8, 0xcf, 0xb5, 0x32, 0x2a,
+	0x7e, 0xcd, 0x67, 0xbb, 0xf5, 0x86, 0xf5, 0xeb, 0x92, 0x19, 0xd6, 0xe0, 0xf7, 0xff, 0x93, 0x45,
+	0x2f, 0x2d, 0x6a, 0xdb, 0x59, 0x63, 0x8b, 0x4f, 0xa2, 0x6f, 0xce, 0xb8, 0x5c, 0xc5, 0x6f, 0xee,
+	0x0f, 0xcb, 0x54, 0x86, 0x39, 0x7c, 0x5d, 0x42, 0x61, 0x6e, 0xfd, 0x24, 0x68, 0x2f, 0x72, 0x25,
+	0x0b, 0x78, 0xfb, 0x1b, 0xf1, 0x69, 0xf4, 0xad, 0x44, 0x00, 0xe4, 0xb1, 0x8f, 0xad, 0x2d, 0x5d,
+	0x65, 0x3f, 0x0d, 0x03, 0x7d, 0x6d, 0xbf, 0x8f, 0x5e, 0x38, 0x7e, 0x06, 0x69, 0x69, 0xe0, 0x33,
+	0xa5, 0x9e, 0xc6, 0xb7, 0x3d, 0x45, 0x90, 0xbd, 0xab, 0xf9, 0x67, 0x63, 0x58, 0x5f, 0xff, 0xb3,
+	0xe8, 0x55, 0x64, 0x58, 0xa8, 0xc4, 0x68, 0x60, 0x59, 0xfc, 0x31, 0x5d, 0x41, 0xc7, 0x75, 0x7a,
+	0xfb, 0xbb, 0xe2, 0x9d, 0xee, 0xdd, 0xbd, 0xf8, 0xb7, 0xd1, 0xf3, 0x53, 0x30, 0x49, 0xba, 0x86,
+	0x8c, 0xc5, 0xef, 0x78, 0x2a, 0xe8, 0xad, 0x9d, 0xca, 0xbb, 0x34, 0xd4, 0x7f, 0xd3, 0x75, 0xf4,
+	0xea, 0x14, 0xcc, 0x44, 0x03, 0x33, 0x90, 0x18, 0x66, 0x20, 0x03, 0x69, 0x0a, 0xef, 0x37, 0x79,
+	0x38, 0xea, 0x9b, 0xbc, 0xb8, 0xa3, 0xdb, 0x34, 0x67, 0xc1, 0x33, 0x28, 0x0c, 0xcb, 0xf2, 0xa0,
+	0xae, 0xcb, 0x8d, 0xe8, 0x0e, 0xf1, 0x5e, 0x77, 0x15, 0xbd, 0x3c, 0x05, 0x33, 0x03, 0x9d, 0xf1,
+	0xa2, 0xe0, 0x4a, 0x16, 0xf1, 0xfb, 0xfe, 0x3a, 0x10, 0xd2, 0xa9, 0x7d, 0xb0, 0x03, 0xd9, 0x0b,
+	0x15, 0x51, 0x5c, 0xf5, 0x80, 0x92, 0x12, 0x52, 0xc3, 0x95, 0xac, 0x7a, 0xa1, 0x88, 0xef, 0x04,
+	0x3a, 0xca, 0xc6, 0x3a, 0xc1, 0x8f, 0x77, 0xa4, 0x7b, 0xd1, 0x7f, 0xed, 0x45, 0x3f, 0xb2, 0x80,
+	0x39, 0x7c, 0x85, 0xe5, 0x1f, 0x8e, 0x55, 0x68, 0xf3, 0x5d, 0x3b, 0x7e, 0x7e, 0xd3, 0x62, 0x7d,
+	0x83, 0x9a, 0x89, 0x3b, 0x51, 0xf2, 0x8a, 0xaf, 0x42, 0x13, 0xb7, 0xb1, 0x8e, 0x4c, 0xdc, 0x0e,
+	0xea, 0x6b, 0xfe, 0x2a, 0xfa, 0xee, 0x14, 0xcc, 0x89, 0x7c, 0x24, 0xf8, 0x6a, 0x6d, 0xe6, 0xb3,
+	0x49, 0x11, 0x07, 0xc6, 0x07, 0x33, 0x9d, 0xca, 0x87, 0xbb, 0xa0, 0x8e, 0xd6, 0x4c, 0xab, 0x14,
+	0x8a, 0xa2, 0xe9, 0xc9, 0xd0, 0x5c, 0x40, 0xcc, 0x88, 0x96, 0x8d, 0x3a, 0x13, 0xf4, 0x33, 0x60,
+	0xc2, 0xac, 0x93, 0x54, 0x69, 0x08, 0x4d, 0x50, 0x84, 0x8c, 0x4c, 0x50, 0x8b, 0x74, 0x3e, 0xea,
+	0x58, 0x6b, 0xa5, 0x4f, 0xd5, 0x6a, 0xc1, 0xb8, 0x08, 0x7d, 0x14, 0x66, 0x46, 0x3e, 0xca, 0x46,
+	0xb1, 0x33, 0x4c, 0x58, 0x6e, 0x4a, 0x0d, 0x47, 0x9c, 0xad, 0xa4, 0x2a, 0x0c, 0x4f, 0xfd, 0xce,
+	0x30, 0xc4, 0x28, 0x67, 0xf0, 0xd1, 0xbd, 0x28, 0x8b, 0x5e, 0x9c, 0xac, 0x21, 0x7d, 0x7a, 0xc4,
+	0x0c, 0x3b, 0xe2, 0x3a, 0xf6, 0x05, 0x7a, 0x0c, 0x74, 0x42, 0xef, 0x8d, 0x72, 0xbd, 0x44, 0x16,
+	0x7d, 0x6f, 0x0a, 0x66, 0xb1, 0xd6, 0xca, 0x18, 0xd1, 0x04, 0xba, 0x38, 0xd0, 0x33, 0x16, 0xd4,
+	0x49, 0x7d, 0xb4, 0x13, 0xdb, 0xcb, 0x2d, 0xa3, 0x97, 0x2a, 0x6b, 0x5d, 0x64, 0xc1, 0x56, 0x45,
+	0xfc, 0x5e, 0xa0, 0x7c, 0x4f, 0x74, 0x42, 0xef, 0x8f, 0x83, 0x78, 0x19, 0x4d, 0xc0, 0xcc, 0x81,
+	0x2d, 0x7f, 0x23, 0xc5, 0xc6, 0xbb, 0x8c, 0x22, 0x3b, 0xb5, 0x8c, 0x5a, 0x18, 0x1e, 0x97, 0xd6,
+	0x70, 0xa1, 0xb9, 0x81, 0x98, 0x28, 0x59, 0x03, 0xd4, 0xb8, 0xd8, 0x1c, 0x9e, 0x6f, 0x48, 0xfb,
+	0x82, 0x9b, 0xf5, 0x62, 0x71, 0xea, 0x9d, 0x6f, 0x43, 0x8c, 0x9a, 0x6f, 0x3e, 0x1a, 0x4f, 0x86,
+	0x04, 0x4c, 0x52, 0xe6, 0xa0, 0xfb, 0xce, 0xfb, 0xd0, 0x5f, 0x89, 0x05, 0x51, 0x93, 0x61, 0xc8,
+	0xf6, 0x72, 0x9b, 0xe8, 0xb5, 0x04, 0xcc, 0xe3, 0x12, 0xf4, 0x26, 0x01, 0x7d, 0x0d, 0xba, 0x8d,
+	0xb2, 0xfb, 0xfe, 0x6a, 0x06, 0x60, 0x27, 0xfb, 0xc9, 0xce, 0x7c, 0x2f, 0x9d, 0x47, 0xaf, 0x4c,
+	0x5b, 0xe2, 0x50, 0xb0, 0xf4, 0xa9, 0xe0, 0x85, 0x89, 0x03, 0x73, 0xd9, 0xa6, 0x3a, 0xd1, 0x3b,
+	0xbb, 0xc1, 0x58, 0x31, 0xd9, 0x49, 0x31, 0xb9, 0x89, 0x62, 0x42, 0x28, 0x7e, 0x11, 0x45, 0x93,
+	0x35, 0x93, 0x2b, 0x58, 0x6c, 0x72, 0x88, 0xdf, 0xf5, 0xc6, 0x84, 0xce, 0xdc, 0x69, 0xdc, 0x1e,
+	0xa1, 0xb0, 0x23, 0x27, 0xa3, 0x8e, 0x9c, 0xec, 0xea, 0xc8, 0x49, 0xc0, 0x91, 0x59, 0xf4, 0xe2,
+	0x1c, 0xae, 0x34, 0x14, 0xeb, 0x26, 0x32, 0xf9, 0x1c, 0x0d, 0x03, 0x94, 0xa3, 0xd9, 0x1c, 0x4e,
+	0xe3, 0xe6, 0x90, 0x97, 0x97, 0x82, 0x17, 0xeb, 0x85, 0xca, 0xd5, 0x1c, 0x52, 0xa5, 0x97, 0xde,
+	0x34, 0xce, 0xc3, 0x51, 0x69, 0x9c, 0x17, 0xc7, 0xab, 0xe4, 0xbc, 0x94, 0xcd, 0xc2, 0x56, 0xc7,
+	0x66, 0xef, 0x2a, 0x69, 0x23, 0xd4, 0x2a, 0xe9, 0x92, 0x78, 0xe2, 0x9d, 0xac, 0xa4, 0xd2, 0xd0,
+	0x98, 0xeb, 0xf5, 0xcd, 0x3b, 0xf1, 0x06, 0x14, 0x35, 0xf1, 0x3c, 0xb0, 0x13, 0xbb, 0xce, 0x18,
+	0x97, 0x06, 0x24, 0x93, 0x29, 0x9c, 0xa9, 0x25, 0x84, 0x62, 0x97, 0x83, 0x8d, 0xc4, 0xae, 0x01,
+	0x8d, 0x83, 0xc9, 0x8c, 0x95, 0x45, 0xdb, 0xa4, 0x39, 0xe4, 0x4a, 0x9b, 0x6a, 0x8f, 0xe7, 0x1b,
+	0x19, 0x1f, 0x48, 0x05, 0x13, 0x3f, 0xef, 0x2c, 0x37, 0xdd, 0x92, 0x17, 0x5a, 0x6e, 0x3a, 0xfb,
+	0xc8, 0x72, 0xb3, 0xc5, 0xf0, 0x54, 0x99, 0x69, 0xc8, 0x99, 0x86, 0x49, 0x69, 0xd4, 0x35, 0x68,
+	0xef, 0x54, 0xb1, 0x11, 0x6a, 0xaa, 0xb8, 0x24, 0x76, 0xea, 0x89, 0xca, 0x32, 0x6e, 0x3a, 0x1d,
+	0x6f, 0x22, 0x81, 0x09, 0xca, 0xa9, 0x1d, 0x10, 0x3b, 0xf5, 0xc1, 0xa5, 0xd2, 0xbd, 0x88, 0xaf,
+	0x23, 0x30, 0x40, 0x39, 0xb5, 0xcd, 0x39, 0x33, 0xb0, 0x8a, 0xfd, 0x5c, 0xae, 0x3e, 0x87, 0xcd,
+	0x9c, 0xc9, 0x55, 0x70, 0x06, 0x3a, 0xd8, 0xc8, 0x0c, 0x1c, 0xd0, 0xbd, 0x68, 0x5a, 0x05, 0xab,
+	0xc2, 0x30, 0x6d, 0xce, 0x36, 0xc5, 0xd7, 0x22, 0x10, 0xac, 0xb6, 0x00, 0x1d, 0xac, 0x30, 0x87,
+	0xf6, 0xd1, 0x69, 0xf4, 0xe2, 0x11, 0xa4, 0x2a, 0x6b, 0xf7, 0x6b, 0x5e, 0x11, 0x0c, 0x50, 0x22,
+	0x36, 0x87, 0x44, 0xfe, 0x14, 0x7d, 0xbf, 0x8e, 0x22, 0x55, 0xe0, 0xea, 0xb6, 0x48, 0xd7, 0xdc,
+	0x6c, 0xe2, 0x4f, 0x42, 0x89, 0xa5, 0x4b, 0x76, 0xb2, 0x77, 0x77, 0x2f, 0xd0, 0xf7, 0xe3, 0xe3,
+	0xe8, 0xb9, 0x0b, 0xa6, 0xb3, 0x27, 0x79, 0xec, 0x3b, 0x32, 0x69, 0x4c, 0x5d, 0xfd, 0x6f, 0x11,
+	0x04, 0xfa, 0xa0, 0x7a, 0x1d, 0x11, 0x8a, 0x2d, 0xdb, 0x03, 0x08, 0xff, 0xd0, 0x6c, 0x01, 0x7a,
+	0x68, 0x30, 0x87, 0x37, 0x23, 0x33, 0x0d, 0x57, 0xf5, 0xe6, 0xab, 0x55, 0x09, 0xf8, 0x1e, 0x66,
+	0xa8, 0xcd, 0xc8, 0x00, 0xc5, 0x01, 0xe7, 0x20, 0xcf, 0xc5, 0xa6, 0xd5, 0xf1, 0x05, 0x1c, 0x64,
+	0xa7, 0x02, 0x8e, 0x85, 0xe1, 0xcc, 0xa1, 0xf9, 0xed, 0x88, 0x5f, 0x5d, 0x79, 0x33, 0x87, 0xad,
+	0x99, 0xca, 0x1c, 0x30, 0x85, 0x7d, 0xf3, 0xa0, 0x28, 0xaa, 0x7d, 0x63, 0x6d, 0x9d, 0xac, 0x83,
+	0xbe, 0x39, 0xc4, 0x28, 0xdf, 0xf4, 0xd1, 0xbd, 0xe8, 0x97, 0xd1, 0x0b, 0x17, 0xcc, 0xa4, 0x6b,
+	0xa2, 0xc7, 0x90, 0x9d, 0xea, 0x31, 0x0b, 0x43, 0x53, 0xec, 0x8b, 0x28, 0x9a, 0x82, 0x39, 0x6f,
+	0x05, 0x02, 0x67, 0x00, 0xe7, 0x76, 0xfd, 0xb7, 0x47, 0x28, 0x2b, 0x64, 0x56, 0x23, 0x75, 0x4e,
+	0xcc, 0x5f, 0x0c, 0x90, 0x21, 0xd3, 0xe2, 0x70, 0x9a, 0xd0, 0x9e, 0xe1, 0x3d, 0x02, 0x93, 0xae,
+	0x0f, 0x8a, 0xa3, 0x4b, 0xe6, 0x4d, 0x13, 0x06, 0x14, 0x95, 0x26, 0x78, 0xe0, 0x5e, 0xf1, 0x8f,
+	0xd1, 0x6b, 0x03, 0xf3, 0x24, 0x39, 0x8f, 0xf7, 0x77, 0xa9, 0x67, 0x92, 0x9c, 0x53, 0x2b, 0xb6,
+	0x9f, 0x47, 0xc3, 0xb5, 0xb1, 0xc5, 0x27, 0x4a, 0x94, 0x99, 0x64, 0x7a, 0x54, 0xbc, 0x03, 0x77,
+	0x15, 0xdf, 0xf2, 0xfd, 0x77, 0xff, 0x39, 0x7a, 0xdd, 0x6e, 0xde, 0x81, 0x10, 0x33, 0xcd, 0xaf,
+	0x8b, 0xf8, 0xee, 0xe8, 0x97, 0x74, 0x68, 0x27, 0x7f, 0xef, 0x06, 0x25, 0xc2, 0x43, 0x7d, 0x90,
+	0xe7, 0x3b, 0x0c, 0xf5, 0x41, 0x9e, 0xef, 0x3e, 0xd4, 0x35, 0x8c, 0xe7, 0xef, 0xf1, 0xb3, 0x5c,
+	0x30, 0x2e, 0xeb, 0xdd, 0x4a, 0xec, 0x3f, 0xb1, 0xde, 0x02, 0xd4, 0xfc, 0xb5, 0xb9, 0x41, 0x4c,
+	0x9c, 0x6a, 0x26, 0x4d, 0x11, 0x8e, 0x89, 0x8d, 0x7d, 0x34, 0x26, 0x76, 0x98, 0x95, 0x1b, 0x55,
+	0x0b, 0x57, 0x51, 0x66, 0xf5, 0x56, 0x25, 0x0e, 0x1e, 0xb2, 0x74, 0x04, 0x99, 0x1b, 0xd9, 0x20,
+	0x56, 0x59, 0xe8, 0x52, 0xa6, 0xcc, 0x40, 0x58, 0xc5, 0x22, 0x28, 0x15, 0x07, 0xc4, 0x7d, 0x35,
+	0x07, 0xc9, 0xb2, 0x56, 0xe3, 0xb6, 0x77, 0x95, 0xeb, 0xed, 0x54, 0x5f, 0x59, 0x18, 0xf6, 0xec,
+	0xf6, 0x0a, 0x40, 0xfd, 0xa1, 0x38, 0x91, 0x7d, 0x02, 0xe6, 0xdd, 0xd8, 0x7b, 0x40, 0x72, 0x63,
+	0xef, 0xe5, 0x91, 0x67, 0xf7, 0xe2, 0x9d, 0xf5, 0x90, 0x4b, 0xa1, 0x56, 0x84, 0xb8, 0x0d, 0x8e,
+	0x8b, 0xbb, 0x3c, 0x12, 0xff, 0x32, 0x7a, 0x61, 0x22, 0x94, 0x84, 0x06, 0xf4, 0xf6, 0x2c, 0xb2,
+	0x53, 0x3d, 0x6b, 0x61, 0x48, 0xa1, 0x39, 0xb0, 0x9b, 0xac, 0x99, 0x2e, 0xfa, 0x63, 0xe9, 0xc0,
+	0x81, 0x9d, 0x05, 0x8d, 0x1c, 0xd8, 0x39, 0xac, 0x7b, 0xdb, 0xd0, 0x9c, 0xf4, 0x9e, 0x56, 0x67,
+	0x16, 0xef, 0x93, 0x87, 0xc1, 0xa7, 0xe8, 0xc0, 0xe2, 0x83, 0x1d, 0x48, 0x3c, 0x27, 0x3f, 0xe7,
+	0x42, 0xb4, 0x46, 0x6f, 0xcf, 0x21, 0x3b, 0xd5, 0x73, 0x16, 0xd6, 0xd7, 0xcf, 0xa3, 0x97, 0xab,
+	0x23, 0xdd, 0x29, 0x48, 0xd0, 0x4c, 0x9c, 0xaa, 0x95, 0xf7, 0x43, 0x6c, 0x84, 0xfa, 0x10, 0x97,
+	0x44, 0x43, 0xf4, 0xbb, 0xe8, 0xf9, 0x66, 0xe0, 0x1e, 0xcf, 0x12, 0xef, 0x95, 0x41, 0x6f, 0xa5,
+	0xae, 0x0c, 0x10, 0x84, 0xea, 0xae, 0xf6, 0x9a, 0x82, 0x5d, 0x43, 0x62, 0x98, 0x29, 0xfd, 0xdd,
+	0x84, 0xec, 0xe4, 0x5e, 0x13, 0x63, 0x78, 0x71, 0x42, 0x86, 0x03, 0x21, 0xaa, 0x54, 0x4a, 0x82,
+	0xf0, 0x2f, 0x4e, 0x7e, 0x94, 0x5a, 0x9c, 0x42, 0x25, 0xf0, 0x3e, 0x7e, 0x0a, 0x66, 0x0e, 0xb9,
+	0xe0, 0x29, 0xab, 0x2f, 0x64, 0x54, 0xa9, 0x53, 0x7f, 0xec, 0xf0, 0x81, 0x94, 0xfb, 0xfa, 0xf9,
+	0x5e, 0xfa, 0xbf, 0x7b, 0xd1, 0x9b, 0xe7, 0x4c, 0xf0, 0x25, 0x33, 0x80, 0xb8, 0x89, 0x86, 0x25,
+	0x48, 0xc3, 0x99, 0x28, 0xe2, 0x5f, 0x7a, 0x6a, 0xa5, 0x8b, 0x74, 0xed, 0xf9, 0xd5, 0xff, 0x51,
+	0xd2, 0xe9, 0x94, 0x6a, 0x61, 0xe2, 0xa0, 0x1f, 0x97, 0x50, 0x42, 0x73, 0x87, 0x13, 0xe8, 0x94,
+	0x01, 0x38, 0xd2, 0x29, 0x1e, 0x1e, 0x07, 0x80, 0x33, 0x56, 0x18, 0xd0, 0x33, 0x55, 0xf0, 0xaa,
+	0x85, 0x5e, 0xbf, 0xb1, 0x11, 0xca, 0x6f, 0x5c, 0xd2, 0xb9, 0x1a, 0x98, 0x1a, 0xbe, 0x9c, 0x95,
+	0x7a, 0x05, 0xcb, 0xd0, 0xd5, 0xc0, 0x96, 0x18, 0xb9, 0x1a, 0xc0, 0xa0, 0x13, 0xcf, 0x9a, 0xc8,
+	0xdd, 0xf4, 0x61, 0xa0, 0x34, 0x42, 0x46, 0xe2, 0x99, 0x45, 0xf6, 0x42, 0x7f, 0xdf, 0x8b, 0x7e,
+	0x60, 0xcf, 0xb7, 0xfa, 0x98, 0xac, 0xd1, 0xbc, 0x3f, 0x3a, 0x39, 0xb7, 0x70, 0xa7, 0xfe, 0xe0,
+	0x46, 0x65, 0xf0, 0xfd, 0x65, 0x62, 0x54, 0x5e, 0xfb, 0x5d, 0x20, 0x18, 0xb5, 0x56, 0x3a, 0x18,
+	0xf5, 0x90, 0x75, 0x5b, 0xd0, 0xfd, 0x7c, 0xc6, 0x25, 0xcf, 0xca, 0xcc, 0x7f, 0x5b, 0xe0, 0x40,
+	0xe4, 0x6d, 0xc1, 0x80, 0xed, 0xe5, 0xfe, 0xb2, 0x17, 0xbd, 0xee, 0x9a, 0xdb, 0x65, 0xf6, 0xee,
+	0x0e, 0x35, 0xd9, 0x2b, 0xee, 0xbd, 0x1b, 0x94, 0x40, 0xd1, 0xf7, 0x6f, 0x7b, 0xd1, 0x1b, 0x87,
+	0x4a, 0x15, 0xb8, 0xd7, 0x27, 0xd5, 0x86, 0xb0, 0xcc, 0x63, 0x5f, 0x95, 0x01, 0xb6, 0x6b, 0xc5,
+	0xfd, 0x9b, 0x14, 0xb1, 0xf7, 0x9a, 0x89, 0x61, 0xda, 0x34, 0x83, 0xea, 0x1f, 0xaf, 0xce, 0x4c,
+	0xee, 0xcf, 0x11, 0xd5, 0xf7, 0xf3, 0x3f, 0xf6, 0xa2, 0x1f, 0x1e, 0xcb, 0x8a, 0x9d, 0x31, 0xcd,
+	0x84, 0x00, 0x81, 0x5a, 0x13, 0xfb, 0x66, 0x61, 0x90, 0xee, 0xb4, 0x3f, 0xbd, 0x59, 0xa1, 0xbe,
+	0x29, 0xff, 0xde, 0x8b, 0x7e, 0x3c, 0x57, 0x26, 0x1c, 0x8e, 0x7d, 0xd7, 0xfa, 0x54, 0x81, 0xae,
+	0x41, 0xbf, 0xb8, 0x71, 0xb9, 0xbe, 0x4d, 0x7f, 0xdd, 0x8b, 0xde, 0x68, 0xb2, 0xa4, 0x52, 0x63,
+	0x3a, 0x49, 0x4e, 0xbd, 0x53, 0x20, 0xc0, 0x52, 0x53, 0x20, 0x58, 0x04, 0x27, 0x33, 0x73, 0xc8,
+	0x59, 0x75, 0x93, 0x2b, 0xd8, 0x26, 0x94, 0xcc, 0xd8, 0x08, 0x79, 0x79, 0xe0, 0x90, 0x68, 0xae,
+	0xfd, 0x73, 0x2f, 0xba, 0xd5, 0xdc, 0xcd, 0x1c, 0x3f, 0x33, 0xa0, 0x25, 0x13, 0xd5, 0x1d, 0x5e,
+	0xce, 0x34, 0x48, 0x03, 0xcb, 0xf8, 0x53, 0x6f, 0x6a, 0x14, 0xc2, 0xbb, 0x36, 0x3c, 0xbc, 0x61,
+	0x29, 0xab, 0xf7, 0x5d, 0xf0, 0x58, 0x40, 0x5a, 0x35, 0xe5, 0xde, 0x0e, 0x95, 0xb6, 0x2c, 0xd5,
+	0xfb, 0xc1, 0x22, 0xce, 0x93, 0x90, 0xda, 0x6f, 0x8a, 0xe0, 0x5b, 0xa6, 0xda, 0x3a, 0xf6, 0x96,
+	0xa9, 0x85, 0x9c, 0x37, 0x45, 0x68, 0xd8, 0xa7, 0x9a, 0xe5, 0xeb, 0xd0, 0x9b, 0x22, 0x97, 0x1b,
+	0x79, 0x53, 0x34, 0xc4, 0xf1, 0xe1, 0xe5, 0x05, 0xe3, 0xe6, 0x50, 0xe4, 0xfd, 0x2a, 0xff, 0x81,
+	0xf7, 0xec, 0xcb, 0x62, 0xa8, 0xc3, 0xcb, 0x01, 0xda, 0x6b, 0xcd, 0xa3, 0x6f, 0x57, 0x91, 0xf6,
+	0x50, 0xe4, 0xf1, 0x5b, 0x81, 0x28, 0x7c, 0x28, 0xfa, 0x10, 0xf9, 0x36, 0x85, 0xf4, 0x75, 0x3e,
+	0x89, 0xbe, 0x53, 0xc7, 0xb2, 0xaa, 0xd2, 0xb7, 0x43, 0x81, 0x0e, 0xd5, 0xfa, 0x0e, 0xc9, 0x58,
+	0xfb, 0xe4, 0x52, 0x1e, 0x8a, 0xfc, 0x89, 0x34, 0x5c, 0xf8, 0xf7, 0xc9, 0x5b, 0x3b, 0xb9, 0x4f,
+	0xc6, 0x98, 0xf3, 0xf8, 0xa2, 0xc9, 0x1f, 0x1e, 0x71, 0x61, 0x40, 0x17, 0xa1, 0xbd, 0x9c, 0x05,
+	0x8d, 0xec, 0xe5, 0x1c, 0x16, 0xcb, 0xcd, 0xa1, 0xb0, 0x26, 0x82, 0x57, 0xce, 0x85, 0x28, 0xb9,
+	0x21, 0x8b, 0x4f, 0x91, 0x4f, 0x24, 0x37, 0x4d, 0xc2, 0xe7, 0x5d, 0xa5, 0xb6, 0x66, 0x6a, 0x95,
+	0xc2, 0x94, 0x15, 0x08, 0x66, 0x2a, 0x2f, 0x45, 0x13, 0xb3, 0xeb, 0x48, 0xf1, 0x6b, 0x55, 0x56,
+	0x2e, 0xeb, 0x0d, 0x04, 0x01, 0x96, 0x0a, 0x04, 0xc1, 0x22, 0xee, 0x63, 0xb5, 0x53, 0x56, 0x18,
+	0x07, 0x3a, 0x96, 0x46, 0x6f, 0x42, 0x8f, 0xd5, 0x42, 0xfc, 0xc8, 0x63, 0xb5, 0x70, 0x31, 0x1c,
+	0x99, 0xaa, 0xde, 0x0a, 0x27, 0x7b, 0xbd, 0x95, 0x8a, 0x4c, 0x08, 0xc2, 0x27, 0x78, 0x47, 0x90,
+	0x29, 0x03, 0xed, 0x70, 0xfa, 0xef, 0x9d, 0xb6, 0x00, 0x7d, 0xef, 0x84, 0x39, 0x2b, 0x63, 0x9e,
+	0x69, 0x55, 0xd9, 0x6a, 0xf5, 0x8b, 0x35, 0xc8, 0x09, 0x2b, 0x57, 0x6b, 0xf3, 0x24, 0xf7, 0x66,
+	0xcc, 0x21, 0x98, 0xca, 0x98, 0xc3, 0x65, 0xac, 0xbc, 0xb6, 0x36, 0xb3, 0xa2, 0xa5, 0x97, 0xfe,
+	0xbc, 0xd6, 0x81, 0xc8, 0xbc, 0x76, 0xc0, 0x5a, 0x09, 0x3a, 0x74, 0x5e, 0xf2, 0x4e, 0xe8, 0xda,
+	0x1b, 0xf7, 0xe9, 0xbb, 0x34, 0x84, 0x77, 0x8d, 0xbe, 0x54, 0xc2, 0xbb, 0x6b, 0xf4, 0x81, 0xd4,
+	0xae, 0xd1, 0xcf, 0x5b, 0xaf, 0x5d, 0xda, 0x4f, 0x6e, 0xaf, 0x32, 0x61, 0x19, 0x53, 0x1d, 0xd3,
+	0x53, 0xe4, 0x6b, 0x97, 0x21, 0x6c, 0xf9, 0x62, 0xb5, 0x30, 0xa0, 0xf6, 0x1c, 0xc8, 0x65, 0xb5,
+	0xc8, 0x36, 0x27, 0x25, 0x0f, 0x03, 0x0b, 0x49, 0x80, 0xa7, 0x7c, 0x91, 0x2c, 0x86, 0x3d, 0x06,
+	0x4f, 0x36, 0xaf, 0xc7, 0x60, 0x80, 0xf2, 0x18, 0x9b, 0xb3, 0x0e, 0x6b, 0xa0, 0x8f, 0x09, 0xc7,
+	0x82, 0xaf, 0xf8, 0x25, 0x17, 0xd5, 0x45, 0xed, 0xdd, 0xd0, 0xd3, 0xaf, 0x01, 0x4a, 0xee, 0x88,
+	0x02, 0x25, 0x70, 0x03, 0xda, 0xe7, 0x26, 0x0d, 0x35, 0x61, 0x72, 0x59, 0x9f, 0x68, 0xc4, 0xc1,
+	0x8b, 0xdf, 0x01, 0x4a, 0x35, 0x20, 0x54, 0x02, 0x27, 0x4c, 0xf5, 0x9d, 0x7c, 0xc6, 0x93, 0x8d,
+	0x4c, 0x0f, 0xd2, 0xa7, 0x13, 0x55, 0x4a, 0x13, 0x07, 0xef, 0xee, 0x6d, 0x8e, 0x4a, 0x98, 0xbc,
+	0xb8, 0x93, 0xa8, 0x1d, 0x95, 0x9a, 0x35, 0x7d, 0x32, 0x53, 0x82, 0xa7, 0x9b, 0x50, 0xa2, 0xe6,
+	0x72, 0x23, 0x89, 0xda, 0x10, 0x77, 0x9e, 0xbc, 0x1e, 0xb2, 0xf4, 0x69, 0x99, 0x9f, 0xf2, 0x8c,
+	0x87, 0xdf, 0xf1, 0x62, 0x66, 0xe4, 0xc9, 0xab, 0x8d, 0x3a, 0x6f, 0xe4, 0x1a, 0x63, 0x9f, 0x16,
+	0x7e, 0x44, 0x55, 0xe1, 0x26, 0x86, 0x77, 0x76, 0x83, 0xf1, 0xcd, 0x7f, 0x63, 0xf3, 0xde, 0xfc,
+	0x37, 0x26, 0xea, 0xe6, 0xbf, 0x23, 0xd0, 0xf6, 0x45, 0x47, 0xaf, 0x9c, 0xc8, 0x54, 0x43, 0x06,
+	0xd2, 0x30, 0xd1, 0xd6, 0xee, 0x7d, 0xfd, 0xe4, 0x52, 0xe4, 0xeb, 0xa7, 0x21, 0x6c, 0x6b, 0xce,
+	0xa1, 0x30, 0x4a, 0xc3, 0x23, 0xad, 0x32, 0x42, 0x73, 0x40, 0x51, 0x9a, 0x1e, 0x18, 0x69, 0x96,
+	0x51, 0xdc, 0x02, 0x0b, 0xd5, 0xff, 0x6d, 0x20, 0x26, 0xea, 0x41, 0x18, 0x75, 0xab, 0xee, 0xa3,
+	0x91, 0x6c, 0xf3, 0xd0, 0xa6, 0x7a, 0xa9, 0x00, 0x5a, 0xc3, 0xb2, 0xfd, 0xd6, 0xc0, 0x43, 0x1b,
+	0x07, 0x1b, 0x79, 0x68, 0x33, 0xa0, 0x9d, 0x3f, 0x26, 0xec, 0x22, 0x3a, 0xbd, 0x91, 0xe8, 0x94,
+	0x12, 0xad, 0x8e, 0x45, 0xba, 0xa7, 0x75, 0x55, 0x8f, 0x4c, 0x54, 0x96, 0x33, 0xd3, 0xc5, 0xdb,
+	0x07, 0xe1, 0xe0, 0x35, 0xa4, 0xa9, 0x63, 0x11, 0xa2, 0x90, 0xe3, 0x98, 0x55, 0x3a, 0xd8, 0xb4,
+	0xf2, 0x44, 0x5e, 0xa9, 0x90, 0x63, 0xda, 0xd4, 0x88, 0x63, 0xba, 0xb0, 0xd3, 0xe3, 0x8d, 0xe9,
+	0x51, 0xf5, 0x8a, 0x52, 0x56, 0x77, 0x34, 0xa4, 0x7b, 0xf7, 0xd8, 0x48, 0x8f, 0x0f, 0x68, 0x2b,
+	0x7d, 0xa9, 0x7a, 0xa3, 0x1b, 0x0a, 0xb6, 0xe4, 0xb5, 0xec, 0x7e, 0xa8, 0xdb, 0x1c, 0x90, 0x4c,
+	0x5f, 0xbc, 0x3c, 0x7e, 0x2e, 0xb2, 0x80, 0xc2, 0xb4, 0xe3, 0xe0, 0xdd, 0xf8, 0x21, 0x3b, 0xb5,
+	0xf1, 0xb3, 0xb0, 0xad, 0xe3, 0x5c, 0x3e, 0x57, 0xff, 0x37, 0xed, 0xc1, 0xff, 0x06, 0x00, 0xd9,
+	0x2b, 0x8f, 0xdb, 0xe8, 0x36, 0x00, 0x00,
 }
@@ -1014,7 +1014,8 @@ func (shardSwap *shardSchemaSwap) applySeedSchemaChange() (err error) {
 		[]byte(shardSwap.parent.sql),
 		0,    /* maxRows */
 		true, /* disableBinlogs */
-		true /* reloadSchema */)
+		true, /* reloadSchema */
+		0 /* maxExecTime */)
 	if err != nil {
 		if undrainErr := shardSwap.undrainSeedTablet(seedTablet, seedTabletType); undrainErr != nil {
 			// We won't return error of undraining because we already have error of SQL execution.
@@ -1030,9 +1031,10 @@ func (shardSwap *shardSchemaSwap) applySeedSchemaChange() (err error) {
 		seedTablet,
 		true, /* usePool */
 		[]byte(updateAppliedSwapQuery),
-		0,    /* maxRows */
-		true, /* disableBinlogs */
-		false /* reloadSchema */)
+		0,     /* maxRows */
+		true,  /* disableBinlogs */
+		false, /* reloadSchema */
+		0 /* maxExecTime */)
 	if err != nil {
 		if undrainErr := shardSwap.undrainSeedTablet(seedTablet, seedTabletType); undrainErr != nil {
 			// We won't return error of undraining because we already have error of SQL execution.
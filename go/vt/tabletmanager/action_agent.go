@@ -25,11 +25,14 @@ import (
 	"encoding/hex"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
 	"net"
 	"os"
 	"path"
 	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -54,6 +57,7 @@ import (
 	"github.com/youtube/vitess/go/vt/topo/topoproto"
 
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
 )
 
@@ -61,6 +65,19 @@ const (
 	// slaveStoppedFile is the file name for the file whose existence informs
 	// vttablet to NOT try to repair replication.
 	slaveStoppedFile = "do_not_replicate"
+
+	// maintenanceFile is the file name for the file whose existence
+	// means the tablet is in maintenance. It contains the reason.
+	maintenanceFile = "maintenance"
+
+	// readOnlyTTLFile is the file name for the file whose existence
+	// means SetReadOnlyWithTTL made mysql read-only. It contains the
+	// time to make it read-write again, in nanoseconds since the epoch.
+	readOnlyTTLFile = "read_only_ttl"
+
+	// readOnlyTTLRetryDelay is how long to wait before trying again
+	// to make mysql read-write, when it fails at the end of the TTL.
+	readOnlyTTLRetryDelay = 10 * time.Second
 )
 
 var (
@@ -147,6 +164,10 @@ type ActionAgent struct {
 	// blacklisting.
 	_blacklistedTables []string
 
+	// _queryBlacklist has the query patterns we are currently
+	// rejecting, as set by SetQueryBlacklist.
+	_queryBlacklist []string
+
 	// set to true if mysql is not up when we start. That way, we
 	// only log once that we'r waiting for mysql.
 	_waitingForMysql bool
@@ -171,6 +192,105 @@ type ActionAgent struct {
 	// _slaveStopped remembers if we've been told to stop replicating.
 	// If it's nil, we'll try to check for the slaveStoppedFile.
 	_slaveStopped *bool
+
+	// _reparentIneligible is set by SetReparentEligibility when reparent
+	// tools should not choose this tablet as the new master, with
+	// _reparentIneligibleReason explaining why. It is not persisted.
+	_reparentIneligible       bool
+	_reparentIneligibleReason string
+
+	// _maintenance remembers if the tablet is in maintenance, with
+	// _maintenanceReason explaining why. If it's nil, we'll try to
+	// check for the maintenanceFile.
+	_maintenance       *bool
+	_maintenanceReason string
+
+	// _healthReportingPausedUntil is set by PauseHealthReporting.
+	// Until then, broadcastHealth publishes _pausedHealthy and
+	// _pausedReplicationDelay, the health when reporting was paused,
+	// instead of the current health. It is zero when reporting is
+	// not paused. It is not persisted.
+	_healthReportingPausedUntil time.Time
+	_pausedHealthy              error
+	_pausedReplicationDelay     time.Duration
+
+	// _throttleMode is the throttle mode forced by SetThrottle, until
+	// _throttleModeUntil. It is empty when heavy operations are
+	// throttled on replication lag. checkThrottle uses it to refuse
+	// or allow them. It is not persisted.
+	_throttleMode      string
+	_throttleModeUntil time.Time
+
+	// _tailingGeneralLog is set while TailGeneralLog runs, as only
+	// one may change the general log settings at a time.
+	_tailingGeneralLog bool
+
+	// _boostingCatchup is set while BoostReplicationCatchup runs, as
+	// only one may change the replication settings at a time.
+	_boostingCatchup bool
+
+	// _cutoverToken identifies the cutover prepared by
+	// PrepareCutover, or is empty. _cutoverTimer aborts it after
+	// -cutover_prepare_timeout, and _cutoverWasReadOnly is the
+	// read-only state to restore on abort. They should only be
+	// accessed while holding actionMutex.
+	_cutoverToken       string
+	_cutoverTimer       *time.Timer
+	_cutoverWasReadOnly bool
+
+	// _servingKeyRange and _servingKeyRangeTypes are the keyrange
+	// the tablet serves and the tablet types it serves it for, as
+	// set together by SetServingKeyRange. _servingKeyRange is nil
+	// if it was never set. The query service enforces them with the
+	// servingKeyRangeQueryRules. They are not persisted.
+	_servingKeyRange      *topodatapb.KeyRange
+	_servingKeyRangeTypes []topodatapb.TabletType
+
+	// _readOnlyTTLExpiry is when mysql is made read-write again,
+	// after SetReadOnlyWithTTL, by _readOnlyTTLTimer. It is zero if
+	// no revert is pending. It is persisted in readOnlyTTLFile. They
+	// should only be accessed while holding actionMutex.
+	_readOnlyTTLExpiry time.Time
+	_readOnlyTTLTimer  *time.Timer
+
+	// _binlogSamples are the last two transaction counts sampled by
+	// the health check, oldest first. GetBinlogStats uses them.
+	_binlogSamples [2]binlogSample
+
+	// _replicationErrors are the replication errors and reconnects
+	// counted by the health check since the last reset.
+	// GetReplicationErrorStats uses them.
+	_replicationErrors replicationErrorCounts
+
+	// _lastBackup describes the last backup taken by Backup since
+	// the tablet started. It is nil if there was none.
+	_lastBackup *tabletmanagerdatapb.BackupInfo
+
+	// _testRestoreRunning is true while TestRestore runs, so only
+	// one scratch mysqld runs at a time.
+	_testRestoreRunning bool
+
+	// schemaNotifierOnce registers schemaChanged with the query
+	// service, the first time WatchSchema is called.
+	schemaNotifierOnce sync.Once
+	// schemaWatchMutex protects _schemaWatchers, _schemaSnapshot and
+	// _schemaTables. It is held while changes are published, so all
+	// the watchers get them in the same order.
+	schemaWatchMutex sync.Mutex
+	// _schemaWatchers are the running WatchSchema calls.
+	// _schemaSnapshot is the definition of each table and view when
+	// changes were last published. It is nil if there are no watchers.
+	// _schemaTables are the tables of the query service schema at its
+	// last notification.
+	_schemaWatchers map[*schemaWatcher]bool
+	_schemaSnapshot map[string]*tabletmanagerdatapb.TableDefinition
+	_schemaTables   map[string]*tabletserver.TableInfo
+
+	// inFlightMutex protects _inFlightRPCs.
+	inFlightMutex sync.Mutex
+	// _inFlightRPCs is the number of RPCs being processed, by method
+	// name. Methods with no RPC in flight are not in the map.
+	_inFlightRPCs map[string]int64
 }
 
 // NewActionAgent creates a new ActionAgent and registers all the
@@ -215,6 +335,10 @@ func NewActionAgent(
 	// Create the TabletType stats
 	agent.exportStats = true
 	agent.statsTabletType = stats.NewString("TabletType")
+	stats.Publish("TabletMaintenanceReason", stats.StringFunc(func() string {
+		_, reason := agent.maintenanceMode()
+		return reason
+	}))
 
 	// Start the binlog player services, not playing at start.
 	agent.BinlogPlayerMap = NewBinlogPlayerMap(ts, mysqld, func() binlogplayer.VtClient {
@@ -240,6 +364,9 @@ func NewActionAgent(
 		return nil, err
 	}
 
+	// A read-only TTL set before a restart still applies.
+	agent.resumeReadOnlyTTL()
+
 	// register the RPC services from the agent
 	servenv.OnRun(func() {
 		agent.registerQueryService()
@@ -357,6 +484,8 @@ func NewComboActionAgent(batchCtx context.Context, ts topo.Server, tabletAlias *
 // registerQueryRuleSources registers query rule sources under control of agent
 func (agent *ActionAgent) registerQueryRuleSources() {
 	agent.QueryServiceControl.RegisterQueryRuleSource(blacklistQueryRules)
+	agent.QueryServiceControl.RegisterQueryRuleSource(queryBlacklistQueryRules)
+	agent.QueryServiceControl.RegisterQueryRuleSource(servingKeyRangeQueryRules)
 }
 
 func (agent *ActionAgent) setTablet(tablet *topodatapb.Tablet) {
@@ -455,6 +584,142 @@ func (agent *ActionAgent) setSlaveStopped(slaveStopped bool) {
 	}
 }
 
+// maintenanceMode returns whether the tablet is in maintenance, and
+// the reason for it.
+func (agent *ActionAgent) maintenanceMode() (bool, string) {
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+
+	if agent._maintenance == nil {
+		// If the marker file exists, we're in maintenance.
+		// Treat any read error as if the file doesn't exist.
+		data, err := ioutil.ReadFile(path.Join(agent.MysqlDaemon.TabletDir(), maintenanceFile))
+		maintenance := err == nil
+		agent._maintenance = &maintenance
+		agent._maintenanceReason = string(data)
+	}
+	return *agent._maintenance, agent._maintenanceReason
+}
+
+// setMaintenanceMode sets or clears the maintenance marker. Unlike
+// setSlaveStopped, persisting the marker is not best-effort: the
+// point of the marker is to survive restarts, so it is an error if
+// it cannot be saved.
+func (agent *ActionAgent) setMaintenanceMode(maintenance bool, reason string) error {
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+
+	tabletDir := agent.MysqlDaemon.TabletDir()
+	if tabletDir == "" {
+		return fmt.Errorf("no tablet directory to persist the maintenance marker in")
+	}
+	markerFile := path.Join(tabletDir, maintenanceFile)
+	if maintenance {
+		if err := ioutil.WriteFile(markerFile, []byte(reason), 0644); err != nil {
+			return err
+		}
+	} else {
+		reason = ""
+		if err := os.Remove(markerFile); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	agent._maintenance = &maintenance
+	agent._maintenanceReason = reason
+	return nil
+}
+
+// setReadOnlyTTLLocked persists expiry in the readOnlyTTLFile marker,
+// and arms the timer to make mysql read-write then. Like
+// setMaintenanceMode, it is an error if the marker cannot be saved.
+// It must be called with actionMutex held.
+func (agent *ActionAgent) setReadOnlyTTLLocked(expiry time.Time) error {
+	tabletDir := agent.MysqlDaemon.TabletDir()
+	if tabletDir == "" {
+		return fmt.Errorf("no tablet directory to persist the read-only TTL marker in")
+	}
+	if err := ioutil.WriteFile(path.Join(tabletDir, readOnlyTTLFile), []byte(strconv.FormatInt(expiry.UnixNano(), 10)), 0644); err != nil {
+		return err
+	}
+	agent.armReadOnlyTTLLocked(expiry)
+	return nil
+}
+
+// armReadOnlyTTLLocked replaces the read-only TTL timer with one
+// firing at expiry. It must be called with actionMutex held.
+func (agent *ActionAgent) armReadOnlyTTLLocked(expiry time.Time) {
+	if agent._readOnlyTTLTimer != nil {
+		agent._readOnlyTTLTimer.Stop()
+	}
+	agent._readOnlyTTLExpiry = expiry
+	agent._readOnlyTTLTimer = time.AfterFunc(expiry.Sub(time.Now()), func() {
+		agent.readOnlyTTLExpired(expiry)
+	})
+}
+
+// clearReadOnlyTTLLocked cancels the pending read-only revert, if any,
+// and removes its marker. It must be called with actionMutex held.
+func (agent *ActionAgent) clearReadOnlyTTLLocked() {
+	if agent._readOnlyTTLTimer != nil {
+		agent._readOnlyTTLTimer.Stop()
+		agent._readOnlyTTLTimer = nil
+	}
+	agent._readOnlyTTLExpiry = time.Time{}
+	if err := os.Remove(path.Join(agent.MysqlDaemon.TabletDir(), readOnlyTTLFile)); err != nil && !os.IsNotExist(err) {
+		log.Warningf("cannot remove the read-only TTL marker: %v", err)
+	}
+}
+
+// readOnlyTTLExpired makes mysql read-write at the end of the TTL set
+// to expire at expiry. A tablet that is not a master any more, for
+// instance after a reparent, is left read-only.
+func (agent *ActionAgent) readOnlyTTLExpired(expiry time.Time) {
+	agent.actionMutex.Lock()
+	defer agent.actionMutex.Unlock()
+
+	// The TTL may have been extended or cleared while we waited for
+	// the lock.
+	if !agent._readOnlyTTLExpiry.Equal(expiry) {
+		return
+	}
+	if tablet := agent.Tablet(); tablet == nil || tablet.Type != topodatapb.TabletType_MASTER {
+		log.Infof("read-only TTL expired, but the tablet is not a master, leaving mysql read-only")
+		agent.clearReadOnlyTTLLocked()
+		return
+	}
+	log.Infof("read-only TTL expired, making mysql read-write")
+	if err := agent.MysqlDaemon.SetReadOnly(false); err != nil {
+		log.Errorf("cannot make mysql read-write at the end of the read-only TTL, trying again in %v: %v", readOnlyTTLRetryDelay, err)
+		agent.armReadOnlyTTLLocked(time.Now().Add(readOnlyTTLRetryDelay))
+		return
+	}
+	agent.clearReadOnlyTTLLocked()
+}
+
+// resumeReadOnlyTTL re-arms the read-only TTL persisted by a previous
+// run of the tablet, if any. If it expired while the tablet was down,
+// mysql is made read-write right away.
+func (agent *ActionAgent) resumeReadOnlyTTL() {
+	// Treat any read error as if the file doesn't exist.
+	data, err := ioutil.ReadFile(path.Join(agent.MysqlDaemon.TabletDir(), readOnlyTTLFile))
+	if err != nil {
+		return
+	}
+
+	agent.actionMutex.Lock()
+	defer agent.actionMutex.Unlock()
+
+	nsec, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		log.Warningf("ignoring invalid read-only TTL marker %q: %v", data, err)
+		agent.clearReadOnlyTTLLocked()
+		return
+	}
+	expiry := time.Unix(0, nsec)
+	log.Infof("resuming the read-only TTL, expiring at %v", expiry)
+	agent.armReadOnlyTTLLocked(expiry)
+}
+
 func (agent *ActionAgent) setServicesDesiredState(disallowQueryService string, enableUpdateStream bool) {
 	agent.mutex.Lock()
 	agent._disallowQueryService = disallowQueryService
@@ -18,14 +18,17 @@ import (
 	"github.com/youtube/vitess/go/sqltypes"
 	"github.com/youtube/vitess/go/vt/hook"
 	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
 	"github.com/youtube/vitess/go/vt/mysqlctl/tmutils"
 	"github.com/youtube/vitess/go/vt/tabletmanager"
 	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
 
+	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vschemapb "github.com/youtube/vitess/go/vt/proto/vschema"
 )
 
 // fakeRPCAgent implements tabletmanager.RPCAgent and fills in all
@@ -240,6 +243,79 @@ func agentRPCTestGetSchemaPanic(ctx context.Context, t *testing.T, client tmclie
 	expectHandleRPCPanic(t, "GetSchema", false /*verbose*/, err)
 }
 
+var testGetSchemaBestEffortTimeout = 10 * time.Second
+
+func (fra *fakeRPCAgent) GetSchemaBestEffort(ctx context.Context, tables, excludeTables []string, includeViews bool, timeout time.Duration) (*tabletmanagerdatapb.SchemaDefinition, bool, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetSchemaBestEffort tables", tables, testGetSchemaTables)
+	compare(fra.t, "GetSchemaBestEffort excludeTables", excludeTables, testGetSchemaExcludeTables)
+	compareBool(fra.t, "GetSchemaBestEffort includeViews", includeViews)
+	compare(fra.t, "GetSchemaBestEffort timeout", timeout, testGetSchemaBestEffortTimeout)
+	return testGetSchemaReply, true, nil
+}
+
+func agentRPCTestGetSchemaBestEffort(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, incomplete, err := client.GetSchemaBestEffort(ctx, tablet, testGetSchemaTables, testGetSchemaExcludeTables, true, testGetSchemaBestEffortTimeout)
+	compareError(t, "GetSchemaBestEffort", err, result, testGetSchemaReply)
+	if !incomplete {
+		t.Errorf("GetSchemaBestEffort lost the incomplete flag")
+	}
+}
+
+func agentRPCTestGetSchemaBestEffortPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, _, err := client.GetSchemaBestEffort(ctx, tablet, testGetSchemaTables, testGetSchemaExcludeTables, true, testGetSchemaBestEffortTimeout)
+	expectHandleRPCPanic(t, "GetSchema", false /*verbose*/, err)
+}
+
+var testGetCreateStatementsReply = map[string]string{
+	"table_name": "CREATE TABLE `table_name` (\n  `id` bigint(20) NOT NULL AUTO_INCREMENT,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB AUTO_INCREMENT=42 DEFAULT CHARSET=utf8mb4",
+}
+
+func (fra *fakeRPCAgent) GetCreateStatements(ctx context.Context, tables, excludeTables []string, includeViews bool) (map[string]string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetCreateStatements tables", tables, testGetSchemaTables)
+	compare(fra.t, "GetCreateStatements excludeTables", excludeTables, testGetSchemaExcludeTables)
+	compareBool(fra.t, "GetCreateStatements includeViews", includeViews)
+	return testGetCreateStatementsReply, nil
+}
+
+func agentRPCTestGetCreateStatements(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetCreateStatements(ctx, tablet, testGetSchemaTables, testGetSchemaExcludeTables, true)
+	compareError(t, "GetCreateStatements", err, result, testGetCreateStatementsReply)
+}
+
+func agentRPCTestGetCreateStatementsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetCreateStatements(ctx, tablet, testGetSchemaTables, testGetSchemaExcludeTables, true)
+	expectHandleRPCPanic(t, "GetCreateStatements", false /*verbose*/, err)
+}
+
+var testGetSchemaTimestampsReply = map[string]time.Time{
+	"table1": time.Unix(1490000000, 0),
+	"table2": time.Unix(1491000000, 0),
+}
+
+func (fra *fakeRPCAgent) GetSchemaTimestamps(ctx context.Context, tables []string) (map[string]time.Time, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetSchemaTimestamps tables", tables, testGetSchemaTables)
+	return testGetSchemaTimestampsReply, nil
+}
+
+func agentRPCTestGetSchemaTimestamps(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetSchemaTimestamps(ctx, tablet, testGetSchemaTables)
+	compareError(t, "GetSchemaTimestamps", err, result, testGetSchemaTimestampsReply)
+}
+
+func agentRPCTestGetSchemaTimestampsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetSchemaTimestamps(ctx, tablet, testGetSchemaTables)
+	expectHandleRPCPanic(t, "GetSchemaTimestamps", false /*verbose*/, err)
+}
+
 var testGetPermissionsReply = &tabletmanagerdatapb.Permissions{
 	UserPermissions: []*tabletmanagerdatapb.UserPermission{
 		{
@@ -282,6 +358,287 @@ func agentRPCTestGetPermissionsPanic(ctx context.Context, t *testing.T, client t
 	expectHandleRPCPanic(t, "GetPermissions", false /*verbose*/, err)
 }
 
+var testGetConnectionStatsReply = &tabletmanagerdatapb.ConnectionStats{
+	OpenConnections:    7,
+	ActiveTransactions: 3,
+	PoolCapacity:       40,
+}
+
+func (fra *fakeRPCAgent) GetConnectionStats(ctx context.Context) (*tabletmanagerdatapb.ConnectionStats, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testGetConnectionStatsReply, nil
+}
+
+func agentRPCTestGetConnectionStats(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetConnectionStats(ctx, tablet)
+	compareError(t, "GetConnectionStats", err, result, testGetConnectionStatsReply)
+}
+
+func agentRPCTestGetConnectionStatsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetConnectionStats(ctx, tablet)
+	expectHandleRPCPanic(t, "GetConnectionStats", false /*verbose*/, err)
+}
+
+var testConnectionRejectionStatsReset = true
+var testConnectionRejectionStatsReply = map[string]int64{
+	"PoolExhausted":  12,
+	"MaxConnections": 2,
+}
+
+func (fra *fakeRPCAgent) GetConnectionRejectionStats(ctx context.Context, reset bool) (map[string]int64, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetConnectionRejectionStats reset", reset, testConnectionRejectionStatsReset)
+	return testConnectionRejectionStatsReply, nil
+}
+
+func agentRPCTestGetConnectionRejectionStats(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetConnectionRejectionStats(ctx, tablet, testConnectionRejectionStatsReset)
+	compareError(t, "GetConnectionRejectionStats", err, result, testConnectionRejectionStatsReply)
+}
+
+func agentRPCTestGetConnectionRejectionStatsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetConnectionRejectionStats(ctx, tablet, testConnectionRejectionStatsReset)
+	expectHandleRPCPanic(t, "GetConnectionRejectionStats", true /*verbose*/, err)
+}
+
+var testGetConfigReply = map[string]string{
+	"queryserver-config-pool-size":     "24",
+	"queryserver-config-query-timeout": "30",
+}
+
+func (fra *fakeRPCAgent) GetConfig(ctx context.Context) (map[string]string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testGetConfigReply, nil
+}
+
+func agentRPCTestGetConfig(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetConfig(ctx, tablet)
+	compareError(t, "GetConfig", err, result, testGetConfigReply)
+}
+
+func agentRPCTestGetConfigPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetConfig(ctx, tablet)
+	expectHandleRPCPanic(t, "GetConfig", false /*verbose*/, err)
+}
+
+var testGetInFlightRPCsReply = map[string]int64{
+	"Backup":            1,
+	"ExecuteFetchAsDba": 3,
+}
+
+func (fra *fakeRPCAgent) GetInFlightRPCs(ctx context.Context) (map[string]int64, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testGetInFlightRPCsReply, nil
+}
+
+func agentRPCTestGetInFlightRPCs(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetInFlightRPCs(ctx, tablet)
+	compareError(t, "GetInFlightRPCs", err, result, testGetInFlightRPCsReply)
+}
+
+func agentRPCTestGetInFlightRPCsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetInFlightRPCs(ctx, tablet)
+	expectHandleRPCPanic(t, "GetInFlightRPCs", false /*verbose*/, err)
+}
+
+var testProcessStats = &tabletmanagerdatapb.ProcessStats{
+	Goroutines:     1234,
+	Threads:        56,
+	OpenFiles:      789,
+	HeapAllocBytes: 64 << 20,
+	SysBytes:       256 << 20,
+}
+
+func (fra *fakeRPCAgent) GetProcessStats(ctx context.Context) (*tabletmanagerdatapb.ProcessStats, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testProcessStats, nil
+}
+
+func agentRPCTestGetProcessStats(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stats, err := client.GetProcessStats(ctx, tablet)
+	compareError(t, "GetProcessStats", err, stats, testProcessStats)
+}
+
+func agentRPCTestGetProcessStatsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetProcessStats(ctx, tablet)
+	expectHandleRPCPanic(t, "GetProcessStats", false /*verbose*/, err)
+}
+
+var testHealthScore = &tabletmanagerdatapb.HealthScore{
+	Score:                87,
+	ReplicationLagFactor: 0.9,
+	ErrorRateFactor:      0.95,
+	LoadFactor:           0.7,
+}
+
+func (fra *fakeRPCAgent) GetHealthScore(ctx context.Context) (*tabletmanagerdatapb.HealthScore, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testHealthScore, nil
+}
+
+func agentRPCTestGetHealthScore(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	score, err := client.GetHealthScore(ctx, tablet)
+	compareError(t, "GetHealthScore", err, score, testHealthScore)
+}
+
+func agentRPCTestGetHealthScorePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetHealthScore(ctx, tablet)
+	expectHandleRPCPanic(t, "GetHealthScore", false /*verbose*/, err)
+}
+
+var testErrorLogTailLines = 2
+var testErrorLogTail = []string{
+	"2017-06-01T10:00:00.000000Z 0 [Note] mysqld: ready for connections.",
+	"2017-06-01T10:05:00.000000Z 12 [Warning] Aborted connection 12 to db: 'vt_ks'",
+}
+
+func (fra *fakeRPCAgent) GetErrorLogTail(ctx context.Context, lines int) ([]string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetErrorLogTail lines", lines, testErrorLogTailLines)
+	return testErrorLogTail, nil
+}
+
+func agentRPCTestGetErrorLogTail(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	lines, err := client.GetErrorLogTail(ctx, tablet, testErrorLogTailLines)
+	compareError(t, "GetErrorLogTail", err, lines, testErrorLogTail)
+}
+
+func agentRPCTestGetErrorLogTailPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetErrorLogTail(ctx, tablet, testErrorLogTailLines)
+	expectHandleRPCPanic(t, "GetErrorLogTail", false /*verbose*/, err)
+}
+
+var testDiagnosticBundle = &tabletmanagerdatapb.DiagnosticBundle{
+	Processes: []*tabletmanagerdatapb.Process{
+		{
+			Id:      12,
+			User:    "vt_app",
+			Host:    "localhost",
+			Db:      "vt_ks",
+			Command: "Query",
+			Time:    300,
+			State:   "Sending data",
+			Info:    "select * from t1",
+		},
+	},
+	SlaveStatus: &replicationdatapb.Status{
+		SlaveIoRunning:      true,
+		SlaveSqlRunning:     true,
+		SecondsBehindMaster: 12,
+	},
+	ErrorLogTail: []string{
+		"2017-06-01T10:05:00.000000Z 12 [Warning] Aborted connection 12 to db: 'vt_ks'",
+	},
+	Variables: map[string]string{
+		"read_only": "ON",
+		"server_id": "42",
+	},
+	DataDirTotalBytes: 100 << 30,
+	DataDirFreeBytes:  40 << 30,
+	Errors: []string{
+		"error log: no MySQL error log configured",
+	},
+}
+
+func (fra *fakeRPCAgent) CaptureDiagnostics(ctx context.Context) (*tabletmanagerdatapb.DiagnosticBundle, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testDiagnosticBundle, nil
+}
+
+func agentRPCTestCaptureDiagnostics(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	bundle, err := client.CaptureDiagnostics(ctx, tablet)
+	compareError(t, "CaptureDiagnostics", err, bundle, testDiagnosticBundle)
+}
+
+func agentRPCTestCaptureDiagnosticsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.CaptureDiagnostics(ctx, tablet)
+	expectHandleRPCPanic(t, "CaptureDiagnostics", false /*verbose*/, err)
+}
+
+var testCheckDataDirProblems = []string{
+	"binlog directory /vt/vt_0000000100/bin-logs is owned by uid 0, not by uid 1000 that mysqld runs as",
+}
+
+func (fra *fakeRPCAgent) CheckDataDir(ctx context.Context) ([]string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testCheckDataDirProblems, nil
+}
+
+func agentRPCTestCheckDataDir(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	problems, err := client.CheckDataDir(ctx, tablet)
+	compareError(t, "CheckDataDir", err, problems, testCheckDataDirProblems)
+}
+
+func agentRPCTestCheckDataDirPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.CheckDataDir(ctx, tablet)
+	expectHandleRPCPanic(t, "CheckDataDir", false /*verbose*/, err)
+}
+
+var testThrottleState = &tabletmanagerdatapb.ThrottleState{
+	Throttled: true,
+	Metric:    "replication_lag_seconds",
+	Value:     45,
+	Threshold: 30,
+	Reason:    "replication lag 45s is above the threshold of 30s",
+}
+
+func (fra *fakeRPCAgent) GetThrottleState(ctx context.Context) (*tabletmanagerdatapb.ThrottleState, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testThrottleState, nil
+}
+
+func agentRPCTestGetThrottleState(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	state, err := client.GetThrottleState(ctx, tablet)
+	compareError(t, "GetThrottleState", err, state, testThrottleState)
+}
+
+func agentRPCTestGetThrottleStatePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetThrottleState(ctx, tablet)
+	expectHandleRPCPanic(t, "GetThrottleState", false /*verbose*/, err)
+}
+
+var testTabletTags = map[string]string{
+	"canary": "true",
+	"owner":  "team-x",
+}
+
+func (fra *fakeRPCAgent) GetTabletTags(ctx context.Context) (map[string]string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testTabletTags, nil
+}
+
+func agentRPCTestGetTabletTags(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	tags, err := client.GetTabletTags(ctx, tablet)
+	compareError(t, "GetTabletTags", err, tags, testTabletTags)
+}
+
+func agentRPCTestGetTabletTagsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetTabletTags(ctx, tablet)
+	expectHandleRPCPanic(t, "GetTabletTags", false /*verbose*/, err)
+}
+
 //
 // Various read-write methods
 //
@@ -318,28 +675,178 @@ func agentRPCTestSetReadOnlyPanic(ctx context.Context, t *testing.T, client tmcl
 	expectHandleRPCPanic(t, "SetReadWrite", true /*verbose*/, err)
 }
 
+// testSetSuperReadOnlySupported is false to make SetSuperReadOnly act
+// as if the MySQL version didn't have super_read_only.
+var testSetSuperReadOnlySupported = true
+
+func (fra *fakeRPCAgent) SetSuperReadOnly(ctx context.Context, on bool) (bool, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	if !testSetSuperReadOnlySupported {
+		return false, tmclient.ErrSuperReadOnlyNotSupported
+	}
+	return on, nil
+}
+
+func agentRPCTestSetSuperReadOnly(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	for _, on := range []bool{true, false} {
+		superReadOnly, err := client.SetSuperReadOnly(ctx, tablet, on)
+		compareError(t, fmt.Sprintf("SetSuperReadOnly(%v)", on), err, superReadOnly, on)
+	}
+
+	testSetSuperReadOnlySupported = false
+	defer func() { testSetSuperReadOnlySupported = true }()
+	_, err := client.SetSuperReadOnly(ctx, tablet, true)
+	if !tmclient.IsSuperReadOnlyNotSupported(err) {
+		t.Errorf("SetSuperReadOnly on an unsupported version returned %v, want %v", err, tmclient.ErrSuperReadOnlyNotSupported)
+	}
+}
+
+func agentRPCTestSetSuperReadOnlyPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.SetSuperReadOnly(ctx, tablet, true)
+	expectHandleRPCPanic(t, "SetSuperReadOnly", true /*verbose*/, err)
+}
+
+var testQueryServerConfig = &tabletmanagerdatapb.QueryServerConfig{
+	PoolSize:             32,
+	QueryTimeoutNs:       int64(10 * time.Second),
+	DisableConsolidation: true,
+}
+
+var testQueryServerConfigApplied = &tabletmanagerdatapb.QueryServerConfig{
+	PoolSize:            32,
+	StreamPoolSize:      200,
+	TransactionPoolSize: 20,
+	QueryTimeoutNs:      int64(10 * time.Second),
+}
+
+var testQueryServerConfigSkipped = []string{"disable_consolidation"}
+
+func (fra *fakeRPCAgent) SetQueryServerConfig(ctx context.Context, config *tabletmanagerdatapb.QueryServerConfig) (*tabletmanagerdatapb.QueryServerConfig, []string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetQueryServerConfig config", config, testQueryServerConfig)
+	return testQueryServerConfigApplied, testQueryServerConfigSkipped, nil
+}
+
+func agentRPCTestSetQueryServerConfig(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	applied, skipped, err := client.SetQueryServerConfig(ctx, tablet, testQueryServerConfig)
+	compareError(t, "SetQueryServerConfig", err, applied, testQueryServerConfigApplied)
+	compare(t, "SetQueryServerConfig skipped", skipped, testQueryServerConfigSkipped)
+}
+
+func agentRPCTestSetQueryServerConfigPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, _, err := client.SetQueryServerConfig(ctx, tablet, testQueryServerConfig)
+	expectHandleRPCPanic(t, "SetQueryServerConfig", true /*verbose*/, err)
+}
+
+var testQueryBlacklist = []string{"select .* from t1", "delete from t2 .*"}
+
+func (fra *fakeRPCAgent) GetQueryBlacklist(ctx context.Context) ([]string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testQueryBlacklist, nil
+}
+
+func agentRPCTestGetQueryBlacklist(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	patterns, err := client.GetQueryBlacklist(ctx, tablet)
+	compareError(t, "GetQueryBlacklist", err, patterns, testQueryBlacklist)
+}
+
+func agentRPCTestGetQueryBlacklistPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetQueryBlacklist(ctx, tablet)
+	expectHandleRPCPanic(t, "GetQueryBlacklist", false /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) SetQueryBlacklist(ctx context.Context, patterns []string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetQueryBlacklist patterns", patterns, testQueryBlacklist)
+	return nil
+}
+
+func agentRPCTestSetQueryBlacklist(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetQueryBlacklist(ctx, tablet, testQueryBlacklist)
+	if err != nil {
+		t.Errorf("SetQueryBlacklist failed: %v", err)
+	}
+}
+
+func agentRPCTestSetQueryBlacklistPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetQueryBlacklist(ctx, tablet, testQueryBlacklist)
+	expectHandleRPCPanic(t, "SetQueryBlacklist", true /*verbose*/, err)
+}
+
+var testSetReadOnlyTTL = 5 * time.Minute
+
+func (fra *fakeRPCAgent) SetReadOnlyWithTTL(ctx context.Context, ttl time.Duration) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetReadOnlyWithTTL ttl", ttl, testSetReadOnlyTTL)
+	return nil
+}
+
+func agentRPCTestSetReadOnlyWithTTL(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetReadOnlyWithTTL(ctx, tablet, testSetReadOnlyTTL)
+	if err != nil {
+		t.Errorf("SetReadOnlyWithTTL failed: %v", err)
+	}
+}
+
+func agentRPCTestSetReadOnlyWithTTLPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetReadOnlyWithTTL(ctx, tablet, testSetReadOnlyTTL)
+	expectHandleRPCPanic(t, "SetReadOnlyWithTTL", true /*verbose*/, err)
+}
+
 var testChangeTypeValue = topodatapb.TabletType_REPLICA
+var testChangeTypeIdempotent = true
 
-func (fra *fakeRPCAgent) ChangeType(ctx context.Context, tabletType topodatapb.TabletType) error {
+func (fra *fakeRPCAgent) ChangeType(ctx context.Context, tabletType topodatapb.TabletType, idempotent bool) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
 	compare(fra.t, "ChangeType tabletType", tabletType, testChangeTypeValue)
+	compare(fra.t, "ChangeType idempotent", idempotent, testChangeTypeIdempotent)
 	return nil
 }
 
 func agentRPCTestChangeType(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	err := client.ChangeType(ctx, tablet, testChangeTypeValue)
+	err := client.ChangeType(ctx, tablet, testChangeTypeValue, testChangeTypeIdempotent)
 	if err != nil {
 		t.Errorf("ChangeType failed: %v", err)
 	}
 }
 
 func agentRPCTestChangeTypePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	err := client.ChangeType(ctx, tablet, testChangeTypeValue)
+	err := client.ChangeType(ctx, tablet, testChangeTypeValue, testChangeTypeIdempotent)
 	expectHandleRPCPanic(t, "ChangeType", true /*verbose*/, err)
 }
 
+func (fra *fakeRPCAgent) SetTabletTags(ctx context.Context, tags map[string]string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetTabletTags tags", tags, testTabletTags)
+	return nil
+}
+
+func agentRPCTestSetTabletTags(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetTabletTags(ctx, tablet, testTabletTags)
+	if err != nil {
+		t.Errorf("SetTabletTags failed: %v", err)
+	}
+}
+
+func agentRPCTestSetTabletTagsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetTabletTags(ctx, tablet, testTabletTags)
+	expectHandleRPCPanic(t, "SetTabletTags", true /*verbose*/, err)
+}
+
 var testSleepDuration = time.Minute
 
 func (fra *fakeRPCAgent) Sleep(ctx context.Context, duration time.Duration) {
@@ -393,34 +900,105 @@ func agentRPCTestExecuteHookPanic(ctx context.Context, t *testing.T, client tmcl
 	expectHandleRPCPanic(t, "ExecuteHook", true /*verbose*/, err)
 }
 
-var testRefreshStateCalled = false
+var testExecuteHookToStreamResponses = []*tabletmanagerdatapb.ExecuteHookToStreamResponse{
+	{Stdout: []byte("first chunk\n")},
+	{Stdout: []byte("second chunk\n")},
+	{Stdout: []byte("third chunk\n")},
+	{
+		Done:       true,
+		ExitStatus: hook.HOOK_STAT_FAILED,
+		Stderr:     "err",
+	},
+}
 
-func (fra *fakeRPCAgent) RefreshState(ctx context.Context) error {
+func (fra *fakeRPCAgent) ExecuteHookToStream(ctx context.Context, hk *hook.Hook, send func(*tabletmanagerdatapb.ExecuteHookToStreamResponse) error) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
-	if testRefreshStateCalled {
-		fra.t.Errorf("RefreshState called multiple times?")
+	compare(fra.t, "ExecuteHookToStream hook", hk, testExecuteHookHook)
+	for _, response := range testExecuteHookToStreamResponses {
+		if err := send(response); err != nil {
+			return err
+		}
 	}
-	testRefreshStateCalled = true
 	return nil
 }
 
-func agentRPCTestRefreshState(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	err := client.RefreshState(ctx, tablet)
+func agentRPCTestExecuteHookToStream(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.ExecuteHookToStream(ctx, tablet, testExecuteHookHook)
 	if err != nil {
-		t.Errorf("RefreshState failed: %v", err)
+		t.Fatalf("ExecuteHookToStream failed: %v", err)
 	}
-	if !testRefreshStateCalled {
-		t.Errorf("RefreshState didn't call the server side")
+	var responses []*tabletmanagerdatapb.ExecuteHookToStreamResponse
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("ExecuteHookToStream stream failed: %v", err)
+		}
+		responses = append(responses, response)
 	}
+	compare(t, "ExecuteHookToStream responses", responses, testExecuteHookToStreamResponses)
 }
 
-func agentRPCTestRefreshStatePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+func agentRPCTestExecuteHookToStreamPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.ExecuteHookToStream(ctx, tablet, testExecuteHookHook)
+	if err != nil {
+		t.Fatalf("ExecuteHookToStream failed: %v", err)
+	}
+	_, err = stream.Recv()
+	expectHandleRPCPanic(t, "ExecuteHookToStream", true /*verbose*/, err)
+}
+
+var testRefreshStateCalled = false
+
+func (fra *fakeRPCAgent) RefreshState(ctx context.Context) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	if testRefreshStateCalled {
+		fra.t.Errorf("RefreshState called multiple times?")
+	}
+	testRefreshStateCalled = true
+	return nil
+}
+
+func agentRPCTestRefreshState(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.RefreshState(ctx, tablet)
+	if err != nil {
+		t.Errorf("RefreshState failed: %v", err)
+	}
+	if !testRefreshStateCalled {
+		t.Errorf("RefreshState didn't call the server side")
+	}
+}
+
+func agentRPCTestRefreshStatePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
 	err := client.RefreshState(ctx, tablet)
 	expectHandleRPCPanic(t, "RefreshState", true /*verbose*/, err)
 }
 
+var testRepublishTopoRecordVersion int64 = 12
+
+func (fra *fakeRPCAgent) RepublishTopoRecord(ctx context.Context) (int64, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testRepublishTopoRecordVersion, nil
+}
+
+func agentRPCTestRepublishTopoRecord(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	version, err := client.RepublishTopoRecord(ctx, tablet)
+	compareError(t, "RepublishTopoRecord", err, version, testRepublishTopoRecordVersion)
+}
+
+func agentRPCTestRepublishTopoRecordPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.RepublishTopoRecord(ctx, tablet)
+	expectHandleRPCPanic(t, "RepublishTopoRecord", true /*verbose*/, err)
+}
+
 func (fra *fakeRPCAgent) RunHealthCheck(ctx context.Context) {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
@@ -461,6 +1039,295 @@ func agentRPCTestIgnoreHealthErrorPanic(ctx context.Context, t *testing.T, clien
 	expectHandleRPCPanic(t, "IgnoreHealthError", false /*verbose*/, err)
 }
 
+var testSetMaintenanceModeReason = "disk replacement"
+
+func (fra *fakeRPCAgent) SetMaintenanceMode(ctx context.Context, on bool, reason string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compareBool(fra.t, "SetMaintenanceMode on", on)
+	compare(fra.t, "SetMaintenanceMode reason", reason, testSetMaintenanceModeReason)
+	return nil
+}
+
+func agentRPCTestSetMaintenanceMode(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetMaintenanceMode(ctx, tablet, true, testSetMaintenanceModeReason)
+	if err != nil {
+		t.Errorf("SetMaintenanceMode failed: %v", err)
+	}
+}
+
+func agentRPCTestSetMaintenanceModePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetMaintenanceMode(ctx, tablet, true, testSetMaintenanceModeReason)
+	expectHandleRPCPanic(t, "SetMaintenanceMode", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) PauseHealthReporting(ctx context.Context, on bool) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compareBool(fra.t, "PauseHealthReporting on", on)
+	return nil
+}
+
+func agentRPCTestPauseHealthReporting(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.PauseHealthReporting(ctx, tablet, true)
+	if err != nil {
+		t.Errorf("PauseHealthReporting failed: %v", err)
+	}
+}
+
+func agentRPCTestPauseHealthReportingPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.PauseHealthReporting(ctx, tablet, true)
+	expectHandleRPCPanic(t, "PauseHealthReporting", true /*verbose*/, err)
+}
+
+var testSetThrottleMode = tmclient.ThrottleModeForceOff
+var testSetThrottleDuration = 15 * time.Minute
+
+func (fra *fakeRPCAgent) SetThrottle(ctx context.Context, mode string, duration time.Duration) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetThrottle mode", mode, testSetThrottleMode)
+	compare(fra.t, "SetThrottle duration", duration, testSetThrottleDuration)
+	return nil
+}
+
+func agentRPCTestSetThrottle(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetThrottle(ctx, tablet, testSetThrottleMode, testSetThrottleDuration)
+	if err != nil {
+		t.Errorf("SetThrottle failed: %v", err)
+	}
+}
+
+func agentRPCTestSetThrottlePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetThrottle(ctx, tablet, testSetThrottleMode, testSetThrottleDuration)
+	expectHandleRPCPanic(t, "SetThrottle", true /*verbose*/, err)
+}
+
+var testCutoverToken = "cutover-token"
+
+func (fra *fakeRPCAgent) PrepareCutover(ctx context.Context) (string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testCutoverToken, nil
+}
+
+func agentRPCTestPrepareCutover(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	token, err := client.PrepareCutover(ctx, tablet)
+	compareError(t, "PrepareCutover", err, token, testCutoverToken)
+}
+
+func agentRPCTestPrepareCutoverPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.PrepareCutover(ctx, tablet)
+	expectHandleRPCPanic(t, "PrepareCutover", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) CommitCutover(ctx context.Context, token string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "CommitCutover token", token, testCutoverToken)
+	return nil
+}
+
+func agentRPCTestCommitCutover(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.CommitCutover(ctx, tablet, testCutoverToken)
+	if err != nil {
+		t.Errorf("CommitCutover failed: %v", err)
+	}
+}
+
+func agentRPCTestCommitCutoverPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.CommitCutover(ctx, tablet, testCutoverToken)
+	expectHandleRPCPanic(t, "CommitCutover", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) AbortCutover(ctx context.Context, token string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "AbortCutover token", token, testCutoverToken)
+	return nil
+}
+
+func agentRPCTestAbortCutover(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.AbortCutover(ctx, tablet, testCutoverToken)
+	if err != nil {
+		t.Errorf("AbortCutover failed: %v", err)
+	}
+}
+
+func agentRPCTestAbortCutoverPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.AbortCutover(ctx, tablet, testCutoverToken)
+	expectHandleRPCPanic(t, "AbortCutover", true /*verbose*/, err)
+}
+
+var testServingKeyRange = &topodatapb.KeyRange{
+	Start: []byte{0x80},
+	End:   []byte{0xc0},
+}
+var testServingKeyRangeTypes = []topodatapb.TabletType{
+	topodatapb.TabletType_REPLICA,
+	topodatapb.TabletType_RDONLY,
+}
+
+func (fra *fakeRPCAgent) SetServingKeyRange(ctx context.Context, keyRange *topodatapb.KeyRange, servedTypes []topodatapb.TabletType) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetServingKeyRange keyRange", keyRange, testServingKeyRange)
+	compare(fra.t, "SetServingKeyRange servedTypes", servedTypes, testServingKeyRangeTypes)
+	return nil
+}
+
+func agentRPCTestSetServingKeyRange(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetServingKeyRange(ctx, tablet, testServingKeyRange, testServingKeyRangeTypes)
+	if err != nil {
+		t.Errorf("SetServingKeyRange failed: %v", err)
+	}
+}
+
+func agentRPCTestSetServingKeyRangePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetServingKeyRange(ctx, tablet, testServingKeyRange, testServingKeyRangeTypes)
+	expectHandleRPCPanic(t, "SetServingKeyRange", true /*verbose*/, err)
+}
+
+var testRestartMysqlHealthyTimeout = 3 * time.Minute
+var testRestartMysqlCalled = false
+
+func (fra *fakeRPCAgent) RestartMysql(ctx context.Context, healthyTimeout time.Duration, logger logutil.Logger) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "RestartMysql healthyTimeout", healthyTimeout, testRestartMysqlHealthyTimeout)
+	logStuff(logger, 10)
+	testRestartMysqlCalled = true
+	return nil
+}
+
+func agentRPCTestRestartMysql(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.RestartMysql(ctx, tablet, tmclient.RestartMysqlOptions{HealthyTimeout: testRestartMysqlHealthyTimeout})
+	if err != nil {
+		t.Fatalf("RestartMysql failed: %v", err)
+	}
+	err = compareLoggedStuff(t, "RestartMysql", stream, 10)
+	compareError(t, "RestartMysql", err, true, testRestartMysqlCalled)
+}
+
+func agentRPCTestRestartMysqlPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.RestartMysql(ctx, tablet, tmclient.RestartMysqlOptions{HealthyTimeout: testRestartMysqlHealthyTimeout})
+	if err != nil {
+		t.Fatalf("RestartMysql failed: %v", err)
+	}
+	e, err := stream.Recv()
+	if err == nil {
+		t.Fatalf("Unexpected RestartMysql logs: %v", e)
+	}
+	expectHandleRPCPanic(t, "RestartMysql", true /*verbose*/, err)
+}
+
+var testDecommissionCalled = false
+
+func (fra *fakeRPCAgent) Decommission(ctx context.Context, stopReplication, stopMysqld bool, logger logutil.Logger) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "Decommission stopReplication", stopReplication, true)
+	compare(fra.t, "Decommission stopMysqld", stopMysqld, false)
+	logStuff(logger, 10)
+	testDecommissionCalled = true
+	return nil
+}
+
+func agentRPCTestDecommission(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.Decommission(ctx, tablet, tmclient.DecommissionOptions{StopReplication: true})
+	if err != nil {
+		t.Fatalf("Decommission failed: %v", err)
+	}
+	err = compareLoggedStuff(t, "Decommission", stream, 10)
+	compareError(t, "Decommission", err, true, testDecommissionCalled)
+}
+
+func agentRPCTestDecommissionPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.Decommission(ctx, tablet, tmclient.DecommissionOptions{StopReplication: true})
+	if err != nil {
+		t.Fatalf("Decommission failed: %v", err)
+	}
+	e, err := stream.Recv()
+	if err == nil {
+		t.Fatalf("Unexpected Decommission logs: %v", e)
+	}
+	expectHandleRPCPanic(t, "Decommission", true /*verbose*/, err)
+}
+
+var testCheckTopoConnectivityLatency = 12 * time.Millisecond
+
+func (fra *fakeRPCAgent) CheckTopoConnectivity(ctx context.Context) (bool, time.Duration) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return false, testCheckTopoConnectivityLatency
+}
+
+func agentRPCTestCheckTopoConnectivity(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	reachable, latency, err := client.CheckTopoConnectivity(ctx, tablet)
+	compareError(t, "CheckTopoConnectivity", err, latency, testCheckTopoConnectivityLatency)
+	if reachable {
+		t.Errorf("CheckTopoConnectivity returned reachable, want unreachable")
+	}
+}
+
+func agentRPCTestCheckTopoConnectivityPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, _, err := client.CheckTopoConnectivity(ctx, tablet)
+	expectHandleRPCPanic(t, "CheckTopoConnectivity", false /*verbose*/, err)
+}
+
+var testWarmUpQueries = []string{"SELECT * FROM t1", "SELECT * FROM t2"}
+var testWarmUpProgress = []*tabletmanagerdatapb.WarmUpResponse{
+	{QueriesRun: 1, QueriesTotal: 2, BufferPoolFill: 0.25},
+	{QueriesRun: 2, QueriesTotal: 2, BufferPoolFill: 0.5},
+	{QueriesRun: 2, QueriesTotal: 2, BufferPoolFill: 1, Done: true},
+}
+
+func (fra *fakeRPCAgent) WarmUp(ctx context.Context, queries []string, loadBufferPool bool, progress func(*tabletmanagerdatapb.WarmUpResponse) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "WarmUp queries", queries, testWarmUpQueries)
+	compareBool(fra.t, "WarmUp loadBufferPool", loadBufferPool)
+	for _, r := range testWarmUpProgress {
+		if err := progress(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func agentRPCTestWarmUp(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	var progress []*tabletmanagerdatapb.WarmUpResponse
+	last, err := tmclient.WarmUpTablet(ctx, client, tablet, tmclient.WarmUpOptions{
+		Queries:        testWarmUpQueries,
+		LoadBufferPool: true,
+		Timeout:        time.Minute,
+		Progress: func(r *tabletmanagerdatapb.WarmUpResponse) {
+			progress = append(progress, r)
+		},
+	})
+	compareError(t, "WarmUp", err, last, testWarmUpProgress[len(testWarmUpProgress)-1])
+	compare(t, "WarmUp progress", progress, testWarmUpProgress)
+}
+
+func agentRPCTestWarmUpPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := tmclient.WarmUpTablet(ctx, client, tablet, tmclient.WarmUpOptions{
+		Queries:        testWarmUpQueries,
+		LoadBufferPool: true,
+	})
+	expectHandleRPCPanic(t, "WarmUp", true /*verbose*/, err)
+}
+
 var testReloadSchemaCalled = false
 
 func (fra *fakeRPCAgent) ReloadSchema(ctx context.Context, waitPosition string) error {
@@ -541,97 +1408,837 @@ func agentRPCTestApplySchemaPanic(ctx context.Context, t *testing.T, client tmcl
 	expectHandleRPCPanic(t, "ApplySchema", true /*verbose*/, err)
 }
 
-var testExecuteFetchQuery = []byte("fetch this invalid utf8 character \x80")
-var testExecuteFetchMaxRows = 100
-var testExecuteFetchResult = &querypb.QueryResult{
-	Fields: []*querypb.Field{
-		{
-			Name: "column1",
-			Type: sqltypes.Blob,
-		},
-		{
-			Name: "column2",
-			Type: sqltypes.Datetime,
-		},
-	},
-	RowsAffected: 10,
-	InsertId:     32,
-	Rows: []*querypb.Row{
-		{
-			Lengths: []int64{
-				3,
-				-1,
-			},
-			Values: []byte{
-				'A', 'B', 'C',
-			},
-		},
-	},
+var testSchemaDiffStatements = []string{
+	"ALTER TABLE `table1` ADD COLUMN `msg` varchar(64) DEFAULT NULL AFTER `id`",
+	"DROP TABLE `table2`",
 }
 
-func (fra *fakeRPCAgent) ExecuteFetchAsDba(ctx context.Context, query []byte, dbName string, maxrows int, disableBinlogs bool, reloadSchema bool) (*querypb.QueryResult, error) {
+func (fra *fakeRPCAgent) SchemaDiff(ctx context.Context, desired *tabletmanagerdatapb.SchemaDefinition) ([]string, error) {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
-	compare(fra.t, "ExecuteFetchAsDba query", query, testExecuteFetchQuery)
-	compare(fra.t, "ExecuteFetchAsDba maxrows", maxrows, testExecuteFetchMaxRows)
-	compareBool(fra.t, "ExecuteFetchAsDba disableBinlogs", disableBinlogs)
-	compareBool(fra.t, "ExecuteFetchAsDba reloadSchema", reloadSchema)
+	compare(fra.t, "SchemaDiff desired", desired, testGetSchemaReply)
+	return testSchemaDiffStatements, nil
+}
 
-	return testExecuteFetchResult, nil
+func agentRPCTestSchemaDiff(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	statements, err := client.SchemaDiff(ctx, tablet, testGetSchemaReply)
+	compareError(t, "SchemaDiff", err, statements, testSchemaDiffStatements)
 }
 
-func (fra *fakeRPCAgent) ExecuteFetchAsAllPrivs(ctx context.Context, query []byte, dbName string, maxrows int, reloadSchema bool) (*querypb.QueryResult, error) {
+func agentRPCTestSchemaDiffPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.SchemaDiff(ctx, tablet, testGetSchemaReply)
+	expectHandleRPCPanic(t, "SchemaDiff", false /*verbose*/, err)
+}
+
+var testAssessSchemaChange = "ALTER TABLE t1 ADD COLUMN c int"
+var testSchemaChangeAssessment = &tabletmanagerdatapb.SchemaChangeAssessment{
+	Table:           "t1",
+	Algorithm:       "COPY",
+	TableSizeBytes:  1 << 30,
+	EstimatedLockNs: int64(20 * time.Second),
+}
+
+func (fra *fakeRPCAgent) AssessSchemaChange(ctx context.Context, change string) (*tabletmanagerdatapb.SchemaChangeAssessment, error) {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
-	compare(fra.t, "ExecuteFetchAsAllPrivs query", query, testExecuteFetchQuery)
-	compare(fra.t, "ExecuteFetchAsAllPrivs maxrows", maxrows, testExecuteFetchMaxRows)
-	compareBool(fra.t, "ExecuteFetchAsAllPrivs reloadSchema", reloadSchema)
+	compare(fra.t, "AssessSchemaChange change", change, testAssessSchemaChange)
+	return testSchemaChangeAssessment, nil
+}
 
-	return testExecuteFetchResult, nil
+func agentRPCTestAssessSchemaChange(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	assessment, err := client.AssessSchemaChange(ctx, tablet, testAssessSchemaChange)
+	compareError(t, "AssessSchemaChange", err, assessment, testSchemaChangeAssessment)
 }
 
-func (fra *fakeRPCAgent) ExecuteFetchAsApp(ctx context.Context, query []byte, maxrows int) (*querypb.QueryResult, error) {
+func agentRPCTestAssessSchemaChangePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.AssessSchemaChange(ctx, tablet, testAssessSchemaChange)
+	expectHandleRPCPanic(t, "AssessSchemaChange", false /*verbose*/, err)
+}
+
+var testSchemaChangeEvents = []*tabletmanagerdatapb.SchemaChangeEvent{
+	{
+		Name: "t1",
+		Definition: &tabletmanagerdatapb.TableDefinition{
+			Name:   "t1",
+			Schema: "CREATE TABLE t1 (id int, msg varchar(64))",
+			Type:   tmutils.TableBaseTable,
+		},
+	},
+	{
+		Name: "t2",
+	},
+}
+
+func (fra *fakeRPCAgent) WatchSchema(ctx context.Context, send func(*tabletmanagerdatapb.SchemaChangeEvent) error) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
-	compare(fra.t, "ExecuteFetchAsApp query", query, testExecuteFetchQuery)
-	compare(fra.t, "ExecuteFetchAsApp maxrows", maxrows, testExecuteFetchMaxRows)
-	return testExecuteFetchResult, nil
+	for _, event := range testSchemaChangeEvents {
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func agentRPCTestExecuteFetch(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	// using pool
-	qr, err := client.ExecuteFetchAsDba(ctx, tablet, true, testExecuteFetchQuery, testExecuteFetchMaxRows, true, true)
-	compareError(t, "ExecuteFetchAsDba", err, qr, testExecuteFetchResult)
-	qr, err = client.ExecuteFetchAsApp(ctx, tablet, true, testExecuteFetchQuery, testExecuteFetchMaxRows)
-	compareError(t, "ExecuteFetchAsApp", err, qr, testExecuteFetchResult)
-
-	// not using pool
-	qr, err = client.ExecuteFetchAsDba(ctx, tablet, false, testExecuteFetchQuery, testExecuteFetchMaxRows, true, true)
+func agentRPCTestWatchSchema(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.WatchSchema(ctx, tablet)
+	if err != nil {
+		t.Fatalf("WatchSchema failed: %v", err)
+	}
+	var events []*tabletmanagerdatapb.SchemaChangeEvent
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("WatchSchema stream failed: %v", err)
+		}
+		events = append(events, event)
+	}
+	compare(t, "WatchSchema events", events, testSchemaChangeEvents)
+}
+
+func agentRPCTestWatchSchemaPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.WatchSchema(ctx, tablet)
+	if err != nil {
+		t.Fatalf("WatchSchema failed: %v", err)
+	}
+	_, err = stream.Recv()
+	expectHandleRPCPanic(t, "WatchSchema", false /*verbose*/, err)
+}
+
+var testVSchema = &vschemapb.Keyspace{
+	Sharded: true,
+	Vindexes: map[string]*vschemapb.Vindex{
+		"hash": {
+			Type: "hash",
+		},
+	},
+	Tables: map[string]*vschemapb.Table{
+		"t1": {
+			ColumnVindexes: []*vschemapb.ColumnVindex{
+				{
+					Column: "id",
+					Name:   "hash",
+				},
+			},
+		},
+	},
+}
+
+var testVSchemaJSON = `{"sharded": true}`
+
+func (fra *fakeRPCAgent) GetVSchema(ctx context.Context) (*vschemapb.Keyspace, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testVSchema, nil
+}
+
+func agentRPCTestGetVSchema(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	vschema, err := client.GetVSchema(ctx, tablet)
+	compareError(t, "GetVSchema", err, vschema, testVSchema)
+}
+
+func agentRPCTestGetVSchemaPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetVSchema(ctx, tablet)
+	expectHandleRPCPanic(t, "GetVSchema", false /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) ApplyVSchema(ctx context.Context, vschemaJSON string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ApplyVSchema vschema", vschemaJSON, testVSchemaJSON)
+	return nil
+}
+
+func agentRPCTestApplyVSchema(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ApplyVSchema(ctx, tablet, testVSchemaJSON)
+	if err != nil {
+		t.Errorf("ApplyVSchema failed: %v", err)
+	}
+}
+
+func agentRPCTestApplyVSchemaPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ApplyVSchema(ctx, tablet, testVSchemaJSON)
+	expectHandleRPCPanic(t, "ApplyVSchema", true /*verbose*/, err)
+}
+
+var testExecuteFetchQuery = []byte("fetch this invalid utf8 character \x80")
+var testExecuteFetchMaxRows = 100
+var testExecuteFetchMaxExecTime = 5 * time.Second
+var testExecuteFetchSlowQuery = []byte("SELECT SLEEP(10)")
+var testExecuteFetchResult = &querypb.QueryResult{
+	Fields: []*querypb.Field{
+		{
+			Name: "column1",
+			Type: sqltypes.Blob,
+		},
+		{
+			Name: "column2",
+			Type: sqltypes.Datetime,
+		},
+	},
+	RowsAffected: 10,
+	InsertId:     32,
+	Rows: []*querypb.Row{
+		{
+			Lengths: []int64{
+				3,
+				-1,
+			},
+			Values: []byte{
+				'A', 'B', 'C',
+			},
+		},
+	},
+}
+
+func (fra *fakeRPCAgent) ExecuteFetchAsDba(ctx context.Context, query []byte, dbName string, maxrows int, disableBinlogs bool, reloadSchema bool, maxExecTime time.Duration) (*querypb.QueryResult, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	if string(query) == string(testExecuteFetchSlowQuery) {
+		return nil, tmclient.ErrMaxExecTimeExceeded
+	}
+	compare(fra.t, "ExecuteFetchAsDba query", query, testExecuteFetchQuery)
+	compare(fra.t, "ExecuteFetchAsDba maxrows", maxrows, testExecuteFetchMaxRows)
+	compareBool(fra.t, "ExecuteFetchAsDba disableBinlogs", disableBinlogs)
+	compareBool(fra.t, "ExecuteFetchAsDba reloadSchema", reloadSchema)
+	compare(fra.t, "ExecuteFetchAsDba maxExecTime", maxExecTime, testExecuteFetchMaxExecTime)
+
+	return testExecuteFetchResult, nil
+}
+
+var testExecuteFetchCSVChunks = [][]byte{
+	[]byte("id,msg\n"),
+	[]byte("1,\"a,b\"\n"),
+}
+
+func (fra *fakeRPCAgent) ExecuteFetchAsDbaCSV(ctx context.Context, query []byte, dbName string, send func([]byte) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ExecuteFetchAsDbaCSV query", query, testExecuteFetchQuery)
+	for _, chunk := range testExecuteFetchCSVChunks {
+		if err := send(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var testExecuteFetchColumnarResult = &tabletmanagerdatapb.ColumnarResult{
+	Fields:   testExecuteFetchResult.Fields,
+	RowCount: 2,
+	Columns: []*querypb.Row{
+		{
+			Lengths: []int64{3, 2},
+			Values:  []byte("ABCDE"),
+		},
+		{
+			Lengths: []int64{-1, 19},
+			Values:  []byte("2017-01-02 03:04:05"),
+		},
+	},
+}
+
+func (fra *fakeRPCAgent) ExecuteFetchColumnar(ctx context.Context, query []byte, dbName string, maxrows int) (*tabletmanagerdatapb.ColumnarResult, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ExecuteFetchColumnar query", query, testExecuteFetchQuery)
+	compare(fra.t, "ExecuteFetchColumnar maxrows", maxrows, testExecuteFetchMaxRows)
+	return testExecuteFetchColumnarResult, nil
+}
+
+func (fra *fakeRPCAgent) ExecuteFetchAsAllPrivs(ctx context.Context, query []byte, dbName string, maxrows int, reloadSchema bool) (*querypb.QueryResult, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ExecuteFetchAsAllPrivs query", query, testExecuteFetchQuery)
+	compare(fra.t, "ExecuteFetchAsAllPrivs maxrows", maxrows, testExecuteFetchMaxRows)
+	compareBool(fra.t, "ExecuteFetchAsAllPrivs reloadSchema", reloadSchema)
+
+	return testExecuteFetchResult, nil
+}
+
+func (fra *fakeRPCAgent) ExecuteFetchAsApp(ctx context.Context, query []byte, maxrows int) (*querypb.QueryResult, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ExecuteFetchAsApp query", query, testExecuteFetchQuery)
+	compare(fra.t, "ExecuteFetchAsApp maxrows", maxrows, testExecuteFetchMaxRows)
+	return testExecuteFetchResult, nil
+}
+
+func agentRPCTestExecuteFetch(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	// using pool
+	qr, err := client.ExecuteFetchAsDba(ctx, tablet, true, testExecuteFetchQuery, testExecuteFetchMaxRows, true, true, testExecuteFetchMaxExecTime)
+	compareError(t, "ExecuteFetchAsDba", err, qr, testExecuteFetchResult)
+	qr, err = client.ExecuteFetchAsApp(ctx, tablet, true, testExecuteFetchQuery, testExecuteFetchMaxRows)
+	compareError(t, "ExecuteFetchAsApp", err, qr, testExecuteFetchResult)
+
+	// not using pool
+	qr, err = client.ExecuteFetchAsDba(ctx, tablet, false, testExecuteFetchQuery, testExecuteFetchMaxRows, true, true, testExecuteFetchMaxExecTime)
 	compareError(t, "ExecuteFetchAsDba", err, qr, testExecuteFetchResult)
 	qr, err = client.ExecuteFetchAsApp(ctx, tablet, false, testExecuteFetchQuery, testExecuteFetchMaxRows)
 	compareError(t, "ExecuteFetchAsApp", err, qr, testExecuteFetchResult)
 	qr, err = client.ExecuteFetchAsAllPrivs(ctx, tablet, testExecuteFetchQuery, testExecuteFetchMaxRows, true)
 	compareError(t, "ExecuteFetchAsAllPrivs", err, qr, testExecuteFetchResult)
 
+	// aborted by max exec time
+	_, err = client.ExecuteFetchAsDba(ctx, tablet, false, testExecuteFetchSlowQuery, testExecuteFetchMaxRows, true, true, testExecuteFetchMaxExecTime)
+	if !tmclient.IsMaxExecTimeExceeded(err) {
+		t.Errorf("ExecuteFetchAsDba of a slow query returned %v, want a max exec time error", err)
+	}
+
+	// streaming as CSV
+	stream, err := client.ExecuteFetchAsDbaCSV(ctx, tablet, testExecuteFetchQuery)
+	if err != nil {
+		t.Fatalf("ExecuteFetchAsDbaCSV failed: %v", err)
+	}
+	var chunks [][]byte
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("ExecuteFetchAsDbaCSV stream failed: %v", err)
+		}
+		chunks = append(chunks, chunk)
+	}
+	compare(t, "ExecuteFetchAsDbaCSV chunks", chunks, testExecuteFetchCSVChunks)
+
+	// columnar
+	cr, err := client.ExecuteFetchColumnar(ctx, tablet, testExecuteFetchQuery, testExecuteFetchMaxRows)
+	compareError(t, "ExecuteFetchColumnar", err, cr, testExecuteFetchColumnarResult)
+}
+
+var testExplainQueryQuery = "SELECT * FROM t1 WHERE id = 1"
+var testExplainQueryFormat = "JSON"
+
+func (fra *fakeRPCAgent) ExplainQuery(ctx context.Context, query, format string) (*querypb.QueryResult, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ExplainQuery query", query, testExplainQueryQuery)
+	compare(fra.t, "ExplainQuery format", format, testExplainQueryFormat)
+	return testExecuteFetchResult, nil
+}
+
+func agentRPCTestExplainQuery(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	qr, err := client.ExplainQuery(ctx, tablet, testExplainQueryQuery, testExplainQueryFormat)
+	compareError(t, "ExplainQuery", err, qr, testExecuteFetchResult)
+}
+
+func agentRPCTestExplainQueryPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.ExplainQuery(ctx, tablet, testExplainQueryQuery, testExplainQueryFormat)
+	expectHandleRPCPanic(t, "ExplainQuery", false /*verbose*/, err)
+}
+
+var testApplyGrantsStatements = []string{
+	"GRANT SELECT ON vt_ks.* TO 'vt_app'@'localhost'",
+	"REVOKE DELETE ON vt_ks.* FROM 'vt_app'@'localhost'",
+}
+var testApplyGrantsCalled = false
+
+func (fra *fakeRPCAgent) ApplyGrants(ctx context.Context, statements []string, atomic bool) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ApplyGrants statements", statements, testApplyGrantsStatements)
+	compare(fra.t, "ApplyGrants atomic", atomic, true)
+	testApplyGrantsCalled = true
+	return nil
+}
+
+func agentRPCTestApplyGrants(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ApplyGrants(ctx, tablet, testApplyGrantsStatements, true)
+	compareError(t, "ApplyGrants", err, true, testApplyGrantsCalled)
+}
+
+func agentRPCTestApplyGrantsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ApplyGrants(ctx, tablet, testApplyGrantsStatements, true)
+	expectHandleRPCPanic(t, "ApplyGrants", true /*verbose*/, err)
+}
+
+func agentRPCTestExecuteFetchPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	// using pool
+	_, err := client.ExecuteFetchAsDba(ctx, tablet, true, testExecuteFetchQuery, testExecuteFetchMaxRows, true, false, testExecuteFetchMaxExecTime)
+	expectHandleRPCPanic(t, "ExecuteFetchAsDba", false /*verbose*/, err)
+	_, err = client.ExecuteFetchAsApp(ctx, tablet, true, testExecuteFetchQuery, testExecuteFetchMaxRows)
+	expectHandleRPCPanic(t, "ExecuteFetchAsApp", false /*verbose*/, err)
+
+	// not using pool
+	_, err = client.ExecuteFetchAsDba(ctx, tablet, false, testExecuteFetchQuery, testExecuteFetchMaxRows, true, false, testExecuteFetchMaxExecTime)
+	expectHandleRPCPanic(t, "ExecuteFetchAsDba", false /*verbose*/, err)
+	_, err = client.ExecuteFetchAsApp(ctx, tablet, false, testExecuteFetchQuery, testExecuteFetchMaxRows)
+	expectHandleRPCPanic(t, "ExecuteFetchAsApp", false /*verbose*/, err)
+	_, err = client.ExecuteFetchAsAllPrivs(ctx, tablet, testExecuteFetchQuery, testExecuteFetchMaxRows, false)
+	expectHandleRPCPanic(t, "ExecuteFetchAsAllPrivs", false /*verbose*/, err)
+
+	// streaming as CSV
+	stream, err := client.ExecuteFetchAsDbaCSV(ctx, tablet, testExecuteFetchQuery)
+	if err != nil {
+		t.Fatalf("ExecuteFetchAsDbaCSV failed: %v", err)
+	}
+	_, err = stream.Recv()
+	expectHandleRPCPanic(t, "ExecuteFetchAsDbaCSV", false /*verbose*/, err)
+
+	// columnar
+	_, err = client.ExecuteFetchColumnar(ctx, tablet, testExecuteFetchQuery, testExecuteFetchMaxRows)
+	expectHandleRPCPanic(t, "ExecuteFetchColumnar", false /*verbose*/, err)
+}
+
+var testChecksumTableTable = "table1"
+var testChecksumTableKeyRange = &topodatapb.KeyRange{
+	Start: []byte{0x40},
+	End:   []byte{0x80},
+}
+var testChecksumTableChecksum uint64 = 0x0123456789abcdef
+var testChecksumTableRowCount int64 = 42
+
+func (fra *fakeRPCAgent) ChecksumTable(ctx context.Context, table string, keyRange *topodatapb.KeyRange) (uint64, int64, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ChecksumTable table", table, testChecksumTableTable)
+	compare(fra.t, "ChecksumTable keyRange", keyRange, testChecksumTableKeyRange)
+	return testChecksumTableChecksum, testChecksumTableRowCount, nil
+}
+
+func agentRPCTestChecksumTable(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	checksum, rowCount, err := client.ChecksumTable(ctx, tablet, testChecksumTableTable, testChecksumTableKeyRange)
+	compareError(t, "ChecksumTable", err, checksum, testChecksumTableChecksum)
+	compare(t, "ChecksumTable rowCount", rowCount, testChecksumTableRowCount)
+}
+
+func agentRPCTestChecksumTablePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, _, err := client.ChecksumTable(ctx, tablet, testChecksumTableTable, testChecksumTableKeyRange)
+	expectHandleRPCPanic(t, "ChecksumTable", false /*verbose*/, err)
+}
+
+var testTruncateTableConfirmKeyspace = "test_keyspace"
+
+func (fra *fakeRPCAgent) TruncateTable(ctx context.Context, table, confirmKeyspace string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "TruncateTable table", table, testChecksumTableTable)
+	compare(fra.t, "TruncateTable confirmKeyspace", confirmKeyspace, testTruncateTableConfirmKeyspace)
+	return nil
+}
+
+func agentRPCTestTruncateTable(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.TruncateTable(ctx, tablet, testChecksumTableTable, testTruncateTableConfirmKeyspace)
+	if err != nil {
+		t.Errorf("TruncateTable failed: %v", err)
+	}
+}
+
+func agentRPCTestTruncateTablePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.TruncateTable(ctx, tablet, testChecksumTableTable, testTruncateTableConfirmKeyspace)
+	expectHandleRPCPanic(t, "TruncateTable", true /*verbose*/, err)
+}
+
+var testRenameTableTo = "table2"
+var testRenameTableResult = &tabletmanagerdatapb.RenameTableResult{
+	DependentViews: []string{"view1"},
+	Renamed:        true,
+}
+
+func (fra *fakeRPCAgent) RenameTable(ctx context.Context, from, to string, cascade bool) (*tabletmanagerdatapb.RenameTableResult, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "RenameTable from", from, testChecksumTableTable)
+	compare(fra.t, "RenameTable to", to, testRenameTableTo)
+	compareBool(fra.t, "RenameTable cascade", cascade)
+	return testRenameTableResult, nil
+}
+
+func agentRPCTestRenameTable(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.RenameTable(ctx, tablet, testChecksumTableTable, testRenameTableTo, tmclient.RenameTableOptions{Cascade: true})
+	compareError(t, "RenameTable", err, result, testRenameTableResult)
+}
+
+func agentRPCTestRenameTablePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.RenameTable(ctx, tablet, testChecksumTableTable, testRenameTableTo, tmclient.RenameTableOptions{Cascade: true})
+	expectHandleRPCPanic(t, "RenameTable", true /*verbose*/, err)
+}
+
+var testStreamRowsInKeyRangeResults = []*querypb.QueryResult{
+	{
+		Fields: testExecuteFetchResult.Fields,
+	},
+	{
+		Rows: testExecuteFetchResult.Rows,
+	},
+}
+
+func (fra *fakeRPCAgent) StreamRowsInKeyRange(ctx context.Context, table string, keyRange *topodatapb.KeyRange, send func(*querypb.QueryResult) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "StreamRowsInKeyRange table", table, testChecksumTableTable)
+	compare(fra.t, "StreamRowsInKeyRange keyRange", keyRange, testChecksumTableKeyRange)
+	for _, result := range testStreamRowsInKeyRangeResults {
+		if err := send(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func agentRPCTestStreamRowsInKeyRange(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamRowsInKeyRange(ctx, tablet, testChecksumTableTable, testChecksumTableKeyRange)
+	if err != nil {
+		t.Fatalf("StreamRowsInKeyRange failed: %v", err)
+	}
+	var results []*querypb.QueryResult
+	for {
+		result, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("StreamRowsInKeyRange stream failed: %v", err)
+		}
+		results = append(results, result)
+	}
+	compare(t, "StreamRowsInKeyRange results", results, testStreamRowsInKeyRangeResults)
+}
+
+func agentRPCTestStreamRowsInKeyRangePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamRowsInKeyRange(ctx, tablet, testChecksumTableTable, testChecksumTableKeyRange)
+	if err != nil {
+		t.Fatalf("StreamRowsInKeyRange failed: %v", err)
+	}
+	_, err = stream.Recv()
+	expectHandleRPCPanic(t, "StreamRowsInKeyRange", false /*verbose*/, err)
+}
+
+var testStreamKeyRangeBinlogPosition = "MariaDB/1-345-789"
+var testStreamKeyRangeBinlogCharset = &binlogdatapb.Charset{
+	Client: 33,
+	Conn:   33,
+	Server: 33,
+}
+var testStreamKeyRangeBinlogTransactions = []*binlogdatapb.BinlogTransaction{
+	{
+		Statements: []*binlogdatapb.BinlogTransaction_Statement{
+			{
+				Category: binlogdatapb.BinlogTransaction_Statement_BL_INSERT,
+				Sql:      []byte("insert into t1(id, msg) values (1, 'a') /* vtgate:: keyspace_id:10 */"),
+			},
+		},
+	},
+	{
+		Statements: []*binlogdatapb.BinlogTransaction_Statement{
+			{
+				Category: binlogdatapb.BinlogTransaction_Statement_BL_UPDATE,
+				Sql:      []byte("update t1 set msg = 'b' where id in (1) /* vtgate:: keyspace_id:10 */"),
+			},
+		},
+	},
+}
+
+func (fra *fakeRPCAgent) StreamKeyRangeBinlog(ctx context.Context, keyRange *topodatapb.KeyRange, startPos string, charset *binlogdatapb.Charset, send func(*binlogdatapb.BinlogTransaction) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "StreamKeyRangeBinlog keyRange", keyRange, testChecksumTableKeyRange)
+	compare(fra.t, "StreamKeyRangeBinlog startPos", startPos, testStreamKeyRangeBinlogPosition)
+	compare(fra.t, "StreamKeyRangeBinlog charset", charset, testStreamKeyRangeBinlogCharset)
+	for _, transaction := range testStreamKeyRangeBinlogTransactions {
+		if err := send(transaction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func agentRPCTestStreamKeyRangeBinlog(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamKeyRangeBinlog(ctx, tablet, testChecksumTableKeyRange, testStreamKeyRangeBinlogPosition, testStreamKeyRangeBinlogCharset)
+	if err != nil {
+		t.Fatalf("StreamKeyRangeBinlog failed: %v", err)
+	}
+	var transactions []*binlogdatapb.BinlogTransaction
+	for {
+		transaction, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("StreamKeyRangeBinlog stream failed: %v", err)
+		}
+		transactions = append(transactions, transaction)
+	}
+	compare(t, "StreamKeyRangeBinlog transactions", transactions, testStreamKeyRangeBinlogTransactions)
+}
+
+func agentRPCTestStreamKeyRangeBinlogPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StreamKeyRangeBinlog(ctx, tablet, testChecksumTableKeyRange, testStreamKeyRangeBinlogPosition, testStreamKeyRangeBinlogCharset)
+	if err != nil {
+		t.Fatalf("StreamKeyRangeBinlog failed: %v", err)
+	}
+	_, err = stream.Recv()
+	expectHandleRPCPanic(t, "StreamKeyRangeBinlog", false /*verbose*/, err)
+}
+
+var testCloneStreamTables = []string{"table1", "table2"}
+var testCloneStreamBufferSize = 4096
+var testCloneStreamResponses = []*tabletmanagerdatapb.CloneStreamResponse{
+	{
+		Position: "MariaDB/0-1-42",
+	},
+	{
+		Table:  "table1",
+		Result: &querypb.QueryResult{Fields: testExecuteFetchResult.Fields},
+	},
+	{
+		Table:  "table1",
+		Result: &querypb.QueryResult{Rows: testExecuteFetchResult.Rows},
+	},
+}
+
+func (fra *fakeRPCAgent) CloneStream(ctx context.Context, tables []string, bufferSize int, send func(*tabletmanagerdatapb.CloneStreamResponse) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "CloneStream tables", tables, testCloneStreamTables)
+	compare(fra.t, "CloneStream bufferSize", bufferSize, testCloneStreamBufferSize)
+	for _, response := range testCloneStreamResponses {
+		if err := send(response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func agentRPCTestCloneStream(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.CloneStream(ctx, tablet, testCloneStreamTables, tmclient.CloneOptions{BufferSize: testCloneStreamBufferSize})
+	if err != nil {
+		t.Fatalf("CloneStream failed: %v", err)
+	}
+	var responses []*tabletmanagerdatapb.CloneStreamResponse
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("CloneStream stream failed: %v", err)
+		}
+		responses = append(responses, response)
+	}
+	compare(t, "CloneStream responses", responses, testCloneStreamResponses)
+}
+
+func agentRPCTestCloneStreamPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.CloneStream(ctx, tablet, testCloneStreamTables, tmclient.CloneOptions{BufferSize: testCloneStreamBufferSize})
+	if err != nil {
+		t.Fatalf("CloneStream failed: %v", err)
+	}
+	_, err = stream.Recv()
+	expectHandleRPCPanic(t, "CloneStream", false /*verbose*/, err)
+}
+
+var testCharsetConfig = &tabletmanagerdatapb.CharsetConfig{
+	CharacterSetServer: "utf8mb4",
+	CollationServer:    "utf8mb4_general_ci",
+	DatabaseCharsets: map[string]string{
+		"vt_test_keyspace": "utf8",
+	},
+	DatabaseCollations: map[string]string{
+		"vt_test_keyspace": "utf8_general_ci",
+	},
+}
+
+func (fra *fakeRPCAgent) GetCharsetConfig(ctx context.Context) (*tabletmanagerdatapb.CharsetConfig, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testCharsetConfig, nil
+}
+
+func agentRPCTestGetCharsetConfig(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	config, err := client.GetCharsetConfig(ctx, tablet)
+	compareError(t, "GetCharsetConfig", err, config, testCharsetConfig)
+}
+
+func agentRPCTestGetCharsetConfigPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetCharsetConfig(ctx, tablet)
+	expectHandleRPCPanic(t, "GetCharsetConfig", false /*verbose*/, err)
+}
+
+var testProcessList = []*tabletmanagerdatapb.Process{
+	{
+		Id:      12,
+		User:    "vt_app",
+		Host:    "localhost",
+		Db:      "vt_test_keyspace",
+		Command: "Query",
+		Time:    42,
+		State:   "Sending data",
+		Info:    "select * from t1",
+	},
+}
+
+func (fra *fakeRPCAgent) GetProcessList(ctx context.Context) ([]*tabletmanagerdatapb.Process, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testProcessList, nil
+}
+
+func agentRPCTestGetProcessList(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	processes, err := client.GetProcessList(ctx, tablet)
+	compareError(t, "GetProcessList", err, processes, testProcessList)
+}
+
+func agentRPCTestGetProcessListPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetProcessList(ctx, tablet)
+	expectHandleRPCPanic(t, "GetProcessList", false /*verbose*/, err)
+}
+
+var testKillProcessID int64 = 12
+
+func (fra *fakeRPCAgent) KillProcess(ctx context.Context, id int64) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "KillProcess id", id, testKillProcessID)
+	return nil
+}
+
+func agentRPCTestKillProcess(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.KillProcess(ctx, tablet, testKillProcessID)
+	if err != nil {
+		t.Errorf("KillProcess failed: %v", err)
+	}
+}
+
+func agentRPCTestKillProcessPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.KillProcess(ctx, tablet, testKillProcessID)
+	expectHandleRPCPanic(t, "KillProcess", true /*verbose*/, err)
+}
+
+var testTailGeneralLogDuration = 10 * time.Second
+var testGeneralLogEntries = []string{
+	"2017-03-01 10:00:00.000001\t12\tQuery\tselect * from t1",
+	"2017-03-01 10:00:00.000002\t12\tQuit\t",
+}
+
+func (fra *fakeRPCAgent) TailGeneralLog(ctx context.Context, duration time.Duration, send func(string) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "TailGeneralLog duration", duration, testTailGeneralLogDuration)
+	for _, entry := range testGeneralLogEntries {
+		if err := send(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func agentRPCTestTailGeneralLog(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.TailGeneralLog(ctx, tablet, testTailGeneralLogDuration)
+	if err != nil {
+		t.Fatalf("TailGeneralLog failed: %v", err)
+	}
+	var entries []string
+	for {
+		entry, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("TailGeneralLog stream failed: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+	compare(t, "TailGeneralLog entries", entries, testGeneralLogEntries)
+}
+
+func agentRPCTestTailGeneralLogPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.TailGeneralLog(ctx, tablet, testTailGeneralLogDuration)
+	if err != nil {
+		t.Fatalf("TailGeneralLog failed: %v", err)
+	}
+	_, err = stream.Recv()
+	expectHandleRPCPanic(t, "TailGeneralLog", true /*verbose*/, err)
+}
+
+var testStreamQPSInterval = 2 * time.Second
+var testQPSSamples = []*tabletmanagerdatapb.QPSSample{
+	{
+		TimestampNs: 1488362400000000000,
+		IntervalNs:  int64(2 * time.Second),
+		ReadQps:     120.5,
+		WriteQps:    30,
+		TotalQps:    160.5,
+	},
+	{
+		TimestampNs: 1488362402000000000,
+		IntervalNs:  int64(2 * time.Second),
+		ReadQps:     98,
+		WriteQps:    41.5,
+		TotalQps:    150,
+		Dropped:     3,
+	},
+}
+
+func (fra *fakeRPCAgent) StreamQPS(ctx context.Context, interval time.Duration, send func(*tabletmanagerdatapb.QPSSample) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "StreamQPS interval", interval, testStreamQPSInterval)
+	for _, sample := range testQPSSamples {
+		if err := send(sample); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func agentRPCTestStreamQPS(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	samples, errFunc, err := client.StreamQPS(ctx, tablet, testStreamQPSInterval)
+	if err != nil {
+		t.Fatalf("StreamQPS failed: %v", err)
+	}
+	var got []*tabletmanagerdatapb.QPSSample
+	for sample := range samples {
+		got = append(got, sample)
+	}
+	if err := errFunc(); err != nil {
+		t.Fatalf("StreamQPS stream failed: %v", err)
+	}
+	// The client drops the second sample if it arrives before the
+	// first one is read.
+	if len(got) == 1 {
+		compare(t, "StreamQPS samples", got, testQPSSamples[:1])
+	} else {
+		compare(t, "StreamQPS samples", got, testQPSSamples)
+	}
 }
 
-func agentRPCTestExecuteFetchPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	// using pool
-	_, err := client.ExecuteFetchAsDba(ctx, tablet, true, testExecuteFetchQuery, testExecuteFetchMaxRows, true, false)
-	expectHandleRPCPanic(t, "ExecuteFetchAsDba", false /*verbose*/, err)
-	_, err = client.ExecuteFetchAsApp(ctx, tablet, true, testExecuteFetchQuery, testExecuteFetchMaxRows)
-	expectHandleRPCPanic(t, "ExecuteFetchAsApp", false /*verbose*/, err)
-
-	// not using pool
-	_, err = client.ExecuteFetchAsDba(ctx, tablet, false, testExecuteFetchQuery, testExecuteFetchMaxRows, true, false)
-	expectHandleRPCPanic(t, "ExecuteFetchAsDba", false /*verbose*/, err)
-	_, err = client.ExecuteFetchAsApp(ctx, tablet, false, testExecuteFetchQuery, testExecuteFetchMaxRows)
-	expectHandleRPCPanic(t, "ExecuteFetchAsApp", false /*verbose*/, err)
-	_, err = client.ExecuteFetchAsAllPrivs(ctx, tablet, testExecuteFetchQuery, testExecuteFetchMaxRows, false)
-	expectHandleRPCPanic(t, "ExecuteFetchAsAllPrivs", false /*verbose*/, err)
+func agentRPCTestStreamQPSPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	samples, errFunc, err := client.StreamQPS(ctx, tablet, testStreamQPSInterval)
+	if err != nil {
+		t.Fatalf("StreamQPS failed: %v", err)
+	}
+	for range samples {
+	}
+	expectHandleRPCPanic(t, "StreamQPS", false /*verbose*/, errFunc())
 }
 
 //
@@ -665,8 +2272,119 @@ func agentRPCTestSlaveStatusPanic(ctx context.Context, t *testing.T, client tmcl
 	expectHandleRPCPanic(t, "SlaveStatus", false /*verbose*/, err)
 }
 
+var testReplicationStatusAllChannels = map[string]*replicationdatapb.Status{
+	"": testReplicationStatus,
+	"source2": {
+		Position:        "MariaDB/2-345-123",
+		SlaveIoRunning:  true,
+		SlaveSqlRunning: true,
+		MasterHost:      "source2.host",
+		MasterPort:      3306,
+	},
+}
+
+func (fra *fakeRPCAgent) SlaveStatusAllChannels(ctx context.Context) (map[string]*replicationdatapb.Status, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testReplicationStatusAllChannels, nil
+}
+
+func agentRPCTestSlaveStatusAllChannels(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	statuses, err := client.SlaveStatusAllChannels(ctx, tablet)
+	compareError(t, "SlaveStatusAllChannels", err, statuses, testReplicationStatusAllChannels)
+}
+
+func agentRPCTestSlaveStatusAllChannelsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.SlaveStatusAllChannels(ctx, tablet)
+	expectHandleRPCPanic(t, "SlaveStatusAllChannels", false /*verbose*/, err)
+}
+
+var testReplicationSource = &tabletmanagerdatapb.ReplicationSource{
+	MasterHost: "master.host",
+	MasterPort: 3306,
+	User:       "vt_repl",
+}
+
+func (fra *fakeRPCAgent) GetReplicationSource(ctx context.Context) (*tabletmanagerdatapb.ReplicationSource, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testReplicationSource, nil
+}
+
+func agentRPCTestGetReplicationSource(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	source, err := client.GetReplicationSource(ctx, tablet)
+	compareError(t, "GetReplicationSource", err, source, testReplicationSource)
+}
+
+func agentRPCTestGetReplicationSourcePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetReplicationSource(ctx, tablet)
+	expectHandleRPCPanic(t, "GetReplicationSource", false /*verbose*/, err)
+}
+
+var testReplicationCredentialsValid = true
+
+func (fra *fakeRPCAgent) ValidateReplicationCredentials(ctx context.Context) (bool, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testReplicationCredentialsValid, nil
+}
+
+func agentRPCTestValidateReplicationCredentials(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	valid, err := client.ValidateReplicationCredentials(ctx, tablet)
+	compareError(t, "ValidateReplicationCredentials", err, valid, testReplicationCredentialsValid)
+}
+
+func agentRPCTestValidateReplicationCredentialsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.ValidateReplicationCredentials(ctx, tablet)
+	expectHandleRPCPanic(t, "ValidateReplicationCredentials", false /*verbose*/, err)
+}
+
+var testApplierQueueStats = &tabletmanagerdatapb.ApplierQueueStats{
+	RelayLogSpace:    104857600,
+	CoordinatorState: "Waiting for Slave Workers to free pending events",
+	Workers: []*tabletmanagerdatapb.ApplierWorkerStats{
+		{
+			WorkerId:     1,
+			ServiceState: "ON",
+			ThreadState:  "Executing event",
+		},
+		{
+			WorkerId:     2,
+			ServiceState: "ON",
+			ThreadState:  "Waiting for an event from Coordinator",
+		},
+	},
+}
+
+func (fra *fakeRPCAgent) GetApplierQueueStats(ctx context.Context) (*tabletmanagerdatapb.ApplierQueueStats, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testApplierQueueStats, nil
+}
+
+func agentRPCTestGetApplierQueueStats(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stats, err := client.GetApplierQueueStats(ctx, tablet)
+	compareError(t, "GetApplierQueueStats", err, stats, testApplierQueueStats)
+}
+
+func agentRPCTestGetApplierQueueStatsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetApplierQueueStats(ctx, tablet)
+	expectHandleRPCPanic(t, "GetApplierQueueStats", false /*verbose*/, err)
+}
+
 var testReplicationPosition = "MariaDB/5-456-890"
 
+// testExpectedKeyspace and testExpectedShard are the keyspace and
+// shard reparent RPCs send along for the tablet to check against.
+var (
+	testExpectedKeyspace = "test_keyspace"
+	testExpectedShard    = "0"
+)
+
 func (fra *fakeRPCAgent) MasterPosition(ctx context.Context) (string, error) {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
@@ -684,6 +2402,70 @@ func agentRPCTestMasterPositionPanic(ctx context.Context, t *testing.T, client t
 	expectHandleRPCPanic(t, "MasterPosition", false /*verbose*/, err)
 }
 
+var testGtidPurged = "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-12"
+
+func (fra *fakeRPCAgent) GetGtidPurged(ctx context.Context) (string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testGtidPurged, nil
+}
+
+func agentRPCTestGetGtidPurged(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	rs, err := client.GetGtidPurged(ctx, tablet)
+	compareError(t, "GetGtidPurged", err, rs, testGtidPurged)
+}
+
+func agentRPCTestGetGtidPurgedPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetGtidPurged(ctx, tablet)
+	expectHandleRPCPanic(t, "GetGtidPurged", false /*verbose*/, err)
+}
+
+var testBinlogStatsTransactionsPerSecond = 123.5
+var testBinlogStatsWindow = 20 * time.Second
+
+func (fra *fakeRPCAgent) GetBinlogStats(ctx context.Context) (float64, time.Duration, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testBinlogStatsTransactionsPerSecond, testBinlogStatsWindow, nil
+}
+
+func agentRPCTestGetBinlogStats(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	transactionsPerSecond, window, err := client.GetBinlogStats(ctx, tablet)
+	compareError(t, "GetBinlogStats", err, transactionsPerSecond, testBinlogStatsTransactionsPerSecond)
+	compare(t, "GetBinlogStats window", window, testBinlogStatsWindow)
+}
+
+func agentRPCTestGetBinlogStatsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, _, err := client.GetBinlogStats(ctx, tablet)
+	expectHandleRPCPanic(t, "GetBinlogStats", false /*verbose*/, err)
+}
+
+var testReplicationErrorStats = &tabletmanagerdatapb.ReplicationErrorStats{
+	IoErrors:   3,
+	SqlErrors:  1,
+	Reconnects: 2,
+}
+
+func (fra *fakeRPCAgent) GetReplicationErrorStats(ctx context.Context, reset bool) (*tabletmanagerdatapb.ReplicationErrorStats, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compareBool(fra.t, "GetReplicationErrorStats reset", reset)
+	return testReplicationErrorStats, nil
+}
+
+func agentRPCTestGetReplicationErrorStats(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stats, err := client.GetReplicationErrorStats(ctx, tablet, true)
+	compareError(t, "GetReplicationErrorStats", err, stats, testReplicationErrorStats)
+}
+
+func agentRPCTestGetReplicationErrorStatsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetReplicationErrorStats(ctx, tablet, true)
+	expectHandleRPCPanic(t, "GetReplicationErrorStats", false /*verbose*/, err)
+}
+
 var testStopSlaveCalled = false
 
 func (fra *fakeRPCAgent) StopSlave(ctx context.Context) error {
@@ -725,6 +2507,124 @@ func agentRPCTestStopSlaveMinimumPanic(ctx context.Context, t *testing.T, client
 	expectHandleRPCPanic(t, "StopSlaveMinimum", true /*verbose*/, err)
 }
 
+var testStopSlaveMinimumStreamResponses = []*tabletmanagerdatapb.StopSlaveMinimumStreamResponse{
+	{
+		Position: "MariaDB/0-1-40",
+	},
+	{
+		Position: "MariaDB/0-1-41",
+	},
+	{
+		Position: testReplicationPositionReturned,
+		Stopped:  true,
+	},
+}
+
+func (fra *fakeRPCAgent) StopSlaveMinimumStream(ctx context.Context, position string, waitTime time.Duration, send func(*tabletmanagerdatapb.StopSlaveMinimumStreamResponse) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "StopSlaveMinimumStream position", position, testReplicationPosition)
+	compare(fra.t, "StopSlaveMinimumStream waitTime", waitTime, testStopSlaveMinimumWaitTime)
+	for _, response := range testStopSlaveMinimumStreamResponses {
+		if err := send(response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func agentRPCTestStopSlaveMinimumStream(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StopSlaveMinimumStream(ctx, tablet, testReplicationPosition, testStopSlaveMinimumWaitTime)
+	if err != nil {
+		t.Fatalf("StopSlaveMinimumStream failed: %v", err)
+	}
+	var responses []*tabletmanagerdatapb.StopSlaveMinimumStreamResponse
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("StopSlaveMinimumStream stream failed: %v", err)
+		}
+		responses = append(responses, response)
+	}
+	compare(t, "StopSlaveMinimumStream responses", responses, testStopSlaveMinimumStreamResponses)
+}
+
+func agentRPCTestStopSlaveMinimumStreamPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.StopSlaveMinimumStream(ctx, tablet, testReplicationPosition, testStopSlaveMinimumWaitTime)
+	if err != nil {
+		t.Fatalf("StopSlaveMinimumStream failed: %v", err)
+	}
+	_, err = stream.Recv()
+	expectHandleRPCPanic(t, "StopSlaveMinimumStream", true /*verbose*/, err)
+}
+
+var testBoostReplicationCatchupDuration = 10 * time.Minute
+var testBoostReplicationCatchupResponses = []*tabletmanagerdatapb.BoostReplicationCatchupResponse{
+	{
+		SecondsBehindMaster: 3600,
+		Settings: map[string]int64{
+			"slave_parallel_workers": 16,
+			"innodb_io_capacity":     4000,
+		},
+	},
+	{
+		SecondsBehindMaster: 1200,
+	},
+	{
+		SecondsBehindMaster: 30,
+		Settings: map[string]int64{
+			"slave_parallel_workers": 4,
+			"innodb_io_capacity":     200,
+		},
+		Reverted: true,
+	},
+}
+
+func (fra *fakeRPCAgent) BoostReplicationCatchup(ctx context.Context, duration time.Duration, send func(*tabletmanagerdatapb.BoostReplicationCatchupResponse) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "BoostReplicationCatchup duration", duration, testBoostReplicationCatchupDuration)
+	for _, response := range testBoostReplicationCatchupResponses {
+		if err := send(response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func agentRPCTestBoostReplicationCatchup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.BoostReplicationCatchup(ctx, tablet, testBoostReplicationCatchupDuration)
+	if err != nil {
+		t.Fatalf("BoostReplicationCatchup failed: %v", err)
+	}
+	var responses []*tabletmanagerdatapb.BoostReplicationCatchupResponse
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("BoostReplicationCatchup stream failed: %v", err)
+		}
+		responses = append(responses, response)
+	}
+	compare(t, "BoostReplicationCatchup responses", responses, testBoostReplicationCatchupResponses)
+}
+
+func agentRPCTestBoostReplicationCatchupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.BoostReplicationCatchup(ctx, tablet, testBoostReplicationCatchupDuration)
+	if err != nil {
+		t.Fatalf("BoostReplicationCatchup failed: %v", err)
+	}
+	_, err = stream.Recv()
+	expectHandleRPCPanic(t, "BoostReplicationCatchup", true /*verbose*/, err)
+}
+
 var testStartSlaveCalled = false
 
 func (fra *fakeRPCAgent) StartSlave(ctx context.Context) error {
@@ -745,23 +2645,131 @@ func agentRPCTestStartSlavePanic(ctx context.Context, t *testing.T, client tmcli
 	expectHandleRPCPanic(t, "StartSlave", true /*verbose*/, err)
 }
 
+var testParallelReplicationWorkers = 8
+var testParallelReplicationType = "LOGICAL_CLOCK"
+
+func (fra *fakeRPCAgent) EnableParallelReplication(ctx context.Context, workers int, parallelType string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "EnableParallelReplication workers", workers, testParallelReplicationWorkers)
+	compare(fra.t, "EnableParallelReplication parallelType", parallelType, testParallelReplicationType)
+	return nil
+}
+
+func agentRPCTestEnableParallelReplication(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.EnableParallelReplication(ctx, tablet, testParallelReplicationWorkers, testParallelReplicationType)
+	if err != nil {
+		t.Errorf("EnableParallelReplication failed: %v", err)
+	}
+}
+
+func agentRPCTestEnableParallelReplicationPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.EnableParallelReplication(ctx, tablet, testParallelReplicationWorkers, testParallelReplicationType)
+	expectHandleRPCPanic(t, "EnableParallelReplication", true /*verbose*/, err)
+}
+
+var testReplicationUser = "vt_repl2"
+var testReplicationPassword = "secret2"
+
+func (fra *fakeRPCAgent) RotateReplicationCredentials(ctx context.Context, user, password string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "RotateReplicationCredentials user", user, testReplicationUser)
+	compare(fra.t, "RotateReplicationCredentials password", password, testReplicationPassword)
+	return nil
+}
+
+func agentRPCTestRotateReplicationCredentials(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.RotateReplicationCredentials(ctx, tablet, testReplicationUser, testReplicationPassword)
+	if err != nil {
+		t.Errorf("RotateReplicationCredentials failed: %v", err)
+	}
+}
+
+func agentRPCTestRotateReplicationCredentialsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.RotateReplicationCredentials(ctx, tablet, testReplicationUser, testReplicationPassword)
+	expectHandleRPCPanic(t, "RotateReplicationCredentials", false /*verbose*/, err)
+}
+
+var testReplicationSSLOptions = &tabletmanagerdatapb.ReplicationSSLOptions{
+	Ca:   "/etc/ssl/repl-ca.pem",
+	Cert: "/etc/ssl/repl-cert.pem",
+	Key:  "/etc/ssl/repl-key.pem",
+}
+
+func (fra *fakeRPCAgent) ConfigureReplicationSSL(ctx context.Context, opts *tabletmanagerdatapb.ReplicationSSLOptions) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ConfigureReplicationSSL opts", opts, testReplicationSSLOptions)
+	return nil
+}
+
+func agentRPCTestConfigureReplicationSSL(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ConfigureReplicationSSL(ctx, tablet, testReplicationSSLOptions)
+	if err != nil {
+		t.Errorf("ConfigureReplicationSSL failed: %v", err)
+	}
+}
+
+func agentRPCTestConfigureReplicationSSLPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ConfigureReplicationSSL(ctx, tablet, testReplicationSSLOptions)
+	expectHandleRPCPanic(t, "ConfigureReplicationSSL", true /*verbose*/, err)
+}
+
+var testRepairRelayLogCalled = false
+
+func (fra *fakeRPCAgent) RepairRelayLog(ctx context.Context, logger logutil.Logger) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	logStuff(logger, 10)
+	testRepairRelayLogCalled = true
+	return nil
+}
+
+func agentRPCTestRepairRelayLog(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.RepairRelayLog(ctx, tablet)
+	if err != nil {
+		t.Fatalf("RepairRelayLog failed: %v", err)
+	}
+	err = compareLoggedStuff(t, "RepairRelayLog", stream, 10)
+	compareError(t, "RepairRelayLog", err, true, testRepairRelayLogCalled)
+}
+
+func agentRPCTestRepairRelayLogPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.RepairRelayLog(ctx, tablet)
+	if err != nil {
+		t.Fatalf("RepairRelayLog failed: %v", err)
+	}
+	e, err := stream.Recv()
+	if err == nil {
+		t.Fatalf("Unexpected RepairRelayLog logs: %v", e)
+	}
+	expectHandleRPCPanic(t, "RepairRelayLog", true /*verbose*/, err)
+}
+
 var testTabletExternallyReparentedCalled = false
+var testTabletExternallyReparentedValidate = true
 
-func (fra *fakeRPCAgent) TabletExternallyReparented(ctx context.Context, externalID string) error {
+func (fra *fakeRPCAgent) TabletExternallyReparented(ctx context.Context, externalID string, validate bool) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "TabletExternallyReparented validate", validate, testTabletExternallyReparentedValidate)
 	testTabletExternallyReparentedCalled = true
 	return nil
 }
 
 func agentRPCTestTabletExternallyReparented(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	err := client.TabletExternallyReparented(ctx, tablet, "")
+	err := client.TabletExternallyReparented(ctx, tablet, "", testTabletExternallyReparentedValidate)
 	compareError(t, "TabletExternallyReparented", err, true, testTabletExternallyReparentedCalled)
 }
 
 func agentRPCTestTabletExternallyReparentedPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	err := client.TabletExternallyReparented(ctx, tablet, "")
+	err := client.TabletExternallyReparented(ctx, tablet, "", testTabletExternallyReparentedValidate)
 	expectHandleRPCPanic(t, "TabletExternallyReparented", false /*verbose*/, err)
 }
 
@@ -784,6 +2792,45 @@ func agentRPCTestGetSlavesPanic(ctx context.Context, t *testing.T, client tmclie
 	expectHandleRPCPanic(t, "GetSlaves", false /*verbose*/, err)
 }
 
+var testReplicationGraph = &tabletmanagerdatapb.ReplicationGraph{
+	Master: &tabletmanagerdatapb.ReplicationNeighbor{
+		Host: "master1",
+		Alias: &topodatapb.TabletAlias{
+			Cell: "cell1",
+			Uid:  100,
+		},
+	},
+	Slaves: []*tabletmanagerdatapb.ReplicationNeighbor{
+		{
+			Host: "slave1",
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  101,
+			},
+		},
+		{
+			Host: "slave2",
+		},
+	},
+}
+
+func (fra *fakeRPCAgent) GetReplicationGraph(ctx context.Context) (*tabletmanagerdatapb.ReplicationGraph, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testReplicationGraph, nil
+}
+
+func agentRPCTestGetReplicationGraph(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	graph, err := client.GetReplicationGraph(ctx, tablet)
+	compareError(t, "GetReplicationGraph", err, graph, testReplicationGraph)
+}
+
+func agentRPCTestGetReplicationGraphPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetReplicationGraph(ctx, tablet)
+	expectHandleRPCPanic(t, "GetReplicationGraph", false /*verbose*/, err)
+}
+
 var testBlpPosition = &tabletmanagerdatapb.BlpPosition{
 	Uid:      73,
 	Position: "testReplicationPosition",
@@ -866,14 +2913,48 @@ func (fra *fakeRPCAgent) RunBlpUntil(ctx context.Context, bpl []*tabletmanagerda
 	return testReplicationPosition, nil
 }
 
-func agentRPCTestRunBlpUntil(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	rp, err := client.RunBlpUntil(ctx, tablet, testBlpPositionList, testRunBlpUntilWaitTime)
-	compareError(t, "RunBlpUntil", err, rp, testReplicationPosition)
+func agentRPCTestRunBlpUntil(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	rp, err := client.RunBlpUntil(ctx, tablet, testBlpPositionList, testRunBlpUntilWaitTime)
+	compareError(t, "RunBlpUntil", err, rp, testReplicationPosition)
+}
+
+func agentRPCTestRunBlpUntilPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.RunBlpUntil(ctx, tablet, testBlpPositionList, testRunBlpUntilWaitTime)
+	expectHandleRPCPanic(t, "RunBlpUntil", true /*verbose*/, err)
+}
+
+var testBinlogFilters = []*tabletmanagerdatapb.BinlogFilter{
+	{
+		Uid:      1,
+		Keyspace: "source_keyspace",
+		Shard:    "-80",
+		KeyRange: &topodatapb.KeyRange{
+			End: []byte{0x40},
+		},
+	},
+	{
+		Uid:      2,
+		Keyspace: "source_keyspace",
+		Shard:    "0",
+		Tables:   []string{"t1", "/t2.*/"},
+	},
+}
+
+func (fra *fakeRPCAgent) GetBinlogFilters(ctx context.Context) ([]*tabletmanagerdatapb.BinlogFilter, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testBinlogFilters, nil
+}
+
+func agentRPCTestGetBinlogFilters(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	filters, err := client.GetBinlogFilters(ctx, tablet)
+	compareError(t, "GetBinlogFilters", err, filters, testBinlogFilters)
 }
 
-func agentRPCTestRunBlpUntilPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	_, err := client.RunBlpUntil(ctx, tablet, testBlpPositionList, testRunBlpUntilWaitTime)
-	expectHandleRPCPanic(t, "RunBlpUntil", true /*verbose*/, err)
+func agentRPCTestGetBinlogFiltersPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetBinlogFilters(ctx, tablet)
+	expectHandleRPCPanic(t, "GetBinlogFilters", false /*verbose*/, err)
 }
 
 //
@@ -900,20 +2981,22 @@ func agentRPCTestResetReplicationPanic(ctx context.Context, t *testing.T, client
 	expectHandleRPCPanic(t, "ResetReplication", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCAgent) InitMaster(ctx context.Context) (string, error) {
+func (fra *fakeRPCAgent) InitMaster(ctx context.Context, expectedKeyspace, expectedShard string) (string, error) {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "InitMaster expectedKeyspace", expectedKeyspace, testExpectedKeyspace)
+	compare(fra.t, "InitMaster expectedShard", expectedShard, testExpectedShard)
 	return testReplicationPosition, nil
 }
 
 func agentRPCTestInitMaster(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	rp, err := client.InitMaster(ctx, tablet)
+	rp, err := client.InitMaster(ctx, tablet, testExpectedKeyspace, testExpectedShard)
 	compareError(t, "InitMaster", err, rp, testReplicationPosition)
 }
 
 func agentRPCTestInitMasterPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	_, err := client.InitMaster(ctx, tablet)
+	_, err := client.InitMaster(ctx, tablet, testExpectedKeyspace, testExpectedShard)
 	expectHandleRPCPanic(t, "InitMaster", true /*verbose*/, err)
 }
 
@@ -925,7 +3008,7 @@ var testMasterAlias = &topodatapb.TabletAlias{
 	Uid:  372,
 }
 
-func (fra *fakeRPCAgent) PopulateReparentJournal(ctx context.Context, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, position string) error {
+func (fra *fakeRPCAgent) PopulateReparentJournal(ctx context.Context, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, position, expectedKeyspace, expectedShard string) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
@@ -933,20 +3016,46 @@ func (fra *fakeRPCAgent) PopulateReparentJournal(ctx context.Context, timeCreate
 	compare(fra.t, "PopulateReparentJournal actionName", actionName, testActionName)
 	compare(fra.t, "PopulateReparentJournal masterAlias", masterAlias, testMasterAlias)
 	compare(fra.t, "PopulateReparentJournal pos", position, testReplicationPosition)
+	compare(fra.t, "PopulateReparentJournal expectedKeyspace", expectedKeyspace, testExpectedKeyspace)
+	compare(fra.t, "PopulateReparentJournal expectedShard", expectedShard, testExpectedShard)
 	testPopulateReparentJournalCalled = true
 	return nil
 }
 
 func agentRPCTestPopulateReparentJournal(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	err := client.PopulateReparentJournal(ctx, tablet, testTimeCreatedNS, testActionName, testMasterAlias, testReplicationPosition)
+	err := client.PopulateReparentJournal(ctx, tablet, testExpectedKeyspace, testExpectedShard, testTimeCreatedNS, testActionName, testMasterAlias, testReplicationPosition)
 	compareError(t, "PopulateReparentJournal", err, true, testPopulateReparentJournalCalled)
 }
 
 func agentRPCTestPopulateReparentJournalPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	err := client.PopulateReparentJournal(ctx, tablet, testTimeCreatedNS, testActionName, testMasterAlias, testReplicationPosition)
+	err := client.PopulateReparentJournal(ctx, tablet, testExpectedKeyspace, testExpectedShard, testTimeCreatedNS, testActionName, testMasterAlias, testReplicationPosition)
 	expectHandleRPCPanic(t, "PopulateReparentJournal", false /*verbose*/, err)
 }
 
+var testLastReparentJournalEntry = &tabletmanagerdatapb.ReparentJournalEntry{
+	TimeCreatedNs:       testTimeCreatedNS,
+	ActionName:          testActionName,
+	MasterAlias:         testMasterAlias,
+	ReplicationPosition: testReplicationPosition,
+}
+
+func (fra *fakeRPCAgent) GetLastReparentJournalEntry(ctx context.Context) (*tabletmanagerdatapb.ReparentJournalEntry, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testLastReparentJournalEntry, nil
+}
+
+func agentRPCTestGetLastReparentJournalEntry(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	entry, err := client.GetLastReparentJournalEntry(ctx, tablet)
+	compareError(t, "GetLastReparentJournalEntry", err, entry, testLastReparentJournalEntry)
+}
+
+func agentRPCTestGetLastReparentJournalEntryPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetLastReparentJournalEntry(ctx, tablet)
+	expectHandleRPCPanic(t, "GetLastReparentJournalEntry", false /*verbose*/, err)
+}
+
 var testInitSlaveCalled = false
 
 func (fra *fakeRPCAgent) InitSlave(ctx context.Context, parent *topodatapb.TabletAlias, position string, timeCreatedNS int64) error {
@@ -1030,27 +3139,61 @@ func agentRPCTestSlaveWasPromotedPanic(ctx context.Context, t *testing.T, client
 var testSetMasterCalled = false
 var testForceStartSlave = true
 
-func (fra *fakeRPCAgent) SetMaster(ctx context.Context, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error {
+func (fra *fakeRPCAgent) SetMaster(ctx context.Context, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool, expectedKeyspace, expectedShard string) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
 	compare(fra.t, "SetMaster parent", parent, testMasterAlias)
 	compare(fra.t, "SetMaster timeCreatedNS", timeCreatedNS, testTimeCreatedNS)
 	compare(fra.t, "SetMaster forceStartSlave", forceStartSlave, testForceStartSlave)
+	compare(fra.t, "SetMaster expectedKeyspace", expectedKeyspace, testExpectedKeyspace)
+	compare(fra.t, "SetMaster expectedShard", expectedShard, testExpectedShard)
 	testSetMasterCalled = true
 	return nil
 }
 
 func agentRPCTestSetMaster(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	err := client.SetMaster(ctx, tablet, testMasterAlias, testTimeCreatedNS, testForceStartSlave)
+	err := client.SetMaster(ctx, tablet, testExpectedKeyspace, testExpectedShard, testMasterAlias, testTimeCreatedNS, testForceStartSlave)
 	compareError(t, "SetMaster", err, true, testSetMasterCalled)
 }
 
 func agentRPCTestSetMasterPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	err := client.SetMaster(ctx, tablet, testMasterAlias, testTimeCreatedNS, testForceStartSlave)
+	err := client.SetMaster(ctx, tablet, testExpectedKeyspace, testExpectedShard, testMasterAlias, testTimeCreatedNS, testForceStartSlave)
 	expectHandleRPCPanic(t, "SetMaster", true /*verbose*/, err)
 }
 
+var testReplicationOptions = tmclient.ReplicationOptions{
+	File:            "vt-0000000101-bin.000012",
+	Position:        4567,
+	ForceStartSlave: true,
+}
+var testConfigureReplicationCalled = false
+
+func (fra *fakeRPCAgent) ConfigureReplication(ctx context.Context, parent *topodatapb.TabletAlias, autoPosition bool, file string, position uint64, forceStartSlave bool, expectedKeyspace, expectedShard string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ConfigureReplication parent", parent, testMasterAlias)
+	compare(fra.t, "ConfigureReplication autoPosition", autoPosition, testReplicationOptions.AutoPosition)
+	compare(fra.t, "ConfigureReplication file", file, testReplicationOptions.File)
+	compare(fra.t, "ConfigureReplication position", position, testReplicationOptions.Position)
+	compare(fra.t, "ConfigureReplication forceStartSlave", forceStartSlave, testReplicationOptions.ForceStartSlave)
+	compare(fra.t, "ConfigureReplication expectedKeyspace", expectedKeyspace, testExpectedKeyspace)
+	compare(fra.t, "ConfigureReplication expectedShard", expectedShard, testExpectedShard)
+	testConfigureReplicationCalled = true
+	return nil
+}
+
+func agentRPCTestConfigureReplication(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ConfigureReplication(ctx, tablet, testExpectedKeyspace, testExpectedShard, testMasterAlias, testReplicationOptions)
+	compareError(t, "ConfigureReplication", err, true, testConfigureReplicationCalled)
+}
+
+func agentRPCTestConfigureReplicationPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.ConfigureReplication(ctx, tablet, testExpectedKeyspace, testExpectedShard, testMasterAlias, testReplicationOptions)
+	expectHandleRPCPanic(t, "ConfigureReplication", true /*verbose*/, err)
+}
+
 var testSlaveWasRestartedParent = &topodatapb.TabletAlias{
 	Cell: "prison",
 	Uid:  42,
@@ -1110,19 +3253,121 @@ func agentRPCTestPromoteSlavePanic(ctx context.Context, t *testing.T, client tmc
 	expectHandleRPCPanic(t, "PromoteSlave", true /*verbose*/, err)
 }
 
+var testReparentIneligibleReason = "scheduled reboot"
+
+func (fra *fakeRPCAgent) SetReparentEligibility(ctx context.Context, eligible bool, reason string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetReparentEligibility eligible", eligible, false)
+	compare(fra.t, "SetReparentEligibility reason", reason, testReparentIneligibleReason)
+	return nil
+}
+
+func agentRPCTestSetReparentEligibility(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetReparentEligibility(ctx, tablet, false, testReparentIneligibleReason)
+	if err != nil {
+		t.Errorf("SetReparentEligibility failed: %v", err)
+	}
+}
+
+func agentRPCTestSetReparentEligibilityPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetReparentEligibility(ctx, tablet, false, testReparentIneligibleReason)
+	expectHandleRPCPanic(t, "SetReparentEligibility", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) CheckReparentCandidate(ctx context.Context) (bool, string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return false, testReparentIneligibleReason, nil
+}
+
+func agentRPCTestCheckReparentCandidate(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	eligible, reason, err := client.CheckReparentCandidate(ctx, tablet)
+	if err != nil {
+		t.Errorf("CheckReparentCandidate failed: %v", err)
+		return
+	}
+	compare(t, "CheckReparentCandidate eligible", eligible, false)
+	compare(t, "CheckReparentCandidate reason", reason, testReparentIneligibleReason)
+}
+
+func agentRPCTestCheckReparentCandidatePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, _, err := client.CheckReparentCandidate(ctx, tablet)
+	expectHandleRPCPanic(t, "CheckReparentCandidate", false /*verbose*/, err)
+}
+
+var testSemiSyncAckCount = 2
+
+func (fra *fakeRPCAgent) SetSemiSyncAckCount(ctx context.Context, count int) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetSemiSyncAckCount count", count, testSemiSyncAckCount)
+	return nil
+}
+
+func agentRPCTestSetSemiSyncAckCount(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetSemiSyncAckCount(ctx, tablet, testSemiSyncAckCount)
+	if err != nil {
+		t.Errorf("SetSemiSyncAckCount failed: %v", err)
+	}
+}
+
+func agentRPCTestSetSemiSyncAckCountPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetSemiSyncAckCount(ctx, tablet, testSemiSyncAckCount)
+	expectHandleRPCPanic(t, "SetSemiSyncAckCount", true /*verbose*/, err)
+}
+
+var testDurabilityPolicy = &tabletmanagerdatapb.DurabilityPolicy{
+	Name:                "semi_sync",
+	SemiSyncMaster:      true,
+	SemiSyncSlave:       true,
+	MysqlSemiSyncMaster: true,
+	MysqlSemiSyncSlave:  true,
+}
+
+func (fra *fakeRPCAgent) GetDurabilityPolicy(ctx context.Context) (*tabletmanagerdatapb.DurabilityPolicy, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testDurabilityPolicy, nil
+}
+
+func agentRPCTestGetDurabilityPolicy(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	policy, err := client.GetDurabilityPolicy(ctx, tablet)
+	compareError(t, "GetDurabilityPolicy", err, policy, testDurabilityPolicy)
+}
+
+func agentRPCTestGetDurabilityPolicyPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetDurabilityPolicy(ctx, tablet)
+	expectHandleRPCPanic(t, "GetDurabilityPolicy", false /*verbose*/, err)
+}
+
 //
 // Backup / restore related methods
 //
 
 var testBackupConcurrency = 24
+var testBackupDefaultConcurrency = 8
+var testBackupMaxConcurrency = 32
 var testBackupCalled = false
+
+// testBackupWantConcurrency is the concurrency the fake agent expects
+// Backup to be called with, after the client applied the limits.
+var testBackupWantConcurrency = testBackupConcurrency
 var testRestoreFromBackupCalled = false
 
+// testRestoreFromBackupAborted is closed by the fake agent when
+// it sees the restore was aborted. If nil, restores complete.
+var testRestoreFromBackupAborted chan struct{}
+
 func (fra *fakeRPCAgent) Backup(ctx context.Context, concurrency int, logger logutil.Logger) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
-	compare(fra.t, "Backup args", concurrency, testBackupConcurrency)
+	compare(fra.t, "Backup args", concurrency, testBackupWantConcurrency)
 	logStuff(logger, 10)
 	testBackupCalled = true
 	return nil
@@ -1137,6 +3382,74 @@ func agentRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.Table
 	compareError(t, "Backup", err, true, testBackupCalled)
 }
 
+func (fra *fakeRPCAgent) GetBackupLimits(ctx context.Context) (int, int, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testBackupDefaultConcurrency, testBackupMaxConcurrency, nil
+}
+
+func agentRPCTestGetBackupLimits(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	defaultConcurrency, maxConcurrency, err := client.GetBackupLimits(ctx, tablet)
+	compareError(t, "GetBackupLimits", err, defaultConcurrency, testBackupDefaultConcurrency)
+	compare(t, "GetBackupLimits maxConcurrency", maxConcurrency, testBackupMaxConcurrency)
+}
+
+func agentRPCTestGetBackupLimitsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, _, err := client.GetBackupLimits(ctx, tablet)
+	expectHandleRPCPanic(t, "GetBackupLimits", false /*verbose*/, err)
+}
+
+var testBackupPosition = "MariaDB/6-678-4567"
+
+func (fra *fakeRPCAgent) GetBackupPosition(ctx context.Context) (string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testBackupPosition, nil
+}
+
+func agentRPCTestGetBackupPosition(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	position, err := client.GetBackupPosition(ctx, tablet)
+	compareError(t, "GetBackupPosition", err, position, testBackupPosition)
+}
+
+func agentRPCTestGetBackupPositionPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetBackupPosition(ctx, tablet)
+	expectHandleRPCPanic(t, "GetBackupPosition", false /*verbose*/, err)
+}
+
+// agentRPCTestBackupConcurrency checks the client applies the tablet
+// backup limits: 0 uses the default, values over the maximum are
+// capped, and negative values are rejected.
+func agentRPCTestBackupConcurrency(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	defer func() { testBackupWantConcurrency = testBackupConcurrency }()
+	for _, tc := range []struct {
+		concurrency int
+		want        int
+	}{
+		{0, testBackupDefaultConcurrency},
+		{testBackupMaxConcurrency + 1, testBackupMaxConcurrency},
+	} {
+		testBackupWantConcurrency = tc.want
+		stream, err := client.Backup(ctx, tablet, tc.concurrency)
+		if err != nil {
+			t.Fatalf("Backup(%v) failed: %v", tc.concurrency, err)
+		}
+		if err := compareLoggedStuff(t, "Backup", stream, 10); err != nil {
+			t.Errorf("Backup(%v) failed: %v", tc.concurrency, err)
+		}
+	}
+
+	testBackupCalled = false
+	if _, err := client.Backup(ctx, tablet, -1); err == nil || !strings.Contains(err.Error(), "invalid backup concurrency -1") {
+		t.Errorf("Backup(-1) returned %v, want an invalid backup concurrency error", err)
+	}
+	if testBackupCalled {
+		t.Errorf("Backup(-1) reached the tablet")
+	}
+}
+
 func agentRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
 	stream, err := client.Backup(ctx, tablet, testBackupConcurrency)
 	if err != nil {
@@ -1149,10 +3462,55 @@ func agentRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.
 	expectHandleRPCPanic(t, "Backup", true /*verbose*/, err)
 }
 
+var testIncrementalBackupBaseName = "2017-01-02.030405.cell1-0000000100"
+var testIncrementalBackupOptions = tmclient.IncrementalBackupOptions{
+	Concurrency: 4,
+}
+var testIncrementalBackupCalled = false
+
+func (fra *fakeRPCAgent) IncrementalBackup(ctx context.Context, baseBackupName string, concurrency int, logger logutil.Logger) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "IncrementalBackup baseBackupName", baseBackupName, testIncrementalBackupBaseName)
+	compare(fra.t, "IncrementalBackup concurrency", concurrency, testIncrementalBackupOptions.Concurrency)
+	logStuff(logger, 10)
+	testIncrementalBackupCalled = true
+	return nil
+}
+
+func agentRPCTestIncrementalBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.IncrementalBackup(ctx, tablet, testIncrementalBackupBaseName, testIncrementalBackupOptions)
+	if err != nil {
+		t.Fatalf("IncrementalBackup failed: %v", err)
+	}
+	err = compareLoggedStuff(t, "IncrementalBackup", stream, 10)
+	compareError(t, "IncrementalBackup", err, true, testIncrementalBackupCalled)
+}
+
+func agentRPCTestIncrementalBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.IncrementalBackup(ctx, tablet, testIncrementalBackupBaseName, testIncrementalBackupOptions)
+	if err != nil {
+		t.Fatalf("IncrementalBackup failed: %v", err)
+	}
+	e, err := stream.Recv()
+	if err == nil {
+		t.Fatalf("Unexpected IncrementalBackup logs: %v", e)
+	}
+	expectHandleRPCPanic(t, "IncrementalBackup", true /*verbose*/, err)
+}
+
 func (fra *fakeRPCAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	if testRestoreFromBackupAborted != nil {
+		// Behave like a long restore, until the client aborts it.
+		logStuff(logger, 1)
+		<-ctx.Done()
+		close(testRestoreFromBackupAborted)
+		return mysqlctl.ErrRestoreAborted
+	}
 	logStuff(logger, 10)
 	testRestoreFromBackupCalled = true
 	return nil
@@ -1167,6 +3525,37 @@ func agentRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmc
 	compareError(t, "RestoreFromBackup", err, true, testRestoreFromBackupCalled)
 }
 
+func agentRPCTestRestoreFromBackupAbort(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	aborted := make(chan struct{})
+	testRestoreFromBackupAborted = aborted
+	defer func() {
+		testRestoreFromBackupAborted = nil
+	}()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.RestoreFromBackup(ctx, tablet)
+	if err != nil {
+		t.Fatalf("RestoreFromBackup failed: %v", err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("No logged value for RestoreFromBackup: %v", err)
+	}
+
+	// Abort the restore, the stream should say so.
+	cancel()
+	if _, err := stream.Recv(); err != tmclient.ErrRestoreAborted {
+		t.Fatalf("Unexpected RestoreFromBackup error after abort: got %v expected %v", err, tmclient.ErrRestoreAborted)
+	}
+
+	// And the server should see it.
+	select {
+	case <-aborted:
+	case <-time.After(10 * time.Second):
+		t.Fatalf("RestoreFromBackup was not aborted on the server side")
+	}
+}
+
 func agentRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
 	stream, err := client.RestoreFromBackup(ctx, tablet)
 	if err != nil {
@@ -1179,6 +3568,225 @@ func agentRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, clien
 	expectHandleRPCPanic(t, "RestoreFromBackup", true /*verbose*/, err)
 }
 
+var testRestoreToTimestampBackupName = "2017-01-02.030405.cell1-0000000100"
+var testRestoreToTimestampTargetTime = time.Date(2017, 1, 2, 4, 0, 0, 0, time.UTC)
+var testRestoreToTimestampCalled = false
+
+func (fra *fakeRPCAgent) RestoreToTimestamp(ctx context.Context, backupName string, targetTime time.Time, logger logutil.Logger) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "RestoreToTimestamp backupName", backupName, testRestoreToTimestampBackupName)
+	if !targetTime.Equal(testRestoreToTimestampTargetTime) {
+		fra.t.Errorf("Unexpected RestoreToTimestamp targetTime: got %v expected %v", targetTime, testRestoreToTimestampTargetTime)
+	}
+	logStuff(logger, 10)
+	testRestoreToTimestampCalled = true
+	return nil
+}
+
+func agentRPCTestRestoreToTimestamp(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.RestoreToTimestamp(ctx, tablet, testRestoreToTimestampBackupName, testRestoreToTimestampTargetTime)
+	if err != nil {
+		t.Fatalf("RestoreToTimestamp failed: %v", err)
+	}
+	err = compareLoggedStuff(t, "RestoreToTimestamp", stream, 10)
+	compareError(t, "RestoreToTimestamp", err, true, testRestoreToTimestampCalled)
+}
+
+func agentRPCTestRestoreToTimestampPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.RestoreToTimestamp(ctx, tablet, testRestoreToTimestampBackupName, testRestoreToTimestampTargetTime)
+	if err != nil {
+		t.Fatalf("RestoreToTimestamp failed: %v", err)
+	}
+	e, err := stream.Recv()
+	if err == nil {
+		t.Fatalf("Unexpected RestoreToTimestamp logs: %v", e)
+	}
+	expectHandleRPCPanic(t, "RestoreToTimestamp", true /*verbose*/, err)
+}
+
+var testPreferredBackupName = "2017-01-02.030405.cell1-0000000100"
+
+func (fra *fakeRPCAgent) SetPreferredBackup(ctx context.Context, backupName string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetPreferredBackup backupName", backupName, testPreferredBackupName)
+	return nil
+}
+
+func agentRPCTestSetPreferredBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetPreferredBackup(ctx, tablet, testPreferredBackupName)
+	if err != nil {
+		t.Errorf("SetPreferredBackup failed: %v", err)
+	}
+}
+
+func agentRPCTestSetPreferredBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetPreferredBackup(ctx, tablet, testPreferredBackupName)
+	expectHandleRPCPanic(t, "SetPreferredBackup", true /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) GetPreferredBackup(ctx context.Context) (string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testPreferredBackupName, nil
+}
+
+func agentRPCTestGetPreferredBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	backupName, err := client.GetPreferredBackup(ctx, tablet)
+	compareError(t, "GetPreferredBackup", err, backupName, testPreferredBackupName)
+}
+
+func agentRPCTestGetPreferredBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetPreferredBackup(ctx, tablet)
+	expectHandleRPCPanic(t, "GetPreferredBackup", false /*verbose*/, err)
+}
+
+var testCompatReport = &tabletmanagerdatapb.CompatReport{
+	Compatible: false,
+	Checks: []*tabletmanagerdatapb.CompatCheck{
+		{
+			Name:        "mysql_version",
+			BackupValue: "5.6.35-log",
+			TabletValue: "5.7.17-log",
+			Compatible:  false,
+			Reason:      "backup has 5.6.35-log, tablet has 5.7.17-log",
+		},
+		{
+			Name:        "character_set",
+			BackupValue: "utf8",
+			TabletValue: "utf8",
+			Compatible:  true,
+		},
+	},
+}
+
+func (fra *fakeRPCAgent) CheckRestoreCompatibility(ctx context.Context, backupName string) (*tabletmanagerdatapb.CompatReport, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "CheckRestoreCompatibility backupName", backupName, testPreferredBackupName)
+	return testCompatReport, nil
+}
+
+func agentRPCTestCheckRestoreCompatibility(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	report, err := client.CheckRestoreCompatibility(ctx, tablet, testPreferredBackupName)
+	compareError(t, "CheckRestoreCompatibility", err, report, testCompatReport)
+}
+
+func agentRPCTestCheckRestoreCompatibilityPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.CheckRestoreCompatibility(ctx, tablet, testPreferredBackupName)
+	expectHandleRPCPanic(t, "CheckRestoreCompatibility", false /*verbose*/, err)
+}
+
+var testTestRestoreBackupName = "2017-01-02.030405.cell1-0000000100"
+var testTestRestoreCalled = false
+
+func (fra *fakeRPCAgent) TestRestore(ctx context.Context, backupName string, logger logutil.Logger) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "TestRestore backupName", backupName, testTestRestoreBackupName)
+	logStuff(logger, 10)
+	testTestRestoreCalled = true
+	return nil
+}
+
+func agentRPCTestTestRestore(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.TestRestore(ctx, tablet, testTestRestoreBackupName)
+	if err != nil {
+		t.Fatalf("TestRestore failed: %v", err)
+	}
+	err = compareLoggedStuff(t, "TestRestore", stream, 10)
+	compareError(t, "TestRestore", err, true, testTestRestoreCalled)
+}
+
+func agentRPCTestTestRestorePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stream, err := client.TestRestore(ctx, tablet, testTestRestoreBackupName)
+	if err != nil {
+		t.Fatalf("TestRestore failed: %v", err)
+	}
+	e, err := stream.Recv()
+	if err == nil {
+		t.Fatalf("Unexpected TestRestore logs: %v", e)
+	}
+	expectHandleRPCPanic(t, "TestRestore", true /*verbose*/, err)
+}
+
+var testLastBackupInfo = &tabletmanagerdatapb.BackupInfo{
+	Name:        testPreferredBackupName,
+	SizeBytes:   123456789,
+	StartTimeNs: 1000000000,
+	EndTimeNs:   61000000000,
+	DurationNs:  60000000000,
+	Success:     true,
+}
+
+func (fra *fakeRPCAgent) GetLastBackupInfo(ctx context.Context) (*tabletmanagerdatapb.BackupInfo, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testLastBackupInfo, nil
+}
+
+func agentRPCTestGetLastBackupInfo(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	info, err := client.GetLastBackupInfo(ctx, tablet)
+	compareError(t, "GetLastBackupInfo", err, info, testLastBackupInfo)
+}
+
+func agentRPCTestGetLastBackupInfoPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetLastBackupInfo(ctx, tablet)
+	expectHandleRPCPanic(t, "GetLastBackupInfo", false /*verbose*/, err)
+}
+
+var testBackupFreshnessName = "2017-03-21.101112.cell1-0000000100"
+var testBackupFreshnessAge = 26 * time.Hour
+
+func (fra *fakeRPCAgent) GetBackupFreshness(ctx context.Context) (string, time.Duration, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testBackupFreshnessName, testBackupFreshnessAge, nil
+}
+
+func agentRPCTestGetBackupFreshness(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	name, age, err := client.GetBackupFreshness(ctx, tablet)
+	if err != nil {
+		t.Fatalf("GetBackupFreshness failed: %v", err)
+	}
+	compare(t, "GetBackupFreshness name", name, testBackupFreshnessName)
+	compare(t, "GetBackupFreshness age", age, testBackupFreshnessAge)
+}
+
+func agentRPCTestGetBackupFreshnessPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, _, err := client.GetBackupFreshness(ctx, tablet)
+	expectHandleRPCPanic(t, "GetBackupFreshness", false /*verbose*/, err)
+}
+
+var testBackupReadinessReport = &tabletmanagerdatapb.ReadinessReport{
+	Reasons: []string{"DDL in progress for 120s on connection 13: alter table t1 add column c int"},
+}
+
+func (fra *fakeRPCAgent) CheckBackupReadiness(ctx context.Context) (*tabletmanagerdatapb.ReadinessReport, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testBackupReadinessReport, nil
+}
+
+func agentRPCTestCheckBackupReadiness(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	report, err := client.CheckBackupReadiness(ctx, tablet)
+	compareError(t, "CheckBackupReadiness", err, report, testBackupReadinessReport)
+}
+
+func agentRPCTestCheckBackupReadinessPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.CheckBackupReadiness(ctx, tablet)
+	expectHandleRPCPanic(t, "CheckBackupReadiness", false /*verbose*/, err)
+}
+
 //
 // RPC helpers
 //
@@ -1191,6 +3799,11 @@ func (fra *fakeRPCAgent) HandleRPCPanic(ctx context.Context, name string, args,
 	}
 }
 
+// TrackRPC is part of the RPCAgent interface
+func (fra *fakeRPCAgent) TrackRPC(name string) func() {
+	return func() {}
+}
+
 // methods to test individual API calls
 
 // Run will run the test suite using the provided client and
@@ -1207,50 +3820,135 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// Various read-only methods
 	agentRPCTestPing(ctx, t, client, tablet)
 	agentRPCTestGetSchema(ctx, t, client, tablet)
+	agentRPCTestGetSchemaBestEffort(ctx, t, client, tablet)
+	agentRPCTestGetCreateStatements(ctx, t, client, tablet)
+	agentRPCTestGetSchemaTimestamps(ctx, t, client, tablet)
 	agentRPCTestGetPermissions(ctx, t, client, tablet)
+	agentRPCTestGetConnectionStats(ctx, t, client, tablet)
+	agentRPCTestGetConnectionRejectionStats(ctx, t, client, tablet)
+	agentRPCTestGetConfig(ctx, t, client, tablet)
+	agentRPCTestGetInFlightRPCs(ctx, t, client, tablet)
+	agentRPCTestGetProcessStats(ctx, t, client, tablet)
+	agentRPCTestGetHealthScore(ctx, t, client, tablet)
+	agentRPCTestGetErrorLogTail(ctx, t, client, tablet)
+	agentRPCTestCaptureDiagnostics(ctx, t, client, tablet)
+	agentRPCTestCheckDataDir(ctx, t, client, tablet)
+	agentRPCTestGetThrottleState(ctx, t, client, tablet)
+	agentRPCTestGetTabletTags(ctx, t, client, tablet)
 
 	// Various read-write methods
 	agentRPCTestSetReadOnly(ctx, t, client, tablet)
+	agentRPCTestSetReadOnlyWithTTL(ctx, t, client, tablet)
+	agentRPCTestSetSuperReadOnly(ctx, t, client, tablet)
+	agentRPCTestSetQueryServerConfig(ctx, t, client, tablet)
+	agentRPCTestGetQueryBlacklist(ctx, t, client, tablet)
+	agentRPCTestSetQueryBlacklist(ctx, t, client, tablet)
 	agentRPCTestChangeType(ctx, t, client, tablet)
+	agentRPCTestSetTabletTags(ctx, t, client, tablet)
 	agentRPCTestSleep(ctx, t, client, tablet)
 	agentRPCTestExecuteHook(ctx, t, client, tablet)
+	agentRPCTestExecuteHookToStream(ctx, t, client, tablet)
 	agentRPCTestRefreshState(ctx, t, client, tablet)
+	agentRPCTestRepublishTopoRecord(ctx, t, client, tablet)
 	agentRPCTestRunHealthCheck(ctx, t, client, tablet)
 	agentRPCTestIgnoreHealthError(ctx, t, client, tablet)
+	agentRPCTestSetMaintenanceMode(ctx, t, client, tablet)
+	agentRPCTestPauseHealthReporting(ctx, t, client, tablet)
+	agentRPCTestSetThrottle(ctx, t, client, tablet)
+	agentRPCTestPrepareCutover(ctx, t, client, tablet)
+	agentRPCTestCommitCutover(ctx, t, client, tablet)
+	agentRPCTestAbortCutover(ctx, t, client, tablet)
+	agentRPCTestSetServingKeyRange(ctx, t, client, tablet)
+	agentRPCTestRestartMysql(ctx, t, client, tablet)
+	agentRPCTestDecommission(ctx, t, client, tablet)
+	agentRPCTestCheckTopoConnectivity(ctx, t, client, tablet)
+	agentRPCTestWarmUp(ctx, t, client, tablet)
 	agentRPCTestReloadSchema(ctx, t, client, tablet)
 	agentRPCTestPreflightSchema(ctx, t, client, tablet)
 	agentRPCTestApplySchema(ctx, t, client, tablet)
+	agentRPCTestSchemaDiff(ctx, t, client, tablet)
+	agentRPCTestAssessSchemaChange(ctx, t, client, tablet)
+	agentRPCTestWatchSchema(ctx, t, client, tablet)
+	agentRPCTestGetVSchema(ctx, t, client, tablet)
+	agentRPCTestApplyVSchema(ctx, t, client, tablet)
 	agentRPCTestExecuteFetch(ctx, t, client, tablet)
+	agentRPCTestExplainQuery(ctx, t, client, tablet)
+	agentRPCTestApplyGrants(ctx, t, client, tablet)
+	agentRPCTestChecksumTable(ctx, t, client, tablet)
+	agentRPCTestTruncateTable(ctx, t, client, tablet)
+	agentRPCTestRenameTable(ctx, t, client, tablet)
+	agentRPCTestStreamRowsInKeyRange(ctx, t, client, tablet)
+	agentRPCTestStreamKeyRangeBinlog(ctx, t, client, tablet)
+	agentRPCTestCloneStream(ctx, t, client, tablet)
+	agentRPCTestGetCharsetConfig(ctx, t, client, tablet)
+	agentRPCTestGetProcessList(ctx, t, client, tablet)
+	agentRPCTestKillProcess(ctx, t, client, tablet)
+	agentRPCTestTailGeneralLog(ctx, t, client, tablet)
+	agentRPCTestStreamQPS(ctx, t, client, tablet)
 
 	// Replication related methods
 	agentRPCTestSlaveStatus(ctx, t, client, tablet)
+	agentRPCTestSlaveStatusAllChannels(ctx, t, client, tablet)
+	agentRPCTestGetReplicationSource(ctx, t, client, tablet)
+	agentRPCTestValidateReplicationCredentials(ctx, t, client, tablet)
+	agentRPCTestGetApplierQueueStats(ctx, t, client, tablet)
 	agentRPCTestMasterPosition(ctx, t, client, tablet)
+	agentRPCTestGetGtidPurged(ctx, t, client, tablet)
+	agentRPCTestGetBinlogStats(ctx, t, client, tablet)
+	agentRPCTestGetReplicationErrorStats(ctx, t, client, tablet)
 	agentRPCTestStopSlave(ctx, t, client, tablet)
 	agentRPCTestStopSlaveMinimum(ctx, t, client, tablet)
+	agentRPCTestStopSlaveMinimumStream(ctx, t, client, tablet)
+	agentRPCTestBoostReplicationCatchup(ctx, t, client, tablet)
 	agentRPCTestStartSlave(ctx, t, client, tablet)
+	agentRPCTestEnableParallelReplication(ctx, t, client, tablet)
+	agentRPCTestRotateReplicationCredentials(ctx, t, client, tablet)
+	agentRPCTestConfigureReplicationSSL(ctx, t, client, tablet)
+	agentRPCTestRepairRelayLog(ctx, t, client, tablet)
 	agentRPCTestTabletExternallyReparented(ctx, t, client, tablet)
 	agentRPCTestGetSlaves(ctx, t, client, tablet)
+	agentRPCTestGetReplicationGraph(ctx, t, client, tablet)
 	agentRPCTestWaitBlpPosition(ctx, t, client, tablet)
 	agentRPCTestStopBlp(ctx, t, client, tablet)
 	agentRPCTestStartBlp(ctx, t, client, tablet)
 	agentRPCTestRunBlpUntil(ctx, t, client, tablet)
+	agentRPCTestGetBinlogFilters(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	agentRPCTestResetReplication(ctx, t, client, tablet)
 	agentRPCTestInitMaster(ctx, t, client, tablet)
 	agentRPCTestPopulateReparentJournal(ctx, t, client, tablet)
+	agentRPCTestGetLastReparentJournalEntry(ctx, t, client, tablet)
 	agentRPCTestInitSlave(ctx, t, client, tablet)
 	agentRPCTestDemoteMaster(ctx, t, client, tablet)
 	agentRPCTestPromoteSlaveWhenCaughtUp(ctx, t, client, tablet)
 	agentRPCTestSlaveWasPromoted(ctx, t, client, tablet)
 	agentRPCTestSetMaster(ctx, t, client, tablet)
+	agentRPCTestConfigureReplication(ctx, t, client, tablet)
 	agentRPCTestSlaveWasRestarted(ctx, t, client, tablet)
 	agentRPCTestStopReplicationAndGetStatus(ctx, t, client, tablet)
 	agentRPCTestPromoteSlave(ctx, t, client, tablet)
+	agentRPCTestSetReparentEligibility(ctx, t, client, tablet)
+	agentRPCTestCheckReparentCandidate(ctx, t, client, tablet)
+	agentRPCTestSetSemiSyncAckCount(ctx, t, client, tablet)
+	agentRPCTestGetDurabilityPolicy(ctx, t, client, tablet)
 
 	// Backup / restore related methods
 	agentRPCTestBackup(ctx, t, client, tablet)
+	agentRPCTestGetBackupLimits(ctx, t, client, tablet)
+	agentRPCTestGetBackupPosition(ctx, t, client, tablet)
+	agentRPCTestBackupConcurrency(ctx, t, client, tablet)
+	agentRPCTestIncrementalBackup(ctx, t, client, tablet)
 	agentRPCTestRestoreFromBackup(ctx, t, client, tablet)
+	agentRPCTestRestoreFromBackupAbort(ctx, t, client, tablet)
+	agentRPCTestRestoreToTimestamp(ctx, t, client, tablet)
+	agentRPCTestSetPreferredBackup(ctx, t, client, tablet)
+	agentRPCTestGetPreferredBackup(ctx, t, client, tablet)
+	agentRPCTestCheckRestoreCompatibility(ctx, t, client, tablet)
+	agentRPCTestTestRestore(ctx, t, client, tablet)
+	agentRPCTestGetLastBackupInfo(ctx, t, client, tablet)
+	agentRPCTestGetBackupFreshness(ctx, t, client, tablet)
+	agentRPCTestCheckBackupReadiness(ctx, t, client, tablet)
 
 	//
 	// Tests panic handling everywhere now
@@ -1260,50 +3958,133 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// Various read-only methods
 	agentRPCTestPingPanic(ctx, t, client, tablet)
 	agentRPCTestGetSchemaPanic(ctx, t, client, tablet)
+	agentRPCTestGetSchemaBestEffortPanic(ctx, t, client, tablet)
+	agentRPCTestGetCreateStatementsPanic(ctx, t, client, tablet)
+	agentRPCTestGetSchemaTimestampsPanic(ctx, t, client, tablet)
 	agentRPCTestGetPermissionsPanic(ctx, t, client, tablet)
+	agentRPCTestGetConnectionStatsPanic(ctx, t, client, tablet)
+	agentRPCTestGetConnectionRejectionStatsPanic(ctx, t, client, tablet)
+	agentRPCTestGetConfigPanic(ctx, t, client, tablet)
+	agentRPCTestGetInFlightRPCsPanic(ctx, t, client, tablet)
+	agentRPCTestGetProcessStatsPanic(ctx, t, client, tablet)
+	agentRPCTestGetHealthScorePanic(ctx, t, client, tablet)
+	agentRPCTestGetErrorLogTailPanic(ctx, t, client, tablet)
+	agentRPCTestCaptureDiagnosticsPanic(ctx, t, client, tablet)
+	agentRPCTestCheckDataDirPanic(ctx, t, client, tablet)
+	agentRPCTestGetThrottleStatePanic(ctx, t, client, tablet)
+	agentRPCTestGetTabletTagsPanic(ctx, t, client, tablet)
 
 	// Various read-write methods
 	agentRPCTestSetReadOnlyPanic(ctx, t, client, tablet)
+	agentRPCTestSetReadOnlyWithTTLPanic(ctx, t, client, tablet)
+	agentRPCTestSetSuperReadOnlyPanic(ctx, t, client, tablet)
+	agentRPCTestSetQueryServerConfigPanic(ctx, t, client, tablet)
+	agentRPCTestGetQueryBlacklistPanic(ctx, t, client, tablet)
+	agentRPCTestSetQueryBlacklistPanic(ctx, t, client, tablet)
 	agentRPCTestChangeTypePanic(ctx, t, client, tablet)
+	agentRPCTestSetTabletTagsPanic(ctx, t, client, tablet)
 	agentRPCTestSleepPanic(ctx, t, client, tablet)
 	agentRPCTestExecuteHookPanic(ctx, t, client, tablet)
+	agentRPCTestExecuteHookToStreamPanic(ctx, t, client, tablet)
 	agentRPCTestRefreshStatePanic(ctx, t, client, tablet)
+	agentRPCTestRepublishTopoRecordPanic(ctx, t, client, tablet)
 	agentRPCTestRunHealthCheckPanic(ctx, t, client, tablet)
 	agentRPCTestIgnoreHealthErrorPanic(ctx, t, client, tablet)
+	agentRPCTestSetMaintenanceModePanic(ctx, t, client, tablet)
+	agentRPCTestPauseHealthReportingPanic(ctx, t, client, tablet)
+	agentRPCTestSetThrottlePanic(ctx, t, client, tablet)
+	agentRPCTestPrepareCutoverPanic(ctx, t, client, tablet)
+	agentRPCTestCommitCutoverPanic(ctx, t, client, tablet)
+	agentRPCTestAbortCutoverPanic(ctx, t, client, tablet)
+	agentRPCTestSetServingKeyRangePanic(ctx, t, client, tablet)
+	agentRPCTestRestartMysqlPanic(ctx, t, client, tablet)
+	agentRPCTestDecommissionPanic(ctx, t, client, tablet)
+	agentRPCTestCheckTopoConnectivityPanic(ctx, t, client, tablet)
+	agentRPCTestWarmUpPanic(ctx, t, client, tablet)
 	agentRPCTestReloadSchemaPanic(ctx, t, client, tablet)
 	agentRPCTestPreflightSchemaPanic(ctx, t, client, tablet)
 	agentRPCTestApplySchemaPanic(ctx, t, client, tablet)
+	agentRPCTestSchemaDiffPanic(ctx, t, client, tablet)
+	agentRPCTestAssessSchemaChangePanic(ctx, t, client, tablet)
+	agentRPCTestWatchSchemaPanic(ctx, t, client, tablet)
+	agentRPCTestGetVSchemaPanic(ctx, t, client, tablet)
+	agentRPCTestApplyVSchemaPanic(ctx, t, client, tablet)
 	agentRPCTestExecuteFetchPanic(ctx, t, client, tablet)
+	agentRPCTestExplainQueryPanic(ctx, t, client, tablet)
+	agentRPCTestApplyGrantsPanic(ctx, t, client, tablet)
+	agentRPCTestChecksumTablePanic(ctx, t, client, tablet)
+	agentRPCTestTruncateTablePanic(ctx, t, client, tablet)
+	agentRPCTestRenameTablePanic(ctx, t, client, tablet)
+	agentRPCTestStreamRowsInKeyRangePanic(ctx, t, client, tablet)
+	agentRPCTestStreamKeyRangeBinlogPanic(ctx, t, client, tablet)
+	agentRPCTestCloneStreamPanic(ctx, t, client, tablet)
+	agentRPCTestGetCharsetConfigPanic(ctx, t, client, tablet)
+	agentRPCTestGetProcessListPanic(ctx, t, client, tablet)
+	agentRPCTestKillProcessPanic(ctx, t, client, tablet)
+	agentRPCTestTailGeneralLogPanic(ctx, t, client, tablet)
+	agentRPCTestStreamQPSPanic(ctx, t, client, tablet)
 
 	// Replication related methods
 	agentRPCTestSlaveStatusPanic(ctx, t, client, tablet)
+	agentRPCTestSlaveStatusAllChannelsPanic(ctx, t, client, tablet)
+	agentRPCTestGetReplicationSourcePanic(ctx, t, client, tablet)
+	agentRPCTestValidateReplicationCredentialsPanic(ctx, t, client, tablet)
+	agentRPCTestGetApplierQueueStatsPanic(ctx, t, client, tablet)
 	agentRPCTestMasterPositionPanic(ctx, t, client, tablet)
+	agentRPCTestGetGtidPurgedPanic(ctx, t, client, tablet)
+	agentRPCTestGetBinlogStatsPanic(ctx, t, client, tablet)
+	agentRPCTestGetReplicationErrorStatsPanic(ctx, t, client, tablet)
 	agentRPCTestStopSlavePanic(ctx, t, client, tablet)
 	agentRPCTestStopSlaveMinimumPanic(ctx, t, client, tablet)
+	agentRPCTestStopSlaveMinimumStreamPanic(ctx, t, client, tablet)
+	agentRPCTestBoostReplicationCatchupPanic(ctx, t, client, tablet)
 	agentRPCTestStartSlavePanic(ctx, t, client, tablet)
+	agentRPCTestEnableParallelReplicationPanic(ctx, t, client, tablet)
+	agentRPCTestRotateReplicationCredentialsPanic(ctx, t, client, tablet)
+	agentRPCTestConfigureReplicationSSLPanic(ctx, t, client, tablet)
+	agentRPCTestRepairRelayLogPanic(ctx, t, client, tablet)
 	agentRPCTestTabletExternallyReparentedPanic(ctx, t, client, tablet)
 	agentRPCTestGetSlavesPanic(ctx, t, client, tablet)
+	agentRPCTestGetReplicationGraphPanic(ctx, t, client, tablet)
 	agentRPCTestWaitBlpPositionPanic(ctx, t, client, tablet)
 	agentRPCTestStopBlpPanic(ctx, t, client, tablet)
 	agentRPCTestStartBlpPanic(ctx, t, client, tablet)
 	agentRPCTestRunBlpUntilPanic(ctx, t, client, tablet)
+	agentRPCTestGetBinlogFiltersPanic(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	agentRPCTestResetReplicationPanic(ctx, t, client, tablet)
 	agentRPCTestInitMasterPanic(ctx, t, client, tablet)
 	agentRPCTestPopulateReparentJournalPanic(ctx, t, client, tablet)
+	agentRPCTestGetLastReparentJournalEntryPanic(ctx, t, client, tablet)
 	agentRPCTestInitSlavePanic(ctx, t, client, tablet)
 	agentRPCTestDemoteMasterPanic(ctx, t, client, tablet)
 	agentRPCTestPromoteSlaveWhenCaughtUpPanic(ctx, t, client, tablet)
 	agentRPCTestSlaveWasPromotedPanic(ctx, t, client, tablet)
 	agentRPCTestSetMasterPanic(ctx, t, client, tablet)
+	agentRPCTestConfigureReplicationPanic(ctx, t, client, tablet)
 	agentRPCTestSlaveWasRestartedPanic(ctx, t, client, tablet)
 	agentRPCTestStopReplicationAndGetStatusPanic(ctx, t, client, tablet)
 	agentRPCTestPromoteSlavePanic(ctx, t, client, tablet)
+	agentRPCTestSetReparentEligibilityPanic(ctx, t, client, tablet)
+	agentRPCTestCheckReparentCandidatePanic(ctx, t, client, tablet)
+	agentRPCTestSetSemiSyncAckCountPanic(ctx, t, client, tablet)
+	agentRPCTestGetDurabilityPolicyPanic(ctx, t, client, tablet)
 
 	// Backup / restore related methods
 	agentRPCTestBackupPanic(ctx, t, client, tablet)
+	agentRPCTestGetBackupLimitsPanic(ctx, t, client, tablet)
+	agentRPCTestGetBackupPositionPanic(ctx, t, client, tablet)
+	agentRPCTestIncrementalBackupPanic(ctx, t, client, tablet)
 	agentRPCTestRestoreFromBackupPanic(ctx, t, client, tablet)
+	agentRPCTestRestoreToTimestampPanic(ctx, t, client, tablet)
+	agentRPCTestSetPreferredBackupPanic(ctx, t, client, tablet)
+	agentRPCTestGetPreferredBackupPanic(ctx, t, client, tablet)
+	agentRPCTestCheckRestoreCompatibilityPanic(ctx, t, client, tablet)
+	agentRPCTestTestRestorePanic(ctx, t, client, tablet)
+	agentRPCTestGetLastBackupInfoPanic(ctx, t, client, tablet)
+	agentRPCTestGetBackupFreshnessPanic(ctx, t, client, tablet)
+	agentRPCTestCheckBackupReadinessPanic(ctx, t, client, tablet)
 
 	client.Close()
 }
@@ -363,6 +363,26 @@ func (bpc *BinlogPlayerController) BlpPosition(vtClient binlogplayer.VtClient) (
 	}, flags, nil
 }
 
+// BinlogFilter returns the filter the player applies to the binlogs
+// of its source shard.
+func (bpc *BinlogPlayerController) BinlogFilter() (*tabletmanagerdatapb.BinlogFilter, error) {
+	filter := &tabletmanagerdatapb.BinlogFilter{
+		Uid:      bpc.sourceShard.Uid,
+		Keyspace: bpc.sourceShard.Keyspace,
+		Shard:    bpc.sourceShard.Shard,
+	}
+	if len(bpc.sourceShard.Tables) > 0 {
+		filter.Tables = bpc.sourceShard.Tables
+		return filter, nil
+	}
+	overlap, err := key.KeyRangesOverlap(bpc.sourceShard.KeyRange, bpc.keyRange)
+	if err != nil {
+		return nil, fmt.Errorf("Source shard %v doesn't overlap destination shard %v", bpc.sourceShard.KeyRange, bpc.keyRange)
+	}
+	filter.KeyRange = overlap
+	return filter, nil
+}
+
 // BinlogPlayerMap controls all the players.
 // It can be stopped and restarted.
 type BinlogPlayerMap struct {
@@ -594,6 +614,29 @@ func (blm *BinlogPlayerMap) BlpPositionList() ([]*tabletmanagerdatapb.BlpPositio
 	return result, nil
 }
 
+// BinlogFilters returns the filters of all the players, sorted by uid.
+func (blm *BinlogPlayerMap) BinlogFilters() ([]*tabletmanagerdatapb.BinlogFilter, error) {
+	blm.mu.Lock()
+	defer blm.mu.Unlock()
+
+	uids := make([]int, 0, len(blm.players))
+	for uid := range blm.players {
+		uids = append(uids, int(uid))
+	}
+	sort.Ints(uids)
+
+	result := make([]*tabletmanagerdatapb.BinlogFilter, 0, len(uids))
+	for _, uid := range uids {
+		bpc := blm.players[uint32(uid)]
+		filter, err := bpc.BinlogFilter()
+		if err != nil {
+			return nil, fmt.Errorf("can't get filter for %v: %v", bpc, err)
+		}
+		result = append(result, filter)
+	}
+	return result, nil
+}
+
 // RunUntil will run all the players until they reach the given position.
 // Holds the map lock during that exercise, shouldn't take long at all.
 func (blm *BinlogPlayerMap) RunUntil(ctx context.Context, blpPositionList []*tabletmanagerdatapb.BlpPosition, waitTimeout time.Duration) error {
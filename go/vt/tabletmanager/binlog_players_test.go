@@ -8,6 +8,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -921,3 +922,72 @@ func TestBinlogPlayerMapVerticalSplit(t *testing.T) {
 		t.Errorf("unexpected state: %v", s)
 	}
 }
+
+func TestGetBinlogFilters(t *testing.T) {
+	destKeyRange := &topodatapb.KeyRange{
+		Start: []byte{0x40},
+		End:   []byte{0x60},
+	}
+	bpm := NewBinlogPlayerMap(nil, nil, nil)
+	// A horizontal split from -80, and a vertical split of t1 and
+	// the t2 tables. The players are only configured, not started.
+	bpm.players[2] = &BinlogPlayerController{
+		keyRange: destKeyRange,
+		sourceShard: &topodatapb.Shard_SourceShard{
+			Uid:      2,
+			Keyspace: "source_ks",
+			Shard:    "0",
+			Tables:   []string{"t1", "/t2.*/"},
+		},
+	}
+	bpm.players[1] = &BinlogPlayerController{
+		keyRange: destKeyRange,
+		sourceShard: &topodatapb.Shard_SourceShard{
+			Uid:      1,
+			Keyspace: "ks",
+			Shard:    "-80",
+			KeyRange: &topodatapb.KeyRange{
+				End: []byte{0x80},
+			},
+		},
+	}
+	agent := &ActionAgent{
+		BinlogPlayerMap: bpm,
+	}
+
+	filters, err := agent.GetBinlogFilters(context.Background())
+	if err != nil {
+		t.Fatalf("GetBinlogFilters failed: %v", err)
+	}
+	want := []*tabletmanagerdatapb.BinlogFilter{
+		{
+			Uid:      1,
+			Keyspace: "ks",
+			Shard:    "-80",
+			KeyRange: destKeyRange,
+		},
+		{
+			Uid:      2,
+			Keyspace: "source_ks",
+			Shard:    "0",
+			Tables:   []string{"t1", "/t2.*/"},
+		},
+	}
+	if !reflect.DeepEqual(filters, want) {
+		t.Errorf("GetBinlogFilters() = %v, want %v", filters, want)
+	}
+
+	// A source shard that doesn't overlap the destination is an error.
+	bpm.players[1].sourceShard.KeyRange = &topodatapb.KeyRange{
+		Start: []byte{0x80},
+	}
+	if _, err := agent.GetBinlogFilters(context.Background()); err == nil || !strings.Contains(err.Error(), "doesn't overlap") {
+		t.Errorf("GetBinlogFilters() with a non-overlapping source = %v, want a doesn't overlap error", err)
+	}
+
+	// Without binlog players, it fails.
+	agent.BinlogPlayerMap = nil
+	if _, err := agent.GetBinlogFilters(context.Background()); err == nil {
+		t.Errorf("GetBinlogFilters() without a BinlogPlayerMap worked")
+	}
+}
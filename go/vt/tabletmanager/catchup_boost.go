@@ -0,0 +1,224 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+var (
+	catchupBoostParallelWorkers = flag.Int64("catchup_boost_slave_parallel_workers", 16, "slave_parallel_workers set by BoostReplicationCatchup, if the current value is lower")
+	catchupBoostIOCapacity      = flag.Int64("catchup_boost_innodb_io_capacity", 4000, "innodb_io_capacity set by BoostReplicationCatchup, if the current value is lower")
+)
+
+// catchupBoostInterval is how often BoostReplicationCatchup sends the
+// replication lag.
+var catchupBoostInterval = 10 * time.Second
+
+const (
+	catchupBoostParallelWorkersVar = "slave_parallel_workers"
+	catchupBoostIOCapacityVar      = "innodb_io_capacity"
+	catchupBoostSettingsQuery      = "SELECT @@GLOBAL.slave_parallel_workers, @@GLOBAL.innodb_io_capacity"
+)
+
+// BoostReplicationCatchup raises the MySQL settings that limit how fast
+// a slave applies its relay logs, for duration, then restores them.
+// Meanwhile, it sends the replication lag every catchupBoostInterval.
+// The first message has the boosted settings, the last one the
+// restored settings, with Reverted set. The settings are restored when
+// it returns, even if the client went away.
+//
+// slave_parallel_workers is only used when the SQL thread starts, so
+// a running SQL thread is restarted to change it. Only the SQL thread
+// is restarted: the slave keeps fetching binlogs from its master.
+// The SQL thread state and the settings are read again under the
+// action lock before restoring them, so a SQL thread stopped meanwhile
+// is not started, and settings changed meanwhile are kept.
+func (agent *ActionAgent) BoostReplicationCatchup(ctx context.Context, duration time.Duration, send func(*tabletmanagerdatapb.BoostReplicationCatchupResponse) error) error {
+	if duration <= 0 {
+		return fmt.Errorf("invalid duration %v: must be positive", duration)
+	}
+	agent.mutex.Lock()
+	if agent._boostingCatchup {
+		agent.mutex.Unlock()
+		return fmt.Errorf("replication catch-up is already boosted")
+	}
+	agent._boostingCatchup = true
+	agent.mutex.Unlock()
+	defer func() {
+		agent.mutex.Lock()
+		agent._boostingCatchup = false
+		agent.mutex.Unlock()
+	}()
+
+	original, boosted, err := agent.applyCatchupSettings(ctx, func(current map[string]int64) map[string]int64 {
+		boosted := map[string]int64{
+			catchupBoostParallelWorkersVar: current[catchupBoostParallelWorkersVar],
+			catchupBoostIOCapacityVar:      current[catchupBoostIOCapacityVar],
+		}
+		if boosted[catchupBoostParallelWorkersVar] < *catchupBoostParallelWorkers {
+			boosted[catchupBoostParallelWorkersVar] = *catchupBoostParallelWorkers
+		}
+		if boosted[catchupBoostIOCapacityVar] < *catchupBoostIOCapacity {
+			boosted[catchupBoostIOCapacityVar] = *catchupBoostIOCapacity
+		}
+		return boosted
+	})
+	if err != nil {
+		return err
+	}
+	// restore puts back the original value of the settings that are
+	// still boosted. Settings changed by someone else meanwhile are
+	// left alone.
+	restore := func(current map[string]int64) map[string]int64 {
+		restored := make(map[string]int64)
+		for name, value := range current {
+			if value == boosted[name] {
+				restored[name] = original[name]
+			} else {
+				restored[name] = value
+			}
+		}
+		return restored
+	}
+
+	// Restore the settings in any case, with a context the client
+	// cannot cancel.
+	reverted := false
+	defer func() {
+		if reverted {
+			return
+		}
+		if _, _, err := agent.applyCatchupSettings(context.Background(), restore); err != nil {
+			log.Errorf("cannot restore the replication settings %v after BoostReplicationCatchup: %v", original, err)
+		}
+	}()
+
+	if err := agent.sendCatchupProgress(send, boosted, false); err != nil {
+		return err
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	ticker := time.NewTicker(catchupBoostInterval)
+	defer ticker.Stop()
+wait:
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			break wait
+		case <-ticker.C:
+			if err := agent.sendCatchupProgress(send, nil, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, restored, err := agent.applyCatchupSettings(ctx, restore)
+	if err != nil {
+		return err
+	}
+	reverted = true
+	return agent.sendCatchupProgress(send, restored, true)
+}
+
+// catchupSettings returns the current values of the settings
+// BoostReplicationCatchup changes.
+func (agent *ActionAgent) catchupSettings(ctx context.Context) (map[string]int64, error) {
+	qr, err := agent.MysqlDaemon.FetchSuperQuery(ctx, catchupBoostSettingsQuery)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		return nil, fmt.Errorf("unexpected result for %v: %v", catchupBoostSettingsQuery, qr.Rows)
+	}
+	settings := make(map[string]int64)
+	for i, name := range []string{catchupBoostParallelWorkersVar, catchupBoostIOCapacityVar} {
+		value, err := qr.Rows[0][i].ParseInt64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %v: %v", name, err)
+		}
+		settings[name] = value
+	}
+	return settings, nil
+}
+
+// applyCatchupSettings reads the current settings, and changes the
+// ones that are different in target(current), under the action lock.
+// If slave_parallel_workers changes while the SQL thread runs, the SQL
+// thread is restarted so the change takes effect. It returns the
+// settings before and after the change.
+func (agent *ActionAgent) applyCatchupSettings(ctx context.Context, target func(current map[string]int64) map[string]int64) (map[string]int64, map[string]int64, error) {
+	if err := agent.lock(ctx); err != nil {
+		return nil, nil, err
+	}
+	defer agent.unlock()
+
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	if err != nil {
+		return nil, nil, err
+	}
+	current, err := agent.catchupSettings(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	to := target(current)
+
+	var names []string
+	for name, value := range to {
+		if current[name] != value {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var queries []string
+	restartSQLThread := false
+	for _, name := range names {
+		set := fmt.Sprintf("SET GLOBAL %v = %v", name, to[name])
+		if name == catchupBoostParallelWorkersVar && status.SlaveSQLRunning {
+			restartSQLThread = true
+			queries = append(queries, "STOP SLAVE SQL_THREAD", set, "START SLAVE SQL_THREAD")
+		} else {
+			queries = append(queries, set)
+		}
+	}
+	if len(queries) == 0 {
+		return current, to, nil
+	}
+	if err := agent.MysqlDaemon.ExecuteSuperQueryList(ctx, queries); err != nil {
+		// The SQL thread was running, it must still be running
+		// even if changing the settings failed midway.
+		if restartSQLThread {
+			if startErr := agent.MysqlDaemon.ExecuteSuperQueryList(context.Background(), []string{"START SLAVE SQL_THREAD"}); startErr != nil {
+				log.Errorf("cannot restart the SQL thread after failing to change the replication settings: %v", startErr)
+			}
+		}
+		return nil, nil, err
+	}
+	return current, to, nil
+}
+
+// sendCatchupProgress sends the current replication lag, and the
+// settings if set.
+func (agent *ActionAgent) sendCatchupProgress(send func(*tabletmanagerdatapb.BoostReplicationCatchupResponse) error, settings map[string]int64, reverted bool) error {
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	if err != nil {
+		return err
+	}
+	return send(&tabletmanagerdatapb.BoostReplicationCatchupResponse{
+		SecondsBehindMaster: int64(status.SecondsBehindMaster),
+		Settings:            settings,
+		Reverted:            reverted,
+	})
+}
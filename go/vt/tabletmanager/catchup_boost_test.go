@@ -0,0 +1,136 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// setCatchupSettings makes the fake mysqld return the given
+// slave_parallel_workers and innodb_io_capacity.
+func setCatchupSettings(mysqlDaemon *mysqlctl.FakeMysqlDaemon, parallelWorkers, ioCapacity string) {
+	mysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		catchupBoostSettingsQuery: {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte(parallelWorkers)), sqltypes.MakeString([]byte(ioCapacity))},
+			},
+		},
+	}
+}
+
+func TestBoostReplicationCatchup(t *testing.T) {
+	defer func(interval time.Duration) { catchupBoostInterval = interval }(catchupBoostInterval)
+	catchupBoostInterval = 10 * time.Millisecond
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Replicating = true
+	mysqlDaemon.SecondsBehindMaster = 3600
+	setCatchupSettings(mysqlDaemon, "4", "200")
+	// slave_parallel_workers needs a restart of the SQL thread, both
+	// to boost it and to restore it.
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"SET GLOBAL innodb_io_capacity = 4000",
+		"STOP SLAVE SQL_THREAD",
+		"SET GLOBAL slave_parallel_workers = 16",
+		"START SLAVE SQL_THREAD",
+		"SET GLOBAL innodb_io_capacity = 200",
+		"STOP SLAVE SQL_THREAD",
+		"SET GLOBAL slave_parallel_workers = 4",
+		"START SLAVE SQL_THREAD",
+	}
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+	}
+
+	var responses []*tabletmanagerdatapb.BoostReplicationCatchupResponse
+	err := agent.BoostReplicationCatchup(ctx, 100*time.Millisecond, func(response *tabletmanagerdatapb.BoostReplicationCatchupResponse) error {
+		if !response.Reverted && mysqlDaemon.ExpectedExecuteSuperQueryCurrent != 4 {
+			t.Errorf("progress sent after %v queries, want the settings boosted and not restored", mysqlDaemon.ExpectedExecuteSuperQueryCurrent)
+		}
+		if len(responses) == 0 {
+			setCatchupSettings(mysqlDaemon, "16", "4000")
+		}
+		responses = append(responses, response)
+		mysqlDaemon.SecondsBehindMaster -= 10
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("BoostReplicationCatchup failed: %v", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("BoostReplicationCatchup did not boost and restore the settings: %v", err)
+	}
+
+	// The boosted settings, some progress, and the restored settings.
+	if len(responses) < 3 {
+		t.Fatalf("got %v responses, want at least 3", len(responses))
+	}
+	first, last := responses[0], responses[len(responses)-1]
+	if want := map[string]int64{"slave_parallel_workers": 16, "innodb_io_capacity": 4000}; first.Reverted || !reflect.DeepEqual(first.Settings, want) || first.SecondsBehindMaster != 3600 {
+		t.Errorf("first response = %v, want the boosted settings %v with a lag of 3600", first, want)
+	}
+	if want := map[string]int64{"slave_parallel_workers": 4, "innodb_io_capacity": 200}; !last.Reverted || !reflect.DeepEqual(last.Settings, want) {
+		t.Errorf("last response = %v, want the restored settings %v", last, want)
+	}
+	if last.SecondsBehindMaster >= first.SecondsBehindMaster {
+		t.Errorf("the lag went from %v to %v, want it to go down", first.SecondsBehindMaster, last.SecondsBehindMaster)
+	}
+
+	if err := agent.BoostReplicationCatchup(ctx, 0, nil); err == nil {
+		t.Errorf("BoostReplicationCatchup with no duration worked, want an error")
+	}
+}
+
+// TestBoostReplicationCatchupChangedMeanwhile verifies the restore
+// looks at the SQL thread and the settings again: a SQL thread stopped
+// meanwhile is not started, and a setting changed meanwhile is kept.
+func TestBoostReplicationCatchupChangedMeanwhile(t *testing.T) {
+	defer func(interval time.Duration) { catchupBoostInterval = interval }(catchupBoostInterval)
+	catchupBoostInterval = time.Hour
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Replicating = true
+	setCatchupSettings(mysqlDaemon, "4", "200")
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"SET GLOBAL innodb_io_capacity = 4000",
+		"STOP SLAVE SQL_THREAD",
+		"SET GLOBAL slave_parallel_workers = 16",
+		"START SLAVE SQL_THREAD",
+		"SET GLOBAL slave_parallel_workers = 4",
+	}
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+	}
+
+	var last *tabletmanagerdatapb.BoostReplicationCatchupResponse
+	err := agent.BoostReplicationCatchup(ctx, 10*time.Millisecond, func(response *tabletmanagerdatapb.BoostReplicationCatchupResponse) error {
+		if !response.Reverted {
+			// Replication is stopped, and innodb_io_capacity
+			// changed, while boosted.
+			mysqlDaemon.Replicating = false
+			setCatchupSettings(mysqlDaemon, "16", "1000")
+		}
+		last = response
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("BoostReplicationCatchup failed: %v", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("BoostReplicationCatchup did not restore only slave_parallel_workers: %v", err)
+	}
+	if want := map[string]int64{"slave_parallel_workers": 4, "innodb_io_capacity": 1000}; !last.Reverted || !reflect.DeepEqual(last.Settings, want) {
+		t.Errorf("last response = %v, want the restored settings %v", last, want)
+	}
+}
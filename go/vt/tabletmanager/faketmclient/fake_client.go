@@ -20,11 +20,13 @@ import (
 	"github.com/youtube/vitess/go/vt/mysqlctl/tmutils"
 	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
 
+	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	logutilpb "github.com/youtube/vitess/go/vt/proto/logutil"
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vschemapb "github.com/youtube/vitess/go/vt/proto/vschema"
 )
 
 // NewFakeTabletManagerClient should be used to create a new FakeTabletManagerClient.
@@ -70,16 +72,97 @@ func (client *FakeTabletManagerClient) ExecuteHook(ctx context.Context, tablet *
 	return &hr, nil
 }
 
+type eofHookStream struct{}
+
+func (e *eofHookStream) Recv() (*tabletmanagerdatapb.ExecuteHookToStreamResponse, error) {
+	return nil, io.EOF
+}
+
+// ExecuteHookToStream is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ExecuteHookToStream(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (tmclient.HookStream, error) {
+	return &eofHookStream{}, nil
+}
+
 // GetSchema is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
 	return client.tmc.GetSchema(ctx, tablet, tables, excludeTables, includeViews)
 }
 
+// GetSchemaBestEffort is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetSchemaBestEffort(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool, timeout time.Duration) (*tabletmanagerdatapb.SchemaDefinition, bool, error) {
+	return client.tmc.GetSchemaBestEffort(ctx, tablet, tables, excludeTables, includeViews, timeout)
+}
+
+// GetCreateStatements is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetCreateStatements(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+// GetSchemaTimestamps is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetSchemaTimestamps(ctx context.Context, tablet *topodatapb.Tablet, tables []string) (map[string]time.Time, error) {
+	return map[string]time.Time{}, nil
+}
+
 // GetPermissions is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
 	return &tabletmanagerdatapb.Permissions{}, nil
 }
 
+// GetConnectionStats is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetConnectionStats(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ConnectionStats, error) {
+	return &tabletmanagerdatapb.ConnectionStats{}, nil
+}
+
+// GetConnectionRejectionStats is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetConnectionRejectionStats(ctx context.Context, tablet *topodatapb.Tablet, reset bool) (map[string]int64, error) {
+	return map[string]int64{}, nil
+}
+
+// GetConfig is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetConfig(ctx context.Context, tablet *topodatapb.Tablet) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+// GetInFlightRPCs is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetInFlightRPCs(ctx context.Context, tablet *topodatapb.Tablet) (map[string]int64, error) {
+	return map[string]int64{}, nil
+}
+
+// GetProcessStats is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetProcessStats(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ProcessStats, error) {
+	return &tabletmanagerdatapb.ProcessStats{}, nil
+}
+
+// GetHealthScore is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetHealthScore(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.HealthScore, error) {
+	return &tabletmanagerdatapb.HealthScore{}, nil
+}
+
+// GetErrorLogTail is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetErrorLogTail(ctx context.Context, tablet *topodatapb.Tablet, lines int) ([]string, error) {
+	return nil, nil
+}
+
+// CaptureDiagnostics is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CaptureDiagnostics(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.DiagnosticBundle, error) {
+	return &tabletmanagerdatapb.DiagnosticBundle{}, nil
+}
+
+// CheckDataDir is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CheckDataDir(ctx context.Context, tablet *topodatapb.Tablet) ([]string, error) {
+	return nil, nil
+}
+
+// GetThrottleState is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetThrottleState(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ThrottleState, error) {
+	return &tabletmanagerdatapb.ThrottleState{}, nil
+}
+
+// GetTabletTags is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetTabletTags(ctx context.Context, tablet *topodatapb.Tablet) (map[string]string, error) {
+	return nil, nil
+}
+
 //
 // Various read-write methods
 //
@@ -94,8 +177,38 @@ func (client *FakeTabletManagerClient) SetReadWrite(ctx context.Context, tablet
 	return nil
 }
 
+// SetReadOnlyWithTTL is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetReadOnlyWithTTL(ctx context.Context, tablet *topodatapb.Tablet, ttl time.Duration) error {
+	return nil
+}
+
+// SetSuperReadOnly is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetSuperReadOnly(ctx context.Context, tablet *topodatapb.Tablet, on bool) (bool, error) {
+	return on, nil
+}
+
+// SetQueryServerConfig is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetQueryServerConfig(ctx context.Context, tablet *topodatapb.Tablet, config *tabletmanagerdatapb.QueryServerConfig) (*tabletmanagerdatapb.QueryServerConfig, []string, error) {
+	return config, nil, nil
+}
+
+// GetQueryBlacklist is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetQueryBlacklist(ctx context.Context, tablet *topodatapb.Tablet) ([]string, error) {
+	return nil, nil
+}
+
+// SetQueryBlacklist is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetQueryBlacklist(ctx context.Context, tablet *topodatapb.Tablet, patterns []string) error {
+	return nil
+}
+
 // ChangeType is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType) error {
+func (client *FakeTabletManagerClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType, idempotent bool) error {
+	return nil
+}
+
+// SetTabletTags is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetTabletTags(ctx context.Context, tablet *topodatapb.Tablet, tags map[string]string) error {
 	return nil
 }
 
@@ -104,6 +217,11 @@ func (client *FakeTabletManagerClient) RefreshState(ctx context.Context, tablet
 	return nil
 }
 
+// RepublishTopoRecord is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) RepublishTopoRecord(ctx context.Context, tablet *topodatapb.Tablet) (int64, error) {
+	return 0, nil
+}
+
 // RunHealthCheck is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return nil
@@ -114,6 +232,73 @@ func (client *FakeTabletManagerClient) IgnoreHealthError(ctx context.Context, ta
 	return nil
 }
 
+// SetMaintenanceMode is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetMaintenanceMode(ctx context.Context, tablet *topodatapb.Tablet, on bool, reason string) error {
+	return nil
+}
+
+// PauseHealthReporting is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) PauseHealthReporting(ctx context.Context, tablet *topodatapb.Tablet, on bool) error {
+	return nil
+}
+
+// SetThrottle is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetThrottle(ctx context.Context, tablet *topodatapb.Tablet, mode string, duration time.Duration) error {
+	return nil
+}
+
+// PrepareCutover is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) PrepareCutover(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	return "", nil
+}
+
+// CommitCutover is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CommitCutover(ctx context.Context, tablet *topodatapb.Tablet, token string) error {
+	return nil
+}
+
+// AbortCutover is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) AbortCutover(ctx context.Context, tablet *topodatapb.Tablet, token string) error {
+	return nil
+}
+
+// SetServingKeyRange is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetServingKeyRange(ctx context.Context, tablet *topodatapb.Tablet, keyRange *topodatapb.KeyRange, servedTypes []topodatapb.TabletType) error {
+	return nil
+}
+
+// RestartMysql is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) RestartMysql(ctx context.Context, tablet *topodatapb.Tablet, opts tmclient.RestartMysqlOptions) (logutil.EventStream, error) {
+	return &eofEventStream{}, nil
+}
+
+// Decommission is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) Decommission(ctx context.Context, tablet *topodatapb.Tablet, opts tmclient.DecommissionOptions) (logutil.EventStream, error) {
+	return &eofEventStream{}, nil
+}
+
+// CheckTopoConnectivity is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CheckTopoConnectivity(ctx context.Context, tablet *topodatapb.Tablet) (bool, time.Duration, error) {
+	return true, 0, nil
+}
+
+type doneWarmUpStream struct {
+	sent bool
+}
+
+func (e *doneWarmUpStream) Recv() (*tabletmanagerdatapb.WarmUpResponse, error) {
+	if e.sent {
+		return nil, io.EOF
+	}
+	e.sent = true
+	return &tabletmanagerdatapb.WarmUpResponse{Done: true}, nil
+}
+
+// WarmUp is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) WarmUp(ctx context.Context, tablet *topodatapb.Tablet, queries []string, loadBufferPool bool) (tmclient.WarmUpStream, error) {
+	return &doneWarmUpStream{}, nil
+}
+
 // ReloadSchema is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
 	return nil
@@ -129,21 +314,159 @@ func (client *FakeTabletManagerClient) ApplySchema(ctx context.Context, tablet *
 	return &tabletmanagerdatapb.SchemaChangeResult{}, nil
 }
 
+// SchemaDiff is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SchemaDiff(ctx context.Context, tablet *topodatapb.Tablet, desired *tabletmanagerdatapb.SchemaDefinition) ([]string, error) {
+	return nil, nil
+}
+
+// AssessSchemaChange is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) AssessSchemaChange(ctx context.Context, tablet *topodatapb.Tablet, change string) (*tabletmanagerdatapb.SchemaChangeAssessment, error) {
+	return &tabletmanagerdatapb.SchemaChangeAssessment{}, nil
+}
+
+type eofSchemaChangeStream struct{}
+
+func (e *eofSchemaChangeStream) Recv() (*tabletmanagerdatapb.SchemaChangeEvent, error) {
+	return nil, io.EOF
+}
+
+// WatchSchema is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) WatchSchema(ctx context.Context, tablet *topodatapb.Tablet) (tmclient.SchemaChangeStream, error) {
+	return &eofSchemaChangeStream{}, nil
+}
+
+// GetVSchema is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetVSchema(ctx context.Context, tablet *topodatapb.Tablet) (*vschemapb.Keyspace, error) {
+	return &vschemapb.Keyspace{}, nil
+}
+
+// ApplyVSchema is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ApplyVSchema(ctx context.Context, tablet *topodatapb.Tablet, vschema string) error {
+	return nil
+}
+
 // ExecuteFetchAsDba is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+func (client *FakeTabletManagerClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool, maxExecTime time.Duration) (*querypb.QueryResult, error) {
 	return &querypb.QueryResult{}, nil
 }
 
+type eofCSVStream struct{}
+
+func (e *eofCSVStream) Recv() ([]byte, error) {
+	return nil, io.EOF
+}
+
+// ExecuteFetchAsDbaCSV is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ExecuteFetchAsDbaCSV(ctx context.Context, tablet *topodatapb.Tablet, query []byte) (tmclient.CSVStream, error) {
+	return &eofCSVStream{}, nil
+}
+
 // ExecuteFetchAsAllPrivs is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) ExecuteFetchAsAllPrivs(ctx context.Context, tablet *topodatapb.Tablet, query []byte, maxRows int, reloadSchema bool) (*querypb.QueryResult, error) {
 	return &querypb.QueryResult{}, nil
 }
 
+// ExecuteFetchColumnar is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ExecuteFetchColumnar(ctx context.Context, tablet *topodatapb.Tablet, query []byte, maxRows int) (*tabletmanagerdatapb.ColumnarResult, error) {
+	return &tabletmanagerdatapb.ColumnarResult{}, nil
+}
+
 // ExecuteFetchAsApp is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int) (*querypb.QueryResult, error) {
 	return &querypb.QueryResult{}, nil
 }
 
+// ExplainQuery is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ExplainQuery(ctx context.Context, tablet *topodatapb.Tablet, query, format string) (*querypb.QueryResult, error) {
+	return &querypb.QueryResult{}, nil
+}
+
+// ApplyGrants is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ApplyGrants(ctx context.Context, tablet *topodatapb.Tablet, statements []string, atomic bool) error {
+	return nil
+}
+
+// ChecksumTable is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ChecksumTable(ctx context.Context, tablet *topodatapb.Tablet, table string, keyRange *topodatapb.KeyRange) (uint64, int64, error) {
+	return 0, 0, nil
+}
+
+// TruncateTable is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) TruncateTable(ctx context.Context, tablet *topodatapb.Tablet, table, confirmKeyspace string) error {
+	return nil
+}
+
+type eofRowStream struct{}
+
+func (e *eofRowStream) Recv() (*querypb.QueryResult, error) {
+	return nil, io.EOF
+}
+
+// RenameTable is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) RenameTable(ctx context.Context, tablet *topodatapb.Tablet, from, to string, opts tmclient.RenameTableOptions) (*tabletmanagerdatapb.RenameTableResult, error) {
+	return &tabletmanagerdatapb.RenameTableResult{}, nil
+}
+
+// StreamRowsInKeyRange is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) StreamRowsInKeyRange(ctx context.Context, tablet *topodatapb.Tablet, table string, keyRange *topodatapb.KeyRange) (tmclient.RowStream, error) {
+	return &eofRowStream{}, nil
+}
+
+type eofBinlogTransactionStream struct{}
+
+func (e *eofBinlogTransactionStream) Recv() (*binlogdatapb.BinlogTransaction, error) {
+	return nil, io.EOF
+}
+
+// StreamKeyRangeBinlog is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) StreamKeyRangeBinlog(ctx context.Context, tablet *topodatapb.Tablet, keyRange *topodatapb.KeyRange, startPos string, charset *binlogdatapb.Charset) (tmclient.BinlogTransactionStream, error) {
+	return &eofBinlogTransactionStream{}, nil
+}
+
+type eofCloneStream struct{}
+
+func (e *eofCloneStream) Recv() (*tabletmanagerdatapb.CloneStreamResponse, error) {
+	return nil, io.EOF
+}
+
+// CloneStream is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CloneStream(ctx context.Context, tablet *topodatapb.Tablet, tables []string, opts tmclient.CloneOptions) (tmclient.CloneStream, error) {
+	return &eofCloneStream{}, nil
+}
+
+// GetCharsetConfig is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetCharsetConfig(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.CharsetConfig, error) {
+	return &tabletmanagerdatapb.CharsetConfig{}, nil
+}
+
+// GetProcessList is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetProcessList(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.Process, error) {
+	return nil, nil
+}
+
+// KillProcess is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) KillProcess(ctx context.Context, tablet *topodatapb.Tablet, id int64) error {
+	return nil
+}
+
+type eofGeneralLogStream struct{}
+
+func (e *eofGeneralLogStream) Recv() (string, error) {
+	return "", io.EOF
+}
+
+// TailGeneralLog is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) TailGeneralLog(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) (tmclient.GeneralLogStream, error) {
+	return &eofGeneralLogStream{}, nil
+}
+
+// StreamQPS is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) StreamQPS(ctx context.Context, tablet *topodatapb.Tablet, interval time.Duration) (<-chan *tabletmanagerdatapb.QPSSample, tmclient.ErrFunc, error) {
+	samples := make(chan *tabletmanagerdatapb.QPSSample)
+	close(samples)
+	return samples, func() error { return nil }, nil
+}
+
 //
 // Replication related methods
 //
@@ -153,11 +476,46 @@ func (client *FakeTabletManagerClient) SlaveStatus(ctx context.Context, tablet *
 	return &replicationdatapb.Status{}, nil
 }
 
+// SlaveStatusAllChannels is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SlaveStatusAllChannels(ctx context.Context, tablet *topodatapb.Tablet) (map[string]*replicationdatapb.Status, error) {
+	return map[string]*replicationdatapb.Status{"": {}}, nil
+}
+
+// GetReplicationSource is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetReplicationSource(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ReplicationSource, error) {
+	return &tabletmanagerdatapb.ReplicationSource{}, nil
+}
+
+// ValidateReplicationCredentials is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ValidateReplicationCredentials(ctx context.Context, tablet *topodatapb.Tablet) (bool, error) {
+	return true, nil
+}
+
+// GetApplierQueueStats is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetApplierQueueStats(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ApplierQueueStats, error) {
+	return &tabletmanagerdatapb.ApplierQueueStats{}, nil
+}
+
 // MasterPosition is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
 	return "", nil
 }
 
+// GetGtidPurged is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetGtidPurged(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	return "", nil
+}
+
+// GetBinlogStats is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetBinlogStats(ctx context.Context, tablet *topodatapb.Tablet) (float64, time.Duration, error) {
+	return 0, 0, nil
+}
+
+// GetReplicationErrorStats is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetReplicationErrorStats(ctx context.Context, tablet *topodatapb.Tablet, reset bool) (*tabletmanagerdatapb.ReplicationErrorStats, error) {
+	return &tabletmanagerdatapb.ReplicationErrorStats{}, nil
+}
+
 // StopSlave is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) StopSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return nil
@@ -168,13 +526,55 @@ func (client *FakeTabletManagerClient) StopSlaveMinimum(ctx context.Context, tab
 	return "", nil
 }
 
+type eofStopSlaveMinimumStream struct{}
+
+func (e *eofStopSlaveMinimumStream) Recv() (*tabletmanagerdatapb.StopSlaveMinimumStreamResponse, error) {
+	return nil, io.EOF
+}
+
+// StopSlaveMinimumStream is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) StopSlaveMinimumStream(ctx context.Context, tablet *topodatapb.Tablet, minPos string, waitTime time.Duration) (tmclient.StopSlaveMinimumStream, error) {
+	return &eofStopSlaveMinimumStream{}, nil
+}
+
+type eofBoostReplicationCatchupStream struct{}
+
+func (e *eofBoostReplicationCatchupStream) Recv() (*tabletmanagerdatapb.BoostReplicationCatchupResponse, error) {
+	return nil, io.EOF
+}
+
+// BoostReplicationCatchup is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) BoostReplicationCatchup(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) (tmclient.BoostReplicationCatchupStream, error) {
+	return &eofBoostReplicationCatchupStream{}, nil
+}
+
 // StartSlave is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) StartSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return nil
 }
 
+// EnableParallelReplication is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) EnableParallelReplication(ctx context.Context, tablet *topodatapb.Tablet, workers int, parallelType string) error {
+	return nil
+}
+
+// RotateReplicationCredentials is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) RotateReplicationCredentials(ctx context.Context, tablet *topodatapb.Tablet, user, password string) error {
+	return nil
+}
+
+// ConfigureReplicationSSL is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ConfigureReplicationSSL(ctx context.Context, tablet *topodatapb.Tablet, opts *tabletmanagerdatapb.ReplicationSSLOptions) error {
+	return nil
+}
+
+// RepairRelayLog is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) RepairRelayLog(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+	return &eofEventStream{}, nil
+}
+
 // TabletExternallyReparented is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) TabletExternallyReparented(ctx context.Context, tablet *topodatapb.Tablet, externalID string) error {
+func (client *FakeTabletManagerClient) TabletExternallyReparented(ctx context.Context, tablet *topodatapb.Tablet, externalID string, validate bool) error {
 	return nil
 }
 
@@ -183,6 +583,11 @@ func (client *FakeTabletManagerClient) GetSlaves(ctx context.Context, tablet *to
 	return nil, nil
 }
 
+// GetReplicationGraph is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetReplicationGraph(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ReplicationGraph, error) {
+	return &tabletmanagerdatapb.ReplicationGraph{}, nil
+}
+
 // WaitBlpPosition is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) WaitBlpPosition(ctx context.Context, tablet *topodatapb.Tablet, blpPosition *tabletmanagerdatapb.BlpPosition, waitTime time.Duration) error {
 	return nil
@@ -211,6 +616,11 @@ func (client *FakeTabletManagerClient) RunBlpUntil(ctx context.Context, tablet *
 	return "", nil
 }
 
+// GetBinlogFilters is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetBinlogFilters(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.BinlogFilter, error) {
+	return nil, nil
+}
+
 //
 // Reparenting related functions
 //
@@ -221,15 +631,20 @@ func (client *FakeTabletManagerClient) ResetReplication(ctx context.Context, tab
 }
 
 // InitMaster is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) InitMaster(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+func (client *FakeTabletManagerClient) InitMaster(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string) (string, error) {
 	return "", nil
 }
 
 // PopulateReparentJournal is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) PopulateReparentJournal(ctx context.Context, tablet *topodatapb.Tablet, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, position string) error {
+func (client *FakeTabletManagerClient) PopulateReparentJournal(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, position string) error {
 	return nil
 }
 
+// GetLastReparentJournalEntry is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetLastReparentJournalEntry(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ReparentJournalEntry, error) {
+	return nil, nil
+}
+
 // InitSlave is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) InitSlave(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, position string, timeCreatedNS int64) error {
 	return nil
@@ -251,7 +666,12 @@ func (client *FakeTabletManagerClient) SlaveWasPromoted(ctx context.Context, tab
 }
 
 // SetMaster is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error {
+func (client *FakeTabletManagerClient) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error {
+	return nil
+}
+
+// ConfigureReplication is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ConfigureReplication(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, parent *topodatapb.TabletAlias, opts tmclient.ReplicationOptions) error {
 	return nil
 }
 
@@ -270,6 +690,26 @@ func (client *FakeTabletManagerClient) PromoteSlave(ctx context.Context, tablet
 	return "", nil
 }
 
+// SetReparentEligibility is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetReparentEligibility(ctx context.Context, tablet *topodatapb.Tablet, eligible bool, reason string) error {
+	return nil
+}
+
+// CheckReparentCandidate is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CheckReparentCandidate(ctx context.Context, tablet *topodatapb.Tablet) (bool, string, error) {
+	return true, "", nil
+}
+
+// SetSemiSyncAckCount is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetSemiSyncAckCount(ctx context.Context, tablet *topodatapb.Tablet, count int) error {
+	return nil
+}
+
+// GetDurabilityPolicy is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetDurabilityPolicy(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.DurabilityPolicy, error) {
+	return &tabletmanagerdatapb.DurabilityPolicy{}, nil
+}
+
 //
 // Backup related methods
 //
@@ -280,16 +720,71 @@ func (e *eofEventStream) Recv() (*logutilpb.Event, error) {
 	return nil, io.EOF
 }
 
+// GetBackupLimits is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetBackupLimits(ctx context.Context, tablet *topodatapb.Tablet) (int, int, error) {
+	return 1, 1, nil
+}
+
+// GetBackupPosition is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetBackupPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	return "", nil
+}
+
 // Backup is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int) (logutil.EventStream, error) {
 	return &eofEventStream{}, nil
 }
 
+// IncrementalBackup is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) IncrementalBackup(ctx context.Context, tablet *topodatapb.Tablet, baseBackupName string, opts tmclient.IncrementalBackupOptions) (logutil.EventStream, error) {
+	return &eofEventStream{}, nil
+}
+
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
 	return &eofEventStream{}, nil
 }
 
+// RestoreToTimestamp is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) RestoreToTimestamp(ctx context.Context, tablet *topodatapb.Tablet, backupName string, targetTime time.Time) (logutil.EventStream, error) {
+	return &eofEventStream{}, nil
+}
+
+// SetPreferredBackup is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetPreferredBackup(ctx context.Context, tablet *topodatapb.Tablet, backupName string) error {
+	return nil
+}
+
+// GetPreferredBackup is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetPreferredBackup(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	return "", nil
+}
+
+// CheckRestoreCompatibility is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CheckRestoreCompatibility(ctx context.Context, tablet *topodatapb.Tablet, backupName string) (*tabletmanagerdatapb.CompatReport, error) {
+	return &tabletmanagerdatapb.CompatReport{Compatible: true}, nil
+}
+
+// TestRestore is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) TestRestore(ctx context.Context, tablet *topodatapb.Tablet, backupName string) (logutil.EventStream, error) {
+	return &eofEventStream{}, nil
+}
+
+// GetLastBackupInfo is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetLastBackupInfo(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.BackupInfo, error) {
+	return nil, nil
+}
+
+// GetBackupFreshness is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetBackupFreshness(ctx context.Context, tablet *topodatapb.Tablet) (string, time.Duration, error) {
+	return "", tmclient.BackupAgeNever, nil
+}
+
+// CheckBackupReadiness is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CheckBackupReadiness(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ReadinessReport, error) {
+	return &tabletmanagerdatapb.ReadinessReport{Ready: true}, nil
+}
+
 //
 // Management related methods
 //
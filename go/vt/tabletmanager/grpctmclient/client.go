@@ -7,10 +7,16 @@ package grpctmclient
 import (
 	"flag"
 	"fmt"
+	"io"
+	"net"
+	"strings"
 	"sync"
 	"time"
 
+	log "github.com/golang/glog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/naming"
 
 	"github.com/youtube/vitess/go/netutil"
 	"github.com/youtube/vitess/go/vt/hook"
@@ -21,12 +27,14 @@ import (
 	"github.com/youtube/vitess/go/vt/topo/topoproto"
 	"golang.org/x/net/context"
 
+	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	logutilpb "github.com/youtube/vitess/go/vt/proto/logutil"
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
 	tabletmanagerservicepb "github.com/youtube/vitess/go/vt/proto/tabletmanagerservice"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vschemapb "github.com/youtube/vitess/go/vt/proto/vschema"
 )
 
 var (
@@ -57,35 +65,230 @@ type Client struct {
 	// The map is protected by the mutex.
 	mu           sync.Mutex
 	rpcClientMap map[string]chan *tmc
+
+	// backupLimits caches the GetBackupLimits result of each tablet,
+	// keyed by alias, for backupLimitsTTL. It is also protected by mu.
+	backupLimits map[string]cachedBackupLimits
+
+	// resolver and target are set by WithResolver.
+	resolver naming.Resolver
+	target   func(tablet *topodatapb.Tablet) string
+
+	// connectParams is set by WithConnectParams.
+	connectParams *ConnectParams
+
+	// rootCtx is set by WithRootContext. conns are the connections
+	// returned by dial that are not closed yet, so they can be closed
+	// once rootCtx is done. It is set to nil then, and new
+	// connections are refused. It is also protected by mu. closed is
+	// closed by Close, to stop waiting for rootCtx. It is also
+	// protected by mu.
+	rootCtx context.Context
+	conns   map[*clientConn]bool
+	closed  chan struct{}
+}
+
+// clientConn is a connection returned by dial, for one RPC or stream.
+type clientConn struct {
+	*grpc.ClientConn
+	client *Client
+}
+
+// Close forgets the connection, and closes it.
+func (cc *clientConn) Close() error {
+	if cc.client.rootCtx != nil {
+		cc.client.mu.Lock()
+		delete(cc.client.conns, cc)
+		cc.client.mu.Unlock()
+	}
+	return cc.ClientConn.Close()
+}
+
+// ClientOption is an optional setting for NewClient.
+type ClientOption func(client *Client)
+
+// WithResolver makes the client dial tablets through the provided gRPC
+// name resolver, instead of using the tablet hostname and grpc port
+// as a literal address. target maps each tablet to the name that is
+// passed to the resolver, e.g. a service name in a service mesh.
+// The resolver is responsible for any scheme used in those names.
+func WithResolver(resolver naming.Resolver, target func(tablet *topodatapb.Tablet) string) ClientOption {
+	return func(client *Client) {
+		client.resolver = resolver
+		client.target = target
+	}
+}
+
+// ConnectParams controls how the client (re)connects to a tablet.
+// The delay before connection attempt n (starting at 0) is
+// BaseDelay * Multiplier^n, capped at MaxDelay, and each attempt
+// is given at least MinConnectTimeout to complete.
+//
+// The vendored gRPC only lets us change its maximum backoff delay,
+// so the attempts are retried by the dialer we install, and gRPC only
+// falls back to its own backoff once that dialer gives up.
+type ConnectParams struct {
+	BaseDelay         time.Duration
+	Multiplier        float64
+	MaxDelay          time.Duration
+	MinConnectTimeout time.Duration
+}
+
+// WithConnectParams makes the client use the provided connection
+// backoff instead of the gRPC default, which waits at least a second
+// between attempts and up to two minutes.
+func WithConnectParams(params ConnectParams) ClientOption {
+	return func(client *Client) {
+		client.connectParams = &params
+	}
+}
+
+// WithRootContext makes ctx the lifecycle context of the client, e.g.
+// to shut it down with the process. Once ctx is done, the connections
+// of the client are closed, which aborts all its in-flight RPCs and
+// streams, and new RPCs fail with the error of ctx.
+func WithRootContext(ctx context.Context) ClientOption {
+	return func(client *Client) {
+		client.rootCtx = ctx
+	}
+}
+
+// backoff returns how long to wait before the given connection attempt.
+func (params ConnectParams) backoff(attempt int) time.Duration {
+	if attempt == 0 {
+		return 0
+	}
+	delay := float64(params.BaseDelay)
+	for i := 1; i < attempt && delay < float64(params.MaxDelay); i++ {
+		delay *= params.Multiplier
+	}
+	if delay > float64(params.MaxDelay) {
+		delay = float64(params.MaxDelay)
+	}
+	return time.Duration(delay)
+}
+
+// dialer returns a gRPC dialer that retries dial with our backoff
+// until it succeeds or timeout, as given by gRPC, runs out.
+func (params ConnectParams) dialer(dial func(network, addr string, timeout time.Duration) (net.Conn, error)) func(addr string, timeout time.Duration) (net.Conn, error) {
+	return func(addr string, timeout time.Duration) (net.Conn, error) {
+		deadline := time.Now().Add(timeout)
+		for attempt := 0; ; attempt++ {
+			time.Sleep(params.backoff(attempt))
+			remaining := deadline.Sub(time.Now())
+			attemptTimeout := params.MinConnectTimeout
+			if timeout > 0 && remaining < attemptTimeout {
+				attemptTimeout = remaining
+			}
+			conn, err := dial("tcp", addr, attemptTimeout)
+			if err == nil {
+				return conn, nil
+			}
+			if timeout > 0 && deadline.Sub(time.Now()) <= params.backoff(attempt+1) {
+				return nil, err
+			}
+		}
+	}
 }
 
 // NewClient returns a new gRPC client.
-func NewClient() *Client {
-	return &Client{}
+func NewClient(opts ...ClientOption) *Client {
+	client := &Client{}
+	for _, opt := range opts {
+		opt(client)
+	}
+	if client.rootCtx != nil {
+		client.conns = make(map[*clientConn]bool)
+		client.closed = make(chan struct{})
+		go client.closeOnDone(client.closed)
+	}
+	return client
 }
 
-// dial returns a client to use
-func (client *Client) dial(tablet *topodatapb.Tablet) (*grpc.ClientConn, tabletmanagerservicepb.TabletManagerClient, error) {
-	addr := netutil.JoinHostPort(tablet.Hostname, int32(tablet.PortMap["grpc"]))
+// closeOnDone closes all the connections of the client once its root
+// context is done. It returns right away if the client is closed
+// first.
+func (client *Client) closeOnDone(closed chan struct{}) {
+	select {
+	case <-client.rootCtx.Done():
+	case <-closed:
+		return
+	}
+	client.mu.Lock()
+	conns := client.conns
+	client.conns = nil
+	client.mu.Unlock()
+	for cc := range conns {
+		cc.ClientConn.Close()
+	}
+	client.Close()
+}
+
+// addr returns the address or resolver target to dial for the tablet.
+func (client *Client) addr(tablet *topodatapb.Tablet) string {
+	if client.target != nil {
+		return client.target(tablet)
+	}
+	return netutil.JoinHostPort(tablet.Hostname, int32(tablet.PortMap["grpc"]))
+}
+
+// dialOptions returns the options to use when dialing a tablet.
+func (client *Client) dialOptions() ([]grpc.DialOption, error) {
 	opt, err := grpcutils.ClientSecureDialOption(*cert, *key, *ca, *name)
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{opt}
+	if client.resolver != nil {
+		opts = append(opts, grpc.WithBalancer(grpc.RoundRobin(client.resolver)))
+	}
+	if client.connectParams != nil {
+		opts = append(opts,
+			grpc.WithDialer(client.connectParams.dialer(net.DialTimeout)),
+			grpc.WithBackoffMaxDelay(client.connectParams.MaxDelay))
+	}
+	return opts, nil
+}
+
+// dial returns a client to use
+func (client *Client) dial(tablet *topodatapb.Tablet) (*clientConn, tabletmanagerservicepb.TabletManagerClient, error) {
+	opts, err := client.dialOptions()
 	if err != nil {
 		return nil, nil, err
 	}
-	cc, err := grpc.Dial(addr, opt)
+	gcc, err := grpc.Dial(client.addr(tablet), opts...)
 	if err != nil {
 		return nil, nil, err
 	}
-	return cc, tabletmanagerservicepb.NewTabletManagerClient(cc), nil
+	cc := &clientConn{
+		ClientConn: gcc,
+		client:     client,
+	}
+	if client.rootCtx != nil {
+		client.mu.Lock()
+		if client.conns == nil {
+			client.mu.Unlock()
+			gcc.Close()
+			return nil, nil, client.rootCtx.Err()
+		}
+		client.conns[cc] = true
+		client.mu.Unlock()
+	}
+	return cc, tabletmanagerservicepb.NewTabletManagerClient(gcc), nil
 }
 
 func (client *Client) dialPool(tablet *topodatapb.Tablet) (tabletmanagerservicepb.TabletManagerClient, error) {
-	addr := netutil.JoinHostPort(tablet.Hostname, int32(tablet.PortMap["grpc"]))
-	opt, err := grpcutils.ClientSecureDialOption(*cert, *key, *ca, *name)
+	addr := client.addr(tablet)
+	opts, err := client.dialOptions()
 	if err != nil {
 		return nil, err
 	}
 
 	client.mu.Lock()
+	if client.rootCtx != nil && client.conns == nil {
+		client.mu.Unlock()
+		return nil, client.rootCtx.Err()
+	}
 	if client.rpcClientMap == nil {
 		client.rpcClientMap = make(map[string]chan *tmc)
 	}
@@ -96,7 +299,7 @@ func (client *Client) dialPool(tablet *topodatapb.Tablet) (tabletmanagerservicep
 		client.mu.Unlock()
 
 		for i := 0; i < cap(c); i++ {
-			cc, err := grpc.Dial(addr, opt)
+			cc, err := grpc.Dial(addr, opts...)
 			if err != nil {
 				return nil, err
 			}
@@ -114,12 +317,38 @@ func (client *Client) dialPool(tablet *topodatapb.Tablet) (tabletmanagerservicep
 	return result.client, nil
 }
 
+// wrapRPCError wraps a non-nil *err into a tmclient.RPCError for the
+// tablet and method. All the RPC methods defer it. A shard mismatch
+// rejected by the tablet is turned back into a
+// *tmclient.ShardMismatchError.
+func wrapRPCError(tablet *topodatapb.Tablet, method string, err *error) {
+	if *err != nil {
+		// The typed errors are recognized by their message, as the
+		// tablet adds the name of the RPC to it, and the gRPC code
+		// doesn't always make it through.
+		desc := grpc.ErrorDesc(*err)
+		if sme, ok := tmclient.ParseShardMismatchError(desc); ok {
+			*err = sme
+		} else if strings.HasSuffix(desc, tmclient.ErrMaxExecTimeExceeded.Error()) {
+			*err = tmclient.ErrMaxExecTimeExceeded
+		} else if strings.HasSuffix(desc, tmclient.ErrSuperReadOnlyNotSupported.Error()) {
+			*err = tmclient.ErrSuperReadOnlyNotSupported
+		}
+		*err = &tmclient.RPCError{
+			Alias:  tablet.Alias,
+			Method: method,
+			Err:    *err,
+		}
+	}
+}
+
 //
 // Various read-only methods
 //
 
 // Ping is part of the tmclient.TabletManagerClient interface.
-func (client *Client) Ping(ctx context.Context, tablet *topodatapb.Tablet) error {
+func (client *Client) Ping(ctx context.Context, tablet *topodatapb.Tablet) (err error) {
+	defer wrapRPCError(tablet, "Ping", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
@@ -138,7 +367,8 @@ func (client *Client) Ping(ctx context.Context, tablet *topodatapb.Tablet) error
 }
 
 // Sleep is part of the tmclient.TabletManagerClient interface.
-func (client *Client) Sleep(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) error {
+func (client *Client) Sleep(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) (err error) {
+	defer wrapRPCError(tablet, "Sleep", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
@@ -151,7 +381,8 @@ func (client *Client) Sleep(ctx context.Context, tablet *topodatapb.Tablet, dura
 }
 
 // ExecuteHook is part of the tmclient.TabletManagerClient interface.
-func (client *Client) ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (*hook.HookResult, error) {
+func (client *Client) ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (_ *hook.HookResult, err error) {
+	defer wrapRPCError(tablet, "ExecuteHook", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
@@ -172,8 +403,51 @@ func (client *Client) ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet
 	}, nil
 }
 
+type executeHookToStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_ExecuteHookToStreamClient
+	cc     *clientConn
+}
+
+func (e *executeHookToStreamAdapter) Recv() (*tabletmanagerdatapb.ExecuteHookToStreamResponse, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "ExecuteHookToStream", &err)
+		}
+		return nil, err
+	}
+	return response, nil
+}
+
+// ExecuteHookToStream is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ExecuteHookToStream(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (_ tmclient.HookStream, err error) {
+	defer wrapRPCError(tablet, "ExecuteHookToStream", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.ExecuteHookToStream(ctx, &tabletmanagerdatapb.ExecuteHookToStreamRequest{
+		Name:       hk.Name,
+		Parameters: hk.Parameters,
+		ExtraEnv:   hk.ExtraEnv,
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &executeHookToStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
 // GetSchema is part of the tmclient.TabletManagerClient interface.
-func (client *Client) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+func (client *Client) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (_ *tabletmanagerdatapb.SchemaDefinition, err error) {
+	defer wrapRPCError(tablet, "GetSchema", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
@@ -190,253 +464,1403 @@ func (client *Client) GetSchema(ctx context.Context, tablet *topodatapb.Tablet,
 	return response.SchemaDefinition, nil
 }
 
-// GetPermissions is part of the tmclient.TabletManagerClient interface.
-func (client *Client) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
+// GetSchemaBestEffort is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetSchemaBestEffort(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool, timeout time.Duration) (_ *tabletmanagerdatapb.SchemaDefinition, _ bool, err error) {
+	defer wrapRPCError(tablet, "GetSchemaBestEffort", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	defer cc.Close()
-	response, err := c.GetPermissions(ctx, &tabletmanagerdatapb.GetPermissionsRequest{})
+	response, err := c.GetSchema(ctx, &tabletmanagerdatapb.GetSchemaRequest{
+		Tables:              tables,
+		ExcludeTables:       excludeTables,
+		IncludeViews:        includeViews,
+		BestEffortTimeoutNs: int64(timeout),
+	})
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	return response.Permissions, nil
+	return response.SchemaDefinition, response.Incomplete, nil
 }
 
-//
-// Various read-write methods
-//
-
-// SetReadOnly is part of the tmclient.TabletManagerClient interface.
-func (client *Client) SetReadOnly(ctx context.Context, tablet *topodatapb.Tablet) error {
+// GetCreateStatements is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetCreateStatements(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (_ map[string]string, err error) {
+	defer wrapRPCError(tablet, "GetCreateStatements", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer cc.Close()
-	_, err = c.SetReadOnly(ctx, &tabletmanagerdatapb.SetReadOnlyRequest{})
-	return err
-}
-
-// SetReadWrite is part of the tmclient.TabletManagerClient interface.
-func (client *Client) SetReadWrite(ctx context.Context, tablet *topodatapb.Tablet) error {
-	cc, c, err := client.dial(tablet)
+	response, err := c.GetCreateStatements(ctx, &tabletmanagerdatapb.GetCreateStatementsRequest{
+		Tables:        tables,
+		ExcludeTables: excludeTables,
+		IncludeViews:  includeViews,
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer cc.Close()
-	_, err = c.SetReadWrite(ctx, &tabletmanagerdatapb.SetReadWriteRequest{})
-	return err
+	return response.CreateStatements, nil
 }
 
-// ChangeType is part of the tmclient.TabletManagerClient interface.
-func (client *Client) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType) error {
+// GetSchemaTimestamps is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetSchemaTimestamps(ctx context.Context, tablet *topodatapb.Tablet, tables []string) (_ map[string]time.Time, err error) {
+	defer wrapRPCError(tablet, "GetSchemaTimestamps", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer cc.Close()
-	_, err = c.ChangeType(ctx, &tabletmanagerdatapb.ChangeTypeRequest{
-		TabletType: dbType,
+	response, err := c.GetSchemaTimestamps(ctx, &tabletmanagerdatapb.GetSchemaTimestampsRequest{
+		Tables: tables,
 	})
-	return err
+	if err != nil {
+		return nil, err
+	}
+	timestamps := make(map[string]time.Time, len(response.Timestamps))
+	for table, ts := range response.Timestamps {
+		timestamps[table] = time.Unix(ts, 0)
+	}
+	return timestamps, nil
 }
 
-// RefreshState is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
+// GetPermissions is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.Permissions, err error) {
+	defer wrapRPCError(tablet, "GetPermissions", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer cc.Close()
-	_, err = c.RefreshState(ctx, &tabletmanagerdatapb.RefreshStateRequest{})
-	return err
+	response, err := c.GetPermissions(ctx, &tabletmanagerdatapb.GetPermissionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Permissions, nil
 }
 
-// RunHealthCheck is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error {
+// GetConnectionStats is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetConnectionStats(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.ConnectionStats, err error) {
+	defer wrapRPCError(tablet, "GetConnectionStats", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer cc.Close()
-	_, err = c.RunHealthCheck(ctx, &tabletmanagerdatapb.RunHealthCheckRequest{})
-	return err
+	response, err := c.GetConnectionStats(ctx, &tabletmanagerdatapb.GetConnectionStatsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.ConnectionStats, nil
 }
 
-// IgnoreHealthError is part of the tmclient.TabletManagerClient interface.
-func (client *Client) IgnoreHealthError(ctx context.Context, tablet *topodatapb.Tablet, pattern string) error {
+// GetConnectionRejectionStats is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetConnectionRejectionStats(ctx context.Context, tablet *topodatapb.Tablet, reset bool) (_ map[string]int64, err error) {
+	defer wrapRPCError(tablet, "GetConnectionRejectionStats", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer cc.Close()
-	_, err = c.IgnoreHealthError(ctx, &tabletmanagerdatapb.IgnoreHealthErrorRequest{
-		Pattern: pattern,
+	response, err := c.GetConnectionRejectionStats(ctx, &tabletmanagerdatapb.GetConnectionRejectionStatsRequest{
+		ResetCounts: reset,
 	})
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return response.Rejections, nil
 }
 
-// ReloadSchema is part of the tmclient.TabletManagerClient interface.
-func (client *Client) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
+// GetConfig is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetConfig(ctx context.Context, tablet *topodatapb.Tablet) (_ map[string]string, err error) {
+	defer wrapRPCError(tablet, "GetConfig", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer cc.Close()
-	_, err = c.ReloadSchema(ctx, &tabletmanagerdatapb.ReloadSchemaRequest{
-		WaitPosition: waitPosition,
-	})
-	return err
+	response, err := c.GetConfig(ctx, &tabletmanagerdatapb.GetConfigRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Flags, nil
 }
 
-// PreflightSchema is part of the tmclient.TabletManagerClient interface.
-func (client *Client) PreflightSchema(ctx context.Context, tablet *topodatapb.Tablet, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error) {
+// GetInFlightRPCs is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetInFlightRPCs(ctx context.Context, tablet *topodatapb.Tablet) (_ map[string]int64, err error) {
+	defer wrapRPCError(tablet, "GetInFlightRPCs", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 	defer cc.Close()
-
-	response, err := c.PreflightSchema(ctx, &tabletmanagerdatapb.PreflightSchemaRequest{
-		Changes: changes,
-	})
+	response, err := c.GetInFlightRPCs(ctx, &tabletmanagerdatapb.GetInFlightRPCsRequest{})
 	if err != nil {
 		return nil, err
 	}
-
-	return response.ChangeResults, nil
+	return response.InFlight, nil
 }
 
-// ApplySchema is part of the tmclient.TabletManagerClient interface.
-func (client *Client) ApplySchema(ctx context.Context, tablet *topodatapb.Tablet, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error) {
+// GetProcessStats is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetProcessStats(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.ProcessStats, err error) {
+	defer wrapRPCError(tablet, "GetProcessStats", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 	defer cc.Close()
-	response, err := c.ApplySchema(ctx, &tabletmanagerdatapb.ApplySchemaRequest{
-		Sql:              change.SQL,
-		Force:            change.Force,
-		AllowReplication: change.AllowReplication,
-		BeforeSchema:     change.BeforeSchema,
-		AfterSchema:      change.AfterSchema,
-	})
+	response, err := c.GetProcessStats(ctx, &tabletmanagerdatapb.GetProcessStatsRequest{})
 	if err != nil {
 		return nil, err
 	}
-	return &tabletmanagerdatapb.SchemaChangeResult{
-		BeforeSchema: response.BeforeSchema,
-		AfterSchema:  response.AfterSchema,
-	}, nil
+	return response.Stats, nil
 }
 
-// ExecuteFetchAsDba is part of the tmclient.TabletManagerClient interface.
-func (client *Client) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
-	var c tabletmanagerservicepb.TabletManagerClient
-	var err error
-	if usePool {
-		c, err = client.dialPool(tablet)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		var cc *grpc.ClientConn
-		cc, c, err = client.dial(tablet)
-		if err != nil {
-			return nil, err
-		}
-		defer cc.Close()
+// GetHealthScore is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetHealthScore(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.HealthScore, err error) {
+	defer wrapRPCError(tablet, "GetHealthScore", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
 	}
-
-	response, err := c.ExecuteFetchAsDba(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{
-		Query:          query,
-		DbName:         topoproto.TabletDbName(tablet),
-		MaxRows:        uint64(maxRows),
-		DisableBinlogs: disableBinlogs,
-		ReloadSchema:   reloadSchema,
-	})
+	defer cc.Close()
+	response, err := c.GetHealthScore(ctx, &tabletmanagerdatapb.GetHealthScoreRequest{})
 	if err != nil {
 		return nil, err
 	}
-	return response.Result, nil
+	return response.Score, nil
 }
 
-// ExecuteFetchAsAllPrivs is part of the tmclient.TabletManagerClient interface.
-func (client *Client) ExecuteFetchAsAllPrivs(ctx context.Context, tablet *topodatapb.Tablet, query []byte, maxRows int, reloadSchema bool) (*querypb.QueryResult, error) {
-	var c tabletmanagerservicepb.TabletManagerClient
-	var err error
-	var cc *grpc.ClientConn
-	cc, c, err = client.dial(tablet)
+// GetErrorLogTail is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetErrorLogTail(ctx context.Context, tablet *topodatapb.Tablet, lines int) (_ []string, err error) {
+	defer wrapRPCError(tablet, "GetErrorLogTail", &err)
+	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 	defer cc.Close()
-
-	response, err := c.ExecuteFetchAsAllPrivs(ctx, &tabletmanagerdatapb.ExecuteFetchAsAllPrivsRequest{
-		Query:        query,
-		DbName:       topoproto.TabletDbName(tablet),
-		MaxRows:      uint64(maxRows),
-		ReloadSchema: reloadSchema,
+	response, err := c.GetErrorLogTail(ctx, &tabletmanagerdatapb.GetErrorLogTailRequest{
+		Lines: int64(lines),
 	})
 	if err != nil {
 		return nil, err
 	}
-	return response.Result, nil
+	return response.Lines, nil
 }
 
-// ExecuteFetchAsApp is part of the tmclient.TabletManagerClient interface.
-func (client *Client) ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int) (*querypb.QueryResult, error) {
-	var c tabletmanagerservicepb.TabletManagerClient
-	var err error
-	if usePool {
-		c, err = client.dialPool(tablet)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		var cc *grpc.ClientConn
-		cc, c, err = client.dial(tablet)
-		if err != nil {
-			return nil, err
-		}
-		defer cc.Close()
+// CaptureDiagnostics is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CaptureDiagnostics(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.DiagnosticBundle, err error) {
+	defer wrapRPCError(tablet, "CaptureDiagnostics", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
 	}
-
-	response, err := c.ExecuteFetchAsApp(ctx, &tabletmanagerdatapb.ExecuteFetchAsAppRequest{
-		Query:   query,
-		MaxRows: uint64(maxRows),
-	})
+	defer cc.Close()
+	response, err := c.CaptureDiagnostics(ctx, &tabletmanagerdatapb.CaptureDiagnosticsRequest{})
 	if err != nil {
 		return nil, err
 	}
-	return response.Result, nil
+	return response.Bundle, nil
 }
 
-//
-// Replication related methods
-//
-
-// SlaveStatus is part of the tmclient.TabletManagerClient interface.
-func (client *Client) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+// CheckDataDir is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CheckDataDir(ctx context.Context, tablet *topodatapb.Tablet) (_ []string, err error) {
+	defer wrapRPCError(tablet, "CheckDataDir", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 	defer cc.Close()
-	response, err := c.SlaveStatus(ctx, &tabletmanagerdatapb.SlaveStatusRequest{})
+	response, err := c.CheckDataDir(ctx, &tabletmanagerdatapb.CheckDataDirRequest{})
 	if err != nil {
 		return nil, err
 	}
-	return response.Status, nil
+	return response.Problems, nil
 }
 
-// MasterPosition is part of the tmclient.TabletManagerClient interface.
-func (client *Client) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+// GetThrottleState is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetThrottleState(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.ThrottleState, err error) {
+	defer wrapRPCError(tablet, "GetThrottleState", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetThrottleState(ctx, &tabletmanagerdatapb.GetThrottleStateRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.State, nil
+}
+
+// GetTabletTags is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetTabletTags(ctx context.Context, tablet *topodatapb.Tablet) (_ map[string]string, err error) {
+	defer wrapRPCError(tablet, "GetTabletTags", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetTabletTags(ctx, &tabletmanagerdatapb.GetTabletTagsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Tags, nil
+}
+
+//
+// Various read-write methods
+//
+
+// SetReadOnly is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetReadOnly(ctx context.Context, tablet *topodatapb.Tablet) (err error) {
+	defer wrapRPCError(tablet, "SetReadOnly", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.SetReadOnly(ctx, &tabletmanagerdatapb.SetReadOnlyRequest{})
+	return err
+}
+
+// SetReadWrite is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetReadWrite(ctx context.Context, tablet *topodatapb.Tablet) (err error) {
+	defer wrapRPCError(tablet, "SetReadWrite", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.SetReadWrite(ctx, &tabletmanagerdatapb.SetReadWriteRequest{})
+	return err
+}
+
+// SetReadOnlyWithTTL is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetReadOnlyWithTTL(ctx context.Context, tablet *topodatapb.Tablet, ttl time.Duration) (err error) {
+	defer wrapRPCError(tablet, "SetReadOnlyWithTTL", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.SetReadOnlyWithTTL(ctx, &tabletmanagerdatapb.SetReadOnlyWithTTLRequest{
+		TtlNs: int64(ttl),
+	})
+	return err
+}
+
+// SetSuperReadOnly is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetSuperReadOnly(ctx context.Context, tablet *topodatapb.Tablet, on bool) (_ bool, err error) {
+	defer wrapRPCError(tablet, "SetSuperReadOnly", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return false, err
+	}
+	defer cc.Close()
+	response, err := c.SetSuperReadOnly(ctx, &tabletmanagerdatapb.SetSuperReadOnlyRequest{
+		On: on,
+	})
+	if err != nil {
+		return false, err
+	}
+	return response.SuperReadOnly, nil
+}
+
+// SetQueryServerConfig is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetQueryServerConfig(ctx context.Context, tablet *topodatapb.Tablet, config *tabletmanagerdatapb.QueryServerConfig) (_ *tabletmanagerdatapb.QueryServerConfig, _ []string, err error) {
+	defer wrapRPCError(tablet, "SetQueryServerConfig", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cc.Close()
+	response, err := c.SetQueryServerConfig(ctx, &tabletmanagerdatapb.SetQueryServerConfigRequest{
+		Config: config,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Applied, response.Skipped, nil
+}
+
+// GetQueryBlacklist is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetQueryBlacklist(ctx context.Context, tablet *topodatapb.Tablet) (_ []string, err error) {
+	defer wrapRPCError(tablet, "GetQueryBlacklist", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetQueryBlacklist(ctx, &tabletmanagerdatapb.GetQueryBlacklistRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Patterns, nil
+}
+
+// SetQueryBlacklist is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetQueryBlacklist(ctx context.Context, tablet *topodatapb.Tablet, patterns []string) (err error) {
+	defer wrapRPCError(tablet, "SetQueryBlacklist", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.SetQueryBlacklist(ctx, &tabletmanagerdatapb.SetQueryBlacklistRequest{
+		Patterns: patterns,
+	})
+	return err
+}
+
+// ChangeType is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType, idempotent bool) (err error) {
+	defer wrapRPCError(tablet, "ChangeType", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.ChangeType(ctx, &tabletmanagerdatapb.ChangeTypeRequest{
+		TabletType: dbType,
+		Idempotent: idempotent,
+	})
+	return err
+}
+
+// SetTabletTags is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetTabletTags(ctx context.Context, tablet *topodatapb.Tablet, tags map[string]string) (err error) {
+	defer wrapRPCError(tablet, "SetTabletTags", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.SetTabletTags(ctx, &tabletmanagerdatapb.SetTabletTagsRequest{
+		Tags: tags,
+	})
+	return err
+}
+
+// RefreshState is part of the tmclient.TabletManagerClient interface.
+func (client *Client) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) (err error) {
+	defer wrapRPCError(tablet, "RefreshState", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.RefreshState(ctx, &tabletmanagerdatapb.RefreshStateRequest{})
+	return err
+}
+
+// RepublishTopoRecord is part of the tmclient.TabletManagerClient interface.
+func (client *Client) RepublishTopoRecord(ctx context.Context, tablet *topodatapb.Tablet) (_ int64, err error) {
+	defer wrapRPCError(tablet, "RepublishTopoRecord", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return 0, err
+	}
+	defer cc.Close()
+	response, err := c.RepublishTopoRecord(ctx, &tabletmanagerdatapb.RepublishTopoRecordRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return response.Version, nil
+}
+
+// RunHealthCheck is part of the tmclient.TabletManagerClient interface.
+func (client *Client) RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) (err error) {
+	defer wrapRPCError(tablet, "RunHealthCheck", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.RunHealthCheck(ctx, &tabletmanagerdatapb.RunHealthCheckRequest{})
+	return err
+}
+
+// IgnoreHealthError is part of the tmclient.TabletManagerClient interface.
+func (client *Client) IgnoreHealthError(ctx context.Context, tablet *topodatapb.Tablet, pattern string) (err error) {
+	defer wrapRPCError(tablet, "IgnoreHealthError", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.IgnoreHealthError(ctx, &tabletmanagerdatapb.IgnoreHealthErrorRequest{
+		Pattern: pattern,
+	})
+	return err
+}
+
+// SetMaintenanceMode is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetMaintenanceMode(ctx context.Context, tablet *topodatapb.Tablet, on bool, reason string) (err error) {
+	defer wrapRPCError(tablet, "SetMaintenanceMode", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.SetMaintenanceMode(ctx, &tabletmanagerdatapb.SetMaintenanceModeRequest{
+		On:     on,
+		Reason: reason,
+	})
+	return err
+}
+
+// PauseHealthReporting is part of the tmclient.TabletManagerClient interface.
+func (client *Client) PauseHealthReporting(ctx context.Context, tablet *topodatapb.Tablet, on bool) (err error) {
+	defer wrapRPCError(tablet, "PauseHealthReporting", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.PauseHealthReporting(ctx, &tabletmanagerdatapb.PauseHealthReportingRequest{
+		On: on,
+	})
+	return err
+}
+
+// SetThrottle is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetThrottle(ctx context.Context, tablet *topodatapb.Tablet, mode string, duration time.Duration) (err error) {
+	defer wrapRPCError(tablet, "SetThrottle", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.SetThrottle(ctx, &tabletmanagerdatapb.SetThrottleRequest{
+		Mode:       mode,
+		DurationNs: int64(duration),
+	})
+	return err
+}
+
+// PrepareCutover is part of the tmclient.TabletManagerClient interface.
+func (client *Client) PrepareCutover(ctx context.Context, tablet *topodatapb.Tablet) (_ string, err error) {
+	defer wrapRPCError(tablet, "PrepareCutover", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return "", err
+	}
+	defer cc.Close()
+	response, err := c.PrepareCutover(ctx, &tabletmanagerdatapb.PrepareCutoverRequest{})
+	if err != nil {
+		return "", err
+	}
+	return response.Token, nil
+}
+
+// CommitCutover is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CommitCutover(ctx context.Context, tablet *topodatapb.Tablet, token string) (err error) {
+	defer wrapRPCError(tablet, "CommitCutover", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.CommitCutover(ctx, &tabletmanagerdatapb.CommitCutoverRequest{
+		Token: token,
+	})
+	return err
+}
+
+// AbortCutover is part of the tmclient.TabletManagerClient interface.
+func (client *Client) AbortCutover(ctx context.Context, tablet *topodatapb.Tablet, token string) (err error) {
+	defer wrapRPCError(tablet, "AbortCutover", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.AbortCutover(ctx, &tabletmanagerdatapb.AbortCutoverRequest{
+		Token: token,
+	})
+	return err
+}
+
+// SetServingKeyRange is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetServingKeyRange(ctx context.Context, tablet *topodatapb.Tablet, keyRange *topodatapb.KeyRange, servedTypes []topodatapb.TabletType) (err error) {
+	defer wrapRPCError(tablet, "SetServingKeyRange", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.SetServingKeyRange(ctx, &tabletmanagerdatapb.SetServingKeyRangeRequest{
+		KeyRange:    keyRange,
+		ServedTypes: servedTypes,
+	})
+	return err
+}
+
+type restartMysqlStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_RestartMysqlClient
+	cc     *clientConn
+}
+
+func (e *restartMysqlStreamAdapter) Recv() (*logutilpb.Event, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "RestartMysql", &err)
+		}
+		return nil, err
+	}
+	return response.Event, nil
+}
+
+// RestartMysql is part of the tmclient.TabletManagerClient interface.
+func (client *Client) RestartMysql(ctx context.Context, tablet *topodatapb.Tablet, opts tmclient.RestartMysqlOptions) (_ logutil.EventStream, err error) {
+	defer wrapRPCError(tablet, "RestartMysql", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.RestartMysql(ctx, &tabletmanagerdatapb.RestartMysqlRequest{
+		HealthyTimeoutNs: int64(opts.HealthyTimeout),
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &restartMysqlStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+type decommissionStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_DecommissionClient
+	cc     *clientConn
+}
+
+func (e *decommissionStreamAdapter) Recv() (*logutilpb.Event, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "Decommission", &err)
+		}
+		return nil, err
+	}
+	return response.Event, nil
+}
+
+// Decommission is part of the tmclient.TabletManagerClient interface.
+func (client *Client) Decommission(ctx context.Context, tablet *topodatapb.Tablet, opts tmclient.DecommissionOptions) (_ logutil.EventStream, err error) {
+	defer wrapRPCError(tablet, "Decommission", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.Decommission(ctx, &tabletmanagerdatapb.DecommissionRequest{
+		StopReplication: opts.StopReplication,
+		StopMysqld:      opts.StopMysqld,
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &decommissionStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+// CheckTopoConnectivity is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CheckTopoConnectivity(ctx context.Context, tablet *topodatapb.Tablet) (_ bool, _ time.Duration, err error) {
+	defer wrapRPCError(tablet, "CheckTopoConnectivity", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return false, 0, err
+	}
+	defer cc.Close()
+	response, err := c.CheckTopoConnectivity(ctx, &tabletmanagerdatapb.CheckTopoConnectivityRequest{})
+	if err != nil {
+		return false, 0, err
+	}
+	return response.Reachable, time.Duration(response.LatencyNs), nil
+}
+
+type warmUpStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_WarmUpClient
+	cc     *clientConn
+}
+
+func (e *warmUpStreamAdapter) Recv() (*tabletmanagerdatapb.WarmUpResponse, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "WarmUp", &err)
+		}
+		return nil, err
+	}
+	return response, nil
+}
+
+// WarmUp is part of the tmclient.TabletManagerClient interface.
+func (client *Client) WarmUp(ctx context.Context, tablet *topodatapb.Tablet, queries []string, loadBufferPool bool) (_ tmclient.WarmUpStream, err error) {
+	defer wrapRPCError(tablet, "WarmUp", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.WarmUp(ctx, &tabletmanagerdatapb.WarmUpRequest{
+		Queries:        queries,
+		LoadBufferPool: loadBufferPool,
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &warmUpStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+// ReloadSchema is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) (err error) {
+	defer wrapRPCError(tablet, "ReloadSchema", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.ReloadSchema(ctx, &tabletmanagerdatapb.ReloadSchemaRequest{
+		WaitPosition: waitPosition,
+	})
+	return err
+}
+
+// PreflightSchema is part of the tmclient.TabletManagerClient interface.
+func (client *Client) PreflightSchema(ctx context.Context, tablet *topodatapb.Tablet, changes []string) (_ []*tabletmanagerdatapb.SchemaChangeResult, err error) {
+	defer wrapRPCError(tablet, "PreflightSchema", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+
+	response, err := c.PreflightSchema(ctx, &tabletmanagerdatapb.PreflightSchemaRequest{
+		Changes: changes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ChangeResults, nil
+}
+
+// ApplySchema is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ApplySchema(ctx context.Context, tablet *topodatapb.Tablet, change *tmutils.SchemaChange) (_ *tabletmanagerdatapb.SchemaChangeResult, err error) {
+	defer wrapRPCError(tablet, "ApplySchema", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.ApplySchema(ctx, &tabletmanagerdatapb.ApplySchemaRequest{
+		Sql:              change.SQL,
+		Force:            change.Force,
+		AllowReplication: change.AllowReplication,
+		BeforeSchema:     change.BeforeSchema,
+		AfterSchema:      change.AfterSchema,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.SchemaChangeResult{
+		BeforeSchema: response.BeforeSchema,
+		AfterSchema:  response.AfterSchema,
+	}, nil
+}
+
+// SchemaDiff is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SchemaDiff(ctx context.Context, tablet *topodatapb.Tablet, desired *tabletmanagerdatapb.SchemaDefinition) (_ []string, err error) {
+	defer wrapRPCError(tablet, "SchemaDiff", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.SchemaDiff(ctx, &tabletmanagerdatapb.SchemaDiffRequest{
+		Desired: desired,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Statements, nil
+}
+
+// AssessSchemaChange is part of the tmclient.TabletManagerClient interface.
+func (client *Client) AssessSchemaChange(ctx context.Context, tablet *topodatapb.Tablet, change string) (_ *tabletmanagerdatapb.SchemaChangeAssessment, err error) {
+	defer wrapRPCError(tablet, "AssessSchemaChange", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.AssessSchemaChange(ctx, &tabletmanagerdatapb.AssessSchemaChangeRequest{
+		Change: change,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Assessment, nil
+}
+
+type schemaChangeStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_WatchSchemaClient
+	cc     *clientConn
+}
+
+func (e *schemaChangeStreamAdapter) Recv() (*tabletmanagerdatapb.SchemaChangeEvent, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "WatchSchema", &err)
+		}
+		return nil, err
+	}
+	return response.Event, nil
+}
+
+// WatchSchema is part of the tmclient.TabletManagerClient interface.
+func (client *Client) WatchSchema(ctx context.Context, tablet *topodatapb.Tablet) (_ tmclient.SchemaChangeStream, err error) {
+	defer wrapRPCError(tablet, "WatchSchema", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.WatchSchema(ctx, &tabletmanagerdatapb.WatchSchemaRequest{})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &schemaChangeStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+// GetVSchema is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetVSchema(ctx context.Context, tablet *topodatapb.Tablet) (_ *vschemapb.Keyspace, err error) {
+	defer wrapRPCError(tablet, "GetVSchema", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetVSchema(ctx, &tabletmanagerdatapb.GetVSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Vschema, nil
+}
+
+// ApplyVSchema is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ApplyVSchema(ctx context.Context, tablet *topodatapb.Tablet, vschema string) (err error) {
+	defer wrapRPCError(tablet, "ApplyVSchema", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.ApplyVSchema(ctx, &tabletmanagerdatapb.ApplyVSchemaRequest{
+		Vschema: vschema,
+	})
+	return err
+}
+
+// ExecuteFetchAsDba is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool, maxExecTime time.Duration) (_ *querypb.QueryResult, err error) {
+	defer wrapRPCError(tablet, "ExecuteFetchAsDba", &err)
+	var c tabletmanagerservicepb.TabletManagerClient
+	if usePool {
+		c, err = client.dialPool(tablet)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var cc *clientConn
+		cc, c, err = client.dial(tablet)
+		if err != nil {
+			return nil, err
+		}
+		defer cc.Close()
+	}
+
+	response, err := c.ExecuteFetchAsDba(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaRequest{
+		Query:          query,
+		DbName:         topoproto.TabletDbName(tablet),
+		MaxRows:        uint64(maxRows),
+		DisableBinlogs: disableBinlogs,
+		ReloadSchema:   reloadSchema,
+		MaxExecTimeNs:  int64(maxExecTime),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Result, nil
+}
+
+type executeFetchAsDbaCSVStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_ExecuteFetchAsDbaCSVClient
+	cc     *clientConn
+}
+
+func (e *executeFetchAsDbaCSVStreamAdapter) Recv() ([]byte, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "ExecuteFetchAsDbaCSV", &err)
+		}
+		return nil, err
+	}
+	return response.Data, nil
+}
+
+// ExecuteFetchAsDbaCSV is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ExecuteFetchAsDbaCSV(ctx context.Context, tablet *topodatapb.Tablet, query []byte) (_ tmclient.CSVStream, err error) {
+	defer wrapRPCError(tablet, "ExecuteFetchAsDbaCSV", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.ExecuteFetchAsDbaCSV(ctx, &tabletmanagerdatapb.ExecuteFetchAsDbaCSVRequest{
+		Query:  query,
+		DbName: topoproto.TabletDbName(tablet),
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &executeFetchAsDbaCSVStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+// ExecuteFetchColumnar is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ExecuteFetchColumnar(ctx context.Context, tablet *topodatapb.Tablet, query []byte, maxRows int) (_ *tabletmanagerdatapb.ColumnarResult, err error) {
+	defer wrapRPCError(tablet, "ExecuteFetchColumnar", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+
+	response, err := c.ExecuteFetchColumnar(ctx, &tabletmanagerdatapb.ExecuteFetchColumnarRequest{
+		Query:   query,
+		DbName:  topoproto.TabletDbName(tablet),
+		MaxRows: uint64(maxRows),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Result, nil
+}
+
+// ExecuteFetchAsAllPrivs is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ExecuteFetchAsAllPrivs(ctx context.Context, tablet *topodatapb.Tablet, query []byte, maxRows int, reloadSchema bool) (_ *querypb.QueryResult, err error) {
+	defer wrapRPCError(tablet, "ExecuteFetchAsAllPrivs", &err)
+	var c tabletmanagerservicepb.TabletManagerClient
+	var cc *clientConn
+	cc, c, err = client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+
+	response, err := c.ExecuteFetchAsAllPrivs(ctx, &tabletmanagerdatapb.ExecuteFetchAsAllPrivsRequest{
+		Query:        query,
+		DbName:       topoproto.TabletDbName(tablet),
+		MaxRows:      uint64(maxRows),
+		ReloadSchema: reloadSchema,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Result, nil
+}
+
+// ExecuteFetchAsApp is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int) (_ *querypb.QueryResult, err error) {
+	defer wrapRPCError(tablet, "ExecuteFetchAsApp", &err)
+	var c tabletmanagerservicepb.TabletManagerClient
+	if usePool {
+		c, err = client.dialPool(tablet)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var cc *clientConn
+		cc, c, err = client.dial(tablet)
+		if err != nil {
+			return nil, err
+		}
+		defer cc.Close()
+	}
+
+	response, err := c.ExecuteFetchAsApp(ctx, &tabletmanagerdatapb.ExecuteFetchAsAppRequest{
+		Query:   query,
+		MaxRows: uint64(maxRows),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Result, nil
+}
+
+// ExplainQuery is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ExplainQuery(ctx context.Context, tablet *topodatapb.Tablet, query, format string) (_ *querypb.QueryResult, err error) {
+	defer wrapRPCError(tablet, "ExplainQuery", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.ExplainQuery(ctx, &tabletmanagerdatapb.ExplainQueryRequest{
+		Query:  query,
+		Format: format,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Result, nil
+}
+
+// ApplyGrants is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ApplyGrants(ctx context.Context, tablet *topodatapb.Tablet, statements []string, atomic bool) (err error) {
+	defer wrapRPCError(tablet, "ApplyGrants", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.ApplyGrants(ctx, &tabletmanagerdatapb.ApplyGrantsRequest{
+		Statements: statements,
+		Atomic:     atomic,
+	})
+	return err
+}
+
+// ChecksumTable is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ChecksumTable(ctx context.Context, tablet *topodatapb.Tablet, table string, keyRange *topodatapb.KeyRange) (_ uint64, _ int64, err error) {
+	defer wrapRPCError(tablet, "ChecksumTable", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer cc.Close()
+	response, err := c.ChecksumTable(ctx, &tabletmanagerdatapb.ChecksumTableRequest{
+		Table:    table,
+		KeyRange: keyRange,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return response.Checksum, response.RowCount, nil
+}
+
+// TruncateTable is part of the tmclient.TabletManagerClient interface.
+func (client *Client) TruncateTable(ctx context.Context, tablet *topodatapb.Tablet, table, confirmKeyspace string) (err error) {
+	defer wrapRPCError(tablet, "TruncateTable", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.TruncateTable(ctx, &tabletmanagerdatapb.TruncateTableRequest{
+		Table:           table,
+		ConfirmKeyspace: confirmKeyspace,
+	})
+	return err
+}
+
+// RenameTable is part of the tmclient.TabletManagerClient interface.
+func (client *Client) RenameTable(ctx context.Context, tablet *topodatapb.Tablet, from, to string, opts tmclient.RenameTableOptions) (_ *tabletmanagerdatapb.RenameTableResult, err error) {
+	defer wrapRPCError(tablet, "RenameTable", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.RenameTable(ctx, &tabletmanagerdatapb.RenameTableRequest{
+		FromTable: from,
+		ToTable:   to,
+		Cascade:   opts.Cascade,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Result, nil
+}
+
+type streamRowsInKeyRangeStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_StreamRowsInKeyRangeClient
+	cc     *clientConn
+}
+
+func (e *streamRowsInKeyRangeStreamAdapter) Recv() (*querypb.QueryResult, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "StreamRowsInKeyRange", &err)
+		}
+		return nil, err
+	}
+	return response.Result, nil
+}
+
+// StreamRowsInKeyRange is part of the tmclient.TabletManagerClient interface.
+func (client *Client) StreamRowsInKeyRange(ctx context.Context, tablet *topodatapb.Tablet, table string, keyRange *topodatapb.KeyRange) (_ tmclient.RowStream, err error) {
+	defer wrapRPCError(tablet, "StreamRowsInKeyRange", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.StreamRowsInKeyRange(ctx, &tabletmanagerdatapb.StreamRowsInKeyRangeRequest{
+		Table:    table,
+		KeyRange: keyRange,
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &streamRowsInKeyRangeStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+type streamKeyRangeBinlogStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_StreamKeyRangeBinlogClient
+	cc     *clientConn
+}
+
+func (e *streamKeyRangeBinlogStreamAdapter) Recv() (*binlogdatapb.BinlogTransaction, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "StreamKeyRangeBinlog", &err)
+		}
+		return nil, err
+	}
+	return response.BinlogTransaction, nil
+}
+
+// StreamKeyRangeBinlog is part of the tmclient.TabletManagerClient interface.
+func (client *Client) StreamKeyRangeBinlog(ctx context.Context, tablet *topodatapb.Tablet, keyRange *topodatapb.KeyRange, startPos string, charset *binlogdatapb.Charset) (_ tmclient.BinlogTransactionStream, err error) {
+	defer wrapRPCError(tablet, "StreamKeyRangeBinlog", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.StreamKeyRangeBinlog(ctx, &tabletmanagerdatapb.StreamKeyRangeBinlogRequest{
+		KeyRange: keyRange,
+		Position: startPos,
+		Charset:  charset,
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &streamKeyRangeBinlogStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+type cloneStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_CloneStreamClient
+	cc     *clientConn
+}
+
+func (e *cloneStreamAdapter) Recv() (*tabletmanagerdatapb.CloneStreamResponse, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "CloneStream", &err)
+		}
+		return nil, err
+	}
+	return response, nil
+}
+
+// CloneStream is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CloneStream(ctx context.Context, tablet *topodatapb.Tablet, tables []string, opts tmclient.CloneOptions) (_ tmclient.CloneStream, err error) {
+	defer wrapRPCError(tablet, "CloneStream", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.CloneStream(ctx, &tabletmanagerdatapb.CloneStreamRequest{
+		Tables:     tables,
+		BufferSize: int64(opts.BufferSize),
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &cloneStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+// GetCharsetConfig is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetCharsetConfig(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.CharsetConfig, err error) {
+	defer wrapRPCError(tablet, "GetCharsetConfig", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetCharsetConfig(ctx, &tabletmanagerdatapb.GetCharsetConfigRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Config, nil
+}
+
+// GetProcessList is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetProcessList(ctx context.Context, tablet *topodatapb.Tablet) (_ []*tabletmanagerdatapb.Process, err error) {
+	defer wrapRPCError(tablet, "GetProcessList", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetProcessList(ctx, &tabletmanagerdatapb.GetProcessListRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Processes, nil
+}
+
+// KillProcess is part of the tmclient.TabletManagerClient interface.
+func (client *Client) KillProcess(ctx context.Context, tablet *topodatapb.Tablet, id int64) (err error) {
+	defer wrapRPCError(tablet, "KillProcess", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.KillProcess(ctx, &tabletmanagerdatapb.KillProcessRequest{
+		Id: id,
+	})
+	return err
+}
+
+type tailGeneralLogStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_TailGeneralLogClient
+	cc     *clientConn
+}
+
+func (e *tailGeneralLogStreamAdapter) Recv() (string, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "TailGeneralLog", &err)
+		}
+		return "", err
+	}
+	return response.Entry, nil
+}
+
+// TailGeneralLog is part of the tmclient.TabletManagerClient interface.
+func (client *Client) TailGeneralLog(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) (_ tmclient.GeneralLogStream, err error) {
+	defer wrapRPCError(tablet, "TailGeneralLog", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.TailGeneralLog(ctx, &tabletmanagerdatapb.TailGeneralLogRequest{
+		DurationNs: int64(duration),
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &tailGeneralLogStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+// StreamQPS is part of the tmclient.TabletManagerClient interface.
+func (client *Client) StreamQPS(ctx context.Context, tablet *topodatapb.Tablet, interval time.Duration) (_ <-chan *tabletmanagerdatapb.QPSSample, _ tmclient.ErrFunc, err error) {
+	defer wrapRPCError(tablet, "StreamQPS", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream, err := c.StreamQPS(ctx, &tabletmanagerdatapb.StreamQPSRequest{
+		IntervalNs: int64(interval),
+	})
+	if err != nil {
+		cc.Close()
+		return nil, nil, err
+	}
+
+	// The tablet only sees a slow reader once the gRPC flow control
+	// window of the stream is full, which takes many samples. So the
+	// stream is always read, and a sample is dropped here if the
+	// reader has not taken the previous one yet. The samples count
+	// the ones dropped here too.
+	samples := make(chan *tabletmanagerdatapb.QPSSample, 1)
+	var streamErr error
+	go func() {
+		defer close(samples)
+		defer cc.Close()
+		var dropped int64
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					wrapRPCError(tablet, "StreamQPS", &err)
+					streamErr = err
+				}
+				return
+			}
+			response.Sample.Dropped += dropped
+			select {
+			case samples <- response.Sample:
+			default:
+				dropped++
+			}
+		}
+	}()
+	return samples, func() error { return streamErr }, nil
+}
+
+//
+// Replication related methods
+//
+
+// SlaveStatus is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (_ *replicationdatapb.Status, err error) {
+	defer wrapRPCError(tablet, "SlaveStatus", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.SlaveStatus(ctx, &tabletmanagerdatapb.SlaveStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Status, nil
+}
+
+// SlaveStatusAllChannels is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SlaveStatusAllChannels(ctx context.Context, tablet *topodatapb.Tablet) (_ map[string]*replicationdatapb.Status, err error) {
+	defer wrapRPCError(tablet, "SlaveStatusAllChannels", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.SlaveStatusAllChannels(ctx, &tabletmanagerdatapb.SlaveStatusAllChannelsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Statuses, nil
+}
+
+// GetReplicationSource is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetReplicationSource(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.ReplicationSource, err error) {
+	defer wrapRPCError(tablet, "GetReplicationSource", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetReplicationSource(ctx, &tabletmanagerdatapb.GetReplicationSourceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Source, nil
+}
+
+// ValidateReplicationCredentials is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ValidateReplicationCredentials(ctx context.Context, tablet *topodatapb.Tablet) (_ bool, err error) {
+	defer wrapRPCError(tablet, "ValidateReplicationCredentials", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return false, err
+	}
+	defer cc.Close()
+	response, err := c.ValidateReplicationCredentials(ctx, &tabletmanagerdatapb.ValidateReplicationCredentialsRequest{})
+	if err != nil {
+		return false, err
+	}
+	return response.Valid, nil
+}
+
+// GetApplierQueueStats is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetApplierQueueStats(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.ApplierQueueStats, err error) {
+	defer wrapRPCError(tablet, "GetApplierQueueStats", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetApplierQueueStats(ctx, &tabletmanagerdatapb.GetApplierQueueStatsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Stats, nil
+}
+
+// MasterPosition is part of the tmclient.TabletManagerClient interface.
+func (client *Client) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (_ string, err error) {
+	defer wrapRPCError(tablet, "MasterPosition", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return "", err
 	}
 	defer cc.Close()
 	response, err := c.MasterPosition(ctx, &tabletmanagerdatapb.MasterPositionRequest{})
@@ -446,8 +1870,56 @@ func (client *Client) MasterPosition(ctx context.Context, tablet *topodatapb.Tab
 	return response.Position, nil
 }
 
+// GetGtidPurged is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetGtidPurged(ctx context.Context, tablet *topodatapb.Tablet) (_ string, err error) {
+	defer wrapRPCError(tablet, "GetGtidPurged", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return "", err
+	}
+	defer cc.Close()
+	response, err := c.GetGtidPurged(ctx, &tabletmanagerdatapb.GetGtidPurgedRequest{})
+	if err != nil {
+		return "", err
+	}
+	return response.Position, nil
+}
+
+// GetBinlogStats is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetBinlogStats(ctx context.Context, tablet *topodatapb.Tablet) (_ float64, _ time.Duration, err error) {
+	defer wrapRPCError(tablet, "GetBinlogStats", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer cc.Close()
+	response, err := c.GetBinlogStats(ctx, &tabletmanagerdatapb.GetBinlogStatsRequest{})
+	if err != nil {
+		return 0, 0, err
+	}
+	return response.TransactionsPerSecond, time.Duration(response.WindowNs), nil
+}
+
+// GetReplicationErrorStats is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetReplicationErrorStats(ctx context.Context, tablet *topodatapb.Tablet, reset bool) (_ *tabletmanagerdatapb.ReplicationErrorStats, err error) {
+	defer wrapRPCError(tablet, "GetReplicationErrorStats", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetReplicationErrorStats(ctx, &tabletmanagerdatapb.GetReplicationErrorStatsRequest{
+		ResetCounts: reset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Stats, nil
+}
+
 // StopSlave is part of the tmclient.TabletManagerClient interface.
-func (client *Client) StopSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
+func (client *Client) StopSlave(ctx context.Context, tablet *topodatapb.Tablet) (err error) {
+	defer wrapRPCError(tablet, "StopSlave", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
@@ -458,7 +1930,8 @@ func (client *Client) StopSlave(ctx context.Context, tablet *topodatapb.Tablet)
 }
 
 // StopSlaveMinimum is part of the tmclient.TabletManagerClient interface.
-func (client *Client) StopSlaveMinimum(ctx context.Context, tablet *topodatapb.Tablet, minPos string, waitTime time.Duration) (string, error) {
+func (client *Client) StopSlaveMinimum(ctx context.Context, tablet *topodatapb.Tablet, minPos string, waitTime time.Duration) (_ string, err error) {
+	defer wrapRPCError(tablet, "StopSlaveMinimum", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return "", err
@@ -474,8 +1947,90 @@ func (client *Client) StopSlaveMinimum(ctx context.Context, tablet *topodatapb.T
 	return response.Position, nil
 }
 
+type stopSlaveMinimumStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_StopSlaveMinimumStreamClient
+	cc     *clientConn
+}
+
+func (e *stopSlaveMinimumStreamAdapter) Recv() (*tabletmanagerdatapb.StopSlaveMinimumStreamResponse, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "StopSlaveMinimumStream", &err)
+		}
+		return nil, err
+	}
+	return response, nil
+}
+
+// StopSlaveMinimumStream is part of the tmclient.TabletManagerClient interface.
+func (client *Client) StopSlaveMinimumStream(ctx context.Context, tablet *topodatapb.Tablet, minPos string, waitTime time.Duration) (_ tmclient.StopSlaveMinimumStream, err error) {
+	defer wrapRPCError(tablet, "StopSlaveMinimumStream", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.StopSlaveMinimumStream(ctx, &tabletmanagerdatapb.StopSlaveMinimumStreamRequest{
+		Position:    minPos,
+		WaitTimeout: int64(waitTime),
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &stopSlaveMinimumStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+type boostReplicationCatchupStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_BoostReplicationCatchupClient
+	cc     *clientConn
+}
+
+func (e *boostReplicationCatchupStreamAdapter) Recv() (*tabletmanagerdatapb.BoostReplicationCatchupResponse, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "BoostReplicationCatchup", &err)
+		}
+		return nil, err
+	}
+	return response, nil
+}
+
+// BoostReplicationCatchup is part of the tmclient.TabletManagerClient interface.
+func (client *Client) BoostReplicationCatchup(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) (_ tmclient.BoostReplicationCatchupStream, err error) {
+	defer wrapRPCError(tablet, "BoostReplicationCatchup", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.BoostReplicationCatchup(ctx, &tabletmanagerdatapb.BoostReplicationCatchupRequest{
+		Duration: int64(duration),
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &boostReplicationCatchupStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
 // StartSlave is part of the tmclient.TabletManagerClient interface.
-func (client *Client) StartSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
+func (client *Client) StartSlave(ctx context.Context, tablet *topodatapb.Tablet) (err error) {
+	defer wrapRPCError(tablet, "StartSlave", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
@@ -485,8 +2040,91 @@ func (client *Client) StartSlave(ctx context.Context, tablet *topodatapb.Tablet)
 	return err
 }
 
+// EnableParallelReplication is part of the tmclient.TabletManagerClient interface.
+func (client *Client) EnableParallelReplication(ctx context.Context, tablet *topodatapb.Tablet, workers int, parallelType string) (err error) {
+	defer wrapRPCError(tablet, "EnableParallelReplication", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.EnableParallelReplication(ctx, &tabletmanagerdatapb.EnableParallelReplicationRequest{
+		Workers:      int64(workers),
+		ParallelType: parallelType,
+	})
+	return err
+}
+
+// RotateReplicationCredentials is part of the tmclient.TabletManagerClient interface.
+func (client *Client) RotateReplicationCredentials(ctx context.Context, tablet *topodatapb.Tablet, user, password string) (err error) {
+	defer wrapRPCError(tablet, "RotateReplicationCredentials", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.RotateReplicationCredentials(ctx, &tabletmanagerdatapb.RotateReplicationCredentialsRequest{
+		User:     user,
+		Password: password,
+	})
+	return err
+}
+
+// ConfigureReplicationSSL is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ConfigureReplicationSSL(ctx context.Context, tablet *topodatapb.Tablet, opts *tabletmanagerdatapb.ReplicationSSLOptions) (err error) {
+	defer wrapRPCError(tablet, "ConfigureReplicationSSL", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.ConfigureReplicationSSL(ctx, &tabletmanagerdatapb.ConfigureReplicationSSLRequest{
+		Options: opts,
+	})
+	return err
+}
+
+type repairRelayLogStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_RepairRelayLogClient
+	cc     *clientConn
+}
+
+func (e *repairRelayLogStreamAdapter) Recv() (*logutilpb.Event, error) {
+	response, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "RepairRelayLog", &err)
+		}
+		return nil, err
+	}
+	return response.Event, nil
+}
+
+// RepairRelayLog is part of the tmclient.TabletManagerClient interface.
+func (client *Client) RepairRelayLog(ctx context.Context, tablet *topodatapb.Tablet) (_ logutil.EventStream, err error) {
+	defer wrapRPCError(tablet, "RepairRelayLog", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.RepairRelayLog(ctx, &tabletmanagerdatapb.RepairRelayLogRequest{})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &repairRelayLogStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
 // TabletExternallyReparented is part of the tmclient.TabletManagerClient interface.
-func (client *Client) TabletExternallyReparented(ctx context.Context, tablet *topodatapb.Tablet, externalID string) error {
+func (client *Client) TabletExternallyReparented(ctx context.Context, tablet *topodatapb.Tablet, externalID string, validate bool) (err error) {
+	defer wrapRPCError(tablet, "TabletExternallyReparented", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
@@ -494,12 +2132,14 @@ func (client *Client) TabletExternallyReparented(ctx context.Context, tablet *to
 	defer cc.Close()
 	_, err = c.TabletExternallyReparented(ctx, &tabletmanagerdatapb.TabletExternallyReparentedRequest{
 		ExternalId: externalID,
+		Validate:   validate,
 	})
 	return err
 }
 
 // GetSlaves is part of the tmclient.TabletManagerClient interface.
-func (client *Client) GetSlaves(ctx context.Context, tablet *topodatapb.Tablet) ([]string, error) {
+func (client *Client) GetSlaves(ctx context.Context, tablet *topodatapb.Tablet) (_ []string, err error) {
+	defer wrapRPCError(tablet, "GetSlaves", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
@@ -512,8 +2152,24 @@ func (client *Client) GetSlaves(ctx context.Context, tablet *topodatapb.Tablet)
 	return response.Addrs, nil
 }
 
+// GetReplicationGraph is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetReplicationGraph(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.ReplicationGraph, err error) {
+	defer wrapRPCError(tablet, "GetReplicationGraph", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetReplicationGraph(ctx, &tabletmanagerdatapb.GetReplicationGraphRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Graph, nil
+}
+
 // WaitBlpPosition is part of the tmclient.TabletManagerClient interface.
-func (client *Client) WaitBlpPosition(ctx context.Context, tablet *topodatapb.Tablet, blpPosition *tabletmanagerdatapb.BlpPosition, waitTime time.Duration) error {
+func (client *Client) WaitBlpPosition(ctx context.Context, tablet *topodatapb.Tablet, blpPosition *tabletmanagerdatapb.BlpPosition, waitTime time.Duration) (err error) {
+	defer wrapRPCError(tablet, "WaitBlpPosition", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
@@ -527,7 +2183,8 @@ func (client *Client) WaitBlpPosition(ctx context.Context, tablet *topodatapb.Ta
 }
 
 // StopBlp is part of the tmclient.TabletManagerClient interface.
-func (client *Client) StopBlp(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.BlpPosition, error) {
+func (client *Client) StopBlp(ctx context.Context, tablet *topodatapb.Tablet) (_ []*tabletmanagerdatapb.BlpPosition, err error) {
+	defer wrapRPCError(tablet, "StopBlp", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
@@ -541,7 +2198,8 @@ func (client *Client) StopBlp(ctx context.Context, tablet *topodatapb.Tablet) ([
 }
 
 // StartBlp is part of the tmclient.TabletManagerClient interface.
-func (client *Client) StartBlp(ctx context.Context, tablet *topodatapb.Tablet) error {
+func (client *Client) StartBlp(ctx context.Context, tablet *topodatapb.Tablet) (err error) {
+	defer wrapRPCError(tablet, "StartBlp", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
@@ -552,7 +2210,8 @@ func (client *Client) StartBlp(ctx context.Context, tablet *topodatapb.Tablet) e
 }
 
 // RunBlpUntil is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RunBlpUntil(ctx context.Context, tablet *topodatapb.Tablet, positions []*tabletmanagerdatapb.BlpPosition, waitTime time.Duration) (string, error) {
+func (client *Client) RunBlpUntil(ctx context.Context, tablet *topodatapb.Tablet, positions []*tabletmanagerdatapb.BlpPosition, waitTime time.Duration) (_ string, err error) {
+	defer wrapRPCError(tablet, "RunBlpUntil", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return "", err
@@ -568,12 +2227,28 @@ func (client *Client) RunBlpUntil(ctx context.Context, tablet *topodatapb.Tablet
 	return response.Position, nil
 }
 
+// GetBinlogFilters is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetBinlogFilters(ctx context.Context, tablet *topodatapb.Tablet) (_ []*tabletmanagerdatapb.BinlogFilter, err error) {
+	defer wrapRPCError(tablet, "GetBinlogFilters", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetBinlogFilters(ctx, &tabletmanagerdatapb.GetBinlogFiltersRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Filters, nil
+}
+
 //
 // Reparenting related functions
 //
 
 // ResetReplication is part of the tmclient.TabletManagerClient interface.
-func (client *Client) ResetReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
+func (client *Client) ResetReplication(ctx context.Context, tablet *topodatapb.Tablet) (err error) {
+	defer wrapRPCError(tablet, "ResetReplication", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
@@ -584,13 +2259,17 @@ func (client *Client) ResetReplication(ctx context.Context, tablet *topodatapb.T
 }
 
 // InitMaster is part of the tmclient.TabletManagerClient interface.
-func (client *Client) InitMaster(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+func (client *Client) InitMaster(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string) (_ string, err error) {
+	defer wrapRPCError(tablet, "InitMaster", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return "", err
 	}
 	defer cc.Close()
-	response, err := c.InitMaster(ctx, &tabletmanagerdatapb.InitMasterRequest{})
+	response, err := c.InitMaster(ctx, &tabletmanagerdatapb.InitMasterRequest{
+		ExpectedKeyspace: expectedKeyspace,
+		ExpectedShard:    expectedShard,
+	})
 	if err != nil {
 		return "", err
 	}
@@ -598,7 +2277,8 @@ func (client *Client) InitMaster(ctx context.Context, tablet *topodatapb.Tablet)
 }
 
 // PopulateReparentJournal is part of the tmclient.TabletManagerClient interface.
-func (client *Client) PopulateReparentJournal(ctx context.Context, tablet *topodatapb.Tablet, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, pos string) error {
+func (client *Client) PopulateReparentJournal(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, pos string) (err error) {
+	defer wrapRPCError(tablet, "PopulateReparentJournal", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
@@ -609,12 +2289,30 @@ func (client *Client) PopulateReparentJournal(ctx context.Context, tablet *topod
 		ActionName:          actionName,
 		MasterAlias:         masterAlias,
 		ReplicationPosition: pos,
+		ExpectedKeyspace:    expectedKeyspace,
+		ExpectedShard:       expectedShard,
 	})
 	return err
 }
 
+// GetLastReparentJournalEntry is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetLastReparentJournalEntry(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.ReparentJournalEntry, err error) {
+	defer wrapRPCError(tablet, "GetLastReparentJournalEntry", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetLastReparentJournalEntry(ctx, &tabletmanagerdatapb.GetLastReparentJournalEntryRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Entry, nil
+}
+
 // InitSlave is part of the tmclient.TabletManagerClient interface.
-func (client *Client) InitSlave(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, replicationPosition string, timeCreatedNS int64) error {
+func (client *Client) InitSlave(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, replicationPosition string, timeCreatedNS int64) (err error) {
+	defer wrapRPCError(tablet, "InitSlave", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
@@ -629,7 +2327,8 @@ func (client *Client) InitSlave(ctx context.Context, tablet *topodatapb.Tablet,
 }
 
 // DemoteMaster is part of the tmclient.TabletManagerClient interface.
-func (client *Client) DemoteMaster(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+func (client *Client) DemoteMaster(ctx context.Context, tablet *topodatapb.Tablet) (_ string, err error) {
+	defer wrapRPCError(tablet, "DemoteMaster", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return "", err
@@ -643,7 +2342,8 @@ func (client *Client) DemoteMaster(ctx context.Context, tablet *topodatapb.Table
 }
 
 // PromoteSlaveWhenCaughtUp is part of the tmclient.TabletManagerClient interface.
-func (client *Client) PromoteSlaveWhenCaughtUp(ctx context.Context, tablet *topodatapb.Tablet, pos string) (string, error) {
+func (client *Client) PromoteSlaveWhenCaughtUp(ctx context.Context, tablet *topodatapb.Tablet, pos string) (_ string, err error) {
+	defer wrapRPCError(tablet, "PromoteSlaveWhenCaughtUp", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return "", err
@@ -659,7 +2359,8 @@ func (client *Client) PromoteSlaveWhenCaughtUp(ctx context.Context, tablet *topo
 }
 
 // SlaveWasPromoted is part of the tmclient.TabletManagerClient interface.
-func (client *Client) SlaveWasPromoted(ctx context.Context, tablet *topodatapb.Tablet) error {
+func (client *Client) SlaveWasPromoted(ctx context.Context, tablet *topodatapb.Tablet) (err error) {
+	defer wrapRPCError(tablet, "SlaveWasPromoted", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
@@ -670,22 +2371,46 @@ func (client *Client) SlaveWasPromoted(ctx context.Context, tablet *topodatapb.T
 }
 
 // SetMaster is part of the tmclient.TabletManagerClient interface.
-func (client *Client) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error {
+func (client *Client) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) (err error) {
+	defer wrapRPCError(tablet, "SetMaster", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
 	}
 	defer cc.Close()
 	_, err = c.SetMaster(ctx, &tabletmanagerdatapb.SetMasterRequest{
-		Parent:          parent,
-		TimeCreatedNs:   timeCreatedNS,
-		ForceStartSlave: forceStartSlave,
+		Parent:           parent,
+		TimeCreatedNs:    timeCreatedNS,
+		ForceStartSlave:  forceStartSlave,
+		ExpectedKeyspace: expectedKeyspace,
+		ExpectedShard:    expectedShard,
+	})
+	return err
+}
+
+// ConfigureReplication is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ConfigureReplication(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, parent *topodatapb.TabletAlias, opts tmclient.ReplicationOptions) (err error) {
+	defer wrapRPCError(tablet, "ConfigureReplication", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.ConfigureReplication(ctx, &tabletmanagerdatapb.ConfigureReplicationRequest{
+		Parent:           parent,
+		AutoPosition:     opts.AutoPosition,
+		File:             opts.File,
+		Position:         opts.Position,
+		ForceStartSlave:  opts.ForceStartSlave,
+		ExpectedKeyspace: expectedKeyspace,
+		ExpectedShard:    expectedShard,
 	})
 	return err
 }
 
 // SlaveWasRestarted is part of the tmclient.TabletManagerClient interface.
-func (client *Client) SlaveWasRestarted(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias) error {
+func (client *Client) SlaveWasRestarted(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias) (err error) {
+	defer wrapRPCError(tablet, "SlaveWasRestarted", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return err
@@ -698,7 +2423,8 @@ func (client *Client) SlaveWasRestarted(ctx context.Context, tablet *topodatapb.
 }
 
 // StopReplicationAndGetStatus is part of the tmclient.TabletManagerClient interface.
-func (client *Client) StopReplicationAndGetStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+func (client *Client) StopReplicationAndGetStatus(ctx context.Context, tablet *topodatapb.Tablet) (_ *replicationdatapb.Status, err error) {
+	defer wrapRPCError(tablet, "StopReplicationAndGetStatus", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
@@ -712,7 +2438,8 @@ func (client *Client) StopReplicationAndGetStatus(ctx context.Context, tablet *t
 }
 
 // PromoteSlave is part of the tmclient.TabletManagerClient interface.
-func (client *Client) PromoteSlave(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+func (client *Client) PromoteSlave(ctx context.Context, tablet *topodatapb.Tablet) (_ string, err error) {
+	defer wrapRPCError(tablet, "PromoteSlave", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return "", err
@@ -725,59 +2452,286 @@ func (client *Client) PromoteSlave(ctx context.Context, tablet *topodatapb.Table
 	return response.Position, nil
 }
 
+// SetReparentEligibility is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetReparentEligibility(ctx context.Context, tablet *topodatapb.Tablet, eligible bool, reason string) (err error) {
+	defer wrapRPCError(tablet, "SetReparentEligibility", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.SetReparentEligibility(ctx, &tabletmanagerdatapb.SetReparentEligibilityRequest{
+		Eligible: eligible,
+		Reason:   reason,
+	})
+	return err
+}
+
+// CheckReparentCandidate is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CheckReparentCandidate(ctx context.Context, tablet *topodatapb.Tablet) (_ bool, _ string, err error) {
+	defer wrapRPCError(tablet, "CheckReparentCandidate", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return false, "", err
+	}
+	defer cc.Close()
+	response, err := c.CheckReparentCandidate(ctx, &tabletmanagerdatapb.CheckReparentCandidateRequest{})
+	if err != nil {
+		return false, "", err
+	}
+	return response.Eligible, response.Reason, nil
+}
+
+// SetSemiSyncAckCount is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetSemiSyncAckCount(ctx context.Context, tablet *topodatapb.Tablet, count int) (err error) {
+	defer wrapRPCError(tablet, "SetSemiSyncAckCount", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.SetSemiSyncAckCount(ctx, &tabletmanagerdatapb.SetSemiSyncAckCountRequest{
+		Count: int32(count),
+	})
+	return err
+}
+
+// GetDurabilityPolicy is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetDurabilityPolicy(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.DurabilityPolicy, err error) {
+	defer wrapRPCError(tablet, "GetDurabilityPolicy", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetDurabilityPolicy(ctx, &tabletmanagerdatapb.GetDurabilityPolicyRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Policy, nil
+}
+
 //
 // Backup related methods
 //
 type backupStreamAdapter struct {
+	tablet *topodatapb.Tablet
 	stream tabletmanagerservicepb.TabletManager_BackupClient
-	cc     *grpc.ClientConn
+	cc     *clientConn
 }
 
 func (e *backupStreamAdapter) Recv() (*logutilpb.Event, error) {
 	br, err := e.stream.Recv()
 	if err != nil {
 		e.cc.Close()
+		if err != io.EOF {
+			e.cc.client.forgetBackupLimits(e.tablet)
+			wrapRPCError(e.tablet, "Backup", &err)
+		}
 		return nil, err
 	}
 	return br.Event, nil
 }
 
+// GetBackupLimits is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetBackupLimits(ctx context.Context, tablet *topodatapb.Tablet) (_ int, _ int, err error) {
+	defer wrapRPCError(tablet, "GetBackupLimits", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer cc.Close()
+	response, err := c.GetBackupLimits(ctx, &tabletmanagerdatapb.GetBackupLimitsRequest{})
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(response.DefaultConcurrency), int(response.MaxConcurrency), nil
+}
+
+// GetBackupPosition is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetBackupPosition(ctx context.Context, tablet *topodatapb.Tablet) (_ string, err error) {
+	defer wrapRPCError(tablet, "GetBackupPosition", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return "", err
+	}
+	defer cc.Close()
+	response, err := c.GetBackupPosition(ctx, &tabletmanagerdatapb.GetBackupPositionRequest{})
+	if err != nil {
+		return "", err
+	}
+	return response.Position, nil
+}
+
+// backupLimitsTTL is how long the backup limits of a tablet are
+// cached. The limits are flags of the tablet, so they only change
+// when it is restarted.
+var backupLimitsTTL = 5 * time.Minute
+
+// cachedBackupLimits is an entry of Client.backupLimits.
+type cachedBackupLimits struct {
+	limits  *tabletmanagerdatapb.GetBackupLimitsResponse
+	fetched time.Time
+}
+
+// forgetBackupLimits removes the cached backup limits of the tablet,
+// so they are asked again for the next backup. It is called when a
+// backup fails, in case the tablet was restarted with other limits.
+func (client *Client) forgetBackupLimits(tablet *topodatapb.Tablet) {
+	client.mu.Lock()
+	delete(client.backupLimits, topoproto.TabletAliasString(tablet.Alias))
+	client.mu.Unlock()
+}
+
+// backupConcurrency validates the concurrency asked for a backup,
+// against the limits of the tablet. The limits are cached for
+// backupLimitsTTL, or until a backup of the tablet fails. Tablets
+// that do not report limits get the concurrency as is, and it then
+// has to be explicit.
+func (client *Client) backupConcurrency(ctx context.Context, c tabletmanagerservicepb.TabletManagerClient, tablet *topodatapb.Tablet, concurrency int) (int, error) {
+	if concurrency < 0 {
+		return 0, fmt.Errorf("invalid backup concurrency %v: must be at least 1, or 0 for the tablet default", concurrency)
+	}
+
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	client.mu.Lock()
+	cached, ok := client.backupLimits[alias]
+	client.mu.Unlock()
+	limits := cached.limits
+	if !ok || time.Since(cached.fetched) > backupLimitsTTL {
+		var err error
+		limits, err = c.GetBackupLimits(ctx, &tabletmanagerdatapb.GetBackupLimitsRequest{})
+		switch {
+		case grpc.Code(err) == codes.Unimplemented:
+			if concurrency == 0 {
+				return 0, fmt.Errorf("tablet does not report a default backup concurrency, it must be set explicitly")
+			}
+			return concurrency, nil
+		case err != nil:
+			return 0, err
+		}
+		client.mu.Lock()
+		if client.backupLimits == nil {
+			client.backupLimits = make(map[string]cachedBackupLimits)
+		}
+		client.backupLimits[alias] = cachedBackupLimits{
+			limits:  limits,
+			fetched: time.Now(),
+		}
+		client.mu.Unlock()
+	}
+
+	if concurrency == 0 {
+		concurrency = int(limits.DefaultConcurrency)
+	}
+	if maxConcurrency := int(limits.MaxConcurrency); maxConcurrency > 0 && concurrency > maxConcurrency {
+		log.Infof("capping backup concurrency for tablet %v from %v to its maximum %v", alias, concurrency, maxConcurrency)
+		concurrency = maxConcurrency
+	}
+	return concurrency, nil
+}
+
 // Backup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int) (logutil.EventStream, error) {
+func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int) (_ logutil.EventStream, err error) {
+	defer wrapRPCError(tablet, "Backup", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
+	concurrency, err = client.backupConcurrency(ctx, c, tablet, concurrency)
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
 
 	stream, err := c.Backup(ctx, &tabletmanagerdatapb.BackupRequest{
 		Concurrency: int64(concurrency),
 	})
 	if err != nil {
 		cc.Close()
+		client.forgetBackupLimits(tablet)
 		return nil, err
 	}
 	return &backupStreamAdapter{
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+type incrementalBackupStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_IncrementalBackupClient
+	cc     *clientConn
+}
+
+func (e *incrementalBackupStreamAdapter) Recv() (*logutilpb.Event, error) {
+	br, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			e.cc.client.forgetBackupLimits(e.tablet)
+			wrapRPCError(e.tablet, "IncrementalBackup", &err)
+		}
+		return nil, err
+	}
+	return br.Event, nil
+}
+
+// IncrementalBackup is part of the tmclient.TabletManagerClient interface.
+func (client *Client) IncrementalBackup(ctx context.Context, tablet *topodatapb.Tablet, baseBackupName string, opts tmclient.IncrementalBackupOptions) (_ logutil.EventStream, err error) {
+	defer wrapRPCError(tablet, "IncrementalBackup", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	concurrency, err := client.backupConcurrency(ctx, c, tablet, opts.Concurrency)
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+
+	stream, err := c.IncrementalBackup(ctx, &tabletmanagerdatapb.IncrementalBackupRequest{
+		BaseBackupName: baseBackupName,
+		Concurrency:    int64(concurrency),
+	})
+	if err != nil {
+		cc.Close()
+		client.forgetBackupLimits(tablet)
+		return nil, err
+	}
+	return &incrementalBackupStreamAdapter{
+		tablet: tablet,
 		stream: stream,
 		cc:     cc,
 	}, nil
 }
 
 type restoreFromBackupStreamAdapter struct {
+	ctx    context.Context
+	tablet *topodatapb.Tablet
 	stream tabletmanagerservicepb.TabletManager_RestoreFromBackupClient
-	cc     *grpc.ClientConn
+	cc     *clientConn
 }
 
 func (e *restoreFromBackupStreamAdapter) Recv() (*logutilpb.Event, error) {
 	br, err := e.stream.Recv()
 	if err != nil {
 		e.cc.Close()
+		if e.ctx.Err() == context.Canceled {
+			return nil, tmclient.ErrRestoreAborted
+		}
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "RestoreFromBackup", &err)
+		}
 		return nil, err
 	}
 	return br.Event, nil
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (_ logutil.EventStream, err error) {
+	defer wrapRPCError(tablet, "RestoreFromBackup", &err)
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
@@ -789,15 +2743,198 @@ func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.
 		return nil, err
 	}
 	return &restoreFromBackupStreamAdapter{
+		ctx:    ctx,
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+type restoreToTimestampStreamAdapter struct {
+	ctx    context.Context
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_RestoreToTimestampClient
+	cc     *clientConn
+}
+
+func (e *restoreToTimestampStreamAdapter) Recv() (*logutilpb.Event, error) {
+	br, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if e.ctx.Err() == context.Canceled {
+			return nil, tmclient.ErrRestoreAborted
+		}
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "RestoreToTimestamp", &err)
+		}
+		return nil, err
+	}
+	return br.Event, nil
+}
+
+// RestoreToTimestamp is part of the tmclient.TabletManagerClient interface.
+func (client *Client) RestoreToTimestamp(ctx context.Context, tablet *topodatapb.Tablet, backupName string, targetTime time.Time) (_ logutil.EventStream, err error) {
+	defer wrapRPCError(tablet, "RestoreToTimestamp", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.RestoreToTimestamp(ctx, &tabletmanagerdatapb.RestoreToTimestampRequest{
+		BackupName:   backupName,
+		TargetTimeNs: targetTime.UnixNano(),
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &restoreToTimestampStreamAdapter{
+		ctx:    ctx,
+		tablet: tablet,
+		stream: stream,
+		cc:     cc,
+	}, nil
+}
+
+// SetPreferredBackup is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetPreferredBackup(ctx context.Context, tablet *topodatapb.Tablet, backupName string) (err error) {
+	defer wrapRPCError(tablet, "SetPreferredBackup", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.SetPreferredBackup(ctx, &tabletmanagerdatapb.SetPreferredBackupRequest{
+		BackupName: backupName,
+	})
+	return err
+}
+
+// GetPreferredBackup is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetPreferredBackup(ctx context.Context, tablet *topodatapb.Tablet) (_ string, err error) {
+	defer wrapRPCError(tablet, "GetPreferredBackup", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return "", err
+	}
+	defer cc.Close()
+	response, err := c.GetPreferredBackup(ctx, &tabletmanagerdatapb.GetPreferredBackupRequest{})
+	if err != nil {
+		return "", err
+	}
+	return response.BackupName, nil
+}
+
+// CheckRestoreCompatibility is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CheckRestoreCompatibility(ctx context.Context, tablet *topodatapb.Tablet, backupName string) (_ *tabletmanagerdatapb.CompatReport, err error) {
+	defer wrapRPCError(tablet, "CheckRestoreCompatibility", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.CheckRestoreCompatibility(ctx, &tabletmanagerdatapb.CheckRestoreCompatibilityRequest{
+		BackupName: backupName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Report, nil
+}
+
+type testRestoreStreamAdapter struct {
+	tablet *topodatapb.Tablet
+	stream tabletmanagerservicepb.TabletManager_TestRestoreClient
+	cc     *clientConn
+}
+
+func (e *testRestoreStreamAdapter) Recv() (*logutilpb.Event, error) {
+	br, err := e.stream.Recv()
+	if err != nil {
+		e.cc.Close()
+		if err != io.EOF {
+			wrapRPCError(e.tablet, "TestRestore", &err)
+		}
+		return nil, err
+	}
+	return br.Event, nil
+}
+
+// TestRestore is part of the tmclient.TabletManagerClient interface.
+func (client *Client) TestRestore(ctx context.Context, tablet *topodatapb.Tablet, backupName string) (_ logutil.EventStream, err error) {
+	defer wrapRPCError(tablet, "TestRestore", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.TestRestore(ctx, &tabletmanagerdatapb.TestRestoreRequest{
+		BackupName: backupName,
+	})
+	if err != nil {
+		cc.Close()
+		return nil, err
+	}
+	return &testRestoreStreamAdapter{
+		tablet: tablet,
 		stream: stream,
 		cc:     cc,
 	}, nil
 }
 
+// GetLastBackupInfo is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetLastBackupInfo(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.BackupInfo, err error) {
+	defer wrapRPCError(tablet, "GetLastBackupInfo", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetLastBackupInfo(ctx, &tabletmanagerdatapb.GetLastBackupInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Info, nil
+}
+
+// GetBackupFreshness is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetBackupFreshness(ctx context.Context, tablet *topodatapb.Tablet) (_ string, _ time.Duration, err error) {
+	defer wrapRPCError(tablet, "GetBackupFreshness", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return "", 0, err
+	}
+	defer cc.Close()
+	response, err := c.GetBackupFreshness(ctx, &tabletmanagerdatapb.GetBackupFreshnessRequest{})
+	if err != nil {
+		return "", 0, err
+	}
+	return response.BackupName, time.Duration(response.AgeNs), nil
+}
+
+// CheckBackupReadiness is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CheckBackupReadiness(ctx context.Context, tablet *topodatapb.Tablet) (_ *tabletmanagerdatapb.ReadinessReport, err error) {
+	defer wrapRPCError(tablet, "CheckBackupReadiness", &err)
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.CheckBackupReadiness(ctx, &tabletmanagerdatapb.CheckBackupReadinessRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Report, nil
+}
+
 // Close is part of the tmclient.TabletManagerClient interface.
 func (client *Client) Close() {
 	client.mu.Lock()
 	defer client.mu.Unlock()
+	if client.closed != nil {
+		close(client.closed)
+		client.closed = nil
+	}
 	for _, c := range client.rpcClientMap {
 		close(c)
 		for ch := range c {
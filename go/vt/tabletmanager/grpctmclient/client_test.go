@@ -0,0 +1,251 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package grpctmclient
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/youtube/vitess/go/vt/hook"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	tabletmanagerservicepb "github.com/youtube/vitess/go/vt/proto/tabletmanagerservice"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestConnectParamsBackoff(t *testing.T) {
+	params := ConnectParams{
+		BaseDelay:  10 * time.Millisecond,
+		Multiplier: 2,
+		MaxDelay:   50 * time.Millisecond,
+	}
+	want := []time.Duration{
+		0,
+		10 * time.Millisecond,
+		20 * time.Millisecond,
+		40 * time.Millisecond,
+		50 * time.Millisecond,
+		50 * time.Millisecond,
+	}
+	for attempt, w := range want {
+		if got := params.backoff(attempt); got != w {
+			t.Errorf("backoff(%v) = %v, want %v", attempt, got, w)
+		}
+	}
+}
+
+// flappingDial fails the first failures dials, and records when each
+// dial happened and the timeout it was given.
+type flappingDial struct {
+	failures int
+	times    []time.Time
+	timeouts []time.Duration
+}
+
+func (f *flappingDial) dial(network, addr string, timeout time.Duration) (net.Conn, error) {
+	f.times = append(f.times, time.Now())
+	f.timeouts = append(f.timeouts, timeout)
+	if len(f.times) <= f.failures {
+		return nil, errors.New("connection refused")
+	}
+	client, server := net.Pipe()
+	server.Close()
+	return client, nil
+}
+
+func TestConnectParamsDialer(t *testing.T) {
+	params := ConnectParams{
+		BaseDelay:         20 * time.Millisecond,
+		Multiplier:        2,
+		MaxDelay:          60 * time.Millisecond,
+		MinConnectTimeout: 100 * time.Millisecond,
+	}
+	client := NewClient(WithConnectParams(params))
+	if client.connectParams == nil || *client.connectParams != params {
+		t.Fatalf("WithConnectParams not applied: %v", client.connectParams)
+	}
+
+	f := &flappingDial{failures: 4}
+	conn, err := client.connectParams.dialer(f.dial)("localhost:1", 10*time.Second)
+	if err != nil {
+		t.Fatalf("dialer failed: %v", err)
+	}
+	conn.Close()
+	if len(f.times) != 5 {
+		t.Fatalf("got %v dial attempts, want 5", len(f.times))
+	}
+	for i := 1; i < len(f.times); i++ {
+		if got, want := f.times[i].Sub(f.times[i-1]), params.backoff(i); got < want {
+			t.Errorf("attempt %v came %v after the previous one, want at least %v", i, got, want)
+		}
+	}
+	for i, timeout := range f.timeouts {
+		if timeout != params.MinConnectTimeout {
+			t.Errorf("attempt %v timeout = %v, want %v", i, timeout, params.MinConnectTimeout)
+		}
+	}
+
+	// The dialer gives up when gRPC's timeout runs out.
+	f = &flappingDial{failures: 1000}
+	start := time.Now()
+	if _, err := client.connectParams.dialer(f.dial)("localhost:1", 150*time.Millisecond); err == nil {
+		t.Fatalf("dialer to a down tablet succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("dialer took %v to give up, want about 150ms", elapsed)
+	}
+	if len(f.times) < 2 || len(f.times) > 5 {
+		t.Errorf("got %v dial attempts within 150ms, want 2 to 5", len(f.times))
+	}
+}
+
+// blockingServer is a tablet manager server whose Sleep and
+// ExecuteHookToStream only return once the client goes away. The
+// other RPCs are not implemented.
+type blockingServer struct {
+	tabletmanagerservicepb.TabletManagerServer
+}
+
+func (s *blockingServer) Sleep(ctx context.Context, request *tabletmanagerdatapb.SleepRequest) (*tabletmanagerdatapb.SleepResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (s *blockingServer) ExecuteHookToStream(request *tabletmanagerdatapb.ExecuteHookToStreamRequest, stream tabletmanagerservicepb.TabletManager_ExecuteHookToStreamServer) error {
+	if err := stream.Send(&tabletmanagerdatapb.ExecuteHookToStreamResponse{Stdout: "started"}); err != nil {
+		return err
+	}
+	<-stream.Context().Done()
+	return stream.Context().Err()
+}
+
+func TestRootContext(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Cannot listen: %v", err)
+	}
+	s := grpc.NewServer()
+	tabletmanagerservicepb.RegisterTabletManagerServer(s, &blockingServer{})
+	go s.Serve(listener)
+	defer s.Stop()
+	tablet := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "test",
+			Uid:  123,
+		},
+		Hostname: "localhost",
+		PortMap: map[string]int32{
+			"grpc": int32(listener.Addr().(*net.TCPAddr).Port),
+		},
+	}
+
+	rootCtx, rootCancel := context.WithCancel(context.Background())
+	client := NewClient(WithRootContext(rootCtx))
+
+	// A stream that is in flight. There is no HealthStream RPC in
+	// this tree, so ExecuteHookToStream stands in for a long-lived
+	// stream.
+	ctx := context.Background()
+	stream, err := client.ExecuteHookToStream(ctx, tablet, &hook.Hook{Name: "test"})
+	if err != nil {
+		t.Fatalf("ExecuteHookToStream failed: %v", err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("ExecuteHookToStream first Recv failed: %v", err)
+	}
+	streamErr := make(chan error, 1)
+	go func() {
+		_, err := stream.Recv()
+		streamErr <- err
+	}()
+
+	// A slow unary call that is in flight.
+	sleepErr := make(chan error, 1)
+	go func() {
+		sleepErr <- client.Sleep(ctx, tablet, time.Hour)
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	rootCancel()
+	for name, c := range map[string]chan error{
+		"Sleep":                    sleepErr,
+		"ExecuteHookToStream Recv": streamErr,
+	} {
+		select {
+		case err := <-c:
+			if err == nil {
+				t.Errorf("%v returned no error after the root context was canceled", name)
+			}
+		case <-time.After(5 * time.Second):
+			t.Errorf("%v was not aborted by canceling the root context", name)
+		}
+	}
+
+	// New RPCs fail right away.
+	if err := client.Ping(ctx, tablet); err == nil {
+		t.Errorf("Ping worked after the root context was canceled")
+	}
+	if _, err := client.ExecuteFetchAsApp(ctx, tablet, true, []byte("SELECT 1"), 1); err == nil {
+		t.Errorf("ExecuteFetchAsApp with a pool worked after the root context was canceled")
+	}
+}
+
+// backupLimitsClient counts the GetBackupLimits calls.
+type backupLimitsClient struct {
+	tabletmanagerservicepb.TabletManagerClient
+	calls int
+}
+
+func (c *backupLimitsClient) GetBackupLimits(ctx context.Context, in *tabletmanagerdatapb.GetBackupLimitsRequest, opts ...grpc.CallOption) (*tabletmanagerdatapb.GetBackupLimitsResponse, error) {
+	c.calls++
+	return &tabletmanagerdatapb.GetBackupLimitsResponse{
+		DefaultConcurrency: 4,
+		MaxConcurrency:     8,
+	}, nil
+}
+
+func TestBackupConcurrencyCache(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient()
+	c := &backupLimitsClient{}
+	tablet := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "test",
+			Uid:  123,
+		},
+	}
+	backupConcurrency := func(concurrency, want, wantCalls int) {
+		got, err := client.backupConcurrency(ctx, c, tablet, concurrency)
+		if err != nil || got != want {
+			t.Errorf("backupConcurrency(%v) = (%v, %v), want (%v, nil)", concurrency, got, err, want)
+		}
+		if c.calls != wantCalls {
+			t.Errorf("backupConcurrency(%v) made %v GetBackupLimits calls, want %v", concurrency, c.calls, wantCalls)
+		}
+	}
+
+	// The limits are asked once, then cached.
+	backupConcurrency(0, 4, 1)
+	backupConcurrency(16, 8, 1)
+
+	// A failed backup invalidates the cache.
+	client.forgetBackupLimits(tablet)
+	backupConcurrency(2, 2, 2)
+
+	// So does the TTL.
+	alias := "test-0000000123"
+	client.mu.Lock()
+	cached := client.backupLimits[alias]
+	cached.fetched = cached.fetched.Add(-2 * backupLimitsTTL)
+	client.backupLimits[alias] = cached
+	client.mu.Unlock()
+	backupConcurrency(0, 4, 3)
+	backupConcurrency(0, 4, 3)
+}
@@ -9,6 +9,7 @@ import (
 
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	"github.com/youtube/vitess/go/vt/callinfo"
 	"github.com/youtube/vitess/go/vt/hook"
@@ -16,9 +17,12 @@ import (
 	"github.com/youtube/vitess/go/vt/mysqlctl/tmutils"
 	"github.com/youtube/vitess/go/vt/servenv"
 	"github.com/youtube/vitess/go/vt/tabletmanager"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
 	"github.com/youtube/vitess/go/vt/vterrors"
 
+	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	logutilpb "github.com/youtube/vitess/go/vt/proto/logutil"
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
 	tabletmanagerservicepb "github.com/youtube/vitess/go/vt/proto/tabletmanagerservice"
 )
@@ -31,6 +35,7 @@ type server struct {
 
 func (s *server) Ping(ctx context.Context, request *tabletmanagerdatapb.PingRequest) (response *tabletmanagerdatapb.PingResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "Ping", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("Ping")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.PingResponse{
 		Payload: s.agent.Ping(ctx, request.Payload),
@@ -40,6 +45,7 @@ func (s *server) Ping(ctx context.Context, request *tabletmanagerdatapb.PingRequ
 
 func (s *server) Sleep(ctx context.Context, request *tabletmanagerdatapb.SleepRequest) (response *tabletmanagerdatapb.SleepResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "Sleep", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("Sleep")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.SleepResponse{}
 	s.agent.Sleep(ctx, time.Duration(request.Duration))
@@ -48,6 +54,7 @@ func (s *server) Sleep(ctx context.Context, request *tabletmanagerdatapb.SleepRe
 
 func (s *server) ExecuteHook(ctx context.Context, request *tabletmanagerdatapb.ExecuteHookRequest) (response *tabletmanagerdatapb.ExecuteHookResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "ExecuteHook", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("ExecuteHook")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.ExecuteHookResponse{}
 	hr := s.agent.ExecuteHook(ctx, &hook.Hook{
@@ -61,10 +68,31 @@ func (s *server) ExecuteHook(ctx context.Context, request *tabletmanagerdatapb.E
 	return response, nil
 }
 
+func (s *server) ExecuteHookToStream(request *tabletmanagerdatapb.ExecuteHookToStreamRequest, stream tabletmanagerservicepb.TabletManager_ExecuteHookToStreamServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "ExecuteHookToStream", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("ExecuteHookToStream")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.agent.ExecuteHookToStream(ctx, &hook.Hook{
+		Name:       request.Name,
+		Parameters: request.Parameters,
+		ExtraEnv:   request.ExtraEnv,
+	}, stream.Send)
+}
+
 func (s *server) GetSchema(ctx context.Context, request *tabletmanagerdatapb.GetSchemaRequest) (response *tabletmanagerdatapb.GetSchemaResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "GetSchema", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetSchema")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.GetSchemaResponse{}
+	if request.BestEffortTimeoutNs > 0 {
+		sd, incomplete, err := s.agent.GetSchemaBestEffort(ctx, request.Tables, request.ExcludeTables, request.IncludeViews, time.Duration(request.BestEffortTimeoutNs))
+		if err == nil {
+			response.SchemaDefinition = sd
+			response.Incomplete = incomplete
+		}
+		return response, err
+	}
 	sd, err := s.agent.GetSchema(ctx, request.Tables, request.ExcludeTables, request.IncludeViews)
 	if err == nil {
 		response.SchemaDefinition = sd
@@ -72,8 +100,36 @@ func (s *server) GetSchema(ctx context.Context, request *tabletmanagerdatapb.Get
 	return response, err
 }
 
+func (s *server) GetCreateStatements(ctx context.Context, request *tabletmanagerdatapb.GetCreateStatementsRequest) (response *tabletmanagerdatapb.GetCreateStatementsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetCreateStatements", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetCreateStatements")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetCreateStatementsResponse{}
+	statements, err := s.agent.GetCreateStatements(ctx, request.Tables, request.ExcludeTables, request.IncludeViews)
+	if err == nil {
+		response.CreateStatements = statements
+	}
+	return response, err
+}
+
+func (s *server) GetSchemaTimestamps(ctx context.Context, request *tabletmanagerdatapb.GetSchemaTimestampsRequest) (response *tabletmanagerdatapb.GetSchemaTimestampsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetSchemaTimestamps", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetSchemaTimestamps")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetSchemaTimestampsResponse{}
+	timestamps, err := s.agent.GetSchemaTimestamps(ctx, request.Tables)
+	if err == nil {
+		response.Timestamps = make(map[string]int64, len(timestamps))
+		for table, ts := range timestamps {
+			response.Timestamps[table] = ts.Unix()
+		}
+	}
+	return response, err
+}
+
 func (s *server) GetPermissions(ctx context.Context, request *tabletmanagerdatapb.GetPermissionsRequest) (response *tabletmanagerdatapb.GetPermissionsResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "GetPermissions", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetPermissions")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.GetPermissionsResponse{}
 	p, err := s.agent.GetPermissions(ctx)
@@ -83,12 +139,144 @@ func (s *server) GetPermissions(ctx context.Context, request *tabletmanagerdatap
 	return response, err
 }
 
+func (s *server) GetConnectionStats(ctx context.Context, request *tabletmanagerdatapb.GetConnectionStatsRequest) (response *tabletmanagerdatapb.GetConnectionStatsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetConnectionStats", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetConnectionStats")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetConnectionStatsResponse{}
+	cs, err := s.agent.GetConnectionStats(ctx)
+	if err == nil {
+		response.ConnectionStats = cs
+	}
+	return response, err
+}
+
+func (s *server) GetConnectionRejectionStats(ctx context.Context, request *tabletmanagerdatapb.GetConnectionRejectionStatsRequest) (response *tabletmanagerdatapb.GetConnectionRejectionStatsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetConnectionRejectionStats", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetConnectionRejectionStats")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetConnectionRejectionStatsResponse{}
+	rejections, err := s.agent.GetConnectionRejectionStats(ctx, request.ResetCounts)
+	if err == nil {
+		response.Rejections = rejections
+	}
+	return response, err
+}
+
+func (s *server) GetConfig(ctx context.Context, request *tabletmanagerdatapb.GetConfigRequest) (response *tabletmanagerdatapb.GetConfigResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetConfig", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetConfig")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetConfigResponse{}
+	flags, err := s.agent.GetConfig(ctx)
+	if err == nil {
+		response.Flags = flags
+	}
+	return response, err
+}
+
+func (s *server) GetInFlightRPCs(ctx context.Context, request *tabletmanagerdatapb.GetInFlightRPCsRequest) (response *tabletmanagerdatapb.GetInFlightRPCsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetInFlightRPCs", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetInFlightRPCsResponse{}
+	inFlight, err := s.agent.GetInFlightRPCs(ctx)
+	if err == nil {
+		response.InFlight = inFlight
+	}
+	return response, err
+}
+
+func (s *server) GetProcessStats(ctx context.Context, request *tabletmanagerdatapb.GetProcessStatsRequest) (response *tabletmanagerdatapb.GetProcessStatsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetProcessStats", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetProcessStats")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetProcessStatsResponse{}
+	stats, err := s.agent.GetProcessStats(ctx)
+	if err == nil {
+		response.Stats = stats
+	}
+	return response, err
+}
+
+func (s *server) GetHealthScore(ctx context.Context, request *tabletmanagerdatapb.GetHealthScoreRequest) (response *tabletmanagerdatapb.GetHealthScoreResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetHealthScore", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetHealthScore")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetHealthScoreResponse{}
+	score, err := s.agent.GetHealthScore(ctx)
+	if err == nil {
+		response.Score = score
+	}
+	return response, err
+}
+
+func (s *server) GetErrorLogTail(ctx context.Context, request *tabletmanagerdatapb.GetErrorLogTailRequest) (response *tabletmanagerdatapb.GetErrorLogTailResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetErrorLogTail", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetErrorLogTail")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetErrorLogTailResponse{}
+	lines, err := s.agent.GetErrorLogTail(ctx, int(request.Lines))
+	if err == nil {
+		response.Lines = lines
+	}
+	return response, err
+}
+
+func (s *server) CaptureDiagnostics(ctx context.Context, request *tabletmanagerdatapb.CaptureDiagnosticsRequest) (response *tabletmanagerdatapb.CaptureDiagnosticsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "CaptureDiagnostics", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("CaptureDiagnostics")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.CaptureDiagnosticsResponse{}
+	bundle, err := s.agent.CaptureDiagnostics(ctx)
+	if err == nil {
+		response.Bundle = bundle
+	}
+	return response, err
+}
+
+func (s *server) CheckDataDir(ctx context.Context, request *tabletmanagerdatapb.CheckDataDirRequest) (response *tabletmanagerdatapb.CheckDataDirResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "CheckDataDir", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("CheckDataDir")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.CheckDataDirResponse{}
+	problems, err := s.agent.CheckDataDir(ctx)
+	if err == nil {
+		response.Problems = problems
+	}
+	return response, err
+}
+
+func (s *server) GetThrottleState(ctx context.Context, request *tabletmanagerdatapb.GetThrottleStateRequest) (response *tabletmanagerdatapb.GetThrottleStateResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetThrottleState", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetThrottleState")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetThrottleStateResponse{}
+	state, err := s.agent.GetThrottleState(ctx)
+	if err == nil {
+		response.State = state
+	}
+	return response, err
+}
+
+func (s *server) GetTabletTags(ctx context.Context, request *tabletmanagerdatapb.GetTabletTagsRequest) (response *tabletmanagerdatapb.GetTabletTagsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetTabletTags", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetTabletTags")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetTabletTagsResponse{}
+	tags, err := s.agent.GetTabletTags(ctx)
+	if err == nil {
+		response.Tags = tags
+	}
+	return response, err
+}
+
 //
 // Various read-write methods
 //
 
 func (s *server) SetReadOnly(ctx context.Context, request *tabletmanagerdatapb.SetReadOnlyRequest) (response *tabletmanagerdatapb.SetReadOnlyResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "SetReadOnly", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetReadOnly")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.SetReadOnlyResponse{}
 	return response, s.agent.SetReadOnly(ctx, true)
@@ -96,27 +284,104 @@ func (s *server) SetReadOnly(ctx context.Context, request *tabletmanagerdatapb.S
 
 func (s *server) SetReadWrite(ctx context.Context, request *tabletmanagerdatapb.SetReadWriteRequest) (response *tabletmanagerdatapb.SetReadWriteResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "SetReadWrite", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetReadWrite")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.SetReadWriteResponse{}
 	return response, s.agent.SetReadOnly(ctx, false)
 }
 
+func (s *server) SetReadOnlyWithTTL(ctx context.Context, request *tabletmanagerdatapb.SetReadOnlyWithTTLRequest) (response *tabletmanagerdatapb.SetReadOnlyWithTTLResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetReadOnlyWithTTL", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetReadOnlyWithTTL")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetReadOnlyWithTTLResponse{}
+	return response, s.agent.SetReadOnlyWithTTL(ctx, time.Duration(request.TtlNs))
+}
+
+func (s *server) SetSuperReadOnly(ctx context.Context, request *tabletmanagerdatapb.SetSuperReadOnlyRequest) (response *tabletmanagerdatapb.SetSuperReadOnlyResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetSuperReadOnly", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetSuperReadOnly")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetSuperReadOnlyResponse{}
+	superReadOnly, err := s.agent.SetSuperReadOnly(ctx, request.On)
+	if err == nil {
+		response.SuperReadOnly = superReadOnly
+	}
+	return response, err
+}
+
+func (s *server) SetQueryServerConfig(ctx context.Context, request *tabletmanagerdatapb.SetQueryServerConfigRequest) (response *tabletmanagerdatapb.SetQueryServerConfigResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetQueryServerConfig", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetQueryServerConfig")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetQueryServerConfigResponse{}
+	applied, skipped, err := s.agent.SetQueryServerConfig(ctx, request.Config)
+	if err == nil {
+		response.Applied = applied
+		response.Skipped = skipped
+	}
+	return response, err
+}
+
+func (s *server) GetQueryBlacklist(ctx context.Context, request *tabletmanagerdatapb.GetQueryBlacklistRequest) (response *tabletmanagerdatapb.GetQueryBlacklistResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetQueryBlacklist", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetQueryBlacklist")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetQueryBlacklistResponse{}
+	patterns, err := s.agent.GetQueryBlacklist(ctx)
+	if err == nil {
+		response.Patterns = patterns
+	}
+	return response, err
+}
+
+func (s *server) SetQueryBlacklist(ctx context.Context, request *tabletmanagerdatapb.SetQueryBlacklistRequest) (response *tabletmanagerdatapb.SetQueryBlacklistResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetQueryBlacklist", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetQueryBlacklist")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetQueryBlacklistResponse{}
+	return response, s.agent.SetQueryBlacklist(ctx, request.Patterns)
+}
+
 func (s *server) ChangeType(ctx context.Context, request *tabletmanagerdatapb.ChangeTypeRequest) (response *tabletmanagerdatapb.ChangeTypeResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "ChangeType", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("ChangeType")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.ChangeTypeResponse{}
-	return response, s.agent.ChangeType(ctx, request.TabletType)
+	return response, s.agent.ChangeType(ctx, request.TabletType, request.Idempotent)
+}
+
+func (s *server) SetTabletTags(ctx context.Context, request *tabletmanagerdatapb.SetTabletTagsRequest) (response *tabletmanagerdatapb.SetTabletTagsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetTabletTags", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetTabletTags")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetTabletTagsResponse{}
+	return response, s.agent.SetTabletTags(ctx, request.Tags)
 }
 
 func (s *server) RefreshState(ctx context.Context, request *tabletmanagerdatapb.RefreshStateRequest) (response *tabletmanagerdatapb.RefreshStateResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "RefreshState", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("RefreshState")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.RefreshStateResponse{}
 	return response, s.agent.RefreshState(ctx)
 }
 
+func (s *server) RepublishTopoRecord(ctx context.Context, request *tabletmanagerdatapb.RepublishTopoRecordRequest) (response *tabletmanagerdatapb.RepublishTopoRecordResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "RepublishTopoRecord", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("RepublishTopoRecord")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.RepublishTopoRecordResponse{}
+	version, err := s.agent.RepublishTopoRecord(ctx)
+	if err == nil {
+		response.Version = version
+	}
+	return response, err
+}
+
 func (s *server) RunHealthCheck(ctx context.Context, request *tabletmanagerdatapb.RunHealthCheckRequest) (response *tabletmanagerdatapb.RunHealthCheckResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "RunHealthCheck", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("RunHealthCheck")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.RunHealthCheckResponse{}
 	s.agent.RunHealthCheck(ctx)
@@ -125,13 +390,132 @@ func (s *server) RunHealthCheck(ctx context.Context, request *tabletmanagerdatap
 
 func (s *server) IgnoreHealthError(ctx context.Context, request *tabletmanagerdatapb.IgnoreHealthErrorRequest) (response *tabletmanagerdatapb.IgnoreHealthErrorResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "IgnoreHealthError", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("IgnoreHealthError")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.IgnoreHealthErrorResponse{}
 	return response, s.agent.IgnoreHealthError(ctx, request.Pattern)
 }
 
+func (s *server) SetMaintenanceMode(ctx context.Context, request *tabletmanagerdatapb.SetMaintenanceModeRequest) (response *tabletmanagerdatapb.SetMaintenanceModeResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetMaintenanceMode", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetMaintenanceMode")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetMaintenanceModeResponse{}
+	return response, s.agent.SetMaintenanceMode(ctx, request.On, request.Reason)
+}
+
+func (s *server) PauseHealthReporting(ctx context.Context, request *tabletmanagerdatapb.PauseHealthReportingRequest) (response *tabletmanagerdatapb.PauseHealthReportingResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "PauseHealthReporting", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("PauseHealthReporting")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.PauseHealthReportingResponse{}
+	return response, s.agent.PauseHealthReporting(ctx, request.On)
+}
+
+func (s *server) SetThrottle(ctx context.Context, request *tabletmanagerdatapb.SetThrottleRequest) (response *tabletmanagerdatapb.SetThrottleResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetThrottle", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetThrottle")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetThrottleResponse{}
+	return response, s.agent.SetThrottle(ctx, request.Mode, time.Duration(request.DurationNs))
+}
+
+func (s *server) PrepareCutover(ctx context.Context, request *tabletmanagerdatapb.PrepareCutoverRequest) (response *tabletmanagerdatapb.PrepareCutoverResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "PrepareCutover", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("PrepareCutover")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.PrepareCutoverResponse{}
+	token, err := s.agent.PrepareCutover(ctx)
+	if err == nil {
+		response.Token = token
+	}
+	return response, err
+}
+
+func (s *server) CommitCutover(ctx context.Context, request *tabletmanagerdatapb.CommitCutoverRequest) (response *tabletmanagerdatapb.CommitCutoverResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "CommitCutover", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("CommitCutover")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.CommitCutoverResponse{}
+	return response, s.agent.CommitCutover(ctx, request.Token)
+}
+
+func (s *server) AbortCutover(ctx context.Context, request *tabletmanagerdatapb.AbortCutoverRequest) (response *tabletmanagerdatapb.AbortCutoverResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "AbortCutover", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("AbortCutover")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.AbortCutoverResponse{}
+	return response, s.agent.AbortCutover(ctx, request.Token)
+}
+
+func (s *server) SetServingKeyRange(ctx context.Context, request *tabletmanagerdatapb.SetServingKeyRangeRequest) (response *tabletmanagerdatapb.SetServingKeyRangeResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetServingKeyRange", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetServingKeyRange")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetServingKeyRangeResponse{}
+	return response, s.agent.SetServingKeyRange(ctx, request.KeyRange, request.ServedTypes)
+}
+
+func (s *server) RestartMysql(request *tabletmanagerdatapb.RestartMysqlRequest, stream tabletmanagerservicepb.TabletManager_RestartMysqlServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "RestartMysql", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("RestartMysql")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+
+	// create a logger, send the result back to the caller
+	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		// If the client disconnects, we will just fail
+		// to send the log events, but won't interrupt
+		// the restart.
+		stream.Send(&tabletmanagerdatapb.RestartMysqlResponse{
+			Event: e,
+		})
+	})
+
+	return s.agent.RestartMysql(ctx, time.Duration(request.HealthyTimeoutNs), logger)
+}
+
+func (s *server) Decommission(request *tabletmanagerdatapb.DecommissionRequest, stream tabletmanagerservicepb.TabletManager_DecommissionServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "Decommission", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("Decommission")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+
+	// create a logger, send the result back to the caller
+	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		// If the client disconnects, we will just fail
+		// to send the log events, but won't interrupt
+		// the decommission.
+		stream.Send(&tabletmanagerdatapb.DecommissionResponse{
+			Event: e,
+		})
+	})
+
+	return s.agent.Decommission(ctx, request.StopReplication, request.StopMysqld, logger)
+}
+
+func (s *server) CheckTopoConnectivity(ctx context.Context, request *tabletmanagerdatapb.CheckTopoConnectivityRequest) (response *tabletmanagerdatapb.CheckTopoConnectivityResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "CheckTopoConnectivity", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("CheckTopoConnectivity")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	reachable, latency := s.agent.CheckTopoConnectivity(ctx)
+	return &tabletmanagerdatapb.CheckTopoConnectivityResponse{
+		Reachable: reachable,
+		LatencyNs: int64(latency),
+	}, nil
+}
+
+func (s *server) WarmUp(request *tabletmanagerdatapb.WarmUpRequest, stream tabletmanagerservicepb.TabletManager_WarmUpServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "WarmUp", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("WarmUp")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.agent.WarmUp(ctx, request.Queries, request.LoadBufferPool, stream.Send)
+}
+
 func (s *server) ReloadSchema(ctx context.Context, request *tabletmanagerdatapb.ReloadSchemaRequest) (response *tabletmanagerdatapb.ReloadSchemaResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "ReloadSchema", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("ReloadSchema")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.ReloadSchemaResponse{}
 	return response, s.agent.ReloadSchema(ctx, request.WaitPosition)
@@ -139,6 +523,7 @@ func (s *server) ReloadSchema(ctx context.Context, request *tabletmanagerdatapb.
 
 func (s *server) PreflightSchema(ctx context.Context, request *tabletmanagerdatapb.PreflightSchemaRequest) (response *tabletmanagerdatapb.PreflightSchemaResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "PreflightSchema", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("PreflightSchema")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.PreflightSchemaResponse{}
 	results, err := s.agent.PreflightSchema(ctx, request.Changes)
@@ -150,6 +535,7 @@ func (s *server) PreflightSchema(ctx context.Context, request *tabletmanagerdata
 
 func (s *server) ApplySchema(ctx context.Context, request *tabletmanagerdatapb.ApplySchemaRequest) (response *tabletmanagerdatapb.ApplySchemaResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "ApplySchema", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("ApplySchema")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.ApplySchemaResponse{}
 	scr, err := s.agent.ApplySchema(ctx, &tmutils.SchemaChange{
@@ -166,20 +552,93 @@ func (s *server) ApplySchema(ctx context.Context, request *tabletmanagerdatapb.A
 	return response, err
 }
 
+func (s *server) SchemaDiff(ctx context.Context, request *tabletmanagerdatapb.SchemaDiffRequest) (response *tabletmanagerdatapb.SchemaDiffResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SchemaDiff", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("SchemaDiff")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SchemaDiffResponse{}
+	statements, err := s.agent.SchemaDiff(ctx, request.Desired)
+	if err == nil {
+		response.Statements = statements
+	}
+	return response, err
+}
+
+func (s *server) AssessSchemaChange(ctx context.Context, request *tabletmanagerdatapb.AssessSchemaChangeRequest) (response *tabletmanagerdatapb.AssessSchemaChangeResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "AssessSchemaChange", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("AssessSchemaChange")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.AssessSchemaChangeResponse{}
+	assessment, err := s.agent.AssessSchemaChange(ctx, request.Change)
+	if err == nil {
+		response.Assessment = assessment
+	}
+	return response, err
+}
+
+func (s *server) WatchSchema(request *tabletmanagerdatapb.WatchSchemaRequest, stream tabletmanagerservicepb.TabletManager_WatchSchemaServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "WatchSchema", request, nil, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("WatchSchema")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return vterrors.ToGRPCError(s.agent.WatchSchema(ctx, func(event *tabletmanagerdatapb.SchemaChangeEvent) error {
+		return stream.Send(&tabletmanagerdatapb.WatchSchemaResponse{
+			Event: event,
+		})
+	}))
+}
+
+func (s *server) GetVSchema(ctx context.Context, request *tabletmanagerdatapb.GetVSchemaRequest) (response *tabletmanagerdatapb.GetVSchemaResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetVSchema", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetVSchema")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetVSchemaResponse{}
+	vschema, err := s.agent.GetVSchema(ctx)
+	if err == nil {
+		response.Vschema = vschema
+	}
+	return response, err
+}
+
+func (s *server) ApplyVSchema(ctx context.Context, request *tabletmanagerdatapb.ApplyVSchemaRequest) (response *tabletmanagerdatapb.ApplyVSchemaResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "ApplyVSchema", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("ApplyVSchema")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ApplyVSchemaResponse{}
+	return response, s.agent.ApplyVSchema(ctx, request.Vschema)
+}
+
 func (s *server) ExecuteFetchAsDba(ctx context.Context, request *tabletmanagerdatapb.ExecuteFetchAsDbaRequest) (response *tabletmanagerdatapb.ExecuteFetchAsDbaResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "ExecuteFetchAsDba", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("ExecuteFetchAsDba")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.ExecuteFetchAsDbaResponse{}
-	qr, err := s.agent.ExecuteFetchAsDba(ctx, request.Query, request.DbName, int(request.MaxRows), request.DisableBinlogs, request.ReloadSchema)
+	qr, err := s.agent.ExecuteFetchAsDba(ctx, request.Query, request.DbName, int(request.MaxRows), request.DisableBinlogs, request.ReloadSchema, time.Duration(request.MaxExecTimeNs))
 	if err != nil {
+		if err == tmclient.ErrMaxExecTimeExceeded {
+			return nil, grpc.Errorf(codes.DeadlineExceeded, "%v", err)
+		}
 		return nil, vterrors.ToGRPCError(err)
 	}
 	response.Result = qr
 	return response, nil
 }
 
+func (s *server) ExecuteFetchAsDbaCSV(request *tabletmanagerdatapb.ExecuteFetchAsDbaCSVRequest, stream tabletmanagerservicepb.TabletManager_ExecuteFetchAsDbaCSVServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "ExecuteFetchAsDbaCSV", request, nil, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("ExecuteFetchAsDbaCSV")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return vterrors.ToGRPCError(s.agent.ExecuteFetchAsDbaCSV(ctx, request.Query, request.DbName, func(data []byte) error {
+		return stream.Send(&tabletmanagerdatapb.ExecuteFetchAsDbaCSVResponse{
+			Data: data,
+		})
+	}))
+}
+
 func (s *server) ExecuteFetchAsAllPrivs(ctx context.Context, request *tabletmanagerdatapb.ExecuteFetchAsAllPrivsRequest) (response *tabletmanagerdatapb.ExecuteFetchAsAllPrivsResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "ExecuteFetchAsAllPrivs", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("ExecuteFetchAsAllPrivs")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.ExecuteFetchAsAllPrivsResponse{}
 	qr, err := s.agent.ExecuteFetchAsAllPrivs(ctx, request.Query, request.DbName, int(request.MaxRows), request.ReloadSchema)
@@ -190,8 +649,22 @@ func (s *server) ExecuteFetchAsAllPrivs(ctx context.Context, request *tabletmana
 	return response, nil
 }
 
+func (s *server) ExecuteFetchColumnar(ctx context.Context, request *tabletmanagerdatapb.ExecuteFetchColumnarRequest) (response *tabletmanagerdatapb.ExecuteFetchColumnarResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "ExecuteFetchColumnar", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("ExecuteFetchColumnar")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ExecuteFetchColumnarResponse{}
+	qr, err := s.agent.ExecuteFetchColumnar(ctx, request.Query, request.DbName, int(request.MaxRows))
+	if err != nil {
+		return nil, vterrors.ToGRPCError(err)
+	}
+	response.Result = qr
+	return response, nil
+}
+
 func (s *server) ExecuteFetchAsApp(ctx context.Context, request *tabletmanagerdatapb.ExecuteFetchAsAppRequest) (response *tabletmanagerdatapb.ExecuteFetchAsAppResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "ExecuteFetchAsApp", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("ExecuteFetchAsApp")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.ExecuteFetchAsAppResponse{}
 	qr, err := s.agent.ExecuteFetchAsApp(ctx, request.Query, int(request.MaxRows))
@@ -202,12 +675,159 @@ func (s *server) ExecuteFetchAsApp(ctx context.Context, request *tabletmanagerda
 	return response, nil
 }
 
+func (s *server) ExplainQuery(ctx context.Context, request *tabletmanagerdatapb.ExplainQueryRequest) (response *tabletmanagerdatapb.ExplainQueryResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "ExplainQuery", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("ExplainQuery")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ExplainQueryResponse{}
+	qr, err := s.agent.ExplainQuery(ctx, request.Query, request.Format)
+	if err == nil {
+		response.Result = qr
+	}
+	return response, err
+}
+
+func (s *server) ApplyGrants(ctx context.Context, request *tabletmanagerdatapb.ApplyGrantsRequest) (response *tabletmanagerdatapb.ApplyGrantsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "ApplyGrants", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("ApplyGrants")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ApplyGrantsResponse{}
+	return response, s.agent.ApplyGrants(ctx, request.Statements, request.Atomic)
+}
+
+func (s *server) ChecksumTable(ctx context.Context, request *tabletmanagerdatapb.ChecksumTableRequest) (response *tabletmanagerdatapb.ChecksumTableResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "ChecksumTable", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("ChecksumTable")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ChecksumTableResponse{}
+	checksum, rowCount, err := s.agent.ChecksumTable(ctx, request.Table, request.KeyRange)
+	if err != nil {
+		return nil, vterrors.ToGRPCError(err)
+	}
+	response.Checksum = checksum
+	response.RowCount = rowCount
+	return response, nil
+}
+
+func (s *server) TruncateTable(ctx context.Context, request *tabletmanagerdatapb.TruncateTableRequest) (response *tabletmanagerdatapb.TruncateTableResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "TruncateTable", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("TruncateTable")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.TruncateTableResponse{}
+	return response, s.agent.TruncateTable(ctx, request.Table, request.ConfirmKeyspace)
+}
+
+func (s *server) RenameTable(ctx context.Context, request *tabletmanagerdatapb.RenameTableRequest) (response *tabletmanagerdatapb.RenameTableResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "RenameTable", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("RenameTable")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.RenameTableResponse{}
+	result, err := s.agent.RenameTable(ctx, request.FromTable, request.ToTable, request.Cascade)
+	if err == nil {
+		response.Result = result
+	}
+	return response, err
+}
+
+func (s *server) StreamRowsInKeyRange(request *tabletmanagerdatapb.StreamRowsInKeyRangeRequest, stream tabletmanagerservicepb.TabletManager_StreamRowsInKeyRangeServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "StreamRowsInKeyRange", request, nil, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("StreamRowsInKeyRange")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return vterrors.ToGRPCError(s.agent.StreamRowsInKeyRange(ctx, request.Table, request.KeyRange, func(result *querypb.QueryResult) error {
+		return stream.Send(&tabletmanagerdatapb.StreamRowsInKeyRangeResponse{
+			Result: result,
+		})
+	}))
+}
+
+func (s *server) StreamKeyRangeBinlog(request *tabletmanagerdatapb.StreamKeyRangeBinlogRequest, stream tabletmanagerservicepb.TabletManager_StreamKeyRangeBinlogServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "StreamKeyRangeBinlog", request, nil, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("StreamKeyRangeBinlog")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return vterrors.ToGRPCError(s.agent.StreamKeyRangeBinlog(ctx, request.KeyRange, request.Position, request.Charset, func(transaction *binlogdatapb.BinlogTransaction) error {
+		return stream.Send(&tabletmanagerdatapb.StreamKeyRangeBinlogResponse{
+			BinlogTransaction: transaction,
+		})
+	}))
+}
+
+func (s *server) CloneStream(request *tabletmanagerdatapb.CloneStreamRequest, stream tabletmanagerservicepb.TabletManager_CloneStreamServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "CloneStream", request, nil, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("CloneStream")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return vterrors.ToGRPCError(s.agent.CloneStream(ctx, request.Tables, int(request.BufferSize), stream.Send))
+}
+
+func (s *server) GetCharsetConfig(ctx context.Context, request *tabletmanagerdatapb.GetCharsetConfigRequest) (response *tabletmanagerdatapb.GetCharsetConfigResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetCharsetConfig", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetCharsetConfig")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetCharsetConfigResponse{}
+	config, err := s.agent.GetCharsetConfig(ctx)
+	if err == nil {
+		response.Config = config
+	}
+	return response, err
+}
+
+func (s *server) GetProcessList(ctx context.Context, request *tabletmanagerdatapb.GetProcessListRequest) (response *tabletmanagerdatapb.GetProcessListResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetProcessList", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetProcessList")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetProcessListResponse{}
+	processes, err := s.agent.GetProcessList(ctx)
+	if err != nil {
+		return nil, vterrors.ToGRPCError(err)
+	}
+	response.Processes = processes
+	return response, nil
+}
+
+func (s *server) KillProcess(ctx context.Context, request *tabletmanagerdatapb.KillProcessRequest) (response *tabletmanagerdatapb.KillProcessResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "KillProcess", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("KillProcess")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.KillProcessResponse{}
+	if err := s.agent.KillProcess(ctx, request.Id); err != nil {
+		return nil, vterrors.ToGRPCError(err)
+	}
+	return response, nil
+}
+
+func (s *server) TailGeneralLog(request *tabletmanagerdatapb.TailGeneralLogRequest, stream tabletmanagerservicepb.TabletManager_TailGeneralLogServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "TailGeneralLog", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("TailGeneralLog")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return vterrors.ToGRPCError(s.agent.TailGeneralLog(ctx, time.Duration(request.DurationNs), func(entry string) error {
+		return stream.Send(&tabletmanagerdatapb.TailGeneralLogResponse{
+			Entry: entry,
+		})
+	}))
+}
+
+func (s *server) StreamQPS(request *tabletmanagerdatapb.StreamQPSRequest, stream tabletmanagerservicepb.TabletManager_StreamQPSServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "StreamQPS", request, nil, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("StreamQPS")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return vterrors.ToGRPCError(s.agent.StreamQPS(ctx, time.Duration(request.IntervalNs), func(sample *tabletmanagerdatapb.QPSSample) error {
+		return stream.Send(&tabletmanagerdatapb.StreamQPSResponse{
+			Sample: sample,
+		})
+	}))
+}
+
 //
 // Replication related methods
 //
 
 func (s *server) SlaveStatus(ctx context.Context, request *tabletmanagerdatapb.SlaveStatusRequest) (response *tabletmanagerdatapb.SlaveStatusResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "SlaveStatus", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("SlaveStatus")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.SlaveStatusResponse{}
 	status, err := s.agent.SlaveStatus(ctx)
@@ -217,8 +837,57 @@ func (s *server) SlaveStatus(ctx context.Context, request *tabletmanagerdatapb.S
 	return response, err
 }
 
+func (s *server) SlaveStatusAllChannels(ctx context.Context, request *tabletmanagerdatapb.SlaveStatusAllChannelsRequest) (response *tabletmanagerdatapb.SlaveStatusAllChannelsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SlaveStatusAllChannels", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("SlaveStatusAllChannels")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SlaveStatusAllChannelsResponse{}
+	statuses, err := s.agent.SlaveStatusAllChannels(ctx)
+	if err == nil {
+		response.Statuses = statuses
+	}
+	return response, err
+}
+
+func (s *server) GetReplicationSource(ctx context.Context, request *tabletmanagerdatapb.GetReplicationSourceRequest) (response *tabletmanagerdatapb.GetReplicationSourceResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetReplicationSource", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetReplicationSource")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetReplicationSourceResponse{}
+	source, err := s.agent.GetReplicationSource(ctx)
+	if err == nil {
+		response.Source = source
+	}
+	return response, err
+}
+
+func (s *server) ValidateReplicationCredentials(ctx context.Context, request *tabletmanagerdatapb.ValidateReplicationCredentialsRequest) (response *tabletmanagerdatapb.ValidateReplicationCredentialsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "ValidateReplicationCredentials", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("ValidateReplicationCredentials")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ValidateReplicationCredentialsResponse{}
+	valid, err := s.agent.ValidateReplicationCredentials(ctx)
+	if err == nil {
+		response.Valid = valid
+	}
+	return response, err
+}
+
+func (s *server) GetApplierQueueStats(ctx context.Context, request *tabletmanagerdatapb.GetApplierQueueStatsRequest) (response *tabletmanagerdatapb.GetApplierQueueStatsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetApplierQueueStats", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetApplierQueueStats")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetApplierQueueStatsResponse{}
+	stats, err := s.agent.GetApplierQueueStats(ctx)
+	if err == nil {
+		response.Stats = stats
+	}
+	return response, err
+}
+
 func (s *server) MasterPosition(ctx context.Context, request *tabletmanagerdatapb.MasterPositionRequest) (response *tabletmanagerdatapb.MasterPositionResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "MasterPosition", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("MasterPosition")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.MasterPositionResponse{}
 	position, err := s.agent.MasterPosition(ctx)
@@ -228,8 +897,46 @@ func (s *server) MasterPosition(ctx context.Context, request *tabletmanagerdatap
 	return response, err
 }
 
+func (s *server) GetGtidPurged(ctx context.Context, request *tabletmanagerdatapb.GetGtidPurgedRequest) (response *tabletmanagerdatapb.GetGtidPurgedResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetGtidPurged", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetGtidPurged")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetGtidPurgedResponse{}
+	position, err := s.agent.GetGtidPurged(ctx)
+	if err == nil {
+		response.Position = position
+	}
+	return response, err
+}
+
+func (s *server) GetBinlogStats(ctx context.Context, request *tabletmanagerdatapb.GetBinlogStatsRequest) (response *tabletmanagerdatapb.GetBinlogStatsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetBinlogStats", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetBinlogStats")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetBinlogStatsResponse{}
+	transactionsPerSecond, window, err := s.agent.GetBinlogStats(ctx)
+	if err == nil {
+		response.TransactionsPerSecond = transactionsPerSecond
+		response.WindowNs = int64(window)
+	}
+	return response, err
+}
+
+func (s *server) GetReplicationErrorStats(ctx context.Context, request *tabletmanagerdatapb.GetReplicationErrorStatsRequest) (response *tabletmanagerdatapb.GetReplicationErrorStatsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetReplicationErrorStats", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetReplicationErrorStats")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetReplicationErrorStatsResponse{}
+	stats, err := s.agent.GetReplicationErrorStats(ctx, request.ResetCounts)
+	if err == nil {
+		response.Stats = stats
+	}
+	return response, err
+}
+
 func (s *server) StopSlave(ctx context.Context, request *tabletmanagerdatapb.StopSlaveRequest) (response *tabletmanagerdatapb.StopSlaveResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "StopSlave", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("StopSlave")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.StopSlaveResponse{}
 	return response, s.agent.StopSlave(ctx)
@@ -237,6 +944,7 @@ func (s *server) StopSlave(ctx context.Context, request *tabletmanagerdatapb.Sto
 
 func (s *server) StopSlaveMinimum(ctx context.Context, request *tabletmanagerdatapb.StopSlaveMinimumRequest) (response *tabletmanagerdatapb.StopSlaveMinimumResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "StopSlaveMinimum", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("StopSlaveMinimum")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.StopSlaveMinimumResponse{}
 	position, err := s.agent.StopSlaveMinimum(ctx, request.Position, time.Duration(request.WaitTimeout))
@@ -246,18 +954,80 @@ func (s *server) StopSlaveMinimum(ctx context.Context, request *tabletmanagerdat
 	return response, err
 }
 
+func (s *server) StopSlaveMinimumStream(request *tabletmanagerdatapb.StopSlaveMinimumStreamRequest, stream tabletmanagerservicepb.TabletManager_StopSlaveMinimumStreamServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "StopSlaveMinimumStream", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("StopSlaveMinimumStream")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.agent.StopSlaveMinimumStream(ctx, request.Position, time.Duration(request.WaitTimeout), stream.Send)
+}
+
+func (s *server) BoostReplicationCatchup(request *tabletmanagerdatapb.BoostReplicationCatchupRequest, stream tabletmanagerservicepb.TabletManager_BoostReplicationCatchupServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "BoostReplicationCatchup", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("BoostReplicationCatchup")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.agent.BoostReplicationCatchup(ctx, time.Duration(request.Duration), stream.Send)
+}
+
 func (s *server) StartSlave(ctx context.Context, request *tabletmanagerdatapb.StartSlaveRequest) (response *tabletmanagerdatapb.StartSlaveResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "StartSlave", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("StartSlave")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.StartSlaveResponse{}
 	return response, s.agent.StartSlave(ctx)
 }
 
+func (s *server) EnableParallelReplication(ctx context.Context, request *tabletmanagerdatapb.EnableParallelReplicationRequest) (response *tabletmanagerdatapb.EnableParallelReplicationResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "EnableParallelReplication", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("EnableParallelReplication")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.EnableParallelReplicationResponse{}
+	return response, s.agent.EnableParallelReplication(ctx, int(request.Workers), request.ParallelType)
+}
+
+func (s *server) RotateReplicationCredentials(ctx context.Context, request *tabletmanagerdatapb.RotateReplicationCredentialsRequest) (response *tabletmanagerdatapb.RotateReplicationCredentialsResponse, err error) {
+	// Not verbose, so the password is not logged.
+	defer s.agent.HandleRPCPanic(ctx, "RotateReplicationCredentials", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("RotateReplicationCredentials")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.RotateReplicationCredentialsResponse{}
+	return response, s.agent.RotateReplicationCredentials(ctx, request.User, request.Password)
+}
+
+func (s *server) ConfigureReplicationSSL(ctx context.Context, request *tabletmanagerdatapb.ConfigureReplicationSSLRequest) (response *tabletmanagerdatapb.ConfigureReplicationSSLResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "ConfigureReplicationSSL", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("ConfigureReplicationSSL")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ConfigureReplicationSSLResponse{}
+	return response, s.agent.ConfigureReplicationSSL(ctx, request.Options)
+}
+
+func (s *server) RepairRelayLog(request *tabletmanagerdatapb.RepairRelayLogRequest, stream tabletmanagerservicepb.TabletManager_RepairRelayLogServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "RepairRelayLog", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("RepairRelayLog")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+
+	// create a logger, send the result back to the caller
+	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		// If the client disconnects, we will just fail
+		// to send the log events, but won't interrupt
+		// the repair.
+		stream.Send(&tabletmanagerdatapb.RepairRelayLogResponse{
+			Event: e,
+		})
+	})
+
+	return s.agent.RepairRelayLog(ctx, logger)
+}
+
 func (s *server) TabletExternallyReparented(ctx context.Context, request *tabletmanagerdatapb.TabletExternallyReparentedRequest) (response *tabletmanagerdatapb.TabletExternallyReparentedResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "TabletExternallyReparented", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("TabletExternallyReparented")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.TabletExternallyReparentedResponse{}
-	return response, s.agent.TabletExternallyReparented(ctx, request.ExternalId)
+	return response, s.agent.TabletExternallyReparented(ctx, request.ExternalId, request.Validate)
 }
 
 func (s *server) TabletExternallyElected(ctx context.Context, request *tabletmanagerdatapb.TabletExternallyElectedRequest) (*tabletmanagerdatapb.TabletExternallyElectedResponse, error) {
@@ -266,6 +1036,7 @@ func (s *server) TabletExternallyElected(ctx context.Context, request *tabletman
 
 func (s *server) GetSlaves(ctx context.Context, request *tabletmanagerdatapb.GetSlavesRequest) (response *tabletmanagerdatapb.GetSlavesResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "GetSlaves", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetSlaves")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.GetSlavesResponse{}
 	addrs, err := s.agent.GetSlaves(ctx)
@@ -275,8 +1046,21 @@ func (s *server) GetSlaves(ctx context.Context, request *tabletmanagerdatapb.Get
 	return response, err
 }
 
+func (s *server) GetReplicationGraph(ctx context.Context, request *tabletmanagerdatapb.GetReplicationGraphRequest) (response *tabletmanagerdatapb.GetReplicationGraphResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetReplicationGraph", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetReplicationGraph")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetReplicationGraphResponse{}
+	graph, err := s.agent.GetReplicationGraph(ctx)
+	if err == nil {
+		response.Graph = graph
+	}
+	return response, err
+}
+
 func (s *server) WaitBlpPosition(ctx context.Context, request *tabletmanagerdatapb.WaitBlpPositionRequest) (response *tabletmanagerdatapb.WaitBlpPositionResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "WaitBlpPosition", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("WaitBlpPosition")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.WaitBlpPositionResponse{}
 	return response, s.agent.WaitBlpPosition(ctx, request.BlpPosition, time.Duration(request.WaitTimeout))
@@ -284,6 +1068,7 @@ func (s *server) WaitBlpPosition(ctx context.Context, request *tabletmanagerdata
 
 func (s *server) StopBlp(ctx context.Context, request *tabletmanagerdatapb.StopBlpRequest) (response *tabletmanagerdatapb.StopBlpResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "StopBlp", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("StopBlp")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.StopBlpResponse{}
 	positions, err := s.agent.StopBlp(ctx)
@@ -295,6 +1080,7 @@ func (s *server) StopBlp(ctx context.Context, request *tabletmanagerdatapb.StopB
 
 func (s *server) StartBlp(ctx context.Context, request *tabletmanagerdatapb.StartBlpRequest) (response *tabletmanagerdatapb.StartBlpResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "StartBlp", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("StartBlp")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.StartBlpResponse{}
 	return response, s.agent.StartBlp(ctx)
@@ -302,6 +1088,7 @@ func (s *server) StartBlp(ctx context.Context, request *tabletmanagerdatapb.Star
 
 func (s *server) RunBlpUntil(ctx context.Context, request *tabletmanagerdatapb.RunBlpUntilRequest) (response *tabletmanagerdatapb.RunBlpUntilResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "RunBlpUntil", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("RunBlpUntil")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.RunBlpUntilResponse{}
 	position, err := s.agent.RunBlpUntil(ctx, request.BlpPositions, time.Duration(request.WaitTimeout))
@@ -311,12 +1098,25 @@ func (s *server) RunBlpUntil(ctx context.Context, request *tabletmanagerdatapb.R
 	return response, err
 }
 
+func (s *server) GetBinlogFilters(ctx context.Context, request *tabletmanagerdatapb.GetBinlogFiltersRequest) (response *tabletmanagerdatapb.GetBinlogFiltersResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetBinlogFilters", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetBinlogFilters")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetBinlogFiltersResponse{}
+	filters, err := s.agent.GetBinlogFilters(ctx)
+	if err == nil {
+		response.Filters = filters
+	}
+	return response, err
+}
+
 //
 // Reparenting related functions
 //
 
 func (s *server) ResetReplication(ctx context.Context, request *tabletmanagerdatapb.ResetReplicationRequest) (response *tabletmanagerdatapb.ResetReplicationResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "ResetReplication", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("ResetReplication")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.ResetReplicationResponse{}
 	return response, s.agent.ResetReplication(ctx)
@@ -324,24 +1124,39 @@ func (s *server) ResetReplication(ctx context.Context, request *tabletmanagerdat
 
 func (s *server) InitMaster(ctx context.Context, request *tabletmanagerdatapb.InitMasterRequest) (response *tabletmanagerdatapb.InitMasterResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "InitMaster", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("InitMaster")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.InitMasterResponse{}
-	position, err := s.agent.InitMaster(ctx)
+	position, err := s.agent.InitMaster(ctx, request.ExpectedKeyspace, request.ExpectedShard)
 	if err == nil {
 		response.Position = position
 	}
-	return response, err
+	return response, shardMismatchToGRPCError(err)
 }
 
 func (s *server) PopulateReparentJournal(ctx context.Context, request *tabletmanagerdatapb.PopulateReparentJournalRequest) (response *tabletmanagerdatapb.PopulateReparentJournalResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "PopulateReparentJournal", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("PopulateReparentJournal")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.PopulateReparentJournalResponse{}
-	return response, s.agent.PopulateReparentJournal(ctx, request.TimeCreatedNs, request.ActionName, request.MasterAlias, request.ReplicationPosition)
+	return response, shardMismatchToGRPCError(s.agent.PopulateReparentJournal(ctx, request.TimeCreatedNs, request.ActionName, request.MasterAlias, request.ReplicationPosition, request.ExpectedKeyspace, request.ExpectedShard))
+}
+
+func (s *server) GetLastReparentJournalEntry(ctx context.Context, request *tabletmanagerdatapb.GetLastReparentJournalEntryRequest) (response *tabletmanagerdatapb.GetLastReparentJournalEntryResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetLastReparentJournalEntry", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetLastReparentJournalEntry")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetLastReparentJournalEntryResponse{}
+	entry, err := s.agent.GetLastReparentJournalEntry(ctx)
+	if err == nil {
+		response.Entry = entry
+	}
+	return response, err
 }
 
 func (s *server) InitSlave(ctx context.Context, request *tabletmanagerdatapb.InitSlaveRequest) (response *tabletmanagerdatapb.InitSlaveResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "InitSlave", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("InitSlave")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.InitSlaveResponse{}
 	return response, s.agent.InitSlave(ctx, request.Parent, request.ReplicationPosition, request.TimeCreatedNs)
@@ -349,6 +1164,7 @@ func (s *server) InitSlave(ctx context.Context, request *tabletmanagerdatapb.Ini
 
 func (s *server) DemoteMaster(ctx context.Context, request *tabletmanagerdatapb.DemoteMasterRequest) (response *tabletmanagerdatapb.DemoteMasterResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "DemoteMaster", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("DemoteMaster")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.DemoteMasterResponse{}
 	position, err := s.agent.DemoteMaster(ctx)
@@ -360,6 +1176,7 @@ func (s *server) DemoteMaster(ctx context.Context, request *tabletmanagerdatapb.
 
 func (s *server) PromoteSlaveWhenCaughtUp(ctx context.Context, request *tabletmanagerdatapb.PromoteSlaveWhenCaughtUpRequest) (response *tabletmanagerdatapb.PromoteSlaveWhenCaughtUpResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "PromoteSlaveWhenCaughtUp", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("PromoteSlaveWhenCaughtUp")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.PromoteSlaveWhenCaughtUpResponse{}
 	position, err := s.agent.PromoteSlaveWhenCaughtUp(ctx, request.Position)
@@ -371,6 +1188,7 @@ func (s *server) PromoteSlaveWhenCaughtUp(ctx context.Context, request *tabletma
 
 func (s *server) SlaveWasPromoted(ctx context.Context, request *tabletmanagerdatapb.SlaveWasPromotedRequest) (response *tabletmanagerdatapb.SlaveWasPromotedResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "SlaveWasPromoted", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SlaveWasPromoted")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.SlaveWasPromotedResponse{}
 	return response, s.agent.SlaveWasPromoted(ctx)
@@ -378,13 +1196,23 @@ func (s *server) SlaveWasPromoted(ctx context.Context, request *tabletmanagerdat
 
 func (s *server) SetMaster(ctx context.Context, request *tabletmanagerdatapb.SetMasterRequest) (response *tabletmanagerdatapb.SetMasterResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "SetMaster", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetMaster")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.SetMasterResponse{}
-	return response, s.agent.SetMaster(ctx, request.Parent, request.TimeCreatedNs, request.ForceStartSlave)
+	return response, shardMismatchToGRPCError(s.agent.SetMaster(ctx, request.Parent, request.TimeCreatedNs, request.ForceStartSlave, request.ExpectedKeyspace, request.ExpectedShard))
+}
+
+func (s *server) ConfigureReplication(ctx context.Context, request *tabletmanagerdatapb.ConfigureReplicationRequest) (response *tabletmanagerdatapb.ConfigureReplicationResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "ConfigureReplication", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("ConfigureReplication")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ConfigureReplicationResponse{}
+	return response, shardMismatchToGRPCError(s.agent.ConfigureReplication(ctx, request.Parent, request.AutoPosition, request.File, request.Position, request.ForceStartSlave, request.ExpectedKeyspace, request.ExpectedShard))
 }
 
 func (s *server) SlaveWasRestarted(ctx context.Context, request *tabletmanagerdatapb.SlaveWasRestartedRequest) (response *tabletmanagerdatapb.SlaveWasRestartedResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "SlaveWasRestarted", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SlaveWasRestarted")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.SlaveWasRestartedResponse{}
 	return response, s.agent.SlaveWasRestarted(ctx, request.Parent)
@@ -392,6 +1220,7 @@ func (s *server) SlaveWasRestarted(ctx context.Context, request *tabletmanagerda
 
 func (s *server) StopReplicationAndGetStatus(ctx context.Context, request *tabletmanagerdatapb.StopReplicationAndGetStatusRequest) (response *tabletmanagerdatapb.StopReplicationAndGetStatusResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "StopReplicationAndGetStatus", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("StopReplicationAndGetStatus")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.StopReplicationAndGetStatusResponse{}
 	status, err := s.agent.StopReplicationAndGetStatus(ctx)
@@ -403,6 +1232,7 @@ func (s *server) StopReplicationAndGetStatus(ctx context.Context, request *table
 
 func (s *server) PromoteSlave(ctx context.Context, request *tabletmanagerdatapb.PromoteSlaveRequest) (response *tabletmanagerdatapb.PromoteSlaveResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "PromoteSlave", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("PromoteSlave")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 	response = &tabletmanagerdatapb.PromoteSlaveResponse{}
 	position, err := s.agent.PromoteSlave(ctx)
@@ -412,9 +1242,76 @@ func (s *server) PromoteSlave(ctx context.Context, request *tabletmanagerdatapb.
 	return response, err
 }
 
+func (s *server) SetReparentEligibility(ctx context.Context, request *tabletmanagerdatapb.SetReparentEligibilityRequest) (response *tabletmanagerdatapb.SetReparentEligibilityResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetReparentEligibility", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetReparentEligibility")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetReparentEligibilityResponse{}
+	return response, s.agent.SetReparentEligibility(ctx, request.Eligible, request.Reason)
+}
+
+func (s *server) CheckReparentCandidate(ctx context.Context, request *tabletmanagerdatapb.CheckReparentCandidateRequest) (response *tabletmanagerdatapb.CheckReparentCandidateResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "CheckReparentCandidate", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("CheckReparentCandidate")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.CheckReparentCandidateResponse{}
+	eligible, reason, err := s.agent.CheckReparentCandidate(ctx)
+	if err == nil {
+		response.Eligible = eligible
+		response.Reason = reason
+	}
+	return response, err
+}
+
+func (s *server) SetSemiSyncAckCount(ctx context.Context, request *tabletmanagerdatapb.SetSemiSyncAckCountRequest) (response *tabletmanagerdatapb.SetSemiSyncAckCountResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetSemiSyncAckCount", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetSemiSyncAckCount")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetSemiSyncAckCountResponse{}
+	return response, s.agent.SetSemiSyncAckCount(ctx, int(request.Count))
+}
+
+func (s *server) GetDurabilityPolicy(ctx context.Context, request *tabletmanagerdatapb.GetDurabilityPolicyRequest) (response *tabletmanagerdatapb.GetDurabilityPolicyResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetDurabilityPolicy", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetDurabilityPolicy")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetDurabilityPolicyResponse{}
+	policy, err := s.agent.GetDurabilityPolicy(ctx)
+	if err == nil {
+		response.Policy = policy
+	}
+	return response, err
+}
+
+func (s *server) GetBackupLimits(ctx context.Context, request *tabletmanagerdatapb.GetBackupLimitsRequest) (response *tabletmanagerdatapb.GetBackupLimitsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetBackupLimits", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetBackupLimits")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetBackupLimitsResponse{}
+	defaultConcurrency, maxConcurrency, err := s.agent.GetBackupLimits(ctx)
+	if err == nil {
+		response.DefaultConcurrency = int64(defaultConcurrency)
+		response.MaxConcurrency = int64(maxConcurrency)
+	}
+	return response, err
+}
+
+func (s *server) GetBackupPosition(ctx context.Context, request *tabletmanagerdatapb.GetBackupPositionRequest) (response *tabletmanagerdatapb.GetBackupPositionResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetBackupPosition", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetBackupPosition")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetBackupPositionResponse{}
+	position, err := s.agent.GetBackupPosition(ctx)
+	if err == nil {
+		response.Position = position
+	}
+	return response, err
+}
+
 func (s *server) Backup(request *tabletmanagerdatapb.BackupRequest, stream tabletmanagerservicepb.TabletManager_BackupServer) (err error) {
 	ctx := stream.Context()
 	defer s.agent.HandleRPCPanic(ctx, "Backup", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("Backup")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 
 	// create a logger, send the result back to the caller
@@ -430,9 +1327,29 @@ func (s *server) Backup(request *tabletmanagerdatapb.BackupRequest, stream table
 	return s.agent.Backup(ctx, int(request.Concurrency), logger)
 }
 
+func (s *server) IncrementalBackup(request *tabletmanagerdatapb.IncrementalBackupRequest, stream tabletmanagerservicepb.TabletManager_IncrementalBackupServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "IncrementalBackup", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("IncrementalBackup")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+
+	// create a logger, send the result back to the caller
+	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		// If the client disconnects, we will just fail
+		// to send the log events, but won't interrupt
+		// the backup.
+		stream.Send(&tabletmanagerdatapb.IncrementalBackupResponse{
+			Event: e,
+		})
+	})
+
+	return s.agent.IncrementalBackup(ctx, request.BaseBackupName, int(request.Concurrency), logger)
+}
+
 func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBackupRequest, stream tabletmanagerservicepb.TabletManager_RestoreFromBackupServer) (err error) {
 	ctx := stream.Context()
 	defer s.agent.HandleRPCPanic(ctx, "RestoreFromBackup", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("RestoreFromBackup")()
 	ctx = callinfo.GRPCCallInfo(ctx)
 
 	// create a logger, send the result back to the caller
@@ -448,6 +1365,123 @@ func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBacku
 	return s.agent.RestoreFromBackup(ctx, logger)
 }
 
+func (s *server) RestoreToTimestamp(request *tabletmanagerdatapb.RestoreToTimestampRequest, stream tabletmanagerservicepb.TabletManager_RestoreToTimestampServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "RestoreToTimestamp", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("RestoreToTimestamp")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+
+	// create a logger, send the result back to the caller
+	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		// If the client disconnects, we will just fail
+		// to send the log events, but won't interrupt
+		// the restore.
+		stream.Send(&tabletmanagerdatapb.RestoreToTimestampResponse{
+			Event: e,
+		})
+	})
+
+	return s.agent.RestoreToTimestamp(ctx, request.BackupName, time.Unix(0, request.TargetTimeNs), logger)
+}
+
+func (s *server) SetPreferredBackup(ctx context.Context, request *tabletmanagerdatapb.SetPreferredBackupRequest) (response *tabletmanagerdatapb.SetPreferredBackupResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetPreferredBackup", request, response, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("SetPreferredBackup")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetPreferredBackupResponse{}
+	return response, s.agent.SetPreferredBackup(ctx, request.BackupName)
+}
+
+func (s *server) GetPreferredBackup(ctx context.Context, request *tabletmanagerdatapb.GetPreferredBackupRequest) (response *tabletmanagerdatapb.GetPreferredBackupResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetPreferredBackup", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetPreferredBackup")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetPreferredBackupResponse{}
+	backupName, err := s.agent.GetPreferredBackup(ctx)
+	if err == nil {
+		response.BackupName = backupName
+	}
+	return response, err
+}
+
+func (s *server) CheckRestoreCompatibility(ctx context.Context, request *tabletmanagerdatapb.CheckRestoreCompatibilityRequest) (response *tabletmanagerdatapb.CheckRestoreCompatibilityResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "CheckRestoreCompatibility", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("CheckRestoreCompatibility")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.CheckRestoreCompatibilityResponse{}
+	report, err := s.agent.CheckRestoreCompatibility(ctx, request.BackupName)
+	if err == nil {
+		response.Report = report
+	}
+	return response, err
+}
+
+func (s *server) TestRestore(request *tabletmanagerdatapb.TestRestoreRequest, stream tabletmanagerservicepb.TabletManager_TestRestoreServer) (err error) {
+	ctx := stream.Context()
+	defer s.agent.HandleRPCPanic(ctx, "TestRestore", request, nil, true /*verbose*/, &err)
+	defer s.agent.TrackRPC("TestRestore")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+
+	// create a logger, send the result back to the caller
+	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		// If the client disconnects, we will just fail
+		// to send the log events, but won't interrupt
+		// the test restore.
+		stream.Send(&tabletmanagerdatapb.TestRestoreResponse{
+			Event: e,
+		})
+	})
+
+	return s.agent.TestRestore(ctx, request.BackupName, logger)
+}
+
+func (s *server) GetLastBackupInfo(ctx context.Context, request *tabletmanagerdatapb.GetLastBackupInfoRequest) (response *tabletmanagerdatapb.GetLastBackupInfoResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetLastBackupInfo", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetLastBackupInfo")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetLastBackupInfoResponse{}
+	info, err := s.agent.GetLastBackupInfo(ctx)
+	if err == nil {
+		response.Info = info
+	}
+	return response, err
+}
+
+func (s *server) GetBackupFreshness(ctx context.Context, request *tabletmanagerdatapb.GetBackupFreshnessRequest) (response *tabletmanagerdatapb.GetBackupFreshnessResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetBackupFreshness", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("GetBackupFreshness")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetBackupFreshnessResponse{}
+	name, age, err := s.agent.GetBackupFreshness(ctx)
+	if err == nil {
+		response.BackupName = name
+		response.AgeNs = int64(age)
+	}
+	return response, err
+}
+
+func (s *server) CheckBackupReadiness(ctx context.Context, request *tabletmanagerdatapb.CheckBackupReadinessRequest) (response *tabletmanagerdatapb.CheckBackupReadinessResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "CheckBackupReadiness", request, response, false /*verbose*/, &err)
+	defer s.agent.TrackRPC("CheckBackupReadiness")()
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.CheckBackupReadinessResponse{}
+	report, err := s.agent.CheckBackupReadiness(ctx)
+	if err == nil {
+		response.Report = report
+	}
+	return response, err
+}
+
+// shardMismatchToGRPCError returns a *tmclient.ShardMismatchError as
+// a FailedPrecondition gRPC error, so the client can rebuild it. Other
+// errors are returned unchanged.
+func shardMismatchToGRPCError(err error) error {
+	if sme, ok := err.(*tmclient.ShardMismatchError); ok {
+		return grpc.Errorf(codes.FailedPrecondition, "%v", sme)
+	}
+	return err
+}
+
 // registration glue
 
 func init() {
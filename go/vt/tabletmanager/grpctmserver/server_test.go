@@ -5,12 +5,20 @@
 package grpctmserver
 
 import (
+	"fmt"
 	"net"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/youtube/vitess/go/vt/tabletmanager/agentrpctest"
 	"github.com/youtube/vitess/go/vt/tabletmanager/grpctmclient"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/naming"
 
 	tabletmanagerservicepb "github.com/youtube/vitess/go/vt/proto/tabletmanagerservice"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
@@ -44,8 +52,168 @@ func TestGRPCTMServer(t *testing.T) {
 		PortMap: map[string]int32{
 			"grpc": port,
 		},
+		Keyspace: "test_keyspace",
+		Shard:    "0",
 	}
 
 	// and run the test suite
 	agentrpctest.Run(t, client, tablet, fakeAgent)
 }
+
+// fakeResolver is a naming.Resolver mapping logical names to
+// backend addresses. It remembers the names it was asked for.
+type fakeResolver struct {
+	backends map[string]string
+
+	mu       sync.Mutex
+	resolved []string
+}
+
+func (r *fakeResolver) Resolve(target string) (naming.Watcher, error) {
+	r.mu.Lock()
+	r.resolved = append(r.resolved, target)
+	r.mu.Unlock()
+
+	addr, ok := r.backends[target]
+	if !ok {
+		return nil, fmt.Errorf("unknown target %v", target)
+	}
+	return &fakeWatcher{
+		updates: []*naming.Update{{Op: naming.Add, Addr: addr}},
+		done:    make(chan struct{}),
+	}, nil
+}
+
+// fakeWatcher returns its updates once, and then blocks until closed.
+type fakeWatcher struct {
+	updates []*naming.Update
+	done    chan struct{}
+	once    sync.Once
+}
+
+func (w *fakeWatcher) Next() ([]*naming.Update, error) {
+	if updates := w.updates; updates != nil {
+		w.updates = nil
+		return updates, nil
+	}
+	<-w.done
+	return nil, fmt.Errorf("watcher closed")
+}
+
+func (w *fakeWatcher) Close() {
+	w.once.Do(func() { close(w.done) })
+}
+
+// TestGRPCTMServerResolver makes sure a client using a resolver dials
+// the backend the resolver maps the tablet to, and not the tablet
+// address from topology.
+func TestGRPCTMServerResolver(t *testing.T) {
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("Cannot listen: %v", err)
+	}
+	s := grpc.NewServer()
+	tabletmanagerservicepb.RegisterTabletManagerServer(s, &server{agent: agentrpctest.NewFakeRPCAgent(t)})
+	go s.Serve(listener)
+	defer s.Stop()
+
+	resolver := &fakeResolver{
+		backends: map[string]string{
+			"vttablet-123.test.mesh": listener.Addr().String(),
+		},
+	}
+	client := grpctmclient.NewClient(grpctmclient.WithResolver(resolver, func(tablet *topodatapb.Tablet) string {
+		return fmt.Sprintf("vttablet-%v.%v.mesh", tablet.Alias.Uid, tablet.Alias.Cell)
+	}))
+	defer client.Close()
+
+	// The topology address is not reachable, only the resolver
+	// knows where the tablet is.
+	tablet := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "test",
+			Uid:  123,
+		},
+		Hostname: "unreachable.invalid",
+		PortMap: map[string]int32{
+			"grpc": 1,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx, tablet); err != nil {
+		t.Fatalf("Ping through resolver failed: %v", err)
+	}
+
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+	if len(resolver.resolved) != 1 || resolver.resolved[0] != "vttablet-123.test.mesh" {
+		t.Errorf("resolver was asked for %v, want [vttablet-123.test.mesh]", resolver.resolved)
+	}
+}
+
+// TestGRPCTMClientRPCError makes sure failed calls return a
+// tmclient.RPCError identifying the tablet and the method, including
+// when the error comes straight from gRPC.
+func TestGRPCTMClientRPCError(t *testing.T) {
+	// Find a port nothing listens on.
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("Cannot listen: %v", err)
+	}
+	host := listener.Addr().(*net.TCPAddr).IP.String()
+	port := int32(listener.Addr().(*net.TCPAddr).Port)
+	listener.Close()
+
+	client := grpctmclient.NewClient()
+	defer client.Close()
+	tablet := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "test",
+			Uid:  123,
+		},
+		Hostname: host,
+		PortMap: map[string]int32{
+			"grpc": port,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	calls := map[string]func() error{
+		"Ping": func() error {
+			return client.Ping(ctx, tablet)
+		},
+		"GetSchema": func() error {
+			_, err := client.GetSchema(ctx, tablet, nil, nil, false)
+			return err
+		},
+		"ExecuteFetchAsApp": func() error {
+			_, err := client.ExecuteFetchAsApp(ctx, tablet, true /* usePool */, []byte("select 1"), 10)
+			return err
+		},
+		"CheckReparentCandidate": func() error {
+			_, _, err := client.CheckReparentCandidate(ctx, tablet)
+			return err
+		},
+		"Backup": func() error {
+			_, err := client.Backup(ctx, tablet, 1)
+			return err
+		},
+	}
+	for method, call := range calls {
+		err := call()
+		rpcErr, ok := err.(*tmclient.RPCError)
+		if !ok {
+			t.Errorf("%v returned %v (%T), want a *tmclient.RPCError", method, err, err)
+			continue
+		}
+		if !proto.Equal(rpcErr.Alias, tablet.Alias) || rpcErr.Method != method {
+			t.Errorf("%v returned an error for %v/%v, want %v/%v", method, topoproto.TabletAliasString(rpcErr.Alias), rpcErr.Method, topoproto.TabletAliasString(tablet.Alias), method)
+		}
+		if rpcErr.Unwrap() == nil {
+			t.Errorf("%v returned an error without an underlying error: %v", method, err)
+		}
+	}
+}
@@ -41,6 +41,8 @@ var (
 	healthCheckInterval = flag.Duration("health_check_interval", 20*time.Second, "Interval between health checks")
 	degradedThreshold   = flag.Duration("degraded_threshold", defaultDegradedThreshold, "replication lag after which a replica is considered degraded (only used in status UI)")
 	unhealthyThreshold  = flag.Duration("unhealthy_threshold", defaultUnhealthyThreshold, "replication lag  after which a replica is considered unhealthy")
+
+	healthReportingMaxPause = flag.Duration("health_reporting_max_pause", time.Hour, "maximum time health reporting stays paused by PauseHealthReporting, after which it resumes on its own")
 )
 
 // HealthRecord records one run of the health checker.
@@ -306,6 +308,14 @@ func (agent *ActionAgent) runHealthCheckLocked() {
 		}
 	}
 
+	// sample the binlog transaction count for GetBinlogStats
+	if pos, err := agent.MysqlDaemon.MasterPosition(); err == nil {
+		agent.recordBinlogSample(time.Now(), pos.TransactionCount())
+	}
+
+	// count the replication errors for GetReplicationErrorStats
+	agent.sampleReplicationErrors()
+
 	// remember our health status
 	agent.mutex.Lock()
 	agent._healthy = healthErr
@@ -317,6 +327,29 @@ func (agent *ActionAgent) runHealthCheckLocked() {
 	agent.broadcastHealth()
 }
 
+// healthReportingPaused returns whether health reporting is paused by
+// PauseHealthReporting. A pause that has lasted healthReportingMaxPause
+// is cleared, so a forgotten pause can't hide a failure forever.
+func (agent *ActionAgent) healthReportingPaused() bool {
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+	return agent.healthReportingPausedLocked()
+}
+
+// healthReportingPausedLocked is healthReportingPaused with agent.mutex
+// held.
+func (agent *ActionAgent) healthReportingPausedLocked() bool {
+	if agent._healthReportingPausedUntil.IsZero() {
+		return false
+	}
+	if time.Now().Before(agent._healthReportingPausedUntil) {
+		return true
+	}
+	log.Warningf("Health reporting was paused for the maximum of %v, resuming it", *healthReportingMaxPause)
+	agent._healthReportingPausedUntil = time.Time{}
+	return false
+}
+
 // terminateHealthChecks is called when we enter lame duck mode.
 // We will clean up our state, and set query service to lame duck mode.
 // We only do something if we are in a serving state, and not a master.
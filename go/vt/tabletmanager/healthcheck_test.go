@@ -264,6 +264,133 @@ func TestHealthCheckControlsQueryService(t *testing.T) {
 	}
 }
 
+// TestPauseHealthReporting verifies that a paused tablet keeps
+// publishing its old health, until it is resumed or the pause times
+// out, while its query service still follows its real health.
+func TestPauseHealthReporting(t *testing.T) {
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+	if _, err := expectBroadcastData(agent.QueryServiceControl, true, "healthcheck not run yet", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := expectStateChange(agent.QueryServiceControl, true, topodatapb.TabletType_REPLICA); err != nil {
+		t.Fatal(err)
+	}
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 12 * time.Second
+	agent.runHealthCheck()
+	if _, err := expectBroadcastData(agent.QueryServiceControl, true, "", 12); err != nil {
+		t.Fatal(err)
+	}
+
+	// Pause, and make the tablet unhealthy: it stops serving, but
+	// keeps publishing its old health error and replication delay.
+	if err := agent.PauseHealthReporting(ctx, true); err != nil {
+		t.Fatalf("PauseHealthReporting(true) failed: %v", err)
+	}
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 13 * time.Second
+	agent.HealthReporter.(*fakeHealthCheck).reportError = fmt.Errorf("tablet is unhealthy")
+	agent.runHealthCheck()
+	if err := expectStateChange(agent.QueryServiceControl, false, topodatapb.TabletType_REPLICA); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := expectBroadcastData(agent.QueryServiceControl, false, "", 12); err != nil {
+		t.Fatal(err)
+	}
+	if record := agent.History.Latest().(*HealthRecord); record.Error == nil {
+		t.Errorf("health check failure was not recorded while paused: %v", record)
+	}
+
+	// Resume: the failure is published right away.
+	if err := agent.PauseHealthReporting(ctx, false); err != nil {
+		t.Fatalf("PauseHealthReporting(false) failed: %v", err)
+	}
+	if _, err := expectBroadcastData(agent.QueryServiceControl, false, "tablet is unhealthy", 13); err != nil {
+		t.Fatal(err)
+	}
+
+	// Get healthy again.
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 12 * time.Second
+	agent.HealthReporter.(*fakeHealthCheck).reportError = nil
+	agent.runHealthCheck()
+	if err := expectStateChange(agent.QueryServiceControl, true, topodatapb.TabletType_REPLICA); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := expectBroadcastData(agent.QueryServiceControl, true, "", 12); err != nil {
+		t.Fatal(err)
+	}
+
+	// A pause that is never resumed times out.
+	*healthReportingMaxPause = 10 * time.Millisecond
+	defer func() {
+		*healthReportingMaxPause = time.Hour
+	}()
+	if err := agent.PauseHealthReporting(ctx, true); err != nil {
+		t.Fatalf("PauseHealthReporting(true) failed: %v", err)
+	}
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 13 * time.Second
+	agent.HealthReporter.(*fakeHealthCheck).reportError = fmt.Errorf("tablet is unhealthy")
+	time.Sleep(20 * time.Millisecond)
+	agent.runHealthCheck()
+	if err := expectStateChange(agent.QueryServiceControl, false, topodatapb.TabletType_REPLICA); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := expectBroadcastData(agent.QueryServiceControl, false, "tablet is unhealthy", 13); err != nil {
+		t.Fatal(err)
+	}
+	if agent.healthReportingPaused() {
+		t.Errorf("health reporting should have resumed after the maximum pause")
+	}
+
+	if err := expectBroadcastDataEmpty(agent.QueryServiceControl); err != nil {
+		t.Fatal(err)
+	}
+	if err := expectStateChangesEmpty(agent.QueryServiceControl); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestGetHealthScore verifies the health score goes down as the
+// replication lag goes up.
+func TestGetHealthScore(t *testing.T) {
+	oldThreshold := *unhealthyThreshold
+	defer func() { *unhealthyThreshold = oldThreshold }()
+	*unhealthyThreshold = time.Hour
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+	agent.QueryServiceControl.(*tabletservermock.Controller).ConnStats = tabletserver.ConnectionStats{
+		OpenConnections: 5,
+		PoolCapacity:    20,
+	}
+
+	lastScore := int32(101)
+	for _, delay := range []time.Duration{0, 10 * time.Minute, 30 * time.Minute, 50 * time.Minute} {
+		agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = delay
+		agent.runHealthCheck()
+		hs, err := agent.GetHealthScore(ctx)
+		if err != nil {
+			t.Fatalf("GetHealthScore failed: %v", err)
+		}
+		if hs.LoadFactor != 0.75 || hs.ErrorRateFactor != 1 || hs.HealthError != "" {
+			t.Errorf("GetHealthScore() with %v lag = %v, want load factor 0.75, error rate factor 1 and no health error", delay, hs)
+		}
+		if hs.Score >= lastScore {
+			t.Errorf("GetHealthScore() with %v lag = %v, want less than %v", delay, hs.Score, lastScore)
+		}
+		lastScore = hs.Score
+	}
+
+	// Over the unhealthy threshold, the health check fails.
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 2 * time.Hour
+	agent.runHealthCheck()
+	hs, err := agent.GetHealthScore(ctx)
+	if err != nil {
+		t.Fatalf("GetHealthScore failed: %v", err)
+	}
+	if hs.Score != 0 || hs.HealthError == "" {
+		t.Errorf("GetHealthScore() of an unhealthy tablet = %v, want a score of 0 and its health error", hs)
+	}
+}
+
 // TestErrSlaveNotRunningIsHealthy verifies that a tablet whose
 // healthcheck reports health.ErrSlaveNotRunning is still considered
 // healthy with high replication lag.
@@ -704,7 +831,7 @@ func TestStateChangeImmediateHealthBroadcast(t *testing.T) {
 
 	// Run TER to turn us into a proper master, wait for it to finish.
 	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 19 * time.Second
-	if err := agent.TabletExternallyReparented(ctx, "unused_id"); err != nil {
+	if err := agent.TabletExternallyReparented(ctx, "unused_id", false /* validate */); err != nil {
 		t.Fatal(err)
 	}
 	select {
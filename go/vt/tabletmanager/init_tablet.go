@@ -171,6 +171,17 @@ func (agent *ActionAgent) InitTablet(port, gRPCPort int32) error {
 			return fmt.Errorf("InitTablet failed because existing tablet keyspace and shard %v/%v differ from the provided ones %v/%v", oldTablet.Keyspace, oldTablet.Shard, tablet.Keyspace, tablet.Shard)
 		}
 
+		// Keep the tags set since the tablet was created, e.g. with
+		// SetTabletTags. init_tags wins for the keys it sets.
+		tags := make(map[string]string, len(oldTablet.Tags)+len(tablet.Tags))
+		for key, value := range oldTablet.Tags {
+			tags[key] = value
+		}
+		for key, value := range tablet.Tags {
+			tags[key] = value
+		}
+		tablet.Tags = tags
+
 		// Then overwrite everything, ignoring version mismatch.
 		if err := agent.TopoServer.UpdateTablet(ctx, topo.NewTabletInfo(tablet, -1)); err != nil {
 			return fmt.Errorf("UpdateTablet failed: %v", err)
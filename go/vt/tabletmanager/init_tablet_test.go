@@ -6,6 +6,7 @@ package tabletmanager
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -140,4 +141,22 @@ func TestInitTablet(t *testing.T) {
 	if len(ti.Tags) != 1 || ti.Tags["aaa"] != "bbb" {
 		t.Errorf("wrong tablet tags: %v", ti.Tags)
 	}
+
+	// Tags added to the tablet record since survive a restart,
+	// but init_tags wins for the keys it sets.
+	ti.Tags["aaa"] = "changed"
+	ti.Tags["owner"] = "team-x"
+	if err := ts.UpdateTablet(ctx, ti); err != nil {
+		t.Fatalf("UpdateTablet failed: %v", err)
+	}
+	if err := agent.InitTablet(port, gRPCPort); err != nil {
+		t.Fatalf("InitTablet(type, healthcheck) failed: %v", err)
+	}
+	ti, err = ts.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	if want := map[string]string{"aaa": "bbb", "owner": "team-x"}; !reflect.DeepEqual(ti.Tags, want) {
+		t.Errorf("wrong tablet tags: %v, want %v", ti.Tags, want)
+	}
 }
@@ -0,0 +1,75 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+)
+
+// tabletDirMysqlDaemon is a FakeMysqlDaemon with a real tablet
+// directory, to persist markers in.
+type tabletDirMysqlDaemon struct {
+	*mysqlctl.FakeMysqlDaemon
+	tabletDir string
+}
+
+func (d *tabletDirMysqlDaemon) TabletDir() string {
+	return d.tabletDir
+}
+
+func TestMaintenanceModeSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	tabletDir, err := ioutil.TempDir("", "maintenance_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(tabletDir)
+	mysqlDaemon := &tabletDirMysqlDaemon{
+		FakeMysqlDaemon: mysqlctl.NewFakeMysqlDaemon(nil),
+		tabletDir:       tabletDir,
+	}
+
+	// newAgent simulates a tablet restart: the new agent only shares
+	// the tablet directory with the previous one.
+	newAgent := func() *ActionAgent {
+		return &ActionAgent{
+			MysqlDaemon: mysqlDaemon,
+		}
+	}
+
+	agent := newAgent()
+	if err := agent.SetMaintenanceMode(ctx, true, "disk replacement"); err != nil {
+		t.Fatalf("SetMaintenanceMode failed: %v", err)
+	}
+
+	agent = newAgent()
+	if maintenance, reason := agent.maintenanceMode(); !maintenance || reason != "disk replacement" {
+		t.Errorf("after restart, maintenanceMode() = (%v, %q), want (true, %q)", maintenance, reason, "disk replacement")
+	}
+	eligible, reason, err := agent.CheckReparentCandidate(ctx)
+	if err != nil {
+		t.Fatalf("CheckReparentCandidate failed: %v", err)
+	}
+	if want := "in maintenance: disk replacement"; eligible || reason != want {
+		t.Errorf("CheckReparentCandidate() = (%v, %q), want (false, %q)", eligible, reason, want)
+	}
+
+	if err := agent.SetMaintenanceMode(ctx, false, ""); err != nil {
+		t.Fatalf("SetMaintenanceMode failed: %v", err)
+	}
+	agent = newAgent()
+	if maintenance, reason := agent.maintenanceMode(); maintenance || reason != "" {
+		t.Errorf("after clearing and restart, maintenanceMode() = (%v, %q), want (false, \"\")", maintenance, reason)
+	}
+	if eligible, _, err := agent.CheckReparentCandidate(ctx); err != nil || !eligible {
+		t.Errorf("CheckReparentCandidate() = (%v, %v), want eligible", eligible, err)
+	}
+}
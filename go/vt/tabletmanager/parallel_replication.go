@@ -0,0 +1,174 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+)
+
+const (
+	// maxParallelReplicationWorkers is the largest
+	// slave_parallel_workers MySQL accepts.
+	maxParallelReplicationWorkers = 1024
+
+	parallelReplicationSettingsQuery = "SELECT @@GLOBAL.slave_parallel_workers, @@GLOBAL.slave_parallel_type"
+	// parallelReplicationStateQuery returns the parallel type, and
+	// the number of workers the running SQL thread started.
+	parallelReplicationStateQuery = "SELECT @@GLOBAL.slave_parallel_type, COUNT(*) FROM performance_schema.replication_applier_status_by_worker"
+)
+
+// parallelReplicationTypes are the valid values of slave_parallel_type.
+var parallelReplicationTypes = map[string]bool{
+	"DATABASE":      true,
+	"LOGICAL_CLOCK": true,
+}
+
+var (
+	// parallelReplicationConfirmTimeout is how long
+	// EnableParallelReplication waits for the SQL thread to start
+	// its workers.
+	parallelReplicationConfirmTimeout = 10 * time.Second
+	// parallelReplicationConfirmInterval is how often it checks.
+	parallelReplicationConfirmInterval = 100 * time.Millisecond
+)
+
+// EnableParallelReplication switches the slave to parallel replication
+// with the given number of workers and slave_parallel_type: it stops
+// the SQL thread, changes the settings, starts the SQL thread again,
+// and waits until it runs with the workers. The SQL thread is started
+// even if it was not running. Invalid settings are refused before
+// anything changes. If the switch fails midway, or replication does not
+// resume in parallel mode, the previous settings are restored.
+func (agent *ActionAgent) EnableParallelReplication(ctx context.Context, workers int, parallelType string) error {
+	parallelType = strings.ToUpper(parallelType)
+	if !parallelReplicationTypes[parallelType] {
+		return fmt.Errorf("invalid parallel type %q: must be DATABASE or LOGICAL_CLOCK", parallelType)
+	}
+	if workers < 1 || workers > maxParallelReplicationWorkers {
+		return fmt.Errorf("invalid worker count %v: must be between 1 and %v", workers, maxParallelReplicationWorkers)
+	}
+
+	agent.mutex.Lock()
+	boosting := agent._boostingCatchup
+	agent.mutex.Unlock()
+	if boosting {
+		return fmt.Errorf("replication catch-up is boosted, cannot change slave_parallel_workers")
+	}
+
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	if err != nil {
+		return err
+	}
+	originalWorkers, originalType, err := agent.parallelReplicationSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = agent.MysqlDaemon.ExecuteSuperQueryList(ctx, parallelReplicationQueries(int64(workers), parallelType, true))
+	if err == nil {
+		err = agent.confirmParallelReplication(ctx, workers, parallelType)
+	}
+	if err != nil {
+		// Restore the settings with a context the client cannot
+		// cancel, and only restart the SQL thread if it was running.
+		if restoreErr := agent.MysqlDaemon.ExecuteSuperQueryList(context.Background(), parallelReplicationQueries(originalWorkers, originalType, status.SlaveSQLRunning)); restoreErr != nil {
+			log.Errorf("cannot restore slave_parallel_workers = %v and slave_parallel_type = %v after EnableParallelReplication failed: %v", originalWorkers, originalType, restoreErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// parallelReplicationSettings returns the current
+// slave_parallel_workers and slave_parallel_type.
+func (agent *ActionAgent) parallelReplicationSettings(ctx context.Context) (int64, string, error) {
+	qr, err := agent.MysqlDaemon.FetchSuperQuery(ctx, parallelReplicationSettingsQuery)
+	if err != nil {
+		return 0, "", err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		return 0, "", fmt.Errorf("unexpected result for %v: %v", parallelReplicationSettingsQuery, qr.Rows)
+	}
+	workers, err := qr.Rows[0][0].ParseInt64()
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid value for slave_parallel_workers: %v", err)
+	}
+	return workers, qr.Rows[0][1].String(), nil
+}
+
+// parallelReplicationQueries returns the queries that change the
+// parallel replication settings with the SQL thread stopped, and start
+// it again if startSQLThread is set. slave_parallel_type can only be
+// changed while the SQL thread is stopped, and slave_parallel_workers
+// is only used when it starts.
+func parallelReplicationQueries(workers int64, parallelType string, startSQLThread bool) []string {
+	queries := []string{
+		"STOP SLAVE SQL_THREAD",
+		fmt.Sprintf("SET GLOBAL slave_parallel_type = '%v'", parallelType),
+		fmt.Sprintf("SET GLOBAL slave_parallel_workers = %v", workers),
+	}
+	if startSQLThread {
+		queries = append(queries, "START SLAVE SQL_THREAD")
+	}
+	return queries
+}
+
+// confirmParallelReplication waits until the SQL thread runs with the
+// given parallel type and number of workers.
+func (agent *ActionAgent) confirmParallelReplication(ctx context.Context, workers int, parallelType string) error {
+	ctx, cancel := context.WithTimeout(ctx, parallelReplicationConfirmTimeout)
+	defer cancel()
+	for {
+		err := agent.checkParallelReplication(ctx, workers, parallelType)
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("replication did not resume in parallel mode: %v", err)
+		case <-time.After(parallelReplicationConfirmInterval):
+		}
+	}
+}
+
+// checkParallelReplication returns an error if the SQL thread is not
+// running with the given parallel type and number of workers.
+func (agent *ActionAgent) checkParallelReplication(ctx context.Context, workers int, parallelType string) error {
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	if err != nil {
+		return err
+	}
+	if !status.SlaveSQLRunning {
+		return fmt.Errorf("the SQL thread is not running")
+	}
+	qr, err := agent.MysqlDaemon.FetchSuperQuery(ctx, parallelReplicationStateQuery)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		return fmt.Errorf("unexpected result for %v: %v", parallelReplicationStateQuery, qr.Rows)
+	}
+	if got := qr.Rows[0][0].String(); got != parallelType {
+		return fmt.Errorf("slave_parallel_type is %v, want %v", got, parallelType)
+	}
+	running, err := qr.Rows[0][1].ParseInt64()
+	if err != nil {
+		return fmt.Errorf("invalid worker count: %v", err)
+	}
+	if running != int64(workers) {
+		return fmt.Errorf("%v workers are running, want %v", running, workers)
+	}
+	return nil
+}
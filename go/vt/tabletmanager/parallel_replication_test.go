@@ -0,0 +1,105 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+)
+
+// parallelReplicationAgent returns an agent for a replicating slave
+// with the given parallel replication settings, and the state reported
+// once the SQL thread is restarted.
+func parallelReplicationAgent(workers, parallelType, runningType, runningWorkers string) (*ActionAgent, *mysqlctl.FakeMysqlDaemon) {
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Replicating = true
+	mysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		parallelReplicationSettingsQuery: {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte(workers)), sqltypes.MakeString([]byte(parallelType))},
+			},
+		},
+		parallelReplicationStateQuery: {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte(runningType)), sqltypes.MakeString([]byte(runningWorkers))},
+			},
+		},
+	}
+	return &ActionAgent{MysqlDaemon: mysqlDaemon}, mysqlDaemon
+}
+
+func TestEnableParallelReplication(t *testing.T) {
+	agent, mysqlDaemon := parallelReplicationAgent("0", "DATABASE", "LOGICAL_CLOCK", "8")
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE SQL_THREAD",
+		"SET GLOBAL slave_parallel_type = 'LOGICAL_CLOCK'",
+		"SET GLOBAL slave_parallel_workers = 8",
+		"START SLAVE SQL_THREAD",
+	}
+
+	if err := agent.EnableParallelReplication(context.Background(), 8, "logical_clock"); err != nil {
+		t.Fatalf("EnableParallelReplication failed: %v", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("EnableParallelReplication did not switch the settings: %v", err)
+	}
+}
+
+func TestEnableParallelReplicationInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		workers      int
+		parallelType string
+		want         string
+	}{
+		{8, "ROW", "invalid parallel type"},
+		{0, "LOGICAL_CLOCK", "invalid worker count"},
+		{2000, "DATABASE", "invalid worker count"},
+	} {
+		agent, mysqlDaemon := parallelReplicationAgent("0", "DATABASE", "DATABASE", "0")
+		err := agent.EnableParallelReplication(context.Background(), tc.workers, tc.parallelType)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("EnableParallelReplication(%v, %v) = %v, want an error containing %q", tc.workers, tc.parallelType, err, tc.want)
+		}
+		if mysqlDaemon.ExpectedExecuteSuperQueryCurrent != 0 {
+			t.Errorf("EnableParallelReplication(%v, %v) ran %v queries, want none", tc.workers, tc.parallelType, mysqlDaemon.ExpectedExecuteSuperQueryCurrent)
+		}
+	}
+}
+
+func TestEnableParallelReplicationNotResumed(t *testing.T) {
+	defer func(timeout, interval time.Duration) {
+		parallelReplicationConfirmTimeout = timeout
+		parallelReplicationConfirmInterval = interval
+	}(parallelReplicationConfirmTimeout, parallelReplicationConfirmInterval)
+	parallelReplicationConfirmTimeout = 50 * time.Millisecond
+	parallelReplicationConfirmInterval = 10 * time.Millisecond
+
+	// The workers never start: the previous settings are restored.
+	agent, mysqlDaemon := parallelReplicationAgent("0", "DATABASE", "LOGICAL_CLOCK", "0")
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE SQL_THREAD",
+		"SET GLOBAL slave_parallel_type = 'LOGICAL_CLOCK'",
+		"SET GLOBAL slave_parallel_workers = 4",
+		"START SLAVE SQL_THREAD",
+		"STOP SLAVE SQL_THREAD",
+		"SET GLOBAL slave_parallel_type = 'DATABASE'",
+		"SET GLOBAL slave_parallel_workers = 0",
+		"START SLAVE SQL_THREAD",
+	}
+
+	err := agent.EnableParallelReplication(context.Background(), 4, "LOGICAL_CLOCK")
+	if err == nil || !strings.Contains(err.Error(), "did not resume in parallel mode") {
+		t.Errorf("EnableParallelReplication() = %v, want a parallel mode error", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("EnableParallelReplication did not restore the settings: %v", err)
+	}
+}
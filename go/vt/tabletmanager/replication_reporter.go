@@ -45,6 +45,7 @@ func (r *replicationReporter) Report(isSlaveType, shouldQueryServiceBeRunning bo
 			// As far as we've been told, it isn't stopped on purpose,
 			// so let's try to start it.
 			log.Infof("Slave is stopped. Trying to reconnect to master...")
+			r.agent.recordReplicationReconnect()
 			ctx, cancel := context.WithTimeout(r.agent.batchCtx, 5*time.Second)
 			if err := repairReplication(ctx, r.agent); err != nil {
 				log.Infof("Failed to reconnect to master: %v", err)
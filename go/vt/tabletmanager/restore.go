@@ -7,6 +7,7 @@ package tabletmanager
 import (
 	"flag"
 	"fmt"
+	"time"
 
 	log "github.com/golang/glog"
 	"golang.org/x/net/context"
@@ -14,6 +15,7 @@ import (
 	"github.com/youtube/vitess/go/mysqlconn/replication"
 	"github.com/youtube/vitess/go/vt/logutil"
 	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
 	"github.com/youtube/vitess/go/vt/topo/topoproto"
 
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
@@ -61,7 +63,14 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 	localMetadata := agent.getLocalMetadataValues(originalType)
 	tablet := agent.Tablet()
 	dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
-	pos, err := mysqlctl.Restore(ctx, agent.MysqlDaemon, dir, *restoreConcurrency, agent.hookExtraEnv(), localMetadata, logger, deleteBeforeRestore, topoproto.TabletDbName(tablet))
+	preferredBackup, err := agent.TopoServer.GetPreferredBackup(ctx, tablet.Keyspace, tablet.Shard)
+	if err != nil {
+		return fmt.Errorf("Cannot read the preferred backup: %v", err)
+	}
+	if preferredBackup != "" {
+		logger.Infof("Restore: the preferred backup of the shard is %v", preferredBackup)
+	}
+	pos, err := mysqlctl.Restore(ctx, agent.MysqlDaemon, dir, preferredBackup, *restoreConcurrency, agent.hookExtraEnv(), localMetadata, logger, deleteBeforeRestore, topoproto.TabletDbName(tablet))
 	switch err {
 	case nil:
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
@@ -78,7 +87,19 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 		// replication reporter may restart replication at the
 		// next health check if it thinks it should. We do not
 		// alter replication here.
+	case mysqlctl.ErrRestoreAborted:
+		// The partially restored files were removed, and mysqld
+		// is down. Go back to the original type rather than stay
+		// in RESTORE: the health check keeps the tablet out of
+		// serving until the next restore.
+		if typeErr := agent.revertRestoreType(originalType); typeErr != nil {
+			return fmt.Errorf("%v, and %v", err, typeErr)
+		}
+		return err
 	default:
+		if typeErr := agent.revertRestoreType(originalType); typeErr != nil {
+			return fmt.Errorf("Can't restore backup: %v, and %v", err, typeErr)
+		}
 		return fmt.Errorf("Can't restore backup: %v", err)
 	}
 
@@ -107,6 +128,22 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 	return nil
 }
 
+// revertRestoreType changes the tablet type back to originalType after
+// a failed restore. The restore context may be canceled, so it uses
+// the background context.
+func (agent *ActionAgent) revertRestoreType(originalType topodatapb.TabletType) error {
+	if _, err := agent.TopoServer.UpdateTabletFields(context.Background(), agent.TabletAlias, func(tablet *topodatapb.Tablet) error {
+		tablet.Type = originalType
+		return nil
+	}); err != nil {
+		return fmt.Errorf("cannot change type back to %v: %v", originalType, err)
+	}
+	if err := agent.refreshTablet(context.Background(), "after failed restore"); err != nil {
+		return fmt.Errorf("failed to update state after failed restore: %v", err)
+	}
+	return nil
+}
+
 func (agent *ActionAgent) startReplication(ctx context.Context, pos replication.Position, tabletType topodatapb.TabletType) error {
 	// Set the position at which to resume from the master.
 	cmds, err := agent.MysqlDaemon.SetSlavePositionCommands(pos)
@@ -175,3 +212,104 @@ func (agent *ActionAgent) getLocalMetadataValues(tabletType topodatapb.TabletTyp
 	}
 	return values
 }
+
+func (agent *ActionAgent) restoreToTimestampLocked(ctx context.Context, logger logutil.Logger, backupName string, targetTime time.Time) error {
+	// Find the master to read the binlogs from, and check it has
+	// them, before changing the type or touching any local data.
+	tablet := agent.Tablet()
+	si, err := agent.TopoServer.GetShard(ctx, tablet.Keyspace, tablet.Shard)
+	if err != nil {
+		return fmt.Errorf("can't read shard: %v", err)
+	}
+	if si.MasterAlias == nil {
+		return fmt.Errorf("shard %v/%v has no master to read binlogs from", tablet.Keyspace, tablet.Shard)
+	}
+	ti, err := agent.TopoServer.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return fmt.Errorf("Cannot read master tablet %v: %v", si.MasterAlias, err)
+	}
+
+	// The master must still have all the binlogs since the backup:
+	// if it purged transactions the backup does not have, applying
+	// the rest would leave a gap in the data.
+	dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
+	backupPos, err := mysqlctl.ManifestPosition(ctx, dir, backupName)
+	if err != nil {
+		return fmt.Errorf("Can't read the position of backup %v: %v", backupName, err)
+	}
+	tmc := tmclient.NewTabletManagerClient()
+	defer tmc.Close()
+	gtidPurged, err := tmc.GetGtidPurged(ctx, ti.Tablet)
+	if err != nil {
+		return fmt.Errorf("Can't read gtid_purged of master %v: %v", topoproto.TabletAliasString(si.MasterAlias), err)
+	}
+	purgedPos, err := replication.DecodePosition(gtidPurged)
+	if err != nil {
+		return fmt.Errorf("Can't decode gtid_purged of master %v: %v", topoproto.TabletAliasString(si.MasterAlias), err)
+	}
+	if !purgedPos.IsZero() && !backupPos.AtLeast(purgedPos) {
+		return fmt.Errorf("master %v purged binlogs newer than backup %v: gtid_purged %v is not in the backup position %v", topoproto.TabletAliasString(si.MasterAlias), backupName, purgedPos, backupPos)
+	}
+
+	// change type to RESTORE (using UpdateTabletFields so it's
+	// always authorized)
+	var originalType topodatapb.TabletType
+	if _, err := agent.TopoServer.UpdateTabletFields(ctx, agent.TabletAlias, func(tablet *topodatapb.Tablet) error {
+		originalType = tablet.Type
+		tablet.Type = topodatapb.TabletType_RESTORE
+		return nil
+	}); err != nil {
+		return fmt.Errorf("Cannot change type to RESTORE: %v", err)
+	}
+
+	// let's update our internal state (stop query service and other things)
+	if err := agent.refreshTablet(ctx, "restore to timestamp"); err != nil {
+		// No local data was touched yet.
+		if err := agent.revertRestoreType(originalType); err != nil {
+			log.Warningf("RestoreToTimestamp: %v", err)
+		}
+		return fmt.Errorf("failed to update state before restore: %v", err)
+	}
+
+	localMetadata := agent.getLocalMetadataValues(originalType)
+	pos, err := mysqlctl.RestoreBackup(ctx, agent.MysqlDaemon, dir, backupName, *restoreConcurrency, agent.hookExtraEnv(), localMetadata, logger)
+	if err != nil {
+		return fmt.Errorf("Can't restore backup %v: %v", backupName, err)
+	}
+
+	// Starting from here we won't be able to recover if we get stopped by a cancelled
+	// context. Thus we use the background context to get through to the finish.
+
+	// Replication must not be started again: the data would move
+	// past targetTime.
+	agent.setSlaveStopped(true)
+
+	cmds, err := agent.MysqlDaemon.SetSlavePositionCommands(pos)
+	if err != nil {
+		return err
+	}
+	if err := agent.MysqlDaemon.ExecuteSuperQueryList(context.Background(), cmds); err != nil {
+		return fmt.Errorf("failed to set slave position: %v", err)
+	}
+
+	logger.Infof("RestoreToTimestamp: applying binlogs of master %v from %v until %v", topoproto.TabletAliasString(si.MasterAlias), pos, targetTime)
+	if err := agent.MysqlDaemon.ApplyBinlogs(context.Background(), ti.Hostname, int(ti.PortMap["mysql"]), pos, targetTime); err != nil {
+		return fmt.Errorf("Can't apply binlogs up to %v: %v", targetTime, err)
+	}
+
+	// The data no longer matches the rest of the shard, so it
+	// cannot serve.
+	if _, err := agent.TopoServer.UpdateTabletFields(context.Background(), tablet.Alias, func(tablet *topodatapb.Tablet) error {
+		tablet.Type = topodatapb.TabletType_DRAINED
+		return nil
+	}); err != nil {
+		return fmt.Errorf("Cannot change type to DRAINED: %v", err)
+	}
+
+	// let's update our internal state
+	if err := agent.refreshTablet(context.Background(), "after restore to timestamp"); err != nil {
+		return fmt.Errorf("failed to update state after restore: %v", err)
+	}
+
+	return nil
+}
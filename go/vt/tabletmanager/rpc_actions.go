@@ -5,15 +5,26 @@
 package tabletmanager
 
 import (
+	"flag"
 	"fmt"
+	"os"
 	"regexp"
+	"runtime"
+	"runtime/pprof"
+	"strings"
 	"time"
 
 	log "github.com/golang/glog"
 	"golang.org/x/net/context"
 
+	"github.com/youtube/vitess/go/vt/health"
 	"github.com/youtube/vitess/go/vt/hook"
+	"github.com/youtube/vitess/go/vt/logutil"
 	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/tabletserver"
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
 	"github.com/youtube/vitess/go/vt/topotools"
 
 	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
@@ -23,6 +34,17 @@ import (
 // This file contains the implementations of RPCAgent methods.
 // Major groups of methods are broken out into files named "rpc_*.go".
 
+var (
+	restartMysqlHealthyTimeout = flag.Duration("restart_mysql_healthy_timeout", 5*time.Minute, "how long RestartMysql waits for the restarted mysqld to be healthy, if the caller does not say")
+
+	// restartMysqlHealthCheckInterval is how often RestartMysql
+	// checks the health of the restarted mysqld. It is a variable
+	// for tests.
+	restartMysqlHealthCheckInterval = time.Second
+
+	topoConnectivityTimeout = flag.Duration("topo_connectivity_check_timeout", 10*time.Second, "how long CheckTopoConnectivity waits for the topo server")
+)
+
 // Ping makes sure RPCs work, and refreshes the tablet record.
 func (agent *ActionAgent) Ping(ctx context.Context, args string) string {
 	return args
@@ -33,6 +55,227 @@ func (agent *ActionAgent) GetPermissions(ctx context.Context) (*tabletmanagerdat
 	return mysqlctl.GetPermissions(agent.MysqlDaemon)
 }
 
+// GetConnectionStats returns the query service connection and
+// transaction counts.
+func (agent *ActionAgent) GetConnectionStats(ctx context.Context) (*tabletmanagerdatapb.ConnectionStats, error) {
+	cs := agent.QueryServiceControl.ConnectionStats()
+	return &tabletmanagerdatapb.ConnectionStats{
+		OpenConnections:    cs.OpenConnections,
+		ActiveTransactions: cs.ActiveTransactions,
+		PoolCapacity:       cs.PoolCapacity,
+	}, nil
+}
+
+// GetConnectionRejectionStats returns the number of connection
+// requests the query service pools rejected since the counts were last
+// reset, by reason. If reset is set, the counts are cleared as they
+// are read.
+func (agent *ActionAgent) GetConnectionRejectionStats(ctx context.Context, reset bool) (map[string]int64, error) {
+	qss := agent.QueryServiceControl.QueryServiceStats()
+	if qss == nil {
+		return nil, fmt.Errorf("query service stats are not available")
+	}
+	return qss.ConnectionRejections.Counts(reset), nil
+}
+
+// GetConfig returns the current value of each command line flag.
+// Values of password flags are redacted.
+func (agent *ActionAgent) GetConfig(ctx context.Context) (map[string]string, error) {
+	result := make(map[string]string)
+	flag.VisitAll(func(f *flag.Flag) {
+		if strings.HasSuffix(f.Name, "-pass") || strings.Contains(f.Name, "password") {
+			result[f.Name] = "****"
+			return
+		}
+		result[f.Name] = f.Value.String()
+	})
+	return result, nil
+}
+
+// GetInFlightRPCs returns the number of RPCs the agent is
+// processing, by method name.
+func (agent *ActionAgent) GetInFlightRPCs(ctx context.Context) (map[string]int64, error) {
+	agent.inFlightMutex.Lock()
+	defer agent.inFlightMutex.Unlock()
+	result := make(map[string]int64, len(agent._inFlightRPCs))
+	for name, count := range agent._inFlightRPCs {
+		result[name] = count
+	}
+	return result, nil
+}
+
+// GetProcessStats returns the resource usage of the process. It only
+// reads runtime and OS counters.
+func (agent *ActionAgent) GetProcessStats(ctx context.Context) (*tabletmanagerdatapb.ProcessStats, error) {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return &tabletmanagerdatapb.ProcessStats{
+		Goroutines:     int64(runtime.NumGoroutine()),
+		Threads:        int64(pprof.Lookup("threadcreate").Count()),
+		OpenFiles:      openFileCount(),
+		HeapAllocBytes: m.HeapAlloc,
+		SysBytes:       m.Sys,
+	}, nil
+}
+
+// openFileCount returns the number of file descriptors the process
+// has open, or -1 if /proc is not available.
+func openFileCount() int64 {
+	dir, err := os.Open("/proc/self/fd")
+	if err != nil {
+		return -1
+	}
+	defer dir.Close()
+	names, err := dir.Readdirnames(-1)
+	if err != nil {
+		return -1
+	}
+	// Don't count the descriptor used to read the directory.
+	return int64(len(names) - 1)
+}
+
+// The weights of the factors of the health score. They add up to 100.
+const (
+	healthScoreLagWeight   = 50
+	healthScoreErrorWeight = 30
+	healthScoreLoadWeight  = 20
+)
+
+// GetHealthScore returns a composite health score of the tablet, so
+// tablets can be compared for routing. It is, rounded down:
+//
+//	50 * lag factor + 30 * error rate factor + 20 * load factor
+//
+// Each factor is between 0 (worst) and 1 (best):
+//   - the lag factor is 1 - replication lag / -unhealthy_threshold.
+//   - the error rate factor is 1 - errors / queries, since the query
+//     service started.
+//   - the load factor is 1 - connections in use / capacity of the
+//     query service connection pools.
+//
+// The score of a tablet whose health check fails is 0.
+func (agent *ActionAgent) GetHealthScore(ctx context.Context) (*tabletmanagerdatapb.HealthScore, error) {
+	replicationDelay, healthErr := agent.Healthy()
+	hs := &tabletmanagerdatapb.HealthScore{
+		ReplicationLagFactor: 1 - healthScoreRatio(float64(replicationDelay), float64(*unhealthyThreshold)),
+		ErrorRateFactor:      1,
+	}
+	if qss := agent.QueryServiceControl.QueryServiceStats(); qss != nil {
+		var errors int64
+		for _, count := range qss.ErrorStats.Counts() {
+			errors += count
+		}
+		hs.ErrorRateFactor = 1 - healthScoreRatio(float64(errors), float64(qss.QueryStats.Count()))
+	}
+	cs := agent.QueryServiceControl.ConnectionStats()
+	hs.LoadFactor = 1 - healthScoreRatio(float64(cs.OpenConnections), float64(cs.PoolCapacity))
+
+	if healthErr != nil {
+		hs.HealthError = healthErr.Error()
+		return hs, nil
+	}
+	hs.Score = int32(healthScoreLagWeight*hs.ReplicationLagFactor + healthScoreErrorWeight*hs.ErrorRateFactor + healthScoreLoadWeight*hs.LoadFactor)
+	return hs, nil
+}
+
+// healthScoreRatio returns n / d, capped to [0, 1], or 0 if d is 0.
+func healthScoreRatio(n, d float64) float64 {
+	if d <= 0 || n <= 0 {
+		return 0
+	}
+	if n >= d {
+		return 1
+	}
+	return n / d
+}
+
+// maxErrorLogTailLines is the most lines GetErrorLogTail returns.
+const maxErrorLogTailLines = 10000
+
+// GetErrorLogTail returns the last lines of the MySQL error log, oldest
+// first.
+func (agent *ActionAgent) GetErrorLogTail(ctx context.Context, lines int) ([]string, error) {
+	if lines < 1 || lines > maxErrorLogTailLines {
+		return nil, fmt.Errorf("invalid number of lines %v: must be between 1 and %v", lines, maxErrorLogTailLines)
+	}
+	return mysqlctl.ErrorLogTail(agent.MysqlDaemon.Cnf(), lines)
+}
+
+// CheckDataDir returns the problems with the directories mysqld needs,
+// so a misconfigured host can be caught before starting mysqld or
+// restoring a backup. It has no side effects.
+func (agent *ActionAgent) CheckDataDir(ctx context.Context) ([]string, error) {
+	return mysqlctl.CheckDataDir(agent.MysqlDaemon.Cnf())
+}
+
+// diagnosticsErrorLogLines is the number of error log lines
+// CaptureDiagnostics returns.
+const diagnosticsErrorLogLines = 100
+
+// diagnosticsVariablesQuery returns the MySQL variables
+// CaptureDiagnostics returns. Variables the server doesn't have are
+// omitted.
+const diagnosticsVariablesQuery = "SHOW GLOBAL VARIABLES WHERE Variable_name IN ('version', 'server_id', 'read_only', 'super_read_only', 'gtid_mode', 'binlog_format', 'sync_binlog', 'innodb_flush_log_at_trx_commit', 'innodb_buffer_pool_size', 'max_connections', 'slave_parallel_workers')"
+
+// CaptureDiagnostics returns the processlist, slave status, error log
+// tail, key variables and disk stats of the tablet, so they can be
+// looked at together during an incident. A section that cannot be
+// captured is reported in the Errors of the bundle, and doesn't
+// prevent the others from being captured.
+func (agent *ActionAgent) CaptureDiagnostics(ctx context.Context) (*tabletmanagerdatapb.DiagnosticBundle, error) {
+	bundle := &tabletmanagerdatapb.DiagnosticBundle{}
+	addError := func(section string, err error) {
+		bundle.Errors = append(bundle.Errors, fmt.Sprintf("%v: %v", section, err))
+	}
+
+	processes, err := mysqlctl.GetProcessList(ctx, agent.MysqlDaemon)
+	if err != nil {
+		addError("processlist", err)
+	}
+	bundle.Processes = processes
+
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	switch err {
+	case nil:
+		bundle.SlaveStatus = mysqlctl.StatusToProto(status)
+	case mysqlctl.ErrNotSlave:
+	default:
+		addError("slave status", err)
+	}
+
+	lines, err := mysqlctl.ErrorLogTail(agent.MysqlDaemon.Cnf(), diagnosticsErrorLogLines)
+	if err != nil {
+		addError("error log", err)
+	}
+	bundle.ErrorLogTail = lines
+
+	if qr, err := agent.MysqlDaemon.FetchSuperQuery(ctx, diagnosticsVariablesQuery); err != nil {
+		addError("variables", err)
+	} else {
+		bundle.Variables = make(map[string]string)
+		for _, row := range qr.Rows {
+			if len(row) != 2 {
+				addError("variables", fmt.Errorf("unexpected row %v", row))
+				break
+			}
+			bundle.Variables[row[0].String()] = row[1].String()
+		}
+	}
+
+	if cnf := agent.MysqlDaemon.Cnf(); cnf != nil && cnf.DataDir != "" {
+		total, free, err := mysqlctl.DiskSpace(cnf.DataDir)
+		if err != nil {
+			addError("disk", err)
+		}
+		bundle.DataDirTotalBytes = total
+		bundle.DataDirFreeBytes = free
+	} else {
+		addError("disk", fmt.Errorf("no MySQL data directory configured"))
+	}
+
+	return bundle, nil
+}
+
 // SetReadOnly makes the mysql instance read-only or read-write.
 func (agent *ActionAgent) SetReadOnly(ctx context.Context, rdonly bool) error {
 	if err := agent.lock(ctx); err != nil {
@@ -40,16 +283,179 @@ func (agent *ActionAgent) SetReadOnly(ctx context.Context, rdonly bool) error {
 	}
 	defer agent.unlock()
 
+	// An explicit setting wins over a pending SetReadOnlyWithTTL.
+	agent.clearReadOnlyTTLLocked()
 	return agent.MysqlDaemon.SetReadOnly(rdonly)
 }
 
-// ChangeType changes the tablet type
-func (agent *ActionAgent) ChangeType(ctx context.Context, tabletType topodatapb.TabletType) error {
+// SetReadOnlyWithTTL makes the mysql instance of a master read-only
+// for ttl, then read-write again. Calling it again extends or shortens
+// the read-only period, and a ttl of 0 makes the instance read-write
+// right away. The end of the period is persisted in the tablet
+// directory, so a tablet restarting in the meantime still reverts.
+// On other tablet types, a ttl of 0 only drops a pending revert, and
+// leaves read_only alone.
+func (agent *ActionAgent) SetReadOnlyWithTTL(ctx context.Context, ttl time.Duration) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	tablet := agent.Tablet()
+	if tablet.Type != topodatapb.TabletType_MASTER {
+		if ttl <= 0 {
+			agent.clearReadOnlyTTLLocked()
+			return nil
+		}
+		return fmt.Errorf("SetReadOnlyWithTTL is only supported on a master, not a %v tablet", tablet.Type)
+	}
+	if ttl <= 0 {
+		agent.clearReadOnlyTTLLocked()
+		return agent.MysqlDaemon.SetReadOnly(false)
+	}
+	if err := agent.setReadOnlyTTLLocked(time.Now().Add(ttl)); err != nil {
+		return err
+	}
+	if err := agent.MysqlDaemon.SetReadOnly(true); err != nil {
+		agent.clearReadOnlyTTLLocked()
+		return err
+	}
+	return nil
+}
+
+// SetSuperReadOnly sets or clears super_read_only, and returns its
+// resulting value, read back from mysql. Setting it also sets
+// read_only, clearing it leaves read_only alone.
+func (agent *ActionAgent) SetSuperReadOnly(ctx context.Context, on bool) (bool, error) {
+	if err := agent.lock(ctx); err != nil {
+		return false, err
+	}
+	defer agent.unlock()
+
+	qr, err := agent.MysqlDaemon.FetchSuperQuery(ctx, "SHOW VARIABLES LIKE 'super_read_only'")
+	if err != nil {
+		return false, err
+	}
+	if len(qr.Rows) != 1 {
+		return false, tmclient.ErrSuperReadOnlyNotSupported
+	}
+
+	query := "SET GLOBAL super_read_only = "
+	if on {
+		query += "ON"
+	} else {
+		query += "OFF"
+	}
+	if err := agent.MysqlDaemon.ExecuteSuperQueryList(ctx, []string{query}); err != nil {
+		return false, err
+	}
+
+	// Read the value back, it is what mysql enforces.
+	qr, err = agent.MysqlDaemon.FetchSuperQuery(ctx, "SELECT @@global.super_read_only")
+	if err != nil {
+		return false, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return false, fmt.Errorf("unexpected result for super_read_only: %v", qr)
+	}
+	switch value := qr.Rows[0][0].String(); value {
+	case "1", "ON":
+		return true, nil
+	case "0", "OFF":
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected value for super_read_only: %v", value)
+	}
+}
+
+// SetQueryServerConfig changes the query server settings of config
+// that are set, without a restart, and returns the resulting settings.
+// Queries are always consolidated: disabling it is skipped, and
+// returned in the names of the skipped settings.
+func (agent *ActionAgent) SetQueryServerConfig(ctx context.Context, config *tabletmanagerdatapb.QueryServerConfig) (*tabletmanagerdatapb.QueryServerConfig, []string, error) {
+	if err := agent.lock(ctx); err != nil {
+		return nil, nil, err
+	}
+	defer agent.unlock()
+
+	if config.PoolSize < 0 || config.StreamPoolSize < 0 || config.TransactionPoolSize < 0 || config.QueryTimeoutNs < 0 {
+		return nil, nil, fmt.Errorf("pool sizes and query timeout cannot be negative: %v", config)
+	}
+	var skipped []string
+	if config.DisableConsolidation {
+		skipped = append(skipped, "disable_consolidation")
+	}
+	if err := agent.QueryServiceControl.SetRuntimeConfig(tabletserver.RuntimeConfig{
+		PoolSize:       int(config.PoolSize),
+		StreamPoolSize: int(config.StreamPoolSize),
+		TxPoolSize:     int(config.TransactionPoolSize),
+		QueryTimeout:   time.Duration(config.QueryTimeoutNs),
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	rc := agent.QueryServiceControl.RuntimeConfig()
+	return &tabletmanagerdatapb.QueryServerConfig{
+		PoolSize:            int64(rc.PoolSize),
+		StreamPoolSize:      int64(rc.StreamPoolSize),
+		TransactionPoolSize: int64(rc.TxPoolSize),
+		QueryTimeoutNs:      int64(rc.QueryTimeout),
+	}, skipped, nil
+}
+
+// GetQueryBlacklist returns the query patterns set by SetQueryBlacklist.
+func (agent *ActionAgent) GetQueryBlacklist(ctx context.Context) ([]string, error) {
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+	return agent._queryBlacklist, nil
+}
+
+// SetQueryBlacklist replaces the query blacklist with patterns. Queries
+// fully matching any of the regular expressions are rejected. An empty
+// list clears the blacklist. The blacklist doesn't survive a restart.
+func (agent *ActionAgent) SetQueryBlacklist(ctx context.Context, patterns []string) error {
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
 	defer agent.unlock()
 
+	qrs := tabletserver.NewQueryRules()
+	for _, pattern := range patterns {
+		qr := tabletserver.NewQueryRule("enforce query blacklist", "blacklisted_query", tabletserver.QRFail)
+		if err := qr.SetQueryCond(pattern); err != nil {
+			return fmt.Errorf("invalid query pattern %q: %v", pattern, err)
+		}
+		qrs.Add(qr)
+	}
+	if err := agent.QueryServiceControl.SetQueryRules(queryBlacklistQueryRules, qrs); err != nil {
+		return err
+	}
+
+	if len(patterns) == 0 {
+		log.Infof("Clearing query blacklist")
+		patterns = nil
+	} else {
+		log.Infof("Blacklisting queries %v", strings.Join(patterns, ", "))
+	}
+	agent.mutex.Lock()
+	agent._queryBlacklist = patterns
+	agent.mutex.Unlock()
+	return nil
+}
+
+// ChangeType changes the tablet type. If idempotent is set, and the
+// tablet already has that type, it does nothing.
+func (agent *ActionAgent) ChangeType(ctx context.Context, tabletType topodatapb.TabletType, idempotent bool) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	if idempotent && agent.Tablet().Type == tabletType {
+		log.Infof("ChangeType: tablet is already %v, nothing to do", tabletType)
+		return nil
+	}
+
 	// change our type in the topology
 	_, err := topotools.ChangeType(ctx, agent.TopoServer, agent.TabletAlias, tabletType)
 	if err != nil {
@@ -71,6 +477,47 @@ func (agent *ActionAgent) ChangeType(ctx context.Context, tabletType topodatapb.
 	return nil
 }
 
+// SetTabletTags merges tags into the tags of the tablet record: a tag
+// with an empty value is removed, the others are added or replace the
+// current value. The tags survive restarts, see InitTablet.
+func (agent *ActionAgent) SetTabletTags(ctx context.Context, tags map[string]string) error {
+	for key := range tags {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("invalid tag %q: key cannot be empty", key)
+		}
+	}
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	if _, err := agent.TopoServer.UpdateTabletFields(ctx, agent.TabletAlias, func(tablet *topodatapb.Tablet) error {
+		if tablet.Tags == nil {
+			tablet.Tags = make(map[string]string)
+		}
+		for key, value := range tags {
+			if value == "" {
+				delete(tablet.Tags, key)
+			} else {
+				tablet.Tags[key] = value
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return agent.refreshTablet(ctx, "SetTabletTags")
+}
+
+// GetTabletTags returns the tags of the tablet record.
+func (agent *ActionAgent) GetTabletTags(ctx context.Context) (map[string]string, error) {
+	ti, err := agent.TopoServer.GetTablet(ctx, agent.TabletAlias)
+	if err != nil {
+		return nil, err
+	}
+	return ti.Tags, nil
+}
+
 // Sleep sleeps for the duration
 func (agent *ActionAgent) Sleep(ctx context.Context, duration time.Duration) {
 	if err := agent.lock(ctx); err != nil {
@@ -102,6 +549,67 @@ func (agent *ActionAgent) ExecuteHook(ctx context.Context, hk *hook.Hook) *hook.
 	return hr
 }
 
+// ExecuteHookToStream executes the provided hook locally, and sends
+// its stdout as it is produced, so large outputs are not buffered.
+// The last response carries the exit status and the stderr. The hook
+// is killed if ctx is canceled.
+func (agent *ActionAgent) ExecuteHookToStream(ctx context.Context, hk *hook.Hook, send func(*tabletmanagerdatapb.ExecuteHookToStreamResponse) error) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	// Execute the hook
+	topotools.ConfigureTabletHook(hk, agent.TabletAlias)
+	hr, err := streamHook(ctx, hk, send)
+
+	// We never know what the hook did, so let's refresh our state.
+	if err := agent.refreshTablet(ctx, "ExecuteHookToStream"); err != nil {
+		log.Errorf("refreshTablet after ExecuteHookToStream failed: %v", err)
+	}
+
+	if err != nil {
+		return err
+	}
+	return send(&tabletmanagerdatapb.ExecuteHookToStreamResponse{
+		Done:       true,
+		ExitStatus: int64(hr.ExitStatus),
+		Stderr:     hr.Stderr,
+	})
+}
+
+// streamHook executes the hook, and sends each chunk of its stdout.
+// It returns the first send error, if any. The hook is killed if ctx
+// is canceled, e.g. when the caller goes away.
+func streamHook(ctx context.Context, hk *hook.Hook, send func(*tabletmanagerdatapb.ExecuteHookToStreamResponse) error) (*hook.HookResult, error) {
+	w := &hookStreamWriter{send: send}
+	hr := hk.ExecuteToWriter(ctx, w)
+	if w.err == nil && ctx.Err() != nil {
+		return hr, ctx.Err()
+	}
+	return hr, w.err
+}
+
+// hookStreamWriter sends what a hook writes to its stdout. Once a send
+// fails, it drops the rest of the output rather than failing the
+// write, so the hook is not left blocked on a full pipe.
+type hookStreamWriter struct {
+	send func(*tabletmanagerdatapb.ExecuteHookToStreamResponse) error
+	err  error
+}
+
+func (w *hookStreamWriter) Write(p []byte) (int, error) {
+	if w.err == nil {
+		// p is reused by the caller, and send may keep it.
+		data := make([]byte, len(p))
+		copy(data, p)
+		w.err = w.send(&tabletmanagerdatapb.ExecuteHookToStreamResponse{
+			Stdout: data,
+		})
+	}
+	return len(p), nil
+}
+
 // RefreshState reload the tablet record from the topo server.
 func (agent *ActionAgent) RefreshState(ctx context.Context) error {
 	if err := agent.lock(ctx); err != nil {
@@ -112,6 +620,32 @@ func (agent *ActionAgent) RefreshState(ctx context.Context) error {
 	return agent.refreshTablet(ctx, "RefreshState")
 }
 
+// RepublishTopoRecord writes the in-memory tablet record to the topo
+// server, creating it if it is missing and overwriting it otherwise,
+// and returns the version written.
+func (agent *ActionAgent) RepublishTopoRecord(ctx context.Context) (int64, error) {
+	if err := agent.lock(ctx); err != nil {
+		return 0, err
+	}
+	defer agent.unlock()
+
+	// CreateTablet also fixes up the ShardReplication record, even
+	// if the tablet record exists.
+	tablet := agent.Tablet()
+	if err := agent.TopoServer.CreateTablet(ctx, tablet); err != nil && err != topo.ErrNodeExists {
+		return 0, fmt.Errorf("CreateTablet failed: %v", err)
+	}
+
+	// Then overwrite the record, ignoring version mismatch, to learn
+	// the version written.
+	ti := topo.NewTabletInfo(tablet, -1)
+	if err := agent.TopoServer.UpdateTablet(ctx, ti); err != nil {
+		return 0, fmt.Errorf("UpdateTablet failed: %v", err)
+	}
+	log.Infof("RepublishTopoRecord: wrote tablet record %v at version %v", topoproto.TabletAliasString(tablet.Alias), ti.Version())
+	return ti.Version(), nil
+}
+
 // RunHealthCheck will manually run the health check on the tablet.
 func (agent *ActionAgent) RunHealthCheck(ctx context.Context) {
 	agent.runHealthCheck()
@@ -131,3 +665,225 @@ func (agent *ActionAgent) IgnoreHealthError(ctx context.Context, pattern string)
 	agent.mutex.Unlock()
 	return nil
 }
+
+// SetMaintenanceMode sets or clears the maintenance marker of the
+// tablet. The marker is persisted in the tablet directory, so it
+// survives restarts.
+func (agent *ActionAgent) SetMaintenanceMode(ctx context.Context, on bool, reason string) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	return agent.setMaintenanceMode(on, reason)
+}
+
+// PauseHealthReporting pauses or resumes the publication of health
+// changes. While paused, health checks still run and still start or
+// stop the query service, but the health error and replication delay
+// published are the ones from the time of the pause. Pausing again
+// extends the pause. It resumes on its own after
+// -health_reporting_max_pause.
+func (agent *ActionAgent) PauseHealthReporting(ctx context.Context, on bool) error {
+	agent.mutex.Lock()
+	if on {
+		if !agent.healthReportingPausedLocked() {
+			agent._pausedHealthy = agent._healthy
+			agent._pausedReplicationDelay = agent._replicationDelay
+		}
+		agent._healthReportingPausedUntil = time.Now().Add(*healthReportingMaxPause)
+	} else {
+		agent._healthReportingPausedUntil = time.Time{}
+	}
+	agent.mutex.Unlock()
+
+	if !on {
+		// Publish the current health right away.
+		agent.runHealthCheck()
+	}
+	return nil
+}
+
+// RestartMysql restarts mysqld in a controlled way: the tablet is
+// made read-only, drained by changing its type to SPARE, mysqld is
+// stopped and started again, and replication is restarted. Once the
+// health check passes, the tablet goes back to its original type. If
+// mysqld does not come back healthy within healthyTimeout, the tablet
+// is left SPARE, so it does not serve from a broken mysqld.
+func (agent *ActionAgent) RestartMysql(ctx context.Context, healthyTimeout time.Duration, logger logutil.Logger) error {
+	if healthyTimeout <= 0 {
+		healthyTimeout = *restartMysqlHealthyTimeout
+	}
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	tablet, err := agent.TopoServer.GetTablet(ctx, agent.TabletAlias)
+	if err != nil {
+		return err
+	}
+	if tablet.Type == topodatapb.TabletType_MASTER {
+		return fmt.Errorf("type MASTER cannot restart mysqld, reparent away from it first")
+	}
+	originalType := tablet.Type
+
+	// create the loggers: tee to console and source
+	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
+
+	l.Infof("RestartMysql: setting read-only")
+	if err := agent.MysqlDaemon.SetReadOnly(true); err != nil {
+		return fmt.Errorf("cannot set read-only: %v", err)
+	}
+
+	l.Infof("RestartMysql: draining, changing type from %v to SPARE", originalType)
+	if _, err := topotools.ChangeType(ctx, agent.TopoServer, tablet.Alias, topodatapb.TabletType_SPARE); err != nil {
+		return err
+	}
+	if err := agent.refreshTablet(ctx, "RestartMysql"); err != nil {
+		return err
+	}
+
+	// From here on, errors leave the tablet SPARE.
+	l.Infof("RestartMysql: stopping mysqld")
+	if err := agent.MysqlDaemon.Shutdown(ctx, true); err != nil {
+		return fmt.Errorf("cannot stop mysqld, tablet left SPARE: %v", err)
+	}
+	l.Infof("RestartMysql: starting mysqld")
+	if err := agent.MysqlDaemon.Start(ctx); err != nil {
+		return fmt.Errorf("mysqld did not restart, tablet left SPARE: %v", err)
+	}
+	if !agent.slaveStopped() {
+		l.Infof("RestartMysql: starting replication")
+		if err := mysqlctl.StartSlave(agent.MysqlDaemon, agent.hookExtraEnv()); err != nil {
+			return fmt.Errorf("cannot start replication, tablet left SPARE: %v", err)
+		}
+	}
+
+	l.Infof("RestartMysql: waiting up to %v for mysqld to be healthy", healthyTimeout)
+	if err := agent.waitForMysqlHealthy(ctx, healthyTimeout); err != nil {
+		return fmt.Errorf("mysqld is not healthy after restart, tablet left SPARE: %v", err)
+	}
+
+	l.Infof("RestartMysql: mysqld is healthy, changing type back to %v", originalType)
+	if _, err := topotools.ChangeType(ctx, agent.TopoServer, tablet.Alias, originalType); err != nil {
+		return err
+	}
+	if err := agent.refreshTablet(ctx, "after RestartMysql"); err != nil {
+		return err
+	}
+
+	// and re-run health check to start serving again
+	agent.runHealthCheckLocked()
+	return nil
+}
+
+// waitForMysqlHealthy runs the health reporter until it reports no
+// error, and a replication lag under -unhealthy_threshold.
+func (agent *ActionAgent) waitForMysqlHealthy(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		replicationDelay, err := agent.HealthReporter.Report(true /* isSlaveType */, true /* shouldQueryServiceBeRunning */)
+		if err == health.ErrSlaveNotRunning && agent.slaveStopped() {
+			// Replication is stopped on purpose.
+			err = nil
+			replicationDelay = 0
+		}
+		if err == nil && replicationDelay > *unhealthyThreshold {
+			err = fmt.Errorf("replication lag %v is higher than unhealthy threshold %v", replicationDelay, *unhealthyThreshold)
+		}
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%v, last health error: %v", ctx.Err(), err)
+		case <-time.After(restartMysqlHealthCheckInterval):
+		}
+	}
+}
+
+// Decommission takes the tablet out of service: it is made read-only,
+// and drained by changing its type to SPARE, which stops the query
+// service after the serving state grace period. Then replication is
+// stopped if stopReplication or stopMysqld is set, and mysqld too if
+// stopMysqld is set. A master tablet is refused, it must be reparented
+// away from first.
+func (agent *ActionAgent) Decommission(ctx context.Context, stopReplication, stopMysqld bool, logger logutil.Logger) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	tablet, err := agent.TopoServer.GetTablet(ctx, agent.TabletAlias)
+	if err != nil {
+		return err
+	}
+	if tablet.Type == topodatapb.TabletType_MASTER {
+		return fmt.Errorf("type MASTER cannot be decommissioned, reparent away from it first")
+	}
+
+	// create the loggers: tee to console and source
+	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
+
+	l.Infof("Decommission: setting read-only")
+	if err := agent.MysqlDaemon.SetReadOnly(true); err != nil {
+		return fmt.Errorf("cannot set read-only: %v", err)
+	}
+
+	if tablet.Type != topodatapb.TabletType_SPARE {
+		l.Infof("Decommission: draining, changing type from %v to SPARE", tablet.Type)
+		if _, err := topotools.ChangeType(ctx, agent.TopoServer, tablet.Alias, topodatapb.TabletType_SPARE); err != nil {
+			return err
+		}
+		if err := agent.refreshTablet(ctx, "Decommission"); err != nil {
+			return err
+		}
+	}
+	if agent.QueryServiceControl.IsServing() {
+		return fmt.Errorf("query service is still serving after changing type to SPARE")
+	}
+	l.Infof("Decommission: tablet is drained, and no longer serving")
+
+	if stopReplication || stopMysqld {
+		l.Infof("Decommission: stopping replication")
+		if err := agent.stopSlaveLocked(ctx); err != nil {
+			return fmt.Errorf("cannot stop replication, tablet left SPARE: %v", err)
+		}
+	}
+	if stopMysqld {
+		l.Infof("Decommission: stopping mysqld")
+		if err := agent.MysqlDaemon.Shutdown(ctx, true); err != nil {
+			return fmt.Errorf("cannot stop mysqld, tablet left SPARE: %v", err)
+		}
+	}
+
+	// and re-run health check to broadcast the new state
+	agent.runHealthCheckLocked()
+	l.Infof("Decommission: tablet is out of service")
+	return nil
+}
+
+// CheckTopoConnectivity reads the tablet record from the local topo
+// server, and the shard record from the global one, and returns
+// whether both reads worked and how long they took. The reads are
+// bounded by -topo_connectivity_check_timeout, as an isolated tablet
+// may otherwise wait forever. It does not take the action lock, so it
+// works even if an action is stuck on the topo server.
+func (agent *ActionAgent) CheckTopoConnectivity(ctx context.Context) (bool, time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, *topoConnectivityTimeout)
+	defer cancel()
+
+	start := time.Now()
+	tablet, err := agent.TopoServer.GetTablet(ctx, agent.TabletAlias)
+	if err == nil {
+		_, err = agent.TopoServer.GetShard(ctx, tablet.Keyspace, tablet.Shard)
+	}
+	latency := time.Since(start)
+	if err != nil {
+		log.Warningf("CheckTopoConnectivity: cannot read from the topo server after %v: %v", latency, err)
+		return false, latency
+	}
+	return true, latency
+}
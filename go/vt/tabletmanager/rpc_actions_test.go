@@ -0,0 +1,1067 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/hook"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/tabletserver"
+	"github.com/youtube/vitess/go/vt/tabletserver/tabletservermock"
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/topo/memorytopo"
+	"github.com/youtube/vitess/go/vt/topotools"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// A password flag, that GetConfig should redact.
+var _ = flag.String("test-get-config-db-pass", "secret", "test password flag")
+
+func TestGetConfig(t *testing.T) {
+	oldTimeout := *finalizeReparentTimeout
+	defer func() { *finalizeReparentTimeout = oldTimeout }()
+	if err := flag.Set("finalize_external_reparent_timeout", "42s"); err != nil {
+		t.Fatalf("flag.Set failed: %v", err)
+	}
+
+	agent := &ActionAgent{}
+	config, err := agent.GetConfig(context.Background())
+	if err != nil {
+		t.Fatalf("GetConfig failed: %v", err)
+	}
+	if got, want := config["finalize_external_reparent_timeout"], "42s"; got != want {
+		t.Errorf("GetConfig()[finalize_external_reparent_timeout] = %q, want %q", got, want)
+	}
+	if got, want := config["test-get-config-db-pass"], "****"; got != want {
+		t.Errorf("GetConfig()[test-get-config-db-pass] = %q, want %q", got, want)
+	}
+}
+
+func TestGetConnectionRejectionStats(t *testing.T) {
+	controller := tabletservermock.NewController()
+	controller.Stats = tabletserver.NewQueryServiceStats("", false)
+	agent := &ActionAgent{
+		QueryServiceControl: controller,
+	}
+	rejections := controller.Stats.ConnectionRejections
+	rejections.Add(tabletserver.RejectionPoolExhausted)
+	rejections.Add(tabletserver.RejectionPoolExhausted)
+	rejections.Add(tabletserver.RejectionMaxConnections)
+	want := map[string]int64{
+		tabletserver.RejectionPoolExhausted:  2,
+		tabletserver.RejectionMaxConnections: 1,
+	}
+
+	got, err := agent.GetConnectionRejectionStats(context.Background(), false)
+	if err != nil {
+		t.Fatalf("GetConnectionRejectionStats failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetConnectionRejectionStats(false) = %v, want %v", got, want)
+	}
+
+	// Reading with reset returns the same counts, and clears them.
+	got, err = agent.GetConnectionRejectionStats(context.Background(), true)
+	if err != nil {
+		t.Fatalf("GetConnectionRejectionStats failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetConnectionRejectionStats(true) = %v, want %v", got, want)
+	}
+	rejections.Add(tabletserver.RejectionPoolClosed)
+	got, err = agent.GetConnectionRejectionStats(context.Background(), false)
+	if err != nil {
+		t.Fatalf("GetConnectionRejectionStats failed: %v", err)
+	}
+	if want := map[string]int64{tabletserver.RejectionPoolClosed: 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetConnectionRejectionStats() after a reset = %v, want %v", got, want)
+	}
+}
+
+func TestGetInFlightRPCs(t *testing.T) {
+	agent := &ActionAgent{}
+	ctx := context.Background()
+
+	doneBackup := agent.TrackRPC("Backup")
+	doneFetch1 := agent.TrackRPC("ExecuteFetchAsDba")
+	doneFetch2 := agent.TrackRPC("ExecuteFetchAsDba")
+	got, err := agent.GetInFlightRPCs(ctx)
+	if err != nil {
+		t.Fatalf("GetInFlightRPCs failed: %v", err)
+	}
+	want := map[string]int64{
+		"Backup":            1,
+		"ExecuteFetchAsDba": 2,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetInFlightRPCs() = %v, want %v", got, want)
+	}
+
+	// Finished RPCs are no longer reported.
+	doneFetch1()
+	doneBackup()
+	got, err = agent.GetInFlightRPCs(ctx)
+	if err != nil {
+		t.Fatalf("GetInFlightRPCs failed: %v", err)
+	}
+	want = map[string]int64{
+		"ExecuteFetchAsDba": 1,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetInFlightRPCs() = %v, want %v", got, want)
+	}
+
+	doneFetch2()
+	got, err = agent.GetInFlightRPCs(ctx)
+	if err != nil {
+		t.Fatalf("GetInFlightRPCs failed: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("GetInFlightRPCs() = %v, want empty", got)
+	}
+}
+
+func TestGetProcessStats(t *testing.T) {
+	ctx := context.Background()
+	agent := &ActionAgent{}
+
+	before, err := agent.GetProcessStats(ctx)
+	if err != nil {
+		t.Fatalf("GetProcessStats failed: %v", err)
+	}
+	if before.Goroutines < 1 || before.Threads < 1 || before.HeapAllocBytes == 0 || before.SysBytes < before.HeapAllocBytes {
+		t.Errorf("GetProcessStats() = %v, want some goroutines, threads and memory", before)
+	}
+
+	// Leak a few goroutines and a file, and see them counted.
+	const leaked = 10
+	done := make(chan struct{})
+	defer close(done)
+	for i := 0; i < leaked; i++ {
+		go func() {
+			<-done
+		}()
+	}
+	f, err := ioutil.TempFile("", "process_stats_test")
+	if err != nil {
+		t.Fatalf("TempFile failed: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	after, err := agent.GetProcessStats(ctx)
+	if err != nil {
+		t.Fatalf("GetProcessStats failed: %v", err)
+	}
+	if after.Goroutines < before.Goroutines+leaked {
+		t.Errorf("GetProcessStats().Goroutines = %v after leaking %v, was %v", after.Goroutines, leaked, before.Goroutines)
+	}
+	if before.OpenFiles != -1 && after.OpenFiles != before.OpenFiles+1 {
+		t.Errorf("GetProcessStats().OpenFiles = %v after opening a file, was %v", after.OpenFiles, before.OpenFiles)
+	}
+}
+
+func TestGetErrorLogTail(t *testing.T) {
+	f, err := ioutil.TempFile("", "error_log_tail_test")
+	if err != nil {
+		t.Fatalf("ioutil.TempFile failed: %v", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString("line 1\nline 2\nline 3\n"); err != nil {
+		t.Fatalf("WriteString failed: %v", err)
+	}
+	f.Close()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Mycnf = &mysqlctl.Mycnf{ErrorLogPath: f.Name()}
+	agent := &ActionAgent{MysqlDaemon: mysqlDaemon}
+	ctx := context.Background()
+
+	got, err := agent.GetErrorLogTail(ctx, 2)
+	if err != nil {
+		t.Fatalf("GetErrorLogTail failed: %v", err)
+	}
+	if want := []string{"line 2", "line 3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetErrorLogTail(2) = %v, want %v", got, want)
+	}
+	if _, err := agent.GetErrorLogTail(ctx, 0); err == nil {
+		t.Errorf("GetErrorLogTail(0) worked")
+	}
+}
+
+func TestCaptureDiagnostics(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "capture_diagnostics_test")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dataDir)
+	errorLog := path.Join(dataDir, "error.log")
+	if err := ioutil.WriteFile(errorLog, []byte("line 1\nline 2\n"), 0600); err != nil {
+		t.Fatalf("ioutil.WriteFile failed: %v", err)
+	}
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Mycnf = &mysqlctl.Mycnf{
+		DataDir:      dataDir,
+		ErrorLogPath: errorLog,
+	}
+	mysqlDaemon.Replicating = true
+	mysqlDaemon.SecondsBehindMaster = 12
+	mysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW FULL PROCESSLIST": {
+			Rows: [][]sqltypes.Value{{
+				sqltypes.MakeString([]byte("12")),
+				sqltypes.MakeString([]byte("vt_app")),
+				sqltypes.MakeString([]byte("localhost")),
+				sqltypes.MakeString([]byte("vt_ks")),
+				sqltypes.MakeString([]byte("Query")),
+				sqltypes.MakeString([]byte("300")),
+				sqltypes.MakeString([]byte("Sending data")),
+				sqltypes.MakeString([]byte("select * from t1")),
+			}},
+		},
+		diagnosticsVariablesQuery: {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte("read_only")), sqltypes.MakeString([]byte("ON"))},
+				{sqltypes.MakeString([]byte("server_id")), sqltypes.MakeString([]byte("42"))},
+			},
+		},
+	}
+	agent := &ActionAgent{MysqlDaemon: mysqlDaemon}
+	ctx := context.Background()
+
+	bundle, err := agent.CaptureDiagnostics(ctx)
+	if err != nil {
+		t.Fatalf("CaptureDiagnostics failed: %v", err)
+	}
+	if len(bundle.Errors) != 0 {
+		t.Errorf("CaptureDiagnostics() errors = %v, want none", bundle.Errors)
+	}
+	if len(bundle.Processes) != 1 || bundle.Processes[0].Info != "select * from t1" {
+		t.Errorf("CaptureDiagnostics() processes = %v, want the query thread", bundle.Processes)
+	}
+	if bundle.SlaveStatus == nil || bundle.SlaveStatus.SecondsBehindMaster != 12 {
+		t.Errorf("CaptureDiagnostics() slave status = %v, want 12s behind", bundle.SlaveStatus)
+	}
+	if want := []string{"line 1", "line 2"}; !reflect.DeepEqual(bundle.ErrorLogTail, want) {
+		t.Errorf("CaptureDiagnostics() error log tail = %v, want %v", bundle.ErrorLogTail, want)
+	}
+	if want := map[string]string{"read_only": "ON", "server_id": "42"}; !reflect.DeepEqual(bundle.Variables, want) {
+		t.Errorf("CaptureDiagnostics() variables = %v, want %v", bundle.Variables, want)
+	}
+	if bundle.DataDirTotalBytes <= 0 || bundle.DataDirFreeBytes < 0 || bundle.DataDirFreeBytes > bundle.DataDirTotalBytes {
+		t.Errorf("CaptureDiagnostics() disk = %v free of %v bytes, want sane values", bundle.DataDirFreeBytes, bundle.DataDirTotalBytes)
+	}
+
+	// A master has no slave status, and a failing section doesn't
+	// prevent the others from being captured.
+	mysqlDaemon.SlaveStatusError = mysqlctl.ErrNotSlave
+	delete(mysqlDaemon.FetchSuperQueryMap, diagnosticsVariablesQuery)
+	bundle, err = agent.CaptureDiagnostics(ctx)
+	if err != nil {
+		t.Fatalf("CaptureDiagnostics failed: %v", err)
+	}
+	if bundle.SlaveStatus != nil {
+		t.Errorf("CaptureDiagnostics() on a master returned slave status %v", bundle.SlaveStatus)
+	}
+	if len(bundle.Errors) != 1 || !strings.HasPrefix(bundle.Errors[0], "variables: ") {
+		t.Errorf("CaptureDiagnostics() errors = %v, want a variables error", bundle.Errors)
+	}
+	if len(bundle.Processes) != 1 || len(bundle.ErrorLogTail) != 2 || bundle.DataDirTotalBytes <= 0 {
+		t.Errorf("CaptureDiagnostics() = %v, want the other sections", bundle)
+	}
+}
+
+func TestSetSuperReadOnly(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW VARIABLES LIKE 'super_read_only'": {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte("super_read_only")), sqltypes.MakeString([]byte("OFF"))},
+			},
+		},
+	}
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"SET GLOBAL super_read_only = ON",
+		"SET GLOBAL super_read_only = OFF",
+	}
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+	}
+
+	// Enable, then disable. The value read back from mysql is
+	// returned.
+	for _, tc := range []struct {
+		on    bool
+		value string
+	}{
+		{true, "1"},
+		{false, "0"},
+	} {
+		mysqlDaemon.FetchSuperQueryMap["SELECT @@global.super_read_only"] = &sqltypes.Result{
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte(tc.value))},
+			},
+		}
+		superReadOnly, err := agent.SetSuperReadOnly(ctx, tc.on)
+		if err != nil {
+			t.Fatalf("SetSuperReadOnly(%v) failed: %v", tc.on, err)
+		}
+		if superReadOnly != tc.on {
+			t.Errorf("SetSuperReadOnly(%v) = %v, want %v", tc.on, superReadOnly, tc.on)
+		}
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("CheckSuperQueryList failed: %v", err)
+	}
+
+	// The setting may not stick, e.g. if mysql refused to clear it.
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"SET GLOBAL super_read_only = OFF",
+	}
+	mysqlDaemon.ExpectedExecuteSuperQueryCurrent = 0
+	mysqlDaemon.FetchSuperQueryMap["SELECT @@global.super_read_only"] = &sqltypes.Result{
+		Rows: [][]sqltypes.Value{
+			{sqltypes.MakeString([]byte("1"))},
+		},
+	}
+	if superReadOnly, err := agent.SetSuperReadOnly(ctx, false); err != nil || !superReadOnly {
+		t.Errorf("SetSuperReadOnly(false) = (%v, %v), want the value read back: (true, nil)", superReadOnly, err)
+	}
+
+	// MySQL versions without super_read_only have no such variable.
+	mysqlDaemon.FetchSuperQueryMap["SHOW VARIABLES LIKE 'super_read_only'"] = &sqltypes.Result{}
+	if _, err := agent.SetSuperReadOnly(ctx, true); err != tmclient.ErrSuperReadOnlyNotSupported {
+		t.Errorf("SetSuperReadOnly on an unsupported version = %v, want %v", err, tmclient.ErrSuperReadOnlyNotSupported)
+	}
+}
+
+func TestStreamHook(t *testing.T) {
+	root, err := ioutil.TempDir("", "stream_hook_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	if err := os.Mkdir(path.Join(root, "vthook"), os.ModePerm); err != nil {
+		t.Fatalf("Mkdir failed: %v", err)
+	}
+	// The hook writes more than a pipe buffer, so its stdout is
+	// streamed in several chunks.
+	const outputSize = 1024 * 1024
+	script := "#!/bin/sh\nhead -c 1048576 /dev/zero\necho done >&2\nexit 3\n"
+	if err := ioutil.WriteFile(path.Join(root, "vthook", "dump"), []byte(script), 0755); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	oldRoot := os.Getenv("VTROOT")
+	os.Setenv("VTROOT", root)
+	defer os.Setenv("VTROOT", oldRoot)
+
+	var chunks int
+	var size int
+	hr, err := streamHook(context.Background(), hook.NewSimpleHook("dump"), func(response *tabletmanagerdatapb.ExecuteHookToStreamResponse) error {
+		chunks++
+		size += len(response.Stdout)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("streamHook failed: %v", err)
+	}
+	if chunks < 2 || size != outputSize {
+		t.Errorf("streamHook sent %v bytes in %v chunks, want %v bytes in several chunks", size, chunks, outputSize)
+	}
+	if hr.ExitStatus != 3 || hr.Stderr != "done\n" || hr.Stdout != "" {
+		t.Errorf("streamHook result = %v, want exit status 3 and stderr only", hr)
+	}
+
+	// A failed send is returned, and the hook still runs to
+	// completion instead of blocking on its stdout.
+	sendErr := errors.New("client went away")
+	sends := 0
+	hr, err = streamHook(context.Background(), hook.NewSimpleHook("dump"), func(response *tabletmanagerdatapb.ExecuteHookToStreamResponse) error {
+		sends++
+		return sendErr
+	})
+	if err != sendErr {
+		t.Errorf("streamHook with a failing send = %v, want %v", err, sendErr)
+	}
+	if sends != 1 || hr.ExitStatus != 3 {
+		t.Errorf("streamHook with a failing send: %v sends, result %v, want 1 send and exit status 3", sends, hr)
+	}
+
+	// Canceling the context kills the hook, and the processes it
+	// started, once it has sent its first chunk.
+	script = "#!/bin/sh\necho started\nsleep 60\necho done\n"
+	if err := ioutil.WriteFile(path.Join(root, "vthook", "hang"), []byte(script), 0755); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	start := time.Now()
+	hr, err = streamHook(ctx, hook.NewSimpleHook("hang"), func(response *tabletmanagerdatapb.ExecuteHookToStreamResponse) error {
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("streamHook with a canceled context = %v, want %v", err, context.Canceled)
+	}
+	if hr.ExitStatus != hook.HOOK_GENERIC_ERROR || time.Since(start) > 30*time.Second {
+		t.Errorf("streamHook with a canceled context: result %v after %v, want the hook killed", hr, time.Since(start))
+	}
+}
+
+func TestRepublishTopoRecord(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	tablet := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "cell1",
+			Uid:  1,
+		},
+		Hostname: "host1",
+		PortMap: map[string]int32{
+			"vt":   100,
+			"grpc": 101,
+		},
+		Keyspace: "ks",
+		Shard:    "-80",
+		KeyRange: &topodatapb.KeyRange{
+			End: []byte{0x80},
+		},
+		Type: topodatapb.TabletType_REPLICA,
+	}
+	agent := &ActionAgent{
+		TopoServer:  ts,
+		TabletAlias: tablet.Alias,
+		_tablet:     tablet,
+	}
+
+	// checkRecord checks the topo record matches the in-memory tablet,
+	// at the given version.
+	checkRecord := func(version int64) {
+		ti, err := ts.GetTablet(ctx, tablet.Alias)
+		if err != nil {
+			t.Fatalf("GetTablet failed: %v", err)
+		}
+		if !reflect.DeepEqual(ti.Tablet, tablet) {
+			t.Errorf("tablet record is %v, want %v", ti.Tablet, tablet)
+		}
+		if ti.Version() != version {
+			t.Errorf("tablet record is at version %v, RepublishTopoRecord returned %v", ti.Version(), version)
+		}
+	}
+
+	// A missing record is created.
+	version, err := agent.RepublishTopoRecord(ctx)
+	if err != nil {
+		t.Fatalf("RepublishTopoRecord failed: %v", err)
+	}
+	checkRecord(version)
+	sri, err := ts.GetShardReplication(ctx, "cell1", "ks", "-80")
+	if err != nil {
+		t.Fatalf("GetShardReplication failed: %v", err)
+	}
+	if _, err := sri.GetShardReplicationNode(tablet.Alias); err != nil {
+		t.Errorf("tablet is not in the shard replication graph: %v", err)
+	}
+
+	// A modified record is rewritten, at a new version.
+	modified, err := ts.UpdateTabletFields(ctx, tablet.Alias, func(t *topodatapb.Tablet) error {
+		t.Hostname = "wrong"
+		t.Type = topodatapb.TabletType_SPARE
+		t.KeyRange = nil
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("UpdateTabletFields failed: %v", err)
+	}
+	if modified == nil {
+		t.Fatalf("UpdateTabletFields did not modify the record")
+	}
+	oldVersion := version
+	version, err = agent.RepublishTopoRecord(ctx)
+	if err != nil {
+		t.Fatalf("RepublishTopoRecord failed: %v", err)
+	}
+	if version == oldVersion {
+		t.Errorf("RepublishTopoRecord returned the previous version %v", version)
+	}
+	checkRecord(version)
+}
+
+func TestChangeTypeIdempotent(t *testing.T) {
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+
+	ti, err := agent.TopoServer.GetTablet(ctx, agent.TabletAlias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	version := ti.Version()
+	healthyTime := agent._healthyTime
+
+	// The tablet is already a REPLICA: nothing should happen.
+	if err := agent.ChangeType(ctx, topodatapb.TabletType_REPLICA, true /*idempotent*/); err != nil {
+		t.Fatalf("ChangeType(idempotent) failed: %v", err)
+	}
+	ti, err = agent.TopoServer.GetTablet(ctx, agent.TabletAlias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	if ti.Version() != version {
+		t.Errorf("ChangeType(idempotent) updated the tablet record: version is %v, was %v", ti.Version(), version)
+	}
+	if agent._healthyTime != healthyTime {
+		t.Errorf("ChangeType(idempotent) ran a health check")
+	}
+
+	// Without the flag, the transition is run again.
+	if err := agent.ChangeType(ctx, topodatapb.TabletType_REPLICA, false /*idempotent*/); err != nil {
+		t.Fatalf("ChangeType failed: %v", err)
+	}
+	ti, err = agent.TopoServer.GetTablet(ctx, agent.TabletAlias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	if ti.Version() == version {
+		t.Errorf("ChangeType did not update the tablet record")
+	}
+
+	// A different type is still applied with the flag.
+	if err := agent.ChangeType(ctx, topodatapb.TabletType_RDONLY, true /*idempotent*/); err != nil {
+		t.Fatalf("ChangeType(idempotent) failed: %v", err)
+	}
+	if got := agent.Tablet().Type; got != topodatapb.TabletType_RDONLY {
+		t.Errorf("ChangeType(idempotent, RDONLY) left the tablet as %v", got)
+	}
+}
+
+func TestTabletTags(t *testing.T) {
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+
+	if err := agent.SetTabletTags(ctx, map[string]string{"canary": "true", "owner": "team-x"}); err != nil {
+		t.Fatalf("SetTabletTags failed: %v", err)
+	}
+	tags, err := agent.GetTabletTags(ctx)
+	if err != nil {
+		t.Fatalf("GetTabletTags failed: %v", err)
+	}
+	if want := map[string]string{"canary": "true", "owner": "team-x"}; !reflect.DeepEqual(tags, want) {
+		t.Errorf("GetTabletTags() = %v, want %v", tags, want)
+	}
+
+	// New tags are merged, existing ones overwritten, and empty
+	// values remove the tag.
+	if err := agent.SetTabletTags(ctx, map[string]string{"owner": "team-y", "canary": "", "zone": "b"}); err != nil {
+		t.Fatalf("SetTabletTags failed: %v", err)
+	}
+	tags, err = agent.GetTabletTags(ctx)
+	if err != nil {
+		t.Fatalf("GetTabletTags failed: %v", err)
+	}
+	want := map[string]string{"owner": "team-y", "zone": "b"}
+	if !reflect.DeepEqual(tags, want) {
+		t.Errorf("GetTabletTags() = %v, want %v", tags, want)
+	}
+	if got := agent.Tablet().Tags; !reflect.DeepEqual(got, want) {
+		t.Errorf("agent.Tablet().Tags = %v, want %v", got, want)
+	}
+
+	// Empty keys are rejected, and nothing is changed.
+	for _, key := range []string{"", " "} {
+		if err := agent.SetTabletTags(ctx, map[string]string{key: "x", "other": "y"}); err == nil {
+			t.Errorf("SetTabletTags(%q) worked", key)
+		}
+	}
+	tags, err = agent.GetTabletTags(ctx)
+	if err != nil {
+		t.Fatalf("GetTabletTags failed: %v", err)
+	}
+	if !reflect.DeepEqual(tags, want) {
+		t.Errorf("GetTabletTags() after invalid SetTabletTags = %v, want %v", tags, want)
+	}
+}
+
+func TestSetQueryServerConfig(t *testing.T) {
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+	qsc := agent.QueryServiceControl.(*tabletservermock.Controller)
+	qsc.CurrentRuntimeConfig = tabletserver.RuntimeConfig{
+		PoolSize:       16,
+		StreamPoolSize: 200,
+		TxPoolSize:     20,
+		QueryTimeout:   30 * time.Second,
+	}
+
+	// The settings that are set are changed, the others are kept.
+	applied, skipped, err := agent.SetQueryServerConfig(ctx, &tabletmanagerdatapb.QueryServerConfig{
+		PoolSize:       32,
+		QueryTimeoutNs: int64(10 * time.Second),
+	})
+	if err != nil {
+		t.Fatalf("SetQueryServerConfig failed: %v", err)
+	}
+	want := &tabletmanagerdatapb.QueryServerConfig{
+		PoolSize:            32,
+		StreamPoolSize:      200,
+		TransactionPoolSize: 20,
+		QueryTimeoutNs:      int64(10 * time.Second),
+	}
+	if !reflect.DeepEqual(applied, want) {
+		t.Errorf("SetQueryServerConfig applied %v, want %v", applied, want)
+	}
+	if len(skipped) != 0 {
+		t.Errorf("SetQueryServerConfig skipped %v, want nothing", skipped)
+	}
+
+	// Consolidation cannot be disabled at runtime, but the other
+	// settings are still applied.
+	applied, skipped, err = agent.SetQueryServerConfig(ctx, &tabletmanagerdatapb.QueryServerConfig{
+		TransactionPoolSize:  40,
+		DisableConsolidation: true,
+	})
+	if err != nil {
+		t.Fatalf("SetQueryServerConfig failed: %v", err)
+	}
+	if applied.TransactionPoolSize != 40 || applied.DisableConsolidation {
+		t.Errorf("SetQueryServerConfig applied %v, want transaction_pool_size 40 and consolidation on", applied)
+	}
+	if want := []string{"disable_consolidation"}; !reflect.DeepEqual(skipped, want) {
+		t.Errorf("SetQueryServerConfig skipped %v, want %v", skipped, want)
+	}
+
+	// Invalid values fail the call, and change nothing.
+	if _, _, err := agent.SetQueryServerConfig(ctx, &tabletmanagerdatapb.QueryServerConfig{PoolSize: 8, StreamPoolSize: -1}); err == nil {
+		t.Errorf("SetQueryServerConfig with a negative pool size worked")
+	}
+	if qsc.CurrentRuntimeConfig.PoolSize != 32 {
+		t.Errorf("a failed SetQueryServerConfig changed the pool size to %v", qsc.CurrentRuntimeConfig.PoolSize)
+	}
+}
+
+func TestQueryBlacklist(t *testing.T) {
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+	qsc := agent.QueryServiceControl.(*tabletservermock.Controller)
+
+	// Set the blacklist, and read it back.
+	patterns := []string{"select .* from t1", "delete from t2 where .*"}
+	if err := agent.SetQueryBlacklist(ctx, patterns); err != nil {
+		t.Fatalf("SetQueryBlacklist failed: %v", err)
+	}
+	got, err := agent.GetQueryBlacklist(ctx)
+	if err != nil {
+		t.Fatalf("GetQueryBlacklist failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, patterns) {
+		t.Errorf("GetQueryBlacklist returned %v, want %v", got, patterns)
+	}
+	qrs := qsc.QueryRules[queryBlacklistQueryRules]
+	if qrs == nil || qrs.Find("blacklisted_query") == nil {
+		t.Fatalf("SetQueryBlacklist didn't set the query rules: %v", qrs)
+	}
+	data, err := json.Marshal(qrs)
+	if err != nil {
+		t.Fatalf("cannot marshal query rules: %v", err)
+	}
+	for _, pattern := range patterns {
+		if !strings.Contains(string(data), pattern) {
+			t.Errorf("query rules %s don't have pattern %q", data, pattern)
+		}
+	}
+
+	// An invalid pattern fails the call, and changes nothing.
+	if err := agent.SetQueryBlacklist(ctx, []string{"select (", "select 1"}); err == nil {
+		t.Errorf("SetQueryBlacklist with an invalid pattern worked")
+	}
+	if got, _ := agent.GetQueryBlacklist(ctx); !reflect.DeepEqual(got, patterns) {
+		t.Errorf("a failed SetQueryBlacklist changed the blacklist to %v", got)
+	}
+
+	// Clear the blacklist.
+	if err := agent.SetQueryBlacklist(ctx, nil); err != nil {
+		t.Fatalf("SetQueryBlacklist(nil) failed: %v", err)
+	}
+	if got, _ := agent.GetQueryBlacklist(ctx); len(got) != 0 {
+		t.Errorf("GetQueryBlacklist returned %v after clearing, want nothing", got)
+	}
+	if qr := qsc.QueryRules[queryBlacklistQueryRules].Find("blacklisted_query"); qr != nil {
+		t.Errorf("clearing the blacklist left rule %v", qr)
+	}
+}
+
+func TestRestartMysql(t *testing.T) {
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+	fmd := agent.MysqlDaemon.(*mysqlctl.FakeMysqlDaemon)
+	fmd.Running = true
+	fmd.ExpectedExecuteSuperQueryList = []string{"START SLAVE"}
+	agent.runHealthCheck()
+	if !agent.QueryServiceControl.IsServing() {
+		t.Fatalf("Query service should be running before RestartMysql")
+	}
+
+	logger := logutil.NewMemoryLogger()
+	if err := agent.RestartMysql(ctx, time.Second, logger); err != nil {
+		t.Fatalf("RestartMysql failed: %v", err)
+	}
+	if !strings.Contains(logger.String(), "stopping mysqld") || !strings.Contains(logger.String(), "starting mysqld") {
+		t.Errorf("RestartMysql did not log its progress: %v", logger.String())
+	}
+
+	// mysqld was restarted, with replication, and the tablet is
+	// serving again as a read-only REPLICA.
+	if !fmd.Running || !fmd.Replicating || !fmd.ReadOnly {
+		t.Errorf("after RestartMysql, mysqld running: %v, replicating: %v, read-only: %v, want all true", fmd.Running, fmd.Replicating, fmd.ReadOnly)
+	}
+	if err := fmd.CheckSuperQueryList(); err != nil {
+		t.Errorf("RestartMysql did not restart replication: %v", err)
+	}
+	ti, err := agent.TopoServer.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	if ti.Type != topodatapb.TabletType_REPLICA {
+		t.Errorf("after RestartMysql, tablet type is %v, want REPLICA", ti.Type)
+	}
+	if !agent.QueryServiceControl.IsServing() {
+		t.Errorf("Query service should be running after RestartMysql")
+	}
+}
+
+func TestDecommission(t *testing.T) {
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+	fmd := agent.MysqlDaemon.(*mysqlctl.FakeMysqlDaemon)
+	fmd.Running = true
+	fmd.ExpectedExecuteSuperQueryList = []string{"START SLAVE"}
+	agent.runHealthCheck()
+	if !agent.QueryServiceControl.IsServing() {
+		t.Fatalf("Query service should be running before Decommission")
+	}
+
+	fmd.ExpectedExecuteSuperQueryList = []string{"STOP SLAVE"}
+	fmd.ExpectedExecuteSuperQueryCurrent = 0
+	logger := logutil.NewMemoryLogger()
+	if err := agent.Decommission(ctx, false /*stopReplication*/, true /*stopMysqld*/, logger); err != nil {
+		t.Fatalf("Decommission failed: %v", err)
+	}
+	if !strings.Contains(logger.String(), "draining") || !strings.Contains(logger.String(), "stopping mysqld") {
+		t.Errorf("Decommission did not log its progress: %v", logger.String())
+	}
+
+	// The tablet is a read-only SPARE that does not serve, and
+	// replication and mysqld are stopped.
+	ti, err := agent.TopoServer.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	if ti.Type != topodatapb.TabletType_SPARE {
+		t.Errorf("after Decommission, tablet type is %v, want SPARE", ti.Type)
+	}
+	if agent.QueryServiceControl.IsServing() {
+		t.Errorf("Query service should not be running after Decommission")
+	}
+	if fmd.Running || fmd.Replicating || !fmd.ReadOnly {
+		t.Errorf("after Decommission, mysqld running: %v, replicating: %v, read-only: %v, want false, false, true", fmd.Running, fmd.Replicating, fmd.ReadOnly)
+	}
+	if err := fmd.CheckSuperQueryList(); err != nil {
+		t.Errorf("Decommission did not stop replication: %v", err)
+	}
+	if !agent.slaveStopped() {
+		t.Errorf("Decommission did not remember replication was stopped")
+	}
+}
+
+func TestDecommissionMaster(t *testing.T) {
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+	fmd := agent.MysqlDaemon.(*mysqlctl.FakeMysqlDaemon)
+	fmd.Running = true
+	if _, err := topotools.ChangeType(ctx, agent.TopoServer, tabletAlias, topodatapb.TabletType_MASTER); err != nil {
+		t.Fatalf("ChangeType failed: %v", err)
+	}
+
+	err := agent.Decommission(ctx, true /*stopReplication*/, true /*stopMysqld*/, logutil.NewMemoryLogger())
+	if err == nil || !strings.Contains(err.Error(), "reparent away from it first") {
+		t.Fatalf("Decommission of a master returned %v, want a refusal", err)
+	}
+
+	// Nothing was changed.
+	ti, err := agent.TopoServer.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	if ti.Type != topodatapb.TabletType_MASTER {
+		t.Errorf("after a refused Decommission, tablet type is %v, want MASTER", ti.Type)
+	}
+	if !fmd.Running {
+		t.Errorf("a refused Decommission stopped mysqld")
+	}
+}
+
+func TestRestartMysqlNotHealthy(t *testing.T) {
+	defer func(interval time.Duration) {
+		restartMysqlHealthCheckInterval = interval
+	}(restartMysqlHealthCheckInterval)
+	restartMysqlHealthCheckInterval = 10 * time.Millisecond
+
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+	fmd := agent.MysqlDaemon.(*mysqlctl.FakeMysqlDaemon)
+	fmd.Running = true
+	fmd.ExpectedExecuteSuperQueryList = []string{"START SLAVE"}
+	agent.runHealthCheck()
+
+	// mysqld comes back, but never gets healthy.
+	agent.HealthReporter.(*fakeHealthCheck).reportError = errors.New("mysqld is still recovering")
+	err := agent.RestartMysql(ctx, 100*time.Millisecond, logutil.NewMemoryLogger())
+	if err == nil || !strings.Contains(err.Error(), "mysqld is still recovering") {
+		t.Fatalf("RestartMysql returned %v, want the health error", err)
+	}
+
+	// The tablet is left SPARE, and does not serve.
+	ti, err := agent.TopoServer.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	if ti.Type != topodatapb.TabletType_SPARE {
+		t.Errorf("after a failed RestartMysql, tablet type is %v, want SPARE", ti.Type)
+	}
+	if agent.QueryServiceControl.IsServing() {
+		t.Errorf("Query service should not be running after a failed RestartMysql")
+	}
+
+	// Neither does a later health check put it back in service.
+	agent.HealthReporter.(*fakeHealthCheck).reportError = nil
+	agent.runHealthCheck()
+	if agent.QueryServiceControl.IsServing() {
+		t.Errorf("Query service should not be running for a SPARE tablet")
+	}
+}
+
+// unreachableTopo is a topo.Impl that cannot reach the topo server
+// once unreachable is set.
+type unreachableTopo struct {
+	topo.Impl
+	unreachable bool
+}
+
+func (ut *unreachableTopo) GetTablet(ctx context.Context, alias *topodatapb.TabletAlias) (*topodatapb.Tablet, int64, error) {
+	if ut.unreachable {
+		return nil, 0, errors.New("dial tcp: connection refused")
+	}
+	return ut.Impl.GetTablet(ctx, alias)
+}
+
+func TestCheckTopoConnectivity(t *testing.T) {
+	ctx := context.Background()
+	ut := &unreachableTopo{Impl: memorytopo.New("cell1")}
+	ts := topo.Server{Impl: ut}
+	if err := ts.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatalf("CreateShard failed: %v", err)
+	}
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 1},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_REPLICA,
+	}
+	if err := ts.CreateTablet(ctx, tablet); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+	agent := &ActionAgent{
+		TopoServer:  ts,
+		TabletAlias: tablet.Alias,
+		_tablet:     tablet,
+	}
+
+	if reachable, latency := agent.CheckTopoConnectivity(ctx); !reachable {
+		t.Errorf("CheckTopoConnectivity() = (%v, %v), want reachable", reachable, latency)
+	}
+
+	// An isolated tablet reports it, instead of failing.
+	ut.unreachable = true
+	if reachable, _ := agent.CheckTopoConnectivity(ctx); reachable {
+		t.Errorf("CheckTopoConnectivity() with an unreachable topo server returned reachable")
+	}
+}
+
+// newReadOnlyTTLAgent returns a master agent, with a tablet directory
+// for the read-only TTL marker, and its fake mysql.
+func newReadOnlyTTLAgent(tabletDir string) (*ActionAgent, *mysqlctl.FakeMysqlDaemon) {
+	fakeMysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	return &ActionAgent{
+		MysqlDaemon: &tabletDirMysqlDaemon{
+			FakeMysqlDaemon: fakeMysqlDaemon,
+			tabletDir:       tabletDir,
+		},
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Type: topodatapb.TabletType_MASTER,
+		},
+	}, fakeMysqlDaemon
+}
+
+// readOnlyTTLState returns whether mysql is read-only and a revert is
+// pending, under actionMutex like the revert.
+func readOnlyTTLState(agent *ActionAgent, fakeMysqlDaemon *mysqlctl.FakeMysqlDaemon) (bool, bool) {
+	agent.actionMutex.Lock()
+	defer agent.actionMutex.Unlock()
+	return fakeMysqlDaemon.ReadOnly, !agent._readOnlyTTLExpiry.IsZero()
+}
+
+// waitForReadWrite waits for the pending revert to make mysql
+// read-write.
+func waitForReadWrite(t *testing.T, agent *ActionAgent, fakeMysqlDaemon *mysqlctl.FakeMysqlDaemon) {
+	timeout := time.After(5 * time.Second)
+	for {
+		readOnly, pending := readOnlyTTLState(agent, fakeMysqlDaemon)
+		if !readOnly && !pending {
+			return
+		}
+		select {
+		case <-timeout:
+			t.Fatalf("mysql was not made read-write at the end of the TTL")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestSetReadOnlyWithTTLAutoRevert(t *testing.T) {
+	ctx := context.Background()
+	tabletDir, err := ioutil.TempDir("", "read_only_ttl_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(tabletDir)
+	agent, fakeMysqlDaemon := newReadOnlyTTLAgent(tabletDir)
+
+	if err := agent.SetReadOnlyWithTTL(ctx, 100*time.Millisecond); err != nil {
+		t.Fatalf("SetReadOnlyWithTTL failed: %v", err)
+	}
+	if readOnly, pending := readOnlyTTLState(agent, fakeMysqlDaemon); !readOnly || !pending {
+		t.Errorf("after SetReadOnlyWithTTL, read-only is %v and revert pending is %v, want true and true", readOnly, pending)
+	}
+	waitForReadWrite(t, agent, fakeMysqlDaemon)
+	if _, err := os.Stat(path.Join(tabletDir, readOnlyTTLFile)); !os.IsNotExist(err) {
+		t.Errorf("read-only TTL marker still exists after the revert: %v", err)
+	}
+
+	// Replicas are read-only anyway.
+	agent._tablet.Type = topodatapb.TabletType_REPLICA
+	if err := agent.SetReadOnlyWithTTL(ctx, time.Minute); err == nil {
+		t.Errorf("SetReadOnlyWithTTL on a replica worked")
+	}
+}
+
+func TestSetReadOnlyWithTTLClearReplica(t *testing.T) {
+	ctx := context.Background()
+	tabletDir, err := ioutil.TempDir("", "read_only_ttl_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(tabletDir)
+	agent, fakeMysqlDaemon := newReadOnlyTTLAgent(tabletDir)
+
+	// A master with a pending revert becomes a replica: clearing
+	// drops the revert, and leaves it read-only.
+	if err := agent.SetReadOnlyWithTTL(ctx, time.Hour); err != nil {
+		t.Fatalf("SetReadOnlyWithTTL failed: %v", err)
+	}
+	agent._tablet.Type = topodatapb.TabletType_REPLICA
+	if err := agent.SetReadOnlyWithTTL(ctx, 0); err != nil {
+		t.Fatalf("SetReadOnlyWithTTL(0) on a replica failed: %v", err)
+	}
+	if readOnly, pending := readOnlyTTLState(agent, fakeMysqlDaemon); !readOnly || pending {
+		t.Errorf("after SetReadOnlyWithTTL(0) on a replica, read-only is %v and revert pending is %v, want true and false", readOnly, pending)
+	}
+	if _, err := os.Stat(path.Join(tabletDir, readOnlyTTLFile)); !os.IsNotExist(err) {
+		t.Errorf("read-only TTL marker still exists after SetReadOnlyWithTTL(0): %v", err)
+	}
+}
+
+func TestSetReadOnlyWithTTLClear(t *testing.T) {
+	ctx := context.Background()
+	tabletDir, err := ioutil.TempDir("", "read_only_ttl_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(tabletDir)
+	agent, fakeMysqlDaemon := newReadOnlyTTLAgent(tabletDir)
+
+	// A TTL of 0 makes mysql read-write before the end of the TTL.
+	if err := agent.SetReadOnlyWithTTL(ctx, time.Hour); err != nil {
+		t.Fatalf("SetReadOnlyWithTTL failed: %v", err)
+	}
+	if err := agent.SetReadOnlyWithTTL(ctx, 0); err != nil {
+		t.Fatalf("SetReadOnlyWithTTL(0) failed: %v", err)
+	}
+	if readOnly, pending := readOnlyTTLState(agent, fakeMysqlDaemon); readOnly || pending {
+		t.Errorf("after SetReadOnlyWithTTL(0), read-only is %v and revert pending is %v, want false and false", readOnly, pending)
+	}
+
+	// SetReadOnly keeps mysql read-only past the end of the TTL.
+	if err := agent.SetReadOnlyWithTTL(ctx, 100*time.Millisecond); err != nil {
+		t.Fatalf("SetReadOnlyWithTTL failed: %v", err)
+	}
+	if err := agent.SetReadOnly(ctx, true); err != nil {
+		t.Fatalf("SetReadOnly failed: %v", err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if readOnly, pending := readOnlyTTLState(agent, fakeMysqlDaemon); !readOnly || pending {
+		t.Errorf("after SetReadOnly, read-only is %v and revert pending is %v, want true and false", readOnly, pending)
+	}
+	if _, err := os.Stat(path.Join(tabletDir, readOnlyTTLFile)); !os.IsNotExist(err) {
+		t.Errorf("read-only TTL marker still exists after SetReadOnly: %v", err)
+	}
+}
+
+func TestSetReadOnlyWithTTLSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	tabletDir, err := ioutil.TempDir("", "read_only_ttl_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(tabletDir)
+	agent, fakeMysqlDaemon := newReadOnlyTTLAgent(tabletDir)
+
+	if err := agent.SetReadOnlyWithTTL(ctx, 200*time.Millisecond); err != nil {
+		t.Fatalf("SetReadOnlyWithTTL failed: %v", err)
+	}
+
+	// The tablet restarts, so the timer is lost, but the marker in
+	// the tablet directory remains.
+	agent.actionMutex.Lock()
+	agent._readOnlyTTLTimer.Stop()
+	agent.actionMutex.Unlock()
+	agent, fakeMysqlDaemon2 := newReadOnlyTTLAgent(tabletDir)
+	fakeMysqlDaemon2.ReadOnly = fakeMysqlDaemon.ReadOnly
+
+	agent.resumeReadOnlyTTL()
+	if readOnly, pending := readOnlyTTLState(agent, fakeMysqlDaemon2); !readOnly || !pending {
+		t.Errorf("after restart, read-only is %v and revert pending is %v, want true and true", readOnly, pending)
+	}
+	waitForReadWrite(t, agent, fakeMysqlDaemon2)
+}
@@ -12,10 +12,12 @@ import (
 	"github.com/youtube/vitess/go/vt/mysqlctl/tmutils"
 	"golang.org/x/net/context"
 
+	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vschemapb "github.com/youtube/vitess/go/vt/proto/vschema"
 )
 
 // RPCAgent defines the interface implemented by the Agent for RPCs.
@@ -29,52 +31,186 @@ type RPCAgent interface {
 
 	GetSchema(ctx context.Context, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error)
 
+	GetSchemaBestEffort(ctx context.Context, tables, excludeTables []string, includeViews bool, timeout time.Duration) (*tabletmanagerdatapb.SchemaDefinition, bool, error)
+
+	GetCreateStatements(ctx context.Context, tables, excludeTables []string, includeViews bool) (map[string]string, error)
+
+	GetSchemaTimestamps(ctx context.Context, tables []string) (map[string]time.Time, error)
+
 	GetPermissions(ctx context.Context) (*tabletmanagerdatapb.Permissions, error)
 
+	GetConnectionStats(ctx context.Context) (*tabletmanagerdatapb.ConnectionStats, error)
+
+	GetConnectionRejectionStats(ctx context.Context, reset bool) (map[string]int64, error)
+
+	GetConfig(ctx context.Context) (map[string]string, error)
+
+	GetInFlightRPCs(ctx context.Context) (map[string]int64, error)
+
+	GetProcessStats(ctx context.Context) (*tabletmanagerdatapb.ProcessStats, error)
+
+	GetHealthScore(ctx context.Context) (*tabletmanagerdatapb.HealthScore, error)
+
+	GetErrorLogTail(ctx context.Context, lines int) ([]string, error)
+
+	CaptureDiagnostics(ctx context.Context) (*tabletmanagerdatapb.DiagnosticBundle, error)
+
+	CheckDataDir(ctx context.Context) ([]string, error)
+
+	GetThrottleState(ctx context.Context) (*tabletmanagerdatapb.ThrottleState, error)
+
+	GetTabletTags(ctx context.Context) (map[string]string, error)
+
 	// Various read-write methods
 
 	SetReadOnly(ctx context.Context, rdonly bool) error
 
-	ChangeType(ctx context.Context, tabletType topodatapb.TabletType) error
+	SetReadOnlyWithTTL(ctx context.Context, ttl time.Duration) error
+
+	SetSuperReadOnly(ctx context.Context, on bool) (bool, error)
+
+	SetQueryServerConfig(ctx context.Context, config *tabletmanagerdatapb.QueryServerConfig) (*tabletmanagerdatapb.QueryServerConfig, []string, error)
+
+	GetQueryBlacklist(ctx context.Context) ([]string, error)
+
+	SetQueryBlacklist(ctx context.Context, patterns []string) error
+
+	ChangeType(ctx context.Context, tabletType topodatapb.TabletType, idempotent bool) error
+
+	SetTabletTags(ctx context.Context, tags map[string]string) error
 
 	Sleep(ctx context.Context, duration time.Duration)
 
 	ExecuteHook(ctx context.Context, hk *hook.Hook) *hook.HookResult
 
+	ExecuteHookToStream(ctx context.Context, hk *hook.Hook, send func(*tabletmanagerdatapb.ExecuteHookToStreamResponse) error) error
+
 	RefreshState(ctx context.Context) error
 
+	RepublishTopoRecord(ctx context.Context) (int64, error)
+
 	RunHealthCheck(ctx context.Context)
 
 	IgnoreHealthError(ctx context.Context, pattern string) error
 
+	SetMaintenanceMode(ctx context.Context, on bool, reason string) error
+
+	PauseHealthReporting(ctx context.Context, on bool) error
+
+	SetThrottle(ctx context.Context, mode string, duration time.Duration) error
+
+	PrepareCutover(ctx context.Context) (string, error)
+
+	CommitCutover(ctx context.Context, token string) error
+
+	AbortCutover(ctx context.Context, token string) error
+
+	SetServingKeyRange(ctx context.Context, keyRange *topodatapb.KeyRange, servedTypes []topodatapb.TabletType) error
+
+	RestartMysql(ctx context.Context, healthyTimeout time.Duration, logger logutil.Logger) error
+
+	Decommission(ctx context.Context, stopReplication, stopMysqld bool, logger logutil.Logger) error
+
+	CheckTopoConnectivity(ctx context.Context) (bool, time.Duration)
+
+	WarmUp(ctx context.Context, queries []string, loadBufferPool bool, progress func(*tabletmanagerdatapb.WarmUpResponse) error) error
+
 	ReloadSchema(ctx context.Context, waitPosition string) error
 
 	PreflightSchema(ctx context.Context, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error)
 
 	ApplySchema(ctx context.Context, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error)
 
-	ExecuteFetchAsDba(ctx context.Context, query []byte, dbName string, maxrows int, disableBinlogs bool, reloadSchema bool) (*querypb.QueryResult, error)
+	SchemaDiff(ctx context.Context, desired *tabletmanagerdatapb.SchemaDefinition) ([]string, error)
+
+	AssessSchemaChange(ctx context.Context, change string) (*tabletmanagerdatapb.SchemaChangeAssessment, error)
+
+	WatchSchema(ctx context.Context, send func(*tabletmanagerdatapb.SchemaChangeEvent) error) error
+
+	GetVSchema(ctx context.Context) (*vschemapb.Keyspace, error)
+
+	ApplyVSchema(ctx context.Context, vschemaJSON string) error
+
+	ExecuteFetchAsDba(ctx context.Context, query []byte, dbName string, maxrows int, disableBinlogs bool, reloadSchema bool, maxExecTime time.Duration) (*querypb.QueryResult, error)
+
+	ExecuteFetchAsDbaCSV(ctx context.Context, query []byte, dbName string, send func([]byte) error) error
+
+	ExecuteFetchColumnar(ctx context.Context, query []byte, dbName string, maxrows int) (*tabletmanagerdatapb.ColumnarResult, error)
 
 	ExecuteFetchAsAllPrivs(ctx context.Context, query []byte, dbName string, maxrows int, reloadSchema bool) (*querypb.QueryResult, error)
 
 	ExecuteFetchAsApp(ctx context.Context, query []byte, maxrows int) (*querypb.QueryResult, error)
 
+	ExplainQuery(ctx context.Context, query, format string) (*querypb.QueryResult, error)
+
+	ApplyGrants(ctx context.Context, statements []string, atomic bool) error
+
+	ChecksumTable(ctx context.Context, table string, keyRange *topodatapb.KeyRange) (uint64, int64, error)
+
+	TruncateTable(ctx context.Context, table, confirmKeyspace string) error
+
+	RenameTable(ctx context.Context, from, to string, cascade bool) (*tabletmanagerdatapb.RenameTableResult, error)
+
+	StreamRowsInKeyRange(ctx context.Context, table string, keyRange *topodatapb.KeyRange, send func(*querypb.QueryResult) error) error
+
+	StreamKeyRangeBinlog(ctx context.Context, keyRange *topodatapb.KeyRange, startPos string, charset *binlogdatapb.Charset, send func(*binlogdatapb.BinlogTransaction) error) error
+
+	CloneStream(ctx context.Context, tables []string, bufferSize int, send func(*tabletmanagerdatapb.CloneStreamResponse) error) error
+
+	GetCharsetConfig(ctx context.Context) (*tabletmanagerdatapb.CharsetConfig, error)
+
+	GetProcessList(ctx context.Context) ([]*tabletmanagerdatapb.Process, error)
+
+	KillProcess(ctx context.Context, id int64) error
+
+	TailGeneralLog(ctx context.Context, duration time.Duration, send func(string) error) error
+
+	StreamQPS(ctx context.Context, interval time.Duration, send func(*tabletmanagerdatapb.QPSSample) error) error
+
 	// Replication related methods
 
 	SlaveStatus(ctx context.Context) (*replicationdatapb.Status, error)
 
+	SlaveStatusAllChannels(ctx context.Context) (map[string]*replicationdatapb.Status, error)
+
+	GetReplicationSource(ctx context.Context) (*tabletmanagerdatapb.ReplicationSource, error)
+
+	ValidateReplicationCredentials(ctx context.Context) (bool, error)
+
+	GetApplierQueueStats(ctx context.Context) (*tabletmanagerdatapb.ApplierQueueStats, error)
+
 	MasterPosition(ctx context.Context) (string, error)
 
+	GetGtidPurged(ctx context.Context) (string, error)
+
+	GetBinlogStats(ctx context.Context) (float64, time.Duration, error)
+
+	GetReplicationErrorStats(ctx context.Context, reset bool) (*tabletmanagerdatapb.ReplicationErrorStats, error)
+
 	StopSlave(ctx context.Context) error
 
 	StopSlaveMinimum(ctx context.Context, position string, waitTime time.Duration) (string, error)
 
+	StopSlaveMinimumStream(ctx context.Context, position string, waitTime time.Duration, send func(*tabletmanagerdatapb.StopSlaveMinimumStreamResponse) error) error
+
+	BoostReplicationCatchup(ctx context.Context, duration time.Duration, send func(*tabletmanagerdatapb.BoostReplicationCatchupResponse) error) error
+
 	StartSlave(ctx context.Context) error
 
-	TabletExternallyReparented(ctx context.Context, externalID string) error
+	EnableParallelReplication(ctx context.Context, workers int, parallelType string) error
+
+	RotateReplicationCredentials(ctx context.Context, user, password string) error
+
+	ConfigureReplicationSSL(ctx context.Context, opts *tabletmanagerdatapb.ReplicationSSLOptions) error
+
+	RepairRelayLog(ctx context.Context, logger logutil.Logger) error
+
+	TabletExternallyReparented(ctx context.Context, externalID string, validate bool) error
 
 	GetSlaves(ctx context.Context) ([]string, error)
 
+	GetReplicationGraph(ctx context.Context) (*tabletmanagerdatapb.ReplicationGraph, error)
+
 	WaitBlpPosition(ctx context.Context, blpPosition *tabletmanagerdatapb.BlpPosition, waitTime time.Duration) error
 
 	StopBlp(ctx context.Context) ([]*tabletmanagerdatapb.BlpPosition, error)
@@ -83,13 +219,17 @@ type RPCAgent interface {
 
 	RunBlpUntil(ctx context.Context, bpl []*tabletmanagerdatapb.BlpPosition, waitTime time.Duration) (string, error)
 
+	GetBinlogFilters(ctx context.Context) ([]*tabletmanagerdatapb.BinlogFilter, error)
+
 	// Reparenting related functions
 
 	ResetReplication(ctx context.Context) error
 
-	InitMaster(ctx context.Context) (string, error)
+	InitMaster(ctx context.Context, expectedKeyspace, expectedShard string) (string, error)
 
-	PopulateReparentJournal(ctx context.Context, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, pos string) error
+	PopulateReparentJournal(ctx context.Context, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, pos, expectedKeyspace, expectedShard string) error
+
+	GetLastReparentJournalEntry(ctx context.Context) (*tabletmanagerdatapb.ReparentJournalEntry, error)
 
 	InitSlave(ctx context.Context, parent *topodatapb.TabletAlias, replicationPosition string, timeCreatedNS int64) error
 
@@ -99,7 +239,9 @@ type RPCAgent interface {
 
 	SlaveWasPromoted(ctx context.Context) error
 
-	SetMaster(ctx context.Context, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error
+	SetMaster(ctx context.Context, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool, expectedKeyspace, expectedShard string) error
+
+	ConfigureReplication(ctx context.Context, parent *topodatapb.TabletAlias, autoPosition bool, file string, position uint64, forceStartSlave bool, expectedKeyspace, expectedShard string) error
 
 	SlaveWasRestarted(ctx context.Context, parent *topodatapb.TabletAlias) error
 
@@ -107,13 +249,47 @@ type RPCAgent interface {
 
 	PromoteSlave(ctx context.Context) (string, error)
 
+	SetReparentEligibility(ctx context.Context, eligible bool, reason string) error
+
+	CheckReparentCandidate(ctx context.Context) (bool, string, error)
+
+	SetSemiSyncAckCount(ctx context.Context, count int) error
+
+	GetDurabilityPolicy(ctx context.Context) (*tabletmanagerdatapb.DurabilityPolicy, error)
+
 	// Backup / restore related methods
 
+	GetBackupLimits(ctx context.Context) (int, int, error)
+
+	GetBackupPosition(ctx context.Context) (string, error)
+
 	Backup(ctx context.Context, concurrency int, logger logutil.Logger) error
 
+	IncrementalBackup(ctx context.Context, baseBackupName string, concurrency int, logger logutil.Logger) error
+
 	RestoreFromBackup(ctx context.Context, logger logutil.Logger) error
 
+	RestoreToTimestamp(ctx context.Context, backupName string, targetTime time.Time, logger logutil.Logger) error
+
+	SetPreferredBackup(ctx context.Context, backupName string) error
+
+	GetPreferredBackup(ctx context.Context) (string, error)
+
+	CheckRestoreCompatibility(ctx context.Context, backupName string) (*tabletmanagerdatapb.CompatReport, error)
+
+	GetLastBackupInfo(ctx context.Context) (*tabletmanagerdatapb.BackupInfo, error)
+
+	TestRestore(ctx context.Context, backupName string, logger logutil.Logger) error
+
+	GetBackupFreshness(ctx context.Context) (string, time.Duration, error)
+
+	CheckBackupReadiness(ctx context.Context) (*tabletmanagerdatapb.ReadinessReport, error)
+
 	// HandleRPCPanic is to be called in a defer statement in each
 	// RPC input point.
 	HandleRPCPanic(ctx context.Context, name string, args, reply interface{}, verbose bool, err *error)
+
+	// TrackRPC is to be called at each RPC input point. It counts
+	// the RPC as in flight until the returned function is called.
+	TrackRPC(name string) func()
 }
@@ -5,20 +5,72 @@
 package tabletmanager
 
 import (
+	"flag"
 	"fmt"
+	"runtime"
+	"strings"
 	"time"
 
+	"github.com/youtube/vitess/go/mysqlconn/replication"
 	"github.com/youtube/vitess/go/vt/logutil"
 	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
 	"github.com/youtube/vitess/go/vt/topo/topoproto"
 	"github.com/youtube/vitess/go/vt/topotools"
 	"golang.org/x/net/context"
 
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
 )
 
-// Backup takes a db backup and sends it to the BackupStorage
+var backupMaxConcurrency = flag.Int("backup_max_concurrency", 32, "the maximum number of files a backup can copy in parallel")
+
+// GetBackupLimits returns the default backup concurrency, the number
+// of CPUs, and the maximum one, both capped by -backup_max_concurrency.
+func (agent *ActionAgent) GetBackupLimits(ctx context.Context) (int, int, error) {
+	defaultConcurrency, maxConcurrency := backupLimits()
+	return defaultConcurrency, maxConcurrency, nil
+}
+
+// backupLimits returns the default and the maximum backup concurrency.
+func backupLimits() (int, int) {
+	defaultConcurrency := runtime.NumCPU()
+	if defaultConcurrency > *backupMaxConcurrency {
+		defaultConcurrency = *backupMaxConcurrency
+	}
+	return defaultConcurrency, *backupMaxConcurrency
+}
+
+// backupConcurrency returns the concurrency a backup runs with. Older
+// clients send 0, or more than the maximum, so the default is used
+// below 1, and the maximum above it.
+func backupConcurrency(concurrency int, logger logutil.Logger) int {
+	defaultConcurrency, maxConcurrency := backupLimits()
+	switch {
+	case concurrency < 1:
+		logger.Infof("backup concurrency %v is not positive, using the default of %v", concurrency, defaultConcurrency)
+		return defaultConcurrency
+	case concurrency > maxConcurrency:
+		logger.Warningf("backup concurrency %v is above -backup_max_concurrency, capping it to %v", concurrency, maxConcurrency)
+		return maxConcurrency
+	}
+	return concurrency
+}
+
+// GetBackupPosition returns the replication position a backup taken
+// now would capture. It has no side effects.
+func (agent *ActionAgent) GetBackupPosition(ctx context.Context) (string, error) {
+	pos, err := mysqlctl.BackupPosition(agent.MysqlDaemon)
+	if err != nil {
+		return "", err
+	}
+	return replication.EncodePosition(pos), nil
+}
+
+// Backup takes a db backup and sends it to the BackupStorage. The
+// concurrency is normalized by backupConcurrency.
 func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger logutil.Logger) error {
+	concurrency = backupConcurrency(concurrency, logger)
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
@@ -46,9 +98,25 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
 	// now we can run the backup
+	start := time.Now()
 	dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
-	name := fmt.Sprintf("%v.%v", time.Now().UTC().Format("2006-01-02.150405"), topoproto.TabletAliasString(tablet.Alias))
-	returnErr := mysqlctl.Backup(ctx, agent.MysqlDaemon, l, dir, name, concurrency, agent.hookExtraEnv())
+	name := fmt.Sprintf("%v.%v", start.UTC().Format(mysqlctl.BackupTimestampFormat), topoproto.TabletAliasString(tablet.Alias))
+	size, returnErr := mysqlctl.Backup(ctx, agent.MysqlDaemon, l, dir, name, concurrency, agent.hookExtraEnv())
+	end := time.Now()
+	info := &tabletmanagerdatapb.BackupInfo{
+		Name:        name,
+		SizeBytes:   size,
+		StartTimeNs: start.UnixNano(),
+		EndTimeNs:   end.UnixNano(),
+		DurationNs:  int64(end.Sub(start)),
+		Success:     returnErr == nil,
+	}
+	if returnErr != nil {
+		info.Error = returnErr.Error()
+	}
+	agent.mutex.Lock()
+	agent._lastBackup = info
+	agent.mutex.Unlock()
 
 	// change our type back to the original value
 	_, err = topotools.ChangeType(ctx, agent.TopoServer, tablet.Alias, originalType)
@@ -72,6 +140,28 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 	return returnErr
 }
 
+// IncrementalBackup backs up the binlogs since the position of the
+// named backup of the shard. Unlike Backup, mysqld keeps running, and
+// the tablet keeps its type and keeps serving.
+func (agent *ActionAgent) IncrementalBackup(ctx context.Context, baseBackupName string, concurrency int, logger logutil.Logger) error {
+	concurrency = backupConcurrency(concurrency, logger)
+	if err := agent.checkThrottle(ctx, "IncrementalBackup"); err != nil {
+		return err
+	}
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	// create the loggers: tee to console and source
+	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
+
+	tablet := agent.Tablet()
+	dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
+	name := fmt.Sprintf("%v.%v", time.Now().UTC().Format(mysqlctl.BackupTimestampFormat), topoproto.TabletAliasString(tablet.Alias))
+	return mysqlctl.IncrementalBackup(ctx, agent.MysqlDaemon, l, dir, name, baseBackupName, concurrency, agent.hookExtraEnv())
+}
+
 // RestoreFromBackup deletes all local data and restores anew from the latest backup.
 func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
 	if err := agent.lock(ctx); err != nil {
@@ -98,3 +188,197 @@ func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.
 
 	return err
 }
+
+// RestoreToTimestamp deletes all local data, restores the named backup,
+// and applies the master binlogs up to targetTime. The tablet is left
+// DRAINED, with replication stopped, so the recovered data can be
+// inspected or copied.
+func (agent *ActionAgent) RestoreToTimestamp(ctx context.Context, backupName string, targetTime time.Time, logger logutil.Logger) error {
+	backupTime, err := mysqlctl.BackupTime(backupName)
+	if err != nil {
+		return err
+	}
+	if targetTime.Before(backupTime) {
+		return fmt.Errorf("target time %v is before backup %v was taken, at %v", targetTime, backupName, backupTime)
+	}
+	if now := time.Now(); targetTime.After(now) {
+		return fmt.Errorf("target time %v is after the latest available binlogs, at %v", targetTime, now)
+	}
+
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	tablet, err := agent.TopoServer.GetTablet(ctx, agent.TabletAlias)
+	if err != nil {
+		return err
+	}
+	if tablet.Type == topodatapb.TabletType_MASTER {
+		return fmt.Errorf("type MASTER cannot restore to a timestamp, if you really need to do this, restart vttablet in replica mode")
+	}
+
+	// create the loggers: tee to console and source
+	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
+
+	// now we can run restore
+	err = agent.restoreToTimestampLocked(ctx, l, backupName, targetTime)
+
+	// re-run health check to be sure to capture any replication delay
+	agent.runHealthCheckLocked()
+
+	return err
+}
+
+// SetPreferredBackup records the named backup of the tablet's shard as
+// the one new tablets restore from, instead of the latest one. The
+// backup must exist and be complete. An empty name clears it.
+func (agent *ActionAgent) SetPreferredBackup(ctx context.Context, backupName string) error {
+	tablet := agent.Tablet()
+	if backupName != "" {
+		dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
+		if err := mysqlctl.CheckBackup(ctx, dir, backupName); err != nil {
+			return fmt.Errorf("cannot use backup %v as the preferred backup: %v", backupName, err)
+		}
+	}
+	return agent.TopoServer.SetPreferredBackup(ctx, tablet.Keyspace, tablet.Shard, backupName)
+}
+
+// GetPreferredBackup returns the preferred backup of the tablet's
+// shard, or "" if new tablets restore from the latest backup.
+func (agent *ActionAgent) GetPreferredBackup(ctx context.Context) (string, error) {
+	tablet := agent.Tablet()
+	return agent.TopoServer.GetPreferredBackup(ctx, tablet.Keyspace, tablet.Shard)
+}
+
+// CheckRestoreCompatibility compares the mysqld settings recorded in
+// the named backup of the tablet's shard with the ones of the tablet,
+// so an incompatible backup can be caught before restoring it.
+func (agent *ActionAgent) CheckRestoreCompatibility(ctx context.Context, backupName string) (*tabletmanagerdatapb.CompatReport, error) {
+	tablet := agent.Tablet()
+	dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
+	return mysqlctl.CheckRestoreCompatibility(ctx, agent.MysqlDaemon, dir, backupName)
+}
+
+// TestRestore restores the named backup of the tablet's shard into a
+// scratch mysqld on the tablet host, checks its tables, and removes
+// it. The tablet keeps serving with its own mysqld. The copy can take
+// a long time, so it doesn't hold the action lock, but only one runs
+// at a time. It is refused on a master, to not take disk and IO from
+// it, and while heavy operations are throttled.
+func (agent *ActionAgent) TestRestore(ctx context.Context, backupName string, logger logutil.Logger) error {
+	tablet := agent.Tablet()
+	if tablet.Type == topodatapb.TabletType_MASTER {
+		return fmt.Errorf("type MASTER cannot test a restore, use a replica or rdonly tablet")
+	}
+	if err := agent.checkThrottle(ctx, "TestRestore"); err != nil {
+		return err
+	}
+
+	agent.mutex.Lock()
+	if agent._testRestoreRunning {
+		agent.mutex.Unlock()
+		return fmt.Errorf("a TestRestore is already running on this tablet")
+	}
+	agent._testRestoreRunning = true
+	agent.mutex.Unlock()
+	defer func() {
+		agent.mutex.Lock()
+		agent._testRestoreRunning = false
+		agent.mutex.Unlock()
+	}()
+
+	// create the loggers: tee to console and source
+	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
+
+	dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
+	return mysqlctl.TestRestoreBackup(ctx, agent.MysqlDaemon, dir, backupName, *restoreConcurrency, agent.hookExtraEnv(), l)
+}
+
+// GetBackupFreshness returns the name of the most recent complete full
+// backup of the tablet's shard, and how long ago it was taken, from
+// its name. If there is none, it returns "" and tmclient.BackupAgeNever.
+func (agent *ActionAgent) GetBackupFreshness(ctx context.Context) (string, time.Duration, error) {
+	tablet := agent.Tablet()
+	dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
+	name, err := mysqlctl.LastBackup(ctx, dir)
+	if err != nil {
+		return "", 0, err
+	}
+	if name == "" {
+		return "", tmclient.BackupAgeNever, nil
+	}
+	backupTime, err := mysqlctl.BackupTime(name)
+	if err != nil {
+		return "", 0, err
+	}
+	return name, time.Since(backupTime), nil
+}
+
+// GetLastBackupInfo returns the last backup taken by Backup since the
+// tablet started, or nil if there was none.
+func (agent *ActionAgent) GetLastBackupInfo(ctx context.Context) (*tabletmanagerdatapb.BackupInfo, error) {
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+	return agent._lastBackup, nil
+}
+
+// backupReadinessDDLPrefixes are the statements CheckBackupReadiness
+// considers DDL, upper case.
+var backupReadinessDDLPrefixes = []string{"ALTER ", "CREATE ", "DROP ", "RENAME ", "TRUNCATE ", "OPTIMIZE "}
+
+// CheckBackupReadiness checks the tablet can take a consistent backup
+// now: it is not a master, no restore or backup is in progress, no
+// DDL is running, replication is healthy, and so is the tablet. The
+// report lists the reasons it is not ready. It has no side effects.
+func (agent *ActionAgent) CheckBackupReadiness(ctx context.Context) (*tabletmanagerdatapb.ReadinessReport, error) {
+	var reasons []string
+	tabletType := agent.Tablet().Type
+	switch tabletType {
+	case topodatapb.TabletType_MASTER:
+		reasons = append(reasons, "type MASTER cannot take backup")
+	case topodatapb.TabletType_RESTORE:
+		reasons = append(reasons, "a restore is in progress")
+	case topodatapb.TabletType_BACKUP:
+		reasons = append(reasons, "a backup is in progress")
+	}
+
+	processes, err := mysqlctl.GetProcessList(ctx, agent.MysqlDaemon)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range processes {
+		info := strings.ToUpper(strings.TrimSpace(p.Info))
+		for _, prefix := range backupReadinessDDLPrefixes {
+			if strings.HasPrefix(info, prefix) {
+				reasons = append(reasons, fmt.Sprintf("DDL in progress for %vs on connection %v: %v", p.Time, p.Id, p.Info))
+				break
+			}
+		}
+	}
+
+	if tabletType != topodatapb.TabletType_MASTER {
+		status, err := agent.MysqlDaemon.SlaveStatus()
+		switch {
+		case err == mysqlctl.ErrNotSlave:
+			reasons = append(reasons, "replication is not configured")
+		case err != nil:
+			return nil, err
+		default:
+			if !status.SlaveIORunning {
+				reasons = append(reasons, fmt.Sprintf("replication IO thread is not running (last error %v)", status.LastIOErrno))
+			}
+			if !status.SlaveSQLRunning {
+				reasons = append(reasons, fmt.Sprintf("replication SQL thread is not running (last error %v)", status.LastSQLErrno))
+			}
+		}
+	}
+
+	if _, err := agent.Healthy(); err != nil {
+		reasons = append(reasons, fmt.Sprintf("tablet is not healthy: %v", err))
+	}
+	return &tabletmanagerdatapb.ReadinessReport{
+		Ready:   len(reasons) == 0,
+		Reasons: reasons,
+	}, nil
+}
@@ -0,0 +1,243 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+	"github.com/youtube/vitess/go/vt/mysqlctl/filebackupstorage"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestGetBackupPosition(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.CurrentMasterPosition = replication.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-42")
+	mysqlDaemon.Replicating = true
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+	}
+
+	// A slave reports the position of its replicated transactions,
+	// a master its own position: both are the fake current position.
+	for _, slaveStatusError := range []error{nil, mysqlctl.ErrNotSlave} {
+		mysqlDaemon.SlaveStatusError = slaveStatusError
+		encoded, err := agent.GetBackupPosition(ctx)
+		if err != nil {
+			t.Fatalf("GetBackupPosition(slave status error %v) failed: %v", slaveStatusError, err)
+		}
+		pos, err := replication.DecodePosition(encoded)
+		if err != nil {
+			t.Fatalf("DecodePosition(%v) failed: %v", encoded, err)
+		}
+		if !pos.Equal(mysqlDaemon.CurrentMasterPosition) {
+			t.Errorf("GetBackupPosition(slave status error %v) = %v, want %v", slaveStatusError, pos, mysqlDaemon.CurrentMasterPosition)
+		}
+	}
+
+	// Unlike a backup, asking for the position does not stop
+	// replication.
+	if !mysqlDaemon.Replicating {
+		t.Errorf("GetBackupPosition stopped replication")
+	}
+}
+
+func TestGetBackupFreshness(t *testing.T) {
+	ctx := context.Background()
+	root, err := ioutil.TempDir("", "backupfreshnesstest")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "fbs")
+	*backupstorage.BackupStorageImplementation = "file"
+	agent := &ActionAgent{
+		_tablet: &topodatapb.Tablet{
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+
+	// A shard that was never backed up is not an error.
+	name, age, err := agent.GetBackupFreshness(ctx)
+	if err != nil {
+		t.Fatalf("GetBackupFreshness failed: %v", err)
+	}
+	if name != "" || age != tmclient.BackupAgeNever {
+		t.Errorf("GetBackupFreshness() with no backup = (%v, %v), want (\"\", %v)", name, age, tmclient.BackupAgeNever)
+	}
+
+	// A complete backup taken an hour ago, and a more recent one
+	// without a MANIFEST, that is still running or failed.
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatalf("GetBackupStorage failed: %v", err)
+	}
+	defer bs.Close()
+	now := time.Now().UTC()
+	complete := now.Add(-time.Hour).Format(mysqlctl.BackupTimestampFormat) + ".cell1-0000000100"
+	incomplete := now.Add(-time.Minute).Format(mysqlctl.BackupTimestampFormat) + ".cell1-0000000100"
+	for _, backupName := range []string{complete, incomplete} {
+		bh, err := bs.StartBackup(ctx, "ks/0", backupName)
+		if err != nil {
+			t.Fatalf("StartBackup failed: %v", err)
+		}
+		if backupName == complete {
+			wc, err := bh.AddFile(ctx, "MANIFEST")
+			if err != nil {
+				t.Fatalf("AddFile failed: %v", err)
+			}
+			if _, err := wc.Write([]byte("{}")); err != nil {
+				t.Fatalf("Write failed: %v", err)
+			}
+			if err := wc.Close(); err != nil {
+				t.Fatalf("Close failed: %v", err)
+			}
+		}
+		if err := bh.EndBackup(ctx); err != nil {
+			t.Fatalf("EndBackup failed: %v", err)
+		}
+	}
+
+	name, age, err = agent.GetBackupFreshness(ctx)
+	if err != nil {
+		t.Fatalf("GetBackupFreshness failed: %v", err)
+	}
+	if name != complete || age < time.Hour || age > time.Hour+time.Minute {
+		t.Errorf("GetBackupFreshness() = (%v, %v), want (%v, about an hour)", name, age, complete)
+	}
+}
+
+// processListRow returns a SHOW FULL PROCESSLIST row of vt_dba.
+func processListRow(id, command, seconds, info string) []sqltypes.Value {
+	return []sqltypes.Value{
+		sqltypes.MakeString([]byte(id)),
+		sqltypes.MakeString([]byte("vt_dba")),
+		sqltypes.MakeString([]byte("localhost")),
+		sqltypes.MakeString([]byte("vt_ks")),
+		sqltypes.MakeString([]byte(command)),
+		sqltypes.MakeString([]byte(seconds)),
+		sqltypes.MakeString([]byte("")),
+		sqltypes.MakeString([]byte(info)),
+	}
+}
+
+func TestCheckBackupReadiness(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Replicating = true
+	mysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW FULL PROCESSLIST": {
+			Rows: [][]sqltypes.Value{
+				processListRow("12", "Query", "3", "select * from t1"),
+			},
+		},
+	}
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Type: topodatapb.TabletType_REPLICA,
+		},
+		_healthyTime: time.Now(),
+	}
+
+	report, err := agent.CheckBackupReadiness(ctx)
+	if err != nil {
+		t.Fatalf("CheckBackupReadiness failed: %v", err)
+	}
+	if !report.Ready || len(report.Reasons) != 0 {
+		t.Errorf("CheckBackupReadiness() = %v, want ready", report)
+	}
+
+	// A running ALTER makes the backup inconsistent.
+	mysqlDaemon.FetchSuperQueryMap["SHOW FULL PROCESSLIST"].Rows = append(mysqlDaemon.FetchSuperQueryMap["SHOW FULL PROCESSLIST"].Rows,
+		processListRow("13", "Query", "120", "alter table t1 add column c int"))
+	report, err = agent.CheckBackupReadiness(ctx)
+	if err != nil {
+		t.Fatalf("CheckBackupReadiness failed: %v", err)
+	}
+	want := &tabletmanagerdatapb.ReadinessReport{
+		Reasons: []string{"DDL in progress for 120s on connection 13: alter table t1 add column c int"},
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("CheckBackupReadiness() with a DDL = %v, want %v", report, want)
+	}
+
+	// So do broken replication and a restore.
+	mysqlDaemon.FetchSuperQueryMap["SHOW FULL PROCESSLIST"].Rows = nil
+	mysqlDaemon.Replicating = false
+	agent._tablet.Type = topodatapb.TabletType_RESTORE
+	report, err = agent.CheckBackupReadiness(ctx)
+	if err != nil {
+		t.Fatalf("CheckBackupReadiness failed: %v", err)
+	}
+	if report.Ready || len(report.Reasons) != 3 {
+		t.Errorf("CheckBackupReadiness() while restoring without replication = %v, want 3 reasons", report)
+	}
+}
+
+func TestTestRestoreRefused(t *testing.T) {
+	ctx := context.Background()
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlctl.NewFakeMysqlDaemon(nil),
+		_tablet: &topodatapb.Tablet{
+			Keyspace: "ks",
+			Shard:    "0",
+			Type:     topodatapb.TabletType_MASTER,
+		},
+	}
+
+	// A master is never used for a test restore.
+	if err := agent.TestRestore(ctx, "backup", logutil.NewMemoryLogger()); err == nil || !strings.Contains(err.Error(), "type MASTER cannot test a restore") {
+		t.Errorf("TestRestore on a master = %v, want a refusal", err)
+	}
+
+	// Only one test restore runs at a time.
+	agent._tablet.Type = topodatapb.TabletType_REPLICA
+	agent._testRestoreRunning = true
+	if err := agent.TestRestore(ctx, "backup", logutil.NewMemoryLogger()); err == nil || !strings.Contains(err.Error(), "already running") {
+		t.Errorf("TestRestore while one is running = %v, want a refusal", err)
+	}
+}
+
+func TestBackupConcurrency(t *testing.T) {
+	oldMax := *backupMaxConcurrency
+	defer func() { *backupMaxConcurrency = oldMax }()
+	*backupMaxConcurrency = 8
+	defaultConcurrency, _ := backupLimits()
+
+	// Older clients send 0, or more than the maximum: the backup
+	// still runs.
+	for _, tc := range []struct {
+		concurrency int
+		want        int
+	}{
+		{0, defaultConcurrency},
+		{-1, defaultConcurrency},
+		{1, 1},
+		{8, 8},
+		{100, 8},
+	} {
+		if got := backupConcurrency(tc.concurrency, logutil.NewMemoryLogger()); got != tc.want {
+			t.Errorf("backupConcurrency(%v) = %v, want %v", tc.concurrency, got, tc.want)
+		}
+	}
+}
@@ -78,3 +78,12 @@ func (agent *ActionAgent) RunBlpUntil(ctx context.Context, bpl []*tabletmanagerd
 	}
 	return replication.EncodePosition(pos), nil
 }
+
+// GetBinlogFilters returns the filters of the binlog players.
+// It doesn't take the action mutex, as it doesn't change anything.
+func (agent *ActionAgent) GetBinlogFilters(ctx context.Context) ([]*tabletmanagerdatapb.BinlogFilter, error) {
+	if agent.BinlogPlayerMap == nil {
+		return nil, fmt.Errorf("No BinlogPlayerMap configured")
+	}
+	return agent.BinlogPlayerMap.BinlogFilters()
+}
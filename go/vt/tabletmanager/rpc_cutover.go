@@ -0,0 +1,208 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/key"
+	"github.com/youtube/vitess/go/vt/tabletserver"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// This file contains the two-phase cutover RPCs. A coordinator
+// prepares all the tablets of a cutover, which stops writes on them,
+// saves the new serving state in the topology, then commits or aborts
+// them all. Committing applies the new serving state. It also
+// contains SetServingKeyRange, which moves the keyrange a tablet
+// serves during the cutover.
+
+var cutoverPrepareTimeout = flag.Duration("cutover_prepare_timeout", 30*time.Second, "how long a cutover prepared with PrepareCutover waits to be committed or aborted before it aborts on its own")
+
+// PrepareCutover makes mysql read-only and returns the token of the
+// prepared cutover. Only one cutover can be prepared at a time. If it
+// is not committed or aborted within -cutover_prepare_timeout, it is
+// aborted, so a coordinator that goes away doesn't leave the tablet
+// read-only.
+func (agent *ActionAgent) PrepareCutover(ctx context.Context) (string, error) {
+	if err := agent.lock(ctx); err != nil {
+		return "", err
+	}
+	defer agent.unlock()
+
+	if agent._cutoverToken != "" {
+		return "", fmt.Errorf("cutover %v is already prepared", agent._cutoverToken)
+	}
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("cannot generate a cutover token: %v", err)
+	}
+	token := hex.EncodeToString(b)
+
+	wasReadOnly, err := agent.MysqlDaemon.IsReadOnly()
+	if err != nil {
+		return "", err
+	}
+	if err := agent.MysqlDaemon.SetReadOnly(true); err != nil {
+		return "", err
+	}
+	agent._cutoverToken = token
+	agent._cutoverWasReadOnly = wasReadOnly
+	agent._cutoverTimer = time.AfterFunc(*cutoverPrepareTimeout, func() {
+		agent.actionMutex.Lock()
+		defer agent.actionMutex.Unlock()
+
+		// The cutover may have been committed or aborted while
+		// we waited for the lock.
+		if agent._cutoverToken != token {
+			return
+		}
+		log.Warningf("cutover %v was not committed within %v, aborting it", token, *cutoverPrepareTimeout)
+		if err := agent.abortCutoverLocked(); err != nil {
+			log.Errorf("cannot abort cutover %v: %v", token, err)
+		}
+	})
+	return token, nil
+}
+
+// CommitCutover completes the cutover prepared with token: it applies
+// the serving state the coordinator saved in the topology, the same way
+// RefreshState does. If the tablet still serves queries after that,
+// mysql goes back to the read-only state it had before the cutover,
+// otherwise it stays read-only.
+func (agent *ActionAgent) CommitCutover(ctx context.Context, token string) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	if err := agent.checkCutoverTokenLocked(token); err != nil {
+		return err
+	}
+	agent._cutoverTimer.Stop()
+	agent._cutoverToken = ""
+	agent._cutoverTimer = nil
+
+	if err := agent.refreshTablet(ctx, "CommitCutover"); err != nil {
+		return err
+	}
+	if !agent.QueryServiceControl.IsServing() {
+		return nil
+	}
+	return agent.MysqlDaemon.SetReadOnly(agent._cutoverWasReadOnly)
+}
+
+// AbortCutover cancels the cutover prepared with token, and restores
+// the read-only state mysql had before.
+func (agent *ActionAgent) AbortCutover(ctx context.Context, token string) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	if err := agent.checkCutoverTokenLocked(token); err != nil {
+		return err
+	}
+	return agent.abortCutoverLocked()
+}
+
+// checkCutoverTokenLocked returns an error if token is not the one of
+// the prepared cutover. It must be called with actionMutex held.
+func (agent *ActionAgent) checkCutoverTokenLocked(token string) error {
+	switch {
+	case agent._cutoverToken == "":
+		return fmt.Errorf("no cutover is prepared, it may have timed out")
+	case agent._cutoverToken != token:
+		return fmt.Errorf("cutover %v is prepared, not %v", agent._cutoverToken, token)
+	}
+	return nil
+}
+
+// abortCutoverLocked clears the prepared cutover and restores the
+// read-only state. It must be called with actionMutex held.
+func (agent *ActionAgent) abortCutoverLocked() error {
+	agent._cutoverTimer.Stop()
+	agent._cutoverToken = ""
+	agent._cutoverTimer = nil
+	return agent.MysqlDaemon.SetReadOnly(agent._cutoverWasReadOnly)
+}
+
+// SetServingKeyRange replaces the keyrange the tablet serves, and the
+// tablet types it serves it for. Both are changed under the same lock,
+// so no reader sees the new keyrange with the old types, or the other
+// way around. keyRange must be within the keyrange of the tablet's
+// shard. A nil keyRange goes back to serving the whole shard. While
+// the tablet has one of servedTypes (all of them if empty), its query
+// service rejects the queries with a keyspace_id bind variable outside
+// keyRange.
+func (agent *ActionAgent) SetServingKeyRange(ctx context.Context, keyRange *topodatapb.KeyRange, servedTypes []topodatapb.TabletType) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	tablet := agent.Tablet()
+	if keyRange != nil && !key.KeyRangeIncludes(tablet.KeyRange, keyRange) {
+		return fmt.Errorf("keyrange %v is not within the keyrange %v of shard %v/%v", key.KeyRangeString(keyRange), key.KeyRangeString(tablet.KeyRange), tablet.Keyspace, tablet.Shard)
+	}
+
+	var types []topodatapb.TabletType
+	if keyRange != nil {
+		types = make([]topodatapb.TabletType, len(servedTypes))
+		copy(types, servedTypes)
+	}
+	if err := agent.loadServingKeyRangeRules(tablet.Type, keyRange, types); err != nil {
+		return err
+	}
+	agent.mutex.Lock()
+	agent._servingKeyRange = proto.Clone(keyRange).(*topodatapb.KeyRange)
+	agent._servingKeyRangeTypes = types
+	agent.mutex.Unlock()
+	log.Infof("serving keyrange %v for %v", key.KeyRangeString(keyRange), servedTypes)
+	return nil
+}
+
+// loadServingKeyRangeRules makes the query service enforce keyRange,
+// if tabletType is one of servedTypes. Otherwise, or if keyRange is
+// nil, the rules are cleared.
+func (agent *ActionAgent) loadServingKeyRangeRules(tabletType topodatapb.TabletType, keyRange *topodatapb.KeyRange, servedTypes []topodatapb.TabletType) error {
+	qrs := tabletserver.NewQueryRules()
+	if keyRange != nil && (len(servedTypes) == 0 || topoproto.IsTypeInList(tabletType, servedTypes)) {
+		qr := tabletserver.NewQueryRule("enforce serving keyrange", "serving_keyrange", tabletserver.QRFail)
+		if err := qr.AddBindVarCond("keyspace_id", false /*onAbsent*/, true /*onMismatch*/, tabletserver.QRNotIn, keyRange); err != nil {
+			return err
+		}
+		qrs.Add(qr)
+	}
+	return agent.QueryServiceControl.SetQueryRules(servingKeyRangeQueryRules, qrs)
+}
+
+// ServingKeyRange returns the keyrange the tablet serves and the
+// tablet types it serves it for. Unless SetServingKeyRange set one,
+// it is the keyrange of the tablet's shard, and the types are nil,
+// meaning all of them.
+func (agent *ActionAgent) ServingKeyRange() (*topodatapb.KeyRange, []topodatapb.TabletType) {
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+	if agent._servingKeyRange == nil {
+		if agent._tablet == nil {
+			return nil, nil
+		}
+		return proto.Clone(agent._tablet.KeyRange).(*topodatapb.KeyRange), nil
+	}
+	types := make([]topodatapb.TabletType, len(agent._servingKeyRangeTypes))
+	copy(types, agent._servingKeyRangeTypes)
+	return proto.Clone(agent._servingKeyRange).(*topodatapb.KeyRange), types
+}
@@ -0,0 +1,248 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/key"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/tabletserver"
+	"github.com/youtube/vitess/go/vt/tabletserver/tabletservermock"
+	"github.com/youtube/vitess/go/vt/topo"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// cutoverState returns the prepared cutover token and whether mysql
+// is read-only, under actionMutex like the auto-abort.
+func cutoverState(agent *ActionAgent) (string, bool) {
+	agent.actionMutex.Lock()
+	defer agent.actionMutex.Unlock()
+	return agent._cutoverToken, agent.MysqlDaemon.(*mysqlctl.FakeMysqlDaemon).ReadOnly
+}
+
+func TestCutoverCommit(t *testing.T) {
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+	if _, err := expectBroadcastData(agent.QueryServiceControl, true, "healthcheck not run yet", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := expectStateChange(agent.QueryServiceControl, true, topodatapb.TabletType_REPLICA); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := agent.PrepareCutover(ctx)
+	if err != nil {
+		t.Fatalf("PrepareCutover failed: %v", err)
+	}
+	if _, readOnly := cutoverState(agent); !readOnly {
+		t.Errorf("PrepareCutover didn't make mysql read-only")
+	}
+	if _, err := agent.PrepareCutover(ctx); err == nil {
+		t.Errorf("PrepareCutover with a cutover already prepared worked")
+	}
+	if err := agent.CommitCutover(ctx, "wrong"); err == nil {
+		t.Errorf("CommitCutover with the wrong token worked")
+	}
+
+	// The tablet still serves after the commit, so it is writable
+	// again.
+	if err := agent.CommitCutover(ctx, token); err != nil {
+		t.Fatalf("CommitCutover failed: %v", err)
+	}
+	if prepared, readOnly := cutoverState(agent); prepared != "" || readOnly {
+		t.Errorf("after CommitCutover, prepared cutover is %q and read-only is %v, want none and false", prepared, readOnly)
+	}
+	if !agent.QueryServiceControl.IsServing() {
+		t.Errorf("Query service should still be running")
+	}
+	if err := agent.AbortCutover(ctx, token); err == nil {
+		t.Errorf("AbortCutover of a committed cutover worked")
+	}
+
+	// The coordinator moves serving away from the tablet between the
+	// two phases: the commit stops the query service, and mysql stays
+	// read-only.
+	token, err = agent.PrepareCutover(ctx)
+	if err != nil {
+		t.Fatalf("PrepareCutover failed: %v", err)
+	}
+	_, err = agent.TopoServer.UpdateShardFields(ctx, "test_keyspace", "0", func(si *topo.ShardInfo) error {
+		si.TabletControls = []*topodatapb.Shard_TabletControl{
+			{
+				TabletType:          topodatapb.TabletType_REPLICA,
+				DisableQueryService: true,
+			},
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("UpdateShardFields failed: %v", err)
+	}
+	if err := agent.CommitCutover(ctx, token); err != nil {
+		t.Fatalf("CommitCutover failed: %v", err)
+	}
+	if agent.QueryServiceControl.IsServing() {
+		t.Errorf("Query service should not be running after the cutover")
+	}
+	if prepared, readOnly := cutoverState(agent); prepared != "" || !readOnly {
+		t.Errorf("after CommitCutover, prepared cutover is %q and read-only is %v, want none and true", prepared, readOnly)
+	}
+	if _, err := expectBroadcastData(agent.QueryServiceControl, false, "healthcheck not run yet", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := expectStateChange(agent.QueryServiceControl, false, topodatapb.TabletType_REPLICA); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCutoverAbort(t *testing.T) {
+	ctx := context.Background()
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlctl.NewFakeMysqlDaemon(nil),
+	}
+
+	token, err := agent.PrepareCutover(ctx)
+	if err != nil {
+		t.Fatalf("PrepareCutover failed: %v", err)
+	}
+	if err := agent.AbortCutover(ctx, token); err != nil {
+		t.Fatalf("AbortCutover failed: %v", err)
+	}
+	if prepared, readOnly := cutoverState(agent); prepared != "" || readOnly {
+		t.Errorf("after AbortCutover, prepared cutover is %q and read-only is %v, want none and false", prepared, readOnly)
+	}
+	if err := agent.CommitCutover(ctx, token); err == nil {
+		t.Errorf("CommitCutover of an aborted cutover worked")
+	}
+
+	// A new cutover can be prepared after an abort.
+	if _, err := agent.PrepareCutover(ctx); err != nil {
+		t.Errorf("PrepareCutover after AbortCutover failed: %v", err)
+	}
+}
+
+func TestCutoverAutoAbort(t *testing.T) {
+	oldTimeout := *cutoverPrepareTimeout
+	defer func() { *cutoverPrepareTimeout = oldTimeout }()
+	*cutoverPrepareTimeout = 100 * time.Millisecond
+
+	ctx := context.Background()
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlctl.NewFakeMysqlDaemon(nil),
+	}
+
+	// The coordinator prepares, then goes away.
+	token, err := agent.PrepareCutover(ctx)
+	if err != nil {
+		t.Fatalf("PrepareCutover failed: %v", err)
+	}
+	timeout := time.After(5 * time.Second)
+	for {
+		prepared, readOnly := cutoverState(agent)
+		if prepared == "" {
+			if readOnly {
+				t.Errorf("cutover was aborted, but mysql is still read-only")
+			}
+			break
+		}
+		select {
+		case <-timeout:
+			t.Fatalf("cutover %v was not aborted after %v", token, *cutoverPrepareTimeout)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	if err := agent.CommitCutover(ctx, token); err == nil {
+		t.Errorf("CommitCutover of a timed out cutover worked")
+	}
+}
+
+func TestSetServingKeyRange(t *testing.T) {
+	ctx := context.Background()
+	shardKeyRange := &topodatapb.KeyRange{Start: []byte{0x80}, End: []byte{0xc0}}
+	qsc := tabletservermock.NewController()
+	agent := &ActionAgent{
+		QueryServiceControl: qsc,
+		_tablet: &topodatapb.Tablet{
+			Keyspace: "ks",
+			Shard:    "80-c0",
+			KeyRange: shardKeyRange,
+			Type:     topodatapb.TabletType_REPLICA,
+		},
+	}
+
+	// Until it is set, the tablet serves its shard for all types.
+	keyRange, servedTypes := agent.ServingKeyRange()
+	if !key.KeyRangeEqual(keyRange, shardKeyRange) || servedTypes != nil {
+		t.Errorf("initial ServingKeyRange() = %v, %v, want %v for all types", key.KeyRangeString(keyRange), servedTypes, key.KeyRangeString(shardKeyRange))
+	}
+
+	// Swap to the lower half, for the non-master types.
+	newKeyRange := &topodatapb.KeyRange{Start: []byte{0x80}, End: []byte{0xa0}}
+	newTypes := []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY}
+	if err := agent.SetServingKeyRange(ctx, newKeyRange, newTypes); err != nil {
+		t.Fatalf("SetServingKeyRange failed: %v", err)
+	}
+	keyRange, servedTypes = agent.ServingKeyRange()
+	if !key.KeyRangeEqual(keyRange, newKeyRange) || !reflect.DeepEqual(servedTypes, newTypes) {
+		t.Errorf("ServingKeyRange() = %v, %v, want %v, %v", key.KeyRangeString(keyRange), servedTypes, key.KeyRangeString(newKeyRange), newTypes)
+	}
+
+	// The query service of the replica rejects the queries outside
+	// the keyrange.
+	want := tabletserver.NewQueryRule("enforce serving keyrange", "serving_keyrange", tabletserver.QRFail)
+	if err := want.AddBindVarCond("keyspace_id", false, true, tabletserver.QRNotIn, newKeyRange); err != nil {
+		t.Fatalf("AddBindVarCond failed: %v", err)
+	}
+	if got := qsc.QueryRules[servingKeyRangeQueryRules].Find("serving_keyrange"); !reflect.DeepEqual(got, want) {
+		t.Errorf("serving keyrange query rule = %v, want %v", got, want)
+	}
+
+	// The types it is not served for, like master here, don't
+	// get the rule.
+	if err := agent.loadServingKeyRangeRules(topodatapb.TabletType_MASTER, newKeyRange, newTypes); err != nil {
+		t.Fatalf("loadServingKeyRangeRules(MASTER) failed: %v", err)
+	}
+	if got := qsc.QueryRules[servingKeyRangeQueryRules].Find("serving_keyrange"); got != nil {
+		t.Errorf("serving keyrange query rule on a master = %v, want none", got)
+	}
+	if err := agent.loadServingKeyRangeRules(topodatapb.TabletType_REPLICA, newKeyRange, newTypes); err != nil {
+		t.Fatalf("loadServingKeyRangeRules(REPLICA) failed: %v", err)
+	}
+
+	// Keyranges outside the shard, or spilling out of it, are
+	// rejected, and the previous one is still served.
+	for _, outside := range []*topodatapb.KeyRange{
+		{Start: []byte{0x00}, End: []byte{0x40}},
+		{Start: []byte{0xa0}, End: []byte{0xe0}},
+	} {
+		err := agent.SetServingKeyRange(ctx, outside, []topodatapb.TabletType{topodatapb.TabletType_MASTER})
+		if err == nil || !strings.Contains(err.Error(), "is not within") {
+			t.Errorf("SetServingKeyRange(%v) = %v, want an error about the shard keyrange", key.KeyRangeString(outside), err)
+		}
+	}
+	keyRange, servedTypes = agent.ServingKeyRange()
+	if !key.KeyRangeEqual(keyRange, newKeyRange) || !reflect.DeepEqual(servedTypes, newTypes) {
+		t.Errorf("after a rejected SetServingKeyRange, ServingKeyRange() = %v, %v, want %v, %v", key.KeyRangeString(keyRange), servedTypes, key.KeyRangeString(newKeyRange), newTypes)
+	}
+
+	// A nil keyrange goes back to the whole shard.
+	if err := agent.SetServingKeyRange(ctx, nil, nil); err != nil {
+		t.Fatalf("SetServingKeyRange(nil) failed: %v", err)
+	}
+	keyRange, servedTypes = agent.ServingKeyRange()
+	if !key.KeyRangeEqual(keyRange, shardKeyRange) || servedTypes != nil {
+		t.Errorf("after SetServingKeyRange(nil), ServingKeyRange() = %v, %v, want %v for all types", key.KeyRangeString(keyRange), servedTypes, key.KeyRangeString(shardKeyRange))
+	}
+	if qr := qsc.QueryRules[servingKeyRangeQueryRules].Find("serving_keyrange"); qr != nil {
+		t.Errorf("serving keyrange query rule kept after SetServingKeyRange(nil): %v", qr)
+	}
+}
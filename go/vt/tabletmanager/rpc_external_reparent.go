@@ -6,6 +6,7 @@ package tabletmanager
 
 import (
 	"flag"
+	"fmt"
 	"sync"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/youtube/vitess/go/stats"
 	"github.com/youtube/vitess/go/trace"
 	"github.com/youtube/vitess/go/vt/concurrency"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
 	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
 	"github.com/youtube/vitess/go/vt/topo"
 	"github.com/youtube/vitess/go/vt/topo/topoproto"
@@ -36,13 +38,20 @@ func SetReparentFlags(timeout time.Duration) {
 }
 
 // TabletExternallyReparented updates all topo records so the current
-// tablet is the new master for this shard.
-func (agent *ActionAgent) TabletExternallyReparented(ctx context.Context, externalID string) error {
+// tablet is the new master for this shard. If validate is set, the
+// claim is rejected when mysqld is still replicating from a master.
+func (agent *ActionAgent) TabletExternallyReparented(ctx context.Context, externalID string, validate bool) error {
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
 	defer agent.unlock()
 
+	if validate {
+		if err := agent.validateExternalReparent(); err != nil {
+			return err
+		}
+	}
+
 	startTime := time.Now()
 
 	// If there is a finalize step running, wait for it to finish or time out
@@ -221,3 +230,24 @@ func (agent *ActionAgent) setLastReparentedTime(t time.Time) {
 	agent._tabletExternallyReparentedTime = t
 	agent._replicationDelay = 0
 }
+
+// validateExternalReparent returns an error if the underlying mysqld
+// still replicates, or still has a master configured: a stopped slave
+// can be restarted, so it is not a master yet either.
+func (agent *ActionAgent) validateExternalReparent() error {
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	switch err {
+	case nil:
+	case mysqlctl.ErrNotSlave:
+		return nil
+	default:
+		return fmt.Errorf("cannot validate external reparent: %v", err)
+	}
+	if status.SlaveIORunning || status.SlaveSQLRunning {
+		return fmt.Errorf("rejecting external reparent: tablet is still replicating from %v:%v", status.MasterHost, status.MasterPort)
+	}
+	if status.MasterHost != "" {
+		return fmt.Errorf("rejecting external reparent: tablet still has master %v:%v configured", status.MasterHost, status.MasterPort)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/tabletserver/planbuilder"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// defaultQPSInterval is the time between StreamQPS samples when the
+// client does not ask for one.
+const defaultQPSInterval = time.Second
+
+var (
+	// qpsReadPlans are the query plans StreamQPS counts as reads.
+	qpsReadPlans = map[string]bool{
+		planbuilder.PlanPassSelect.String():   true,
+		planbuilder.PlanSelectLock.String():   true,
+		planbuilder.PlanSelectStream.String(): true,
+	}
+	// qpsWritePlans are the query plans StreamQPS counts as writes.
+	qpsWritePlans = map[string]bool{
+		planbuilder.PlanPassDML.String():        true,
+		planbuilder.PlanDMLPK.String():          true,
+		planbuilder.PlanDMLSubquery.String():    true,
+		planbuilder.PlanInsertPK.String():       true,
+		planbuilder.PlanInsertSubquery.String(): true,
+		planbuilder.PlanUpsertPK.String():       true,
+		planbuilder.PlanInsertMessage.String():  true,
+	}
+)
+
+// StreamQPS sends the query rates of the tablet every interval, until
+// ctx is canceled or send fails. Samples are sent from their own
+// goroutine, through a buffer of one: if send has not returned by the
+// time the next sample is taken, that sample is dropped, so a slow
+// client never holds up the tablet. Each sample reports how many were
+// dropped so far. Over gRPC, send only blocks once the flow control
+// window of the stream is full, so the client drops samples for a slow
+// reader too.
+func (agent *ActionAgent) StreamQPS(ctx context.Context, interval time.Duration, send func(*tabletmanagerdatapb.QPSSample) error) (err error) {
+	qss := agent.QueryServiceControl.QueryServiceStats()
+	if qss == nil {
+		return fmt.Errorf("query service stats are not available")
+	}
+	if interval <= 0 {
+		interval = defaultQPSInterval
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	samples := make(chan *tabletmanagerdatapb.QPSSample, 1)
+	done := make(chan struct{})
+	var sendErr error
+	go func() {
+		defer close(done)
+		for sample := range samples {
+			if sendErr != nil {
+				continue
+			}
+			if sendErr = send(sample); sendErr != nil {
+				cancel()
+			}
+		}
+	}()
+	defer func() {
+		close(samples)
+		<-done
+		if sendErr != nil {
+			err = sendErr
+		}
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	counts := qss.QueryStats.Counts()
+	last := time.Now()
+	var dropped int64
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			newCounts := qss.QueryStats.Counts()
+			sample := qpsSample(counts, newCounts, now.Sub(last))
+			sample.TimestampNs = now.UnixNano()
+			sample.Dropped = dropped
+			counts, last = newCounts, now
+			select {
+			case samples <- sample:
+			default:
+				dropped++
+			}
+		}
+	}
+}
+
+// qpsSample returns the query rates between two snapshots of the
+// query counts, taken elapsed apart.
+func qpsSample(before, after map[string]int64, elapsed time.Duration) *tabletmanagerdatapb.QPSSample {
+	sample := &tabletmanagerdatapb.QPSSample{
+		IntervalNs: int64(elapsed),
+	}
+	seconds := elapsed.Seconds()
+	if seconds <= 0 {
+		return sample
+	}
+	var reads, writes int64
+	for name, count := range after {
+		switch {
+		case qpsReadPlans[name]:
+			reads += count - before[name]
+		case qpsWritePlans[name]:
+			writes += count - before[name]
+		}
+	}
+	sample.ReadQps = float64(reads) / seconds
+	sample.WriteQps = float64(writes) / seconds
+	sample.TotalQps = float64(after["All"]-before["All"]) / seconds
+	return sample
+}
@@ -0,0 +1,100 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/tabletserver"
+	"github.com/youtube/vitess/go/vt/tabletserver/tabletservermock"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestQPSSample(t *testing.T) {
+	before := map[string]int64{
+		"PASS_SELECT": 10,
+		"INSERT_PK":   4,
+		"All":         20,
+	}
+	after := map[string]int64{
+		"PASS_SELECT": 30,
+		"SELECT_LOCK": 4,
+		"INSERT_PK":   10,
+		"DML_PK":      2,
+		"BEGIN":       6,
+		"All":         68,
+	}
+	got := qpsSample(before, after, 2*time.Second)
+	want := &tabletmanagerdatapb.QPSSample{
+		IntervalNs: int64(2 * time.Second),
+		ReadQps:    12,
+		WriteQps:   4,
+		TotalQps:   24,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("qpsSample() = %v, want %v", got, want)
+	}
+}
+
+func TestStreamQPS(t *testing.T) {
+	controller := tabletservermock.NewController()
+	controller.Stats = tabletserver.NewQueryServiceStats("", false)
+	agent := &ActionAgent{
+		QueryServiceControl: controller,
+	}
+
+	// The first send is slow, so the samples taken meanwhile are
+	// dropped instead of blocking the sampling.
+	errEnough := errors.New("enough samples")
+	var samples []*tabletmanagerdatapb.QPSSample
+	err := agent.StreamQPS(context.Background(), time.Millisecond, func(sample *tabletmanagerdatapb.QPSSample) error {
+		samples = append(samples, sample)
+		switch len(samples) {
+		case 1:
+			time.Sleep(50 * time.Millisecond)
+		case 5:
+			return errEnough
+		}
+		return nil
+	})
+	if err != errEnough {
+		t.Fatalf("StreamQPS returned %v, want %v", err, errEnough)
+	}
+
+	if len(samples) != 5 {
+		t.Fatalf("StreamQPS sent %v samples, want 5", len(samples))
+	}
+	if samples[0].Dropped != 0 {
+		t.Errorf("first sample reports %v dropped samples, want 0", samples[0].Dropped)
+	}
+	for i := 1; i < len(samples); i++ {
+		if samples[i].Dropped < samples[i-1].Dropped {
+			t.Errorf("sample %v reports %v dropped samples, less than the %v before", i, samples[i].Dropped, samples[i-1].Dropped)
+		}
+		if samples[i].TimestampNs <= samples[i-1].TimestampNs {
+			t.Errorf("sample %v is not after the one before: %v <= %v", i, samples[i].TimestampNs, samples[i-1].TimestampNs)
+		}
+	}
+	if last := samples[len(samples)-1]; last.Dropped == 0 {
+		t.Errorf("no sample was dropped while the client was slow: %v", last)
+	}
+}
+
+func TestStreamQPSNoStats(t *testing.T) {
+	agent := &ActionAgent{
+		QueryServiceControl: tabletservermock.NewController(),
+	}
+	if err := agent.StreamQPS(context.Background(), time.Millisecond, func(*tabletmanagerdatapb.QPSSample) error {
+		return nil
+	}); err == nil {
+		t.Errorf("StreamQPS without query service stats worked")
+	}
+}
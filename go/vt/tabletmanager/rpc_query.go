@@ -5,14 +5,59 @@
 package tabletmanager
 
 import (
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/sqldb"
 	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/binlog"
+	"github.com/youtube/vitess/go/vt/dbconnpool"
+	"github.com/youtube/vitess/go/vt/key"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/sqlparser"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
 	"golang.org/x/net/context"
 
+	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+const (
+	// errUnknownSystemVariable is the mysql error for setting a
+	// variable the server does not have.
+	errUnknownSystemVariable = 1193
+	// errMaxExecTimeExceeded and errMariaDBMaxStatementTimeExceeded
+	// are the errors of a query aborted by max_execution_time, resp.
+	// MariaDB's max_statement_time.
+	errMaxExecTimeExceeded             = 3024
+	errMariaDBMaxStatementTimeExceeded = 1969
 )
 
+// setMaxExecTime caps the execution time of the queries run on conn.
+// MySQL 5.7 has max_execution_time, in milliseconds. MariaDB has
+// max_statement_time instead, in seconds.
+func setMaxExecTime(conn *dbconnpool.DBConnection, maxExecTime time.Duration) error {
+	_, err := conn.ExecuteFetch(fmt.Sprintf("SET SESSION max_execution_time = %v", int64(maxExecTime/time.Millisecond)), 0, false)
+	if sqlErr, ok := err.(*sqldb.SQLError); ok && sqlErr.Number() == errUnknownSystemVariable {
+		_, err = conn.ExecuteFetch(fmt.Sprintf("SET SESSION max_statement_time = %v", maxExecTime.Seconds()), 0, false)
+	}
+	return err
+}
+
 // ExecuteFetchAsDba will execute the given query, possibly disabling binlogs and reload schema.
-func (agent *ActionAgent) ExecuteFetchAsDba(ctx context.Context, query []byte, dbName string, maxrows int, disableBinlogs bool, reloadSchema bool) (*querypb.QueryResult, error) {
+// If maxExecTime is not 0, mysql aborts the query after that long,
+// and tmclient.ErrMaxExecTimeExceeded is returned.
+func (agent *ActionAgent) ExecuteFetchAsDba(ctx context.Context, query []byte, dbName string, maxrows int, disableBinlogs bool, reloadSchema bool, maxExecTime time.Duration) (*querypb.QueryResult, error) {
 	// get a connection
 	conn, err := agent.MysqlDaemon.GetDbaConnection()
 	if err != nil {
@@ -34,8 +79,17 @@ func (agent *ActionAgent) ExecuteFetchAsDba(ctx context.Context, query []byte, d
 		conn.ExecuteFetch("USE "+dbName, 1, false)
 	}
 
+	if maxExecTime > 0 {
+		if err := setMaxExecTime(conn, maxExecTime); err != nil {
+			return nil, fmt.Errorf("cannot set max exec time: %v", err)
+		}
+	}
+
 	// run the query
 	result, err := conn.ExecuteFetch(string(query), maxrows, true /*wantFields*/)
+	if sqlErr, ok := err.(*sqldb.SQLError); ok && (sqlErr.Number() == errMaxExecTimeExceeded || sqlErr.Number() == errMariaDBMaxStatementTimeExceeded) {
+		err = tmclient.ErrMaxExecTimeExceeded
+	}
 
 	// re-enable binlogs if necessary
 	if disableBinlogs && !conn.IsClosed() {
@@ -53,6 +107,139 @@ func (agent *ActionAgent) ExecuteFetchAsDba(ctx context.Context, query []byte, d
 	return sqltypes.ResultToProto3(result), err
 }
 
+// ExecuteFetchColumnar executes the given query with the DBA user,
+// and returns the result in columnar form.
+func (agent *ActionAgent) ExecuteFetchColumnar(ctx context.Context, query []byte, dbName string, maxrows int) (*tabletmanagerdatapb.ColumnarResult, error) {
+	// get a connection
+	conn, err := agent.MysqlDaemon.GetDbaConnection()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if dbName != "" {
+		if _, err := conn.ExecuteFetch("USE "+dbName, 1, false); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := conn.ExecuteFetch(string(query), maxrows, true /*wantFields*/)
+	if err != nil {
+		return nil, err
+	}
+	return resultToColumnar(result), nil
+}
+
+// resultToColumnar converts qr to columnar form. The values of each
+// column are encoded like a querypb.Row, so the conversion allocates
+// a few times per column, where ResultToProto3 allocates a few times
+// per row.
+func resultToColumnar(qr *sqltypes.Result) *tabletmanagerdatapb.ColumnarResult {
+	columns := make([]*querypb.Row, len(qr.Fields))
+	for i := range columns {
+		column := &querypb.Row{
+			Lengths: make([]int64, len(qr.Rows)),
+		}
+		total := 0
+		for j, row := range qr.Rows {
+			if row[i].IsNull() {
+				column.Lengths[j] = -1
+				continue
+			}
+			length := row[i].Len()
+			column.Lengths[j] = int64(length)
+			total += length
+		}
+		column.Values = make([]byte, 0, total)
+		for _, row := range qr.Rows {
+			column.Values = append(column.Values, row[i].Raw()...)
+		}
+		columns[i] = column
+	}
+	return &tabletmanagerdatapb.ColumnarResult{
+		Fields:       qr.Fields,
+		RowsAffected: qr.RowsAffected,
+		InsertId:     qr.InsertID,
+		RowCount:     uint64(len(qr.Rows)),
+		Columns:      columns,
+	}
+}
+
+// csvStreamBufferSize is the size of the MySQL result chunks
+// ExecuteFetchAsDbaCSV encodes and sends at once.
+const csvStreamBufferSize = 32 * 1024
+
+// ExecuteFetchAsDbaCSV streams the result of the given query, encoded
+// as CSV after a header row of field names. NULL values are encoded as
+// empty strings.
+func (agent *ActionAgent) ExecuteFetchAsDbaCSV(ctx context.Context, query []byte, dbName string, send func([]byte) error) error {
+	// get a connection
+	conn, err := agent.MysqlDaemon.GetDbaConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if dbName != "" {
+		if _, err := conn.ExecuteFetch("USE "+dbName, 1, false); err != nil {
+			return err
+		}
+	}
+
+	cw := newCSVResultWriter(send)
+	return conn.ExecuteStreamFetch(string(query), cw.write, csvStreamBufferSize)
+}
+
+// csvResultWriter encodes streamed query results as CSV, and sends
+// each encoded chunk.
+type csvResultWriter struct {
+	buf  bytes.Buffer
+	w    *csv.Writer
+	send func([]byte) error
+}
+
+func newCSVResultWriter(send func([]byte) error) *csvResultWriter {
+	cw := &csvResultWriter{
+		send: send,
+	}
+	cw.w = csv.NewWriter(&cw.buf)
+	return cw
+}
+
+// write encodes a result: the header row if it has fields, then its
+// rows. It is a callback for ExecuteStreamFetch.
+func (cw *csvResultWriter) write(qr *sqltypes.Result) error {
+	if len(qr.Fields) > 0 {
+		record := make([]string, len(qr.Fields))
+		for i, field := range qr.Fields {
+			record[i] = field.Name
+		}
+		if err := cw.w.Write(record); err != nil {
+			return err
+		}
+	}
+	for _, row := range qr.Rows {
+		record := make([]string, len(row))
+		for i, value := range row {
+			record[i] = value.String()
+		}
+		if err := cw.w.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.w.Flush()
+	if err := cw.w.Error(); err != nil {
+		return err
+	}
+	if cw.buf.Len() == 0 {
+		return nil
+	}
+	data := make([]byte, cw.buf.Len())
+	copy(data, cw.buf.Bytes())
+	cw.buf.Reset()
+	return cw.send(data)
+}
+
 // ExecuteFetchAsAllPrivs will execute the given query, possibly reloading schema.
 func (agent *ActionAgent) ExecuteFetchAsAllPrivs(ctx context.Context, query []byte, dbName string, maxrows int, reloadSchema bool) (*querypb.QueryResult, error) {
 	// get a connection
@@ -88,3 +275,487 @@ func (agent *ActionAgent) ExecuteFetchAsApp(ctx context.Context, query []byte, m
 	result, err := conn.ExecuteFetch(string(query), maxrows, true /*wantFields*/)
 	return sqltypes.ResultToProto3(result), err
 }
+
+// explainMaxRows is the most rows ExplainQuery returns: a plan has
+// about one row per table in the query.
+const explainMaxRows = 1000
+
+// explainClauses are the EXPLAIN clauses of the formats ExplainQuery
+// accepts.
+var explainClauses = map[string]string{
+	"":            "EXPLAIN ",
+	"TRADITIONAL": "EXPLAIN ",
+	"JSON":        "EXPLAIN FORMAT=JSON ",
+}
+
+// ExplainQuery returns the execution plan of query in the tablet
+// database, as computed by MySQL with the statistics of the tablet.
+// format is TRADITIONAL (or empty) for the tabular plan, or JSON. The
+// query is explained, never run: only SELECT, INSERT, UPDATE and
+// DELETE statements are accepted.
+func (agent *ActionAgent) ExplainQuery(ctx context.Context, query, format string) (*querypb.QueryResult, error) {
+	clause, ok := explainClauses[strings.ToUpper(format)]
+	if !ok {
+		return nil, fmt.Errorf("invalid EXPLAIN format %q: must be TRADITIONAL or JSON", format)
+	}
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse query: %v", err)
+	}
+	switch stmt.(type) {
+	case sqlparser.SelectStatement, *sqlparser.Insert, *sqlparser.Update, *sqlparser.Delete:
+	default:
+		return nil, fmt.Errorf("cannot explain %q: only SELECT, INSERT, UPDATE and DELETE can be explained", query)
+	}
+
+	conn, err := agent.MysqlDaemon.GetDbaConnection()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if _, err := conn.ExecuteFetch("USE "+sqlparser.Backtick(topoproto.TabletDbName(agent.Tablet())), 1, false); err != nil {
+		return nil, err
+	}
+	result, err := conn.ExecuteFetch(clause+query, explainMaxRows, true /*wantFields*/)
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.ResultToProto3(result), nil
+}
+
+// ApplyGrants runs a batch of GRANT and REVOKE statements, see
+// mysqlctl.ApplyGrants.
+func (agent *ActionAgent) ApplyGrants(ctx context.Context, statements []string, atomic bool) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	return mysqlctl.ApplyGrants(ctx, agent.MysqlDaemon, statements, atomic)
+}
+
+// rowStreamBufferSize is the approximate size in bytes of the row
+// batches read from mysqld by ChecksumTable and StreamRowsInKeyRange.
+const rowStreamBufferSize = 32 * 1024
+
+// ChecksumTable returns the checksum of the rows of the table that are
+// in keyRange, and their count. Each row is hashed on its own, and the
+// checksum is the sum of the row hashes, so it does not depend on the
+// order the rows are read in. The rows are streamed from mysqld, so
+// the table is never held in memory.
+func (agent *ActionAgent) ChecksumTable(ctx context.Context, table string, keyRange *topodatapb.KeyRange) (uint64, int64, error) {
+	dbName := topoproto.TabletDbName(agent.Tablet())
+	conn, err := agent.MysqlDaemon.GetDbaConnection()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer conn.Close()
+
+	var checksum uint64
+	var rowCount int64
+	var inKeyRange func([]sqltypes.Value) (bool, error)
+	buf := make([]byte, binary.MaxVarintLen64)
+	err = conn.ExecuteStreamFetch(fmt.Sprintf("SELECT * FROM %v.%v", sqlparser.Backtick(dbName), sqlparser.Backtick(table)), func(qr *sqltypes.Result) error {
+		if inKeyRange == nil {
+			// The first result only has the fields.
+			var err error
+			inKeyRange, err = agent.keyRangeFilter(ctx, table, qr.Fields, keyRange)
+			return err
+		}
+		for _, row := range qr.Rows {
+			in, err := inKeyRange(row)
+			if err != nil {
+				return err
+			}
+			if !in {
+				continue
+			}
+
+			// Values are length-prefixed, so different rows with
+			// the same concatenated bytes hash differently. NULL
+			// is distinguished from the empty value.
+			h := fnv.New64a()
+			for _, value := range row {
+				if value.IsNull() {
+					h.Write([]byte{0})
+					continue
+				}
+				h.Write([]byte{1})
+				h.Write(buf[:binary.PutUvarint(buf, uint64(len(value.Raw())))])
+				h.Write(value.Raw())
+			}
+			checksum += h.Sum64()
+			rowCount++
+		}
+		return nil
+	}, rowStreamBufferSize)
+	if err != nil {
+		return 0, 0, err
+	}
+	return checksum, rowCount, nil
+}
+
+// TruncateTable truncates the table, if confirmKeyspace is the
+// keyspace of the tablet. The check guards against truncating the
+// tables of the wrong keyspace, so TruncateTable should be preferred
+// to running TRUNCATE TABLE with ExecuteFetchAsDba.
+func (agent *ActionAgent) TruncateTable(ctx context.Context, table, confirmKeyspace string) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	tablet := agent.Tablet()
+	if confirmKeyspace != tablet.Keyspace {
+		return fmt.Errorf("confirm keyspace %q does not match the tablet keyspace %q, not truncating %v", confirmKeyspace, tablet.Keyspace, table)
+	}
+	if table == "" {
+		return fmt.Errorf("no table to truncate")
+	}
+	log.Infof("TruncateTable: truncating %v in keyspace %v", table, tablet.Keyspace)
+	return agent.MysqlDaemon.ExecuteSuperQueryList(ctx, []string{
+		fmt.Sprintf("TRUNCATE TABLE %v.%v", sqlparser.Backtick(topoproto.TabletDbName(tablet)), sqlparser.Backtick(table)),
+	})
+}
+
+// RenameTable renames the table from to the table to, in the tablet
+// database. It first looks for the views and the foreign keys that
+// depend on the table, and returns them. If there are some, the rename
+// is refused unless cascade is set: the dependent views are then
+// recreated on the new name, and InnoDB makes the foreign keys follow
+// the rename. RenameTable should be preferred to running RENAME TABLE
+// with ExecuteFetchAsDba, which would break the views.
+func (agent *ActionAgent) RenameTable(ctx context.Context, from, to string, cascade bool) (*tabletmanagerdatapb.RenameTableResult, error) {
+	if from == "" || to == "" {
+		return nil, fmt.Errorf("both the table to rename and its new name are required")
+	}
+	if from == to {
+		return nil, fmt.Errorf("table %v already has that name", from)
+	}
+	if err := agent.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer agent.unlock()
+
+	dbName := topoproto.TabletDbName(agent.Tablet())
+	result := &tabletmanagerdatapb.RenameTableResult{}
+	oldName := sqlparser.Backtick(dbName) + "." + sqlparser.Backtick(from)
+	newName := sqlparser.Backtick(dbName) + "." + sqlparser.Backtick(to)
+	qr, err := agent.MysqlDaemon.FetchSuperQuery(ctx, fmt.Sprintf("SELECT table_name FROM information_schema.views WHERE table_schema = %v AND view_definition LIKE %v", encodeString(dbName), encodeString("%"+likeEscaper.Replace(oldName)+"%")))
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range qr.Rows {
+		result.DependentViews = append(result.DependentViews, row[0].String())
+	}
+	qr, err = agent.MysqlDaemon.FetchSuperQuery(ctx, fmt.Sprintf("SELECT table_name, constraint_name FROM information_schema.referential_constraints WHERE constraint_schema = %v AND referenced_table_name = %v AND table_name != %v", encodeString(dbName), encodeString(from), encodeString(from)))
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range qr.Rows {
+		result.DependentForeignKeys = append(result.DependentForeignKeys, row[0].String()+"."+row[1].String())
+	}
+	if !cascade && (len(result.DependentViews) > 0 || len(result.DependentForeignKeys) > 0) {
+		log.Warningf("RenameTable: not renaming %v, views %v and foreign keys %v depend on it", from, result.DependentViews, result.DependentForeignKeys)
+		return result, nil
+	}
+
+	cmds := []string{fmt.Sprintf("RENAME TABLE %v TO %v", oldName, newName)}
+	for _, view := range result.DependentViews {
+		qr, err := agent.MysqlDaemon.FetchSuperQuery(ctx, fmt.Sprintf("SHOW CREATE VIEW %v.%v", sqlparser.Backtick(dbName), sqlparser.Backtick(view)))
+		if err != nil {
+			return nil, err
+		}
+		if len(qr.Rows) != 1 || len(qr.Rows[0]) < 2 {
+			return nil, fmt.Errorf("unexpected result for SHOW CREATE VIEW %v: %v", view, qr.Rows)
+		}
+		create := strings.Replace(qr.Rows[0][1].String(), oldName, newName, -1)
+		cmds = append(cmds, "CREATE OR REPLACE "+strings.TrimPrefix(create, "CREATE "))
+	}
+	log.Infof("RenameTable: renaming %v to %v in %v, recreating views %v", from, to, dbName, result.DependentViews)
+	if err := agent.MysqlDaemon.ExecuteSuperQueryList(ctx, cmds); err != nil {
+		return nil, err
+	}
+	result.Renamed = true
+	return result, nil
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern, so it matches
+// the string literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// StreamRowsInKeyRange sends the rows of the table that are in keyRange
+// (all rows if keyRange is nil), in primary key order, so the rows of
+// several shards can be merged. Text primary key columns are ordered by
+// their bytes, not their collation. The first result only has the fields.
+// The rows are streamed from mysqld, in a transaction with a
+// consistent snapshot, so they are a consistent view of the table
+// whatever its size.
+func (agent *ActionAgent) StreamRowsInKeyRange(ctx context.Context, table string, keyRange *topodatapb.KeyRange, send func(*querypb.QueryResult) error) error {
+	tablet := agent.Tablet()
+	dbName := topoproto.TabletDbName(tablet)
+	sd, err := agent.MysqlDaemon.GetSchema(dbName, []string{table}, nil, false)
+	if err != nil {
+		return err
+	}
+	var td *tabletmanagerdatapb.TableDefinition
+	for _, t := range sd.TableDefinitions {
+		if t.Name == table {
+			td = t
+			break
+		}
+	}
+	if td == nil {
+		return fmt.Errorf("table %v not found in %v", table, dbName)
+	}
+	if len(td.PrimaryKeyColumns) == 0 {
+		return fmt.Errorf("table %v has no primary key, cannot stream its rows in order", table)
+	}
+
+	conn, err := agent.MysqlDaemon.GetDbaConnection()
+	if err != nil {
+		return err
+	}
+	// Closing the connection ends the transaction if we fail
+	// half-way.
+	defer conn.Close()
+	if _, err := conn.ExecuteFetch("START TRANSACTION WITH CONSISTENT SNAPSHOT", 0, false); err != nil {
+		return err
+	}
+
+	// Text columns are ordered by their bytes, not their collation,
+	// so the rows of several shards can be merged by comparing bytes,
+	// whatever the collation.
+	qr, err := conn.ExecuteFetch(fmt.Sprintf("SELECT * FROM %v.%v LIMIT 0", sqlparser.Backtick(dbName), sqlparser.Backtick(table)), 0, true)
+	if err != nil {
+		return err
+	}
+	types := make(map[string]querypb.Type, len(qr.Fields))
+	for _, field := range qr.Fields {
+		types[field.Name] = field.Type
+	}
+	orderBy := make([]string, len(td.PrimaryKeyColumns))
+	for i, column := range td.PrimaryKeyColumns {
+		orderBy[i] = sqlparser.Backtick(column)
+		if sqltypes.IsText(types[column]) {
+			orderBy[i] = "BINARY " + orderBy[i]
+		}
+	}
+
+	var inKeyRange func([]sqltypes.Value) (bool, error)
+	if err := conn.ExecuteStreamFetch(fmt.Sprintf("SELECT * FROM %v.%v ORDER BY %v", sqlparser.Backtick(dbName), sqlparser.Backtick(table), strings.Join(orderBy, ", ")), func(qr *sqltypes.Result) error {
+		if inKeyRange == nil {
+			// The first result only has the fields.
+			var err error
+			inKeyRange, err = agent.keyRangeFilter(ctx, table, qr.Fields, keyRange)
+			if err != nil {
+				return err
+			}
+			return send(&querypb.QueryResult{Fields: qr.Fields})
+		}
+		batch := &sqltypes.Result{}
+		for _, row := range qr.Rows {
+			in, err := inKeyRange(row)
+			if err != nil {
+				return err
+			}
+			if in {
+				batch.Rows = append(batch.Rows, row)
+			}
+		}
+		if len(batch.Rows) == 0 {
+			return nil
+		}
+		return send(sqltypes.ResultToProto3(batch))
+	}, rowStreamBufferSize); err != nil {
+		return err
+	}
+	_, err = conn.ExecuteFetch("ROLLBACK", 0, false)
+	return err
+}
+
+// StreamKeyRangeBinlog sends the binlog transactions that touch rows in
+// keyRange, starting at the replication position startPos, until ctx
+// is done or send fails.
+func (agent *ActionAgent) StreamKeyRangeBinlog(ctx context.Context, keyRange *topodatapb.KeyRange, startPos string, charset *binlogdatapb.Charset, send func(*binlogdatapb.BinlogTransaction) error) error {
+	if keyRange == nil {
+		return fmt.Errorf("no key range to stream the binlogs of")
+	}
+	pos, err := replication.DecodePosition(startPos)
+	if err != nil {
+		return err
+	}
+	dbName := topoproto.TabletDbName(agent.Tablet())
+	bls := binlog.NewStreamer(dbName, agent.MysqlDaemon, charset, pos, 0, keyRangeBinlogFilter(keyRange, send))
+	return bls.Stream(ctx)
+}
+
+// keyRangeBinlogFilter returns a function that removes the statements
+// of a transaction that are outside of keyRange, and calls send if
+// some are left. binlog.KeyRangeFilterFunc does the filtering, and
+// splits the multi-row inserts spanning the boundary of keyRange. It
+// sends the transactions with no statement left so filtered
+// replication can update its position, but a client of
+// StreamKeyRangeBinlog only wants the ones touching the key range.
+func keyRangeBinlogFilter(keyRange *topodatapb.KeyRange, send func(*binlogdatapb.BinlogTransaction) error) func(*binlogdatapb.BinlogTransaction) error {
+	return binlog.KeyRangeFilterFunc(keyRange, func(transaction *binlogdatapb.BinlogTransaction) error {
+		if len(transaction.Statements) == 0 {
+			return nil
+		}
+		return send(transaction)
+	})
+}
+
+// cloneStreamBufferSize is the default approximate size in bytes of
+// the row batches sent by CloneStream.
+const cloneStreamBufferSize = 32 * 1024
+
+// CloneStream sends a consistent dump of the tables. The first message
+// only has the replication position of the snapshot. Then, for each
+// table, a message has its fields, and the next ones its rows.
+//
+// All the tables are read in a single transaction with a consistent
+// snapshot. The snapshot is started while holding a global read lock,
+// so no transaction can commit between the start of the snapshot and
+// the read of the position.
+func (agent *ActionAgent) CloneStream(ctx context.Context, tables []string, bufferSize int, send func(*tabletmanagerdatapb.CloneStreamResponse) error) error {
+	if len(tables) == 0 {
+		return fmt.Errorf("no table to clone")
+	}
+	if bufferSize <= 0 {
+		bufferSize = cloneStreamBufferSize
+	}
+	dbName := topoproto.TabletDbName(agent.Tablet())
+
+	conn, err := agent.MysqlDaemon.GetDbaConnection()
+	if err != nil {
+		return err
+	}
+	// Closing the connection releases the lock and ends the
+	// transaction if we fail half-way.
+	defer conn.Close()
+
+	if _, err := conn.ExecuteFetch("FLUSH TABLES WITH READ LOCK", 0, false); err != nil {
+		return err
+	}
+	if _, err := conn.ExecuteFetch("START TRANSACTION WITH CONSISTENT SNAPSHOT", 0, false); err != nil {
+		return err
+	}
+	position, err := agent.MysqlDaemon.MasterPosition()
+	if err != nil {
+		return fmt.Errorf("can't get master position: %v", err)
+	}
+	if _, err := conn.ExecuteFetch("UNLOCK TABLES", 0, false); err != nil {
+		return err
+	}
+	if err := send(&tabletmanagerdatapb.CloneStreamResponse{
+		Position: replication.EncodePosition(position),
+	}); err != nil {
+		return err
+	}
+
+	for _, table := range tables {
+		if err := conn.ExecuteStreamFetch(fmt.Sprintf("SELECT * FROM %v.%v", sqlparser.Backtick(dbName), sqlparser.Backtick(table)), func(qr *sqltypes.Result) error {
+			return send(&tabletmanagerdatapb.CloneStreamResponse{
+				Table:  table,
+				Result: sqltypes.ResultToProto3(qr),
+			})
+		}, bufferSize); err != nil {
+			return fmt.Errorf("cannot clone table %v: %v", table, err)
+		}
+	}
+	_, err = conn.ExecuteFetch("ROLLBACK", 0, false)
+	return err
+}
+
+// keyRangeFilter returns a function that tells if a row of the table,
+// whose columns are fields, is in keyRange. The sharding column of
+// the keyspace is used to compute the keyspace id of the row. If
+// keyRange is nil, all rows are in it.
+func (agent *ActionAgent) keyRangeFilter(ctx context.Context, table string, fields []*querypb.Field, keyRange *topodatapb.KeyRange) (func([]sqltypes.Value) (bool, error), error) {
+	if keyRange == nil {
+		return func([]sqltypes.Value) (bool, error) {
+			return true, nil
+		}, nil
+	}
+
+	keyspace := agent.Tablet().Keyspace
+	ki, err := agent.TopoServer.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	if ki.ShardingColumnName == "" {
+		return nil, fmt.Errorf("keyspace %v has no sharding column, cannot filter rows on a key range", keyspace)
+	}
+	shardingColumn := -1
+	for i, field := range fields {
+		if field.Name == ki.ShardingColumnName {
+			shardingColumn = i
+			break
+		}
+	}
+	if shardingColumn == -1 {
+		return nil, fmt.Errorf("table %v has no sharding column %v", table, ki.ShardingColumnName)
+	}
+
+	return func(row []sqltypes.Value) (bool, error) {
+		keyspaceID, err := rowKeyspaceID(row[shardingColumn], ki.ShardingColumnType)
+		if err != nil {
+			return false, err
+		}
+		return key.KeyRangeContains(keyRange, keyspaceID), nil
+	}, nil
+}
+
+// GetCharsetConfig returns the default character set and collation
+// of the server and of its databases.
+func (agent *ActionAgent) GetCharsetConfig(ctx context.Context) (*tabletmanagerdatapb.CharsetConfig, error) {
+	return mysqlctl.GetCharsetConfig(ctx, agent.MysqlDaemon)
+}
+
+// GetProcessList returns the threads currently running in MySQL.
+func (agent *ActionAgent) GetProcessList(ctx context.Context) ([]*tabletmanagerdatapb.Process, error) {
+	return mysqlctl.GetProcessList(ctx, agent.MysqlDaemon)
+}
+
+// KillProcess kills the MySQL connection with the given ID, unless it
+// is a system or replication thread.
+func (agent *ActionAgent) KillProcess(ctx context.Context, id int64) error {
+	return mysqlctl.KillProcess(ctx, agent.MysqlDaemon, id)
+}
+
+// TailGeneralLog enables the MySQL general log for duration, and sends
+// the entries logged in that time. The general log settings are
+// restored when it returns, even if the client went away.
+func (agent *ActionAgent) TailGeneralLog(ctx context.Context, duration time.Duration, send func(string) error) error {
+	agent.mutex.Lock()
+	if agent._tailingGeneralLog {
+		agent.mutex.Unlock()
+		return fmt.Errorf("the general log is already being tailed")
+	}
+	agent._tailingGeneralLog = true
+	agent.mutex.Unlock()
+	defer func() {
+		agent.mutex.Lock()
+		agent._tailingGeneralLog = false
+		agent.mutex.Unlock()
+	}()
+
+	return mysqlctl.TailGeneralLog(ctx, agent.MysqlDaemon, duration, send)
+}
+
+// rowKeyspaceID returns the keyspace id for the value of a sharding
+// column.
+func rowKeyspaceID(value sqltypes.Value, shardingColumnType topodatapb.KeyspaceIdType) ([]byte, error) {
+	switch shardingColumnType {
+	case topodatapb.KeyspaceIdType_UINT64:
+		id, err := value.ParseUint64()
+		if err != nil {
+			return nil, err
+		}
+		return key.Uint64Key(id).Bytes(), nil
+	case topodatapb.KeyspaceIdType_BYTES:
+		return value.Raw(), nil
+	}
+	return nil, fmt.Errorf("unsupported sharding column type %v", shardingColumnType)
+}
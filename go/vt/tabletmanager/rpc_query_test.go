@@ -0,0 +1,715 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/sqldb"
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/mysqlctl/tmutils"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/topo/memorytopo"
+	"github.com/youtube/vitess/go/vt/vttest/fakesqldb"
+
+	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+const checksumTableQuery = "SELECT * FROM `vt_ks`.`t1`"
+
+// newChecksumAgent returns an agent whose database returns rows for
+// table t1. Each row is an (id, msg) pair.
+func newChecksumAgent(t *testing.T, rows [][]string) (*ActionAgent, *fakesqldb.DB) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{
+		ShardingColumnName: "id",
+		ShardingColumnType: topodatapb.KeyspaceIdType_UINT64,
+	}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+
+	result := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Uint64},
+			{Name: "msg", Type: sqltypes.VarChar},
+		},
+	}
+	for _, row := range rows {
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.MakeTrusted(sqltypes.Uint64, []byte(row[0])),
+			sqltypes.MakeTrusted(sqltypes.VarChar, []byte(row[1])),
+		})
+	}
+	db := fakesqldb.Register()
+	db.AddQuery(checksumTableQuery, result)
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(db)
+	mysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{}
+	return &ActionAgent{
+		TopoServer:  ts,
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}, db
+}
+
+func TestChecksumTable(t *testing.T) {
+	ctx := context.Background()
+	rows := [][]string{
+		{"1", "a"},
+		{"2", "b"},
+		{"3", "c"},
+	}
+	agent1, _ := newChecksumAgent(t, rows)
+	// Same rows, in a different order.
+	agent2, _ := newChecksumAgent(t, [][]string{rows[2], rows[0], rows[1]})
+	// One row differs.
+	agent3, _ := newChecksumAgent(t, [][]string{rows[0], rows[1], {"3", "d"}})
+
+	checksum1, rowCount1, err := agent1.ChecksumTable(ctx, "t1", nil)
+	if err != nil {
+		t.Fatalf("ChecksumTable failed: %v", err)
+	}
+	if rowCount1 != 3 {
+		t.Errorf("ChecksumTable returned %v rows, want 3", rowCount1)
+	}
+	checksum2, _, err := agent2.ChecksumTable(ctx, "t1", nil)
+	if err != nil {
+		t.Fatalf("ChecksumTable failed: %v", err)
+	}
+	if checksum1 != checksum2 {
+		t.Errorf("identical data has different checksums: %x and %x", checksum1, checksum2)
+	}
+	checksum3, _, err := agent3.ChecksumTable(ctx, "t1", nil)
+	if err != nil {
+		t.Fatalf("ChecksumTable failed: %v", err)
+	}
+	if checksum1 == checksum3 {
+		t.Errorf("divergent data has the same checksum: %x", checksum1)
+	}
+
+	// Restricted to a key range that only contains the matching rows,
+	// the checksums agree again. Rows 1 and 2 have keyspace ids
+	// 0x0000000000000001 and 0x0000000000000002.
+	keyRange := &topodatapb.KeyRange{
+		End: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03},
+	}
+	checksum1, rowCount1, err = agent1.ChecksumTable(ctx, "t1", keyRange)
+	if err != nil {
+		t.Fatalf("ChecksumTable failed: %v", err)
+	}
+	if rowCount1 != 2 {
+		t.Errorf("ChecksumTable in %v returned %v rows, want 2", keyRange, rowCount1)
+	}
+	checksum3, _, err = agent3.ChecksumTable(ctx, "t1", keyRange)
+	if err != nil {
+		t.Fatalf("ChecksumTable failed: %v", err)
+	}
+	if checksum1 != checksum3 {
+		t.Errorf("identical rows in %v have different checksums: %x and %x", keyRange, checksum1, checksum3)
+	}
+}
+
+func TestStreamRowsInKeyRange(t *testing.T) {
+	ctx := context.Background()
+	// The rows are in primary key order, as MySQL returns them.
+	// 0x8000000000000000 is the first keyspace id of shard 80-.
+	agent, db := newChecksumAgent(t, [][]string{
+		{"1", "a"},
+		{"9223372036854775807", "b"},
+		{"9223372036854775808", "c"},
+		{"9223372036854775809", "d"},
+	})
+	mysqlDaemon := agent.MysqlDaemon.(*mysqlctl.FakeMysqlDaemon)
+	result, _ := db.GetQuery(checksumTableQuery)
+	db.AddQuery("SELECT * FROM `vt_ks`.`t1` LIMIT 0", &sqltypes.Result{Fields: result.Fields})
+	db.AddQuery("SELECT * FROM `vt_ks`.`t1` ORDER BY `id`", result)
+	// Text columns are ordered by their bytes.
+	db.AddQuery("SELECT * FROM `vt_ks`.`t1` ORDER BY BINARY `msg`, `id`", result)
+	for _, query := range []string{"START TRANSACTION WITH CONSISTENT SNAPSHOT", "ROLLBACK"} {
+		db.AddQuery(query, &sqltypes.Result{})
+	}
+	mysqlDaemon.Schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:              "t1",
+				Type:              tmutils.TableBaseTable,
+				PrimaryKeyColumns: []string{"id"},
+			},
+		},
+	}
+
+	streamIDs := func(keyRange *topodatapb.KeyRange) []string {
+		var ids []string
+		first := true
+		err := agent.StreamRowsInKeyRange(ctx, "t1", keyRange, func(result *querypb.QueryResult) error {
+			if first {
+				if len(result.Fields) != 2 || len(result.Rows) != 0 {
+					t.Errorf("first result should only have the fields: %v", result)
+				}
+				first = false
+				return nil
+			}
+			for _, row := range sqltypes.Proto3ToResult(result).Rows {
+				ids = append(ids, row[0].String())
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("StreamRowsInKeyRange(%v) failed: %v", keyRange, err)
+		}
+		return ids
+	}
+
+	// The split point belongs to the shard it starts.
+	if got, want := streamIDs(&topodatapb.KeyRange{End: []byte{0x80}}), []string{"1", "9223372036854775807"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StreamRowsInKeyRange(-80) returned %v, want %v", got, want)
+	}
+	if got, want := streamIDs(&topodatapb.KeyRange{Start: []byte{0x80}}), []string{"9223372036854775808", "9223372036854775809"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StreamRowsInKeyRange(80-) returned %v, want %v", got, want)
+	}
+	if got, want := streamIDs(nil), []string{"1", "9223372036854775807", "9223372036854775808", "9223372036854775809"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StreamRowsInKeyRange(nil) returned %v, want %v", got, want)
+	}
+
+	// Each stream reads in its own consistent snapshot.
+	if got := db.GetQueryCalledNum("START TRANSACTION WITH CONSISTENT SNAPSHOT"); got != 3 {
+		t.Errorf("StreamRowsInKeyRange started %v snapshots, want 3", got)
+	}
+
+	mysqlDaemon.Schema.TableDefinitions[0].PrimaryKeyColumns = []string{"msg", "id"}
+	if got, want := streamIDs(nil), []string{"1", "9223372036854775807", "9223372036854775808", "9223372036854775809"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StreamRowsInKeyRange(nil) on (msg, id) returned %v, want %v", got, want)
+	}
+	if got := db.GetQueryCalledNum("SELECT * FROM `vt_ks`.`t1` ORDER BY BINARY `msg`, `id`"); got != 1 {
+		t.Errorf("StreamRowsInKeyRange ordered %v times by the bytes of msg, want 1", got)
+	}
+
+	mysqlDaemon.Schema.TableDefinitions[0].PrimaryKeyColumns = nil
+	if err := agent.StreamRowsInKeyRange(ctx, "t1", nil, func(*querypb.QueryResult) error { return nil }); err == nil {
+		t.Errorf("StreamRowsInKeyRange on a table without primary key should have failed")
+	}
+}
+
+func TestKeyRangeBinlogFilter(t *testing.T) {
+	var got [][]string
+	f := keyRangeBinlogFilter(&topodatapb.KeyRange{End: []byte{0x80}}, func(transaction *binlogdatapb.BinlogTransaction) error {
+		var statements []string
+		for _, statement := range transaction.Statements {
+			statements = append(statements, string(statement.Sql))
+		}
+		got = append(got, statements)
+		return nil
+	})
+	for _, statements := range [][]string{
+		// All in the key range.
+		{"insert into t1(id, msg) values (1, 'a') /* vtgate:: keyspace_id:10 */"},
+		// None in the key range, not sent.
+		{"insert into t1(id, msg) values (2, 'b') /* vtgate:: keyspace_id:90 */"},
+		// Spanning the boundary: only the first row is in range.
+		{
+			"insert into t1(id, msg) values (3, 'c'), (4, 'd') /* vtgate:: keyspace_id:7f,80 */",
+			"update t1 set msg = 'e' where id in (5) /* vtgate:: keyspace_id:a0 */",
+		},
+	} {
+		transaction := &binlogdatapb.BinlogTransaction{}
+		for _, sql := range statements {
+			category := binlogdatapb.BinlogTransaction_Statement_BL_INSERT
+			if strings.HasPrefix(sql, "update") {
+				category = binlogdatapb.BinlogTransaction_Statement_BL_UPDATE
+			}
+			transaction.Statements = append(transaction.Statements, &binlogdatapb.BinlogTransaction_Statement{
+				Category: category,
+				Sql:      []byte(sql),
+			})
+		}
+		if err := f(transaction); err != nil {
+			t.Fatalf("filter failed: %v", err)
+		}
+	}
+
+	want := [][]string{
+		{"insert into t1(id, msg) values (1, 'a') /* vtgate:: keyspace_id:10 */"},
+		{"insert into t1(id, msg) values (3, 'c') /* vtgate:: keyspace_id:7f,80 */"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("filtered transactions:\n%v\nwant:\n%v", got, want)
+	}
+}
+
+// snapshotMysqlDaemon is a FakeMysqlDaemon that records the queries
+// run before the position is read.
+type snapshotMysqlDaemon struct {
+	*mysqlctl.FakeMysqlDaemon
+	db *fakesqldb.DB
+
+	// consistent is set if the position was read after the
+	// snapshot was started, while the tables were locked.
+	consistent bool
+}
+
+// MasterPosition is part of the MysqlDaemon interface.
+func (smd *snapshotMysqlDaemon) MasterPosition() (replication.Position, error) {
+	smd.consistent = smd.db.GetQueryCalledNum("FLUSH TABLES WITH READ LOCK") == 1 &&
+		smd.db.GetQueryCalledNum("START TRANSACTION WITH CONSISTENT SNAPSHOT") == 1 &&
+		smd.db.GetQueryCalledNum("UNLOCK TABLES") == 0 &&
+		smd.db.GetQueryCalledNum(checksumTableQuery) == 0
+	return smd.FakeMysqlDaemon.MasterPosition()
+}
+
+func TestCloneStream(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	for _, query := range []string{"FLUSH TABLES WITH READ LOCK", "START TRANSACTION WITH CONSISTENT SNAPSHOT", "UNLOCK TABLES", "ROLLBACK"} {
+		db.AddQuery(query, &sqltypes.Result{})
+	}
+	db.AddQuery(checksumTableQuery, &sqltypes.Result{
+		RowsAffected: 2,
+		Rows: [][]sqltypes.Value{
+			{sqltypes.MakeTrusted(sqltypes.Uint64, []byte("1")), sqltypes.MakeTrusted(sqltypes.VarChar, []byte("a"))},
+			{sqltypes.MakeTrusted(sqltypes.Uint64, []byte("2")), sqltypes.MakeTrusted(sqltypes.VarChar, []byte("b"))},
+		},
+	})
+	mysqlDaemon := &snapshotMysqlDaemon{
+		FakeMysqlDaemon: mysqlctl.NewFakeMysqlDaemon(db),
+		db:              db,
+	}
+	mysqlDaemon.CurrentMasterPosition = replication.Position{
+		GTIDSet: replication.MariadbGTID{Domain: 0, Server: 1, Sequence: 42},
+	}
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+
+	var responses []*tabletmanagerdatapb.CloneStreamResponse
+	if err := agent.CloneStream(ctx, []string{"t1"}, 0, func(response *tabletmanagerdatapb.CloneStreamResponse) error {
+		responses = append(responses, response)
+		return nil
+	}); err != nil {
+		t.Fatalf("CloneStream failed: %v", err)
+	}
+
+	// The position comes first, and is the one of the snapshot.
+	if len(responses) < 2 {
+		t.Fatalf("CloneStream sent %v messages, want the position then the rows", len(responses))
+	}
+	if want := replication.EncodePosition(mysqlDaemon.CurrentMasterPosition); responses[0].Position != want || responses[0].Table != "" || responses[0].Result != nil {
+		t.Errorf("first CloneStream message = %v, want only position %v", responses[0], want)
+	}
+	if !mysqlDaemon.consistent {
+		t.Errorf("CloneStream did not read the position in a locked consistent snapshot")
+	}
+	var rows int
+	for _, response := range responses[1:] {
+		if response.Position != "" || response.Table != "t1" {
+			t.Errorf("CloneStream row message = %v, want rows of t1 without position", response)
+		}
+		rows += len(response.Result.Rows)
+	}
+	if rows != 2 {
+		t.Errorf("CloneStream sent %v rows, want 2", rows)
+	}
+	for _, query := range []string{"UNLOCK TABLES", "ROLLBACK"} {
+		if got := db.GetQueryCalledNum(query); got != 1 {
+			t.Errorf("%v was run %v times, want 1", query, got)
+		}
+	}
+
+	// Backticks in the table name are escaped.
+	db.AddQuery("SELECT * FROM `vt_ks`.`t``2`", &sqltypes.Result{})
+	if err := agent.CloneStream(ctx, []string{"t`2"}, 0, func(*tabletmanagerdatapb.CloneStreamResponse) error { return nil }); err != nil {
+		t.Errorf("CloneStream(t`2) failed: %v", err)
+	}
+
+	if err := agent.CloneStream(ctx, nil, 0, func(*tabletmanagerdatapb.CloneStreamResponse) error { return nil }); err == nil {
+		t.Errorf("CloneStream without tables should have failed")
+	}
+}
+
+func TestCSVResultWriter(t *testing.T) {
+	var chunks []string
+	cw := newCSVResultWriter(func(data []byte) error {
+		chunks = append(chunks, string(data))
+		return nil
+	})
+
+	// Results are streamed as by ExecuteStreamFetch: first the
+	// fields, then batches of rows.
+	for _, qr := range []*sqltypes.Result{
+		{
+			Fields: []*querypb.Field{
+				{Name: "id", Type: sqltypes.Int64},
+				{Name: "msg", Type: sqltypes.VarChar},
+			},
+		},
+		{
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeTrusted(sqltypes.Int64, []byte("1")), sqltypes.MakeTrusted(sqltypes.VarChar, []byte("plain"))},
+				{sqltypes.MakeTrusted(sqltypes.Int64, []byte("2")), sqltypes.MakeTrusted(sqltypes.VarChar, []byte("with, comma"))},
+			},
+		},
+		{
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeTrusted(sqltypes.Int64, []byte("3")), sqltypes.MakeTrusted(sqltypes.VarChar, []byte("two\nlines"))},
+				{sqltypes.MakeTrusted(sqltypes.Int64, []byte("4")), sqltypes.MakeTrusted(sqltypes.VarChar, []byte(`say "hi"`))},
+				{sqltypes.MakeTrusted(sqltypes.Int64, []byte("5")), sqltypes.NULL},
+			},
+		},
+	} {
+		if err := cw.write(qr); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+	}
+
+	want := []string{
+		"id,msg\n",
+		"1,plain\n2,\"with, comma\"\n",
+		"3,\"two\nlines\"\n4,\"say \"\"hi\"\"\"\n5,\n",
+	}
+	if !reflect.DeepEqual(chunks, want) {
+		t.Errorf("got CSV chunks %q, want %q", chunks, want)
+	}
+}
+
+func TestExecuteFetchAsDbaMaxExecTime(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	db.AddQuery("SET SESSION max_execution_time = 1500", &sqltypes.Result{})
+	db.AddQuery("SELECT 1", &sqltypes.Result{RowsAffected: 1})
+	db.AddRejectedQuery("SELECT SLEEP(10)", sqldb.NewSQLError(errMaxExecTimeExceeded, "HY000", "Query execution was interrupted, maximum statement execution time exceeded"))
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlctl.NewFakeMysqlDaemon(db),
+	}
+
+	// A fast query is not affected by the cap.
+	if _, err := agent.ExecuteFetchAsDba(ctx, []byte("SELECT 1"), "", 1, false, false, 1500*time.Millisecond); err != nil {
+		t.Fatalf("ExecuteFetchAsDba(fast query) failed: %v", err)
+	}
+	if got := db.GetQueryCalledNum("SET SESSION max_execution_time = 1500"); got != 1 {
+		t.Errorf("max_execution_time was set %v times, want 1", got)
+	}
+
+	// A slow query is aborted by mysql.
+	_, err := agent.ExecuteFetchAsDba(ctx, []byte("SELECT SLEEP(10)"), "", 1, false, false, 1500*time.Millisecond)
+	if err != tmclient.ErrMaxExecTimeExceeded {
+		t.Errorf("ExecuteFetchAsDba(slow query) = %v, want %v", err, tmclient.ErrMaxExecTimeExceeded)
+	}
+	if !tmclient.IsMaxExecTimeExceeded(&tmclient.RPCError{Method: "ExecuteFetchAsDba", Err: err}) {
+		t.Errorf("IsMaxExecTimeExceeded(%v) = false, want true", err)
+	}
+
+	// Without a cap, max_execution_time is left alone.
+	if _, err := agent.ExecuteFetchAsDba(ctx, []byte("SELECT 1"), "", 1, false, false, 0); err != nil {
+		t.Fatalf("ExecuteFetchAsDba(no cap) failed: %v", err)
+	}
+	if got := db.GetQueryCalledNum("SET SESSION max_execution_time = 1500"); got != 2 {
+		t.Errorf("max_execution_time was set %v times, want 2", got)
+	}
+}
+
+func TestExplainQuery(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	db.AddQuery("USE `vt_ks`", &sqltypes.Result{})
+	plan := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "table", Type: sqltypes.VarChar},
+			{Name: "type", Type: sqltypes.VarChar},
+			{Name: "key", Type: sqltypes.VarChar},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			{
+				sqltypes.MakeString([]byte("t1")),
+				sqltypes.MakeString([]byte("const")),
+				sqltypes.MakeString([]byte("PRIMARY")),
+			},
+		},
+	}
+	db.AddQuery("EXPLAIN SELECT * FROM t1 WHERE id = 1", plan)
+	jsonPlan := &sqltypes.Result{
+		Fields:       []*querypb.Field{{Name: "EXPLAIN", Type: sqltypes.VarChar}},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			{sqltypes.MakeString([]byte(`{"query_block": {"select_id": 1}}`))},
+		},
+	}
+	db.AddQuery("EXPLAIN FORMAT=JSON SELECT * FROM t1 WHERE id = 1", jsonPlan)
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlctl.NewFakeMysqlDaemon(db),
+		_tablet:     &topodatapb.Tablet{Keyspace: "ks"},
+	}
+
+	qr, err := agent.ExplainQuery(ctx, "SELECT * FROM t1 WHERE id = 1", "")
+	if err != nil {
+		t.Fatalf("ExplainQuery failed: %v", err)
+	}
+	if want := sqltypes.ResultToProto3(plan); !reflect.DeepEqual(qr, want) {
+		t.Errorf("ExplainQuery() = %v, want %v", qr, want)
+	}
+	qr, err = agent.ExplainQuery(ctx, "SELECT * FROM t1 WHERE id = 1", "json")
+	if err != nil {
+		t.Fatalf("ExplainQuery(JSON) failed: %v", err)
+	}
+	if want := sqltypes.ResultToProto3(jsonPlan); !reflect.DeepEqual(qr, want) {
+		t.Errorf("ExplainQuery(JSON) = %v, want %v", qr, want)
+	}
+
+	// An invalid query returns the parse error, without reaching mysql.
+	_, err = agent.ExplainQuery(ctx, "SELEC * FROM t1", "")
+	if err == nil || !strings.Contains(err.Error(), "cannot parse query") {
+		t.Errorf("ExplainQuery(invalid query) = %v, want a parse error", err)
+	}
+	if _, err := agent.ExplainQuery(ctx, "DROP TABLE t1", ""); err == nil {
+		t.Errorf("ExplainQuery(DROP TABLE) worked")
+	}
+	if _, err := agent.ExplainQuery(ctx, "SELECT * FROM t1 WHERE id = 1", "tree"); err == nil {
+		t.Errorf("ExplainQuery(tree format) worked")
+	}
+	if got := db.GetQueryCalledNum("USE `vt_ks`"); got != 2 {
+		t.Errorf("database was selected %v times, want 2", got)
+	}
+}
+
+func TestTruncateTable(t *testing.T) {
+	ctx := context.Background()
+	agent, _ := newChecksumAgent(t, nil)
+	mysqlDaemon := agent.MysqlDaemon.(*mysqlctl.FakeMysqlDaemon)
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"TRUNCATE TABLE `vt_ks`.`t1`",
+		"TRUNCATE TABLE `vt_ks`.`t``2`",
+	}
+
+	// The keyspace of another tablet is rejected, nothing is run.
+	err := agent.TruncateTable(ctx, "t1", "prod_ks")
+	if err == nil || !strings.Contains(err.Error(), "does not match the tablet keyspace") {
+		t.Errorf("TruncateTable(prod_ks) = %v, want a keyspace mismatch error", err)
+	}
+	if mysqlDaemon.ExpectedExecuteSuperQueryCurrent != 0 {
+		t.Errorf("TruncateTable(prod_ks) ran a query")
+	}
+
+	// The confirmed keyspace truncates the table.
+	if err := agent.TruncateTable(ctx, "t1", "ks"); err != nil {
+		t.Fatalf("TruncateTable(ks) failed: %v", err)
+	}
+	// Backticks in the table name are escaped.
+	if err := agent.TruncateTable(ctx, "t`2", "ks"); err != nil {
+		t.Fatalf("TruncateTable(t`2) failed: %v", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("TruncateTable(ks) did not truncate the tables: %v", err)
+	}
+}
+
+func TestRenameTable(t *testing.T) {
+	ctx := context.Background()
+	agent, _ := newChecksumAgent(t, nil)
+	mysqlDaemon := agent.MysqlDaemon.(*mysqlctl.FakeMysqlDaemon)
+	viewsQuery := "SELECT table_name FROM information_schema.views WHERE table_schema = 'vt_ks' AND view_definition LIKE '%`vt\\\\_ks`.`t1`%'"
+	foreignKeysQuery := "SELECT table_name, constraint_name FROM information_schema.referential_constraints WHERE constraint_schema = 'vt_ks' AND referenced_table_name = 't1' AND table_name != 't1'"
+
+	// Nothing depends on the table: it is renamed.
+	mysqlDaemon.FetchSuperQueryMap[viewsQuery] = &sqltypes.Result{}
+	mysqlDaemon.FetchSuperQueryMap[foreignKeysQuery] = &sqltypes.Result{}
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"RENAME TABLE `vt_ks`.`t1` TO `vt_ks`.`t2`",
+	}
+	result, err := agent.RenameTable(ctx, "t1", "t2", false)
+	if err != nil {
+		t.Fatalf("RenameTable failed: %v", err)
+	}
+	if want := (&tabletmanagerdatapb.RenameTableResult{Renamed: true}); !reflect.DeepEqual(result, want) {
+		t.Errorf("RenameTable() = %v, want %v", result, want)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("RenameTable did not rename the table: %v", err)
+	}
+
+	// The names are quoted, in the identifiers and in the strings.
+	mysqlDaemon.FetchSuperQueryMap["SELECT table_name FROM information_schema.views WHERE table_schema = 'vt_ks' AND view_definition LIKE '%`vt\\\\_ks`.`t\\'1`%'"] = &sqltypes.Result{}
+	mysqlDaemon.FetchSuperQueryMap["SELECT table_name, constraint_name FROM information_schema.referential_constraints WHERE constraint_schema = 'vt_ks' AND referenced_table_name = 't\\'1' AND table_name != 't\\'1'"] = &sqltypes.Result{}
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"RENAME TABLE `vt_ks`.`t'1` TO `vt_ks`.`t``2`",
+	}
+	mysqlDaemon.ExpectedExecuteSuperQueryCurrent = 0
+	if _, err := agent.RenameTable(ctx, "t'1", "t`2", false); err != nil {
+		t.Fatalf("RenameTable(t'1, t`2) failed: %v", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("RenameTable(t'1, t`2) did not rename the table: %v", err)
+	}
+
+	// A view and a foreign key depend on the table: the rename is
+	// refused, and nothing is run.
+	mysqlDaemon.FetchSuperQueryMap[viewsQuery] = &sqltypes.Result{
+		Rows: [][]sqltypes.Value{
+			{sqltypes.MakeString([]byte("v1"))},
+		},
+	}
+	mysqlDaemon.FetchSuperQueryMap[foreignKeysQuery] = &sqltypes.Result{
+		Rows: [][]sqltypes.Value{
+			{sqltypes.MakeString([]byte("t3")), sqltypes.MakeString([]byte("t3_ibfk_1"))},
+		},
+	}
+	mysqlDaemon.ExpectedExecuteSuperQueryList = nil
+	mysqlDaemon.ExpectedExecuteSuperQueryCurrent = 0
+	result, err = agent.RenameTable(ctx, "t1", "t2", false)
+	if err != nil {
+		t.Fatalf("RenameTable with dependents failed: %v", err)
+	}
+	want := &tabletmanagerdatapb.RenameTableResult{
+		DependentViews:       []string{"v1"},
+		DependentForeignKeys: []string{"t3.t3_ibfk_1"},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("RenameTable() with dependents = %v, want %v", result, want)
+	}
+	if mysqlDaemon.ExpectedExecuteSuperQueryCurrent != 0 {
+		t.Errorf("RenameTable with dependents ran a query")
+	}
+
+	// With cascade, the table is renamed and the view recreated.
+	mysqlDaemon.FetchSuperQueryMap["SHOW CREATE VIEW `vt_ks`.`v1`"] = &sqltypes.Result{
+		Rows: [][]sqltypes.Value{
+			{
+				sqltypes.MakeString([]byte("v1")),
+				sqltypes.MakeString([]byte("CREATE ALGORITHM=UNDEFINED DEFINER=`vt_app`@`localhost` SQL SECURITY DEFINER VIEW `v1` AS select `vt_ks`.`t1`.`id` AS `id` from `vt_ks`.`t1`")),
+			},
+		},
+	}
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"RENAME TABLE `vt_ks`.`t1` TO `vt_ks`.`t2`",
+		"CREATE OR REPLACE ALGORITHM=UNDEFINED DEFINER=`vt_app`@`localhost` SQL SECURITY DEFINER VIEW `v1` AS select `vt_ks`.`t2`.`id` AS `id` from `vt_ks`.`t2`",
+	}
+	result, err = agent.RenameTable(ctx, "t1", "t2", true)
+	if err != nil {
+		t.Fatalf("RenameTable with cascade failed: %v", err)
+	}
+	want.Renamed = true
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("RenameTable() with cascade = %v, want %v", result, want)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("RenameTable with cascade did not run the expected queries: %v", err)
+	}
+}
+
+func TestExecuteFetchColumnar(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	db.AddQuery("SELECT id, msg FROM t1", &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Uint64},
+			{Name: "msg", Type: sqltypes.VarChar},
+		},
+		RowsAffected: 3,
+		Rows: [][]sqltypes.Value{
+			{sqltypes.MakeTrusted(sqltypes.Uint64, []byte("1")), sqltypes.MakeTrusted(sqltypes.VarChar, []byte("abc"))},
+			{sqltypes.MakeTrusted(sqltypes.Uint64, []byte("2")), sqltypes.NULL},
+			{sqltypes.MakeTrusted(sqltypes.Uint64, []byte("10")), sqltypes.MakeTrusted(sqltypes.VarChar, []byte(""))},
+		},
+	})
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlctl.NewFakeMysqlDaemon(db),
+	}
+
+	got, err := agent.ExecuteFetchColumnar(ctx, []byte("SELECT id, msg FROM t1"), "", 10)
+	if err != nil {
+		t.Fatalf("ExecuteFetchColumnar failed: %v", err)
+	}
+	want := &tabletmanagerdatapb.ColumnarResult{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Uint64},
+			{Name: "msg", Type: sqltypes.VarChar},
+		},
+		RowsAffected: 3,
+		RowCount:     3,
+		Columns: []*querypb.Row{
+			{Lengths: []int64{1, 1, 2}, Values: []byte("1210")},
+			{Lengths: []int64{3, -1, 0}, Values: []byte("abc")},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExecuteFetchColumnar() = %v, want %v", got, want)
+	}
+}
+
+// wideResult returns a result of rowCount rows of 4 columns.
+func wideResult(rowCount int) *sqltypes.Result {
+	qr := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Uint64},
+			{Name: "user_id", Type: sqltypes.Uint64},
+			{Name: "event", Type: sqltypes.VarChar},
+			{Name: "created", Type: sqltypes.Datetime},
+		},
+		RowsAffected: uint64(rowCount),
+		Rows:         make([][]sqltypes.Value, rowCount),
+	}
+	for i := range qr.Rows {
+		qr.Rows[i] = []sqltypes.Value{
+			sqltypes.MakeTrusted(sqltypes.Uint64, []byte(fmt.Sprintf("%v", i))),
+			sqltypes.MakeTrusted(sqltypes.Uint64, []byte(fmt.Sprintf("%v", i%100))),
+			sqltypes.MakeTrusted(sqltypes.VarChar, []byte("page_view")),
+			sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2017-01-02 03:04:05")),
+		}
+	}
+	return qr
+}
+
+func TestResultToColumnarAllocs(t *testing.T) {
+	qr := wideResult(10000)
+	rowAllocs := testing.AllocsPerRun(10, func() {
+		sqltypes.ResultToProto3(qr)
+	})
+	columnarAllocs := testing.AllocsPerRun(10, func() {
+		resultToColumnar(qr)
+	})
+	// The row-oriented form allocates for each of the 10000 rows, the
+	// columnar one only for each of the 4 columns.
+	if columnarAllocs*1000 > rowAllocs {
+		t.Errorf("resultToColumnar made %v allocations, ResultToProto3 %v: want at least 1000 times fewer", columnarAllocs, rowAllocs)
+	}
+}
+
+func BenchmarkResultToProto3(b *testing.B) {
+	qr := wideResult(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sqltypes.ResultToProto3(qr)
+	}
+}
+
+func BenchmarkResultToColumnar(b *testing.B) {
+	qr := wideResult(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resultToColumnar(qr)
+	}
+}
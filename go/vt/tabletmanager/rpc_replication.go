@@ -12,20 +12,35 @@ import (
 	log "github.com/golang/glog"
 	"golang.org/x/net/context"
 
+	"github.com/youtube/vitess/go/mysqlconn"
 	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/sqldb"
+	"github.com/youtube/vitess/go/vt/logutil"
 	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
 	"github.com/youtube/vitess/go/vt/topo"
 	"github.com/youtube/vitess/go/vt/topo/topoproto"
 	"github.com/youtube/vitess/go/vt/topotools"
 
 	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
 )
 
 var (
 	enableSemiSync = flag.Bool("enable_semi_sync", false, "Enable semi-sync when configuring replication, on master and replica tablets only (rdonly tablets will not ack).")
+
+	rotateReplicationCredentialsTimeout = flag.Duration("rotate_replication_credentials_timeout", 30*time.Second, "how long RotateReplicationCredentials waits for replication to resume with the new credentials before rolling back")
+
+	configureReplicationSSLTimeout = flag.Duration("configure_replication_ssl_timeout", 30*time.Second, "how long ConfigureReplicationSSL waits for replication to resume with the new SSL options before rolling back")
+
+	repairRelayLogTimeout = flag.Duration("repair_relay_log_timeout", 30*time.Second, "how long RepairRelayLog waits for the replication IO thread to start")
 )
 
+// errMasterFatalErrorReadingBinlog is the error of a slave IO thread
+// that cannot read the binlogs of its master, e.g. as they were purged.
+const errMasterFatalErrorReadingBinlog = 1236
+
 // SlaveStatus returns the replication status
 func (agent *ActionAgent) SlaveStatus(ctx context.Context) (*replicationdatapb.Status, error) {
 	status, err := agent.MysqlDaemon.SlaveStatus()
@@ -35,6 +50,63 @@ func (agent *ActionAgent) SlaveStatus(ctx context.Context) (*replicationdatapb.S
 	return mysqlctl.StatusToProto(status), nil
 }
 
+// SlaveStatusAllChannels returns the replication status of each
+// replication channel, by channel name.
+func (agent *ActionAgent) SlaveStatusAllChannels(ctx context.Context) (map[string]*replicationdatapb.Status, error) {
+	statuses, err := agent.MysqlDaemon.SlaveStatusAllChannels()
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]*replicationdatapb.Status, len(statuses))
+	for channel, status := range statuses {
+		result[channel] = mysqlctl.StatusToProto(status)
+	}
+	return result, nil
+}
+
+// GetReplicationSource returns the master the slave replicates from,
+// and the user it replicates as. The password is not read at all, so
+// it cannot leak.
+func (agent *ActionAgent) GetReplicationSource(ctx context.Context) (*tabletmanagerdatapb.ReplicationSource, error) {
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.ReplicationSource{
+		MasterHost: status.MasterHost,
+		MasterPort: int32(status.MasterPort),
+		User:       status.MasterUser,
+	}, nil
+}
+
+// ValidateReplicationCredentials checks that the slave can still log
+// in to its master with its replication credentials, so a password
+// that changed or expired is caught before replication needs to
+// reconnect. It uses a separate connection: the running replication is
+// not affected. It returns false if the master denies access, and an
+// error if the check could not be done, e.g. the master is down.
+func (agent *ActionAgent) ValidateReplicationCredentials(ctx context.Context) (bool, error) {
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	if err != nil {
+		return false, err
+	}
+	err = agent.MysqlDaemon.CheckReplicationCredentials(ctx, status.MasterHost, status.MasterPort)
+	if sqlErr, ok := err.(*sqldb.SQLError); ok && sqlErr.Number() == mysqlconn.ERAccessDeniedError {
+		log.Warningf("replication credentials denied by master %v:%v: %v", status.MasterHost, status.MasterPort, err)
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("cannot check the replication credentials against master %v:%v: %v", status.MasterHost, status.MasterPort, err)
+	}
+	return true, nil
+}
+
+// GetApplierQueueStats returns the relay log backlog and the state of
+// the replication applier threads, see mysqlctl.GetApplierQueueStats.
+func (agent *ActionAgent) GetApplierQueueStats(ctx context.Context) (*tabletmanagerdatapb.ApplierQueueStats, error) {
+	return mysqlctl.GetApplierQueueStats(ctx, agent.MysqlDaemon)
+}
+
 // MasterPosition returns the master position
 func (agent *ActionAgent) MasterPosition(ctx context.Context) (string, error) {
 	pos, err := agent.MysqlDaemon.MasterPosition()
@@ -44,6 +116,109 @@ func (agent *ActionAgent) MasterPosition(ctx context.Context) (string, error) {
 	return replication.EncodePosition(pos), nil
 }
 
+// GetGtidPurged returns the set of transactions purged from the
+// binary logs.
+func (agent *ActionAgent) GetGtidPurged(ctx context.Context) (string, error) {
+	pos, err := agent.MysqlDaemon.PurgedPosition()
+	if err != nil {
+		return "", err
+	}
+	return replication.EncodePosition(pos), nil
+}
+
+// binlogSample is the transaction count of the tablet at a given time.
+type binlogSample struct {
+	time  time.Time
+	count uint64
+}
+
+// recordBinlogSample remembers the transaction count of the tablet,
+// as sampled by the health check.
+func (agent *ActionAgent) recordBinlogSample(now time.Time, count uint64) {
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+	agent._binlogSamples[0] = agent._binlogSamples[1]
+	agent._binlogSamples[1] = binlogSample{time: now, count: count}
+}
+
+// GetBinlogStats returns how many transactions per second were added
+// to the binlogs in the window between the last two health checks.
+// On a master that is the write rate, on a slave the apply rate.
+func (agent *ActionAgent) GetBinlogStats(ctx context.Context) (float64, time.Duration, error) {
+	agent.mutex.Lock()
+	samples := agent._binlogSamples
+	agent.mutex.Unlock()
+
+	if samples[0].time.IsZero() {
+		return 0, 0, fmt.Errorf("not enough binlog samples yet, they are taken every %v by the health check", *healthCheckInterval)
+	}
+	window := samples[1].time.Sub(samples[0].time)
+	if samples[1].count < samples[0].count || window <= 0 {
+		// The binlogs were reset in between.
+		return 0, window, nil
+	}
+	return float64(samples[1].count-samples[0].count) / window.Seconds(), window, nil
+}
+
+// replicationErrorCounts are the replication errors and reconnects of
+// the tablet. lastIOErrno and lastSQLErrno are the errors seen by the
+// last sample, so an error is only counted once while it persists.
+type replicationErrorCounts struct {
+	ioErrors     int64
+	sqlErrors    int64
+	reconnects   int64
+	lastIOErrno  int
+	lastSQLErrno int
+}
+
+// sampleReplicationErrors counts the new errors of the replication
+// threads. It is called by the health check.
+func (agent *ActionAgent) sampleReplicationErrors() {
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	if err != nil {
+		return
+	}
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+	counts := &agent._replicationErrors
+	if status.LastIOErrno != 0 && status.LastIOErrno != counts.lastIOErrno {
+		counts.ioErrors++
+	}
+	if status.LastSQLErrno != 0 && status.LastSQLErrno != counts.lastSQLErrno {
+		counts.sqlErrors++
+	}
+	counts.lastIOErrno = status.LastIOErrno
+	counts.lastSQLErrno = status.LastSQLErrno
+}
+
+// recordReplicationReconnect counts an attempt to reconnect a stopped
+// slave to its master.
+func (agent *ActionAgent) recordReplicationReconnect() {
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+	agent._replicationErrors.reconnects++
+}
+
+// GetReplicationErrorStats returns the number of replication IO and
+// SQL errors, and of reconnects, since the last reset. If reset is
+// true, the counts are cleared as they are read.
+func (agent *ActionAgent) GetReplicationErrorStats(ctx context.Context, reset bool) (*tabletmanagerdatapb.ReplicationErrorStats, error) {
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+	counts := &agent._replicationErrors
+	stats := &tabletmanagerdatapb.ReplicationErrorStats{
+		IoErrors:   counts.ioErrors,
+		SqlErrors:  counts.sqlErrors,
+		Reconnects: counts.reconnects,
+	}
+	if reset {
+		counts.ioErrors = 0
+		counts.sqlErrors = 0
+		counts.reconnects = 0
+	}
+	return stats, nil
+}
+
 // StopSlave will stop the replication. Works both when Vitess manages
 // replication or not (using hook if not).
 func (agent *ActionAgent) StopSlave(ctx context.Context) error {
@@ -103,6 +278,64 @@ func (agent *ActionAgent) StopSlaveMinimum(ctx context.Context, position string,
 	return replication.EncodePosition(pos), nil
 }
 
+// stopSlaveMinimumStreamInterval is how often StopSlaveMinimumStream
+// sends the slave position while waiting.
+var stopSlaveMinimumStreamInterval = 1 * time.Second
+
+// StopSlaveMinimumStream is like StopSlaveMinimum, but it sends the
+// slave position periodically until it reaches the provided
+// position. The last message has the position replication was
+// stopped at.
+func (agent *ActionAgent) StopSlaveMinimumStream(ctx context.Context, position string, waitTime time.Duration, send func(*tabletmanagerdatapb.StopSlaveMinimumStreamResponse) error) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	pos, err := replication.DecodePosition(position)
+	if err != nil {
+		return err
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, waitTime)
+	defer cancel()
+	for {
+		status, err := agent.MysqlDaemon.SlaveStatus()
+		if err != nil {
+			return err
+		}
+		if status.Position.AtLeast(pos) {
+			break
+		}
+		current := replication.EncodePosition(status.Position)
+		if err := send(&tabletmanagerdatapb.StopSlaveMinimumStreamResponse{
+			Position: current,
+		}); err != nil {
+			return err
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("slave did not reach %v in %v, it is at %v", position, waitTime, current)
+		case <-time.After(stopSlaveMinimumStreamInterval):
+		}
+	}
+
+	if err := agent.stopSlaveLocked(ctx); err != nil {
+		return err
+	}
+	pos, err = agent.MysqlDaemon.MasterPosition()
+	if err != nil {
+		return err
+	}
+	return send(&tabletmanagerdatapb.StopSlaveMinimumStreamResponse{
+		Position: replication.EncodePosition(pos),
+		Stopped:  true,
+	})
+}
+
 // StartSlave will start the replication. Works both when Vitess manages
 // replication or not (using hook if not).
 func (agent *ActionAgent) StartSlave(ctx context.Context) error {
@@ -130,11 +363,251 @@ func (agent *ActionAgent) StartSlave(ctx context.Context) error {
 	return mysqlctl.StartSlave(agent.MysqlDaemon, agent.hookExtraEnv())
 }
 
+// RotateReplicationCredentials changes the credentials the slave
+// connects to its master with. It restarts the IO thread with them,
+// and only returns success once the IO thread is running again.
+// Otherwise, it goes back to the previous credentials.
+func (agent *ActionAgent) RotateReplicationCredentials(ctx context.Context, user, password string) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	if err != nil {
+		return err
+	}
+	if !status.SlaveIORunning {
+		return fmt.Errorf("replication IO thread is not running, cannot check new replication credentials")
+	}
+	oldUser, oldPassword, err := agent.MysqlDaemon.ReplicationCredentials()
+	if err != nil {
+		return err
+	}
+
+	err = agent.restartSlaveIOThread(ctx, mysqlctl.ChangeReplicationCredentialsCommands(user, password), *rotateReplicationCredentialsTimeout)
+	if err == nil {
+		agent.MysqlDaemon.SetReplicationCredentials(user, password)
+		return nil
+	}
+
+	// Roll back with a fresh context, as ctx may be the reason we
+	// failed.
+	log.Warningf("replication did not resume with user %v, rolling back to user %v: %v", user, oldUser, err)
+	rollbackCtx, cancel := context.WithTimeout(context.Background(), *rotateReplicationCredentialsTimeout)
+	defer cancel()
+	if rollbackErr := agent.restartSlaveIOThread(rollbackCtx, mysqlctl.ChangeReplicationCredentialsCommands(oldUser, oldPassword), *rotateReplicationCredentialsTimeout); rollbackErr != nil {
+		return fmt.Errorf("replication did not resume with the new credentials (%v), and rolling back failed: %v", err, rollbackErr)
+	}
+	return fmt.Errorf("replication did not resume with the new credentials, rolled back: %v", err)
+}
+
+// ConfigureReplicationSSL makes the slave connect to its master with
+// SSL, using the given files. It restarts the IO thread with them,
+// and only returns success once the IO thread is running again with
+// SSL. Otherwise, for instance if the SSL handshake fails, it goes
+// back to the previous SSL options. The new options are only kept in
+// memory, they are lost when vttablet restarts.
+func (agent *ActionAgent) ConfigureReplicationSSL(ctx context.Context, opts *tabletmanagerdatapb.ReplicationSSLOptions) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	oldStatus, err := agent.MysqlDaemon.SlaveStatus()
+	if err != nil {
+		return err
+	}
+	if !oldStatus.SlaveIORunning {
+		return fmt.Errorf("replication IO thread is not running, cannot check new replication SSL options")
+	}
+
+	err = agent.restartSlaveIOThread(ctx, mysqlctl.ChangeReplicationSSLCommands(true, opts.Ca, opts.Cert, opts.Key), *configureReplicationSSLTimeout)
+	if err == nil {
+		var status mysqlctl.Status
+		status, err = agent.MysqlDaemon.SlaveStatus()
+		if err == nil && !status.MasterSSLAllowed {
+			err = fmt.Errorf("replication resumed without SSL")
+		}
+	}
+	if err == nil {
+		agent.MysqlDaemon.SetReplicationSSL(opts.Ca, opts.Cert, opts.Key)
+		return nil
+	}
+
+	// Roll back with a fresh context, as ctx may be the reason we
+	// failed.
+	log.Warningf("replication did not resume with SSL CA %v, rolling back to SSL CA %v (SSL allowed: %v): %v", opts.Ca, oldStatus.MasterSSLCA, oldStatus.MasterSSLAllowed, err)
+	rollbackCtx, cancel := context.WithTimeout(context.Background(), *configureReplicationSSLTimeout)
+	defer cancel()
+	if rollbackErr := agent.restartSlaveIOThread(rollbackCtx, mysqlctl.ChangeReplicationSSLCommands(oldStatus.MasterSSLAllowed, oldStatus.MasterSSLCA, oldStatus.MasterSSLCert, oldStatus.MasterSSLKey), *configureReplicationSSLTimeout); rollbackErr != nil {
+		return fmt.Errorf("replication did not resume with the new SSL options (%v), and rolling back failed: %v", err, rollbackErr)
+	}
+	return fmt.Errorf("replication did not resume with the new SSL options, rolled back: %v", err)
+}
+
+// restartSlaveIOThread runs the commands with the IO thread stopped,
+// restarts it, and waits until it is running, for up to timeout.
+func (agent *ActionAgent) restartSlaveIOThread(ctx context.Context, changeCmds []string, timeout time.Duration) error {
+	cmds := []string{mysqlctl.SQLStopSlaveIOThread}
+	cmds = append(cmds, changeCmds...)
+	cmds = append(cmds, mysqlctl.SQLStartSlaveIOThread)
+	if err := agent.MysqlDaemon.ExecuteSuperQueryList(ctx, cmds); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		status, err := agent.MysqlDaemon.SlaveStatus()
+		if err != nil {
+			return err
+		}
+		if status.SlaveIORunning {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("replication IO thread did not start: %v", ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// RepairRelayLog discards the relay logs, e.g. after a crash truncated
+// them, and restarts replication from the last applied position, so the
+// IO thread fetches them again from the master. It waits until the IO
+// thread runs, and fails if the master purged the binlogs it needs.
+func (agent *ActionAgent) RepairRelayLog(ctx context.Context, logger logutil.Logger) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	// create the loggers: tee to console and source
+	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
+
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	if err == mysqlctl.ErrNotSlave {
+		return fmt.Errorf("tablet is not a slave, it has no relay log to repair")
+	}
+	if err != nil {
+		return err
+	}
+	masterAddr := status.MasterAddr()
+	position := status.Position
+	l.Infof("RepairRelayLog: last applied position is %v, fetching the relay logs again from master %v", position, masterAddr)
+
+	// RESET SLAVE removes the relay logs, and the master position
+	// they were read up to. The IO thread then restarts from the
+	// position the slave applied.
+	cmds := []string{mysqlctl.SQLStopSlave, mysqlctl.SQLResetSlave}
+	smc, err := agent.MysqlDaemon.SetMasterCommands(status.MasterHost, status.MasterPort)
+	if err != nil {
+		return err
+	}
+	cmds = append(cmds, smc...)
+	cmds = append(cmds, mysqlctl.SQLStartSlave)
+	agent.setSlaveStopped(false)
+	if err := agent.MysqlDaemon.ExecuteSuperQueryList(ctx, cmds); err != nil {
+		return err
+	}
+	l.Infof("RepairRelayLog: relay logs discarded, waiting for the replication IO thread to start")
+
+	ctx, cancel := context.WithTimeout(ctx, *repairRelayLogTimeout)
+	defer cancel()
+	for {
+		status, err := agent.MysqlDaemon.SlaveStatus()
+		if err != nil {
+			return err
+		}
+		if status.SlaveIORunning {
+			l.Infof("RepairRelayLog: replication restarted from position %v", position)
+			return nil
+		}
+		if status.LastIOErrno == errMasterFatalErrorReadingBinlog {
+			return fmt.Errorf("master %v no longer has the binlogs since position %v, the tablet must be restored from a backup", masterAddr, position)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("replication IO thread did not start (last IO error: %v): %v", status.LastIOErrno, ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
 // GetSlaves returns the address of all the slaves
 func (agent *ActionAgent) GetSlaves(ctx context.Context) ([]string, error) {
 	return mysqlctl.FindSlaves(agent.MysqlDaemon)
 }
 
+// GetReplicationGraph returns the master this tablet replicates from,
+// and the slaves connected to it, as mysql sees them. The hosts are
+// resolved to the tablets of the shard with that hostname or IP, on a
+// best-effort basis: a host matching no tablet, or several, is left
+// without an alias.
+func (agent *ActionAgent) GetReplicationGraph(ctx context.Context) (*tabletmanagerdatapb.ReplicationGraph, error) {
+	graph := &tabletmanagerdatapb.ReplicationGraph{}
+	status, err := agent.MysqlDaemon.SlaveStatus()
+	switch err {
+	case nil:
+	case mysqlctl.ErrNotSlave:
+	default:
+		return nil, err
+	}
+	if status.MasterHost != "" {
+		graph.Master = &tabletmanagerdatapb.ReplicationNeighbor{
+			Host: status.MasterHost,
+		}
+	}
+	slaves, err := mysqlctl.FindSlaves(agent.MysqlDaemon)
+	if err != nil {
+		return nil, err
+	}
+	for _, host := range slaves {
+		graph.Slaves = append(graph.Slaves, &tabletmanagerdatapb.ReplicationNeighbor{
+			Host: host,
+		})
+	}
+
+	tablet := agent.Tablet()
+	tabletMap, err := agent.TopoServer.GetTabletMapForShard(ctx, tablet.Keyspace, tablet.Shard)
+	switch err {
+	case nil:
+	case topo.ErrPartialResult:
+		log.Warningf("GetReplicationGraph: some tablets of the shard could not be read, they won't be resolved")
+	default:
+		log.Warningf("GetReplicationGraph: cannot read the tablets of the shard, not resolving aliases: %v", err)
+		return graph, nil
+	}
+
+	// resolve returns the alias of the only tablet on host, using
+	// the mysql port to tell tablets apart if it is not 0.
+	resolve := func(host string, port int32) *topodatapb.TabletAlias {
+		var alias *topodatapb.TabletAlias
+		for _, ti := range tabletMap {
+			if ti.Hostname != host && ti.Ip != host {
+				continue
+			}
+			if port != 0 && ti.PortMap["mysql"] != port {
+				continue
+			}
+			if alias != nil {
+				return nil
+			}
+			alias = ti.Alias
+		}
+		return alias
+	}
+	if graph.Master != nil {
+		graph.Master.Alias = resolve(graph.Master.Host, int32(status.MasterPort))
+	}
+	for _, slave := range graph.Slaves {
+		slave.Alias = resolve(slave.Host, 0)
+	}
+	return graph, nil
+}
+
 // ResetReplication completely resets the replication on the host.
 // All binary and relay logs are flushed. All replication positions are reset.
 func (agent *ActionAgent) ResetReplication(ctx context.Context) error {
@@ -152,12 +625,18 @@ func (agent *ActionAgent) ResetReplication(ctx context.Context) error {
 }
 
 // InitMaster enables writes and returns the replication position.
-func (agent *ActionAgent) InitMaster(ctx context.Context) (string, error) {
+// If expectedKeyspace and expectedShard are set, it first checks the
+// tablet is in them, see checkShard.
+func (agent *ActionAgent) InitMaster(ctx context.Context, expectedKeyspace, expectedShard string) (string, error) {
 	if err := agent.lock(ctx); err != nil {
 		return "", err
 	}
 	defer agent.unlock()
 
+	if err := agent.checkShard(expectedKeyspace, expectedShard); err != nil {
+		return "", err
+	}
+
 	// Initializing as master implies undoing any previous "do not replicate".
 	agent.setSlaveStopped(false)
 
@@ -205,7 +684,12 @@ func (agent *ActionAgent) InitMaster(ctx context.Context) (string, error) {
 }
 
 // PopulateReparentJournal adds an entry into the reparent_journal table.
-func (agent *ActionAgent) PopulateReparentJournal(ctx context.Context, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, position string) error {
+// If expectedKeyspace and expectedShard are set, it first checks the
+// tablet is in them, see checkShard.
+func (agent *ActionAgent) PopulateReparentJournal(ctx context.Context, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, position, expectedKeyspace, expectedShard string) error {
+	if err := agent.checkShard(expectedKeyspace, expectedShard); err != nil {
+		return err
+	}
 	pos, err := replication.DecodePosition(position)
 	if err != nil {
 		return err
@@ -216,6 +700,12 @@ func (agent *ActionAgent) PopulateReparentJournal(ctx context.Context, timeCreat
 	return agent.MysqlDaemon.ExecuteSuperQueryList(ctx, cmds)
 }
 
+// GetLastReparentJournalEntry returns the most recent entry of the
+// reparent_journal table, or nil if there is none.
+func (agent *ActionAgent) GetLastReparentJournalEntry(ctx context.Context) (*tabletmanagerdatapb.ReparentJournalEntry, error) {
+	return mysqlctl.ReadLastReparentJournalEntry(ctx, agent.MysqlDaemon)
+}
+
 // InitSlave sets replication master and position, and waits for the
 // reparent_journal table entry up to context timeout
 func (agent *ActionAgent) InitSlave(ctx context.Context, parent *topodatapb.TabletAlias, position string, timeCreatedNS int64) error {
@@ -383,17 +873,61 @@ func (agent *ActionAgent) SlaveWasPromoted(ctx context.Context) error {
 }
 
 // SetMaster sets replication master, and waits for the
-// reparent_journal table entry up to context timeout
-func (agent *ActionAgent) SetMaster(ctx context.Context, parentAlias *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error {
+// reparent_journal table entry up to context timeout.
+// If expectedKeyspace and expectedShard are set, it first checks the
+// tablet is in them, see checkShard.
+func (agent *ActionAgent) SetMaster(ctx context.Context, parentAlias *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool, expectedKeyspace, expectedShard string) error {
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
 	defer agent.unlock()
 
+	if err := agent.checkShard(expectedKeyspace, expectedShard); err != nil {
+		return err
+	}
+
 	return agent.setMasterLocked(ctx, parentAlias, timeCreatedNS, forceStartSlave)
 }
 
 func (agent *ActionAgent) setMasterLocked(ctx context.Context, parentAlias *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error {
+	return agent.configureReplicationLocked(ctx, parentAlias, timeCreatedNS, forceStartSlave, agent.MysqlDaemon.SetMasterCommands)
+}
+
+// ConfigureReplication points replication at the parent tablet, like
+// SetMaster. With autoPosition, replication starts from our GTID
+// position. Otherwise it starts from the given binlog file and
+// position of the parent, and GTID auto-positioning is turned off.
+// If expectedKeyspace and expectedShard are set, it first checks the
+// tablet is in them, see checkShard.
+func (agent *ActionAgent) ConfigureReplication(ctx context.Context, parentAlias *topodatapb.TabletAlias, autoPosition bool, file string, position uint64, forceStartSlave bool, expectedKeyspace, expectedShard string) error {
+	if autoPosition && (file != "" || position != 0) {
+		return fmt.Errorf("cannot use a binlog file and position with auto-position")
+	}
+	if !autoPosition && file == "" {
+		return fmt.Errorf("a binlog file is required without auto-position")
+	}
+
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	if err := agent.checkShard(expectedKeyspace, expectedShard); err != nil {
+		return err
+	}
+
+	setMasterCommands := agent.MysqlDaemon.SetMasterCommands
+	if !autoPosition {
+		setMasterCommands = func(masterHost string, masterPort int) ([]string, error) {
+			return agent.MysqlDaemon.SetMasterFilePositionCommands(masterHost, masterPort, file, position)
+		}
+	}
+	return agent.configureReplicationLocked(ctx, parentAlias, 0, forceStartSlave, setMasterCommands)
+}
+
+// configureReplicationLocked points replication at the parent tablet,
+// using setMasterCommands to build the CHANGE MASTER TO commands.
+func (agent *ActionAgent) configureReplicationLocked(ctx context.Context, parentAlias *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool, setMasterCommands func(masterHost string, masterPort int) ([]string, error)) error {
 	parent, err := agent.TopoServer.GetTablet(ctx, parentAlias)
 	if err != nil {
 		return err
@@ -427,7 +961,7 @@ func (agent *ActionAgent) setMasterLocked(ctx context.Context, parentAlias *topo
 	if wasReplicating {
 		cmds = append(cmds, mysqlctl.SQLStopSlave)
 	}
-	smc, err := agent.MysqlDaemon.SetMasterCommands(parent.Hostname, int(parent.PortMap["mysql"]))
+	smc, err := setMasterCommands(parent.Hostname, int(parent.PortMap["mysql"]))
 	if err != nil {
 		return err
 	}
@@ -563,6 +1097,87 @@ func (agent *ActionAgent) PromoteSlave(ctx context.Context) (string, error) {
 	return replication.EncodePosition(pos), nil
 }
 
+// SetReparentEligibility remembers whether this tablet can be chosen
+// as the new master by reparent tools.
+func (agent *ActionAgent) SetReparentEligibility(ctx context.Context, eligible bool, reason string) error {
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+
+	agent._reparentIneligible = !eligible
+	agent._reparentIneligibleReason = ""
+	if !eligible {
+		agent._reparentIneligibleReason = reason
+	}
+	return nil
+}
+
+// CheckReparentCandidate returns whether this tablet can be chosen
+// as the new master by reparent tools, and if not, why.
+func (agent *ActionAgent) CheckReparentCandidate(ctx context.Context) (bool, string, error) {
+	if maintenance, reason := agent.maintenanceMode(); maintenance {
+		return false, "in maintenance: " + reason, nil
+	}
+
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+
+	return !agent._reparentIneligible, agent._reparentIneligibleReason, nil
+}
+
+// SetSemiSyncAckCount sets rpl_semi_sync_master_wait_for_slave_count
+// on the master. A count higher than the number of connected
+// semi-sync slaves is rejected, as every commit would then wait for
+// the semi-sync timeout.
+func (agent *ActionAgent) SetSemiSyncAckCount(ctx context.Context, count int) error {
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	if count < 1 {
+		return fmt.Errorf("invalid semi-sync ack count %v, must be at least 1", count)
+	}
+	if tabletType := agent.Tablet().Type; tabletType != topodatapb.TabletType_MASTER {
+		return fmt.Errorf("tablet is %v, semi-sync ack count can only be set on a master", tabletType)
+	}
+	if master, _ := agent.MysqlDaemon.SemiSyncEnabled(); !master {
+		return fmt.Errorf("semi-sync is not enabled on the master")
+	}
+	clients, err := mysqlctl.SemiSyncMasterClients(agent.MysqlDaemon)
+	if err != nil {
+		return fmt.Errorf("cannot get the number of semi-sync slaves: %v", err)
+	}
+	if count > clients {
+		return fmt.Errorf("cannot wait for %v semi-sync slaves, only %v are connected", count, clients)
+	}
+
+	log.Infof("Setting semi-sync ack count to %v (%v semi-sync slaves connected)", count, clients)
+	return agent.MysqlDaemon.ExecuteSuperQueryList(ctx, []string{
+		fmt.Sprintf("SET GLOBAL rpl_semi_sync_master_wait_for_slave_count = %v", count),
+	})
+}
+
+const (
+	// durabilityNone is the durability policy without semi-sync.
+	durabilityNone = "none"
+	// durabilitySemiSync is the durability policy of -enable_semi_sync.
+	durabilitySemiSync = "semi_sync"
+)
+
+// GetDurabilityPolicy returns the semi-sync policy this tablet
+// enforces, and how it applies to the current tablet type.
+func (agent *ActionAgent) GetDurabilityPolicy(ctx context.Context) (*tabletmanagerdatapb.DurabilityPolicy, error) {
+	policy := &tabletmanagerdatapb.DurabilityPolicy{
+		Name: durabilityNone,
+	}
+	if *enableSemiSync {
+		policy.Name = durabilitySemiSync
+	}
+	policy.SemiSyncMaster, policy.SemiSyncSlave = semiSyncSettings(agent.Tablet().Type)
+	policy.MysqlSemiSyncMaster, policy.MysqlSemiSyncSlave = agent.MysqlDaemon.SemiSyncEnabled()
+	return policy, nil
+}
+
 func isMasterEligible(tabletType topodatapb.TabletType) bool {
 	switch tabletType {
 	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA:
@@ -572,21 +1187,31 @@ func isMasterEligible(tabletType topodatapb.TabletType) bool {
 	return false
 }
 
-func (agent *ActionAgent) fixSemiSync(tabletType topodatapb.TabletType) error {
+// semiSyncSettings returns the semi-sync master and slave settings
+// for a tablet type.
+func semiSyncSettings(tabletType topodatapb.TabletType) (master, slave bool) {
 	if !*enableSemiSync {
-		// Semi-sync handling is not enabled.
-		return nil
+		return false, false
 	}
 
 	// Only enable if we're eligible for becoming master (REPLICA type).
 	// Ineligible slaves (RDONLY) shouldn't ACK because we'll never promote them.
 	if !isMasterEligible(tabletType) {
-		return agent.MysqlDaemon.SetSemiSyncEnabled(false, false)
+		return false, false
 	}
 
 	// Always enable slave-side since it doesn't hurt to keep it on for a master.
 	// The master-side needs to be off for a slave, or else it will get stuck.
-	return agent.MysqlDaemon.SetSemiSyncEnabled(tabletType == topodatapb.TabletType_MASTER, true)
+	return tabletType == topodatapb.TabletType_MASTER, true
+}
+
+func (agent *ActionAgent) fixSemiSync(tabletType topodatapb.TabletType) error {
+	if !*enableSemiSync {
+		// Semi-sync handling is not enabled.
+		return nil
+	}
+
+	return agent.MysqlDaemon.SetSemiSyncEnabled(semiSyncSettings(tabletType))
 }
 
 func (agent *ActionAgent) fixSemiSyncAndReplication(tabletType topodatapb.TabletType) error {
@@ -638,3 +1263,21 @@ func (agent *ActionAgent) fixSemiSyncAndReplication(tabletType topodatapb.Tablet
 	}
 	return nil
 }
+
+// checkShard returns a *tmclient.ShardMismatchError if an expected
+// keyspace and shard are given, and the tablet is not in them.
+func (agent *ActionAgent) checkShard(expectedKeyspace, expectedShard string) error {
+	if expectedKeyspace == "" && expectedShard == "" {
+		return nil
+	}
+	tablet := agent.Tablet()
+	if tablet.Keyspace == expectedKeyspace && tablet.Shard == expectedShard {
+		return nil
+	}
+	return &tmclient.ShardMismatchError{
+		ExpectedKeyspace: expectedKeyspace,
+		ExpectedShard:    expectedShard,
+		Keyspace:         tablet.Keyspace,
+		Shard:            tablet.Shard,
+	}
+}
@@ -0,0 +1,924 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn"
+	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/sqldb"
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/topo/memorytopo"
+
+	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestReparentRPCsRejectShardMismatch(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.ReadOnly = true
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+	masterAlias := &topodatapb.TabletAlias{
+		Cell: "cell1",
+		Uid:  2,
+	}
+	want := &tmclient.ShardMismatchError{
+		ExpectedKeyspace: "ks",
+		ExpectedShard:    "80-",
+		Keyspace:         "ks",
+		Shard:            "0",
+	}
+
+	_, err := agent.InitMaster(ctx, "ks", "80-")
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("InitMaster in the wrong shard returned %v, want %v", err, want)
+	}
+	err = agent.PopulateReparentJournal(ctx, 1, "PlannedReparentShard", masterAlias, "MariaDB/0-1-1", "ks", "80-")
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("PopulateReparentJournal in the wrong shard returned %v, want %v", err, want)
+	}
+	err = agent.SetMaster(ctx, masterAlias, 0, false, "ks", "80-")
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("SetMaster in the wrong shard returned %v, want %v", err, want)
+	}
+	err = agent.ConfigureReplication(ctx, masterAlias, true, "", 0, false, "ks", "80-")
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("ConfigureReplication in the wrong shard returned %v, want %v", err, want)
+	}
+
+	// Nothing was changed on the tablet.
+	if !mysqlDaemon.ReadOnly {
+		t.Errorf("tablet was made read-write")
+	}
+	if mysqlDaemon.ExpectedExecuteSuperQueryCurrent != 0 {
+		t.Errorf("%v queries were run on the tablet", mysqlDaemon.ExpectedExecuteSuperQueryCurrent)
+	}
+
+	// The error survives the trip over the wire, where the tablet
+	// adds the name of the RPC to it.
+	message := "TabletManager.SetMaster on cell1-0000000001 error: rpc error: code = FailedPrecondition desc = " + want.Error()
+	sme, ok := tmclient.ParseShardMismatchError(message)
+	if !ok || !reflect.DeepEqual(sme, want) {
+		t.Errorf("ParseShardMismatchError(%q) = (%v, %v), want (%v, true)", message, sme, ok, want)
+	}
+	if !tmclient.IsShardMismatch(&tmclient.RPCError{Err: sme}) {
+		t.Errorf("IsShardMismatch of a wrapped ShardMismatchError is false")
+	}
+}
+
+func TestConfigureReplication(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	master := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "cell1",
+			Uid:  1,
+		},
+		Hostname: "host1",
+		PortMap: map[string]int32{
+			"mysql": 3306,
+		},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_MASTER,
+	}
+	replica := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "cell1",
+			Uid:  2,
+		},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_REPLICA,
+	}
+	for _, tablet := range []*topodatapb.Tablet{master, replica} {
+		if err := ts.CreateTablet(ctx, tablet); err != nil {
+			t.Fatalf("CreateTablet failed: %v", err)
+		}
+	}
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	agent := &ActionAgent{
+		TopoServer:  ts,
+		TabletAlias: replica.Alias,
+		MysqlDaemon: mysqlDaemon,
+		_tablet:     replica,
+	}
+
+	// With auto-position, the GTID position is used.
+	mysqlDaemon.SetMasterCommandsInput = "host1:3306"
+	mysqlDaemon.SetMasterCommandsResult = []string{"set master cmd 1"}
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"set master cmd 1",
+		"START SLAVE",
+	}
+	if err := agent.ConfigureReplication(ctx, master.Alias, true, "", 0, true, "ks", "0"); err != nil {
+		t.Fatalf("ConfigureReplication(auto-position) failed: %v", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("ConfigureReplication(auto-position): %v", err)
+	}
+
+	// Otherwise, the binlog file and position are used.
+	mysqlDaemon.SetMasterFilePositionCommandsInput = "host1:3306 vt-0000000001-bin.000012:4567"
+	mysqlDaemon.SetMasterFilePositionCommandsResult = []string{"set master file position cmd 1"}
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"set master file position cmd 1",
+	}
+	mysqlDaemon.ExpectedExecuteSuperQueryCurrent = 0
+	if err := agent.ConfigureReplication(ctx, master.Alias, false, "vt-0000000001-bin.000012", 4567, false, "ks", "0"); err != nil {
+		t.Fatalf("ConfigureReplication(file position) failed: %v", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("ConfigureReplication(file position): %v", err)
+	}
+
+	// Mixing both is an error, and so is a missing file.
+	if err := agent.ConfigureReplication(ctx, master.Alias, true, "vt-0000000001-bin.000012", 4567, false, "", ""); err == nil {
+		t.Errorf("ConfigureReplication(auto-position with a file) worked")
+	}
+	if err := agent.ConfigureReplication(ctx, master.Alias, false, "", 0, false, "", ""); err == nil {
+		t.Errorf("ConfigureReplication(no auto-position, no file) worked")
+	}
+}
+
+func TestSlaveStatusAllChannels(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.CurrentMasterHost = "source1"
+	mysqlDaemon.CurrentMasterPort = 3306
+	mysqlDaemon.Replicating = true
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+	}
+
+	// A tablet with a single source only has the default channel.
+	statuses, err := agent.SlaveStatusAllChannels(ctx)
+	if err != nil {
+		t.Fatalf("SlaveStatusAllChannels failed: %v", err)
+	}
+	if len(statuses) != 1 || statuses[""] == nil || statuses[""].MasterHost != "source1" {
+		t.Errorf("SlaveStatusAllChannels() = %v, want only the default channel", statuses)
+	}
+
+	// Each channel of a multi-source tablet has its own status.
+	mysqlDaemon.SlaveStatusChannels = map[string]mysqlctl.Status{
+		"": {
+			MasterHost:      "source1",
+			MasterPort:      3306,
+			SlaveIORunning:  true,
+			SlaveSQLRunning: true,
+		},
+		"source2": {
+			MasterHost:          "source2",
+			MasterPort:          3307,
+			SlaveIORunning:      true,
+			SecondsBehindMaster: 5,
+		},
+	}
+	statuses, err = agent.SlaveStatusAllChannels(ctx)
+	if err != nil {
+		t.Fatalf("SlaveStatusAllChannels failed: %v", err)
+	}
+	want := map[string]*replicationdatapb.Status{
+		"":        mysqlctl.StatusToProto(mysqlDaemon.SlaveStatusChannels[""]),
+		"source2": mysqlctl.StatusToProto(mysqlDaemon.SlaveStatusChannels["source2"]),
+	}
+	if !reflect.DeepEqual(statuses, want) {
+		t.Errorf("SlaveStatusAllChannels() = %v, want %v", statuses, want)
+	}
+}
+
+func TestGetReplicationSource(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.CurrentMasterHost = "master1"
+	mysqlDaemon.CurrentMasterPort = 3306
+	mysqlDaemon.ReplicationUser = "vt_repl"
+	mysqlDaemon.ReplicationPassword = "s3cr3t"
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+	}
+
+	source, err := agent.GetReplicationSource(ctx)
+	if err != nil {
+		t.Fatalf("GetReplicationSource failed: %v", err)
+	}
+	want := &tabletmanagerdatapb.ReplicationSource{
+		MasterHost: "master1",
+		MasterPort: 3306,
+		User:       "vt_repl",
+	}
+	if !reflect.DeepEqual(source, want) {
+		t.Errorf("GetReplicationSource() = %v, want %v", source, want)
+	}
+	if strings.Contains(source.String(), "s3cr3t") {
+		t.Errorf("GetReplicationSource() leaked the password: %v", source)
+	}
+
+	// A tablet that is not a slave has no replication source.
+	mysqlDaemon.SlaveStatusError = mysqlctl.ErrNotSlave
+	if _, err := agent.GetReplicationSource(ctx); err != mysqlctl.ErrNotSlave {
+		t.Errorf("GetReplicationSource() on a master returned %v, want %v", err, mysqlctl.ErrNotSlave)
+	}
+}
+
+func TestValidateReplicationCredentials(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.CurrentMasterHost = "master1"
+	mysqlDaemon.CurrentMasterPort = 3306
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+	}
+
+	valid, err := agent.ValidateReplicationCredentials(ctx)
+	if err != nil || !valid {
+		t.Errorf("ValidateReplicationCredentials() = (%v, %v), want (true, nil)", valid, err)
+	}
+
+	// Denied credentials are not an error.
+	mysqlDaemon.CheckReplicationCredentialsError = sqldb.NewSQLError(mysqlconn.ERAccessDeniedError, mysqlconn.SSAccessDeniedError, "Access denied for user 'vt_repl'")
+	valid, err = agent.ValidateReplicationCredentials(ctx)
+	if err != nil || valid {
+		t.Errorf("ValidateReplicationCredentials() with denied credentials = (%v, %v), want (false, nil)", valid, err)
+	}
+
+	// Other failures say nothing about the credentials.
+	mysqlDaemon.CheckReplicationCredentialsError = sqldb.NewSQLError(mysqlconn.CRConnHostError, "", "connection refused")
+	if valid, err = agent.ValidateReplicationCredentials(ctx); err == nil || valid {
+		t.Errorf("ValidateReplicationCredentials() with an unreachable master = (%v, %v), want an error", valid, err)
+	} else if !strings.Contains(err.Error(), "master1:3306") {
+		t.Errorf("ValidateReplicationCredentials() error %q doesn't name the master", err)
+	}
+
+	mysqlDaemon.SlaveStatusError = mysqlctl.ErrNotSlave
+	if _, err := agent.ValidateReplicationCredentials(ctx); err != mysqlctl.ErrNotSlave {
+		t.Errorf("ValidateReplicationCredentials() on a master returned %v, want %v", err, mysqlctl.ErrNotSlave)
+	}
+}
+
+func TestGetGtidPurged(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.CurrentPurgedPosition = replication.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-12")
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+	}
+
+	encoded, err := agent.GetGtidPurged(ctx)
+	if err != nil {
+		t.Fatalf("GetGtidPurged failed: %v", err)
+	}
+	purged, err := replication.DecodePosition(encoded)
+	if err != nil {
+		t.Fatalf("DecodePosition(%v) failed: %v", encoded, err)
+	}
+	if !purged.Equal(mysqlDaemon.CurrentPurgedPosition) {
+		t.Errorf("GetGtidPurged returned %v, want %v", purged, mysqlDaemon.CurrentPurgedPosition)
+	}
+
+	// A replica can only start from a position that contains all
+	// the purged transactions.
+	compatible := replication.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-20")
+	if !compatible.AtLeast(purged) {
+		t.Errorf("replica at %v should be able to replicate from a master that purged %v", compatible, purged)
+	}
+	incompatible := replication.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-5")
+	if incompatible.AtLeast(purged) {
+		t.Errorf("replica at %v should not be able to replicate from a master that purged %v", incompatible, purged)
+	}
+}
+
+func TestGetBinlogStats(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+	}
+
+	if _, _, err := agent.GetBinlogStats(ctx); err == nil {
+		t.Errorf("GetBinlogStats without samples should have failed")
+	}
+
+	// Sample the fake position as the health check does, 20s apart,
+	// with 500 transactions in between.
+	start := time.Now()
+	for i, pos := range []string{
+		"00010203-0405-0607-0809-0a0b0c0d0e0f:1-1000",
+		"00010203-0405-0607-0809-0a0b0c0d0e0f:1-1500",
+	} {
+		mysqlDaemon.CurrentMasterPosition = replication.MustParsePosition("MySQL56", pos)
+		masterPos, err := mysqlDaemon.MasterPosition()
+		if err != nil {
+			t.Fatalf("MasterPosition failed: %v", err)
+		}
+		agent.recordBinlogSample(start.Add(time.Duration(i)*20*time.Second), masterPos.TransactionCount())
+	}
+
+	transactionsPerSecond, window, err := agent.GetBinlogStats(ctx)
+	if err != nil {
+		t.Fatalf("GetBinlogStats failed: %v", err)
+	}
+	if transactionsPerSecond != 25 || window != 20*time.Second {
+		t.Errorf("GetBinlogStats returned %v transactions/s over %v, want 25 over 20s", transactionsPerSecond, window)
+	}
+}
+
+func TestGetReplicationErrorStats(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Replicating = true
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+	}
+
+	// An IO error is counted once while it persists, a new one is
+	// counted again.
+	mysqlDaemon.LastIOErrno = 2003
+	agent.sampleReplicationErrors()
+	agent.sampleReplicationErrors()
+	mysqlDaemon.LastIOErrno = 0
+	agent.sampleReplicationErrors()
+	mysqlDaemon.LastIOErrno = 2003
+	agent.sampleReplicationErrors()
+	mysqlDaemon.LastSQLErrno = 1062
+	agent.sampleReplicationErrors()
+	agent.recordReplicationReconnect()
+
+	want := &tabletmanagerdatapb.ReplicationErrorStats{
+		IoErrors:   2,
+		SqlErrors:  1,
+		Reconnects: 1,
+	}
+	for _, reset := range []bool{false, true} {
+		stats, err := agent.GetReplicationErrorStats(ctx, reset)
+		if err != nil {
+			t.Fatalf("GetReplicationErrorStats(%v) failed: %v", reset, err)
+		}
+		if !reflect.DeepEqual(stats, want) {
+			t.Errorf("GetReplicationErrorStats(%v) = %v, want %v", reset, stats, want)
+		}
+	}
+
+	// The counts were cleared, and the errors that persist are not
+	// counted again.
+	agent.sampleReplicationErrors()
+	stats, err := agent.GetReplicationErrorStats(ctx, false)
+	if err != nil {
+		t.Fatalf("GetReplicationErrorStats failed: %v", err)
+	}
+	if want := (&tabletmanagerdatapb.ReplicationErrorStats{}); !reflect.DeepEqual(stats, want) {
+		t.Errorf("GetReplicationErrorStats after reset = %v, want %v", stats, want)
+	}
+}
+
+func TestGetReplicationGraph(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	newTablet := func(uid uint32, hostname, ip string, tabletType topodatapb.TabletType) *topodatapb.Tablet {
+		tablet := &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  uid,
+			},
+			Hostname: hostname,
+			Ip:       ip,
+			PortMap: map[string]int32{
+				"mysql": 3306,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+			Type:     tabletType,
+		}
+		if err := ts.CreateTablet(ctx, tablet); err != nil {
+			t.Fatalf("CreateTablet failed: %v", err)
+		}
+		return tablet
+	}
+	master := newTablet(1, "host1", "10.0.0.1", topodatapb.TabletType_MASTER)
+	replica := newTablet(2, "host2", "10.0.0.2", topodatapb.TabletType_REPLICA)
+	rdonly := newTablet(3, "host3", "10.0.0.3", topodatapb.TabletType_RDONLY)
+
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.CurrentMasterHost = "host1"
+	mysqlDaemon.CurrentMasterPort = 3306
+	processRow := func(id, addr, command string) []sqltypes.Value {
+		return []sqltypes.Value{
+			sqltypes.MakeString([]byte(id)),
+			sqltypes.MakeString([]byte("vt_repl")),
+			sqltypes.MakeString([]byte(addr)),
+			sqltypes.NULL,
+			sqltypes.MakeString([]byte(command)),
+		}
+	}
+	mysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW PROCESSLIST": {
+			Rows: [][]sqltypes.Value{
+				processRow("1", "10.0.0.3:51234", "Binlog Dump GTID"),
+				processRow("2", "10.0.0.9:51235", "Binlog Dump"),
+				processRow("3", "localhost", "Binlog Dump"),
+				processRow("4", "10.0.0.1:51236", "Query"),
+			},
+		},
+	}
+	agent := &ActionAgent{
+		TopoServer:  ts,
+		MysqlDaemon: mysqlDaemon,
+		_tablet:     replica,
+	}
+
+	// The replica replicates from the master, and the rdonly and an
+	// unknown host replicate from it.
+	graph, err := agent.GetReplicationGraph(ctx)
+	if err != nil {
+		t.Fatalf("GetReplicationGraph failed: %v", err)
+	}
+	want := &tabletmanagerdatapb.ReplicationGraph{
+		Master: &tabletmanagerdatapb.ReplicationNeighbor{
+			Host:  "host1",
+			Alias: master.Alias,
+		},
+		Slaves: []*tabletmanagerdatapb.ReplicationNeighbor{
+			{
+				Host:  "10.0.0.3",
+				Alias: rdonly.Alias,
+			},
+			{
+				Host: "10.0.0.9",
+			},
+		},
+	}
+	if !reflect.DeepEqual(graph, want) {
+		t.Errorf("GetReplicationGraph() = %v, want %v", graph, want)
+	}
+
+	// A master has no upstream.
+	mysqlDaemon.SlaveStatusError = mysqlctl.ErrNotSlave
+	graph, err = agent.GetReplicationGraph(ctx)
+	if err != nil {
+		t.Fatalf("GetReplicationGraph failed: %v", err)
+	}
+	if graph.Master != nil || len(graph.Slaves) != 2 {
+		t.Errorf("GetReplicationGraph() without slave status = %v, want no master and 2 slaves", graph)
+	}
+}
+
+func TestRotateReplicationCredentials(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Replicating = true
+	mysqlDaemon.ReplicationUser = "vt_repl"
+	mysqlDaemon.ReplicationPassword = "old"
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+	defer func(timeout time.Duration) {
+		*rotateReplicationCredentialsTimeout = timeout
+	}(*rotateReplicationCredentialsTimeout)
+	*rotateReplicationCredentialsTimeout = 200 * time.Millisecond
+	changeCommands := func(user, password string) []string {
+		cmds := []string{mysqlctl.SQLStopSlaveIOThread}
+		cmds = append(cmds, mysqlctl.ChangeReplicationCredentialsCommands(user, password)...)
+		return append(cmds, mysqlctl.SQLStartSlaveIOThread)
+	}
+
+	// The IO thread resumes with the new credentials, and they are kept.
+	mysqlDaemon.ExpectedExecuteSuperQueryList = changeCommands("vt_repl2", "new")
+	if err := agent.RotateReplicationCredentials(ctx, "vt_repl2", "new"); err != nil {
+		t.Fatalf("RotateReplicationCredentials failed: %v", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("RotateReplicationCredentials: %v", err)
+	}
+	if mysqlDaemon.ReplicationUser != "vt_repl2" || mysqlDaemon.ReplicationPassword != "new" {
+		t.Errorf("RotateReplicationCredentials stored credentials %v/%v, want vt_repl2/new", mysqlDaemon.ReplicationUser, mysqlDaemon.ReplicationPassword)
+	}
+
+	// The IO thread does not start with the next credentials, so the
+	// previous ones are restored.
+	mysqlDaemon.ExpectedExecuteSuperQueryList = append(changeCommands("vt_repl3", "bad"), changeCommands("vt_repl2", "new")...)
+	mysqlDaemon.ExpectedExecuteSuperQueryCurrent = 0
+	mysqlDaemon.StartSlaveIOThreadFailures = 1
+	err := agent.RotateReplicationCredentials(ctx, "vt_repl3", "bad")
+	if err == nil || !strings.Contains(err.Error(), "rolled back") {
+		t.Errorf("RotateReplicationCredentials with a failed restart returned %v, want a rollback error", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("RotateReplicationCredentials did not roll back: %v", err)
+	}
+	if mysqlDaemon.ReplicationUser != "vt_repl2" || mysqlDaemon.ReplicationPassword != "new" {
+		t.Errorf("RotateReplicationCredentials after rollback stored credentials %v/%v, want vt_repl2/new", mysqlDaemon.ReplicationUser, mysqlDaemon.ReplicationPassword)
+	}
+	if !mysqlDaemon.Replicating {
+		t.Errorf("RotateReplicationCredentials did not resume replication after rollback")
+	}
+
+	// A slave that is not replicating cannot check new credentials.
+	mysqlDaemon.Replicating = false
+	if err := agent.RotateReplicationCredentials(ctx, "vt_repl3", "new"); err == nil {
+		t.Errorf("RotateReplicationCredentials without replication succeeded")
+	}
+}
+
+func TestConfigureReplicationSSL(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Replicating = true
+	mysqlDaemon.ReplicationSSLAllowed = true
+	mysqlDaemon.ReplicationSSLCA = "old-ca.pem"
+	mysqlDaemon.ReplicationSSLCert = "old-cert.pem"
+	mysqlDaemon.ReplicationSSLKey = "old-key.pem"
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+	defer func(timeout time.Duration) {
+		*configureReplicationSSLTimeout = timeout
+	}(*configureReplicationSSLTimeout)
+	*configureReplicationSSLTimeout = 200 * time.Millisecond
+	changeCommands := func(enabled bool, ca, cert, key string) []string {
+		cmds := []string{mysqlctl.SQLStopSlaveIOThread}
+		cmds = append(cmds, mysqlctl.ChangeReplicationSSLCommands(enabled, ca, cert, key)...)
+		return append(cmds, mysqlctl.SQLStartSlaveIOThread)
+	}
+
+	// The IO thread resumes with SSL, and the new files are kept.
+	mysqlDaemon.ExpectedExecuteSuperQueryList = changeCommands(true, "ca.pem", "cert.pem", "key.pem")
+	opts := &tabletmanagerdatapb.ReplicationSSLOptions{
+		Ca:   "ca.pem",
+		Cert: "cert.pem",
+		Key:  "key.pem",
+	}
+	if err := agent.ConfigureReplicationSSL(ctx, opts); err != nil {
+		t.Fatalf("ConfigureReplicationSSL failed: %v", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("ConfigureReplicationSSL: %v", err)
+	}
+	if mysqlDaemon.ReplicationSSLCA != "ca.pem" || mysqlDaemon.ReplicationSSLCert != "cert.pem" || mysqlDaemon.ReplicationSSLKey != "key.pem" {
+		t.Errorf("ConfigureReplicationSSL stored SSL files %v/%v/%v, want ca.pem/cert.pem/key.pem", mysqlDaemon.ReplicationSSLCA, mysqlDaemon.ReplicationSSLCert, mysqlDaemon.ReplicationSSLKey)
+	}
+
+	// The SSL handshake fails with the next files, so the IO thread
+	// does not start, and the previous files are restored.
+	mysqlDaemon.ExpectedExecuteSuperQueryList = append(changeCommands(true, "bad-ca.pem", "cert.pem", "key.pem"), changeCommands(true, "ca.pem", "cert.pem", "key.pem")...)
+	mysqlDaemon.ExpectedExecuteSuperQueryCurrent = 0
+	mysqlDaemon.StartSlaveIOThreadFailures = 1
+	opts = &tabletmanagerdatapb.ReplicationSSLOptions{
+		Ca:   "bad-ca.pem",
+		Cert: "cert.pem",
+		Key:  "key.pem",
+	}
+	err := agent.ConfigureReplicationSSL(ctx, opts)
+	if err == nil || !strings.Contains(err.Error(), "rolled back") {
+		t.Errorf("ConfigureReplicationSSL with a failed handshake returned %v, want a rollback error", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("ConfigureReplicationSSL did not roll back: %v", err)
+	}
+	if mysqlDaemon.ReplicationSSLCA != "ca.pem" {
+		t.Errorf("ConfigureReplicationSSL after rollback stored SSL CA %v, want ca.pem", mysqlDaemon.ReplicationSSLCA)
+	}
+	if !mysqlDaemon.Replicating {
+		t.Errorf("ConfigureReplicationSSL did not resume replication after rollback")
+	}
+
+	// Replication resuming without SSL is rolled back too.
+	mysqlDaemon.ReplicationSSLAllowed = false
+	mysqlDaemon.ExpectedExecuteSuperQueryList = append(changeCommands(true, "bad-ca.pem", "cert.pem", "key.pem"), changeCommands(false, "ca.pem", "cert.pem", "key.pem")...)
+	mysqlDaemon.ExpectedExecuteSuperQueryCurrent = 0
+	err = agent.ConfigureReplicationSSL(ctx, opts)
+	if err == nil || !strings.Contains(err.Error(), "resumed without SSL") {
+		t.Errorf("ConfigureReplicationSSL without SSL returned %v, want a rollback error", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("ConfigureReplicationSSL did not roll back: %v", err)
+	}
+
+	// A slave that is not replicating cannot check new SSL options.
+	mysqlDaemon.Replicating = false
+	if err := agent.ConfigureReplicationSSL(ctx, opts); err == nil {
+		t.Errorf("ConfigureReplicationSSL without replication succeeded")
+	}
+}
+
+func TestRepairRelayLog(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.CurrentMasterHost = "master1"
+	mysqlDaemon.CurrentMasterPort = 3306
+	mysqlDaemon.CurrentMasterPosition = replication.Position{
+		GTIDSet: replication.MariadbGTID{Domain: 0, Server: 1, Sequence: 42},
+	}
+	mysqlDaemon.SetMasterCommandsInput = "master1:3306"
+	mysqlDaemon.SetMasterCommandsResult = []string{"set master cmd 1"}
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+	defer func(timeout time.Duration) {
+		*repairRelayLogTimeout = timeout
+	}(*repairRelayLogTimeout)
+	*repairRelayLogTimeout = 200 * time.Millisecond
+	repairCommands := []string{
+		mysqlctl.SQLStopSlave,
+		mysqlctl.SQLResetSlave,
+		"set master cmd 1",
+		mysqlctl.SQLStartSlave,
+	}
+
+	// The relay logs are fetched again, and replication restarts.
+	mysqlDaemon.ExpectedExecuteSuperQueryList = repairCommands
+	logger := logutil.NewMemoryLogger()
+	if err := agent.RepairRelayLog(ctx, logger); err != nil {
+		t.Fatalf("RepairRelayLog failed: %v", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("RepairRelayLog: %v", err)
+	}
+	if !mysqlDaemon.Replicating {
+		t.Errorf("RepairRelayLog did not restart replication")
+	}
+	if got := logger.String(); !strings.Contains(got, "replication restarted from position") {
+		t.Errorf("RepairRelayLog did not log its progress: %v", got)
+	}
+
+	// The master purged the binlogs since the applied position.
+	mysqlDaemon.Replicating = false
+	mysqlDaemon.ExpectedExecuteSuperQueryList = repairCommands
+	mysqlDaemon.ExpectedExecuteSuperQueryCurrent = 0
+	mysqlDaemon.StartSlaveIOErrno = errMasterFatalErrorReadingBinlog
+	err := agent.RepairRelayLog(ctx, logutil.NewMemoryLogger())
+	if err == nil || !strings.Contains(err.Error(), "no longer has the binlogs since position") {
+		t.Errorf("RepairRelayLog with purged binlogs returned %v, want a missing binlogs error", err)
+	}
+	if mysqlDaemon.Replicating {
+		t.Errorf("RepairRelayLog with purged binlogs left replication running")
+	}
+
+	// A tablet that is not a slave has no relay log.
+	mysqlDaemon.SlaveStatusError = mysqlctl.ErrNotSlave
+	if err := agent.RepairRelayLog(ctx, logutil.NewMemoryLogger()); err == nil {
+		t.Errorf("RepairRelayLog on a master succeeded")
+	}
+}
+
+func TestSetSemiSyncAckCount(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.SemiSyncMasterEnabled = true
+	mysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW STATUS LIKE 'Rpl_semi_sync_master_clients'": {
+			Rows: [][]sqltypes.Value{
+				{
+					sqltypes.MakeString([]byte("Rpl_semi_sync_master_clients")),
+					sqltypes.MakeString([]byte("2")),
+				},
+			},
+		},
+	}
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+			Type:     topodatapb.TabletType_MASTER,
+		},
+	}
+
+	// Two semi-sync slaves are connected, waiting for both is fine.
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"SET GLOBAL rpl_semi_sync_master_wait_for_slave_count = 2",
+	}
+	if err := agent.SetSemiSyncAckCount(ctx, 2); err != nil {
+		t.Fatalf("SetSemiSyncAckCount(2) failed: %v", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("SetSemiSyncAckCount(2) ran the wrong queries: %v", err)
+	}
+
+	// Waiting for a third one would stall all writes.
+	mysqlDaemon.ExpectedExecuteSuperQueryList = nil
+	mysqlDaemon.ExpectedExecuteSuperQueryCurrent = 0
+	err := agent.SetSemiSyncAckCount(ctx, 3)
+	if err == nil || !strings.Contains(err.Error(), "only 2 are connected") {
+		t.Errorf("SetSemiSyncAckCount(3) returned %v, want an error about the 2 connected slaves", err)
+	}
+	if mysqlDaemon.ExpectedExecuteSuperQueryCurrent != 0 {
+		t.Errorf("SetSemiSyncAckCount(3) ran queries")
+	}
+
+	// A count of 0 is never valid.
+	if err := agent.SetSemiSyncAckCount(ctx, 0); err == nil {
+		t.Errorf("SetSemiSyncAckCount(0) worked, want an error")
+	}
+
+	// Only a master with semi-sync enabled can take the setting.
+	mysqlDaemon.SemiSyncMasterEnabled = false
+	if err := agent.SetSemiSyncAckCount(ctx, 1); err == nil {
+		t.Errorf("SetSemiSyncAckCount without semi-sync worked, want an error")
+	}
+	mysqlDaemon.SemiSyncMasterEnabled = true
+	agent._tablet.Type = topodatapb.TabletType_REPLICA
+	if err := agent.SetSemiSyncAckCount(ctx, 1); err == nil {
+		t.Errorf("SetSemiSyncAckCount on a REPLICA worked, want an error")
+	}
+}
+
+func TestStopSlaveMinimumStream(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Replicating = true
+	mysqlDaemon.CurrentMasterPosition = replication.MustParsePosition("MariaDB", "0-1-40")
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		mysqlctl.SQLStopSlave,
+	}
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+	defer func(interval time.Duration) {
+		stopSlaveMinimumStreamInterval = interval
+	}(stopSlaveMinimumStreamInterval)
+	stopSlaveMinimumStreamInterval = time.Millisecond
+
+	// The slave catches up by one transaction after each update.
+	var responses []*tabletmanagerdatapb.StopSlaveMinimumStreamResponse
+	catchUp := []string{"0-1-41", "0-1-42"}
+	send := func(response *tabletmanagerdatapb.StopSlaveMinimumStreamResponse) error {
+		responses = append(responses, response)
+		if len(catchUp) > 0 {
+			mysqlDaemon.CurrentMasterPosition = replication.MustParsePosition("MariaDB", catchUp[0])
+			catchUp = catchUp[1:]
+		}
+		return nil
+	}
+	if err := agent.StopSlaveMinimumStream(ctx, "MariaDB/0-1-42", time.Minute, send); err != nil {
+		t.Fatalf("StopSlaveMinimumStream failed: %v", err)
+	}
+	want := []*tabletmanagerdatapb.StopSlaveMinimumStreamResponse{
+		{
+			Position: "MariaDB/0-1-40",
+		},
+		{
+			Position: "MariaDB/0-1-41",
+		},
+		{
+			Position: "MariaDB/0-1-42",
+			Stopped:  true,
+		},
+	}
+	if !reflect.DeepEqual(responses, want) {
+		t.Errorf("StopSlaveMinimumStream sent %v, want %v", responses, want)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("StopSlaveMinimumStream did not stop replication: %v", err)
+	}
+
+	// A slave that does not catch up in time is not stopped.
+	mysqlDaemon.ExpectedExecuteSuperQueryList = nil
+	mysqlDaemon.ExpectedExecuteSuperQueryCurrent = 0
+	responses = nil
+	stuck := func(response *tabletmanagerdatapb.StopSlaveMinimumStreamResponse) error {
+		responses = append(responses, response)
+		return nil
+	}
+	err := agent.StopSlaveMinimumStream(ctx, "MariaDB/0-1-50", 20*time.Millisecond, stuck)
+	if err == nil || !strings.Contains(err.Error(), "did not reach") {
+		t.Errorf("StopSlaveMinimumStream returned %v, want a timeout error", err)
+	}
+	for _, response := range responses {
+		if response.Stopped {
+			t.Errorf("StopSlaveMinimumStream sent %v, replication should not have been stopped", response)
+		}
+	}
+}
+
+func TestGetDurabilityPolicy(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.SemiSyncMasterEnabled = false
+	mysqlDaemon.SemiSyncSlaveEnabled = true
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Type: topodatapb.TabletType_REPLICA,
+		},
+	}
+	defer func(enabled bool) {
+		*enableSemiSync = enabled
+	}(*enableSemiSync)
+
+	testCases := []struct {
+		semiSync   bool
+		tabletType topodatapb.TabletType
+		want       *tabletmanagerdatapb.DurabilityPolicy
+	}{
+		{
+			semiSync:   false,
+			tabletType: topodatapb.TabletType_REPLICA,
+			want: &tabletmanagerdatapb.DurabilityPolicy{
+				Name:               "none",
+				MysqlSemiSyncSlave: true,
+			},
+		},
+		{
+			semiSync:   true,
+			tabletType: topodatapb.TabletType_MASTER,
+			want: &tabletmanagerdatapb.DurabilityPolicy{
+				Name:               "semi_sync",
+				SemiSyncMaster:     true,
+				SemiSyncSlave:      true,
+				MysqlSemiSyncSlave: true,
+			},
+		},
+		{
+			semiSync:   true,
+			tabletType: topodatapb.TabletType_REPLICA,
+			want: &tabletmanagerdatapb.DurabilityPolicy{
+				Name:               "semi_sync",
+				SemiSyncSlave:      true,
+				MysqlSemiSyncSlave: true,
+			},
+		},
+		{
+			semiSync:   true,
+			tabletType: topodatapb.TabletType_RDONLY,
+			want: &tabletmanagerdatapb.DurabilityPolicy{
+				Name:               "semi_sync",
+				MysqlSemiSyncSlave: true,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		*enableSemiSync = tc.semiSync
+		agent._tablet.Type = tc.tabletType
+		policy, err := agent.GetDurabilityPolicy(ctx)
+		if err != nil {
+			t.Fatalf("GetDurabilityPolicy failed: %v", err)
+		}
+		if !reflect.DeepEqual(policy, tc.want) {
+			t.Errorf("GetDurabilityPolicy() with semi-sync %v on a %v = %v, want %v", tc.semiSync, tc.tabletType, policy, tc.want)
+		}
+	}
+}
@@ -5,23 +5,87 @@
 package tabletmanager
 
 import (
+	"bytes"
+	"flag"
 	"fmt"
+	"time"
 
 	log "github.com/golang/glog"
 	"golang.org/x/net/context"
 
 	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/sqltypes"
 	"github.com/youtube/vitess/go/vt/mysqlctl/tmutils"
 	"github.com/youtube/vitess/go/vt/topo/topoproto"
 
 	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
 )
 
+var schemaChangeCopyRate = flag.Int64("schema_change_copy_rate", 50*1024*1024, "bytes per second a table is expected to be copied at by an ALTER TABLE, used to estimate how long it blocks writes")
+
 // GetSchema returns the schema.
 func (agent *ActionAgent) GetSchema(ctx context.Context, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
 	return agent.MysqlDaemon.GetSchema(topoproto.TabletDbName(agent.Tablet()), tables, excludeTables, includeViews)
 }
 
+// GetSchemaBestEffort returns the schema gathered within timeout, and
+// true if that was not enough to gather all tables.
+func (agent *ActionAgent) GetSchemaBestEffort(ctx context.Context, tables, excludeTables []string, includeViews bool, timeout time.Duration) (*tabletmanagerdatapb.SchemaDefinition, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return agent.MysqlDaemon.GetSchemaBestEffort(ctx, topoproto.TabletDbName(agent.Tablet()), tables, excludeTables, includeViews)
+}
+
+// GetCreateStatements returns the raw CREATE statements of the tables,
+// as the server prints them.
+func (agent *ActionAgent) GetCreateStatements(ctx context.Context, tables, excludeTables []string, includeViews bool) (map[string]string, error) {
+	return agent.MysqlDaemon.GetCreateStatements(ctx, topoproto.TabletDbName(agent.Tablet()), tables, excludeTables, includeViews)
+}
+
+// GetSchemaTimestamps returns the CREATE_TIME of the tables, by name.
+// InnoDB resets it when an ALTER TABLE rebuilds the table, so it tells
+// when the table was last altered.
+func (agent *ActionAgent) GetSchemaTimestamps(ctx context.Context, tables []string) (map[string]time.Time, error) {
+	dbName := topoproto.TabletDbName(agent.Tablet())
+	qr, err := agent.MysqlDaemon.FetchSuperQuery(ctx, fmt.Sprintf("SELECT table_name, UNIX_TIMESTAMP(create_time) FROM information_schema.tables WHERE table_schema = %v AND table_type = '%v'", encodeString(dbName), tmutils.TableBaseTable))
+	if err != nil {
+		return nil, err
+	}
+
+	// Select the tables the same way GetSchema does.
+	sd := &tabletmanagerdatapb.SchemaDefinition{}
+	createTimes := make(map[string]int64, len(qr.Rows))
+	for _, row := range qr.Rows {
+		if len(row) != 2 {
+			return nil, fmt.Errorf("unexpected result format for the table timestamps: %#v", qr)
+		}
+		table := row[0].String()
+		if row[1].IsNull() {
+			// Some engines don't track it.
+			continue
+		}
+		createTime, err := row[1].ParseInt64()
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse the create time of table %v: %v", table, err)
+		}
+		sd.TableDefinitions = append(sd.TableDefinitions, &tabletmanagerdatapb.TableDefinition{
+			Name: table,
+			Type: tmutils.TableBaseTable,
+		})
+		createTimes[table] = createTime
+	}
+	sd, err = tmutils.FilterTables(sd, tables, nil, false)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamps := make(map[string]time.Time, len(sd.TableDefinitions))
+	for _, td := range sd.TableDefinitions {
+		timestamps[td.Name] = time.Unix(createTimes[td.Name], 0)
+	}
+	return timestamps, nil
+}
+
 // ReloadSchema will reload the schema
 // This doesn't need the action mutex because periodic schema reloads happen
 // in the background anyway.
@@ -80,3 +144,71 @@ func (agent *ActionAgent) ApplySchema(ctx context.Context, change *tmutils.Schem
 	agent.ReloadSchema(ctx, "")
 	return scr, nil
 }
+
+// SchemaDiff returns the DDL statements that would turn the current
+// schema of the tablet, including views, into the desired one.
+func (agent *ActionAgent) SchemaDiff(ctx context.Context, desired *tabletmanagerdatapb.SchemaDefinition) ([]string, error) {
+	current, err := agent.GetSchema(ctx, nil, nil, true /*includeViews*/)
+	if err != nil {
+		return nil, err
+	}
+	return tmutils.DiffSchemaToSQL(current, desired)
+}
+
+// AssessSchemaChange returns how MySQL would run the ALTER TABLE change
+// on the live table, without running it. Only a copy blocks writes
+// for long, for the time it takes to copy the table.
+func (agent *ActionAgent) AssessSchemaChange(ctx context.Context, change string) (*tabletmanagerdatapb.SchemaChangeAssessment, error) {
+	table, clauses, err := tmutils.ParseAlterTable(change)
+	if err != nil {
+		return nil, err
+	}
+	dbName := topoproto.TabletDbName(agent.Tablet())
+	sd, err := agent.MysqlDaemon.GetSchema(dbName, []string{table}, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	var td *tabletmanagerdatapb.TableDefinition
+	for _, t := range sd.TableDefinitions {
+		if t.Name == table {
+			td = t
+			break
+		}
+	}
+	if td == nil {
+		return nil, fmt.Errorf("table %v not found in %v", table, dbName)
+	}
+	algorithm, err := tmutils.AlterAlgorithm(clauses, td.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	qr, err := agent.MysqlDaemon.FetchSuperQuery(ctx, fmt.Sprintf("SELECT data_length + index_length FROM information_schema.tables WHERE table_schema = %v AND table_name = %v", encodeString(dbName), encodeString(table)))
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return nil, fmt.Errorf("unexpected result format for the size of table %v: %#v", table, qr)
+	}
+	size, err := qr.Rows[0][0].ParseInt64()
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse the size of table %v: %v", table, err)
+	}
+
+	assessment := &tabletmanagerdatapb.SchemaChangeAssessment{
+		Table:          table,
+		Algorithm:      algorithm,
+		TableSizeBytes: size,
+	}
+	if algorithm == tmutils.AlterAlgorithmCopy && *schemaChangeCopyRate > 0 {
+		assessment.EstimatedLockNs = int64(float64(size) / float64(*schemaChangeCopyRate) * float64(time.Second))
+	}
+	return assessment, nil
+}
+
+// encodeString returns s as an SQL string literal.
+func encodeString(s string) string {
+	buf := &bytes.Buffer{}
+	sqltypes.MakeString([]byte(s)).EncodeSQL(buf)
+	return buf.String()
+}
@@ -0,0 +1,269 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestGetSchemaBestEffort(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Schema = &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: "CREATE DATABASE {{.DatabaseName}}",
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{Name: "t1", Schema: "CREATE TABLE t1 (id bigint)", Type: "BASE TABLE"},
+			{Name: "t2", Schema: "CREATE TABLE t2 (id bigint)", Type: "BASE TABLE"},
+			{Name: "t3", Schema: "CREATE TABLE t3 (id bigint)", Type: "BASE TABLE"},
+		},
+	}
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+
+	// Each table takes 100ms to gather, so a 150ms deadline only
+	// leaves time for the first one.
+	mysqlDaemon.SchemaTableDelay = 100 * time.Millisecond
+	sd, incomplete, err := agent.GetSchemaBestEffort(ctx, nil, nil, true, 150*time.Millisecond)
+	if err != nil {
+		t.Fatalf("GetSchemaBestEffort failed: %v", err)
+	}
+	if !incomplete {
+		t.Errorf("GetSchemaBestEffort past its deadline is not marked incomplete")
+	}
+	want := &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema:   mysqlDaemon.Schema.DatabaseSchema,
+		TableDefinitions: mysqlDaemon.Schema.TableDefinitions[:1],
+	}
+	if !reflect.DeepEqual(sd, want) {
+		t.Errorf("GetSchemaBestEffort past its deadline = %v, want %v", sd, want)
+	}
+
+	// With enough time, the whole schema is returned.
+	mysqlDaemon.SchemaTableDelay = time.Millisecond
+	sd, incomplete, err = agent.GetSchemaBestEffort(ctx, nil, nil, true, 10*time.Second)
+	if err != nil {
+		t.Fatalf("GetSchemaBestEffort failed: %v", err)
+	}
+	if incomplete {
+		t.Errorf("GetSchemaBestEffort within its deadline is marked incomplete")
+	}
+	if !reflect.DeepEqual(sd, mysqlDaemon.Schema) {
+		t.Errorf("GetSchemaBestEffort = %v, want %v", sd, mysqlDaemon.Schema)
+	}
+}
+
+func TestGetCreateStatements(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Schema = &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: "CREATE DATABASE {{.DatabaseName}}",
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{Name: "t1", Schema: "CREATE TABLE `t1` (\n  `id` bigint(20) NOT NULL AUTO_INCREMENT,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4", Type: "BASE TABLE"},
+			{Name: "t2", Schema: "CREATE TABLE `t2` (\n  `id` bigint(20) NOT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=latin1", Type: "BASE TABLE"},
+			{Name: "v1", Schema: "CREATE VIEW `{{.DatabaseName}}`.`v1` AS select `{{.DatabaseName}}`.`t1`.`id` AS `id` from `{{.DatabaseName}}`.`t1`", Type: "VIEW"},
+		},
+	}
+	// The raw statements keep what GetSchema normalizes away.
+	mysqlDaemon.CreateStatements = map[string]string{
+		"t1": "CREATE TABLE `t1` (\n  `id` bigint(20) NOT NULL AUTO_INCREMENT,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB AUTO_INCREMENT=1234 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin",
+		"t2": "CREATE TABLE `t2` (\n  `id` bigint(20) NOT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=latin1 ROW_FORMAT=COMPRESSED",
+		"v1": "CREATE ALGORITHM=UNDEFINED DEFINER=`vt_dba`@`localhost` SQL SECURITY DEFINER VIEW `vt_ks`.`v1` AS select `vt_ks`.`t1`.`id` AS `id` from `vt_ks`.`t1`",
+	}
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+
+	got, err := agent.GetCreateStatements(ctx, nil, nil, true)
+	if err != nil {
+		t.Fatalf("GetCreateStatements failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, mysqlDaemon.CreateStatements) {
+		t.Errorf("GetCreateStatements = %v, want %v", got, mysqlDaemon.CreateStatements)
+	}
+
+	got, err = agent.GetCreateStatements(ctx, []string{"t1", "v1"}, nil, false)
+	if err != nil {
+		t.Fatalf("GetCreateStatements failed: %v", err)
+	}
+	want := map[string]string{
+		"t1": mysqlDaemon.CreateStatements["t1"],
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetCreateStatements(t1, v1) without views = %v, want %v", got, want)
+	}
+}
+
+func TestGetSchemaTimestamps(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+
+	// setCreateTimes has the fake mysql return the given create time
+	// for t1 and t2. t3 has none.
+	setCreateTimes := func(t1, t2 string) {
+		row := func(table, createTime string) []sqltypes.Value {
+			if createTime == "" {
+				return []sqltypes.Value{sqltypes.MakeString([]byte(table)), sqltypes.NULL}
+			}
+			return []sqltypes.Value{sqltypes.MakeString([]byte(table)), sqltypes.MakeTrusted(sqltypes.Int64, []byte(createTime))}
+		}
+		mysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+			"SELECT table_name, UNIX_TIMESTAMP(create_time) FROM information_schema.tables WHERE table_schema = 'vt_ks' AND table_type = 'BASE TABLE'": {
+				Fields: []*querypb.Field{
+					{Name: "table_name", Type: sqltypes.VarChar},
+					{Name: "UNIX_TIMESTAMP(create_time)", Type: sqltypes.Int64},
+				},
+				Rows: [][]sqltypes.Value{
+					row("t1", t1),
+					row("t2", t2),
+					row("t3", ""),
+				},
+			},
+		}
+	}
+
+	setCreateTimes("1490000000", "1490000100")
+	got, err := agent.GetSchemaTimestamps(ctx, nil)
+	if err != nil {
+		t.Fatalf("GetSchemaTimestamps failed: %v", err)
+	}
+	want := map[string]time.Time{
+		"t1": time.Unix(1490000000, 0),
+		"t2": time.Unix(1490000100, 0),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetSchemaTimestamps = %v, want %v", got, want)
+	}
+
+	// An ALTER TABLE rebuilding t1 resets its create time.
+	setCreateTimes("1490005000", "1490000100")
+	got, err = agent.GetSchemaTimestamps(ctx, []string{"t1"})
+	if err != nil {
+		t.Fatalf("GetSchemaTimestamps failed: %v", err)
+	}
+	want = map[string]time.Time{
+		"t1": time.Unix(1490005000, 0),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetSchemaTimestamps(t1) after ALTER = %v, want %v", got, want)
+	}
+}
+
+func TestAssessSchemaChange(t *testing.T) {
+	ctx := context.Background()
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqlDaemon.Schema = &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: "CREATE DATABASE {{.DatabaseName}}",
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:   "t1",
+				Schema: "CREATE TABLE `t1` (\n  `id` bigint(20) NOT NULL,\n  `msg` varchar(64) DEFAULT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8",
+				Type:   "BASE TABLE",
+			},
+		},
+	}
+	// The table takes 100MB, copied in 2s at the default rate.
+	mysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SELECT data_length + index_length FROM information_schema.tables WHERE table_schema = 'vt_ks' AND table_name = 't1'": {
+			Fields: []*querypb.Field{{Name: "size", Type: sqltypes.Int64}},
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeTrusted(sqltypes.Int64, []byte("104857600"))},
+			},
+		},
+	}
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+
+	testcases := []struct {
+		change string
+		want   *tabletmanagerdatapb.SchemaChangeAssessment
+	}{{
+		change: "ALTER TABLE t1 ALTER COLUMN msg SET DEFAULT 'hello'",
+		want: &tabletmanagerdatapb.SchemaChangeAssessment{
+			Table:          "t1",
+			Algorithm:      "INSTANT",
+			TableSizeBytes: 104857600,
+		},
+	}, {
+		change: "ALTER TABLE `vt_ks`.`t1` ADD INDEX msg_idx (msg)",
+		want: &tabletmanagerdatapb.SchemaChangeAssessment{
+			Table:          "t1",
+			Algorithm:      "INPLACE",
+			TableSizeBytes: 104857600,
+		},
+	}, {
+		change: "ALTER TABLE t1 MODIFY msg text",
+		want: &tabletmanagerdatapb.SchemaChangeAssessment{
+			Table:           "t1",
+			Algorithm:       "COPY",
+			TableSizeBytes:  104857600,
+			EstimatedLockNs: int64(2 * time.Second),
+		},
+	}}
+	for _, tcase := range testcases {
+		got, err := agent.AssessSchemaChange(ctx, tcase.change)
+		if err != nil {
+			t.Errorf("AssessSchemaChange(%v) failed: %v", tcase.change, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("AssessSchemaChange(%v) = %v, want %v", tcase.change, got, tcase.want)
+		}
+	}
+
+	if _, err := agent.AssessSchemaChange(ctx, "ALTER TABLE t2 ADD COLUMN c int"); err == nil {
+		t.Errorf("AssessSchemaChange on a missing table worked")
+	}
+	if _, err := agent.AssessSchemaChange(ctx, "DROP TABLE t1"); err == nil {
+		t.Errorf("AssessSchemaChange of a DROP TABLE worked")
+	}
+}
@@ -72,6 +72,25 @@ func (agent *ActionAgent) HandleRPCPanic(ctx context.Context, name string, args,
 	}
 }
 
+// TrackRPC is part of the RPCAgent interface.
+func (agent *ActionAgent) TrackRPC(name string) func() {
+	agent.inFlightMutex.Lock()
+	if agent._inFlightRPCs == nil {
+		agent._inFlightRPCs = make(map[string]int64)
+	}
+	agent._inFlightRPCs[name]++
+	agent.inFlightMutex.Unlock()
+
+	return func() {
+		agent.inFlightMutex.Lock()
+		defer agent.inFlightMutex.Unlock()
+		agent._inFlightRPCs[name]--
+		if agent._inFlightRPCs[name] == 0 {
+			delete(agent._inFlightRPCs, name)
+		}
+	}
+}
+
 //
 // RegisterQueryService is used to delay registration of RPC servers until we have all the objects.
 type RegisterQueryService func(*ActionAgent)
@@ -0,0 +1,50 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/topotools"
+	"github.com/youtube/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "github.com/youtube/vitess/go/vt/proto/vschema"
+)
+
+// GetVSchema returns the VSchema of the tablet keyspace, as stored
+// in the topology.
+func (agent *ActionAgent) GetVSchema(ctx context.Context) (*vschemapb.Keyspace, error) {
+	return agent.TopoServer.GetVSchema(ctx, agent.Tablet().Keyspace)
+}
+
+// ApplyVSchema parses and validates a JSON encoded VSchema, saves it
+// as the VSchema of the tablet keyspace, and rebuilds the SrvVSchema
+// of the tablet cell so query routing in that cell picks it up.
+// It is meant for local testing: the change is visible to every
+// tablet and vtgate of the keyspace in that cell.
+func (agent *ActionAgent) ApplyVSchema(ctx context.Context, vschemaJSON string) error {
+	vschema := &vschemapb.Keyspace{}
+	if err := json.Unmarshal([]byte(vschemaJSON), vschema); err != nil {
+		return fmt.Errorf("cannot parse vschema: %v", err)
+	}
+	if err := vindexes.ValidateKeyspace(vschema); err != nil {
+		return fmt.Errorf("invalid vschema: %v", err)
+	}
+
+	tablet := agent.Tablet()
+	if err := agent.TopoServer.SaveVSchema(ctx, tablet.Keyspace, vschema); err != nil {
+		return fmt.Errorf("SaveVSchema failed: %v", err)
+	}
+	if err := topotools.RebuildVSchema(ctx, logutil.NewConsoleLogger(), agent.TopoServer, []string{tablet.Alias.Cell}); err != nil {
+		return fmt.Errorf("RebuildVSchema failed: %v", err)
+	}
+	log.Infof("ApplyVSchema: keyspace %v now uses vschema %v", tablet.Keyspace, vschema)
+	return nil
+}
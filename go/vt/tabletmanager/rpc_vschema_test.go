@@ -0,0 +1,122 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vschemapb "github.com/youtube/vitess/go/vt/proto/vschema"
+)
+
+func TestVSchema(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	topoVSchema := &vschemapb.Keyspace{
+		Tables: map[string]*vschemapb.Table{
+			"t1": {},
+		},
+	}
+	if err := ts.SaveVSchema(ctx, "ks", topoVSchema); err != nil {
+		t.Fatalf("SaveVSchema failed: %v", err)
+	}
+	agent := &ActionAgent{
+		TopoServer: ts,
+		_tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  1,
+			},
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+
+	// GetVSchema returns the topology VSchema.
+	got, err := agent.GetVSchema(ctx)
+	if err != nil {
+		t.Fatalf("GetVSchema failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, topoVSchema) {
+		t.Errorf("GetVSchema() = %v, want %v", got, topoVSchema)
+	}
+
+	// An applied VSchema is saved in the topology, and in the
+	// SrvVSchema of the tablet cell.
+	if err := agent.ApplyVSchema(ctx, `{
+  "sharded": true,
+  "vindexes": {
+    "hash": {"type": "hash"}
+  },
+  "tables": {
+    "t2": {"column_vindexes": [{"column": "id", "name": "hash"}]}
+  }
+}`); err != nil {
+		t.Fatalf("ApplyVSchema failed: %v", err)
+	}
+	want := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t2": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{
+					{Column: "id", Name: "hash"},
+				},
+			},
+		},
+	}
+	got, err = agent.GetVSchema(ctx)
+	if err != nil {
+		t.Fatalf("GetVSchema failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetVSchema() after ApplyVSchema = %v, want %v", got, want)
+	}
+	srvVSchema, err := ts.GetSrvVSchema(ctx, "cell1")
+	if err != nil {
+		t.Fatalf("GetSrvVSchema failed: %v", err)
+	}
+	if got := srvVSchema.Keyspaces["ks"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("SrvVSchema keyspace ks after ApplyVSchema = %v, want %v", got, want)
+	}
+
+	// Invalid VSchemas are rejected, and the previous one is kept.
+	for _, tc := range []struct {
+		vschema string
+		err     string
+	}{
+		{
+			vschema: `{"sharded": tru`,
+			err:     "cannot parse vschema",
+		},
+		{
+			vschema: `{"tables": {"t1": {"column_vindexes": [{"column": "id", "name": "missing"}]}}}`,
+			err:     "invalid vschema",
+		},
+	} {
+		err := agent.ApplyVSchema(ctx, tc.vschema)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("ApplyVSchema(%v) returned %v, want an error containing %q", tc.vschema, err, tc.err)
+		}
+	}
+	got, err = agent.GetVSchema(ctx)
+	if err != nil {
+		t.Fatalf("GetVSchema failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetVSchema() after invalid ApplyVSchema = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/sqlparser"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+const (
+	// bufferPoolPagesQuery returns the InnoDB buffer pool page counts.
+	bufferPoolPagesQuery = "SHOW GLOBAL STATUS LIKE 'Innodb_buffer_pool_pages_%'"
+	// bufferPoolLoadStatusQuery returns the progress of a buffer pool load.
+	bufferPoolLoadStatusQuery = "SHOW GLOBAL STATUS LIKE 'Innodb_buffer_pool_load_status'"
+	// bufferPoolLoadQuery starts loading the buffer pool from its last dump.
+	bufferPoolLoadQuery = "SET GLOBAL innodb_buffer_pool_load_now = ON"
+
+	// warmUpStreamBufferSize is the size of the result chunks read
+	// from MySQL by warm up queries.
+	warmUpStreamBufferSize = 32 * 1024
+)
+
+// warmUpPollInterval is how often WarmUp checks the progress of the
+// buffer pool load.
+var warmUpPollInterval = time.Second
+
+// WarmUp runs each query once, discarding the results, then loads the
+// InnoDB buffer pool from its last dump if loadBufferPool is set. It
+// reports its progress after each query and each buffer pool check,
+// and a last time with Done set. Canceling ctx stops it.
+func (agent *ActionAgent) WarmUp(ctx context.Context, queries []string, loadBufferPool bool, progress func(*tabletmanagerdatapb.WarmUpResponse) error) error {
+	report := func(queriesRun int, done bool) error {
+		fill, err := agent.bufferPoolFill(ctx)
+		if err != nil {
+			return err
+		}
+		return progress(&tabletmanagerdatapb.WarmUpResponse{
+			QueriesRun:     int64(queriesRun),
+			QueriesTotal:   int64(len(queries)),
+			BufferPoolFill: fill,
+			Done:           done,
+		})
+	}
+
+	if len(queries) > 0 {
+		conn, err := agent.MysqlDaemon.GetDbaConnection()
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		// The dba connection has no default database, and the
+		// queries are usually not qualified.
+		dbName := topoproto.TabletDbName(agent.Tablet())
+		if _, err := conn.ExecuteFetch("USE "+sqlparser.Backtick(dbName), 1, false); err != nil {
+			return fmt.Errorf("cannot use database %v: %v", dbName, err)
+		}
+		for i, query := range queries {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := conn.ExecuteStreamFetch(query, func(*sqltypes.Result) error { return nil }, warmUpStreamBufferSize); err != nil {
+				return fmt.Errorf("warm up query %v failed: %v", query, err)
+			}
+			if err := report(i+1, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	if loadBufferPool {
+		if err := agent.MysqlDaemon.ExecuteSuperQueryList(ctx, []string{bufferPoolLoadQuery}); err != nil {
+			return fmt.Errorf("cannot start buffer pool load: %v", err)
+		}
+		for {
+			qr, err := agent.MysqlDaemon.FetchSuperQuery(ctx, bufferPoolLoadStatusQuery)
+			if err != nil {
+				return err
+			}
+			if len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+				return fmt.Errorf("unexpected result for %v: %v", bufferPoolLoadStatusQuery, qr.Rows)
+			}
+			status := qr.Rows[0][1].String()
+			if strings.Contains(status, "completed") {
+				break
+			}
+			if strings.Contains(status, "aborted") {
+				return fmt.Errorf("buffer pool load aborted: %v", status)
+			}
+			if err := report(len(queries), false); err != nil {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(warmUpPollInterval):
+			}
+		}
+	}
+
+	log.Infof("WarmUp: ran %v queries, buffer pool loaded: %v", len(queries), loadBufferPool)
+	return report(len(queries), true)
+}
+
+// bufferPoolFill returns the fraction of the InnoDB buffer pool pages
+// that hold data.
+func (agent *ActionAgent) bufferPoolFill(ctx context.Context) (float64, error) {
+	qr, err := agent.MysqlDaemon.FetchSuperQuery(ctx, bufferPoolPagesQuery)
+	if err != nil {
+		return 0, err
+	}
+	var data, total float64
+	for _, row := range qr.Rows {
+		if len(row) != 2 {
+			return 0, fmt.Errorf("unexpected result for %v: %v", bufferPoolPagesQuery, qr.Rows)
+		}
+		var value *float64
+		switch strings.ToLower(row[0].String()) {
+		case "innodb_buffer_pool_pages_data":
+			value = &data
+		case "innodb_buffer_pool_pages_total":
+			value = &total
+		default:
+			continue
+		}
+		if *value, err = strconv.ParseFloat(row[1].String(), 64); err != nil {
+			return 0, fmt.Errorf("invalid value for %v: %v", row[0].String(), err)
+		}
+	}
+	if total == 0 {
+		return 0, nil
+	}
+	return data / total, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/vttest/fakesqldb"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// statusResult returns a SHOW STATUS result with the given
+// variable name and value pairs.
+func statusResult(pairs ...string) *sqltypes.Result {
+	qr := &sqltypes.Result{}
+	for i := 0; i < len(pairs); i += 2 {
+		qr.Rows = append(qr.Rows, []sqltypes.Value{
+			sqltypes.MakeTrusted(sqltypes.VarChar, []byte(pairs[i])),
+			sqltypes.MakeTrusted(sqltypes.VarChar, []byte(pairs[i+1])),
+		})
+	}
+	return qr
+}
+
+func TestWarmUp(t *testing.T) {
+	defer func(d time.Duration) { warmUpPollInterval = d }(warmUpPollInterval)
+	warmUpPollInterval = time.Millisecond
+
+	db := fakesqldb.Register()
+	db.AddQuery("USE `vt_ks`", &sqltypes.Result{})
+	db.AddQuery("SELECT * FROM t1", &sqltypes.Result{})
+	db.AddQuery("SELECT * FROM t2", &sqltypes.Result{})
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(db)
+	mysqlDaemon.ExpectedExecuteSuperQueryList = []string{bufferPoolLoadQuery}
+	mysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		bufferPoolPagesQuery: statusResult(
+			"Innodb_buffer_pool_pages_data", "250",
+			"Innodb_buffer_pool_pages_free", "750",
+			"Innodb_buffer_pool_pages_total", "1000",
+		),
+		bufferPoolLoadStatusQuery: statusResult("Innodb_buffer_pool_load_status", "Loaded 250/1000 pages"),
+	}
+	agent := &ActionAgent{
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+
+	var got []*tabletmanagerdatapb.WarmUpResponse
+	err := agent.WarmUp(context.Background(), []string{"SELECT * FROM t1", "SELECT * FROM t2"}, true, func(r *tabletmanagerdatapb.WarmUpResponse) error {
+		got = append(got, r)
+		if len(got) == 3 {
+			// The first buffer pool check was reported,
+			// let the load complete.
+			mysqlDaemon.FetchSuperQueryMap[bufferPoolPagesQuery] = statusResult(
+				"Innodb_buffer_pool_pages_data", "1000",
+				"Innodb_buffer_pool_pages_total", "1000",
+			)
+			mysqlDaemon.FetchSuperQueryMap[bufferPoolLoadStatusQuery] = statusResult("Innodb_buffer_pool_load_status", "Buffer pool(s) load completed at 170102  3:04:05")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WarmUp failed: %v", err)
+	}
+	if err := mysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("buffer pool load was not started: %v", err)
+	}
+	if got := db.GetQueryCalledNum("USE `vt_ks`"); got != 1 {
+		t.Errorf("tablet database was selected %v times, want 1", got)
+	}
+
+	want := []*tabletmanagerdatapb.WarmUpResponse{
+		{QueriesRun: 1, QueriesTotal: 2, BufferPoolFill: 0.25},
+		{QueriesRun: 2, QueriesTotal: 2, BufferPoolFill: 0.25},
+		{QueriesRun: 2, QueriesTotal: 2, BufferPoolFill: 0.25},
+		{QueriesRun: 2, QueriesTotal: 2, BufferPoolFill: 1, Done: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got progress %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"sort"
+	"sync"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/tabletserver"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// This file contains the WatchSchema RPC. The query service notifies
+// the agent every time a table of its schema cache is created, changed
+// or dropped, whatever triggered it. The definition of the table is
+// then read from mysql, and published if it is not the last one sent.
+
+// schemaWatcher queues the schema changes of a WatchSchema call,
+// so a slow caller doesn't block the query service, nor lose changes.
+type schemaWatcher struct {
+	mu     sync.Mutex
+	events []*tabletmanagerdatapb.SchemaChangeEvent
+
+	// wakeup is signaled when events are queued.
+	wakeup chan struct{}
+}
+
+func (w *schemaWatcher) queue(events []*tabletmanagerdatapb.SchemaChangeEvent) {
+	w.mu.Lock()
+	w.events = append(w.events, events...)
+	w.mu.Unlock()
+
+	select {
+	case w.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+func (w *schemaWatcher) dequeue() []*tabletmanagerdatapb.SchemaChangeEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	events := w.events
+	w.events = nil
+	return events
+}
+
+// WatchSchema sends the changes to the schema of the tablet, until
+// the context is canceled or send fails.
+func (agent *ActionAgent) WatchSchema(ctx context.Context, send func(*tabletmanagerdatapb.SchemaChangeEvent) error) error {
+	w, err := agent.addSchemaWatcher()
+	if err != nil {
+		return err
+	}
+	defer agent.removeSchemaWatcher(w)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.wakeup:
+		}
+		for _, event := range w.dequeue() {
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (agent *ActionAgent) addSchemaWatcher() (*schemaWatcher, error) {
+	// The notifier is called right away, so this must be done
+	// before schemaWatchMutex is taken.
+	agent.schemaNotifierOnce.Do(func() {
+		agent.QueryServiceControl.RegisterSchemaNotifier("WatchSchema", agent.schemaChanged)
+	})
+
+	agent.schemaWatchMutex.Lock()
+	defer agent.schemaWatchMutex.Unlock()
+
+	if len(agent._schemaWatchers) == 0 {
+		snapshot, err := agent.schemaSnapshot()
+		if err != nil {
+			return nil, err
+		}
+		agent._schemaSnapshot = snapshot
+		agent._schemaWatchers = make(map[*schemaWatcher]bool)
+	}
+	w := &schemaWatcher{
+		wakeup: make(chan struct{}, 1),
+	}
+	agent._schemaWatchers[w] = true
+	return w, nil
+}
+
+func (agent *ActionAgent) removeSchemaWatcher(w *schemaWatcher) {
+	agent.schemaWatchMutex.Lock()
+	defer agent.schemaWatchMutex.Unlock()
+
+	delete(agent._schemaWatchers, w)
+	if len(agent._schemaWatchers) == 0 {
+		agent._schemaSnapshot = nil
+	}
+}
+
+// schemaSnapshot returns the definition of each table and view of
+// the tablet, by name.
+func (agent *ActionAgent) schemaSnapshot() (map[string]*tabletmanagerdatapb.TableDefinition, error) {
+	sd, err := agent.MysqlDaemon.GetSchema(topoproto.TabletDbName(agent.Tablet()), nil, nil, true /*includeViews*/)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := make(map[string]*tabletmanagerdatapb.TableDefinition, len(sd.TableDefinitions))
+	for _, td := range sd.TableDefinitions {
+		snapshot[td.Name] = td
+	}
+	return snapshot, nil
+}
+
+// tableDefinition returns the definition of a table or view of the
+// tablet, or nil if there is no such table in mysql.
+func (agent *ActionAgent) tableDefinition(name string) (*tabletmanagerdatapb.TableDefinition, error) {
+	sd, err := agent.MysqlDaemon.GetSchema(topoproto.TabletDbName(agent.Tablet()), []string{name}, nil, true /*includeViews*/)
+	if err != nil {
+		return nil, err
+	}
+	for _, td := range sd.TableDefinitions {
+		if td.Name == name {
+			return td, nil
+		}
+	}
+	return nil, nil
+}
+
+// schemaChanged is the schema notifier of the query service. It sends
+// the tables that changed since its last call to the WatchSchema calls.
+// The query service calls it once per table created, changed or
+// dropped, so the changes are sent in the order they were seen.
+func (agent *ActionAgent) schemaChanged(tables map[string]*tabletserver.TableInfo) {
+	agent.schemaWatchMutex.Lock()
+	defer agent.schemaWatchMutex.Unlock()
+
+	if tables == nil {
+		// The query service is closed. The last tables are kept,
+		// to be compared with the ones it has once reopened.
+		return
+	}
+	previous := agent._schemaTables
+	agent._schemaTables = make(map[string]*tabletserver.TableInfo, len(tables))
+	for name, ti := range tables {
+		agent._schemaTables[name] = ti
+	}
+	if len(agent._schemaWatchers) == 0 {
+		return
+	}
+
+	// The TableInfo of a table is replaced when it changes. There is
+	// usually a single name, but all the tables look changed after
+	// the query service was reopened: their definition is then only
+	// sent if it differs from the last one sent.
+	var names []string
+	for name, ti := range tables {
+		if previous[name] != ti {
+			names = append(names, name)
+		}
+	}
+	for name := range previous {
+		if _, ok := tables[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var events []*tabletmanagerdatapb.SchemaChangeEvent
+	for _, name := range names {
+		if _, ok := tables[name]; !ok {
+			if _, ok := agent._schemaSnapshot[name]; ok {
+				delete(agent._schemaSnapshot, name)
+				events = append(events, &tabletmanagerdatapb.SchemaChangeEvent{
+					Name: name,
+				})
+			}
+			continue
+		}
+		td, err := agent.tableDefinition(name)
+		if err != nil {
+			log.Warningf("cannot get definition of %v for WatchSchema: %v", name, err)
+			continue
+		}
+		if td == nil {
+			// Not a table of mysql, like dual.
+			continue
+		}
+		if old, ok := agent._schemaSnapshot[name]; ok && old.Schema == td.Schema {
+			continue
+		}
+		agent._schemaSnapshot[name] = td
+		events = append(events, &tabletmanagerdatapb.SchemaChangeEvent{
+			Name:       name,
+			Definition: td,
+		})
+	}
+
+	if len(events) == 0 {
+		return
+	}
+	for w := range agent._schemaWatchers {
+		w.queue(events)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/tabletserver"
+	"github.com/youtube/vitess/go/vt/tabletserver/tabletservermock"
+	"github.com/youtube/vitess/go/vt/vttest/fakesqldb"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// waitForSchemaWatchers waits until the agent has n WatchSchema calls.
+func waitForSchemaWatchers(t *testing.T, agent *ActionAgent, n int) {
+	for i := 0; i < 100; i++ {
+		agent.schemaWatchMutex.Lock()
+		got := len(agent._schemaWatchers)
+		agent.schemaWatchMutex.Unlock()
+		if got == n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %v schema watchers", n)
+}
+
+func TestWatchSchema(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t1 := &tabletmanagerdatapb.TableDefinition{Name: "t1", Schema: "CREATE TABLE t1 (id bigint)", Type: "BASE TABLE"}
+	t1Altered := &tabletmanagerdatapb.TableDefinition{Name: "t1", Schema: "CREATE TABLE t1 (id bigint, msg varchar(64))", Type: "BASE TABLE"}
+	t2 := &tabletmanagerdatapb.TableDefinition{Name: "t2", Schema: "CREATE TABLE t2 (id bigint)", Type: "BASE TABLE"}
+	t2Altered := &tabletmanagerdatapb.TableDefinition{Name: "t2", Schema: "CREATE TABLE t2 (id bigint, msg varchar(64))", Type: "BASE TABLE"}
+
+	mysqlDaemon := mysqlctl.NewFakeMysqlDaemon(fakesqldb.Register())
+	setSchema := func(tds ...*tabletmanagerdatapb.TableDefinition) {
+		mysqlDaemon.Schema = &tabletmanagerdatapb.SchemaDefinition{
+			TableDefinitions: tds,
+		}
+	}
+	setSchema(t1)
+	qsc := tabletservermock.NewController()
+	qsc.NotifySchema(map[string]*tabletserver.TableInfo{
+		"dual": {},
+		"t1":   {},
+	})
+	agent := &ActionAgent{
+		MysqlDaemon:         mysqlDaemon,
+		QueryServiceControl: qsc,
+		_tablet: &topodatapb.Tablet{
+			Keyspace: "ks",
+			Shard:    "0",
+		},
+	}
+
+	events := make(chan *tabletmanagerdatapb.SchemaChangeEvent, 10)
+	done := make(chan error)
+	go func() {
+		done <- agent.WatchSchema(ctx, func(event *tabletmanagerdatapb.SchemaChangeEvent) error {
+			events <- event
+			return nil
+		})
+	}()
+	waitForSchemaWatchers(t, agent, 1)
+
+	// Change the schema several times before the first event is
+	// read, the query service notifying each change. The TableInfo
+	// of a table is replaced when it changes.
+	dual := &tabletserver.TableInfo{}
+	t2Info := &tabletserver.TableInfo{}
+	setSchema(t1Altered)
+	qsc.NotifySchema(map[string]*tabletserver.TableInfo{
+		"dual": dual,
+		"t1":   {},
+	})
+	setSchema(t1Altered, t2)
+	qsc.NotifySchema(map[string]*tabletserver.TableInfo{
+		"dual": dual,
+		"t1":   {},
+		"t2":   t2Info,
+	})
+	setSchema(t2)
+	qsc.NotifySchema(map[string]*tabletserver.TableInfo{
+		"dual": dual,
+		"t2":   t2Info,
+	})
+
+	// Reopening the query service replaces all the TableInfo, but
+	// only the tables that really changed are sent.
+	qsc.NotifySchema(nil)
+	setSchema(t2Altered)
+	qsc.NotifySchema(map[string]*tabletserver.TableInfo{
+		"dual": {},
+		"t2":   {},
+	})
+
+	want := []*tabletmanagerdatapb.SchemaChangeEvent{
+		{Name: "t1", Definition: t1Altered},
+		{Name: "t2", Definition: t2},
+		{Name: "t1"},
+		{Name: "t2", Definition: t2Altered},
+	}
+	for i, w := range want {
+		select {
+		case got := <-events:
+			if !reflect.DeepEqual(got, w) {
+				t.Errorf("event %v = %v, want %v", i, got, w)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for event %v", i)
+		}
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("WatchSchema failed: %v", err)
+	}
+	select {
+	case got := <-events:
+		t.Errorf("unexpected event %v", got)
+	default:
+	}
+	if agent._schemaSnapshot != nil {
+		t.Errorf("schema snapshot was kept after the last watcher left")
+	}
+}
@@ -42,6 +42,12 @@ var (
 // Query rules from blacklist
 const blacklistQueryRules string = "BlacklistQueryRules"
 
+// Query rule from the query blacklist set by SetQueryBlacklist
+const queryBlacklistQueryRules string = "QueryBlacklistQueryRules"
+
+// Query rule from the keyrange set by SetServingKeyRange
+const servingKeyRangeQueryRules string = "ServingKeyRangeQueryRules"
+
 // loadBlacklistRules loads and builds the blacklist query rules
 func (agent *ActionAgent) loadBlacklistRules(tablet *topodatapb.Tablet, blacklistedTables []string) (err error) {
 	blacklistRules := tabletserver.NewQueryRules()
@@ -87,6 +93,10 @@ func (agent *ActionAgent) broadcastHealth() {
 	agent.mutex.Lock()
 	replicationDelay := agent._replicationDelay
 	healthError := agent._healthy
+	if agent.healthReportingPausedLocked() {
+		replicationDelay = agent._pausedReplicationDelay
+		healthError = agent._pausedHealthy
+	}
 	terTime := agent._tabletExternallyReparentedTime
 	agent.mutex.Unlock()
 
@@ -164,6 +174,8 @@ func (agent *ActionAgent) updateState(ctx context.Context, newTablet *topodatapb
 //
 // It owns updating the blacklisted tables.
 //
+// It owns updating the serving keyrange rules for the new tablet type.
+//
 // It owns updating the stats record for 'TabletType'.
 //
 // It owns starting and stopping the update stream service.
@@ -219,6 +231,12 @@ func (agent *ActionAgent) changeCallback(ctx context.Context, oldTablet, newTabl
 			agent.setBlacklistedTables(blacklistedTables)
 		}
 	}
+	agent.mutex.Lock()
+	keyRange, servedTypes := agent._servingKeyRange, agent._servingKeyRangeTypes
+	agent.mutex.Unlock()
+	if err := agent.loadServingKeyRangeRules(newTablet.Type, keyRange, servedTypes); err != nil {
+		log.Errorf("Cannot update serving keyrange rule: %v", err)
+	}
 
 	if allowQuery {
 		// Query service should be running.
@@ -0,0 +1,109 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// throttleMetricReplicationLag is the metric heavy operations are
+// throttled on.
+const throttleMetricReplicationLag = "replication_lag_seconds"
+
+var throttleThreshold = flag.Duration("throttle_replication_lag_threshold", 30*time.Second, "replication lag above which the heavy operations run while the tablet serves, IncrementalBackup and TestRestore, are refused")
+
+// GetThrottleState returns whether the heavy operations of the tablet
+// are throttled: as forced by SetThrottle, or else based on the
+// replication lag seen by the last health check. It has no side
+// effects. The heavy operations are the ones that run while the
+// tablet serves, IncrementalBackup and TestRestore: they are refused
+// while throttled. Backup takes the tablet out of serving, and
+// filtered replication runs on masters, so they are not throttled.
+func (agent *ActionAgent) GetThrottleState(ctx context.Context) (*tabletmanagerdatapb.ThrottleState, error) {
+	replicationDelay, _ := agent.Healthy()
+	mode, until := agent.throttleMode()
+	state := &tabletmanagerdatapb.ThrottleState{
+		Metric:    throttleMetricReplicationLag,
+		Value:     int64(replicationDelay.Seconds()),
+		Threshold: int64(throttleThreshold.Seconds()),
+		Mode:      mode,
+	}
+	switch mode {
+	case tmclient.ThrottleModeForceOn:
+		state.Throttled = true
+		state.Reason = fmt.Sprintf("throttling forced on until %v", until)
+		state.ModeExpireTimeNs = until.UnixNano()
+	case tmclient.ThrottleModeForceOff:
+		state.ModeExpireTimeNs = until.UnixNano()
+	default:
+		if replicationDelay > *throttleThreshold {
+			state.Throttled = true
+			state.Reason = fmt.Sprintf("replication lag %v is above the threshold of %v", replicationDelay, *throttleThreshold)
+		}
+	}
+	return state, nil
+}
+
+// checkThrottle returns an error saying why, if heavy operations are
+// throttled, so they are not started.
+func (agent *ActionAgent) checkThrottle(ctx context.Context, operation string) error {
+	state, err := agent.GetThrottleState(ctx)
+	if err != nil {
+		return err
+	}
+	if state.Throttled {
+		return fmt.Errorf("%v is throttled: %v", operation, state.Reason)
+	}
+	return nil
+}
+
+// SetThrottle forces heavy operations to be throttled or not, for
+// duration, after which the tablet goes back to throttling them on
+// replication lag. ThrottleModeAuto goes back to it right away. While
+// forced on, IncrementalBackup and TestRestore are refused whatever
+// the lag. While forced off, they run whatever the lag.
+func (agent *ActionAgent) SetThrottle(ctx context.Context, mode string, duration time.Duration) error {
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+
+	switch mode {
+	case tmclient.ThrottleModeAuto:
+		agent._throttleMode = ""
+		agent._throttleModeUntil = time.Time{}
+	case tmclient.ThrottleModeForceOn, tmclient.ThrottleModeForceOff:
+		if duration <= 0 {
+			return fmt.Errorf("throttle mode %v requires a positive duration, not %v", mode, duration)
+		}
+		agent._throttleMode = mode
+		agent._throttleModeUntil = time.Now().Add(duration)
+	default:
+		return fmt.Errorf("unknown throttle mode %q, must be one of %v, %v or %v", mode, tmclient.ThrottleModeAuto, tmclient.ThrottleModeForceOn, tmclient.ThrottleModeForceOff)
+	}
+	return nil
+}
+
+// throttleMode returns the throttle mode, and when a forced mode goes
+// back to ThrottleModeAuto. A forced mode that expired is cleared.
+func (agent *ActionAgent) throttleMode() (string, time.Time) {
+	agent.mutex.Lock()
+	defer agent.mutex.Unlock()
+
+	if agent._throttleMode != "" && !time.Now().Before(agent._throttleModeUntil) {
+		agent._throttleMode = ""
+		agent._throttleModeUntil = time.Time{}
+	}
+	if agent._throttleMode == "" {
+		return tmclient.ThrottleModeAuto, time.Time{}
+	}
+	return agent._throttleMode, agent._throttleModeUntil
+}
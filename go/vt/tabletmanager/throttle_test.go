@@ -0,0 +1,162 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+)
+
+// TestGetThrottleState verifies heavy operations are throttled when the
+// replication lag goes above the threshold, and the state says why.
+func TestGetThrottleState(t *testing.T) {
+	oldThreshold := *throttleThreshold
+	defer func() { *throttleThreshold = oldThreshold }()
+	*throttleThreshold = 30 * time.Second
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+
+	// Below the threshold, nothing is throttled.
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 10 * time.Second
+	agent.runHealthCheck()
+	state, err := agent.GetThrottleState(ctx)
+	if err != nil {
+		t.Fatalf("GetThrottleState failed: %v", err)
+	}
+	if state.Throttled || state.Reason != "" || state.Value != 10 || state.Threshold != 30 {
+		t.Errorf("GetThrottleState() with 10s lag = %v, want not throttled, with a value of 10 and a threshold of 30", state)
+	}
+
+	// Above it, the reason has the lag and the threshold.
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 45 * time.Second
+	agent.runHealthCheck()
+	state, err = agent.GetThrottleState(ctx)
+	if err != nil {
+		t.Fatalf("GetThrottleState failed: %v", err)
+	}
+	if !state.Throttled || state.Metric != throttleMetricReplicationLag || state.Value != 45 || state.Threshold != 30 {
+		t.Errorf("GetThrottleState() with 45s lag = %v, want throttled on %v, with a value of 45 and a threshold of 30", state, throttleMetricReplicationLag)
+	}
+	if !strings.Contains(state.Reason, "45s") || !strings.Contains(state.Reason, "30s") {
+		t.Errorf("GetThrottleState() reason is %q, want the lag and the threshold", state.Reason)
+	}
+}
+
+// TestSetThrottle verifies forcing throttling on or off overrides the
+// replication lag, until the override times out.
+func TestSetThrottle(t *testing.T) {
+	oldThreshold := *throttleThreshold
+	defer func() { *throttleThreshold = oldThreshold }()
+	*throttleThreshold = 30 * time.Second
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+
+	expectThrottled := func(name string, throttled bool, mode string) {
+		state, err := agent.GetThrottleState(ctx)
+		if err != nil {
+			t.Fatalf("GetThrottleState failed: %v", err)
+		}
+		if state.Throttled != throttled || state.Mode != mode {
+			t.Errorf("GetThrottleState() %v = %v, want throttled %v in mode %v", name, state, throttled, mode)
+		}
+		if (mode == tmclient.ThrottleModeAuto) != (state.ModeExpireTimeNs == 0) {
+			t.Errorf("GetThrottleState() %v = %v, want an expire time only for a forced mode", name, state)
+		}
+	}
+
+	// An override needs a duration, so it cannot be forgotten.
+	if err := agent.SetThrottle(ctx, tmclient.ThrottleModeForceOn, 0); err == nil {
+		t.Errorf("SetThrottle(%v, 0) worked, want an error", tmclient.ThrottleModeForceOn)
+	}
+	if err := agent.SetThrottle(ctx, "sometimes", time.Hour); err == nil {
+		t.Errorf("SetThrottle with an unknown mode worked, want an error")
+	}
+
+	// Forcing throttling on with no lag.
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 0
+	agent.runHealthCheck()
+	expectThrottled("with no lag", false, tmclient.ThrottleModeAuto)
+	if err := agent.SetThrottle(ctx, tmclient.ThrottleModeForceOn, time.Hour); err != nil {
+		t.Fatalf("SetThrottle failed: %v", err)
+	}
+	expectThrottled("forced on", true, tmclient.ThrottleModeForceOn)
+
+	// Forcing it off with a lot of lag.
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = time.Minute
+	agent.runHealthCheck()
+	if err := agent.SetThrottle(ctx, tmclient.ThrottleModeForceOff, time.Hour); err != nil {
+		t.Fatalf("SetThrottle failed: %v", err)
+	}
+	expectThrottled("forced off", false, tmclient.ThrottleModeForceOff)
+
+	// Going back to auto explicitly.
+	if err := agent.SetThrottle(ctx, tmclient.ThrottleModeAuto, 0); err != nil {
+		t.Fatalf("SetThrottle failed: %v", err)
+	}
+	expectThrottled("back to auto", true, tmclient.ThrottleModeAuto)
+
+	// And on its own, after the duration.
+	if err := agent.SetThrottle(ctx, tmclient.ThrottleModeForceOff, 10*time.Millisecond); err != nil {
+		t.Fatalf("SetThrottle failed: %v", err)
+	}
+	expectThrottled("forced off again", false, tmclient.ThrottleModeForceOff)
+	time.Sleep(20 * time.Millisecond)
+	expectThrottled("after the override timed out", true, tmclient.ThrottleModeAuto)
+}
+
+// TestThrottledOperations verifies the heavy operations are refused
+// while throttled.
+func TestThrottledOperations(t *testing.T) {
+	oldThreshold := *throttleThreshold
+	defer func() { *throttleThreshold = oldThreshold }()
+	*throttleThreshold = 30 * time.Second
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = time.Minute
+	agent.runHealthCheck()
+
+	if err := agent.IncrementalBackup(ctx, "base", 1, logutil.NewMemoryLogger()); err == nil || !strings.Contains(err.Error(), "IncrementalBackup is throttled: replication lag 1m0s") {
+		t.Errorf("IncrementalBackup with 1m lag = %v, want a throttled error", err)
+	}
+	if err := agent.TestRestore(ctx, "backup", logutil.NewMemoryLogger()); err == nil || !strings.Contains(err.Error(), "TestRestore is throttled: replication lag 1m0s") {
+		t.Errorf("TestRestore with 1m lag = %v, want a throttled error", err)
+	}
+}
+
+// TestSetThrottleOperations verifies a forced mode decides whether the
+// heavy operations run, whatever the replication lag.
+func TestSetThrottleOperations(t *testing.T) {
+	oldThreshold := *throttleThreshold
+	defer func() { *throttleThreshold = oldThreshold }()
+	*throttleThreshold = 30 * time.Second
+	ctx := context.Background()
+	agent, _ := createTestAgent(ctx, t, nil)
+
+	// Forced on with no lag, they are refused.
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 0
+	agent.runHealthCheck()
+	if err := agent.SetThrottle(ctx, tmclient.ThrottleModeForceOn, time.Hour); err != nil {
+		t.Fatalf("SetThrottle failed: %v", err)
+	}
+	if err := agent.TestRestore(ctx, "backup", logutil.NewMemoryLogger()); err == nil || !strings.Contains(err.Error(), "TestRestore is throttled: throttling forced on") {
+		t.Errorf("TestRestore forced on = %v, want a throttled error", err)
+	}
+
+	// Forced off with a lot of lag, they go past the throttle check.
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = time.Minute
+	agent.runHealthCheck()
+	if err := agent.SetThrottle(ctx, tmclient.ThrottleModeForceOff, time.Hour); err != nil {
+		t.Fatalf("SetThrottle failed: %v", err)
+	}
+	if err := agent.checkThrottle(ctx, "TestRestore"); err != nil {
+		t.Errorf("checkThrottle forced off = %v, want nil", err)
+	}
+}
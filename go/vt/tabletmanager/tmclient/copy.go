@@ -0,0 +1,240 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/sqlparser"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// defaultCopyBatchSize is the default for CopyTablesOptions.BatchSize.
+const defaultCopyBatchSize = 1000
+
+// CopyTablesOptions are the options for CopyTables.
+type CopyTablesOptions struct {
+	// BatchSize is the number of rows read from the source, and
+	// inserted on the destination, per query. Defaults to 1000.
+	BatchSize int
+	// DisableBinlogs does not write the changes on the destination
+	// to its binary logs, so they are not replicated further.
+	DisableBinlogs bool
+	// Progress, if set, is called after each copied batch, and once
+	// more with Done set when a table is fully copied.
+	Progress func(CopyTablesProgress)
+}
+
+// CopyTablesProgress reports the progress of CopyTables for a table.
+type CopyTablesProgress struct {
+	// Table is the table being copied.
+	Table string
+	// RowsCopied is the number of rows of Table copied so far.
+	RowsCopied int64
+	// Done is set once all the rows of Table are copied.
+	Done bool
+}
+
+// CopyTables copies the named tables from source to dest: each table
+// is re-created on dest with the source schema, then its rows are
+// copied in batches, in primary key order.
+// To read a consistent snapshot, replication is stopped on source for
+// the duration of the copy, and restarted afterwards if it was running.
+// So source cannot be a master, and all tables need a primary key.
+func CopyTables(ctx context.Context, tmc TabletManagerClient, source, dest *topodatapb.Tablet, tables []string, opts CopyTablesOptions) (err error) {
+	sourceAlias := topoproto.TabletAliasString(source.Alias)
+	if topoproto.TabletAliasEqual(source.Alias, dest.Alias) {
+		return fmt.Errorf("cannot copy tables from tablet %v to itself", sourceAlias)
+	}
+	if source.Type == topodatapb.TabletType_MASTER {
+		return fmt.Errorf("source tablet %v is a master, cannot get a consistent snapshot", sourceAlias)
+	}
+	if len(tables) == 0 {
+		return fmt.Errorf("no table to copy")
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultCopyBatchSize
+	}
+
+	sd, err := tmc.GetSchema(ctx, source, tables, nil, false)
+	if err != nil {
+		return fmt.Errorf("cannot get schema of tables %v on source tablet %v: %v", tables, sourceAlias, err)
+	}
+	tds := make(map[string]*tabletmanagerdatapb.TableDefinition, len(sd.TableDefinitions))
+	for _, td := range sd.TableDefinitions {
+		tds[td.Name] = td
+	}
+	for _, table := range tables {
+		td, ok := tds[table]
+		if !ok {
+			return fmt.Errorf("table %v does not exist on source tablet %v", table, sourceAlias)
+		}
+		if len(td.PrimaryKeyColumns) == 0 {
+			return fmt.Errorf("table %v has no primary key, cannot copy it in a defined order", table)
+		}
+	}
+
+	// Freeze the source data for the duration of the copy.
+	status, err := tmc.SlaveStatus(ctx, source)
+	if err != nil {
+		return fmt.Errorf("cannot get replication status of source tablet %v: %v", sourceAlias, err)
+	}
+	if status.SlaveIoRunning || status.SlaveSqlRunning {
+		if err := tmc.StopSlave(ctx, source); err != nil {
+			return fmt.Errorf("cannot stop replication on source tablet %v: %v", sourceAlias, err)
+		}
+		defer func() {
+			if startErr := tmc.StartSlave(ctx, source); startErr != nil {
+				log.Warningf("cannot restart replication on source tablet %v: %v", sourceAlias, startErr)
+				if err == nil {
+					err = fmt.Errorf("tables were copied, but replication could not be restarted on source tablet %v: %v", sourceAlias, startErr)
+				}
+			}
+		}()
+	}
+
+	for _, table := range tables {
+		if err := copyTable(ctx, tmc, source, dest, tds[table], batchSize, opts); err != nil {
+			return fmt.Errorf("cannot copy table %v from %v to %v: %v", table, sourceAlias, topoproto.TabletAliasString(dest.Alias), err)
+		}
+	}
+	return nil
+}
+
+// copyTable re-creates a table on dest, and copies all its rows from
+// source.
+func copyTable(ctx context.Context, tmc TabletManagerClient, source, dest *topodatapb.Tablet, td *tabletmanagerdatapb.TableDefinition, batchSize int, opts CopyTablesOptions) error {
+	log.Infof("copying table %v from %v to %v", td.Name, topoproto.TabletAliasString(source.Alias), topoproto.TabletAliasString(dest.Alias))
+	for _, query := range []string{
+		"DROP TABLE IF EXISTS " + sqlparser.Backtick(td.Name),
+		td.Schema,
+	} {
+		if _, err := tmc.ExecuteFetchAsDba(ctx, dest, false, []byte(query), 0, opts.DisableBinlogs, true, 0); err != nil {
+			return err
+		}
+	}
+
+	columns := make([]string, len(td.Columns))
+	for i, column := range td.Columns {
+		columns[i] = sqlparser.Backtick(column)
+	}
+	pkColumns := make([]string, len(td.PrimaryKeyColumns))
+	pkIndexes := make([]int, len(td.PrimaryKeyColumns))
+	for i, column := range td.PrimaryKeyColumns {
+		pkColumns[i] = sqlparser.Backtick(column)
+		pkIndexes[i] = -1
+		for j, c := range td.Columns {
+			if c == column {
+				pkIndexes[i] = j
+				break
+			}
+		}
+		if pkIndexes[i] == -1 {
+			return fmt.Errorf("primary key column %v is not a column of table %v", column, td.Name)
+		}
+	}
+	selectHead := fmt.Sprintf("SELECT %v FROM %v", strings.Join(columns, ", "), sqlparser.Backtick(td.Name))
+	selectTail := fmt.Sprintf(" ORDER BY %v LIMIT %v", strings.Join(pkColumns, ", "), batchSize)
+	insertHead := fmt.Sprintf("INSERT INTO %v (%v) VALUES ", sqlparser.Backtick(td.Name), strings.Join(columns, ", "))
+
+	var copied int64
+	var lastPK []sqltypes.Value
+	for {
+		// Each batch starts after the primary key of the last row
+		// of the previous one, so it is a range scan of the
+		// primary key. Replication is stopped on source, so the
+		// batches read a consistent view of the table.
+		query := selectHead
+		if lastPK != nil {
+			query += " WHERE " + afterPrimaryKey(pkColumns, lastPK)
+		}
+		query += selectTail
+		qr, err := tmc.ExecuteFetchAsApp(ctx, source, false, []byte(query), batchSize)
+		if err != nil {
+			return err
+		}
+		result := sqltypes.Proto3ToResult(qr)
+		if len(result.Rows) > 0 {
+			buf := bytes.Buffer{}
+			buf.WriteString(insertHead)
+			for i, row := range result.Rows {
+				if i > 0 {
+					buf.WriteString(", ")
+				}
+				buf.WriteByte('(')
+				for j, value := range row {
+					if j > 0 {
+						buf.WriteString(", ")
+					}
+					value.EncodeSQL(&buf)
+				}
+				buf.WriteByte(')')
+			}
+			if _, err := tmc.ExecuteFetchAsDba(ctx, dest, false, buf.Bytes(), 0, opts.DisableBinlogs, false, 0); err != nil {
+				return err
+			}
+			copied += int64(len(result.Rows))
+			last := result.Rows[len(result.Rows)-1]
+			lastPK = make([]sqltypes.Value, len(pkIndexes))
+			for i, j := range pkIndexes {
+				lastPK[i] = last[j]
+			}
+			if opts.Progress != nil {
+				opts.Progress(CopyTablesProgress{
+					Table:      td.Name,
+					RowsCopied: copied,
+				})
+			}
+		}
+		if len(result.Rows) < batchSize {
+			break
+		}
+	}
+
+	if opts.Progress != nil {
+		opts.Progress(CopyTablesProgress{
+			Table:      td.Name,
+			RowsCopied: copied,
+			Done:       true,
+		})
+	}
+	return nil
+}
+
+// afterPrimaryKey returns the condition selecting the rows whose
+// primary key sorts after values. For a primary key (a, b) it is
+// "a > x OR (a = x AND b > y)", which MySQL reads as a range of the
+// primary key, unlike the equivalent "(a, b) > (x, y)".
+func afterPrimaryKey(columns []string, values []sqltypes.Value) string {
+	buf := bytes.Buffer{}
+	for i := range columns {
+		if i > 0 {
+			buf.WriteString(" OR (")
+		}
+		for j := 0; j < i; j++ {
+			buf.WriteString(columns[j])
+			buf.WriteString(" = ")
+			values[j].EncodeSQL(&buf)
+			buf.WriteString(" AND ")
+		}
+		buf.WriteString(columns[i])
+		buf.WriteString(" > ")
+		values[i].EncodeSQL(&buf)
+		if i > 0 {
+			buf.WriteByte(')')
+		}
+	}
+	return buf.String()
+}
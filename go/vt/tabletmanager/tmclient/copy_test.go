@@ -0,0 +1,201 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// copyFakeClient serves the rows of a single table from the source
+// tablet, and records the queries run on the destination tablet.
+type copyFakeClient struct {
+	fakeClient
+	td   *tabletmanagerdatapb.TableDefinition
+	rows [][]sqltypes.Value
+
+	sourceQueries []string
+	destQueries   []string
+}
+
+func (c *copyFakeClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	c.record("GetSchema", tablet)
+	return &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{c.td},
+	}, nil
+}
+
+func (c *copyFakeClient) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	c.record("SlaveStatus", tablet)
+	return &replicationdatapb.Status{
+		SlaveIoRunning:  true,
+		SlaveSqlRunning: true,
+	}, nil
+}
+
+func (c *copyFakeClient) StopSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.record("StopSlave", tablet)
+	return nil
+}
+
+func (c *copyFakeClient) StartSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.record("StartSlave", tablet)
+	return nil
+}
+
+func (c *copyFakeClient) ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int) (*querypb.QueryResult, error) {
+	c.record("ExecuteFetchAsApp", tablet)
+	c.sourceQueries = append(c.sourceQueries, string(query))
+	q := string(query)
+	// The rows have ids 1 to len(c.rows), so the rows after
+	// id N start at offset N.
+	var offset, count int
+	if i := strings.Index(q, " WHERE `id` > "); i != -1 {
+		if _, err := fmt.Sscanf(q[i:], " WHERE `id` > %d", &offset); err != nil {
+			return nil, fmt.Errorf("unexpected query %v: %v", q, err)
+		}
+	}
+	if _, err := fmt.Sscanf(q[strings.LastIndex(q, " LIMIT "):], " LIMIT %d", &count); err != nil {
+		return nil, fmt.Errorf("unexpected query %v: %v", q, err)
+	}
+	end := offset + count
+	if end > len(c.rows) {
+		end = len(c.rows)
+	}
+	result := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "msg", Type: sqltypes.VarChar},
+		},
+	}
+	if offset < end {
+		result.Rows = c.rows[offset:end]
+	}
+	return sqltypes.ResultToProto3(result), nil
+}
+
+func (c *copyFakeClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool, maxExecTime time.Duration) (*querypb.QueryResult, error) {
+	c.record("ExecuteFetchAsDba", tablet)
+	c.destQueries = append(c.destQueries, string(query))
+	return &querypb.QueryResult{}, nil
+}
+
+func TestCopyTables(t *testing.T) {
+	source := newTablet(1)
+	source.Type = topodatapb.TabletType_REPLICA
+	dest := newTablet(2)
+	tmc := &copyFakeClient{
+		td: &tabletmanagerdatapb.TableDefinition{
+			Name:              "t1",
+			Schema:            "CREATE TABLE `t1` (`id` bigint, `msg` varchar(64), PRIMARY KEY (`id`))",
+			Columns:           []string{"id", "msg"},
+			PrimaryKeyColumns: []string{"id"},
+		},
+	}
+	for i := 1; i <= 5; i++ {
+		tmc.rows = append(tmc.rows, []sqltypes.Value{
+			sqltypes.MakeTrusted(sqltypes.Int64, []byte(fmt.Sprintf("%v", i))),
+			sqltypes.MakeTrusted(sqltypes.VarChar, []byte(fmt.Sprintf("msg%v", i))),
+		})
+	}
+
+	var progress []CopyTablesProgress
+	if err := CopyTables(context.Background(), tmc, source, dest, []string{"t1"}, CopyTablesOptions{
+		BatchSize: 2,
+		Progress: func(p CopyTablesProgress) {
+			progress = append(progress, p)
+		},
+	}); err != nil {
+		t.Fatalf("CopyTables failed: %v", err)
+	}
+
+	wantProgress := []CopyTablesProgress{
+		{Table: "t1", RowsCopied: 2},
+		{Table: "t1", RowsCopied: 4},
+		{Table: "t1", RowsCopied: 5},
+		{Table: "t1", RowsCopied: 5, Done: true},
+	}
+	if !reflect.DeepEqual(progress, wantProgress) {
+		t.Errorf("got progress %v, want %v", progress, wantProgress)
+	}
+
+	// The batches are read after the last primary key.
+	wantSourceQueries := []string{
+		"SELECT `id`, `msg` FROM `t1` ORDER BY `id` LIMIT 2",
+		"SELECT `id`, `msg` FROM `t1` WHERE `id` > 2 ORDER BY `id` LIMIT 2",
+		"SELECT `id`, `msg` FROM `t1` WHERE `id` > 4 ORDER BY `id` LIMIT 2",
+	}
+	if !reflect.DeepEqual(tmc.sourceQueries, wantSourceQueries) {
+		t.Errorf("got queries on source %v, want %v", tmc.sourceQueries, wantSourceQueries)
+	}
+
+	wantQueries := []string{
+		"DROP TABLE IF EXISTS `t1`",
+		"CREATE TABLE `t1` (`id` bigint, `msg` varchar(64), PRIMARY KEY (`id`))",
+		"INSERT INTO `t1` (`id`, `msg`) VALUES (1, 'msg1'), (2, 'msg2')",
+		"INSERT INTO `t1` (`id`, `msg`) VALUES (3, 'msg3'), (4, 'msg4')",
+		"INSERT INTO `t1` (`id`, `msg`) VALUES (5, 'msg5')",
+	}
+	if !reflect.DeepEqual(tmc.destQueries, wantQueries) {
+		t.Errorf("got queries on destination %v, want %v", tmc.destQueries, wantQueries)
+	}
+
+	// Replication is stopped on the source before the first read,
+	// and restarted once the copy completes.
+	wantCalls := []string{
+		"GetSchema(cell1-0000000001)",
+		"SlaveStatus(cell1-0000000001)",
+		"StopSlave(cell1-0000000001)",
+		"ExecuteFetchAsDba(cell1-0000000002)",
+		"ExecuteFetchAsDba(cell1-0000000002)",
+		"ExecuteFetchAsApp(cell1-0000000001)",
+		"ExecuteFetchAsDba(cell1-0000000002)",
+		"ExecuteFetchAsApp(cell1-0000000001)",
+		"ExecuteFetchAsDba(cell1-0000000002)",
+		"ExecuteFetchAsApp(cell1-0000000001)",
+		"ExecuteFetchAsDba(cell1-0000000002)",
+		"StartSlave(cell1-0000000001)",
+	}
+	if !reflect.DeepEqual(tmc.calls, wantCalls) {
+		t.Errorf("got calls %v, want %v", tmc.calls, wantCalls)
+	}
+}
+
+func TestAfterPrimaryKey(t *testing.T) {
+	got := afterPrimaryKey([]string{"`a`", "`b`", "`c`"}, []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.Int64, []byte("1")),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte("x'y")),
+		sqltypes.MakeTrusted(sqltypes.Int64, []byte("3")),
+	})
+	want := "`a` > 1 OR (`a` = 1 AND `b` > 'x\\'y') OR (`a` = 1 AND `b` = 'x\\'y' AND `c` > 3)"
+	if got != want {
+		t.Errorf("afterPrimaryKey() = %v, want %v", got, want)
+	}
+}
+
+func TestCopyTablesFromMaster(t *testing.T) {
+	source := newTablet(1)
+	source.Type = topodatapb.TabletType_MASTER
+	tmc := &copyFakeClient{}
+	err := CopyTables(context.Background(), tmc, source, newTablet(2), []string{"t1"}, CopyTablesOptions{})
+	if err == nil || !strings.Contains(err.Error(), "cannot get a consistent snapshot") {
+		t.Errorf("CopyTables from a master returned %v, want a consistent snapshot error", err)
+	}
+	if len(tmc.calls) != 0 {
+		t.Errorf("CopyTables from a master made calls: %v", tmc.calls)
+	}
+}
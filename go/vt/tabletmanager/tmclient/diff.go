@@ -0,0 +1,313 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// RowDiffType is the kind of difference DiffTable found for a row.
+type RowDiffType int
+
+const (
+	// RowOnlyInA is a row that only exists on the first tablet.
+	RowOnlyInA RowDiffType = iota
+	// RowOnlyInB is a row that only exists on the second tablet.
+	RowOnlyInB
+	// RowMismatch is a row that exists on both tablets, with the
+	// same primary key but different values.
+	RowMismatch
+)
+
+// String returns a human-readable RowDiffType.
+func (t RowDiffType) String() string {
+	switch t {
+	case RowOnlyInA:
+		return "only in A"
+	case RowOnlyInB:
+		return "only in B"
+	case RowMismatch:
+		return "mismatch"
+	}
+	return fmt.Sprintf("RowDiffType(%d)", int(t))
+}
+
+// RowDiff is a row that differs between the two tablets DiffTable
+// compares.
+type RowDiff struct {
+	Type RowDiffType
+	// A and B are the row on each tablet. Only the one of the
+	// tablet that has the row is set for RowOnlyInA and RowOnlyInB.
+	A []sqltypes.Value
+	B []sqltypes.Value
+}
+
+// ErrFunc returns the error that ended a stream, once its channel is
+// closed. It returns nil if the stream went through.
+type ErrFunc func() error
+
+// DiffTable compares the rows of table that are in keyRange (all rows
+// if keyRange is nil) on tabletA and tabletB, and sends the rows that
+// differ on the returned channel, in primary key order. The rows are
+// streamed from both tablets with StreamRowsInKeyRange, and merged as
+// they arrive: only the current batch of each tablet is held in
+// memory, so tables of any size can be compared. Text primary keys
+// are compared as bytes: StreamRowsInKeyRange orders them by BINARY,
+// not by their collation, so both agree.
+// The channel is closed when the comparison is done, or failed: the
+// ErrFunc then returns why. Cancel ctx to stop early.
+func DiffTable(ctx context.Context, tmc TabletManagerClient, tabletA, tabletB *topodatapb.Tablet, table string, keyRange *topodatapb.KeyRange) (<-chan RowDiff, ErrFunc, error) {
+	aliasA := topoproto.TabletAliasString(tabletA.Alias)
+	aliasB := topoproto.TabletAliasString(tabletB.Alias)
+	sd, err := tmc.GetSchema(ctx, tabletA, []string{table}, nil, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot get schema of table %v on tablet %v: %v", table, aliasA, err)
+	}
+	var pkColumns []string
+	for _, td := range sd.TableDefinitions {
+		if td.Name == table {
+			pkColumns = td.PrimaryKeyColumns
+		}
+	}
+	if len(pkColumns) == 0 {
+		return nil, nil, fmt.Errorf("table %v does not exist, or has no primary key, on tablet %v", table, aliasA)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	a, err := newDiffRowReader(ctx, tmc, tabletA, table, keyRange)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	b, err := newDiffRowReader(ctx, tmc, tabletB, table, keyRange)
+	if err != nil {
+		cancel()
+		a.close()
+		return nil, nil, err
+	}
+	pk, err := diffPrimaryKey(a.fields, b.fields, pkColumns)
+	if err != nil {
+		cancel()
+		a.close()
+		b.close()
+		return nil, nil, fmt.Errorf("cannot compare table %v on tablets %v and %v: %v", table, aliasA, aliasB, err)
+	}
+
+	diffs := make(chan RowDiff)
+	var diffErr error
+	go func() {
+		defer close(diffs)
+		defer func() {
+			cancel()
+			a.close()
+			b.close()
+		}()
+		diffErr = mergeDiffRows(ctx, a, b, pk, diffs)
+	}()
+	return diffs, func() error { return diffErr }, nil
+}
+
+// mergeDiffRows reads the rows of a and b, both in primary key order,
+// and sends the differences.
+func mergeDiffRows(ctx context.Context, a, b *diffRowReader, pk []int, diffs chan<- RowDiff) error {
+	rowA, err := a.next()
+	if err != nil {
+		return err
+	}
+	rowB, err := b.next()
+	if err != nil {
+		return err
+	}
+	for rowA != nil || rowB != nil {
+		var c int
+		switch {
+		case rowB == nil:
+			c = -1
+		case rowA == nil:
+			c = 1
+		default:
+			if c, err = comparePrimaryKeys(pk, rowA, rowB); err != nil {
+				return err
+			}
+		}
+
+		var diff *RowDiff
+		switch {
+		case c < 0:
+			diff = &RowDiff{Type: RowOnlyInA, A: rowA}
+			if rowA, err = a.next(); err != nil {
+				return err
+			}
+		case c > 0:
+			diff = &RowDiff{Type: RowOnlyInB, B: rowB}
+			if rowB, err = b.next(); err != nil {
+				return err
+			}
+		default:
+			if !rowsEqual(rowA, rowB) {
+				diff = &RowDiff{Type: RowMismatch, A: rowA, B: rowB}
+			}
+			if rowA, err = a.next(); err != nil {
+				return err
+			}
+			if rowB, err = b.next(); err != nil {
+				return err
+			}
+		}
+		if diff == nil {
+			continue
+		}
+		select {
+		case diffs <- *diff:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// diffPrimaryKey checks both tablets returned the same columns, and
+// returns the indexes of the primary key columns.
+func diffPrimaryKey(fieldsA, fieldsB []*querypb.Field, pkColumns []string) ([]int, error) {
+	if len(fieldsA) != len(fieldsB) {
+		return nil, fmt.Errorf("different number of columns: %v and %v", len(fieldsA), len(fieldsB))
+	}
+	indexes := make(map[string]int, len(fieldsA))
+	for i, field := range fieldsA {
+		if field.Name != fieldsB[i].Name || field.Type != fieldsB[i].Type {
+			return nil, fmt.Errorf("column %v differs: %v %v and %v %v", i, field.Name, field.Type, fieldsB[i].Name, fieldsB[i].Type)
+		}
+		indexes[field.Name] = i
+	}
+	pk := make([]int, len(pkColumns))
+	for i, column := range pkColumns {
+		index, ok := indexes[column]
+		if !ok {
+			return nil, fmt.Errorf("primary key column %v is not in the rows", column)
+		}
+		pk[i] = index
+	}
+	return pk, nil
+}
+
+// comparePrimaryKeys returns -1, 0 or 1 if the primary key of a is
+// before, the same as, or after the primary key of b. Both rows have
+// the same types.
+func comparePrimaryKeys(pk []int, a, b []sqltypes.Value) (int, error) {
+	for _, i := range pk {
+		switch l := a[i].ToNative().(type) {
+		case int64:
+			r := b[i].ToNative().(int64)
+			if l < r {
+				return -1, nil
+			} else if l > r {
+				return 1, nil
+			}
+		case uint64:
+			r := b[i].ToNative().(uint64)
+			if l < r {
+				return -1, nil
+			} else if l > r {
+				return 1, nil
+			}
+		case float64:
+			r := b[i].ToNative().(float64)
+			if l < r {
+				return -1, nil
+			} else if l > r {
+				return 1, nil
+			}
+		case []byte:
+			if c := bytes.Compare(l, b[i].ToNative().([]byte)); c != 0 {
+				return c, nil
+			}
+		default:
+			return 0, fmt.Errorf("unsupported primary key type %T", l)
+		}
+	}
+	return 0, nil
+}
+
+// rowsEqual returns true if both rows have the same values.
+func rowsEqual(a, b []sqltypes.Value) bool {
+	for i := range a {
+		if a[i].IsNull() != b[i].IsNull() || !bytes.Equal(a[i].Raw(), b[i].Raw()) {
+			return false
+		}
+	}
+	return true
+}
+
+// diffRowReader reads the rows of a RowStream one at a time, holding
+// only the current batch.
+type diffRowReader struct {
+	alias  string
+	stream RowStream
+	fields []*querypb.Field
+	rows   [][]sqltypes.Value
+	done   bool
+}
+
+// newDiffRowReader starts streaming the rows of the table, and reads
+// their fields.
+func newDiffRowReader(ctx context.Context, tmc TabletManagerClient, tablet *topodatapb.Tablet, table string, keyRange *topodatapb.KeyRange) (*diffRowReader, error) {
+	r := &diffRowReader{
+		alias: topoproto.TabletAliasString(tablet.Alias),
+	}
+	var err error
+	r.stream, err = tmc.StreamRowsInKeyRange(ctx, tablet, table, keyRange)
+	if err != nil {
+		return nil, fmt.Errorf("cannot stream rows of table %v from tablet %v: %v", table, r.alias, err)
+	}
+	qr, err := r.stream.Recv()
+	if err != nil {
+		r.done = true
+		return nil, fmt.Errorf("cannot stream rows of table %v from tablet %v: %v", table, r.alias, err)
+	}
+	r.fields = qr.Fields
+	return r, nil
+}
+
+// next returns the next row, or nil once all were read.
+func (r *diffRowReader) next() ([]sqltypes.Value, error) {
+	for len(r.rows) == 0 {
+		if r.done {
+			return nil, nil
+		}
+		qr, err := r.stream.Recv()
+		if err == io.EOF {
+			r.done = true
+			return nil, nil
+		}
+		if err != nil {
+			r.done = true
+			return nil, fmt.Errorf("cannot read rows from tablet %v: %v", r.alias, err)
+		}
+		r.rows = sqltypes.CustomProto3ToResult(r.fields, qr).Rows
+	}
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+// close reads what is left of the stream, so it releases its
+// connection. The stream context must be canceled first.
+func (r *diffRowReader) close() {
+	for !r.done {
+		if _, err := r.stream.Recv(); err != nil {
+			r.done = true
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+var diffFields = []*querypb.Field{
+	{Name: "id", Type: sqltypes.Int64},
+	{Name: "msg", Type: sqltypes.VarChar},
+}
+
+func diffRow(id int64, msg string) []sqltypes.Value {
+	return []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.Int64, []byte(fmt.Sprintf("%v", id))),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte(msg)),
+	}
+}
+
+// diffFakeClient serves the rows of a table from each tablet, one row
+// per batch.
+type diffFakeClient struct {
+	fakeClient
+	rows map[uint32][][]sqltypes.Value
+}
+
+func (c *diffFakeClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	c.record("GetSchema", tablet)
+	return &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:              "t1",
+				Columns:           []string{"id", "msg"},
+				PrimaryKeyColumns: []string{"id"},
+			},
+		},
+	}, nil
+}
+
+func (c *diffFakeClient) StreamRowsInKeyRange(ctx context.Context, tablet *topodatapb.Tablet, table string, keyRange *topodatapb.KeyRange) (RowStream, error) {
+	c.record("StreamRowsInKeyRange", tablet)
+	results := []*querypb.QueryResult{{Fields: diffFields}}
+	for _, row := range c.rows[tablet.Alias.Uid] {
+		results = append(results, &querypb.QueryResult{
+			Rows: sqltypes.RowsToProto3([][]sqltypes.Value{row}),
+		})
+	}
+	return &sliceRowStream{results: results}, nil
+}
+
+type sliceRowStream struct {
+	results []*querypb.QueryResult
+}
+
+func (s *sliceRowStream) Recv() (*querypb.QueryResult, error) {
+	if len(s.results) == 0 {
+		return nil, io.EOF
+	}
+	qr := s.results[0]
+	s.results = s.results[1:]
+	return qr, nil
+}
+
+func TestDiffTable(t *testing.T) {
+	c := &diffFakeClient{
+		rows: map[uint32][][]sqltypes.Value{
+			1: {
+				diffRow(1, "same"),
+				diffRow(2, "deleted on B"),
+				diffRow(3, "before"),
+				diffRow(5, "same"),
+			},
+			2: {
+				diffRow(1, "same"),
+				diffRow(3, "after"),
+				diffRow(4, "inserted on B"),
+				diffRow(5, "same"),
+				diffRow(6, "inserted on B"),
+			},
+		},
+	}
+	diffs, errFunc, err := DiffTable(context.Background(), c, newTablet(1), newTablet(2), "t1", nil)
+	if err != nil {
+		t.Fatalf("DiffTable failed: %v", err)
+	}
+	var got []RowDiff
+	for diff := range diffs {
+		got = append(got, diff)
+	}
+	if err := errFunc(); err != nil {
+		t.Fatalf("DiffTable stream failed: %v", err)
+	}
+	want := []RowDiff{
+		{Type: RowOnlyInA, A: diffRow(2, "deleted on B")},
+		{Type: RowMismatch, A: diffRow(3, "before"), B: diffRow(3, "after")},
+		{Type: RowOnlyInB, B: diffRow(4, "inserted on B")},
+		{Type: RowOnlyInB, B: diffRow(6, "inserted on B")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffTable() = %v, want %v", got, want)
+	}
+
+	// Identical tables have no difference.
+	c.rows[2] = c.rows[1]
+	diffs, errFunc, err = DiffTable(context.Background(), c, newTablet(1), newTablet(2), "t1", nil)
+	if err != nil {
+		t.Fatalf("DiffTable failed: %v", err)
+	}
+	for diff := range diffs {
+		t.Errorf("DiffTable() of identical tables returned %v", diff)
+	}
+	if err := errFunc(); err != nil {
+		t.Errorf("DiffTable stream failed: %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// fakeClient is the base for the fake TabletManagerClient
+// implementations used in this package's tests. Tests embed it and
+// override the methods they need: calling any other method panics.
+type fakeClient struct {
+	TabletManagerClient
+
+	mu    sync.Mutex
+	calls []string
+}
+
+// record remembers a call, as "Method(alias)".
+func (c *fakeClient) record(method string, tablet *topodatapb.Tablet) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, method+"("+topoproto.TabletAliasString(tablet.Alias)+")")
+}
+
+// sortedCalls returns the recorded calls, sorted, for comparison
+// when the calls were made in parallel.
+func (c *fakeClient) sortedCalls() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result := make([]string, len(c.calls))
+	copy(result, c.calls)
+	sort.Strings(result)
+	return result
+}
+
+func newTablet(uid uint32) *topodatapb.Tablet {
+	return &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "cell1",
+			Uid:  uid,
+		},
+		Keyspace: "ks",
+		Shard:    "0",
+	}
+}
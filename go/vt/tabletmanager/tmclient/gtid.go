@@ -0,0 +1,132 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// GtidConsistency is the result of VerifyShardGtidConsistency for a
+// tablet.
+type GtidConsistency struct {
+	Tablet *topodatapb.Tablet
+	// Position is the executed GTID set of the tablet.
+	Position replication.Position
+	// Behind is set if the tablet has not applied all the
+	// transactions of the target position. Missing is the number
+	// of transactions it still has to apply.
+	Behind  bool
+	Missing uint64
+	// Errant is set if the tablet has transactions that are not
+	// in the target position. For MySQL 5.6 GTID sets, ErrantGTIDs
+	// has those transactions.
+	Errant      bool
+	ErrantGTIDs replication.GTIDSet
+	// Err is the error getting the position of the tablet, if any.
+	Err error
+}
+
+// VerifyShardGtidConsistency checks that all the tablets have applied
+// exactly the transactions of targetPos, e.g. before a resharding
+// cutover. It gets the position of all the tablets in parallel.
+// It returns the result for each tablet, in the order of tablets. If
+// any tablet is behind, has errant transactions, or could not be
+// checked, it also returns an error naming those tablets. A tablet
+// can be both behind and errant.
+func VerifyShardGtidConsistency(ctx context.Context, tmc TabletManagerClient, tablets []*topodatapb.Tablet, targetPos replication.Position) ([]*GtidConsistency, error) {
+	results := make([]*GtidConsistency, len(tablets))
+	wg := sync.WaitGroup{}
+	for i, tablet := range tablets {
+		results[i] = &GtidConsistency{
+			Tablet: tablet,
+		}
+		wg.Add(1)
+		go func(result *GtidConsistency) {
+			defer wg.Done()
+			pos, err := tmc.MasterPosition(ctx, result.Tablet)
+			if err != nil {
+				result.Err = err
+				return
+			}
+			result.Position, result.Err = replication.DecodePosition(pos)
+			if result.Err != nil {
+				return
+			}
+			compareGtidPositions(result, targetPos)
+		}(results[i])
+	}
+	wg.Wait()
+
+	var behind, errant, failed []string
+	for _, result := range results {
+		alias := topoproto.TabletAliasString(result.Tablet.Alias)
+		if result.Err != nil {
+			failed = append(failed, fmt.Sprintf("%v: %v", alias, result.Err))
+			continue
+		}
+		if result.Behind {
+			behind = append(behind, fmt.Sprintf("%v: missing %v transactions", alias, result.Missing))
+		}
+		if result.Errant {
+			if result.ErrantGTIDs != nil {
+				errant = append(errant, fmt.Sprintf("%v: %v", alias, result.ErrantGTIDs))
+			} else {
+				errant = append(errant, fmt.Sprintf("%v: at %v", alias, result.Position))
+			}
+		}
+	}
+	var errs []string
+	if len(behind) > 0 {
+		errs = append(errs, fmt.Sprintf("behind [%v]", strings.Join(behind, ", ")))
+	}
+	if len(errant) > 0 {
+		errs = append(errs, fmt.Sprintf("errant GTIDs [%v]", strings.Join(errant, ", ")))
+	}
+	if len(failed) > 0 {
+		errs = append(errs, fmt.Sprintf("failed [%v]", strings.Join(failed, ", ")))
+	}
+	if len(errs) > 0 {
+		return results, fmt.Errorf("tablets are not consistent with %v: %v", targetPos, strings.Join(errs, ", "))
+	}
+	return results, nil
+}
+
+// compareGtidPositions fills in the Behind and Errant fields of the
+// result, comparing its position to targetPos.
+func compareGtidPositions(result *GtidConsistency, targetPos replication.Position) {
+	gtidSet, ok := result.Position.GTIDSet.(replication.Mysql56GTIDSet)
+	targetSet, targetOk := targetPos.GTIDSet.(replication.Mysql56GTIDSet)
+	if ok && targetOk {
+		missing := targetSet.Difference(gtidSet)
+		if len(missing) > 0 {
+			result.Behind = true
+			result.Missing = replication.Position{GTIDSet: missing}.TransactionCount()
+		}
+		errant := gtidSet.Difference(targetSet)
+		if len(errant) > 0 {
+			result.Errant = true
+			result.ErrantGTIDs = errant
+		}
+		return
+	}
+
+	// Other flavors can only be compared as a whole.
+	result.Behind = !result.Position.AtLeast(targetPos)
+	result.Errant = !targetPos.AtLeast(result.Position)
+	if result.Behind {
+		if pos, target := result.Position.TransactionCount(), targetPos.TransactionCount(); target > pos {
+			result.Missing = target - pos
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn/replication"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// masterPositionFakeClient returns positions[uid] for MasterPosition.
+type masterPositionFakeClient struct {
+	fakeClient
+	positions map[uint32]string
+}
+
+func (c *masterPositionFakeClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	c.record("MasterPosition", tablet)
+	pos, ok := c.positions[tablet.Alias.Uid]
+	if !ok {
+		return "", fmt.Errorf("mysqld is not running")
+	}
+	return replication.EncodePosition(replication.MustParsePosition("MySQL56", pos)), nil
+}
+
+func TestVerifyShardGtidConsistency(t *testing.T) {
+	const sid1 = "00010203-0405-0607-0809-0a0b0c0d0e0f"
+	const sid2 = "00010203-0405-0607-0809-0a0b0c0d0e10"
+	targetPos := replication.MustParsePosition("MySQL56", sid1+":1-100")
+	tablets := []*topodatapb.Tablet{newTablet(1), newTablet(2), newTablet(3)}
+	tmc := &masterPositionFakeClient{
+		positions: map[uint32]string{
+			// Up to date.
+			1: sid1 + ":1-100",
+			// Behind by 10 transactions.
+			2: sid1 + ":1-90",
+			// Up to date, with errant transactions.
+			3: sid1 + ":1-100," + sid2 + ":1-2",
+		},
+	}
+
+	results, err := VerifyShardGtidConsistency(context.Background(), tmc, tablets, targetPos)
+	if err == nil {
+		t.Fatalf("VerifyShardGtidConsistency worked, want an error")
+	}
+	for _, want := range []string{
+		"behind [cell1-0000000002: missing 10 transactions]",
+		"errant GTIDs [cell1-0000000003: " + sid2 + ":1-2]",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("VerifyShardGtidConsistency returned %v, want it to contain %q", err, want)
+		}
+	}
+
+	if len(results) != len(tablets) {
+		t.Fatalf("got %v results, want %v", len(results), len(tablets))
+	}
+	want := []struct {
+		behind  bool
+		missing uint64
+		errant  bool
+	}{
+		{false, 0, false},
+		{true, 10, false},
+		{false, 0, true},
+	}
+	for i, result := range results {
+		if result.Tablet != tablets[i] {
+			t.Errorf("result %v is for tablet %v, want %v", i, result.Tablet.Alias, tablets[i].Alias)
+		}
+		if result.Err != nil {
+			t.Errorf("tablet %v: got error %v", result.Tablet.Alias, result.Err)
+		}
+		if result.Behind != want[i].behind || result.Missing != want[i].missing || result.Errant != want[i].errant {
+			t.Errorf("tablet %v: got behind=%v missing=%v errant=%v, want %+v", result.Tablet.Alias, result.Behind, result.Missing, result.Errant, want[i])
+		}
+	}
+	wantCalls := []string{
+		"MasterPosition(cell1-0000000001)",
+		"MasterPosition(cell1-0000000002)",
+		"MasterPosition(cell1-0000000003)",
+	}
+	if got := tmc.sortedCalls(); !reflect.DeepEqual(got, wantCalls) {
+		t.Errorf("got calls %v, want %v", got, wantCalls)
+	}
+
+	// A tablet that cannot be checked fails the verification.
+	tmc = &masterPositionFakeClient{
+		positions: map[uint32]string{
+			1: sid1 + ":1-100",
+		},
+	}
+	if _, err := VerifyShardGtidConsistency(context.Background(), tmc, tablets[:2], targetPos); err == nil || !strings.Contains(err.Error(), "failed [cell1-0000000002: mysqld is not running]") {
+		t.Errorf("VerifyShardGtidConsistency returned %v, want it to fail on cell1-0000000002", err)
+	}
+
+	// All tablets up to date.
+	if _, err := VerifyShardGtidConsistency(context.Background(), tmc, tablets[:1], targetPos); err != nil {
+		t.Errorf("VerifyShardGtidConsistency failed: %v", err)
+	}
+}
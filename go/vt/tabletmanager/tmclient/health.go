@@ -0,0 +1,104 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/tabletserver/tabletconn"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// healthConnectTimeout is the timeout to connect to the tablet
+// health stream in WaitForHealthy.
+const healthConnectTimeout = 30 * time.Second
+
+// WaitForHealthy streams the health of the tablet, and returns once
+// it has been serving as targetType, without any health error, for
+// stableDuration in a row. Any unhealthy report restarts the window.
+// It returns an error if the health stream fails, or ctx.Err() if ctx
+// is done first.
+func WaitForHealthy(ctx context.Context, tablet *topodatapb.Tablet, stableDuration time.Duration, targetType topodatapb.TabletType) error {
+	conn, err := tabletconn.GetDialer()(tablet, healthConnectTimeout)
+	if err != nil {
+		return fmt.Errorf("cannot connect to tablet %v: %v", topoproto.TabletAliasString(tablet.Alias), err)
+	}
+	defer conn.Close(ctx)
+
+	// Cancelling the stream context makes the reader goroutine exit.
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := conn.StreamHealth(streamCtx)
+	if err != nil {
+		return err
+	}
+
+	type healthResult struct {
+		shr *querypb.StreamHealthResponse
+		err error
+	}
+	results := make(chan healthResult)
+	go func() {
+		for {
+			shr, err := stream.Recv()
+			select {
+			case results <- healthResult{shr, err}:
+			case <-streamCtx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	// stable is only set while the tablet is healthy, and fires
+	// when it has been so for stableDuration.
+	var timer *time.Timer
+	var stable <-chan time.Time
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-stable:
+			return nil
+		case r := <-results:
+			if r.err != nil {
+				return fmt.Errorf("health stream of tablet %v ended: %v", topoproto.TabletAliasString(tablet.Alias), r.err)
+			}
+			if !isHealthy(r.shr, targetType) {
+				if timer != nil {
+					timer.Stop()
+				}
+				timer, stable = nil, nil
+				continue
+			}
+			if stable == nil {
+				timer = time.NewTimer(stableDuration)
+				stable = timer.C
+			}
+		}
+	}
+}
+
+// isHealthy returns true if shr reports a serving tablet of the
+// given type, with no health error.
+func isHealthy(shr *querypb.StreamHealthResponse, targetType topodatapb.TabletType) bool {
+	if !shr.Serving || shr.Target == nil || shr.Target.TabletType != targetType {
+		return false
+	}
+	return shr.RealtimeStats == nil || shr.RealtimeStats.HealthError == ""
+}
@@ -0,0 +1,133 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/tabletserver/tabletconn"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// healthFakeConn is a tabletconn.TabletConn that streams the health
+// responses sent on its channel.
+type healthFakeConn struct {
+	tabletconn.TabletConn
+	ctx       context.Context
+	responses chan *querypb.StreamHealthResponse
+}
+
+func (c *healthFakeConn) StreamHealth(ctx context.Context) (tabletconn.StreamHealthReader, error) {
+	c.ctx = ctx
+	return c, nil
+}
+
+func (c *healthFakeConn) Recv() (*querypb.StreamHealthResponse, error) {
+	select {
+	case shr := <-c.responses:
+		return shr, nil
+	case <-c.ctx.Done():
+		return nil, c.ctx.Err()
+	}
+}
+
+func (c *healthFakeConn) Close(ctx context.Context) error {
+	return nil
+}
+
+var healthConn *healthFakeConn
+
+func init() {
+	tabletconn.RegisterDialer("tmclient_health_test", func(tablet *topodatapb.Tablet, timeout time.Duration) (tabletconn.TabletConn, error) {
+		return healthConn, nil
+	})
+}
+
+func healthResponse(serving bool, healthError string) *querypb.StreamHealthResponse {
+	return &querypb.StreamHealthResponse{
+		Target: &querypb.Target{
+			TabletType: topodatapb.TabletType_REPLICA,
+		},
+		Serving: serving,
+		RealtimeStats: &querypb.RealtimeStats{
+			HealthError: healthError,
+		},
+	}
+}
+
+func TestWaitForHealthy(t *testing.T) {
+	oldProtocol := *tabletconn.TabletProtocol
+	*tabletconn.TabletProtocol = "tmclient_health_test"
+	defer func() { *tabletconn.TabletProtocol = oldProtocol }()
+	healthConn = &healthFakeConn{
+		responses: make(chan *querypb.StreamHealthResponse),
+	}
+
+	stableDuration := 200 * time.Millisecond
+	done := make(chan error)
+	go func() {
+		done <- WaitForHealthy(context.Background(), newTablet(1), stableDuration, topodatapb.TabletType_REPLICA)
+	}()
+
+	// Health flaps: every healthy report is followed by an unhealthy
+	// one before the stable window is over.
+	for _, shr := range []*querypb.StreamHealthResponse{
+		healthResponse(true, ""),
+		healthResponse(true, "replication lag too high"),
+		healthResponse(true, ""),
+		healthResponse(false, ""),
+		healthResponse(true, ""),
+		healthResponse(true, "replication lag too high"),
+	} {
+		healthConn.responses <- shr
+		select {
+		case err := <-done:
+			t.Fatalf("WaitForHealthy returned while health was flapping: %v", err)
+		case <-time.After(stableDuration / 4):
+		}
+	}
+
+	// Health stabilizes: the call returns only after the stable window.
+	healthConn.responses <- healthResponse(true, "")
+	stableStart := time.Now()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("WaitForHealthy failed: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("WaitForHealthy did not return after health stabilized")
+	}
+	if elapsed := time.Since(stableStart); elapsed < stableDuration {
+		t.Errorf("WaitForHealthy returned after %v, before the %v stable window", elapsed, stableDuration)
+	}
+}
+
+func TestWaitForHealthyTimeout(t *testing.T) {
+	oldProtocol := *tabletconn.TabletProtocol
+	*tabletconn.TabletProtocol = "tmclient_health_test"
+	defer func() { *tabletconn.TabletProtocol = oldProtocol }()
+	healthConn = &healthFakeConn{
+		responses: make(chan *querypb.StreamHealthResponse, 1),
+	}
+
+	// The tablet is serving, but as the wrong type.
+	healthConn.responses <- &querypb.StreamHealthResponse{
+		Target: &querypb.Target{
+			TabletType: topodatapb.TabletType_RDONLY,
+		},
+		Serving: true,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := WaitForHealthy(ctx, newTablet(1), 10*time.Millisecond, topodatapb.TabletType_REPLICA); err != context.DeadlineExceeded {
+		t.Errorf("WaitForHealthy() = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
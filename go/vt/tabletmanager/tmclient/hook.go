@@ -0,0 +1,50 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/hook"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// HookJSONResult is the result of ExecuteHookJSON.
+type HookJSONResult struct {
+	// ExitStatus is hook.HOOK_SUCCESS if the hook succeeded.
+	ExitStatus int
+	// Output is the JSON object the hook printed on stdout, decoded.
+	// It is only set if the hook succeeded.
+	Output map[string]interface{}
+	// Stderr is what the hook printed on stderr.
+	Stderr string
+}
+
+// ExecuteHookJSON runs a hook that prints a JSON object on stdout, and
+// returns it decoded. If the hook fails, its stdout is not parsed, and
+// only the exit status and stderr are returned. If the hook succeeds
+// but stdout is not a JSON object, it returns an error along with the
+// exit status and stderr.
+func ExecuteHookJSON(ctx context.Context, tmc TabletManagerClient, tablet *topodatapb.Tablet, hk *hook.Hook) (*HookJSONResult, error) {
+	hr, err := tmc.ExecuteHook(ctx, tablet, hk)
+	if err != nil {
+		return nil, err
+	}
+	result := &HookJSONResult{
+		ExitStatus: hr.ExitStatus,
+		Stderr:     hr.Stderr,
+	}
+	if hr.ExitStatus != hook.HOOK_SUCCESS {
+		return result, nil
+	}
+	if err := json.Unmarshal([]byte(hr.Stdout), &result.Output); err != nil {
+		return result, fmt.Errorf("hook %v did not print a JSON object on stdout: %v", hk.Name, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/hook"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// hookFakeClient returns the same result for all hooks.
+type hookFakeClient struct {
+	fakeClient
+	hr *hook.HookResult
+}
+
+func (c *hookFakeClient) ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (*hook.HookResult, error) {
+	c.record("ExecuteHook", tablet)
+	return c.hr, nil
+}
+
+func TestExecuteHookJSON(t *testing.T) {
+	ctx := context.Background()
+	hk := &hook.Hook{Name: "inventory"}
+
+	// A successful hook has its stdout decoded.
+	tmc := &hookFakeClient{hr: &hook.HookResult{
+		ExitStatus: hook.HOOK_SUCCESS,
+		Stdout:     `{"disks": 2, "model": "x1", "tags": ["a", "b"]}`,
+		Stderr:     "took 1s",
+	}}
+	result, err := ExecuteHookJSON(ctx, tmc, newTablet(1), hk)
+	if err != nil {
+		t.Fatalf("ExecuteHookJSON failed: %v", err)
+	}
+	want := &HookJSONResult{
+		ExitStatus: hook.HOOK_SUCCESS,
+		Output: map[string]interface{}{
+			"disks": float64(2),
+			"model": "x1",
+			"tags":  []interface{}{"a", "b"},
+		},
+		Stderr: "took 1s",
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("ExecuteHookJSON() = %#v, want %#v", result, want)
+	}
+
+	// A successful hook that does not print JSON is an error.
+	tmc.hr = &hook.HookResult{
+		ExitStatus: hook.HOOK_SUCCESS,
+		Stdout:     "disks: 2",
+	}
+	result, err = ExecuteHookJSON(ctx, tmc, newTablet(1), hk)
+	if err == nil || !strings.Contains(err.Error(), "did not print a JSON object") {
+		t.Errorf("ExecuteHookJSON with non-JSON output returned %v, want a JSON error", err)
+	}
+	if result == nil || result.ExitStatus != hook.HOOK_SUCCESS {
+		t.Errorf("ExecuteHookJSON with non-JSON output returned result %#v, want the exit status", result)
+	}
+
+	// A failed hook returns its exit status, without parsing stdout.
+	tmc.hr = &hook.HookResult{
+		ExitStatus: 3,
+		Stdout:     "not JSON",
+		Stderr:     "no disks found",
+	}
+	result, err = ExecuteHookJSON(ctx, tmc, newTablet(1), hk)
+	if err != nil {
+		t.Fatalf("ExecuteHookJSON of a failed hook failed: %v", err)
+	}
+	want = &HookJSONResult{
+		ExitStatus: 3,
+		Stderr:     "no disks found",
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("ExecuteHookJSON() of a failed hook = %#v, want %#v", result, want)
+	}
+}
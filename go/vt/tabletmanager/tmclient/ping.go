@@ -0,0 +1,52 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// PingAll pings all the tablets in parallel, with at most concurrency
+// Ping RPCs in flight at any time. It returns the Ping result for each
+// tablet: a nil error means the tablet is alive.
+// If ctx is done before all tablets were pinged, the remaining tablets
+// get ctx.Err() as their result, and PingAll returns ctx.Err() as well.
+func PingAll(ctx context.Context, tmc TabletManagerClient, tablets []*topodatapb.Tablet, concurrency int) (map[topodatapb.TabletAlias]error, error) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	sema := make(chan struct{}, concurrency)
+
+	mu := sync.Mutex{}
+	result := make(map[topodatapb.TabletAlias]error, len(tablets))
+	wg := sync.WaitGroup{}
+	for _, tablet := range tablets {
+		select {
+		case sema <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			result[*tablet.Alias] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(tablet *topodatapb.Tablet) {
+			defer wg.Done()
+			err := tmc.Ping(ctx, tablet)
+			<-sema
+
+			mu.Lock()
+			result[*tablet.Alias] = err
+			mu.Unlock()
+		}(tablet)
+	}
+	wg.Wait()
+	return result, ctx.Err()
+}
@@ -0,0 +1,105 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// pingFakeClient answers Ping based on the tablet uid:
+// multiples of 3 are dead, multiples of 5 are slow (they never
+// answer before ctx is done), all others are alive.
+type pingFakeClient struct {
+	fakeClient
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (c *pingFakeClient) Ping(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.mu.Lock()
+	c.inFlight++
+	if c.inFlight > c.maxInFlight {
+		c.maxInFlight = c.inFlight
+	}
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.inFlight--
+		c.mu.Unlock()
+	}()
+
+	uid := tablet.Alias.Uid
+	switch {
+	case uid%5 == 0:
+		<-ctx.Done()
+		return ctx.Err()
+	case uid%3 == 0:
+		return fmt.Errorf("connection refused")
+	}
+	time.Sleep(time.Millisecond)
+	return nil
+}
+
+func TestPingAll(t *testing.T) {
+	var tablets []*topodatapb.Tablet
+	for i := uint32(1); i <= 100; i++ {
+		if i%5 == 0 {
+			continue
+		}
+		tablets = append(tablets, newTablet(i))
+	}
+
+	tmc := &pingFakeClient{}
+	result, err := PingAll(context.Background(), tmc, tablets, 4)
+	if err != nil {
+		t.Fatalf("PingAll failed: %v", err)
+	}
+	if len(result) != len(tablets) {
+		t.Fatalf("got %v results, want %v", len(result), len(tablets))
+	}
+	for _, tablet := range tablets {
+		err := result[*tablet.Alias]
+		if dead := tablet.Alias.Uid%3 == 0; dead != (err != nil) {
+			t.Errorf("tablet %v: got %v, want dead=%v", tablet.Alias, err, dead)
+		}
+	}
+	if tmc.maxInFlight > 4 {
+		t.Errorf("got %v concurrent pings, want at most 4", tmc.maxInFlight)
+	}
+}
+
+func TestPingAllTimeout(t *testing.T) {
+	tablets := []*topodatapb.Tablet{newTablet(1), newTablet(3), newTablet(5), newTablet(7), newTablet(10)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	result, err := PingAll(ctx, &pingFakeClient{}, tablets, 1)
+	if err != context.DeadlineExceeded {
+		t.Errorf("PingAll returned %v, want %v", err, context.DeadlineExceeded)
+	}
+	want := map[uint32]error{
+		1:  nil,
+		5:  context.DeadlineExceeded,
+		7:  context.DeadlineExceeded,
+		10: context.DeadlineExceeded,
+	}
+	for uid, wantErr := range want {
+		if got := result[*newTablet(uid).Alias]; got != wantErr {
+			t.Errorf("tablet %v: got %v, want %v", uid, got, wantErr)
+		}
+	}
+	if result[*newTablet(3).Alias] == nil {
+		t.Errorf("tablet 3 should be dead")
+	}
+}
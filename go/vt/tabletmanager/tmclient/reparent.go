@@ -0,0 +1,200 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/concurrency"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// PlannedReparentOptions are the options for PlannedReparent.
+type PlannedReparentOptions struct {
+	// ActionName is recorded in the reparent journal of the new master.
+	// Defaults to "PlannedReparent".
+	ActionName string
+}
+
+// PlannedReparent moves the master role from oldMaster to newMaster,
+// then points all the replicas (and the old master) to the new master.
+// It calls DemoteMaster on the old master to get its final position,
+// then PromoteSlaveWhenCaughtUp on the new master up to that position,
+// and finally PopulateReparentJournal on the new master and SetMaster
+// on everybody else, in parallel.
+//
+// If the new master cannot be promoted, the old master is made
+// read-write again and its state is refreshed, so it keeps serving.
+// Once the new master is promoted, it may have taken writes, and
+// there is no going back: errors are just returned.
+func PlannedReparent(ctx context.Context, tmc TabletManagerClient, oldMaster, newMaster *topodatapb.Tablet, replicas []*topodatapb.Tablet, opts PlannedReparentOptions) error {
+	if topoproto.TabletAliasEqual(oldMaster.Alias, newMaster.Alias) {
+		return fmt.Errorf("new master %v is already the master", topoproto.TabletAliasString(newMaster.Alias))
+	}
+	actionName := opts.ActionName
+	if actionName == "" {
+		actionName = "PlannedReparent"
+	}
+
+	// Demote the current master, get its replication position.
+	log.Infof("demote current master %v", topoproto.TabletAliasString(oldMaster.Alias))
+	rp, err := tmc.DemoteMaster(ctx, oldMaster)
+	if err != nil {
+		return fmt.Errorf("old master tablet %v DemoteMaster failed: %v", topoproto.TabletAliasString(oldMaster.Alias), err)
+	}
+
+	// Wait on the new master until it reaches that position,
+	// then promote it.
+	log.Infof("promote slave %v", topoproto.TabletAliasString(newMaster.Alias))
+	rp, err = tmc.PromoteSlaveWhenCaughtUp(ctx, newMaster, rp)
+	if err != nil {
+		err = fmt.Errorf("new master tablet %v failed to catch up with replication or be upgraded to master: %v", topoproto.TabletAliasString(newMaster.Alias), err)
+		if rbErr := undoDemoteMaster(ctx, tmc, oldMaster); rbErr != nil {
+			return fmt.Errorf("%v, and rolling back the old master failed: %v", err, rbErr)
+		}
+		return err
+	}
+
+	// Create a cancelable context for the following RPCs.
+	// If the master fails, we can cancel all outgoing RPCs.
+	replCtx, replCancel := context.WithCancel(ctx)
+	defer replCancel()
+
+	now := time.Now().UnixNano()
+	var masterErr error
+	wgMaster := sync.WaitGroup{}
+	wgMaster.Add(1)
+	go func() {
+		defer wgMaster.Done()
+		log.Infof("populating reparent journal on new master %v", topoproto.TabletAliasString(newMaster.Alias))
+		masterErr = tmc.PopulateReparentJournal(replCtx, newMaster, newMaster.Keyspace, newMaster.Shard, now, actionName, newMaster.Alias, rp)
+	}()
+
+	// The old master also needs to replicate from the new master,
+	// and to restart replication.
+	wgSlaves := sync.WaitGroup{}
+	rec := concurrency.AllErrorRecorder{}
+	setMaster := func(tablet *topodatapb.Tablet, forceStartSlave bool) {
+		defer wgSlaves.Done()
+		log.Infof("setting new master on slave %v", topoproto.TabletAliasString(tablet.Alias))
+		if err := tmc.SetMaster(replCtx, tablet, newMaster.Keyspace, newMaster.Shard, newMaster.Alias, now, forceStartSlave); err != nil {
+			rec.RecordError(fmt.Errorf("tablet %v SetMaster failed: %v", topoproto.TabletAliasString(tablet.Alias), err))
+		}
+	}
+	wgSlaves.Add(1)
+	go setMaster(oldMaster, true)
+	for _, tablet := range replicas {
+		if topoproto.TabletAliasEqual(tablet.Alias, newMaster.Alias) || topoproto.TabletAliasEqual(tablet.Alias, oldMaster.Alias) {
+			continue
+		}
+		wgSlaves.Add(1)
+		go setMaster(tablet, false)
+	}
+
+	wgMaster.Wait()
+	if masterErr != nil {
+		// The master failed, there is no way the
+		// slaves will work. So we cancel them all.
+		log.Warningf("master failed to PopulateReparentJournal, canceling slaves")
+		replCancel()
+		wgSlaves.Wait()
+		return fmt.Errorf("failed to PopulateReparentJournal on master: %v", masterErr)
+	}
+
+	wgSlaves.Wait()
+	return rec.Error()
+}
+
+// undoDemoteMaster makes a demoted master serve again. DemoteMaster
+// leaves the tablet type alone, so refreshing the state re-enables
+// the query service.
+func undoDemoteMaster(ctx context.Context, tmc TabletManagerClient, tablet *topodatapb.Tablet) error {
+	log.Warningf("rolling back DemoteMaster on %v", topoproto.TabletAliasString(tablet.Alias))
+	if err := tmc.SetReadWrite(ctx, tablet); err != nil {
+		return err
+	}
+	return tmc.RefreshState(ctx, tablet)
+}
+
+// ReparentSubsetOptions are the options for ReparentSubset.
+type ReparentSubsetOptions struct {
+	// Position is the replication position the new master has to
+	// reach before it is promoted. Defaults to its current position.
+	Position string
+	// ForceStartSlave makes SetMaster start replication on the
+	// replicas, even if it was stopped.
+	ForceStartSlave bool
+}
+
+// ReparentSubsetResult is the result of ReparentSubset for a replica.
+type ReparentSubsetResult struct {
+	Tablet *topodatapb.Tablet
+	// Err is the error repointing the replica, if any.
+	Err error
+}
+
+// ReparentSubset promotes newMaster, and points only the given
+// replicas to it, e.g. to move a cell's replicas under a new
+// intermediate master. The other tablets are left alone.
+// It calls PromoteSlaveWhenCaughtUp on the new master, then SetMaster
+// on the replicas, in parallel.
+//
+// If the new master cannot be promoted, no replica is touched. A
+// replica that fails to repoint doesn't roll back the others: the
+// result for each replica is returned, in the order of replicas,
+// with an error naming the replicas that failed.
+func ReparentSubset(ctx context.Context, tmc TabletManagerClient, newMaster *topodatapb.Tablet, replicas []*topodatapb.Tablet, opts ReparentSubsetOptions) ([]*ReparentSubsetResult, error) {
+	pos := opts.Position
+	if pos == "" {
+		var err error
+		pos, err = tmc.MasterPosition(ctx, newMaster)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get the position of new master tablet %v: %v", topoproto.TabletAliasString(newMaster.Alias), err)
+		}
+	}
+
+	log.Infof("promote slave %v", topoproto.TabletAliasString(newMaster.Alias))
+	if _, err := tmc.PromoteSlaveWhenCaughtUp(ctx, newMaster, pos); err != nil {
+		return nil, fmt.Errorf("new master tablet %v failed to catch up with replication or be upgraded to master: %v", topoproto.TabletAliasString(newMaster.Alias), err)
+	}
+
+	var results []*ReparentSubsetResult
+	wg := sync.WaitGroup{}
+	for _, tablet := range replicas {
+		if topoproto.TabletAliasEqual(tablet.Alias, newMaster.Alias) {
+			continue
+		}
+		result := &ReparentSubsetResult{
+			Tablet: tablet,
+		}
+		results = append(results, result)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.Infof("setting new master on slave %v", topoproto.TabletAliasString(result.Tablet.Alias))
+			result.Err = tmc.SetMaster(ctx, result.Tablet, newMaster.Keyspace, newMaster.Shard, newMaster.Alias, 0, opts.ForceStartSlave)
+		}()
+	}
+	wg.Wait()
+
+	var failed []string
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, fmt.Sprintf("%v: %v", topoproto.TabletAliasString(result.Tablet.Alias), result.Err))
+		}
+	}
+	if len(failed) > 0 {
+		return results, fmt.Errorf("new master %v was promoted, but some replicas failed to point to it: [%v]", topoproto.TabletAliasString(newMaster.Alias), strings.Join(failed, ", "))
+	}
+	return results, nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+type reparentFakeClient struct {
+	fakeClient
+	// failPromote makes PromoteSlaveWhenCaughtUp fail.
+	failPromote bool
+}
+
+func (c *reparentFakeClient) DemoteMaster(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	c.record("DemoteMaster", tablet)
+	return "demote_pos", nil
+}
+
+func (c *reparentFakeClient) PromoteSlaveWhenCaughtUp(ctx context.Context, tablet *topodatapb.Tablet, pos string) (string, error) {
+	c.record("PromoteSlaveWhenCaughtUp", tablet)
+	if c.failPromote {
+		return "", fmt.Errorf("replication is broken")
+	}
+	if pos != "demote_pos" {
+		return "", fmt.Errorf("unexpected position %v", pos)
+	}
+	return "promote_pos", nil
+}
+
+func (c *reparentFakeClient) PopulateReparentJournal(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, pos string) error {
+	c.record("PopulateReparentJournal", tablet)
+	if pos != "promote_pos" {
+		return fmt.Errorf("unexpected position %v", pos)
+	}
+	return nil
+}
+
+func (c *reparentFakeClient) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error {
+	c.record("SetMaster", tablet)
+	return nil
+}
+
+func (c *reparentFakeClient) SetReadWrite(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.record("SetReadWrite", tablet)
+	return nil
+}
+
+func (c *reparentFakeClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.record("RefreshState", tablet)
+	return nil
+}
+
+func TestPlannedReparent(t *testing.T) {
+	ctx := context.Background()
+	oldMaster := newTablet(1)
+	newMaster := newTablet(2)
+	replicas := []*topodatapb.Tablet{newMaster, newTablet(3), newTablet(4)}
+
+	tmc := &reparentFakeClient{}
+	if err := PlannedReparent(ctx, tmc, oldMaster, newMaster, replicas, PlannedReparentOptions{}); err != nil {
+		t.Fatalf("PlannedReparent failed: %v", err)
+	}
+	want := []string{
+		"DemoteMaster(cell1-0000000001)",
+		"PopulateReparentJournal(cell1-0000000002)",
+		"PromoteSlaveWhenCaughtUp(cell1-0000000002)",
+		"SetMaster(cell1-0000000001)",
+		"SetMaster(cell1-0000000003)",
+		"SetMaster(cell1-0000000004)",
+	}
+	if got := tmc.sortedCalls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %v, want %v", got, want)
+	}
+	// The first two calls are sequential.
+	if tmc.calls[0] != want[0] || tmc.calls[1] != want[2] {
+		t.Errorf("calls out of order: %v", tmc.calls)
+	}
+}
+
+func TestPlannedReparentRollback(t *testing.T) {
+	ctx := context.Background()
+	oldMaster := newTablet(1)
+	newMaster := newTablet(2)
+	replicas := []*topodatapb.Tablet{newMaster, newTablet(3)}
+
+	tmc := &reparentFakeClient{failPromote: true}
+	err := PlannedReparent(ctx, tmc, oldMaster, newMaster, replicas, PlannedReparentOptions{})
+	if err == nil || !strings.Contains(err.Error(), "replication is broken") {
+		t.Fatalf("PlannedReparent returned %v, want promotion error", err)
+	}
+	want := []string{
+		"DemoteMaster(cell1-0000000001)",
+		"PromoteSlaveWhenCaughtUp(cell1-0000000002)",
+		"SetReadWrite(cell1-0000000001)",
+		"RefreshState(cell1-0000000001)",
+	}
+	if !reflect.DeepEqual(tmc.calls, want) {
+		t.Errorf("calls = %v, want %v", tmc.calls, want)
+	}
+}
+
+// reparentSubsetFakeClient makes SetMaster fail on some tablets.
+type reparentSubsetFakeClient struct {
+	reparentFakeClient
+	failSetMaster map[uint32]bool
+}
+
+func (c *reparentSubsetFakeClient) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error {
+	c.record("SetMaster", tablet)
+	if c.failSetMaster[tablet.Alias.Uid] {
+		return fmt.Errorf("cannot connect to master")
+	}
+	return nil
+}
+
+func TestReparentSubset(t *testing.T) {
+	ctx := context.Background()
+	newMaster := newTablet(2)
+	// Tablets 5 and 6 are not in the subset, and are not touched.
+	replicas := []*topodatapb.Tablet{newMaster, newTablet(3), newTablet(4)}
+
+	tmc := &reparentSubsetFakeClient{
+		failSetMaster: map[uint32]bool{4: true},
+	}
+	results, err := ReparentSubset(ctx, tmc, newMaster, replicas, ReparentSubsetOptions{Position: "demote_pos"})
+	if err == nil || !strings.Contains(err.Error(), "[cell1-0000000004: cannot connect to master]") {
+		t.Fatalf("ReparentSubset returned %v, want error for cell1-0000000004", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %v results, want 2", len(results))
+	}
+	if results[0].Tablet != replicas[1] || results[0].Err != nil {
+		t.Errorf("result 0 is %v %v, want cell1-0000000003 with no error", results[0].Tablet.Alias, results[0].Err)
+	}
+	if results[1].Tablet != replicas[2] || results[1].Err == nil {
+		t.Errorf("result 1 is %v %v, want cell1-0000000004 with an error", results[1].Tablet.Alias, results[1].Err)
+	}
+	want := []string{
+		"PromoteSlaveWhenCaughtUp(cell1-0000000002)",
+		"SetMaster(cell1-0000000003)",
+		"SetMaster(cell1-0000000004)",
+	}
+	if got := tmc.sortedCalls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %v, want %v", got, want)
+	}
+
+	// If the promotion fails, no replica is touched.
+	tmc = &reparentSubsetFakeClient{}
+	tmc.failPromote = true
+	if _, err := ReparentSubset(ctx, tmc, newMaster, replicas, ReparentSubsetOptions{Position: "demote_pos"}); err == nil || !strings.Contains(err.Error(), "replication is broken") {
+		t.Errorf("ReparentSubset returned %v, want promotion error", err)
+	}
+	if want := []string{"PromoteSlaveWhenCaughtUp(cell1-0000000002)"}; !reflect.DeepEqual(tmc.calls, want) {
+		t.Errorf("calls = %v, want %v", tmc.calls, want)
+	}
+}
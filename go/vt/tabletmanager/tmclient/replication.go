@@ -0,0 +1,127 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// StopAtPositionResult is the result of StopAllAtPosition for a
+// replica.
+type StopAtPositionResult struct {
+	Tablet *topodatapb.Tablet
+	// Position is the position replication was stopped at.
+	Position replication.Position
+	// Past is set if the replica was already past the target
+	// position before the call, so it was stopped right away, at its
+	// own position.
+	Past bool
+	// Overshot is set if the replica was behind the target position,
+	// and went past it while catching up before it was stopped.
+	Overshot bool
+	// Err is the error stopping the replica, e.g. if it did not
+	// reach the target position in time.
+	Err error
+}
+
+// StopAllAtPosition stops replication on all the replicas at targetPos,
+// e.g. to get a consistent stop point across a shard for maintenance.
+// It calls StopSlaveMinimum on all the replicas in parallel, each one
+// waiting up to waitTime to reach targetPos.
+//
+// StopSlaveMinimum stops replication once the replica is at least at
+// targetPos, not exactly at it: a replica that is catching up while
+// the master takes writes usually applies a few more transactions
+// before it is stopped. Only replicas that are idle at targetPos, e.g.
+// because the master stopped taking writes, stop exactly at it.
+//
+// It returns the result for each replica, in the order of replicas.
+// If any replica could not be stopped exactly at targetPos, because
+// it was already past it, because it went past it while catching up,
+// or because it failed to reach it, it also returns an error naming
+// those replicas. Replication stays stopped on all the replicas that
+// were stopped.
+func StopAllAtPosition(ctx context.Context, tmc TabletManagerClient, replicas []*topodatapb.Tablet, targetPos replication.Position, waitTime time.Duration) ([]*StopAtPositionResult, error) {
+	encodedPos := replication.EncodePosition(targetPos)
+	results := make([]*StopAtPositionResult, len(replicas))
+	wg := sync.WaitGroup{}
+	for i, tablet := range replicas {
+		results[i] = &StopAtPositionResult{
+			Tablet: tablet,
+		}
+		wg.Add(1)
+		go func(result *StopAtPositionResult) {
+			defer wg.Done()
+			status, err := tmc.SlaveStatus(ctx, result.Tablet)
+			if err != nil {
+				result.Err = fmt.Errorf("cannot get replication status: %v", err)
+				return
+			}
+			startPos, err := replication.DecodePosition(status.Position)
+			if err != nil {
+				result.Err = err
+				return
+			}
+			pos, err := tmc.StopSlaveMinimum(ctx, result.Tablet, encodedPos, waitTime)
+			if err != nil {
+				result.Err = err
+				return
+			}
+			result.Position, result.Err = replication.DecodePosition(pos)
+			if result.Err != nil {
+				return
+			}
+			if !result.Position.AtLeast(targetPos) {
+				result.Err = fmt.Errorf("replication stopped at %v, before %v", result.Position, targetPos)
+				return
+			}
+			if !result.Position.Equal(targetPos) {
+				if startPos.AtLeast(targetPos) {
+					result.Past = true
+				} else {
+					result.Overshot = true
+				}
+			}
+		}(results[i])
+	}
+	wg.Wait()
+
+	var past, overshot, failed []string
+	for _, result := range results {
+		alias := topoproto.TabletAliasString(result.Tablet.Alias)
+		switch {
+		case result.Err != nil:
+			failed = append(failed, fmt.Sprintf("%v: %v", alias, result.Err))
+		case result.Past:
+			past = append(past, fmt.Sprintf("%v: at %v", alias, result.Position))
+		case result.Overshot:
+			overshot = append(overshot, fmt.Sprintf("%v: at %v", alias, result.Position))
+		}
+	}
+	var errs []string
+	if len(past) > 0 {
+		errs = append(errs, fmt.Sprintf("already past it [%v]", strings.Join(past, ", ")))
+	}
+	if len(overshot) > 0 {
+		errs = append(errs, fmt.Sprintf("went past it while catching up [%v]", strings.Join(overshot, ", ")))
+	}
+	if len(failed) > 0 {
+		errs = append(errs, fmt.Sprintf("failed [%v]", strings.Join(failed, ", ")))
+	}
+	if len(errs) > 0 {
+		return results, fmt.Errorf("replicas are not all stopped at %v: %v", targetPos, strings.Join(errs, ", "))
+	}
+	return results, nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn/replication"
+
+	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// stopSlaveMinimumFakeClient simulates replicas at positions[uid],
+// that can replicate up to the target position, except the ones that
+// are stuck, and the ones that overshoot it and stop at overshoot[uid].
+type stopSlaveMinimumFakeClient struct {
+	fakeClient
+	positions map[uint32]string
+	stuck     map[uint32]bool
+	overshoot map[uint32]string
+}
+
+func (c *stopSlaveMinimumFakeClient) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	return &replicationdatapb.Status{
+		Position: replication.EncodePosition(replication.MustParsePosition("MariaDB", c.positions[tablet.Alias.Uid])),
+	}, nil
+}
+
+func (c *stopSlaveMinimumFakeClient) StopSlaveMinimum(ctx context.Context, tablet *topodatapb.Tablet, stopPos string, waitTime time.Duration) (string, error) {
+	c.record("StopSlaveMinimum", tablet)
+	target, err := replication.DecodePosition(stopPos)
+	if err != nil {
+		return "", err
+	}
+	pos := replication.MustParsePosition("MariaDB", c.positions[tablet.Alias.Uid])
+	if pos.AtLeast(target) {
+		return replication.EncodePosition(pos), nil
+	}
+	if c.stuck[tablet.Alias.Uid] {
+		return "", fmt.Errorf("timed out waiting for %v", target)
+	}
+	if overshoot, ok := c.overshoot[tablet.Alias.Uid]; ok {
+		return replication.EncodePosition(replication.MustParsePosition("MariaDB", overshoot)), nil
+	}
+	return stopPos, nil
+}
+
+func TestStopAllAtPosition(t *testing.T) {
+	targetPos := replication.MustParsePosition("MariaDB", "0-1-100")
+	replicas := []*topodatapb.Tablet{newTablet(1), newTablet(2), newTablet(3), newTablet(4), newTablet(5)}
+	tmc := &stopSlaveMinimumFakeClient{
+		positions: map[uint32]string{
+			// Behind, catches up.
+			1: "0-1-90",
+			// Exactly at the target.
+			2: "0-1-100",
+			// Already past the target.
+			3: "0-1-110",
+			// Behind, and does not catch up in time.
+			4: "0-1-50",
+			// Behind, and goes past the target while catching up.
+			5: "0-1-80",
+		},
+		stuck:     map[uint32]bool{4: true},
+		overshoot: map[uint32]string{5: "0-1-105"},
+	}
+
+	results, err := StopAllAtPosition(context.Background(), tmc, replicas, targetPos, time.Minute)
+	if err == nil {
+		t.Fatalf("StopAllAtPosition worked, want an error")
+	}
+	for _, want := range []string{
+		"already past it [cell1-0000000003: at 0-1-110]",
+		"went past it while catching up [cell1-0000000005: at 0-1-105]",
+		"failed [cell1-0000000004: timed out waiting for 0-1-100]",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("StopAllAtPosition returned %v, want it to contain %q", err, want)
+		}
+	}
+
+	if len(results) != len(replicas) {
+		t.Fatalf("got %v results, want %v", len(results), len(replicas))
+	}
+	for i, want := range []struct {
+		pos      string
+		past     bool
+		overshot bool
+		err      bool
+	}{
+		{"0-1-100", false, false, false},
+		{"0-1-100", false, false, false},
+		{"0-1-110", true, false, false},
+		{"", false, false, true},
+		{"0-1-105", false, true, false},
+	} {
+		result := results[i]
+		if result.Tablet != replicas[i] {
+			t.Errorf("result %v is for %v, want %v", i, result.Tablet.Alias, replicas[i].Alias)
+		}
+		if (result.Err != nil) != want.err || result.Past != want.past || result.Overshot != want.overshot {
+			t.Errorf("result %v: past %v, overshot %v, error %v, want past %v, overshot %v and error %v", i, result.Past, result.Overshot, result.Err, want.past, want.overshot, want.err)
+		}
+		if want.pos != "" && !result.Position.Equal(replication.MustParsePosition("MariaDB", want.pos)) {
+			t.Errorf("result %v stopped at %v, want %v", i, result.Position, want.pos)
+		}
+	}
+
+	wantCalls := []string{
+		"StopSlaveMinimum(cell1-0000000001)",
+		"StopSlaveMinimum(cell1-0000000002)",
+		"StopSlaveMinimum(cell1-0000000003)",
+		"StopSlaveMinimum(cell1-0000000004)",
+		"StopSlaveMinimum(cell1-0000000005)",
+	}
+	if got := tmc.sortedCalls(); !reflect.DeepEqual(got, wantCalls) {
+		t.Errorf("calls = %v, want %v", got, wantCalls)
+	}
+
+	// When they all reach the target, there is no error.
+	results, err = StopAllAtPosition(context.Background(), tmc, replicas[:2], targetPos, time.Minute)
+	if err != nil {
+		t.Errorf("StopAllAtPosition failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("got %v results, want 2", len(results))
+	}
+}
@@ -5,6 +5,7 @@
 package tmclient
 
 import (
+	"errors"
 	"flag"
 	"time"
 
@@ -14,17 +15,177 @@ import (
 	"github.com/youtube/vitess/go/vt/mysqlctl/tmutils"
 	"golang.org/x/net/context"
 
+	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vschemapb "github.com/youtube/vitess/go/vt/proto/vschema"
 )
 
 // TabletManagerProtocol is the implementation to use for tablet
 // manager protocol. It is exported for tests only.
 var TabletManagerProtocol = flag.String("tablet_manager_protocol", "grpc", "the protocol to use to talk to vttablet")
 
-// TabletManagerClient defines the interface used to talk to a remote tablet
+// ErrRestoreAborted is returned by the RestoreFromBackup event stream
+// when the restore was aborted by canceling its context. The remote
+// tablet then removes the partially restored files.
+var ErrRestoreAborted = errors.New("restore aborted")
+
+// The modes of SetThrottle.
+const (
+	// ThrottleModeAuto throttles heavy operations, IncrementalBackup
+	// and TestRestore, when the replication lag is above the tablet
+	// threshold.
+	ThrottleModeAuto = "auto"
+	// ThrottleModeForceOn always throttles heavy operations, so they
+	// are refused.
+	ThrottleModeForceOn = "force_on"
+	// ThrottleModeForceOff never throttles heavy operations.
+	ThrottleModeForceOff = "force_off"
+)
+
+// BackupAgeNever is the age returned by GetBackupFreshness when the
+// shard never had a complete backup.
+const BackupAgeNever = time.Duration(-1)
+
+// RestartMysqlOptions are the options for RestartMysql.
+type RestartMysqlOptions struct {
+	// HealthyTimeout is how long to wait for the restarted mysqld
+	// to be healthy. Defaults to the tablet
+	// -restart_mysql_healthy_timeout.
+	HealthyTimeout time.Duration
+}
+
+// DecommissionOptions are the options for Decommission.
+type DecommissionOptions struct {
+	// StopReplication stops replication once the tablet is drained.
+	StopReplication bool
+	// StopMysqld stops mysqld once the tablet is drained. It
+	// implies StopReplication.
+	StopMysqld bool
+}
+
+// RenameTableOptions are the options for RenameTable.
+type RenameTableOptions struct {
+	// Cascade renames the table even if views or foreign keys
+	// depend on it. The views are recreated on the new name, and
+	// InnoDB makes the foreign keys follow the rename.
+	Cascade bool
+}
+
+// IncrementalBackupOptions are the options for IncrementalBackup.
+type IncrementalBackupOptions struct {
+	// Concurrency is the number of binlogs copied at the same
+	// time. Like for Backup, 0 uses the tablet default, and a
+	// concurrency over the tablet maximum is capped to it.
+	Concurrency int
+}
+
+// ReplicationOptions are the options for ConfigureReplication.
+type ReplicationOptions struct {
+	// AutoPosition replicates from the GTID position of the tablet.
+	// File and Position must then be empty.
+	AutoPosition bool
+
+	// File and Position are the binlog coordinates of the parent
+	// to replicate from, when AutoPosition is not set.
+	File     string
+	Position uint64
+
+	// ForceStartSlave starts replication even if it was not running.
+	ForceStartSlave bool
+}
+
+// CloneOptions are the options for CloneStream.
+type CloneOptions struct {
+	// BufferSize is the approximate size in bytes of the row
+	// batches. 0 uses a default size.
+	BufferSize int
+}
+
+// WarmUpStream is the stream returned by WarmUp.
+type WarmUpStream interface {
+	// Recv returns the next progress report. It returns io.EOF
+	// after the last one, which has Done set.
+	Recv() (*tabletmanagerdatapb.WarmUpResponse, error)
+}
+
+// HookStream is the stream returned by ExecuteHookToStream.
+type HookStream interface {
+	// Recv returns the next chunk of the hook's stdout. It returns
+	// io.EOF after the last response, which has Done set and the
+	// exit status of the hook.
+	Recv() (*tabletmanagerdatapb.ExecuteHookToStreamResponse, error)
+}
+
+// SchemaChangeStream is the stream returned by WatchSchema.
+type SchemaChangeStream interface {
+	// Recv returns the next schema change. The stream only ends
+	// when the context is canceled or on error.
+	Recv() (*tabletmanagerdatapb.SchemaChangeEvent, error)
+}
+
+// CSVStream is the stream returned by ExecuteFetchAsDbaCSV.
+type CSVStream interface {
+	// Recv returns the next chunk of CSV data. It returns io.EOF
+	// once all the rows were received.
+	Recv() ([]byte, error)
+}
+
+// BinlogTransactionStream is the stream returned by
+// StreamKeyRangeBinlog.
+type BinlogTransactionStream interface {
+	// Recv returns the next transaction. It returns io.EOF once
+	// the stream ends.
+	Recv() (*binlogdatapb.BinlogTransaction, error)
+}
+
+// RowStream is the stream returned by StreamRowsInKeyRange.
+type RowStream interface {
+	// Recv returns the next batch of rows. The first result only
+	// has the fields. It returns io.EOF once all the rows were
+	// received.
+	Recv() (*querypb.QueryResult, error)
+}
+
+// CloneStream is the stream returned by CloneStream.
+type CloneStream interface {
+	// Recv returns the next message. The first one only has the
+	// replication position of the snapshot, the next ones have the
+	// fields, then the rows, of each table in turn. It returns
+	// io.EOF once all the rows were received.
+	Recv() (*tabletmanagerdatapb.CloneStreamResponse, error)
+}
+
+// BoostReplicationCatchupStream is the stream returned by
+// BoostReplicationCatchup.
+type BoostReplicationCatchupStream interface {
+	// Recv returns the next message. The first one has the boosted
+	// settings, the next ones the replication lag, and the last one
+	// the restored settings, with Reverted set. It returns io.EOF
+	// after that.
+	Recv() (*tabletmanagerdatapb.BoostReplicationCatchupResponse, error)
+}
+
+// StopSlaveMinimumStream is the stream returned by StopSlaveMinimumStream.
+type StopSlaveMinimumStream interface {
+	// Recv returns the next message. All of them but the last one
+	// have the current slave position. The last one has the position
+	// replication was stopped at, and Stopped set. It returns io.EOF
+	// after that.
+	Recv() (*tabletmanagerdatapb.StopSlaveMinimumStreamResponse, error)
+}
+
+// GeneralLogStream is the stream returned by TailGeneralLog.
+type GeneralLogStream interface {
+	// Recv returns the next general log entry. It returns io.EOF
+	// once the duration has passed.
+	Recv() (string, error)
+}
+
+// TabletManagerClient defines the interface used to talk to a remote tablet.
+// Failed calls return a *RPCError, identifying the tablet and the method.
 type TabletManagerClient interface {
 	//
 	// Various read-only methods
@@ -36,9 +197,81 @@ type TabletManagerClient interface {
 	// GetSchema asks the remote tablet for its database schema
 	GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error)
 
+	// GetSchemaBestEffort is like GetSchema, but the tablet spends at
+	// most timeout gathering the schema. If that is not enough, it
+	// returns the tables gathered so far and true. timeout should be
+	// shorter than the deadline of ctx, for the reply to make it back.
+	GetSchemaBestEffort(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool, timeout time.Duration) (*tabletmanagerdatapb.SchemaDefinition, bool, error)
+
+	// GetCreateStatements asks the remote tablet for the raw CREATE
+	// statements of its tables, by name
+	GetCreateStatements(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (map[string]string, error)
+
+	// GetSchemaTimestamps asks the remote tablet when its tables were
+	// created or last rebuilt by an ALTER TABLE, by name. If tables is
+	// empty, it returns all tables.
+	GetSchemaTimestamps(ctx context.Context, tablet *topodatapb.Tablet, tables []string) (map[string]time.Time, error)
+
 	// GetPermissions asks the remote tablet for its permissions list
 	GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error)
 
+	// GetConnectionStats asks the remote tablet for its current
+	// connection and transaction counts
+	GetConnectionStats(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ConnectionStats, error)
+
+	// GetConnectionRejectionStats asks the remote tablet for the number
+	// of connection requests its query service rejected since the last
+	// reset, by reason (pool exhausted, max_connections reached...).
+	// If reset is set, the counts are cleared as they are read.
+	GetConnectionRejectionStats(ctx context.Context, tablet *topodatapb.Tablet, reset bool) (map[string]int64, error)
+
+	// GetConfig asks the remote tablet for the current value of each
+	// of its command line flags
+	GetConfig(ctx context.Context, tablet *topodatapb.Tablet) (map[string]string, error)
+
+	// GetInFlightRPCs asks the remote tablet for the number of RPCs
+	// its tablet manager is processing, by method name. A controller
+	// can use it to back off from a saturated tablet manager.
+	GetInFlightRPCs(ctx context.Context, tablet *topodatapb.Tablet) (map[string]int64, error)
+
+	// GetProcessStats asks the remote tablet for the resource usage
+	// of its process: goroutines, threads, open files and memory.
+	// Sampled over time, it shows a tablet manager leaking them.
+	GetProcessStats(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ProcessStats, error)
+
+	// GetHealthScore asks the remote tablet for its composite health
+	// score, between 0 and 100, and the replication lag, error rate
+	// and load factors it is computed from. Routing can compare the
+	// scores of tablets instead of their raw health stats.
+	GetHealthScore(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.HealthScore, error)
+
+	// GetErrorLogTail asks the remote tablet for the last lines of its
+	// MySQL error log, oldest first. If the log has fewer lines, all
+	// of them are returned.
+	GetErrorLogTail(ctx context.Context, tablet *topodatapb.Tablet, lines int) ([]string, error)
+
+	// CaptureDiagnostics asks the remote tablet for its processlist,
+	// slave status, error log tail, key MySQL variables and data
+	// directory disk usage, in one call. Sections that could not be
+	// captured are listed in the Errors of the bundle.
+	CaptureDiagnostics(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.DiagnosticBundle, error)
+
+	// CheckDataDir asks the remote tablet for the problems with its
+	// data directory, binlog directory and socket path: whether they
+	// exist, with the right owner and permissions for mysqld. It
+	// returns an empty list if there is none.
+	CheckDataDir(ctx context.Context, tablet *topodatapb.Tablet) ([]string, error)
+
+	// GetThrottleState asks the remote tablet whether its heavy
+	// operations, IncrementalBackup and TestRestore, are throttled,
+	// with the metric and threshold that decide it. They are refused
+	// while throttled.
+	GetThrottleState(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ThrottleState, error)
+
+	// GetTabletTags returns the tags of the remote tablet, as stored
+	// in its tablet record.
+	GetTabletTags(ctx context.Context, tablet *topodatapb.Tablet) (map[string]string, error)
+
 	//
 	// Various read-write methods
 	//
@@ -49,8 +282,52 @@ type TabletManagerClient interface {
 	// SetReadWrite makes the mysql instance read-write
 	SetReadWrite(ctx context.Context, tablet *topodatapb.Tablet) error
 
-	// ChangeType asks the remote tablet to change its type
-	ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType) error
+	// SetReadOnlyWithTTL makes the mysql instance of a master
+	// read-only for ttl, after which it goes back to read-write on
+	// its own, even across tablet restarts. Calling it again extends
+	// or shortens the read-only period, and a ttl of 0 makes the
+	// instance read-write right away. SetReadOnly and SetReadWrite
+	// cancel the pending revert. On other tablet types, a ttl of 0
+	// only cancels a pending revert.
+	SetReadOnlyWithTTL(ctx context.Context, tablet *topodatapb.Tablet, ttl time.Duration) error
+
+	// SetSuperReadOnly sets or clears super_read_only on the mysql
+	// instance, and returns its resulting value, as read back from
+	// mysql. Unlike read_only, super_read_only also prevents SUPER
+	// users, like replication, from writing, so it fences an old
+	// master better during a reparent. The error is
+	// ErrSuperReadOnlyNotSupported if the MySQL version doesn't have
+	// super_read_only.
+	SetSuperReadOnly(ctx context.Context, tablet *topodatapb.Tablet, on bool) (bool, error)
+
+	// SetQueryServerConfig changes the query server settings of
+	// config that are set, without a restart. It returns the
+	// resulting settings, and the names of the settings that cannot
+	// change at runtime, which were left unchanged. If a setting is
+	// invalid, for instance a pool size above the maximum set by
+	// -queryserver-config-pool-max-size-factor, none is changed.
+	SetQueryServerConfig(ctx context.Context, tablet *topodatapb.Tablet, config *tabletmanagerdatapb.QueryServerConfig) (*tabletmanagerdatapb.QueryServerConfig, []string, error)
+
+	// GetQueryBlacklist returns the query patterns the tablet is
+	// rejecting.
+	GetQueryBlacklist(ctx context.Context, tablet *topodatapb.Tablet) ([]string, error)
+
+	// SetQueryBlacklist replaces the query patterns the tablet is
+	// rejecting. The patterns are regular expressions matching the
+	// full query. An empty list clears the blacklist.
+	SetQueryBlacklist(ctx context.Context, tablet *topodatapb.Tablet, patterns []string) error
+
+	// ChangeType asks the remote tablet to change its type. If
+	// idempotent is set, and the tablet already has that type, it
+	// does nothing: the tablet record is not updated, and the tablet
+	// state is not refreshed.
+	ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType, idempotent bool) error
+
+	// SetTabletTags asks the remote tablet to merge tags into the
+	// tags of its tablet record: a tag with an empty value is
+	// removed, the others are added or replace the current value.
+	// Tag keys cannot be empty.
+	SetTabletTags(ctx context.Context, tablet *topodatapb.Tablet, tags map[string]string) error
 
 	// Sleep will sleep for a duration (used for tests)
 	Sleep(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) error
@@ -58,15 +335,96 @@ type TabletManagerClient interface {
 	// ExecuteHook executes the provided hook remotely
 	ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (*hook.HookResult, error)
 
+	// ExecuteHookToStream executes the provided hook remotely, and
+	// streams its stdout as it is produced, for hooks with large
+	// outputs. The last response carries the exit status. Canceling
+	// ctx kills the hook.
+	ExecuteHookToStream(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (HookStream, error)
+
 	// RefreshState asks the remote tablet to reload its tablet record
 	RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error
 
+	// RepublishTopoRecord asks the remote tablet to rewrite its tablet
+	// record in the topology from its in-memory copy, to recover from
+	// a lost or corrupted record. It returns the version written.
+	RepublishTopoRecord(ctx context.Context, tablet *topodatapb.Tablet) (int64, error)
+
 	// RunHealthCheck asks the remote tablet to run a health check cycle
 	RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error
 
 	// IgnoreHealthError sets the regexp for health errors to ignore.
 	IgnoreHealthError(ctx context.Context, tablet *topodatapb.Tablet, pattern string) error
 
+	// SetMaintenanceMode sets (on is true) or clears a maintenance
+	// marker on the tablet, with the reason for it. The marker is
+	// persisted, so it survives tablet restarts. A tablet in
+	// maintenance is not a reparent candidate.
+	SetMaintenanceMode(ctx context.Context, tablet *topodatapb.Tablet, on bool, reason string) error
+
+	// PauseHealthReporting pauses (on is true) or resumes the
+	// publication of health changes by the tablet. While paused, the
+	// tablet keeps running its health checks, which still start and
+	// stop its query service, but keeps reporting the health error and
+	// replication delay it had when paused. Reporting resumes on
+	// its own after -health_reporting_max_pause.
+	PauseHealthReporting(ctx context.Context, tablet *topodatapb.Tablet, on bool) error
+
+	// SetThrottle sets the throttle mode of the heavy operations of
+	// the tablet: one of the ThrottleMode constants. A forced mode
+	// goes back to ThrottleModeAuto after duration, which must be
+	// positive, so an override cannot be forgotten.
+	SetThrottle(ctx context.Context, tablet *topodatapb.Tablet, mode string, duration time.Duration) error
+
+	// PrepareCutover is the first phase of a cutover: it makes the
+	// tablet read-only and returns a token for the second phase. A
+	// coordinator prepares all the tablets of a cutover, then commits
+	// or aborts them all. A cutover neither committed nor aborted
+	// within -cutover_prepare_timeout is aborted by the tablet.
+	PrepareCutover(ctx context.Context, tablet *topodatapb.Tablet) (string, error)
+
+	// CommitCutover completes the cutover prepared with token: the
+	// tablet applies the serving state saved in the topology since
+	// PrepareCutover. It stays read-only only if it stopped serving.
+	CommitCutover(ctx context.Context, tablet *topodatapb.Tablet, token string) error
+
+	// AbortCutover cancels the cutover prepared with token, restoring
+	// the read-only state the tablet had before.
+	AbortCutover(ctx context.Context, tablet *topodatapb.Tablet, token string) error
+
+	// SetServingKeyRange replaces, in one step, the keyrange the
+	// tablet serves and the tablet types it serves it for. It fails
+	// if keyRange is not within the keyrange of the tablet's shard.
+	// It returns once the query service rejects the queries with a
+	// keyspace_id outside keyRange.
+	SetServingKeyRange(ctx context.Context, tablet *topodatapb.Tablet, keyRange *topodatapb.KeyRange, servedTypes []topodatapb.TabletType) error
+
+	// RestartMysql restarts mysqld on a non-master tablet: the tablet
+	// is made read-only and stops serving, mysqld is stopped and
+	// started, and the tablet serves again once it is healthy. If
+	// mysqld does not come back healthy, the tablet is left SPARE.
+	// The stream returns the progress, and the error if any.
+	RestartMysql(ctx context.Context, tablet *topodatapb.Tablet, opts RestartMysqlOptions) (logutil.EventStream, error)
+
+	// Decommission takes a non-master tablet out of service: it is
+	// made read-only, drained by changing its type to SPARE, and
+	// replication and mysqld are optionally stopped. A master tablet
+	// is refused, it must be reparented away from first. The stream
+	// returns the progress, and the error if any. It ends once the
+	// tablet no longer serves.
+	Decommission(ctx context.Context, tablet *topodatapb.Tablet, opts DecommissionOptions) (logutil.EventStream, error)
+
+	// CheckTopoConnectivity asks the tablet to read its own record,
+	// and its shard record, from the topo server. It returns whether
+	// both reads worked, and how long they took. An error means the
+	// tablet itself could not be asked, so reachable is false with a
+	// nil error when only the topo server is out of reach.
+	CheckTopoConnectivity(ctx context.Context, tablet *topodatapb.Tablet) (reachable bool, latency time.Duration, err error)
+
+	// WarmUp runs the queries on the remote tablet, and loads its
+	// InnoDB buffer pool if loadBufferPool is set, streaming the
+	// progress. See WarmUpTablet for a blocking version.
+	WarmUp(ctx context.Context, tablet *topodatapb.Tablet, queries []string, loadBufferPool bool) (WarmUpStream, error)
+
 	// ReloadSchema asks the remote tablet to reload its schema
 	ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error
 
@@ -76,10 +434,50 @@ type TabletManagerClient interface {
 	// ApplySchema will apply a schema change
 	ApplySchema(ctx context.Context, tablet *topodatapb.Tablet, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error)
 
+	// SchemaDiff returns the DDL statements that would turn the schema
+	// of the tablet into the desired one, in the order they should be
+	// run. Columns that may have been renamed are an error, as the
+	// change is ambiguous.
+	SchemaDiff(ctx context.Context, tablet *topodatapb.Tablet, desired *tabletmanagerdatapb.SchemaDefinition) ([]string, error)
+
+	// AssessSchemaChange returns how MySQL would run the ALTER TABLE
+	// change on the tablet: the online DDL algorithm, the size of the
+	// table, and an estimate of how long writes would be blocked.
+	// The change is not run.
+	AssessSchemaChange(ctx context.Context, tablet *topodatapb.Tablet, change string) (*tabletmanagerdatapb.SchemaChangeAssessment, error)
+
+	// WatchSchema streams the changes to the tables and views of the
+	// tablet: one event per changed object, in the order the changes
+	// were seen. Changes are seen when the query service of the tablet
+	// sees them: on its periodic or requested schema reloads, and on
+	// the DDLs it runs.
+	WatchSchema(ctx context.Context, tablet *topodatapb.Tablet) (SchemaChangeStream, error)
+
+	// GetVSchema returns the VSchema of the tablet keyspace.
+	GetVSchema(ctx context.Context, tablet *topodatapb.Tablet) (*vschemapb.Keyspace, error)
+
+	// ApplyVSchema saves the given JSON encoded VSchema for the tablet
+	// keyspace and rebuilds the SrvVSchema of the tablet cell, so
+	// query routing uses it. It is meant for local testing. An invalid
+	// VSchema is rejected.
+	ApplyVSchema(ctx context.Context, tablet *topodatapb.Tablet, vschema string) error
+
 	// ExecuteFetchAsDba executes a query remotely using the DBA pool.
 	// If usePool is set, a connection pool may be used to make the
 	// query faster. Close() should close the pool in that case.
-	ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error)
+	// If maxExecTime is not 0, mysql aborts the query after running it
+	// for that long, and the error is ErrMaxExecTimeExceeded.
+	ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool, maxExecTime time.Duration) (*querypb.QueryResult, error)
+
+	// ExecuteFetchAsDbaCSV executes a query remotely using the DBA
+	// user, and streams the rows encoded as CSV, after a header row
+	// of field names. There is no limit on the number of rows.
+	ExecuteFetchAsDbaCSV(ctx context.Context, tablet *topodatapb.Tablet, query []byte) (CSVStream, error)
+
+	// ExecuteFetchColumnar executes a query remotely using the DBA
+	// user, and returns the result in columnar form. It is meant for
+	// results with many rows, which take much less memory that way.
+	ExecuteFetchColumnar(ctx context.Context, tablet *topodatapb.Tablet, query []byte, maxRows int) (*tabletmanagerdatapb.ColumnarResult, error)
 
 	// ExecuteFetchAsAllPrivs executes a query remotely using the allprivs user.
 	ExecuteFetchAsAllPrivs(ctx context.Context, tablet *topodatapb.Tablet, query []byte, maxRows int, reloadSchema bool) (*querypb.QueryResult, error)
@@ -89,6 +487,84 @@ type TabletManagerClient interface {
 	// query faster. Close() should close the pool in that case.
 	ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int) (*querypb.QueryResult, error)
 
+	// ExplainQuery returns the execution plan of query on the tablet,
+	// computed by MySQL without running the query. format is
+	// TRADITIONAL (or empty) or JSON. Only SELECT, INSERT, UPDATE
+	// and DELETE statements can be explained.
+	ExplainQuery(ctx context.Context, tablet *topodatapb.Tablet, query, format string) (*querypb.QueryResult, error)
+
+	// ApplyGrants runs a batch of GRANT and REVOKE statements on the
+	// tablet, in order. With atomic, the grants of the accounts they
+	// apply to are restored if one of them fails, and the accounts
+	// that didn't exist are dropped. MySQL cannot run them in a
+	// transaction, so the restore is best effort. The error names the
+	// failing statement.
+	ApplyGrants(ctx context.Context, tablet *topodatapb.Tablet, statements []string, atomic bool) error
+
+	// ChecksumTable returns a checksum of the rows of the table that
+	// are in keyRange (all rows if keyRange is nil), and their count.
+	// The checksum does not depend on the order of the rows, so it can
+	// be compared across tablets.
+	ChecksumTable(ctx context.Context, tablet *topodatapb.Tablet, table string, keyRange *topodatapb.KeyRange) (checksum uint64, rowCount int64, err error)
+
+	// TruncateTable removes all the rows of the table. As a guard
+	// against truncating a table of the wrong keyspace, e.g. a
+	// production one, it fails unless confirmKeyspace is the keyspace
+	// of the tablet.
+	TruncateTable(ctx context.Context, tablet *topodatapb.Tablet, table, confirmKeyspace string) error
+
+	// RenameTable renames the table from to to. It first looks for
+	// the views and the foreign keys that depend on the table: if
+	// there are some, the table is only renamed with opts.Cascade.
+	// The result lists them, and whether the table was renamed.
+	RenameTable(ctx context.Context, tablet *topodatapb.Tablet, from, to string, opts RenameTableOptions) (*tabletmanagerdatapb.RenameTableResult, error)
+
+	// StreamRowsInKeyRange streams the rows of the table that are in
+	// keyRange (all rows if keyRange is nil), in primary key order,
+	// so the streams of several shards can be merged and compared.
+	// Text primary key columns are ordered by their bytes, whatever
+	// their collation.
+	// The rows are a consistent snapshot of the table.
+	StreamRowsInKeyRange(ctx context.Context, tablet *topodatapb.Tablet, table string, keyRange *topodatapb.KeyRange) (RowStream, error)
+
+	// StreamKeyRangeBinlog streams the binlog transactions that touch
+	// rows in keyRange, starting at the replication position
+	// startPos. The statements outside of keyRange are removed,
+	// multi-row inserts spanning the boundary are split, and the
+	// transactions left with no statement are not sent.
+	StreamKeyRangeBinlog(ctx context.Context, tablet *topodatapb.Tablet, keyRange *topodatapb.KeyRange, startPos string, charset *binlogdatapb.Charset) (BinlogTransactionStream, error)
+
+	// CloneStream streams a consistent dump of the tables, e.g. to
+	// load them in an external system. The first message has the
+	// replication position of the snapshot the rows are read from,
+	// so changes can be applied from that exact position later on.
+	CloneStream(ctx context.Context, tablet *topodatapb.Tablet, tables []string, opts CloneOptions) (CloneStream, error)
+
+	// GetCharsetConfig returns the default character set and
+	// collation of the MySQL server, and of each of its databases.
+	// Compare the results of several tablets with
+	// tmutils.DiffCharsetConfigs.
+	GetCharsetConfig(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.CharsetConfig, error)
+
+	// GetProcessList returns the threads currently running in MySQL.
+	GetProcessList(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.Process, error)
+
+	// KillProcess kills the MySQL connection with the given ID.
+	// It refuses to kill system and replication threads.
+	KillProcess(ctx context.Context, tablet *topodatapb.Tablet, id int64) error
+
+	// TailGeneralLog enables the MySQL general log for duration, and
+	// streams its entries. The log is disabled again when the stream
+	// ends, including when ctx is canceled.
+	TailGeneralLog(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) (GeneralLogStream, error)
+
+	// StreamQPS sends the read, write and total query rates of the
+	// tablet every interval on the returned channel, until ctx is
+	// canceled or the stream fails. The channel is then closed, and
+	// the ErrFunc returns why. Samples are dropped rather than wait
+	// for a slow reader: QPSSample.Dropped counts them.
+	StreamQPS(ctx context.Context, tablet *topodatapb.Tablet, interval time.Duration) (<-chan *tabletmanagerdatapb.QPSSample, ErrFunc, error)
+
 	//
 	// Replication related methods
 	//
@@ -96,9 +572,51 @@ type TabletManagerClient interface {
 	// SlaveStatus returns the tablet's mysql slave status.
 	SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error)
 
+	// SlaveStatusAllChannels returns the tablet's mysql slave status
+	// for each replication channel, by channel name. A tablet with a
+	// single source only has the default channel, named "".
+	SlaveStatusAllChannels(ctx context.Context, tablet *topodatapb.Tablet) (map[string]*replicationdatapb.Status, error)
+
+	// GetReplicationSource returns the master host and port the
+	// tablet replicates from, and the user it replicates as. The
+	// replication password is never returned.
+	GetReplicationSource(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ReplicationSource, error)
+
+	// ValidateReplicationCredentials asks the remote slave to log in
+	// to its master with its replication credentials, on a separate
+	// connection that doesn't affect replication. It returns false
+	// if the master denied access, and an error if the check could
+	// not be done, e.g. the master is unreachable.
+	ValidateReplicationCredentials(ctx context.Context, tablet *topodatapb.Tablet) (bool, error)
+
+	// GetApplierQueueStats returns the size of the relay logs of the
+	// slave, and the state of the replication coordinator and
+	// workers, to tell an apply bottleneck (the coordinator waits for
+	// the workers) from a fetch bottleneck (the workers wait for
+	// events).
+	GetApplierQueueStats(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ApplierQueueStats, error)
+
 	// MasterPosition returns the tablet's master position
 	MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error)
 
+	// GetGtidPurged returns the tablet's gtid_purged, the set of
+	// transactions that are gone from its binary logs. A slave
+	// can only replicate from the tablet if its own position
+	// contains that set.
+	GetGtidPurged(ctx context.Context, tablet *topodatapb.Tablet) (string, error)
+
+	// GetBinlogStats returns the rate of transactions per second added
+	// to the binlogs of the tablet, measured over window between its
+	// last two health checks. On a master it is the write rate, on a
+	// slave the apply rate, so comparing them explains lag.
+	GetBinlogStats(ctx context.Context, tablet *topodatapb.Tablet) (transactionsPerSecond float64, window time.Duration, err error)
+
+	// GetReplicationErrorStats returns the number of replication IO
+	// and SQL errors, and of reconnects, of the tablet since the
+	// counts were last reset. If reset is true, the counts are read
+	// and cleared atomically, for periodic sampling.
+	GetReplicationErrorStats(ctx context.Context, tablet *topodatapb.Tablet, reset bool) (*tabletmanagerdatapb.ReplicationErrorStats, error)
+
 	// StopSlave stops the mysql replication
 	StopSlave(ctx context.Context, tablet *topodatapb.Tablet) error
 
@@ -106,20 +624,73 @@ type TabletManagerClient interface {
 	// the provided minimum point
 	StopSlaveMinimum(ctx context.Context, tablet *topodatapb.Tablet, stopPos string, waitTime time.Duration) (string, error)
 
+	// StopSlaveMinimumStream is like StopSlaveMinimum, but it
+	// periodically streams the slave position while waiting, to
+	// follow a long catch-up.
+	StopSlaveMinimumStream(ctx context.Context, tablet *topodatapb.Tablet, stopPos string, waitTime time.Duration) (StopSlaveMinimumStream, error)
+
+	// BoostReplicationCatchup raises the settings that limit how fast
+	// the slave applies its relay logs, like slave_parallel_workers
+	// and innodb_io_capacity, for duration, then restores the ones
+	// still boosted. It streams the replication lag meanwhile. A
+	// running SQL thread is restarted to change
+	// slave_parallel_workers.
+	BoostReplicationCatchup(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) (BoostReplicationCatchupStream, error)
+
 	// StartSlave starts the mysql replication
 	StartSlave(ctx context.Context, tablet *topodatapb.Tablet) error
 
+	// EnableParallelReplication switches the slave to parallel
+	// replication with the given slave_parallel_workers and
+	// slave_parallel_type (DATABASE or LOGICAL_CLOCK), restarting the
+	// SQL thread. Invalid settings are refused before any change. It
+	// only succeeds once the SQL thread runs with the workers,
+	// otherwise the slave goes back to its previous settings.
+	EnableParallelReplication(ctx context.Context, tablet *topodatapb.Tablet, workers int, parallelType string) error
+
+	// RotateReplicationCredentials changes the credentials the slave
+	// connects to its master with, and restarts the IO thread. It only
+	// succeeds once replication resumes, otherwise the slave goes back
+	// to its previous credentials. The tablet does not persist them:
+	// after a restart, reparents use its -db-config-repl-* flags again.
+	RotateReplicationCredentials(ctx context.Context, tablet *topodatapb.Tablet, user, password string) error
+
+	// ConfigureReplicationSSL makes the slave connect to its master
+	// with SSL, using the given files, and restarts the IO thread. It
+	// only succeeds once replication resumes with SSL, otherwise, for
+	// instance if the SSL handshake fails, the slave goes back to its
+	// previous SSL options. The tablet doesn't persist the options:
+	// after a restart, reparents use its -db-config-repl-ssl-* flags
+	// again.
+	ConfigureReplicationSSL(ctx context.Context, tablet *topodatapb.Tablet, opts *tabletmanagerdatapb.ReplicationSSLOptions) error
+
+	// RepairRelayLog discards the relay logs of the slave, e.g. after
+	// a crash truncated them, and fetches them again from the master,
+	// from the last position the slave applied. It restarts
+	// replication and streams its progress. It fails if the master
+	// purged the binlogs since that position: the tablet must then be
+	// restored from a backup.
+	RepairRelayLog(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
+
 	// TabletExternallyReparented tells a tablet it is now the master, after an
 	// external tool has already promoted the underlying mysqld to master and
 	// reparented the other mysqld servers to it.
 	//
 	// externalID is an optional string provided by the external tool that
 	// vttablet will emit in logs to facilitate cross-referencing.
-	TabletExternallyReparented(ctx context.Context, tablet *topodatapb.Tablet, externalID string) error
+	//
+	// If validate is set, the tablet rejects the call when its mysqld
+	// is still replicating from another master.
+	TabletExternallyReparented(ctx context.Context, tablet *topodatapb.Tablet, externalID string, validate bool) error
 
 	// GetSlaves returns the addresses of the slaves
 	GetSlaves(ctx context.Context, tablet *topodatapb.Tablet) ([]string, error)
 
+	// GetReplicationGraph returns the master the tablet replicates
+	// from and its connected slaves, as its mysql sees them, resolved
+	// to the aliases of the tablets of the shard where possible.
+	GetReplicationGraph(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ReplicationGraph, error)
+
 	// WaitBlpPosition asks the tablet to wait until it reaches that
 	// position in replication
 	WaitBlpPosition(ctx context.Context, tablet *topodatapb.Tablet, blpPosition *tabletmanagerdatapb.BlpPosition, waitTime time.Duration) error
@@ -135,6 +706,11 @@ type TabletManagerClient interface {
 	// it reaches the given positions, if not there yet.
 	RunBlpUntil(ctx context.Context, tablet *topodatapb.Tablet, positions []*tabletmanagerdatapb.BlpPosition, waitTime time.Duration) (string, error)
 
+	// GetBinlogFilters returns the filter of each binlog player of
+	// the tablet, sorted by uid: the keyrange or the tables it
+	// replicates from its source shard.
+	GetBinlogFilters(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.BinlogFilter, error)
+
 	//
 	// Reparenting related functions
 	//
@@ -146,12 +722,21 @@ type TabletManagerClient interface {
 
 	// InitMaster tells a tablet to make itself the new master,
 	// and return the replication position the slaves should use to
-	// reparent to it.
-	InitMaster(ctx context.Context, tablet *topodatapb.Tablet) (string, error)
+	// reparent to it. It fails with a ShardMismatchError if the
+	// tablet is no longer in expectedKeyspace / expectedShard, the
+	// shard being reparented. Empty values skip the check.
+	InitMaster(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string) (string, error)
 
 	// PopulateReparentJournal asks the master to insert a row in
-	// its reparent_journal table.
-	PopulateReparentJournal(ctx context.Context, tablet *topodatapb.Tablet, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, pos string) error
+	// its reparent_journal table. It fails with a ShardMismatchError
+	// if the tablet is no longer in expectedKeyspace / expectedShard.
+	PopulateReparentJournal(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, pos string) error
+
+	// GetLastReparentJournalEntry returns the most recent row of the
+	// reparent_journal table of the tablet, to check the row
+	// PopulateReparentJournal wrote landed. It returns nil if the
+	// journal is empty.
+	GetLastReparentJournalEntry(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ReparentJournalEntry, error)
 
 	// InitSlave tells a tablet to make itself a slave to the
 	// passed in master tablet alias, and wait for the row in the
@@ -171,7 +756,17 @@ type TabletManagerClient interface {
 	// SetMaster tells a tablet to make itself a slave to the
 	// passed in master tablet alias, and wait for the row in the
 	// reparent_journal table (if timeCreatedNS is non-zero).
-	SetMaster(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error
+	// It fails with a ShardMismatchError if the tablet is no longer
+	// in expectedKeyspace / expectedShard.
+	SetMaster(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, parent *topodatapb.TabletAlias, timeCreatedNS int64, forceStartSlave bool) error
+
+	// ConfigureReplication tells a tablet to make itself a slave to
+	// the passed in master tablet alias, like SetMaster, but lets the
+	// caller choose between GTID auto-position and an explicit binlog
+	// file and position, see ReplicationOptions.
+	// It fails with a ShardMismatchError if the tablet is no longer
+	// in expectedKeyspace / expectedShard. Empty values skip the check.
+	ConfigureReplication(ctx context.Context, tablet *topodatapb.Tablet, expectedKeyspace, expectedShard string, parent *topodatapb.TabletAlias, opts ReplicationOptions) error
 
 	// SlaveWasRestarted tells the remote tablet its master has changed
 	SlaveWasRestarted(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias) error
@@ -183,16 +778,99 @@ type TabletManagerClient interface {
 	// PromoteSlave makes the tablet the new master
 	PromoteSlave(ctx context.Context, tablet *topodatapb.Tablet) (string, error)
 
+	// SetReparentEligibility tells the tablet whether it can be chosen
+	// as the new master by reparent tools. It does not change the
+	// tablet type or its serving state.
+	SetReparentEligibility(ctx context.Context, tablet *topodatapb.Tablet, eligible bool, reason string) error
+
+	// CheckReparentCandidate returns whether the tablet can be chosen
+	// as the new master, and if not, why.
+	CheckReparentCandidate(ctx context.Context, tablet *topodatapb.Tablet) (eligible bool, reason string, err error)
+
+	// SetSemiSyncAckCount sets the number of semi-sync slaves the
+	// master waits for before acknowledging a commit. It fails,
+	// without changing anything, if fewer semi-sync slaves are
+	// connected, as all writes would then stall.
+	SetSemiSyncAckCount(ctx context.Context, tablet *topodatapb.Tablet, count int) error
+
+	// GetDurabilityPolicy returns the semi-sync policy the tablet
+	// enforces, the semi-sync settings it requires for the tablet
+	// type, and the ones currently in effect in MySQL.
+	GetDurabilityPolicy(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.DurabilityPolicy, error)
+
 	//
 	// Backup / restore related methods
 	//
 
-	// Backup creates a database backup
+	// GetBackupLimits returns the backup concurrency the tablet uses
+	// by default, and the maximum one it accepts.
+	GetBackupLimits(ctx context.Context, tablet *topodatapb.Tablet) (defaultConcurrency, maxConcurrency int, err error)
+
+	// GetBackupPosition returns the replication position a backup
+	// taken now would capture, without taking it. A coordinator can
+	// use it to pick a common position for the tablets of a shard.
+	GetBackupPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error)
+
+	// Backup creates a database backup. A concurrency of 0 uses the
+	// tablet default, and a concurrency over the tablet maximum is
+	// capped to it. A negative concurrency is an error.
 	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int) (logutil.EventStream, error)
 
-	// RestoreFromBackup deletes local data and restores database from backup
+	// IncrementalBackup backs up the binlogs of the tablet, from the
+	// position of the named backup of the shard to now. It fails
+	// before starting if binlogs since that position were purged, or
+	// if the tablet is throttled.
+	IncrementalBackup(ctx context.Context, tablet *topodatapb.Tablet, baseBackupName string, opts IncrementalBackupOptions) (logutil.EventStream, error)
+
+	// RestoreFromBackup deletes local data and restores database from backup.
+	// Canceling ctx aborts the restore: the stream then returns
+	// ErrRestoreAborted, instead of the error the restore failed with.
 	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
 
+	// RestoreToTimestamp deletes local data, restores the named
+	// backup, and applies the master binlogs up to targetTime.
+	// targetTime has to be between the backup time and now.
+	// The tablet is then DRAINED, with replication stopped.
+	RestoreToTimestamp(ctx context.Context, tablet *topodatapb.Tablet, backupName string, targetTime time.Time) (logutil.EventStream, error)
+
+	// SetPreferredBackup records the named backup as the one new
+	// tablets of the shard restore from, instead of the latest one.
+	// An empty backupName clears it.
+	SetPreferredBackup(ctx context.Context, tablet *topodatapb.Tablet, backupName string) error
+
+	// GetPreferredBackup returns the preferred backup of the shard,
+	// or "" if there is none.
+	GetPreferredBackup(ctx context.Context, tablet *topodatapb.Tablet) (string, error)
+
+	// CheckRestoreCompatibility compares the MySQL version, character
+	// set and storage engine recorded in the named backup of the shard
+	// with the tablet's, without restoring it.
+	CheckRestoreCompatibility(ctx context.Context, tablet *topodatapb.Tablet, backupName string) (*tabletmanagerdatapb.CompatReport, error)
+
+	// TestRestore restores the named backup of the shard into a
+	// scratch mysqld on the tablet host, checks its tables and
+	// removes it, without touching the tablet's own mysqld. It is
+	// refused on a master, if one is already running, and while the
+	// tablet is throttled.
+	TestRestore(ctx context.Context, tablet *topodatapb.Tablet, backupName string) (logutil.EventStream, error)
+
+	// GetLastBackupInfo returns the size, duration and outcome of the
+	// last backup the tablet took since it started. It returns nil,
+	// and no error, if the tablet did not take any.
+	GetLastBackupInfo(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.BackupInfo, error)
+
+	// GetBackupFreshness returns the name of the most recent complete
+	// full backup of the tablet's shard, and how long ago it was
+	// taken. If the shard never had one, it returns an empty name
+	// and BackupAgeNever, and no error.
+	GetBackupFreshness(ctx context.Context, tablet *topodatapb.Tablet) (string, time.Duration, error)
+
+	// CheckBackupReadiness checks the tablet can take a consistent
+	// backup now: no DDL or restore is in progress, replication is
+	// running, and the tablet is healthy. The report says why not.
+	// It is meant to gate automated backups.
+	CheckBackupReadiness(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.ReadinessReport, error)
+
 	//
 	// Management methods
 	//
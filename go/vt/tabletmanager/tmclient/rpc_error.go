@@ -0,0 +1,130 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// RPCError is the error returned by TabletManagerClient methods when
+// the call fails. It records which tablet and which method failed, so
+// failures can be attributed when fanning out calls to many tablets.
+type RPCError struct {
+	// Alias is the alias of the tablet the call was sent to.
+	Alias *topodatapb.TabletAlias
+	// Method is the name of the failed method, e.g. "SlaveStatus".
+	Method string
+	// Err is the underlying error.
+	Err error
+}
+
+// Error is part of the error interface.
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("%v on tablet %v failed: %v", e.Method, topoproto.TabletAliasString(e.Alias), e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RPCError) Unwrap() error {
+	return e.Err
+}
+
+// ShardMismatchError is the error of InitMaster, PopulateReparentJournal
+// and SetMaster when the tablet is not in the keyspace and shard of the
+// tablet record the call was made with. The tablet rejects such calls
+// before changing anything, to guard against mis-typed aliases.
+type ShardMismatchError struct {
+	// ExpectedKeyspace and ExpectedShard are where the caller
+	// expected the tablet to be.
+	ExpectedKeyspace string
+	ExpectedShard    string
+	// Keyspace and Shard are where the tablet actually is.
+	Keyspace string
+	Shard    string
+}
+
+// shardMismatchPrefix and shardMismatchFormat are the start and the
+// format of ShardMismatchError messages, parsed back by
+// ParseShardMismatchError.
+const (
+	shardMismatchPrefix = "tablet is in shard "
+	shardMismatchFormat = shardMismatchPrefix + "%s instead of %s"
+)
+
+// Error is part of the error interface.
+func (e *ShardMismatchError) Error() string {
+	return fmt.Sprintf(shardMismatchFormat, e.Keyspace+"/"+e.Shard, e.ExpectedKeyspace+"/"+e.ExpectedShard)
+}
+
+// ParseShardMismatchError returns the ShardMismatchError with the
+// given message, or false if the message is not one. It is used to
+// rebuild the error after it went over the wire. The message may have
+// a prefix, as the tablet adds the name of the RPC to its errors.
+func ParseShardMismatchError(message string) (*ShardMismatchError, bool) {
+	i := strings.Index(message, shardMismatchPrefix)
+	if i == -1 {
+		return nil, false
+	}
+	var actual, expected string
+	if _, err := fmt.Sscanf(message[i:], shardMismatchFormat, &actual, &expected); err != nil {
+		return nil, false
+	}
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(actual)
+	if err != nil {
+		return nil, false
+	}
+	expectedKeyspace, expectedShard, err := topoproto.ParseKeyspaceShard(expected)
+	if err != nil {
+		return nil, false
+	}
+	return &ShardMismatchError{
+		ExpectedKeyspace: expectedKeyspace,
+		ExpectedShard:    expectedShard,
+		Keyspace:         keyspace,
+		Shard:            shard,
+	}, true
+}
+
+// IsShardMismatch returns true if err is a ShardMismatchError, possibly
+// wrapped in an RPCError.
+func IsShardMismatch(err error) bool {
+	if rpcErr, ok := err.(*RPCError); ok {
+		err = rpcErr.Err
+	}
+	_, ok := err.(*ShardMismatchError)
+	return ok
+}
+
+// ErrSuperReadOnlyNotSupported is the error of SetSuperReadOnly when
+// the MySQL version doesn't have super_read_only. It was added in
+// MySQL 5.7.8.
+var ErrSuperReadOnlyNotSupported = errors.New("super_read_only is not supported by this MySQL version")
+
+// IsSuperReadOnlyNotSupported returns true if err is
+// ErrSuperReadOnlyNotSupported, possibly wrapped in an RPCError.
+func IsSuperReadOnlyNotSupported(err error) bool {
+	if rpcErr, ok := err.(*RPCError); ok {
+		err = rpcErr.Err
+	}
+	return err == ErrSuperReadOnlyNotSupported
+}
+
+// ErrMaxExecTimeExceeded is the error of ExecuteFetchAsDba when mysql
+// aborted the query because it ran for longer than maxExecTime.
+var ErrMaxExecTimeExceeded = errors.New("query exceeded max exec time")
+
+// IsMaxExecTimeExceeded returns true if err is ErrMaxExecTimeExceeded,
+// possibly wrapped in an RPCError.
+func IsMaxExecTimeExceeded(err error) bool {
+	if rpcErr, ok := err.(*RPCError); ok {
+		err = rpcErr.Err
+	}
+	return err == ErrMaxExecTimeExceeded
+}
@@ -0,0 +1,91 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl/tmutils"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// ApplySchemaMultiOptions are the options for ApplySchemaMulti.
+type ApplySchemaMultiOptions struct {
+	// Concurrency is the maximum number of ApplySchema RPCs in
+	// flight at any time. Defaults to 1.
+	Concurrency int
+}
+
+// ApplySchemaMultiResult is the result of ApplySchemaMulti for a
+// tablet.
+type ApplySchemaMultiResult struct {
+	Tablet *topodatapb.Tablet
+	// Result is the ApplySchema result, if it succeeded.
+	Result *tabletmanagerdatapb.SchemaChangeResult
+	// Err is the ApplySchema error, if it failed.
+	Err error
+}
+
+// ApplySchemaMulti applies the same schema change to all the tablets
+// in parallel, e.g. to build an index directly on all the replicas
+// of a shard. Unless change.AllowReplication is set, the change is
+// not replicated further. It is best-effort: a failure on a tablet
+// does not stop the others, so the tablets may be left with
+// different schemas.
+// It returns the result for each tablet, in the order of tablets. If
+// any tablet failed, it also returns an error naming the tablets the
+// change failed on, and the ones it was applied on. If ctx is done
+// before all tablets were started, the remaining tablets get
+// ctx.Err() as their error.
+func ApplySchemaMulti(ctx context.Context, tmc TabletManagerClient, tablets []*topodatapb.Tablet, change *tmutils.SchemaChange, opts ApplySchemaMultiOptions) ([]*ApplySchemaMultiResult, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	sema := make(chan struct{}, concurrency)
+
+	results := make([]*ApplySchemaMultiResult, len(tablets))
+	wg := sync.WaitGroup{}
+	for i, tablet := range tablets {
+		results[i] = &ApplySchemaMultiResult{
+			Tablet: tablet,
+		}
+		select {
+		case sema <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *ApplySchemaMultiResult) {
+			defer wg.Done()
+			result.Result, result.Err = tmc.ApplySchema(ctx, result.Tablet, change)
+			<-sema
+		}(results[i])
+	}
+	wg.Wait()
+
+	var failed, applied []string
+	for _, result := range results {
+		alias := topoproto.TabletAliasString(result.Tablet.Alias)
+		if result.Err != nil {
+			failed = append(failed, fmt.Sprintf("%v: %v", alias, result.Err))
+			continue
+		}
+		applied = append(applied, alias)
+	}
+	if len(failed) > 0 {
+		return results, fmt.Errorf("schema change failed on %v of %v tablets, the schemas are now inconsistent: failed on [%v], applied on [%v]", len(failed), len(tablets), strings.Join(failed, ", "), strings.Join(applied, ", "))
+	}
+	return results, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl/tmutils"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// applySchemaFakeClient fails ApplySchema on the tablets in failUids.
+type applySchemaFakeClient struct {
+	fakeClient
+	failUids map[uint32]bool
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (c *applySchemaFakeClient) ApplySchema(ctx context.Context, tablet *topodatapb.Tablet, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error) {
+	c.record("ApplySchema", tablet)
+	c.mu.Lock()
+	c.inFlight++
+	if c.inFlight > c.maxInFlight {
+		c.maxInFlight = c.inFlight
+	}
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.inFlight--
+		c.mu.Unlock()
+	}()
+
+	time.Sleep(time.Millisecond)
+	if c.failUids[tablet.Alias.Uid] {
+		return nil, fmt.Errorf("Duplicate key name 'idx_name'")
+	}
+	return &tabletmanagerdatapb.SchemaChangeResult{
+		AfterSchema: &tabletmanagerdatapb.SchemaDefinition{
+			DatabaseSchema: change.SQL,
+		},
+	}, nil
+}
+
+func TestApplySchemaMulti(t *testing.T) {
+	tablets := []*topodatapb.Tablet{newTablet(1), newTablet(2), newTablet(3), newTablet(4)}
+	tmc := &applySchemaFakeClient{
+		failUids: map[uint32]bool{3: true},
+	}
+	change := &tmutils.SchemaChange{
+		SQL: "ALTER TABLE t ADD INDEX idx_name (name)",
+	}
+
+	results, err := ApplySchemaMulti(context.Background(), tmc, tablets, change, ApplySchemaMultiOptions{Concurrency: 2})
+	if err == nil {
+		t.Fatalf("ApplySchemaMulti worked, want an error for cell1-0000000003")
+	}
+	for _, want := range []string{
+		"failed on 1 of 4 tablets",
+		"failed on [cell1-0000000003: Duplicate key name 'idx_name']",
+		"applied on [cell1-0000000001, cell1-0000000002, cell1-0000000004]",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("ApplySchemaMulti returned %v, want it to contain %q", err, want)
+		}
+	}
+
+	// The other tablets still got the change.
+	if len(results) != len(tablets) {
+		t.Fatalf("got %v results, want %v", len(results), len(tablets))
+	}
+	for i, result := range results {
+		if result.Tablet != tablets[i] {
+			t.Errorf("result %v is for tablet %v, want %v", i, result.Tablet.Alias, tablets[i].Alias)
+		}
+		if failed := result.Tablet.Alias.Uid == 3; failed != (result.Err != nil) {
+			t.Errorf("tablet %v: got error %v, want failed=%v", result.Tablet.Alias, result.Err, failed)
+		}
+		if result.Err == nil && result.Result.AfterSchema.DatabaseSchema != change.SQL {
+			t.Errorf("tablet %v: got result %v", result.Tablet.Alias, result.Result)
+		}
+	}
+	wantCalls := []string{
+		"ApplySchema(cell1-0000000001)",
+		"ApplySchema(cell1-0000000002)",
+		"ApplySchema(cell1-0000000003)",
+		"ApplySchema(cell1-0000000004)",
+	}
+	if got := tmc.sortedCalls(); !reflect.DeepEqual(got, wantCalls) {
+		t.Errorf("got calls %v, want %v", got, wantCalls)
+	}
+	if tmc.maxInFlight > 2 {
+		t.Errorf("got %v concurrent ApplySchema, want at most 2", tmc.maxInFlight)
+	}
+
+	// Without failures, there is no error.
+	tmc = &applySchemaFakeClient{}
+	if _, err := ApplySchemaMulti(context.Background(), tmc, tablets, change, ApplySchemaMultiOptions{}); err != nil {
+		t.Errorf("ApplySchemaMulti failed: %v", err)
+	}
+	if tmc.maxInFlight > 1 {
+		t.Errorf("got %v concurrent ApplySchema with the default concurrency, want 1", tmc.maxInFlight)
+	}
+}
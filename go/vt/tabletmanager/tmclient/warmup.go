@@ -0,0 +1,68 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tmclient
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// WarmUpOptions are the options for WarmUpTablet.
+type WarmUpOptions struct {
+	// Queries are run once each on the tablet, for instance a set
+	// of representative queries of the application.
+	Queries []string
+	// LoadBufferPool loads the InnoDB buffer pool from its last dump.
+	LoadBufferPool bool
+	// Timeout, if set, bounds the duration of the warm up.
+	Timeout time.Duration
+	// Progress, if set, is called with each progress report.
+	Progress func(*tabletmanagerdatapb.WarmUpResponse)
+}
+
+// WarmUpTablet warms up the caches of a tablet, and returns once it is
+// done, with the final cache fill state. Use it before routing traffic
+// to a tablet, then wait for it to serve with WaitForHealthy.
+func WarmUpTablet(ctx context.Context, tmc TabletManagerClient, tablet *topodatapb.Tablet, opts WarmUpOptions) (*tabletmanagerdatapb.WarmUpResponse, error) {
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	stream, err := tmc.WarmUp(ctx, tablet, opts.Queries, opts.LoadBufferPool)
+	if err != nil {
+		return nil, err
+	}
+	var last *tabletmanagerdatapb.WarmUpResponse
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				return last, fmt.Errorf("warm up of tablet %v timed out: %v", topoproto.TabletAliasString(tablet.Alias), err)
+			}
+			return last, err
+		}
+		last = r
+		if opts.Progress != nil {
+			opts.Progress(r)
+		}
+	}
+	if last == nil || !last.Done {
+		return last, fmt.Errorf("warm up of tablet %v ended before it was done", topoproto.TabletAliasString(tablet.Alias))
+	}
+	return last, nil
+}
@@ -32,6 +32,7 @@ var (
 func init() {
 	flag.IntVar(&qsConfig.PoolSize, "queryserver-config-pool-size", DefaultQsConfig.PoolSize, "query server connection pool size, connection pool is used by regular queries (non streaming, not in a transaction)")
 	flag.IntVar(&qsConfig.StreamPoolSize, "queryserver-config-stream-pool-size", DefaultQsConfig.StreamPoolSize, "query server stream connection pool size, stream pool is used by stream queries: queries that return results to client in a streaming fashion")
+	flag.IntVar(&qsConfig.PoolMaxSizeFactor, "queryserver-config-pool-max-size-factor", DefaultQsConfig.PoolMaxSizeFactor, "how many times their configured size the connection, stream connection and transaction pools can be raised to at runtime")
 	flag.IntVar(&qsConfig.MessagePoolSize, "queryserver-config-message-conn-pool-size", DefaultQsConfig.MessagePoolSize, "query server message connection pool size, message pool is used by message managers: recommended value is one per message table")
 	flag.IntVar(&qsConfig.TransactionCap, "queryserver-config-transaction-cap", DefaultQsConfig.TransactionCap, "query server transaction cap is the maximum number of transactions allowed to happen at any given point of a time for a single vttablet. E.g. by setting transaction cap to 100, there are at most 100 transactions will be processed by a vttablet and the 101th transaction will be blocked (and fail if it cannot get connection within specified timeout)")
 	flag.Float64Var(&qsConfig.TransactionTimeout, "queryserver-config-transaction-timeout", DefaultQsConfig.TransactionTimeout, "query server transaction timeout (in seconds), a transaction will be killed if it takes longer than this value")
@@ -74,6 +75,7 @@ func Init() {
 type Config struct {
 	PoolSize                int
 	StreamPoolSize          int
+	PoolMaxSizeFactor       int
 	MessagePoolSize         int
 	TransactionCap          int
 	TransactionTimeout      float64
@@ -116,6 +118,7 @@ type Config struct {
 var DefaultQsConfig = Config{
 	PoolSize:                16,
 	StreamPoolSize:          200,
+	PoolMaxSizeFactor:       4,
 	MessagePoolSize:         5,
 	TransactionCap:          20,
 	TransactionTimeout:      30,
@@ -212,6 +215,15 @@ type Controller interface {
 	// ReloadSchema makes the quey service reload its schema cache
 	ReloadSchema(ctx context.Context) error
 
+	// RegisterSchemaNotifier calls f with the tables of the schema
+	// cache right away, and then every time a table is created,
+	// changed or dropped in it, until UnregisterSchemaNotifier.
+	RegisterSchemaNotifier(name string, f func(tables map[string]*TableInfo))
+
+	// UnregisterSchemaNotifier stops calling the function registered
+	// with RegisterSchemaNotifier under name.
+	UnregisterSchemaNotifier(name string)
+
 	// RegisterQueryRuleSource adds a query rule source
 	RegisterQueryRuleSource(ruleSource string)
 
@@ -226,6 +238,15 @@ type Controller interface {
 
 	QueryServiceStats() *QueryServiceStats
 
+	// ConnectionStats returns the current usage of the connection pools
+	ConnectionStats() ConnectionStats
+
+	// RuntimeConfig returns the settings that can be changed at runtime
+	RuntimeConfig() RuntimeConfig
+
+	// SetRuntimeConfig changes the settings that are not zero
+	SetRuntimeConfig(config RuntimeConfig) error
+
 	// BroadcastHealth sends the current health to all listeners
 	BroadcastHealth(terTimestamp int64, stats *querypb.RealtimeStats)
 }
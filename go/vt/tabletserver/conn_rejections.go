@@ -0,0 +1,92 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletserver
+
+import (
+	"sync"
+
+	"github.com/youtube/vitess/go/mysql"
+	"github.com/youtube/vitess/go/pools"
+	"github.com/youtube/vitess/go/sqldb"
+)
+
+// The reasons ConnectionRejections counts a connection request for.
+const (
+	// RejectionPoolExhausted is a request that timed out waiting
+	// for a connection of a full pool.
+	RejectionPoolExhausted = "PoolExhausted"
+	// RejectionPoolClosed is a request to a closed pool.
+	RejectionPoolClosed = "PoolClosed"
+	// RejectionMaxConnections is a new connection MySQL refused
+	// because max_connections was reached.
+	RejectionMaxConnections = "MaxConnections"
+	// RejectionMaxUserConnections is a new connection MySQL refused
+	// because the user reached max_user_connections.
+	RejectionMaxUserConnections = "MaxUserConnections"
+	// RejectionConnectFailed is a new connection that failed for
+	// any other reason.
+	RejectionConnectFailed = "ConnectFailed"
+)
+
+// ConnectionRejections counts the connection requests of the query
+// service pools that failed, by reason. Unlike the published stats,
+// the counts can be reset, to see what happened since a given time.
+type ConnectionRejections struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+// NewConnectionRejections returns a ConnectionRejections with all
+// counts at 0.
+func NewConnectionRejections() *ConnectionRejections {
+	return &ConnectionRejections{
+		counts: make(map[string]int64),
+	}
+}
+
+// Add counts a rejected connection request.
+func (cr *ConnectionRejections) Add(reason string) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	cr.counts[reason]++
+}
+
+// Counts returns the number of rejected connection requests for
+// each reason since the last reset. If reset is set, the counts are
+// cleared in the same step, so no rejection is lost or counted twice.
+func (cr *ConnectionRejections) Counts(reset bool) map[string]int64 {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	counts := cr.counts
+	if reset {
+		cr.counts = make(map[string]int64)
+		return counts
+	}
+	result := make(map[string]int64, len(counts))
+	for reason, count := range counts {
+		result[reason] = count
+	}
+	return result
+}
+
+// connectionRejectionReason returns the reason a pool returned err
+// for a connection request.
+func connectionRejectionReason(err error) string {
+	switch err {
+	case pools.ErrTimeout:
+		return RejectionPoolExhausted
+	case pools.ErrClosed, ErrConnPoolClosed:
+		return RejectionPoolClosed
+	}
+	if sqlErr, ok := err.(*sqldb.SQLError); ok {
+		switch sqlErr.Number() {
+		case mysql.ErrConCount:
+			return RejectionMaxConnections
+		case mysql.ErrTooManyUserConnections:
+			return RejectionMaxUserConnections
+		}
+	}
+	return RejectionConnectFailed
+}
@@ -0,0 +1,94 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletserver
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/youtube/vitess/go/mysql"
+	"github.com/youtube/vitess/go/pools"
+	"github.com/youtube/vitess/go/sqldb"
+	"github.com/youtube/vitess/go/vt/vttest/fakesqldb"
+	"golang.org/x/net/context"
+)
+
+func TestConnectionRejectionReason(t *testing.T) {
+	testcases := []struct {
+		err  error
+		want string
+	}{
+		{pools.ErrTimeout, RejectionPoolExhausted},
+		{pools.ErrClosed, RejectionPoolClosed},
+		{ErrConnPoolClosed, RejectionPoolClosed},
+		{sqldb.NewSQLError(mysql.ErrConCount, "08004", "Too many connections"), RejectionMaxConnections},
+		{sqldb.NewSQLError(mysql.ErrTooManyUserConnections, "42000", "User vt_app already has more than 'max_user_connections' active connections"), RejectionMaxUserConnections},
+		{sqldb.NewSQLError(2003, "", "Can't connect to MySQL server"), RejectionConnectFailed},
+		{errors.New("unknown"), RejectionConnectFailed},
+	}
+	for _, tcase := range testcases {
+		if got := connectionRejectionReason(tcase.err); got != tcase.want {
+			t.Errorf("connectionRejectionReason(%v) = %v, want %v", tcase.err, got, tcase.want)
+		}
+	}
+}
+
+func TestConnectionRejectionsCounts(t *testing.T) {
+	cr := NewConnectionRejections()
+	cr.Add(RejectionPoolExhausted)
+	cr.Add(RejectionPoolExhausted)
+	cr.Add(RejectionMaxConnections)
+	want := map[string]int64{
+		RejectionPoolExhausted:  2,
+		RejectionMaxConnections: 1,
+	}
+	if got := cr.Counts(false); !reflect.DeepEqual(got, want) {
+		t.Errorf("Counts(false) = %v, want %v", got, want)
+	}
+	if got := cr.Counts(true); !reflect.DeepEqual(got, want) {
+		t.Errorf("Counts(true) = %v, want %v", got, want)
+	}
+	if got := cr.Counts(false); len(got) != 0 {
+		t.Errorf("Counts(false) after a reset = %v, want no rejection", got)
+	}
+}
+
+func TestConnPoolGetRejections(t *testing.T) {
+	db := fakesqldb.Register()
+	testUtils := newTestUtils()
+	connPool := testUtils.newConnPool()
+	rejections := connPool.queryServiceStats.ConnectionRejections
+
+	if _, err := connPool.Get(context.Background()); err != ErrConnPoolClosed {
+		t.Errorf("Get on a closed pool returned %v, want %v", err, ErrConnPoolClosed)
+	}
+
+	appParams := &sqldb.ConnParams{Engine: db.Name}
+	dbaParams := &sqldb.ConnParams{Engine: db.Name}
+	connPool.Open(appParams, dbaParams)
+	defer connPool.Close()
+	if err := connPool.SetCapacity(1); err != nil {
+		t.Fatalf("SetCapacity failed: %v", err)
+	}
+	dbConn, err := connPool.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	defer dbConn.Recycle()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := connPool.Get(ctx); err != pools.ErrTimeout {
+		t.Errorf("Get on an exhausted pool returned %v, want %v", err, pools.ErrTimeout)
+	}
+
+	want := map[string]int64{
+		RejectionPoolClosed:    1,
+		RejectionPoolExhausted: 1,
+	}
+	if got := rejections.Counts(false); !reflect.DeepEqual(got, want) {
+		t.Errorf("rejections = %v, want %v", got, want)
+	}
+}
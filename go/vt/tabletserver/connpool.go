@@ -25,6 +25,7 @@ type ConnPool struct {
 	mu                sync.Mutex
 	connections       *pools.ResourcePool
 	capacity          int
+	maxCap            int
 	idleTimeout       time.Duration
 	dbaPool           *dbconnpool.ConnectionPool
 	queryServiceStats *QueryServiceStats
@@ -76,7 +77,11 @@ func (cp *ConnPool) Open(appParams, dbaParams *sqldb.ConnParams) {
 	f := func() (pools.Resource, error) {
 		return NewDBConn(cp, appParams, dbaParams, cp.queryServiceStats)
 	}
-	cp.connections = pools.NewResourcePool(f, cp.capacity, cp.capacity, cp.idleTimeout)
+	maxCap := cp.capacity
+	if cp.maxCap > maxCap {
+		maxCap = cp.maxCap
+	}
+	cp.connections = pools.NewResourcePool(f, cp.capacity, maxCap, cp.idleTimeout)
 	cp.dbaPool.Open(dbconnpool.DBConnectionCreator(dbaParams, cp.queryServiceStats.MySQLStats))
 }
 
@@ -101,10 +106,12 @@ func (cp *ConnPool) Close() {
 func (cp *ConnPool) Get(ctx context.Context) (*DBConn, error) {
 	p := cp.pool()
 	if p == nil {
+		cp.queryServiceStats.ConnectionRejections.Add(RejectionPoolClosed)
 		return nil, ErrConnPoolClosed
 	}
 	r, err := p.Get(ctx)
 	if err != nil {
+		cp.queryServiceStats.ConnectionRejections.Add(connectionRejectionReason(err))
 		return nil, err
 	}
 	return r.(*DBConn), nil
@@ -137,6 +144,15 @@ func (cp *ConnPool) SetCapacity(capacity int) (err error) {
 	return nil
 }
 
+// SetMaxCap sets how large SetCapacity can make the pool once it is
+// open. It takes effect on the next Open. The pool is never opened
+// with a max capacity below its capacity.
+func (cp *ConnPool) SetMaxCap(maxCap int) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.maxCap = maxCap
+}
+
 // SetIdleTimeout sets the idleTimeout on the pool.
 func (cp *ConnPool) SetIdleTimeout(idleTimeout time.Duration) {
 	cp.mu.Lock()
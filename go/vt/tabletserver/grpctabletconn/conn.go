@@ -6,6 +6,7 @@ package grpctabletconn
 
 import (
 	"flag"
+	"fmt"
 	"io"
 	"sync"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/youtube/vitess/go/vt/tabletserver/tabletconn"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 	queryservicepb "github.com/youtube/vitess/go/vt/proto/queryservice"
@@ -31,6 +33,11 @@ var (
 	key  = flag.String("tablet_grpc_key", "", "the key to use to connect")
 	ca   = flag.String("tablet_grpc_ca", "", "the server ca to use to validate servers when connecting")
 	name = flag.String("tablet_grpc_server_name", "", "the server name to use to validate server certificate")
+
+	// The default is above gRPC's own 4MB limit, so that replies the
+	// gRPC default would reject (e.g. health messages with many tags)
+	// are accepted unless the limit is lowered explicitly.
+	maxMessageSize = flag.Int("tablet_grpc_max_message_size", 16*1024*1024, "Maximum RPC message size the client accepts from vttablet. Larger replies (e.g. health messages with many tags) are rejected with a HealthMessageTooLargeError.")
 )
 
 func init() {
@@ -61,7 +68,7 @@ func DialTablet(tablet *topodatapb.Tablet, timeout time.Duration) (tabletconn.Ta
 	if err != nil {
 		return nil, err
 	}
-	opts := []grpc.DialOption{opt}
+	opts := []grpc.DialOption{opt, grpc.WithMaxMsgSize(*maxMessageSize)}
 	if timeout > 0 {
 		opts = append(opts, grpc.WithBlock(), grpc.WithTimeout(timeout))
 	}
@@ -600,7 +607,29 @@ func (conn *gRPCQueryClient) StreamHealth(ctx context.Context) (tabletconn.Strea
 		return nil, tabletconn.ConnClosed
 	}
 
-	return conn.c.StreamHealth(ctx, &querypb.StreamHealthRequest{})
+	stream, err := conn.c.StreamHealth(ctx, &querypb.StreamHealthRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &streamHealthAdapter{stream: stream}, nil
+}
+
+type streamHealthAdapter struct {
+	stream queryservicepb.Query_StreamHealthClient
+}
+
+// Recv returns a HealthMessageTooLargeError if the health message
+// was rejected for being over the gRPC message size limit, either the
+// client's -tablet_grpc_max_message_size or the server's
+// -grpc_max_message_size.
+func (a *streamHealthAdapter) Recv() (*querypb.StreamHealthResponse, error) {
+	shr, err := a.stream.Recv()
+	if err != nil && grpc.Code(err) == codes.ResourceExhausted {
+		return nil, &tabletconn.HealthMessageTooLargeError{
+			Err: fmt.Sprintf("vttablet: health message too large: %v", err),
+		}
+	}
+	return shr, err
 }
 
 type updateStreamAdapter struct {
@@ -6,13 +6,21 @@ package grpctabletconn
 
 import (
 	"net"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	"github.com/youtube/vitess/go/vt/tabletserver/grpcqueryservice"
+	"github.com/youtube/vitess/go/vt/tabletserver/tabletconn"
 	"github.com/youtube/vitess/go/vt/tabletserver/tabletconntest"
 
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	queryservicepb "github.com/youtube/vitess/go/vt/proto/queryservice"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
 )
 
@@ -45,3 +53,101 @@ func TestGRPCTabletConn(t *testing.T) {
 		},
 	}, service)
 }
+
+// TestStreamHealthOverGRPCDefault makes sure a health message larger
+// than gRPC's 4MB default is accepted with the default
+// -tablet_grpc_max_message_size.
+func TestStreamHealthOverGRPCDefault(t *testing.T) {
+	service := tabletconntest.CreateFakeServer(t)
+	service.StreamHealthResponse = &querypb.StreamHealthResponse{
+		Target: tabletconntest.TestTarget,
+		RealtimeStats: &querypb.RealtimeStats{
+			HealthError: strings.Repeat("x", 5*1024*1024),
+		},
+	}
+
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("Cannot listen: %v", err)
+	}
+	host := listener.Addr().(*net.TCPAddr).IP.String()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	server := grpc.NewServer()
+	grpcqueryservice.Register(server, service)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := DialTablet(&topodatapb.Tablet{
+		Hostname: host,
+		PortMap: map[string]int32{
+			"grpc": int32(port),
+		},
+	}, 10*time.Second)
+	if err != nil {
+		t.Fatalf("DialTablet failed: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	defer conn.Close(ctx)
+
+	stream, err := conn.StreamHealth(ctx)
+	if err != nil {
+		t.Fatalf("StreamHealth failed: %v", err)
+	}
+	shr, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() failed: %v", err)
+	}
+	if got, want := len(shr.RealtimeStats.HealthError), 5*1024*1024; got != want {
+		t.Errorf("len(HealthError) = %v, want %v", got, want)
+	}
+}
+
+// fakeStreamHealthClient returns a fixed response and error from Recv.
+type fakeStreamHealthClient struct {
+	queryservicepb.Query_StreamHealthClient
+	shr *querypb.StreamHealthResponse
+	err error
+}
+
+func (f *fakeStreamHealthClient) Recv() (*querypb.StreamHealthResponse, error) {
+	return f.shr, f.err
+}
+
+func TestStreamHealthTooLarge(t *testing.T) {
+	// A health message with a lot of detail, which the
+	// transport rejected for being over the size limit.
+	shr := &querypb.StreamHealthResponse{
+		RealtimeStats: &querypb.RealtimeStats{
+			HealthError: strings.Repeat("x", 5*1024*1024),
+		},
+	}
+	a := &streamHealthAdapter{
+		stream: &fakeStreamHealthClient{
+			err: grpc.Errorf(codes.ResourceExhausted, "grpc: received message length %v exceeding the max size %v", proto.Size(shr), 4*1024*1024),
+		},
+	}
+	_, err := a.Recv()
+	if _, ok := err.(*tabletconn.HealthMessageTooLargeError); !ok {
+		t.Fatalf("Recv() returned %T (%v), want *tabletconn.HealthMessageTooLargeError", err, err)
+	}
+
+	// Other errors are passed through unchanged.
+	want := grpc.Errorf(codes.Unavailable, "transport is closing")
+	a = &streamHealthAdapter{
+		stream: &fakeStreamHealthClient{err: want},
+	}
+	if _, err := a.Recv(); err != want {
+		t.Errorf("Recv() = %v, want %v", err, want)
+	}
+
+	// And so are valid messages.
+	a = &streamHealthAdapter{
+		stream: &fakeStreamHealthClient{shr: shr},
+	}
+	got, err := a.Recv()
+	if err != nil || got != shr {
+		t.Errorf("Recv() = (%p, %v), want (%p, nil)", got, err, shr)
+	}
+}
@@ -128,6 +128,8 @@ func NewQueryEngine(checker MySQLChecker, config Config, queryServiceStats *Quer
 		qe.queryServiceStats,
 		checker,
 	)
+	qe.connPool.SetMaxCap(config.PoolSize * config.PoolMaxSizeFactor)
+	qe.streamConnPool.SetMaxCap(config.StreamPoolSize * config.PoolMaxSizeFactor)
 
 	qe.consolidator = sync2.NewConsolidator()
 	http.Handle(config.DebugURLPrefix+"/consolidations", qe.consolidator)
@@ -40,6 +40,9 @@ type QueryServiceStats struct {
 	QPSRates *stats.Rates
 	// ResultStats shows the histogram of number of rows returned.
 	ResultStats *stats.Histogram
+	// ConnectionRejections counts the failed connection requests of
+	// the pools, by reason. It is not published.
+	ConnectionRejections *ConnectionRejections
 }
 
 // NewQueryServiceStats returns a new QueryServiceStats instance.
@@ -94,7 +97,8 @@ func NewQueryServiceStats(statsPrefix string, enablePublishStats bool) *QuerySer
 		UserTransactionTimesNs: stats.NewMultiCounters(
 			userTransactionTimesNsName, []string{"CallerID", "Conclusion"}),
 		// Sample every 5 seconds and keep samples for up to 15 minutes.
-		QPSRates:    stats.NewRates(qpsRateName, queryStats, 15*60/5, 5*time.Second),
-		ResultStats: stats.NewHistogram(resultStatsName, resultBuckets),
+		QPSRates:             stats.NewRates(qpsRateName, queryStats, 15*60/5, 5*time.Second),
+		ResultStats:          stats.NewHistogram(resultStatsName, resultBuckets),
+		ConnectionRejections: NewConnectionRejections(),
 	}
 }
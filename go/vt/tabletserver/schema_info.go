@@ -133,6 +133,7 @@ func NewSchemaInfo(
 		reloadTime:        reloadTime,
 		queryRuleSources:  NewQueryRuleInfo(),
 		queryServiceStats: queryServiceStats,
+		notifiers:         make(map[string]notifier),
 	}
 	if enablePublishStats {
 		stats.Publish(statsPrefix+"QueryCacheLength", stats.IntFunc(si.queries.Length))
@@ -230,7 +231,6 @@ func (si *SchemaInfo) Open(dbaParams *sqldb.ConnParams, strictMode bool) {
 			log.Errorf("periodic schema reload failed: %v", err)
 		}
 	})
-	si.notifiers = make(map[string]notifier)
 	si.isOpen = true
 }
 
@@ -243,7 +243,6 @@ func (si *SchemaInfo) Close() {
 	si.connPool.Close()
 	si.queries.Clear()
 	si.tables = nil
-	si.notifiers = nil
 	si.isOpen = false
 }
 
@@ -377,7 +376,9 @@ func (si *SchemaInfo) DropTable(tableName sqlparser.TableIdent) {
 }
 
 // RegisterNotifier registers the function for schema change notification.
-// It also causes an immediate notification to the caller.
+// It also causes an immediate notification to the caller. Notifiers are
+// kept when SchemaInfo is closed and reopened, they are called with a
+// nil map while it is closed.
 func (si *SchemaInfo) RegisterNotifier(name string, f notifier) {
 	si.mu.Lock()
 	defer si.mu.Unlock()
@@ -49,6 +49,16 @@ type OperationalError string
 
 func (e OperationalError) Error() string { return string(e) }
 
+// HealthMessageTooLargeError is returned by a StreamHealthReader when a
+// single StreamHealthResponse exceeds the gRPC message size limit.
+// Callers can use it to tell an oversized health reply (e.g. too many
+// tags) apart from a broken stream.
+type HealthMessageTooLargeError struct {
+	Err string
+}
+
+func (e *HealthMessageTooLargeError) Error() string { return e.Err }
+
 // StreamHealthReader defines the interface for a reader to read
 // StreamHealth messages.
 type StreamHealthReader interface {
@@ -580,6 +580,18 @@ func (tsv *TabletServer) ReloadSchema(ctx context.Context) error {
 	return nil
 }
 
+// RegisterSchemaNotifier is part of the Controller interface.
+// f is called with the schema lock held, and must not call back into
+// the TabletServer.
+func (tsv *TabletServer) RegisterSchemaNotifier(name string, f func(tables map[string]*TableInfo)) {
+	tsv.qe.schemaInfo.RegisterNotifier(name, f)
+}
+
+// UnregisterSchemaNotifier is part of the Controller interface.
+func (tsv *TabletServer) UnregisterSchemaNotifier(name string) {
+	tsv.qe.schemaInfo.UnregisterNotifier(name)
+}
+
 // ClearQueryPlanCache clears internal query plan cache
 func (tsv *TabletServer) ClearQueryPlanCache() {
 	// We should ideally bracket this with start & endErequest,
@@ -599,6 +611,28 @@ func (tsv *TabletServer) QueryServiceStats() *QueryServiceStats {
 	return tsv.qe.queryServiceStats
 }
 
+// ConnectionStats is a snapshot of the connection pools usage.
+type ConnectionStats struct {
+	// OpenConnections is the number of connections currently in use
+	// across the regular, streaming and transaction pools.
+	OpenConnections int64
+	// ActiveTransactions is the number of open transactions.
+	ActiveTransactions int64
+	// PoolCapacity is the combined capacity of the three pools.
+	PoolCapacity int64
+}
+
+// ConnectionStats returns the current usage of the connection pools.
+func (tsv *TabletServer) ConnectionStats() ConnectionStats {
+	var cs ConnectionStats
+	for _, cp := range []*ConnPool{tsv.qe.connPool, tsv.qe.streamConnPool, tsv.te.txPool.pool} {
+		cs.OpenConnections += cp.Capacity() - cp.Available()
+		cs.PoolCapacity += cp.Capacity()
+	}
+	cs.ActiveTransactions = tsv.te.txPool.activePool.Size()
+	return cs
+}
+
 // Begin starts a new transaction. This is allowed only if the state is StateServing.
 func (tsv *TabletServer) Begin(ctx context.Context, target *querypb.Target) (transactionID int64, err error) {
 	err = tsv.execRequest(
@@ -1662,6 +1696,69 @@ func (tsv *TabletServer) MaxDMLRows() int {
 	return int(tsv.qe.maxDMLRows.Get())
 }
 
+// RuntimeConfig is the part of the query server configuration that
+// can be changed without a restart.
+type RuntimeConfig struct {
+	PoolSize       int
+	StreamPoolSize int
+	TxPoolSize     int
+	QueryTimeout   time.Duration
+}
+
+// RuntimeConfig returns the current runtime configuration.
+func (tsv *TabletServer) RuntimeConfig() RuntimeConfig {
+	return RuntimeConfig{
+		PoolSize:       tsv.PoolSize(),
+		StreamPoolSize: tsv.StreamPoolSize(),
+		TxPoolSize:     tsv.TxPoolSize(),
+		QueryTimeout:   tsv.QueryTimeout.Get(),
+	}
+}
+
+// SetRuntimeConfig changes the settings of config that are not zero,
+// and leaves the others unchanged. All the settings are checked before
+// any is changed, so an invalid one changes nothing. The pools can be
+// raised up to -queryserver-config-pool-max-size-factor times the size
+// they were configured with.
+func (tsv *TabletServer) SetRuntimeConfig(config RuntimeConfig) error {
+	pools := []struct {
+		name string
+		pool *ConnPool
+		size int
+	}{
+		{"pool size", tsv.qe.connPool, config.PoolSize},
+		{"stream pool size", tsv.qe.streamConnPool, config.StreamPoolSize},
+		{"transaction pool size", tsv.te.txPool.pool, config.TxPoolSize},
+	}
+	for _, p := range pools {
+		if p.size < 0 {
+			return fmt.Errorf("cannot set %v to %v: it must be positive", p.name, p.size)
+		}
+		// A closed pool has no max capacity yet, it is opened
+		// with at least its new capacity.
+		if maxCap := int(p.pool.MaxCap()); maxCap > 0 && p.size > maxCap {
+			return fmt.Errorf("cannot set %v to %v: it is above the maximum of %v", p.name, p.size, maxCap)
+		}
+	}
+	if config.QueryTimeout < 0 {
+		return fmt.Errorf("cannot set query timeout to %v: it must be positive", config.QueryTimeout)
+	}
+
+	for _, p := range pools {
+		if p.size == 0 {
+			continue
+		}
+		// This can only fail if the pool is being closed.
+		if err := p.pool.SetCapacity(p.size); err != nil {
+			return fmt.Errorf("cannot set %v to %v: %v", p.name, p.size, err)
+		}
+	}
+	if config.QueryTimeout != 0 {
+		tsv.QueryTimeout.Set(config.QueryTimeout)
+	}
+	return nil
+}
+
 func init() {
 	rand.Seed(time.Now().UnixNano())
 }
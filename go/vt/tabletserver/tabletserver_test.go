@@ -1885,6 +1885,56 @@ func TestConfigChanges(t *testing.T) {
 	}
 }
 
+func TestRuntimeConfig(t *testing.T) {
+	db := setUpTabletServerTest()
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServer(config)
+	dbconfigs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	err := tsv.StartService(target, dbconfigs, testUtils.newMysqld(&dbconfigs))
+	if err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+
+	// Zero values are left unchanged.
+	before := tsv.RuntimeConfig()
+	if err := tsv.SetRuntimeConfig(RuntimeConfig{PoolSize: 7, QueryTimeout: 3 * time.Second}); err != nil {
+		t.Fatalf("SetRuntimeConfig failed: %v", err)
+	}
+	want := before
+	want.PoolSize = 7
+	want.QueryTimeout = 3 * time.Second
+	if got := tsv.RuntimeConfig(); got != want {
+		t.Errorf("RuntimeConfig: %+v, want %+v", got, want)
+	}
+
+	if err := tsv.SetRuntimeConfig(RuntimeConfig{StreamPoolSize: -1}); err == nil {
+		t.Errorf("SetRuntimeConfig with a negative stream pool size worked")
+	}
+
+	// The live pools can be raised above their configured size.
+	raised := config.PoolSize * 2
+	if err := tsv.SetRuntimeConfig(RuntimeConfig{PoolSize: raised}); err != nil {
+		t.Fatalf("SetRuntimeConfig(PoolSize: %v) failed: %v", raised, err)
+	}
+	if got := tsv.qe.connPool.Capacity(); got != int64(raised) {
+		t.Errorf("pool capacity: %v, want %v", got, raised)
+	}
+
+	// An invalid setting changes nothing, not even the valid
+	// settings before it.
+	before = tsv.RuntimeConfig()
+	tooLarge := config.StreamPoolSize*config.PoolMaxSizeFactor + 1
+	if err := tsv.SetRuntimeConfig(RuntimeConfig{PoolSize: 9, StreamPoolSize: tooLarge}); err == nil {
+		t.Errorf("SetRuntimeConfig with a stream pool size above its maximum worked")
+	}
+	if got := tsv.RuntimeConfig(); got != before {
+		t.Errorf("RuntimeConfig after a failed SetRuntimeConfig: %+v, want %+v", got, before)
+	}
+}
+
 func setUpTabletServerTest() *fakesqldb.DB {
 	db := fakesqldb.Register()
 	for query, result := range getSupportedQueries() {
@@ -56,6 +56,20 @@ type Controller struct {
 	// SetServingTypeError is the return value for SetServingType.
 	SetServingTypeError error
 
+	// ConnStats is the return value for ConnectionStats.
+	ConnStats tabletserver.ConnectionStats
+
+	// Stats is the return value for QueryServiceStats.
+	Stats *tabletserver.QueryServiceStats
+
+	// CurrentRuntimeConfig is returned by RuntimeConfig, and
+	// updated by SetRuntimeConfig.
+	CurrentRuntimeConfig tabletserver.RuntimeConfig
+
+	// QueryRules has the last rules set by SetQueryRules, by
+	// rule source.
+	QueryRules map[string]*tabletserver.QueryRules
+
 	// mu protects the next fields in this structure. They are
 	// accessed by both the methods in this interface, and the
 	// background health check.
@@ -66,6 +80,13 @@ type Controller struct {
 
 	// isInLameduck is a state variable.
 	isInLameduck bool
+
+	// schemaTables are the tables last sent by NotifySchema.
+	schemaTables map[string]*tabletserver.TableInfo
+
+	// schemaNotifiers are the functions registered with
+	// RegisterSchemaNotifier, by name.
+	schemaNotifiers map[string]func(map[string]*tabletserver.TableInfo)
 }
 
 // NewController returns a mock of tabletserver.Controller
@@ -133,6 +154,39 @@ func (tqsc *Controller) ReloadSchema(ctx context.Context) error {
 	return nil
 }
 
+// RegisterSchemaNotifier is part of the tabletserver.Controller interface
+func (tqsc *Controller) RegisterSchemaNotifier(name string, f func(tables map[string]*tabletserver.TableInfo)) {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+
+	if tqsc.schemaNotifiers == nil {
+		tqsc.schemaNotifiers = make(map[string]func(map[string]*tabletserver.TableInfo))
+	}
+	tqsc.schemaNotifiers[name] = f
+	f(tqsc.schemaTables)
+}
+
+// UnregisterSchemaNotifier is part of the tabletserver.Controller interface
+func (tqsc *Controller) UnregisterSchemaNotifier(name string) {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+
+	delete(tqsc.schemaNotifiers, name)
+}
+
+// NotifySchema makes tables the tables of the schema, and calls the
+// functions registered with RegisterSchemaNotifier, like the query
+// service does when its schema changes.
+func (tqsc *Controller) NotifySchema(tables map[string]*tabletserver.TableInfo) {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+
+	tqsc.schemaTables = tables
+	for _, f := range tqsc.schemaNotifiers {
+		f(tables)
+	}
+}
+
 //ClearQueryPlanCache is part of the tabletserver.Controller interface
 func (tqsc *Controller) ClearQueryPlanCache() {
 }
@@ -147,6 +201,13 @@ func (tqsc *Controller) UnRegisterQueryRuleSource(ruleSource string) {
 
 // SetQueryRules is part of the tabletserver.Controller interface
 func (tqsc *Controller) SetQueryRules(ruleSource string, qrs *tabletserver.QueryRules) error {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+
+	if tqsc.QueryRules == nil {
+		tqsc.QueryRules = make(map[string]*tabletserver.QueryRules)
+	}
+	tqsc.QueryRules[ruleSource] = qrs
 	return nil
 }
 
@@ -157,6 +218,33 @@ func (tqsc *Controller) QueryService() queryservice.QueryService {
 
 // QueryServiceStats is part of the tabletserver.Controller interface
 func (tqsc *Controller) QueryServiceStats() *tabletserver.QueryServiceStats {
+	return tqsc.Stats
+}
+
+// ConnectionStats is part of the tabletserver.Controller interface
+func (tqsc *Controller) ConnectionStats() tabletserver.ConnectionStats {
+	return tqsc.ConnStats
+}
+
+// RuntimeConfig is part of the tabletserver.Controller interface
+func (tqsc *Controller) RuntimeConfig() tabletserver.RuntimeConfig {
+	return tqsc.CurrentRuntimeConfig
+}
+
+// SetRuntimeConfig is part of the tabletserver.Controller interface
+func (tqsc *Controller) SetRuntimeConfig(config tabletserver.RuntimeConfig) error {
+	if config.PoolSize != 0 {
+		tqsc.CurrentRuntimeConfig.PoolSize = config.PoolSize
+	}
+	if config.StreamPoolSize != 0 {
+		tqsc.CurrentRuntimeConfig.StreamPoolSize = config.StreamPoolSize
+	}
+	if config.TxPoolSize != 0 {
+		tqsc.CurrentRuntimeConfig.TxPoolSize = config.TxPoolSize
+	}
+	if config.QueryTimeout != 0 {
+		tqsc.CurrentRuntimeConfig.QueryTimeout = config.QueryTimeout
+	}
 	return nil
 }
 
@@ -48,6 +48,7 @@ func NewTxEngine(checker MySQLChecker, config Config, queryServiceStats *QuerySe
 		queryServiceStats,
 		checker,
 	)
+	te.txPool.pool.SetMaxCap(config.TransactionCap * config.PoolMaxSizeFactor)
 	te.queryServiceStats = queryServiceStats
 	te.twopcEnabled = config.TwoPCEnable
 	if te.twopcEnabled {
@@ -0,0 +1,55 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"path"
+
+	"golang.org/x/net/context"
+)
+
+// This file provides the utility methods to save / retrieve the
+// preferred backup of a shard in the topology Backend.
+//
+// The preferred backup is the name of the backup new tablets of the
+// shard restore from, instead of the most recent one. It is stored in
+// the global cell, next to the Shard record, as the plain backup name.
+
+// PreferredBackupFile is the name of the file that contains the
+// preferred backup of a shard.
+const PreferredBackupFile = "PreferredBackup"
+
+func pathForPreferredBackup(keyspace, shard string) string {
+	return path.Join("keyspaces", keyspace, "shards", shard, PreferredBackupFile)
+}
+
+// GetPreferredBackup returns the name of the preferred backup of a
+// shard, or "" if there is none.
+func (ts Server) GetPreferredBackup(ctx context.Context, keyspace, shard string) (string, error) {
+	contents, _, err := ts.Get(ctx, GlobalCell, pathForPreferredBackup(keyspace, shard))
+	switch err {
+	case nil:
+		return string(contents), nil
+	case ErrNoNode:
+		return "", nil
+	default:
+		return "", err
+	}
+}
+
+// SetPreferredBackup saves the name of the preferred backup of a
+// shard. An empty name clears it, so tablets restore from the most
+// recent backup again.
+func (ts Server) SetPreferredBackup(ctx context.Context, keyspace, shard, name string) error {
+	filePath := pathForPreferredBackup(keyspace, shard)
+	if name == "" {
+		if err := ts.Delete(ctx, GlobalCell, filePath, nil); err != nil && err != ErrNoNode {
+			return err
+		}
+		return nil
+	}
+	_, err := ts.Update(ctx, GlobalCell, filePath, []byte(name), nil)
+	return err
+}
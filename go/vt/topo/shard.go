@@ -322,6 +322,10 @@ func (ts Server) DeleteShard(ctx context.Context, keyspace, shard string) error
 	if err := ts.Impl.DeleteShard(ctx, keyspace, shard); err != nil {
 		return err
 	}
+	// The preferred backup is stored next to the shard record.
+	if err := ts.SetPreferredBackup(ctx, keyspace, shard, ""); err != nil {
+		log.Warningf("cannot delete the preferred backup of shard %v/%v: %v", keyspace, shard, err)
+	}
 	event.Dispatch(&events.ShardChange{
 		KeyspaceName: keyspace,
 		ShardName:    shard,
@@ -8,6 +8,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/youtube/vitess/go/vt/logutil"
 	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
@@ -33,6 +34,11 @@ func init() {
 		commandRestoreFromBackup,
 		"<tablet alias>",
 		"Stops mysqld and restores the data from the latest backup."})
+	addCommand("Tablets", command{
+		"RestoreToTimestamp",
+		commandRestoreToTimestamp,
+		"-backup=<backup name> -target_time=<RFC3339 time> <tablet alias>",
+		"Stops mysqld, restores the data from the named backup, and applies the master binlogs up to the target time. The tablet is left DRAINED, with replication stopped."})
 }
 
 func commandListBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -119,3 +125,45 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 		}
 	}
 }
+
+func commandRestoreToTimestamp(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	backupName := subFlags.String("backup", "", "the name of the backup to restore, as returned by ListBackups")
+	targetTimeStr := subFlags.String("target_time", "", "the time to recover to, in RFC3339 format (e.g. 2006-01-02T15:04:05Z)")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the RestoreToTimestamp command requires the <tablet alias> argument")
+	}
+	if *backupName == "" {
+		return fmt.Errorf("the RestoreToTimestamp command requires the -backup flag")
+	}
+	targetTime, err := time.Parse(time.RFC3339, *targetTimeStr)
+	if err != nil {
+		return fmt.Errorf("invalid -target_time %q: %v", *targetTimeStr, err)
+	}
+
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	stream, err := wr.TabletManagerClient().RestoreToTimestamp(ctx, tabletInfo.Tablet, *backupName, targetTime)
+	if err != nil {
+		return err
+	}
+	for {
+		e, err := stream.Recv()
+		switch err {
+		case nil:
+			logutil.LogEvent(wr.Logger(), e)
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
@@ -194,7 +194,7 @@ var commands = []commandGroup{
 				"Runs the specified hook on the given tablet. A hook is a script that resides in the $VTROOT/vthook directory. You can put any script into that directory and use this command to run that script.\n" +
 					"For this command, the param=value arguments are parameters that the command passes to the specified hook."},
 			{"ExecuteFetchAsDba", commandExecuteFetchAsDba,
-				"[-max_rows=10000] [-disable_binlogs] [-json] <tablet alias> <sql command>",
+				"[-max_rows=10000] [-disable_binlogs] [-max_exec_time=<duration>] [-json] <tablet alias> <sql command>",
 				"Runs the given SQL command as a DBA on the remote tablet."},
 		},
 	},
@@ -207,7 +207,7 @@ var commands = []commandGroup{
 				"<keyspace/shard>",
 				"Outputs a JSON structure that contains information about the Shard."},
 			{"TabletExternallyReparented", commandTabletExternallyReparented,
-				"<tablet alias>",
+				"[-validate] <tablet alias>",
 				"Changes metadata in the topology server to acknowledge a shard master change performed by an external tool. See the Reparenting guide for more information:" +
 					"https://github.com/youtube/vitess/blob/master/doc/Reparenting.md#external-reparents."},
 			{"ValidateShard", commandValidateShard,
@@ -993,7 +993,7 @@ func commandSleep(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 }
 
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
-	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
+	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously. 0 uses the tablet default, the number of CPUs")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1030,6 +1030,7 @@ func commandExecuteFetchAsDba(ctx context.Context, wr *wrangler.Wrangler, subFla
 	maxRows := subFlags.Int("max_rows", 10000, "Specifies the maximum number of rows to allow in reset")
 	disableBinlogs := subFlags.Bool("disable_binlogs", false, "Disables writing to binlogs during the query")
 	reloadSchema := subFlags.Bool("reload_schema", false, "Indicates whether the tablet schema will be reloaded after executing the SQL command. The default value is <code>false</code>, which indicates that the tablet schema will not be reloaded.")
+	maxExecTime := subFlags.Duration("max_exec_time", 0, "If not 0, MySQL aborts the query after running it for that long")
 	json := subFlags.Bool("json", false, "Output JSON instead of human-readable table")
 
 	if err := subFlags.Parse(args); err != nil {
@@ -1044,7 +1045,7 @@ func commandExecuteFetchAsDba(ctx context.Context, wr *wrangler.Wrangler, subFla
 		return err
 	}
 	query := subFlags.Arg(1)
-	qrproto, err := wr.ExecuteFetchAsDba(ctx, alias, query, *maxRows, *disableBinlogs, *reloadSchema)
+	qrproto, err := wr.ExecuteFetchAsDba(ctx, alias, query, *maxRows, *disableBinlogs, *reloadSchema, *maxExecTime)
 	if err != nil {
 		return err
 	}
@@ -1124,6 +1125,7 @@ func commandGetShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 }
 
 func commandTabletExternallyReparented(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	validate := subFlags.Bool("validate", false, "Rejects the reparent if the tablet is still replicating from a master")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1139,7 +1141,7 @@ func commandTabletExternallyReparented(ctx context.Context, wr *wrangler.Wrangle
 	if err != nil {
 		return err
 	}
-	return wr.TabletManagerClient().TabletExternallyReparented(ctx, ti.Tablet, "")
+	return wr.TabletManagerClient().TabletExternallyReparented(ctx, ti.Tablet, "", *validate)
 }
 
 func commandValidateShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
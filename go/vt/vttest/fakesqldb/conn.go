@@ -230,7 +230,10 @@ func (conn *Conn) CloseResult() {
 
 // Fields returns the current fields description for the query
 func (conn *Conn) Fields() ([]*querypb.Field, error) {
-	return make([]*querypb.Field, 0), nil
+	if conn.curQueryResult == nil || conn.curQueryResult.Fields == nil {
+		return make([]*querypb.Field, 0), nil
+	}
+	return conn.curQueryResult.Fields, nil
 }
 
 // ID returns the connection id.
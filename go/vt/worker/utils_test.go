@@ -101,7 +101,7 @@ func newFakeTMCTopo(ts topo.Server) tmclient.TabletManagerClient {
 }
 
 // ChangeType is part of the tmclient.TabletManagerClient interface.
-func (f *fakeTMCTopo) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType) error {
+func (f *fakeTMCTopo) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType, idempotent bool) error {
 	_, err := f.server.UpdateTabletFields(ctx, tablet.Alias, func(t *topodatapb.Tablet) error {
 		t.Type = dbType
 		return nil
@@ -135,7 +135,7 @@ func RecordChangeSlaveTypeAction(cleaner *Cleaner, tabletAlias *topodatapb.Table
 		}
 
 		// ask the tablet to make the change
-		return wr.tmc.ChangeType(ctx, ti.Tablet, to)
+		return wr.tmc.ChangeType(ctx, ti.Tablet, to, false /*idempotent*/)
 	})
 }
 
@@ -114,7 +114,7 @@ func (wr *Wrangler) ReparentTablet(ctx context.Context, tabletAlias *topodatapb.
 	}
 
 	// and do the remote command
-	return wr.tmc.SetMaster(ctx, ti.Tablet, shardInfo.MasterAlias, 0, false)
+	return wr.tmc.SetMaster(ctx, ti.Tablet, shardInfo.Keyspace(), shardInfo.ShardName(), shardInfo.MasterAlias, 0, false)
 }
 
 // InitShardMaster will make the provided tablet the master for the shard.
@@ -212,7 +212,7 @@ func (wr *Wrangler) initShardMasterLocked(ctx context.Context, ev *events.Repare
 	// position
 	wr.logger.Infof("initializing master on %v", topoproto.TabletAliasString(masterElectTabletAlias))
 	event.DispatchUpdate(ev, "initializing master")
-	rp, err := wr.tmc.InitMaster(ctx, masterElectTabletInfo.Tablet)
+	rp, err := wr.tmc.InitMaster(ctx, masterElectTabletInfo.Tablet, keyspace, shard)
 	if err != nil {
 		return err
 	}
@@ -239,7 +239,7 @@ func (wr *Wrangler) initShardMasterLocked(ctx context.Context, ev *events.Repare
 			go func(alias topodatapb.TabletAlias, tabletInfo *topo.TabletInfo) {
 				defer wgMaster.Done()
 				wr.logger.Infof("populating reparent journal on new master %v", topoproto.TabletAliasString(&alias))
-				masterErr = wr.tmc.PopulateReparentJournal(replCtx, tabletInfo.Tablet, now, initShardMasterOperation, &alias, rp)
+				masterErr = wr.tmc.PopulateReparentJournal(replCtx, tabletInfo.Tablet, keyspace, shard, now, initShardMasterOperation, &alias, rp)
 			}(alias, tabletInfo)
 		} else {
 			wgSlaves.Add(1)
@@ -289,7 +289,7 @@ func (wr *Wrangler) initShardMasterLocked(ctx context.Context, ev *events.Repare
 	// If the database doesn't exist, it means the user intends for these tablets
 	// to begin serving with no data (i.e. first time initialization).
 	createDB := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", sqlparser.Backtick(topoproto.TabletDbName(masterElectTabletInfo.Tablet)))
-	if _, err := wr.tmc.ExecuteFetchAsDba(ctx, masterElectTabletInfo.Tablet, false, []byte(createDB), 1, false, true); err != nil {
+	if _, err := wr.tmc.ExecuteFetchAsDba(ctx, masterElectTabletInfo.Tablet, false, []byte(createDB), 1, false, true, 0); err != nil {
 		return fmt.Errorf("failed to create database: %v", err)
 	}
 
@@ -410,7 +410,7 @@ func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.R
 			go func(alias topodatapb.TabletAlias, tabletInfo *topo.TabletInfo) {
 				defer wgMaster.Done()
 				wr.logger.Infof("populating reparent journal on new master %v", topoproto.TabletAliasString(&alias))
-				masterErr = wr.tmc.PopulateReparentJournal(replCtx, tabletInfo.Tablet, now, plannedReparentShardOperation, &alias, rp)
+				masterErr = wr.tmc.PopulateReparentJournal(replCtx, tabletInfo.Tablet, keyspace, shard, now, plannedReparentShardOperation, &alias, rp)
 			}(alias, tabletInfo)
 		} else {
 			wgSlaves.Add(1)
@@ -419,7 +419,7 @@ func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.R
 				wr.logger.Infof("setting new master on slave %v", topoproto.TabletAliasString(&alias))
 				// also restart replication on old master
 				forceStartSlave := topoproto.TabletAliasEqual(&alias, oldMasterTabletInfo.Alias)
-				if err := wr.tmc.SetMaster(replCtx, tabletInfo.Tablet, masterElectTabletAlias, now, forceStartSlave); err != nil {
+				if err := wr.tmc.SetMaster(replCtx, tabletInfo.Tablet, keyspace, shard, masterElectTabletAlias, now, forceStartSlave); err != nil {
 					rec.RecordError(fmt.Errorf("Tablet %v SetMaster failed: %v", topoproto.TabletAliasString(&alias), err))
 					return
 				}
@@ -476,6 +476,17 @@ func (maxPosSearch *maxReplPosSearch) processTablet(tablet *topodatapb.Tablet) {
 	slaveStatusCtx, cancelSlaveStatus := context.WithTimeout(maxPosSearch.ctx, maxPosSearch.waitSlaveTimeout)
 	defer cancelSlaveStatus()
 
+	// Tablets that were marked as not eligible (e.g. before a
+	// maintenance) are skipped. Tablets that cannot answer are
+	// assumed to be eligible, as they may run an older version.
+	eligible, reason, err := maxPosSearch.wrangler.tmc.CheckReparentCandidate(slaveStatusCtx, tablet)
+	if err != nil {
+		maxPosSearch.wrangler.logger.Warningf("failed to check reparent eligibility of %v, assuming it is eligible: %v", topoproto.TabletAliasString(tablet.Alias), err)
+	} else if !eligible {
+		maxPosSearch.wrangler.logger.Infof("tablet %v is not eligible for reparent (%v), ignoring tablet", topoproto.TabletAliasString(tablet.Alias), reason)
+		return
+	}
+
 	status, err := maxPosSearch.wrangler.tmc.SlaveStatus(slaveStatusCtx, tablet)
 	if err != nil {
 		maxPosSearch.wrangler.logger.Warningf("failed to get replication status from %v, ignoring tablet: %v", topoproto.TabletAliasString(tablet.Alias), err)
@@ -497,7 +508,8 @@ func (maxPosSearch *maxReplPosSearch) processTablet(tablet *topodatapb.Tablet) {
 
 // chooseNewMaster finds a tablet that is going to become master after reparent. The criterias
 // for the new master-elect are (preferably) to be in the same cell as the current master, and
-// to be different from avoidMasterTabletAlias. The tablet with the largest replication
+// to be different from avoidMasterTabletAlias. Tablets that report they are not eligible
+// through CheckReparentCandidate are skipped. The tablet with the largest replication
 // position is chosen to minimize the time of catching up with the master. Note that the search
 // for largest replication position will race with transactions being executed on the master at
 // the same time, so when all tablets are roughly at the same position then the choice of the
@@ -692,7 +704,7 @@ func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events
 			go func(alias topodatapb.TabletAlias, tabletInfo *topo.TabletInfo) {
 				defer wgMaster.Done()
 				wr.logger.Infof("populating reparent journal on new master %v", topoproto.TabletAliasString(&alias))
-				masterErr = wr.tmc.PopulateReparentJournal(replCtx, tabletInfo.Tablet, now, emergencyReparentShardOperation, &alias, rp)
+				masterErr = wr.tmc.PopulateReparentJournal(replCtx, tabletInfo.Tablet, keyspace, shard, now, emergencyReparentShardOperation, &alias, rp)
 			}(alias, tabletInfo)
 		} else {
 			wgSlaves.Add(1)
@@ -703,7 +715,7 @@ func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events
 				if status, ok := statusMap[alias]; ok {
 					forceStartSlave = status.SlaveIoRunning || status.SlaveSqlRunning
 				}
-				if err := wr.tmc.SetMaster(replCtx, tabletInfo.Tablet, masterElectTabletAlias, now, forceStartSlave); err != nil {
+				if err := wr.tmc.SetMaster(replCtx, tabletInfo.Tablet, keyspace, shard, masterElectTabletAlias, now, forceStartSlave); err != nil {
 					rec.RecordError(fmt.Errorf("Tablet %v SetMaster failed: %v", topoproto.TabletAliasString(&alias), err))
 				}
 			}(alias, tabletInfo)
@@ -0,0 +1,94 @@
+// Copyright 2017, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wrangler
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysqlconn/replication"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/topo/memorytopo"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// eligibilityFakeTMC returns a replication position and a reparent
+// eligibility for each tablet, keyed by uid.
+type eligibilityFakeTMC struct {
+	tmclient.TabletManagerClient
+	positions  map[uint32]uint64
+	ineligible map[uint32]string
+}
+
+func (c *eligibilityFakeTMC) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	return &replicationdatapb.Status{
+		Position: replication.EncodePosition(replication.Position{
+			GTIDSet: replication.MariadbGTID{Domain: 0, Server: 1, Sequence: c.positions[tablet.Alias.Uid]},
+		}),
+	}, nil
+}
+
+func (c *eligibilityFakeTMC) CheckReparentCandidate(ctx context.Context, tablet *topodatapb.Tablet) (bool, string, error) {
+	if reason, ok := c.ineligible[tablet.Alias.Uid]; ok {
+		return false, reason, nil
+	}
+	return true, "", nil
+}
+
+func TestChooseNewMasterSkipsIneligible(t *testing.T) {
+	tmc := &eligibilityFakeTMC{
+		positions: map[uint32]uint64{
+			1: 10,
+			2: 10,
+			3: 5,
+		},
+	}
+	wr := New(logutil.NewMemoryLogger(), memorytopo.NewServer("cell1"), tmc)
+
+	tabletMap := make(map[topodatapb.TabletAlias]*topo.TabletInfo)
+	for uid := uint32(0); uid < 4; uid++ {
+		tablet := &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "cell1",
+				Uid:  uid,
+			},
+			Type: topodatapb.TabletType_REPLICA,
+		}
+		if uid == 0 {
+			tablet.Type = topodatapb.TabletType_MASTER
+		}
+		tabletMap[*tablet.Alias] = &topo.TabletInfo{Tablet: tablet}
+	}
+	masterAlias := &topodatapb.TabletAlias{Cell: "cell1", Uid: 0}
+	shardInfo := topo.NewShardInfo("ks", "0", &topodatapb.Shard{MasterAlias: masterAlias}, 0)
+
+	// Tablet 2 has the most advanced position, but is not eligible.
+	tmc.positions[2] = 20
+	tmc.ineligible = map[uint32]string{2: "scheduled reboot"}
+	got, err := wr.chooseNewMaster(context.Background(), shardInfo, tabletMap, masterAlias, time.Second)
+	if err != nil {
+		t.Fatalf("chooseNewMaster failed: %v", err)
+	}
+	if want := (&topodatapb.TabletAlias{Cell: "cell1", Uid: 1}); !topoproto.TabletAliasEqual(got, want) {
+		t.Errorf("chooseNewMaster() = %v, want %v", topoproto.TabletAliasString(got), topoproto.TabletAliasString(want))
+	}
+
+	// With all replicas ineligible, no tablet is chosen.
+	tmc.ineligible = map[uint32]string{1: "maintenance", 2: "maintenance", 3: "maintenance"}
+	got, err = wr.chooseNewMaster(context.Background(), shardInfo, tabletMap, masterAlias, time.Second)
+	if err != nil {
+		t.Fatalf("chooseNewMaster failed: %v", err)
+	}
+	if got != nil {
+		t.Errorf("chooseNewMaster() = %v, want nil", topoproto.TabletAliasString(got))
+	}
+}
@@ -331,7 +331,7 @@ func (wr *Wrangler) CopySchemaShard(ctx context.Context, sourceTabletAlias *topo
 // tablet to the destination tablet. It's assumed that destination tablet is a
 // master and binlogging is not turned off when INSERT statements are executed.
 func (wr *Wrangler) copyShardMetadata(ctx context.Context, srcTabletAlias *topodatapb.TabletAlias, destTabletAlias *topodatapb.TabletAlias) error {
-	presenceResult, err := wr.ExecuteFetchAsDba(ctx, srcTabletAlias, "SELECT 1 FROM information_schema.tables WHERE table_schema = '_vt' AND table_name = 'shard_metadata'", 1, false, false)
+	presenceResult, err := wr.ExecuteFetchAsDba(ctx, srcTabletAlias, "SELECT 1 FROM information_schema.tables WHERE table_schema = '_vt' AND table_name = 'shard_metadata'", 1, false, false, 0)
 	if err != nil {
 		return err
 	}
@@ -340,7 +340,7 @@ func (wr *Wrangler) copyShardMetadata(ctx context.Context, srcTabletAlias *topod
 		return nil
 	}
 
-	dataProto, err := wr.ExecuteFetchAsDba(ctx, srcTabletAlias, "SELECT name, value FROM _vt.shard_metadata", 100, false, false)
+	dataProto, err := wr.ExecuteFetchAsDba(ctx, srcTabletAlias, "SELECT name, value FROM _vt.shard_metadata", 100, false, false, 0)
 	if err != nil {
 		return err
 	}
@@ -356,7 +356,7 @@ func (wr *Wrangler) copyShardMetadata(ctx context.Context, srcTabletAlias *topod
 		queryBuf.WriteString(") ON DUPLICATE KEY UPDATE value = ")
 		value.EncodeSQL(&queryBuf)
 
-		_, err := wr.ExecuteFetchAsDba(ctx, destTabletAlias, queryBuf.String(), 0, false, false)
+		_, err := wr.ExecuteFetchAsDba(ctx, destTabletAlias, queryBuf.String(), 0, false, false, 0)
 		if err != nil {
 			return err
 		}
@@ -394,7 +394,7 @@ func (wr *Wrangler) applySQLShard(ctx context.Context, tabletInfo *topo.TabletIn
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 	// Need to make sure that we enable binlog, since we're only applying the statement on masters.
-	_, err = wr.tmc.ExecuteFetchAsDba(ctx, tabletInfo.Tablet, false, []byte(filledChange), 0, false, reloadSchema)
+	_, err = wr.tmc.ExecuteFetchAsDba(ctx, tabletInfo.Tablet, false, []byte(filledChange), 0, false, reloadSchema, 0)
 	return err
 }
 
@@ -6,6 +6,7 @@ package wrangler
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/youtube/vitess/go/vt/key"
 	"github.com/youtube/vitess/go/vt/topo"
@@ -144,14 +145,15 @@ func (wr *Wrangler) ChangeSlaveType(ctx context.Context, tabletAlias *topodatapb
 	}
 
 	// and ask the tablet to make the change
-	return wr.tmc.ChangeType(ctx, ti.Tablet, tabletType)
+	return wr.tmc.ChangeType(ctx, ti.Tablet, tabletType, false /*idempotent*/)
 }
 
-// ExecuteFetchAsDba executes a query remotely using the DBA pool
-func (wr *Wrangler) ExecuteFetchAsDba(ctx context.Context, tabletAlias *topodatapb.TabletAlias, query string, maxRows int, disableBinlogs bool, reloadSchema bool) (*querypb.QueryResult, error) {
+// ExecuteFetchAsDba executes a query remotely using the DBA pool.
+// If maxExecTime is not 0, mysql aborts the query after that long.
+func (wr *Wrangler) ExecuteFetchAsDba(ctx context.Context, tabletAlias *topodatapb.TabletAlias, query string, maxRows int, disableBinlogs bool, reloadSchema bool, maxExecTime time.Duration) (*querypb.QueryResult, error) {
 	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
 	if err != nil {
 		return nil, err
 	}
-	return wr.tmc.ExecuteFetchAsDba(ctx, ti.Tablet, false, []byte(query), maxRows, disableBinlogs, reloadSchema)
+	return wr.tmc.ExecuteFetchAsDba(ctx, ti.Tablet, false, []byte(query), maxRows, disableBinlogs, reloadSchema, maxExecTime)
 }
@@ -5,11 +5,15 @@
 package testlib
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
+	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -25,6 +29,7 @@ import (
 	"github.com/youtube/vitess/go/vt/vttest/fakesqldb"
 	"github.com/youtube/vitess/go/vt/wrangler"
 
+	logutilpb "github.com/youtube/vitess/go/vt/proto/logutil"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
 )
 
@@ -104,6 +109,15 @@ func TestBackupRestore(t *testing.T) {
 	sourceTablet.StartActionLoop(t, wr)
 	defer sourceTablet.StopActionLoop(t)
 
+	// no backup was taken yet
+	info, err := wr.TabletManagerClient().GetLastBackupInfo(ctx, sourceTablet.Tablet)
+	if err != nil {
+		t.Fatalf("GetLastBackupInfo failed: %v", err)
+	}
+	if info != nil {
+		t.Errorf("GetLastBackupInfo() = %v before any backup, want nil", info)
+	}
+
 	// run the backup
 	if err := vp.Run([]string{"Backup", topoproto.TabletAliasString(sourceTablet.Tablet.Alias)}); err != nil {
 		t.Fatalf("Backup failed: %v", err)
@@ -120,6 +134,33 @@ func TestBackupRestore(t *testing.T) {
 		t.Errorf("sourceTablet.FakeMysqlDaemon.Running not set")
 	}
 
+	// the backup is reported, with the size of its files in storage
+	info, err = wr.TabletManagerClient().GetLastBackupInfo(ctx, sourceTablet.Tablet)
+	if err != nil {
+		t.Fatalf("GetLastBackupInfo failed: %v", err)
+	}
+	if info == nil || !info.Success || info.Error != "" {
+		t.Fatalf("GetLastBackupInfo() = %v, want a successful backup", info)
+	}
+	if !strings.HasSuffix(info.Name, topoproto.TabletAliasString(sourceTablet.Tablet.Alias)) {
+		t.Errorf("GetLastBackupInfo() name is %v, want a backup of %v", info.Name, topoproto.TabletAliasString(sourceTablet.Tablet.Alias))
+	}
+	var storedBytes int64
+	backupDir := path.Join(fbsRoot, sourceTablet.Tablet.Keyspace, sourceTablet.Tablet.Shard, info.Name)
+	files, err := ioutil.ReadDir(backupDir)
+	if err != nil {
+		t.Fatalf("ReadDir(%v) failed: %v", backupDir, err)
+	}
+	for _, fi := range files {
+		storedBytes += fi.Size()
+	}
+	if info.SizeBytes != storedBytes {
+		t.Errorf("GetLastBackupInfo() size is %v, want the %v bytes in %v", info.SizeBytes, storedBytes, backupDir)
+	}
+	if info.DurationNs != info.EndTimeNs-info.StartTimeNs || info.DurationNs < 0 {
+		t.Errorf("GetLastBackupInfo() = %v, inconsistent times", info)
+	}
+
 	// create a destination tablet, set it up so we can do restores
 	destTablet := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, db)
 	destTablet.FakeMysqlDaemon.ReadOnly = true
@@ -160,6 +201,11 @@ func TestBackupRestore(t *testing.T) {
 		t.Fatalf("RestoreData failed: %v", err)
 	}
 
+	// restoring is not taking a backup
+	if info, err := wr.TabletManagerClient().GetLastBackupInfo(ctx, destTablet.Tablet); err != nil || info != nil {
+		t.Errorf("GetLastBackupInfo() = (%v, %v) for a restored tablet, want (nil, nil)", info, err)
+	}
+
 	// verify the full status
 	if err := destTablet.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
 		t.Errorf("destTablet.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
@@ -172,3 +218,638 @@ func TestBackupRestore(t *testing.T) {
 	}
 
 }
+
+func TestRestoreToTimestamp(t *testing.T) {
+	// Initialize our environment
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	// Set up mock query results.
+	db.AddQuery("CREATE DATABASE IF NOT EXISTS _vt", &sqltypes.Result{})
+	db.AddQuery("BEGIN", &sqltypes.Result{})
+	db.AddQuery("COMMIT", &sqltypes.Result{})
+	db.AddQueryPattern(`SET @@session\.sql_log_bin = .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`CREATE TABLE IF NOT EXISTS _vt\.shard_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`CREATE TABLE IF NOT EXISTS _vt\.local_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`INSERT INTO _vt\.local_metadata .*`, &sqltypes.Result{})
+
+	// Initialize our temp dirs
+	root, err := ioutil.TempDir("", "backuptest")
+	if err != nil {
+		t.Fatalf("os.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	// Initialize BackupStorage
+	fbsRoot := path.Join(root, "fbs")
+	*filebackupstorage.FileBackupStorageRoot = fbsRoot
+	*backupstorage.BackupStorageImplementation = "file"
+
+	// Initialize the fake mysql root directories
+	sourceInnodbDataDir := path.Join(root, "source_innodb_data")
+	sourceInnodbLogDir := path.Join(root, "source_innodb_log")
+	sourceDataDir := path.Join(root, "source_data")
+	sourceDataDbDir := path.Join(sourceDataDir, "vt_db")
+	for _, s := range []string{sourceInnodbDataDir, sourceInnodbLogDir, sourceDataDbDir} {
+		if err := os.MkdirAll(s, os.ModePerm); err != nil {
+			t.Fatalf("failed to create directory %v: %v", s, err)
+		}
+	}
+	if err := ioutil.WriteFile(path.Join(sourceDataDbDir, "db.opt"), []byte("db opt file"), os.ModePerm); err != nil {
+		t.Fatalf("failed to write file db.opt: %v", err)
+	}
+
+	// create a master tablet to read the binlogs from, it only
+	// purged binlogs older than the backup
+	master := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, db)
+	master.FakeMysqlDaemon.CurrentPurgedPosition = replication.Position{
+		GTIDSet: replication.MariadbGTID{
+			Domain:   2,
+			Server:   123,
+			Sequence: 400,
+		},
+	}
+	master.StartActionLoop(t, wr)
+	defer master.StopActionLoop(t)
+
+	// create a single tablet, and take a backup
+	sourceTablet := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, db)
+	sourceTablet.FakeMysqlDaemon.ReadOnly = true
+	sourceTablet.FakeMysqlDaemon.Replicating = true
+	sourceTablet.FakeMysqlDaemon.CurrentMasterPosition = replication.Position{
+		GTIDSet: replication.MariadbGTID{
+			Domain:   2,
+			Server:   123,
+			Sequence: 457,
+		},
+	}
+	sourceTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE",
+		"START SLAVE",
+	}
+	sourceTablet.FakeMysqlDaemon.Mycnf = &mysqlctl.Mycnf{
+		DataDir:               sourceDataDir,
+		InnodbDataHomeDir:     sourceInnodbDataDir,
+		InnodbLogGroupHomeDir: sourceInnodbLogDir,
+	}
+	sourceTablet.StartActionLoop(t, wr)
+	defer sourceTablet.StopActionLoop(t)
+
+	if err := vp.Run([]string{"Backup", topoproto.TabletAliasString(sourceTablet.Tablet.Alias)}); err != nil {
+		t.Fatalf("Backup failed: %v", err)
+	}
+
+	// find the backup name, and the time it was taken at
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatalf("GetBackupStorage failed: %v", err)
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, fmt.Sprintf("%v/%v", sourceTablet.Tablet.Keyspace, sourceTablet.Tablet.Shard))
+	if err != nil || len(bhs) != 1 {
+		t.Fatalf("ListBackups returned %v backups, %v, want 1", len(bhs), err)
+	}
+	backupName := bhs[0].Name()
+	backupTime, err := mysqlctl.BackupTime(backupName)
+	if err != nil {
+		t.Fatalf("BackupTime failed: %v", err)
+	}
+
+	// create a destination tablet, set it up so we can do restores
+	destTablet := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, db)
+	destTablet.FakeMysqlDaemon.ReadOnly = true
+	destTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"cmd1",
+	}
+	destTablet.FakeMysqlDaemon.Mycnf = &mysqlctl.Mycnf{
+		DataDir:               sourceDataDir,
+		InnodbDataHomeDir:     sourceInnodbDataDir,
+		InnodbLogGroupHomeDir: sourceInnodbLogDir,
+		BinLogPath:            path.Join(root, "bin-logs/filename_prefix"),
+		RelayLogPath:          path.Join(root, "relay-logs/filename_prefix"),
+		RelayLogIndexPath:     path.Join(root, "relay-log.index"),
+		RelayLogInfoPath:      path.Join(root, "relay-log.info"),
+	}
+	destTablet.FakeMysqlDaemon.SetSlavePositionCommandsPos = sourceTablet.FakeMysqlDaemon.CurrentMasterPosition
+	destTablet.FakeMysqlDaemon.SetSlavePositionCommandsResult = []string{"cmd1"}
+
+	destTablet.StartActionLoop(t, wr)
+	defer destTablet.StopActionLoop(t)
+
+	// a target before the backup, or in the future, is rejected
+	for _, tc := range []struct {
+		targetTime time.Time
+		want       string
+	}{
+		{backupTime.Add(-time.Second), "is before backup"},
+		{time.Now().Add(time.Hour), "is after the latest available binlogs"},
+	} {
+		err := destTablet.Agent.RestoreToTimestamp(ctx, backupName, tc.targetTime, logutil.NewConsoleLogger())
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("RestoreToTimestamp(%v) returned %v, want error containing %q", tc.targetTime, err, tc.want)
+		}
+	}
+	if destTablet.FakeMysqlDaemon.ApplyBinlogsMaster != "" {
+		t.Errorf("ApplyBinlogs was called for a rejected target time")
+	}
+
+	// if the master purged binlogs newer than the backup, there
+	// would be a gap: the restore is rejected before touching the
+	// data, and the tablet keeps its type
+	purgedPos := master.FakeMysqlDaemon.CurrentPurgedPosition
+	master.FakeMysqlDaemon.CurrentPurgedPosition = replication.Position{
+		GTIDSet: replication.MariadbGTID{
+			Domain:   2,
+			Server:   123,
+			Sequence: 500,
+		},
+	}
+	if err := destTablet.Agent.RestoreToTimestamp(ctx, backupName, time.Now(), logutil.NewConsoleLogger()); err == nil || !strings.Contains(err.Error(), "purged binlogs newer than backup") {
+		t.Errorf("RestoreToTimestamp with purged binlogs returned %v, want a gap error", err)
+	}
+	if destTablet.FakeMysqlDaemon.ApplyBinlogsMaster != "" {
+		t.Errorf("ApplyBinlogs was called with purged binlogs")
+	}
+	if ti, err := ts.GetTablet(ctx, destTablet.Tablet.Alias); err != nil || ti.Type != topodatapb.TabletType_REPLICA {
+		t.Errorf("destTablet after a rejected RestoreToTimestamp: %v, %v, want a REPLICA", ti, err)
+	}
+	master.FakeMysqlDaemon.CurrentPurgedPosition = purgedPos
+
+	// a backup that cannot be read is also rejected before the
+	// tablet changes type
+	missingBackup := backupName + ".missing"
+	if err := destTablet.Agent.RestoreToTimestamp(ctx, missingBackup, time.Now(), logutil.NewConsoleLogger()); err == nil || !strings.Contains(err.Error(), "Can't read the position of backup "+missingBackup) {
+		t.Errorf("RestoreToTimestamp of a missing backup returned %v, want a read error", err)
+	}
+	if ti, err := ts.GetTablet(ctx, destTablet.Tablet.Alias); err != nil || ti.Type != topodatapb.TabletType_REPLICA {
+		t.Errorf("destTablet after RestoreToTimestamp of a missing backup: %v, %v, want a REPLICA", ti, err)
+	}
+
+	// recover to a time between the backup and now
+	targetTime := time.Now()
+	if err := destTablet.Agent.RestoreToTimestamp(ctx, backupName, targetTime, logutil.NewConsoleLogger()); err != nil {
+		t.Fatalf("RestoreToTimestamp failed: %v", err)
+	}
+
+	// verify the binlogs were applied from the backup position,
+	// until the target time, and replication was left stopped
+	if err := destTablet.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("destTablet.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
+	}
+	if got, want := destTablet.FakeMysqlDaemon.ApplyBinlogsMaster, fmt.Sprintf("%v:%v", master.Tablet.Hostname, master.Tablet.PortMap["mysql"]); got != want {
+		t.Errorf("ApplyBinlogs master: got %v, want %v", got, want)
+	}
+	if got, want := destTablet.FakeMysqlDaemon.ApplyBinlogsStartPosition, sourceTablet.FakeMysqlDaemon.CurrentMasterPosition; !got.Equal(want) {
+		t.Errorf("ApplyBinlogs start position: got %v, want %v", got, want)
+	}
+	if got := destTablet.FakeMysqlDaemon.ApplyBinlogsStopTime; !got.Equal(targetTime) {
+		t.Errorf("ApplyBinlogs stop time: got %v, want %v", got, targetTime)
+	}
+	if destTablet.FakeMysqlDaemon.Replicating {
+		t.Errorf("destTablet.FakeMysqlDaemon.Replicating set")
+	}
+	ti, err := ts.GetTablet(ctx, destTablet.Tablet.Alias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	if ti.Type != topodatapb.TabletType_DRAINED {
+		t.Errorf("destTablet has type %v after RestoreToTimestamp, want DRAINED", ti.Type)
+	}
+}
+
+func TestRestorePreferredBackup(t *testing.T) {
+	// Initialize our environment
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	// Set up mock query results.
+	db.AddQuery("CREATE DATABASE IF NOT EXISTS _vt", &sqltypes.Result{})
+	db.AddQuery("BEGIN", &sqltypes.Result{})
+	db.AddQuery("COMMIT", &sqltypes.Result{})
+	db.AddQueryPattern(`SET @@session\.sql_log_bin = .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`CREATE TABLE IF NOT EXISTS _vt\.shard_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`CREATE TABLE IF NOT EXISTS _vt\.local_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`INSERT INTO _vt\.local_metadata .*`, &sqltypes.Result{})
+
+	// Initialize our temp dirs
+	root, err := ioutil.TempDir("", "backuptest")
+	if err != nil {
+		t.Fatalf("os.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	// Initialize BackupStorage
+	fbsRoot := path.Join(root, "fbs")
+	*filebackupstorage.FileBackupStorageRoot = fbsRoot
+	*backupstorage.BackupStorageImplementation = "file"
+
+	// Initialize the fake mysql root directories
+	sourceInnodbDataDir := path.Join(root, "source_innodb_data")
+	sourceInnodbLogDir := path.Join(root, "source_innodb_log")
+	sourceDataDir := path.Join(root, "source_data")
+	sourceDataDbDir := path.Join(sourceDataDir, "vt_db")
+	for _, s := range []string{sourceInnodbDataDir, sourceInnodbLogDir, sourceDataDbDir} {
+		if err := os.MkdirAll(s, os.ModePerm); err != nil {
+			t.Fatalf("failed to create directory %v: %v", s, err)
+		}
+	}
+	if err := ioutil.WriteFile(path.Join(sourceDataDbDir, "db.opt"), []byte("db opt file"), os.ModePerm); err != nil {
+		t.Fatalf("failed to write file db.opt: %v", err)
+	}
+
+	// create a master tablet, not started, just for shard health
+	master := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, db)
+
+	// take two backups, from two tablets at different positions:
+	// the second one is the latest
+	var positions []replication.Position
+	var backupNames []string
+	for _, uid := range []uint32{1, 3} {
+		sourceTablet := NewFakeTablet(t, wr, "cell1", uid, topodatapb.TabletType_REPLICA, db)
+		sourceTablet.FakeMysqlDaemon.ReadOnly = true
+		sourceTablet.FakeMysqlDaemon.Replicating = true
+		sourceTablet.FakeMysqlDaemon.CurrentMasterPosition = replication.Position{
+			GTIDSet: replication.MariadbGTID{
+				Domain:   2,
+				Server:   123,
+				Sequence: 456 + uint64(uid),
+			},
+		}
+		sourceTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+			"STOP SLAVE",
+			"START SLAVE",
+		}
+		sourceTablet.FakeMysqlDaemon.Mycnf = &mysqlctl.Mycnf{
+			DataDir:               sourceDataDir,
+			InnodbDataHomeDir:     sourceInnodbDataDir,
+			InnodbLogGroupHomeDir: sourceInnodbLogDir,
+		}
+		sourceTablet.StartActionLoop(t, wr)
+		defer sourceTablet.StopActionLoop(t)
+
+		if err := vp.Run([]string{"Backup", topoproto.TabletAliasString(sourceTablet.Tablet.Alias)}); err != nil {
+			t.Fatalf("Backup of %v failed: %v", uid, err)
+		}
+		positions = append(positions, sourceTablet.FakeMysqlDaemon.CurrentMasterPosition)
+	}
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatalf("GetBackupStorage failed: %v", err)
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, fmt.Sprintf("%v/%v", master.Tablet.Keyspace, master.Tablet.Shard))
+	if err != nil || len(bhs) != 2 {
+		t.Fatalf("ListBackups returned %v backups, %v, want 2", len(bhs), err)
+	}
+	for _, bh := range bhs {
+		backupNames = append(backupNames, bh.Name())
+	}
+
+	// create a destination tablet, set it up so we can do restores
+	destTablet := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, db)
+	destTablet.FakeMysqlDaemon.ReadOnly = true
+	destTablet.FakeMysqlDaemon.Replicating = true
+	destTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"cmd1",
+		"set master cmd 1",
+		"START SLAVE",
+	}
+	destTablet.FakeMysqlDaemon.Mycnf = &mysqlctl.Mycnf{
+		DataDir:               sourceDataDir,
+		InnodbDataHomeDir:     sourceInnodbDataDir,
+		InnodbLogGroupHomeDir: sourceInnodbLogDir,
+		BinLogPath:            path.Join(root, "bin-logs/filename_prefix"),
+		RelayLogPath:          path.Join(root, "relay-logs/filename_prefix"),
+		RelayLogIndexPath:     path.Join(root, "relay-log.index"),
+		RelayLogInfoPath:      path.Join(root, "relay-log.info"),
+	}
+	destTablet.FakeMysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW DATABASES": {},
+	}
+	destTablet.FakeMysqlDaemon.SetSlavePositionCommandsResult = []string{"cmd1"}
+	destTablet.FakeMysqlDaemon.SetMasterCommandsInput = fmt.Sprintf("%v:%v", master.Tablet.Hostname, master.Tablet.PortMap["mysql"])
+	destTablet.FakeMysqlDaemon.SetMasterCommandsResult = []string{"set master cmd 1"}
+
+	destTablet.StartActionLoop(t, wr)
+	defer destTablet.StopActionLoop(t)
+
+	// an unknown backup cannot be preferred
+	if err := wr.TabletManagerClient().SetPreferredBackup(ctx, destTablet.Tablet, "2017-01-02.030405.cell1-0000000099"); err == nil || !strings.Contains(err.Error(), "no backup") {
+		t.Errorf("SetPreferredBackup of an unknown backup returned %v, want a no backup error", err)
+	}
+
+	// prefer the first backup
+	if err := wr.TabletManagerClient().SetPreferredBackup(ctx, destTablet.Tablet, backupNames[0]); err != nil {
+		t.Fatalf("SetPreferredBackup failed: %v", err)
+	}
+	preferredBackup, err := wr.TabletManagerClient().GetPreferredBackup(ctx, destTablet.Tablet)
+	if err != nil || preferredBackup != backupNames[0] {
+		t.Fatalf("GetPreferredBackup returned %v, %v, want %v", preferredBackup, err, backupNames[0])
+	}
+
+	// the restore starts from the position of the preferred backup,
+	// not from the position of the latest one
+	destTablet.FakeMysqlDaemon.SetSlavePositionCommandsPos = positions[0]
+	if err := destTablet.Agent.RestoreData(ctx, logutil.NewConsoleLogger(), false /* deleteBeforeRestore */); err != nil {
+		t.Fatalf("RestoreData failed: %v", err)
+	}
+	if err := destTablet.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("destTablet.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
+	}
+
+	// clearing it goes back to the latest backup
+	if err := wr.TabletManagerClient().SetPreferredBackup(ctx, destTablet.Tablet, ""); err != nil {
+		t.Fatalf("SetPreferredBackup(\"\") failed: %v", err)
+	}
+	preferredBackup, err = ts.GetPreferredBackup(ctx, master.Tablet.Keyspace, master.Tablet.Shard)
+	if err != nil || preferredBackup != "" {
+		t.Errorf("GetPreferredBackup after clearing it returned %v, %v, want no preferred backup", preferredBackup, err)
+	}
+}
+
+func TestTestRestore(t *testing.T) {
+	// Initialize our environment
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	// Initialize our temp dirs
+	root, err := ioutil.TempDir("", "backuptest")
+	if err != nil {
+		t.Fatalf("os.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	// Initialize BackupStorage
+	fbsRoot := path.Join(root, "fbs")
+	*filebackupstorage.FileBackupStorageRoot = fbsRoot
+	*backupstorage.BackupStorageImplementation = "file"
+
+	// Initialize the fake mysql root directories
+	sourceInnodbDataDir := path.Join(root, "source_innodb_data")
+	sourceInnodbLogDir := path.Join(root, "source_innodb_log")
+	sourceDataDir := path.Join(root, "source_data")
+	sourceDataDbDir := path.Join(sourceDataDir, "vt_db")
+	for _, s := range []string{sourceInnodbDataDir, sourceInnodbLogDir, sourceDataDbDir} {
+		if err := os.MkdirAll(s, os.ModePerm); err != nil {
+			t.Fatalf("failed to create directory %v: %v", s, err)
+		}
+	}
+	if err := ioutil.WriteFile(path.Join(sourceInnodbDataDir, "innodb_data_1"), []byte("innodb data 1 contents"), os.ModePerm); err != nil {
+		t.Fatalf("failed to write file innodb_data_1: %v", err)
+	}
+	if err := ioutil.WriteFile(path.Join(sourceDataDbDir, "db.opt"), []byte("db opt file"), os.ModePerm); err != nil {
+		t.Fatalf("failed to write file db.opt: %v", err)
+	}
+
+	// create a master tablet, not started, just for shard health
+	NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, db)
+
+	// take a backup, and test-restore it on the same tablet
+	tablet := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, db)
+	tablet.FakeMysqlDaemon.ReadOnly = true
+	tablet.FakeMysqlDaemon.Replicating = true
+	tablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE",
+		"START SLAVE",
+	}
+	tablet.FakeMysqlDaemon.Mycnf = &mysqlctl.Mycnf{
+		DataDir:               sourceDataDir,
+		InnodbDataHomeDir:     sourceInnodbDataDir,
+		InnodbLogGroupHomeDir: sourceInnodbLogDir,
+	}
+	scratch := mysqlctl.NewFakeMysqlDaemon(nil)
+	scratch.Running = false
+	scratch.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW DATABASES": {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte("mysql"))},
+				{sqltypes.MakeString([]byte("vt_db"))},
+			},
+		},
+		"SHOW TABLES FROM `vt_db`": {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte("t1"))},
+			},
+		},
+		"CHECK TABLE `vt_db`.`t1`": {
+			Rows: [][]sqltypes.Value{
+				{
+					sqltypes.MakeString([]byte("vt_db.t1")),
+					sqltypes.MakeString([]byte("check")),
+					sqltypes.MakeString([]byte("status")),
+					sqltypes.MakeString([]byte("OK")),
+				},
+			},
+		},
+	}
+	tablet.FakeMysqlDaemon.Scratch = scratch
+	tablet.StartActionLoop(t, wr)
+	defer tablet.StopActionLoop(t)
+
+	if err := vp.Run([]string{"Backup", topoproto.TabletAliasString(tablet.Tablet.Alias)}); err != nil {
+		t.Fatalf("Backup failed: %v", err)
+	}
+	info, err := wr.TabletManagerClient().GetLastBackupInfo(ctx, tablet.Tablet)
+	if err != nil || info == nil {
+		t.Fatalf("GetLastBackupInfo returned %v, %v, want the backup", info, err)
+	}
+
+	testRestore := func() error {
+		stream, err := wr.TabletManagerClient().TestRestore(ctx, tablet.Tablet, info.Name)
+		if err != nil {
+			return err
+		}
+		for {
+			if _, err := stream.Recv(); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+
+	// the backup is restored into the scratch mysqld, which is
+	// stopped and removed afterwards
+	if err := testRestore(); err != nil {
+		t.Fatalf("TestRestore failed: %v", err)
+	}
+	if scratch.Mycnf == nil || !strings.HasPrefix(scratch.Mycnf.DataDir, root) {
+		t.Errorf("scratch mysqld Mycnf is %v, want a directory in %v", scratch.Mycnf, root)
+	}
+	if scratch.Running {
+		t.Errorf("scratch mysqld was not shut down")
+	}
+	if _, err := os.Stat(path.Join(root, "test_restore")); !os.IsNotExist(err) {
+		t.Errorf("scratch directory was not removed: %v", err)
+	}
+
+	// and the tablet's own mysqld and data are not touched
+	if !tablet.FakeMysqlDaemon.Running {
+		t.Errorf("tablet.FakeMysqlDaemon.Running not set")
+	}
+	if err := tablet.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("tablet.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
+	}
+	if data, err := ioutil.ReadFile(path.Join(sourceDataDbDir, "db.opt")); err != nil || string(data) != "db opt file" {
+		t.Errorf("tablet data was changed: %q, %v", data, err)
+	}
+
+	// record a backup too big for the disk: the test restore fails
+	// before creating the scratch mysqld
+	manifestPath := path.Join(fbsRoot, tablet.Tablet.Keyspace, tablet.Tablet.Shard, info.Name, "MANIFEST")
+	data, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		t.Fatalf("ReadFile(%v) failed: %v", manifestPath, err)
+	}
+	bm := &mysqlctl.BackupManifest{}
+	if err := json.Unmarshal(data, bm); err != nil {
+		t.Fatalf("cannot decode MANIFEST: %v", err)
+	}
+	if bm.DataSize() == 0 {
+		t.Errorf("backup MANIFEST does not record the size of its files")
+	}
+	bm.FileEntries[0].Size = 1 << 60
+	data, err = json.Marshal(bm)
+	if err != nil {
+		t.Fatalf("cannot encode MANIFEST: %v", err)
+	}
+	if err := ioutil.WriteFile(manifestPath, data, os.ModePerm); err != nil {
+		t.Fatalf("WriteFile(%v) failed: %v", manifestPath, err)
+	}
+	scratch.Mycnf = nil
+	if err := testRestore(); err == nil || !strings.Contains(err.Error(), "not enough disk space") {
+		t.Errorf("TestRestore of a too big backup returned %v, want a disk space error", err)
+	}
+	if scratch.Mycnf != nil {
+		t.Errorf("scratch mysqld was created for a too big backup")
+	}
+	if _, err := os.Stat(path.Join(root, "test_restore")); !os.IsNotExist(err) {
+		t.Errorf("scratch directory was created for a too big backup: %v", err)
+	}
+}
+
+func TestRestoreAborted(t *testing.T) {
+	// Initialize our environment
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	// Initialize our temp dirs
+	root, err := ioutil.TempDir("", "backuptest")
+	if err != nil {
+		t.Fatalf("os.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	// Initialize BackupStorage
+	fbsRoot := path.Join(root, "fbs")
+	*filebackupstorage.FileBackupStorageRoot = fbsRoot
+	*backupstorage.BackupStorageImplementation = "file"
+
+	// Initialize the fake mysql root directories
+	sourceInnodbDataDir := path.Join(root, "source_innodb_data")
+	sourceInnodbLogDir := path.Join(root, "source_innodb_log")
+	sourceDataDir := path.Join(root, "source_data")
+	sourceDataDbDir := path.Join(sourceDataDir, "vt_db")
+	for _, s := range []string{sourceInnodbDataDir, sourceInnodbLogDir, sourceDataDbDir} {
+		if err := os.MkdirAll(s, os.ModePerm); err != nil {
+			t.Fatalf("failed to create directory %v: %v", s, err)
+		}
+	}
+	if err := ioutil.WriteFile(path.Join(sourceDataDbDir, "db.opt"), []byte("db opt file"), os.ModePerm); err != nil {
+		t.Fatalf("failed to write file db.opt: %v", err)
+	}
+
+	// create a master tablet, not started, just for shard health
+	NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, db)
+
+	// take a backup
+	sourceTablet := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, db)
+	sourceTablet.FakeMysqlDaemon.ReadOnly = true
+	sourceTablet.FakeMysqlDaemon.Replicating = true
+	sourceTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE",
+		"START SLAVE",
+	}
+	sourceTablet.FakeMysqlDaemon.Mycnf = &mysqlctl.Mycnf{
+		DataDir:               sourceDataDir,
+		InnodbDataHomeDir:     sourceInnodbDataDir,
+		InnodbLogGroupHomeDir: sourceInnodbLogDir,
+	}
+	sourceTablet.StartActionLoop(t, wr)
+	defer sourceTablet.StopActionLoop(t)
+	if err := vp.Run([]string{"Backup", topoproto.TabletAliasString(sourceTablet.Tablet.Alias)}); err != nil {
+		t.Fatalf("Backup failed: %v", err)
+	}
+
+	// create a destination tablet with its own directories
+	destDataDir := path.Join(root, "dest_data")
+	destTablet := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, db)
+	destTablet.FakeMysqlDaemon.ReadOnly = true
+	destTablet.FakeMysqlDaemon.Mycnf = &mysqlctl.Mycnf{
+		DataDir:               destDataDir,
+		InnodbDataHomeDir:     path.Join(root, "dest_innodb_data"),
+		InnodbLogGroupHomeDir: path.Join(root, "dest_innodb_log"),
+		BinLogPath:            path.Join(root, "dest-bin-logs/filename_prefix"),
+		RelayLogPath:          path.Join(root, "dest-relay-logs/filename_prefix"),
+		RelayLogIndexPath:     path.Join(root, "dest-relay-log.index"),
+		RelayLogInfoPath:      path.Join(root, "dest-relay-log.info"),
+	}
+	destTablet.FakeMysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW DATABASES": {},
+	}
+	destTablet.StartActionLoop(t, wr)
+	defer destTablet.StopActionLoop(t)
+
+	// Abort the restore when it starts copying, after leaving a
+	// file behind as if it was partially copied.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		if e.Value != "Restore: copying all files" {
+			return
+		}
+		if err := os.MkdirAll(destDataDir, os.ModePerm); err != nil {
+			t.Errorf("failed to create directory %v: %v", destDataDir, err)
+		}
+		if err := ioutil.WriteFile(path.Join(destDataDir, "partial"), []byte("partial"), os.ModePerm); err != nil {
+			t.Errorf("failed to write file partial: %v", err)
+		}
+		cancel()
+	})
+	if err := destTablet.Agent.RestoreData(ctx, logger, false /* deleteBeforeRestore */); err != mysqlctl.ErrRestoreAborted {
+		t.Fatalf("RestoreData returned %v, want %v", err, mysqlctl.ErrRestoreAborted)
+	}
+
+	// the partially restored files are removed, and the tablet
+	// is back to its original type
+	if _, err := os.Stat(destDataDir); !os.IsNotExist(err) {
+		t.Errorf("partially restored files were not removed: %v", err)
+	}
+	ti, err := ts.GetTablet(context.Background(), destTablet.Tablet.Alias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	if ti.Type != topodatapb.TabletType_REPLICA {
+		t.Errorf("tablet type after an aborted restore is %v, want %v", ti.Type, topodatapb.TabletType_REPLICA)
+	}
+	if destTablet.FakeMysqlDaemon.Running {
+		t.Errorf("destTablet.FakeMysqlDaemon.Running set after an aborted restore")
+	}
+}
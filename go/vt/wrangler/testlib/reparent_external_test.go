@@ -6,6 +6,7 @@ package testlib
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -133,7 +134,7 @@ func TestTabletExternallyReparented(t *testing.T) {
 		t.Fatalf("GetTablet failed: %v", err)
 	}
 	waitID := makeWaitID()
-	if err := tmc.TabletExternallyReparented(context.Background(), ti.Tablet, waitID); err != nil {
+	if err := tmc.TabletExternallyReparented(context.Background(), ti.Tablet, waitID, false /* validate */); err != nil {
 		t.Fatalf("TabletExternallyReparented(slave) error: %v", err)
 	}
 	waitForExternalReparent(t, waitID)
@@ -145,7 +146,7 @@ func TestTabletExternallyReparented(t *testing.T) {
 		t.Fatalf("GetTablet failed: %v", err)
 	}
 	waitID = makeWaitID()
-	if err := tmc.TabletExternallyReparented(context.Background(), ti.Tablet, waitID); err != nil {
+	if err := tmc.TabletExternallyReparented(context.Background(), ti.Tablet, waitID, false /* validate */); err != nil {
 		t.Fatalf("TabletExternallyReparented(replica) failed: %v", err)
 	}
 	waitForExternalReparent(t, waitID)
@@ -194,7 +195,7 @@ func TestTabletExternallyReparentedWithDifferentMysqlPort(t *testing.T) {
 		t.Fatalf("GetTablet failed: %v", err)
 	}
 	waitID := makeWaitID()
-	if err := tmc.TabletExternallyReparented(context.Background(), ti.Tablet, waitID); err != nil {
+	if err := tmc.TabletExternallyReparented(context.Background(), ti.Tablet, waitID, false /* validate */); err != nil {
 		t.Fatalf("TabletExternallyReparented(replica) failed: %v", err)
 	}
 	waitForExternalReparent(t, waitID)
@@ -238,7 +239,7 @@ func TestTabletExternallyReparentedContinueOnUnexpectedMaster(t *testing.T) {
 		t.Fatalf("GetTablet failed: %v", err)
 	}
 	waitID := makeWaitID()
-	if err := tmc.TabletExternallyReparented(context.Background(), ti.Tablet, waitID); err != nil {
+	if err := tmc.TabletExternallyReparented(context.Background(), ti.Tablet, waitID, false /* validate */); err != nil {
 		t.Fatalf("TabletExternallyReparented(replica) failed: %v", err)
 	}
 	waitForExternalReparent(t, waitID)
@@ -280,7 +281,7 @@ func TestTabletExternallyReparentedFailedOldMaster(t *testing.T) {
 		t.Fatalf("GetTablet failed: %v", err)
 	}
 	waitID := makeWaitID()
-	if err := tmc.TabletExternallyReparented(context.Background(), ti.Tablet, waitID); err != nil {
+	if err := tmc.TabletExternallyReparented(context.Background(), ti.Tablet, waitID, false /* validate */); err != nil {
 		t.Fatalf("TabletExternallyReparented(replica) failed: %v", err)
 	}
 	waitForExternalReparent(t, waitID)
@@ -295,6 +296,72 @@ func TestTabletExternallyReparentedFailedOldMaster(t *testing.T) {
 	}
 }
 
+// TestTabletExternallyReparentedValidate makes sure a validated external
+// reparent is rejected by a tablet that is still replicating or still
+// has a master configured, and accepted by one that has no master.
+func TestTabletExternallyReparentedValidate(t *testing.T) {
+	tabletmanager.SetReparentFlags(time.Minute /* finalizeTimeout */)
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+
+	// Create an old master, a new master, and a slave.
+	oldMaster := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, nil)
+	newMaster := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, nil)
+	slave := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, nil)
+
+	// The slave is still replicating from the old master.
+	slave.FakeMysqlDaemon.Replicating = true
+	slave.FakeMysqlDaemon.CurrentMasterHost = oldMaster.Tablet.Hostname
+	slave.FakeMysqlDaemon.CurrentMasterPort = int(oldMaster.Tablet.PortMap["mysql"])
+
+	newMaster.StartActionLoop(t, wr)
+	defer newMaster.StopActionLoop(t)
+	oldMaster.StartActionLoop(t, wr)
+	defer oldMaster.StopActionLoop(t)
+	slave.StartActionLoop(t, wr)
+	defer slave.StopActionLoop(t)
+
+	// A claim on the replicating slave should be rejected,
+	// and leave the shard record alone.
+	tmc := tmclient.NewTabletManagerClient()
+	ti, err := ts.GetTablet(ctx, slave.Tablet.Alias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	err = tmc.TabletExternallyReparented(context.Background(), ti.Tablet, makeWaitID(), true /* validate */)
+	if err == nil || !strings.Contains(err.Error(), "still replicating") {
+		t.Fatalf("TabletExternallyReparented(slave) should have been rejected, got: %v", err)
+	}
+	si, err := ts.GetShard(ctx, "test_keyspace", "0")
+	if err != nil {
+		t.Fatalf("GetShard failed: %v", err)
+	}
+	if topoproto.TabletAliasEqual(si.MasterAlias, slave.Tablet.Alias) {
+		t.Fatalf("rejected reparent updated the shard master to %v", topoproto.TabletAliasString(si.MasterAlias))
+	}
+
+	// Stopping replication is not enough, the master is still
+	// configured.
+	slave.FakeMysqlDaemon.Replicating = false
+	err = tmc.TabletExternallyReparented(context.Background(), ti.Tablet, makeWaitID(), true /* validate */)
+	if err == nil || !strings.Contains(err.Error(), "still has master") {
+		t.Fatalf("TabletExternallyReparented(stopped slave) should have been rejected, got: %v", err)
+	}
+
+	// The new master has no master configured, so the claim is accepted.
+	ti, err = ts.GetTablet(ctx, newMaster.Tablet.Alias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	waitID := makeWaitID()
+	if err := tmc.TabletExternallyReparented(context.Background(), ti.Tablet, waitID, true /* validate */); err != nil {
+		t.Fatalf("TabletExternallyReparented(new master) failed: %v", err)
+	}
+	waitForExternalReparent(t, waitID)
+}
+
 var (
 	externalReparents      = make(map[string]chan struct{})
 	externalReparentsMutex sync.Mutex
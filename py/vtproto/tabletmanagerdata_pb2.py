@@ -13,19 +13,21 @@ from google.protobuf import descriptor_pb2
 _sym_db = _symbol_database.Default()
 
 
+import binlogdata_pb2 as binlogdata__pb2
 import query_pb2 as query__pb2
 import topodata_pb2 as topodata__pb2
 import replicationdata_pb2 as replicationdata__pb2
 import logutil_pb2 as logutil__pb2
+import vschema_pb2 as vschema__pb2
 
 
 DESCRIPTOR = _descriptor.FileDescriptor(
   name='tabletmanagerdata.proto',
   package='tabletmanagerdata',
   syntax='proto3',
-  serialized_pb=_b('\n\x17tabletmanagerdata.proto\x12\x11tabletmanagerdata\x1a\x0bquery.proto\x1a\x0etopodata.proto\x1a\x15replicationdata.proto\x1a\rlogutil.proto\"\x93\x01\n\x0fTableDefinition\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06schema\x18\x02 \x01(\t\x12\x0f\n\x07\x63olumns\x18\x03 \x03(\t\x12\x1b\n\x13primary_key_columns\x18\x04 \x03(\t\x12\x0c\n\x04type\x18\x05 \x01(\t\x12\x13\n\x0b\x64\x61ta_length\x18\x06 \x01(\x04\x12\x11\n\trow_count\x18\x07 \x01(\x04\"{\n\x10SchemaDefinition\x12\x17\n\x0f\x64\x61tabase_schema\x18\x01 \x01(\t\x12=\n\x11table_definitions\x18\x02 \x03(\x0b\x32\".tabletmanagerdata.TableDefinition\x12\x0f\n\x07version\x18\x03 \x01(\t\"\x8b\x01\n\x12SchemaChangeResult\x12:\n\rbefore_schema\x18\x01 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\x12\x39\n\x0c\x61\x66ter_schema\x18\x02 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\"\xc1\x01\n\x0eUserPermission\x12\x0c\n\x04host\x18\x01 \x01(\t\x12\x0c\n\x04user\x18\x02 \x01(\t\x12\x19\n\x11password_checksum\x18\x03 \x01(\x04\x12\x45\n\nprivileges\x18\x04 \x03(\x0b\x32\x31.tabletmanagerdata.UserPermission.PrivilegesEntry\x1a\x31\n\x0fPrivilegesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xae\x01\n\x0c\x44\x62Permission\x12\x0c\n\x04host\x18\x01 \x01(\t\x12\n\n\x02\x64\x62\x18\x02 \x01(\t\x12\x0c\n\x04user\x18\x03 \x01(\t\x12\x43\n\nprivileges\x18\x04 \x03(\x0b\x32/.tabletmanagerdata.DbPermission.PrivilegesEntry\x1a\x31\n\x0fPrivilegesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x83\x01\n\x0bPermissions\x12;\n\x10user_permissions\x18\x01 \x03(\x0b\x32!.tabletmanagerdata.UserPermission\x12\x37\n\x0e\x64\x62_permissions\x18\x02 \x03(\x0b\x32\x1f.tabletmanagerdata.DbPermission\",\n\x0b\x42lpPosition\x12\x0b\n\x03uid\x18\x01 \x01(\r\x12\x10\n\x08position\x18\x02 \x01(\t\"\x1e\n\x0bPingRequest\x12\x0f\n\x07payload\x18\x01 \x01(\t\"\x1f\n\x0cPingResponse\x12\x0f\n\x07payload\x18\x01 \x01(\t\" \n\x0cSleepRequest\x12\x10\n\x08\x64uration\x18\x01 \x01(\x03\"\x0f\n\rSleepResponse\"\xaf\x01\n\x12\x45xecuteHookRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x12\n\nparameters\x18\x02 \x03(\t\x12\x46\n\textra_env\x18\x03 \x03(\x0b\x32\x33.tabletmanagerdata.ExecuteHookRequest.ExtraEnvEntry\x1a/\n\rExtraEnvEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"J\n\x13\x45xecuteHookResponse\x12\x13\n\x0b\x65xit_status\x18\x01 \x01(\x03\x12\x0e\n\x06stdout\x18\x02 \x01(\t\x12\x0e\n\x06stderr\x18\x03 \x01(\t\"Q\n\x10GetSchemaRequest\x12\x0e\n\x06tables\x18\x01 \x03(\t\x12\x15\n\rinclude_views\x18\x02 \x01(\x08\x12\x16\n\x0e\x65xclude_tables\x18\x03 \x03(\t\"S\n\x11GetSchemaResponse\x12>\n\x11schema_definition\x18\x01 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\"\x17\n\x15GetPermissionsRequest\"M\n\x16GetPermissionsResponse\x12\x33\n\x0bpermissions\x18\x01 \x01(\x0b\x32\x1e.tabletmanagerdata.Permissions\"\x14\n\x12SetReadOnlyRequest\"\x15\n\x13SetReadOnlyResponse\"\x15\n\x13SetReadWriteRequest\"\x16\n\x14SetReadWriteResponse\">\n\x11\x43hangeTypeRequest\x12)\n\x0btablet_type\x18\x01 \x01(\x0e\x32\x14.topodata.TabletType\"\x14\n\x12\x43hangeTypeResponse\"\x15\n\x13RefreshStateRequest\"\x16\n\x14RefreshStateResponse\"\x17\n\x15RunHealthCheckRequest\"\x18\n\x16RunHealthCheckResponse\"+\n\x18IgnoreHealthErrorRequest\x12\x0f\n\x07pattern\x18\x01 \x01(\t\"\x1b\n\x19IgnoreHealthErrorResponse\",\n\x13ReloadSchemaRequest\x12\x15\n\rwait_position\x18\x01 \x01(\t\"\x16\n\x14ReloadSchemaResponse\")\n\x16PreflightSchemaRequest\x12\x0f\n\x07\x63hanges\x18\x01 \x03(\t\"X\n\x17PreflightSchemaResponse\x12=\n\x0e\x63hange_results\x18\x01 \x03(\x0b\x32%.tabletmanagerdata.SchemaChangeResult\"\xc2\x01\n\x12\x41pplySchemaRequest\x12\x0b\n\x03sql\x18\x01 \x01(\t\x12\r\n\x05\x66orce\x18\x02 \x01(\x08\x12\x19\n\x11\x61llow_replication\x18\x03 \x01(\x08\x12:\n\rbefore_schema\x18\x04 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\x12\x39\n\x0c\x61\x66ter_schema\x18\x05 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\"\x8c\x01\n\x13\x41pplySchemaResponse\x12:\n\rbefore_schema\x18\x01 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\x12\x39\n\x0c\x61\x66ter_schema\x18\x02 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\"|\n\x18\x45xecuteFetchAsDbaRequest\x12\r\n\x05query\x18\x01 \x01(\x0c\x12\x0f\n\x07\x64\x62_name\x18\x02 \x01(\t\x12\x10\n\x08max_rows\x18\x03 \x01(\x04\x12\x17\n\x0f\x64isable_binlogs\x18\x04 \x01(\x08\x12\x15\n\rreload_schema\x18\x05 \x01(\x08\"?\n\x19\x45xecuteFetchAsDbaResponse\x12\"\n\x06result\x18\x01 \x01(\x0b\x32\x12.query.QueryResult\"h\n\x1d\x45xecuteFetchAsAllPrivsRequest\x12\r\n\x05query\x18\x01 \x01(\x0c\x12\x0f\n\x07\x64\x62_name\x18\x02 \x01(\t\x12\x10\n\x08max_rows\x18\x03 \x01(\x04\x12\x15\n\rreload_schema\x18\x04 \x01(\x08\"D\n\x1e\x45xecuteFetchAsAllPrivsResponse\x12\"\n\x06result\x18\x01 \x01(\x0b\x32\x12.query.QueryResult\";\n\x18\x45xecuteFetchAsAppRequest\x12\r\n\x05query\x18\x01 \x01(\x0c\x12\x10\n\x08max_rows\x18\x02 \x01(\x04\"?\n\x19\x45xecuteFetchAsAppResponse\x12\"\n\x06result\x18\x01 \x01(\x0b\x32\x12.query.QueryResult\"\x14\n\x12SlaveStatusRequest\">\n\x13SlaveStatusResponse\x12\'\n\x06status\x18\x01 \x01(\x0b\x32\x17.replicationdata.Status\"\x17\n\x15MasterPositionRequest\"*\n\x16MasterPositionResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"\x12\n\x10StopSlaveRequest\"\x13\n\x11StopSlaveResponse\"A\n\x17StopSlaveMinimumRequest\x12\x10\n\x08position\x18\x01 \x01(\t\x12\x14\n\x0cwait_timeout\x18\x02 \x01(\x03\",\n\x18StopSlaveMinimumResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"\x13\n\x11StartSlaveRequest\"\x14\n\x12StartSlaveResponse\"8\n!TabletExternallyReparentedRequest\x12\x13\n\x0b\x65xternal_id\x18\x01 \x01(\t\"$\n\"TabletExternallyReparentedResponse\" \n\x1eTabletExternallyElectedRequest\"!\n\x1fTabletExternallyElectedResponse\"\x12\n\x10GetSlavesRequest\"\"\n\x11GetSlavesResponse\x12\r\n\x05\x61\x64\x64rs\x18\x01 \x03(\t\"d\n\x16WaitBlpPositionRequest\x12\x34\n\x0c\x62lp_position\x18\x01 \x01(\x0b\x32\x1e.tabletmanagerdata.BlpPosition\x12\x14\n\x0cwait_timeout\x18\x02 \x01(\x03\"\x19\n\x17WaitBlpPositionResponse\"\x10\n\x0eStopBlpRequest\"H\n\x0fStopBlpResponse\x12\x35\n\rblp_positions\x18\x01 \x03(\x0b\x32\x1e.tabletmanagerdata.BlpPosition\"\x11\n\x0fStartBlpRequest\"\x12\n\x10StartBlpResponse\"a\n\x12RunBlpUntilRequest\x12\x35\n\rblp_positions\x18\x01 \x03(\x0b\x32\x1e.tabletmanagerdata.BlpPosition\x12\x14\n\x0cwait_timeout\x18\x02 \x01(\x03\"\'\n\x13RunBlpUntilResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"\x19\n\x17ResetReplicationRequest\"\x1a\n\x18ResetReplicationResponse\"\x13\n\x11InitMasterRequest\"&\n\x12InitMasterResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"\x99\x01\n\x1ePopulateReparentJournalRequest\x12\x17\n\x0ftime_created_ns\x18\x01 \x01(\x03\x12\x13\n\x0b\x61\x63tion_name\x18\x02 \x01(\t\x12+\n\x0cmaster_alias\x18\x03 \x01(\x0b\x32\x15.topodata.TabletAlias\x12\x1c\n\x14replication_position\x18\x04 \x01(\t\"!\n\x1fPopulateReparentJournalResponse\"p\n\x10InitSlaveRequest\x12%\n\x06parent\x18\x01 \x01(\x0b\x32\x15.topodata.TabletAlias\x12\x1c\n\x14replication_position\x18\x02 \x01(\t\x12\x17\n\x0ftime_created_ns\x18\x03 \x01(\x03\"\x13\n\x11InitSlaveResponse\"\x15\n\x13\x44\x65moteMasterRequest\"(\n\x14\x44\x65moteMasterResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"3\n\x1fPromoteSlaveWhenCaughtUpRequest\x12\x10\n\x08position\x18\x01 \x01(\t\"4\n PromoteSlaveWhenCaughtUpResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"\x19\n\x17SlaveWasPromotedRequest\"\x1a\n\x18SlaveWasPromotedResponse\"m\n\x10SetMasterRequest\x12%\n\x06parent\x18\x01 \x01(\x0b\x32\x15.topodata.TabletAlias\x12\x17\n\x0ftime_created_ns\x18\x02 \x01(\x03\x12\x19\n\x11\x66orce_start_slave\x18\x03 \x01(\x08\"\x13\n\x11SetMasterResponse\"A\n\x18SlaveWasRestartedRequest\x12%\n\x06parent\x18\x01 \x01(\x0b\x32\x15.topodata.TabletAlias\"\x1b\n\x19SlaveWasRestartedResponse\"$\n\"StopReplicationAndGetStatusRequest\"N\n#StopReplicationAndGetStatusResponse\x12\'\n\x06status\x18\x01 \x01(\x0b\x32\x17.replicationdata.Status\"\x15\n\x13PromoteSlaveRequest\"(\n\x14PromoteSlaveResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"$\n\rBackupRequest\x12\x13\n\x0b\x63oncurrency\x18\x01 \x01(\x03\"/\n\x0e\x42\x61\x63kupResponse\x12\x1d\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x0e.logutil.Event\"\x1a\n\x18RestoreFromBackupRequest\":\n\x19RestoreFromBackupResponse\x12\x1d\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x0e.logutil.Eventb\x06proto3')
+  serialized_pb=_b('\n\x17tabletmanagerdata.proto\x12\x11tabletmanagerdata\x1a\x10\x62inlogdata.proto\x1a\x0bquery.proto\x1a\x0etopodata.proto\x1a\x15replicationdata.proto\x1a\rlogutil.proto\x1a\rvschema.proto\"\x93\x01\n\x0fTableDefinition\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06schema\x18\x02 \x01(\t\x12\x0f\n\x07\x63olumns\x18\x03 \x03(\t\x12\x1b\n\x13primary_key_columns\x18\x04 \x03(\t\x12\x0c\n\x04type\x18\x05 \x01(\t\x12\x13\n\x0b\x64\x61ta_length\x18\x06 \x01(\x04\x12\x11\n\trow_count\x18\x07 \x01(\x04\"{\n\x10SchemaDefinition\x12\x17\n\x0f\x64\x61tabase_schema\x18\x01 \x01(\t\x12=\n\x11table_definitions\x18\x02 \x03(\x0b\x32\".tabletmanagerdata.TableDefinition\x12\x0f\n\x07version\x18\x03 \x01(\t\"\x8b\x01\n\x12SchemaChangeResult\x12:\n\rbefore_schema\x18\x01 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\x12\x39\n\x0c\x61\x66ter_schema\x18\x02 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\"\xc1\x01\n\x0eUserPermission\x12\x0c\n\x04host\x18\x01 \x01(\t\x12\x0c\n\x04user\x18\x02 \x01(\t\x12\x19\n\x11password_checksum\x18\x03 \x01(\x04\x12\x45\n\nprivileges\x18\x04 \x03(\x0b\x32\x31.tabletmanagerdata.UserPermission.PrivilegesEntry\x1a\x31\n\x0fPrivilegesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xae\x01\n\x0c\x44\x62Permission\x12\x0c\n\x04host\x18\x01 \x01(\t\x12\n\n\x02\x64\x62\x18\x02 \x01(\t\x12\x0c\n\x04user\x18\x03 \x01(\t\x12\x43\n\nprivileges\x18\x04 \x03(\x0b\x32/.tabletmanagerdata.DbPermission.PrivilegesEntry\x1a\x31\n\x0fPrivilegesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x83\x01\n\x0bPermissions\x12;\n\x10user_permissions\x18\x01 \x03(\x0b\x32!.tabletmanagerdata.UserPermission\x12\x37\n\x0e\x64\x62_permissions\x18\x02 \x03(\x0b\x32\x1f.tabletmanagerdata.DbPermission\",\n\x0b\x42lpPosition\x12\x0b\n\x03uid\x18\x01 \x01(\r\x12\x10\n\x08position\x18\x02 \x01(\t\"\x1e\n\x0bPingRequest\x12\x0f\n\x07payload\x18\x01 \x01(\t\"\x1f\n\x0cPingResponse\x12\x0f\n\x07payload\x18\x01 \x01(\t\" \n\x0cSleepRequest\x12\x10\n\x08\x64uration\x18\x01 \x01(\x03\"\x0f\n\rSleepResponse\"\xaf\x01\n\x12\x45xecuteHookRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x12\n\nparameters\x18\x02 \x03(\t\x12\x46\n\textra_env\x18\x03 \x03(\x0b\x32\x33.tabletmanagerdata.ExecuteHookRequest.ExtraEnvEntry\x1a/\n\rExtraEnvEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"J\n\x13\x45xecuteHookResponse\x12\x13\n\x0b\x65xit_status\x18\x01 \x01(\x03\x12\x0e\n\x06stdout\x18\x02 \x01(\t\x12\x0e\n\x06stderr\x18\x03 \x01(\t\"\xbf\x01\n\x1a\x45xecuteHookToStreamRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x12\n\nparameters\x18\x02 \x03(\t\x12N\n\textra_env\x18\x03 \x03(\x0b\x32;.tabletmanagerdata.ExecuteHookToStreamRequest.ExtraEnvEntry\x1a/\n\rExtraEnvEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"`\n\x1b\x45xecuteHookToStreamResponse\x12\x0e\n\x06stdout\x18\x01 \x01(\x0c\x12\x0c\n\x04\x64one\x18\x02 \x01(\x08\x12\x13\n\x0b\x65xit_status\x18\x03 \x01(\x03\x12\x0e\n\x06stderr\x18\x04 \x01(\t\"q\n\x10GetSchemaRequest\x12\x0e\n\x06tables\x18\x01 \x03(\t\x12\x15\n\rinclude_views\x18\x02 \x01(\x08\x12\x16\n\x0e\x65xclude_tables\x18\x03 \x03(\t\x12\x1e\n\x16\x62\x65st_effort_timeout_ns\x18\x04 \x01(\x03\"g\n\x11GetSchemaResponse\x12>\n\x11schema_definition\x18\x01 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\x12\x12\n\nincomplete\x18\x02 \x01(\x08\"[\n\x1aGetCreateStatementsRequest\x12\x0e\n\x06tables\x18\x01 \x03(\t\x12\x15\n\rinclude_views\x18\x02 \x01(\x08\x12\x16\n\x0e\x65xclude_tables\x18\x03 \x03(\t\"\xb7\x01\n\x1bGetCreateStatementsResponse\x12_\n\x11\x63reate_statements\x18\x01 \x03(\x0b\x32\x44.tabletmanagerdata.GetCreateStatementsResponse.CreateStatementsEntry\x1a\x37\n\x15\x43reateStatementsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\",\n\x1aGetSchemaTimestampsRequest\x12\x0e\n\x06tables\x18\x01 \x03(\t\"\xa4\x01\n\x1bGetSchemaTimestampsResponse\x12R\n\ntimestamps\x18\x01 \x03(\x0b\x32>.tabletmanagerdata.GetSchemaTimestampsResponse.TimestampsEntry\x1a\x31\n\x0fTimestampsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x03:\x02\x38\x01\"\x17\n\x15GetPermissionsRequest\"M\n\x16GetPermissionsResponse\x12\x33\n\x0bpermissions\x18\x01 \x01(\x0b\x32\x1e.tabletmanagerdata.Permissions\"_\n\x0f\x43onnectionStats\x12\x18\n\x10open_connections\x18\x01 \x01(\x03\x12\x1b\n\x13\x61\x63tive_transactions\x18\x02 \x01(\x03\x12\x15\n\rpool_capacity\x18\x03 \x01(\x03\"\x1b\n\x19GetConnectionStatsRequest\"Z\n\x1aGetConnectionStatsResponse\x12<\n\x10\x63onnection_stats\x18\x01 \x01(\x0b\x32\".tabletmanagerdata.ConnectionStats\":\n\"GetConnectionRejectionStatsRequest\x12\x14\n\x0creset_counts\x18\x01 \x01(\x08\"\xb4\x01\n#GetConnectionRejectionStatsResponse\x12Z\n\nrejections\x18\x01 \x03(\x0b\x32\x46.tabletmanagerdata.GetConnectionRejectionStatsResponse.RejectionsEntry\x1a\x31\n\x0fRejectionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x03:\x02\x38\x01\"\x12\n\x10GetConfigRequest\"\x81\x01\n\x11GetConfigResponse\x12>\n\x05\x66lags\x18\x01 \x03(\x0b\x32/.tabletmanagerdata.GetConfigResponse.FlagsEntry\x1a,\n\nFlagsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x18\n\x16GetInFlightRPCsRequest\"\x97\x01\n\x17GetInFlightRPCsResponse\x12K\n\tin_flight\x18\x01 \x03(\x0b\x32\x38.tabletmanagerdata.GetInFlightRPCsResponse.InFlightEntry\x1a/\n\rInFlightEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x03:\x02\x38\x01\"t\n\x0cProcessStats\x12\x12\n\ngoroutines\x18\x01 \x01(\x03\x12\x0f\n\x07threads\x18\x02 \x01(\x03\x12\x12\n\nopen_files\x18\x03 \x01(\x03\x12\x18\n\x10heap_alloc_bytes\x18\x04 \x01(\x04\x12\x11\n\tsys_bytes\x18\x05 \x01(\x04\"\x18\n\x16GetProcessStatsRequest\"I\n\x17GetProcessStatsResponse\x12.\n\x05stats\x18\x01 \x01(\x0b\x32\x1f.tabletmanagerdata.ProcessStats\"\x82\x01\n\x0bHealthScore\x12\r\n\x05score\x18\x01 \x01(\x05\x12\x1e\n\x16replication_lag_factor\x18\x02 \x01(\x01\x12\x19\n\x11\x65rror_rate_factor\x18\x03 \x01(\x01\x12\x13\n\x0bload_factor\x18\x04 \x01(\x01\x12\x14\n\x0chealth_error\x18\x05 \x01(\t\"\x17\n\x15GetHealthScoreRequest\"G\n\x16GetHealthScoreResponse\x12-\n\x05score\x18\x01 \x01(\x0b\x32\x1e.tabletmanagerdata.HealthScore\"\'\n\x16GetErrorLogTailRequest\x12\r\n\x05lines\x18\x01 \x01(\x03\"(\n\x17GetErrorLogTailResponse\x12\r\n\x05lines\x18\x01 \x03(\t\"\x15\n\x13\x43heckDataDirRequest\"(\n\x14\x43heckDataDirResponse\x12\x10\n\x08problems\x18\x01 \x03(\t\"\xcc\x02\n\x10\x44iagnosticBundle\x12-\n\tprocesses\x18\x01 \x03(\x0b\x32\x1a.tabletmanagerdata.Process\x12-\n\x0cslave_status\x18\x02 \x01(\x0b\x32\x17.replicationdata.Status\x12\x16\n\x0e\x65rror_log_tail\x18\x03 \x03(\t\x12\x45\n\tvariables\x18\x04 \x03(\x0b\x32\x32.tabletmanagerdata.DiagnosticBundle.VariablesEntry\x12\x1c\n\x14\x64\x61ta_dir_total_bytes\x18\x05 \x01(\x03\x12\x1b\n\x13\x64\x61ta_dir_free_bytes\x18\x06 \x01(\x03\x12\x0e\n\x06\x65rrors\x18\x07 \x03(\t\x1a\x30\n\x0eVariablesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x1b\n\x19\x43\x61ptureDiagnosticsRequest\"Q\n\x1a\x43\x61ptureDiagnosticsResponse\x12\x33\n\x06\x62undle\x18\x01 \x01(\x0b\x32#.tabletmanagerdata.DiagnosticBundle\"\x8f\x01\n\rThrottleState\x12\x11\n\tthrottled\x18\x01 \x01(\x08\x12\x0e\n\x06metric\x18\x02 \x01(\t\x12\r\n\x05value\x18\x03 \x01(\x03\x12\x11\n\tthreshold\x18\x04 \x01(\x03\x12\x0e\n\x06reason\x18\x05 \x01(\t\x12\x0c\n\x04mode\x18\x06 \x01(\t\x12\x1b\n\x13mode_expire_time_ns\x18\x07 \x01(\x03\"\x19\n\x17GetThrottleStateRequest\"K\n\x18GetThrottleStateResponse\x12/\n\x05state\x18\x01 \x01(\x0b\x32 .tabletmanagerdata.ThrottleState\"\x16\n\x14GetTabletTagsRequest\"\x86\x01\n\x15GetTabletTagsResponse\x12@\n\x04tags\x18\x01 \x03(\x0b\x32\x32.tabletmanagerdata.GetTabletTagsResponse.TagsEntry\x1a+\n\tTagsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"7\n\x12SetThrottleRequest\x12\x0c\n\x04mode\x18\x01 \x01(\t\x12\x13\n\x0b\x64uration_ns\x18\x02 \x01(\x03\"\x15\n\x13SetThrottleResponse\"\x14\n\x12SetReadOnlyRequest\"\x15\n\x13SetReadOnlyResponse\"\x15\n\x13SetReadWriteRequest\"\x16\n\x14SetReadWriteResponse\"+\n\x19SetReadOnlyWithTTLRequest\x12\x0e\n\x06ttl_ns\x18\x01 \x01(\x03\"\x1c\n\x1aSetReadOnlyWithTTLResponse\"%\n\x17SetSuperReadOnlyRequest\x12\n\n\x02on\x18\x01 \x01(\x08\"3\n\x18SetSuperReadOnlyResponse\x12\x17\n\x0fsuper_read_only\x18\x01 \x01(\x08\"\x98\x01\n\x11QueryServerConfig\x12\x11\n\tpool_size\x18\x01 \x01(\x03\x12\x18\n\x10stream_pool_size\x18\x02 \x01(\x03\x12\x1d\n\x15transaction_pool_size\x18\x03 \x01(\x03\x12\x18\n\x10query_timeout_ns\x18\x04 \x01(\x03\x12\x1d\n\x15\x64isable_consolidation\x18\x05 \x01(\x08\"S\n\x1bSetQueryServerConfigRequest\x12\x34\n\x06\x63onfig\x18\x01 \x01(\x0b\x32$.tabletmanagerdata.QueryServerConfig\"f\n\x1cSetQueryServerConfigResponse\x12\x35\n\x07\x61pplied\x18\x01 \x01(\x0b\x32$.tabletmanagerdata.QueryServerConfig\x12\x0f\n\x07skipped\x18\x02 \x03(\t\"\x1a\n\x18GetQueryBlacklistRequest\"-\n\x19GetQueryBlacklistResponse\x12\x10\n\x08patterns\x18\x01 \x03(\t\",\n\x18SetQueryBlacklistRequest\x12\x10\n\x08patterns\x18\x01 \x03(\t\"\x1b\n\x19SetQueryBlacklistResponse\"R\n\x11\x43hangeTypeRequest\x12)\n\x0btablet_type\x18\x01 \x01(\x0e\x32\x14.topodata.TabletType\x12\x12\n\nidempotent\x18\x02 \x01(\x08\"\x14\n\x12\x43hangeTypeResponse\"\x84\x01\n\x14SetTabletTagsRequest\x12?\n\x04tags\x18\x01 \x03(\x0b\x32\x31.tabletmanagerdata.SetTabletTagsRequest.TagsEntry\x1a+\n\tTagsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x17\n\x15SetTabletTagsResponse\"\x15\n\x13RefreshStateRequest\"\x16\n\x14RefreshStateResponse\"\x1c\n\x1aRepublishTopoRecordRequest\".\n\x1bRepublishTopoRecordResponse\x12\x0f\n\x07version\x18\x01 \x01(\x03\"\x17\n\x15RunHealthCheckRequest\"\x18\n\x16RunHealthCheckResponse\"+\n\x18IgnoreHealthErrorRequest\x12\x0f\n\x07pattern\x18\x01 \x01(\t\"\x1b\n\x19IgnoreHealthErrorResponse\"7\n\x19SetMaintenanceModeRequest\x12\n\n\x02on\x18\x01 \x01(\x08\x12\x0e\n\x06reason\x18\x02 \x01(\t\"\x1c\n\x1aSetMaintenanceModeResponse\")\n\x1bPauseHealthReportingRequest\x12\n\n\x02on\x18\x01 \x01(\x08\"\x1e\n\x1cPauseHealthReportingResponse\"\x17\n\x15PrepareCutoverRequest\"\'\n\x16PrepareCutoverResponse\x12\r\n\x05token\x18\x01 \x01(\t\"%\n\x14\x43ommitCutoverRequest\x12\r\n\x05token\x18\x01 \x01(\t\"\x17\n\x15\x43ommitCutoverResponse\"$\n\x13\x41\x62ortCutoverRequest\x12\r\n\x05token\x18\x01 \x01(\t\"\x16\n\x14\x41\x62ortCutoverResponse\"n\n\x19SetServingKeyRangeRequest\x12%\n\tkey_range\x18\x01 \x01(\x0b\x32\x12.topodata.KeyRange\x12*\n\x0cserved_types\x18\x02 \x03(\x0e\x32\x14.topodata.TabletType\"\x1c\n\x1aSetServingKeyRangeResponse\"1\n\x13RestartMysqlRequest\x12\x1a\n\x12healthy_timeout_ns\x18\x01 \x01(\x03\"5\n\x14RestartMysqlResponse\x12\x1d\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x0e.logutil.Event\"D\n\x13\x44\x65\x63ommissionRequest\x12\x18\n\x10stop_replication\x18\x01 \x01(\x08\x12\x13\n\x0bstop_mysqld\x18\x02 \x01(\x08\"5\n\x14\x44\x65\x63ommissionResponse\x12\x1d\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x0e.logutil.Event\"\x1e\n\x1c\x43heckTopoConnectivityRequest\"F\n\x1d\x43heckTopoConnectivityResponse\x12\x11\n\treachable\x18\x01 \x01(\x08\x12\x12\n\nlatency_ns\x18\x02 \x01(\x03\":\n\rWarmUpRequest\x12\x0f\n\x07queries\x18\x01 \x03(\t\x12\x18\n\x10load_buffer_pool\x18\x02 \x01(\x08\"d\n\x0eWarmUpResponse\x12\x13\n\x0bqueries_run\x18\x01 \x01(\x03\x12\x15\n\rqueries_total\x18\x02 \x01(\x03\x12\x18\n\x10\x62uffer_pool_fill\x18\x03 \x01(\x01\x12\x0c\n\x04\x64one\x18\x04 \x01(\x08\",\n\x13ReloadSchemaRequest\x12\x15\n\rwait_position\x18\x01 \x01(\t\"\x16\n\x14ReloadSchemaResponse\")\n\x16PreflightSchemaRequest\x12\x0f\n\x07\x63hanges\x18\x01 \x03(\t\"X\n\x17PreflightSchemaResponse\x12=\n\x0e\x63hange_results\x18\x01 \x03(\x0b\x32%.tabletmanagerdata.SchemaChangeResult\"\xc2\x01\n\x12\x41pplySchemaRequest\x12\x0b\n\x03sql\x18\x01 \x01(\t\x12\r\n\x05\x66orce\x18\x02 \x01(\x08\x12\x19\n\x11\x61llow_replication\x18\x03 \x01(\x08\x12:\n\rbefore_schema\x18\x04 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\x12\x39\n\x0c\x61\x66ter_schema\x18\x05 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\"\x8c\x01\n\x13\x41pplySchemaResponse\x12:\n\rbefore_schema\x18\x01 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\x12\x39\n\x0c\x61\x66ter_schema\x18\x02 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\"I\n\x11SchemaDiffRequest\x12\x34\n\x07\x64\x65sired\x18\x01 \x01(\x0b\x32#.tabletmanagerdata.SchemaDefinition\"(\n\x12SchemaDiffResponse\x12\x12\n\nstatements\x18\x01 \x03(\t\"o\n\x16SchemaChangeAssessment\x12\r\n\x05table\x18\x01 \x01(\t\x12\x11\n\talgorithm\x18\x02 \x01(\t\x12\x18\n\x10table_size_bytes\x18\x03 \x01(\x03\x12\x19\n\x11\x65stimated_lock_ns\x18\x04 \x01(\x03\"+\n\x19\x41ssessSchemaChangeRequest\x12\x0e\n\x06\x63hange\x18\x01 \x01(\t\"[\n\x1a\x41ssessSchemaChangeResponse\x12=\n\nassessment\x18\x01 \x01(\x0b\x32).tabletmanagerdata.SchemaChangeAssessment\"Y\n\x11SchemaChangeEvent\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x36\n\ndefinition\x18\x02 \x01(\x0b\x32\".tabletmanagerdata.TableDefinition\"\x14\n\x12WatchSchemaRequest\"J\n\x13WatchSchemaResponse\x12\x33\n\x05\x65vent\x18\x01 \x01(\x0b\x32$.tabletmanagerdata.SchemaChangeEvent\"\x13\n\x11GetVSchemaRequest\"8\n\x12GetVSchemaResponse\x12\"\n\x07vschema\x18\x01 \x01(\x0b\x32\x11.vschema.Keyspace\"&\n\x13\x41pplyVSchemaRequest\x12\x0f\n\x07vschema\x18\x01 \x01(\t\"\x16\n\x14\x41pplyVSchemaResponse\"\x96\x01\n\x18\x45xecuteFetchAsDbaRequest\x12\r\n\x05query\x18\x01 \x01(\x0c\x12\x0f\n\x07\x64\x62_name\x18\x02 \x01(\t\x12\x10\n\x08max_rows\x18\x03 \x01(\x04\x12\x17\n\x0f\x64isable_binlogs\x18\x04 \x01(\x08\x12\x15\n\rreload_schema\x18\x05 \x01(\x08\x12\x18\n\x10max_exec_time_ns\x18\x06 \x01(\x03\"?\n\x19\x45xecuteFetchAsDbaResponse\x12\"\n\x06result\x18\x01 \x01(\x0b\x32\x12.query.QueryResult\"=\n\x1b\x45xecuteFetchAsDbaCSVRequest\x12\r\n\x05query\x18\x01 \x01(\x0c\x12\x0f\n\x07\x64\x62_name\x18\x02 \x01(\t\",\n\x1c\x45xecuteFetchAsDbaCSVResponse\x12\x0c\n\x04\x64\x61ta\x18\x01 \x01(\x0c\"\x88\x01\n\x0e\x43olumnarResult\x12\x1c\n\x06\x66ields\x18\x01 \x03(\x0b\x32\x0c.query.Field\x12\x15\n\rrows_affected\x18\x02 \x01(\x04\x12\x11\n\tinsert_id\x18\x03 \x01(\x04\x12\x11\n\trow_count\x18\x04 \x01(\x04\x12\x1b\n\x07\x63olumns\x18\x05 \x03(\x0b\x32\n.query.Row\"O\n\x1b\x45xecuteFetchColumnarRequest\x12\r\n\x05query\x18\x01 \x01(\x0c\x12\x0f\n\x07\x64\x62_name\x18\x02 \x01(\t\x12\x10\n\x08max_rows\x18\x03 \x01(\x04\"Q\n\x1c\x45xecuteFetchColumnarResponse\x12\x31\n\x06result\x18\x01 \x01(\x0b\x32!.tabletmanagerdata.ColumnarResult\"h\n\x1d\x45xecuteFetchAsAllPrivsRequest\x12\r\n\x05query\x18\x01 \x01(\x0c\x12\x0f\n\x07\x64\x62_name\x18\x02 \x01(\t\x12\x10\n\x08max_rows\x18\x03 \x01(\x04\x12\x15\n\rreload_schema\x18\x04 \x01(\x08\"D\n\x1e\x45xecuteFetchAsAllPrivsResponse\x12\"\n\x06result\x18\x01 \x01(\x0b\x32\x12.query.QueryResult\";\n\x18\x45xecuteFetchAsAppRequest\x12\r\n\x05query\x18\x01 \x01(\x0c\x12\x10\n\x08max_rows\x18\x02 \x01(\x04\"?\n\x19\x45xecuteFetchAsAppResponse\x12\"\n\x06result\x18\x01 \x01(\x0b\x32\x12.query.QueryResult\"4\n\x13\x45xplainQueryRequest\x12\r\n\x05query\x18\x01 \x01(\t\x12\x0e\n\x06\x66ormat\x18\x02 \x01(\t\":\n\x14\x45xplainQueryResponse\x12\"\n\x06result\x18\x01 \x01(\x0b\x32\x12.query.QueryResult\"8\n\x12\x41pplyGrantsRequest\x12\x12\n\nstatements\x18\x01 \x03(\t\x12\x0e\n\x06\x61tomic\x18\x02 \x01(\x08\"\x15\n\x13\x41pplyGrantsResponse\"L\n\x14\x43hecksumTableRequest\x12\r\n\x05table\x18\x01 \x01(\t\x12%\n\tkey_range\x18\x02 \x01(\x0b\x32\x12.topodata.KeyRange\"<\n\x15\x43hecksumTableResponse\x12\x10\n\x08\x63hecksum\x18\x01 \x01(\x04\x12\x11\n\trow_count\x18\x02 \x01(\x03\"?\n\x14TruncateTableRequest\x12\r\n\x05table\x18\x01 \x01(\t\x12\x18\n\x10\x63onfirm_keyspace\x18\x02 \x01(\t\"\x17\n\x15TruncateTableResponse\"K\n\x12RenameTableRequest\x12\x12\n\nfrom_table\x18\x01 \x01(\t\x12\x10\n\x08to_table\x18\x02 \x01(\t\x12\x0f\n\x07\x63\x61scade\x18\x03 \x01(\x08\"]\n\x11RenameTableResult\x12\x17\n\x0f\x64\x65pendent_views\x18\x01 \x03(\t\x12\x1e\n\x16\x64\x65pendent_foreign_keys\x18\x02 \x03(\t\x12\x0f\n\x07renamed\x18\x03 \x01(\x08\"K\n\x13RenameTableResponse\x12\x34\n\x06result\x18\x01 \x01(\x0b\x32$.tabletmanagerdata.RenameTableResult\"S\n\x1bStreamRowsInKeyRangeRequest\x12\r\n\x05table\x18\x01 \x01(\t\x12%\n\tkey_range\x18\x02 \x01(\x0b\x32\x12.topodata.KeyRange\"B\n\x1cStreamRowsInKeyRangeResponse\x12\"\n\x06result\x18\x01 \x01(\x0b\x32\x12.query.QueryResult\"|\n\x1bStreamKeyRangeBinlogRequest\x12%\n\tkey_range\x18\x01 \x01(\x0b\x32\x12.topodata.KeyRange\x12\x10\n\x08position\x18\x02 \x01(\t\x12$\n\x07\x63harset\x18\x03 \x01(\x0b\x32\x13.binlogdata.Charset\"Y\n\x1cStreamKeyRangeBinlogResponse\x12\x39\n\x12\x62inlog_transaction\x18\x01 \x01(\x0b\x32\x1d.binlogdata.BinlogTransaction\"9\n\x12\x43loneStreamRequest\x12\x0e\n\x06tables\x18\x01 \x03(\t\x12\x13\n\x0b\x62uffer_size\x18\x02 \x01(\x03\"Z\n\x13\x43loneStreamResponse\x12\x10\n\x08position\x18\x01 \x01(\t\x12\r\n\x05table\x18\x02 \x01(\t\x12\"\n\x06result\x18\x03 \x01(\x0b\x32\x12.query.QueryResult\"y\n\x07Process\x12\n\n\x02id\x18\x01 \x01(\x03\x12\x0c\n\x04user\x18\x02 \x01(\t\x12\x0c\n\x04host\x18\x03 \x01(\t\x12\n\n\x02\x64\x62\x18\x04 \x01(\t\x12\x0f\n\x07\x63ommand\x18\x05 \x01(\t\x12\x0c\n\x04time\x18\x06 \x01(\x03\x12\r\n\x05state\x18\x07 \x01(\t\x12\x0c\n\x04info\x18\x08 \x01(\t\"\x17\n\x15GetProcessListRequest\"G\n\x16GetProcessListResponse\x12-\n\tprocesses\x18\x01 \x03(\x0b\x32\x1a.tabletmanagerdata.Process\"\xe5\x02\n\rCharsetConfig\x12\x1c\n\x14\x63haracter_set_server\x18\x01 \x01(\t\x12\x18\n\x10\x63ollation_server\x18\x02 \x01(\t\x12Q\n\x11\x64\x61tabase_charsets\x18\x03 \x03(\x0b\x32\x36.tabletmanagerdata.CharsetConfig.DatabaseCharsetsEntry\x12U\n\x13\x64\x61tabase_collations\x18\x04 \x03(\x0b\x32\x38.tabletmanagerdata.CharsetConfig.DatabaseCollationsEntry\x1a\x37\n\x15\x44\x61tabaseCharsetsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x39\n\x17\x44\x61tabaseCollationsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x19\n\x17GetCharsetConfigRequest\"L\n\x18GetCharsetConfigResponse\x12\x30\n\x06\x63onfig\x18\x01 \x01(\x0b\x32 .tabletmanagerdata.CharsetConfig\" \n\x12KillProcessRequest\x12\n\n\x02id\x18\x01 \x01(\x03\"\x15\n\x13KillProcessResponse\",\n\x15TailGeneralLogRequest\x12\x13\n\x0b\x64uration_ns\x18\x01 \x01(\x03\"\'\n\x16TailGeneralLogResponse\x12\r\n\x05\x65ntry\x18\x01 \x01(\t\"\x7f\n\tQPSSample\x12\x14\n\x0ctimestamp_ns\x18\x01 \x01(\x03\x12\x13\n\x0binterval_ns\x18\x02 \x01(\x03\x12\x10\n\x08read_qps\x18\x03 \x01(\x01\x12\x11\n\twrite_qps\x18\x04 \x01(\x01\x12\x11\n\ttotal_qps\x18\x05 \x01(\x01\x12\x0f\n\x07\x64ropped\x18\x06 \x01(\x03\"\'\n\x10StreamQPSRequest\x12\x13\n\x0binterval_ns\x18\x01 \x01(\x03\"A\n\x11StreamQPSResponse\x12,\n\x06sample\x18\x01 \x01(\x0b\x32\x1c.tabletmanagerdata.QPSSample\"\x14\n\x12SlaveStatusRequest\">\n\x13SlaveStatusResponse\x12\'\n\x06status\x18\x01 \x01(\x0b\x32\x17.replicationdata.Status\"\x1f\n\x1dSlaveStatusAllChannelsRequest\"\xbd\x01\n\x1eSlaveStatusAllChannelsResponse\x12Q\n\x08statuses\x18\x01 \x03(\x0b\x32?.tabletmanagerdata.SlaveStatusAllChannelsResponse.StatusesEntry\x1aH\n\rStatusesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.replicationdata.Status:\x02\x38\x01\"K\n\x11ReplicationSource\x12\x13\n\x0bmaster_host\x18\x01 \x01(\t\x12\x13\n\x0bmaster_port\x18\x02 \x01(\x05\x12\x0c\n\x04user\x18\x03 \x01(\t\"\x1d\n\x1bGetReplicationSourceRequest\"T\n\x1cGetReplicationSourceResponse\x12\x34\n\x06source\x18\x01 \x01(\x0b\x32$.tabletmanagerdata.ReplicationSource\"\'\n%ValidateReplicationCredentialsRequest\"7\n&ValidateReplicationCredentialsResponse\x12\r\n\x05valid\x18\x01 \x01(\x08\"s\n\x12\x41pplierWorkerStats\x12\x11\n\tworker_id\x18\x01 \x01(\x03\x12\x15\n\rservice_state\x18\x02 \x01(\t\x12\x14\n\x0cthread_state\x18\x03 \x01(\t\x12\x1d\n\x15last_seen_transaction\x18\x04 \x01(\t\"\x7f\n\x11\x41pplierQueueStats\x12\x17\n\x0frelay_log_space\x18\x01 \x01(\x03\x12\x19\n\x11\x63oordinator_state\x18\x02 \x01(\t\x12\x36\n\x07workers\x18\x03 \x03(\x0b\x32%.tabletmanagerdata.ApplierWorkerStats\"\x1d\n\x1bGetApplierQueueStatsRequest\"S\n\x1cGetApplierQueueStatsResponse\x12\x33\n\x05stats\x18\x01 \x01(\x0b\x32$.tabletmanagerdata.ApplierQueueStats\"\x17\n\x15MasterPositionRequest\"*\n\x16MasterPositionResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"\x16\n\x14GetGtidPurgedRequest\")\n\x15GetGtidPurgedResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"\x17\n\x15GetBinlogStatsRequest\"L\n\x16GetBinlogStatsResponse\x12\x1f\n\x17transactions_per_second\x18\x01 \x01(\x01\x12\x11\n\twindow_ns\x18\x02 \x01(\x03\"R\n\x15ReplicationErrorStats\x12\x11\n\tio_errors\x18\x01 \x01(\x03\x12\x12\n\nsql_errors\x18\x02 \x01(\x03\x12\x12\n\nreconnects\x18\x03 \x01(\x03\"7\n\x1fGetReplicationErrorStatsRequest\x12\x14\n\x0creset_counts\x18\x01 \x01(\x08\"[\n GetReplicationErrorStatsResponse\x12\x37\n\x05stats\x18\x01 \x01(\x0b\x32(.tabletmanagerdata.ReplicationErrorStats\"\x12\n\x10StopSlaveRequest\"\x13\n\x11StopSlaveResponse\"A\n\x17StopSlaveMinimumRequest\x12\x10\n\x08position\x18\x01 \x01(\t\x12\x14\n\x0cwait_timeout\x18\x02 \x01(\x03\",\n\x18StopSlaveMinimumResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"G\n\x1dStopSlaveMinimumStreamRequest\x12\x10\n\x08position\x18\x01 \x01(\t\x12\x14\n\x0cwait_timeout\x18\x02 \x01(\x03\"C\n\x1eStopSlaveMinimumStreamResponse\x12\x10\n\x08position\x18\x01 \x01(\t\x12\x0f\n\x07stopped\x18\x02 \x01(\x08\"2\n\x1e\x42oostReplicationCatchupRequest\x12\x10\n\x08\x64uration\x18\x01 \x01(\x03\"\xd7\x01\n\x1f\x42oostReplicationCatchupResponse\x12\x1d\n\x15seconds_behind_master\x18\x01 \x01(\x03\x12R\n\x08settings\x18\x02 \x03(\x0b\x32@.tabletmanagerdata.BoostReplicationCatchupResponse.SettingsEntry\x12\x10\n\x08reverted\x18\x03 \x01(\x08\x1a/\n\rSettingsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x03:\x02\x38\x01\"\x13\n\x11StartSlaveRequest\"\x14\n\x12StartSlaveResponse\"J\n EnableParallelReplicationRequest\x12\x0f\n\x07workers\x18\x01 \x01(\x03\x12\x15\n\rparallel_type\x18\x02 \x01(\t\"#\n!EnableParallelReplicationResponse\"E\n#RotateReplicationCredentialsRequest\x12\x0c\n\x04user\x18\x01 \x01(\t\x12\x10\n\x08password\x18\x02 \x01(\t\"&\n$RotateReplicationCredentialsResponse\">\n\x15ReplicationSSLOptions\x12\n\n\x02\x63\x61\x18\x01 \x01(\t\x12\x0c\n\x04\x63\x65rt\x18\x02 \x01(\t\x12\x0b\n\x03key\x18\x03 \x01(\t\"[\n\x1e\x43onfigureReplicationSSLRequest\x12\x39\n\x07options\x18\x01 \x01(\x0b\x32(.tabletmanagerdata.ReplicationSSLOptions\"!\n\x1f\x43onfigureReplicationSSLResponse\"\x17\n\x15RepairRelayLogRequest\"7\n\x16RepairRelayLogResponse\x12\x1d\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x0e.logutil.Event\"J\n!TabletExternallyReparentedRequest\x12\x13\n\x0b\x65xternal_id\x18\x01 \x01(\t\x12\x10\n\x08validate\x18\x02 \x01(\x08\"$\n\"TabletExternallyReparentedResponse\" \n\x1eTabletExternallyElectedRequest\"!\n\x1fTabletExternallyElectedResponse\"\x12\n\x10GetSlavesRequest\"\"\n\x11GetSlavesResponse\x12\r\n\x05\x61\x64\x64rs\x18\x01 \x03(\t\"I\n\x13ReplicationNeighbor\x12\x0c\n\x04host\x18\x01 \x01(\t\x12$\n\x05\x61lias\x18\x02 \x01(\x0b\x32\x15.topodata.TabletAlias\"\x82\x01\n\x10ReplicationGraph\x12\x36\n\x06master\x18\x01 \x01(\x0b\x32&.tabletmanagerdata.ReplicationNeighbor\x12\x36\n\x06slaves\x18\x02 \x03(\x0b\x32&.tabletmanagerdata.ReplicationNeighbor\"\x1c\n\x1aGetReplicationGraphRequest\"Q\n\x1bGetReplicationGraphResponse\x12\x32\n\x05graph\x18\x01 \x01(\x0b\x32#.tabletmanagerdata.ReplicationGraph\"d\n\x16WaitBlpPositionRequest\x12\x34\n\x0c\x62lp_position\x18\x01 \x01(\x0b\x32\x1e.tabletmanagerdata.BlpPosition\x12\x14\n\x0cwait_timeout\x18\x02 \x01(\x03\"\x19\n\x17WaitBlpPositionResponse\"\x10\n\x0eStopBlpRequest\"H\n\x0fStopBlpResponse\x12\x35\n\rblp_positions\x18\x01 \x03(\x0b\x32\x1e.tabletmanagerdata.BlpPosition\"\x11\n\x0fStartBlpRequest\"\x12\n\x10StartBlpResponse\"a\n\x12RunBlpUntilRequest\x12\x35\n\rblp_positions\x18\x01 \x03(\x0b\x32\x1e.tabletmanagerdata.BlpPosition\x12\x14\n\x0cwait_timeout\x18\x02 \x01(\x03\"\'\n\x13RunBlpUntilResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"s\n\x0c\x42inlogFilter\x12\x0b\n\x03uid\x18\x01 \x01(\r\x12\x10\n\x08keyspace\x18\x02 \x01(\t\x12\r\n\x05shard\x18\x03 \x01(\t\x12%\n\tkey_range\x18\x04 \x01(\x0b\x32\x12.topodata.KeyRange\x12\x0e\n\x06tables\x18\x05 \x03(\t\"\x19\n\x17GetBinlogFiltersRequest\"L\n\x18GetBinlogFiltersResponse\x12\x30\n\x07\x66ilters\x18\x01 \x03(\x0b\x32\x1f.tabletmanagerdata.BinlogFilter\"\x19\n\x17ResetReplicationRequest\"\x1a\n\x18ResetReplicationResponse\"F\n\x11InitMasterRequest\x12\x19\n\x11\x65xpected_keyspace\x18\x01 \x01(\t\x12\x16\n\x0e\x65xpected_shard\x18\x02 \x01(\t\"&\n\x12InitMasterResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"\xcc\x01\n\x1ePopulateReparentJournalRequest\x12\x17\n\x0ftime_created_ns\x18\x01 \x01(\x03\x12\x13\n\x0b\x61\x63tion_name\x18\x02 \x01(\t\x12+\n\x0cmaster_alias\x18\x03 \x01(\x0b\x32\x15.topodata.TabletAlias\x12\x1c\n\x14replication_position\x18\x04 \x01(\t\x12\x19\n\x11\x65xpected_keyspace\x18\x05 \x01(\t\x12\x16\n\x0e\x65xpected_shard\x18\x06 \x01(\t\"!\n\x1fPopulateReparentJournalResponse\"\x8f\x01\n\x14ReparentJournalEntry\x12\x17\n\x0ftime_created_ns\x18\x01 \x01(\x03\x12\x13\n\x0b\x61\x63tion_name\x18\x02 \x01(\t\x12+\n\x0cmaster_alias\x18\x03 \x01(\x0b\x32\x15.topodata.TabletAlias\x12\x1c\n\x14replication_position\x18\x04 \x01(\t\"$\n\"GetLastReparentJournalEntryRequest\"]\n#GetLastReparentJournalEntryResponse\x12\x36\n\x05\x65ntry\x18\x01 \x01(\x0b\x32\'.tabletmanagerdata.ReparentJournalEntry\"p\n\x10InitSlaveRequest\x12%\n\x06parent\x18\x01 \x01(\x0b\x32\x15.topodata.TabletAlias\x12\x1c\n\x14replication_position\x18\x02 \x01(\t\x12\x17\n\x0ftime_created_ns\x18\x03 \x01(\x03\"\x13\n\x11InitSlaveResponse\"\x15\n\x13\x44\x65moteMasterRequest\"(\n\x14\x44\x65moteMasterResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"3\n\x1fPromoteSlaveWhenCaughtUpRequest\x12\x10\n\x08position\x18\x01 \x01(\t\"4\n PromoteSlaveWhenCaughtUpResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"\x19\n\x17SlaveWasPromotedRequest\"\x1a\n\x18SlaveWasPromotedResponse\"\xa0\x01\n\x10SetMasterRequest\x12%\n\x06parent\x18\x01 \x01(\x0b\x32\x15.topodata.TabletAlias\x12\x17\n\x0ftime_created_ns\x18\x02 \x01(\x03\x12\x19\n\x11\x66orce_start_slave\x18\x03 \x01(\x08\x12\x19\n\x11\x65xpected_keyspace\x18\x04 \x01(\t\x12\x16\n\x0e\x65xpected_shard\x18\x05 \x01(\t\"\x13\n\x11SetMasterResponse\"\xc9\x01\n\x1b\x43onfigureReplicationRequest\x12%\n\x06parent\x18\x01 \x01(\x0b\x32\x15.topodata.TabletAlias\x12\x15\n\rauto_position\x18\x02 \x01(\x08\x12\x0c\n\x04\x66ile\x18\x03 \x01(\t\x12\x10\n\x08position\x18\x04 \x01(\x04\x12\x19\n\x11\x66orce_start_slave\x18\x05 \x01(\x08\x12\x19\n\x11\x65xpected_keyspace\x18\x06 \x01(\t\x12\x16\n\x0e\x65xpected_shard\x18\x07 \x01(\t\"\x1e\n\x1c\x43onfigureReplicationResponse\"A\n\x18SlaveWasRestartedRequest\x12%\n\x06parent\x18\x01 \x01(\x0b\x32\x15.topodata.TabletAlias\"\x1b\n\x19SlaveWasRestartedResponse\"$\n\"StopReplicationAndGetStatusRequest\"N\n#StopReplicationAndGetStatusResponse\x12\'\n\x06status\x18\x01 \x01(\x0b\x32\x17.replicationdata.Status\"\x15\n\x13PromoteSlaveRequest\"(\n\x14PromoteSlaveResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"A\n\x1dSetReparentEligibilityRequest\x12\x10\n\x08\x65ligible\x18\x01 \x01(\x08\x12\x0e\n\x06reason\x18\x02 \x01(\t\" \n\x1eSetReparentEligibilityResponse\"\x1f\n\x1d\x43heckReparentCandidateRequest\"B\n\x1e\x43heckReparentCandidateResponse\x12\x10\n\x08\x65ligible\x18\x01 \x01(\x08\x12\x0e\n\x06reason\x18\x02 \x01(\t\"+\n\x1aSetSemiSyncAckCountRequest\x12\r\n\x05\x63ount\x18\x01 \x01(\x05\"\x1d\n\x1bSetSemiSyncAckCountResponse\"\x92\x01\n\x10\x44urabilityPolicy\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x18\n\x10semi_sync_master\x18\x02 \x01(\x08\x12\x17\n\x0fsemi_sync_slave\x18\x03 \x01(\x08\x12\x1e\n\x16mysql_semi_sync_master\x18\x04 \x01(\x08\x12\x1d\n\x15mysql_semi_sync_slave\x18\x05 \x01(\x08\"\x1c\n\x1aGetDurabilityPolicyRequest\"R\n\x1bGetDurabilityPolicyResponse\x12\x33\n\x06policy\x18\x01 \x01(\x0b\x32#.tabletmanagerdata.DurabilityPolicy\"\x18\n\x16GetBackupLimitsRequest\"O\n\x17GetBackupLimitsResponse\x12\x1b\n\x13\x64\x65\x66\x61ult_concurrency\x18\x01 \x01(\x03\x12\x17\n\x0fmax_concurrency\x18\x02 \x01(\x03\"\x1a\n\x18GetBackupPositionRequest\"-\n\x19GetBackupPositionResponse\x12\x10\n\x08position\x18\x01 \x01(\t\"$\n\rBackupRequest\x12\x13\n\x0b\x63oncurrency\x18\x01 \x01(\x03\"/\n\x0e\x42\x61\x63kupResponse\x12\x1d\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x0e.logutil.Event\"I\n\x18IncrementalBackupRequest\x12\x18\n\x10\x62\x61se_backup_name\x18\x01 \x01(\t\x12\x13\n\x0b\x63oncurrency\x18\x02 \x01(\x03\":\n\x19IncrementalBackupResponse\x12\x1d\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x0e.logutil.Event\"\x1a\n\x18RestoreFromBackupRequest\":\n\x19RestoreFromBackupResponse\x12\x1d\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x0e.logutil.Event\"H\n\x19RestoreToTimestampRequest\x12\x13\n\x0b\x62\x61\x63kup_name\x18\x01 \x01(\t\x12\x16\n\x0etarget_time_ns\x18\x02 \x01(\x03\";\n\x1aRestoreToTimestampResponse\x12\x1d\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x0e.logutil.Event\"0\n\x19SetPreferredBackupRequest\x12\x13\n\x0b\x62\x61\x63kup_name\x18\x01 \x01(\t\"\x1c\n\x1aSetPreferredBackupResponse\"\x1b\n\x19GetPreferredBackupRequest\"1\n\x1aGetPreferredBackupResponse\x12\x13\n\x0b\x62\x61\x63kup_name\x18\x01 \x01(\t\"k\n\x0b\x43ompatCheck\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x0c\x62\x61\x63kup_value\x18\x02 \x01(\t\x12\x14\n\x0ctablet_value\x18\x03 \x01(\t\x12\x12\n\ncompatible\x18\x04 \x01(\x08\x12\x0e\n\x06reason\x18\x05 \x01(\t\"R\n\x0c\x43ompatReport\x12\x12\n\ncompatible\x18\x01 \x01(\x08\x12.\n\x06\x63hecks\x18\x02 \x03(\x0b\x32\x1e.tabletmanagerdata.CompatCheck\"7\n CheckRestoreCompatibilityRequest\x12\x13\n\x0b\x62\x61\x63kup_name\x18\x01 \x01(\t\"T\n!CheckRestoreCompatibilityResponse\x12/\n\x06report\x18\x01 \x01(\x0b\x32\x1f.tabletmanagerdata.CompatReport\"\x8f\x01\n\nBackupInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x12\n\nsize_bytes\x18\x02 \x01(\x03\x12\x15\n\rstart_time_ns\x18\x03 \x01(\x03\x12\x13\n\x0b\x65nd_time_ns\x18\x04 \x01(\x03\x12\x13\n\x0b\x64uration_ns\x18\x05 \x01(\x03\x12\x0f\n\x07success\x18\x06 \x01(\x08\x12\r\n\x05\x65rror\x18\x07 \x01(\t\"\x1a\n\x18GetLastBackupInfoRequest\"H\n\x19GetLastBackupInfoResponse\x12+\n\x04info\x18\x01 \x01(\x0b\x32\x1d.tabletmanagerdata.BackupInfo\"\x1b\n\x19GetBackupFreshnessRequest\"A\n\x1aGetBackupFreshnessResponse\x12\x13\n\x0b\x62\x61\x63kup_name\x18\x01 \x01(\t\x12\x0e\n\x06\x61ge_ns\x18\x02 \x01(\x03\"1\n\x0fReadinessReport\x12\r\n\x05ready\x18\x01 \x01(\x08\x12\x0f\n\x07reasons\x18\x02 \x03(\t\"\x1d\n\x1b\x43heckBackupReadinessRequest\"R\n\x1c\x43heckBackupReadinessResponse\x12\x32\n\x06report\x18\x01 \x01(\x0b\x32\".tabletmanagerdata.ReadinessReport\")\n\x12TestRestoreRequest\x12\x13\n\x0b\x62\x61\x63kup_name\x18\x01 \x01(\t\"4\n\x13TestRestoreResponse\x12\x1d\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x0e.logutil.Eventb\x06proto3')
   ,
-  dependencies=[query__pb2.DESCRIPTOR,topodata__pb2.DESCRIPTOR,replicationdata__pb2.DESCRIPTOR,logutil__pb2.DESCRIPTOR,])
+  dependencies=[binlogdata__pb2.DESCRIPTOR,query__pb2.DESCRIPTOR,topodata__pb2.DESCRIPTOR,replicationdata__pb2.DESCRIPTOR,logutil__pb2.DESCRIPTOR,vschema__pb2.DESCRIPTOR,])
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 
@@ -99,8 +101,8 @@ _TABLEDEFINITION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=114,
-  serialized_end=261,
+  serialized_start=147,
+  serialized_end=294,
 )
 
 
@@ -144,8 +146,8 @@ _SCHEMADEFINITION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=263,
-  serialized_end=386,
+  serialized_start=296,
+  serialized_end=419,
 )
 
 
@@ -182,8 +184,8 @@ _SCHEMACHANGERESULT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=389,
-  serialized_end=528,
+  serialized_start=422,
+  serialized_end=561,
 )
 
 
@@ -220,8 +222,8 @@ _USERPERMISSION_PRIVILEGESENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=675,
-  serialized_end=724,
+  serialized_start=708,
+  serialized_end=757,
 )
 
 _USERPERMISSION = _descriptor.Descriptor(
@@ -271,8 +273,8 @@ _USERPERMISSION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=531,
-  serialized_end=724,
+  serialized_start=564,
+  serialized_end=757,
 )
 
 
@@ -309,8 +311,8 @@ _DBPERMISSION_PRIVILEGESENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=675,
-  serialized_end=724,
+  serialized_start=708,
+  serialized_end=757,
 )
 
 _DBPERMISSION = _descriptor.Descriptor(
@@ -360,8 +362,8 @@ _DBPERMISSION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=727,
-  serialized_end=901,
+  serialized_start=760,
+  serialized_end=934,
 )
 
 
@@ -398,8 +400,8 @@ _PERMISSIONS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=904,
-  serialized_end=1035,
+  serialized_start=937,
+  serialized_end=1068,
 )
 
 
@@ -436,8 +438,8 @@ _BLPPOSITION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1037,
-  serialized_end=1081,
+  serialized_start=1070,
+  serialized_end=1114,
 )
 
 
@@ -467,8 +469,8 @@ _PINGREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1083,
-  serialized_end=1113,
+  serialized_start=1116,
+  serialized_end=1146,
 )
 
 
@@ -498,8 +500,8 @@ _PINGRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1115,
-  serialized_end=1146,
+  serialized_start=1148,
+  serialized_end=1179,
 )
 
 
@@ -529,8 +531,8 @@ _SLEEPREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1148,
-  serialized_end=1180,
+  serialized_start=1181,
+  serialized_end=1213,
 )
 
 
@@ -553,8 +555,8 @@ _SLEEPRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1182,
-  serialized_end=1197,
+  serialized_start=1215,
+  serialized_end=1230,
 )
 
 
@@ -591,8 +593,8 @@ _EXECUTEHOOKREQUEST_EXTRAENVENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1328,
-  serialized_end=1375,
+  serialized_start=1361,
+  serialized_end=1408,
 )
 
 _EXECUTEHOOKREQUEST = _descriptor.Descriptor(
@@ -635,8 +637,8 @@ _EXECUTEHOOKREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1200,
-  serialized_end=1375,
+  serialized_start=1233,
+  serialized_end=1408,
 )
 
 
@@ -680,35 +682,72 @@ _EXECUTEHOOKRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1377,
-  serialized_end=1451,
+  serialized_start=1410,
+  serialized_end=1484,
 )
 
 
-_GETSCHEMAREQUEST = _descriptor.Descriptor(
-  name='GetSchemaRequest',
-  full_name='tabletmanagerdata.GetSchemaRequest',
+_EXECUTEHOOKTOSTREAMREQUEST_EXTRAENVENTRY = _descriptor.Descriptor(
+  name='ExtraEnvEntry',
+  full_name='tabletmanagerdata.ExecuteHookToStreamRequest.ExtraEnvEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='tables', full_name='tabletmanagerdata.GetSchemaRequest.tables', index=0,
-      number=1, type=9, cpp_type=9, label=3,
-      has_default_value=False, default_value=[],
+      name='key', full_name='tabletmanagerdata.ExecuteHookToStreamRequest.ExtraEnvEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
     _descriptor.FieldDescriptor(
-      name='include_views', full_name='tabletmanagerdata.GetSchemaRequest.include_views', index=1,
-      number=2, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
+      name='value', full_name='tabletmanagerdata.ExecuteHookToStreamRequest.ExtraEnvEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1361,
+  serialized_end=1408,
+)
+
+_EXECUTEHOOKTOSTREAMREQUEST = _descriptor.Descriptor(
+  name='ExecuteHookToStreamRequest',
+  full_name='tabletmanagerdata.ExecuteHookToStreamRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='tabletmanagerdata.ExecuteHookToStreamRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
     _descriptor.FieldDescriptor(
-      name='exclude_tables', full_name='tabletmanagerdata.GetSchemaRequest.exclude_tables', index=2,
-      number=3, type=9, cpp_type=9, label=3,
+      name='parameters', full_name='tabletmanagerdata.ExecuteHookToStreamRequest.parameters', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='extra_env', full_name='tabletmanagerdata.ExecuteHookToStreamRequest.extra_env', index=2,
+      number=3, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -716,7 +755,7 @@ _GETSCHEMAREQUEST = _descriptor.Descriptor(
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_EXECUTEHOOKTOSTREAMREQUEST_EXTRAENVENTRY, ],
   enum_types=[
   ],
   options=None,
@@ -725,22 +764,43 @@ _GETSCHEMAREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1453,
-  serialized_end=1534,
+  serialized_start=1487,
+  serialized_end=1678,
 )
 
 
-_GETSCHEMARESPONSE = _descriptor.Descriptor(
-  name='GetSchemaResponse',
-  full_name='tabletmanagerdata.GetSchemaResponse',
+_EXECUTEHOOKTOSTREAMRESPONSE = _descriptor.Descriptor(
+  name='ExecuteHookToStreamResponse',
+  full_name='tabletmanagerdata.ExecuteHookToStreamResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='schema_definition', full_name='tabletmanagerdata.GetSchemaResponse.schema_definition', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='stdout', full_name='tabletmanagerdata.ExecuteHookToStreamResponse.stdout', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='done', full_name='tabletmanagerdata.ExecuteHookToStreamResponse.done', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='exit_status', full_name='tabletmanagerdata.ExecuteHookToStreamResponse.exit_status', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='stderr', full_name='tabletmanagerdata.ExecuteHookToStreamResponse.stderr', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -756,18 +816,46 @@ _GETSCHEMARESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1536,
-  serialized_end=1619,
+  serialized_start=1680,
+  serialized_end=1776,
 )
 
 
-_GETPERMISSIONSREQUEST = _descriptor.Descriptor(
-  name='GetPermissionsRequest',
-  full_name='tabletmanagerdata.GetPermissionsRequest',
+_GETSCHEMAREQUEST = _descriptor.Descriptor(
+  name='GetSchemaRequest',
+  full_name='tabletmanagerdata.GetSchemaRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='tables', full_name='tabletmanagerdata.GetSchemaRequest.tables', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='include_views', full_name='tabletmanagerdata.GetSchemaRequest.include_views', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='exclude_tables', full_name='tabletmanagerdata.GetSchemaRequest.exclude_tables', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='best_effort_timeout_ns', full_name='tabletmanagerdata.GetSchemaRequest.best_effort_timeout_ns', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -780,25 +868,32 @@ _GETPERMISSIONSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1621,
-  serialized_end=1644,
+  serialized_start=1778,
+  serialized_end=1891,
 )
 
 
-_GETPERMISSIONSRESPONSE = _descriptor.Descriptor(
-  name='GetPermissionsResponse',
-  full_name='tabletmanagerdata.GetPermissionsResponse',
+_GETSCHEMARESPONSE = _descriptor.Descriptor(
+  name='GetSchemaResponse',
+  full_name='tabletmanagerdata.GetSchemaResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='permissions', full_name='tabletmanagerdata.GetPermissionsResponse.permissions', index=0,
+      name='schema_definition', full_name='tabletmanagerdata.GetSchemaResponse.schema_definition', index=0,
       number=1, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='incomplete', full_name='tabletmanagerdata.GetSchemaResponse.incomplete', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -811,18 +906,39 @@ _GETPERMISSIONSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1646,
-  serialized_end=1723,
+  serialized_start=1893,
+  serialized_end=1996,
 )
 
 
-_SETREADONLYREQUEST = _descriptor.Descriptor(
-  name='SetReadOnlyRequest',
-  full_name='tabletmanagerdata.SetReadOnlyRequest',
+_GETCREATESTATEMENTSREQUEST = _descriptor.Descriptor(
+  name='GetCreateStatementsRequest',
+  full_name='tabletmanagerdata.GetCreateStatementsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='tables', full_name='tabletmanagerdata.GetCreateStatementsRequest.tables', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='include_views', full_name='tabletmanagerdata.GetCreateStatementsRequest.include_views', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='exclude_tables', full_name='tabletmanagerdata.GetCreateStatementsRequest.exclude_tables', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -835,46 +951,66 @@ _SETREADONLYREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1725,
-  serialized_end=1745,
+  serialized_start=1998,
+  serialized_end=2089,
 )
 
 
-_SETREADONLYRESPONSE = _descriptor.Descriptor(
-  name='SetReadOnlyResponse',
-  full_name='tabletmanagerdata.SetReadOnlyResponse',
+_GETCREATESTATEMENTSRESPONSE_CREATESTATEMENTSENTRY = _descriptor.Descriptor(
+  name='CreateStatementsEntry',
+  full_name='tabletmanagerdata.GetCreateStatementsResponse.CreateStatementsEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='tabletmanagerdata.GetCreateStatementsResponse.CreateStatementsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='tabletmanagerdata.GetCreateStatementsResponse.CreateStatementsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  options=None,
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1747,
-  serialized_end=1768,
+  serialized_start=2220,
+  serialized_end=2275,
 )
 
-
-_SETREADWRITEREQUEST = _descriptor.Descriptor(
-  name='SetReadWriteRequest',
-  full_name='tabletmanagerdata.SetReadWriteRequest',
+_GETCREATESTATEMENTSRESPONSE = _descriptor.Descriptor(
+  name='GetCreateStatementsResponse',
+  full_name='tabletmanagerdata.GetCreateStatementsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='create_statements', full_name='tabletmanagerdata.GetCreateStatementsResponse.create_statements', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_GETCREATESTATEMENTSRESPONSE_CREATESTATEMENTSENTRY, ],
   enum_types=[
   ],
   options=None,
@@ -883,18 +1019,25 @@ _SETREADWRITEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1770,
-  serialized_end=1791,
+  serialized_start=2092,
+  serialized_end=2275,
 )
 
 
-_SETREADWRITERESPONSE = _descriptor.Descriptor(
-  name='SetReadWriteResponse',
-  full_name='tabletmanagerdata.SetReadWriteResponse',
+_GETSCHEMATIMESTAMPSREQUEST = _descriptor.Descriptor(
+  name='GetSchemaTimestampsRequest',
+  full_name='tabletmanagerdata.GetSchemaTimestampsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='tables', full_name='tabletmanagerdata.GetSchemaTimestampsRequest.tables', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -907,21 +1050,28 @@ _SETREADWRITERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1793,
-  serialized_end=1815,
+  serialized_start=2277,
+  serialized_end=2321,
 )
 
 
-_CHANGETYPEREQUEST = _descriptor.Descriptor(
-  name='ChangeTypeRequest',
-  full_name='tabletmanagerdata.ChangeTypeRequest',
+_GETSCHEMATIMESTAMPSRESPONSE_TIMESTAMPSENTRY = _descriptor.Descriptor(
+  name='TimestampsEntry',
+  full_name='tabletmanagerdata.GetSchemaTimestampsResponse.TimestampsEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='tablet_type', full_name='tabletmanagerdata.ChangeTypeRequest.tablet_type', index=0,
-      number=1, type=14, cpp_type=8, label=1,
+      name='key', full_name='tabletmanagerdata.GetSchemaTimestampsResponse.TimestampsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='tabletmanagerdata.GetSchemaTimestampsResponse.TimestampsEntry.value', index=1,
+      number=2, type=3, cpp_type=2, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -932,28 +1082,34 @@ _CHANGETYPEREQUEST = _descriptor.Descriptor(
   nested_types=[],
   enum_types=[
   ],
-  options=None,
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1817,
-  serialized_end=1879,
+  serialized_start=2439,
+  serialized_end=2488,
 )
 
-
-_CHANGETYPERESPONSE = _descriptor.Descriptor(
-  name='ChangeTypeResponse',
-  full_name='tabletmanagerdata.ChangeTypeResponse',
+_GETSCHEMATIMESTAMPSRESPONSE = _descriptor.Descriptor(
+  name='GetSchemaTimestampsResponse',
+  full_name='tabletmanagerdata.GetSchemaTimestampsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='timestamps', full_name='tabletmanagerdata.GetSchemaTimestampsResponse.timestamps', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_GETSCHEMATIMESTAMPSRESPONSE_TIMESTAMPSENTRY, ],
   enum_types=[
   ],
   options=None,
@@ -962,14 +1118,14 @@ _CHANGETYPERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1881,
-  serialized_end=1901,
+  serialized_start=2324,
+  serialized_end=2488,
 )
 
 
-_REFRESHSTATEREQUEST = _descriptor.Descriptor(
-  name='RefreshStateRequest',
-  full_name='tabletmanagerdata.RefreshStateRequest',
+_GETPERMISSIONSREQUEST = _descriptor.Descriptor(
+  name='GetPermissionsRequest',
+  full_name='tabletmanagerdata.GetPermissionsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -986,18 +1142,25 @@ _REFRESHSTATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1903,
-  serialized_end=1924,
+  serialized_start=2490,
+  serialized_end=2513,
 )
 
 
-_REFRESHSTATERESPONSE = _descriptor.Descriptor(
-  name='RefreshStateResponse',
-  full_name='tabletmanagerdata.RefreshStateResponse',
+_GETPERMISSIONSRESPONSE = _descriptor.Descriptor(
+  name='GetPermissionsResponse',
+  full_name='tabletmanagerdata.GetPermissionsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='permissions', full_name='tabletmanagerdata.GetPermissionsResponse.permissions', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1010,18 +1173,39 @@ _REFRESHSTATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1926,
-  serialized_end=1948,
+  serialized_start=2515,
+  serialized_end=2592,
 )
 
 
-_RUNHEALTHCHECKREQUEST = _descriptor.Descriptor(
-  name='RunHealthCheckRequest',
-  full_name='tabletmanagerdata.RunHealthCheckRequest',
+_CONNECTIONSTATS = _descriptor.Descriptor(
+  name='ConnectionStats',
+  full_name='tabletmanagerdata.ConnectionStats',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='open_connections', full_name='tabletmanagerdata.ConnectionStats.open_connections', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='active_transactions', full_name='tabletmanagerdata.ConnectionStats.active_transactions', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='pool_capacity', full_name='tabletmanagerdata.ConnectionStats.pool_capacity', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1034,14 +1218,14 @@ _RUNHEALTHCHECKREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1950,
-  serialized_end=1973,
+  serialized_start=2594,
+  serialized_end=2689,
 )
 
 
-_RUNHEALTHCHECKRESPONSE = _descriptor.Descriptor(
-  name='RunHealthCheckResponse',
-  full_name='tabletmanagerdata.RunHealthCheckResponse',
+_GETCONNECTIONSTATSREQUEST = _descriptor.Descriptor(
+  name='GetConnectionStatsRequest',
+  full_name='tabletmanagerdata.GetConnectionStatsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -1058,22 +1242,22 @@ _RUNHEALTHCHECKRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1975,
-  serialized_end=1999,
+  serialized_start=2691,
+  serialized_end=2718,
 )
 
 
-_IGNOREHEALTHERRORREQUEST = _descriptor.Descriptor(
-  name='IgnoreHealthErrorRequest',
-  full_name='tabletmanagerdata.IgnoreHealthErrorRequest',
+_GETCONNECTIONSTATSRESPONSE = _descriptor.Descriptor(
+  name='GetConnectionStatsResponse',
+  full_name='tabletmanagerdata.GetConnectionStatsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='pattern', full_name='tabletmanagerdata.IgnoreHealthErrorRequest.pattern', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='connection_stats', full_name='tabletmanagerdata.GetConnectionStatsResponse.connection_stats', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -1089,18 +1273,25 @@ _IGNOREHEALTHERRORREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2001,
-  serialized_end=2044,
+  serialized_start=2720,
+  serialized_end=2810,
 )
 
 
-_IGNOREHEALTHERRORRESPONSE = _descriptor.Descriptor(
-  name='IgnoreHealthErrorResponse',
-  full_name='tabletmanagerdata.IgnoreHealthErrorResponse',
+_GETCONNECTIONREJECTIONSTATSREQUEST = _descriptor.Descriptor(
+  name='GetConnectionRejectionStatsRequest',
+  full_name='tabletmanagerdata.GetConnectionRejectionStatsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='reset_counts', full_name='tabletmanagerdata.GetConnectionRejectionStatsRequest.reset_counts', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1113,53 +1304,66 @@ _IGNOREHEALTHERRORRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2046,
-  serialized_end=2073,
+  serialized_start=2812,
+  serialized_end=2870,
 )
 
 
-_RELOADSCHEMAREQUEST = _descriptor.Descriptor(
-  name='ReloadSchemaRequest',
-  full_name='tabletmanagerdata.ReloadSchemaRequest',
+_GETCONNECTIONREJECTIONSTATSRESPONSE_REJECTIONSENTRY = _descriptor.Descriptor(
+  name='RejectionsEntry',
+  full_name='tabletmanagerdata.GetConnectionRejectionStatsResponse.RejectionsEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='wait_position', full_name='tabletmanagerdata.ReloadSchemaRequest.wait_position', index=0,
+      name='key', full_name='tabletmanagerdata.GetConnectionRejectionStatsResponse.RejectionsEntry.key', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='tabletmanagerdata.GetConnectionRejectionStatsResponse.RejectionsEntry.value', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  options=None,
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2075,
-  serialized_end=2119,
+  serialized_start=3004,
+  serialized_end=3053,
 )
 
-
-_RELOADSCHEMARESPONSE = _descriptor.Descriptor(
-  name='ReloadSchemaResponse',
-  full_name='tabletmanagerdata.ReloadSchemaResponse',
+_GETCONNECTIONREJECTIONSTATSRESPONSE = _descriptor.Descriptor(
+  name='GetConnectionRejectionStatsResponse',
+  full_name='tabletmanagerdata.GetConnectionRejectionStatsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='rejections', full_name='tabletmanagerdata.GetConnectionRejectionStatsResponse.rejections', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_GETCONNECTIONREJECTIONSTATSRESPONSE_REJECTIONSENTRY, ],
   enum_types=[
   ],
   options=None,
@@ -1168,25 +1372,18 @@ _RELOADSCHEMARESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2121,
-  serialized_end=2143,
+  serialized_start=2873,
+  serialized_end=3053,
 )
 
 
-_PREFLIGHTSCHEMAREQUEST = _descriptor.Descriptor(
-  name='PreflightSchemaRequest',
-  full_name='tabletmanagerdata.PreflightSchemaRequest',
+_GETCONFIGREQUEST = _descriptor.Descriptor(
+  name='GetConfigRequest',
+  full_name='tabletmanagerdata.GetConfigRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-    _descriptor.FieldDescriptor(
-      name='changes', full_name='tabletmanagerdata.PreflightSchemaRequest.changes', index=0,
-      number=1, type=9, cpp_type=9, label=3,
-      has_default_value=False, default_value=[],
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
   ],
   extensions=[
   ],
@@ -1199,22 +1396,29 @@ _PREFLIGHTSCHEMAREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2145,
-  serialized_end=2186,
+  serialized_start=3055,
+  serialized_end=3073,
 )
 
 
-_PREFLIGHTSCHEMARESPONSE = _descriptor.Descriptor(
-  name='PreflightSchemaResponse',
-  full_name='tabletmanagerdata.PreflightSchemaResponse',
+_GETCONFIGRESPONSE_FLAGSENTRY = _descriptor.Descriptor(
+  name='FlagsEntry',
+  full_name='tabletmanagerdata.GetConfigResponse.FlagsEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='change_results', full_name='tabletmanagerdata.PreflightSchemaResponse.change_results', index=0,
-      number=1, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
+      name='key', full_name='tabletmanagerdata.GetConfigResponse.FlagsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='tabletmanagerdata.GetConfigResponse.FlagsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -1224,63 +1428,34 @@ _PREFLIGHTSCHEMARESPONSE = _descriptor.Descriptor(
   nested_types=[],
   enum_types=[
   ],
-  options=None,
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2188,
-  serialized_end=2276,
+  serialized_start=3161,
+  serialized_end=3205,
 )
 
-
-_APPLYSCHEMAREQUEST = _descriptor.Descriptor(
-  name='ApplySchemaRequest',
-  full_name='tabletmanagerdata.ApplySchemaRequest',
+_GETCONFIGRESPONSE = _descriptor.Descriptor(
+  name='GetConfigResponse',
+  full_name='tabletmanagerdata.GetConfigResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='sql', full_name='tabletmanagerdata.ApplySchemaRequest.sql', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
-    _descriptor.FieldDescriptor(
-      name='force', full_name='tabletmanagerdata.ApplySchemaRequest.force', index=1,
-      number=2, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
-    _descriptor.FieldDescriptor(
-      name='allow_replication', full_name='tabletmanagerdata.ApplySchemaRequest.allow_replication', index=2,
-      number=3, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
-    _descriptor.FieldDescriptor(
-      name='before_schema', full_name='tabletmanagerdata.ApplySchemaRequest.before_schema', index=3,
-      number=4, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
-    _descriptor.FieldDescriptor(
-      name='after_schema', full_name='tabletmanagerdata.ApplySchemaRequest.after_schema', index=4,
-      number=5, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='flags', full_name='tabletmanagerdata.GetConfigResponse.flags', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_GETCONFIGRESPONSE_FLAGSENTRY, ],
   enum_types=[
   ],
   options=None,
@@ -1289,32 +1464,18 @@ _APPLYSCHEMAREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2279,
-  serialized_end=2473,
+  serialized_start=3076,
+  serialized_end=3205,
 )
 
 
-_APPLYSCHEMARESPONSE = _descriptor.Descriptor(
-  name='ApplySchemaResponse',
-  full_name='tabletmanagerdata.ApplySchemaResponse',
+_GETINFLIGHTRPCSREQUEST = _descriptor.Descriptor(
+  name='GetInFlightRPCsRequest',
+  full_name='tabletmanagerdata.GetInFlightRPCsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-    _descriptor.FieldDescriptor(
-      name='before_schema', full_name='tabletmanagerdata.ApplySchemaResponse.before_schema', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
-    _descriptor.FieldDescriptor(
-      name='after_schema', full_name='tabletmanagerdata.ApplySchemaResponse.after_schema', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
   ],
   extensions=[
   ],
@@ -1327,88 +1488,66 @@ _APPLYSCHEMARESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2476,
-  serialized_end=2616,
+  serialized_start=3207,
+  serialized_end=3231,
 )
 
 
-_EXECUTEFETCHASDBAREQUEST = _descriptor.Descriptor(
-  name='ExecuteFetchAsDbaRequest',
-  full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest',
+_GETINFLIGHTRPCSRESPONSE_INFLIGHTENTRY = _descriptor.Descriptor(
+  name='InFlightEntry',
+  full_name='tabletmanagerdata.GetInFlightRPCsResponse.InFlightEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='query', full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest.query', index=0,
-      number=1, type=12, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b(""),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
-    _descriptor.FieldDescriptor(
-      name='db_name', full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest.db_name', index=1,
-      number=2, type=9, cpp_type=9, label=1,
+      name='key', full_name='tabletmanagerdata.GetInFlightRPCsResponse.InFlightEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
     _descriptor.FieldDescriptor(
-      name='max_rows', full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest.max_rows', index=2,
-      number=3, type=4, cpp_type=4, label=1,
+      name='value', full_name='tabletmanagerdata.GetInFlightRPCsResponse.InFlightEntry.value', index=1,
+      number=2, type=3, cpp_type=2, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
-    _descriptor.FieldDescriptor(
-      name='disable_binlogs', full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest.disable_binlogs', index=3,
-      number=4, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
-    _descriptor.FieldDescriptor(
-      name='reload_schema', full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest.reload_schema', index=4,
-      number=5, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  options=None,
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2618,
-  serialized_end=2742,
+  serialized_start=3338,
+  serialized_end=3385,
 )
 
-
-_EXECUTEFETCHASDBARESPONSE = _descriptor.Descriptor(
-  name='ExecuteFetchAsDbaResponse',
-  full_name='tabletmanagerdata.ExecuteFetchAsDbaResponse',
+_GETINFLIGHTRPCSRESPONSE = _descriptor.Descriptor(
+  name='GetInFlightRPCsResponse',
+  full_name='tabletmanagerdata.GetInFlightRPCsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='result', full_name='tabletmanagerdata.ExecuteFetchAsDbaResponse.result', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='in_flight', full_name='tabletmanagerdata.GetInFlightRPCsResponse.in_flight', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_GETINFLIGHTRPCSRESPONSE_INFLIGHTENTRY, ],
   enum_types=[
   ],
   options=None,
@@ -1417,43 +1556,50 @@ _EXECUTEFETCHASDBARESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2744,
-  serialized_end=2807,
+  serialized_start=3234,
+  serialized_end=3385,
 )
 
 
-_EXECUTEFETCHASALLPRIVSREQUEST = _descriptor.Descriptor(
-  name='ExecuteFetchAsAllPrivsRequest',
-  full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsRequest',
+_PROCESSSTATS = _descriptor.Descriptor(
+  name='ProcessStats',
+  full_name='tabletmanagerdata.ProcessStats',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='query', full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsRequest.query', index=0,
-      number=1, type=12, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b(""),
+      name='goroutines', full_name='tabletmanagerdata.ProcessStats.goroutines', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
     _descriptor.FieldDescriptor(
-      name='db_name', full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsRequest.db_name', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='threads', full_name='tabletmanagerdata.ProcessStats.threads', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
     _descriptor.FieldDescriptor(
-      name='max_rows', full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsRequest.max_rows', index=2,
-      number=3, type=4, cpp_type=4, label=1,
+      name='open_files', full_name='tabletmanagerdata.ProcessStats.open_files', index=2,
+      number=3, type=3, cpp_type=2, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
     _descriptor.FieldDescriptor(
-      name='reload_schema', full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsRequest.reload_schema', index=3,
-      number=4, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
+      name='heap_alloc_bytes', full_name='tabletmanagerdata.ProcessStats.heap_alloc_bytes', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='sys_bytes', full_name='tabletmanagerdata.ProcessStats.sys_bytes', index=4,
+      number=5, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -1469,25 +1615,18 @@ _EXECUTEFETCHASALLPRIVSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2809,
-  serialized_end=2913,
+  serialized_start=3387,
+  serialized_end=3503,
 )
 
 
-_EXECUTEFETCHASALLPRIVSRESPONSE = _descriptor.Descriptor(
-  name='ExecuteFetchAsAllPrivsResponse',
-  full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsResponse',
+_GETPROCESSSTATSREQUEST = _descriptor.Descriptor(
+  name='GetProcessStatsRequest',
+  full_name='tabletmanagerdata.GetProcessStatsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-    _descriptor.FieldDescriptor(
-      name='result', full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsResponse.result', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
   ],
   extensions=[
   ],
@@ -1500,29 +1639,22 @@ _EXECUTEFETCHASALLPRIVSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2915,
-  serialized_end=2983,
+  serialized_start=3505,
+  serialized_end=3529,
 )
 
 
-_EXECUTEFETCHASAPPREQUEST = _descriptor.Descriptor(
-  name='ExecuteFetchAsAppRequest',
-  full_name='tabletmanagerdata.ExecuteFetchAsAppRequest',
+_GETPROCESSSTATSRESPONSE = _descriptor.Descriptor(
+  name='GetProcessStatsResponse',
+  full_name='tabletmanagerdata.GetProcessStatsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='query', full_name='tabletmanagerdata.ExecuteFetchAsAppRequest.query', index=0,
-      number=1, type=12, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b(""),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
-    _descriptor.FieldDescriptor(
-      name='max_rows', full_name='tabletmanagerdata.ExecuteFetchAsAppRequest.max_rows', index=1,
-      number=2, type=4, cpp_type=4, label=1,
-      has_default_value=False, default_value=0,
+      name='stats', full_name='tabletmanagerdata.GetProcessStatsResponse.stats', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -1538,22 +1670,50 @@ _EXECUTEFETCHASAPPREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2985,
-  serialized_end=3044,
+  serialized_start=3531,
+  serialized_end=3604,
 )
 
 
-_EXECUTEFETCHASAPPRESPONSE = _descriptor.Descriptor(
-  name='ExecuteFetchAsAppResponse',
-  full_name='tabletmanagerdata.ExecuteFetchAsAppResponse',
+_HEALTHSCORE = _descriptor.Descriptor(
+  name='HealthScore',
+  full_name='tabletmanagerdata.HealthScore',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='result', full_name='tabletmanagerdata.ExecuteFetchAsAppResponse.result', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='score', full_name='tabletmanagerdata.HealthScore.score', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='replication_lag_factor', full_name='tabletmanagerdata.HealthScore.replication_lag_factor', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='error_rate_factor', full_name='tabletmanagerdata.HealthScore.error_rate_factor', index=2,
+      number=3, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='load_factor', full_name='tabletmanagerdata.HealthScore.load_factor', index=3,
+      number=4, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='health_error', full_name='tabletmanagerdata.HealthScore.health_error', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -1569,14 +1729,14 @@ _EXECUTEFETCHASAPPRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3046,
-  serialized_end=3109,
+  serialized_start=3607,
+  serialized_end=3737,
 )
 
 
-_SLAVESTATUSREQUEST = _descriptor.Descriptor(
-  name='SlaveStatusRequest',
-  full_name='tabletmanagerdata.SlaveStatusRequest',
+_GETHEALTHSCOREREQUEST = _descriptor.Descriptor(
+  name='GetHealthScoreRequest',
+  full_name='tabletmanagerdata.GetHealthScoreRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -1593,20 +1753,20 @@ _SLAVESTATUSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3111,
-  serialized_end=3131,
+  serialized_start=3739,
+  serialized_end=3762,
 )
 
 
-_SLAVESTATUSRESPONSE = _descriptor.Descriptor(
-  name='SlaveStatusResponse',
-  full_name='tabletmanagerdata.SlaveStatusResponse',
+_GETHEALTHSCORERESPONSE = _descriptor.Descriptor(
+  name='GetHealthScoreResponse',
+  full_name='tabletmanagerdata.GetHealthScoreResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='status', full_name='tabletmanagerdata.SlaveStatusResponse.status', index=0,
+      name='score', full_name='tabletmanagerdata.GetHealthScoreResponse.score', index=0,
       number=1, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
@@ -1624,18 +1784,25 @@ _SLAVESTATUSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3133,
-  serialized_end=3195,
+  serialized_start=3764,
+  serialized_end=3835,
 )
 
 
-_MASTERPOSITIONREQUEST = _descriptor.Descriptor(
-  name='MasterPositionRequest',
-  full_name='tabletmanagerdata.MasterPositionRequest',
+_GETERRORLOGTAILREQUEST = _descriptor.Descriptor(
+  name='GetErrorLogTailRequest',
+  full_name='tabletmanagerdata.GetErrorLogTailRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='lines', full_name='tabletmanagerdata.GetErrorLogTailRequest.lines', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1648,22 +1815,22 @@ _MASTERPOSITIONREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3197,
-  serialized_end=3220,
+  serialized_start=3837,
+  serialized_end=3876,
 )
 
 
-_MASTERPOSITIONRESPONSE = _descriptor.Descriptor(
-  name='MasterPositionResponse',
-  full_name='tabletmanagerdata.MasterPositionResponse',
+_GETERRORLOGTAILRESPONSE = _descriptor.Descriptor(
+  name='GetErrorLogTailResponse',
+  full_name='tabletmanagerdata.GetErrorLogTailResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='position', full_name='tabletmanagerdata.MasterPositionResponse.position', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='lines', full_name='tabletmanagerdata.GetErrorLogTailResponse.lines', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -1679,14 +1846,14 @@ _MASTERPOSITIONRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3222,
-  serialized_end=3264,
+  serialized_start=3878,
+  serialized_end=3918,
 )
 
 
-_STOPSLAVEREQUEST = _descriptor.Descriptor(
-  name='StopSlaveRequest',
-  full_name='tabletmanagerdata.StopSlaveRequest',
+_CHECKDATADIRREQUEST = _descriptor.Descriptor(
+  name='CheckDataDirRequest',
+  full_name='tabletmanagerdata.CheckDataDirRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -1703,18 +1870,25 @@ _STOPSLAVEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3266,
-  serialized_end=3284,
+  serialized_start=3920,
+  serialized_end=3941,
 )
 
 
-_STOPSLAVERESPONSE = _descriptor.Descriptor(
-  name='StopSlaveResponse',
-  full_name='tabletmanagerdata.StopSlaveResponse',
+_CHECKDATADIRRESPONSE = _descriptor.Descriptor(
+  name='CheckDataDirResponse',
+  full_name='tabletmanagerdata.CheckDataDirResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='problems', full_name='tabletmanagerdata.CheckDataDirResponse.problems', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1727,29 +1901,29 @@ _STOPSLAVERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3286,
-  serialized_end=3305,
+  serialized_start=3943,
+  serialized_end=3983,
 )
 
 
-_STOPSLAVEMINIMUMREQUEST = _descriptor.Descriptor(
-  name='StopSlaveMinimumRequest',
-  full_name='tabletmanagerdata.StopSlaveMinimumRequest',
+_DIAGNOSTICBUNDLE_VARIABLESENTRY = _descriptor.Descriptor(
+  name='VariablesEntry',
+  full_name='tabletmanagerdata.DiagnosticBundle.VariablesEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='position', full_name='tabletmanagerdata.StopSlaveMinimumRequest.position', index=0,
+      name='key', full_name='tabletmanagerdata.DiagnosticBundle.VariablesEntry.key', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
     _descriptor.FieldDescriptor(
-      name='wait_timeout', full_name='tabletmanagerdata.StopSlaveMinimumRequest.wait_timeout', index=1,
-      number=2, type=3, cpp_type=2, label=1,
-      has_default_value=False, default_value=0,
+      name='value', full_name='tabletmanagerdata.DiagnosticBundle.VariablesEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -1759,35 +1933,76 @@ _STOPSLAVEMINIMUMREQUEST = _descriptor.Descriptor(
   nested_types=[],
   enum_types=[
   ],
-  options=None,
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3307,
-  serialized_end=3372,
+  serialized_start=4270,
+  serialized_end=4318,
 )
 
-
-_STOPSLAVEMINIMUMRESPONSE = _descriptor.Descriptor(
-  name='StopSlaveMinimumResponse',
-  full_name='tabletmanagerdata.StopSlaveMinimumResponse',
+_DIAGNOSTICBUNDLE = _descriptor.Descriptor(
+  name='DiagnosticBundle',
+  full_name='tabletmanagerdata.DiagnosticBundle',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='position', full_name='tabletmanagerdata.StopSlaveMinimumResponse.position', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='processes', full_name='tabletmanagerdata.DiagnosticBundle.processes', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='slave_status', full_name='tabletmanagerdata.DiagnosticBundle.slave_status', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='error_log_tail', full_name='tabletmanagerdata.DiagnosticBundle.error_log_tail', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='variables', full_name='tabletmanagerdata.DiagnosticBundle.variables', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='data_dir_total_bytes', full_name='tabletmanagerdata.DiagnosticBundle.data_dir_total_bytes', index=4,
+      number=5, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='data_dir_free_bytes', full_name='tabletmanagerdata.DiagnosticBundle.data_dir_free_bytes', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='errors', full_name='tabletmanagerdata.DiagnosticBundle.errors', index=6,
+      number=7, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_DIAGNOSTICBUNDLE_VARIABLESENTRY, ],
   enum_types=[
   ],
   options=None,
@@ -1796,14 +2011,14 @@ _STOPSLAVEMINIMUMRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3374,
-  serialized_end=3418,
+  serialized_start=3986,
+  serialized_end=4318,
 )
 
 
-_STARTSLAVEREQUEST = _descriptor.Descriptor(
-  name='StartSlaveRequest',
-  full_name='tabletmanagerdata.StartSlaveRequest',
+_CAPTUREDIAGNOSTICSREQUEST = _descriptor.Descriptor(
+  name='CaptureDiagnosticsRequest',
+  full_name='tabletmanagerdata.CaptureDiagnosticsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -1820,18 +2035,25 @@ _STARTSLAVEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3420,
-  serialized_end=3439,
+  serialized_start=4320,
+  serialized_end=4347,
 )
 
 
-_STARTSLAVERESPONSE = _descriptor.Descriptor(
-  name='StartSlaveResponse',
-  full_name='tabletmanagerdata.StartSlaveResponse',
+_CAPTUREDIAGNOSTICSRESPONSE = _descriptor.Descriptor(
+  name='CaptureDiagnosticsResponse',
+  full_name='tabletmanagerdata.CaptureDiagnosticsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='bundle', full_name='tabletmanagerdata.CaptureDiagnosticsResponse.bundle', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1844,25 +2066,67 @@ _STARTSLAVERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3441,
-  serialized_end=3461,
+  serialized_start=4349,
+  serialized_end=4430,
 )
 
 
-_TABLETEXTERNALLYREPARENTEDREQUEST = _descriptor.Descriptor(
-  name='TabletExternallyReparentedRequest',
-  full_name='tabletmanagerdata.TabletExternallyReparentedRequest',
+_THROTTLESTATE = _descriptor.Descriptor(
+  name='ThrottleState',
+  full_name='tabletmanagerdata.ThrottleState',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='external_id', full_name='tabletmanagerdata.TabletExternallyReparentedRequest.external_id', index=0,
-      number=1, type=9, cpp_type=9, label=1,
+      name='throttled', full_name='tabletmanagerdata.ThrottleState.throttled', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='metric', full_name='tabletmanagerdata.ThrottleState.metric', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='tabletmanagerdata.ThrottleState.value', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='threshold', full_name='tabletmanagerdata.ThrottleState.threshold', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='reason', full_name='tabletmanagerdata.ThrottleState.reason', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='mode', full_name='tabletmanagerdata.ThrottleState.mode', index=5,
+      number=6, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='mode_expire_time_ns', full_name='tabletmanagerdata.ThrottleState.mode_expire_time_ns', index=6,
+      number=7, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1875,14 +2139,14 @@ _TABLETEXTERNALLYREPARENTEDREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3463,
-  serialized_end=3519,
+  serialized_start=4433,
+  serialized_end=4576,
 )
 
 
-_TABLETEXTERNALLYREPARENTEDRESPONSE = _descriptor.Descriptor(
-  name='TabletExternallyReparentedResponse',
-  full_name='tabletmanagerdata.TabletExternallyReparentedResponse',
+_GETTHROTTLESTATEREQUEST = _descriptor.Descriptor(
+  name='GetThrottleStateRequest',
+  full_name='tabletmanagerdata.GetThrottleStateRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -1899,18 +2163,25 @@ _TABLETEXTERNALLYREPARENTEDRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3521,
-  serialized_end=3557,
+  serialized_start=4578,
+  serialized_end=4603,
 )
 
 
-_TABLETEXTERNALLYELECTEDREQUEST = _descriptor.Descriptor(
-  name='TabletExternallyElectedRequest',
-  full_name='tabletmanagerdata.TabletExternallyElectedRequest',
+_GETTHROTTLESTATERESPONSE = _descriptor.Descriptor(
+  name='GetThrottleStateResponse',
+  full_name='tabletmanagerdata.GetThrottleStateResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='state', full_name='tabletmanagerdata.GetThrottleStateResponse.state', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1923,14 +2194,14 @@ _TABLETEXTERNALLYELECTEDREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3559,
-  serialized_end=3591,
+  serialized_start=4605,
+  serialized_end=4680,
 )
 
 
-_TABLETEXTERNALLYELECTEDRESPONSE = _descriptor.Descriptor(
-  name='TabletExternallyElectedResponse',
-  full_name='tabletmanagerdata.TabletExternallyElectedResponse',
+_GETTABLETTAGSREQUEST = _descriptor.Descriptor(
+  name='GetTabletTagsRequest',
+  full_name='tabletmanagerdata.GetTabletTagsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -1947,45 +2218,58 @@ _TABLETEXTERNALLYELECTEDRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3593,
-  serialized_end=3626,
+  serialized_start=4682,
+  serialized_end=4704,
 )
 
 
-_GETSLAVESREQUEST = _descriptor.Descriptor(
-  name='GetSlavesRequest',
-  full_name='tabletmanagerdata.GetSlavesRequest',
+_GETTABLETTAGSRESPONSE_TAGSENTRY = _descriptor.Descriptor(
+  name='TagsEntry',
+  full_name='tabletmanagerdata.GetTabletTagsResponse.TagsEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='tabletmanagerdata.GetTabletTagsResponse.TagsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='tabletmanagerdata.GetTabletTagsResponse.TagsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  options=None,
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3628,
-  serialized_end=3646,
+  serialized_start=4798,
+  serialized_end=4841,
 )
 
-
-_GETSLAVESRESPONSE = _descriptor.Descriptor(
-  name='GetSlavesResponse',
-  full_name='tabletmanagerdata.GetSlavesResponse',
+_GETTABLETTAGSRESPONSE = _descriptor.Descriptor(
+  name='GetTabletTagsResponse',
+  full_name='tabletmanagerdata.GetTabletTagsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='addrs', full_name='tabletmanagerdata.GetSlavesResponse.addrs', index=0,
-      number=1, type=9, cpp_type=9, label=3,
+      name='tags', full_name='tabletmanagerdata.GetTabletTagsResponse.tags', index=0,
+      number=1, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -1993,7 +2277,7 @@ _GETSLAVESRESPONSE = _descriptor.Descriptor(
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_GETTABLETTAGSRESPONSE_TAGSENTRY, ],
   enum_types=[
   ],
   options=None,
@@ -2002,27 +2286,27 @@ _GETSLAVESRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3648,
-  serialized_end=3682,
+  serialized_start=4707,
+  serialized_end=4841,
 )
 
 
-_WAITBLPPOSITIONREQUEST = _descriptor.Descriptor(
-  name='WaitBlpPositionRequest',
-  full_name='tabletmanagerdata.WaitBlpPositionRequest',
+_SETTHROTTLEREQUEST = _descriptor.Descriptor(
+  name='SetThrottleRequest',
+  full_name='tabletmanagerdata.SetThrottleRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='blp_position', full_name='tabletmanagerdata.WaitBlpPositionRequest.blp_position', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='mode', full_name='tabletmanagerdata.SetThrottleRequest.mode', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
     _descriptor.FieldDescriptor(
-      name='wait_timeout', full_name='tabletmanagerdata.WaitBlpPositionRequest.wait_timeout', index=1,
+      name='duration_ns', full_name='tabletmanagerdata.SetThrottleRequest.duration_ns', index=1,
       number=2, type=3, cpp_type=2, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
@@ -2040,14 +2324,14 @@ _WAITBLPPOSITIONREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3684,
-  serialized_end=3784,
+  serialized_start=4843,
+  serialized_end=4898,
 )
 
 
-_WAITBLPPOSITIONRESPONSE = _descriptor.Descriptor(
-  name='WaitBlpPositionResponse',
-  full_name='tabletmanagerdata.WaitBlpPositionResponse',
+_SETTHROTTLERESPONSE = _descriptor.Descriptor(
+  name='SetThrottleResponse',
+  full_name='tabletmanagerdata.SetThrottleResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -2064,14 +2348,14 @@ _WAITBLPPOSITIONRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3786,
-  serialized_end=3811,
+  serialized_start=4900,
+  serialized_end=4921,
 )
 
 
-_STOPBLPREQUEST = _descriptor.Descriptor(
-  name='StopBlpRequest',
-  full_name='tabletmanagerdata.StopBlpRequest',
+_SETREADONLYREQUEST = _descriptor.Descriptor(
+  name='SetReadOnlyRequest',
+  full_name='tabletmanagerdata.SetReadOnlyRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -2088,25 +2372,18 @@ _STOPBLPREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3813,
-  serialized_end=3829,
+  serialized_start=4923,
+  serialized_end=4943,
 )
 
 
-_STOPBLPRESPONSE = _descriptor.Descriptor(
-  name='StopBlpResponse',
-  full_name='tabletmanagerdata.StopBlpResponse',
+_SETREADONLYRESPONSE = _descriptor.Descriptor(
+  name='SetReadOnlyResponse',
+  full_name='tabletmanagerdata.SetReadOnlyResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-    _descriptor.FieldDescriptor(
-      name='blp_positions', full_name='tabletmanagerdata.StopBlpResponse.blp_positions', index=0,
-      number=1, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
   ],
   extensions=[
   ],
@@ -2119,14 +2396,14 @@ _STOPBLPRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3831,
-  serialized_end=3903,
+  serialized_start=4945,
+  serialized_end=4966,
 )
 
 
-_STARTBLPREQUEST = _descriptor.Descriptor(
-  name='StartBlpRequest',
-  full_name='tabletmanagerdata.StartBlpRequest',
+_SETREADWRITEREQUEST = _descriptor.Descriptor(
+  name='SetReadWriteRequest',
+  full_name='tabletmanagerdata.SetReadWriteRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -2143,14 +2420,14 @@ _STARTBLPREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3905,
-  serialized_end=3922,
+  serialized_start=4968,
+  serialized_end=4989,
 )
 
 
-_STARTBLPRESPONSE = _descriptor.Descriptor(
-  name='StartBlpResponse',
-  full_name='tabletmanagerdata.StartBlpResponse',
+_SETREADWRITERESPONSE = _descriptor.Descriptor(
+  name='SetReadWriteResponse',
+  full_name='tabletmanagerdata.SetReadWriteResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -2167,28 +2444,21 @@ _STARTBLPRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3924,
-  serialized_end=3942,
+  serialized_start=4991,
+  serialized_end=5013,
 )
 
 
-_RUNBLPUNTILREQUEST = _descriptor.Descriptor(
-  name='RunBlpUntilRequest',
-  full_name='tabletmanagerdata.RunBlpUntilRequest',
+_SETREADONLYWITHTTLREQUEST = _descriptor.Descriptor(
+  name='SetReadOnlyWithTTLRequest',
+  full_name='tabletmanagerdata.SetReadOnlyWithTTLRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='blp_positions', full_name='tabletmanagerdata.RunBlpUntilRequest.blp_positions', index=0,
-      number=1, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
-    _descriptor.FieldDescriptor(
-      name='wait_timeout', full_name='tabletmanagerdata.RunBlpUntilRequest.wait_timeout', index=1,
-      number=2, type=3, cpp_type=2, label=1,
+      name='ttl_ns', full_name='tabletmanagerdata.SetReadOnlyWithTTLRequest.ttl_ns', index=0,
+      number=1, type=3, cpp_type=2, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -2205,25 +2475,18 @@ _RUNBLPUNTILREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3944,
-  serialized_end=4041,
+  serialized_start=5015,
+  serialized_end=5058,
 )
 
 
-_RUNBLPUNTILRESPONSE = _descriptor.Descriptor(
-  name='RunBlpUntilResponse',
-  full_name='tabletmanagerdata.RunBlpUntilResponse',
+_SETREADONLYWITHTTLRESPONSE = _descriptor.Descriptor(
+  name='SetReadOnlyWithTTLResponse',
+  full_name='tabletmanagerdata.SetReadOnlyWithTTLResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-    _descriptor.FieldDescriptor(
-      name='position', full_name='tabletmanagerdata.RunBlpUntilResponse.position', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
   ],
   extensions=[
   ],
@@ -2236,18 +2499,25 @@ _RUNBLPUNTILRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4043,
-  serialized_end=4082,
+  serialized_start=5060,
+  serialized_end=5088,
 )
 
 
-_RESETREPLICATIONREQUEST = _descriptor.Descriptor(
-  name='ResetReplicationRequest',
-  full_name='tabletmanagerdata.ResetReplicationRequest',
+_SETSUPERREADONLYREQUEST = _descriptor.Descriptor(
+  name='SetSuperReadOnlyRequest',
+  full_name='tabletmanagerdata.SetSuperReadOnlyRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='on', full_name='tabletmanagerdata.SetSuperReadOnlyRequest.on', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -2260,18 +2530,25 @@ _RESETREPLICATIONREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4084,
-  serialized_end=4109,
+  serialized_start=5090,
+  serialized_end=5127,
 )
 
 
-_RESETREPLICATIONRESPONSE = _descriptor.Descriptor(
-  name='ResetReplicationResponse',
-  full_name='tabletmanagerdata.ResetReplicationResponse',
+_SETSUPERREADONLYRESPONSE = _descriptor.Descriptor(
+  name='SetSuperReadOnlyResponse',
+  full_name='tabletmanagerdata.SetSuperReadOnlyResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='super_read_only', full_name='tabletmanagerdata.SetSuperReadOnlyResponse.super_read_only', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -2284,98 +2561,50 @@ _RESETREPLICATIONRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4111,
-  serialized_end=4137,
+  serialized_start=5129,
+  serialized_end=5180,
 )
 
 
-_INITMASTERREQUEST = _descriptor.Descriptor(
-  name='InitMasterRequest',
-  full_name='tabletmanagerdata.InitMasterRequest',
-  filename=None,
-  file=DESCRIPTOR,
-  containing_type=None,
-  fields=[
-  ],
-  extensions=[
-  ],
-  nested_types=[],
-  enum_types=[
-  ],
-  options=None,
-  is_extendable=False,
-  syntax='proto3',
-  extension_ranges=[],
-  oneofs=[
-  ],
-  serialized_start=4139,
-  serialized_end=4158,
-)
-
-
-_INITMASTERRESPONSE = _descriptor.Descriptor(
-  name='InitMasterResponse',
-  full_name='tabletmanagerdata.InitMasterResponse',
+_QUERYSERVERCONFIG = _descriptor.Descriptor(
+  name='QueryServerConfig',
+  full_name='tabletmanagerdata.QueryServerConfig',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='position', full_name='tabletmanagerdata.InitMasterResponse.position', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='pool_size', full_name='tabletmanagerdata.QueryServerConfig.pool_size', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
-  ],
-  extensions=[
-  ],
-  nested_types=[],
-  enum_types=[
-  ],
-  options=None,
-  is_extendable=False,
-  syntax='proto3',
-  extension_ranges=[],
-  oneofs=[
-  ],
-  serialized_start=4160,
-  serialized_end=4198,
-)
-
-
-_POPULATEREPARENTJOURNALREQUEST = _descriptor.Descriptor(
-  name='PopulateReparentJournalRequest',
-  full_name='tabletmanagerdata.PopulateReparentJournalRequest',
-  filename=None,
-  file=DESCRIPTOR,
-  containing_type=None,
-  fields=[
     _descriptor.FieldDescriptor(
-      name='time_created_ns', full_name='tabletmanagerdata.PopulateReparentJournalRequest.time_created_ns', index=0,
-      number=1, type=3, cpp_type=2, label=1,
+      name='stream_pool_size', full_name='tabletmanagerdata.QueryServerConfig.stream_pool_size', index=1,
+      number=2, type=3, cpp_type=2, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
     _descriptor.FieldDescriptor(
-      name='action_name', full_name='tabletmanagerdata.PopulateReparentJournalRequest.action_name', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='transaction_pool_size', full_name='tabletmanagerdata.QueryServerConfig.transaction_pool_size', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
     _descriptor.FieldDescriptor(
-      name='master_alias', full_name='tabletmanagerdata.PopulateReparentJournalRequest.master_alias', index=2,
-      number=3, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='query_timeout_ns', full_name='tabletmanagerdata.QueryServerConfig.query_timeout_ns', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
     _descriptor.FieldDescriptor(
-      name='replication_position', full_name='tabletmanagerdata.PopulateReparentJournalRequest.replication_position', index=3,
-      number=4, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='disable_consolidation', full_name='tabletmanagerdata.QueryServerConfig.disable_consolidation', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -2391,18 +2620,25 @@ _POPULATEREPARENTJOURNALREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4201,
-  serialized_end=4354,
+  serialized_start=5183,
+  serialized_end=5335,
 )
 
 
-_POPULATEREPARENTJOURNALRESPONSE = _descriptor.Descriptor(
-  name='PopulateReparentJournalResponse',
-  full_name='tabletmanagerdata.PopulateReparentJournalResponse',
+_SETQUERYSERVERCONFIGREQUEST = _descriptor.Descriptor(
+  name='SetQueryServerConfigRequest',
+  full_name='tabletmanagerdata.SetQueryServerConfigRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='config', full_name='tabletmanagerdata.SetQueryServerConfigRequest.config', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -2415,36 +2651,29 @@ _POPULATEREPARENTJOURNALRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4356,
-  serialized_end=4389,
+  serialized_start=5337,
+  serialized_end=5420,
 )
 
 
-_INITSLAVEREQUEST = _descriptor.Descriptor(
-  name='InitSlaveRequest',
-  full_name='tabletmanagerdata.InitSlaveRequest',
+_SETQUERYSERVERCONFIGRESPONSE = _descriptor.Descriptor(
+  name='SetQueryServerConfigResponse',
+  full_name='tabletmanagerdata.SetQueryServerConfigResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='parent', full_name='tabletmanagerdata.InitSlaveRequest.parent', index=0,
+      name='applied', full_name='tabletmanagerdata.SetQueryServerConfigResponse.applied', index=0,
       number=1, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
     _descriptor.FieldDescriptor(
-      name='replication_position', full_name='tabletmanagerdata.InitSlaveRequest.replication_position', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
-    _descriptor.FieldDescriptor(
-      name='time_created_ns', full_name='tabletmanagerdata.InitSlaveRequest.time_created_ns', index=2,
-      number=3, type=3, cpp_type=2, label=1,
-      has_default_value=False, default_value=0,
+      name='skipped', full_name='tabletmanagerdata.SetQueryServerConfigResponse.skipped', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -2460,14 +2689,14 @@ _INITSLAVEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4391,
-  serialized_end=4503,
+  serialized_start=5422,
+  serialized_end=5524,
 )
 
 
-_INITSLAVERESPONSE = _descriptor.Descriptor(
-  name='InitSlaveResponse',
-  full_name='tabletmanagerdata.InitSlaveResponse',
+_GETQUERYBLACKLISTREQUEST = _descriptor.Descriptor(
+  name='GetQueryBlacklistRequest',
+  full_name='tabletmanagerdata.GetQueryBlacklistRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -2484,18 +2713,25 @@ _INITSLAVERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4505,
-  serialized_end=4524,
+  serialized_start=5526,
+  serialized_end=5552,
 )
 
 
-_DEMOTEMASTERREQUEST = _descriptor.Descriptor(
-  name='DemoteMasterRequest',
-  full_name='tabletmanagerdata.DemoteMasterRequest',
+_GETQUERYBLACKLISTRESPONSE = _descriptor.Descriptor(
+  name='GetQueryBlacklistResponse',
+  full_name='tabletmanagerdata.GetQueryBlacklistResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='patterns', full_name='tabletmanagerdata.GetQueryBlacklistResponse.patterns', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -2508,22 +2744,22 @@ _DEMOTEMASTERREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4526,
-  serialized_end=4547,
+  serialized_start=5554,
+  serialized_end=5599,
 )
 
 
-_DEMOTEMASTERRESPONSE = _descriptor.Descriptor(
-  name='DemoteMasterResponse',
-  full_name='tabletmanagerdata.DemoteMasterResponse',
+_SETQUERYBLACKLISTREQUEST = _descriptor.Descriptor(
+  name='SetQueryBlacklistRequest',
+  full_name='tabletmanagerdata.SetQueryBlacklistRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='position', full_name='tabletmanagerdata.DemoteMasterResponse.position', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='patterns', full_name='tabletmanagerdata.SetQueryBlacklistRequest.patterns', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -2539,25 +2775,18 @@ _DEMOTEMASTERRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4549,
-  serialized_end=4589,
+  serialized_start=5601,
+  serialized_end=5645,
 )
 
 
-_PROMOTESLAVEWHENCAUGHTUPREQUEST = _descriptor.Descriptor(
-  name='PromoteSlaveWhenCaughtUpRequest',
-  full_name='tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest',
+_SETQUERYBLACKLISTRESPONSE = _descriptor.Descriptor(
+  name='SetQueryBlacklistResponse',
+  full_name='tabletmanagerdata.SetQueryBlacklistResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-    _descriptor.FieldDescriptor(
-      name='position', full_name='tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest.position', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
   ],
   extensions=[
   ],
@@ -2570,22 +2799,29 @@ _PROMOTESLAVEWHENCAUGHTUPREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4591,
-  serialized_end=4642,
+  serialized_start=5647,
+  serialized_end=5674,
 )
 
 
-_PROMOTESLAVEWHENCAUGHTUPRESPONSE = _descriptor.Descriptor(
-  name='PromoteSlaveWhenCaughtUpResponse',
-  full_name='tabletmanagerdata.PromoteSlaveWhenCaughtUpResponse',
+_CHANGETYPEREQUEST = _descriptor.Descriptor(
+  name='ChangeTypeRequest',
+  full_name='tabletmanagerdata.ChangeTypeRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='position', full_name='tabletmanagerdata.PromoteSlaveWhenCaughtUpResponse.position', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='tablet_type', full_name='tabletmanagerdata.ChangeTypeRequest.tablet_type', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='idempotent', full_name='tabletmanagerdata.ChangeTypeRequest.idempotent', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -2601,14 +2837,14 @@ _PROMOTESLAVEWHENCAUGHTUPRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4644,
-  serialized_end=4696,
+  serialized_start=5676,
+  serialized_end=5758,
 )
 
 
-_SLAVEWASPROMOTEDREQUEST = _descriptor.Descriptor(
-  name='SlaveWasPromotedRequest',
-  full_name='tabletmanagerdata.SlaveWasPromotedRequest',
+_CHANGETYPERESPONSE = _descriptor.Descriptor(
+  name='ChangeTypeResponse',
+  full_name='tabletmanagerdata.ChangeTypeResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -2625,67 +2861,66 @@ _SLAVEWASPROMOTEDREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4698,
-  serialized_end=4723,
+  serialized_start=5760,
+  serialized_end=5780,
 )
 
 
-_SLAVEWASPROMOTEDRESPONSE = _descriptor.Descriptor(
-  name='SlaveWasPromotedResponse',
-  full_name='tabletmanagerdata.SlaveWasPromotedResponse',
+_SETTABLETTAGSREQUEST_TAGSENTRY = _descriptor.Descriptor(
+  name='TagsEntry',
+  full_name='tabletmanagerdata.SetTabletTagsRequest.TagsEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='tabletmanagerdata.SetTabletTagsRequest.TagsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='tabletmanagerdata.SetTabletTagsRequest.TagsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  options=None,
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4725,
-  serialized_end=4751,
+  serialized_start=4798,
+  serialized_end=4841,
 )
 
-
-_SETMASTERREQUEST = _descriptor.Descriptor(
-  name='SetMasterRequest',
-  full_name='tabletmanagerdata.SetMasterRequest',
+_SETTABLETTAGSREQUEST = _descriptor.Descriptor(
+  name='SetTabletTagsRequest',
+  full_name='tabletmanagerdata.SetTabletTagsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='parent', full_name='tabletmanagerdata.SetMasterRequest.parent', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
-    _descriptor.FieldDescriptor(
-      name='time_created_ns', full_name='tabletmanagerdata.SetMasterRequest.time_created_ns', index=1,
-      number=2, type=3, cpp_type=2, label=1,
-      has_default_value=False, default_value=0,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
-    _descriptor.FieldDescriptor(
-      name='force_start_slave', full_name='tabletmanagerdata.SetMasterRequest.force_start_slave', index=2,
-      number=3, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
+      name='tags', full_name='tabletmanagerdata.SetTabletTagsRequest.tags', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_SETTABLETTAGSREQUEST_TAGSENTRY, ],
   enum_types=[
   ],
   options=None,
@@ -2694,14 +2929,14 @@ _SETMASTERREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4753,
-  serialized_end=4862,
+  serialized_start=5783,
+  serialized_end=5915,
 )
 
 
-_SETMASTERRESPONSE = _descriptor.Descriptor(
-  name='SetMasterResponse',
-  full_name='tabletmanagerdata.SetMasterResponse',
+_SETTABLETTAGSRESPONSE = _descriptor.Descriptor(
+  name='SetTabletTagsResponse',
+  full_name='tabletmanagerdata.SetTabletTagsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -2718,25 +2953,18 @@ _SETMASTERRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4864,
-  serialized_end=4883,
+  serialized_start=5917,
+  serialized_end=5940,
 )
 
 
-_SLAVEWASRESTARTEDREQUEST = _descriptor.Descriptor(
-  name='SlaveWasRestartedRequest',
-  full_name='tabletmanagerdata.SlaveWasRestartedRequest',
+_REFRESHSTATEREQUEST = _descriptor.Descriptor(
+  name='RefreshStateRequest',
+  full_name='tabletmanagerdata.RefreshStateRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-    _descriptor.FieldDescriptor(
-      name='parent', full_name='tabletmanagerdata.SlaveWasRestartedRequest.parent', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
   ],
   extensions=[
   ],
@@ -2749,14 +2977,14 @@ _SLAVEWASRESTARTEDREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4885,
-  serialized_end=4950,
+  serialized_start=5942,
+  serialized_end=5963,
 )
 
 
-_SLAVEWASRESTARTEDRESPONSE = _descriptor.Descriptor(
-  name='SlaveWasRestartedResponse',
-  full_name='tabletmanagerdata.SlaveWasRestartedResponse',
+_REFRESHSTATERESPONSE = _descriptor.Descriptor(
+  name='RefreshStateResponse',
+  full_name='tabletmanagerdata.RefreshStateResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -2773,14 +3001,14 @@ _SLAVEWASRESTARTEDRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4952,
-  serialized_end=4979,
+  serialized_start=5965,
+  serialized_end=5987,
 )
 
 
-_STOPREPLICATIONANDGETSTATUSREQUEST = _descriptor.Descriptor(
-  name='StopReplicationAndGetStatusRequest',
-  full_name='tabletmanagerdata.StopReplicationAndGetStatusRequest',
+_REPUBLISHTOPORECORDREQUEST = _descriptor.Descriptor(
+  name='RepublishTopoRecordRequest',
+  full_name='tabletmanagerdata.RepublishTopoRecordRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -2797,22 +3025,22 @@ _STOPREPLICATIONANDGETSTATUSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4981,
-  serialized_end=5017,
+  serialized_start=5989,
+  serialized_end=6017,
 )
 
 
-_STOPREPLICATIONANDGETSTATUSRESPONSE = _descriptor.Descriptor(
-  name='StopReplicationAndGetStatusResponse',
-  full_name='tabletmanagerdata.StopReplicationAndGetStatusResponse',
+_REPUBLISHTOPORECORDRESPONSE = _descriptor.Descriptor(
+  name='RepublishTopoRecordResponse',
+  full_name='tabletmanagerdata.RepublishTopoRecordResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='status', full_name='tabletmanagerdata.StopReplicationAndGetStatusResponse.status', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='version', full_name='tabletmanagerdata.RepublishTopoRecordResponse.version', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -2828,14 +3056,14 @@ _STOPREPLICATIONANDGETSTATUSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5019,
-  serialized_end=5097,
+  serialized_start=6019,
+  serialized_end=6065,
 )
 
 
-_PROMOTESLAVEREQUEST = _descriptor.Descriptor(
-  name='PromoteSlaveRequest',
-  full_name='tabletmanagerdata.PromoteSlaveRequest',
+_RUNHEALTHCHECKREQUEST = _descriptor.Descriptor(
+  name='RunHealthCheckRequest',
+  full_name='tabletmanagerdata.RunHealthCheckRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -2852,25 +3080,18 @@ _PROMOTESLAVEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5099,
-  serialized_end=5120,
+  serialized_start=6067,
+  serialized_end=6090,
 )
 
 
-_PROMOTESLAVERESPONSE = _descriptor.Descriptor(
-  name='PromoteSlaveResponse',
-  full_name='tabletmanagerdata.PromoteSlaveResponse',
+_RUNHEALTHCHECKRESPONSE = _descriptor.Descriptor(
+  name='RunHealthCheckResponse',
+  full_name='tabletmanagerdata.RunHealthCheckResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-    _descriptor.FieldDescriptor(
-      name='position', full_name='tabletmanagerdata.PromoteSlaveResponse.position', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
   ],
   extensions=[
   ],
@@ -2883,22 +3104,22 @@ _PROMOTESLAVERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5122,
-  serialized_end=5162,
+  serialized_start=6092,
+  serialized_end=6116,
 )
 
 
-_BACKUPREQUEST = _descriptor.Descriptor(
-  name='BackupRequest',
-  full_name='tabletmanagerdata.BackupRequest',
+_IGNOREHEALTHERRORREQUEST = _descriptor.Descriptor(
+  name='IgnoreHealthErrorRequest',
+  full_name='tabletmanagerdata.IgnoreHealthErrorRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='concurrency', full_name='tabletmanagerdata.BackupRequest.concurrency', index=0,
-      number=1, type=3, cpp_type=2, label=1,
-      has_default_value=False, default_value=0,
+      name='pattern', full_name='tabletmanagerdata.IgnoreHealthErrorRequest.pattern', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -2914,25 +3135,18 @@ _BACKUPREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5164,
-  serialized_end=5200,
+  serialized_start=6118,
+  serialized_end=6161,
 )
 
 
-_BACKUPRESPONSE = _descriptor.Descriptor(
-  name='BackupResponse',
-  full_name='tabletmanagerdata.BackupResponse',
+_IGNOREHEALTHERRORRESPONSE = _descriptor.Descriptor(
+  name='IgnoreHealthErrorResponse',
+  full_name='tabletmanagerdata.IgnoreHealthErrorResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-    _descriptor.FieldDescriptor(
-      name='event', full_name='tabletmanagerdata.BackupResponse.event', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None),
   ],
   extensions=[
   ],
@@ -2945,49 +3159,314 @@ _BACKUPRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5202,
-  serialized_end=5249,
+  serialized_start=6163,
+  serialized_end=6190,
 )
 
 
-_RESTOREFROMBACKUPREQUEST = _descriptor.Descriptor(
-  name='RestoreFromBackupRequest',
-  full_name='tabletmanagerdata.RestoreFromBackupRequest',
+_SETMAINTENANCEMODEREQUEST = _descriptor.Descriptor(
+  name='SetMaintenanceModeRequest',
+  full_name='tabletmanagerdata.SetMaintenanceModeRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-  ],
-  extensions=[
-  ],
-  nested_types=[],
-  enum_types=[
-  ],
+    _descriptor.FieldDescriptor(
+      name='on', full_name='tabletmanagerdata.SetMaintenanceModeRequest.on', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='reason', full_name='tabletmanagerdata.SetMaintenanceModeRequest.reason', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
   options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5251,
-  serialized_end=5277,
+  serialized_start=6192,
+  serialized_end=6247,
 )
 
 
-_RESTOREFROMBACKUPRESPONSE = _descriptor.Descriptor(
-  name='RestoreFromBackupResponse',
-  full_name='tabletmanagerdata.RestoreFromBackupResponse',
+_SETMAINTENANCEMODERESPONSE = _descriptor.Descriptor(
+  name='SetMaintenanceModeResponse',
+  full_name='tabletmanagerdata.SetMaintenanceModeResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6249,
+  serialized_end=6277,
+)
+
+
+_PAUSEHEALTHREPORTINGREQUEST = _descriptor.Descriptor(
+  name='PauseHealthReportingRequest',
+  full_name='tabletmanagerdata.PauseHealthReportingRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='event', full_name='tabletmanagerdata.RestoreFromBackupResponse.event', index=0,
+      name='on', full_name='tabletmanagerdata.PauseHealthReportingRequest.on', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6279,
+  serialized_end=6320,
+)
+
+
+_PAUSEHEALTHREPORTINGRESPONSE = _descriptor.Descriptor(
+  name='PauseHealthReportingResponse',
+  full_name='tabletmanagerdata.PauseHealthReportingResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6322,
+  serialized_end=6352,
+)
+
+
+_PREPARECUTOVERREQUEST = _descriptor.Descriptor(
+  name='PrepareCutoverRequest',
+  full_name='tabletmanagerdata.PrepareCutoverRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6354,
+  serialized_end=6377,
+)
+
+
+_PREPARECUTOVERRESPONSE = _descriptor.Descriptor(
+  name='PrepareCutoverResponse',
+  full_name='tabletmanagerdata.PrepareCutoverResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='token', full_name='tabletmanagerdata.PrepareCutoverResponse.token', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6379,
+  serialized_end=6418,
+)
+
+
+_COMMITCUTOVERREQUEST = _descriptor.Descriptor(
+  name='CommitCutoverRequest',
+  full_name='tabletmanagerdata.CommitCutoverRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='token', full_name='tabletmanagerdata.CommitCutoverRequest.token', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6420,
+  serialized_end=6457,
+)
+
+
+_COMMITCUTOVERRESPONSE = _descriptor.Descriptor(
+  name='CommitCutoverResponse',
+  full_name='tabletmanagerdata.CommitCutoverResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6459,
+  serialized_end=6482,
+)
+
+
+_ABORTCUTOVERREQUEST = _descriptor.Descriptor(
+  name='AbortCutoverRequest',
+  full_name='tabletmanagerdata.AbortCutoverRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='token', full_name='tabletmanagerdata.AbortCutoverRequest.token', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6484,
+  serialized_end=6520,
+)
+
+
+_ABORTCUTOVERRESPONSE = _descriptor.Descriptor(
+  name='AbortCutoverResponse',
+  full_name='tabletmanagerdata.AbortCutoverResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6522,
+  serialized_end=6544,
+)
+
+
+_SETSERVINGKEYRANGEREQUEST = _descriptor.Descriptor(
+  name='SetServingKeyRangeRequest',
+  full_name='tabletmanagerdata.SetServingKeyRangeRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key_range', full_name='tabletmanagerdata.SetServingKeyRangeRequest.key_range', index=0,
       number=1, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='served_types', full_name='tabletmanagerdata.SetServingKeyRangeRequest.served_types', index=1,
+      number=2, type=14, cpp_type=8, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -3000,46 +3479,6843 @@ _RESTOREFROMBACKUPRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5279,
-  serialized_end=5337,
+  serialized_start=6546,
+  serialized_end=6656,
 )
 
-_SCHEMADEFINITION.fields_by_name['table_definitions'].message_type = _TABLEDEFINITION
-_SCHEMACHANGERESULT.fields_by_name['before_schema'].message_type = _SCHEMADEFINITION
-_SCHEMACHANGERESULT.fields_by_name['after_schema'].message_type = _SCHEMADEFINITION
-_USERPERMISSION_PRIVILEGESENTRY.containing_type = _USERPERMISSION
-_USERPERMISSION.fields_by_name['privileges'].message_type = _USERPERMISSION_PRIVILEGESENTRY
-_DBPERMISSION_PRIVILEGESENTRY.containing_type = _DBPERMISSION
-_DBPERMISSION.fields_by_name['privileges'].message_type = _DBPERMISSION_PRIVILEGESENTRY
-_PERMISSIONS.fields_by_name['user_permissions'].message_type = _USERPERMISSION
-_PERMISSIONS.fields_by_name['db_permissions'].message_type = _DBPERMISSION
-_EXECUTEHOOKREQUEST_EXTRAENVENTRY.containing_type = _EXECUTEHOOKREQUEST
-_EXECUTEHOOKREQUEST.fields_by_name['extra_env'].message_type = _EXECUTEHOOKREQUEST_EXTRAENVENTRY
-_GETSCHEMARESPONSE.fields_by_name['schema_definition'].message_type = _SCHEMADEFINITION
-_GETPERMISSIONSRESPONSE.fields_by_name['permissions'].message_type = _PERMISSIONS
-_CHANGETYPEREQUEST.fields_by_name['tablet_type'].enum_type = topodata__pb2._TABLETTYPE
-_PREFLIGHTSCHEMARESPONSE.fields_by_name['change_results'].message_type = _SCHEMACHANGERESULT
-_APPLYSCHEMAREQUEST.fields_by_name['before_schema'].message_type = _SCHEMADEFINITION
-_APPLYSCHEMAREQUEST.fields_by_name['after_schema'].message_type = _SCHEMADEFINITION
-_APPLYSCHEMARESPONSE.fields_by_name['before_schema'].message_type = _SCHEMADEFINITION
-_APPLYSCHEMARESPONSE.fields_by_name['after_schema'].message_type = _SCHEMADEFINITION
-_EXECUTEFETCHASDBARESPONSE.fields_by_name['result'].message_type = query__pb2._QUERYRESULT
-_EXECUTEFETCHASALLPRIVSRESPONSE.fields_by_name['result'].message_type = query__pb2._QUERYRESULT
-_EXECUTEFETCHASAPPRESPONSE.fields_by_name['result'].message_type = query__pb2._QUERYRESULT
-_SLAVESTATUSRESPONSE.fields_by_name['status'].message_type = replicationdata__pb2._STATUS
-_WAITBLPPOSITIONREQUEST.fields_by_name['blp_position'].message_type = _BLPPOSITION
-_STOPBLPRESPONSE.fields_by_name['blp_positions'].message_type = _BLPPOSITION
-_RUNBLPUNTILREQUEST.fields_by_name['blp_positions'].message_type = _BLPPOSITION
-_POPULATEREPARENTJOURNALREQUEST.fields_by_name['master_alias'].message_type = topodata__pb2._TABLETALIAS
-_INITSLAVEREQUEST.fields_by_name['parent'].message_type = topodata__pb2._TABLETALIAS
-_SETMASTERREQUEST.fields_by_name['parent'].message_type = topodata__pb2._TABLETALIAS
-_SLAVEWASRESTARTEDREQUEST.fields_by_name['parent'].message_type = topodata__pb2._TABLETALIAS
-_STOPREPLICATIONANDGETSTATUSRESPONSE.fields_by_name['status'].message_type = replicationdata__pb2._STATUS
-_BACKUPRESPONSE.fields_by_name['event'].message_type = logutil__pb2._EVENT
-_RESTOREFROMBACKUPRESPONSE.fields_by_name['event'].message_type = logutil__pb2._EVENT
-DESCRIPTOR.message_types_by_name['TableDefinition'] = _TABLEDEFINITION
-DESCRIPTOR.message_types_by_name['SchemaDefinition'] = _SCHEMADEFINITION
-DESCRIPTOR.message_types_by_name['SchemaChangeResult'] = _SCHEMACHANGERESULT
+
+_SETSERVINGKEYRANGERESPONSE = _descriptor.Descriptor(
+  name='SetServingKeyRangeResponse',
+  full_name='tabletmanagerdata.SetServingKeyRangeResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6658,
+  serialized_end=6686,
+)
+
+
+_RESTARTMYSQLREQUEST = _descriptor.Descriptor(
+  name='RestartMysqlRequest',
+  full_name='tabletmanagerdata.RestartMysqlRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='healthy_timeout_ns', full_name='tabletmanagerdata.RestartMysqlRequest.healthy_timeout_ns', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6688,
+  serialized_end=6737,
+)
+
+
+_RESTARTMYSQLRESPONSE = _descriptor.Descriptor(
+  name='RestartMysqlResponse',
+  full_name='tabletmanagerdata.RestartMysqlResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='event', full_name='tabletmanagerdata.RestartMysqlResponse.event', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6739,
+  serialized_end=6792,
+)
+
+
+_DECOMMISSIONREQUEST = _descriptor.Descriptor(
+  name='DecommissionRequest',
+  full_name='tabletmanagerdata.DecommissionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='stop_replication', full_name='tabletmanagerdata.DecommissionRequest.stop_replication', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='stop_mysqld', full_name='tabletmanagerdata.DecommissionRequest.stop_mysqld', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6794,
+  serialized_end=6862,
+)
+
+
+_DECOMMISSIONRESPONSE = _descriptor.Descriptor(
+  name='DecommissionResponse',
+  full_name='tabletmanagerdata.DecommissionResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='event', full_name='tabletmanagerdata.DecommissionResponse.event', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6864,
+  serialized_end=6917,
+)
+
+
+_CHECKTOPOCONNECTIVITYREQUEST = _descriptor.Descriptor(
+  name='CheckTopoConnectivityRequest',
+  full_name='tabletmanagerdata.CheckTopoConnectivityRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6919,
+  serialized_end=6949,
+)
+
+
+_CHECKTOPOCONNECTIVITYRESPONSE = _descriptor.Descriptor(
+  name='CheckTopoConnectivityResponse',
+  full_name='tabletmanagerdata.CheckTopoConnectivityResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='reachable', full_name='tabletmanagerdata.CheckTopoConnectivityResponse.reachable', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='latency_ns', full_name='tabletmanagerdata.CheckTopoConnectivityResponse.latency_ns', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6951,
+  serialized_end=7021,
+)
+
+
+_WARMUPREQUEST = _descriptor.Descriptor(
+  name='WarmUpRequest',
+  full_name='tabletmanagerdata.WarmUpRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='queries', full_name='tabletmanagerdata.WarmUpRequest.queries', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='load_buffer_pool', full_name='tabletmanagerdata.WarmUpRequest.load_buffer_pool', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7023,
+  serialized_end=7081,
+)
+
+
+_WARMUPRESPONSE = _descriptor.Descriptor(
+  name='WarmUpResponse',
+  full_name='tabletmanagerdata.WarmUpResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='queries_run', full_name='tabletmanagerdata.WarmUpResponse.queries_run', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='queries_total', full_name='tabletmanagerdata.WarmUpResponse.queries_total', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='buffer_pool_fill', full_name='tabletmanagerdata.WarmUpResponse.buffer_pool_fill', index=2,
+      number=3, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='done', full_name='tabletmanagerdata.WarmUpResponse.done', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7083,
+  serialized_end=7183,
+)
+
+
+_RELOADSCHEMAREQUEST = _descriptor.Descriptor(
+  name='ReloadSchemaRequest',
+  full_name='tabletmanagerdata.ReloadSchemaRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='wait_position', full_name='tabletmanagerdata.ReloadSchemaRequest.wait_position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7185,
+  serialized_end=7229,
+)
+
+
+_RELOADSCHEMARESPONSE = _descriptor.Descriptor(
+  name='ReloadSchemaResponse',
+  full_name='tabletmanagerdata.ReloadSchemaResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7231,
+  serialized_end=7253,
+)
+
+
+_PREFLIGHTSCHEMAREQUEST = _descriptor.Descriptor(
+  name='PreflightSchemaRequest',
+  full_name='tabletmanagerdata.PreflightSchemaRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='changes', full_name='tabletmanagerdata.PreflightSchemaRequest.changes', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7255,
+  serialized_end=7296,
+)
+
+
+_PREFLIGHTSCHEMARESPONSE = _descriptor.Descriptor(
+  name='PreflightSchemaResponse',
+  full_name='tabletmanagerdata.PreflightSchemaResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='change_results', full_name='tabletmanagerdata.PreflightSchemaResponse.change_results', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7298,
+  serialized_end=7386,
+)
+
+
+_APPLYSCHEMAREQUEST = _descriptor.Descriptor(
+  name='ApplySchemaRequest',
+  full_name='tabletmanagerdata.ApplySchemaRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sql', full_name='tabletmanagerdata.ApplySchemaRequest.sql', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='force', full_name='tabletmanagerdata.ApplySchemaRequest.force', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='allow_replication', full_name='tabletmanagerdata.ApplySchemaRequest.allow_replication', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='before_schema', full_name='tabletmanagerdata.ApplySchemaRequest.before_schema', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='after_schema', full_name='tabletmanagerdata.ApplySchemaRequest.after_schema', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7389,
+  serialized_end=7583,
+)
+
+
+_APPLYSCHEMARESPONSE = _descriptor.Descriptor(
+  name='ApplySchemaResponse',
+  full_name='tabletmanagerdata.ApplySchemaResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='before_schema', full_name='tabletmanagerdata.ApplySchemaResponse.before_schema', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='after_schema', full_name='tabletmanagerdata.ApplySchemaResponse.after_schema', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7586,
+  serialized_end=7726,
+)
+
+
+_SCHEMADIFFREQUEST = _descriptor.Descriptor(
+  name='SchemaDiffRequest',
+  full_name='tabletmanagerdata.SchemaDiffRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='desired', full_name='tabletmanagerdata.SchemaDiffRequest.desired', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7728,
+  serialized_end=7801,
+)
+
+
+_SCHEMADIFFRESPONSE = _descriptor.Descriptor(
+  name='SchemaDiffResponse',
+  full_name='tabletmanagerdata.SchemaDiffResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='statements', full_name='tabletmanagerdata.SchemaDiffResponse.statements', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7803,
+  serialized_end=7843,
+)
+
+
+_SCHEMACHANGEASSESSMENT = _descriptor.Descriptor(
+  name='SchemaChangeAssessment',
+  full_name='tabletmanagerdata.SchemaChangeAssessment',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='table', full_name='tabletmanagerdata.SchemaChangeAssessment.table', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='algorithm', full_name='tabletmanagerdata.SchemaChangeAssessment.algorithm', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='table_size_bytes', full_name='tabletmanagerdata.SchemaChangeAssessment.table_size_bytes', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='estimated_lock_ns', full_name='tabletmanagerdata.SchemaChangeAssessment.estimated_lock_ns', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7845,
+  serialized_end=7956,
+)
+
+
+_ASSESSSCHEMACHANGEREQUEST = _descriptor.Descriptor(
+  name='AssessSchemaChangeRequest',
+  full_name='tabletmanagerdata.AssessSchemaChangeRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='change', full_name='tabletmanagerdata.AssessSchemaChangeRequest.change', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7958,
+  serialized_end=8001,
+)
+
+
+_ASSESSSCHEMACHANGERESPONSE = _descriptor.Descriptor(
+  name='AssessSchemaChangeResponse',
+  full_name='tabletmanagerdata.AssessSchemaChangeResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='assessment', full_name='tabletmanagerdata.AssessSchemaChangeResponse.assessment', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8003,
+  serialized_end=8094,
+)
+
+
+_SCHEMACHANGEEVENT = _descriptor.Descriptor(
+  name='SchemaChangeEvent',
+  full_name='tabletmanagerdata.SchemaChangeEvent',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='tabletmanagerdata.SchemaChangeEvent.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='definition', full_name='tabletmanagerdata.SchemaChangeEvent.definition', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8096,
+  serialized_end=8185,
+)
+
+
+_WATCHSCHEMAREQUEST = _descriptor.Descriptor(
+  name='WatchSchemaRequest',
+  full_name='tabletmanagerdata.WatchSchemaRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8187,
+  serialized_end=8207,
+)
+
+
+_WATCHSCHEMARESPONSE = _descriptor.Descriptor(
+  name='WatchSchemaResponse',
+  full_name='tabletmanagerdata.WatchSchemaResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='event', full_name='tabletmanagerdata.WatchSchemaResponse.event', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8209,
+  serialized_end=8283,
+)
+
+
+_GETVSCHEMAREQUEST = _descriptor.Descriptor(
+  name='GetVSchemaRequest',
+  full_name='tabletmanagerdata.GetVSchemaRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8285,
+  serialized_end=8304,
+)
+
+
+_GETVSCHEMARESPONSE = _descriptor.Descriptor(
+  name='GetVSchemaResponse',
+  full_name='tabletmanagerdata.GetVSchemaResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='vschema', full_name='tabletmanagerdata.GetVSchemaResponse.vschema', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8306,
+  serialized_end=8362,
+)
+
+
+_APPLYVSCHEMAREQUEST = _descriptor.Descriptor(
+  name='ApplyVSchemaRequest',
+  full_name='tabletmanagerdata.ApplyVSchemaRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='vschema', full_name='tabletmanagerdata.ApplyVSchemaRequest.vschema', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8364,
+  serialized_end=8402,
+)
+
+
+_APPLYVSCHEMARESPONSE = _descriptor.Descriptor(
+  name='ApplyVSchemaResponse',
+  full_name='tabletmanagerdata.ApplyVSchemaResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8404,
+  serialized_end=8426,
+)
+
+
+_EXECUTEFETCHASDBAREQUEST = _descriptor.Descriptor(
+  name='ExecuteFetchAsDbaRequest',
+  full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='query', full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest.query', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='db_name', full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest.db_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='max_rows', full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest.max_rows', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='disable_binlogs', full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest.disable_binlogs', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='reload_schema', full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest.reload_schema', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='max_exec_time_ns', full_name='tabletmanagerdata.ExecuteFetchAsDbaRequest.max_exec_time_ns', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8429,
+  serialized_end=8579,
+)
+
+
+_EXECUTEFETCHASDBARESPONSE = _descriptor.Descriptor(
+  name='ExecuteFetchAsDbaResponse',
+  full_name='tabletmanagerdata.ExecuteFetchAsDbaResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='result', full_name='tabletmanagerdata.ExecuteFetchAsDbaResponse.result', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8581,
+  serialized_end=8644,
+)
+
+
+_EXECUTEFETCHASDBACSVREQUEST = _descriptor.Descriptor(
+  name='ExecuteFetchAsDbaCSVRequest',
+  full_name='tabletmanagerdata.ExecuteFetchAsDbaCSVRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='query', full_name='tabletmanagerdata.ExecuteFetchAsDbaCSVRequest.query', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='db_name', full_name='tabletmanagerdata.ExecuteFetchAsDbaCSVRequest.db_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8646,
+  serialized_end=8707,
+)
+
+
+_EXECUTEFETCHASDBACSVRESPONSE = _descriptor.Descriptor(
+  name='ExecuteFetchAsDbaCSVResponse',
+  full_name='tabletmanagerdata.ExecuteFetchAsDbaCSVResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='data', full_name='tabletmanagerdata.ExecuteFetchAsDbaCSVResponse.data', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8709,
+  serialized_end=8753,
+)
+
+
+_COLUMNARRESULT = _descriptor.Descriptor(
+  name='ColumnarResult',
+  full_name='tabletmanagerdata.ColumnarResult',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='fields', full_name='tabletmanagerdata.ColumnarResult.fields', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='rows_affected', full_name='tabletmanagerdata.ColumnarResult.rows_affected', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='insert_id', full_name='tabletmanagerdata.ColumnarResult.insert_id', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='row_count', full_name='tabletmanagerdata.ColumnarResult.row_count', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='columns', full_name='tabletmanagerdata.ColumnarResult.columns', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8756,
+  serialized_end=8892,
+)
+
+
+_EXECUTEFETCHCOLUMNARREQUEST = _descriptor.Descriptor(
+  name='ExecuteFetchColumnarRequest',
+  full_name='tabletmanagerdata.ExecuteFetchColumnarRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='query', full_name='tabletmanagerdata.ExecuteFetchColumnarRequest.query', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='db_name', full_name='tabletmanagerdata.ExecuteFetchColumnarRequest.db_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='max_rows', full_name='tabletmanagerdata.ExecuteFetchColumnarRequest.max_rows', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8894,
+  serialized_end=8973,
+)
+
+
+_EXECUTEFETCHCOLUMNARRESPONSE = _descriptor.Descriptor(
+  name='ExecuteFetchColumnarResponse',
+  full_name='tabletmanagerdata.ExecuteFetchColumnarResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='result', full_name='tabletmanagerdata.ExecuteFetchColumnarResponse.result', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=8975,
+  serialized_end=9056,
+)
+
+
+_EXECUTEFETCHASALLPRIVSREQUEST = _descriptor.Descriptor(
+  name='ExecuteFetchAsAllPrivsRequest',
+  full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='query', full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsRequest.query', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='db_name', full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsRequest.db_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='max_rows', full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsRequest.max_rows', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='reload_schema', full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsRequest.reload_schema', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9058,
+  serialized_end=9162,
+)
+
+
+_EXECUTEFETCHASALLPRIVSRESPONSE = _descriptor.Descriptor(
+  name='ExecuteFetchAsAllPrivsResponse',
+  full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='result', full_name='tabletmanagerdata.ExecuteFetchAsAllPrivsResponse.result', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9164,
+  serialized_end=9232,
+)
+
+
+_EXECUTEFETCHASAPPREQUEST = _descriptor.Descriptor(
+  name='ExecuteFetchAsAppRequest',
+  full_name='tabletmanagerdata.ExecuteFetchAsAppRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='query', full_name='tabletmanagerdata.ExecuteFetchAsAppRequest.query', index=0,
+      number=1, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='max_rows', full_name='tabletmanagerdata.ExecuteFetchAsAppRequest.max_rows', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9234,
+  serialized_end=9293,
+)
+
+
+_EXECUTEFETCHASAPPRESPONSE = _descriptor.Descriptor(
+  name='ExecuteFetchAsAppResponse',
+  full_name='tabletmanagerdata.ExecuteFetchAsAppResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='result', full_name='tabletmanagerdata.ExecuteFetchAsAppResponse.result', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9295,
+  serialized_end=9358,
+)
+
+
+_EXPLAINQUERYREQUEST = _descriptor.Descriptor(
+  name='ExplainQueryRequest',
+  full_name='tabletmanagerdata.ExplainQueryRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='query', full_name='tabletmanagerdata.ExplainQueryRequest.query', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='format', full_name='tabletmanagerdata.ExplainQueryRequest.format', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9360,
+  serialized_end=9412,
+)
+
+
+_EXPLAINQUERYRESPONSE = _descriptor.Descriptor(
+  name='ExplainQueryResponse',
+  full_name='tabletmanagerdata.ExplainQueryResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='result', full_name='tabletmanagerdata.ExplainQueryResponse.result', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9414,
+  serialized_end=9472,
+)
+
+
+_APPLYGRANTSREQUEST = _descriptor.Descriptor(
+  name='ApplyGrantsRequest',
+  full_name='tabletmanagerdata.ApplyGrantsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='statements', full_name='tabletmanagerdata.ApplyGrantsRequest.statements', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='atomic', full_name='tabletmanagerdata.ApplyGrantsRequest.atomic', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9474,
+  serialized_end=9530,
+)
+
+
+_APPLYGRANTSRESPONSE = _descriptor.Descriptor(
+  name='ApplyGrantsResponse',
+  full_name='tabletmanagerdata.ApplyGrantsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9532,
+  serialized_end=9553,
+)
+
+
+_CHECKSUMTABLEREQUEST = _descriptor.Descriptor(
+  name='ChecksumTableRequest',
+  full_name='tabletmanagerdata.ChecksumTableRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='table', full_name='tabletmanagerdata.ChecksumTableRequest.table', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='key_range', full_name='tabletmanagerdata.ChecksumTableRequest.key_range', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9555,
+  serialized_end=9631,
+)
+
+
+_CHECKSUMTABLERESPONSE = _descriptor.Descriptor(
+  name='ChecksumTableResponse',
+  full_name='tabletmanagerdata.ChecksumTableResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='checksum', full_name='tabletmanagerdata.ChecksumTableResponse.checksum', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='row_count', full_name='tabletmanagerdata.ChecksumTableResponse.row_count', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9633,
+  serialized_end=9693,
+)
+
+
+_TRUNCATETABLEREQUEST = _descriptor.Descriptor(
+  name='TruncateTableRequest',
+  full_name='tabletmanagerdata.TruncateTableRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='table', full_name='tabletmanagerdata.TruncateTableRequest.table', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='confirm_keyspace', full_name='tabletmanagerdata.TruncateTableRequest.confirm_keyspace', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9695,
+  serialized_end=9758,
+)
+
+
+_TRUNCATETABLERESPONSE = _descriptor.Descriptor(
+  name='TruncateTableResponse',
+  full_name='tabletmanagerdata.TruncateTableResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9760,
+  serialized_end=9783,
+)
+
+
+_RENAMETABLEREQUEST = _descriptor.Descriptor(
+  name='RenameTableRequest',
+  full_name='tabletmanagerdata.RenameTableRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='from_table', full_name='tabletmanagerdata.RenameTableRequest.from_table', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='to_table', full_name='tabletmanagerdata.RenameTableRequest.to_table', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='cascade', full_name='tabletmanagerdata.RenameTableRequest.cascade', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9785,
+  serialized_end=9860,
+)
+
+
+_RENAMETABLERESULT = _descriptor.Descriptor(
+  name='RenameTableResult',
+  full_name='tabletmanagerdata.RenameTableResult',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='dependent_views', full_name='tabletmanagerdata.RenameTableResult.dependent_views', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='dependent_foreign_keys', full_name='tabletmanagerdata.RenameTableResult.dependent_foreign_keys', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='renamed', full_name='tabletmanagerdata.RenameTableResult.renamed', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9862,
+  serialized_end=9955,
+)
+
+
+_RENAMETABLERESPONSE = _descriptor.Descriptor(
+  name='RenameTableResponse',
+  full_name='tabletmanagerdata.RenameTableResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='result', full_name='tabletmanagerdata.RenameTableResponse.result', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9957,
+  serialized_end=10032,
+)
+
+
+_STREAMROWSINKEYRANGEREQUEST = _descriptor.Descriptor(
+  name='StreamRowsInKeyRangeRequest',
+  full_name='tabletmanagerdata.StreamRowsInKeyRangeRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='table', full_name='tabletmanagerdata.StreamRowsInKeyRangeRequest.table', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='key_range', full_name='tabletmanagerdata.StreamRowsInKeyRangeRequest.key_range', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10034,
+  serialized_end=10117,
+)
+
+
+_STREAMROWSINKEYRANGERESPONSE = _descriptor.Descriptor(
+  name='StreamRowsInKeyRangeResponse',
+  full_name='tabletmanagerdata.StreamRowsInKeyRangeResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='result', full_name='tabletmanagerdata.StreamRowsInKeyRangeResponse.result', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10119,
+  serialized_end=10185,
+)
+
+
+_STREAMKEYRANGEBINLOGREQUEST = _descriptor.Descriptor(
+  name='StreamKeyRangeBinlogRequest',
+  full_name='tabletmanagerdata.StreamKeyRangeBinlogRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key_range', full_name='tabletmanagerdata.StreamKeyRangeBinlogRequest.key_range', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.StreamKeyRangeBinlogRequest.position', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='charset', full_name='tabletmanagerdata.StreamKeyRangeBinlogRequest.charset', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10187,
+  serialized_end=10311,
+)
+
+
+_STREAMKEYRANGEBINLOGRESPONSE = _descriptor.Descriptor(
+  name='StreamKeyRangeBinlogResponse',
+  full_name='tabletmanagerdata.StreamKeyRangeBinlogResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='binlog_transaction', full_name='tabletmanagerdata.StreamKeyRangeBinlogResponse.binlog_transaction', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10313,
+  serialized_end=10402,
+)
+
+
+_CLONESTREAMREQUEST = _descriptor.Descriptor(
+  name='CloneStreamRequest',
+  full_name='tabletmanagerdata.CloneStreamRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='tables', full_name='tabletmanagerdata.CloneStreamRequest.tables', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='buffer_size', full_name='tabletmanagerdata.CloneStreamRequest.buffer_size', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10404,
+  serialized_end=10461,
+)
+
+
+_CLONESTREAMRESPONSE = _descriptor.Descriptor(
+  name='CloneStreamResponse',
+  full_name='tabletmanagerdata.CloneStreamResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.CloneStreamResponse.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='table', full_name='tabletmanagerdata.CloneStreamResponse.table', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='result', full_name='tabletmanagerdata.CloneStreamResponse.result', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10463,
+  serialized_end=10553,
+)
+
+
+_PROCESS = _descriptor.Descriptor(
+  name='Process',
+  full_name='tabletmanagerdata.Process',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='tabletmanagerdata.Process.id', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='user', full_name='tabletmanagerdata.Process.user', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='host', full_name='tabletmanagerdata.Process.host', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='db', full_name='tabletmanagerdata.Process.db', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='command', full_name='tabletmanagerdata.Process.command', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='time', full_name='tabletmanagerdata.Process.time', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='tabletmanagerdata.Process.state', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='info', full_name='tabletmanagerdata.Process.info', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10555,
+  serialized_end=10676,
+)
+
+
+_GETPROCESSLISTREQUEST = _descriptor.Descriptor(
+  name='GetProcessListRequest',
+  full_name='tabletmanagerdata.GetProcessListRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10678,
+  serialized_end=10701,
+)
+
+
+_GETPROCESSLISTRESPONSE = _descriptor.Descriptor(
+  name='GetProcessListResponse',
+  full_name='tabletmanagerdata.GetProcessListResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='processes', full_name='tabletmanagerdata.GetProcessListResponse.processes', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10703,
+  serialized_end=10774,
+)
+
+
+_CHARSETCONFIG_DATABASECHARSETSENTRY = _descriptor.Descriptor(
+  name='DatabaseCharsetsEntry',
+  full_name='tabletmanagerdata.CharsetConfig.DatabaseCharsetsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='tabletmanagerdata.CharsetConfig.DatabaseCharsetsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='tabletmanagerdata.CharsetConfig.DatabaseCharsetsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11020,
+  serialized_end=11075,
+)
+
+_CHARSETCONFIG_DATABASECOLLATIONSENTRY = _descriptor.Descriptor(
+  name='DatabaseCollationsEntry',
+  full_name='tabletmanagerdata.CharsetConfig.DatabaseCollationsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='tabletmanagerdata.CharsetConfig.DatabaseCollationsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='tabletmanagerdata.CharsetConfig.DatabaseCollationsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11077,
+  serialized_end=11134,
+)
+
+_CHARSETCONFIG = _descriptor.Descriptor(
+  name='CharsetConfig',
+  full_name='tabletmanagerdata.CharsetConfig',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='character_set_server', full_name='tabletmanagerdata.CharsetConfig.character_set_server', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='collation_server', full_name='tabletmanagerdata.CharsetConfig.collation_server', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='database_charsets', full_name='tabletmanagerdata.CharsetConfig.database_charsets', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='database_collations', full_name='tabletmanagerdata.CharsetConfig.database_collations', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[_CHARSETCONFIG_DATABASECHARSETSENTRY, _CHARSETCONFIG_DATABASECOLLATIONSENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10777,
+  serialized_end=11134,
+)
+
+
+_GETCHARSETCONFIGREQUEST = _descriptor.Descriptor(
+  name='GetCharsetConfigRequest',
+  full_name='tabletmanagerdata.GetCharsetConfigRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11136,
+  serialized_end=11161,
+)
+
+
+_GETCHARSETCONFIGRESPONSE = _descriptor.Descriptor(
+  name='GetCharsetConfigResponse',
+  full_name='tabletmanagerdata.GetCharsetConfigResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='config', full_name='tabletmanagerdata.GetCharsetConfigResponse.config', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11163,
+  serialized_end=11239,
+)
+
+
+_KILLPROCESSREQUEST = _descriptor.Descriptor(
+  name='KillProcessRequest',
+  full_name='tabletmanagerdata.KillProcessRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='tabletmanagerdata.KillProcessRequest.id', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11241,
+  serialized_end=11273,
+)
+
+
+_KILLPROCESSRESPONSE = _descriptor.Descriptor(
+  name='KillProcessResponse',
+  full_name='tabletmanagerdata.KillProcessResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11275,
+  serialized_end=11296,
+)
+
+
+_TAILGENERALLOGREQUEST = _descriptor.Descriptor(
+  name='TailGeneralLogRequest',
+  full_name='tabletmanagerdata.TailGeneralLogRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='duration_ns', full_name='tabletmanagerdata.TailGeneralLogRequest.duration_ns', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11298,
+  serialized_end=11342,
+)
+
+
+_TAILGENERALLOGRESPONSE = _descriptor.Descriptor(
+  name='TailGeneralLogResponse',
+  full_name='tabletmanagerdata.TailGeneralLogResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='entry', full_name='tabletmanagerdata.TailGeneralLogResponse.entry', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11344,
+  serialized_end=11383,
+)
+
+
+_QPSSAMPLE = _descriptor.Descriptor(
+  name='QPSSample',
+  full_name='tabletmanagerdata.QPSSample',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='timestamp_ns', full_name='tabletmanagerdata.QPSSample.timestamp_ns', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='interval_ns', full_name='tabletmanagerdata.QPSSample.interval_ns', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='read_qps', full_name='tabletmanagerdata.QPSSample.read_qps', index=2,
+      number=3, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='write_qps', full_name='tabletmanagerdata.QPSSample.write_qps', index=3,
+      number=4, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='total_qps', full_name='tabletmanagerdata.QPSSample.total_qps', index=4,
+      number=5, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='dropped', full_name='tabletmanagerdata.QPSSample.dropped', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11385,
+  serialized_end=11512,
+)
+
+
+_STREAMQPSREQUEST = _descriptor.Descriptor(
+  name='StreamQPSRequest',
+  full_name='tabletmanagerdata.StreamQPSRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='interval_ns', full_name='tabletmanagerdata.StreamQPSRequest.interval_ns', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11514,
+  serialized_end=11553,
+)
+
+
+_STREAMQPSRESPONSE = _descriptor.Descriptor(
+  name='StreamQPSResponse',
+  full_name='tabletmanagerdata.StreamQPSResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sample', full_name='tabletmanagerdata.StreamQPSResponse.sample', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11555,
+  serialized_end=11620,
+)
+
+
+_SLAVESTATUSREQUEST = _descriptor.Descriptor(
+  name='SlaveStatusRequest',
+  full_name='tabletmanagerdata.SlaveStatusRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11622,
+  serialized_end=11642,
+)
+
+
+_SLAVESTATUSRESPONSE = _descriptor.Descriptor(
+  name='SlaveStatusResponse',
+  full_name='tabletmanagerdata.SlaveStatusResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='status', full_name='tabletmanagerdata.SlaveStatusResponse.status', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11644,
+  serialized_end=11706,
+)
+
+
+_SLAVESTATUSALLCHANNELSREQUEST = _descriptor.Descriptor(
+  name='SlaveStatusAllChannelsRequest',
+  full_name='tabletmanagerdata.SlaveStatusAllChannelsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11708,
+  serialized_end=11739,
+)
+
+
+_SLAVESTATUSALLCHANNELSRESPONSE_STATUSESENTRY = _descriptor.Descriptor(
+  name='StatusesEntry',
+  full_name='tabletmanagerdata.SlaveStatusAllChannelsResponse.StatusesEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='tabletmanagerdata.SlaveStatusAllChannelsResponse.StatusesEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='tabletmanagerdata.SlaveStatusAllChannelsResponse.StatusesEntry.value', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11859,
+  serialized_end=11931,
+)
+
+_SLAVESTATUSALLCHANNELSRESPONSE = _descriptor.Descriptor(
+  name='SlaveStatusAllChannelsResponse',
+  full_name='tabletmanagerdata.SlaveStatusAllChannelsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='statuses', full_name='tabletmanagerdata.SlaveStatusAllChannelsResponse.statuses', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[_SLAVESTATUSALLCHANNELSRESPONSE_STATUSESENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11742,
+  serialized_end=11931,
+)
+
+
+_REPLICATIONSOURCE = _descriptor.Descriptor(
+  name='ReplicationSource',
+  full_name='tabletmanagerdata.ReplicationSource',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='master_host', full_name='tabletmanagerdata.ReplicationSource.master_host', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='master_port', full_name='tabletmanagerdata.ReplicationSource.master_port', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='user', full_name='tabletmanagerdata.ReplicationSource.user', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11933,
+  serialized_end=12008,
+)
+
+
+_GETREPLICATIONSOURCEREQUEST = _descriptor.Descriptor(
+  name='GetReplicationSourceRequest',
+  full_name='tabletmanagerdata.GetReplicationSourceRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12010,
+  serialized_end=12039,
+)
+
+
+_GETREPLICATIONSOURCERESPONSE = _descriptor.Descriptor(
+  name='GetReplicationSourceResponse',
+  full_name='tabletmanagerdata.GetReplicationSourceResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='source', full_name='tabletmanagerdata.GetReplicationSourceResponse.source', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12041,
+  serialized_end=12125,
+)
+
+
+_VALIDATEREPLICATIONCREDENTIALSREQUEST = _descriptor.Descriptor(
+  name='ValidateReplicationCredentialsRequest',
+  full_name='tabletmanagerdata.ValidateReplicationCredentialsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12127,
+  serialized_end=12166,
+)
+
+
+_VALIDATEREPLICATIONCREDENTIALSRESPONSE = _descriptor.Descriptor(
+  name='ValidateReplicationCredentialsResponse',
+  full_name='tabletmanagerdata.ValidateReplicationCredentialsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='valid', full_name='tabletmanagerdata.ValidateReplicationCredentialsResponse.valid', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12168,
+  serialized_end=12223,
+)
+
+
+_APPLIERWORKERSTATS = _descriptor.Descriptor(
+  name='ApplierWorkerStats',
+  full_name='tabletmanagerdata.ApplierWorkerStats',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='worker_id', full_name='tabletmanagerdata.ApplierWorkerStats.worker_id', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='service_state', full_name='tabletmanagerdata.ApplierWorkerStats.service_state', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='thread_state', full_name='tabletmanagerdata.ApplierWorkerStats.thread_state', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='last_seen_transaction', full_name='tabletmanagerdata.ApplierWorkerStats.last_seen_transaction', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12225,
+  serialized_end=12340,
+)
+
+
+_APPLIERQUEUESTATS = _descriptor.Descriptor(
+  name='ApplierQueueStats',
+  full_name='tabletmanagerdata.ApplierQueueStats',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='relay_log_space', full_name='tabletmanagerdata.ApplierQueueStats.relay_log_space', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='coordinator_state', full_name='tabletmanagerdata.ApplierQueueStats.coordinator_state', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='workers', full_name='tabletmanagerdata.ApplierQueueStats.workers', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12342,
+  serialized_end=12469,
+)
+
+
+_GETAPPLIERQUEUESTATSREQUEST = _descriptor.Descriptor(
+  name='GetApplierQueueStatsRequest',
+  full_name='tabletmanagerdata.GetApplierQueueStatsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12471,
+  serialized_end=12500,
+)
+
+
+_GETAPPLIERQUEUESTATSRESPONSE = _descriptor.Descriptor(
+  name='GetApplierQueueStatsResponse',
+  full_name='tabletmanagerdata.GetApplierQueueStatsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='stats', full_name='tabletmanagerdata.GetApplierQueueStatsResponse.stats', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12502,
+  serialized_end=12585,
+)
+
+
+_MASTERPOSITIONREQUEST = _descriptor.Descriptor(
+  name='MasterPositionRequest',
+  full_name='tabletmanagerdata.MasterPositionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12587,
+  serialized_end=12610,
+)
+
+
+_MASTERPOSITIONRESPONSE = _descriptor.Descriptor(
+  name='MasterPositionResponse',
+  full_name='tabletmanagerdata.MasterPositionResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.MasterPositionResponse.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12612,
+  serialized_end=12654,
+)
+
+
+_GETGTIDPURGEDREQUEST = _descriptor.Descriptor(
+  name='GetGtidPurgedRequest',
+  full_name='tabletmanagerdata.GetGtidPurgedRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12656,
+  serialized_end=12678,
+)
+
+
+_GETGTIDPURGEDRESPONSE = _descriptor.Descriptor(
+  name='GetGtidPurgedResponse',
+  full_name='tabletmanagerdata.GetGtidPurgedResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.GetGtidPurgedResponse.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12680,
+  serialized_end=12721,
+)
+
+
+_GETBINLOGSTATSREQUEST = _descriptor.Descriptor(
+  name='GetBinlogStatsRequest',
+  full_name='tabletmanagerdata.GetBinlogStatsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12723,
+  serialized_end=12746,
+)
+
+
+_GETBINLOGSTATSRESPONSE = _descriptor.Descriptor(
+  name='GetBinlogStatsResponse',
+  full_name='tabletmanagerdata.GetBinlogStatsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='transactions_per_second', full_name='tabletmanagerdata.GetBinlogStatsResponse.transactions_per_second', index=0,
+      number=1, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='window_ns', full_name='tabletmanagerdata.GetBinlogStatsResponse.window_ns', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12748,
+  serialized_end=12824,
+)
+
+
+_REPLICATIONERRORSTATS = _descriptor.Descriptor(
+  name='ReplicationErrorStats',
+  full_name='tabletmanagerdata.ReplicationErrorStats',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='io_errors', full_name='tabletmanagerdata.ReplicationErrorStats.io_errors', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='sql_errors', full_name='tabletmanagerdata.ReplicationErrorStats.sql_errors', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='reconnects', full_name='tabletmanagerdata.ReplicationErrorStats.reconnects', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12826,
+  serialized_end=12908,
+)
+
+
+_GETREPLICATIONERRORSTATSREQUEST = _descriptor.Descriptor(
+  name='GetReplicationErrorStatsRequest',
+  full_name='tabletmanagerdata.GetReplicationErrorStatsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='reset_counts', full_name='tabletmanagerdata.GetReplicationErrorStatsRequest.reset_counts', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12910,
+  serialized_end=12965,
+)
+
+
+_GETREPLICATIONERRORSTATSRESPONSE = _descriptor.Descriptor(
+  name='GetReplicationErrorStatsResponse',
+  full_name='tabletmanagerdata.GetReplicationErrorStatsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='stats', full_name='tabletmanagerdata.GetReplicationErrorStatsResponse.stats', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12967,
+  serialized_end=13058,
+)
+
+
+_STOPSLAVEREQUEST = _descriptor.Descriptor(
+  name='StopSlaveRequest',
+  full_name='tabletmanagerdata.StopSlaveRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13060,
+  serialized_end=13078,
+)
+
+
+_STOPSLAVERESPONSE = _descriptor.Descriptor(
+  name='StopSlaveResponse',
+  full_name='tabletmanagerdata.StopSlaveResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13080,
+  serialized_end=13099,
+)
+
+
+_STOPSLAVEMINIMUMREQUEST = _descriptor.Descriptor(
+  name='StopSlaveMinimumRequest',
+  full_name='tabletmanagerdata.StopSlaveMinimumRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.StopSlaveMinimumRequest.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='wait_timeout', full_name='tabletmanagerdata.StopSlaveMinimumRequest.wait_timeout', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13101,
+  serialized_end=13166,
+)
+
+
+_STOPSLAVEMINIMUMRESPONSE = _descriptor.Descriptor(
+  name='StopSlaveMinimumResponse',
+  full_name='tabletmanagerdata.StopSlaveMinimumResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.StopSlaveMinimumResponse.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13168,
+  serialized_end=13212,
+)
+
+
+_STOPSLAVEMINIMUMSTREAMREQUEST = _descriptor.Descriptor(
+  name='StopSlaveMinimumStreamRequest',
+  full_name='tabletmanagerdata.StopSlaveMinimumStreamRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.StopSlaveMinimumStreamRequest.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='wait_timeout', full_name='tabletmanagerdata.StopSlaveMinimumStreamRequest.wait_timeout', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13214,
+  serialized_end=13285,
+)
+
+
+_STOPSLAVEMINIMUMSTREAMRESPONSE = _descriptor.Descriptor(
+  name='StopSlaveMinimumStreamResponse',
+  full_name='tabletmanagerdata.StopSlaveMinimumStreamResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.StopSlaveMinimumStreamResponse.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='stopped', full_name='tabletmanagerdata.StopSlaveMinimumStreamResponse.stopped', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13287,
+  serialized_end=13354,
+)
+
+
+_BOOSTREPLICATIONCATCHUPREQUEST = _descriptor.Descriptor(
+  name='BoostReplicationCatchupRequest',
+  full_name='tabletmanagerdata.BoostReplicationCatchupRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='duration', full_name='tabletmanagerdata.BoostReplicationCatchupRequest.duration', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13356,
+  serialized_end=13406,
+)
+
+
+_BOOSTREPLICATIONCATCHUPRESPONSE_SETTINGSENTRY = _descriptor.Descriptor(
+  name='SettingsEntry',
+  full_name='tabletmanagerdata.BoostReplicationCatchupResponse.SettingsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='tabletmanagerdata.BoostReplicationCatchupResponse.SettingsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='tabletmanagerdata.BoostReplicationCatchupResponse.SettingsEntry.value', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13577,
+  serialized_end=13624,
+)
+
+_BOOSTREPLICATIONCATCHUPRESPONSE = _descriptor.Descriptor(
+  name='BoostReplicationCatchupResponse',
+  full_name='tabletmanagerdata.BoostReplicationCatchupResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='seconds_behind_master', full_name='tabletmanagerdata.BoostReplicationCatchupResponse.seconds_behind_master', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='settings', full_name='tabletmanagerdata.BoostReplicationCatchupResponse.settings', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='reverted', full_name='tabletmanagerdata.BoostReplicationCatchupResponse.reverted', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[_BOOSTREPLICATIONCATCHUPRESPONSE_SETTINGSENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13409,
+  serialized_end=13624,
+)
+
+
+_STARTSLAVEREQUEST = _descriptor.Descriptor(
+  name='StartSlaveRequest',
+  full_name='tabletmanagerdata.StartSlaveRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13626,
+  serialized_end=13645,
+)
+
+
+_STARTSLAVERESPONSE = _descriptor.Descriptor(
+  name='StartSlaveResponse',
+  full_name='tabletmanagerdata.StartSlaveResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13647,
+  serialized_end=13667,
+)
+
+
+_ENABLEPARALLELREPLICATIONREQUEST = _descriptor.Descriptor(
+  name='EnableParallelReplicationRequest',
+  full_name='tabletmanagerdata.EnableParallelReplicationRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='workers', full_name='tabletmanagerdata.EnableParallelReplicationRequest.workers', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='parallel_type', full_name='tabletmanagerdata.EnableParallelReplicationRequest.parallel_type', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13669,
+  serialized_end=13743,
+)
+
+
+_ENABLEPARALLELREPLICATIONRESPONSE = _descriptor.Descriptor(
+  name='EnableParallelReplicationResponse',
+  full_name='tabletmanagerdata.EnableParallelReplicationResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13745,
+  serialized_end=13780,
+)
+
+
+_ROTATEREPLICATIONCREDENTIALSREQUEST = _descriptor.Descriptor(
+  name='RotateReplicationCredentialsRequest',
+  full_name='tabletmanagerdata.RotateReplicationCredentialsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='user', full_name='tabletmanagerdata.RotateReplicationCredentialsRequest.user', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='password', full_name='tabletmanagerdata.RotateReplicationCredentialsRequest.password', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13782,
+  serialized_end=13851,
+)
+
+
+_ROTATEREPLICATIONCREDENTIALSRESPONSE = _descriptor.Descriptor(
+  name='RotateReplicationCredentialsResponse',
+  full_name='tabletmanagerdata.RotateReplicationCredentialsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13853,
+  serialized_end=13891,
+)
+
+
+_REPLICATIONSSLOPTIONS = _descriptor.Descriptor(
+  name='ReplicationSSLOptions',
+  full_name='tabletmanagerdata.ReplicationSSLOptions',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='ca', full_name='tabletmanagerdata.ReplicationSSLOptions.ca', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='cert', full_name='tabletmanagerdata.ReplicationSSLOptions.cert', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='key', full_name='tabletmanagerdata.ReplicationSSLOptions.key', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13893,
+  serialized_end=13955,
+)
+
+
+_CONFIGUREREPLICATIONSSLREQUEST = _descriptor.Descriptor(
+  name='ConfigureReplicationSSLRequest',
+  full_name='tabletmanagerdata.ConfigureReplicationSSLRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='options', full_name='tabletmanagerdata.ConfigureReplicationSSLRequest.options', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13957,
+  serialized_end=14048,
+)
+
+
+_CONFIGUREREPLICATIONSSLRESPONSE = _descriptor.Descriptor(
+  name='ConfigureReplicationSSLResponse',
+  full_name='tabletmanagerdata.ConfigureReplicationSSLResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14050,
+  serialized_end=14083,
+)
+
+
+_REPAIRRELAYLOGREQUEST = _descriptor.Descriptor(
+  name='RepairRelayLogRequest',
+  full_name='tabletmanagerdata.RepairRelayLogRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14085,
+  serialized_end=14108,
+)
+
+
+_REPAIRRELAYLOGRESPONSE = _descriptor.Descriptor(
+  name='RepairRelayLogResponse',
+  full_name='tabletmanagerdata.RepairRelayLogResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='event', full_name='tabletmanagerdata.RepairRelayLogResponse.event', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14110,
+  serialized_end=14165,
+)
+
+
+_TABLETEXTERNALLYREPARENTEDREQUEST = _descriptor.Descriptor(
+  name='TabletExternallyReparentedRequest',
+  full_name='tabletmanagerdata.TabletExternallyReparentedRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='external_id', full_name='tabletmanagerdata.TabletExternallyReparentedRequest.external_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='validate', full_name='tabletmanagerdata.TabletExternallyReparentedRequest.validate', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14167,
+  serialized_end=14241,
+)
+
+
+_TABLETEXTERNALLYREPARENTEDRESPONSE = _descriptor.Descriptor(
+  name='TabletExternallyReparentedResponse',
+  full_name='tabletmanagerdata.TabletExternallyReparentedResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14243,
+  serialized_end=14279,
+)
+
+
+_TABLETEXTERNALLYELECTEDREQUEST = _descriptor.Descriptor(
+  name='TabletExternallyElectedRequest',
+  full_name='tabletmanagerdata.TabletExternallyElectedRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14281,
+  serialized_end=14313,
+)
+
+
+_TABLETEXTERNALLYELECTEDRESPONSE = _descriptor.Descriptor(
+  name='TabletExternallyElectedResponse',
+  full_name='tabletmanagerdata.TabletExternallyElectedResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14315,
+  serialized_end=14348,
+)
+
+
+_GETSLAVESREQUEST = _descriptor.Descriptor(
+  name='GetSlavesRequest',
+  full_name='tabletmanagerdata.GetSlavesRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14350,
+  serialized_end=14368,
+)
+
+
+_GETSLAVESRESPONSE = _descriptor.Descriptor(
+  name='GetSlavesResponse',
+  full_name='tabletmanagerdata.GetSlavesResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='addrs', full_name='tabletmanagerdata.GetSlavesResponse.addrs', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14370,
+  serialized_end=14404,
+)
+
+
+_REPLICATIONNEIGHBOR = _descriptor.Descriptor(
+  name='ReplicationNeighbor',
+  full_name='tabletmanagerdata.ReplicationNeighbor',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='host', full_name='tabletmanagerdata.ReplicationNeighbor.host', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='alias', full_name='tabletmanagerdata.ReplicationNeighbor.alias', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14406,
+  serialized_end=14479,
+)
+
+
+_REPLICATIONGRAPH = _descriptor.Descriptor(
+  name='ReplicationGraph',
+  full_name='tabletmanagerdata.ReplicationGraph',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='master', full_name='tabletmanagerdata.ReplicationGraph.master', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='slaves', full_name='tabletmanagerdata.ReplicationGraph.slaves', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14482,
+  serialized_end=14612,
+)
+
+
+_GETREPLICATIONGRAPHREQUEST = _descriptor.Descriptor(
+  name='GetReplicationGraphRequest',
+  full_name='tabletmanagerdata.GetReplicationGraphRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14614,
+  serialized_end=14642,
+)
+
+
+_GETREPLICATIONGRAPHRESPONSE = _descriptor.Descriptor(
+  name='GetReplicationGraphResponse',
+  full_name='tabletmanagerdata.GetReplicationGraphResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='graph', full_name='tabletmanagerdata.GetReplicationGraphResponse.graph', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14644,
+  serialized_end=14725,
+)
+
+
+_WAITBLPPOSITIONREQUEST = _descriptor.Descriptor(
+  name='WaitBlpPositionRequest',
+  full_name='tabletmanagerdata.WaitBlpPositionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='blp_position', full_name='tabletmanagerdata.WaitBlpPositionRequest.blp_position', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='wait_timeout', full_name='tabletmanagerdata.WaitBlpPositionRequest.wait_timeout', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14727,
+  serialized_end=14827,
+)
+
+
+_WAITBLPPOSITIONRESPONSE = _descriptor.Descriptor(
+  name='WaitBlpPositionResponse',
+  full_name='tabletmanagerdata.WaitBlpPositionResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14829,
+  serialized_end=14854,
+)
+
+
+_STOPBLPREQUEST = _descriptor.Descriptor(
+  name='StopBlpRequest',
+  full_name='tabletmanagerdata.StopBlpRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14856,
+  serialized_end=14872,
+)
+
+
+_STOPBLPRESPONSE = _descriptor.Descriptor(
+  name='StopBlpResponse',
+  full_name='tabletmanagerdata.StopBlpResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='blp_positions', full_name='tabletmanagerdata.StopBlpResponse.blp_positions', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14874,
+  serialized_end=14946,
+)
+
+
+_STARTBLPREQUEST = _descriptor.Descriptor(
+  name='StartBlpRequest',
+  full_name='tabletmanagerdata.StartBlpRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14948,
+  serialized_end=14965,
+)
+
+
+_STARTBLPRESPONSE = _descriptor.Descriptor(
+  name='StartBlpResponse',
+  full_name='tabletmanagerdata.StartBlpResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14967,
+  serialized_end=14985,
+)
+
+
+_RUNBLPUNTILREQUEST = _descriptor.Descriptor(
+  name='RunBlpUntilRequest',
+  full_name='tabletmanagerdata.RunBlpUntilRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='blp_positions', full_name='tabletmanagerdata.RunBlpUntilRequest.blp_positions', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='wait_timeout', full_name='tabletmanagerdata.RunBlpUntilRequest.wait_timeout', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14987,
+  serialized_end=15084,
+)
+
+
+_RUNBLPUNTILRESPONSE = _descriptor.Descriptor(
+  name='RunBlpUntilResponse',
+  full_name='tabletmanagerdata.RunBlpUntilResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.RunBlpUntilResponse.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15086,
+  serialized_end=15125,
+)
+
+
+_BINLOGFILTER = _descriptor.Descriptor(
+  name='BinlogFilter',
+  full_name='tabletmanagerdata.BinlogFilter',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='uid', full_name='tabletmanagerdata.BinlogFilter.uid', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='keyspace', full_name='tabletmanagerdata.BinlogFilter.keyspace', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='shard', full_name='tabletmanagerdata.BinlogFilter.shard', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='key_range', full_name='tabletmanagerdata.BinlogFilter.key_range', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='tables', full_name='tabletmanagerdata.BinlogFilter.tables', index=4,
+      number=5, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15127,
+  serialized_end=15242,
+)
+
+
+_GETBINLOGFILTERSREQUEST = _descriptor.Descriptor(
+  name='GetBinlogFiltersRequest',
+  full_name='tabletmanagerdata.GetBinlogFiltersRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15244,
+  serialized_end=15269,
+)
+
+
+_GETBINLOGFILTERSRESPONSE = _descriptor.Descriptor(
+  name='GetBinlogFiltersResponse',
+  full_name='tabletmanagerdata.GetBinlogFiltersResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='filters', full_name='tabletmanagerdata.GetBinlogFiltersResponse.filters', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15271,
+  serialized_end=15347,
+)
+
+
+_RESETREPLICATIONREQUEST = _descriptor.Descriptor(
+  name='ResetReplicationRequest',
+  full_name='tabletmanagerdata.ResetReplicationRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15349,
+  serialized_end=15374,
+)
+
+
+_RESETREPLICATIONRESPONSE = _descriptor.Descriptor(
+  name='ResetReplicationResponse',
+  full_name='tabletmanagerdata.ResetReplicationResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15376,
+  serialized_end=15402,
+)
+
+
+_INITMASTERREQUEST = _descriptor.Descriptor(
+  name='InitMasterRequest',
+  full_name='tabletmanagerdata.InitMasterRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='expected_keyspace', full_name='tabletmanagerdata.InitMasterRequest.expected_keyspace', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='expected_shard', full_name='tabletmanagerdata.InitMasterRequest.expected_shard', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15404,
+  serialized_end=15474,
+)
+
+
+_INITMASTERRESPONSE = _descriptor.Descriptor(
+  name='InitMasterResponse',
+  full_name='tabletmanagerdata.InitMasterResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.InitMasterResponse.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15476,
+  serialized_end=15514,
+)
+
+
+_POPULATEREPARENTJOURNALREQUEST = _descriptor.Descriptor(
+  name='PopulateReparentJournalRequest',
+  full_name='tabletmanagerdata.PopulateReparentJournalRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='time_created_ns', full_name='tabletmanagerdata.PopulateReparentJournalRequest.time_created_ns', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='action_name', full_name='tabletmanagerdata.PopulateReparentJournalRequest.action_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='master_alias', full_name='tabletmanagerdata.PopulateReparentJournalRequest.master_alias', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='replication_position', full_name='tabletmanagerdata.PopulateReparentJournalRequest.replication_position', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='expected_keyspace', full_name='tabletmanagerdata.PopulateReparentJournalRequest.expected_keyspace', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='expected_shard', full_name='tabletmanagerdata.PopulateReparentJournalRequest.expected_shard', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15517,
+  serialized_end=15721,
+)
+
+
+_POPULATEREPARENTJOURNALRESPONSE = _descriptor.Descriptor(
+  name='PopulateReparentJournalResponse',
+  full_name='tabletmanagerdata.PopulateReparentJournalResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15723,
+  serialized_end=15756,
+)
+
+
+_REPARENTJOURNALENTRY = _descriptor.Descriptor(
+  name='ReparentJournalEntry',
+  full_name='tabletmanagerdata.ReparentJournalEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='time_created_ns', full_name='tabletmanagerdata.ReparentJournalEntry.time_created_ns', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='action_name', full_name='tabletmanagerdata.ReparentJournalEntry.action_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='master_alias', full_name='tabletmanagerdata.ReparentJournalEntry.master_alias', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='replication_position', full_name='tabletmanagerdata.ReparentJournalEntry.replication_position', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15759,
+  serialized_end=15902,
+)
+
+
+_GETLASTREPARENTJOURNALENTRYREQUEST = _descriptor.Descriptor(
+  name='GetLastReparentJournalEntryRequest',
+  full_name='tabletmanagerdata.GetLastReparentJournalEntryRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15904,
+  serialized_end=15940,
+)
+
+
+_GETLASTREPARENTJOURNALENTRYRESPONSE = _descriptor.Descriptor(
+  name='GetLastReparentJournalEntryResponse',
+  full_name='tabletmanagerdata.GetLastReparentJournalEntryResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='entry', full_name='tabletmanagerdata.GetLastReparentJournalEntryResponse.entry', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=15942,
+  serialized_end=16035,
+)
+
+
+_INITSLAVEREQUEST = _descriptor.Descriptor(
+  name='InitSlaveRequest',
+  full_name='tabletmanagerdata.InitSlaveRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='parent', full_name='tabletmanagerdata.InitSlaveRequest.parent', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='replication_position', full_name='tabletmanagerdata.InitSlaveRequest.replication_position', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='time_created_ns', full_name='tabletmanagerdata.InitSlaveRequest.time_created_ns', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16037,
+  serialized_end=16149,
+)
+
+
+_INITSLAVERESPONSE = _descriptor.Descriptor(
+  name='InitSlaveResponse',
+  full_name='tabletmanagerdata.InitSlaveResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16151,
+  serialized_end=16170,
+)
+
+
+_DEMOTEMASTERREQUEST = _descriptor.Descriptor(
+  name='DemoteMasterRequest',
+  full_name='tabletmanagerdata.DemoteMasterRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16172,
+  serialized_end=16193,
+)
+
+
+_DEMOTEMASTERRESPONSE = _descriptor.Descriptor(
+  name='DemoteMasterResponse',
+  full_name='tabletmanagerdata.DemoteMasterResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.DemoteMasterResponse.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16195,
+  serialized_end=16235,
+)
+
+
+_PROMOTESLAVEWHENCAUGHTUPREQUEST = _descriptor.Descriptor(
+  name='PromoteSlaveWhenCaughtUpRequest',
+  full_name='tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16237,
+  serialized_end=16288,
+)
+
+
+_PROMOTESLAVEWHENCAUGHTUPRESPONSE = _descriptor.Descriptor(
+  name='PromoteSlaveWhenCaughtUpResponse',
+  full_name='tabletmanagerdata.PromoteSlaveWhenCaughtUpResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.PromoteSlaveWhenCaughtUpResponse.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16290,
+  serialized_end=16342,
+)
+
+
+_SLAVEWASPROMOTEDREQUEST = _descriptor.Descriptor(
+  name='SlaveWasPromotedRequest',
+  full_name='tabletmanagerdata.SlaveWasPromotedRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16344,
+  serialized_end=16369,
+)
+
+
+_SLAVEWASPROMOTEDRESPONSE = _descriptor.Descriptor(
+  name='SlaveWasPromotedResponse',
+  full_name='tabletmanagerdata.SlaveWasPromotedResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16371,
+  serialized_end=16397,
+)
+
+
+_SETMASTERREQUEST = _descriptor.Descriptor(
+  name='SetMasterRequest',
+  full_name='tabletmanagerdata.SetMasterRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='parent', full_name='tabletmanagerdata.SetMasterRequest.parent', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='time_created_ns', full_name='tabletmanagerdata.SetMasterRequest.time_created_ns', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='force_start_slave', full_name='tabletmanagerdata.SetMasterRequest.force_start_slave', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='expected_keyspace', full_name='tabletmanagerdata.SetMasterRequest.expected_keyspace', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='expected_shard', full_name='tabletmanagerdata.SetMasterRequest.expected_shard', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16400,
+  serialized_end=16560,
+)
+
+
+_SETMASTERRESPONSE = _descriptor.Descriptor(
+  name='SetMasterResponse',
+  full_name='tabletmanagerdata.SetMasterResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16562,
+  serialized_end=16581,
+)
+
+
+_CONFIGUREREPLICATIONREQUEST = _descriptor.Descriptor(
+  name='ConfigureReplicationRequest',
+  full_name='tabletmanagerdata.ConfigureReplicationRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='parent', full_name='tabletmanagerdata.ConfigureReplicationRequest.parent', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='auto_position', full_name='tabletmanagerdata.ConfigureReplicationRequest.auto_position', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='file', full_name='tabletmanagerdata.ConfigureReplicationRequest.file', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.ConfigureReplicationRequest.position', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='force_start_slave', full_name='tabletmanagerdata.ConfigureReplicationRequest.force_start_slave', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='expected_keyspace', full_name='tabletmanagerdata.ConfigureReplicationRequest.expected_keyspace', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='expected_shard', full_name='tabletmanagerdata.ConfigureReplicationRequest.expected_shard', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16584,
+  serialized_end=16785,
+)
+
+
+_CONFIGUREREPLICATIONRESPONSE = _descriptor.Descriptor(
+  name='ConfigureReplicationResponse',
+  full_name='tabletmanagerdata.ConfigureReplicationResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16787,
+  serialized_end=16817,
+)
+
+
+_SLAVEWASRESTARTEDREQUEST = _descriptor.Descriptor(
+  name='SlaveWasRestartedRequest',
+  full_name='tabletmanagerdata.SlaveWasRestartedRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='parent', full_name='tabletmanagerdata.SlaveWasRestartedRequest.parent', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16819,
+  serialized_end=16884,
+)
+
+
+_SLAVEWASRESTARTEDRESPONSE = _descriptor.Descriptor(
+  name='SlaveWasRestartedResponse',
+  full_name='tabletmanagerdata.SlaveWasRestartedResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16886,
+  serialized_end=16913,
+)
+
+
+_STOPREPLICATIONANDGETSTATUSREQUEST = _descriptor.Descriptor(
+  name='StopReplicationAndGetStatusRequest',
+  full_name='tabletmanagerdata.StopReplicationAndGetStatusRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16915,
+  serialized_end=16951,
+)
+
+
+_STOPREPLICATIONANDGETSTATUSRESPONSE = _descriptor.Descriptor(
+  name='StopReplicationAndGetStatusResponse',
+  full_name='tabletmanagerdata.StopReplicationAndGetStatusResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='status', full_name='tabletmanagerdata.StopReplicationAndGetStatusResponse.status', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16953,
+  serialized_end=17031,
+)
+
+
+_PROMOTESLAVEREQUEST = _descriptor.Descriptor(
+  name='PromoteSlaveRequest',
+  full_name='tabletmanagerdata.PromoteSlaveRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17033,
+  serialized_end=17054,
+)
+
+
+_PROMOTESLAVERESPONSE = _descriptor.Descriptor(
+  name='PromoteSlaveResponse',
+  full_name='tabletmanagerdata.PromoteSlaveResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.PromoteSlaveResponse.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17056,
+  serialized_end=17096,
+)
+
+
+_SETREPARENTELIGIBILITYREQUEST = _descriptor.Descriptor(
+  name='SetReparentEligibilityRequest',
+  full_name='tabletmanagerdata.SetReparentEligibilityRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='eligible', full_name='tabletmanagerdata.SetReparentEligibilityRequest.eligible', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='reason', full_name='tabletmanagerdata.SetReparentEligibilityRequest.reason', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17098,
+  serialized_end=17163,
+)
+
+
+_SETREPARENTELIGIBILITYRESPONSE = _descriptor.Descriptor(
+  name='SetReparentEligibilityResponse',
+  full_name='tabletmanagerdata.SetReparentEligibilityResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17165,
+  serialized_end=17197,
+)
+
+
+_CHECKREPARENTCANDIDATEREQUEST = _descriptor.Descriptor(
+  name='CheckReparentCandidateRequest',
+  full_name='tabletmanagerdata.CheckReparentCandidateRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17199,
+  serialized_end=17230,
+)
+
+
+_CHECKREPARENTCANDIDATERESPONSE = _descriptor.Descriptor(
+  name='CheckReparentCandidateResponse',
+  full_name='tabletmanagerdata.CheckReparentCandidateResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='eligible', full_name='tabletmanagerdata.CheckReparentCandidateResponse.eligible', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='reason', full_name='tabletmanagerdata.CheckReparentCandidateResponse.reason', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17232,
+  serialized_end=17298,
+)
+
+
+_SETSEMISYNCACKCOUNTREQUEST = _descriptor.Descriptor(
+  name='SetSemiSyncAckCountRequest',
+  full_name='tabletmanagerdata.SetSemiSyncAckCountRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='count', full_name='tabletmanagerdata.SetSemiSyncAckCountRequest.count', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17300,
+  serialized_end=17343,
+)
+
+
+_SETSEMISYNCACKCOUNTRESPONSE = _descriptor.Descriptor(
+  name='SetSemiSyncAckCountResponse',
+  full_name='tabletmanagerdata.SetSemiSyncAckCountResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17345,
+  serialized_end=17374,
+)
+
+
+_DURABILITYPOLICY = _descriptor.Descriptor(
+  name='DurabilityPolicy',
+  full_name='tabletmanagerdata.DurabilityPolicy',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='tabletmanagerdata.DurabilityPolicy.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='semi_sync_master', full_name='tabletmanagerdata.DurabilityPolicy.semi_sync_master', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='semi_sync_slave', full_name='tabletmanagerdata.DurabilityPolicy.semi_sync_slave', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='mysql_semi_sync_master', full_name='tabletmanagerdata.DurabilityPolicy.mysql_semi_sync_master', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='mysql_semi_sync_slave', full_name='tabletmanagerdata.DurabilityPolicy.mysql_semi_sync_slave', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17377,
+  serialized_end=17523,
+)
+
+
+_GETDURABILITYPOLICYREQUEST = _descriptor.Descriptor(
+  name='GetDurabilityPolicyRequest',
+  full_name='tabletmanagerdata.GetDurabilityPolicyRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17525,
+  serialized_end=17553,
+)
+
+
+_GETDURABILITYPOLICYRESPONSE = _descriptor.Descriptor(
+  name='GetDurabilityPolicyResponse',
+  full_name='tabletmanagerdata.GetDurabilityPolicyResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='policy', full_name='tabletmanagerdata.GetDurabilityPolicyResponse.policy', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17555,
+  serialized_end=17637,
+)
+
+
+_GETBACKUPLIMITSREQUEST = _descriptor.Descriptor(
+  name='GetBackupLimitsRequest',
+  full_name='tabletmanagerdata.GetBackupLimitsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17639,
+  serialized_end=17663,
+)
+
+
+_GETBACKUPLIMITSRESPONSE = _descriptor.Descriptor(
+  name='GetBackupLimitsResponse',
+  full_name='tabletmanagerdata.GetBackupLimitsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='default_concurrency', full_name='tabletmanagerdata.GetBackupLimitsResponse.default_concurrency', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='max_concurrency', full_name='tabletmanagerdata.GetBackupLimitsResponse.max_concurrency', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17665,
+  serialized_end=17744,
+)
+
+
+_GETBACKUPPOSITIONREQUEST = _descriptor.Descriptor(
+  name='GetBackupPositionRequest',
+  full_name='tabletmanagerdata.GetBackupPositionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17746,
+  serialized_end=17772,
+)
+
+
+_GETBACKUPPOSITIONRESPONSE = _descriptor.Descriptor(
+  name='GetBackupPositionResponse',
+  full_name='tabletmanagerdata.GetBackupPositionResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='position', full_name='tabletmanagerdata.GetBackupPositionResponse.position', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17774,
+  serialized_end=17819,
+)
+
+
+_BACKUPREQUEST = _descriptor.Descriptor(
+  name='BackupRequest',
+  full_name='tabletmanagerdata.BackupRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='concurrency', full_name='tabletmanagerdata.BackupRequest.concurrency', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17821,
+  serialized_end=17857,
+)
+
+
+_BACKUPRESPONSE = _descriptor.Descriptor(
+  name='BackupResponse',
+  full_name='tabletmanagerdata.BackupResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='event', full_name='tabletmanagerdata.BackupResponse.event', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17859,
+  serialized_end=17906,
+)
+
+
+_INCREMENTALBACKUPREQUEST = _descriptor.Descriptor(
+  name='IncrementalBackupRequest',
+  full_name='tabletmanagerdata.IncrementalBackupRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='base_backup_name', full_name='tabletmanagerdata.IncrementalBackupRequest.base_backup_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='concurrency', full_name='tabletmanagerdata.IncrementalBackupRequest.concurrency', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17908,
+  serialized_end=17981,
+)
+
+
+_INCREMENTALBACKUPRESPONSE = _descriptor.Descriptor(
+  name='IncrementalBackupResponse',
+  full_name='tabletmanagerdata.IncrementalBackupResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='event', full_name='tabletmanagerdata.IncrementalBackupResponse.event', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=17983,
+  serialized_end=18041,
+)
+
+
+_RESTOREFROMBACKUPREQUEST = _descriptor.Descriptor(
+  name='RestoreFromBackupRequest',
+  full_name='tabletmanagerdata.RestoreFromBackupRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18043,
+  serialized_end=18069,
+)
+
+
+_RESTOREFROMBACKUPRESPONSE = _descriptor.Descriptor(
+  name='RestoreFromBackupResponse',
+  full_name='tabletmanagerdata.RestoreFromBackupResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='event', full_name='tabletmanagerdata.RestoreFromBackupResponse.event', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18071,
+  serialized_end=18129,
+)
+
+
+_RESTORETOTIMESTAMPREQUEST = _descriptor.Descriptor(
+  name='RestoreToTimestampRequest',
+  full_name='tabletmanagerdata.RestoreToTimestampRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='backup_name', full_name='tabletmanagerdata.RestoreToTimestampRequest.backup_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='target_time_ns', full_name='tabletmanagerdata.RestoreToTimestampRequest.target_time_ns', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18131,
+  serialized_end=18203,
+)
+
+
+_RESTORETOTIMESTAMPRESPONSE = _descriptor.Descriptor(
+  name='RestoreToTimestampResponse',
+  full_name='tabletmanagerdata.RestoreToTimestampResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='event', full_name='tabletmanagerdata.RestoreToTimestampResponse.event', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18205,
+  serialized_end=18264,
+)
+
+
+_SETPREFERREDBACKUPREQUEST = _descriptor.Descriptor(
+  name='SetPreferredBackupRequest',
+  full_name='tabletmanagerdata.SetPreferredBackupRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='backup_name', full_name='tabletmanagerdata.SetPreferredBackupRequest.backup_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18266,
+  serialized_end=18314,
+)
+
+
+_SETPREFERREDBACKUPRESPONSE = _descriptor.Descriptor(
+  name='SetPreferredBackupResponse',
+  full_name='tabletmanagerdata.SetPreferredBackupResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18316,
+  serialized_end=18344,
+)
+
+
+_GETPREFERREDBACKUPREQUEST = _descriptor.Descriptor(
+  name='GetPreferredBackupRequest',
+  full_name='tabletmanagerdata.GetPreferredBackupRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18346,
+  serialized_end=18373,
+)
+
+
+_GETPREFERREDBACKUPRESPONSE = _descriptor.Descriptor(
+  name='GetPreferredBackupResponse',
+  full_name='tabletmanagerdata.GetPreferredBackupResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='backup_name', full_name='tabletmanagerdata.GetPreferredBackupResponse.backup_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18375,
+  serialized_end=18424,
+)
+
+
+_COMPATCHECK = _descriptor.Descriptor(
+  name='CompatCheck',
+  full_name='tabletmanagerdata.CompatCheck',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='tabletmanagerdata.CompatCheck.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='backup_value', full_name='tabletmanagerdata.CompatCheck.backup_value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='tablet_value', full_name='tabletmanagerdata.CompatCheck.tablet_value', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='compatible', full_name='tabletmanagerdata.CompatCheck.compatible', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='reason', full_name='tabletmanagerdata.CompatCheck.reason', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18426,
+  serialized_end=18533,
+)
+
+
+_COMPATREPORT = _descriptor.Descriptor(
+  name='CompatReport',
+  full_name='tabletmanagerdata.CompatReport',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='compatible', full_name='tabletmanagerdata.CompatReport.compatible', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='checks', full_name='tabletmanagerdata.CompatReport.checks', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18535,
+  serialized_end=18617,
+)
+
+
+_CHECKRESTORECOMPATIBILITYREQUEST = _descriptor.Descriptor(
+  name='CheckRestoreCompatibilityRequest',
+  full_name='tabletmanagerdata.CheckRestoreCompatibilityRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='backup_name', full_name='tabletmanagerdata.CheckRestoreCompatibilityRequest.backup_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18619,
+  serialized_end=18674,
+)
+
+
+_CHECKRESTORECOMPATIBILITYRESPONSE = _descriptor.Descriptor(
+  name='CheckRestoreCompatibilityResponse',
+  full_name='tabletmanagerdata.CheckRestoreCompatibilityResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='report', full_name='tabletmanagerdata.CheckRestoreCompatibilityResponse.report', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18676,
+  serialized_end=18760,
+)
+
+
+_BACKUPINFO = _descriptor.Descriptor(
+  name='BackupInfo',
+  full_name='tabletmanagerdata.BackupInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='tabletmanagerdata.BackupInfo.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='size_bytes', full_name='tabletmanagerdata.BackupInfo.size_bytes', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='start_time_ns', full_name='tabletmanagerdata.BackupInfo.start_time_ns', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='end_time_ns', full_name='tabletmanagerdata.BackupInfo.end_time_ns', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='duration_ns', full_name='tabletmanagerdata.BackupInfo.duration_ns', index=4,
+      number=5, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='success', full_name='tabletmanagerdata.BackupInfo.success', index=5,
+      number=6, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='tabletmanagerdata.BackupInfo.error', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18763,
+  serialized_end=18906,
+)
+
+
+_GETLASTBACKUPINFOREQUEST = _descriptor.Descriptor(
+  name='GetLastBackupInfoRequest',
+  full_name='tabletmanagerdata.GetLastBackupInfoRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18908,
+  serialized_end=18934,
+)
+
+
+_GETLASTBACKUPINFORESPONSE = _descriptor.Descriptor(
+  name='GetLastBackupInfoResponse',
+  full_name='tabletmanagerdata.GetLastBackupInfoResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='info', full_name='tabletmanagerdata.GetLastBackupInfoResponse.info', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=18936,
+  serialized_end=19008,
+)
+
+
+_GETBACKUPFRESHNESSREQUEST = _descriptor.Descriptor(
+  name='GetBackupFreshnessRequest',
+  full_name='tabletmanagerdata.GetBackupFreshnessRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=19010,
+  serialized_end=19037,
+)
+
+
+_GETBACKUPFRESHNESSRESPONSE = _descriptor.Descriptor(
+  name='GetBackupFreshnessResponse',
+  full_name='tabletmanagerdata.GetBackupFreshnessResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='backup_name', full_name='tabletmanagerdata.GetBackupFreshnessResponse.backup_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='age_ns', full_name='tabletmanagerdata.GetBackupFreshnessResponse.age_ns', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=19039,
+  serialized_end=19104,
+)
+
+
+_READINESSREPORT = _descriptor.Descriptor(
+  name='ReadinessReport',
+  full_name='tabletmanagerdata.ReadinessReport',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='ready', full_name='tabletmanagerdata.ReadinessReport.ready', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='reasons', full_name='tabletmanagerdata.ReadinessReport.reasons', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=19106,
+  serialized_end=19155,
+)
+
+
+_CHECKBACKUPREADINESSREQUEST = _descriptor.Descriptor(
+  name='CheckBackupReadinessRequest',
+  full_name='tabletmanagerdata.CheckBackupReadinessRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=19157,
+  serialized_end=19186,
+)
+
+
+_CHECKBACKUPREADINESSRESPONSE = _descriptor.Descriptor(
+  name='CheckBackupReadinessResponse',
+  full_name='tabletmanagerdata.CheckBackupReadinessResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='report', full_name='tabletmanagerdata.CheckBackupReadinessResponse.report', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=19188,
+  serialized_end=19270,
+)
+
+
+_TESTRESTOREREQUEST = _descriptor.Descriptor(
+  name='TestRestoreRequest',
+  full_name='tabletmanagerdata.TestRestoreRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='backup_name', full_name='tabletmanagerdata.TestRestoreRequest.backup_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=19272,
+  serialized_end=19313,
+)
+
+
+_TESTRESTORERESPONSE = _descriptor.Descriptor(
+  name='TestRestoreResponse',
+  full_name='tabletmanagerdata.TestRestoreResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='event', full_name='tabletmanagerdata.TestRestoreResponse.event', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=19315,
+  serialized_end=19367,
+)
+
+_SCHEMADEFINITION.fields_by_name['table_definitions'].message_type = _TABLEDEFINITION
+_SCHEMACHANGERESULT.fields_by_name['before_schema'].message_type = _SCHEMADEFINITION
+_SCHEMACHANGERESULT.fields_by_name['after_schema'].message_type = _SCHEMADEFINITION
+_USERPERMISSION_PRIVILEGESENTRY.containing_type = _USERPERMISSION
+_USERPERMISSION.fields_by_name['privileges'].message_type = _USERPERMISSION_PRIVILEGESENTRY
+_DBPERMISSION_PRIVILEGESENTRY.containing_type = _DBPERMISSION
+_DBPERMISSION.fields_by_name['privileges'].message_type = _DBPERMISSION_PRIVILEGESENTRY
+_PERMISSIONS.fields_by_name['user_permissions'].message_type = _USERPERMISSION
+_PERMISSIONS.fields_by_name['db_permissions'].message_type = _DBPERMISSION
+_EXECUTEHOOKREQUEST_EXTRAENVENTRY.containing_type = _EXECUTEHOOKREQUEST
+_EXECUTEHOOKREQUEST.fields_by_name['extra_env'].message_type = _EXECUTEHOOKREQUEST_EXTRAENVENTRY
+_EXECUTEHOOKTOSTREAMREQUEST_EXTRAENVENTRY.containing_type = _EXECUTEHOOKTOSTREAMREQUEST
+_EXECUTEHOOKTOSTREAMREQUEST.fields_by_name['extra_env'].message_type = _EXECUTEHOOKTOSTREAMREQUEST_EXTRAENVENTRY
+_GETSCHEMARESPONSE.fields_by_name['schema_definition'].message_type = _SCHEMADEFINITION
+_GETCREATESTATEMENTSRESPONSE_CREATESTATEMENTSENTRY.containing_type = _GETCREATESTATEMENTSRESPONSE
+_GETCREATESTATEMENTSRESPONSE.fields_by_name['create_statements'].message_type = _GETCREATESTATEMENTSRESPONSE_CREATESTATEMENTSENTRY
+_GETSCHEMATIMESTAMPSRESPONSE_TIMESTAMPSENTRY.containing_type = _GETSCHEMATIMESTAMPSRESPONSE
+_GETSCHEMATIMESTAMPSRESPONSE.fields_by_name['timestamps'].message_type = _GETSCHEMATIMESTAMPSRESPONSE_TIMESTAMPSENTRY
+_GETPERMISSIONSRESPONSE.fields_by_name['permissions'].message_type = _PERMISSIONS
+_GETCONNECTIONSTATSRESPONSE.fields_by_name['connection_stats'].message_type = _CONNECTIONSTATS
+_GETCONNECTIONREJECTIONSTATSRESPONSE_REJECTIONSENTRY.containing_type = _GETCONNECTIONREJECTIONSTATSRESPONSE
+_GETCONNECTIONREJECTIONSTATSRESPONSE.fields_by_name['rejections'].message_type = _GETCONNECTIONREJECTIONSTATSRESPONSE_REJECTIONSENTRY
+_GETCONFIGRESPONSE_FLAGSENTRY.containing_type = _GETCONFIGRESPONSE
+_GETCONFIGRESPONSE.fields_by_name['flags'].message_type = _GETCONFIGRESPONSE_FLAGSENTRY
+_GETINFLIGHTRPCSRESPONSE_INFLIGHTENTRY.containing_type = _GETINFLIGHTRPCSRESPONSE
+_GETINFLIGHTRPCSRESPONSE.fields_by_name['in_flight'].message_type = _GETINFLIGHTRPCSRESPONSE_INFLIGHTENTRY
+_GETPROCESSSTATSRESPONSE.fields_by_name['stats'].message_type = _PROCESSSTATS
+_GETHEALTHSCORERESPONSE.fields_by_name['score'].message_type = _HEALTHSCORE
+_DIAGNOSTICBUNDLE_VARIABLESENTRY.containing_type = _DIAGNOSTICBUNDLE
+_DIAGNOSTICBUNDLE.fields_by_name['processes'].message_type = _PROCESS
+_DIAGNOSTICBUNDLE.fields_by_name['slave_status'].message_type = replicationdata__pb2._STATUS
+_DIAGNOSTICBUNDLE.fields_by_name['variables'].message_type = _DIAGNOSTICBUNDLE_VARIABLESENTRY
+_CAPTUREDIAGNOSTICSRESPONSE.fields_by_name['bundle'].message_type = _DIAGNOSTICBUNDLE
+_GETTHROTTLESTATERESPONSE.fields_by_name['state'].message_type = _THROTTLESTATE
+_GETTABLETTAGSRESPONSE_TAGSENTRY.containing_type = _GETTABLETTAGSRESPONSE
+_GETTABLETTAGSRESPONSE.fields_by_name['tags'].message_type = _GETTABLETTAGSRESPONSE_TAGSENTRY
+_SETQUERYSERVERCONFIGREQUEST.fields_by_name['config'].message_type = _QUERYSERVERCONFIG
+_SETQUERYSERVERCONFIGRESPONSE.fields_by_name['applied'].message_type = _QUERYSERVERCONFIG
+_CHANGETYPEREQUEST.fields_by_name['tablet_type'].enum_type = topodata__pb2._TABLETTYPE
+_SETTABLETTAGSREQUEST_TAGSENTRY.containing_type = _SETTABLETTAGSREQUEST
+_SETTABLETTAGSREQUEST.fields_by_name['tags'].message_type = _SETTABLETTAGSREQUEST_TAGSENTRY
+_SETSERVINGKEYRANGEREQUEST.fields_by_name['key_range'].message_type = topodata__pb2._KEYRANGE
+_SETSERVINGKEYRANGEREQUEST.fields_by_name['served_types'].enum_type = topodata__pb2._TABLETTYPE
+_RESTARTMYSQLRESPONSE.fields_by_name['event'].message_type = logutil__pb2._EVENT
+_DECOMMISSIONRESPONSE.fields_by_name['event'].message_type = logutil__pb2._EVENT
+_PREFLIGHTSCHEMARESPONSE.fields_by_name['change_results'].message_type = _SCHEMACHANGERESULT
+_APPLYSCHEMAREQUEST.fields_by_name['before_schema'].message_type = _SCHEMADEFINITION
+_APPLYSCHEMAREQUEST.fields_by_name['after_schema'].message_type = _SCHEMADEFINITION
+_APPLYSCHEMARESPONSE.fields_by_name['before_schema'].message_type = _SCHEMADEFINITION
+_APPLYSCHEMARESPONSE.fields_by_name['after_schema'].message_type = _SCHEMADEFINITION
+_SCHEMADIFFREQUEST.fields_by_name['desired'].message_type = _SCHEMADEFINITION
+_ASSESSSCHEMACHANGERESPONSE.fields_by_name['assessment'].message_type = _SCHEMACHANGEASSESSMENT
+_SCHEMACHANGEEVENT.fields_by_name['definition'].message_type = _TABLEDEFINITION
+_WATCHSCHEMARESPONSE.fields_by_name['event'].message_type = _SCHEMACHANGEEVENT
+_GETVSCHEMARESPONSE.fields_by_name['vschema'].message_type = vschema__pb2._KEYSPACE
+_EXECUTEFETCHASDBARESPONSE.fields_by_name['result'].message_type = query__pb2._QUERYRESULT
+_COLUMNARRESULT.fields_by_name['fields'].message_type = query__pb2._FIELD
+_COLUMNARRESULT.fields_by_name['columns'].message_type = query__pb2._ROW
+_EXECUTEFETCHCOLUMNARRESPONSE.fields_by_name['result'].message_type = _COLUMNARRESULT
+_EXECUTEFETCHASALLPRIVSRESPONSE.fields_by_name['result'].message_type = query__pb2._QUERYRESULT
+_EXECUTEFETCHASAPPRESPONSE.fields_by_name['result'].message_type = query__pb2._QUERYRESULT
+_EXPLAINQUERYRESPONSE.fields_by_name['result'].message_type = query__pb2._QUERYRESULT
+_CHECKSUMTABLEREQUEST.fields_by_name['key_range'].message_type = topodata__pb2._KEYRANGE
+_RENAMETABLERESPONSE.fields_by_name['result'].message_type = _RENAMETABLERESULT
+_STREAMROWSINKEYRANGEREQUEST.fields_by_name['key_range'].message_type = topodata__pb2._KEYRANGE
+_STREAMROWSINKEYRANGERESPONSE.fields_by_name['result'].message_type = query__pb2._QUERYRESULT
+_STREAMKEYRANGEBINLOGREQUEST.fields_by_name['key_range'].message_type = topodata__pb2._KEYRANGE
+_STREAMKEYRANGEBINLOGREQUEST.fields_by_name['charset'].message_type = binlogdata__pb2._CHARSET
+_STREAMKEYRANGEBINLOGRESPONSE.fields_by_name['binlog_transaction'].message_type = binlogdata__pb2._BINLOGTRANSACTION
+_CLONESTREAMRESPONSE.fields_by_name['result'].message_type = query__pb2._QUERYRESULT
+_GETPROCESSLISTRESPONSE.fields_by_name['processes'].message_type = _PROCESS
+_CHARSETCONFIG_DATABASECHARSETSENTRY.containing_type = _CHARSETCONFIG
+_CHARSETCONFIG_DATABASECOLLATIONSENTRY.containing_type = _CHARSETCONFIG
+_CHARSETCONFIG.fields_by_name['database_charsets'].message_type = _CHARSETCONFIG_DATABASECHARSETSENTRY
+_CHARSETCONFIG.fields_by_name['database_collations'].message_type = _CHARSETCONFIG_DATABASECOLLATIONSENTRY
+_GETCHARSETCONFIGRESPONSE.fields_by_name['config'].message_type = _CHARSETCONFIG
+_STREAMQPSRESPONSE.fields_by_name['sample'].message_type = _QPSSAMPLE
+_SLAVESTATUSRESPONSE.fields_by_name['status'].message_type = replicationdata__pb2._STATUS
+_SLAVESTATUSALLCHANNELSRESPONSE_STATUSESENTRY.fields_by_name['value'].message_type = replicationdata__pb2._STATUS
+_SLAVESTATUSALLCHANNELSRESPONSE_STATUSESENTRY.containing_type = _SLAVESTATUSALLCHANNELSRESPONSE
+_SLAVESTATUSALLCHANNELSRESPONSE.fields_by_name['statuses'].message_type = _SLAVESTATUSALLCHANNELSRESPONSE_STATUSESENTRY
+_GETREPLICATIONSOURCERESPONSE.fields_by_name['source'].message_type = _REPLICATIONSOURCE
+_APPLIERQUEUESTATS.fields_by_name['workers'].message_type = _APPLIERWORKERSTATS
+_GETAPPLIERQUEUESTATSRESPONSE.fields_by_name['stats'].message_type = _APPLIERQUEUESTATS
+_GETREPLICATIONERRORSTATSRESPONSE.fields_by_name['stats'].message_type = _REPLICATIONERRORSTATS
+_BOOSTREPLICATIONCATCHUPRESPONSE_SETTINGSENTRY.containing_type = _BOOSTREPLICATIONCATCHUPRESPONSE
+_BOOSTREPLICATIONCATCHUPRESPONSE.fields_by_name['settings'].message_type = _BOOSTREPLICATIONCATCHUPRESPONSE_SETTINGSENTRY
+_CONFIGUREREPLICATIONSSLREQUEST.fields_by_name['options'].message_type = _REPLICATIONSSLOPTIONS
+_REPAIRRELAYLOGRESPONSE.fields_by_name['event'].message_type = logutil__pb2._EVENT
+_REPLICATIONNEIGHBOR.fields_by_name['alias'].message_type = topodata__pb2._TABLETALIAS
+_REPLICATIONGRAPH.fields_by_name['master'].message_type = _REPLICATIONNEIGHBOR
+_REPLICATIONGRAPH.fields_by_name['slaves'].message_type = _REPLICATIONNEIGHBOR
+_GETREPLICATIONGRAPHRESPONSE.fields_by_name['graph'].message_type = _REPLICATIONGRAPH
+_WAITBLPPOSITIONREQUEST.fields_by_name['blp_position'].message_type = _BLPPOSITION
+_STOPBLPRESPONSE.fields_by_name['blp_positions'].message_type = _BLPPOSITION
+_RUNBLPUNTILREQUEST.fields_by_name['blp_positions'].message_type = _BLPPOSITION
+_BINLOGFILTER.fields_by_name['key_range'].message_type = topodata__pb2._KEYRANGE
+_GETBINLOGFILTERSRESPONSE.fields_by_name['filters'].message_type = _BINLOGFILTER
+_POPULATEREPARENTJOURNALREQUEST.fields_by_name['master_alias'].message_type = topodata__pb2._TABLETALIAS
+_REPARENTJOURNALENTRY.fields_by_name['master_alias'].message_type = topodata__pb2._TABLETALIAS
+_GETLASTREPARENTJOURNALENTRYRESPONSE.fields_by_name['entry'].message_type = _REPARENTJOURNALENTRY
+_INITSLAVEREQUEST.fields_by_name['parent'].message_type = topodata__pb2._TABLETALIAS
+_SETMASTERREQUEST.fields_by_name['parent'].message_type = topodata__pb2._TABLETALIAS
+_CONFIGUREREPLICATIONREQUEST.fields_by_name['parent'].message_type = topodata__pb2._TABLETALIAS
+_SLAVEWASRESTARTEDREQUEST.fields_by_name['parent'].message_type = topodata__pb2._TABLETALIAS
+_STOPREPLICATIONANDGETSTATUSRESPONSE.fields_by_name['status'].message_type = replicationdata__pb2._STATUS
+_GETDURABILITYPOLICYRESPONSE.fields_by_name['policy'].message_type = _DURABILITYPOLICY
+_BACKUPRESPONSE.fields_by_name['event'].message_type = logutil__pb2._EVENT
+_INCREMENTALBACKUPRESPONSE.fields_by_name['event'].message_type = logutil__pb2._EVENT
+_RESTOREFROMBACKUPRESPONSE.fields_by_name['event'].message_type = logutil__pb2._EVENT
+_RESTORETOTIMESTAMPRESPONSE.fields_by_name['event'].message_type = logutil__pb2._EVENT
+_COMPATREPORT.fields_by_name['checks'].message_type = _COMPATCHECK
+_CHECKRESTORECOMPATIBILITYRESPONSE.fields_by_name['report'].message_type = _COMPATREPORT
+_GETLASTBACKUPINFORESPONSE.fields_by_name['info'].message_type = _BACKUPINFO
+_CHECKBACKUPREADINESSRESPONSE.fields_by_name['report'].message_type = _READINESSREPORT
+_TESTRESTORERESPONSE.fields_by_name['event'].message_type = logutil__pb2._EVENT
+DESCRIPTOR.message_types_by_name['TableDefinition'] = _TABLEDEFINITION
+DESCRIPTOR.message_types_by_name['SchemaDefinition'] = _SCHEMADEFINITION
+DESCRIPTOR.message_types_by_name['SchemaChangeResult'] = _SCHEMACHANGERESULT
 DESCRIPTOR.message_types_by_name['UserPermission'] = _USERPERMISSION
 DESCRIPTOR.message_types_by_name['DbPermission'] = _DBPERMISSION
 DESCRIPTOR.message_types_by_name['Permissions'] = _PERMISSIONS
@@ -3050,50 +10326,202 @@ DESCRIPTOR.message_types_by_name['SleepRequest'] = _SLEEPREQUEST
 DESCRIPTOR.message_types_by_name['SleepResponse'] = _SLEEPRESPONSE
 DESCRIPTOR.message_types_by_name['ExecuteHookRequest'] = _EXECUTEHOOKREQUEST
 DESCRIPTOR.message_types_by_name['ExecuteHookResponse'] = _EXECUTEHOOKRESPONSE
+DESCRIPTOR.message_types_by_name['ExecuteHookToStreamRequest'] = _EXECUTEHOOKTOSTREAMREQUEST
+DESCRIPTOR.message_types_by_name['ExecuteHookToStreamResponse'] = _EXECUTEHOOKTOSTREAMRESPONSE
 DESCRIPTOR.message_types_by_name['GetSchemaRequest'] = _GETSCHEMAREQUEST
 DESCRIPTOR.message_types_by_name['GetSchemaResponse'] = _GETSCHEMARESPONSE
+DESCRIPTOR.message_types_by_name['GetCreateStatementsRequest'] = _GETCREATESTATEMENTSREQUEST
+DESCRIPTOR.message_types_by_name['GetCreateStatementsResponse'] = _GETCREATESTATEMENTSRESPONSE
+DESCRIPTOR.message_types_by_name['GetSchemaTimestampsRequest'] = _GETSCHEMATIMESTAMPSREQUEST
+DESCRIPTOR.message_types_by_name['GetSchemaTimestampsResponse'] = _GETSCHEMATIMESTAMPSRESPONSE
 DESCRIPTOR.message_types_by_name['GetPermissionsRequest'] = _GETPERMISSIONSREQUEST
 DESCRIPTOR.message_types_by_name['GetPermissionsResponse'] = _GETPERMISSIONSRESPONSE
+DESCRIPTOR.message_types_by_name['ConnectionStats'] = _CONNECTIONSTATS
+DESCRIPTOR.message_types_by_name['GetConnectionStatsRequest'] = _GETCONNECTIONSTATSREQUEST
+DESCRIPTOR.message_types_by_name['GetConnectionStatsResponse'] = _GETCONNECTIONSTATSRESPONSE
+DESCRIPTOR.message_types_by_name['GetConnectionRejectionStatsRequest'] = _GETCONNECTIONREJECTIONSTATSREQUEST
+DESCRIPTOR.message_types_by_name['GetConnectionRejectionStatsResponse'] = _GETCONNECTIONREJECTIONSTATSRESPONSE
+DESCRIPTOR.message_types_by_name['GetConfigRequest'] = _GETCONFIGREQUEST
+DESCRIPTOR.message_types_by_name['GetConfigResponse'] = _GETCONFIGRESPONSE
+DESCRIPTOR.message_types_by_name['GetInFlightRPCsRequest'] = _GETINFLIGHTRPCSREQUEST
+DESCRIPTOR.message_types_by_name['GetInFlightRPCsResponse'] = _GETINFLIGHTRPCSRESPONSE
+DESCRIPTOR.message_types_by_name['ProcessStats'] = _PROCESSSTATS
+DESCRIPTOR.message_types_by_name['GetProcessStatsRequest'] = _GETPROCESSSTATSREQUEST
+DESCRIPTOR.message_types_by_name['GetProcessStatsResponse'] = _GETPROCESSSTATSRESPONSE
+DESCRIPTOR.message_types_by_name['HealthScore'] = _HEALTHSCORE
+DESCRIPTOR.message_types_by_name['GetHealthScoreRequest'] = _GETHEALTHSCOREREQUEST
+DESCRIPTOR.message_types_by_name['GetHealthScoreResponse'] = _GETHEALTHSCORERESPONSE
+DESCRIPTOR.message_types_by_name['GetErrorLogTailRequest'] = _GETERRORLOGTAILREQUEST
+DESCRIPTOR.message_types_by_name['GetErrorLogTailResponse'] = _GETERRORLOGTAILRESPONSE
+DESCRIPTOR.message_types_by_name['CheckDataDirRequest'] = _CHECKDATADIRREQUEST
+DESCRIPTOR.message_types_by_name['CheckDataDirResponse'] = _CHECKDATADIRRESPONSE
+DESCRIPTOR.message_types_by_name['DiagnosticBundle'] = _DIAGNOSTICBUNDLE
+DESCRIPTOR.message_types_by_name['CaptureDiagnosticsRequest'] = _CAPTUREDIAGNOSTICSREQUEST
+DESCRIPTOR.message_types_by_name['CaptureDiagnosticsResponse'] = _CAPTUREDIAGNOSTICSRESPONSE
+DESCRIPTOR.message_types_by_name['ThrottleState'] = _THROTTLESTATE
+DESCRIPTOR.message_types_by_name['GetThrottleStateRequest'] = _GETTHROTTLESTATEREQUEST
+DESCRIPTOR.message_types_by_name['GetThrottleStateResponse'] = _GETTHROTTLESTATERESPONSE
+DESCRIPTOR.message_types_by_name['GetTabletTagsRequest'] = _GETTABLETTAGSREQUEST
+DESCRIPTOR.message_types_by_name['GetTabletTagsResponse'] = _GETTABLETTAGSRESPONSE
+DESCRIPTOR.message_types_by_name['SetThrottleRequest'] = _SETTHROTTLEREQUEST
+DESCRIPTOR.message_types_by_name['SetThrottleResponse'] = _SETTHROTTLERESPONSE
 DESCRIPTOR.message_types_by_name['SetReadOnlyRequest'] = _SETREADONLYREQUEST
 DESCRIPTOR.message_types_by_name['SetReadOnlyResponse'] = _SETREADONLYRESPONSE
 DESCRIPTOR.message_types_by_name['SetReadWriteRequest'] = _SETREADWRITEREQUEST
 DESCRIPTOR.message_types_by_name['SetReadWriteResponse'] = _SETREADWRITERESPONSE
+DESCRIPTOR.message_types_by_name['SetReadOnlyWithTTLRequest'] = _SETREADONLYWITHTTLREQUEST
+DESCRIPTOR.message_types_by_name['SetReadOnlyWithTTLResponse'] = _SETREADONLYWITHTTLRESPONSE
+DESCRIPTOR.message_types_by_name['SetSuperReadOnlyRequest'] = _SETSUPERREADONLYREQUEST
+DESCRIPTOR.message_types_by_name['SetSuperReadOnlyResponse'] = _SETSUPERREADONLYRESPONSE
+DESCRIPTOR.message_types_by_name['QueryServerConfig'] = _QUERYSERVERCONFIG
+DESCRIPTOR.message_types_by_name['SetQueryServerConfigRequest'] = _SETQUERYSERVERCONFIGREQUEST
+DESCRIPTOR.message_types_by_name['SetQueryServerConfigResponse'] = _SETQUERYSERVERCONFIGRESPONSE
+DESCRIPTOR.message_types_by_name['GetQueryBlacklistRequest'] = _GETQUERYBLACKLISTREQUEST
+DESCRIPTOR.message_types_by_name['GetQueryBlacklistResponse'] = _GETQUERYBLACKLISTRESPONSE
+DESCRIPTOR.message_types_by_name['SetQueryBlacklistRequest'] = _SETQUERYBLACKLISTREQUEST
+DESCRIPTOR.message_types_by_name['SetQueryBlacklistResponse'] = _SETQUERYBLACKLISTRESPONSE
 DESCRIPTOR.message_types_by_name['ChangeTypeRequest'] = _CHANGETYPEREQUEST
 DESCRIPTOR.message_types_by_name['ChangeTypeResponse'] = _CHANGETYPERESPONSE
+DESCRIPTOR.message_types_by_name['SetTabletTagsRequest'] = _SETTABLETTAGSREQUEST
+DESCRIPTOR.message_types_by_name['SetTabletTagsResponse'] = _SETTABLETTAGSRESPONSE
 DESCRIPTOR.message_types_by_name['RefreshStateRequest'] = _REFRESHSTATEREQUEST
 DESCRIPTOR.message_types_by_name['RefreshStateResponse'] = _REFRESHSTATERESPONSE
+DESCRIPTOR.message_types_by_name['RepublishTopoRecordRequest'] = _REPUBLISHTOPORECORDREQUEST
+DESCRIPTOR.message_types_by_name['RepublishTopoRecordResponse'] = _REPUBLISHTOPORECORDRESPONSE
 DESCRIPTOR.message_types_by_name['RunHealthCheckRequest'] = _RUNHEALTHCHECKREQUEST
 DESCRIPTOR.message_types_by_name['RunHealthCheckResponse'] = _RUNHEALTHCHECKRESPONSE
 DESCRIPTOR.message_types_by_name['IgnoreHealthErrorRequest'] = _IGNOREHEALTHERRORREQUEST
 DESCRIPTOR.message_types_by_name['IgnoreHealthErrorResponse'] = _IGNOREHEALTHERRORRESPONSE
+DESCRIPTOR.message_types_by_name['SetMaintenanceModeRequest'] = _SETMAINTENANCEMODEREQUEST
+DESCRIPTOR.message_types_by_name['SetMaintenanceModeResponse'] = _SETMAINTENANCEMODERESPONSE
+DESCRIPTOR.message_types_by_name['PauseHealthReportingRequest'] = _PAUSEHEALTHREPORTINGREQUEST
+DESCRIPTOR.message_types_by_name['PauseHealthReportingResponse'] = _PAUSEHEALTHREPORTINGRESPONSE
+DESCRIPTOR.message_types_by_name['PrepareCutoverRequest'] = _PREPARECUTOVERREQUEST
+DESCRIPTOR.message_types_by_name['PrepareCutoverResponse'] = _PREPARECUTOVERRESPONSE
+DESCRIPTOR.message_types_by_name['CommitCutoverRequest'] = _COMMITCUTOVERREQUEST
+DESCRIPTOR.message_types_by_name['CommitCutoverResponse'] = _COMMITCUTOVERRESPONSE
+DESCRIPTOR.message_types_by_name['AbortCutoverRequest'] = _ABORTCUTOVERREQUEST
+DESCRIPTOR.message_types_by_name['AbortCutoverResponse'] = _ABORTCUTOVERRESPONSE
+DESCRIPTOR.message_types_by_name['SetServingKeyRangeRequest'] = _SETSERVINGKEYRANGEREQUEST
+DESCRIPTOR.message_types_by_name['SetServingKeyRangeResponse'] = _SETSERVINGKEYRANGERESPONSE
+DESCRIPTOR.message_types_by_name['RestartMysqlRequest'] = _RESTARTMYSQLREQUEST
+DESCRIPTOR.message_types_by_name['RestartMysqlResponse'] = _RESTARTMYSQLRESPONSE
+DESCRIPTOR.message_types_by_name['DecommissionRequest'] = _DECOMMISSIONREQUEST
+DESCRIPTOR.message_types_by_name['DecommissionResponse'] = _DECOMMISSIONRESPONSE
+DESCRIPTOR.message_types_by_name['CheckTopoConnectivityRequest'] = _CHECKTOPOCONNECTIVITYREQUEST
+DESCRIPTOR.message_types_by_name['CheckTopoConnectivityResponse'] = _CHECKTOPOCONNECTIVITYRESPONSE
+DESCRIPTOR.message_types_by_name['WarmUpRequest'] = _WARMUPREQUEST
+DESCRIPTOR.message_types_by_name['WarmUpResponse'] = _WARMUPRESPONSE
 DESCRIPTOR.message_types_by_name['ReloadSchemaRequest'] = _RELOADSCHEMAREQUEST
 DESCRIPTOR.message_types_by_name['ReloadSchemaResponse'] = _RELOADSCHEMARESPONSE
 DESCRIPTOR.message_types_by_name['PreflightSchemaRequest'] = _PREFLIGHTSCHEMAREQUEST
 DESCRIPTOR.message_types_by_name['PreflightSchemaResponse'] = _PREFLIGHTSCHEMARESPONSE
 DESCRIPTOR.message_types_by_name['ApplySchemaRequest'] = _APPLYSCHEMAREQUEST
 DESCRIPTOR.message_types_by_name['ApplySchemaResponse'] = _APPLYSCHEMARESPONSE
+DESCRIPTOR.message_types_by_name['SchemaDiffRequest'] = _SCHEMADIFFREQUEST
+DESCRIPTOR.message_types_by_name['SchemaDiffResponse'] = _SCHEMADIFFRESPONSE
+DESCRIPTOR.message_types_by_name['SchemaChangeAssessment'] = _SCHEMACHANGEASSESSMENT
+DESCRIPTOR.message_types_by_name['AssessSchemaChangeRequest'] = _ASSESSSCHEMACHANGEREQUEST
+DESCRIPTOR.message_types_by_name['AssessSchemaChangeResponse'] = _ASSESSSCHEMACHANGERESPONSE
+DESCRIPTOR.message_types_by_name['SchemaChangeEvent'] = _SCHEMACHANGEEVENT
+DESCRIPTOR.message_types_by_name['WatchSchemaRequest'] = _WATCHSCHEMAREQUEST
+DESCRIPTOR.message_types_by_name['WatchSchemaResponse'] = _WATCHSCHEMARESPONSE
+DESCRIPTOR.message_types_by_name['GetVSchemaRequest'] = _GETVSCHEMAREQUEST
+DESCRIPTOR.message_types_by_name['GetVSchemaResponse'] = _GETVSCHEMARESPONSE
+DESCRIPTOR.message_types_by_name['ApplyVSchemaRequest'] = _APPLYVSCHEMAREQUEST
+DESCRIPTOR.message_types_by_name['ApplyVSchemaResponse'] = _APPLYVSCHEMARESPONSE
 DESCRIPTOR.message_types_by_name['ExecuteFetchAsDbaRequest'] = _EXECUTEFETCHASDBAREQUEST
 DESCRIPTOR.message_types_by_name['ExecuteFetchAsDbaResponse'] = _EXECUTEFETCHASDBARESPONSE
+DESCRIPTOR.message_types_by_name['ExecuteFetchAsDbaCSVRequest'] = _EXECUTEFETCHASDBACSVREQUEST
+DESCRIPTOR.message_types_by_name['ExecuteFetchAsDbaCSVResponse'] = _EXECUTEFETCHASDBACSVRESPONSE
+DESCRIPTOR.message_types_by_name['ColumnarResult'] = _COLUMNARRESULT
+DESCRIPTOR.message_types_by_name['ExecuteFetchColumnarRequest'] = _EXECUTEFETCHCOLUMNARREQUEST
+DESCRIPTOR.message_types_by_name['ExecuteFetchColumnarResponse'] = _EXECUTEFETCHCOLUMNARRESPONSE
 DESCRIPTOR.message_types_by_name['ExecuteFetchAsAllPrivsRequest'] = _EXECUTEFETCHASALLPRIVSREQUEST
 DESCRIPTOR.message_types_by_name['ExecuteFetchAsAllPrivsResponse'] = _EXECUTEFETCHASALLPRIVSRESPONSE
 DESCRIPTOR.message_types_by_name['ExecuteFetchAsAppRequest'] = _EXECUTEFETCHASAPPREQUEST
 DESCRIPTOR.message_types_by_name['ExecuteFetchAsAppResponse'] = _EXECUTEFETCHASAPPRESPONSE
+DESCRIPTOR.message_types_by_name['ExplainQueryRequest'] = _EXPLAINQUERYREQUEST
+DESCRIPTOR.message_types_by_name['ExplainQueryResponse'] = _EXPLAINQUERYRESPONSE
+DESCRIPTOR.message_types_by_name['ApplyGrantsRequest'] = _APPLYGRANTSREQUEST
+DESCRIPTOR.message_types_by_name['ApplyGrantsResponse'] = _APPLYGRANTSRESPONSE
+DESCRIPTOR.message_types_by_name['ChecksumTableRequest'] = _CHECKSUMTABLEREQUEST
+DESCRIPTOR.message_types_by_name['ChecksumTableResponse'] = _CHECKSUMTABLERESPONSE
+DESCRIPTOR.message_types_by_name['TruncateTableRequest'] = _TRUNCATETABLEREQUEST
+DESCRIPTOR.message_types_by_name['TruncateTableResponse'] = _TRUNCATETABLERESPONSE
+DESCRIPTOR.message_types_by_name['RenameTableRequest'] = _RENAMETABLEREQUEST
+DESCRIPTOR.message_types_by_name['RenameTableResult'] = _RENAMETABLERESULT
+DESCRIPTOR.message_types_by_name['RenameTableResponse'] = _RENAMETABLERESPONSE
+DESCRIPTOR.message_types_by_name['StreamRowsInKeyRangeRequest'] = _STREAMROWSINKEYRANGEREQUEST
+DESCRIPTOR.message_types_by_name['StreamRowsInKeyRangeResponse'] = _STREAMROWSINKEYRANGERESPONSE
+DESCRIPTOR.message_types_by_name['StreamKeyRangeBinlogRequest'] = _STREAMKEYRANGEBINLOGREQUEST
+DESCRIPTOR.message_types_by_name['StreamKeyRangeBinlogResponse'] = _STREAMKEYRANGEBINLOGRESPONSE
+DESCRIPTOR.message_types_by_name['CloneStreamRequest'] = _CLONESTREAMREQUEST
+DESCRIPTOR.message_types_by_name['CloneStreamResponse'] = _CLONESTREAMRESPONSE
+DESCRIPTOR.message_types_by_name['Process'] = _PROCESS
+DESCRIPTOR.message_types_by_name['GetProcessListRequest'] = _GETPROCESSLISTREQUEST
+DESCRIPTOR.message_types_by_name['GetProcessListResponse'] = _GETPROCESSLISTRESPONSE
+DESCRIPTOR.message_types_by_name['CharsetConfig'] = _CHARSETCONFIG
+DESCRIPTOR.message_types_by_name['GetCharsetConfigRequest'] = _GETCHARSETCONFIGREQUEST
+DESCRIPTOR.message_types_by_name['GetCharsetConfigResponse'] = _GETCHARSETCONFIGRESPONSE
+DESCRIPTOR.message_types_by_name['KillProcessRequest'] = _KILLPROCESSREQUEST
+DESCRIPTOR.message_types_by_name['KillProcessResponse'] = _KILLPROCESSRESPONSE
+DESCRIPTOR.message_types_by_name['TailGeneralLogRequest'] = _TAILGENERALLOGREQUEST
+DESCRIPTOR.message_types_by_name['TailGeneralLogResponse'] = _TAILGENERALLOGRESPONSE
+DESCRIPTOR.message_types_by_name['QPSSample'] = _QPSSAMPLE
+DESCRIPTOR.message_types_by_name['StreamQPSRequest'] = _STREAMQPSREQUEST
+DESCRIPTOR.message_types_by_name['StreamQPSResponse'] = _STREAMQPSRESPONSE
 DESCRIPTOR.message_types_by_name['SlaveStatusRequest'] = _SLAVESTATUSREQUEST
 DESCRIPTOR.message_types_by_name['SlaveStatusResponse'] = _SLAVESTATUSRESPONSE
+DESCRIPTOR.message_types_by_name['SlaveStatusAllChannelsRequest'] = _SLAVESTATUSALLCHANNELSREQUEST
+DESCRIPTOR.message_types_by_name['SlaveStatusAllChannelsResponse'] = _SLAVESTATUSALLCHANNELSRESPONSE
+DESCRIPTOR.message_types_by_name['ReplicationSource'] = _REPLICATIONSOURCE
+DESCRIPTOR.message_types_by_name['GetReplicationSourceRequest'] = _GETREPLICATIONSOURCEREQUEST
+DESCRIPTOR.message_types_by_name['GetReplicationSourceResponse'] = _GETREPLICATIONSOURCERESPONSE
+DESCRIPTOR.message_types_by_name['ValidateReplicationCredentialsRequest'] = _VALIDATEREPLICATIONCREDENTIALSREQUEST
+DESCRIPTOR.message_types_by_name['ValidateReplicationCredentialsResponse'] = _VALIDATEREPLICATIONCREDENTIALSRESPONSE
+DESCRIPTOR.message_types_by_name['ApplierWorkerStats'] = _APPLIERWORKERSTATS
+DESCRIPTOR.message_types_by_name['ApplierQueueStats'] = _APPLIERQUEUESTATS
+DESCRIPTOR.message_types_by_name['GetApplierQueueStatsRequest'] = _GETAPPLIERQUEUESTATSREQUEST
+DESCRIPTOR.message_types_by_name['GetApplierQueueStatsResponse'] = _GETAPPLIERQUEUESTATSRESPONSE
 DESCRIPTOR.message_types_by_name['MasterPositionRequest'] = _MASTERPOSITIONREQUEST
 DESCRIPTOR.message_types_by_name['MasterPositionResponse'] = _MASTERPOSITIONRESPONSE
+DESCRIPTOR.message_types_by_name['GetGtidPurgedRequest'] = _GETGTIDPURGEDREQUEST
+DESCRIPTOR.message_types_by_name['GetGtidPurgedResponse'] = _GETGTIDPURGEDRESPONSE
+DESCRIPTOR.message_types_by_name['GetBinlogStatsRequest'] = _GETBINLOGSTATSREQUEST
+DESCRIPTOR.message_types_by_name['GetBinlogStatsResponse'] = _GETBINLOGSTATSRESPONSE
+DESCRIPTOR.message_types_by_name['ReplicationErrorStats'] = _REPLICATIONERRORSTATS
+DESCRIPTOR.message_types_by_name['GetReplicationErrorStatsRequest'] = _GETREPLICATIONERRORSTATSREQUEST
+DESCRIPTOR.message_types_by_name['GetReplicationErrorStatsResponse'] = _GETREPLICATIONERRORSTATSRESPONSE
 DESCRIPTOR.message_types_by_name['StopSlaveRequest'] = _STOPSLAVEREQUEST
 DESCRIPTOR.message_types_by_name['StopSlaveResponse'] = _STOPSLAVERESPONSE
 DESCRIPTOR.message_types_by_name['StopSlaveMinimumRequest'] = _STOPSLAVEMINIMUMREQUEST
 DESCRIPTOR.message_types_by_name['StopSlaveMinimumResponse'] = _STOPSLAVEMINIMUMRESPONSE
+DESCRIPTOR.message_types_by_name['StopSlaveMinimumStreamRequest'] = _STOPSLAVEMINIMUMSTREAMREQUEST
+DESCRIPTOR.message_types_by_name['StopSlaveMinimumStreamResponse'] = _STOPSLAVEMINIMUMSTREAMRESPONSE
+DESCRIPTOR.message_types_by_name['BoostReplicationCatchupRequest'] = _BOOSTREPLICATIONCATCHUPREQUEST
+DESCRIPTOR.message_types_by_name['BoostReplicationCatchupResponse'] = _BOOSTREPLICATIONCATCHUPRESPONSE
 DESCRIPTOR.message_types_by_name['StartSlaveRequest'] = _STARTSLAVEREQUEST
 DESCRIPTOR.message_types_by_name['StartSlaveResponse'] = _STARTSLAVERESPONSE
+DESCRIPTOR.message_types_by_name['EnableParallelReplicationRequest'] = _ENABLEPARALLELREPLICATIONREQUEST
+DESCRIPTOR.message_types_by_name['EnableParallelReplicationResponse'] = _ENABLEPARALLELREPLICATIONRESPONSE
+DESCRIPTOR.message_types_by_name['RotateReplicationCredentialsRequest'] = _ROTATEREPLICATIONCREDENTIALSREQUEST
+DESCRIPTOR.message_types_by_name['RotateReplicationCredentialsResponse'] = _ROTATEREPLICATIONCREDENTIALSRESPONSE
+DESCRIPTOR.message_types_by_name['ReplicationSSLOptions'] = _REPLICATIONSSLOPTIONS
+DESCRIPTOR.message_types_by_name['ConfigureReplicationSSLRequest'] = _CONFIGUREREPLICATIONSSLREQUEST
+DESCRIPTOR.message_types_by_name['ConfigureReplicationSSLResponse'] = _CONFIGUREREPLICATIONSSLRESPONSE
+DESCRIPTOR.message_types_by_name['RepairRelayLogRequest'] = _REPAIRRELAYLOGREQUEST
+DESCRIPTOR.message_types_by_name['RepairRelayLogResponse'] = _REPAIRRELAYLOGRESPONSE
 DESCRIPTOR.message_types_by_name['TabletExternallyReparentedRequest'] = _TABLETEXTERNALLYREPARENTEDREQUEST
 DESCRIPTOR.message_types_by_name['TabletExternallyReparentedResponse'] = _TABLETEXTERNALLYREPARENTEDRESPONSE
 DESCRIPTOR.message_types_by_name['TabletExternallyElectedRequest'] = _TABLETEXTERNALLYELECTEDREQUEST
 DESCRIPTOR.message_types_by_name['TabletExternallyElectedResponse'] = _TABLETEXTERNALLYELECTEDRESPONSE
 DESCRIPTOR.message_types_by_name['GetSlavesRequest'] = _GETSLAVESREQUEST
 DESCRIPTOR.message_types_by_name['GetSlavesResponse'] = _GETSLAVESRESPONSE
+DESCRIPTOR.message_types_by_name['ReplicationNeighbor'] = _REPLICATIONNEIGHBOR
+DESCRIPTOR.message_types_by_name['ReplicationGraph'] = _REPLICATIONGRAPH
+DESCRIPTOR.message_types_by_name['GetReplicationGraphRequest'] = _GETREPLICATIONGRAPHREQUEST
+DESCRIPTOR.message_types_by_name['GetReplicationGraphResponse'] = _GETREPLICATIONGRAPHRESPONSE
 DESCRIPTOR.message_types_by_name['WaitBlpPositionRequest'] = _WAITBLPPOSITIONREQUEST
 DESCRIPTOR.message_types_by_name['WaitBlpPositionResponse'] = _WAITBLPPOSITIONRESPONSE
 DESCRIPTOR.message_types_by_name['StopBlpRequest'] = _STOPBLPREQUEST
@@ -3102,12 +10530,18 @@ DESCRIPTOR.message_types_by_name['StartBlpRequest'] = _STARTBLPREQUEST
 DESCRIPTOR.message_types_by_name['StartBlpResponse'] = _STARTBLPRESPONSE
 DESCRIPTOR.message_types_by_name['RunBlpUntilRequest'] = _RUNBLPUNTILREQUEST
 DESCRIPTOR.message_types_by_name['RunBlpUntilResponse'] = _RUNBLPUNTILRESPONSE
+DESCRIPTOR.message_types_by_name['BinlogFilter'] = _BINLOGFILTER
+DESCRIPTOR.message_types_by_name['GetBinlogFiltersRequest'] = _GETBINLOGFILTERSREQUEST
+DESCRIPTOR.message_types_by_name['GetBinlogFiltersResponse'] = _GETBINLOGFILTERSRESPONSE
 DESCRIPTOR.message_types_by_name['ResetReplicationRequest'] = _RESETREPLICATIONREQUEST
 DESCRIPTOR.message_types_by_name['ResetReplicationResponse'] = _RESETREPLICATIONRESPONSE
 DESCRIPTOR.message_types_by_name['InitMasterRequest'] = _INITMASTERREQUEST
 DESCRIPTOR.message_types_by_name['InitMasterResponse'] = _INITMASTERRESPONSE
 DESCRIPTOR.message_types_by_name['PopulateReparentJournalRequest'] = _POPULATEREPARENTJOURNALREQUEST
 DESCRIPTOR.message_types_by_name['PopulateReparentJournalResponse'] = _POPULATEREPARENTJOURNALRESPONSE
+DESCRIPTOR.message_types_by_name['ReparentJournalEntry'] = _REPARENTJOURNALENTRY
+DESCRIPTOR.message_types_by_name['GetLastReparentJournalEntryRequest'] = _GETLASTREPARENTJOURNALENTRYREQUEST
+DESCRIPTOR.message_types_by_name['GetLastReparentJournalEntryResponse'] = _GETLASTREPARENTJOURNALENTRYRESPONSE
 DESCRIPTOR.message_types_by_name['InitSlaveRequest'] = _INITSLAVEREQUEST
 DESCRIPTOR.message_types_by_name['InitSlaveResponse'] = _INITSLAVERESPONSE
 DESCRIPTOR.message_types_by_name['DemoteMasterRequest'] = _DEMOTEMASTERREQUEST
@@ -3118,341 +10552,1370 @@ DESCRIPTOR.message_types_by_name['SlaveWasPromotedRequest'] = _SLAVEWASPROMOTEDR
 DESCRIPTOR.message_types_by_name['SlaveWasPromotedResponse'] = _SLAVEWASPROMOTEDRESPONSE
 DESCRIPTOR.message_types_by_name['SetMasterRequest'] = _SETMASTERREQUEST
 DESCRIPTOR.message_types_by_name['SetMasterResponse'] = _SETMASTERRESPONSE
+DESCRIPTOR.message_types_by_name['ConfigureReplicationRequest'] = _CONFIGUREREPLICATIONREQUEST
+DESCRIPTOR.message_types_by_name['ConfigureReplicationResponse'] = _CONFIGUREREPLICATIONRESPONSE
 DESCRIPTOR.message_types_by_name['SlaveWasRestartedRequest'] = _SLAVEWASRESTARTEDREQUEST
 DESCRIPTOR.message_types_by_name['SlaveWasRestartedResponse'] = _SLAVEWASRESTARTEDRESPONSE
 DESCRIPTOR.message_types_by_name['StopReplicationAndGetStatusRequest'] = _STOPREPLICATIONANDGETSTATUSREQUEST
 DESCRIPTOR.message_types_by_name['StopReplicationAndGetStatusResponse'] = _STOPREPLICATIONANDGETSTATUSRESPONSE
 DESCRIPTOR.message_types_by_name['PromoteSlaveRequest'] = _PROMOTESLAVEREQUEST
 DESCRIPTOR.message_types_by_name['PromoteSlaveResponse'] = _PROMOTESLAVERESPONSE
+DESCRIPTOR.message_types_by_name['SetReparentEligibilityRequest'] = _SETREPARENTELIGIBILITYREQUEST
+DESCRIPTOR.message_types_by_name['SetReparentEligibilityResponse'] = _SETREPARENTELIGIBILITYRESPONSE
+DESCRIPTOR.message_types_by_name['CheckReparentCandidateRequest'] = _CHECKREPARENTCANDIDATEREQUEST
+DESCRIPTOR.message_types_by_name['CheckReparentCandidateResponse'] = _CHECKREPARENTCANDIDATERESPONSE
+DESCRIPTOR.message_types_by_name['SetSemiSyncAckCountRequest'] = _SETSEMISYNCACKCOUNTREQUEST
+DESCRIPTOR.message_types_by_name['SetSemiSyncAckCountResponse'] = _SETSEMISYNCACKCOUNTRESPONSE
+DESCRIPTOR.message_types_by_name['DurabilityPolicy'] = _DURABILITYPOLICY
+DESCRIPTOR.message_types_by_name['GetDurabilityPolicyRequest'] = _GETDURABILITYPOLICYREQUEST
+DESCRIPTOR.message_types_by_name['GetDurabilityPolicyResponse'] = _GETDURABILITYPOLICYRESPONSE
+DESCRIPTOR.message_types_by_name['GetBackupLimitsRequest'] = _GETBACKUPLIMITSREQUEST
+DESCRIPTOR.message_types_by_name['GetBackupLimitsResponse'] = _GETBACKUPLIMITSRESPONSE
+DESCRIPTOR.message_types_by_name['GetBackupPositionRequest'] = _GETBACKUPPOSITIONREQUEST
+DESCRIPTOR.message_types_by_name['GetBackupPositionResponse'] = _GETBACKUPPOSITIONRESPONSE
 DESCRIPTOR.message_types_by_name['BackupRequest'] = _BACKUPREQUEST
 DESCRIPTOR.message_types_by_name['BackupResponse'] = _BACKUPRESPONSE
+DESCRIPTOR.message_types_by_name['IncrementalBackupRequest'] = _INCREMENTALBACKUPREQUEST
+DESCRIPTOR.message_types_by_name['IncrementalBackupResponse'] = _INCREMENTALBACKUPRESPONSE
 DESCRIPTOR.message_types_by_name['RestoreFromBackupRequest'] = _RESTOREFROMBACKUPREQUEST
 DESCRIPTOR.message_types_by_name['RestoreFromBackupResponse'] = _RESTOREFROMBACKUPRESPONSE
+DESCRIPTOR.message_types_by_name['RestoreToTimestampRequest'] = _RESTORETOTIMESTAMPREQUEST
+DESCRIPTOR.message_types_by_name['RestoreToTimestampResponse'] = _RESTORETOTIMESTAMPRESPONSE
+DESCRIPTOR.message_types_by_name['SetPreferredBackupRequest'] = _SETPREFERREDBACKUPREQUEST
+DESCRIPTOR.message_types_by_name['SetPreferredBackupResponse'] = _SETPREFERREDBACKUPRESPONSE
+DESCRIPTOR.message_types_by_name['GetPreferredBackupRequest'] = _GETPREFERREDBACKUPREQUEST
+DESCRIPTOR.message_types_by_name['GetPreferredBackupResponse'] = _GETPREFERREDBACKUPRESPONSE
+DESCRIPTOR.message_types_by_name['CompatCheck'] = _COMPATCHECK
+DESCRIPTOR.message_types_by_name['CompatReport'] = _COMPATREPORT
+DESCRIPTOR.message_types_by_name['CheckRestoreCompatibilityRequest'] = _CHECKRESTORECOMPATIBILITYREQUEST
+DESCRIPTOR.message_types_by_name['CheckRestoreCompatibilityResponse'] = _CHECKRESTORECOMPATIBILITYRESPONSE
+DESCRIPTOR.message_types_by_name['BackupInfo'] = _BACKUPINFO
+DESCRIPTOR.message_types_by_name['GetLastBackupInfoRequest'] = _GETLASTBACKUPINFOREQUEST
+DESCRIPTOR.message_types_by_name['GetLastBackupInfoResponse'] = _GETLASTBACKUPINFORESPONSE
+DESCRIPTOR.message_types_by_name['GetBackupFreshnessRequest'] = _GETBACKUPFRESHNESSREQUEST
+DESCRIPTOR.message_types_by_name['GetBackupFreshnessResponse'] = _GETBACKUPFRESHNESSRESPONSE
+DESCRIPTOR.message_types_by_name['ReadinessReport'] = _READINESSREPORT
+DESCRIPTOR.message_types_by_name['CheckBackupReadinessRequest'] = _CHECKBACKUPREADINESSREQUEST
+DESCRIPTOR.message_types_by_name['CheckBackupReadinessResponse'] = _CHECKBACKUPREADINESSRESPONSE
+DESCRIPTOR.message_types_by_name['TestRestoreRequest'] = _TESTRESTOREREQUEST
+DESCRIPTOR.message_types_by_name['TestRestoreResponse'] = _TESTRESTORERESPONSE
+
+TableDefinition = _reflection.GeneratedProtocolMessageType('TableDefinition', (_message.Message,), dict(
+  DESCRIPTOR = _TABLEDEFINITION,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.TableDefinition)
+  ))
+_sym_db.RegisterMessage(TableDefinition)
+
+SchemaDefinition = _reflection.GeneratedProtocolMessageType('SchemaDefinition', (_message.Message,), dict(
+  DESCRIPTOR = _SCHEMADEFINITION,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SchemaDefinition)
+  ))
+_sym_db.RegisterMessage(SchemaDefinition)
+
+SchemaChangeResult = _reflection.GeneratedProtocolMessageType('SchemaChangeResult', (_message.Message,), dict(
+  DESCRIPTOR = _SCHEMACHANGERESULT,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SchemaChangeResult)
+  ))
+_sym_db.RegisterMessage(SchemaChangeResult)
+
+UserPermission = _reflection.GeneratedProtocolMessageType('UserPermission', (_message.Message,), dict(
+
+  PrivilegesEntry = _reflection.GeneratedProtocolMessageType('PrivilegesEntry', (_message.Message,), dict(
+    DESCRIPTOR = _USERPERMISSION_PRIVILEGESENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.UserPermission.PrivilegesEntry)
+    ))
+  ,
+  DESCRIPTOR = _USERPERMISSION,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.UserPermission)
+  ))
+_sym_db.RegisterMessage(UserPermission)
+_sym_db.RegisterMessage(UserPermission.PrivilegesEntry)
+
+DbPermission = _reflection.GeneratedProtocolMessageType('DbPermission', (_message.Message,), dict(
+
+  PrivilegesEntry = _reflection.GeneratedProtocolMessageType('PrivilegesEntry', (_message.Message,), dict(
+    DESCRIPTOR = _DBPERMISSION_PRIVILEGESENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.DbPermission.PrivilegesEntry)
+    ))
+  ,
+  DESCRIPTOR = _DBPERMISSION,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.DbPermission)
+  ))
+_sym_db.RegisterMessage(DbPermission)
+_sym_db.RegisterMessage(DbPermission.PrivilegesEntry)
+
+Permissions = _reflection.GeneratedProtocolMessageType('Permissions', (_message.Message,), dict(
+  DESCRIPTOR = _PERMISSIONS,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.Permissions)
+  ))
+_sym_db.RegisterMessage(Permissions)
+
+BlpPosition = _reflection.GeneratedProtocolMessageType('BlpPosition', (_message.Message,), dict(
+  DESCRIPTOR = _BLPPOSITION,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.BlpPosition)
+  ))
+_sym_db.RegisterMessage(BlpPosition)
+
+PingRequest = _reflection.GeneratedProtocolMessageType('PingRequest', (_message.Message,), dict(
+  DESCRIPTOR = _PINGREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.PingRequest)
+  ))
+_sym_db.RegisterMessage(PingRequest)
+
+PingResponse = _reflection.GeneratedProtocolMessageType('PingResponse', (_message.Message,), dict(
+  DESCRIPTOR = _PINGRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.PingResponse)
+  ))
+_sym_db.RegisterMessage(PingResponse)
+
+SleepRequest = _reflection.GeneratedProtocolMessageType('SleepRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SLEEPREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SleepRequest)
+  ))
+_sym_db.RegisterMessage(SleepRequest)
+
+SleepResponse = _reflection.GeneratedProtocolMessageType('SleepResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SLEEPRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SleepResponse)
+  ))
+_sym_db.RegisterMessage(SleepResponse)
+
+ExecuteHookRequest = _reflection.GeneratedProtocolMessageType('ExecuteHookRequest', (_message.Message,), dict(
+
+  ExtraEnvEntry = _reflection.GeneratedProtocolMessageType('ExtraEnvEntry', (_message.Message,), dict(
+    DESCRIPTOR = _EXECUTEHOOKREQUEST_EXTRAENVENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteHookRequest.ExtraEnvEntry)
+    ))
+  ,
+  DESCRIPTOR = _EXECUTEHOOKREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteHookRequest)
+  ))
+_sym_db.RegisterMessage(ExecuteHookRequest)
+_sym_db.RegisterMessage(ExecuteHookRequest.ExtraEnvEntry)
+
+ExecuteHookResponse = _reflection.GeneratedProtocolMessageType('ExecuteHookResponse', (_message.Message,), dict(
+  DESCRIPTOR = _EXECUTEHOOKRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteHookResponse)
+  ))
+_sym_db.RegisterMessage(ExecuteHookResponse)
+
+ExecuteHookToStreamRequest = _reflection.GeneratedProtocolMessageType('ExecuteHookToStreamRequest', (_message.Message,), dict(
+
+  ExtraEnvEntry = _reflection.GeneratedProtocolMessageType('ExtraEnvEntry', (_message.Message,), dict(
+    DESCRIPTOR = _EXECUTEHOOKTOSTREAMREQUEST_EXTRAENVENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteHookToStreamRequest.ExtraEnvEntry)
+    ))
+  ,
+  DESCRIPTOR = _EXECUTEHOOKTOSTREAMREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteHookToStreamRequest)
+  ))
+_sym_db.RegisterMessage(ExecuteHookToStreamRequest)
+_sym_db.RegisterMessage(ExecuteHookToStreamRequest.ExtraEnvEntry)
+
+ExecuteHookToStreamResponse = _reflection.GeneratedProtocolMessageType('ExecuteHookToStreamResponse', (_message.Message,), dict(
+  DESCRIPTOR = _EXECUTEHOOKTOSTREAMRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteHookToStreamResponse)
+  ))
+_sym_db.RegisterMessage(ExecuteHookToStreamResponse)
+
+GetSchemaRequest = _reflection.GeneratedProtocolMessageType('GetSchemaRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETSCHEMAREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetSchemaRequest)
+  ))
+_sym_db.RegisterMessage(GetSchemaRequest)
+
+GetSchemaResponse = _reflection.GeneratedProtocolMessageType('GetSchemaResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETSCHEMARESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetSchemaResponse)
+  ))
+_sym_db.RegisterMessage(GetSchemaResponse)
+
+GetCreateStatementsRequest = _reflection.GeneratedProtocolMessageType('GetCreateStatementsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETCREATESTATEMENTSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetCreateStatementsRequest)
+  ))
+_sym_db.RegisterMessage(GetCreateStatementsRequest)
+
+GetCreateStatementsResponse = _reflection.GeneratedProtocolMessageType('GetCreateStatementsResponse', (_message.Message,), dict(
+
+  CreateStatementsEntry = _reflection.GeneratedProtocolMessageType('CreateStatementsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _GETCREATESTATEMENTSRESPONSE_CREATESTATEMENTSENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetCreateStatementsResponse.CreateStatementsEntry)
+    ))
+  ,
+  DESCRIPTOR = _GETCREATESTATEMENTSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetCreateStatementsResponse)
+  ))
+_sym_db.RegisterMessage(GetCreateStatementsResponse)
+_sym_db.RegisterMessage(GetCreateStatementsResponse.CreateStatementsEntry)
+
+GetSchemaTimestampsRequest = _reflection.GeneratedProtocolMessageType('GetSchemaTimestampsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETSCHEMATIMESTAMPSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetSchemaTimestampsRequest)
+  ))
+_sym_db.RegisterMessage(GetSchemaTimestampsRequest)
+
+GetSchemaTimestampsResponse = _reflection.GeneratedProtocolMessageType('GetSchemaTimestampsResponse', (_message.Message,), dict(
+
+  TimestampsEntry = _reflection.GeneratedProtocolMessageType('TimestampsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _GETSCHEMATIMESTAMPSRESPONSE_TIMESTAMPSENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetSchemaTimestampsResponse.TimestampsEntry)
+    ))
+  ,
+  DESCRIPTOR = _GETSCHEMATIMESTAMPSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetSchemaTimestampsResponse)
+  ))
+_sym_db.RegisterMessage(GetSchemaTimestampsResponse)
+_sym_db.RegisterMessage(GetSchemaTimestampsResponse.TimestampsEntry)
+
+GetPermissionsRequest = _reflection.GeneratedProtocolMessageType('GetPermissionsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETPERMISSIONSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetPermissionsRequest)
+  ))
+_sym_db.RegisterMessage(GetPermissionsRequest)
+
+GetPermissionsResponse = _reflection.GeneratedProtocolMessageType('GetPermissionsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETPERMISSIONSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetPermissionsResponse)
+  ))
+_sym_db.RegisterMessage(GetPermissionsResponse)
+
+ConnectionStats = _reflection.GeneratedProtocolMessageType('ConnectionStats', (_message.Message,), dict(
+  DESCRIPTOR = _CONNECTIONSTATS,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ConnectionStats)
+  ))
+_sym_db.RegisterMessage(ConnectionStats)
+
+GetConnectionStatsRequest = _reflection.GeneratedProtocolMessageType('GetConnectionStatsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETCONNECTIONSTATSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetConnectionStatsRequest)
+  ))
+_sym_db.RegisterMessage(GetConnectionStatsRequest)
+
+GetConnectionStatsResponse = _reflection.GeneratedProtocolMessageType('GetConnectionStatsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETCONNECTIONSTATSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetConnectionStatsResponse)
+  ))
+_sym_db.RegisterMessage(GetConnectionStatsResponse)
+
+GetConnectionRejectionStatsRequest = _reflection.GeneratedProtocolMessageType('GetConnectionRejectionStatsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETCONNECTIONREJECTIONSTATSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetConnectionRejectionStatsRequest)
+  ))
+_sym_db.RegisterMessage(GetConnectionRejectionStatsRequest)
+
+GetConnectionRejectionStatsResponse = _reflection.GeneratedProtocolMessageType('GetConnectionRejectionStatsResponse', (_message.Message,), dict(
+
+  RejectionsEntry = _reflection.GeneratedProtocolMessageType('RejectionsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _GETCONNECTIONREJECTIONSTATSRESPONSE_REJECTIONSENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetConnectionRejectionStatsResponse.RejectionsEntry)
+    ))
+  ,
+  DESCRIPTOR = _GETCONNECTIONREJECTIONSTATSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetConnectionRejectionStatsResponse)
+  ))
+_sym_db.RegisterMessage(GetConnectionRejectionStatsResponse)
+_sym_db.RegisterMessage(GetConnectionRejectionStatsResponse.RejectionsEntry)
+
+GetConfigRequest = _reflection.GeneratedProtocolMessageType('GetConfigRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETCONFIGREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetConfigRequest)
+  ))
+_sym_db.RegisterMessage(GetConfigRequest)
+
+GetConfigResponse = _reflection.GeneratedProtocolMessageType('GetConfigResponse', (_message.Message,), dict(
+
+  FlagsEntry = _reflection.GeneratedProtocolMessageType('FlagsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _GETCONFIGRESPONSE_FLAGSENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetConfigResponse.FlagsEntry)
+    ))
+  ,
+  DESCRIPTOR = _GETCONFIGRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetConfigResponse)
+  ))
+_sym_db.RegisterMessage(GetConfigResponse)
+_sym_db.RegisterMessage(GetConfigResponse.FlagsEntry)
+
+GetInFlightRPCsRequest = _reflection.GeneratedProtocolMessageType('GetInFlightRPCsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETINFLIGHTRPCSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetInFlightRPCsRequest)
+  ))
+_sym_db.RegisterMessage(GetInFlightRPCsRequest)
+
+GetInFlightRPCsResponse = _reflection.GeneratedProtocolMessageType('GetInFlightRPCsResponse', (_message.Message,), dict(
+
+  InFlightEntry = _reflection.GeneratedProtocolMessageType('InFlightEntry', (_message.Message,), dict(
+    DESCRIPTOR = _GETINFLIGHTRPCSRESPONSE_INFLIGHTENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetInFlightRPCsResponse.InFlightEntry)
+    ))
+  ,
+  DESCRIPTOR = _GETINFLIGHTRPCSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetInFlightRPCsResponse)
+  ))
+_sym_db.RegisterMessage(GetInFlightRPCsResponse)
+_sym_db.RegisterMessage(GetInFlightRPCsResponse.InFlightEntry)
+
+ProcessStats = _reflection.GeneratedProtocolMessageType('ProcessStats', (_message.Message,), dict(
+  DESCRIPTOR = _PROCESSSTATS,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ProcessStats)
+  ))
+_sym_db.RegisterMessage(ProcessStats)
+
+GetProcessStatsRequest = _reflection.GeneratedProtocolMessageType('GetProcessStatsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETPROCESSSTATSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetProcessStatsRequest)
+  ))
+_sym_db.RegisterMessage(GetProcessStatsRequest)
+
+GetProcessStatsResponse = _reflection.GeneratedProtocolMessageType('GetProcessStatsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETPROCESSSTATSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetProcessStatsResponse)
+  ))
+_sym_db.RegisterMessage(GetProcessStatsResponse)
+
+HealthScore = _reflection.GeneratedProtocolMessageType('HealthScore', (_message.Message,), dict(
+  DESCRIPTOR = _HEALTHSCORE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.HealthScore)
+  ))
+_sym_db.RegisterMessage(HealthScore)
+
+GetHealthScoreRequest = _reflection.GeneratedProtocolMessageType('GetHealthScoreRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETHEALTHSCOREREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetHealthScoreRequest)
+  ))
+_sym_db.RegisterMessage(GetHealthScoreRequest)
+
+GetHealthScoreResponse = _reflection.GeneratedProtocolMessageType('GetHealthScoreResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETHEALTHSCORERESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetHealthScoreResponse)
+  ))
+_sym_db.RegisterMessage(GetHealthScoreResponse)
+
+GetErrorLogTailRequest = _reflection.GeneratedProtocolMessageType('GetErrorLogTailRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETERRORLOGTAILREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetErrorLogTailRequest)
+  ))
+_sym_db.RegisterMessage(GetErrorLogTailRequest)
+
+GetErrorLogTailResponse = _reflection.GeneratedProtocolMessageType('GetErrorLogTailResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETERRORLOGTAILRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetErrorLogTailResponse)
+  ))
+_sym_db.RegisterMessage(GetErrorLogTailResponse)
+
+CheckDataDirRequest = _reflection.GeneratedProtocolMessageType('CheckDataDirRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CHECKDATADIRREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CheckDataDirRequest)
+  ))
+_sym_db.RegisterMessage(CheckDataDirRequest)
+
+CheckDataDirResponse = _reflection.GeneratedProtocolMessageType('CheckDataDirResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CHECKDATADIRRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CheckDataDirResponse)
+  ))
+_sym_db.RegisterMessage(CheckDataDirResponse)
+
+DiagnosticBundle = _reflection.GeneratedProtocolMessageType('DiagnosticBundle', (_message.Message,), dict(
+
+  VariablesEntry = _reflection.GeneratedProtocolMessageType('VariablesEntry', (_message.Message,), dict(
+    DESCRIPTOR = _DIAGNOSTICBUNDLE_VARIABLESENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.DiagnosticBundle.VariablesEntry)
+    ))
+  ,
+  DESCRIPTOR = _DIAGNOSTICBUNDLE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.DiagnosticBundle)
+  ))
+_sym_db.RegisterMessage(DiagnosticBundle)
+_sym_db.RegisterMessage(DiagnosticBundle.VariablesEntry)
+
+CaptureDiagnosticsRequest = _reflection.GeneratedProtocolMessageType('CaptureDiagnosticsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CAPTUREDIAGNOSTICSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CaptureDiagnosticsRequest)
+  ))
+_sym_db.RegisterMessage(CaptureDiagnosticsRequest)
+
+CaptureDiagnosticsResponse = _reflection.GeneratedProtocolMessageType('CaptureDiagnosticsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CAPTUREDIAGNOSTICSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CaptureDiagnosticsResponse)
+  ))
+_sym_db.RegisterMessage(CaptureDiagnosticsResponse)
+
+ThrottleState = _reflection.GeneratedProtocolMessageType('ThrottleState', (_message.Message,), dict(
+  DESCRIPTOR = _THROTTLESTATE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ThrottleState)
+  ))
+_sym_db.RegisterMessage(ThrottleState)
+
+GetThrottleStateRequest = _reflection.GeneratedProtocolMessageType('GetThrottleStateRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETTHROTTLESTATEREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetThrottleStateRequest)
+  ))
+_sym_db.RegisterMessage(GetThrottleStateRequest)
+
+GetThrottleStateResponse = _reflection.GeneratedProtocolMessageType('GetThrottleStateResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETTHROTTLESTATERESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetThrottleStateResponse)
+  ))
+_sym_db.RegisterMessage(GetThrottleStateResponse)
+
+GetTabletTagsRequest = _reflection.GeneratedProtocolMessageType('GetTabletTagsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETTABLETTAGSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetTabletTagsRequest)
+  ))
+_sym_db.RegisterMessage(GetTabletTagsRequest)
+
+GetTabletTagsResponse = _reflection.GeneratedProtocolMessageType('GetTabletTagsResponse', (_message.Message,), dict(
+
+  TagsEntry = _reflection.GeneratedProtocolMessageType('TagsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _GETTABLETTAGSRESPONSE_TAGSENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetTabletTagsResponse.TagsEntry)
+    ))
+  ,
+  DESCRIPTOR = _GETTABLETTAGSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetTabletTagsResponse)
+  ))
+_sym_db.RegisterMessage(GetTabletTagsResponse)
+_sym_db.RegisterMessage(GetTabletTagsResponse.TagsEntry)
+
+SetThrottleRequest = _reflection.GeneratedProtocolMessageType('SetThrottleRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETTHROTTLEREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetThrottleRequest)
+  ))
+_sym_db.RegisterMessage(SetThrottleRequest)
+
+SetThrottleResponse = _reflection.GeneratedProtocolMessageType('SetThrottleResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETTHROTTLERESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetThrottleResponse)
+  ))
+_sym_db.RegisterMessage(SetThrottleResponse)
+
+SetReadOnlyRequest = _reflection.GeneratedProtocolMessageType('SetReadOnlyRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETREADONLYREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetReadOnlyRequest)
+  ))
+_sym_db.RegisterMessage(SetReadOnlyRequest)
+
+SetReadOnlyResponse = _reflection.GeneratedProtocolMessageType('SetReadOnlyResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETREADONLYRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetReadOnlyResponse)
+  ))
+_sym_db.RegisterMessage(SetReadOnlyResponse)
+
+SetReadWriteRequest = _reflection.GeneratedProtocolMessageType('SetReadWriteRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETREADWRITEREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetReadWriteRequest)
+  ))
+_sym_db.RegisterMessage(SetReadWriteRequest)
+
+SetReadWriteResponse = _reflection.GeneratedProtocolMessageType('SetReadWriteResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETREADWRITERESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetReadWriteResponse)
+  ))
+_sym_db.RegisterMessage(SetReadWriteResponse)
+
+SetReadOnlyWithTTLRequest = _reflection.GeneratedProtocolMessageType('SetReadOnlyWithTTLRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETREADONLYWITHTTLREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetReadOnlyWithTTLRequest)
+  ))
+_sym_db.RegisterMessage(SetReadOnlyWithTTLRequest)
+
+SetReadOnlyWithTTLResponse = _reflection.GeneratedProtocolMessageType('SetReadOnlyWithTTLResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETREADONLYWITHTTLRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetReadOnlyWithTTLResponse)
+  ))
+_sym_db.RegisterMessage(SetReadOnlyWithTTLResponse)
+
+SetSuperReadOnlyRequest = _reflection.GeneratedProtocolMessageType('SetSuperReadOnlyRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETSUPERREADONLYREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetSuperReadOnlyRequest)
+  ))
+_sym_db.RegisterMessage(SetSuperReadOnlyRequest)
+
+SetSuperReadOnlyResponse = _reflection.GeneratedProtocolMessageType('SetSuperReadOnlyResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETSUPERREADONLYRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetSuperReadOnlyResponse)
+  ))
+_sym_db.RegisterMessage(SetSuperReadOnlyResponse)
+
+QueryServerConfig = _reflection.GeneratedProtocolMessageType('QueryServerConfig', (_message.Message,), dict(
+  DESCRIPTOR = _QUERYSERVERCONFIG,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.QueryServerConfig)
+  ))
+_sym_db.RegisterMessage(QueryServerConfig)
+
+SetQueryServerConfigRequest = _reflection.GeneratedProtocolMessageType('SetQueryServerConfigRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETQUERYSERVERCONFIGREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetQueryServerConfigRequest)
+  ))
+_sym_db.RegisterMessage(SetQueryServerConfigRequest)
+
+SetQueryServerConfigResponse = _reflection.GeneratedProtocolMessageType('SetQueryServerConfigResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETQUERYSERVERCONFIGRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetQueryServerConfigResponse)
+  ))
+_sym_db.RegisterMessage(SetQueryServerConfigResponse)
+
+GetQueryBlacklistRequest = _reflection.GeneratedProtocolMessageType('GetQueryBlacklistRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETQUERYBLACKLISTREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetQueryBlacklistRequest)
+  ))
+_sym_db.RegisterMessage(GetQueryBlacklistRequest)
+
+GetQueryBlacklistResponse = _reflection.GeneratedProtocolMessageType('GetQueryBlacklistResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETQUERYBLACKLISTRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetQueryBlacklistResponse)
+  ))
+_sym_db.RegisterMessage(GetQueryBlacklistResponse)
+
+SetQueryBlacklistRequest = _reflection.GeneratedProtocolMessageType('SetQueryBlacklistRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETQUERYBLACKLISTREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetQueryBlacklistRequest)
+  ))
+_sym_db.RegisterMessage(SetQueryBlacklistRequest)
+
+SetQueryBlacklistResponse = _reflection.GeneratedProtocolMessageType('SetQueryBlacklistResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETQUERYBLACKLISTRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetQueryBlacklistResponse)
+  ))
+_sym_db.RegisterMessage(SetQueryBlacklistResponse)
+
+ChangeTypeRequest = _reflection.GeneratedProtocolMessageType('ChangeTypeRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CHANGETYPEREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ChangeTypeRequest)
+  ))
+_sym_db.RegisterMessage(ChangeTypeRequest)
+
+ChangeTypeResponse = _reflection.GeneratedProtocolMessageType('ChangeTypeResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CHANGETYPERESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ChangeTypeResponse)
+  ))
+_sym_db.RegisterMessage(ChangeTypeResponse)
+
+SetTabletTagsRequest = _reflection.GeneratedProtocolMessageType('SetTabletTagsRequest', (_message.Message,), dict(
+
+  TagsEntry = _reflection.GeneratedProtocolMessageType('TagsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _SETTABLETTAGSREQUEST_TAGSENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetTabletTagsRequest.TagsEntry)
+    ))
+  ,
+  DESCRIPTOR = _SETTABLETTAGSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetTabletTagsRequest)
+  ))
+_sym_db.RegisterMessage(SetTabletTagsRequest)
+_sym_db.RegisterMessage(SetTabletTagsRequest.TagsEntry)
+
+SetTabletTagsResponse = _reflection.GeneratedProtocolMessageType('SetTabletTagsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETTABLETTAGSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetTabletTagsResponse)
+  ))
+_sym_db.RegisterMessage(SetTabletTagsResponse)
+
+RefreshStateRequest = _reflection.GeneratedProtocolMessageType('RefreshStateRequest', (_message.Message,), dict(
+  DESCRIPTOR = _REFRESHSTATEREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RefreshStateRequest)
+  ))
+_sym_db.RegisterMessage(RefreshStateRequest)
+
+RefreshStateResponse = _reflection.GeneratedProtocolMessageType('RefreshStateResponse', (_message.Message,), dict(
+  DESCRIPTOR = _REFRESHSTATERESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RefreshStateResponse)
+  ))
+_sym_db.RegisterMessage(RefreshStateResponse)
+
+RepublishTopoRecordRequest = _reflection.GeneratedProtocolMessageType('RepublishTopoRecordRequest', (_message.Message,), dict(
+  DESCRIPTOR = _REPUBLISHTOPORECORDREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RepublishTopoRecordRequest)
+  ))
+_sym_db.RegisterMessage(RepublishTopoRecordRequest)
+
+RepublishTopoRecordResponse = _reflection.GeneratedProtocolMessageType('RepublishTopoRecordResponse', (_message.Message,), dict(
+  DESCRIPTOR = _REPUBLISHTOPORECORDRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RepublishTopoRecordResponse)
+  ))
+_sym_db.RegisterMessage(RepublishTopoRecordResponse)
+
+RunHealthCheckRequest = _reflection.GeneratedProtocolMessageType('RunHealthCheckRequest', (_message.Message,), dict(
+  DESCRIPTOR = _RUNHEALTHCHECKREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RunHealthCheckRequest)
+  ))
+_sym_db.RegisterMessage(RunHealthCheckRequest)
+
+RunHealthCheckResponse = _reflection.GeneratedProtocolMessageType('RunHealthCheckResponse', (_message.Message,), dict(
+  DESCRIPTOR = _RUNHEALTHCHECKRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RunHealthCheckResponse)
+  ))
+_sym_db.RegisterMessage(RunHealthCheckResponse)
+
+IgnoreHealthErrorRequest = _reflection.GeneratedProtocolMessageType('IgnoreHealthErrorRequest', (_message.Message,), dict(
+  DESCRIPTOR = _IGNOREHEALTHERRORREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.IgnoreHealthErrorRequest)
+  ))
+_sym_db.RegisterMessage(IgnoreHealthErrorRequest)
+
+IgnoreHealthErrorResponse = _reflection.GeneratedProtocolMessageType('IgnoreHealthErrorResponse', (_message.Message,), dict(
+  DESCRIPTOR = _IGNOREHEALTHERRORRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.IgnoreHealthErrorResponse)
+  ))
+_sym_db.RegisterMessage(IgnoreHealthErrorResponse)
+
+SetMaintenanceModeRequest = _reflection.GeneratedProtocolMessageType('SetMaintenanceModeRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETMAINTENANCEMODEREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetMaintenanceModeRequest)
+  ))
+_sym_db.RegisterMessage(SetMaintenanceModeRequest)
+
+SetMaintenanceModeResponse = _reflection.GeneratedProtocolMessageType('SetMaintenanceModeResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETMAINTENANCEMODERESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetMaintenanceModeResponse)
+  ))
+_sym_db.RegisterMessage(SetMaintenanceModeResponse)
+
+PauseHealthReportingRequest = _reflection.GeneratedProtocolMessageType('PauseHealthReportingRequest', (_message.Message,), dict(
+  DESCRIPTOR = _PAUSEHEALTHREPORTINGREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.PauseHealthReportingRequest)
+  ))
+_sym_db.RegisterMessage(PauseHealthReportingRequest)
+
+PauseHealthReportingResponse = _reflection.GeneratedProtocolMessageType('PauseHealthReportingResponse', (_message.Message,), dict(
+  DESCRIPTOR = _PAUSEHEALTHREPORTINGRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.PauseHealthReportingResponse)
+  ))
+_sym_db.RegisterMessage(PauseHealthReportingResponse)
+
+PrepareCutoverRequest = _reflection.GeneratedProtocolMessageType('PrepareCutoverRequest', (_message.Message,), dict(
+  DESCRIPTOR = _PREPARECUTOVERREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.PrepareCutoverRequest)
+  ))
+_sym_db.RegisterMessage(PrepareCutoverRequest)
+
+PrepareCutoverResponse = _reflection.GeneratedProtocolMessageType('PrepareCutoverResponse', (_message.Message,), dict(
+  DESCRIPTOR = _PREPARECUTOVERRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.PrepareCutoverResponse)
+  ))
+_sym_db.RegisterMessage(PrepareCutoverResponse)
+
+CommitCutoverRequest = _reflection.GeneratedProtocolMessageType('CommitCutoverRequest', (_message.Message,), dict(
+  DESCRIPTOR = _COMMITCUTOVERREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CommitCutoverRequest)
+  ))
+_sym_db.RegisterMessage(CommitCutoverRequest)
+
+CommitCutoverResponse = _reflection.GeneratedProtocolMessageType('CommitCutoverResponse', (_message.Message,), dict(
+  DESCRIPTOR = _COMMITCUTOVERRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CommitCutoverResponse)
+  ))
+_sym_db.RegisterMessage(CommitCutoverResponse)
+
+AbortCutoverRequest = _reflection.GeneratedProtocolMessageType('AbortCutoverRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ABORTCUTOVERREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.AbortCutoverRequest)
+  ))
+_sym_db.RegisterMessage(AbortCutoverRequest)
+
+AbortCutoverResponse = _reflection.GeneratedProtocolMessageType('AbortCutoverResponse', (_message.Message,), dict(
+  DESCRIPTOR = _ABORTCUTOVERRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.AbortCutoverResponse)
+  ))
+_sym_db.RegisterMessage(AbortCutoverResponse)
+
+SetServingKeyRangeRequest = _reflection.GeneratedProtocolMessageType('SetServingKeyRangeRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETSERVINGKEYRANGEREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetServingKeyRangeRequest)
+  ))
+_sym_db.RegisterMessage(SetServingKeyRangeRequest)
+
+SetServingKeyRangeResponse = _reflection.GeneratedProtocolMessageType('SetServingKeyRangeResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETSERVINGKEYRANGERESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetServingKeyRangeResponse)
+  ))
+_sym_db.RegisterMessage(SetServingKeyRangeResponse)
+
+RestartMysqlRequest = _reflection.GeneratedProtocolMessageType('RestartMysqlRequest', (_message.Message,), dict(
+  DESCRIPTOR = _RESTARTMYSQLREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RestartMysqlRequest)
+  ))
+_sym_db.RegisterMessage(RestartMysqlRequest)
+
+RestartMysqlResponse = _reflection.GeneratedProtocolMessageType('RestartMysqlResponse', (_message.Message,), dict(
+  DESCRIPTOR = _RESTARTMYSQLRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RestartMysqlResponse)
+  ))
+_sym_db.RegisterMessage(RestartMysqlResponse)
+
+DecommissionRequest = _reflection.GeneratedProtocolMessageType('DecommissionRequest', (_message.Message,), dict(
+  DESCRIPTOR = _DECOMMISSIONREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.DecommissionRequest)
+  ))
+_sym_db.RegisterMessage(DecommissionRequest)
+
+DecommissionResponse = _reflection.GeneratedProtocolMessageType('DecommissionResponse', (_message.Message,), dict(
+  DESCRIPTOR = _DECOMMISSIONRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.DecommissionResponse)
+  ))
+_sym_db.RegisterMessage(DecommissionResponse)
+
+CheckTopoConnectivityRequest = _reflection.GeneratedProtocolMessageType('CheckTopoConnectivityRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CHECKTOPOCONNECTIVITYREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CheckTopoConnectivityRequest)
+  ))
+_sym_db.RegisterMessage(CheckTopoConnectivityRequest)
+
+CheckTopoConnectivityResponse = _reflection.GeneratedProtocolMessageType('CheckTopoConnectivityResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CHECKTOPOCONNECTIVITYRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CheckTopoConnectivityResponse)
+  ))
+_sym_db.RegisterMessage(CheckTopoConnectivityResponse)
+
+WarmUpRequest = _reflection.GeneratedProtocolMessageType('WarmUpRequest', (_message.Message,), dict(
+  DESCRIPTOR = _WARMUPREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.WarmUpRequest)
+  ))
+_sym_db.RegisterMessage(WarmUpRequest)
+
+WarmUpResponse = _reflection.GeneratedProtocolMessageType('WarmUpResponse', (_message.Message,), dict(
+  DESCRIPTOR = _WARMUPRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.WarmUpResponse)
+  ))
+_sym_db.RegisterMessage(WarmUpResponse)
+
+ReloadSchemaRequest = _reflection.GeneratedProtocolMessageType('ReloadSchemaRequest', (_message.Message,), dict(
+  DESCRIPTOR = _RELOADSCHEMAREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ReloadSchemaRequest)
+  ))
+_sym_db.RegisterMessage(ReloadSchemaRequest)
+
+ReloadSchemaResponse = _reflection.GeneratedProtocolMessageType('ReloadSchemaResponse', (_message.Message,), dict(
+  DESCRIPTOR = _RELOADSCHEMARESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ReloadSchemaResponse)
+  ))
+_sym_db.RegisterMessage(ReloadSchemaResponse)
+
+PreflightSchemaRequest = _reflection.GeneratedProtocolMessageType('PreflightSchemaRequest', (_message.Message,), dict(
+  DESCRIPTOR = _PREFLIGHTSCHEMAREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.PreflightSchemaRequest)
+  ))
+_sym_db.RegisterMessage(PreflightSchemaRequest)
+
+PreflightSchemaResponse = _reflection.GeneratedProtocolMessageType('PreflightSchemaResponse', (_message.Message,), dict(
+  DESCRIPTOR = _PREFLIGHTSCHEMARESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.PreflightSchemaResponse)
+  ))
+_sym_db.RegisterMessage(PreflightSchemaResponse)
+
+ApplySchemaRequest = _reflection.GeneratedProtocolMessageType('ApplySchemaRequest', (_message.Message,), dict(
+  DESCRIPTOR = _APPLYSCHEMAREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ApplySchemaRequest)
+  ))
+_sym_db.RegisterMessage(ApplySchemaRequest)
+
+ApplySchemaResponse = _reflection.GeneratedProtocolMessageType('ApplySchemaResponse', (_message.Message,), dict(
+  DESCRIPTOR = _APPLYSCHEMARESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ApplySchemaResponse)
+  ))
+_sym_db.RegisterMessage(ApplySchemaResponse)
 
-TableDefinition = _reflection.GeneratedProtocolMessageType('TableDefinition', (_message.Message,), dict(
-  DESCRIPTOR = _TABLEDEFINITION,
+SchemaDiffRequest = _reflection.GeneratedProtocolMessageType('SchemaDiffRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SCHEMADIFFREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.TableDefinition)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SchemaDiffRequest)
   ))
-_sym_db.RegisterMessage(TableDefinition)
+_sym_db.RegisterMessage(SchemaDiffRequest)
 
-SchemaDefinition = _reflection.GeneratedProtocolMessageType('SchemaDefinition', (_message.Message,), dict(
-  DESCRIPTOR = _SCHEMADEFINITION,
+SchemaDiffResponse = _reflection.GeneratedProtocolMessageType('SchemaDiffResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SCHEMADIFFRESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SchemaDefinition)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SchemaDiffResponse)
   ))
-_sym_db.RegisterMessage(SchemaDefinition)
+_sym_db.RegisterMessage(SchemaDiffResponse)
 
-SchemaChangeResult = _reflection.GeneratedProtocolMessageType('SchemaChangeResult', (_message.Message,), dict(
-  DESCRIPTOR = _SCHEMACHANGERESULT,
+SchemaChangeAssessment = _reflection.GeneratedProtocolMessageType('SchemaChangeAssessment', (_message.Message,), dict(
+  DESCRIPTOR = _SCHEMACHANGEASSESSMENT,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SchemaChangeResult)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SchemaChangeAssessment)
   ))
-_sym_db.RegisterMessage(SchemaChangeResult)
+_sym_db.RegisterMessage(SchemaChangeAssessment)
 
-UserPermission = _reflection.GeneratedProtocolMessageType('UserPermission', (_message.Message,), dict(
+AssessSchemaChangeRequest = _reflection.GeneratedProtocolMessageType('AssessSchemaChangeRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ASSESSSCHEMACHANGEREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.AssessSchemaChangeRequest)
+  ))
+_sym_db.RegisterMessage(AssessSchemaChangeRequest)
 
-  PrivilegesEntry = _reflection.GeneratedProtocolMessageType('PrivilegesEntry', (_message.Message,), dict(
-    DESCRIPTOR = _USERPERMISSION_PRIVILEGESENTRY,
-    __module__ = 'tabletmanagerdata_pb2'
-    # @@protoc_insertion_point(class_scope:tabletmanagerdata.UserPermission.PrivilegesEntry)
-    ))
-  ,
-  DESCRIPTOR = _USERPERMISSION,
+AssessSchemaChangeResponse = _reflection.GeneratedProtocolMessageType('AssessSchemaChangeResponse', (_message.Message,), dict(
+  DESCRIPTOR = _ASSESSSCHEMACHANGERESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.UserPermission)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.AssessSchemaChangeResponse)
   ))
-_sym_db.RegisterMessage(UserPermission)
-_sym_db.RegisterMessage(UserPermission.PrivilegesEntry)
+_sym_db.RegisterMessage(AssessSchemaChangeResponse)
 
-DbPermission = _reflection.GeneratedProtocolMessageType('DbPermission', (_message.Message,), dict(
+SchemaChangeEvent = _reflection.GeneratedProtocolMessageType('SchemaChangeEvent', (_message.Message,), dict(
+  DESCRIPTOR = _SCHEMACHANGEEVENT,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SchemaChangeEvent)
+  ))
+_sym_db.RegisterMessage(SchemaChangeEvent)
 
-  PrivilegesEntry = _reflection.GeneratedProtocolMessageType('PrivilegesEntry', (_message.Message,), dict(
-    DESCRIPTOR = _DBPERMISSION_PRIVILEGESENTRY,
-    __module__ = 'tabletmanagerdata_pb2'
-    # @@protoc_insertion_point(class_scope:tabletmanagerdata.DbPermission.PrivilegesEntry)
-    ))
-  ,
-  DESCRIPTOR = _DBPERMISSION,
+WatchSchemaRequest = _reflection.GeneratedProtocolMessageType('WatchSchemaRequest', (_message.Message,), dict(
+  DESCRIPTOR = _WATCHSCHEMAREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.DbPermission)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.WatchSchemaRequest)
   ))
-_sym_db.RegisterMessage(DbPermission)
-_sym_db.RegisterMessage(DbPermission.PrivilegesEntry)
+_sym_db.RegisterMessage(WatchSchemaRequest)
 
-Permissions = _reflection.GeneratedProtocolMessageType('Permissions', (_message.Message,), dict(
-  DESCRIPTOR = _PERMISSIONS,
+WatchSchemaResponse = _reflection.GeneratedProtocolMessageType('WatchSchemaResponse', (_message.Message,), dict(
+  DESCRIPTOR = _WATCHSCHEMARESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.Permissions)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.WatchSchemaResponse)
   ))
-_sym_db.RegisterMessage(Permissions)
+_sym_db.RegisterMessage(WatchSchemaResponse)
 
-BlpPosition = _reflection.GeneratedProtocolMessageType('BlpPosition', (_message.Message,), dict(
-  DESCRIPTOR = _BLPPOSITION,
+GetVSchemaRequest = _reflection.GeneratedProtocolMessageType('GetVSchemaRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETVSCHEMAREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.BlpPosition)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetVSchemaRequest)
   ))
-_sym_db.RegisterMessage(BlpPosition)
+_sym_db.RegisterMessage(GetVSchemaRequest)
 
-PingRequest = _reflection.GeneratedProtocolMessageType('PingRequest', (_message.Message,), dict(
-  DESCRIPTOR = _PINGREQUEST,
+GetVSchemaResponse = _reflection.GeneratedProtocolMessageType('GetVSchemaResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETVSCHEMARESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.PingRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetVSchemaResponse)
   ))
-_sym_db.RegisterMessage(PingRequest)
+_sym_db.RegisterMessage(GetVSchemaResponse)
 
-PingResponse = _reflection.GeneratedProtocolMessageType('PingResponse', (_message.Message,), dict(
-  DESCRIPTOR = _PINGRESPONSE,
+ApplyVSchemaRequest = _reflection.GeneratedProtocolMessageType('ApplyVSchemaRequest', (_message.Message,), dict(
+  DESCRIPTOR = _APPLYVSCHEMAREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.PingResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ApplyVSchemaRequest)
   ))
-_sym_db.RegisterMessage(PingResponse)
+_sym_db.RegisterMessage(ApplyVSchemaRequest)
 
-SleepRequest = _reflection.GeneratedProtocolMessageType('SleepRequest', (_message.Message,), dict(
-  DESCRIPTOR = _SLEEPREQUEST,
+ApplyVSchemaResponse = _reflection.GeneratedProtocolMessageType('ApplyVSchemaResponse', (_message.Message,), dict(
+  DESCRIPTOR = _APPLYVSCHEMARESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SleepRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ApplyVSchemaResponse)
   ))
-_sym_db.RegisterMessage(SleepRequest)
+_sym_db.RegisterMessage(ApplyVSchemaResponse)
 
-SleepResponse = _reflection.GeneratedProtocolMessageType('SleepResponse', (_message.Message,), dict(
-  DESCRIPTOR = _SLEEPRESPONSE,
+ExecuteFetchAsDbaRequest = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsDbaRequest', (_message.Message,), dict(
+  DESCRIPTOR = _EXECUTEFETCHASDBAREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SleepResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsDbaRequest)
   ))
-_sym_db.RegisterMessage(SleepResponse)
+_sym_db.RegisterMessage(ExecuteFetchAsDbaRequest)
 
-ExecuteHookRequest = _reflection.GeneratedProtocolMessageType('ExecuteHookRequest', (_message.Message,), dict(
+ExecuteFetchAsDbaResponse = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsDbaResponse', (_message.Message,), dict(
+  DESCRIPTOR = _EXECUTEFETCHASDBARESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsDbaResponse)
+  ))
+_sym_db.RegisterMessage(ExecuteFetchAsDbaResponse)
 
-  ExtraEnvEntry = _reflection.GeneratedProtocolMessageType('ExtraEnvEntry', (_message.Message,), dict(
-    DESCRIPTOR = _EXECUTEHOOKREQUEST_EXTRAENVENTRY,
-    __module__ = 'tabletmanagerdata_pb2'
-    # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteHookRequest.ExtraEnvEntry)
-    ))
-  ,
-  DESCRIPTOR = _EXECUTEHOOKREQUEST,
+ExecuteFetchAsDbaCSVRequest = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsDbaCSVRequest', (_message.Message,), dict(
+  DESCRIPTOR = _EXECUTEFETCHASDBACSVREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteHookRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsDbaCSVRequest)
   ))
-_sym_db.RegisterMessage(ExecuteHookRequest)
-_sym_db.RegisterMessage(ExecuteHookRequest.ExtraEnvEntry)
+_sym_db.RegisterMessage(ExecuteFetchAsDbaCSVRequest)
 
-ExecuteHookResponse = _reflection.GeneratedProtocolMessageType('ExecuteHookResponse', (_message.Message,), dict(
-  DESCRIPTOR = _EXECUTEHOOKRESPONSE,
+ExecuteFetchAsDbaCSVResponse = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsDbaCSVResponse', (_message.Message,), dict(
+  DESCRIPTOR = _EXECUTEFETCHASDBACSVRESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteHookResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsDbaCSVResponse)
   ))
-_sym_db.RegisterMessage(ExecuteHookResponse)
+_sym_db.RegisterMessage(ExecuteFetchAsDbaCSVResponse)
 
-GetSchemaRequest = _reflection.GeneratedProtocolMessageType('GetSchemaRequest', (_message.Message,), dict(
-  DESCRIPTOR = _GETSCHEMAREQUEST,
+ColumnarResult = _reflection.GeneratedProtocolMessageType('ColumnarResult', (_message.Message,), dict(
+  DESCRIPTOR = _COLUMNARRESULT,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetSchemaRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ColumnarResult)
   ))
-_sym_db.RegisterMessage(GetSchemaRequest)
+_sym_db.RegisterMessage(ColumnarResult)
 
-GetSchemaResponse = _reflection.GeneratedProtocolMessageType('GetSchemaResponse', (_message.Message,), dict(
-  DESCRIPTOR = _GETSCHEMARESPONSE,
+ExecuteFetchColumnarRequest = _reflection.GeneratedProtocolMessageType('ExecuteFetchColumnarRequest', (_message.Message,), dict(
+  DESCRIPTOR = _EXECUTEFETCHCOLUMNARREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetSchemaResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchColumnarRequest)
   ))
-_sym_db.RegisterMessage(GetSchemaResponse)
+_sym_db.RegisterMessage(ExecuteFetchColumnarRequest)
 
-GetPermissionsRequest = _reflection.GeneratedProtocolMessageType('GetPermissionsRequest', (_message.Message,), dict(
-  DESCRIPTOR = _GETPERMISSIONSREQUEST,
+ExecuteFetchColumnarResponse = _reflection.GeneratedProtocolMessageType('ExecuteFetchColumnarResponse', (_message.Message,), dict(
+  DESCRIPTOR = _EXECUTEFETCHCOLUMNARRESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetPermissionsRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchColumnarResponse)
   ))
-_sym_db.RegisterMessage(GetPermissionsRequest)
+_sym_db.RegisterMessage(ExecuteFetchColumnarResponse)
 
-GetPermissionsResponse = _reflection.GeneratedProtocolMessageType('GetPermissionsResponse', (_message.Message,), dict(
-  DESCRIPTOR = _GETPERMISSIONSRESPONSE,
+ExecuteFetchAsAllPrivsRequest = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsAllPrivsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _EXECUTEFETCHASALLPRIVSREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetPermissionsResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsAllPrivsRequest)
   ))
-_sym_db.RegisterMessage(GetPermissionsResponse)
+_sym_db.RegisterMessage(ExecuteFetchAsAllPrivsRequest)
 
-SetReadOnlyRequest = _reflection.GeneratedProtocolMessageType('SetReadOnlyRequest', (_message.Message,), dict(
-  DESCRIPTOR = _SETREADONLYREQUEST,
+ExecuteFetchAsAllPrivsResponse = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsAllPrivsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _EXECUTEFETCHASALLPRIVSRESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetReadOnlyRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsAllPrivsResponse)
   ))
-_sym_db.RegisterMessage(SetReadOnlyRequest)
+_sym_db.RegisterMessage(ExecuteFetchAsAllPrivsResponse)
 
-SetReadOnlyResponse = _reflection.GeneratedProtocolMessageType('SetReadOnlyResponse', (_message.Message,), dict(
-  DESCRIPTOR = _SETREADONLYRESPONSE,
+ExecuteFetchAsAppRequest = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsAppRequest', (_message.Message,), dict(
+  DESCRIPTOR = _EXECUTEFETCHASAPPREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetReadOnlyResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsAppRequest)
   ))
-_sym_db.RegisterMessage(SetReadOnlyResponse)
+_sym_db.RegisterMessage(ExecuteFetchAsAppRequest)
 
-SetReadWriteRequest = _reflection.GeneratedProtocolMessageType('SetReadWriteRequest', (_message.Message,), dict(
-  DESCRIPTOR = _SETREADWRITEREQUEST,
+ExecuteFetchAsAppResponse = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsAppResponse', (_message.Message,), dict(
+  DESCRIPTOR = _EXECUTEFETCHASAPPRESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetReadWriteRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsAppResponse)
   ))
-_sym_db.RegisterMessage(SetReadWriteRequest)
+_sym_db.RegisterMessage(ExecuteFetchAsAppResponse)
 
-SetReadWriteResponse = _reflection.GeneratedProtocolMessageType('SetReadWriteResponse', (_message.Message,), dict(
-  DESCRIPTOR = _SETREADWRITERESPONSE,
+ExplainQueryRequest = _reflection.GeneratedProtocolMessageType('ExplainQueryRequest', (_message.Message,), dict(
+  DESCRIPTOR = _EXPLAINQUERYREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetReadWriteResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExplainQueryRequest)
   ))
-_sym_db.RegisterMessage(SetReadWriteResponse)
+_sym_db.RegisterMessage(ExplainQueryRequest)
 
-ChangeTypeRequest = _reflection.GeneratedProtocolMessageType('ChangeTypeRequest', (_message.Message,), dict(
-  DESCRIPTOR = _CHANGETYPEREQUEST,
+ExplainQueryResponse = _reflection.GeneratedProtocolMessageType('ExplainQueryResponse', (_message.Message,), dict(
+  DESCRIPTOR = _EXPLAINQUERYRESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ChangeTypeRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExplainQueryResponse)
   ))
-_sym_db.RegisterMessage(ChangeTypeRequest)
+_sym_db.RegisterMessage(ExplainQueryResponse)
 
-ChangeTypeResponse = _reflection.GeneratedProtocolMessageType('ChangeTypeResponse', (_message.Message,), dict(
-  DESCRIPTOR = _CHANGETYPERESPONSE,
+ApplyGrantsRequest = _reflection.GeneratedProtocolMessageType('ApplyGrantsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _APPLYGRANTSREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ChangeTypeResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ApplyGrantsRequest)
   ))
-_sym_db.RegisterMessage(ChangeTypeResponse)
+_sym_db.RegisterMessage(ApplyGrantsRequest)
 
-RefreshStateRequest = _reflection.GeneratedProtocolMessageType('RefreshStateRequest', (_message.Message,), dict(
-  DESCRIPTOR = _REFRESHSTATEREQUEST,
+ApplyGrantsResponse = _reflection.GeneratedProtocolMessageType('ApplyGrantsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _APPLYGRANTSRESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RefreshStateRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ApplyGrantsResponse)
   ))
-_sym_db.RegisterMessage(RefreshStateRequest)
+_sym_db.RegisterMessage(ApplyGrantsResponse)
 
-RefreshStateResponse = _reflection.GeneratedProtocolMessageType('RefreshStateResponse', (_message.Message,), dict(
-  DESCRIPTOR = _REFRESHSTATERESPONSE,
+ChecksumTableRequest = _reflection.GeneratedProtocolMessageType('ChecksumTableRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CHECKSUMTABLEREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RefreshStateResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ChecksumTableRequest)
   ))
-_sym_db.RegisterMessage(RefreshStateResponse)
+_sym_db.RegisterMessage(ChecksumTableRequest)
 
-RunHealthCheckRequest = _reflection.GeneratedProtocolMessageType('RunHealthCheckRequest', (_message.Message,), dict(
-  DESCRIPTOR = _RUNHEALTHCHECKREQUEST,
+ChecksumTableResponse = _reflection.GeneratedProtocolMessageType('ChecksumTableResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CHECKSUMTABLERESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RunHealthCheckRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ChecksumTableResponse)
   ))
-_sym_db.RegisterMessage(RunHealthCheckRequest)
+_sym_db.RegisterMessage(ChecksumTableResponse)
 
-RunHealthCheckResponse = _reflection.GeneratedProtocolMessageType('RunHealthCheckResponse', (_message.Message,), dict(
-  DESCRIPTOR = _RUNHEALTHCHECKRESPONSE,
+TruncateTableRequest = _reflection.GeneratedProtocolMessageType('TruncateTableRequest', (_message.Message,), dict(
+  DESCRIPTOR = _TRUNCATETABLEREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RunHealthCheckResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.TruncateTableRequest)
   ))
-_sym_db.RegisterMessage(RunHealthCheckResponse)
+_sym_db.RegisterMessage(TruncateTableRequest)
 
-IgnoreHealthErrorRequest = _reflection.GeneratedProtocolMessageType('IgnoreHealthErrorRequest', (_message.Message,), dict(
-  DESCRIPTOR = _IGNOREHEALTHERRORREQUEST,
+TruncateTableResponse = _reflection.GeneratedProtocolMessageType('TruncateTableResponse', (_message.Message,), dict(
+  DESCRIPTOR = _TRUNCATETABLERESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.IgnoreHealthErrorRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.TruncateTableResponse)
   ))
-_sym_db.RegisterMessage(IgnoreHealthErrorRequest)
+_sym_db.RegisterMessage(TruncateTableResponse)
 
-IgnoreHealthErrorResponse = _reflection.GeneratedProtocolMessageType('IgnoreHealthErrorResponse', (_message.Message,), dict(
-  DESCRIPTOR = _IGNOREHEALTHERRORRESPONSE,
+RenameTableRequest = _reflection.GeneratedProtocolMessageType('RenameTableRequest', (_message.Message,), dict(
+  DESCRIPTOR = _RENAMETABLEREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.IgnoreHealthErrorResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RenameTableRequest)
   ))
-_sym_db.RegisterMessage(IgnoreHealthErrorResponse)
+_sym_db.RegisterMessage(RenameTableRequest)
 
-ReloadSchemaRequest = _reflection.GeneratedProtocolMessageType('ReloadSchemaRequest', (_message.Message,), dict(
-  DESCRIPTOR = _RELOADSCHEMAREQUEST,
+RenameTableResult = _reflection.GeneratedProtocolMessageType('RenameTableResult', (_message.Message,), dict(
+  DESCRIPTOR = _RENAMETABLERESULT,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ReloadSchemaRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RenameTableResult)
   ))
-_sym_db.RegisterMessage(ReloadSchemaRequest)
+_sym_db.RegisterMessage(RenameTableResult)
 
-ReloadSchemaResponse = _reflection.GeneratedProtocolMessageType('ReloadSchemaResponse', (_message.Message,), dict(
-  DESCRIPTOR = _RELOADSCHEMARESPONSE,
+RenameTableResponse = _reflection.GeneratedProtocolMessageType('RenameTableResponse', (_message.Message,), dict(
+  DESCRIPTOR = _RENAMETABLERESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ReloadSchemaResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RenameTableResponse)
   ))
-_sym_db.RegisterMessage(ReloadSchemaResponse)
+_sym_db.RegisterMessage(RenameTableResponse)
 
-PreflightSchemaRequest = _reflection.GeneratedProtocolMessageType('PreflightSchemaRequest', (_message.Message,), dict(
-  DESCRIPTOR = _PREFLIGHTSCHEMAREQUEST,
+StreamRowsInKeyRangeRequest = _reflection.GeneratedProtocolMessageType('StreamRowsInKeyRangeRequest', (_message.Message,), dict(
+  DESCRIPTOR = _STREAMROWSINKEYRANGEREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.PreflightSchemaRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.StreamRowsInKeyRangeRequest)
+  ))
+_sym_db.RegisterMessage(StreamRowsInKeyRangeRequest)
+
+StreamRowsInKeyRangeResponse = _reflection.GeneratedProtocolMessageType('StreamRowsInKeyRangeResponse', (_message.Message,), dict(
+  DESCRIPTOR = _STREAMROWSINKEYRANGERESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.StreamRowsInKeyRangeResponse)
+  ))
+_sym_db.RegisterMessage(StreamRowsInKeyRangeResponse)
+
+StreamKeyRangeBinlogRequest = _reflection.GeneratedProtocolMessageType('StreamKeyRangeBinlogRequest', (_message.Message,), dict(
+  DESCRIPTOR = _STREAMKEYRANGEBINLOGREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.StreamKeyRangeBinlogRequest)
+  ))
+_sym_db.RegisterMessage(StreamKeyRangeBinlogRequest)
+
+StreamKeyRangeBinlogResponse = _reflection.GeneratedProtocolMessageType('StreamKeyRangeBinlogResponse', (_message.Message,), dict(
+  DESCRIPTOR = _STREAMKEYRANGEBINLOGRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.StreamKeyRangeBinlogResponse)
+  ))
+_sym_db.RegisterMessage(StreamKeyRangeBinlogResponse)
+
+CloneStreamRequest = _reflection.GeneratedProtocolMessageType('CloneStreamRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CLONESTREAMREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CloneStreamRequest)
+  ))
+_sym_db.RegisterMessage(CloneStreamRequest)
+
+CloneStreamResponse = _reflection.GeneratedProtocolMessageType('CloneStreamResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CLONESTREAMRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CloneStreamResponse)
+  ))
+_sym_db.RegisterMessage(CloneStreamResponse)
+
+Process = _reflection.GeneratedProtocolMessageType('Process', (_message.Message,), dict(
+  DESCRIPTOR = _PROCESS,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.Process)
+  ))
+_sym_db.RegisterMessage(Process)
+
+GetProcessListRequest = _reflection.GeneratedProtocolMessageType('GetProcessListRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETPROCESSLISTREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetProcessListRequest)
+  ))
+_sym_db.RegisterMessage(GetProcessListRequest)
+
+GetProcessListResponse = _reflection.GeneratedProtocolMessageType('GetProcessListResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETPROCESSLISTRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetProcessListResponse)
+  ))
+_sym_db.RegisterMessage(GetProcessListResponse)
+
+CharsetConfig = _reflection.GeneratedProtocolMessageType('CharsetConfig', (_message.Message,), dict(
+
+  DatabaseCharsetsEntry = _reflection.GeneratedProtocolMessageType('DatabaseCharsetsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _CHARSETCONFIG_DATABASECHARSETSENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.CharsetConfig.DatabaseCharsetsEntry)
+    ))
+  ,
+
+  DatabaseCollationsEntry = _reflection.GeneratedProtocolMessageType('DatabaseCollationsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _CHARSETCONFIG_DATABASECOLLATIONSENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.CharsetConfig.DatabaseCollationsEntry)
+    ))
+  ,
+  DESCRIPTOR = _CHARSETCONFIG,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CharsetConfig)
+  ))
+_sym_db.RegisterMessage(CharsetConfig)
+_sym_db.RegisterMessage(CharsetConfig.DatabaseCharsetsEntry)
+_sym_db.RegisterMessage(CharsetConfig.DatabaseCollationsEntry)
+
+GetCharsetConfigRequest = _reflection.GeneratedProtocolMessageType('GetCharsetConfigRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETCHARSETCONFIGREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetCharsetConfigRequest)
+  ))
+_sym_db.RegisterMessage(GetCharsetConfigRequest)
+
+GetCharsetConfigResponse = _reflection.GeneratedProtocolMessageType('GetCharsetConfigResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETCHARSETCONFIGRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetCharsetConfigResponse)
+  ))
+_sym_db.RegisterMessage(GetCharsetConfigResponse)
+
+KillProcessRequest = _reflection.GeneratedProtocolMessageType('KillProcessRequest', (_message.Message,), dict(
+  DESCRIPTOR = _KILLPROCESSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.KillProcessRequest)
+  ))
+_sym_db.RegisterMessage(KillProcessRequest)
+
+KillProcessResponse = _reflection.GeneratedProtocolMessageType('KillProcessResponse', (_message.Message,), dict(
+  DESCRIPTOR = _KILLPROCESSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.KillProcessResponse)
+  ))
+_sym_db.RegisterMessage(KillProcessResponse)
+
+TailGeneralLogRequest = _reflection.GeneratedProtocolMessageType('TailGeneralLogRequest', (_message.Message,), dict(
+  DESCRIPTOR = _TAILGENERALLOGREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.TailGeneralLogRequest)
+  ))
+_sym_db.RegisterMessage(TailGeneralLogRequest)
+
+TailGeneralLogResponse = _reflection.GeneratedProtocolMessageType('TailGeneralLogResponse', (_message.Message,), dict(
+  DESCRIPTOR = _TAILGENERALLOGRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.TailGeneralLogResponse)
+  ))
+_sym_db.RegisterMessage(TailGeneralLogResponse)
+
+QPSSample = _reflection.GeneratedProtocolMessageType('QPSSample', (_message.Message,), dict(
+  DESCRIPTOR = _QPSSAMPLE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.QPSSample)
+  ))
+_sym_db.RegisterMessage(QPSSample)
+
+StreamQPSRequest = _reflection.GeneratedProtocolMessageType('StreamQPSRequest', (_message.Message,), dict(
+  DESCRIPTOR = _STREAMQPSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.StreamQPSRequest)
+  ))
+_sym_db.RegisterMessage(StreamQPSRequest)
+
+StreamQPSResponse = _reflection.GeneratedProtocolMessageType('StreamQPSResponse', (_message.Message,), dict(
+  DESCRIPTOR = _STREAMQPSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.StreamQPSResponse)
+  ))
+_sym_db.RegisterMessage(StreamQPSResponse)
+
+SlaveStatusRequest = _reflection.GeneratedProtocolMessageType('SlaveStatusRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SLAVESTATUSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SlaveStatusRequest)
+  ))
+_sym_db.RegisterMessage(SlaveStatusRequest)
+
+SlaveStatusResponse = _reflection.GeneratedProtocolMessageType('SlaveStatusResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SLAVESTATUSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SlaveStatusResponse)
   ))
-_sym_db.RegisterMessage(PreflightSchemaRequest)
+_sym_db.RegisterMessage(SlaveStatusResponse)
 
-PreflightSchemaResponse = _reflection.GeneratedProtocolMessageType('PreflightSchemaResponse', (_message.Message,), dict(
-  DESCRIPTOR = _PREFLIGHTSCHEMARESPONSE,
+SlaveStatusAllChannelsRequest = _reflection.GeneratedProtocolMessageType('SlaveStatusAllChannelsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SLAVESTATUSALLCHANNELSREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.PreflightSchemaResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SlaveStatusAllChannelsRequest)
   ))
-_sym_db.RegisterMessage(PreflightSchemaResponse)
+_sym_db.RegisterMessage(SlaveStatusAllChannelsRequest)
 
-ApplySchemaRequest = _reflection.GeneratedProtocolMessageType('ApplySchemaRequest', (_message.Message,), dict(
-  DESCRIPTOR = _APPLYSCHEMAREQUEST,
+SlaveStatusAllChannelsResponse = _reflection.GeneratedProtocolMessageType('SlaveStatusAllChannelsResponse', (_message.Message,), dict(
+
+  StatusesEntry = _reflection.GeneratedProtocolMessageType('StatusesEntry', (_message.Message,), dict(
+    DESCRIPTOR = _SLAVESTATUSALLCHANNELSRESPONSE_STATUSESENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.SlaveStatusAllChannelsResponse.StatusesEntry)
+    ))
+  ,
+  DESCRIPTOR = _SLAVESTATUSALLCHANNELSRESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ApplySchemaRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SlaveStatusAllChannelsResponse)
   ))
-_sym_db.RegisterMessage(ApplySchemaRequest)
+_sym_db.RegisterMessage(SlaveStatusAllChannelsResponse)
+_sym_db.RegisterMessage(SlaveStatusAllChannelsResponse.StatusesEntry)
 
-ApplySchemaResponse = _reflection.GeneratedProtocolMessageType('ApplySchemaResponse', (_message.Message,), dict(
-  DESCRIPTOR = _APPLYSCHEMARESPONSE,
+ReplicationSource = _reflection.GeneratedProtocolMessageType('ReplicationSource', (_message.Message,), dict(
+  DESCRIPTOR = _REPLICATIONSOURCE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ApplySchemaResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ReplicationSource)
   ))
-_sym_db.RegisterMessage(ApplySchemaResponse)
+_sym_db.RegisterMessage(ReplicationSource)
 
-ExecuteFetchAsDbaRequest = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsDbaRequest', (_message.Message,), dict(
-  DESCRIPTOR = _EXECUTEFETCHASDBAREQUEST,
+GetReplicationSourceRequest = _reflection.GeneratedProtocolMessageType('GetReplicationSourceRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETREPLICATIONSOURCEREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsDbaRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetReplicationSourceRequest)
   ))
-_sym_db.RegisterMessage(ExecuteFetchAsDbaRequest)
+_sym_db.RegisterMessage(GetReplicationSourceRequest)
 
-ExecuteFetchAsDbaResponse = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsDbaResponse', (_message.Message,), dict(
-  DESCRIPTOR = _EXECUTEFETCHASDBARESPONSE,
+GetReplicationSourceResponse = _reflection.GeneratedProtocolMessageType('GetReplicationSourceResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETREPLICATIONSOURCERESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsDbaResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetReplicationSourceResponse)
   ))
-_sym_db.RegisterMessage(ExecuteFetchAsDbaResponse)
+_sym_db.RegisterMessage(GetReplicationSourceResponse)
 
-ExecuteFetchAsAllPrivsRequest = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsAllPrivsRequest', (_message.Message,), dict(
-  DESCRIPTOR = _EXECUTEFETCHASALLPRIVSREQUEST,
+ValidateReplicationCredentialsRequest = _reflection.GeneratedProtocolMessageType('ValidateReplicationCredentialsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _VALIDATEREPLICATIONCREDENTIALSREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsAllPrivsRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ValidateReplicationCredentialsRequest)
   ))
-_sym_db.RegisterMessage(ExecuteFetchAsAllPrivsRequest)
+_sym_db.RegisterMessage(ValidateReplicationCredentialsRequest)
 
-ExecuteFetchAsAllPrivsResponse = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsAllPrivsResponse', (_message.Message,), dict(
-  DESCRIPTOR = _EXECUTEFETCHASALLPRIVSRESPONSE,
+ValidateReplicationCredentialsResponse = _reflection.GeneratedProtocolMessageType('ValidateReplicationCredentialsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _VALIDATEREPLICATIONCREDENTIALSRESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsAllPrivsResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ValidateReplicationCredentialsResponse)
   ))
-_sym_db.RegisterMessage(ExecuteFetchAsAllPrivsResponse)
+_sym_db.RegisterMessage(ValidateReplicationCredentialsResponse)
 
-ExecuteFetchAsAppRequest = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsAppRequest', (_message.Message,), dict(
-  DESCRIPTOR = _EXECUTEFETCHASAPPREQUEST,
+ApplierWorkerStats = _reflection.GeneratedProtocolMessageType('ApplierWorkerStats', (_message.Message,), dict(
+  DESCRIPTOR = _APPLIERWORKERSTATS,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsAppRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ApplierWorkerStats)
   ))
-_sym_db.RegisterMessage(ExecuteFetchAsAppRequest)
+_sym_db.RegisterMessage(ApplierWorkerStats)
 
-ExecuteFetchAsAppResponse = _reflection.GeneratedProtocolMessageType('ExecuteFetchAsAppResponse', (_message.Message,), dict(
-  DESCRIPTOR = _EXECUTEFETCHASAPPRESPONSE,
+ApplierQueueStats = _reflection.GeneratedProtocolMessageType('ApplierQueueStats', (_message.Message,), dict(
+  DESCRIPTOR = _APPLIERQUEUESTATS,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ExecuteFetchAsAppResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ApplierQueueStats)
   ))
-_sym_db.RegisterMessage(ExecuteFetchAsAppResponse)
+_sym_db.RegisterMessage(ApplierQueueStats)
 
-SlaveStatusRequest = _reflection.GeneratedProtocolMessageType('SlaveStatusRequest', (_message.Message,), dict(
-  DESCRIPTOR = _SLAVESTATUSREQUEST,
+GetApplierQueueStatsRequest = _reflection.GeneratedProtocolMessageType('GetApplierQueueStatsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETAPPLIERQUEUESTATSREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SlaveStatusRequest)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetApplierQueueStatsRequest)
   ))
-_sym_db.RegisterMessage(SlaveStatusRequest)
+_sym_db.RegisterMessage(GetApplierQueueStatsRequest)
 
-SlaveStatusResponse = _reflection.GeneratedProtocolMessageType('SlaveStatusResponse', (_message.Message,), dict(
-  DESCRIPTOR = _SLAVESTATUSRESPONSE,
+GetApplierQueueStatsResponse = _reflection.GeneratedProtocolMessageType('GetApplierQueueStatsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETAPPLIERQUEUESTATSRESPONSE,
   __module__ = 'tabletmanagerdata_pb2'
-  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SlaveStatusResponse)
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetApplierQueueStatsResponse)
   ))
-_sym_db.RegisterMessage(SlaveStatusResponse)
+_sym_db.RegisterMessage(GetApplierQueueStatsResponse)
 
 MasterPositionRequest = _reflection.GeneratedProtocolMessageType('MasterPositionRequest', (_message.Message,), dict(
   DESCRIPTOR = _MASTERPOSITIONREQUEST,
@@ -3468,6 +11931,55 @@ MasterPositionResponse = _reflection.GeneratedProtocolMessageType('MasterPositio
   ))
 _sym_db.RegisterMessage(MasterPositionResponse)
 
+GetGtidPurgedRequest = _reflection.GeneratedProtocolMessageType('GetGtidPurgedRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETGTIDPURGEDREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetGtidPurgedRequest)
+  ))
+_sym_db.RegisterMessage(GetGtidPurgedRequest)
+
+GetGtidPurgedResponse = _reflection.GeneratedProtocolMessageType('GetGtidPurgedResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETGTIDPURGEDRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetGtidPurgedResponse)
+  ))
+_sym_db.RegisterMessage(GetGtidPurgedResponse)
+
+GetBinlogStatsRequest = _reflection.GeneratedProtocolMessageType('GetBinlogStatsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETBINLOGSTATSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetBinlogStatsRequest)
+  ))
+_sym_db.RegisterMessage(GetBinlogStatsRequest)
+
+GetBinlogStatsResponse = _reflection.GeneratedProtocolMessageType('GetBinlogStatsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETBINLOGSTATSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetBinlogStatsResponse)
+  ))
+_sym_db.RegisterMessage(GetBinlogStatsResponse)
+
+ReplicationErrorStats = _reflection.GeneratedProtocolMessageType('ReplicationErrorStats', (_message.Message,), dict(
+  DESCRIPTOR = _REPLICATIONERRORSTATS,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ReplicationErrorStats)
+  ))
+_sym_db.RegisterMessage(ReplicationErrorStats)
+
+GetReplicationErrorStatsRequest = _reflection.GeneratedProtocolMessageType('GetReplicationErrorStatsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETREPLICATIONERRORSTATSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetReplicationErrorStatsRequest)
+  ))
+_sym_db.RegisterMessage(GetReplicationErrorStatsRequest)
+
+GetReplicationErrorStatsResponse = _reflection.GeneratedProtocolMessageType('GetReplicationErrorStatsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETREPLICATIONERRORSTATSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetReplicationErrorStatsResponse)
+  ))
+_sym_db.RegisterMessage(GetReplicationErrorStatsResponse)
+
 StopSlaveRequest = _reflection.GeneratedProtocolMessageType('StopSlaveRequest', (_message.Message,), dict(
   DESCRIPTOR = _STOPSLAVEREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
@@ -3496,6 +12008,42 @@ StopSlaveMinimumResponse = _reflection.GeneratedProtocolMessageType('StopSlaveMi
   ))
 _sym_db.RegisterMessage(StopSlaveMinimumResponse)
 
+StopSlaveMinimumStreamRequest = _reflection.GeneratedProtocolMessageType('StopSlaveMinimumStreamRequest', (_message.Message,), dict(
+  DESCRIPTOR = _STOPSLAVEMINIMUMSTREAMREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.StopSlaveMinimumStreamRequest)
+  ))
+_sym_db.RegisterMessage(StopSlaveMinimumStreamRequest)
+
+StopSlaveMinimumStreamResponse = _reflection.GeneratedProtocolMessageType('StopSlaveMinimumStreamResponse', (_message.Message,), dict(
+  DESCRIPTOR = _STOPSLAVEMINIMUMSTREAMRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.StopSlaveMinimumStreamResponse)
+  ))
+_sym_db.RegisterMessage(StopSlaveMinimumStreamResponse)
+
+BoostReplicationCatchupRequest = _reflection.GeneratedProtocolMessageType('BoostReplicationCatchupRequest', (_message.Message,), dict(
+  DESCRIPTOR = _BOOSTREPLICATIONCATCHUPREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.BoostReplicationCatchupRequest)
+  ))
+_sym_db.RegisterMessage(BoostReplicationCatchupRequest)
+
+BoostReplicationCatchupResponse = _reflection.GeneratedProtocolMessageType('BoostReplicationCatchupResponse', (_message.Message,), dict(
+
+  SettingsEntry = _reflection.GeneratedProtocolMessageType('SettingsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _BOOSTREPLICATIONCATCHUPRESPONSE_SETTINGSENTRY,
+    __module__ = 'tabletmanagerdata_pb2'
+    # @@protoc_insertion_point(class_scope:tabletmanagerdata.BoostReplicationCatchupResponse.SettingsEntry)
+    ))
+  ,
+  DESCRIPTOR = _BOOSTREPLICATIONCATCHUPRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.BoostReplicationCatchupResponse)
+  ))
+_sym_db.RegisterMessage(BoostReplicationCatchupResponse)
+_sym_db.RegisterMessage(BoostReplicationCatchupResponse.SettingsEntry)
+
 StartSlaveRequest = _reflection.GeneratedProtocolMessageType('StartSlaveRequest', (_message.Message,), dict(
   DESCRIPTOR = _STARTSLAVEREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
@@ -3510,6 +12058,69 @@ StartSlaveResponse = _reflection.GeneratedProtocolMessageType('StartSlaveRespons
   ))
 _sym_db.RegisterMessage(StartSlaveResponse)
 
+EnableParallelReplicationRequest = _reflection.GeneratedProtocolMessageType('EnableParallelReplicationRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ENABLEPARALLELREPLICATIONREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.EnableParallelReplicationRequest)
+  ))
+_sym_db.RegisterMessage(EnableParallelReplicationRequest)
+
+EnableParallelReplicationResponse = _reflection.GeneratedProtocolMessageType('EnableParallelReplicationResponse', (_message.Message,), dict(
+  DESCRIPTOR = _ENABLEPARALLELREPLICATIONRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.EnableParallelReplicationResponse)
+  ))
+_sym_db.RegisterMessage(EnableParallelReplicationResponse)
+
+RotateReplicationCredentialsRequest = _reflection.GeneratedProtocolMessageType('RotateReplicationCredentialsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ROTATEREPLICATIONCREDENTIALSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RotateReplicationCredentialsRequest)
+  ))
+_sym_db.RegisterMessage(RotateReplicationCredentialsRequest)
+
+RotateReplicationCredentialsResponse = _reflection.GeneratedProtocolMessageType('RotateReplicationCredentialsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _ROTATEREPLICATIONCREDENTIALSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RotateReplicationCredentialsResponse)
+  ))
+_sym_db.RegisterMessage(RotateReplicationCredentialsResponse)
+
+ReplicationSSLOptions = _reflection.GeneratedProtocolMessageType('ReplicationSSLOptions', (_message.Message,), dict(
+  DESCRIPTOR = _REPLICATIONSSLOPTIONS,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ReplicationSSLOptions)
+  ))
+_sym_db.RegisterMessage(ReplicationSSLOptions)
+
+ConfigureReplicationSSLRequest = _reflection.GeneratedProtocolMessageType('ConfigureReplicationSSLRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CONFIGUREREPLICATIONSSLREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ConfigureReplicationSSLRequest)
+  ))
+_sym_db.RegisterMessage(ConfigureReplicationSSLRequest)
+
+ConfigureReplicationSSLResponse = _reflection.GeneratedProtocolMessageType('ConfigureReplicationSSLResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CONFIGUREREPLICATIONSSLRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ConfigureReplicationSSLResponse)
+  ))
+_sym_db.RegisterMessage(ConfigureReplicationSSLResponse)
+
+RepairRelayLogRequest = _reflection.GeneratedProtocolMessageType('RepairRelayLogRequest', (_message.Message,), dict(
+  DESCRIPTOR = _REPAIRRELAYLOGREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RepairRelayLogRequest)
+  ))
+_sym_db.RegisterMessage(RepairRelayLogRequest)
+
+RepairRelayLogResponse = _reflection.GeneratedProtocolMessageType('RepairRelayLogResponse', (_message.Message,), dict(
+  DESCRIPTOR = _REPAIRRELAYLOGRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RepairRelayLogResponse)
+  ))
+_sym_db.RegisterMessage(RepairRelayLogResponse)
+
 TabletExternallyReparentedRequest = _reflection.GeneratedProtocolMessageType('TabletExternallyReparentedRequest', (_message.Message,), dict(
   DESCRIPTOR = _TABLETEXTERNALLYREPARENTEDREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
@@ -3552,6 +12163,34 @@ GetSlavesResponse = _reflection.GeneratedProtocolMessageType('GetSlavesResponse'
   ))
 _sym_db.RegisterMessage(GetSlavesResponse)
 
+ReplicationNeighbor = _reflection.GeneratedProtocolMessageType('ReplicationNeighbor', (_message.Message,), dict(
+  DESCRIPTOR = _REPLICATIONNEIGHBOR,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ReplicationNeighbor)
+  ))
+_sym_db.RegisterMessage(ReplicationNeighbor)
+
+ReplicationGraph = _reflection.GeneratedProtocolMessageType('ReplicationGraph', (_message.Message,), dict(
+  DESCRIPTOR = _REPLICATIONGRAPH,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ReplicationGraph)
+  ))
+_sym_db.RegisterMessage(ReplicationGraph)
+
+GetReplicationGraphRequest = _reflection.GeneratedProtocolMessageType('GetReplicationGraphRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETREPLICATIONGRAPHREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetReplicationGraphRequest)
+  ))
+_sym_db.RegisterMessage(GetReplicationGraphRequest)
+
+GetReplicationGraphResponse = _reflection.GeneratedProtocolMessageType('GetReplicationGraphResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETREPLICATIONGRAPHRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetReplicationGraphResponse)
+  ))
+_sym_db.RegisterMessage(GetReplicationGraphResponse)
+
 WaitBlpPositionRequest = _reflection.GeneratedProtocolMessageType('WaitBlpPositionRequest', (_message.Message,), dict(
   DESCRIPTOR = _WAITBLPPOSITIONREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
@@ -3608,6 +12247,27 @@ RunBlpUntilResponse = _reflection.GeneratedProtocolMessageType('RunBlpUntilRespo
   ))
 _sym_db.RegisterMessage(RunBlpUntilResponse)
 
+BinlogFilter = _reflection.GeneratedProtocolMessageType('BinlogFilter', (_message.Message,), dict(
+  DESCRIPTOR = _BINLOGFILTER,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.BinlogFilter)
+  ))
+_sym_db.RegisterMessage(BinlogFilter)
+
+GetBinlogFiltersRequest = _reflection.GeneratedProtocolMessageType('GetBinlogFiltersRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETBINLOGFILTERSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetBinlogFiltersRequest)
+  ))
+_sym_db.RegisterMessage(GetBinlogFiltersRequest)
+
+GetBinlogFiltersResponse = _reflection.GeneratedProtocolMessageType('GetBinlogFiltersResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETBINLOGFILTERSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetBinlogFiltersResponse)
+  ))
+_sym_db.RegisterMessage(GetBinlogFiltersResponse)
+
 ResetReplicationRequest = _reflection.GeneratedProtocolMessageType('ResetReplicationRequest', (_message.Message,), dict(
   DESCRIPTOR = _RESETREPLICATIONREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
@@ -3650,6 +12310,27 @@ PopulateReparentJournalResponse = _reflection.GeneratedProtocolMessageType('Popu
   ))
 _sym_db.RegisterMessage(PopulateReparentJournalResponse)
 
+ReparentJournalEntry = _reflection.GeneratedProtocolMessageType('ReparentJournalEntry', (_message.Message,), dict(
+  DESCRIPTOR = _REPARENTJOURNALENTRY,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ReparentJournalEntry)
+  ))
+_sym_db.RegisterMessage(ReparentJournalEntry)
+
+GetLastReparentJournalEntryRequest = _reflection.GeneratedProtocolMessageType('GetLastReparentJournalEntryRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETLASTREPARENTJOURNALENTRYREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetLastReparentJournalEntryRequest)
+  ))
+_sym_db.RegisterMessage(GetLastReparentJournalEntryRequest)
+
+GetLastReparentJournalEntryResponse = _reflection.GeneratedProtocolMessageType('GetLastReparentJournalEntryResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETLASTREPARENTJOURNALENTRYRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetLastReparentJournalEntryResponse)
+  ))
+_sym_db.RegisterMessage(GetLastReparentJournalEntryResponse)
+
 InitSlaveRequest = _reflection.GeneratedProtocolMessageType('InitSlaveRequest', (_message.Message,), dict(
   DESCRIPTOR = _INITSLAVEREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
@@ -3720,6 +12401,20 @@ SetMasterResponse = _reflection.GeneratedProtocolMessageType('SetMasterResponse'
   ))
 _sym_db.RegisterMessage(SetMasterResponse)
 
+ConfigureReplicationRequest = _reflection.GeneratedProtocolMessageType('ConfigureReplicationRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CONFIGUREREPLICATIONREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ConfigureReplicationRequest)
+  ))
+_sym_db.RegisterMessage(ConfigureReplicationRequest)
+
+ConfigureReplicationResponse = _reflection.GeneratedProtocolMessageType('ConfigureReplicationResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CONFIGUREREPLICATIONRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ConfigureReplicationResponse)
+  ))
+_sym_db.RegisterMessage(ConfigureReplicationResponse)
+
 SlaveWasRestartedRequest = _reflection.GeneratedProtocolMessageType('SlaveWasRestartedRequest', (_message.Message,), dict(
   DESCRIPTOR = _SLAVEWASRESTARTEDREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
@@ -3762,6 +12457,97 @@ PromoteSlaveResponse = _reflection.GeneratedProtocolMessageType('PromoteSlaveRes
   ))
 _sym_db.RegisterMessage(PromoteSlaveResponse)
 
+SetReparentEligibilityRequest = _reflection.GeneratedProtocolMessageType('SetReparentEligibilityRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETREPARENTELIGIBILITYREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetReparentEligibilityRequest)
+  ))
+_sym_db.RegisterMessage(SetReparentEligibilityRequest)
+
+SetReparentEligibilityResponse = _reflection.GeneratedProtocolMessageType('SetReparentEligibilityResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETREPARENTELIGIBILITYRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetReparentEligibilityResponse)
+  ))
+_sym_db.RegisterMessage(SetReparentEligibilityResponse)
+
+CheckReparentCandidateRequest = _reflection.GeneratedProtocolMessageType('CheckReparentCandidateRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CHECKREPARENTCANDIDATEREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CheckReparentCandidateRequest)
+  ))
+_sym_db.RegisterMessage(CheckReparentCandidateRequest)
+
+CheckReparentCandidateResponse = _reflection.GeneratedProtocolMessageType('CheckReparentCandidateResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CHECKREPARENTCANDIDATERESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CheckReparentCandidateResponse)
+  ))
+_sym_db.RegisterMessage(CheckReparentCandidateResponse)
+
+SetSemiSyncAckCountRequest = _reflection.GeneratedProtocolMessageType('SetSemiSyncAckCountRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETSEMISYNCACKCOUNTREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetSemiSyncAckCountRequest)
+  ))
+_sym_db.RegisterMessage(SetSemiSyncAckCountRequest)
+
+SetSemiSyncAckCountResponse = _reflection.GeneratedProtocolMessageType('SetSemiSyncAckCountResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETSEMISYNCACKCOUNTRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetSemiSyncAckCountResponse)
+  ))
+_sym_db.RegisterMessage(SetSemiSyncAckCountResponse)
+
+DurabilityPolicy = _reflection.GeneratedProtocolMessageType('DurabilityPolicy', (_message.Message,), dict(
+  DESCRIPTOR = _DURABILITYPOLICY,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.DurabilityPolicy)
+  ))
+_sym_db.RegisterMessage(DurabilityPolicy)
+
+GetDurabilityPolicyRequest = _reflection.GeneratedProtocolMessageType('GetDurabilityPolicyRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETDURABILITYPOLICYREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetDurabilityPolicyRequest)
+  ))
+_sym_db.RegisterMessage(GetDurabilityPolicyRequest)
+
+GetDurabilityPolicyResponse = _reflection.GeneratedProtocolMessageType('GetDurabilityPolicyResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETDURABILITYPOLICYRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetDurabilityPolicyResponse)
+  ))
+_sym_db.RegisterMessage(GetDurabilityPolicyResponse)
+
+GetBackupLimitsRequest = _reflection.GeneratedProtocolMessageType('GetBackupLimitsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETBACKUPLIMITSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetBackupLimitsRequest)
+  ))
+_sym_db.RegisterMessage(GetBackupLimitsRequest)
+
+GetBackupLimitsResponse = _reflection.GeneratedProtocolMessageType('GetBackupLimitsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETBACKUPLIMITSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetBackupLimitsResponse)
+  ))
+_sym_db.RegisterMessage(GetBackupLimitsResponse)
+
+GetBackupPositionRequest = _reflection.GeneratedProtocolMessageType('GetBackupPositionRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETBACKUPPOSITIONREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetBackupPositionRequest)
+  ))
+_sym_db.RegisterMessage(GetBackupPositionRequest)
+
+GetBackupPositionResponse = _reflection.GeneratedProtocolMessageType('GetBackupPositionResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETBACKUPPOSITIONRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetBackupPositionResponse)
+  ))
+_sym_db.RegisterMessage(GetBackupPositionResponse)
+
 BackupRequest = _reflection.GeneratedProtocolMessageType('BackupRequest', (_message.Message,), dict(
   DESCRIPTOR = _BACKUPREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
@@ -3776,6 +12562,20 @@ BackupResponse = _reflection.GeneratedProtocolMessageType('BackupResponse', (_me
   ))
 _sym_db.RegisterMessage(BackupResponse)
 
+IncrementalBackupRequest = _reflection.GeneratedProtocolMessageType('IncrementalBackupRequest', (_message.Message,), dict(
+  DESCRIPTOR = _INCREMENTALBACKUPREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.IncrementalBackupRequest)
+  ))
+_sym_db.RegisterMessage(IncrementalBackupRequest)
+
+IncrementalBackupResponse = _reflection.GeneratedProtocolMessageType('IncrementalBackupResponse', (_message.Message,), dict(
+  DESCRIPTOR = _INCREMENTALBACKUPRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.IncrementalBackupResponse)
+  ))
+_sym_db.RegisterMessage(IncrementalBackupResponse)
+
 RestoreFromBackupRequest = _reflection.GeneratedProtocolMessageType('RestoreFromBackupRequest', (_message.Message,), dict(
   DESCRIPTOR = _RESTOREFROMBACKUPREQUEST,
   __module__ = 'tabletmanagerdata_pb2'
@@ -3790,6 +12590,146 @@ RestoreFromBackupResponse = _reflection.GeneratedProtocolMessageType('RestoreFro
   ))
 _sym_db.RegisterMessage(RestoreFromBackupResponse)
 
+RestoreToTimestampRequest = _reflection.GeneratedProtocolMessageType('RestoreToTimestampRequest', (_message.Message,), dict(
+  DESCRIPTOR = _RESTORETOTIMESTAMPREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RestoreToTimestampRequest)
+  ))
+_sym_db.RegisterMessage(RestoreToTimestampRequest)
+
+RestoreToTimestampResponse = _reflection.GeneratedProtocolMessageType('RestoreToTimestampResponse', (_message.Message,), dict(
+  DESCRIPTOR = _RESTORETOTIMESTAMPRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.RestoreToTimestampResponse)
+  ))
+_sym_db.RegisterMessage(RestoreToTimestampResponse)
+
+SetPreferredBackupRequest = _reflection.GeneratedProtocolMessageType('SetPreferredBackupRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETPREFERREDBACKUPREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetPreferredBackupRequest)
+  ))
+_sym_db.RegisterMessage(SetPreferredBackupRequest)
+
+SetPreferredBackupResponse = _reflection.GeneratedProtocolMessageType('SetPreferredBackupResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETPREFERREDBACKUPRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.SetPreferredBackupResponse)
+  ))
+_sym_db.RegisterMessage(SetPreferredBackupResponse)
+
+GetPreferredBackupRequest = _reflection.GeneratedProtocolMessageType('GetPreferredBackupRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETPREFERREDBACKUPREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetPreferredBackupRequest)
+  ))
+_sym_db.RegisterMessage(GetPreferredBackupRequest)
+
+GetPreferredBackupResponse = _reflection.GeneratedProtocolMessageType('GetPreferredBackupResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETPREFERREDBACKUPRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetPreferredBackupResponse)
+  ))
+_sym_db.RegisterMessage(GetPreferredBackupResponse)
+
+CompatCheck = _reflection.GeneratedProtocolMessageType('CompatCheck', (_message.Message,), dict(
+  DESCRIPTOR = _COMPATCHECK,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CompatCheck)
+  ))
+_sym_db.RegisterMessage(CompatCheck)
+
+CompatReport = _reflection.GeneratedProtocolMessageType('CompatReport', (_message.Message,), dict(
+  DESCRIPTOR = _COMPATREPORT,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CompatReport)
+  ))
+_sym_db.RegisterMessage(CompatReport)
+
+CheckRestoreCompatibilityRequest = _reflection.GeneratedProtocolMessageType('CheckRestoreCompatibilityRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CHECKRESTORECOMPATIBILITYREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CheckRestoreCompatibilityRequest)
+  ))
+_sym_db.RegisterMessage(CheckRestoreCompatibilityRequest)
+
+CheckRestoreCompatibilityResponse = _reflection.GeneratedProtocolMessageType('CheckRestoreCompatibilityResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CHECKRESTORECOMPATIBILITYRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CheckRestoreCompatibilityResponse)
+  ))
+_sym_db.RegisterMessage(CheckRestoreCompatibilityResponse)
+
+BackupInfo = _reflection.GeneratedProtocolMessageType('BackupInfo', (_message.Message,), dict(
+  DESCRIPTOR = _BACKUPINFO,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.BackupInfo)
+  ))
+_sym_db.RegisterMessage(BackupInfo)
+
+GetLastBackupInfoRequest = _reflection.GeneratedProtocolMessageType('GetLastBackupInfoRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETLASTBACKUPINFOREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetLastBackupInfoRequest)
+  ))
+_sym_db.RegisterMessage(GetLastBackupInfoRequest)
+
+GetLastBackupInfoResponse = _reflection.GeneratedProtocolMessageType('GetLastBackupInfoResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETLASTBACKUPINFORESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetLastBackupInfoResponse)
+  ))
+_sym_db.RegisterMessage(GetLastBackupInfoResponse)
+
+GetBackupFreshnessRequest = _reflection.GeneratedProtocolMessageType('GetBackupFreshnessRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETBACKUPFRESHNESSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetBackupFreshnessRequest)
+  ))
+_sym_db.RegisterMessage(GetBackupFreshnessRequest)
+
+GetBackupFreshnessResponse = _reflection.GeneratedProtocolMessageType('GetBackupFreshnessResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETBACKUPFRESHNESSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.GetBackupFreshnessResponse)
+  ))
+_sym_db.RegisterMessage(GetBackupFreshnessResponse)
+
+ReadinessReport = _reflection.GeneratedProtocolMessageType('ReadinessReport', (_message.Message,), dict(
+  DESCRIPTOR = _READINESSREPORT,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.ReadinessReport)
+  ))
+_sym_db.RegisterMessage(ReadinessReport)
+
+CheckBackupReadinessRequest = _reflection.GeneratedProtocolMessageType('CheckBackupReadinessRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CHECKBACKUPREADINESSREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CheckBackupReadinessRequest)
+  ))
+_sym_db.RegisterMessage(CheckBackupReadinessRequest)
+
+CheckBackupReadinessResponse = _reflection.GeneratedProtocolMessageType('CheckBackupReadinessResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CHECKBACKUPREADINESSRESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.CheckBackupReadinessResponse)
+  ))
+_sym_db.RegisterMessage(CheckBackupReadinessResponse)
+
+TestRestoreRequest = _reflection.GeneratedProtocolMessageType('TestRestoreRequest', (_message.Message,), dict(
+  DESCRIPTOR = _TESTRESTOREREQUEST,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.TestRestoreRequest)
+  ))
+_sym_db.RegisterMessage(TestRestoreRequest)
+
+TestRestoreResponse = _reflection.GeneratedProtocolMessageType('TestRestoreResponse', (_message.Message,), dict(
+  DESCRIPTOR = _TESTRESTORERESPONSE,
+  __module__ = 'tabletmanagerdata_pb2'
+  # @@protoc_insertion_point(class_scope:tabletmanagerdata.TestRestoreResponse)
+  ))
+_sym_db.RegisterMessage(TestRestoreResponse)
+
 
 _USERPERMISSION_PRIVILEGESENTRY.has_options = True
 _USERPERMISSION_PRIVILEGESENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
@@ -3797,6 +12737,32 @@ _DBPERMISSION_PRIVILEGESENTRY.has_options = True
 _DBPERMISSION_PRIVILEGESENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 _EXECUTEHOOKREQUEST_EXTRAENVENTRY.has_options = True
 _EXECUTEHOOKREQUEST_EXTRAENVENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_EXECUTEHOOKTOSTREAMREQUEST_EXTRAENVENTRY.has_options = True
+_EXECUTEHOOKTOSTREAMREQUEST_EXTRAENVENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_GETCREATESTATEMENTSRESPONSE_CREATESTATEMENTSENTRY.has_options = True
+_GETCREATESTATEMENTSRESPONSE_CREATESTATEMENTSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_GETSCHEMATIMESTAMPSRESPONSE_TIMESTAMPSENTRY.has_options = True
+_GETSCHEMATIMESTAMPSRESPONSE_TIMESTAMPSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_GETCONNECTIONREJECTIONSTATSRESPONSE_REJECTIONSENTRY.has_options = True
+_GETCONNECTIONREJECTIONSTATSRESPONSE_REJECTIONSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_GETCONFIGRESPONSE_FLAGSENTRY.has_options = True
+_GETCONFIGRESPONSE_FLAGSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_GETINFLIGHTRPCSRESPONSE_INFLIGHTENTRY.has_options = True
+_GETINFLIGHTRPCSRESPONSE_INFLIGHTENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_DIAGNOSTICBUNDLE_VARIABLESENTRY.has_options = True
+_DIAGNOSTICBUNDLE_VARIABLESENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_GETTABLETTAGSRESPONSE_TAGSENTRY.has_options = True
+_GETTABLETTAGSRESPONSE_TAGSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_SETTABLETTAGSREQUEST_TAGSENTRY.has_options = True
+_SETTABLETTAGSREQUEST_TAGSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_CHARSETCONFIG_DATABASECHARSETSENTRY.has_options = True
+_CHARSETCONFIG_DATABASECHARSETSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_CHARSETCONFIG_DATABASECOLLATIONSENTRY.has_options = True
+_CHARSETCONFIG_DATABASECOLLATIONSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_SLAVESTATUSALLCHANNELSRESPONSE_STATUSESENTRY.has_options = True
+_SLAVESTATUSALLCHANNELSRESPONSE_STATUSESENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_BOOSTREPLICATIONCATCHUPRESPONSE_SETTINGSENTRY.has_options = True
+_BOOSTREPLICATIONCATCHUPRESPONSE_SETTINGSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 import grpc
 from grpc.beta import implementations as beta_implementations
 from grpc.beta import interfaces as beta_interfaces
@@ -20,7 +20,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   name='tabletmanagerservice.proto',
   package='tabletmanagerservice',
   syntax='proto3',
-  serialized_pb=_b('\n\x1atabletmanagerservice.proto\x12\x14tabletmanagerservice\x1a\x17tabletmanagerdata.proto2\x9c\"\n\rTabletManager\x12I\n\x04Ping\x12\x1e.tabletmanagerdata.PingRequest\x1a\x1f.tabletmanagerdata.PingResponse\"\x00\x12L\n\x05Sleep\x12\x1f.tabletmanagerdata.SleepRequest\x1a .tabletmanagerdata.SleepResponse\"\x00\x12^\n\x0b\x45xecuteHook\x12%.tabletmanagerdata.ExecuteHookRequest\x1a&.tabletmanagerdata.ExecuteHookResponse\"\x00\x12X\n\tGetSchema\x12#.tabletmanagerdata.GetSchemaRequest\x1a$.tabletmanagerdata.GetSchemaResponse\"\x00\x12g\n\x0eGetPermissions\x12(.tabletmanagerdata.GetPermissionsRequest\x1a).tabletmanagerdata.GetPermissionsResponse\"\x00\x12^\n\x0bSetReadOnly\x12%.tabletmanagerdata.SetReadOnlyRequest\x1a&.tabletmanagerdata.SetReadOnlyResponse\"\x00\x12\x61\n\x0cSetReadWrite\x12&.tabletmanagerdata.SetReadWriteRequest\x1a\'.tabletmanagerdata.SetReadWriteResponse\"\x00\x12[\n\nChangeType\x12$.tabletmanagerdata.ChangeTypeRequest\x1a%.tabletmanagerdata.ChangeTypeResponse\"\x00\x12\x61\n\x0cRefreshState\x12&.tabletmanagerdata.RefreshStateRequest\x1a\'.tabletmanagerdata.RefreshStateResponse\"\x00\x12g\n\x0eRunHealthCheck\x12(.tabletmanagerdata.RunHealthCheckRequest\x1a).tabletmanagerdata.RunHealthCheckResponse\"\x00\x12p\n\x11IgnoreHealthError\x12+.tabletmanagerdata.IgnoreHealthErrorRequest\x1a,.tabletmanagerdata.IgnoreHealthErrorResponse\"\x00\x12\x61\n\x0cReloadSchema\x12&.tabletmanagerdata.ReloadSchemaRequest\x1a\'.tabletmanagerdata.ReloadSchemaResponse\"\x00\x12j\n\x0fPreflightSchema\x12).tabletmanagerdata.PreflightSchemaRequest\x1a*.tabletmanagerdata.PreflightSchemaResponse\"\x00\x12^\n\x0b\x41pplySchema\x12%.tabletmanagerdata.ApplySchemaRequest\x1a&.tabletmanagerdata.ApplySchemaResponse\"\x00\x12p\n\x11\x45xecuteFetchAsDba\x12+.tabletmanagerdata.ExecuteFetchAsDbaRequest\x1a,.tabletmanagerdata.ExecuteFetchAsDbaResponse\"\x00\x12\x7f\n\x16\x45xecuteFetchAsAllPrivs\x12\x30.tabletmanagerdata.ExecuteFetchAsAllPrivsRequest\x1a\x31.tabletmanagerdata.ExecuteFetchAsAllPrivsResponse\"\x00\x12p\n\x11\x45xecuteFetchAsApp\x12+.tabletmanagerdata.ExecuteFetchAsAppRequest\x1a,.tabletmanagerdata.ExecuteFetchAsAppResponse\"\x00\x12^\n\x0bSlaveStatus\x12%.tabletmanagerdata.SlaveStatusRequest\x1a&.tabletmanagerdata.SlaveStatusResponse\"\x00\x12g\n\x0eMasterPosition\x12(.tabletmanagerdata.MasterPositionRequest\x1a).tabletmanagerdata.MasterPositionResponse\"\x00\x12X\n\tStopSlave\x12#.tabletmanagerdata.StopSlaveRequest\x1a$.tabletmanagerdata.StopSlaveResponse\"\x00\x12m\n\x10StopSlaveMinimum\x12*.tabletmanagerdata.StopSlaveMinimumRequest\x1a+.tabletmanagerdata.StopSlaveMinimumResponse\"\x00\x12[\n\nStartSlave\x12$.tabletmanagerdata.StartSlaveRequest\x1a%.tabletmanagerdata.StartSlaveResponse\"\x00\x12\x8b\x01\n\x1aTabletExternallyReparented\x12\x34.tabletmanagerdata.TabletExternallyReparentedRequest\x1a\x35.tabletmanagerdata.TabletExternallyReparentedResponse\"\x00\x12\x82\x01\n\x17TabletExternallyElected\x12\x31.tabletmanagerdata.TabletExternallyElectedRequest\x1a\x32.tabletmanagerdata.TabletExternallyElectedResponse\"\x00\x12X\n\tGetSlaves\x12#.tabletmanagerdata.GetSlavesRequest\x1a$.tabletmanagerdata.GetSlavesResponse\"\x00\x12j\n\x0fWaitBlpPosition\x12).tabletmanagerdata.WaitBlpPositionRequest\x1a*.tabletmanagerdata.WaitBlpPositionResponse\"\x00\x12R\n\x07StopBlp\x12!.tabletmanagerdata.StopBlpRequest\x1a\".tabletmanagerdata.StopBlpResponse\"\x00\x12U\n\x08StartBlp\x12\".tabletmanagerdata.StartBlpRequest\x1a#.tabletmanagerdata.StartBlpResponse\"\x00\x12^\n\x0bRunBlpUntil\x12%.tabletmanagerdata.RunBlpUntilRequest\x1a&.tabletmanagerdata.RunBlpUntilResponse\"\x00\x12m\n\x10ResetReplication\x12*.tabletmanagerdata.ResetReplicationRequest\x1a+.tabletmanagerdata.ResetReplicationResponse\"\x00\x12[\n\nInitMaster\x12$.tabletmanagerdata.InitMasterRequest\x1a%.tabletmanagerdata.InitMasterResponse\"\x00\x12\x82\x01\n\x17PopulateReparentJournal\x12\x31.tabletmanagerdata.PopulateReparentJournalRequest\x1a\x32.tabletmanagerdata.PopulateReparentJournalResponse\"\x00\x12X\n\tInitSlave\x12#.tabletmanagerdata.InitSlaveRequest\x1a$.tabletmanagerdata.InitSlaveResponse\"\x00\x12\x61\n\x0c\x44\x65moteMaster\x12&.tabletmanagerdata.DemoteMasterRequest\x1a\'.tabletmanagerdata.DemoteMasterResponse\"\x00\x12\x85\x01\n\x18PromoteSlaveWhenCaughtUp\x12\x32.tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest\x1a\x33.tabletmanagerdata.PromoteSlaveWhenCaughtUpResponse\"\x00\x12m\n\x10SlaveWasPromoted\x12*.tabletmanagerdata.SlaveWasPromotedRequest\x1a+.tabletmanagerdata.SlaveWasPromotedResponse\"\x00\x12X\n\tSetMaster\x12#.tabletmanagerdata.SetMasterRequest\x1a$.tabletmanagerdata.SetMasterResponse\"\x00\x12p\n\x11SlaveWasRestarted\x12+.tabletmanagerdata.SlaveWasRestartedRequest\x1a,.tabletmanagerdata.SlaveWasRestartedResponse\"\x00\x12\x8e\x01\n\x1bStopReplicationAndGetStatus\x12\x35.tabletmanagerdata.StopReplicationAndGetStatusRequest\x1a\x36.tabletmanagerdata.StopReplicationAndGetStatusResponse\"\x00\x12\x61\n\x0cPromoteSlave\x12&.tabletmanagerdata.PromoteSlaveRequest\x1a\'.tabletmanagerdata.PromoteSlaveResponse\"\x00\x12Q\n\x06\x42\x61\x63kup\x12 .tabletmanagerdata.BackupRequest\x1a!.tabletmanagerdata.BackupResponse\"\x00\x30\x01\x12r\n\x11RestoreFromBackup\x12+.tabletmanagerdata.RestoreFromBackupRequest\x1a,.tabletmanagerdata.RestoreFromBackupResponse\"\x00\x30\x01\x62\x06proto3')
+  serialized_pb=_b('\n\x1atabletmanagerservice.proto\x12\x14tabletmanagerservice\x1a\x17tabletmanagerdata.proto2\x92m\n\rTabletManager\x12I\n\x04Ping\x12\x1e.tabletmanagerdata.PingRequest\x1a\x1f.tabletmanagerdata.PingResponse\"\x00\x12L\n\x05Sleep\x12\x1f.tabletmanagerdata.SleepRequest\x1a .tabletmanagerdata.SleepResponse\"\x00\x12^\n\x0b\x45xecuteHook\x12%.tabletmanagerdata.ExecuteHookRequest\x1a&.tabletmanagerdata.ExecuteHookResponse\"\x00\x12x\n\x13\x45xecuteHookToStream\x12-.tabletmanagerdata.ExecuteHookToStreamRequest\x1a..tabletmanagerdata.ExecuteHookToStreamResponse\"\x00\x30\x01\x12X\n\tGetSchema\x12#.tabletmanagerdata.GetSchemaRequest\x1a$.tabletmanagerdata.GetSchemaResponse\"\x00\x12v\n\x13GetCreateStatements\x12-.tabletmanagerdata.GetCreateStatementsRequest\x1a..tabletmanagerdata.GetCreateStatementsResponse\"\x00\x12v\n\x13GetSchemaTimestamps\x12-.tabletmanagerdata.GetSchemaTimestampsRequest\x1a..tabletmanagerdata.GetSchemaTimestampsResponse\"\x00\x12g\n\x0eGetPermissions\x12(.tabletmanagerdata.GetPermissionsRequest\x1a).tabletmanagerdata.GetPermissionsResponse\"\x00\x12s\n\x12GetConnectionStats\x12,.tabletmanagerdata.GetConnectionStatsRequest\x1a-.tabletmanagerdata.GetConnectionStatsResponse\"\x00\x12\x8e\x01\n\x1bGetConnectionRejectionStats\x12\x35.tabletmanagerdata.GetConnectionRejectionStatsRequest\x1a\x36.tabletmanagerdata.GetConnectionRejectionStatsResponse\"\x00\x12X\n\tGetConfig\x12#.tabletmanagerdata.GetConfigRequest\x1a$.tabletmanagerdata.GetConfigResponse\"\x00\x12j\n\x0fGetInFlightRPCs\x12).tabletmanagerdata.GetInFlightRPCsRequest\x1a*.tabletmanagerdata.GetInFlightRPCsResponse\"\x00\x12j\n\x0fGetProcessStats\x12).tabletmanagerdata.GetProcessStatsRequest\x1a*.tabletmanagerdata.GetProcessStatsResponse\"\x00\x12g\n\x0eGetHealthScore\x12(.tabletmanagerdata.GetHealthScoreRequest\x1a).tabletmanagerdata.GetHealthScoreResponse\"\x00\x12j\n\x0fGetErrorLogTail\x12).tabletmanagerdata.GetErrorLogTailRequest\x1a*.tabletmanagerdata.GetErrorLogTailResponse\"\x00\x12s\n\x12\x43\x61ptureDiagnostics\x12,.tabletmanagerdata.CaptureDiagnosticsRequest\x1a-.tabletmanagerdata.CaptureDiagnosticsResponse\"\x00\x12\x61\n\x0c\x43heckDataDir\x12&.tabletmanagerdata.CheckDataDirRequest\x1a\'.tabletmanagerdata.CheckDataDirResponse\"\x00\x12m\n\x10GetThrottleState\x12*.tabletmanagerdata.GetThrottleStateRequest\x1a+.tabletmanagerdata.GetThrottleStateResponse\"\x00\x12\x64\n\rGetTabletTags\x12\'.tabletmanagerdata.GetTabletTagsRequest\x1a(.tabletmanagerdata.GetTabletTagsResponse\"\x00\x12^\n\x0bSetReadOnly\x12%.tabletmanagerdata.SetReadOnlyRequest\x1a&.tabletmanagerdata.SetReadOnlyResponse\"\x00\x12\x61\n\x0cSetReadWrite\x12&.tabletmanagerdata.SetReadWriteRequest\x1a\'.tabletmanagerdata.SetReadWriteResponse\"\x00\x12s\n\x12SetReadOnlyWithTTL\x12,.tabletmanagerdata.SetReadOnlyWithTTLRequest\x1a-.tabletmanagerdata.SetReadOnlyWithTTLResponse\"\x00\x12m\n\x10SetSuperReadOnly\x12*.tabletmanagerdata.SetSuperReadOnlyRequest\x1a+.tabletmanagerdata.SetSuperReadOnlyResponse\"\x00\x12y\n\x14SetQueryServerConfig\x12..tabletmanagerdata.SetQueryServerConfigRequest\x1a/.tabletmanagerdata.SetQueryServerConfigResponse\"\x00\x12p\n\x11GetQueryBlacklist\x12+.tabletmanagerdata.GetQueryBlacklistRequest\x1a,.tabletmanagerdata.GetQueryBlacklistResponse\"\x00\x12p\n\x11SetQueryBlacklist\x12+.tabletmanagerdata.SetQueryBlacklistRequest\x1a,.tabletmanagerdata.SetQueryBlacklistResponse\"\x00\x12[\n\nChangeType\x12$.tabletmanagerdata.ChangeTypeRequest\x1a%.tabletmanagerdata.ChangeTypeResponse\"\x00\x12\x64\n\rSetTabletTags\x12\'.tabletmanagerdata.SetTabletTagsRequest\x1a(.tabletmanagerdata.SetTabletTagsResponse\"\x00\x12\x61\n\x0cRefreshState\x12&.tabletmanagerdata.RefreshStateRequest\x1a\'.tabletmanagerdata.RefreshStateResponse\"\x00\x12v\n\x13RepublishTopoRecord\x12-.tabletmanagerdata.RepublishTopoRecordRequest\x1a..tabletmanagerdata.RepublishTopoRecordResponse\"\x00\x12g\n\x0eRunHealthCheck\x12(.tabletmanagerdata.RunHealthCheckRequest\x1a).tabletmanagerdata.RunHealthCheckResponse\"\x00\x12p\n\x11IgnoreHealthError\x12+.tabletmanagerdata.IgnoreHealthErrorRequest\x1a,.tabletmanagerdata.IgnoreHealthErrorResponse\"\x00\x12s\n\x12SetMaintenanceMode\x12,.tabletmanagerdata.SetMaintenanceModeRequest\x1a-.tabletmanagerdata.SetMaintenanceModeResponse\"\x00\x12y\n\x14PauseHealthReporting\x12..tabletmanagerdata.PauseHealthReportingRequest\x1a/.tabletmanagerdata.PauseHealthReportingResponse\"\x00\x12^\n\x0bSetThrottle\x12%.tabletmanagerdata.SetThrottleRequest\x1a&.tabletmanagerdata.SetThrottleResponse\"\x00\x12g\n\x0ePrepareCutover\x12(.tabletmanagerdata.PrepareCutoverRequest\x1a).tabletmanagerdata.PrepareCutoverResponse\"\x00\x12\x64\n\rCommitCutover\x12\'.tabletmanagerdata.CommitCutoverRequest\x1a(.tabletmanagerdata.CommitCutoverResponse\"\x00\x12\x61\n\x0c\x41\x62ortCutover\x12&.tabletmanagerdata.AbortCutoverRequest\x1a\'.tabletmanagerdata.AbortCutoverResponse\"\x00\x12s\n\x12SetServingKeyRange\x12,.tabletmanagerdata.SetServingKeyRangeRequest\x1a-.tabletmanagerdata.SetServingKeyRangeResponse\"\x00\x12\x63\n\x0cRestartMysql\x12&.tabletmanagerdata.RestartMysqlRequest\x1a\'.tabletmanagerdata.RestartMysqlResponse\"\x00\x30\x01\x12\x63\n\x0c\x44\x65\x63ommission\x12&.tabletmanagerdata.DecommissionRequest\x1a\'.tabletmanagerdata.DecommissionResponse\"\x00\x30\x01\x12|\n\x15\x43heckTopoConnectivity\x12/.tabletmanagerdata.CheckTopoConnectivityRequest\x1a\x30.tabletmanagerdata.CheckTopoConnectivityResponse\"\x00\x12Q\n\x06WarmUp\x12 .tabletmanagerdata.WarmUpRequest\x1a!.tabletmanagerdata.WarmUpResponse\"\x00\x30\x01\x12\x61\n\x0cReloadSchema\x12&.tabletmanagerdata.ReloadSchemaRequest\x1a\'.tabletmanagerdata.ReloadSchemaResponse\"\x00\x12j\n\x0fPreflightSchema\x12).tabletmanagerdata.PreflightSchemaRequest\x1a*.tabletmanagerdata.PreflightSchemaResponse\"\x00\x12^\n\x0b\x41pplySchema\x12%.tabletmanagerdata.ApplySchemaRequest\x1a&.tabletmanagerdata.ApplySchemaResponse\"\x00\x12[\n\nSchemaDiff\x12$.tabletmanagerdata.SchemaDiffRequest\x1a%.tabletmanagerdata.SchemaDiffResponse\"\x00\x12s\n\x12\x41ssessSchemaChange\x12,.tabletmanagerdata.AssessSchemaChangeRequest\x1a-.tabletmanagerdata.AssessSchemaChangeResponse\"\x00\x12`\n\x0bWatchSchema\x12%.tabletmanagerdata.WatchSchemaRequest\x1a&.tabletmanagerdata.WatchSchemaResponse\"\x00\x30\x01\x12[\n\nGetVSchema\x12$.tabletmanagerdata.GetVSchemaRequest\x1a%.tabletmanagerdata.GetVSchemaResponse\"\x00\x12\x61\n\x0c\x41pplyVSchema\x12&.tabletmanagerdata.ApplyVSchemaRequest\x1a\'.tabletmanagerdata.ApplyVSchemaResponse\"\x00\x12p\n\x11\x45xecuteFetchAsDba\x12+.tabletmanagerdata.ExecuteFetchAsDbaRequest\x1a,.tabletmanagerdata.ExecuteFetchAsDbaResponse\"\x00\x12{\n\x14\x45xecuteFetchAsDbaCSV\x12..tabletmanagerdata.ExecuteFetchAsDbaCSVRequest\x1a/.tabletmanagerdata.ExecuteFetchAsDbaCSVResponse\"\x00\x30\x01\x12y\n\x14\x45xecuteFetchColumnar\x12..tabletmanagerdata.ExecuteFetchColumnarRequest\x1a/.tabletmanagerdata.ExecuteFetchColumnarResponse\"\x00\x12\x7f\n\x16\x45xecuteFetchAsAllPrivs\x12\x30.tabletmanagerdata.ExecuteFetchAsAllPrivsRequest\x1a\x31.tabletmanagerdata.ExecuteFetchAsAllPrivsResponse\"\x00\x12p\n\x11\x45xecuteFetchAsApp\x12+.tabletmanagerdata.ExecuteFetchAsAppRequest\x1a,.tabletmanagerdata.ExecuteFetchAsAppResponse\"\x00\x12\x61\n\x0c\x45xplainQuery\x12&.tabletmanagerdata.ExplainQueryRequest\x1a\'.tabletmanagerdata.ExplainQueryResponse\"\x00\x12^\n\x0b\x41pplyGrants\x12%.tabletmanagerdata.ApplyGrantsRequest\x1a&.tabletmanagerdata.ApplyGrantsResponse\"\x00\x12\x64\n\rChecksumTable\x12\'.tabletmanagerdata.ChecksumTableRequest\x1a(.tabletmanagerdata.ChecksumTableResponse\"\x00\x12\x64\n\rTruncateTable\x12\'.tabletmanagerdata.TruncateTableRequest\x1a(.tabletmanagerdata.TruncateTableResponse\"\x00\x12^\n\x0bRenameTable\x12%.tabletmanagerdata.RenameTableRequest\x1a&.tabletmanagerdata.RenameTableResponse\"\x00\x12{\n\x14StreamRowsInKeyRange\x12..tabletmanagerdata.StreamRowsInKeyRangeRequest\x1a/.tabletmanagerdata.StreamRowsInKeyRangeResponse\"\x00\x30\x01\x12{\n\x14StreamKeyRangeBinlog\x12..tabletmanagerdata.StreamKeyRangeBinlogRequest\x1a/.tabletmanagerdata.StreamKeyRangeBinlogResponse\"\x00\x30\x01\x12`\n\x0b\x43loneStream\x12%.tabletmanagerdata.CloneStreamRequest\x1a&.tabletmanagerdata.CloneStreamResponse\"\x00\x30\x01\x12m\n\x10GetCharsetConfig\x12*.tabletmanagerdata.GetCharsetConfigRequest\x1a+.tabletmanagerdata.GetCharsetConfigResponse\"\x00\x12g\n\x0eGetProcessList\x12(.tabletmanagerdata.GetProcessListRequest\x1a).tabletmanagerdata.GetProcessListResponse\"\x00\x12^\n\x0bKillProcess\x12%.tabletmanagerdata.KillProcessRequest\x1a&.tabletmanagerdata.KillProcessResponse\"\x00\x12i\n\x0eTailGeneralLog\x12(.tabletmanagerdata.TailGeneralLogRequest\x1a).tabletmanagerdata.TailGeneralLogResponse\"\x00\x30\x01\x12Z\n\tStreamQPS\x12#.tabletmanagerdata.StreamQPSRequest\x1a$.tabletmanagerdata.StreamQPSResponse\"\x00\x30\x01\x12^\n\x0bSlaveStatus\x12%.tabletmanagerdata.SlaveStatusRequest\x1a&.tabletmanagerdata.SlaveStatusResponse\"\x00\x12\x7f\n\x16SlaveStatusAllChannels\x12\x30.tabletmanagerdata.SlaveStatusAllChannelsRequest\x1a\x31.tabletmanagerdata.SlaveStatusAllChannelsResponse\"\x00\x12y\n\x14GetReplicationSource\x12..tabletmanagerdata.GetReplicationSourceRequest\x1a/.tabletmanagerdata.GetReplicationSourceResponse\"\x00\x12\x97\x01\n\x1eValidateReplicationCredentials\x12\x38.tabletmanagerdata.ValidateReplicationCredentialsRequest\x1a\x39.tabletmanagerdata.ValidateReplicationCredentialsResponse\"\x00\x12y\n\x14GetApplierQueueStats\x12..tabletmanagerdata.GetApplierQueueStatsRequest\x1a/.tabletmanagerdata.GetApplierQueueStatsResponse\"\x00\x12g\n\x0eMasterPosition\x12(.tabletmanagerdata.MasterPositionRequest\x1a).tabletmanagerdata.MasterPositionResponse\"\x00\x12\x64\n\rGetGtidPurged\x12\'.tabletmanagerdata.GetGtidPurgedRequest\x1a(.tabletmanagerdata.GetGtidPurgedResponse\"\x00\x12g\n\x0eGetBinlogStats\x12(.tabletmanagerdata.GetBinlogStatsRequest\x1a).tabletmanagerdata.GetBinlogStatsResponse\"\x00\x12\x85\x01\n\x18GetReplicationErrorStats\x12\x32.tabletmanagerdata.GetReplicationErrorStatsRequest\x1a\x33.tabletmanagerdata.GetReplicationErrorStatsResponse\"\x00\x12X\n\tStopSlave\x12#.tabletmanagerdata.StopSlaveRequest\x1a$.tabletmanagerdata.StopSlaveResponse\"\x00\x12m\n\x10StopSlaveMinimum\x12*.tabletmanagerdata.StopSlaveMinimumRequest\x1a+.tabletmanagerdata.StopSlaveMinimumResponse\"\x00\x12\x81\x01\n\x16StopSlaveMinimumStream\x12\x30.tabletmanagerdata.StopSlaveMinimumStreamRequest\x1a\x31.tabletmanagerdata.StopSlaveMinimumStreamResponse\"\x00\x30\x01\x12\x84\x01\n\x17\x42oostReplicationCatchup\x12\x31.tabletmanagerdata.BoostReplicationCatchupRequest\x1a\x32.tabletmanagerdata.BoostReplicationCatchupResponse\"\x00\x30\x01\x12[\n\nStartSlave\x12$.tabletmanagerdata.StartSlaveRequest\x1a%.tabletmanagerdata.StartSlaveResponse\"\x00\x12\x88\x01\n\x19\x45nableParallelReplication\x12\x33.tabletmanagerdata.EnableParallelReplicationRequest\x1a\x34.tabletmanagerdata.EnableParallelReplicationResponse\"\x00\x12\x91\x01\n\x1cRotateReplicationCredentials\x12\x36.tabletmanagerdata.RotateReplicationCredentialsRequest\x1a\x37.tabletmanagerdata.RotateReplicationCredentialsResponse\"\x00\x12\x82\x01\n\x17\x43onfigureReplicationSSL\x12\x31.tabletmanagerdata.ConfigureReplicationSSLRequest\x1a\x32.tabletmanagerdata.ConfigureReplicationSSLResponse\"\x00\x12i\n\x0eRepairRelayLog\x12(.tabletmanagerdata.RepairRelayLogRequest\x1a).tabletmanagerdata.RepairRelayLogResponse\"\x00\x30\x01\x12\x8b\x01\n\x1aTabletExternallyReparented\x12\x34.tabletmanagerdata.TabletExternallyReparentedRequest\x1a\x35.tabletmanagerdata.TabletExternallyReparentedResponse\"\x00\x12\x82\x01\n\x17TabletExternallyElected\x12\x31.tabletmanagerdata.TabletExternallyElectedRequest\x1a\x32.tabletmanagerdata.TabletExternallyElectedResponse\"\x00\x12X\n\tGetSlaves\x12#.tabletmanagerdata.GetSlavesRequest\x1a$.tabletmanagerdata.GetSlavesResponse\"\x00\x12v\n\x13GetReplicationGraph\x12-.tabletmanagerdata.GetReplicationGraphRequest\x1a..tabletmanagerdata.GetReplicationGraphResponse\"\x00\x12j\n\x0fWaitBlpPosition\x12).tabletmanagerdata.WaitBlpPositionRequest\x1a*.tabletmanagerdata.WaitBlpPositionResponse\"\x00\x12R\n\x07StopBlp\x12!.tabletmanagerdata.StopBlpRequest\x1a\".tabletmanagerdata.StopBlpResponse\"\x00\x12U\n\x08StartBlp\x12\".tabletmanagerdata.StartBlpRequest\x1a#.tabletmanagerdata.StartBlpResponse\"\x00\x12^\n\x0bRunBlpUntil\x12%.tabletmanagerdata.RunBlpUntilRequest\x1a&.tabletmanagerdata.RunBlpUntilResponse\"\x00\x12m\n\x10GetBinlogFilters\x12*.tabletmanagerdata.GetBinlogFiltersRequest\x1a+.tabletmanagerdata.GetBinlogFiltersResponse\"\x00\x12m\n\x10ResetReplication\x12*.tabletmanagerdata.ResetReplicationRequest\x1a+.tabletmanagerdata.ResetReplicationResponse\"\x00\x12[\n\nInitMaster\x12$.tabletmanagerdata.InitMasterRequest\x1a%.tabletmanagerdata.InitMasterResponse\"\x00\x12\x82\x01\n\x17PopulateReparentJournal\x12\x31.tabletmanagerdata.PopulateReparentJournalRequest\x1a\x32.tabletmanagerdata.PopulateReparentJournalResponse\"\x00\x12\x8e\x01\n\x1bGetLastReparentJournalEntry\x12\x35.tabletmanagerdata.GetLastReparentJournalEntryRequest\x1a\x36.tabletmanagerdata.GetLastReparentJournalEntryResponse\"\x00\x12X\n\tInitSlave\x12#.tabletmanagerdata.InitSlaveRequest\x1a$.tabletmanagerdata.InitSlaveResponse\"\x00\x12\x61\n\x0c\x44\x65moteMaster\x12&.tabletmanagerdata.DemoteMasterRequest\x1a\'.tabletmanagerdata.DemoteMasterResponse\"\x00\x12\x85\x01\n\x18PromoteSlaveWhenCaughtUp\x12\x32.tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest\x1a\x33.tabletmanagerdata.PromoteSlaveWhenCaughtUpResponse\"\x00\x12m\n\x10SlaveWasPromoted\x12*.tabletmanagerdata.SlaveWasPromotedRequest\x1a+.tabletmanagerdata.SlaveWasPromotedResponse\"\x00\x12X\n\tSetMaster\x12#.tabletmanagerdata.SetMasterRequest\x1a$.tabletmanagerdata.SetMasterResponse\"\x00\x12y\n\x14\x43onfigureReplication\x12..tabletmanagerdata.ConfigureReplicationRequest\x1a/.tabletmanagerdata.ConfigureReplicationResponse\"\x00\x12p\n\x11SlaveWasRestarted\x12+.tabletmanagerdata.SlaveWasRestartedRequest\x1a,.tabletmanagerdata.SlaveWasRestartedResponse\"\x00\x12\x8e\x01\n\x1bStopReplicationAndGetStatus\x12\x35.tabletmanagerdata.StopReplicationAndGetStatusRequest\x1a\x36.tabletmanagerdata.StopReplicationAndGetStatusResponse\"\x00\x12\x61\n\x0cPromoteSlave\x12&.tabletmanagerdata.PromoteSlaveRequest\x1a\'.tabletmanagerdata.PromoteSlaveResponse\"\x00\x12\x7f\n\x16SetReparentEligibility\x12\x30.tabletmanagerdata.SetReparentEligibilityRequest\x1a\x31.tabletmanagerdata.SetReparentEligibilityResponse\"\x00\x12\x7f\n\x16\x43heckReparentCandidate\x12\x30.tabletmanagerdata.CheckReparentCandidateRequest\x1a\x31.tabletmanagerdata.CheckReparentCandidateResponse\"\x00\x12v\n\x13SetSemiSyncAckCount\x12-.tabletmanagerdata.SetSemiSyncAckCountRequest\x1a..tabletmanagerdata.SetSemiSyncAckCountResponse\"\x00\x12v\n\x13GetDurabilityPolicy\x12-.tabletmanagerdata.GetDurabilityPolicyRequest\x1a..tabletmanagerdata.GetDurabilityPolicyResponse\"\x00\x12j\n\x0fGetBackupLimits\x12).tabletmanagerdata.GetBackupLimitsRequest\x1a*.tabletmanagerdata.GetBackupLimitsResponse\"\x00\x12p\n\x11GetBackupPosition\x12+.tabletmanagerdata.GetBackupPositionRequest\x1a,.tabletmanagerdata.GetBackupPositionResponse\"\x00\x12Q\n\x06\x42\x61\x63kup\x12 .tabletmanagerdata.BackupRequest\x1a!.tabletmanagerdata.BackupResponse\"\x00\x30\x01\x12r\n\x11IncrementalBackup\x12+.tabletmanagerdata.IncrementalBackupRequest\x1a,.tabletmanagerdata.IncrementalBackupResponse\"\x00\x30\x01\x12r\n\x11RestoreFromBackup\x12+.tabletmanagerdata.RestoreFromBackupRequest\x1a,.tabletmanagerdata.RestoreFromBackupResponse\"\x00\x30\x01\x12u\n\x12RestoreToTimestamp\x12,.tabletmanagerdata.RestoreToTimestampRequest\x1a-.tabletmanagerdata.RestoreToTimestampResponse\"\x00\x30\x01\x12s\n\x12SetPreferredBackup\x12,.tabletmanagerdata.SetPreferredBackupRequest\x1a-.tabletmanagerdata.SetPreferredBackupResponse\"\x00\x12s\n\x12GetPreferredBackup\x12,.tabletmanagerdata.GetPreferredBackupRequest\x1a-.tabletmanagerdata.GetPreferredBackupResponse\"\x00\x12\x88\x01\n\x19\x43heckRestoreCompatibility\x12\x33.tabletmanagerdata.CheckRestoreCompatibilityRequest\x1a\x34.tabletmanagerdata.CheckRestoreCompatibilityResponse\"\x00\x12p\n\x11GetLastBackupInfo\x12+.tabletmanagerdata.GetLastBackupInfoRequest\x1a,.tabletmanagerdata.GetLastBackupInfoResponse\"\x00\x12s\n\x12GetBackupFreshness\x12,.tabletmanagerdata.GetBackupFreshnessRequest\x1a-.tabletmanagerdata.GetBackupFreshnessResponse\"\x00\x12y\n\x14\x43heckBackupReadiness\x12..tabletmanagerdata.CheckBackupReadinessRequest\x1a/.tabletmanagerdata.CheckBackupReadinessResponse\"\x00\x12`\n\x0bTestRestore\x12%.tabletmanagerdata.TestRestoreRequest\x1a&.tabletmanagerdata.TestRestoreResponse\"\x00\x30\x01\x62\x06proto3')
   ,
   dependencies=[tabletmanagerdata__pb2.DESCRIPTOR,])
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
@@ -64,16 +64,86 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.ExecuteHookRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.ExecuteHookResponse.FromString,
         )
+    self.ExecuteHookToStream = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/ExecuteHookToStream',
+        request_serializer=tabletmanagerdata__pb2.ExecuteHookToStreamRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.ExecuteHookToStreamResponse.FromString,
+        )
     self.GetSchema = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/GetSchema',
         request_serializer=tabletmanagerdata__pb2.GetSchemaRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.GetSchemaResponse.FromString,
         )
+    self.GetCreateStatements = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetCreateStatements',
+        request_serializer=tabletmanagerdata__pb2.GetCreateStatementsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetCreateStatementsResponse.FromString,
+        )
+    self.GetSchemaTimestamps = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetSchemaTimestamps',
+        request_serializer=tabletmanagerdata__pb2.GetSchemaTimestampsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetSchemaTimestampsResponse.FromString,
+        )
     self.GetPermissions = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/GetPermissions',
         request_serializer=tabletmanagerdata__pb2.GetPermissionsRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.GetPermissionsResponse.FromString,
         )
+    self.GetConnectionStats = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetConnectionStats',
+        request_serializer=tabletmanagerdata__pb2.GetConnectionStatsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetConnectionStatsResponse.FromString,
+        )
+    self.GetConnectionRejectionStats = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetConnectionRejectionStats',
+        request_serializer=tabletmanagerdata__pb2.GetConnectionRejectionStatsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetConnectionRejectionStatsResponse.FromString,
+        )
+    self.GetConfig = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetConfig',
+        request_serializer=tabletmanagerdata__pb2.GetConfigRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetConfigResponse.FromString,
+        )
+    self.GetInFlightRPCs = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetInFlightRPCs',
+        request_serializer=tabletmanagerdata__pb2.GetInFlightRPCsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetInFlightRPCsResponse.FromString,
+        )
+    self.GetProcessStats = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetProcessStats',
+        request_serializer=tabletmanagerdata__pb2.GetProcessStatsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetProcessStatsResponse.FromString,
+        )
+    self.GetHealthScore = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetHealthScore',
+        request_serializer=tabletmanagerdata__pb2.GetHealthScoreRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetHealthScoreResponse.FromString,
+        )
+    self.GetErrorLogTail = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetErrorLogTail',
+        request_serializer=tabletmanagerdata__pb2.GetErrorLogTailRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetErrorLogTailResponse.FromString,
+        )
+    self.CaptureDiagnostics = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/CaptureDiagnostics',
+        request_serializer=tabletmanagerdata__pb2.CaptureDiagnosticsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.CaptureDiagnosticsResponse.FromString,
+        )
+    self.CheckDataDir = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/CheckDataDir',
+        request_serializer=tabletmanagerdata__pb2.CheckDataDirRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.CheckDataDirResponse.FromString,
+        )
+    self.GetThrottleState = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetThrottleState',
+        request_serializer=tabletmanagerdata__pb2.GetThrottleStateRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetThrottleStateResponse.FromString,
+        )
+    self.GetTabletTags = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetTabletTags',
+        request_serializer=tabletmanagerdata__pb2.GetTabletTagsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetTabletTagsResponse.FromString,
+        )
     self.SetReadOnly = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/SetReadOnly',
         request_serializer=tabletmanagerdata__pb2.SetReadOnlyRequest.SerializeToString,
@@ -84,16 +154,51 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.SetReadWriteRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.SetReadWriteResponse.FromString,
         )
+    self.SetReadOnlyWithTTL = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SetReadOnlyWithTTL',
+        request_serializer=tabletmanagerdata__pb2.SetReadOnlyWithTTLRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SetReadOnlyWithTTLResponse.FromString,
+        )
+    self.SetSuperReadOnly = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SetSuperReadOnly',
+        request_serializer=tabletmanagerdata__pb2.SetSuperReadOnlyRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SetSuperReadOnlyResponse.FromString,
+        )
+    self.SetQueryServerConfig = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SetQueryServerConfig',
+        request_serializer=tabletmanagerdata__pb2.SetQueryServerConfigRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SetQueryServerConfigResponse.FromString,
+        )
+    self.GetQueryBlacklist = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetQueryBlacklist',
+        request_serializer=tabletmanagerdata__pb2.GetQueryBlacklistRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetQueryBlacklistResponse.FromString,
+        )
+    self.SetQueryBlacklist = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SetQueryBlacklist',
+        request_serializer=tabletmanagerdata__pb2.SetQueryBlacklistRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SetQueryBlacklistResponse.FromString,
+        )
     self.ChangeType = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/ChangeType',
         request_serializer=tabletmanagerdata__pb2.ChangeTypeRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.ChangeTypeResponse.FromString,
         )
+    self.SetTabletTags = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SetTabletTags',
+        request_serializer=tabletmanagerdata__pb2.SetTabletTagsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SetTabletTagsResponse.FromString,
+        )
     self.RefreshState = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/RefreshState',
         request_serializer=tabletmanagerdata__pb2.RefreshStateRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.RefreshStateResponse.FromString,
         )
+    self.RepublishTopoRecord = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/RepublishTopoRecord',
+        request_serializer=tabletmanagerdata__pb2.RepublishTopoRecordRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.RepublishTopoRecordResponse.FromString,
+        )
     self.RunHealthCheck = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/RunHealthCheck',
         request_serializer=tabletmanagerdata__pb2.RunHealthCheckRequest.SerializeToString,
@@ -104,6 +209,61 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.IgnoreHealthErrorRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.IgnoreHealthErrorResponse.FromString,
         )
+    self.SetMaintenanceMode = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SetMaintenanceMode',
+        request_serializer=tabletmanagerdata__pb2.SetMaintenanceModeRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SetMaintenanceModeResponse.FromString,
+        )
+    self.PauseHealthReporting = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/PauseHealthReporting',
+        request_serializer=tabletmanagerdata__pb2.PauseHealthReportingRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.PauseHealthReportingResponse.FromString,
+        )
+    self.SetThrottle = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SetThrottle',
+        request_serializer=tabletmanagerdata__pb2.SetThrottleRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SetThrottleResponse.FromString,
+        )
+    self.PrepareCutover = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/PrepareCutover',
+        request_serializer=tabletmanagerdata__pb2.PrepareCutoverRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.PrepareCutoverResponse.FromString,
+        )
+    self.CommitCutover = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/CommitCutover',
+        request_serializer=tabletmanagerdata__pb2.CommitCutoverRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.CommitCutoverResponse.FromString,
+        )
+    self.AbortCutover = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/AbortCutover',
+        request_serializer=tabletmanagerdata__pb2.AbortCutoverRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.AbortCutoverResponse.FromString,
+        )
+    self.SetServingKeyRange = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SetServingKeyRange',
+        request_serializer=tabletmanagerdata__pb2.SetServingKeyRangeRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SetServingKeyRangeResponse.FromString,
+        )
+    self.RestartMysql = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/RestartMysql',
+        request_serializer=tabletmanagerdata__pb2.RestartMysqlRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.RestartMysqlResponse.FromString,
+        )
+    self.Decommission = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/Decommission',
+        request_serializer=tabletmanagerdata__pb2.DecommissionRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.DecommissionResponse.FromString,
+        )
+    self.CheckTopoConnectivity = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/CheckTopoConnectivity',
+        request_serializer=tabletmanagerdata__pb2.CheckTopoConnectivityRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.CheckTopoConnectivityResponse.FromString,
+        )
+    self.WarmUp = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/WarmUp',
+        request_serializer=tabletmanagerdata__pb2.WarmUpRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.WarmUpResponse.FromString,
+        )
     self.ReloadSchema = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/ReloadSchema',
         request_serializer=tabletmanagerdata__pb2.ReloadSchemaRequest.SerializeToString,
@@ -119,11 +279,46 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.ApplySchemaRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.ApplySchemaResponse.FromString,
         )
+    self.SchemaDiff = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SchemaDiff',
+        request_serializer=tabletmanagerdata__pb2.SchemaDiffRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SchemaDiffResponse.FromString,
+        )
+    self.AssessSchemaChange = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/AssessSchemaChange',
+        request_serializer=tabletmanagerdata__pb2.AssessSchemaChangeRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.AssessSchemaChangeResponse.FromString,
+        )
+    self.WatchSchema = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/WatchSchema',
+        request_serializer=tabletmanagerdata__pb2.WatchSchemaRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.WatchSchemaResponse.FromString,
+        )
+    self.GetVSchema = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetVSchema',
+        request_serializer=tabletmanagerdata__pb2.GetVSchemaRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetVSchemaResponse.FromString,
+        )
+    self.ApplyVSchema = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/ApplyVSchema',
+        request_serializer=tabletmanagerdata__pb2.ApplyVSchemaRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.ApplyVSchemaResponse.FromString,
+        )
     self.ExecuteFetchAsDba = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/ExecuteFetchAsDba',
         request_serializer=tabletmanagerdata__pb2.ExecuteFetchAsDbaRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.ExecuteFetchAsDbaResponse.FromString,
         )
+    self.ExecuteFetchAsDbaCSV = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/ExecuteFetchAsDbaCSV',
+        request_serializer=tabletmanagerdata__pb2.ExecuteFetchAsDbaCSVRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.ExecuteFetchAsDbaCSVResponse.FromString,
+        )
+    self.ExecuteFetchColumnar = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/ExecuteFetchColumnar',
+        request_serializer=tabletmanagerdata__pb2.ExecuteFetchColumnarRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.ExecuteFetchColumnarResponse.FromString,
+        )
     self.ExecuteFetchAsAllPrivs = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/ExecuteFetchAsAllPrivs',
         request_serializer=tabletmanagerdata__pb2.ExecuteFetchAsAllPrivsRequest.SerializeToString,
@@ -134,16 +329,116 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.ExecuteFetchAsAppRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.ExecuteFetchAsAppResponse.FromString,
         )
+    self.ExplainQuery = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/ExplainQuery',
+        request_serializer=tabletmanagerdata__pb2.ExplainQueryRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.ExplainQueryResponse.FromString,
+        )
+    self.ApplyGrants = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/ApplyGrants',
+        request_serializer=tabletmanagerdata__pb2.ApplyGrantsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.ApplyGrantsResponse.FromString,
+        )
+    self.ChecksumTable = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/ChecksumTable',
+        request_serializer=tabletmanagerdata__pb2.ChecksumTableRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.ChecksumTableResponse.FromString,
+        )
+    self.TruncateTable = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/TruncateTable',
+        request_serializer=tabletmanagerdata__pb2.TruncateTableRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.TruncateTableResponse.FromString,
+        )
+    self.RenameTable = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/RenameTable',
+        request_serializer=tabletmanagerdata__pb2.RenameTableRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.RenameTableResponse.FromString,
+        )
+    self.StreamRowsInKeyRange = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/StreamRowsInKeyRange',
+        request_serializer=tabletmanagerdata__pb2.StreamRowsInKeyRangeRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.StreamRowsInKeyRangeResponse.FromString,
+        )
+    self.StreamKeyRangeBinlog = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/StreamKeyRangeBinlog',
+        request_serializer=tabletmanagerdata__pb2.StreamKeyRangeBinlogRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.StreamKeyRangeBinlogResponse.FromString,
+        )
+    self.CloneStream = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/CloneStream',
+        request_serializer=tabletmanagerdata__pb2.CloneStreamRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.CloneStreamResponse.FromString,
+        )
+    self.GetCharsetConfig = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetCharsetConfig',
+        request_serializer=tabletmanagerdata__pb2.GetCharsetConfigRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetCharsetConfigResponse.FromString,
+        )
+    self.GetProcessList = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetProcessList',
+        request_serializer=tabletmanagerdata__pb2.GetProcessListRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetProcessListResponse.FromString,
+        )
+    self.KillProcess = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/KillProcess',
+        request_serializer=tabletmanagerdata__pb2.KillProcessRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.KillProcessResponse.FromString,
+        )
+    self.TailGeneralLog = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/TailGeneralLog',
+        request_serializer=tabletmanagerdata__pb2.TailGeneralLogRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.TailGeneralLogResponse.FromString,
+        )
+    self.StreamQPS = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/StreamQPS',
+        request_serializer=tabletmanagerdata__pb2.StreamQPSRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.StreamQPSResponse.FromString,
+        )
     self.SlaveStatus = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/SlaveStatus',
         request_serializer=tabletmanagerdata__pb2.SlaveStatusRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.SlaveStatusResponse.FromString,
         )
+    self.SlaveStatusAllChannels = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SlaveStatusAllChannels',
+        request_serializer=tabletmanagerdata__pb2.SlaveStatusAllChannelsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SlaveStatusAllChannelsResponse.FromString,
+        )
+    self.GetReplicationSource = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetReplicationSource',
+        request_serializer=tabletmanagerdata__pb2.GetReplicationSourceRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetReplicationSourceResponse.FromString,
+        )
+    self.ValidateReplicationCredentials = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/ValidateReplicationCredentials',
+        request_serializer=tabletmanagerdata__pb2.ValidateReplicationCredentialsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.ValidateReplicationCredentialsResponse.FromString,
+        )
+    self.GetApplierQueueStats = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetApplierQueueStats',
+        request_serializer=tabletmanagerdata__pb2.GetApplierQueueStatsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetApplierQueueStatsResponse.FromString,
+        )
     self.MasterPosition = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/MasterPosition',
         request_serializer=tabletmanagerdata__pb2.MasterPositionRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.MasterPositionResponse.FromString,
         )
+    self.GetGtidPurged = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetGtidPurged',
+        request_serializer=tabletmanagerdata__pb2.GetGtidPurgedRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetGtidPurgedResponse.FromString,
+        )
+    self.GetBinlogStats = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetBinlogStats',
+        request_serializer=tabletmanagerdata__pb2.GetBinlogStatsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetBinlogStatsResponse.FromString,
+        )
+    self.GetReplicationErrorStats = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetReplicationErrorStats',
+        request_serializer=tabletmanagerdata__pb2.GetReplicationErrorStatsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetReplicationErrorStatsResponse.FromString,
+        )
     self.StopSlave = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/StopSlave',
         request_serializer=tabletmanagerdata__pb2.StopSlaveRequest.SerializeToString,
@@ -154,11 +449,41 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.StopSlaveMinimumRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.StopSlaveMinimumResponse.FromString,
         )
+    self.StopSlaveMinimumStream = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/StopSlaveMinimumStream',
+        request_serializer=tabletmanagerdata__pb2.StopSlaveMinimumStreamRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.StopSlaveMinimumStreamResponse.FromString,
+        )
+    self.BoostReplicationCatchup = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/BoostReplicationCatchup',
+        request_serializer=tabletmanagerdata__pb2.BoostReplicationCatchupRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.BoostReplicationCatchupResponse.FromString,
+        )
     self.StartSlave = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/StartSlave',
         request_serializer=tabletmanagerdata__pb2.StartSlaveRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.StartSlaveResponse.FromString,
         )
+    self.EnableParallelReplication = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/EnableParallelReplication',
+        request_serializer=tabletmanagerdata__pb2.EnableParallelReplicationRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.EnableParallelReplicationResponse.FromString,
+        )
+    self.RotateReplicationCredentials = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/RotateReplicationCredentials',
+        request_serializer=tabletmanagerdata__pb2.RotateReplicationCredentialsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.RotateReplicationCredentialsResponse.FromString,
+        )
+    self.ConfigureReplicationSSL = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/ConfigureReplicationSSL',
+        request_serializer=tabletmanagerdata__pb2.ConfigureReplicationSSLRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.ConfigureReplicationSSLResponse.FromString,
+        )
+    self.RepairRelayLog = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/RepairRelayLog',
+        request_serializer=tabletmanagerdata__pb2.RepairRelayLogRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.RepairRelayLogResponse.FromString,
+        )
     self.TabletExternallyReparented = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/TabletExternallyReparented',
         request_serializer=tabletmanagerdata__pb2.TabletExternallyReparentedRequest.SerializeToString,
@@ -174,6 +499,11 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.GetSlavesRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.GetSlavesResponse.FromString,
         )
+    self.GetReplicationGraph = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetReplicationGraph',
+        request_serializer=tabletmanagerdata__pb2.GetReplicationGraphRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetReplicationGraphResponse.FromString,
+        )
     self.WaitBlpPosition = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/WaitBlpPosition',
         request_serializer=tabletmanagerdata__pb2.WaitBlpPositionRequest.SerializeToString,
@@ -194,6 +524,11 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.RunBlpUntilRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.RunBlpUntilResponse.FromString,
         )
+    self.GetBinlogFilters = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetBinlogFilters',
+        request_serializer=tabletmanagerdata__pb2.GetBinlogFiltersRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetBinlogFiltersResponse.FromString,
+        )
     self.ResetReplication = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/ResetReplication',
         request_serializer=tabletmanagerdata__pb2.ResetReplicationRequest.SerializeToString,
@@ -209,6 +544,11 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.PopulateReparentJournalRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.PopulateReparentJournalResponse.FromString,
         )
+    self.GetLastReparentJournalEntry = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetLastReparentJournalEntry',
+        request_serializer=tabletmanagerdata__pb2.GetLastReparentJournalEntryRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetLastReparentJournalEntryResponse.FromString,
+        )
     self.InitSlave = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/InitSlave',
         request_serializer=tabletmanagerdata__pb2.InitSlaveRequest.SerializeToString,
@@ -234,6 +574,11 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.SetMasterRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.SetMasterResponse.FromString,
         )
+    self.ConfigureReplication = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/ConfigureReplication',
+        request_serializer=tabletmanagerdata__pb2.ConfigureReplicationRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.ConfigureReplicationResponse.FromString,
+        )
     self.SlaveWasRestarted = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/SlaveWasRestarted',
         request_serializer=tabletmanagerdata__pb2.SlaveWasRestartedRequest.SerializeToString,
@@ -249,16 +594,91 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.PromoteSlaveRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.PromoteSlaveResponse.FromString,
         )
+    self.SetReparentEligibility = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SetReparentEligibility',
+        request_serializer=tabletmanagerdata__pb2.SetReparentEligibilityRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SetReparentEligibilityResponse.FromString,
+        )
+    self.CheckReparentCandidate = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/CheckReparentCandidate',
+        request_serializer=tabletmanagerdata__pb2.CheckReparentCandidateRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.CheckReparentCandidateResponse.FromString,
+        )
+    self.SetSemiSyncAckCount = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SetSemiSyncAckCount',
+        request_serializer=tabletmanagerdata__pb2.SetSemiSyncAckCountRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SetSemiSyncAckCountResponse.FromString,
+        )
+    self.GetDurabilityPolicy = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetDurabilityPolicy',
+        request_serializer=tabletmanagerdata__pb2.GetDurabilityPolicyRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetDurabilityPolicyResponse.FromString,
+        )
+    self.GetBackupLimits = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetBackupLimits',
+        request_serializer=tabletmanagerdata__pb2.GetBackupLimitsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetBackupLimitsResponse.FromString,
+        )
+    self.GetBackupPosition = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetBackupPosition',
+        request_serializer=tabletmanagerdata__pb2.GetBackupPositionRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetBackupPositionResponse.FromString,
+        )
     self.Backup = channel.unary_stream(
         '/tabletmanagerservice.TabletManager/Backup',
         request_serializer=tabletmanagerdata__pb2.BackupRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.BackupResponse.FromString,
         )
+    self.IncrementalBackup = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/IncrementalBackup',
+        request_serializer=tabletmanagerdata__pb2.IncrementalBackupRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.IncrementalBackupResponse.FromString,
+        )
     self.RestoreFromBackup = channel.unary_stream(
         '/tabletmanagerservice.TabletManager/RestoreFromBackup',
         request_serializer=tabletmanagerdata__pb2.RestoreFromBackupRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.RestoreFromBackupResponse.FromString,
         )
+    self.RestoreToTimestamp = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/RestoreToTimestamp',
+        request_serializer=tabletmanagerdata__pb2.RestoreToTimestampRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.RestoreToTimestampResponse.FromString,
+        )
+    self.SetPreferredBackup = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/SetPreferredBackup',
+        request_serializer=tabletmanagerdata__pb2.SetPreferredBackupRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.SetPreferredBackupResponse.FromString,
+        )
+    self.GetPreferredBackup = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetPreferredBackup',
+        request_serializer=tabletmanagerdata__pb2.GetPreferredBackupRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetPreferredBackupResponse.FromString,
+        )
+    self.CheckRestoreCompatibility = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/CheckRestoreCompatibility',
+        request_serializer=tabletmanagerdata__pb2.CheckRestoreCompatibilityRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.CheckRestoreCompatibilityResponse.FromString,
+        )
+    self.GetLastBackupInfo = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetLastBackupInfo',
+        request_serializer=tabletmanagerdata__pb2.GetLastBackupInfoRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetLastBackupInfoResponse.FromString,
+        )
+    self.GetBackupFreshness = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/GetBackupFreshness',
+        request_serializer=tabletmanagerdata__pb2.GetBackupFreshnessRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.GetBackupFreshnessResponse.FromString,
+        )
+    self.CheckBackupReadiness = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/CheckBackupReadiness',
+        request_serializer=tabletmanagerdata__pb2.CheckBackupReadinessRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.CheckBackupReadinessResponse.FromString,
+        )
+    self.TestRestore = channel.unary_stream(
+        '/tabletmanagerservice.TabletManager/TestRestore',
+        request_serializer=tabletmanagerdata__pb2.TestRestoreRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.TestRestoreResponse.FromString,
+        )
 
 
 class TabletManagerServicer(object):
@@ -289,6 +709,14 @@ class TabletManagerServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def ExecuteHookToStream(self, request, context):
+    """ExecuteHookToStream executes the hook remotely, and streams its
+    stdout as it is produced, then its exit status.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def GetSchema(self, request, context):
     """GetSchema asks the tablet for its schema
     """
@@ -296,6 +724,22 @@ class TabletManagerServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def GetCreateStatements(self, request, context):
+    """GetCreateStatements returns the raw CREATE statements of the
+    tablet's tables and views
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetSchemaTimestamps(self, request, context):
+    """GetSchemaTimestamps returns when the tablet's tables were last
+    altered
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def GetPermissions(self, request, context):
     """GetPermissions asks the tablet for its permissions
     """
@@ -303,293 +747,936 @@ class TabletManagerServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def SetReadOnly(self, request, context):
+  def GetConnectionStats(self, request, context):
+    """GetConnectionStats returns the current connection and transaction
+    counts of the tablet's query service
     """
-    Various read-write methods
-
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
 
+  def GetConnectionRejectionStats(self, request, context):
+    """GetConnectionRejectionStats returns the connection requests the
+    query service rejected since the last reset, by reason
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def SetReadWrite(self, request, context):
+  def GetConfig(self, request, context):
+    """GetConfig returns the effective values of the tablet command line flags
+    """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def ChangeType(self, request, context):
-    """ChangeType asks the remote tablet to change its type
+  def GetInFlightRPCs(self, request, context):
+    """GetInFlightRPCs returns the number of RPCs the tablet manager is
+    processing, by method
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def RefreshState(self, request, context):
+  def GetProcessStats(self, request, context):
+    """GetProcessStats returns the goroutine, thread, open file and
+    memory usage of the tablet manager process
+    """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def RunHealthCheck(self, request, context):
+  def GetHealthScore(self, request, context):
+    """GetHealthScore returns a composite health score of the tablet,
+    combining replication lag, error rate and load
+    """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def IgnoreHealthError(self, request, context):
+  def GetErrorLogTail(self, request, context):
+    """GetErrorLogTail returns the last lines of the MySQL error log
+    """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def ReloadSchema(self, request, context):
+  def CaptureDiagnostics(self, request, context):
+    """CaptureDiagnostics returns the processlist, slave status, error
+    log tail, key variables and disk stats of the tablet at once.
+    """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def PreflightSchema(self, request, context):
+  def CheckDataDir(self, request, context):
+    """CheckDataDir returns the problems with the ownership and the
+    permissions of the directories mysqld needs
+    """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def ApplySchema(self, request, context):
+  def GetThrottleState(self, request, context):
+    """GetThrottleState returns whether the heavy operations of the
+    tablet are throttled, and why
+    """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def ExecuteFetchAsDba(self, request, context):
+  def GetTabletTags(self, request, context):
+    """GetTabletTags returns the tags of the tablet record
+    """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def ExecuteFetchAsAllPrivs(self, request, context):
+  def SetReadOnly(self, request, context):
+    """
+    Various read-write methods
+
+
+    """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def ExecuteFetchAsApp(self, request, context):
+  def SetReadWrite(self, request, context):
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def SlaveStatus(self, request, context):
+  def SetReadOnlyWithTTL(self, request, context):
+    """SetReadOnlyWithTTL makes the tablet read-only for a while, after
+    which it goes back to read-write on its own
     """
-    Replication related methods
-
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
 
-    SlaveStatus returns the current slave status.
+  def SetSuperReadOnly(self, request, context):
+    """SetSuperReadOnly sets or clears super_read_only, which also
+    prevents SUPER users from writing
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def MasterPosition(self, request, context):
-    """MasterPosition returns the current master position
+  def SetQueryServerConfig(self, request, context):
+    """SetQueryServerConfig changes the pool sizes, query timeout and
+    consolidation of the query server without a restart.
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def StopSlave(self, request, context):
-    """StopSlave makes mysql stop its replication
+  def GetQueryBlacklist(self, request, context):
+    """GetQueryBlacklist returns the query patterns the tablet rejects
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def StopSlaveMinimum(self, request, context):
-    """StopSlaveMinimum stops the mysql replication after it reaches
-    the provided minimum point
+  def SetQueryBlacklist(self, request, context):
+    """SetQueryBlacklist replaces the query patterns the tablet rejects
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def StartSlave(self, request, context):
-    """StartSlave starts the mysql replication
+  def ChangeType(self, request, context):
+    """ChangeType asks the remote tablet to change its type
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def TabletExternallyReparented(self, request, context):
-    """TabletExternallyReparented tells a tablet that its underlying MySQL is
-    currently the master. It is only used in environments (tabletmanagerdata.such as Vitess+MoB)
-    in which MySQL is reparented by some agent external to Vitess, and then
-    that agent simply notifies Vitess.
+  def SetTabletTags(self, request, context):
+    """SetTabletTags merges tags into the tags of the tablet record
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
 
-    This call is idempotent with respect to a single target tablet.
-    However, the tablet assumes there is a cooling-off period following the
-    initial external reparent from A to B, before this call is repeated on any
-    tablet other than B. This assumption is configurable with the vttablet flag
-    "finalize_external_reparent_timeout".
+  def RefreshState(self, request, context):
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
 
-    For more information, see the design doc at go/vt-fast-failover.
+  def RepublishTopoRecord(self, request, context):
+    """RepublishTopoRecord rewrites the tablet record in the topology
+    from the tablet's in-memory copy.
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def TabletExternallyElected(self, request, context):
-    """TabletExternallyElected is an notification that may be sent in
-    anticipation of potentially later sending TabletExternallyReparented.
-    The tablet can use this extra lead time to prepare to react quickly if
-    TabletExternallyReparented does follow.
+  def RunHealthCheck(self, request, context):
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
 
-    This call is effectively a no-op if it is not followed by a call to
-    TabletExternallyReparented, so the external agent doing the reparent can
-    still change its mind.
+  def IgnoreHealthError(self, request, context):
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
 
-    The agent does not need to wait for this call or cancel it before calling
-    TabletExternallyReparented if the external reparent operation finishes
-    before TabletExternallyElected returns.
+  def SetMaintenanceMode(self, request, context):
+    """SetMaintenanceMode sets or clears a maintenance marker, that
+    survives tablet restarts
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def GetSlaves(self, request, context):
-    """GetSlaves asks for the list of mysql slaves
+  def PauseHealthReporting(self, request, context):
+    """PauseHealthReporting pauses or resumes the publication of the
+    tablet health, which resumes on its own after a maximum duration
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def WaitBlpPosition(self, request, context):
-    """WaitBlpPosition tells the remote tablet to wait until it reaches
-    the specified binolg player position
+  def SetThrottle(self, request, context):
+    """SetThrottle forces the heavy operations of the tablet to be
+    throttled or not, for a while, or goes back to throttling them
+    on replication lag
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def StopBlp(self, request, context):
-    """StopBlp asks the tablet to stop all its binlog players,
-    and returns the current position for all of them
+  def PrepareCutover(self, request, context):
+    """PrepareCutover makes the tablet read-only, as the first phase of
+    a cutover. The cutover aborts on its own if neither committed nor
+    aborted in time.
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def StartBlp(self, request, context):
-    """StartBlp asks the tablet to restart its binlog players
+  def CommitCutover(self, request, context):
+    """CommitCutover completes a prepared cutover, applying the serving
+    state saved in the topology
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def RunBlpUntil(self, request, context):
-    """RunBlpUntil asks the tablet to restart its binlog players
+  def AbortCutover(self, request, context):
+    """AbortCutover cancels a prepared cutover, restoring the read-only
+    state the tablet had before
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def ResetReplication(self, request, context):
+  def SetServingKeyRange(self, request, context):
+    """SetServingKeyRange atomically replaces the keyrange the tablet
+    serves, and the tablet types it serves it for
     """
-    Reparenting related functions
-
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
 
-    ResetReplication makes the target not replicating
+  def RestartMysql(self, request, context):
+    """RestartMysql drains the tablet, restarts mysqld, and waits for it
+    to be healthy before serving again. It streams its progress.
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def InitMaster(self, request, context):
-    """InitMaster initializes the tablet as a master
+  def Decommission(self, request, context):
+    """Decommission drains a non-master tablet, changes its type to
+    SPARE, and optionally stops replication and mysqld. It streams
+    its progress.
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def PopulateReparentJournal(self, request, context):
-    """PopulateReparentJournal tells the tablet to add an entry to its
-    reparent journal
+  def CheckTopoConnectivity(self, request, context):
+    """CheckTopoConnectivity returns whether the tablet can read from
+    the topo server, and how long it took.
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def InitSlave(self, request, context):
-    """InitSlave tells the tablet to reparent to the master unconditionnally
+  def WarmUp(self, request, context):
+    """WarmUp fills the caches of the tablet before it serves, and
+    streams its progress.
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def DemoteMaster(self, request, context):
-    """DemoteMaster tells the soon-to-be-former master it's gonna change
-    """
+  def ReloadSchema(self, request, context):
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def PromoteSlaveWhenCaughtUp(self, request, context):
-    """PromoteSlaveWhenCaughtUp tells the remote tablet to catch up,
-    and then be the master
-    """
+  def PreflightSchema(self, request, context):
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def SlaveWasPromoted(self, request, context):
-    """SlaveWasPromoted tells the remote tablet it is now the master
-    """
+  def ApplySchema(self, request, context):
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def SetMaster(self, request, context):
-    """SetMaster tells the slave to reparent
+  def SchemaDiff(self, request, context):
+    """SchemaDiff returns the DDL statements that would turn the schema
+    of the tablet into the provided one.
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def SlaveWasRestarted(self, request, context):
-    """SlaveWasRestarted tells the remote tablet its master has changed
+  def AssessSchemaChange(self, request, context):
+    """AssessSchemaChange estimates how disruptive an ALTER TABLE would
+    be on the live table, without running it.
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def StopReplicationAndGetStatus(self, request, context):
-    """StopReplicationAndGetStatus stops MySQL replication, and returns the
-    replication status
+  def WatchSchema(self, request, context):
+    """WatchSchema streams the changes to the schema of the tablet,
+    as its query service sees them.
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def PromoteSlave(self, request, context):
-    """PromoteSlave makes the slave the new master
+  def GetVSchema(self, request, context):
+    """GetVSchema returns the VSchema of the tablet keyspace.
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def Backup(self, request, context):
+  def ApplyVSchema(self, request, context):
+    """ApplyVSchema saves the VSchema of the tablet keyspace and
+    rebuilds the SrvVSchema of the tablet cell, for local testing.
     """
-    Backup related methods
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
 
+  def ExecuteFetchAsDba(self, request, context):
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
 
+  def ExecuteFetchAsDbaCSV(self, request, context):
+    """ExecuteFetchAsDbaCSV streams the result of a query run with the
+    DBA user, encoded as CSV.
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def RestoreFromBackup(self, request, context):
-    """RestoreFromBackup deletes all local data and restores it from the latest backup.
+  def ExecuteFetchColumnar(self, request, context):
+    """ExecuteFetchColumnar runs a query with the DBA user, and returns
+    the result in columnar form
     """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def ExecuteFetchAsAllPrivs(self, request, context):
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
 
-def add_TabletManagerServicer_to_server(servicer, server):
-  rpc_method_handlers = {
-      'Ping': grpc.unary_unary_rpc_method_handler(
-          servicer.Ping,
-          request_deserializer=tabletmanagerdata__pb2.PingRequest.FromString,
+  def ExecuteFetchAsApp(self, request, context):
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ExplainQuery(self, request, context):
+    """ExplainQuery returns the MySQL execution plan of a query,
+    without running it
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ApplyGrants(self, request, context):
+    """ApplyGrants runs a batch of GRANT and REVOKE statements,
+    optionally restoring the previous grants if one fails
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ChecksumTable(self, request, context):
+    """ChecksumTable returns an order independent checksum of the rows
+    of a table, optionally restricted to a key range
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def TruncateTable(self, request, context):
+    """TruncateTable truncates a table, if the caller confirmed the
+    keyspace of the tablet
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def RenameTable(self, request, context):
+    """RenameTable renames a table, unless views or foreign keys depend
+    on it and the caller did not ask to cascade
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def StreamRowsInKeyRange(self, request, context):
+    """StreamRowsInKeyRange streams the rows of a table in primary key
+    order, optionally restricted to a key range
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def StreamKeyRangeBinlog(self, request, context):
+    """StreamKeyRangeBinlog streams the binlog transactions touching
+    rows in a key range, from a replication position
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def CloneStream(self, request, context):
+    """CloneStream streams the replication position of a consistent
+    snapshot, then the rows of the tables in that snapshot
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetCharsetConfig(self, request, context):
+    """GetCharsetConfig returns the server and database default
+    character sets and collations.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetProcessList(self, request, context):
+    """GetProcessList returns the threads currently running in MySQL.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def KillProcess(self, request, context):
+    """KillProcess kills a MySQL connection by ID. It refuses to kill
+    system and replication threads.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def TailGeneralLog(self, request, context):
+    """TailGeneralLog enables the MySQL general log for a while, and
+    streams its entries. The log is disabled again when the stream ends.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def StreamQPS(self, request, context):
+    """StreamQPS streams the read, write and total query rates of the
+    tablet. Samples are dropped, not queued, if the client is slow.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SlaveStatus(self, request, context):
+    """
+    Replication related methods
+
+
+    SlaveStatus returns the current slave status.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SlaveStatusAllChannels(self, request, context):
+    """SlaveStatusAllChannels returns the slave status of each
+    replication channel, for multi-source replication.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetReplicationSource(self, request, context):
+    """GetReplicationSource returns the master host, port and
+    replication user of the slave, without the password.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ValidateReplicationCredentials(self, request, context):
+    """ValidateReplicationCredentials checks the slave can still log in
+    to its master with its replication credentials.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetApplierQueueStats(self, request, context):
+    """GetApplierQueueStats returns the relay log backlog and the state
+    of the replication applier threads
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def MasterPosition(self, request, context):
+    """MasterPosition returns the current master position
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetGtidPurged(self, request, context):
+    """GetGtidPurged returns the set of transactions purged from the
+    binary logs, that can't be replicated from this tablet anymore
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetBinlogStats(self, request, context):
+    """GetBinlogStats returns the recent rate of transactions written
+    to (master) or applied from (slave) the binary logs
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetReplicationErrorStats(self, request, context):
+    """GetReplicationErrorStats returns the number of replication errors
+    and reconnects since the counts were last reset
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def StopSlave(self, request, context):
+    """StopSlave makes mysql stop its replication
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def StopSlaveMinimum(self, request, context):
+    """StopSlaveMinimum stops the mysql replication after it reaches
+    the provided minimum point
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def StopSlaveMinimumStream(self, request, context):
+    """StopSlaveMinimumStream is like StopSlaveMinimum, but it streams the
+    slave position while waiting
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def BoostReplicationCatchup(self, request, context):
+    """BoostReplicationCatchup raises the MySQL settings limiting how fast
+    the slave applies its relay logs for a while, and streams the
+    replication lag
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def StartSlave(self, request, context):
+    """StartSlave starts the mysql replication
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def EnableParallelReplication(self, request, context):
+    """EnableParallelReplication restarts the SQL thread with the given
+    parallel replication settings, and rolls back if it does not resume.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def RotateReplicationCredentials(self, request, context):
+    """RotateReplicationCredentials changes the credentials the slave
+    connects to its master with, and rolls back if the IO thread
+    does not resume with them.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ConfigureReplicationSSL(self, request, context):
+    """ConfigureReplicationSSL makes the slave connect to its master
+    with SSL, and rolls back if the IO thread does not resume with it.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def RepairRelayLog(self, request, context):
+    """RepairRelayLog discards the relay logs of the slave, and fetches
+    them again from the master, from the last applied position
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def TabletExternallyReparented(self, request, context):
+    """TabletExternallyReparented tells a tablet that its underlying MySQL is
+    currently the master. It is only used in environments (tabletmanagerdata.such as Vitess+MoB)
+    in which MySQL is reparented by some agent external to Vitess, and then
+    that agent simply notifies Vitess.
+
+    This call is idempotent with respect to a single target tablet.
+    However, the tablet assumes there is a cooling-off period following the
+    initial external reparent from A to B, before this call is repeated on any
+    tablet other than B. This assumption is configurable with the vttablet flag
+    "finalize_external_reparent_timeout".
+
+    For more information, see the design doc at go/vt-fast-failover.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def TabletExternallyElected(self, request, context):
+    """TabletExternallyElected is an notification that may be sent in
+    anticipation of potentially later sending TabletExternallyReparented.
+    The tablet can use this extra lead time to prepare to react quickly if
+    TabletExternallyReparented does follow.
+
+    This call is effectively a no-op if it is not followed by a call to
+    TabletExternallyReparented, so the external agent doing the reparent can
+    still change its mind.
+
+    The agent does not need to wait for this call or cancel it before calling
+    TabletExternallyReparented if the external reparent operation finishes
+    before TabletExternallyElected returns.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetSlaves(self, request, context):
+    """GetSlaves asks for the list of mysql slaves
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetReplicationGraph(self, request, context):
+    """GetReplicationGraph returns the master and the slaves of the
+    tablet, as its mysql sees them
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def WaitBlpPosition(self, request, context):
+    """WaitBlpPosition tells the remote tablet to wait until it reaches
+    the specified binolg player position
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def StopBlp(self, request, context):
+    """StopBlp asks the tablet to stop all its binlog players,
+    and returns the current position for all of them
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def StartBlp(self, request, context):
+    """StartBlp asks the tablet to restart its binlog players
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def RunBlpUntil(self, request, context):
+    """RunBlpUntil asks the tablet to restart its binlog players
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetBinlogFilters(self, request, context):
+    """GetBinlogFilters returns the filters the binlog players of the
+    tablet apply.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ResetReplication(self, request, context):
+    """
+    Reparenting related functions
+
+
+    ResetReplication makes the target not replicating
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def InitMaster(self, request, context):
+    """InitMaster initializes the tablet as a master
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def PopulateReparentJournal(self, request, context):
+    """PopulateReparentJournal tells the tablet to add an entry to its
+    reparent journal
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetLastReparentJournalEntry(self, request, context):
+    """GetLastReparentJournalEntry returns the most recent entry of the
+    tablet reparent journal
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def InitSlave(self, request, context):
+    """InitSlave tells the tablet to reparent to the master unconditionnally
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def DemoteMaster(self, request, context):
+    """DemoteMaster tells the soon-to-be-former master it's gonna change
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def PromoteSlaveWhenCaughtUp(self, request, context):
+    """PromoteSlaveWhenCaughtUp tells the remote tablet to catch up,
+    and then be the master
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SlaveWasPromoted(self, request, context):
+    """SlaveWasPromoted tells the remote tablet it is now the master
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SetMaster(self, request, context):
+    """SetMaster tells the slave to reparent
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ConfigureReplication(self, request, context):
+    """ConfigureReplication tells the slave to reparent, either with
+    GTID auto-position or from an explicit binlog file and position
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SlaveWasRestarted(self, request, context):
+    """SlaveWasRestarted tells the remote tablet its master has changed
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def StopReplicationAndGetStatus(self, request, context):
+    """StopReplicationAndGetStatus stops MySQL replication, and returns the
+    replication status
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def PromoteSlave(self, request, context):
+    """PromoteSlave makes the slave the new master
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SetReparentEligibility(self, request, context):
+    """SetReparentEligibility marks the tablet as eligible or not to be
+    chosen as the new master by reparent tools
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def CheckReparentCandidate(self, request, context):
+    """CheckReparentCandidate returns whether the tablet can be chosen
+    as the new master by reparent tools
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SetSemiSyncAckCount(self, request, context):
+    """SetSemiSyncAckCount sets the number of semi-sync slaves the master
+    waits for, if that many are connected
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetDurabilityPolicy(self, request, context):
+    """GetDurabilityPolicy returns the semi-sync policy the tablet
+    enforces
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetBackupLimits(self, request, context):
+    """
+    Backup related methods
+
+
+    GetBackupLimits returns the default and maximum backup concurrency
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetBackupPosition(self, request, context):
+    """GetBackupPosition returns the replication position a backup
+    taken now would capture, without side effects
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Backup(self, request, context):
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def IncrementalBackup(self, request, context):
+    """IncrementalBackup backs up the binlogs since the position of a
+    prior backup
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def RestoreFromBackup(self, request, context):
+    """RestoreFromBackup deletes all local data and restores it from the latest backup.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def RestoreToTimestamp(self, request, context):
+    """RestoreToTimestamp deletes all local data, restores it from the
+    named backup, and applies the master binlogs up to a target time.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SetPreferredBackup(self, request, context):
+    """SetPreferredBackup records the backup new tablets of the shard
+    restore from, instead of the latest one.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetPreferredBackup(self, request, context):
+    """GetPreferredBackup returns the preferred backup of the shard.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def CheckRestoreCompatibility(self, request, context):
+    """CheckRestoreCompatibility compares the mysqld settings recorded
+    in a backup of the shard with the tablet's, without restoring it.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetLastBackupInfo(self, request, context):
+    """GetLastBackupInfo returns the size, duration and outcome of the
+    last backup taken by the tablet
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetBackupFreshness(self, request, context):
+    """GetBackupFreshness returns the most recent complete backup of the
+    shard of the tablet, and how long ago it was taken
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def CheckBackupReadiness(self, request, context):
+    """CheckBackupReadiness checks the tablet is not running DDL nor
+    restoring, and replicates and serves fine, so it can take a
+    consistent backup
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def TestRestore(self, request, context):
+    """TestRestore restores a backup of the shard into a scratch mysqld
+    on the tablet host and checks it, without touching the tablet.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+
+def add_TabletManagerServicer_to_server(servicer, server):
+  rpc_method_handlers = {
+      'Ping': grpc.unary_unary_rpc_method_handler(
+          servicer.Ping,
+          request_deserializer=tabletmanagerdata__pb2.PingRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.PingResponse.SerializeToString,
       ),
       'Sleep': grpc.unary_unary_rpc_method_handler(
@@ -602,16 +1689,86 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.ExecuteHookRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.ExecuteHookResponse.SerializeToString,
       ),
+      'ExecuteHookToStream': grpc.unary_stream_rpc_method_handler(
+          servicer.ExecuteHookToStream,
+          request_deserializer=tabletmanagerdata__pb2.ExecuteHookToStreamRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.ExecuteHookToStreamResponse.SerializeToString,
+      ),
       'GetSchema': grpc.unary_unary_rpc_method_handler(
           servicer.GetSchema,
           request_deserializer=tabletmanagerdata__pb2.GetSchemaRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.GetSchemaResponse.SerializeToString,
       ),
+      'GetCreateStatements': grpc.unary_unary_rpc_method_handler(
+          servicer.GetCreateStatements,
+          request_deserializer=tabletmanagerdata__pb2.GetCreateStatementsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetCreateStatementsResponse.SerializeToString,
+      ),
+      'GetSchemaTimestamps': grpc.unary_unary_rpc_method_handler(
+          servicer.GetSchemaTimestamps,
+          request_deserializer=tabletmanagerdata__pb2.GetSchemaTimestampsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetSchemaTimestampsResponse.SerializeToString,
+      ),
       'GetPermissions': grpc.unary_unary_rpc_method_handler(
           servicer.GetPermissions,
           request_deserializer=tabletmanagerdata__pb2.GetPermissionsRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.GetPermissionsResponse.SerializeToString,
       ),
+      'GetConnectionStats': grpc.unary_unary_rpc_method_handler(
+          servicer.GetConnectionStats,
+          request_deserializer=tabletmanagerdata__pb2.GetConnectionStatsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetConnectionStatsResponse.SerializeToString,
+      ),
+      'GetConnectionRejectionStats': grpc.unary_unary_rpc_method_handler(
+          servicer.GetConnectionRejectionStats,
+          request_deserializer=tabletmanagerdata__pb2.GetConnectionRejectionStatsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetConnectionRejectionStatsResponse.SerializeToString,
+      ),
+      'GetConfig': grpc.unary_unary_rpc_method_handler(
+          servicer.GetConfig,
+          request_deserializer=tabletmanagerdata__pb2.GetConfigRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetConfigResponse.SerializeToString,
+      ),
+      'GetInFlightRPCs': grpc.unary_unary_rpc_method_handler(
+          servicer.GetInFlightRPCs,
+          request_deserializer=tabletmanagerdata__pb2.GetInFlightRPCsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetInFlightRPCsResponse.SerializeToString,
+      ),
+      'GetProcessStats': grpc.unary_unary_rpc_method_handler(
+          servicer.GetProcessStats,
+          request_deserializer=tabletmanagerdata__pb2.GetProcessStatsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetProcessStatsResponse.SerializeToString,
+      ),
+      'GetHealthScore': grpc.unary_unary_rpc_method_handler(
+          servicer.GetHealthScore,
+          request_deserializer=tabletmanagerdata__pb2.GetHealthScoreRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetHealthScoreResponse.SerializeToString,
+      ),
+      'GetErrorLogTail': grpc.unary_unary_rpc_method_handler(
+          servicer.GetErrorLogTail,
+          request_deserializer=tabletmanagerdata__pb2.GetErrorLogTailRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetErrorLogTailResponse.SerializeToString,
+      ),
+      'CaptureDiagnostics': grpc.unary_unary_rpc_method_handler(
+          servicer.CaptureDiagnostics,
+          request_deserializer=tabletmanagerdata__pb2.CaptureDiagnosticsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.CaptureDiagnosticsResponse.SerializeToString,
+      ),
+      'CheckDataDir': grpc.unary_unary_rpc_method_handler(
+          servicer.CheckDataDir,
+          request_deserializer=tabletmanagerdata__pb2.CheckDataDirRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.CheckDataDirResponse.SerializeToString,
+      ),
+      'GetThrottleState': grpc.unary_unary_rpc_method_handler(
+          servicer.GetThrottleState,
+          request_deserializer=tabletmanagerdata__pb2.GetThrottleStateRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetThrottleStateResponse.SerializeToString,
+      ),
+      'GetTabletTags': grpc.unary_unary_rpc_method_handler(
+          servicer.GetTabletTags,
+          request_deserializer=tabletmanagerdata__pb2.GetTabletTagsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetTabletTagsResponse.SerializeToString,
+      ),
       'SetReadOnly': grpc.unary_unary_rpc_method_handler(
           servicer.SetReadOnly,
           request_deserializer=tabletmanagerdata__pb2.SetReadOnlyRequest.FromString,
@@ -622,16 +1779,51 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.SetReadWriteRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.SetReadWriteResponse.SerializeToString,
       ),
+      'SetReadOnlyWithTTL': grpc.unary_unary_rpc_method_handler(
+          servicer.SetReadOnlyWithTTL,
+          request_deserializer=tabletmanagerdata__pb2.SetReadOnlyWithTTLRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SetReadOnlyWithTTLResponse.SerializeToString,
+      ),
+      'SetSuperReadOnly': grpc.unary_unary_rpc_method_handler(
+          servicer.SetSuperReadOnly,
+          request_deserializer=tabletmanagerdata__pb2.SetSuperReadOnlyRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SetSuperReadOnlyResponse.SerializeToString,
+      ),
+      'SetQueryServerConfig': grpc.unary_unary_rpc_method_handler(
+          servicer.SetQueryServerConfig,
+          request_deserializer=tabletmanagerdata__pb2.SetQueryServerConfigRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SetQueryServerConfigResponse.SerializeToString,
+      ),
+      'GetQueryBlacklist': grpc.unary_unary_rpc_method_handler(
+          servicer.GetQueryBlacklist,
+          request_deserializer=tabletmanagerdata__pb2.GetQueryBlacklistRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetQueryBlacklistResponse.SerializeToString,
+      ),
+      'SetQueryBlacklist': grpc.unary_unary_rpc_method_handler(
+          servicer.SetQueryBlacklist,
+          request_deserializer=tabletmanagerdata__pb2.SetQueryBlacklistRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SetQueryBlacklistResponse.SerializeToString,
+      ),
       'ChangeType': grpc.unary_unary_rpc_method_handler(
           servicer.ChangeType,
           request_deserializer=tabletmanagerdata__pb2.ChangeTypeRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.ChangeTypeResponse.SerializeToString,
       ),
+      'SetTabletTags': grpc.unary_unary_rpc_method_handler(
+          servicer.SetTabletTags,
+          request_deserializer=tabletmanagerdata__pb2.SetTabletTagsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SetTabletTagsResponse.SerializeToString,
+      ),
       'RefreshState': grpc.unary_unary_rpc_method_handler(
           servicer.RefreshState,
           request_deserializer=tabletmanagerdata__pb2.RefreshStateRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.RefreshStateResponse.SerializeToString,
       ),
+      'RepublishTopoRecord': grpc.unary_unary_rpc_method_handler(
+          servicer.RepublishTopoRecord,
+          request_deserializer=tabletmanagerdata__pb2.RepublishTopoRecordRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.RepublishTopoRecordResponse.SerializeToString,
+      ),
       'RunHealthCheck': grpc.unary_unary_rpc_method_handler(
           servicer.RunHealthCheck,
           request_deserializer=tabletmanagerdata__pb2.RunHealthCheckRequest.FromString,
@@ -642,6 +1834,61 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.IgnoreHealthErrorRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.IgnoreHealthErrorResponse.SerializeToString,
       ),
+      'SetMaintenanceMode': grpc.unary_unary_rpc_method_handler(
+          servicer.SetMaintenanceMode,
+          request_deserializer=tabletmanagerdata__pb2.SetMaintenanceModeRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SetMaintenanceModeResponse.SerializeToString,
+      ),
+      'PauseHealthReporting': grpc.unary_unary_rpc_method_handler(
+          servicer.PauseHealthReporting,
+          request_deserializer=tabletmanagerdata__pb2.PauseHealthReportingRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.PauseHealthReportingResponse.SerializeToString,
+      ),
+      'SetThrottle': grpc.unary_unary_rpc_method_handler(
+          servicer.SetThrottle,
+          request_deserializer=tabletmanagerdata__pb2.SetThrottleRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SetThrottleResponse.SerializeToString,
+      ),
+      'PrepareCutover': grpc.unary_unary_rpc_method_handler(
+          servicer.PrepareCutover,
+          request_deserializer=tabletmanagerdata__pb2.PrepareCutoverRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.PrepareCutoverResponse.SerializeToString,
+      ),
+      'CommitCutover': grpc.unary_unary_rpc_method_handler(
+          servicer.CommitCutover,
+          request_deserializer=tabletmanagerdata__pb2.CommitCutoverRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.CommitCutoverResponse.SerializeToString,
+      ),
+      'AbortCutover': grpc.unary_unary_rpc_method_handler(
+          servicer.AbortCutover,
+          request_deserializer=tabletmanagerdata__pb2.AbortCutoverRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.AbortCutoverResponse.SerializeToString,
+      ),
+      'SetServingKeyRange': grpc.unary_unary_rpc_method_handler(
+          servicer.SetServingKeyRange,
+          request_deserializer=tabletmanagerdata__pb2.SetServingKeyRangeRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SetServingKeyRangeResponse.SerializeToString,
+      ),
+      'RestartMysql': grpc.unary_stream_rpc_method_handler(
+          servicer.RestartMysql,
+          request_deserializer=tabletmanagerdata__pb2.RestartMysqlRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.RestartMysqlResponse.SerializeToString,
+      ),
+      'Decommission': grpc.unary_stream_rpc_method_handler(
+          servicer.Decommission,
+          request_deserializer=tabletmanagerdata__pb2.DecommissionRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.DecommissionResponse.SerializeToString,
+      ),
+      'CheckTopoConnectivity': grpc.unary_unary_rpc_method_handler(
+          servicer.CheckTopoConnectivity,
+          request_deserializer=tabletmanagerdata__pb2.CheckTopoConnectivityRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.CheckTopoConnectivityResponse.SerializeToString,
+      ),
+      'WarmUp': grpc.unary_stream_rpc_method_handler(
+          servicer.WarmUp,
+          request_deserializer=tabletmanagerdata__pb2.WarmUpRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.WarmUpResponse.SerializeToString,
+      ),
       'ReloadSchema': grpc.unary_unary_rpc_method_handler(
           servicer.ReloadSchema,
           request_deserializer=tabletmanagerdata__pb2.ReloadSchemaRequest.FromString,
@@ -657,11 +1904,46 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.ApplySchemaRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.ApplySchemaResponse.SerializeToString,
       ),
+      'SchemaDiff': grpc.unary_unary_rpc_method_handler(
+          servicer.SchemaDiff,
+          request_deserializer=tabletmanagerdata__pb2.SchemaDiffRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SchemaDiffResponse.SerializeToString,
+      ),
+      'AssessSchemaChange': grpc.unary_unary_rpc_method_handler(
+          servicer.AssessSchemaChange,
+          request_deserializer=tabletmanagerdata__pb2.AssessSchemaChangeRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.AssessSchemaChangeResponse.SerializeToString,
+      ),
+      'WatchSchema': grpc.unary_stream_rpc_method_handler(
+          servicer.WatchSchema,
+          request_deserializer=tabletmanagerdata__pb2.WatchSchemaRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.WatchSchemaResponse.SerializeToString,
+      ),
+      'GetVSchema': grpc.unary_unary_rpc_method_handler(
+          servicer.GetVSchema,
+          request_deserializer=tabletmanagerdata__pb2.GetVSchemaRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetVSchemaResponse.SerializeToString,
+      ),
+      'ApplyVSchema': grpc.unary_unary_rpc_method_handler(
+          servicer.ApplyVSchema,
+          request_deserializer=tabletmanagerdata__pb2.ApplyVSchemaRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.ApplyVSchemaResponse.SerializeToString,
+      ),
       'ExecuteFetchAsDba': grpc.unary_unary_rpc_method_handler(
           servicer.ExecuteFetchAsDba,
           request_deserializer=tabletmanagerdata__pb2.ExecuteFetchAsDbaRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.ExecuteFetchAsDbaResponse.SerializeToString,
       ),
+      'ExecuteFetchAsDbaCSV': grpc.unary_stream_rpc_method_handler(
+          servicer.ExecuteFetchAsDbaCSV,
+          request_deserializer=tabletmanagerdata__pb2.ExecuteFetchAsDbaCSVRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.ExecuteFetchAsDbaCSVResponse.SerializeToString,
+      ),
+      'ExecuteFetchColumnar': grpc.unary_unary_rpc_method_handler(
+          servicer.ExecuteFetchColumnar,
+          request_deserializer=tabletmanagerdata__pb2.ExecuteFetchColumnarRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.ExecuteFetchColumnarResponse.SerializeToString,
+      ),
       'ExecuteFetchAsAllPrivs': grpc.unary_unary_rpc_method_handler(
           servicer.ExecuteFetchAsAllPrivs,
           request_deserializer=tabletmanagerdata__pb2.ExecuteFetchAsAllPrivsRequest.FromString,
@@ -672,16 +1954,116 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.ExecuteFetchAsAppRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.ExecuteFetchAsAppResponse.SerializeToString,
       ),
+      'ExplainQuery': grpc.unary_unary_rpc_method_handler(
+          servicer.ExplainQuery,
+          request_deserializer=tabletmanagerdata__pb2.ExplainQueryRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.ExplainQueryResponse.SerializeToString,
+      ),
+      'ApplyGrants': grpc.unary_unary_rpc_method_handler(
+          servicer.ApplyGrants,
+          request_deserializer=tabletmanagerdata__pb2.ApplyGrantsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.ApplyGrantsResponse.SerializeToString,
+      ),
+      'ChecksumTable': grpc.unary_unary_rpc_method_handler(
+          servicer.ChecksumTable,
+          request_deserializer=tabletmanagerdata__pb2.ChecksumTableRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.ChecksumTableResponse.SerializeToString,
+      ),
+      'TruncateTable': grpc.unary_unary_rpc_method_handler(
+          servicer.TruncateTable,
+          request_deserializer=tabletmanagerdata__pb2.TruncateTableRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.TruncateTableResponse.SerializeToString,
+      ),
+      'RenameTable': grpc.unary_unary_rpc_method_handler(
+          servicer.RenameTable,
+          request_deserializer=tabletmanagerdata__pb2.RenameTableRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.RenameTableResponse.SerializeToString,
+      ),
+      'StreamRowsInKeyRange': grpc.unary_stream_rpc_method_handler(
+          servicer.StreamRowsInKeyRange,
+          request_deserializer=tabletmanagerdata__pb2.StreamRowsInKeyRangeRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.StreamRowsInKeyRangeResponse.SerializeToString,
+      ),
+      'StreamKeyRangeBinlog': grpc.unary_stream_rpc_method_handler(
+          servicer.StreamKeyRangeBinlog,
+          request_deserializer=tabletmanagerdata__pb2.StreamKeyRangeBinlogRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.StreamKeyRangeBinlogResponse.SerializeToString,
+      ),
+      'CloneStream': grpc.unary_stream_rpc_method_handler(
+          servicer.CloneStream,
+          request_deserializer=tabletmanagerdata__pb2.CloneStreamRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.CloneStreamResponse.SerializeToString,
+      ),
+      'GetCharsetConfig': grpc.unary_unary_rpc_method_handler(
+          servicer.GetCharsetConfig,
+          request_deserializer=tabletmanagerdata__pb2.GetCharsetConfigRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetCharsetConfigResponse.SerializeToString,
+      ),
+      'GetProcessList': grpc.unary_unary_rpc_method_handler(
+          servicer.GetProcessList,
+          request_deserializer=tabletmanagerdata__pb2.GetProcessListRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetProcessListResponse.SerializeToString,
+      ),
+      'KillProcess': grpc.unary_unary_rpc_method_handler(
+          servicer.KillProcess,
+          request_deserializer=tabletmanagerdata__pb2.KillProcessRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.KillProcessResponse.SerializeToString,
+      ),
+      'TailGeneralLog': grpc.unary_stream_rpc_method_handler(
+          servicer.TailGeneralLog,
+          request_deserializer=tabletmanagerdata__pb2.TailGeneralLogRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.TailGeneralLogResponse.SerializeToString,
+      ),
+      'StreamQPS': grpc.unary_stream_rpc_method_handler(
+          servicer.StreamQPS,
+          request_deserializer=tabletmanagerdata__pb2.StreamQPSRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.StreamQPSResponse.SerializeToString,
+      ),
       'SlaveStatus': grpc.unary_unary_rpc_method_handler(
           servicer.SlaveStatus,
           request_deserializer=tabletmanagerdata__pb2.SlaveStatusRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.SlaveStatusResponse.SerializeToString,
       ),
+      'SlaveStatusAllChannels': grpc.unary_unary_rpc_method_handler(
+          servicer.SlaveStatusAllChannels,
+          request_deserializer=tabletmanagerdata__pb2.SlaveStatusAllChannelsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SlaveStatusAllChannelsResponse.SerializeToString,
+      ),
+      'GetReplicationSource': grpc.unary_unary_rpc_method_handler(
+          servicer.GetReplicationSource,
+          request_deserializer=tabletmanagerdata__pb2.GetReplicationSourceRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetReplicationSourceResponse.SerializeToString,
+      ),
+      'ValidateReplicationCredentials': grpc.unary_unary_rpc_method_handler(
+          servicer.ValidateReplicationCredentials,
+          request_deserializer=tabletmanagerdata__pb2.ValidateReplicationCredentialsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.ValidateReplicationCredentialsResponse.SerializeToString,
+      ),
+      'GetApplierQueueStats': grpc.unary_unary_rpc_method_handler(
+          servicer.GetApplierQueueStats,
+          request_deserializer=tabletmanagerdata__pb2.GetApplierQueueStatsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetApplierQueueStatsResponse.SerializeToString,
+      ),
       'MasterPosition': grpc.unary_unary_rpc_method_handler(
           servicer.MasterPosition,
           request_deserializer=tabletmanagerdata__pb2.MasterPositionRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.MasterPositionResponse.SerializeToString,
       ),
+      'GetGtidPurged': grpc.unary_unary_rpc_method_handler(
+          servicer.GetGtidPurged,
+          request_deserializer=tabletmanagerdata__pb2.GetGtidPurgedRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetGtidPurgedResponse.SerializeToString,
+      ),
+      'GetBinlogStats': grpc.unary_unary_rpc_method_handler(
+          servicer.GetBinlogStats,
+          request_deserializer=tabletmanagerdata__pb2.GetBinlogStatsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetBinlogStatsResponse.SerializeToString,
+      ),
+      'GetReplicationErrorStats': grpc.unary_unary_rpc_method_handler(
+          servicer.GetReplicationErrorStats,
+          request_deserializer=tabletmanagerdata__pb2.GetReplicationErrorStatsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetReplicationErrorStatsResponse.SerializeToString,
+      ),
       'StopSlave': grpc.unary_unary_rpc_method_handler(
           servicer.StopSlave,
           request_deserializer=tabletmanagerdata__pb2.StopSlaveRequest.FromString,
@@ -692,11 +2074,41 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.StopSlaveMinimumRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.StopSlaveMinimumResponse.SerializeToString,
       ),
+      'StopSlaveMinimumStream': grpc.unary_stream_rpc_method_handler(
+          servicer.StopSlaveMinimumStream,
+          request_deserializer=tabletmanagerdata__pb2.StopSlaveMinimumStreamRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.StopSlaveMinimumStreamResponse.SerializeToString,
+      ),
+      'BoostReplicationCatchup': grpc.unary_stream_rpc_method_handler(
+          servicer.BoostReplicationCatchup,
+          request_deserializer=tabletmanagerdata__pb2.BoostReplicationCatchupRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.BoostReplicationCatchupResponse.SerializeToString,
+      ),
       'StartSlave': grpc.unary_unary_rpc_method_handler(
           servicer.StartSlave,
           request_deserializer=tabletmanagerdata__pb2.StartSlaveRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.StartSlaveResponse.SerializeToString,
       ),
+      'EnableParallelReplication': grpc.unary_unary_rpc_method_handler(
+          servicer.EnableParallelReplication,
+          request_deserializer=tabletmanagerdata__pb2.EnableParallelReplicationRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.EnableParallelReplicationResponse.SerializeToString,
+      ),
+      'RotateReplicationCredentials': grpc.unary_unary_rpc_method_handler(
+          servicer.RotateReplicationCredentials,
+          request_deserializer=tabletmanagerdata__pb2.RotateReplicationCredentialsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.RotateReplicationCredentialsResponse.SerializeToString,
+      ),
+      'ConfigureReplicationSSL': grpc.unary_unary_rpc_method_handler(
+          servicer.ConfigureReplicationSSL,
+          request_deserializer=tabletmanagerdata__pb2.ConfigureReplicationSSLRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.ConfigureReplicationSSLResponse.SerializeToString,
+      ),
+      'RepairRelayLog': grpc.unary_stream_rpc_method_handler(
+          servicer.RepairRelayLog,
+          request_deserializer=tabletmanagerdata__pb2.RepairRelayLogRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.RepairRelayLogResponse.SerializeToString,
+      ),
       'TabletExternallyReparented': grpc.unary_unary_rpc_method_handler(
           servicer.TabletExternallyReparented,
           request_deserializer=tabletmanagerdata__pb2.TabletExternallyReparentedRequest.FromString,
@@ -712,6 +2124,11 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.GetSlavesRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.GetSlavesResponse.SerializeToString,
       ),
+      'GetReplicationGraph': grpc.unary_unary_rpc_method_handler(
+          servicer.GetReplicationGraph,
+          request_deserializer=tabletmanagerdata__pb2.GetReplicationGraphRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetReplicationGraphResponse.SerializeToString,
+      ),
       'WaitBlpPosition': grpc.unary_unary_rpc_method_handler(
           servicer.WaitBlpPosition,
           request_deserializer=tabletmanagerdata__pb2.WaitBlpPositionRequest.FromString,
@@ -732,6 +2149,11 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.RunBlpUntilRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.RunBlpUntilResponse.SerializeToString,
       ),
+      'GetBinlogFilters': grpc.unary_unary_rpc_method_handler(
+          servicer.GetBinlogFilters,
+          request_deserializer=tabletmanagerdata__pb2.GetBinlogFiltersRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetBinlogFiltersResponse.SerializeToString,
+      ),
       'ResetReplication': grpc.unary_unary_rpc_method_handler(
           servicer.ResetReplication,
           request_deserializer=tabletmanagerdata__pb2.ResetReplicationRequest.FromString,
@@ -747,6 +2169,11 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.PopulateReparentJournalRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.PopulateReparentJournalResponse.SerializeToString,
       ),
+      'GetLastReparentJournalEntry': grpc.unary_unary_rpc_method_handler(
+          servicer.GetLastReparentJournalEntry,
+          request_deserializer=tabletmanagerdata__pb2.GetLastReparentJournalEntryRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetLastReparentJournalEntryResponse.SerializeToString,
+      ),
       'InitSlave': grpc.unary_unary_rpc_method_handler(
           servicer.InitSlave,
           request_deserializer=tabletmanagerdata__pb2.InitSlaveRequest.FromString,
@@ -772,6 +2199,11 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.SetMasterRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.SetMasterResponse.SerializeToString,
       ),
+      'ConfigureReplication': grpc.unary_unary_rpc_method_handler(
+          servicer.ConfigureReplication,
+          request_deserializer=tabletmanagerdata__pb2.ConfigureReplicationRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.ConfigureReplicationResponse.SerializeToString,
+      ),
       'SlaveWasRestarted': grpc.unary_unary_rpc_method_handler(
           servicer.SlaveWasRestarted,
           request_deserializer=tabletmanagerdata__pb2.SlaveWasRestartedRequest.FromString,
@@ -787,16 +2219,91 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.PromoteSlaveRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.PromoteSlaveResponse.SerializeToString,
       ),
+      'SetReparentEligibility': grpc.unary_unary_rpc_method_handler(
+          servicer.SetReparentEligibility,
+          request_deserializer=tabletmanagerdata__pb2.SetReparentEligibilityRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SetReparentEligibilityResponse.SerializeToString,
+      ),
+      'CheckReparentCandidate': grpc.unary_unary_rpc_method_handler(
+          servicer.CheckReparentCandidate,
+          request_deserializer=tabletmanagerdata__pb2.CheckReparentCandidateRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.CheckReparentCandidateResponse.SerializeToString,
+      ),
+      'SetSemiSyncAckCount': grpc.unary_unary_rpc_method_handler(
+          servicer.SetSemiSyncAckCount,
+          request_deserializer=tabletmanagerdata__pb2.SetSemiSyncAckCountRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SetSemiSyncAckCountResponse.SerializeToString,
+      ),
+      'GetDurabilityPolicy': grpc.unary_unary_rpc_method_handler(
+          servicer.GetDurabilityPolicy,
+          request_deserializer=tabletmanagerdata__pb2.GetDurabilityPolicyRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetDurabilityPolicyResponse.SerializeToString,
+      ),
+      'GetBackupLimits': grpc.unary_unary_rpc_method_handler(
+          servicer.GetBackupLimits,
+          request_deserializer=tabletmanagerdata__pb2.GetBackupLimitsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetBackupLimitsResponse.SerializeToString,
+      ),
+      'GetBackupPosition': grpc.unary_unary_rpc_method_handler(
+          servicer.GetBackupPosition,
+          request_deserializer=tabletmanagerdata__pb2.GetBackupPositionRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetBackupPositionResponse.SerializeToString,
+      ),
       'Backup': grpc.unary_stream_rpc_method_handler(
           servicer.Backup,
           request_deserializer=tabletmanagerdata__pb2.BackupRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.BackupResponse.SerializeToString,
       ),
+      'IncrementalBackup': grpc.unary_stream_rpc_method_handler(
+          servicer.IncrementalBackup,
+          request_deserializer=tabletmanagerdata__pb2.IncrementalBackupRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.IncrementalBackupResponse.SerializeToString,
+      ),
       'RestoreFromBackup': grpc.unary_stream_rpc_method_handler(
           servicer.RestoreFromBackup,
           request_deserializer=tabletmanagerdata__pb2.RestoreFromBackupRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.RestoreFromBackupResponse.SerializeToString,
       ),
+      'RestoreToTimestamp': grpc.unary_stream_rpc_method_handler(
+          servicer.RestoreToTimestamp,
+          request_deserializer=tabletmanagerdata__pb2.RestoreToTimestampRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.RestoreToTimestampResponse.SerializeToString,
+      ),
+      'SetPreferredBackup': grpc.unary_unary_rpc_method_handler(
+          servicer.SetPreferredBackup,
+          request_deserializer=tabletmanagerdata__pb2.SetPreferredBackupRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.SetPreferredBackupResponse.SerializeToString,
+      ),
+      'GetPreferredBackup': grpc.unary_unary_rpc_method_handler(
+          servicer.GetPreferredBackup,
+          request_deserializer=tabletmanagerdata__pb2.GetPreferredBackupRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetPreferredBackupResponse.SerializeToString,
+      ),
+      'CheckRestoreCompatibility': grpc.unary_unary_rpc_method_handler(
+          servicer.CheckRestoreCompatibility,
+          request_deserializer=tabletmanagerdata__pb2.CheckRestoreCompatibilityRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.CheckRestoreCompatibilityResponse.SerializeToString,
+      ),
+      'GetLastBackupInfo': grpc.unary_unary_rpc_method_handler(
+          servicer.GetLastBackupInfo,
+          request_deserializer=tabletmanagerdata__pb2.GetLastBackupInfoRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetLastBackupInfoResponse.SerializeToString,
+      ),
+      'GetBackupFreshness': grpc.unary_unary_rpc_method_handler(
+          servicer.GetBackupFreshness,
+          request_deserializer=tabletmanagerdata__pb2.GetBackupFreshnessRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.GetBackupFreshnessResponse.SerializeToString,
+      ),
+      'CheckBackupReadiness': grpc.unary_unary_rpc_method_handler(
+          servicer.CheckBackupReadiness,
+          request_deserializer=tabletmanagerdata__pb2.CheckBackupReadinessRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.CheckBackupReadinessResponse.SerializeToString,
+      ),
+      'TestRestore': grpc.unary_stream_rpc_method_handler(
+          servicer.TestRestore,
+          request_deserializer=tabletmanagerdata__pb2.TestRestoreRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.TestRestoreResponse.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'tabletmanagerservice.TabletManager', rpc_method_handlers)
@@ -821,14 +2328,81 @@ class BetaTabletManagerServicer(object):
     """ExecuteHook executes the hook remotely
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ExecuteHookToStream(self, request, context):
+    """ExecuteHookToStream executes the hook remotely, and streams its
+    stdout as it is produced, then its exit status.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def GetSchema(self, request, context):
     """GetSchema asks the tablet for its schema
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetCreateStatements(self, request, context):
+    """GetCreateStatements returns the raw CREATE statements of the
+    tablet's tables and views
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetSchemaTimestamps(self, request, context):
+    """GetSchemaTimestamps returns when the tablet's tables were last
+    altered
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def GetPermissions(self, request, context):
     """GetPermissions asks the tablet for its permissions
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetConnectionStats(self, request, context):
+    """GetConnectionStats returns the current connection and transaction
+    counts of the tablet's query service
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetConnectionRejectionStats(self, request, context):
+    """GetConnectionRejectionStats returns the connection requests the
+    query service rejected since the last reset, by reason
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetConfig(self, request, context):
+    """GetConfig returns the effective values of the tablet command line flags
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetInFlightRPCs(self, request, context):
+    """GetInFlightRPCs returns the number of RPCs the tablet manager is
+    processing, by method
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetProcessStats(self, request, context):
+    """GetProcessStats returns the goroutine, thread, open file and
+    memory usage of the tablet manager process
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetHealthScore(self, request, context):
+    """GetHealthScore returns a composite health score of the tablet,
+    combining replication lag, error rate and load
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetErrorLogTail(self, request, context):
+    """GetErrorLogTail returns the last lines of the MySQL error log
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def CaptureDiagnostics(self, request, context):
+    """CaptureDiagnostics returns the processlist, slave status, error
+    log tail, key variables and disk stats of the tablet at once.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def CheckDataDir(self, request, context):
+    """CheckDataDir returns the problems with the ownership and the
+    permissions of the directories mysqld needs
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetThrottleState(self, request, context):
+    """GetThrottleState returns whether the heavy operations of the
+    tablet are throttled, and why
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetTabletTags(self, request, context):
+    """GetTabletTags returns the tags of the tablet record
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def SetReadOnly(self, request, context):
     """
     Various read-write methods
@@ -836,29 +2410,217 @@ class BetaTabletManagerServicer(object):
 
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
-  def SetReadWrite(self, request, context):
+  def SetReadWrite(self, request, context):
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def SetReadOnlyWithTTL(self, request, context):
+    """SetReadOnlyWithTTL makes the tablet read-only for a while, after
+    which it goes back to read-write on its own
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def SetSuperReadOnly(self, request, context):
+    """SetSuperReadOnly sets or clears super_read_only, which also
+    prevents SUPER users from writing
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def SetQueryServerConfig(self, request, context):
+    """SetQueryServerConfig changes the pool sizes, query timeout and
+    consolidation of the query server without a restart.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetQueryBlacklist(self, request, context):
+    """GetQueryBlacklist returns the query patterns the tablet rejects
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def SetQueryBlacklist(self, request, context):
+    """SetQueryBlacklist replaces the query patterns the tablet rejects
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ChangeType(self, request, context):
+    """ChangeType asks the remote tablet to change its type
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def SetTabletTags(self, request, context):
+    """SetTabletTags merges tags into the tags of the tablet record
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def RefreshState(self, request, context):
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def RepublishTopoRecord(self, request, context):
+    """RepublishTopoRecord rewrites the tablet record in the topology
+    from the tablet's in-memory copy.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def RunHealthCheck(self, request, context):
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def IgnoreHealthError(self, request, context):
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def SetMaintenanceMode(self, request, context):
+    """SetMaintenanceMode sets or clears a maintenance marker, that
+    survives tablet restarts
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def PauseHealthReporting(self, request, context):
+    """PauseHealthReporting pauses or resumes the publication of the
+    tablet health, which resumes on its own after a maximum duration
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def SetThrottle(self, request, context):
+    """SetThrottle forces the heavy operations of the tablet to be
+    throttled or not, for a while, or goes back to throttling them
+    on replication lag
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def PrepareCutover(self, request, context):
+    """PrepareCutover makes the tablet read-only, as the first phase of
+    a cutover. The cutover aborts on its own if neither committed nor
+    aborted in time.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def CommitCutover(self, request, context):
+    """CommitCutover completes a prepared cutover, applying the serving
+    state saved in the topology
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def AbortCutover(self, request, context):
+    """AbortCutover cancels a prepared cutover, restoring the read-only
+    state the tablet had before
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def SetServingKeyRange(self, request, context):
+    """SetServingKeyRange atomically replaces the keyrange the tablet
+    serves, and the tablet types it serves it for
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def RestartMysql(self, request, context):
+    """RestartMysql drains the tablet, restarts mysqld, and waits for it
+    to be healthy before serving again. It streams its progress.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def Decommission(self, request, context):
+    """Decommission drains a non-master tablet, changes its type to
+    SPARE, and optionally stops replication and mysqld. It streams
+    its progress.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def CheckTopoConnectivity(self, request, context):
+    """CheckTopoConnectivity returns whether the tablet can read from
+    the topo server, and how long it took.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def WarmUp(self, request, context):
+    """WarmUp fills the caches of the tablet before it serves, and
+    streams its progress.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ReloadSchema(self, request, context):
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def PreflightSchema(self, request, context):
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ApplySchema(self, request, context):
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def SchemaDiff(self, request, context):
+    """SchemaDiff returns the DDL statements that would turn the schema
+    of the tablet into the provided one.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def AssessSchemaChange(self, request, context):
+    """AssessSchemaChange estimates how disruptive an ALTER TABLE would
+    be on the live table, without running it.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def WatchSchema(self, request, context):
+    """WatchSchema streams the changes to the schema of the tablet,
+    as its query service sees them.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetVSchema(self, request, context):
+    """GetVSchema returns the VSchema of the tablet keyspace.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ApplyVSchema(self, request, context):
+    """ApplyVSchema saves the VSchema of the tablet keyspace and
+    rebuilds the SrvVSchema of the tablet cell, for local testing.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ExecuteFetchAsDba(self, request, context):
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ExecuteFetchAsDbaCSV(self, request, context):
+    """ExecuteFetchAsDbaCSV streams the result of a query run with the
+    DBA user, encoded as CSV.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ExecuteFetchColumnar(self, request, context):
+    """ExecuteFetchColumnar runs a query with the DBA user, and returns
+    the result in columnar form
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ExecuteFetchAsAllPrivs(self, request, context):
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ExecuteFetchAsApp(self, request, context):
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ExplainQuery(self, request, context):
+    """ExplainQuery returns the MySQL execution plan of a query,
+    without running it
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ApplyGrants(self, request, context):
+    """ApplyGrants runs a batch of GRANT and REVOKE statements,
+    optionally restoring the previous grants if one fails
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ChecksumTable(self, request, context):
+    """ChecksumTable returns an order independent checksum of the rows
+    of a table, optionally restricted to a key range
+    """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
-  def ChangeType(self, request, context):
-    """ChangeType asks the remote tablet to change its type
+  def TruncateTable(self, request, context):
+    """TruncateTable truncates a table, if the caller confirmed the
+    keyspace of the tablet
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
-  def RefreshState(self, request, context):
+  def RenameTable(self, request, context):
+    """RenameTable renames a table, unless views or foreign keys depend
+    on it and the caller did not ask to cascade
+    """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
-  def RunHealthCheck(self, request, context):
+  def StreamRowsInKeyRange(self, request, context):
+    """StreamRowsInKeyRange streams the rows of a table in primary key
+    order, optionally restricted to a key range
+    """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
-  def IgnoreHealthError(self, request, context):
+  def StreamKeyRangeBinlog(self, request, context):
+    """StreamKeyRangeBinlog streams the binlog transactions touching
+    rows in a key range, from a replication position
+    """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
-  def ReloadSchema(self, request, context):
+  def CloneStream(self, request, context):
+    """CloneStream streams the replication position of a consistent
+    snapshot, then the rows of the tables in that snapshot
+    """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
-  def PreflightSchema(self, request, context):
+  def GetCharsetConfig(self, request, context):
+    """GetCharsetConfig returns the server and database default
+    character sets and collations.
+    """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
-  def ApplySchema(self, request, context):
+  def GetProcessList(self, request, context):
+    """GetProcessList returns the threads currently running in MySQL.
+    """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
-  def ExecuteFetchAsDba(self, request, context):
+  def KillProcess(self, request, context):
+    """KillProcess kills a MySQL connection by ID. It refuses to kill
+    system and replication threads.
+    """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
-  def ExecuteFetchAsAllPrivs(self, request, context):
+  def TailGeneralLog(self, request, context):
+    """TailGeneralLog enables the MySQL general log for a while, and
+    streams its entries. The log is disabled again when the stream ends.
+    """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
-  def ExecuteFetchAsApp(self, request, context):
+  def StreamQPS(self, request, context):
+    """StreamQPS streams the read, write and total query rates of the
+    tablet. Samples are dropped, not queued, if the client is slow.
+    """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def SlaveStatus(self, request, context):
     """
@@ -868,10 +2630,45 @@ class BetaTabletManagerServicer(object):
     SlaveStatus returns the current slave status.
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def SlaveStatusAllChannels(self, request, context):
+    """SlaveStatusAllChannels returns the slave status of each
+    replication channel, for multi-source replication.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetReplicationSource(self, request, context):
+    """GetReplicationSource returns the master host, port and
+    replication user of the slave, without the password.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ValidateReplicationCredentials(self, request, context):
+    """ValidateReplicationCredentials checks the slave can still log in
+    to its master with its replication credentials.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetApplierQueueStats(self, request, context):
+    """GetApplierQueueStats returns the relay log backlog and the state
+    of the replication applier threads
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def MasterPosition(self, request, context):
     """MasterPosition returns the current master position
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetGtidPurged(self, request, context):
+    """GetGtidPurged returns the set of transactions purged from the
+    binary logs, that can't be replicated from this tablet anymore
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetBinlogStats(self, request, context):
+    """GetBinlogStats returns the recent rate of transactions written
+    to (master) or applied from (slave) the binary logs
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetReplicationErrorStats(self, request, context):
+    """GetReplicationErrorStats returns the number of replication errors
+    and reconnects since the counts were last reset
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def StopSlave(self, request, context):
     """StopSlave makes mysql stop its replication
     """
@@ -881,10 +2678,42 @@ class BetaTabletManagerServicer(object):
     the provided minimum point
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def StopSlaveMinimumStream(self, request, context):
+    """StopSlaveMinimumStream is like StopSlaveMinimum, but it streams the
+    slave position while waiting
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def BoostReplicationCatchup(self, request, context):
+    """BoostReplicationCatchup raises the MySQL settings limiting how fast
+    the slave applies its relay logs for a while, and streams the
+    replication lag
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def StartSlave(self, request, context):
     """StartSlave starts the mysql replication
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def EnableParallelReplication(self, request, context):
+    """EnableParallelReplication restarts the SQL thread with the given
+    parallel replication settings, and rolls back if it does not resume.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def RotateReplicationCredentials(self, request, context):
+    """RotateReplicationCredentials changes the credentials the slave
+    connects to its master with, and rolls back if the IO thread
+    does not resume with them.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ConfigureReplicationSSL(self, request, context):
+    """ConfigureReplicationSSL makes the slave connect to its master
+    with SSL, and rolls back if the IO thread does not resume with it.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def RepairRelayLog(self, request, context):
+    """RepairRelayLog discards the relay logs of the slave, and fetches
+    them again from the master, from the last applied position
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def TabletExternallyReparented(self, request, context):
     """TabletExternallyReparented tells a tablet that its underlying MySQL is
     currently the master. It is only used in environments (tabletmanagerdata.such as Vitess+MoB)
@@ -919,6 +2748,11 @@ class BetaTabletManagerServicer(object):
     """GetSlaves asks for the list of mysql slaves
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetReplicationGraph(self, request, context):
+    """GetReplicationGraph returns the master and the slaves of the
+    tablet, as its mysql sees them
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def WaitBlpPosition(self, request, context):
     """WaitBlpPosition tells the remote tablet to wait until it reaches
     the specified binolg player position
@@ -937,6 +2771,11 @@ class BetaTabletManagerServicer(object):
     """RunBlpUntil asks the tablet to restart its binlog players
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetBinlogFilters(self, request, context):
+    """GetBinlogFilters returns the filters the binlog players of the
+    tablet apply.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def ResetReplication(self, request, context):
     """
     Reparenting related functions
@@ -954,6 +2793,11 @@ class BetaTabletManagerServicer(object):
     reparent journal
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetLastReparentJournalEntry(self, request, context):
+    """GetLastReparentJournalEntry returns the most recent entry of the
+    tablet reparent journal
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def InitSlave(self, request, context):
     """InitSlave tells the tablet to reparent to the master unconditionnally
     """
@@ -975,6 +2819,11 @@ class BetaTabletManagerServicer(object):
     """SetMaster tells the slave to reparent
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def ConfigureReplication(self, request, context):
+    """ConfigureReplication tells the slave to reparent, either with
+    GTID auto-position or from an explicit binlog file and position
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def SlaveWasRestarted(self, request, context):
     """SlaveWasRestarted tells the remote tablet its master has changed
     """
@@ -988,17 +2837,90 @@ class BetaTabletManagerServicer(object):
     """PromoteSlave makes the slave the new master
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
-  def Backup(self, request, context):
+  def SetReparentEligibility(self, request, context):
+    """SetReparentEligibility marks the tablet as eligible or not to be
+    chosen as the new master by reparent tools
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def CheckReparentCandidate(self, request, context):
+    """CheckReparentCandidate returns whether the tablet can be chosen
+    as the new master by reparent tools
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def SetSemiSyncAckCount(self, request, context):
+    """SetSemiSyncAckCount sets the number of semi-sync slaves the master
+    waits for, if that many are connected
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetDurabilityPolicy(self, request, context):
+    """GetDurabilityPolicy returns the semi-sync policy the tablet
+    enforces
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetBackupLimits(self, request, context):
     """
     Backup related methods
 
 
+    GetBackupLimits returns the default and maximum backup concurrency
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetBackupPosition(self, request, context):
+    """GetBackupPosition returns the replication position a backup
+    taken now would capture, without side effects
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def Backup(self, request, context):
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def IncrementalBackup(self, request, context):
+    """IncrementalBackup backs up the binlogs since the position of a
+    prior backup
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def RestoreFromBackup(self, request, context):
     """RestoreFromBackup deletes all local data and restores it from the latest backup.
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def RestoreToTimestamp(self, request, context):
+    """RestoreToTimestamp deletes all local data, restores it from the
+    named backup, and applies the master binlogs up to a target time.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def SetPreferredBackup(self, request, context):
+    """SetPreferredBackup records the backup new tablets of the shard
+    restore from, instead of the latest one.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetPreferredBackup(self, request, context):
+    """GetPreferredBackup returns the preferred backup of the shard.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def CheckRestoreCompatibility(self, request, context):
+    """CheckRestoreCompatibility compares the mysqld settings recorded
+    in a backup of the shard with the tablet's, without restoring it.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetLastBackupInfo(self, request, context):
+    """GetLastBackupInfo returns the size, duration and outcome of the
+    last backup taken by the tablet
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def GetBackupFreshness(self, request, context):
+    """GetBackupFreshness returns the most recent complete backup of the
+    shard of the tablet, and how long ago it was taken
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def CheckBackupReadiness(self, request, context):
+    """CheckBackupReadiness checks the tablet is not running DDL nor
+    restoring, and replicates and serves fine, so it can take a
+    consistent backup
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def TestRestore(self, request, context):
+    """TestRestore restores a backup of the shard into a scratch mysqld
+    on the tablet host and checks it, without touching the tablet.
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
 
 
 class BetaTabletManagerStub(object):
@@ -1022,16 +2944,96 @@ class BetaTabletManagerStub(object):
     """
     raise NotImplementedError()
   ExecuteHook.future = None
+  def ExecuteHookToStream(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """ExecuteHookToStream executes the hook remotely, and streams its
+    stdout as it is produced, then its exit status.
+    """
+    raise NotImplementedError()
   def GetSchema(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """GetSchema asks the tablet for its schema
     """
     raise NotImplementedError()
   GetSchema.future = None
+  def GetCreateStatements(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetCreateStatements returns the raw CREATE statements of the
+    tablet's tables and views
+    """
+    raise NotImplementedError()
+  GetCreateStatements.future = None
+  def GetSchemaTimestamps(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetSchemaTimestamps returns when the tablet's tables were last
+    altered
+    """
+    raise NotImplementedError()
+  GetSchemaTimestamps.future = None
   def GetPermissions(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """GetPermissions asks the tablet for its permissions
     """
     raise NotImplementedError()
   GetPermissions.future = None
+  def GetConnectionStats(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetConnectionStats returns the current connection and transaction
+    counts of the tablet's query service
+    """
+    raise NotImplementedError()
+  GetConnectionStats.future = None
+  def GetConnectionRejectionStats(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetConnectionRejectionStats returns the connection requests the
+    query service rejected since the last reset, by reason
+    """
+    raise NotImplementedError()
+  GetConnectionRejectionStats.future = None
+  def GetConfig(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetConfig returns the effective values of the tablet command line flags
+    """
+    raise NotImplementedError()
+  GetConfig.future = None
+  def GetInFlightRPCs(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetInFlightRPCs returns the number of RPCs the tablet manager is
+    processing, by method
+    """
+    raise NotImplementedError()
+  GetInFlightRPCs.future = None
+  def GetProcessStats(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetProcessStats returns the goroutine, thread, open file and
+    memory usage of the tablet manager process
+    """
+    raise NotImplementedError()
+  GetProcessStats.future = None
+  def GetHealthScore(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetHealthScore returns a composite health score of the tablet,
+    combining replication lag, error rate and load
+    """
+    raise NotImplementedError()
+  GetHealthScore.future = None
+  def GetErrorLogTail(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetErrorLogTail returns the last lines of the MySQL error log
+    """
+    raise NotImplementedError()
+  GetErrorLogTail.future = None
+  def CaptureDiagnostics(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """CaptureDiagnostics returns the processlist, slave status, error
+    log tail, key variables and disk stats of the tablet at once.
+    """
+    raise NotImplementedError()
+  CaptureDiagnostics.future = None
+  def CheckDataDir(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """CheckDataDir returns the problems with the ownership and the
+    permissions of the directories mysqld needs
+    """
+    raise NotImplementedError()
+  CheckDataDir.future = None
+  def GetThrottleState(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetThrottleState returns whether the heavy operations of the
+    tablet are throttled, and why
+    """
+    raise NotImplementedError()
+  GetThrottleState.future = None
+  def GetTabletTags(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetTabletTags returns the tags of the tablet record
+    """
+    raise NotImplementedError()
+  GetTabletTags.future = None
   def SetReadOnly(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """
     Various read-write methods
@@ -1043,20 +3045,125 @@ class BetaTabletManagerStub(object):
   def SetReadWrite(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     raise NotImplementedError()
   SetReadWrite.future = None
+  def SetReadOnlyWithTTL(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SetReadOnlyWithTTL makes the tablet read-only for a while, after
+    which it goes back to read-write on its own
+    """
+    raise NotImplementedError()
+  SetReadOnlyWithTTL.future = None
+  def SetSuperReadOnly(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SetSuperReadOnly sets or clears super_read_only, which also
+    prevents SUPER users from writing
+    """
+    raise NotImplementedError()
+  SetSuperReadOnly.future = None
+  def SetQueryServerConfig(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SetQueryServerConfig changes the pool sizes, query timeout and
+    consolidation of the query server without a restart.
+    """
+    raise NotImplementedError()
+  SetQueryServerConfig.future = None
+  def GetQueryBlacklist(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetQueryBlacklist returns the query patterns the tablet rejects
+    """
+    raise NotImplementedError()
+  GetQueryBlacklist.future = None
+  def SetQueryBlacklist(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SetQueryBlacklist replaces the query patterns the tablet rejects
+    """
+    raise NotImplementedError()
+  SetQueryBlacklist.future = None
   def ChangeType(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """ChangeType asks the remote tablet to change its type
     """
     raise NotImplementedError()
   ChangeType.future = None
+  def SetTabletTags(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SetTabletTags merges tags into the tags of the tablet record
+    """
+    raise NotImplementedError()
+  SetTabletTags.future = None
   def RefreshState(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     raise NotImplementedError()
   RefreshState.future = None
+  def RepublishTopoRecord(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """RepublishTopoRecord rewrites the tablet record in the topology
+    from the tablet's in-memory copy.
+    """
+    raise NotImplementedError()
+  RepublishTopoRecord.future = None
   def RunHealthCheck(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     raise NotImplementedError()
   RunHealthCheck.future = None
   def IgnoreHealthError(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     raise NotImplementedError()
   IgnoreHealthError.future = None
+  def SetMaintenanceMode(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SetMaintenanceMode sets or clears a maintenance marker, that
+    survives tablet restarts
+    """
+    raise NotImplementedError()
+  SetMaintenanceMode.future = None
+  def PauseHealthReporting(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """PauseHealthReporting pauses or resumes the publication of the
+    tablet health, which resumes on its own after a maximum duration
+    """
+    raise NotImplementedError()
+  PauseHealthReporting.future = None
+  def SetThrottle(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SetThrottle forces the heavy operations of the tablet to be
+    throttled or not, for a while, or goes back to throttling them
+    on replication lag
+    """
+    raise NotImplementedError()
+  SetThrottle.future = None
+  def PrepareCutover(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """PrepareCutover makes the tablet read-only, as the first phase of
+    a cutover. The cutover aborts on its own if neither committed nor
+    aborted in time.
+    """
+    raise NotImplementedError()
+  PrepareCutover.future = None
+  def CommitCutover(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """CommitCutover completes a prepared cutover, applying the serving
+    state saved in the topology
+    """
+    raise NotImplementedError()
+  CommitCutover.future = None
+  def AbortCutover(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """AbortCutover cancels a prepared cutover, restoring the read-only
+    state the tablet had before
+    """
+    raise NotImplementedError()
+  AbortCutover.future = None
+  def SetServingKeyRange(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SetServingKeyRange atomically replaces the keyrange the tablet
+    serves, and the tablet types it serves it for
+    """
+    raise NotImplementedError()
+  SetServingKeyRange.future = None
+  def RestartMysql(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """RestartMysql drains the tablet, restarts mysqld, and waits for it
+    to be healthy before serving again. It streams its progress.
+    """
+    raise NotImplementedError()
+  def Decommission(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """Decommission drains a non-master tablet, changes its type to
+    SPARE, and optionally stops replication and mysqld. It streams
+    its progress.
+    """
+    raise NotImplementedError()
+  def CheckTopoConnectivity(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """CheckTopoConnectivity returns whether the tablet can read from
+    the topo server, and how long it took.
+    """
+    raise NotImplementedError()
+  CheckTopoConnectivity.future = None
+  def WarmUp(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """WarmUp fills the caches of the tablet before it serves, and
+    streams its progress.
+    """
+    raise NotImplementedError()
   def ReloadSchema(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     raise NotImplementedError()
   ReloadSchema.future = None
@@ -1066,15 +3173,126 @@ class BetaTabletManagerStub(object):
   def ApplySchema(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     raise NotImplementedError()
   ApplySchema.future = None
+  def SchemaDiff(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SchemaDiff returns the DDL statements that would turn the schema
+    of the tablet into the provided one.
+    """
+    raise NotImplementedError()
+  SchemaDiff.future = None
+  def AssessSchemaChange(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """AssessSchemaChange estimates how disruptive an ALTER TABLE would
+    be on the live table, without running it.
+    """
+    raise NotImplementedError()
+  AssessSchemaChange.future = None
+  def WatchSchema(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """WatchSchema streams the changes to the schema of the tablet,
+    as its query service sees them.
+    """
+    raise NotImplementedError()
+  def GetVSchema(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetVSchema returns the VSchema of the tablet keyspace.
+    """
+    raise NotImplementedError()
+  GetVSchema.future = None
+  def ApplyVSchema(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """ApplyVSchema saves the VSchema of the tablet keyspace and
+    rebuilds the SrvVSchema of the tablet cell, for local testing.
+    """
+    raise NotImplementedError()
+  ApplyVSchema.future = None
   def ExecuteFetchAsDba(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     raise NotImplementedError()
   ExecuteFetchAsDba.future = None
+  def ExecuteFetchAsDbaCSV(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """ExecuteFetchAsDbaCSV streams the result of a query run with the
+    DBA user, encoded as CSV.
+    """
+    raise NotImplementedError()
+  def ExecuteFetchColumnar(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """ExecuteFetchColumnar runs a query with the DBA user, and returns
+    the result in columnar form
+    """
+    raise NotImplementedError()
+  ExecuteFetchColumnar.future = None
   def ExecuteFetchAsAllPrivs(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     raise NotImplementedError()
   ExecuteFetchAsAllPrivs.future = None
   def ExecuteFetchAsApp(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     raise NotImplementedError()
   ExecuteFetchAsApp.future = None
+  def ExplainQuery(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """ExplainQuery returns the MySQL execution plan of a query,
+    without running it
+    """
+    raise NotImplementedError()
+  ExplainQuery.future = None
+  def ApplyGrants(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """ApplyGrants runs a batch of GRANT and REVOKE statements,
+    optionally restoring the previous grants if one fails
+    """
+    raise NotImplementedError()
+  ApplyGrants.future = None
+  def ChecksumTable(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """ChecksumTable returns an order independent checksum of the rows
+    of a table, optionally restricted to a key range
+    """
+    raise NotImplementedError()
+  ChecksumTable.future = None
+  def TruncateTable(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """TruncateTable truncates a table, if the caller confirmed the
+    keyspace of the tablet
+    """
+    raise NotImplementedError()
+  TruncateTable.future = None
+  def RenameTable(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """RenameTable renames a table, unless views or foreign keys depend
+    on it and the caller did not ask to cascade
+    """
+    raise NotImplementedError()
+  RenameTable.future = None
+  def StreamRowsInKeyRange(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """StreamRowsInKeyRange streams the rows of a table in primary key
+    order, optionally restricted to a key range
+    """
+    raise NotImplementedError()
+  def StreamKeyRangeBinlog(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """StreamKeyRangeBinlog streams the binlog transactions touching
+    rows in a key range, from a replication position
+    """
+    raise NotImplementedError()
+  def CloneStream(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """CloneStream streams the replication position of a consistent
+    snapshot, then the rows of the tables in that snapshot
+    """
+    raise NotImplementedError()
+  def GetCharsetConfig(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetCharsetConfig returns the server and database default
+    character sets and collations.
+    """
+    raise NotImplementedError()
+  GetCharsetConfig.future = None
+  def GetProcessList(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetProcessList returns the threads currently running in MySQL.
+    """
+    raise NotImplementedError()
+  GetProcessList.future = None
+  def KillProcess(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """KillProcess kills a MySQL connection by ID. It refuses to kill
+    system and replication threads.
+    """
+    raise NotImplementedError()
+  KillProcess.future = None
+  def TailGeneralLog(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """TailGeneralLog enables the MySQL general log for a while, and
+    streams its entries. The log is disabled again when the stream ends.
+    """
+    raise NotImplementedError()
+  def StreamQPS(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """StreamQPS streams the read, write and total query rates of the
+    tablet. Samples are dropped, not queued, if the client is slow.
+    """
+    raise NotImplementedError()
   def SlaveStatus(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """
     Replication related methods
@@ -1084,11 +3302,53 @@ class BetaTabletManagerStub(object):
     """
     raise NotImplementedError()
   SlaveStatus.future = None
+  def SlaveStatusAllChannels(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SlaveStatusAllChannels returns the slave status of each
+    replication channel, for multi-source replication.
+    """
+    raise NotImplementedError()
+  SlaveStatusAllChannels.future = None
+  def GetReplicationSource(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetReplicationSource returns the master host, port and
+    replication user of the slave, without the password.
+    """
+    raise NotImplementedError()
+  GetReplicationSource.future = None
+  def ValidateReplicationCredentials(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """ValidateReplicationCredentials checks the slave can still log in
+    to its master with its replication credentials.
+    """
+    raise NotImplementedError()
+  ValidateReplicationCredentials.future = None
+  def GetApplierQueueStats(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetApplierQueueStats returns the relay log backlog and the state
+    of the replication applier threads
+    """
+    raise NotImplementedError()
+  GetApplierQueueStats.future = None
   def MasterPosition(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """MasterPosition returns the current master position
     """
     raise NotImplementedError()
   MasterPosition.future = None
+  def GetGtidPurged(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetGtidPurged returns the set of transactions purged from the
+    binary logs, that can't be replicated from this tablet anymore
+    """
+    raise NotImplementedError()
+  GetGtidPurged.future = None
+  def GetBinlogStats(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetBinlogStats returns the recent rate of transactions written
+    to (master) or applied from (slave) the binary logs
+    """
+    raise NotImplementedError()
+  GetBinlogStats.future = None
+  def GetReplicationErrorStats(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetReplicationErrorStats returns the number of replication errors
+    and reconnects since the counts were last reset
+    """
+    raise NotImplementedError()
+  GetReplicationErrorStats.future = None
   def StopSlave(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """StopSlave makes mysql stop its replication
     """
@@ -1100,11 +3360,46 @@ class BetaTabletManagerStub(object):
     """
     raise NotImplementedError()
   StopSlaveMinimum.future = None
+  def StopSlaveMinimumStream(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """StopSlaveMinimumStream is like StopSlaveMinimum, but it streams the
+    slave position while waiting
+    """
+    raise NotImplementedError()
+  def BoostReplicationCatchup(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """BoostReplicationCatchup raises the MySQL settings limiting how fast
+    the slave applies its relay logs for a while, and streams the
+    replication lag
+    """
+    raise NotImplementedError()
   def StartSlave(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """StartSlave starts the mysql replication
     """
     raise NotImplementedError()
   StartSlave.future = None
+  def EnableParallelReplication(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """EnableParallelReplication restarts the SQL thread with the given
+    parallel replication settings, and rolls back if it does not resume.
+    """
+    raise NotImplementedError()
+  EnableParallelReplication.future = None
+  def RotateReplicationCredentials(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """RotateReplicationCredentials changes the credentials the slave
+    connects to its master with, and rolls back if the IO thread
+    does not resume with them.
+    """
+    raise NotImplementedError()
+  RotateReplicationCredentials.future = None
+  def ConfigureReplicationSSL(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """ConfigureReplicationSSL makes the slave connect to its master
+    with SSL, and rolls back if the IO thread does not resume with it.
+    """
+    raise NotImplementedError()
+  ConfigureReplicationSSL.future = None
+  def RepairRelayLog(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """RepairRelayLog discards the relay logs of the slave, and fetches
+    them again from the master, from the last applied position
+    """
+    raise NotImplementedError()
   def TabletExternallyReparented(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """TabletExternallyReparented tells a tablet that its underlying MySQL is
     currently the master. It is only used in environments (tabletmanagerdata.such as Vitess+MoB)
@@ -1142,6 +3437,12 @@ class BetaTabletManagerStub(object):
     """
     raise NotImplementedError()
   GetSlaves.future = None
+  def GetReplicationGraph(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetReplicationGraph returns the master and the slaves of the
+    tablet, as its mysql sees them
+    """
+    raise NotImplementedError()
+  GetReplicationGraph.future = None
   def WaitBlpPosition(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """WaitBlpPosition tells the remote tablet to wait until it reaches
     the specified binolg player position
@@ -1164,6 +3465,12 @@ class BetaTabletManagerStub(object):
     """
     raise NotImplementedError()
   RunBlpUntil.future = None
+  def GetBinlogFilters(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetBinlogFilters returns the filters the binlog players of the
+    tablet apply.
+    """
+    raise NotImplementedError()
+  GetBinlogFilters.future = None
   def ResetReplication(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """
     Reparenting related functions
@@ -1184,6 +3491,12 @@ class BetaTabletManagerStub(object):
     """
     raise NotImplementedError()
   PopulateReparentJournal.future = None
+  def GetLastReparentJournalEntry(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetLastReparentJournalEntry returns the most recent entry of the
+    tablet reparent journal
+    """
+    raise NotImplementedError()
+  GetLastReparentJournalEntry.future = None
   def InitSlave(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """InitSlave tells the tablet to reparent to the master unconditionnally
     """
@@ -1210,6 +3523,12 @@ class BetaTabletManagerStub(object):
     """
     raise NotImplementedError()
   SetMaster.future = None
+  def ConfigureReplication(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """ConfigureReplication tells the slave to reparent, either with
+    GTID auto-position or from an explicit binlog file and position
+    """
+    raise NotImplementedError()
+  ConfigureReplication.future = None
   def SlaveWasRestarted(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """SlaveWasRestarted tells the remote tablet its master has changed
     """
@@ -1226,51 +3545,210 @@ class BetaTabletManagerStub(object):
     """
     raise NotImplementedError()
   PromoteSlave.future = None
-  def Backup(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+  def SetReparentEligibility(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SetReparentEligibility marks the tablet as eligible or not to be
+    chosen as the new master by reparent tools
+    """
+    raise NotImplementedError()
+  SetReparentEligibility.future = None
+  def CheckReparentCandidate(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """CheckReparentCandidate returns whether the tablet can be chosen
+    as the new master by reparent tools
+    """
+    raise NotImplementedError()
+  CheckReparentCandidate.future = None
+  def SetSemiSyncAckCount(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SetSemiSyncAckCount sets the number of semi-sync slaves the master
+    waits for, if that many are connected
+    """
+    raise NotImplementedError()
+  SetSemiSyncAckCount.future = None
+  def GetDurabilityPolicy(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetDurabilityPolicy returns the semi-sync policy the tablet
+    enforces
+    """
+    raise NotImplementedError()
+  GetDurabilityPolicy.future = None
+  def GetBackupLimits(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """
     Backup related methods
 
 
+    GetBackupLimits returns the default and maximum backup concurrency
+    """
+    raise NotImplementedError()
+  GetBackupLimits.future = None
+  def GetBackupPosition(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetBackupPosition returns the replication position a backup
+    taken now would capture, without side effects
+    """
+    raise NotImplementedError()
+  GetBackupPosition.future = None
+  def Backup(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    raise NotImplementedError()
+  def IncrementalBackup(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """IncrementalBackup backs up the binlogs since the position of a
+    prior backup
     """
     raise NotImplementedError()
   def RestoreFromBackup(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """RestoreFromBackup deletes all local data and restores it from the latest backup.
     """
     raise NotImplementedError()
+  def RestoreToTimestamp(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """RestoreToTimestamp deletes all local data, restores it from the
+    named backup, and applies the master binlogs up to a target time.
+    """
+    raise NotImplementedError()
+  def SetPreferredBackup(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """SetPreferredBackup records the backup new tablets of the shard
+    restore from, instead of the latest one.
+    """
+    raise NotImplementedError()
+  SetPreferredBackup.future = None
+  def GetPreferredBackup(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetPreferredBackup returns the preferred backup of the shard.
+    """
+    raise NotImplementedError()
+  GetPreferredBackup.future = None
+  def CheckRestoreCompatibility(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """CheckRestoreCompatibility compares the mysqld settings recorded
+    in a backup of the shard with the tablet's, without restoring it.
+    """
+    raise NotImplementedError()
+  CheckRestoreCompatibility.future = None
+  def GetLastBackupInfo(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetLastBackupInfo returns the size, duration and outcome of the
+    last backup taken by the tablet
+    """
+    raise NotImplementedError()
+  GetLastBackupInfo.future = None
+  def GetBackupFreshness(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """GetBackupFreshness returns the most recent complete backup of the
+    shard of the tablet, and how long ago it was taken
+    """
+    raise NotImplementedError()
+  GetBackupFreshness.future = None
+  def CheckBackupReadiness(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """CheckBackupReadiness checks the tablet is not running DDL nor
+    restoring, and replicates and serves fine, so it can take a
+    consistent backup
+    """
+    raise NotImplementedError()
+  CheckBackupReadiness.future = None
+  def TestRestore(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """TestRestore restores a backup of the shard into a scratch mysqld
+    on the tablet host and checks it, without touching the tablet.
+    """
+    raise NotImplementedError()
 
 
 def beta_create_TabletManager_server(servicer, pool=None, pool_size=None, default_timeout=None, maximum_timeout=None):
   request_deserializers = {
+    ('tabletmanagerservice.TabletManager', 'AbortCutover'): tabletmanagerdata__pb2.AbortCutoverRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'ApplyGrants'): tabletmanagerdata__pb2.ApplyGrantsRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'ApplySchema'): tabletmanagerdata__pb2.ApplySchemaRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'ApplyVSchema'): tabletmanagerdata__pb2.ApplyVSchemaRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'AssessSchemaChange'): tabletmanagerdata__pb2.AssessSchemaChangeRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'Backup'): tabletmanagerdata__pb2.BackupRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'BoostReplicationCatchup'): tabletmanagerdata__pb2.BoostReplicationCatchupRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'CaptureDiagnostics'): tabletmanagerdata__pb2.CaptureDiagnosticsRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'ChangeType'): tabletmanagerdata__pb2.ChangeTypeRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'CheckBackupReadiness'): tabletmanagerdata__pb2.CheckBackupReadinessRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'CheckDataDir'): tabletmanagerdata__pb2.CheckDataDirRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'CheckReparentCandidate'): tabletmanagerdata__pb2.CheckReparentCandidateRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'CheckRestoreCompatibility'): tabletmanagerdata__pb2.CheckRestoreCompatibilityRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'CheckTopoConnectivity'): tabletmanagerdata__pb2.CheckTopoConnectivityRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'ChecksumTable'): tabletmanagerdata__pb2.ChecksumTableRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'CloneStream'): tabletmanagerdata__pb2.CloneStreamRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'CommitCutover'): tabletmanagerdata__pb2.CommitCutoverRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'ConfigureReplication'): tabletmanagerdata__pb2.ConfigureReplicationRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'ConfigureReplicationSSL'): tabletmanagerdata__pb2.ConfigureReplicationSSLRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'Decommission'): tabletmanagerdata__pb2.DecommissionRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'DemoteMaster'): tabletmanagerdata__pb2.DemoteMasterRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'EnableParallelReplication'): tabletmanagerdata__pb2.EnableParallelReplicationRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsAllPrivs'): tabletmanagerdata__pb2.ExecuteFetchAsAllPrivsRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsApp'): tabletmanagerdata__pb2.ExecuteFetchAsAppRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsDba'): tabletmanagerdata__pb2.ExecuteFetchAsDbaRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsDbaCSV'): tabletmanagerdata__pb2.ExecuteFetchAsDbaCSVRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'ExecuteFetchColumnar'): tabletmanagerdata__pb2.ExecuteFetchColumnarRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'ExecuteHook'): tabletmanagerdata__pb2.ExecuteHookRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'ExecuteHookToStream'): tabletmanagerdata__pb2.ExecuteHookToStreamRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'ExplainQuery'): tabletmanagerdata__pb2.ExplainQueryRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetApplierQueueStats'): tabletmanagerdata__pb2.GetApplierQueueStatsRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetBackupFreshness'): tabletmanagerdata__pb2.GetBackupFreshnessRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetBackupLimits'): tabletmanagerdata__pb2.GetBackupLimitsRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetBackupPosition'): tabletmanagerdata__pb2.GetBackupPositionRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetBinlogFilters'): tabletmanagerdata__pb2.GetBinlogFiltersRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetBinlogStats'): tabletmanagerdata__pb2.GetBinlogStatsRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetCharsetConfig'): tabletmanagerdata__pb2.GetCharsetConfigRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetConfig'): tabletmanagerdata__pb2.GetConfigRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetConnectionRejectionStats'): tabletmanagerdata__pb2.GetConnectionRejectionStatsRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetConnectionStats'): tabletmanagerdata__pb2.GetConnectionStatsRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetCreateStatements'): tabletmanagerdata__pb2.GetCreateStatementsRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetDurabilityPolicy'): tabletmanagerdata__pb2.GetDurabilityPolicyRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetErrorLogTail'): tabletmanagerdata__pb2.GetErrorLogTailRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetGtidPurged'): tabletmanagerdata__pb2.GetGtidPurgedRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetHealthScore'): tabletmanagerdata__pb2.GetHealthScoreRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetInFlightRPCs'): tabletmanagerdata__pb2.GetInFlightRPCsRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetLastBackupInfo'): tabletmanagerdata__pb2.GetLastBackupInfoRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetLastReparentJournalEntry'): tabletmanagerdata__pb2.GetLastReparentJournalEntryRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'GetPermissions'): tabletmanagerdata__pb2.GetPermissionsRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetPreferredBackup'): tabletmanagerdata__pb2.GetPreferredBackupRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetProcessList'): tabletmanagerdata__pb2.GetProcessListRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetProcessStats'): tabletmanagerdata__pb2.GetProcessStatsRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetQueryBlacklist'): tabletmanagerdata__pb2.GetQueryBlacklistRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetReplicationErrorStats'): tabletmanagerdata__pb2.GetReplicationErrorStatsRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetReplicationGraph'): tabletmanagerdata__pb2.GetReplicationGraphRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetReplicationSource'): tabletmanagerdata__pb2.GetReplicationSourceRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'GetSchema'): tabletmanagerdata__pb2.GetSchemaRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetSchemaTimestamps'): tabletmanagerdata__pb2.GetSchemaTimestampsRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'GetSlaves'): tabletmanagerdata__pb2.GetSlavesRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetTabletTags'): tabletmanagerdata__pb2.GetTabletTagsRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetThrottleState'): tabletmanagerdata__pb2.GetThrottleStateRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetVSchema'): tabletmanagerdata__pb2.GetVSchemaRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'IgnoreHealthError'): tabletmanagerdata__pb2.IgnoreHealthErrorRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'IncrementalBackup'): tabletmanagerdata__pb2.IncrementalBackupRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'InitMaster'): tabletmanagerdata__pb2.InitMasterRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'InitSlave'): tabletmanagerdata__pb2.InitSlaveRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'KillProcess'): tabletmanagerdata__pb2.KillProcessRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'MasterPosition'): tabletmanagerdata__pb2.MasterPositionRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'PauseHealthReporting'): tabletmanagerdata__pb2.PauseHealthReportingRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'Ping'): tabletmanagerdata__pb2.PingRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'PopulateReparentJournal'): tabletmanagerdata__pb2.PopulateReparentJournalRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'PreflightSchema'): tabletmanagerdata__pb2.PreflightSchemaRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'PrepareCutover'): tabletmanagerdata__pb2.PrepareCutoverRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'PromoteSlave'): tabletmanagerdata__pb2.PromoteSlaveRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'PromoteSlaveWhenCaughtUp'): tabletmanagerdata__pb2.PromoteSlaveWhenCaughtUpRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'RefreshState'): tabletmanagerdata__pb2.RefreshStateRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'ReloadSchema'): tabletmanagerdata__pb2.ReloadSchemaRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'RenameTable'): tabletmanagerdata__pb2.RenameTableRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'RepairRelayLog'): tabletmanagerdata__pb2.RepairRelayLogRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'RepublishTopoRecord'): tabletmanagerdata__pb2.RepublishTopoRecordRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'ResetReplication'): tabletmanagerdata__pb2.ResetReplicationRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'RestartMysql'): tabletmanagerdata__pb2.RestartMysqlRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'RestoreFromBackup'): tabletmanagerdata__pb2.RestoreFromBackupRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'RestoreToTimestamp'): tabletmanagerdata__pb2.RestoreToTimestampRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'RotateReplicationCredentials'): tabletmanagerdata__pb2.RotateReplicationCredentialsRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'RunBlpUntil'): tabletmanagerdata__pb2.RunBlpUntilRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'RunHealthCheck'): tabletmanagerdata__pb2.RunHealthCheckRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SchemaDiff'): tabletmanagerdata__pb2.SchemaDiffRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetMaintenanceMode'): tabletmanagerdata__pb2.SetMaintenanceModeRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'SetMaster'): tabletmanagerdata__pb2.SetMasterRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetPreferredBackup'): tabletmanagerdata__pb2.SetPreferredBackupRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetQueryBlacklist'): tabletmanagerdata__pb2.SetQueryBlacklistRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetQueryServerConfig'): tabletmanagerdata__pb2.SetQueryServerConfigRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'SetReadOnly'): tabletmanagerdata__pb2.SetReadOnlyRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetReadOnlyWithTTL'): tabletmanagerdata__pb2.SetReadOnlyWithTTLRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'SetReadWrite'): tabletmanagerdata__pb2.SetReadWriteRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetReparentEligibility'): tabletmanagerdata__pb2.SetReparentEligibilityRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetSemiSyncAckCount'): tabletmanagerdata__pb2.SetSemiSyncAckCountRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetServingKeyRange'): tabletmanagerdata__pb2.SetServingKeyRangeRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetSuperReadOnly'): tabletmanagerdata__pb2.SetSuperReadOnlyRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetTabletTags'): tabletmanagerdata__pb2.SetTabletTagsRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetThrottle'): tabletmanagerdata__pb2.SetThrottleRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'SlaveStatus'): tabletmanagerdata__pb2.SlaveStatusRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'SlaveStatusAllChannels'): tabletmanagerdata__pb2.SlaveStatusAllChannelsRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'SlaveWasPromoted'): tabletmanagerdata__pb2.SlaveWasPromotedRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'SlaveWasRestarted'): tabletmanagerdata__pb2.SlaveWasRestartedRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'Sleep'): tabletmanagerdata__pb2.SleepRequest.FromString,
@@ -1280,41 +3758,125 @@ def beta_create_TabletManager_server(servicer, pool=None, pool_size=None, defaul
     ('tabletmanagerservice.TabletManager', 'StopReplicationAndGetStatus'): tabletmanagerdata__pb2.StopReplicationAndGetStatusRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'StopSlave'): tabletmanagerdata__pb2.StopSlaveRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'StopSlaveMinimum'): tabletmanagerdata__pb2.StopSlaveMinimumRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'StopSlaveMinimumStream'): tabletmanagerdata__pb2.StopSlaveMinimumStreamRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'StreamKeyRangeBinlog'): tabletmanagerdata__pb2.StreamKeyRangeBinlogRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'StreamQPS'): tabletmanagerdata__pb2.StreamQPSRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'StreamRowsInKeyRange'): tabletmanagerdata__pb2.StreamRowsInKeyRangeRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyElected'): tabletmanagerdata__pb2.TabletExternallyElectedRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyReparented'): tabletmanagerdata__pb2.TabletExternallyReparentedRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'TailGeneralLog'): tabletmanagerdata__pb2.TailGeneralLogRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'TestRestore'): tabletmanagerdata__pb2.TestRestoreRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'TruncateTable'): tabletmanagerdata__pb2.TruncateTableRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'ValidateReplicationCredentials'): tabletmanagerdata__pb2.ValidateReplicationCredentialsRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'WaitBlpPosition'): tabletmanagerdata__pb2.WaitBlpPositionRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'WarmUp'): tabletmanagerdata__pb2.WarmUpRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'WatchSchema'): tabletmanagerdata__pb2.WatchSchemaRequest.FromString,
   }
   response_serializers = {
+    ('tabletmanagerservice.TabletManager', 'AbortCutover'): tabletmanagerdata__pb2.AbortCutoverResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ApplyGrants'): tabletmanagerdata__pb2.ApplyGrantsResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ApplySchema'): tabletmanagerdata__pb2.ApplySchemaResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ApplyVSchema'): tabletmanagerdata__pb2.ApplyVSchemaResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'AssessSchemaChange'): tabletmanagerdata__pb2.AssessSchemaChangeResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'Backup'): tabletmanagerdata__pb2.BackupResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'BoostReplicationCatchup'): tabletmanagerdata__pb2.BoostReplicationCatchupResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CaptureDiagnostics'): tabletmanagerdata__pb2.CaptureDiagnosticsResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ChangeType'): tabletmanagerdata__pb2.ChangeTypeResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CheckBackupReadiness'): tabletmanagerdata__pb2.CheckBackupReadinessResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CheckDataDir'): tabletmanagerdata__pb2.CheckDataDirResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CheckReparentCandidate'): tabletmanagerdata__pb2.CheckReparentCandidateResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CheckRestoreCompatibility'): tabletmanagerdata__pb2.CheckRestoreCompatibilityResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CheckTopoConnectivity'): tabletmanagerdata__pb2.CheckTopoConnectivityResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ChecksumTable'): tabletmanagerdata__pb2.ChecksumTableResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CloneStream'): tabletmanagerdata__pb2.CloneStreamResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CommitCutover'): tabletmanagerdata__pb2.CommitCutoverResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ConfigureReplication'): tabletmanagerdata__pb2.ConfigureReplicationResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ConfigureReplicationSSL'): tabletmanagerdata__pb2.ConfigureReplicationSSLResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'Decommission'): tabletmanagerdata__pb2.DecommissionResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'DemoteMaster'): tabletmanagerdata__pb2.DemoteMasterResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'EnableParallelReplication'): tabletmanagerdata__pb2.EnableParallelReplicationResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsAllPrivs'): tabletmanagerdata__pb2.ExecuteFetchAsAllPrivsResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsApp'): tabletmanagerdata__pb2.ExecuteFetchAsAppResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsDba'): tabletmanagerdata__pb2.ExecuteFetchAsDbaResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsDbaCSV'): tabletmanagerdata__pb2.ExecuteFetchAsDbaCSVResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ExecuteFetchColumnar'): tabletmanagerdata__pb2.ExecuteFetchColumnarResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ExecuteHook'): tabletmanagerdata__pb2.ExecuteHookResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ExecuteHookToStream'): tabletmanagerdata__pb2.ExecuteHookToStreamResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ExplainQuery'): tabletmanagerdata__pb2.ExplainQueryResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetApplierQueueStats'): tabletmanagerdata__pb2.GetApplierQueueStatsResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetBackupFreshness'): tabletmanagerdata__pb2.GetBackupFreshnessResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetBackupLimits'): tabletmanagerdata__pb2.GetBackupLimitsResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetBackupPosition'): tabletmanagerdata__pb2.GetBackupPositionResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetBinlogFilters'): tabletmanagerdata__pb2.GetBinlogFiltersResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetBinlogStats'): tabletmanagerdata__pb2.GetBinlogStatsResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetCharsetConfig'): tabletmanagerdata__pb2.GetCharsetConfigResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetConfig'): tabletmanagerdata__pb2.GetConfigResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetConnectionRejectionStats'): tabletmanagerdata__pb2.GetConnectionRejectionStatsResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetConnectionStats'): tabletmanagerdata__pb2.GetConnectionStatsResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetCreateStatements'): tabletmanagerdata__pb2.GetCreateStatementsResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetDurabilityPolicy'): tabletmanagerdata__pb2.GetDurabilityPolicyResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetErrorLogTail'): tabletmanagerdata__pb2.GetErrorLogTailResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetGtidPurged'): tabletmanagerdata__pb2.GetGtidPurgedResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetHealthScore'): tabletmanagerdata__pb2.GetHealthScoreResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetInFlightRPCs'): tabletmanagerdata__pb2.GetInFlightRPCsResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetLastBackupInfo'): tabletmanagerdata__pb2.GetLastBackupInfoResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetLastReparentJournalEntry'): tabletmanagerdata__pb2.GetLastReparentJournalEntryResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'GetPermissions'): tabletmanagerdata__pb2.GetPermissionsResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetPreferredBackup'): tabletmanagerdata__pb2.GetPreferredBackupResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetProcessList'): tabletmanagerdata__pb2.GetProcessListResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetProcessStats'): tabletmanagerdata__pb2.GetProcessStatsResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetQueryBlacklist'): tabletmanagerdata__pb2.GetQueryBlacklistResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetReplicationErrorStats'): tabletmanagerdata__pb2.GetReplicationErrorStatsResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetReplicationGraph'): tabletmanagerdata__pb2.GetReplicationGraphResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetReplicationSource'): tabletmanagerdata__pb2.GetReplicationSourceResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'GetSchema'): tabletmanagerdata__pb2.GetSchemaResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetSchemaTimestamps'): tabletmanagerdata__pb2.GetSchemaTimestampsResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'GetSlaves'): tabletmanagerdata__pb2.GetSlavesResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetTabletTags'): tabletmanagerdata__pb2.GetTabletTagsResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetThrottleState'): tabletmanagerdata__pb2.GetThrottleStateResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetVSchema'): tabletmanagerdata__pb2.GetVSchemaResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'IgnoreHealthError'): tabletmanagerdata__pb2.IgnoreHealthErrorResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'IncrementalBackup'): tabletmanagerdata__pb2.IncrementalBackupResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'InitMaster'): tabletmanagerdata__pb2.InitMasterResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'InitSlave'): tabletmanagerdata__pb2.InitSlaveResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'KillProcess'): tabletmanagerdata__pb2.KillProcessResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'MasterPosition'): tabletmanagerdata__pb2.MasterPositionResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'PauseHealthReporting'): tabletmanagerdata__pb2.PauseHealthReportingResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'Ping'): tabletmanagerdata__pb2.PingResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'PopulateReparentJournal'): tabletmanagerdata__pb2.PopulateReparentJournalResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'PreflightSchema'): tabletmanagerdata__pb2.PreflightSchemaResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'PrepareCutover'): tabletmanagerdata__pb2.PrepareCutoverResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'PromoteSlave'): tabletmanagerdata__pb2.PromoteSlaveResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'PromoteSlaveWhenCaughtUp'): tabletmanagerdata__pb2.PromoteSlaveWhenCaughtUpResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'RefreshState'): tabletmanagerdata__pb2.RefreshStateResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ReloadSchema'): tabletmanagerdata__pb2.ReloadSchemaResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'RenameTable'): tabletmanagerdata__pb2.RenameTableResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'RepairRelayLog'): tabletmanagerdata__pb2.RepairRelayLogResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'RepublishTopoRecord'): tabletmanagerdata__pb2.RepublishTopoRecordResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ResetReplication'): tabletmanagerdata__pb2.ResetReplicationResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'RestartMysql'): tabletmanagerdata__pb2.RestartMysqlResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'RestoreFromBackup'): tabletmanagerdata__pb2.RestoreFromBackupResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'RestoreToTimestamp'): tabletmanagerdata__pb2.RestoreToTimestampResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'RotateReplicationCredentials'): tabletmanagerdata__pb2.RotateReplicationCredentialsResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'RunBlpUntil'): tabletmanagerdata__pb2.RunBlpUntilResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'RunHealthCheck'): tabletmanagerdata__pb2.RunHealthCheckResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SchemaDiff'): tabletmanagerdata__pb2.SchemaDiffResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetMaintenanceMode'): tabletmanagerdata__pb2.SetMaintenanceModeResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'SetMaster'): tabletmanagerdata__pb2.SetMasterResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetPreferredBackup'): tabletmanagerdata__pb2.SetPreferredBackupResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetQueryBlacklist'): tabletmanagerdata__pb2.SetQueryBlacklistResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetQueryServerConfig'): tabletmanagerdata__pb2.SetQueryServerConfigResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'SetReadOnly'): tabletmanagerdata__pb2.SetReadOnlyResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetReadOnlyWithTTL'): tabletmanagerdata__pb2.SetReadOnlyWithTTLResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'SetReadWrite'): tabletmanagerdata__pb2.SetReadWriteResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetReparentEligibility'): tabletmanagerdata__pb2.SetReparentEligibilityResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetSemiSyncAckCount'): tabletmanagerdata__pb2.SetSemiSyncAckCountResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetServingKeyRange'): tabletmanagerdata__pb2.SetServingKeyRangeResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetSuperReadOnly'): tabletmanagerdata__pb2.SetSuperReadOnlyResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetTabletTags'): tabletmanagerdata__pb2.SetTabletTagsResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetThrottle'): tabletmanagerdata__pb2.SetThrottleResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'SlaveStatus'): tabletmanagerdata__pb2.SlaveStatusResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SlaveStatusAllChannels'): tabletmanagerdata__pb2.SlaveStatusAllChannelsResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'SlaveWasPromoted'): tabletmanagerdata__pb2.SlaveWasPromotedResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'SlaveWasRestarted'): tabletmanagerdata__pb2.SlaveWasRestartedResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'Sleep'): tabletmanagerdata__pb2.SleepResponse.SerializeToString,
@@ -1324,41 +3886,125 @@ def beta_create_TabletManager_server(servicer, pool=None, pool_size=None, defaul
     ('tabletmanagerservice.TabletManager', 'StopReplicationAndGetStatus'): tabletmanagerdata__pb2.StopReplicationAndGetStatusResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StopSlave'): tabletmanagerdata__pb2.StopSlaveResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StopSlaveMinimum'): tabletmanagerdata__pb2.StopSlaveMinimumResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'StopSlaveMinimumStream'): tabletmanagerdata__pb2.StopSlaveMinimumStreamResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'StreamKeyRangeBinlog'): tabletmanagerdata__pb2.StreamKeyRangeBinlogResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'StreamQPS'): tabletmanagerdata__pb2.StreamQPSResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'StreamRowsInKeyRange'): tabletmanagerdata__pb2.StreamRowsInKeyRangeResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyElected'): tabletmanagerdata__pb2.TabletExternallyElectedResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyReparented'): tabletmanagerdata__pb2.TabletExternallyReparentedResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'TailGeneralLog'): tabletmanagerdata__pb2.TailGeneralLogResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'TestRestore'): tabletmanagerdata__pb2.TestRestoreResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'TruncateTable'): tabletmanagerdata__pb2.TruncateTableResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ValidateReplicationCredentials'): tabletmanagerdata__pb2.ValidateReplicationCredentialsResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'WaitBlpPosition'): tabletmanagerdata__pb2.WaitBlpPositionResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'WarmUp'): tabletmanagerdata__pb2.WarmUpResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'WatchSchema'): tabletmanagerdata__pb2.WatchSchemaResponse.SerializeToString,
   }
   method_implementations = {
+    ('tabletmanagerservice.TabletManager', 'AbortCutover'): face_utilities.unary_unary_inline(servicer.AbortCutover),
+    ('tabletmanagerservice.TabletManager', 'ApplyGrants'): face_utilities.unary_unary_inline(servicer.ApplyGrants),
     ('tabletmanagerservice.TabletManager', 'ApplySchema'): face_utilities.unary_unary_inline(servicer.ApplySchema),
+    ('tabletmanagerservice.TabletManager', 'ApplyVSchema'): face_utilities.unary_unary_inline(servicer.ApplyVSchema),
+    ('tabletmanagerservice.TabletManager', 'AssessSchemaChange'): face_utilities.unary_unary_inline(servicer.AssessSchemaChange),
     ('tabletmanagerservice.TabletManager', 'Backup'): face_utilities.unary_stream_inline(servicer.Backup),
+    ('tabletmanagerservice.TabletManager', 'BoostReplicationCatchup'): face_utilities.unary_stream_inline(servicer.BoostReplicationCatchup),
+    ('tabletmanagerservice.TabletManager', 'CaptureDiagnostics'): face_utilities.unary_unary_inline(servicer.CaptureDiagnostics),
     ('tabletmanagerservice.TabletManager', 'ChangeType'): face_utilities.unary_unary_inline(servicer.ChangeType),
+    ('tabletmanagerservice.TabletManager', 'CheckBackupReadiness'): face_utilities.unary_unary_inline(servicer.CheckBackupReadiness),
+    ('tabletmanagerservice.TabletManager', 'CheckDataDir'): face_utilities.unary_unary_inline(servicer.CheckDataDir),
+    ('tabletmanagerservice.TabletManager', 'CheckReparentCandidate'): face_utilities.unary_unary_inline(servicer.CheckReparentCandidate),
+    ('tabletmanagerservice.TabletManager', 'CheckRestoreCompatibility'): face_utilities.unary_unary_inline(servicer.CheckRestoreCompatibility),
+    ('tabletmanagerservice.TabletManager', 'CheckTopoConnectivity'): face_utilities.unary_unary_inline(servicer.CheckTopoConnectivity),
+    ('tabletmanagerservice.TabletManager', 'ChecksumTable'): face_utilities.unary_unary_inline(servicer.ChecksumTable),
+    ('tabletmanagerservice.TabletManager', 'CloneStream'): face_utilities.unary_stream_inline(servicer.CloneStream),
+    ('tabletmanagerservice.TabletManager', 'CommitCutover'): face_utilities.unary_unary_inline(servicer.CommitCutover),
+    ('tabletmanagerservice.TabletManager', 'ConfigureReplication'): face_utilities.unary_unary_inline(servicer.ConfigureReplication),
+    ('tabletmanagerservice.TabletManager', 'ConfigureReplicationSSL'): face_utilities.unary_unary_inline(servicer.ConfigureReplicationSSL),
+    ('tabletmanagerservice.TabletManager', 'Decommission'): face_utilities.unary_stream_inline(servicer.Decommission),
     ('tabletmanagerservice.TabletManager', 'DemoteMaster'): face_utilities.unary_unary_inline(servicer.DemoteMaster),
+    ('tabletmanagerservice.TabletManager', 'EnableParallelReplication'): face_utilities.unary_unary_inline(servicer.EnableParallelReplication),
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsAllPrivs'): face_utilities.unary_unary_inline(servicer.ExecuteFetchAsAllPrivs),
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsApp'): face_utilities.unary_unary_inline(servicer.ExecuteFetchAsApp),
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsDba'): face_utilities.unary_unary_inline(servicer.ExecuteFetchAsDba),
+    ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsDbaCSV'): face_utilities.unary_stream_inline(servicer.ExecuteFetchAsDbaCSV),
+    ('tabletmanagerservice.TabletManager', 'ExecuteFetchColumnar'): face_utilities.unary_unary_inline(servicer.ExecuteFetchColumnar),
     ('tabletmanagerservice.TabletManager', 'ExecuteHook'): face_utilities.unary_unary_inline(servicer.ExecuteHook),
+    ('tabletmanagerservice.TabletManager', 'ExecuteHookToStream'): face_utilities.unary_stream_inline(servicer.ExecuteHookToStream),
+    ('tabletmanagerservice.TabletManager', 'ExplainQuery'): face_utilities.unary_unary_inline(servicer.ExplainQuery),
+    ('tabletmanagerservice.TabletManager', 'GetApplierQueueStats'): face_utilities.unary_unary_inline(servicer.GetApplierQueueStats),
+    ('tabletmanagerservice.TabletManager', 'GetBackupFreshness'): face_utilities.unary_unary_inline(servicer.GetBackupFreshness),
+    ('tabletmanagerservice.TabletManager', 'GetBackupLimits'): face_utilities.unary_unary_inline(servicer.GetBackupLimits),
+    ('tabletmanagerservice.TabletManager', 'GetBackupPosition'): face_utilities.unary_unary_inline(servicer.GetBackupPosition),
+    ('tabletmanagerservice.TabletManager', 'GetBinlogFilters'): face_utilities.unary_unary_inline(servicer.GetBinlogFilters),
+    ('tabletmanagerservice.TabletManager', 'GetBinlogStats'): face_utilities.unary_unary_inline(servicer.GetBinlogStats),
+    ('tabletmanagerservice.TabletManager', 'GetCharsetConfig'): face_utilities.unary_unary_inline(servicer.GetCharsetConfig),
+    ('tabletmanagerservice.TabletManager', 'GetConfig'): face_utilities.unary_unary_inline(servicer.GetConfig),
+    ('tabletmanagerservice.TabletManager', 'GetConnectionRejectionStats'): face_utilities.unary_unary_inline(servicer.GetConnectionRejectionStats),
+    ('tabletmanagerservice.TabletManager', 'GetConnectionStats'): face_utilities.unary_unary_inline(servicer.GetConnectionStats),
+    ('tabletmanagerservice.TabletManager', 'GetCreateStatements'): face_utilities.unary_unary_inline(servicer.GetCreateStatements),
+    ('tabletmanagerservice.TabletManager', 'GetDurabilityPolicy'): face_utilities.unary_unary_inline(servicer.GetDurabilityPolicy),
+    ('tabletmanagerservice.TabletManager', 'GetErrorLogTail'): face_utilities.unary_unary_inline(servicer.GetErrorLogTail),
+    ('tabletmanagerservice.TabletManager', 'GetGtidPurged'): face_utilities.unary_unary_inline(servicer.GetGtidPurged),
+    ('tabletmanagerservice.TabletManager', 'GetHealthScore'): face_utilities.unary_unary_inline(servicer.GetHealthScore),
+    ('tabletmanagerservice.TabletManager', 'GetInFlightRPCs'): face_utilities.unary_unary_inline(servicer.GetInFlightRPCs),
+    ('tabletmanagerservice.TabletManager', 'GetLastBackupInfo'): face_utilities.unary_unary_inline(servicer.GetLastBackupInfo),
+    ('tabletmanagerservice.TabletManager', 'GetLastReparentJournalEntry'): face_utilities.unary_unary_inline(servicer.GetLastReparentJournalEntry),
     ('tabletmanagerservice.TabletManager', 'GetPermissions'): face_utilities.unary_unary_inline(servicer.GetPermissions),
+    ('tabletmanagerservice.TabletManager', 'GetPreferredBackup'): face_utilities.unary_unary_inline(servicer.GetPreferredBackup),
+    ('tabletmanagerservice.TabletManager', 'GetProcessList'): face_utilities.unary_unary_inline(servicer.GetProcessList),
+    ('tabletmanagerservice.TabletManager', 'GetProcessStats'): face_utilities.unary_unary_inline(servicer.GetProcessStats),
+    ('tabletmanagerservice.TabletManager', 'GetQueryBlacklist'): face_utilities.unary_unary_inline(servicer.GetQueryBlacklist),
+    ('tabletmanagerservice.TabletManager', 'GetReplicationErrorStats'): face_utilities.unary_unary_inline(servicer.GetReplicationErrorStats),
+    ('tabletmanagerservice.TabletManager', 'GetReplicationGraph'): face_utilities.unary_unary_inline(servicer.GetReplicationGraph),
+    ('tabletmanagerservice.TabletManager', 'GetReplicationSource'): face_utilities.unary_unary_inline(servicer.GetReplicationSource),
     ('tabletmanagerservice.TabletManager', 'GetSchema'): face_utilities.unary_unary_inline(servicer.GetSchema),
+    ('tabletmanagerservice.TabletManager', 'GetSchemaTimestamps'): face_utilities.unary_unary_inline(servicer.GetSchemaTimestamps),
     ('tabletmanagerservice.TabletManager', 'GetSlaves'): face_utilities.unary_unary_inline(servicer.GetSlaves),
+    ('tabletmanagerservice.TabletManager', 'GetTabletTags'): face_utilities.unary_unary_inline(servicer.GetTabletTags),
+    ('tabletmanagerservice.TabletManager', 'GetThrottleState'): face_utilities.unary_unary_inline(servicer.GetThrottleState),
+    ('tabletmanagerservice.TabletManager', 'GetVSchema'): face_utilities.unary_unary_inline(servicer.GetVSchema),
     ('tabletmanagerservice.TabletManager', 'IgnoreHealthError'): face_utilities.unary_unary_inline(servicer.IgnoreHealthError),
+    ('tabletmanagerservice.TabletManager', 'IncrementalBackup'): face_utilities.unary_stream_inline(servicer.IncrementalBackup),
     ('tabletmanagerservice.TabletManager', 'InitMaster'): face_utilities.unary_unary_inline(servicer.InitMaster),
     ('tabletmanagerservice.TabletManager', 'InitSlave'): face_utilities.unary_unary_inline(servicer.InitSlave),
+    ('tabletmanagerservice.TabletManager', 'KillProcess'): face_utilities.unary_unary_inline(servicer.KillProcess),
     ('tabletmanagerservice.TabletManager', 'MasterPosition'): face_utilities.unary_unary_inline(servicer.MasterPosition),
+    ('tabletmanagerservice.TabletManager', 'PauseHealthReporting'): face_utilities.unary_unary_inline(servicer.PauseHealthReporting),
     ('tabletmanagerservice.TabletManager', 'Ping'): face_utilities.unary_unary_inline(servicer.Ping),
     ('tabletmanagerservice.TabletManager', 'PopulateReparentJournal'): face_utilities.unary_unary_inline(servicer.PopulateReparentJournal),
     ('tabletmanagerservice.TabletManager', 'PreflightSchema'): face_utilities.unary_unary_inline(servicer.PreflightSchema),
+    ('tabletmanagerservice.TabletManager', 'PrepareCutover'): face_utilities.unary_unary_inline(servicer.PrepareCutover),
     ('tabletmanagerservice.TabletManager', 'PromoteSlave'): face_utilities.unary_unary_inline(servicer.PromoteSlave),
     ('tabletmanagerservice.TabletManager', 'PromoteSlaveWhenCaughtUp'): face_utilities.unary_unary_inline(servicer.PromoteSlaveWhenCaughtUp),
     ('tabletmanagerservice.TabletManager', 'RefreshState'): face_utilities.unary_unary_inline(servicer.RefreshState),
     ('tabletmanagerservice.TabletManager', 'ReloadSchema'): face_utilities.unary_unary_inline(servicer.ReloadSchema),
+    ('tabletmanagerservice.TabletManager', 'RenameTable'): face_utilities.unary_unary_inline(servicer.RenameTable),
+    ('tabletmanagerservice.TabletManager', 'RepairRelayLog'): face_utilities.unary_stream_inline(servicer.RepairRelayLog),
+    ('tabletmanagerservice.TabletManager', 'RepublishTopoRecord'): face_utilities.unary_unary_inline(servicer.RepublishTopoRecord),
     ('tabletmanagerservice.TabletManager', 'ResetReplication'): face_utilities.unary_unary_inline(servicer.ResetReplication),
+    ('tabletmanagerservice.TabletManager', 'RestartMysql'): face_utilities.unary_stream_inline(servicer.RestartMysql),
     ('tabletmanagerservice.TabletManager', 'RestoreFromBackup'): face_utilities.unary_stream_inline(servicer.RestoreFromBackup),
+    ('tabletmanagerservice.TabletManager', 'RestoreToTimestamp'): face_utilities.unary_stream_inline(servicer.RestoreToTimestamp),
+    ('tabletmanagerservice.TabletManager', 'RotateReplicationCredentials'): face_utilities.unary_unary_inline(servicer.RotateReplicationCredentials),
     ('tabletmanagerservice.TabletManager', 'RunBlpUntil'): face_utilities.unary_unary_inline(servicer.RunBlpUntil),
     ('tabletmanagerservice.TabletManager', 'RunHealthCheck'): face_utilities.unary_unary_inline(servicer.RunHealthCheck),
+    ('tabletmanagerservice.TabletManager', 'SchemaDiff'): face_utilities.unary_unary_inline(servicer.SchemaDiff),
+    ('tabletmanagerservice.TabletManager', 'SetMaintenanceMode'): face_utilities.unary_unary_inline(servicer.SetMaintenanceMode),
     ('tabletmanagerservice.TabletManager', 'SetMaster'): face_utilities.unary_unary_inline(servicer.SetMaster),
+    ('tabletmanagerservice.TabletManager', 'SetPreferredBackup'): face_utilities.unary_unary_inline(servicer.SetPreferredBackup),
+    ('tabletmanagerservice.TabletManager', 'SetQueryBlacklist'): face_utilities.unary_unary_inline(servicer.SetQueryBlacklist),
+    ('tabletmanagerservice.TabletManager', 'SetQueryServerConfig'): face_utilities.unary_unary_inline(servicer.SetQueryServerConfig),
     ('tabletmanagerservice.TabletManager', 'SetReadOnly'): face_utilities.unary_unary_inline(servicer.SetReadOnly),
+    ('tabletmanagerservice.TabletManager', 'SetReadOnlyWithTTL'): face_utilities.unary_unary_inline(servicer.SetReadOnlyWithTTL),
     ('tabletmanagerservice.TabletManager', 'SetReadWrite'): face_utilities.unary_unary_inline(servicer.SetReadWrite),
+    ('tabletmanagerservice.TabletManager', 'SetReparentEligibility'): face_utilities.unary_unary_inline(servicer.SetReparentEligibility),
+    ('tabletmanagerservice.TabletManager', 'SetSemiSyncAckCount'): face_utilities.unary_unary_inline(servicer.SetSemiSyncAckCount),
+    ('tabletmanagerservice.TabletManager', 'SetServingKeyRange'): face_utilities.unary_unary_inline(servicer.SetServingKeyRange),
+    ('tabletmanagerservice.TabletManager', 'SetSuperReadOnly'): face_utilities.unary_unary_inline(servicer.SetSuperReadOnly),
+    ('tabletmanagerservice.TabletManager', 'SetTabletTags'): face_utilities.unary_unary_inline(servicer.SetTabletTags),
+    ('tabletmanagerservice.TabletManager', 'SetThrottle'): face_utilities.unary_unary_inline(servicer.SetThrottle),
     ('tabletmanagerservice.TabletManager', 'SlaveStatus'): face_utilities.unary_unary_inline(servicer.SlaveStatus),
+    ('tabletmanagerservice.TabletManager', 'SlaveStatusAllChannels'): face_utilities.unary_unary_inline(servicer.SlaveStatusAllChannels),
     ('tabletmanagerservice.TabletManager', 'SlaveWasPromoted'): face_utilities.unary_unary_inline(servicer.SlaveWasPromoted),
     ('tabletmanagerservice.TabletManager', 'SlaveWasRestarted'): face_utilities.unary_unary_inline(servicer.SlaveWasRestarted),
     ('tabletmanagerservice.TabletManager', 'Sleep'): face_utilities.unary_unary_inline(servicer.Sleep),
@@ -1368,9 +4014,19 @@ def beta_create_TabletManager_server(servicer, pool=None, pool_size=None, defaul
     ('tabletmanagerservice.TabletManager', 'StopReplicationAndGetStatus'): face_utilities.unary_unary_inline(servicer.StopReplicationAndGetStatus),
     ('tabletmanagerservice.TabletManager', 'StopSlave'): face_utilities.unary_unary_inline(servicer.StopSlave),
     ('tabletmanagerservice.TabletManager', 'StopSlaveMinimum'): face_utilities.unary_unary_inline(servicer.StopSlaveMinimum),
+    ('tabletmanagerservice.TabletManager', 'StopSlaveMinimumStream'): face_utilities.unary_stream_inline(servicer.StopSlaveMinimumStream),
+    ('tabletmanagerservice.TabletManager', 'StreamKeyRangeBinlog'): face_utilities.unary_stream_inline(servicer.StreamKeyRangeBinlog),
+    ('tabletmanagerservice.TabletManager', 'StreamQPS'): face_utilities.unary_stream_inline(servicer.StreamQPS),
+    ('tabletmanagerservice.TabletManager', 'StreamRowsInKeyRange'): face_utilities.unary_stream_inline(servicer.StreamRowsInKeyRange),
     ('tabletmanagerservice.TabletManager', 'TabletExternallyElected'): face_utilities.unary_unary_inline(servicer.TabletExternallyElected),
     ('tabletmanagerservice.TabletManager', 'TabletExternallyReparented'): face_utilities.unary_unary_inline(servicer.TabletExternallyReparented),
+    ('tabletmanagerservice.TabletManager', 'TailGeneralLog'): face_utilities.unary_stream_inline(servicer.TailGeneralLog),
+    ('tabletmanagerservice.TabletManager', 'TestRestore'): face_utilities.unary_stream_inline(servicer.TestRestore),
+    ('tabletmanagerservice.TabletManager', 'TruncateTable'): face_utilities.unary_unary_inline(servicer.TruncateTable),
+    ('tabletmanagerservice.TabletManager', 'ValidateReplicationCredentials'): face_utilities.unary_unary_inline(servicer.ValidateReplicationCredentials),
     ('tabletmanagerservice.TabletManager', 'WaitBlpPosition'): face_utilities.unary_unary_inline(servicer.WaitBlpPosition),
+    ('tabletmanagerservice.TabletManager', 'WarmUp'): face_utilities.unary_stream_inline(servicer.WarmUp),
+    ('tabletmanagerservice.TabletManager', 'WatchSchema'): face_utilities.unary_stream_inline(servicer.WatchSchema),
   }
   server_options = beta_implementations.server_options(request_deserializers=request_deserializers, response_serializers=response_serializers, thread_pool=pool, thread_pool_size=pool_size, default_timeout=default_timeout, maximum_timeout=maximum_timeout)
   return beta_implementations.server(method_implementations, options=server_options)
@@ -1378,36 +4034,110 @@ def beta_create_TabletManager_server(servicer, pool=None, pool_size=None, defaul
 
 def beta_create_TabletManager_stub(channel, host=None, metadata_transformer=None, pool=None, pool_size=None):
   request_serializers = {
+    ('tabletmanagerservice.TabletManager', 'AbortCutover'): tabletmanagerdata__pb2.AbortCutoverRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ApplyGrants'): tabletmanagerdata__pb2.ApplyGrantsRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ApplySchema'): tabletmanagerdata__pb2.ApplySchemaRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ApplyVSchema'): tabletmanagerdata__pb2.ApplyVSchemaRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'AssessSchemaChange'): tabletmanagerdata__pb2.AssessSchemaChangeRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'Backup'): tabletmanagerdata__pb2.BackupRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'BoostReplicationCatchup'): tabletmanagerdata__pb2.BoostReplicationCatchupRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CaptureDiagnostics'): tabletmanagerdata__pb2.CaptureDiagnosticsRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ChangeType'): tabletmanagerdata__pb2.ChangeTypeRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CheckBackupReadiness'): tabletmanagerdata__pb2.CheckBackupReadinessRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CheckDataDir'): tabletmanagerdata__pb2.CheckDataDirRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CheckReparentCandidate'): tabletmanagerdata__pb2.CheckReparentCandidateRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CheckRestoreCompatibility'): tabletmanagerdata__pb2.CheckRestoreCompatibilityRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CheckTopoConnectivity'): tabletmanagerdata__pb2.CheckTopoConnectivityRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ChecksumTable'): tabletmanagerdata__pb2.ChecksumTableRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CloneStream'): tabletmanagerdata__pb2.CloneStreamRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'CommitCutover'): tabletmanagerdata__pb2.CommitCutoverRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ConfigureReplication'): tabletmanagerdata__pb2.ConfigureReplicationRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ConfigureReplicationSSL'): tabletmanagerdata__pb2.ConfigureReplicationSSLRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'Decommission'): tabletmanagerdata__pb2.DecommissionRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'DemoteMaster'): tabletmanagerdata__pb2.DemoteMasterRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'EnableParallelReplication'): tabletmanagerdata__pb2.EnableParallelReplicationRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsAllPrivs'): tabletmanagerdata__pb2.ExecuteFetchAsAllPrivsRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsApp'): tabletmanagerdata__pb2.ExecuteFetchAsAppRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsDba'): tabletmanagerdata__pb2.ExecuteFetchAsDbaRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsDbaCSV'): tabletmanagerdata__pb2.ExecuteFetchAsDbaCSVRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ExecuteFetchColumnar'): tabletmanagerdata__pb2.ExecuteFetchColumnarRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ExecuteHook'): tabletmanagerdata__pb2.ExecuteHookRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ExecuteHookToStream'): tabletmanagerdata__pb2.ExecuteHookToStreamRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ExplainQuery'): tabletmanagerdata__pb2.ExplainQueryRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetApplierQueueStats'): tabletmanagerdata__pb2.GetApplierQueueStatsRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetBackupFreshness'): tabletmanagerdata__pb2.GetBackupFreshnessRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetBackupLimits'): tabletmanagerdata__pb2.GetBackupLimitsRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetBackupPosition'): tabletmanagerdata__pb2.GetBackupPositionRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetBinlogFilters'): tabletmanagerdata__pb2.GetBinlogFiltersRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetBinlogStats'): tabletmanagerdata__pb2.GetBinlogStatsRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetCharsetConfig'): tabletmanagerdata__pb2.GetCharsetConfigRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetConfig'): tabletmanagerdata__pb2.GetConfigRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetConnectionRejectionStats'): tabletmanagerdata__pb2.GetConnectionRejectionStatsRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetConnectionStats'): tabletmanagerdata__pb2.GetConnectionStatsRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetCreateStatements'): tabletmanagerdata__pb2.GetCreateStatementsRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetDurabilityPolicy'): tabletmanagerdata__pb2.GetDurabilityPolicyRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetErrorLogTail'): tabletmanagerdata__pb2.GetErrorLogTailRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetGtidPurged'): tabletmanagerdata__pb2.GetGtidPurgedRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetHealthScore'): tabletmanagerdata__pb2.GetHealthScoreRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetInFlightRPCs'): tabletmanagerdata__pb2.GetInFlightRPCsRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetLastBackupInfo'): tabletmanagerdata__pb2.GetLastBackupInfoRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetLastReparentJournalEntry'): tabletmanagerdata__pb2.GetLastReparentJournalEntryRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'GetPermissions'): tabletmanagerdata__pb2.GetPermissionsRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetPreferredBackup'): tabletmanagerdata__pb2.GetPreferredBackupRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetProcessList'): tabletmanagerdata__pb2.GetProcessListRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetProcessStats'): tabletmanagerdata__pb2.GetProcessStatsRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetQueryBlacklist'): tabletmanagerdata__pb2.GetQueryBlacklistRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetReplicationErrorStats'): tabletmanagerdata__pb2.GetReplicationErrorStatsRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetReplicationGraph'): tabletmanagerdata__pb2.GetReplicationGraphRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetReplicationSource'): tabletmanagerdata__pb2.GetReplicationSourceRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'GetSchema'): tabletmanagerdata__pb2.GetSchemaRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetSchemaTimestamps'): tabletmanagerdata__pb2.GetSchemaTimestampsRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'GetSlaves'): tabletmanagerdata__pb2.GetSlavesRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetTabletTags'): tabletmanagerdata__pb2.GetTabletTagsRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetThrottleState'): tabletmanagerdata__pb2.GetThrottleStateRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'GetVSchema'): tabletmanagerdata__pb2.GetVSchemaRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'IgnoreHealthError'): tabletmanagerdata__pb2.IgnoreHealthErrorRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'IncrementalBackup'): tabletmanagerdata__pb2.IncrementalBackupRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'InitMaster'): tabletmanagerdata__pb2.InitMasterRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'InitSlave'): tabletmanagerdata__pb2.InitSlaveRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'KillProcess'): tabletmanagerdata__pb2.KillProcessRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'MasterPosition'): tabletmanagerdata__pb2.MasterPositionRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'PauseHealthReporting'): tabletmanagerdata__pb2.PauseHealthReportingRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'Ping'): tabletmanagerdata__pb2.PingRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'PopulateReparentJournal'): tabletmanagerdata__pb2.PopulateReparentJournalRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'PreflightSchema'): tabletmanagerdata__pb2.PreflightSchemaRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'PrepareCutover'): tabletmanagerdata__pb2.PrepareCutoverRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'PromoteSlave'): tabletmanagerdata__pb2.PromoteSlaveRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'PromoteSlaveWhenCaughtUp'): tabletmanagerdata__pb2.PromoteSlaveWhenCaughtUpRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'RefreshState'): tabletmanagerdata__pb2.RefreshStateRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ReloadSchema'): tabletmanagerdata__pb2.ReloadSchemaRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'RenameTable'): tabletmanagerdata__pb2.RenameTableRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'RepairRelayLog'): tabletmanagerdata__pb2.RepairRelayLogRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'RepublishTopoRecord'): tabletmanagerdata__pb2.RepublishTopoRecordRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'ResetReplication'): tabletmanagerdata__pb2.ResetReplicationRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'RestartMysql'): tabletmanagerdata__pb2.RestartMysqlRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'RestoreFromBackup'): tabletmanagerdata__pb2.RestoreFromBackupRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'RestoreToTimestamp'): tabletmanagerdata__pb2.RestoreToTimestampRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'RotateReplicationCredentials'): tabletmanagerdata__pb2.RotateReplicationCredentialsRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'RunBlpUntil'): tabletmanagerdata__pb2.RunBlpUntilRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'RunHealthCheck'): tabletmanagerdata__pb2.RunHealthCheckRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SchemaDiff'): tabletmanagerdata__pb2.SchemaDiffRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetMaintenanceMode'): tabletmanagerdata__pb2.SetMaintenanceModeRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'SetMaster'): tabletmanagerdata__pb2.SetMasterRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetPreferredBackup'): tabletmanagerdata__pb2.SetPreferredBackupRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetQueryBlacklist'): tabletmanagerdata__pb2.SetQueryBlacklistRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetQueryServerConfig'): tabletmanagerdata__pb2.SetQueryServerConfigRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'SetReadOnly'): tabletmanagerdata__pb2.SetReadOnlyRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetReadOnlyWithTTL'): tabletmanagerdata__pb2.SetReadOnlyWithTTLRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'SetReadWrite'): tabletmanagerdata__pb2.SetReadWriteRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetReparentEligibility'): tabletmanagerdata__pb2.SetReparentEligibilityRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetSemiSyncAckCount'): tabletmanagerdata__pb2.SetSemiSyncAckCountRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetServingKeyRange'): tabletmanagerdata__pb2.SetServingKeyRangeRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetSuperReadOnly'): tabletmanagerdata__pb2.SetSuperReadOnlyRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetTabletTags'): tabletmanagerdata__pb2.SetTabletTagsRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SetThrottle'): tabletmanagerdata__pb2.SetThrottleRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'SlaveStatus'): tabletmanagerdata__pb2.SlaveStatusRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'SlaveStatusAllChannels'): tabletmanagerdata__pb2.SlaveStatusAllChannelsRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'SlaveWasPromoted'): tabletmanagerdata__pb2.SlaveWasPromotedRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'SlaveWasRestarted'): tabletmanagerdata__pb2.SlaveWasRestartedRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'Sleep'): tabletmanagerdata__pb2.SleepRequest.SerializeToString,
@@ -1417,41 +4147,125 @@ def beta_create_TabletManager_stub(channel, host=None, metadata_transformer=None
     ('tabletmanagerservice.TabletManager', 'StopReplicationAndGetStatus'): tabletmanagerdata__pb2.StopReplicationAndGetStatusRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StopSlave'): tabletmanagerdata__pb2.StopSlaveRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StopSlaveMinimum'): tabletmanagerdata__pb2.StopSlaveMinimumRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'StopSlaveMinimumStream'): tabletmanagerdata__pb2.StopSlaveMinimumStreamRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'StreamKeyRangeBinlog'): tabletmanagerdata__pb2.StreamKeyRangeBinlogRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'StreamQPS'): tabletmanagerdata__pb2.StreamQPSRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'StreamRowsInKeyRange'): tabletmanagerdata__pb2.StreamRowsInKeyRangeRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyElected'): tabletmanagerdata__pb2.TabletExternallyElectedRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyReparented'): tabletmanagerdata__pb2.TabletExternallyReparentedRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'TailGeneralLog'): tabletmanagerdata__pb2.TailGeneralLogRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'TestRestore'): tabletmanagerdata__pb2.TestRestoreRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'TruncateTable'): tabletmanagerdata__pb2.TruncateTableRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'ValidateReplicationCredentials'): tabletmanagerdata__pb2.ValidateReplicationCredentialsRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'WaitBlpPosition'): tabletmanagerdata__pb2.WaitBlpPositionRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'WarmUp'): tabletmanagerdata__pb2.WarmUpRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'WatchSchema'): tabletmanagerdata__pb2.WatchSchemaRequest.SerializeToString,
   }
   response_deserializers = {
+    ('tabletmanagerservice.TabletManager', 'AbortCutover'): tabletmanagerdata__pb2.AbortCutoverResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'ApplyGrants'): tabletmanagerdata__pb2.ApplyGrantsResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'ApplySchema'): tabletmanagerdata__pb2.ApplySchemaResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'ApplyVSchema'): tabletmanagerdata__pb2.ApplyVSchemaResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'AssessSchemaChange'): tabletmanagerdata__pb2.AssessSchemaChangeResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'Backup'): tabletmanagerdata__pb2.BackupResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'BoostReplicationCatchup'): tabletmanagerdata__pb2.BoostReplicationCatchupResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'CaptureDiagnostics'): tabletmanagerdata__pb2.CaptureDiagnosticsResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'ChangeType'): tabletmanagerdata__pb2.ChangeTypeResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'CheckBackupReadiness'): tabletmanagerdata__pb2.CheckBackupReadinessResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'CheckDataDir'): tabletmanagerdata__pb2.CheckDataDirResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'CheckReparentCandidate'): tabletmanagerdata__pb2.CheckReparentCandidateResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'CheckRestoreCompatibility'): tabletmanagerdata__pb2.CheckRestoreCompatibilityResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'CheckTopoConnectivity'): tabletmanagerdata__pb2.CheckTopoConnectivityResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'ChecksumTable'): tabletmanagerdata__pb2.ChecksumTableResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'CloneStream'): tabletmanagerdata__pb2.CloneStreamResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'CommitCutover'): tabletmanagerdata__pb2.CommitCutoverResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'ConfigureReplication'): tabletmanagerdata__pb2.ConfigureReplicationResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'ConfigureReplicationSSL'): tabletmanagerdata__pb2.ConfigureReplicationSSLResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'Decommission'): tabletmanagerdata__pb2.DecommissionResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'DemoteMaster'): tabletmanagerdata__pb2.DemoteMasterResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'EnableParallelReplication'): tabletmanagerdata__pb2.EnableParallelReplicationResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsAllPrivs'): tabletmanagerdata__pb2.ExecuteFetchAsAllPrivsResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsApp'): tabletmanagerdata__pb2.ExecuteFetchAsAppResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsDba'): tabletmanagerdata__pb2.ExecuteFetchAsDbaResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'ExecuteFetchAsDbaCSV'): tabletmanagerdata__pb2.ExecuteFetchAsDbaCSVResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'ExecuteFetchColumnar'): tabletmanagerdata__pb2.ExecuteFetchColumnarResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'ExecuteHook'): tabletmanagerdata__pb2.ExecuteHookResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'ExecuteHookToStream'): tabletmanagerdata__pb2.ExecuteHookToStreamResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'ExplainQuery'): tabletmanagerdata__pb2.ExplainQueryResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetApplierQueueStats'): tabletmanagerdata__pb2.GetApplierQueueStatsResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetBackupFreshness'): tabletmanagerdata__pb2.GetBackupFreshnessResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetBackupLimits'): tabletmanagerdata__pb2.GetBackupLimitsResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetBackupPosition'): tabletmanagerdata__pb2.GetBackupPositionResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetBinlogFilters'): tabletmanagerdata__pb2.GetBinlogFiltersResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetBinlogStats'): tabletmanagerdata__pb2.GetBinlogStatsResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetCharsetConfig'): tabletmanagerdata__pb2.GetCharsetConfigResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetConfig'): tabletmanagerdata__pb2.GetConfigResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetConnectionRejectionStats'): tabletmanagerdata__pb2.GetConnectionRejectionStatsResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetConnectionStats'): tabletmanagerdata__pb2.GetConnectionStatsResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetCreateStatements'): tabletmanagerdata__pb2.GetCreateStatementsResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetDurabilityPolicy'): tabletmanagerdata__pb2.GetDurabilityPolicyResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetErrorLogTail'): tabletmanagerdata__pb2.GetErrorLogTailResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetGtidPurged'): tabletmanagerdata__pb2.GetGtidPurgedResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetHealthScore'): tabletmanagerdata__pb2.GetHealthScoreResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetInFlightRPCs'): tabletmanagerdata__pb2.GetInFlightRPCsResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetLastBackupInfo'): tabletmanagerdata__pb2.GetLastBackupInfoResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetLastReparentJournalEntry'): tabletmanagerdata__pb2.GetLastReparentJournalEntryResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'GetPermissions'): tabletmanagerdata__pb2.GetPermissionsResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetPreferredBackup'): tabletmanagerdata__pb2.GetPreferredBackupResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetProcessList'): tabletmanagerdata__pb2.GetProcessListResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetProcessStats'): tabletmanagerdata__pb2.GetProcessStatsResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetQueryBlacklist'): tabletmanagerdata__pb2.GetQueryBlacklistResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetReplicationErrorStats'): tabletmanagerdata__pb2.GetReplicationErrorStatsResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetReplicationGraph'): tabletmanagerdata__pb2.GetReplicationGraphResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetReplicationSource'): tabletmanagerdata__pb2.GetReplicationSourceResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'GetSchema'): tabletmanagerdata__pb2.GetSchemaResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetSchemaTimestamps'): tabletmanagerdata__pb2.GetSchemaTimestampsResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'GetSlaves'): tabletmanagerdata__pb2.GetSlavesResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetTabletTags'): tabletmanagerdata__pb2.GetTabletTagsResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetThrottleState'): tabletmanagerdata__pb2.GetThrottleStateResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'GetVSchema'): tabletmanagerdata__pb2.GetVSchemaResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'IgnoreHealthError'): tabletmanagerdata__pb2.IgnoreHealthErrorResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'IncrementalBackup'): tabletmanagerdata__pb2.IncrementalBackupResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'InitMaster'): tabletmanagerdata__pb2.InitMasterResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'InitSlave'): tabletmanagerdata__pb2.InitSlaveResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'KillProcess'): tabletmanagerdata__pb2.KillProcessResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'MasterPosition'): tabletmanagerdata__pb2.MasterPositionResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'PauseHealthReporting'): tabletmanagerdata__pb2.PauseHealthReportingResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'Ping'): tabletmanagerdata__pb2.PingResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'PopulateReparentJournal'): tabletmanagerdata__pb2.PopulateReparentJournalResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'PreflightSchema'): tabletmanagerdata__pb2.PreflightSchemaResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'PrepareCutover'): tabletmanagerdata__pb2.PrepareCutoverResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'PromoteSlave'): tabletmanagerdata__pb2.PromoteSlaveResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'PromoteSlaveWhenCaughtUp'): tabletmanagerdata__pb2.PromoteSlaveWhenCaughtUpResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'RefreshState'): tabletmanagerdata__pb2.RefreshStateResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'ReloadSchema'): tabletmanagerdata__pb2.ReloadSchemaResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'RenameTable'): tabletmanagerdata__pb2.RenameTableResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'RepairRelayLog'): tabletmanagerdata__pb2.RepairRelayLogResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'RepublishTopoRecord'): tabletmanagerdata__pb2.RepublishTopoRecordResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'ResetReplication'): tabletmanagerdata__pb2.ResetReplicationResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'RestartMysql'): tabletmanagerdata__pb2.RestartMysqlResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'RestoreFromBackup'): tabletmanagerdata__pb2.RestoreFromBackupResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'RestoreToTimestamp'): tabletmanagerdata__pb2.RestoreToTimestampResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'RotateReplicationCredentials'): tabletmanagerdata__pb2.RotateReplicationCredentialsResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'RunBlpUntil'): tabletmanagerdata__pb2.RunBlpUntilResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'RunHealthCheck'): tabletmanagerdata__pb2.RunHealthCheckResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SchemaDiff'): tabletmanagerdata__pb2.SchemaDiffResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetMaintenanceMode'): tabletmanagerdata__pb2.SetMaintenanceModeResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'SetMaster'): tabletmanagerdata__pb2.SetMasterResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetPreferredBackup'): tabletmanagerdata__pb2.SetPreferredBackupResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetQueryBlacklist'): tabletmanagerdata__pb2.SetQueryBlacklistResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetQueryServerConfig'): tabletmanagerdata__pb2.SetQueryServerConfigResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'SetReadOnly'): tabletmanagerdata__pb2.SetReadOnlyResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetReadOnlyWithTTL'): tabletmanagerdata__pb2.SetReadOnlyWithTTLResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'SetReadWrite'): tabletmanagerdata__pb2.SetReadWriteResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetReparentEligibility'): tabletmanagerdata__pb2.SetReparentEligibilityResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetSemiSyncAckCount'): tabletmanagerdata__pb2.SetSemiSyncAckCountResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetServingKeyRange'): tabletmanagerdata__pb2.SetServingKeyRangeResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetSuperReadOnly'): tabletmanagerdata__pb2.SetSuperReadOnlyResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetTabletTags'): tabletmanagerdata__pb2.SetTabletTagsResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SetThrottle'): tabletmanagerdata__pb2.SetThrottleResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'SlaveStatus'): tabletmanagerdata__pb2.SlaveStatusResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'SlaveStatusAllChannels'): tabletmanagerdata__pb2.SlaveStatusAllChannelsResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'SlaveWasPromoted'): tabletmanagerdata__pb2.SlaveWasPromotedResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'SlaveWasRestarted'): tabletmanagerdata__pb2.SlaveWasRestartedResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'Sleep'): tabletmanagerdata__pb2.SleepResponse.FromString,
@@ -1461,41 +4275,125 @@ def beta_create_TabletManager_stub(channel, host=None, metadata_transformer=None
     ('tabletmanagerservice.TabletManager', 'StopReplicationAndGetStatus'): tabletmanagerdata__pb2.StopReplicationAndGetStatusResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'StopSlave'): tabletmanagerdata__pb2.StopSlaveResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'StopSlaveMinimum'): tabletmanagerdata__pb2.StopSlaveMinimumResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'StopSlaveMinimumStream'): tabletmanagerdata__pb2.StopSlaveMinimumStreamResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'StreamKeyRangeBinlog'): tabletmanagerdata__pb2.StreamKeyRangeBinlogResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'StreamQPS'): tabletmanagerdata__pb2.StreamQPSResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'StreamRowsInKeyRange'): tabletmanagerdata__pb2.StreamRowsInKeyRangeResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyElected'): tabletmanagerdata__pb2.TabletExternallyElectedResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyReparented'): tabletmanagerdata__pb2.TabletExternallyReparentedResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'TailGeneralLog'): tabletmanagerdata__pb2.TailGeneralLogResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'TestRestore'): tabletmanagerdata__pb2.TestRestoreResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'TruncateTable'): tabletmanagerdata__pb2.TruncateTableResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'ValidateReplicationCredentials'): tabletmanagerdata__pb2.ValidateReplicationCredentialsResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'WaitBlpPosition'): tabletmanagerdata__pb2.WaitBlpPositionResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'WarmUp'): tabletmanagerdata__pb2.WarmUpResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'WatchSchema'): tabletmanagerdata__pb2.WatchSchemaResponse.FromString,
   }
   cardinalities = {
+    'AbortCutover': cardinality.Cardinality.UNARY_UNARY,
+    'ApplyGrants': cardinality.Cardinality.UNARY_UNARY,
     'ApplySchema': cardinality.Cardinality.UNARY_UNARY,
+    'ApplyVSchema': cardinality.Cardinality.UNARY_UNARY,
+    'AssessSchemaChange': cardinality.Cardinality.UNARY_UNARY,
     'Backup': cardinality.Cardinality.UNARY_STREAM,
+    'BoostReplicationCatchup': cardinality.Cardinality.UNARY_STREAM,
+    'CaptureDiagnostics': cardinality.Cardinality.UNARY_UNARY,
     'ChangeType': cardinality.Cardinality.UNARY_UNARY,
+    'CheckBackupReadiness': cardinality.Cardinality.UNARY_UNARY,
+    'CheckDataDir': cardinality.Cardinality.UNARY_UNARY,
+    'CheckReparentCandidate': cardinality.Cardinality.UNARY_UNARY,
+    'CheckRestoreCompatibility': cardinality.Cardinality.UNARY_UNARY,
+    'CheckTopoConnectivity': cardinality.Cardinality.UNARY_UNARY,
+    'ChecksumTable': cardinality.Cardinality.UNARY_UNARY,
+    'CloneStream': cardinality.Cardinality.UNARY_STREAM,
+    'CommitCutover': cardinality.Cardinality.UNARY_UNARY,
+    'ConfigureReplication': cardinality.Cardinality.UNARY_UNARY,
+    'ConfigureReplicationSSL': cardinality.Cardinality.UNARY_UNARY,
+    'Decommission': cardinality.Cardinality.UNARY_STREAM,
     'DemoteMaster': cardinality.Cardinality.UNARY_UNARY,
+    'EnableParallelReplication': cardinality.Cardinality.UNARY_UNARY,
     'ExecuteFetchAsAllPrivs': cardinality.Cardinality.UNARY_UNARY,
     'ExecuteFetchAsApp': cardinality.Cardinality.UNARY_UNARY,
     'ExecuteFetchAsDba': cardinality.Cardinality.UNARY_UNARY,
+    'ExecuteFetchAsDbaCSV': cardinality.Cardinality.UNARY_STREAM,
+    'ExecuteFetchColumnar': cardinality.Cardinality.UNARY_UNARY,
     'ExecuteHook': cardinality.Cardinality.UNARY_UNARY,
+    'ExecuteHookToStream': cardinality.Cardinality.UNARY_STREAM,
+    'ExplainQuery': cardinality.Cardinality.UNARY_UNARY,
+    'GetApplierQueueStats': cardinality.Cardinality.UNARY_UNARY,
+    'GetBackupFreshness': cardinality.Cardinality.UNARY_UNARY,
+    'GetBackupLimits': cardinality.Cardinality.UNARY_UNARY,
+    'GetBackupPosition': cardinality.Cardinality.UNARY_UNARY,
+    'GetBinlogFilters': cardinality.Cardinality.UNARY_UNARY,
+    'GetBinlogStats': cardinality.Cardinality.UNARY_UNARY,
+    'GetCharsetConfig': cardinality.Cardinality.UNARY_UNARY,
+    'GetConfig': cardinality.Cardinality.UNARY_UNARY,
+    'GetConnectionRejectionStats': cardinality.Cardinality.UNARY_UNARY,
+    'GetConnectionStats': cardinality.Cardinality.UNARY_UNARY,
+    'GetCreateStatements': cardinality.Cardinality.UNARY_UNARY,
+    'GetDurabilityPolicy': cardinality.Cardinality.UNARY_UNARY,
+    'GetErrorLogTail': cardinality.Cardinality.UNARY_UNARY,
+    'GetGtidPurged': cardinality.Cardinality.UNARY_UNARY,
+    'GetHealthScore': cardinality.Cardinality.UNARY_UNARY,
+    'GetInFlightRPCs': cardinality.Cardinality.UNARY_UNARY,
+    'GetLastBackupInfo': cardinality.Cardinality.UNARY_UNARY,
+    'GetLastReparentJournalEntry': cardinality.Cardinality.UNARY_UNARY,
     'GetPermissions': cardinality.Cardinality.UNARY_UNARY,
+    'GetPreferredBackup': cardinality.Cardinality.UNARY_UNARY,
+    'GetProcessList': cardinality.Cardinality.UNARY_UNARY,
+    'GetProcessStats': cardinality.Cardinality.UNARY_UNARY,
+    'GetQueryBlacklist': cardinality.Cardinality.UNARY_UNARY,
+    'GetReplicationErrorStats': cardinality.Cardinality.UNARY_UNARY,
+    'GetReplicationGraph': cardinality.Cardinality.UNARY_UNARY,
+    'GetReplicationSource': cardinality.Cardinality.UNARY_UNARY,
     'GetSchema': cardinality.Cardinality.UNARY_UNARY,
+    'GetSchemaTimestamps': cardinality.Cardinality.UNARY_UNARY,
     'GetSlaves': cardinality.Cardinality.UNARY_UNARY,
+    'GetTabletTags': cardinality.Cardinality.UNARY_UNARY,
+    'GetThrottleState': cardinality.Cardinality.UNARY_UNARY,
+    'GetVSchema': cardinality.Cardinality.UNARY_UNARY,
     'IgnoreHealthError': cardinality.Cardinality.UNARY_UNARY,
+    'IncrementalBackup': cardinality.Cardinality.UNARY_STREAM,
     'InitMaster': cardinality.Cardinality.UNARY_UNARY,
     'InitSlave': cardinality.Cardinality.UNARY_UNARY,
+    'KillProcess': cardinality.Cardinality.UNARY_UNARY,
     'MasterPosition': cardinality.Cardinality.UNARY_UNARY,
+    'PauseHealthReporting': cardinality.Cardinality.UNARY_UNARY,
     'Ping': cardinality.Cardinality.UNARY_UNARY,
     'PopulateReparentJournal': cardinality.Cardinality.UNARY_UNARY,
     'PreflightSchema': cardinality.Cardinality.UNARY_UNARY,
+    'PrepareCutover': cardinality.Cardinality.UNARY_UNARY,
     'PromoteSlave': cardinality.Cardinality.UNARY_UNARY,
     'PromoteSlaveWhenCaughtUp': cardinality.Cardinality.UNARY_UNARY,
     'RefreshState': cardinality.Cardinality.UNARY_UNARY,
     'ReloadSchema': cardinality.Cardinality.UNARY_UNARY,
+    'RenameTable': cardinality.Cardinality.UNARY_UNARY,
+    'RepairRelayLog': cardinality.Cardinality.UNARY_STREAM,
+    'RepublishTopoRecord': cardinality.Cardinality.UNARY_UNARY,
     'ResetReplication': cardinality.Cardinality.UNARY_UNARY,
+    'RestartMysql': cardinality.Cardinality.UNARY_STREAM,
     'RestoreFromBackup': cardinality.Cardinality.UNARY_STREAM,
+    'RestoreToTimestamp': cardinality.Cardinality.UNARY_STREAM,
+    'RotateReplicationCredentials': cardinality.Cardinality.UNARY_UNARY,
     'RunBlpUntil': cardinality.Cardinality.UNARY_UNARY,
     'RunHealthCheck': cardinality.Cardinality.UNARY_UNARY,
+    'SchemaDiff': cardinality.Cardinality.UNARY_UNARY,
+    'SetMaintenanceMode': cardinality.Cardinality.UNARY_UNARY,
     'SetMaster': cardinality.Cardinality.UNARY_UNARY,
+    'SetPreferredBackup': cardinality.Cardinality.UNARY_UNARY,
+    'SetQueryBlacklist': cardinality.Cardinality.UNARY_UNARY,
+    'SetQueryServerConfig': cardinality.Cardinality.UNARY_UNARY,
     'SetReadOnly': cardinality.Cardinality.UNARY_UNARY,
+    'SetReadOnlyWithTTL': cardinality.Cardinality.UNARY_UNARY,
     'SetReadWrite': cardinality.Cardinality.UNARY_UNARY,
+    'SetReparentEligibility': cardinality.Cardinality.UNARY_UNARY,
+    'SetSemiSyncAckCount': cardinality.Cardinality.UNARY_UNARY,
+    'SetServingKeyRange': cardinality.Cardinality.UNARY_UNARY,
+    'SetSuperReadOnly': cardinality.Cardinality.UNARY_UNARY,
+    'SetTabletTags': cardinality.Cardinality.UNARY_UNARY,
+    'SetThrottle': cardinality.Cardinality.UNARY_UNARY,
     'SlaveStatus': cardinality.Cardinality.UNARY_UNARY,
+    'SlaveStatusAllChannels': cardinality.Cardinality.UNARY_UNARY,
     'SlaveWasPromoted': cardinality.Cardinality.UNARY_UNARY,
     'SlaveWasRestarted': cardinality.Cardinality.UNARY_UNARY,
     'Sleep': cardinality.Cardinality.UNARY_UNARY,
@@ -1505,9 +4403,19 @@ def beta_create_TabletManager_stub(channel, host=None, metadata_transformer=None
     'StopReplicationAndGetStatus': cardinality.Cardinality.UNARY_UNARY,
     'StopSlave': cardinality.Cardinality.UNARY_UNARY,
     'StopSlaveMinimum': cardinality.Cardinality.UNARY_UNARY,
+    'StopSlaveMinimumStream': cardinality.Cardinality.UNARY_STREAM,
+    'StreamKeyRangeBinlog': cardinality.Cardinality.UNARY_STREAM,
+    'StreamQPS': cardinality.Cardinality.UNARY_STREAM,
+    'StreamRowsInKeyRange': cardinality.Cardinality.UNARY_STREAM,
     'TabletExternallyElected': cardinality.Cardinality.UNARY_UNARY,
     'TabletExternallyReparented': cardinality.Cardinality.UNARY_UNARY,
+    'TailGeneralLog': cardinality.Cardinality.UNARY_STREAM,
+    'TestRestore': cardinality.Cardinality.UNARY_STREAM,
+    'TruncateTable': cardinality.Cardinality.UNARY_UNARY,
+    'ValidateReplicationCredentials': cardinality.Cardinality.UNARY_UNARY,
     'WaitBlpPosition': cardinality.Cardinality.UNARY_UNARY,
+    'WarmUp': cardinality.Cardinality.UNARY_STREAM,
+    'WatchSchema': cardinality.Cardinality.UNARY_STREAM,
   }
   stub_options = beta_implementations.stub_options(host=host, metadata_transformer=metadata_transformer, request_serializers=request_serializers, response_deserializers=response_deserializers, thread_pool=pool, thread_pool_size=pool_size)
   return beta_implementations.dynamic_stub(channel, 'tabletmanagerservice.TabletManager', cardinalities, options=stub_options)